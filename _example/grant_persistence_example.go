@@ -0,0 +1,211 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	gosql "database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+)
+
+// This file shows two ways an application might store the data of the "mysql" database outside of memory. Either one
+// is given to the database with "SetPersister", as shown in users_example.go.
+//
+// FilePersister stores all of the data in a single file, which is rewritten whenever a user or privilege changes. As
+// it can also load the file, FLUSH PRIVILEGES picks up changes made to the file by other processes.
+//
+// SQLPersister stores every user and role grant as a separate row of another SQL database, and only writes the rows
+// changed by each statement, within a transaction, so that a GRANT never rewrites the data of other users.
+
+// FilePersister stores the data of the "mysql" database in a file.
+type FilePersister struct {
+	Path string
+}
+
+var _ mysql_db.LoadableMySQLDbPersistence = (*FilePersister)(nil)
+
+// Persist implements the interface mysql_db.MySQLDbPersistence. The data is written to a temporary file, which then
+// replaces the file, so that a crash never leaves a partially written file behind.
+func (p *FilePersister) Persist(ctx *sql.Context, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(p.Path), filepath.Base(p.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), p.Path)
+}
+
+// Load implements the interface mysql_db.LoadableMySQLDbPersistence. The data it returns is given to "LoadData" when
+// the application starts.
+func (p *FilePersister) Load(ctx *sql.Context) ([]byte, error) {
+	data, err := os.ReadFile(p.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// SQLPersister stores the users and role grants of the "mysql" database in tables of another SQL database, such as a
+// MySQL server opened with the "github.com/go-sql-driver/mysql" driver. Replication sources aren't stored.
+type SQLPersister struct {
+	DB *gosql.DB
+}
+
+var _ mysql_db.IncrementalMySQLDbPersistence = (*SQLPersister)(nil)
+
+// CreateTables creates the tables the users and role grants are stored in, if they don't exist yet.
+func (p *SQLPersister) CreateTables(ctx *sql.Context) error {
+	for _, query := range []string{
+		"CREATE TABLE IF NOT EXISTS grant_users (host VARCHAR(255), user VARCHAR(32), data LONGTEXT, PRIMARY KEY (host, user))",
+		"CREATE TABLE IF NOT EXISTS grant_role_edges (from_host VARCHAR(255), from_user VARCHAR(32), to_host VARCHAR(255), to_user VARCHAR(32), data LONGTEXT, PRIMARY KEY (from_host, from_user, to_host, to_user))",
+	} {
+		if _, err := p.DB.ExecContext(ctx, query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Persist implements the interface mysql_db.MySQLDbPersistence. The database only calls PersistChanges, so this is
+// only needed to store data persisted with another persister, which replaces all of the stored data.
+func (p *SQLPersister) Persist(ctx *sql.Context, data []byte) error {
+	loaded := mysql_db.CreateEmptyMySQLDb()
+	if err := loaded.LoadData(ctx, data); err != nil {
+		return err
+	}
+	changes := mysql_db.MySQLDbChanges{Reset: true}
+	for _, entry := range loaded.UserTable().Data().ToSlice(ctx) {
+		changes.Users = append(changes.Users, entry.(*mysql_db.User))
+	}
+	for _, entry := range loaded.RoleEdgesTable().Data().ToSlice(ctx) {
+		changes.RoleEdges = append(changes.RoleEdges, entry.(*mysql_db.RoleEdge))
+	}
+	return p.PersistChanges(ctx, changes)
+}
+
+// PersistChanges implements the interface mysql_db.IncrementalMySQLDbPersistence. All of the changes are written in a
+// single transaction, so that either all of them are stored or none of them are.
+func (p *SQLPersister) PersistChanges(ctx *sql.Context, changes mysql_db.MySQLDbChanges) (err error) {
+	tx, err := p.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if changes.Reset {
+		for _, query := range []string{"DELETE FROM grant_users", "DELETE FROM grant_role_edges"} {
+			if _, err = tx.ExecContext(ctx, query); err != nil {
+				return err
+			}
+		}
+	}
+	for _, user := range changes.Users {
+		var data string
+		if data, err = user.ToJson(ctx); err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, "REPLACE INTO grant_users VALUES (?, ?, ?)", user.Host, user.User, data); err != nil {
+			return err
+		}
+	}
+	for _, key := range changes.RemovedUsers {
+		if _, err = tx.ExecContext(ctx, "DELETE FROM grant_users WHERE host = ? AND user = ?", key.Host, key.User); err != nil {
+			return err
+		}
+	}
+	for _, edge := range changes.RoleEdges {
+		var data string
+		if data, err = edge.ToJson(ctx); err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, "REPLACE INTO grant_role_edges VALUES (?, ?, ?, ?, ?)",
+			edge.FromHost, edge.FromUser, edge.ToHost, edge.ToUser, data); err != nil {
+			return err
+		}
+	}
+	for _, key := range changes.RemovedRoleEdges {
+		if _, err = tx.ExecContext(ctx, "DELETE FROM grant_role_edges WHERE from_host = ? AND from_user = ? AND to_host = ? AND to_user = ?",
+			key.FromHost, key.FromUser, key.ToHost, key.ToUser); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Load implements the interface mysql_db.LoadableMySQLDbPersistence. The stored users and role grants are returned in
+// the JSON format that "LoadData" accepts.
+func (p *SQLPersister) Load(ctx *sql.Context) ([]byte, error) {
+	var data struct {
+		Users []*mysql_db.User
+		Roles []*mysql_db.RoleEdge
+	}
+	if err := p.loadRows(ctx, "SELECT data FROM grant_users", func(row string) error {
+		user := &mysql_db.User{}
+		data.Users = append(data.Users, user)
+		return json.Unmarshal([]byte(row), user)
+	}); err != nil {
+		return nil, err
+	}
+	if err := p.loadRows(ctx, "SELECT data FROM grant_role_edges", func(row string) error {
+		edge := &mysql_db.RoleEdge{}
+		data.Roles = append(data.Roles, edge)
+		return json.Unmarshal([]byte(row), edge)
+	}); err != nil {
+		return nil, err
+	}
+	if len(data.Users) == 0 && len(data.Roles) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(data)
+}
+
+// loadRows calls |load| with the single column of every row returned by the query given.
+func (p *SQLPersister) loadRows(ctx *sql.Context, query string, load func(string) error) error {
+	rows, err := p.DB.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var row string
+		if err = rows.Scan(&row); err != nil {
+			return err
+		}
+		if err = load(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
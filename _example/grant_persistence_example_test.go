@@ -0,0 +1,75 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	gosql "database/sql"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+)
+
+func TestFilePersister(t *testing.T) {
+	testGrantPersister(t, &FilePersister{Path: filepath.Join(t.TempDir(), "privileges.db")})
+}
+
+func TestSQLPersister(t *testing.T) {
+	enableUsers = false
+	useUnusedPort(t)
+	go func() {
+		main()
+	}()
+
+	db, err := gosql.Open("mysql", fmt.Sprintf("root:@tcp(%s:%d)/%s", address, port, dbName))
+	require.NoError(t, err)
+	defer db.Close()
+	require.Eventually(t, func() bool { return db.Ping() == nil }, 5*time.Second, 10*time.Millisecond)
+	persister := &SQLPersister{DB: db}
+	require.NoError(t, persister.CreateTables(sql.NewEmptyContext()))
+	testGrantPersister(t, persister)
+}
+
+// testGrantPersister persists users with the persister given, and checks that they're loaded back.
+func testGrantPersister(t *testing.T, persister mysql_db.LoadableMySQLDbPersistence) {
+	ctx := sql.NewEmptyContext()
+	db := mysql_db.CreateEmptyMySQLDb()
+	db.SetPersister(persister)
+	db.AddRootAccount()
+
+	for _, name := range []string{"alice", "bob"} {
+		user := &mysql_db.User{User: name, Host: "localhost", PrivilegeSet: mysql_db.NewPrivilegeSet(), Plugin: "mysql_native_password"}
+		user.PrivilegeSet.AddGlobalStatic(sql.PrivilegeType_Select)
+		require.NoError(t, db.UserTable().Data().Put(ctx, user))
+		require.NoError(t, db.Persist(ctx))
+	}
+	require.NoError(t, db.UserTable().Data().Remove(ctx, mysql_db.UserPrimaryKey{Host: "localhost", User: "alice"}, nil))
+	require.NoError(t, db.Persist(ctx))
+
+	data, err := persister.Load(ctx)
+	require.NoError(t, err)
+	loaded := mysql_db.CreateEmptyMySQLDb()
+	require.NoError(t, loaded.LoadData(ctx, data))
+	require.Nil(t, loaded.GetUser("alice", "localhost", false))
+	bob := loaded.GetUser("bob", "localhost", false)
+	require.NotNil(t, bob)
+	require.True(t, bob.PrivilegeSet.Has(sql.PrivilegeType_Select))
+	require.Nil(t, loaded.GetUser("root", "localhost", false))
+}
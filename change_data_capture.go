@@ -0,0 +1,73 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// EnableChangeDataCapture registers a new change feed in the engine's services, which captures the committed changes
+// of the tables subscribed to with SubscribeChanges, and returns it. The feed already registered is returned if
+// there's one.
+func (e *Engine) EnableChangeDataCapture() *sql.ChangeFeed {
+	if feed, ok := sql.LookupService(e.Services, sql.ChangeFeedService); ok {
+		return feed
+	}
+	feed := sql.NewChangeFeed()
+	sql.RegisterService(e.Services, sql.ChangeFeedService, feed)
+	return feed
+}
+
+// SubscribeChanges returns a new subscription to the committed changes of the tables given, which buffers the number
+// of changes given until they're read, enabling change data capture if it isn't already. It returns
+// ErrChangeCaptureNotSupported if a table doesn't notify its changes. Subscriptions must be closed once they're not
+// read anymore, since transactions committing changes to their tables wait for them once their buffer is full.
+func (e *Engine) SubscribeChanges(ctx *sql.Context, bufferSize int, tables ...sql.DbTable) (*sql.ChangeSubscription, error) {
+	captured, err := e.capturedTables(ctx, tables)
+	if err != nil {
+		return nil, err
+	}
+	return e.EnableChangeDataCapture().Subscribe(bufferSize, captured...), nil
+}
+
+// OnTableChanges registers the callback given to be called with each committed insert, update and delete of the rows
+// of the tables given, with the rows before and after the change, enabling change data capture if it isn't already.
+// The callback is called after the transaction making the changes is committed, on the goroutine committing it, until
+// the subscription returned is closed. It returns ErrChangeCaptureNotSupported if a table doesn't notify its changes.
+func (e *Engine) OnTableChanges(ctx *sql.Context, callback sql.ChangeCallback, tables ...sql.DbTable) (*sql.ChangeSubscription, error) {
+	captured, err := e.capturedTables(ctx, tables)
+	if err != nil {
+		return nil, err
+	}
+	return e.EnableChangeDataCapture().OnChange(callback, captured...), nil
+}
+
+// capturedTables returns the tables given whose changes are captured by change data capture. It returns
+// ErrChangeCaptureNotSupported if a table doesn't notify its changes.
+func (e *Engine) capturedTables(ctx *sql.Context, tables []sql.DbTable) ([]sql.CapturedTable, error) {
+	captured := make([]sql.CapturedTable, len(tables))
+	for i, t := range tables {
+		table, db, err := e.Analyzer.Catalog.Table(ctx, t.Db, t.Table)
+		if err != nil {
+			return nil, err
+		}
+		notifying, ok := sql.GetChangeNotifyingTable(table)
+		if !ok {
+			return nil, sql.ErrChangeCaptureNotSupported.New(t.String())
+		}
+		captured[i] = sql.CapturedTable{Database: db.Name(), Table: notifying}
+	}
+	return captured, nil
+}
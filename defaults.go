@@ -0,0 +1,83 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Defaults are the defaults of the settings of an engine and its sessions, which are otherwise set with SET GLOBAL
+// statements or sql.SystemVariables. The settings held by system variables are set as their global values when the
+// engine is created, which sessions copy when they're created. System variables are shared by the engines of a
+// process, so the defaults of the engine created last apply to them. Settings are left unchanged when they're zero.
+// New panics if a default isn't valid for its system variable.
+type Defaults struct {
+	// SQLMode is the default sql_mode of sessions.
+	SQLMode string
+	// TimeZone is the default time_zone of sessions, e.g. "+00:00" or "SYSTEM".
+	TimeZone string
+	// MaxConnections is the max_connections limit, which servers configured with server.Config.NewConfig use.
+	MaxConnections int64
+	// DisableForeignKeyChecks disables the enforcement of foreign keys by default, setting foreign_key_checks to 0.
+	DisableForeignKeyChecks bool
+	// SystemVariables are the defaults of other system variables, by name. They're applied after the settings above,
+	// which they override.
+	SystemVariables map[string]interface{}
+
+	// MaxMemory is the memory budget of the engine in bytes, beyond which the rows cached by queries are freed, and
+	// queries needing to cache more rows fail. It defaults to the MAX_MEMORY environment variable, in megabytes.
+	MaxMemory uint64
+	// Parallelism is the number of goroutines reading the partitions of a table concurrently. It defaults to the
+	// parallelism of the engine's analyzer.
+	Parallelism int
+	// PlanCacheSize is the maximum number of plans of prepared statements cached for each session. The least recently
+	// prepared plans are evicted beyond it, and prepared again when they're executed. It's unbounded by default.
+	PlanCacheSize int
+}
+
+// systemVariables returns the values of the system variables set by the defaults.
+func (d Defaults) systemVariables() map[string]interface{} {
+	vals := make(map[string]interface{})
+	if d.SQLMode != "" {
+		vals["sql_mode"] = d.SQLMode
+	}
+	if d.TimeZone != "" {
+		vals["time_zone"] = d.TimeZone
+	}
+	if d.MaxConnections > 0 {
+		vals["max_connections"] = d.MaxConnections
+	}
+	if d.DisableForeignKeyChecks {
+		vals["foreign_key_checks"] = int8(0)
+	}
+	for name, val := range d.SystemVariables {
+		vals[name] = val
+	}
+	return vals
+}
+
+// applySystemVariables sets the global values of the system variables set by the defaults.
+func (d Defaults) applySystemVariables() error {
+	vals := d.systemVariables()
+	if len(vals) == 0 {
+		return nil
+	}
+	if err := sql.SystemVariables.AssignValues(vals); err != nil {
+		return fmt.Errorf("invalid engine defaults: %w", err)
+	}
+	return nil
+}
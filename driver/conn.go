@@ -16,7 +16,10 @@ package driver
 
 import (
 	"context"
+	stdsql "database/sql"
 	"database/sql/driver"
+	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -30,6 +33,8 @@ type Conn struct {
 	contexts ContextBuilder
 	indexes  *sql.IndexRegistry
 	views    *sql.ViewRegistry
+	// inTx is whether a transaction begun with BeginTx is open
+	inTx bool
 }
 
 // DSN returns the driver connection string.
@@ -43,6 +48,12 @@ func (c *Conn) Prepare(query string) (driver.Stmt, error) {
 	return c.newStmt(context.Background(), query)
 }
 
+// PrepareContext validates the query and returns a statement, whose prepared query is cached in the session of the
+// connection until the statement is closed.
+func (c *Conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return c.newStmt(ctx, query)
+}
+
 // newStmt builds a new statement with the query.
 func (c *Conn) newStmt(ctx context.Context, query string) (*Stmt, error) {
 	sctx, err := c.newContextWithQuery(ctx, query)
@@ -59,14 +70,50 @@ func (c *Conn) newStmt(ctx context.Context, query string) (*Stmt, error) {
 	return &Stmt{c, query}, nil
 }
 
-// Close does nothing.
+// Close rolls back the open transaction of the connection, if there's one, and discards the prepared queries of its
+// session.
 func (c *Conn) Close() error {
-	return nil
+	var err error
+	if c.inTx {
+		err = c.run(context.Background(), "ROLLBACK")
+		c.inTx = false
+	}
+	c.dbConn.engine.PreparedDataCache.DeleteSessionData(c.session.ID())
+	return err
 }
 
-// Begin returns a fake transaction.
+// Begin starts a transaction in the session of the connection.
 func (c *Conn) Begin() (driver.Tx, error) {
-	return fakeTransaction{}, nil
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+// BeginTx starts a transaction in the session of the connection with the options given. The isolation levels of MySQL
+// are supported, and whether the transaction is read only. Whether statements are isolated from those of other
+// sessions, and whether they can be rolled back, depends on the transaction support of the databases.
+func (c *Conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if c.inTx {
+		return nil, ErrTransactionInProgress
+	}
+
+	switch isolation := stdsql.IsolationLevel(opts.Isolation); isolation {
+	case stdsql.LevelDefault:
+	case stdsql.LevelReadUncommitted, stdsql.LevelReadCommitted, stdsql.LevelRepeatableRead, stdsql.LevelSerializable:
+		if err := c.run(ctx, "SET TRANSACTION ISOLATION LEVEL "+strings.ToUpper(isolation.String())); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("isolation level %s is not supported", isolation)
+	}
+
+	query := "START TRANSACTION"
+	if opts.ReadOnly {
+		query += " READ ONLY"
+	}
+	if err := c.run(ctx, query); err != nil {
+		return nil, err
+	}
+	c.inTx = true
+	return &Tx{conn: c}, nil
 }
 
 // Exec executes a query that doesn't return rows.
@@ -143,16 +190,27 @@ func (c *Conn) newContextWithQuery(ctx context.Context, query string) (*sql.Cont
 		sql.WithProcessList(c.dbConn.engine.ProcessList))
 }
 
-type fakeTransaction struct{}
-
-func (fakeTransaction) Commit() error   { return nil }
-func (fakeTransaction) Rollback() error { return nil }
+// run executes the query given to completion, discarding its rows.
+func (c *Conn) run(ctx context.Context, query string) error {
+	qctx, err := c.newContextWithQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+	sch, rows, err := c.dbConn.engine.Query(qctx, query)
+	if err != nil {
+		return err
+	}
+	_, err = sql.RowIterToRows(qctx, sch, rows)
+	return err
+}
 
 // _ is a type assertion
 var (
-	_ driver.Conn           = ((*Conn)(nil))
-	_ driver.Execer         = ((*Conn)(nil))
-	_ driver.ExecerContext  = ((*Conn)(nil))
-	_ driver.Queryer        = ((*Conn)(nil))
-	_ driver.QueryerContext = ((*Conn)(nil))
+	_ driver.Conn               = ((*Conn)(nil))
+	_ driver.ConnPrepareContext = ((*Conn)(nil))
+	_ driver.ConnBeginTx        = ((*Conn)(nil))
+	_ driver.Execer             = ((*Conn)(nil))
+	_ driver.ExecerContext      = ((*Conn)(nil))
+	_ driver.Queryer            = ((*Conn)(nil))
+	_ driver.QueryerContext     = ((*Conn)(nil))
 )
@@ -17,6 +17,7 @@ package driver
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"net/url"
 	"sync"
@@ -126,6 +127,10 @@ func (d *Driver) Open(name string) (driver.Conn, error) {
 
 // OpenConnector calls the driver factory and returns a new connector.
 func (d *Driver) OpenConnector(dsn string) (driver.Connector, error) {
+	if d.provider == nil {
+		return nil, errors.New("driver has no provider to resolve DSNs")
+	}
+
 	options := d.options // copy
 	if options == nil {
 		options = &Options{}
@@ -177,6 +182,26 @@ func (d *Driver) OpenConnector(dsn string) (driver.Connector, error) {
 	}, nil
 }
 
+// NewEngineConnector returns a connector to the engine given, whose connections run their statements in new sessions
+// of the engine in the same process. It lets applications embedding an engine they've configured use it with
+// database/sql, e.g. with sql.OpenDB. The connector's driver can't open DSNs, and the engine isn't closed with it.
+func NewEngineConnector(engine *sqle.Engine, options *Options) *Connector {
+	if options == nil {
+		options = &Options{}
+	}
+	d := &Driver{
+		options:  options,
+		sessions: DefaultSessionBuilder{},
+		contexts: DefaultContextBuilder{},
+		dbs:      map[string]*dbConn{},
+	}
+	return &Connector{
+		driver:  d,
+		options: options,
+		dbConn:  &dbConn{engine: engine},
+	}
+}
+
 func (d *Driver) Close() error {
 	var firstErr error
 	for _, conn := range d.dbs {
@@ -1,12 +1,16 @@
 package driver_test
 
 import (
+	"context"
+	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/driver"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -117,3 +121,53 @@ func TestExec(t *testing.T) {
 		})
 	}
 }
+
+func TestEngineConnector(t *testing.T) {
+	mtb, records := personMemTable("db", "person")
+	_, pro, err := mtb.Resolve(t.Name(), nil)
+	require.NoError(t, err)
+	e := sqle.NewDefault(pro)
+	defer e.Close()
+
+	db := sql.OpenDB(driver.NewEngineConnector(e, nil))
+	defer db.Close()
+	ctx := context.Background()
+
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer conn.Close()
+	var sessionID uint32
+	require.NoError(t, conn.Raw(func(driverConn any) error {
+		sessionID = driverConn.(*driver.Conn).Session().ID()
+		return nil
+	}))
+
+	stmt, err := conn.PrepareContext(ctx, "SELECT COUNT(*) FROM db.person WHERE name = ?")
+	require.NoError(t, err)
+	require.Len(t, e.PreparedDataCache.GetSessionData(sessionID), 1)
+	var count int
+	require.NoError(t, stmt.QueryRowContext(ctx, "John Doe").Scan(&count))
+	assert.Equal(t, 2, count)
+	require.NoError(t, stmt.QueryRowContext(ctx, "Evil Bob").Scan(&count))
+	assert.Equal(t, 1, count)
+	require.NoError(t, stmt.Close())
+	require.Empty(t, e.PreparedDataCache.GetSessionData(sessionID))
+
+	tx, err := conn.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	_, err = tx.ExecContext(ctx, `INSERT INTO db.person VALUES ('foo', 'bar', '["baz"]', NOW())`)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.ErrorIs(t, tx.Commit(), sql.ErrTxDone)
+	require.NoError(t, conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM db.person").Scan(&count))
+	assert.Equal(t, len(records)+1, count)
+
+	tx, err = conn.BeginTx(ctx, &sql.TxOptions{ReadOnly: true, Isolation: sql.LevelReadCommitted})
+	require.NoError(t, err)
+	_, err = tx.ExecContext(ctx, "DELETE FROM db.person")
+	require.Error(t, err)
+	require.NoError(t, tx.Rollback())
+
+	_, err = conn.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelLinearizable})
+	require.Error(t, err)
+}
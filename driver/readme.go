@@ -14,9 +14,13 @@
 
 // Package driver implements a driver for Go's database/sql support.
 //
+// Connections are opened with a Driver, which creates an engine for each server name its Provider resolves DSNs to, or
+// with the Connector returned by NewEngineConnector for an existing engine. Each connection runs its statements in
+// its own session of the engine.
+//
 // # Caveats
 //
-// Transactions have no effect.
+// Transactions only isolate and roll back statements of databases that support transactions.
 //
 // sql.Result.LastInsertID is not implemented.
 package driver
@@ -27,8 +27,9 @@ type Stmt struct {
 	queryStr string
 }
 
-// Close does nothing.
+// Close discards the prepared query of the statement.
 func (s *Stmt) Close() error {
+	s.conn.dbConn.engine.PreparedDataCache.UncacheStmt(s.conn.session.ID(), s.queryStr)
 	return nil
 }
 
@@ -0,0 +1,54 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+)
+
+// ErrTransactionInProgress is returned when a transaction is begun on a connection whose transaction is still open
+var ErrTransactionInProgress = errors.New("transaction already in progress")
+
+// ErrTransactionDone is returned when a transaction is committed or rolled back after it was already
+var ErrTransactionDone = errors.New("transaction has already been committed or rolled back")
+
+// Tx is a transaction in the session of a connection.
+type Tx struct {
+	conn *Conn
+	done bool
+}
+
+// Commit commits the transaction.
+func (t *Tx) Commit() error {
+	return t.end("COMMIT")
+}
+
+// Rollback rolls back the transaction.
+func (t *Tx) Rollback() error {
+	return t.end("ROLLBACK")
+}
+
+func (t *Tx) end(query string) error {
+	if t.done {
+		return ErrTransactionDone
+	}
+	t.done = true
+	t.conn.inTx = false
+	return t.conn.run(context.Background(), query)
+}
+
+var _ driver.Tx = (*Tx)(nil)
@@ -16,8 +16,12 @@ package sqle
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -46,6 +50,45 @@ type Config struct {
 	// disabled, and including any users here will enable authentication. All users in this list will have full access.
 	// This field is only temporary, and will be removed as development on users and authentication continues.
 	TemporaryUsers []TemporaryUser
+	// EnableRowIter2 runs queries with row frame iterators (sql.RowIter2) when all the values they return and compare
+	// can be held in row frames. It's also enabled by setting the ENABLE_ROW_ITER_2 environment variable.
+	EnableRowIter2 bool
+	// MaxStatementRetries is the number of times an autocommit statement is retried in a new transaction when it fails
+	// with a serialization failure (sql.ErrLockDeadlock) reported by the backend, before it has returned any rows.
+	// Statements calling stored procedures or stored functions aren't retried, since they may have effects that
+	// rolling back the transaction doesn't undo. Statements aren't retried if it's zero, which is the default.
+	MaxStatementRetries int
+	// StatementRetryBackoff is how long to wait before the first retry of a statement, doubling on each further retry.
+	// It defaults to 10 milliseconds.
+	StatementRetryBackoff time.Duration
+	// PreParseRewriters rewrite the text of statements before they're parsed, in order.
+	PreParseRewriters []sql.PreParseRewriter
+	// PostParseRewriters rewrite parsed statements before they're analyzed, in order.
+	PostParseRewriters []sql.PostParseRewriter
+	// Throttler limits the concurrency and rate of queries by statement digest and user. Queries aren't throttled if
+	// it's nil, which is the default.
+	Throttler *sql.QueryThrottler
+	// Services are the services of the integrator shared by the queries of the engine, which its rules, functions and
+	// tables access with sql.GetService. A new registry is created if it's nil.
+	Services *sql.ServiceRegistry
+	// QueryListeners are notified of the lifecycle events of every query, in order, and may veto queries.
+	QueryListeners []sql.QueryListener
+	// QueryEventRowInterval is the number of rows returned by a query between its QueryRowsRead events. It defaults
+	// to 1000.
+	QueryEventRowInterval int64
+	// ResultCache caches the results of read-only queries of tables implementing sql.DataVersionedTable, until the
+	// data versions of their tables change. Results aren't cached if it's nil, which is the default.
+	ResultCache *sql.ResultCache
+	// InitScripts are run in order by RunInitScripts, which servers call before accepting connections. Embedders that
+	// don't start a server call it themselves. InitScriptsFromFS returns the scripts of embedded files.
+	InitScripts []InitScript
+	// IteratorGracePeriod is how long queries wait for the iterators of tables to return after the query is canceled,
+	// before abandoning them and failing with sql.ErrIteratorUnresponsive. It guards against integrators whose
+	// iterators don't honor cancelation, at the cost of running their calls on another goroutine. Iterators aren't
+	// watched if it's zero, which is the default.
+	IteratorGracePeriod time.Duration
+	// Defaults are the defaults of the settings of the engine and of the sessions created after it.
+	Defaults Defaults
 }
 
 // TemporaryUser is a user that will be added to the engine. This is for temporary use while the remaining features
@@ -58,13 +101,30 @@ type TemporaryUser struct {
 // PreparedDataCache manages all the prepared data for every session for every query for an engine
 type PreparedDataCache struct {
 	data map[uint32]map[string]sql.Node
-	mu   *sync.Mutex
+	// sources are the unanalyzed statements of named prepared statements, used to prepare them again after the
+	// cache has been invalidated
+	sources map[uint32]map[string]sql.Node
+	// maxPlans is the maximum number of prepared nodes cached for each session, or zero if it's unbounded
+	maxPlans int
+	// order holds the queries of the prepared nodes of each session in the order they were cached, when bounded
+	order map[uint32][]string
+	mu    *sync.Mutex
 }
 
 func NewPreparedDataCache() *PreparedDataCache {
+	return NewPreparedDataCacheWithLimit(0)
+}
+
+// NewPreparedDataCacheWithLimit returns a new cache holding up to the number of prepared nodes given for each session,
+// evicting the least recently cached ones beyond it. Named prepared statements whose nodes were evicted are prepared
+// again from their unanalyzed statements the next time they're executed. The cache is unbounded if it's zero.
+func NewPreparedDataCacheWithLimit(maxPlans int) *PreparedDataCache {
 	return &PreparedDataCache{
-		data: make(map[uint32]map[string]sql.Node),
-		mu:   &sync.Mutex{},
+		data:     make(map[uint32]map[string]sql.Node),
+		sources:  make(map[uint32]map[string]sql.Node),
+		maxPlans: maxPlans,
+		order:    make(map[uint32][]string),
+		mu:       &sync.Mutex{},
 	}
 }
 
@@ -92,6 +152,8 @@ func (p *PreparedDataCache) DeleteSessionData(sessId uint32) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	delete(p.data, sessId)
+	delete(p.sources, sessId)
+	delete(p.order, sessId)
 }
 
 // CacheStmt saves the prepared node and associates a ctx.SessionId and query to it
@@ -102,16 +164,81 @@ func (p *PreparedDataCache) CacheStmt(sessId uint32, query string, node sql.Node
 		p.data[sessId] = make(map[string]sql.Node)
 	}
 	p.data[sessId][query] = node
+	if p.maxPlans > 0 {
+		order := append(removeQuery(p.order[sessId], query), query)
+		for len(order) > p.maxPlans {
+			delete(p.data[sessId], order[0])
+			order = order[1:]
+		}
+		p.order[sessId] = order
+	}
+}
+
+// removeQuery returns the queries given without the query given.
+func removeQuery(queries []string, query string) []string {
+	for i, q := range queries {
+		if q == query {
+			return append(queries[:i:i], queries[i+1:]...)
+		}
+	}
+	return queries
 }
 
 // UncacheStmt removes the prepared node associated with a ctx.SessionId and query to it
 func (p *PreparedDataCache) UncacheStmt(sessId uint32, query string) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	if _, ok := p.data[sessId]; !ok {
-		return
-	}
 	delete(p.data[sessId], query)
+	delete(p.sources[sessId], query)
+	if p.maxPlans > 0 {
+		p.order[sessId] = removeQuery(p.order[sessId], query)
+	}
+}
+
+// CacheStmtSource saves the unanalyzed statement of the named prepared statement given, so that it can be prepared
+// again if the cache is invalidated
+func (p *PreparedDataCache) CacheStmtSource(sessId uint32, name string, node sql.Node) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.sources[sessId]; !ok {
+		p.sources[sessId] = make(map[string]sql.Node)
+	}
+	p.sources[sessId][name] = node
+}
+
+// GetStmtSource will retrieve the unanalyzed statement of the named prepared statement given if it exists
+func (p *PreparedDataCache) GetStmtSource(sessId uint32, name string) (sql.Node, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	node, ok := p.sources[sessId][name]
+	return node, ok
+}
+
+// Status returns the number of sessions with prepared nodes, and of the prepared nodes and named prepared statements
+// of all sessions.
+func (p *PreparedDataCache) Status() sql.PlanCacheStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	status := sql.PlanCacheStatus{MaxPlansPerSession: p.maxPlans}
+	for _, plans := range p.data {
+		if len(plans) > 0 {
+			status.Sessions++
+			status.Plans += len(plans)
+		}
+	}
+	for _, sources := range p.sources {
+		status.NamedStatements += len(sources)
+	}
+	return status
+}
+
+// Invalidate removes the prepared nodes of all sessions, which may refer to tables that have since changed. Named
+// prepared statements are prepared again from their unanalyzed statements the next time they're executed.
+func (p *PreparedDataCache) Invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.data = make(map[uint32]map[string]sql.Node)
+	p.order = make(map[uint32][]string)
 }
 
 // Engine is a SQL engine.
@@ -124,7 +251,37 @@ type Engine struct {
 	IsReadOnly        bool
 	IsServerLocked    bool
 	PreparedDataCache *PreparedDataCache
-	mu                *sync.Mutex
+	// EnableRowIter2 is whether queries are run with row frame iterators when they can be
+	EnableRowIter2 bool
+	// MaxStatementRetries is the number of times a statement failing with a serialization failure is retried
+	MaxStatementRetries int
+	// StatementRetryBackoff is how long to wait before the first retry of a statement
+	StatementRetryBackoff time.Duration
+	// PreParseRewriters rewrite the text of statements before they're parsed
+	PreParseRewriters []sql.PreParseRewriter
+	// PostParseRewriters rewrite parsed statements before they're analyzed
+	PostParseRewriters []sql.PostParseRewriter
+	// Throttler limits the concurrency and rate of queries, if set
+	Throttler *sql.QueryThrottler
+	// Services are the services shared by the queries of this engine, set in the contexts of its queries
+	Services *sql.ServiceRegistry
+	// QueryListeners are notified of the lifecycle events of every query
+	QueryListeners []sql.QueryListener
+	// QueryEventRowInterval is the number of rows between the QueryRowsRead events of a query
+	QueryEventRowInterval int64
+	// ResultCache caches the results of read-only queries, if set
+	ResultCache *sql.ResultCache
+	// InitScripts are the scripts run by RunInitScripts
+	InitScripts []InitScript
+	// IteratorGracePeriod is how long queries wait for the iterators of tables to return after being canceled
+	IteratorGracePeriod time.Duration
+	// QueryProfiles keeps the profiles of the queries of sessions with profiling enabled
+	QueryProfiles    *sql.QueryProfiles
+	mu               *sync.Mutex
+	statementRetries uint64
+	analyzerStats    analyzerStats
+	initScriptsMu    *sync.Mutex
+	initScriptsRun   int
 }
 
 type ColumnWithRawDefault struct {
@@ -144,6 +301,17 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 		a.Catalog.MySQLDb.AddRootAccount()
 	}
 
+	if err := cfg.Defaults.applySystemVariables(); err != nil {
+		panic(err)
+	}
+	if cfg.Defaults.Parallelism > 0 {
+		a.Parallelism = cfg.Defaults.Parallelism
+	}
+	var memoryReporter sql.Reporter = sql.ProcessMemory
+	if cfg.Defaults.MaxMemory > 0 {
+		memoryReporter = sql.ProcessMemoryWithLimit(cfg.Defaults.MaxMemory)
+	}
+
 	ls := sql.NewLockSubsystem()
 
 	emptyCtx := sql.NewEmptyContext()
@@ -153,19 +321,55 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 	})
 	a.Catalog.RegisterFunction(emptyCtx, function.GetLockingFuncs(ls)...)
 
-	return &Engine{
-		Analyzer:          a,
-		MemoryManager:     sql.NewMemoryManager(sql.ProcessMemory),
-		ProcessList:       NewProcessList(),
-		LS:                ls,
-		BackgroundThreads: sql.NewBackgroundThreads(),
-		IsReadOnly:        cfg.IsReadOnly,
-		IsServerLocked:    cfg.IsServerLocked,
-		PreparedDataCache: NewPreparedDataCache(),
-		mu:                &sync.Mutex{},
+	services := cfg.Services
+	if services == nil {
+		services = sql.NewServiceRegistry()
+	}
+
+	retryBackoff := cfg.StatementRetryBackoff
+	if retryBackoff <= 0 {
+		retryBackoff = defaultStatementRetryBackoff
+	}
+	rowInterval := cfg.QueryEventRowInterval
+	if rowInterval <= 0 {
+		rowInterval = defaultQueryEventRowInterval
+	}
+
+	e := &Engine{
+		Analyzer:              a,
+		MemoryManager:         sql.NewMemoryManager(memoryReporter),
+		ProcessList:           NewProcessList(),
+		LS:                    ls,
+		BackgroundThreads:     sql.NewBackgroundThreads(),
+		IsReadOnly:            cfg.IsReadOnly,
+		IsServerLocked:        cfg.IsServerLocked,
+		PreparedDataCache:     NewPreparedDataCacheWithLimit(cfg.Defaults.PlanCacheSize),
+		EnableRowIter2:        cfg.EnableRowIter2 || enableRowIter2,
+		MaxStatementRetries:   cfg.MaxStatementRetries,
+		StatementRetryBackoff: retryBackoff,
+		PreParseRewriters:     cfg.PreParseRewriters,
+		PostParseRewriters:    cfg.PostParseRewriters,
+		Throttler:             cfg.Throttler,
+		Services:              services,
+		QueryListeners:        cfg.QueryListeners,
+		QueryEventRowInterval: rowInterval,
+		ResultCache:           cfg.ResultCache,
+		InitScripts:           cfg.InitScripts,
+		IteratorGracePeriod:   cfg.IteratorGracePeriod,
+		QueryProfiles:         sql.NewQueryProfiles(),
+		mu:                    &sync.Mutex{},
+		initScriptsMu:         &sync.Mutex{},
 	}
+	sql.RegisterService(services, sql.PreparedStatementsService, sql.PreparedStatements(preparedStatements{e: e}))
+	sql.RegisterService(services, sql.EngineStatusService, sql.EngineStatusReporter(e))
+	sql.RegisterService(services, sql.QueryProfilesService, e.QueryProfiles)
+	sql.RegisterService(services, sql.IndexBuildsService, sql.NewIndexBuilds())
+	return e
 }
 
+// defaultStatementRetryBackoff is how long to wait before the first retry of a statement, if not configured.
+const defaultStatementRetryBackoff = 10 * time.Millisecond
+
 // NewDefault creates a new default Engine.
 func NewDefault(pro sql.DatabaseProvider) *Engine {
 	a := analyzer.NewDefault(pro)
@@ -177,7 +381,8 @@ func (e *Engine) AnalyzeQuery(
 	ctx *sql.Context,
 	query string,
 ) (sql.Node, error) {
-	parsed, err := parse.Parse(ctx, query)
+	e.setServices(ctx)
+	parsed, err := e.parseQuery(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +394,8 @@ func (e *Engine) PrepareQuery(
 	ctx *sql.Context,
 	query string,
 ) (sql.Node, error) {
-	parsed, err := parse.Parse(ctx, query)
+	e.setServices(ctx)
+	parsed, err := e.parseQuery(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -214,12 +420,234 @@ func (e *Engine) QueryWithBindings(ctx *sql.Context, query string, bindings map[
 }
 
 // QueryNodeWithBindings executes the query given with the bindings provided. If parsed is non-nil, it will be used
-// instead of parsing the query from text.
+// instead of parsing the query from text, and must have been parsed from the query after rewriting it with
+// RewriteQuery.
 func (e *Engine) QueryNodeWithBindings(
 	ctx *sql.Context,
 	query string,
 	parsed sql.Node,
 	bindings map[string]sql.Expression,
+) (sql.Schema, sql.RowIter, error) {
+	e.setServices(ctx)
+	profiler := e.startProfiling(ctx, query)
+	events := e.newQueryEvents(query)
+	var err error
+	if parsed == nil {
+		parsed, err = e.parseQuery(ctx, query)
+	} else {
+		parsed, err = e.rewriteParsed(ctx, query, parsed)
+	}
+	if err == nil {
+		err = events.emit(ctx, sql.QueryParsed, parsed)
+	}
+	if err != nil {
+		e.recordProfile(ctx, profiler)
+		return nil, nil, events.fail(ctx, err)
+	}
+	stopProfilingStatement(ctx, parsed)
+	profiler = ctx.QueryProfiler()
+	profiler.Stage(sql.ProfileStageAnalyzing)
+
+	release, err := e.throttle(ctx, query)
+	if err != nil {
+		e.recordProfile(ctx, profiler)
+		return nil, nil, events.fail(ctx, err)
+	}
+
+	var sch sql.Schema
+	var iter sql.RowIter
+	retry, err := e.canRetryStatement(ctx, parsed)
+	if err == nil && retry {
+		sch, iter, err = e.queryWithRetries(ctx, query, parsed, bindings, events)
+	} else if err == nil {
+		sch, iter, err = e.queryNode(ctx, query, parsed, bindings, events)
+	}
+	if err != nil {
+		release()
+		e.recordProfile(ctx, profiler)
+		return nil, nil, events.fail(ctx, err)
+	}
+	if events != nil {
+		iter = &eventIter{RowIter: iter, events: events}
+	}
+	if e.Throttler != nil {
+		iter = &throttledIter{RowIter: iter, release: release}
+	}
+	if profiler != nil {
+		iter = &profiledIter{RowIter: iter, e: e, profiler: profiler}
+	}
+	return sch, iter, nil
+}
+
+// setServices sets the engine's service registry in the context given, unless it has one already, such as a registry
+// whose parent is the engine's, overriding some of its services. The engine's iterator grace period is set likewise.
+func (e *Engine) setServices(ctx *sql.Context) {
+	if ctx.ServiceRegistry() == nil && e.Services != nil {
+		ctx.ApplyOpts(sql.WithServiceRegistry(e.Services))
+	}
+	if ctx.IteratorGracePeriod() == 0 && e.IteratorGracePeriod > 0 {
+		ctx.ApplyOpts(sql.WithIteratorGracePeriod(e.IteratorGracePeriod))
+	}
+}
+
+// throttle waits for the engine's throttler to allow the query given to run, returning the function to call once
+// its rows have been returned, or ErrQueryThrottled if the query is rejected.
+func (e *Engine) throttle(ctx *sql.Context, query string) (func(), error) {
+	if e.Throttler == nil || !e.Throttler.HasRules() {
+		return func() {}, nil
+	}
+	digest, err := sql.StatementDigest(query)
+	if err != nil {
+		// Statements that can't be tokenized fail to parse, so they're never run
+		return func() {}, nil
+	}
+	return e.Throttler.Acquire(ctx, digest)
+}
+
+// RewriteQuery returns the query given rewritten by the engine's pre-parse rewriters. Callers that parse queries
+// themselves before executing them with QueryNodeWithBindings must parse the rewritten query.
+func (e *Engine) RewriteQuery(ctx *sql.Context, query string) (string, error) {
+	for _, rewriter := range e.PreParseRewriters {
+		var err error
+		query, err = rewriter(ctx, query)
+		if err != nil {
+			return "", err
+		}
+	}
+	return query, nil
+}
+
+// rewriteParsed returns the node parsed from the query given rewritten by the engine's post-parse rewriters.
+func (e *Engine) rewriteParsed(ctx *sql.Context, query string, parsed sql.Node) (sql.Node, error) {
+	for _, rewriter := range e.PostParseRewriters {
+		var err error
+		parsed, err = rewriter(ctx, query, parsed)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return parsed, nil
+}
+
+// parseQuery parses the query given, applying the engine's query rewriters before and after parsing it.
+func (e *Engine) parseQuery(ctx *sql.Context, query string) (sql.Node, error) {
+	rewritten, err := e.RewriteQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := parse.Parse(ctx, rewritten)
+	if err != nil {
+		return nil, err
+	}
+	return e.rewriteParsed(ctx, query, parsed)
+}
+
+// StatementRetries returns the number of times a statement has been retried after a serialization failure.
+func (e *Engine) StatementRetries() uint64 {
+	return atomic.LoadUint64(&e.statementRetries)
+}
+
+// canRetryStatement returns whether the statement given can be retried after a serialization failure, which is the
+// case for statements run in a transaction of their own, when autocommit is on, whose effects are all undone by
+// rolling back that transaction.
+func (e *Engine) canRetryStatement(ctx *sql.Context, parsed sql.Node) (bool, error) {
+	if e.MaxStatementRetries <= 0 || ctx.GetIgnoreAutoCommit() || ctx.GetTransaction() != nil {
+		return false, nil
+	}
+	if e.callsRoutines(ctx, parsed) {
+		return false, nil
+	}
+	return plan.IsSessionAutocommit(ctx)
+}
+
+// callsRoutines returns whether the parsed statement given calls a stored procedure or a function that isn't
+// built-in, which is a stored function, including in its subqueries.
+func (e *Engine) callsRoutines(ctx *sql.Context, parsed sql.Node) bool {
+	calls := false
+	transform.Inspect(parsed, func(n sql.Node) bool {
+		if _, ok := n.(*plan.Call); ok {
+			calls = true
+		}
+		if ne, ok := n.(sql.Expressioner); ok && !calls {
+			for _, expr := range ne.Expressions() {
+				calls = calls || transform.InspectExpr(expr, func(expr sql.Expression) bool {
+					switch expr := expr.(type) {
+					case *expression.UnresolvedFunction:
+						if expr.Database != "" {
+							return true
+						}
+						_, err := e.Analyzer.Catalog.Function(ctx, expr.Name())
+						return err != nil
+					case *plan.Subquery:
+						return e.callsRoutines(ctx, expr.Query)
+					}
+					return false
+				})
+			}
+		}
+		return !calls
+	})
+	return calls
+}
+
+// queryWithRetries runs the query given, and runs it again in a new transaction if it fails with a serialization
+// failure before returning any rows, up to MaxStatementRetries times with an exponential backoff. Its rows are
+// streamed, so a failure once some of them have been returned, or when it's closed, isn't retried.
+func (e *Engine) queryWithRetries(
+	ctx *sql.Context,
+	query string,
+	parsed sql.Node,
+	bindings map[string]sql.Expression,
+	events *queryEvents,
+) (sql.Schema, sql.RowIter, error) {
+	attempts := 0
+	backoff := e.StatementRetryBackoff
+	retry := func(ctx *sql.Context, err error) (sql.Schema, sql.RowIter, error) {
+		for {
+			if attempts >= e.MaxStatementRetries || !sql.ErrLockDeadlock.Is(sql.UnwrapError(err)) {
+				return nil, nil, err
+			}
+			attempts++
+
+			if err := rollbackAutocommitTransaction(ctx); err != nil {
+				return nil, nil, err
+			}
+			atomic.AddUint64(&e.statementRetries, 1)
+			ctx.GetLogger().Warnf("retrying statement after serialization failure: %s", err.Error())
+
+			select {
+			case <-ctx.Done():
+				return nil, nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+
+			var schema sql.Schema
+			var iter sql.RowIter
+			schema, iter, err = e.queryNode(ctx, query, parsed, bindings, events)
+			if err == nil {
+				return schema, iter, nil
+			}
+		}
+	}
+
+	schema, iter, err := e.queryNode(ctx, query, parsed, bindings, events)
+	if err != nil {
+		schema, iter, err = retry(ctx, err)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return schema, &retryingIter{RowIter: iter, retry: retry}, nil
+}
+
+// queryNode executes the parsed query given with the bindings provided, emitting its analysis and execution events.
+func (e *Engine) queryNode(
+	ctx *sql.Context,
+	query string,
+	parsed sql.Node,
+	bindings map[string]sql.Expression,
+	events *queryEvents,
 ) (sql.Schema, sql.RowIter, error) {
 	var (
 		analyzed sql.Node
@@ -228,13 +656,6 @@ func (e *Engine) QueryNodeWithBindings(
 		err      error
 	)
 
-	if parsed == nil {
-		parsed, err = parse.Parse(ctx, query)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-
 	// Before we begin a transaction, we need to know if the database being operated on is not the one
 	// currently selected
 	transactionDatabase := analyzer.GetTransactionDatabase(ctx, parsed)
@@ -250,16 +671,51 @@ func (e *Engine) QueryNodeWithBindings(
 		return nil, nil, err
 	}
 
+	err = e.xaStateCheck(ctx, parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = e.beginTransaction(ctx, transactionDatabase)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	analyzeStart := time.Now()
 	if p, ok := e.PreparedDataCache.GetCachedStmt(ctx.Session.ID(), query); ok {
 		analyzed, err = e.analyzePreparedQuery(ctx, query, p, bindings)
 	} else {
 		analyzed, err = e.analyzeQuery(ctx, query, parsed, bindings)
 	}
+	e.analyzerStats.record(time.Since(analyzeStart))
+	if err == nil {
+		err = events.emit(ctx, sql.QueryAnalyzed, analyzed)
+	}
+	if err != nil {
+		err2 := clearAutocommitTransaction(ctx)
+		if err2 != nil {
+			err = errors.Wrap(err, "unable to clear autocommit transaction: "+err2.Error())
+		}
+
+		return nil, nil, err
+	}
+
+	err = e.startTwoPhaseTransactions(ctx, analyzed)
+	if err == nil {
+		err = events.emit(ctx, sql.QueryExecuteBegin, analyzed)
+	}
+	if err != nil {
+		err2 := clearAutocommitTransaction(ctx)
+		if err2 != nil {
+			err = errors.Wrap(err, "unable to clear autocommit transaction: "+err2.Error())
+		}
+
+		return nil, nil, err
+	}
+
+	ctx.QueryProfiler().Stage(sql.ProfileStageExecuting)
+	var cacheResult func(sql.RowIter) sql.RowIter
+	analyzed, cacheResult, err = e.useResultCache(ctx, query, analyzed, bindings)
 	if err != nil {
 		err2 := clearAutocommitTransaction(ctx)
 		if err2 != nil {
@@ -270,12 +726,12 @@ func (e *Engine) QueryNodeWithBindings(
 	}
 
 	useIter2 := false
-	if enableRowIter2 {
-		useIter2 = allNode2(analyzed)
+	if e.EnableRowIter2 {
+		useIter2 = canUseRowIter2(analyzed)
 	}
 
 	if useIter2 {
-		iter2, err = analyzed.(sql.Node2).RowIter2(ctx, nil)
+		iter2, err = sql.NewRowIter2(ctx, analyzed, nil)
 		iter = iter2
 	} else {
 		iter, err = analyzed.RowIter(ctx, nil)
@@ -295,6 +751,8 @@ func (e *Engine) QueryNodeWithBindings(
 			iter2:   iter2,
 			isNode2: useIter2,
 		}
+	} else if cacheResult != nil {
+		iter = cacheResult(iter)
 	}
 
 	return analyzed.Schema(), iter, nil
@@ -321,24 +779,62 @@ func clearAutocommitTransaction(ctx *sql.Context) error {
 
 	if autocommit {
 		ctx.SetTransaction(nil)
+		sql.DiscardCapturedChanges(ctx)
+		return sql.RollbackTwoPhaseTransactions(ctx)
 	}
 
 	return nil
 }
 
+// rollbackAutocommitTransaction rolls back the implicitly created autocommit transaction of the session, if it has
+// one, so that the next statement starts a new one.
+func rollbackAutocommitTransaction(ctx *sql.Context) error {
+	sql.DiscardCapturedChanges(ctx)
+	if err := sql.RollbackTwoPhaseTransactions(ctx); err != nil {
+		return err
+	}
+	tx := ctx.GetTransaction()
+	if tx == nil {
+		return nil
+	}
+	if ts, ok := ctx.Session.(sql.TransactionSession); ok {
+		if err := ts.Rollback(ctx, tx); err != nil {
+			return err
+		}
+	}
+	ctx.SetTransaction(nil)
+	return nil
+}
+
 // CloseSession deletes session specific prepared statement data
 func (e *Engine) CloseSession(connID uint32) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	e.PreparedDataCache.DeleteSessionData(connID)
+	e.Analyzer.Catalog.XATransactions.EndSession(connID)
+	e.Analyzer.Catalog.Handlers.EndSession(connID)
+	e.QueryProfiles.EndSession(connID)
+	if feed, ok := sql.LookupService(e.Services, sql.ChangeFeedService); ok {
+		feed.EndSession(connID)
+	}
 }
 
 // Count number of BindVars in given tree
 func countBindVars(node sql.Node) int {
-	bindCnt := 0
+	bindVars := map[string]bool{}
+	collectBindVars(node, bindVars)
+	return len(bindVars)
+}
+
+// collectBindVars adds the names of the BindVars of the tree given to [bindVars], including those of its subqueries,
+// which may appear more than once in the tree once their expressions are pushed down or projected.
+func collectBindVars(node sql.Node, bindVars map[string]bool) {
 	bindCntFunc := func(e sql.Expression) bool {
-		if _, ok := e.(*expression.BindVar); ok {
-			bindCnt++
+		switch e := e.(type) {
+		case *expression.BindVar:
+			bindVars[e.Name] = true
+		case *plan.Subquery:
+			collectBindVars(e.Query, bindVars)
 		}
 		return true
 	}
@@ -352,7 +848,6 @@ func countBindVars(node sql.Node) int {
 		}
 		return true
 	})
-	return bindCnt
 }
 
 func (e *Engine) analyzeQuery(ctx *sql.Context, query string, parsed sql.Node, bindings map[string]sql.Expression) (sql.Node, error) {
@@ -362,7 +857,7 @@ func (e *Engine) analyzeQuery(ctx *sql.Context, query string, parsed sql.Node, b
 	)
 
 	if parsed == nil {
-		parsed, err = parse.Parse(ctx, query)
+		parsed, err = e.parseQuery(ctx, query)
 		if err != nil {
 			return nil, err
 		}
@@ -376,56 +871,12 @@ func (e *Engine) analyzeQuery(ctx *sql.Context, query string, parsed sql.Node, b
 	// TODO: eventually, we should have this logic be in the RowIter() of the respective plans
 	// along with a new rule that handles analysis
 	switch n := parsed.(type) {
-	case *plan.PrepareQuery:
-		analyzedChild, err := e.Analyzer.PrepareQuery(ctx, n.Child, nil)
-		if err != nil {
-			return nil, err
-		}
-		e.PreparedDataCache.CacheStmt(ctx.Session.ID(), n.Name, analyzedChild)
+	case *plan.PrepareQuery, *plan.DeallocateQuery:
+		// These are run by their RowIter, through the engine's sql.PreparedStatements
 		return parsed, nil
 	case *plan.ExecuteQuery:
 		// replace execute query node with the one prepared
-		p, ok := e.PreparedDataCache.GetCachedStmt(ctx.Session.ID(), n.Name)
-		if !ok {
-			return nil, sql.ErrUnknownPreparedStatement.New(n.Name)
-		}
-
-		// number of BindVars provided must match number of BindVars expected
-		if countBindVars(p) != len(n.BindVars) {
-			return nil, sql.ErrInvalidArgument.New(n.Name)
-		}
-		parsed = p
-
-		bindings = map[string]sql.Expression{}
-		for i, binding := range n.BindVars {
-			varName := fmt.Sprintf("v%d", i+1)
-			bindings[varName] = binding
-		}
-
-		if len(bindings) > 0 {
-			var usedBindings map[string]bool
-			parsed, usedBindings, err = plan.ApplyBindings(parsed, bindings)
-			if err != nil {
-				return nil, err
-			}
-			for binding := range bindings {
-				if !usedBindings[binding] && !plan.HasEmptyTable(analyzed) {
-					return nil, fmt.Errorf("unused binding %s", binding)
-				}
-			}
-		}
-
-		analyzed, _, err = e.Analyzer.AnalyzePrepared(ctx, parsed, nil)
-		if err != nil {
-			return nil, err
-		}
-		return analyzed, nil
-	case *plan.DeallocateQuery:
-		if _, ok := e.PreparedDataCache.GetCachedStmt(ctx.Session.ID(), n.Name); !ok {
-			return nil, sql.ErrUnknownPreparedStatement.New(n.Name)
-		}
-		e.PreparedDataCache.UncacheStmt(ctx.Session.ID(), n.Name)
-		return parsed, nil
+		return e.analyzeExecute(ctx, n.Name, n.BindVars)
 	}
 
 	if len(bindings) > 0 {
@@ -446,9 +897,103 @@ func (e *Engine) analyzeQuery(ctx *sql.Context, query string, parsed sql.Node, b
 		return nil, err
 	}
 
+	if f, ok := parsed.(*plan.Flush); ok {
+		e.flush(f.Option)
+	} else if plan.IsDDLNode(parsed) {
+		// Prepared statements may refer to the objects changed, so they're prepared again the next time they're run
+		e.PreparedDataCache.Invalidate()
+	}
+
 	return analyzed, nil
 }
 
+// prepareStatement prepares the unanalyzed statement given as the named prepared statement given of the session of
+// the context given.
+func (e *Engine) prepareStatement(ctx *sql.Context, name string, stmt sql.Node) error {
+	prepared, err := e.Analyzer.PrepareQuery(ctx, stmt, nil)
+	if err != nil {
+		return err
+	}
+	e.PreparedDataCache.CacheStmt(ctx.Session.ID(), name, prepared)
+	e.PreparedDataCache.CacheStmtSource(ctx.Session.ID(), name, stmt)
+	return nil
+}
+
+// analyzeExecute returns the analyzed node of the named prepared statement given, with the values given bound to its
+// parameters.
+func (e *Engine) analyzeExecute(ctx *sql.Context, name string, bindVars []sql.Expression) (sql.Node, error) {
+	p, err := e.preparedStatement(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// number of BindVars provided must match number of BindVars expected
+	if countBindVars(p) != len(bindVars) {
+		return nil, sql.ErrInvalidArgument.New(name)
+	}
+
+	if len(bindVars) > 0 {
+		bindings := map[string]sql.Expression{}
+		for i, binding := range bindVars {
+			varName := fmt.Sprintf("v%d", i+1)
+			bindings[varName] = binding
+		}
+		var usedBindings map[string]bool
+		p, usedBindings, err = plan.ApplyBindings(p, bindings)
+		if err != nil {
+			return nil, err
+		}
+		for binding := range bindings {
+			if !usedBindings[binding] && !plan.HasEmptyTable(p) {
+				return nil, fmt.Errorf("unused binding %s", binding)
+			}
+		}
+	}
+
+	analyzed, _, err := e.Analyzer.AnalyzePrepared(ctx, p, nil)
+	return analyzed, err
+}
+
+// deallocateStatement removes the named prepared statement given of the session of the context given.
+func (e *Engine) deallocateStatement(ctx *sql.Context, name string) error {
+	_, cached := e.PreparedDataCache.GetCachedStmt(ctx.Session.ID(), name)
+	_, hasSource := e.PreparedDataCache.GetStmtSource(ctx.Session.ID(), name)
+	if !cached && !hasSource {
+		return sql.ErrUnknownPreparedStatement.New(name)
+	}
+	e.PreparedDataCache.UncacheStmt(ctx.Session.ID(), name)
+	return nil
+}
+
+// preparedStatement returns the prepared node of the named prepared statement given, preparing it again from its
+// unanalyzed statement if the cache has been invalidated since it was prepared.
+func (e *Engine) preparedStatement(ctx *sql.Context, name string) (sql.Node, error) {
+	if p, ok := e.PreparedDataCache.GetCachedStmt(ctx.Session.ID(), name); ok {
+		return p, nil
+	}
+	source, ok := e.PreparedDataCache.GetStmtSource(ctx.Session.ID(), name)
+	if !ok {
+		return nil, sql.ErrUnknownPreparedStatement.New(name)
+	}
+	p, err := e.Analyzer.PrepareQuery(ctx, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	e.PreparedDataCache.CacheStmt(ctx.Session.ID(), name, p)
+	return p, nil
+}
+
+// flush handles the effects of a FLUSH statement on the state of the engine. FLUSH TABLES discards the prepared
+// plans of all sessions, which may refer to tables that have changed, and FLUSH STATUS resets the engine's counters.
+func (e *Engine) flush(option sql.FlushOption) {
+	switch option {
+	case sql.FlushTables:
+		e.PreparedDataCache.Invalidate()
+	case sql.FlushStatus:
+		atomic.StoreUint64(&e.statementRetries, 0)
+	}
+}
+
 func (e *Engine) analyzePreparedQuery(ctx *sql.Context, query string, analyzed sql.Node, bindings map[string]sql.Expression) (sql.Node, error) {
 	ctx.GetLogger().Tracef("optimizing prepared plan for query: %s", query)
 
@@ -480,49 +1025,87 @@ func (e *Engine) analyzePreparedQuery(ctx *sql.Context, query string, analyzed s
 	return analyzed, nil
 }
 
-// allNode2 returns whether all the nodes in the tree implement Node2.
-func allNode2(n sql.Node) bool {
-	allNode2 := true
+// canUseRowIter2 returns whether the tree given can be run with row frame iterators. The rows of every node that's run
+// are held in row frames, which requires the types of their schemas to implement Type2. A node is run with its
+// RowIter2 if it implements Node2, all of its expressions implement Expression2 with types that implement Type2, and
+// the table it reads from, if any, implements Table2. Any other node is run with its RowIter, and its rows are
+// converted to row frames. Row frame iterators are only used when at least one table is read with them, since
+// converting the rows of all tables gains nothing.
+func canUseRowIter2(n sql.Node) bool {
+	canUse, readsTable := true, false
 	transform.Inspect(n, func(n sql.Node) bool {
-		switch n := n.(type) {
-		case *plan.ResolvedTable:
-			table := n.Table
-			if tw, ok := table.(sql.TableWrapper); ok {
-				table = tw.Underlying()
-			}
-			if _, ok := table.(sql.Table2); !ok {
-				allNode2 = false
-				return false
-			}
+		if !canUse || n == nil {
+			return false
+		}
+		if !hasType2Schema(n) {
+			canUse = false
+			return false
 		}
-		if _, ok := n.(sql.Node2); n != nil && !ok {
-			allNode2 = false
+		if !isNode2(n) {
 			return false
 		}
+		switch n.(type) {
+		case *plan.ResolvedTable, *plan.IndexedTableAccess:
+			readsTable = true
+		}
 		return true
 	})
-	if !allNode2 {
-		return allNode2
-	}
+	return canUse && readsTable
+}
 
-	// All expressions in the tree must likewise be Expression2, and all types Type2, or we can't use rowFrame iteration
-	// TODO: likely that some nodes rely on expressions but don't implement sql.Expressioner, or implement it incompletely
-	transform.InspectExpressions(n, func(e sql.Expression) bool {
-		if e == nil {
+// hasType2Schema returns whether all the types of the schema of the node given implement Type2.
+func hasType2Schema(n sql.Node) bool {
+	for _, col := range n.Schema() {
+		if _, ok := col.Type.(sql.Type2); !ok {
 			return false
 		}
-		if _, ok := e.(sql.Expression2); !ok {
-			allNode2 = false
-			return false
+	}
+	return true
+}
+
+// isNode2 returns whether the node given can be run with its RowIter2.
+func isNode2(n sql.Node) bool {
+	if _, ok := n.(sql.Node2); !ok {
+		return false
+	}
+
+	var table sql.Table
+	switch n := n.(type) {
+	case *plan.ResolvedTable:
+		table = n.Table
+	case *plan.IndexedTableAccess:
+		table = n.Table
+	}
+	if table != nil {
+		if tw, ok := table.(sql.TableWrapper); ok {
+			table = tw.Underlying()
 		}
-		if _, ok := e.Type().(sql.Type2); !ok {
-			allNode2 = false
+		if _, ok := table.(sql.Table2); !ok {
 			return false
 		}
-		return true
-	})
+	}
 
-	return allNode2
+	// TODO: likely that some nodes rely on expressions but don't implement sql.Expressioner, or implement it incompletely
+	if ne, ok := n.(sql.Expressioner); ok {
+		for _, e := range ne.Expressions() {
+			if !isExpression2(e) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isExpression2 returns whether the expression given and all of its children implement Expression2 with types that
+// implement Type2.
+func isExpression2(e sql.Expression) bool {
+	return !transform.InspectExpr(e, func(e sql.Expression) bool {
+		if _, ok := e.(sql.Expression2); !ok {
+			return true
+		}
+		_, ok := e.Type().(sql.Type2)
+		return !ok
+	})
 }
 
 // rowFormatSelectorIter is a wrapping row iter that implements RowIterTypeSelector so that clients consuming rows from it
@@ -556,6 +1139,53 @@ func (t rowFormatSelectorIter) IsNode2() bool {
 	return t.isNode2
 }
 
+// throttledIter is a wrapping row iter that releases the throttler limits acquired by its query when it's closed.
+// retryingIter returns the rows of a statement, which is run again when it fails before returning any.
+type retryingIter struct {
+	sql.RowIter
+	// retry runs the statement again after the failure given, or returns the failure if it can't be retried
+	retry    func(ctx *sql.Context, err error) (sql.Schema, sql.RowIter, error)
+	returned bool
+}
+
+func (i *retryingIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.RowIter.Next(ctx)
+	for err != nil && err != io.EOF && !i.returned {
+		_ = i.RowIter.Close(ctx)
+		var iter sql.RowIter
+		if _, iter, err = i.retry(ctx, err); err != nil {
+			i.RowIter = sql.RowsToRowIter()
+			return nil, err
+		}
+		i.RowIter = iter
+		row, err = i.RowIter.Next(ctx)
+	}
+	i.returned = true
+	return row, err
+}
+
+type throttledIter struct {
+	sql.RowIter
+	release func()
+}
+
+var _ sql.RowIterTypeSelector = (*throttledIter)(nil)
+var _ sql.RowIter2 = (*throttledIter)(nil)
+
+func (t *throttledIter) Close(ctx *sql.Context) error {
+	defer t.release()
+	return t.RowIter.Close(ctx)
+}
+
+func (t *throttledIter) Next2(ctx *sql.Context, frame *sql.RowFrame) error {
+	return t.RowIter.(sql.RowIter2).Next2(ctx, frame)
+}
+
+func (t *throttledIter) IsNode2() bool {
+	selector, ok := t.RowIter.(sql.RowIterTypeSelector)
+	return ok && selector.IsNode2()
+}
+
 const (
 	enableIter2EnvVar = "ENABLE_ROW_ITER_2"
 )
@@ -600,6 +1230,93 @@ func (e *Engine) beginTransaction(ctx *sql.Context, transactionDatabase string)
 	return nil
 }
 
+// xaStateCheck returns an error if the statement given can't be executed in the XA transaction the session is
+// associated with, if any. Transaction control statements can't be executed in an XA transaction, and only XA
+// statements can once its work has ended.
+func (e *Engine) xaStateCheck(ctx *sql.Context, node sql.Node) error {
+	xa := e.Analyzer.Catalog.XATransactions.SessionTransaction(ctx.ID())
+	if xa == nil {
+		return nil
+	}
+	switch node.(type) {
+	case *plan.XA:
+		return nil
+	case *plan.StartTransaction, *plan.Commit, *plan.Rollback:
+		return sql.ErrXAInvalidState.New(xa.State)
+	}
+	if xa.State != sql.XAActive {
+		return sql.ErrXAInvalidState.New(xa.State)
+	}
+	return nil
+}
+
+// startTwoPhaseTransactions starts a two-phase transaction in each of the databases written by the node given that
+// commits in two phases, unless the session's transaction has already written to it.
+func (e *Engine) startTwoPhaseTransactions(ctx *sql.Context, node sql.Node) error {
+	for _, dbName := range writtenDatabases(ctx, node) {
+		db, err := e.Analyzer.Catalog.Database(ctx, dbName)
+		if err != nil {
+			return err
+		}
+		if err := sql.StartTwoPhaseTransaction(ctx, db); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writtenDatabases returns the names of the databases written by the node given, including by the triggers it fires.
+// For an update of a join, every database of the join is considered written.
+func writtenDatabases(ctx *sql.Context, node sql.Node) []string {
+	var dbNames []string
+	addDb := func(dbName string) {
+		if dbName == "" {
+			dbName = ctx.GetCurrentDatabase()
+		}
+		for _, name := range dbNames {
+			if strings.EqualFold(name, dbName) {
+				return
+			}
+		}
+		dbNames = append(dbNames, dbName)
+	}
+
+	transform.Inspect(node, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.InsertInto:
+			if db := n.Database(); db != nil {
+				addDb(db.Name())
+			}
+		case *plan.Update:
+			if _, ok := n.Child.(*plan.UpdateJoin); !ok {
+				addDb(n.Database())
+				return true
+			}
+			transform.Inspect(n.Child, func(n sql.Node) bool {
+				if rt, ok := n.(*plan.ResolvedTable); ok && rt.Database != nil {
+					addDb(rt.Database.Name())
+				}
+				return true
+			})
+		case *plan.DeleteFrom:
+			if !n.HasExplicitTargets() {
+				addDb(n.Database())
+				return true
+			}
+			for _, target := range n.GetDeleteTargets() {
+				transform.Inspect(target, func(n sql.Node) bool {
+					if rt, ok := n.(*plan.ResolvedTable); ok && rt.Database != nil {
+						addDb(rt.Database.Name())
+					}
+					return true
+				})
+			}
+		}
+		return true
+	})
+	return dbNames
+}
+
 func (e *Engine) Close() error {
 	for _, p := range e.ProcessList.Processes() {
 		e.ProcessList.Kill(p.Connection)
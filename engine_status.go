@@ -0,0 +1,112 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+)
+
+var _ sql.EngineStatusReporter = (*Engine)(nil)
+
+// EngineStatus returns the internal state of the engine, which SHOW ENGINE GMS STATUS reports. Open transactions are
+// only reported for the connections of servers, which are tracked by the engine's process list.
+func (e *Engine) EngineStatus(ctx *sql.Context) (sql.EngineStatus, error) {
+	status := sql.EngineStatus{
+		PlanCache: e.PreparedDataCache.Status(),
+		Memory: sql.MemoryStatus{
+			UsedBytes: e.MemoryManager.UsedMemory(),
+			MaxBytes:  e.MemoryManager.MaxMemory(),
+			Caches:    e.MemoryManager.NumCaches(),
+		},
+		BackgroundThreads: e.BackgroundThreads.Threads(),
+		Analyzer:          e.analyzerStats.status(),
+		StatementRetries:  e.StatementRetries(),
+	}
+
+	if pl, ok := e.ProcessList.(*ProcessList); ok {
+		for _, sess := range pl.Sessions() {
+			tx := sess.GetTransaction()
+			if tx == nil {
+				continue
+			}
+			status.Transactions = append(status.Transactions, sql.TransactionStatus{
+				Connection:  sess.ID(),
+				User:        sess.Client().User,
+				Host:        sess.Client().Address,
+				Transaction: tx.String(),
+				ReadOnly:    tx.IsReadOnly(),
+			})
+		}
+		sort.Slice(status.Transactions, func(i, j int) bool {
+			return status.Transactions[i].Connection < status.Transactions[j].Connection
+		})
+	}
+
+	if controller := e.Analyzer.BinlogReplicaController; controller != nil {
+		replicaStatus, err := controller.GetReplicaStatus(ctx)
+		if err != nil {
+			return sql.EngineStatus{}, err
+		}
+		status.Replication = &sql.ReplicationStatus{
+			IoRunning:  binlogreplication.ReplicaIoNotRunning,
+			SqlRunning: binlogreplication.ReplicaSqlNotRunning,
+		}
+		if replicaStatus != nil {
+			status.Replication = &sql.ReplicationStatus{
+				SourceHost:   replicaStatus.SourceHost,
+				SourcePort:   replicaStatus.SourcePort,
+				IoRunning:    replicaStatus.ReplicaIoRunning,
+				SqlRunning:   replicaStatus.ReplicaSqlRunning,
+				LastIoError:  replicaStatus.LastIoError,
+				LastSqlError: replicaStatus.LastSqlError,
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// analyzerStats accumulates the timings of the analysis of the queries of an engine.
+type analyzerStats struct {
+	queries   uint64
+	totalTime int64
+	maxTime   int64
+}
+
+// record records the analysis of a query taking the time given.
+func (s *analyzerStats) record(d time.Duration) {
+	atomic.AddUint64(&s.queries, 1)
+	atomic.AddInt64(&s.totalTime, int64(d))
+	for {
+		max := atomic.LoadInt64(&s.maxTime)
+		if int64(d) <= max || atomic.CompareAndSwapInt64(&s.maxTime, max, int64(d)) {
+			return
+		}
+	}
+}
+
+// status returns the timings recorded.
+func (s *analyzerStats) status() sql.AnalyzerStatus {
+	return sql.AnalyzerStatus{
+		Queries:   atomic.LoadUint64(&s.queries),
+		TotalTime: time.Duration(atomic.LoadInt64(&s.totalTime)),
+		MaxTime:   time.Duration(atomic.LoadInt64(&s.maxTime)),
+	}
+}
@@ -1002,6 +1002,109 @@ func (t TestProvider) TableFunction(_ *sql.Context, name string) (sql.TableFunct
 	return nil, sql.ErrTableFunctionNotFound.New(name)
 }
 
+func TestLazyValues(t *testing.T) {
+	loads := 0
+	db := memory.NewDatabase("mydb")
+	db.AddTable("blobs", &lazyBlobTable{loads: &loads})
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	ctx.SetCurrentDatabase("mydb")
+
+	tests := []struct {
+		query    string
+		expected []sql.Row
+		loads    int
+	}{
+		{"select pk from blobs where pk > 1 order by pk", []sql.Row{{int64(2)}, {int64(3)}}, 0},
+		{"select * from blobs where pk = 2", []sql.Row{{int64(2), "blob 2"}}, 1},
+		{"select pk, b from blobs where pk < 3 order by pk", []sql.Row{{int64(1), "blob 1"}, {int64(2), "blob 2"}}, 2},
+		{"select pk from blobs where b = 'blob 1'", []sql.Row{{int64(1)}}, 3},
+		{"select length(b) from blobs where pk = 1", []sql.Row{{int32(6)}}, 1},
+		{"select count(distinct b) from blobs", []sql.Row{{int64(2)}}, 3},
+		{"select distinct b from blobs order by b", []sql.Row{{"blob 1"}, {"blob 2"}}, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			loads = 0
+			sch, iter, err := e.Query(ctx, test.query)
+			require.NoError(t, err)
+			rows, err := sql.RowIterToRows(ctx, sch, iter)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, rows)
+			require.Equal(t, test.loads, loads)
+		})
+	}
+}
+
+// lazyBlob is a handle to a value of lazyBlobTable, counting the times it's loaded.
+type lazyBlob struct {
+	value string
+	loads *int
+}
+
+func (b lazyBlob) Load(*sql.Context) (interface{}, error) {
+	*b.loads++
+	return b.value, nil
+}
+
+// lazyBlobTable is a table of three rows, whose TEXT values are returned as handles.
+type lazyBlobTable struct {
+	loads *int
+}
+
+var _ sql.Table = (*lazyBlobTable)(nil)
+
+func (t *lazyBlobTable) Name() string {
+	return "blobs"
+}
+
+func (t *lazyBlobTable) String() string {
+	return "blobs"
+}
+
+func (t *lazyBlobTable) Schema() sql.Schema {
+	return sql.Schema{
+		{Name: "pk", Type: types.Int64, Source: "blobs", PrimaryKey: true},
+		{Name: "b", Type: types.Text, Source: "blobs", Nullable: true},
+	}
+}
+
+func (t *lazyBlobTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+func (t *lazyBlobTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return &lazyBlobPartitionIter{}, nil
+}
+
+func (t *lazyBlobTable) PartitionRows(*sql.Context, sql.Partition) (sql.RowIter, error) {
+	var rows []sql.Row
+	for i := int64(1); i <= 3; i++ {
+		value := "blob " + fmt.Sprint(i)
+		if i == 3 {
+			value = "blob 2"
+		}
+		rows = append(rows, sql.NewRow(i, lazyBlob{value: value, loads: t.loads}))
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+type lazyBlobPartitionIter struct {
+	done bool
+}
+
+func (p *lazyBlobPartitionIter) Next(*sql.Context) (sql.Partition, error) {
+	if p.done {
+		return nil, io.EOF
+	}
+	p.done = true
+	return memory.NewPartition([]byte("blobs")), nil
+}
+
+func (p *lazyBlobPartitionIter) Close(*sql.Context) error {
+	return nil
+}
+
 func TestTimestampBindingsCanBeConverted(t *testing.T) {
 	db, close := newDatabase()
 	defer close()
@@ -1037,7 +1140,98 @@ func TestTimestampBindingsCanBeCompared(t *testing.T) {
 	require.Equal(t, 1, count)
 }
 
+func TestPreparedStatementLongData(t *testing.T) {
+	// A small max_allowed_packet makes the driver stream larger parameters with COM_STMT_SEND_LONG_DATA
+	db, close := newDatabaseWithParams("maxAllowedPacket=1024")
+	defer close()
+
+	_, err := db.Exec("CREATE TABLE blobs (pk int primary key, b longblob, t longtext)")
+	require.NoError(t, err)
+
+	value := strings.Repeat("abcdefgh", 100000)
+	_, err = db.Exec("INSERT INTO blobs VALUES (?, ?, ?)", 1, []byte(value), value)
+	require.NoError(t, err)
+
+	var b []byte
+	var s string
+	err = db.QueryRow("SELECT b, t FROM blobs WHERE pk = ?", 1).Scan(&b, &s)
+	require.NoError(t, err)
+	require.Equal(t, []byte(value), b)
+	require.Equal(t, value, s)
+
+	// The chunks of a parameter are only kept for the execution they were sent for
+	stmt, err := db.Prepare("SELECT length(?), ? = t FROM blobs WHERE pk = 1")
+	require.NoError(t, err)
+	defer stmt.Close()
+	for _, v := range []string{value, "abc", value[:4000]} {
+		var length int
+		var eq bool
+		require.NoError(t, stmt.QueryRow(v, v).Scan(&length, &eq))
+		require.Equal(t, len(v), length)
+		require.Equal(t, v == value, eq)
+	}
+
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.ExecContext(context.Background(), "SET max_allowed_packet = 65536")
+	require.NoError(t, err)
+	_, err = conn.ExecContext(context.Background(), "INSERT INTO blobs VALUES (?, ?, ?)", 2, []byte(value), "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "longer than 'max_allowed_packet' bytes")
+}
+
+func TestProcedureResultSetsOverWire(t *testing.T) {
+	db, close := newDatabaseWithParams("multiStatements=true")
+	defer close()
+
+	_, err := db.Exec("CREATE PROCEDURE p() BEGIN SELECT 1; SELECT 2, 3; END")
+	require.NoError(t, err)
+
+	readResultSets := func(query string) [][][]string {
+		conn, err := db.Conn(context.Background())
+		require.NoError(t, err)
+		defer conn.Close()
+		rows, err := conn.QueryContext(context.Background(), query)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var resultSets [][][]string
+		for {
+			cols, err := rows.Columns()
+			require.NoError(t, err)
+			var resultSet [][]string
+			for rows.Next() {
+				row := make([]string, len(cols))
+				dest := make([]interface{}, len(cols))
+				for i := range row {
+					dest[i] = &row[i]
+				}
+				require.NoError(t, rows.Scan(dest...))
+				resultSet = append(resultSet, row)
+			}
+			resultSets = append(resultSets, resultSet)
+			if !rows.NextResultSet() {
+				break
+			}
+		}
+		require.NoError(t, rows.Err())
+		return resultSets
+	}
+
+	// every result set of the procedure is returned, followed by those of the next statements
+	require.Equal(t, [][][]string{{{"1"}}, {{"2", "3"}}}, readResultSets("CALL p()"))
+	require.Equal(t, [][][]string{{{"1"}}, {{"2", "3"}}, {{"4"}}}, readResultSets("CALL p(); SELECT 4"))
+	require.Equal(t, [][][]string{{{"0"}}, {{"1"}}, {{"2", "3"}}}, readResultSets("SELECT 0; CALL p()"))
+}
+
 func newDatabase() (*sql2.DB, func()) {
+	return newDatabaseWithParams("")
+}
+
+// newDatabaseWithParams starts a server over an empty database and returns a client connected to it with the DSN
+// parameters given.
+func newDatabaseWithParams(params string) (*sql2.DB, func()) {
 	// Grab an empty port so that tests do not fail if a specific port is already in use
 	listener, err := net.Listen("tcp", ":0")
 	if err != nil {
@@ -1064,9 +1258,199 @@ func newDatabase() (*sql2.DB, func()) {
 	}
 	go srv.Start()
 
-	db, err := sql2.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/mydb", port))
+	db, err := sql2.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/mydb?%s", port, params))
 	if err != nil {
 		panic(err)
 	}
 	return db, func() { srv.Close() }
 }
+
+func TestOnlineIndexBuild(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	harness := enginetest.NewDefaultMemoryHarness()
+	ctx := enginetest.NewContext(harness)
+	ctx.SetCurrentDatabase("mydb")
+	for _, query := range []string{
+		"create table t (pk int primary key, a int, b int)",
+		"insert into t values (1, 10, 100), (2, 20, 200), (3, 30, 300)",
+	} {
+		enginetest.RunQueryWithContext(t, e, harness, ctx, query)
+	}
+
+	table, ok, err := db.GetTableInsensitive(ctx, "t")
+	require.NoError(t, err)
+	require.True(t, ok)
+	memTable := table.(*memory.Table)
+	memTable.EnableOnlineIndexBuilds()
+	blocking := &blockingIndexBuildTable{Table: memTable, copied: make(chan struct{}), resume: make(chan struct{})}
+	db.AddTable("t", blocking)
+
+	query := func(ctx *sql.Context, query string) ([]sql.Row, error) {
+		sch, iter, err := e.Query(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, sch, iter)
+	}
+	// createIndex runs the CREATE INDEX statement given in a session of its own, and checks the build of the index
+	// once the rows of the table are copied, before running the statements given and resuming the build.
+	createIndex := func(t *testing.T, create, index string, rowCount int64, statements ...string) error {
+		buildCtx := enginetest.NewContext(harness)
+		buildCtx.SetCurrentDatabase("mydb")
+		done := make(chan error, 1)
+		go func() {
+			_, err := query(buildCtx, create)
+			done <- err
+		}()
+
+		select {
+		case <-blocking.copied:
+		case err := <-done:
+			require.FailNow(t, "the index build ended before copying the rows of the table", "%v", err)
+		}
+		rows, err := query(ctx, "show index builds")
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, sql.Row{"mydb", "t", index, buildCtx.ID(), sql.IndexBuildPhaseCopying, rowCount, rowCount}, rows[0][1:8])
+		require.Equal(t, "100", fmt.Sprint(rows[0][8]))
+
+		// The table is written while the index is built
+		for _, statement := range statements {
+			_, err := query(ctx, statement)
+			require.NoError(t, err)
+		}
+		blocking.resume <- struct{}{}
+		err = <-done
+
+		rows, err2 := query(ctx, "show index builds")
+		require.NoError(t, err2)
+		require.Empty(t, rows)
+		return err
+	}
+
+	t.Run("writes during the build", func(t *testing.T) {
+		err := createIndex(t, "create unique index a on t (a)", "a", 3, "insert into t values (4, 40, 400)")
+		require.NoError(t, err)
+		rows, err := query(ctx, "select pk from t where a = 40")
+		require.NoError(t, err)
+		require.Equal(t, []sql.Row{{int32(4)}}, rows)
+		_, err = query(ctx, "insert into t values (5, 40, 500)")
+		require.ErrorContains(t, err, "duplicate unique key given")
+	})
+
+	t.Run("duplicate key written during the build", func(t *testing.T) {
+		err := createIndex(t, "alter table t add unique index b (b)", "b", 4, "update t set b = 100 where pk = 4")
+		require.ErrorContains(t, err, "duplicate unique key given")
+		rows, err := query(ctx, "select index_name from information_schema.statistics where table_name = 't' order by 1")
+		require.NoError(t, err)
+		require.Equal(t, []sql.Row{{"a"}}, rows)
+	})
+}
+
+// blockingIndexBuildTable is a memory table whose index builds wait to be resumed once they've copied the rows of
+// the table.
+type blockingIndexBuildTable struct {
+	*memory.Table
+	copied chan struct{}
+	resume chan struct{}
+}
+
+var _ sql.OnlineIndexBuilder = (*blockingIndexBuildTable)(nil)
+
+func (t *blockingIndexBuildTable) StartIndexBuild(ctx *sql.Context, idx sql.IndexDef) (sql.IndexBuild, error) {
+	build, err := t.Table.StartIndexBuild(ctx, idx)
+	if err != nil {
+		return nil, err
+	}
+	return &blockingIndexBuild{IndexBuild: build, table: t}, nil
+}
+
+type blockingIndexBuild struct {
+	sql.IndexBuild
+	table *blockingIndexBuildTable
+}
+
+func (b *blockingIndexBuild) Copy(ctx *sql.Context, progress func(rows int64)) error {
+	if err := b.IndexBuild.Copy(ctx, progress); err != nil {
+		return err
+	}
+	b.table.copied <- struct{}{}
+	<-b.table.resume
+	return nil
+}
+
+func TestTableMaintenanceHooks(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	harness := enginetest.NewDefaultMemoryHarness()
+	ctx := enginetest.NewContext(harness)
+	ctx.SetCurrentDatabase("mydb")
+	for _, query := range []string{
+		"create table t (pk int primary key, a int)",
+		"insert into t values (1, 10), (2, 20)",
+	} {
+		enginetest.RunQueryWithContext(t, e, harness, ctx, query)
+	}
+
+	table, ok, err := db.GetTableInsensitive(ctx, "t")
+	require.NoError(t, err)
+	require.True(t, ok)
+	maintained := &maintenanceHooksTable{Table: table.(*memory.Table)}
+	db.AddTable("t", maintained)
+
+	query := func(query string) []sql.Row {
+		sch, iter, err := e.Query(ctx, query)
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+
+	require.Equal(t, []sql.Row{{"mydb.t", int64(42)}}, query("checksum table t"))
+	require.Equal(t, []sql.Row{{"mydb.t", int64(42)}}, query("checksum table t quick"))
+	extended := query("checksum table t extended")
+	require.NotEqual(t, int64(42), extended[0][1])
+
+	require.Equal(t, []sql.Row{
+		{"mydb.t", "check", "warning", "checked with options [QUICK]"},
+		{"mydb.t", "check", "status", "OK"},
+	}, query("check table t quick"))
+	maintained.corrupt = true
+	require.Equal(t, []sql.Row{
+		{"mydb.t", "check", "warning", "checked with options []"},
+		{"mydb.t", "check", "error", "page 3 is corrupt"},
+		{"mydb.t", "check", "error", "Corrupt"},
+	}, query("check table t"))
+
+	require.Equal(t, []sql.Row{{"mydb.t", "optimize", "status", "OK"}}, query("optimize table t"))
+	require.Equal(t, 1, maintained.optimized)
+}
+
+// maintenanceHooksTable is a memory table implementing the hooks of the table maintenance statements.
+type maintenanceHooksTable struct {
+	*memory.Table
+	corrupt   bool
+	optimized int
+}
+
+var _ sql.ChecksumTable = (*maintenanceHooksTable)(nil)
+var _ sql.CheckableTable = (*maintenanceHooksTable)(nil)
+var _ sql.OptimizableTable = (*maintenanceHooksTable)(nil)
+
+func (t *maintenanceHooksTable) TableChecksum(ctx *sql.Context) (uint64, error) {
+	return 42, nil
+}
+
+func (t *maintenanceHooksTable) CheckTable(ctx *sql.Context, options []string) ([]sql.TableMaintenanceMessage, error) {
+	messages := []sql.TableMaintenanceMessage{{Type: sql.TableMaintenanceWarning, Text: fmt.Sprintf("checked with options %v", options)}}
+	if t.corrupt {
+		messages = append(messages, sql.TableMaintenanceMessage{Type: sql.TableMaintenanceError, Text: "page 3 is corrupt"})
+	}
+	return messages, nil
+}
+
+func (t *maintenanceHooksTable) OptimizeTable(ctx *sql.Context) error {
+	t.optimized++
+	return nil
+}
@@ -711,6 +711,13 @@ func TestReplaceInto(t *testing.T, harness Harness) {
 	}
 }
 
+func TestUniqueKeys(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.UniqueKeyScripts {
+		TestScript(t, harness, script)
+	}
+}
+
 func TestReplaceIntoErrors(t *testing.T, harness Harness) {
 	harness.Setup(setup.MydbData, setup.MytableData)
 	for _, tt := range queries.ReplaceErrorTests {
@@ -1777,20 +1784,20 @@ func TestStoredProcedures(t *testing.T, harness Harness) {
 		TestQueryWithContext(t, ctx, e, harness, "CREATE PROCEDURE mydb.p2() SELECT 6", []sql.Row{{types.OkResult{}}}, nil, nil)
 
 		TestQueryWithContext(t, ctx, e, harness, "SHOW PROCEDURE STATUS", []sql.Row{
-			{"mydb", "p1", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p1", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
-			{"mydb", "p2", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p2", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
-			{"mydb", "p5", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p5", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 		}, nil, nil)
 
 		TestQueryWithContext(t, ctx, e, harness, "DROP PROCEDURE mydb.p1", []sql.Row{}, nil, nil)
 
 		TestQueryWithContext(t, ctx, e, harness, "SHOW PROCEDURE STATUS", []sql.Row{
-			{"mydb", "p2", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p2", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
-			{"mydb", "p5", "PROCEDURE", "", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
+			{"mydb", "p5", "PROCEDURE", "root@localhost", time.Unix(0, 0).UTC(), time.Unix(0, 0).UTC(),
 				"DEFINER", "", "utf8mb4", "utf8mb4_0900_bin", "utf8mb4_0900_bin"},
 		}, nil, nil)
 	})
@@ -2270,6 +2277,24 @@ func TestRenameTable(t *testing.T, harness Harness) {
 	require.Error(err)
 	require.True(sql.ErrTableAlreadyExists.Is(err))
 
+	// Tables can be swapped through an intermediate name
+	TestQueryWithContext(t, ctx, e, harness, "RENAME TABLE emptytable TO tmp, niltable TO emptytable, tmp TO niltable", []sql.Row{{types.NewOkResult(0)}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, "SELECT COUNT(*) FROM emptytable", []sql.Row{{6}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, harness, "SELECT COUNT(*) FROM niltable", []sql.Row{{0}}, nil, nil)
+
+	// A multi-table rename is applied entirely or not at all
+	_, _, err = e.Query(NewContext(harness), "RENAME TABLE othertable2 TO othertable, niltable TO emptytable")
+	require.Error(err)
+	require.True(sql.ErrTableAlreadyExists.Is(err))
+
+	_, ok, err = db.GetTableInsensitive(NewContext(harness), "othertable2")
+	require.NoError(err)
+	require.True(ok)
+
+	_, ok, err = db.GetTableInsensitive(NewContext(harness), "othertable")
+	require.NoError(err)
+	require.False(ok)
+
 	t.Run("no database selected", func(t *testing.T) {
 		ctx := NewContext(harness)
 		ctx.SetCurrentDatabase("")
@@ -2902,13 +2927,14 @@ func TestCreateDatabase(t *testing.T, harness Harness) {
 	})
 
 	t.Run("CREATE DATABASE error handling", func(t *testing.T) {
-		AssertWarningAndTestQuery(t, e, ctx, harness, "CREATE DATABASE newtestdb CHARACTER SET utf8mb4 ENCRYPTION='N'",
-			[]sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 0, Info: nil}}}, nil, mysql.ERNotSupportedYet, 1,
-			"", false)
+		ctx.ClearWarnings()
+		TestQueryWithContext(t, ctx, e, harness, "CREATE DATABASE newtestdb CHARACTER SET utf8mb4 ENCRYPTION='N'",
+			[]sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 0, Info: nil}}}, nil, nil)
+		TestQueryWithContext(t, ctx, e, harness, "CREATE DATABASE newtest1db DEFAULT COLLATE binary ENCRYPTION='Y'",
+			[]sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 0, Info: nil}}}, nil, nil)
+		require.Empty(t, ctx.Warnings())
 
-		AssertWarningAndTestQuery(t, e, ctx, harness, "CREATE DATABASE newtest1db DEFAULT COLLATE binary ENCRYPTION='Y'",
-			[]sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 0, Info: nil}}}, nil, mysql.ERNotSupportedYet, 1,
-			"", false)
+		AssertErr(t, e, harness, "CREATE DATABASE newtest2db ENCRYPTION='X'", sql.ErrInvalidEncryptionOption)
 
 		AssertErr(t, e, harness, "CREATE DATABASE mydb", sql.ErrDatabaseExists)
 
@@ -3591,6 +3617,34 @@ func TestChecksOnUpdate(t *testing.T, harness Harness) {
 	}
 }
 
+func TestGeneratedColumns(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.GeneratedColumnTests {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestGeneratedColumnsPrepared(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.GeneratedColumnTests {
+		TestScriptPrepared(t, harness, script)
+	}
+}
+
+func TestFunctionalIndexes(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.FunctionalIndexTests {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestFunctionalIndexesPrepared(t *testing.T, harness Harness) {
+	harness.Setup(setup.MydbData)
+	for _, script := range queries.FunctionalIndexTests {
+		TestScriptPrepared(t, harness, script)
+	}
+}
+
 func TestDisallowedCheckConstraints(t *testing.T, harness Harness) {
 	harness.Setup(setup.MydbData)
 	e := mustNewEngine(t, harness)
@@ -4734,9 +4788,9 @@ func TestWarnings(t *testing.T, harness Harness) {
 	defer e.Close()
 	ctx := NewContext(harness)
 
-	ctx.Session.Warn(&sql.Warning{Code: 1})
-	ctx.Session.Warn(&sql.Warning{Code: 2})
-	ctx.Session.Warn(&sql.Warning{Code: 3})
+	ctx.AddWarning(&sql.Warning{Code: 1})
+	ctx.AddWarning(&sql.Warning{Code: 2})
+	ctx.AddWarning(&sql.Warning{Code: 3})
 
 	for _, tt := range queries {
 		TestQueryWithContext(t, ctx, e, harness, tt.Query, tt.Expected, nil, nil)
@@ -4788,7 +4842,7 @@ func TestClearWarnings(t *testing.T, harness Harness) {
 	err = iter.Close(ctx)
 	require.NoError(err)
 
-	require.Equal(0, len(ctx.Session.Warnings()))
+	require.Equal(0, len(ctx.Warnings()))
 }
 
 func TestUse(t *testing.T, harness Harness) {
@@ -4861,6 +4915,36 @@ func TestConcurrentTransactions(t *testing.T, harness Harness) {
 	require.Len(rows, 1)
 }
 
+func TestXATransactions(t *testing.T, harness Harness) {
+	for _, script := range queries.XATransactionScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestHandlers(t *testing.T, harness Harness) {
+	for _, script := range queries.HandlerScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestStoredFunctions(t *testing.T, harness Harness) {
+	for _, script := range queries.StoredFunctionScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestErrorCodes(t *testing.T, harness Harness) {
+	for _, script := range queries.ErrorCodeScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestErrorCodesPrepared(t *testing.T, harness Harness) {
+	for _, script := range queries.ErrorCodeScripts {
+		TestScriptPrepared(t, harness, script)
+	}
+}
+
 func TestTransactionScripts(t *testing.T, harness Harness) {
 	for _, script := range queries.TransactionTests {
 		TestTransactionScript(t, harness, script)
@@ -4970,9 +5054,7 @@ func TestTracing(t *testing.T, harness Harness) {
 	var expectedSpans = []string{
 		"plan.Limit",
 		"plan.TopN",
-		"plan.Distinct",
 		"plan.Project",
-		"plan.Filter",
 		"plan.IndexedTableAccess",
 	}
 
@@ -6431,18 +6513,19 @@ func TestCharsetCollationEngine(t *testing.T, harness Harness) {
 							_, err := sql.RowIterToRows(ctx, sch, iter)
 							require.Error(t, err)
 							if query.ErrKind != nil {
-								require.True(t, query.ErrKind.Is(err))
+								require.True(t, query.ErrKind.Is(sql.UnwrapError(err)))
 							}
 						} else {
 							require.Error(t, err)
 							if query.ErrKind != nil {
-								require.True(t, query.ErrKind.Is(err))
+								require.True(t, query.ErrKind.Is(sql.UnwrapError(err)))
 							}
 						}
 					} else {
 						require.NoError(t, err)
 						rows, err := sql.RowIterToRows(ctx, sch, iter)
 						require.NoError(t, err)
+						ignoreUnexpectedInsertInfo(query.Expected, rows)
 						require.Equal(t, query.Expected, rows)
 					}
 				})
@@ -6764,11 +6847,8 @@ func TestPrivilegePersistence(t *testing.T, h Harness) {
 	require.ElementsMatch(t, []string{"REPLICATION_SLAVE_ADMIN"}, testuser.PrivilegeSet.ToSliceDynamic(false))
 	require.ElementsMatch(t, []string{}, testuser.PrivilegeSet.ToSliceDynamic(true))
 
-	_, _, err := engine.Query(ctx, "FLUSH NO_WRITE_TO_BINLOG PRIVILEGES")
-	require.Error(t, err)
-
-	_, _, err = engine.Query(ctx, "FLUSH LOCAL PRIVILEGES")
-	require.Error(t, err)
+	RunQueryWithContext(t, engine, harness, ctx, "FLUSH NO_WRITE_TO_BINLOG PRIVILEGES")
+	RunQueryWithContext(t, engine, harness, ctx, "FLUSH LOCAL PRIVILEGES")
 }
 
 // findUser returns *mysql_db.User corresponding to specific user and host names.
@@ -94,6 +94,8 @@ func TestScriptWithEngine(t *testing.T, e *sqle.Engine, harness Harness, script
 					AssertErr(t, e, harness, assertion.Query, assertion.ExpectedErr)
 				} else if assertion.ExpectedErrStr != "" {
 					AssertErr(t, e, harness, assertion.Query, nil, assertion.ExpectedErrStr)
+				} else if assertion.ExpectedErrCode != 0 {
+					AssertErrCode(t, e, harness, NewContext(harness), assertion.Query, assertion.ExpectedErrCode, assertion.ExpectedSQLState)
 				} else if assertion.ExpectedWarning != 0 {
 					AssertWarningAndTestQuery(t, e, nil, harness, assertion.Query,
 						assertion.Expected, nil, assertion.ExpectedWarning, assertion.ExpectedWarningsCount,
@@ -166,6 +168,11 @@ func TestScriptWithEnginePrepared(t *testing.T, e *sqle.Engine, harness Harness,
 			t.Run(assertion.Query, func(t *testing.T) {
 				AssertErrPrepared(t, e, harness, assertion.Query, nil, assertion.ExpectedErrStr)
 			})
+		} else if assertion.ExpectedErrCode != 0 {
+			t.Run(assertion.Query, func(t *testing.T) {
+				_, _, err := runQueryPreparedWithCtx(t, ctx.WithQuery(assertion.Query), e, assertion.Query)
+				requireErrCode(t, err, assertion.ExpectedErrCode, assertion.ExpectedSQLState)
+			})
 		} else if assertion.ExpectedWarning != 0 {
 			t.Run(assertion.Query, func(t *testing.T) {
 				AssertWarningAndTestQuery(t, e, nil, harness, assertion.Query,
@@ -447,6 +454,7 @@ func checkResults(
 ) {
 	widenedRows := WidenRows(sch, rows)
 	widenedExpected := WidenRows(sch, expected)
+	ignoreUnexpectedInsertInfo(widenedExpected, widenedRows)
 
 	upperQuery := strings.ToUpper(q)
 	orderBy := strings.Contains(upperQuery, "ORDER BY ")
@@ -488,6 +496,23 @@ func checkResults(
 	}
 }
 
+// ignoreUnexpectedInsertInfo removes the info string of insert results that are expected without one, so that
+// expected results only need to spell out the info string of an insert when it's under test.
+func ignoreUnexpectedInsertInfo(expected, rows []sql.Row) {
+	if len(expected) != 1 || len(rows) != 1 || !types.IsOkResult(expected[0]) || !types.IsOkResult(rows[0]) {
+		return
+	}
+	if expected[0][0].(types.OkResult).Info != nil {
+		return
+	}
+	okResult := rows[0][0].(types.OkResult)
+	switch okResult.Info.(type) {
+	case plan.InsertInfo, plan.LoadDataInfo:
+		okResult.Info = nil
+		rows[0] = sql.NewRow(okResult)
+	}
+}
+
 func stripSchema(s sql.Schema) []*sql.Column {
 	fields := make([]*sql.Column, len(s))
 	for i, c := range s {
@@ -658,6 +683,27 @@ func AssertErrWithCtx(t *testing.T, e *sqle.Engine, harness Harness, ctx *sql.Co
 	validateEngine(t, ctx, harness, e)
 }
 
+// AssertErrCode asserts that the given query returns an error during its execution that is reported to clients with
+// the MySQL error number and, if it's not empty, SQLSTATE given.
+func AssertErrCode(t *testing.T, e *sqle.Engine, harness Harness, ctx *sql.Context, query string, expectedCode int, expectedSQLState string) {
+	ctx = ctx.WithQuery(query)
+	sch, iter, err := e.Query(ctx, query)
+	if err == nil {
+		_, err = sql.RowIterToRows(ctx, sch, iter)
+	}
+	requireErrCode(t, err, expectedCode, expectedSQLState)
+	validateEngine(t, ctx, harness, e)
+}
+
+func requireErrCode(t *testing.T, err error, expectedCode int, expectedSQLState string) {
+	require.Error(t, err)
+	sqlErr := sql.CastSQLError(err)
+	require.Equal(t, expectedCode, sqlErr.Number(), "unexpected error code for %v", err)
+	if expectedSQLState != "" {
+		require.Equal(t, expectedSQLState, sqlErr.SQLState(), "unexpected SQLSTATE for %v", err)
+	}
+}
+
 // AssertErrPrepared asserts that the given query returns an error during its execution, optionally specifying a type of error.
 func AssertErrPrepared(t *testing.T, e *sqle.Engine, harness Harness, query string, expectedErrKind *errors.Kind, errStrs ...string) {
 	AssertErrPreparedWithCtx(t, e, harness, NewContext(harness), query, expectedErrKind, errStrs...)
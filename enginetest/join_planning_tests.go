@@ -214,12 +214,12 @@ var JoinPlanningTests = []struct {
 		tests: []JoinPlanTest{
 			{
 				q:     "select * from xy where y+1 not in (select u from uv);",
-				types: []plan.JoinType{plan.JoinTypeAntiLookup},
+				types: []plan.JoinType{plan.JoinTypeAntiHash},
 				exp:   []sql.Row{{3, 3}},
 			},
 			{
 				q:     "select * from xy where x not in (select u from uv where u not in (select a from ab where a not in (select r from rs where r = 1))) order by 1;",
-				types: []plan.JoinType{plan.JoinTypeAnti, plan.JoinTypeAnti, plan.JoinTypeAntiLookup},
+				types: []plan.JoinType{plan.JoinTypeAntiHash, plan.JoinTypeAntiHash, plan.JoinTypeAntiLookup},
 				exp:   []sql.Row{{0, 2}, {2, 1}, {3, 3}},
 			},
 			{
@@ -332,6 +332,64 @@ order by 1;`,
 			},
 		},
 	},
+	{
+		name: "anti join null semantics",
+		setup: []string{
+			"CREATE table xy (x int primary key, y int);",
+			"CREATE table uv (u int primary key, v int);",
+			"CREATE table ab (a int primary key, b int);",
+			"insert into xy values (1,1), (2,2), (3,null), (4,4);",
+			"insert into uv values (1,1), (2,null);",
+			"insert into ab values (1,1), (2,2);",
+		},
+		tests: []JoinPlanTest{
+			{
+				q:     "select * from xy where y not in (select v from uv) order by 1;",
+				types: []plan.JoinType{plan.JoinTypeAntiHash},
+				exp:   []sql.Row{},
+			},
+			{
+				q:     "select * from xy where y not in (select v from uv where v is not null) order by 1;",
+				types: []plan.JoinType{plan.JoinTypeAntiHash},
+				exp:   []sql.Row{{2, 2}, {4, 4}},
+			},
+			{
+				q:     "select * from xy where y not in (select b from ab where b > 5) order by 1;",
+				types: []plan.JoinType{plan.JoinTypeAntiHash},
+				exp:   []sql.Row{{1, 1}, {2, 2}, {3, nil}, {4, 4}},
+			},
+			{
+				q:     "select * from xy where y not in (select v from uv where u = x) order by 1;",
+				types: []plan.JoinType{plan.JoinTypeAntiHash},
+				exp:   []sql.Row{{3, nil}, {4, 4}},
+			},
+			{
+				q:     "select * from xy where y not in (select b from ab where a = x) order by 1;",
+				types: []plan.JoinType{plan.JoinTypeAntiHash},
+				exp:   []sql.Row{{3, nil}, {4, 4}},
+			},
+			{
+				q:     "select * from xy where x not in (select a from ab) order by 1;",
+				types: []plan.JoinType{plan.JoinTypeAntiHash},
+				exp:   []sql.Row{{3, nil}, {4, 4}},
+			},
+			{
+				q:     "select * from xy where not exists (select * from uv where v = y) order by 1;",
+				types: []plan.JoinType{plan.JoinTypeAntiHash},
+				exp:   []sql.Row{{2, 2}, {3, nil}, {4, 4}},
+			},
+			{
+				q:     "select * from xy where y != (select v from uv where u = 1) order by 1;",
+				types: []plan.JoinType{plan.JoinTypeAnti},
+				exp:   []sql.Row{{2, 2}, {4, 4}},
+			},
+			{
+				q:     "select * from xy where y != (select v from uv where u = 3) order by 1;",
+				types: []plan.JoinType{plan.JoinTypeAnti},
+				exp:   []sql.Row{},
+			},
+		},
+	},
 	{
 		name: "empty join tests",
 		setup: []string{
@@ -649,6 +707,27 @@ where u in (select * from rec);`,
 				q:     "select /*+ JOIN_ORDER(b,applySubq0,a) */ 1 from xy a join xy b on a.x+3 = b.x WHERE a.x in (select u from uv c)",
 				order: []string{"b", "applySubq0", "a"},
 			},
+			{
+				q:     "select straight_join 1 from xy b join xy c on b.x = c.x join xy a on a.x+3 = c.x and a.x+3 = b.x",
+				order: []string{"b", "c", "a"},
+			},
+			{
+				q:     "select straight_join 1 from xy c join xy a on a.x+3 = c.x join xy b on a.x+3 = b.x",
+				order: []string{"c", "a", "b"},
+			},
+			{
+				q:     "select 1 from xy b straight_join xy c on b.x = c.x join xy a on a.x+3 = c.x and a.x+3 = b.x",
+				order: []string{"b", "c", "a"},
+			},
+			{
+				q:     "select 1 from xy b join xy c on b.x = c.x straight_join xy a on a.x+3 = c.x and a.x+3 = b.x",
+				order: []string{"b", "c", "a"},
+			},
+			{
+				q:     "select /*+ LOOKUP_JOIN(c,a) */ straight_join 1 from xy c join xy a on a.x = c.x+3",
+				types: []plan.JoinType{plan.JoinTypeLookup},
+				order: []string{"c", "a"},
+			},
 		},
 	},
 	{
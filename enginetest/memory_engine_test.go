@@ -15,20 +15,34 @@
 package enginetest_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
+
+	sqle "github.com/dolthub/go-mysql-server"
 	"github.com/dolthub/go-mysql-server/enginetest"
 	"github.com/dolthub/go-mysql-server/enginetest/queries"
 	"github.com/dolthub/go-mysql-server/enginetest/scriptgen/setup"
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/server"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
-
-	_ "github.com/dolthub/go-mysql-server/sql/variables"
+	"github.com/dolthub/go-mysql-server/sql/variables"
 )
 
 // This file is for validating both the engine itself and the in-memory database implementation in the memory package.
@@ -112,6 +126,21 @@ func TestQueriesSimple(t *testing.T) {
 	enginetest.TestQueries(t, enginetest.NewMemoryHarness("simple", 1, testNumPartitions, true, nil))
 }
 
+// TestQueriesRowIter2 runs the canonical test queries with row frame iterators enabled, which the queries that only
+// return and compare numbers are run with.
+func TestQueriesRowIter2(t *testing.T) {
+	harness := enginetest.NewMemoryHarness("simple", 1, testNumPartitions, true, nil)
+	harness.Setup(setup.SimpleSetup...)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.EnableRowIter2 = true
+	for _, tt := range queries.QueryTests {
+		enginetest.TestQueryWithEngine(t, harness, e, tt)
+	}
+}
+
 // TestJoinQueries runs the canonical test queries against a single threaded index enabled harness.
 func TestJoinQueries(t *testing.T) {
 	enginetest.TestJoinQueries(t, enginetest.NewMemoryHarness("simple", 1, testNumPartitions, true, nil))
@@ -482,6 +511,65 @@ func TestReplaceInto(t *testing.T) {
 	enginetest.TestReplaceInto(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestUniqueKeys(t *testing.T) {
+	enginetest.TestUniqueKeys(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestXATransactions(t *testing.T) {
+	enginetest.TestXATransactions(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestHandlers(t *testing.T) {
+	enginetest.TestHandlers(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestStoredFunctions(t *testing.T) {
+	enginetest.TestStoredFunctions(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestErrorCodes(t *testing.T) {
+	enginetest.TestErrorCodes(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestErrorCodesPrepared(t *testing.T) {
+	enginetest.TestErrorCodesPrepared(t, enginetest.NewDefaultMemoryHarness())
+}
+
+// TestEngineEnforcedUniqueKeys runs the unique key scripts against tables that leave the enforcement of their unique
+// keys to the engine.
+func TestEngineEnforcedUniqueKeys(t *testing.T) {
+	for _, script := range queries.UniqueKeyScripts {
+		harness := enginetest.NewDefaultMemoryHarness()
+		harness.Setup(setup.MydbData)
+		engine, err := harness.NewEngine(t)
+		require.NoError(t, err)
+
+		for _, statement := range script.SetUpScript {
+			enginetest.RunQuery(t, engine, harness, statement)
+		}
+		disableUniqueKeyEnforcement(t, harness, engine)
+
+		enginetest.TestScriptWithEngine(t, engine, harness, queries.ScriptTest{
+			Name:       script.Name,
+			Assertions: script.Assertions,
+		})
+		engine.Close()
+	}
+}
+
+func disableUniqueKeyEnforcement(t *testing.T, harness *enginetest.MemoryHarness, engine *sqle.Engine) {
+	ctx := harness.NewContext()
+	db, err := engine.Analyzer.Catalog.Database(ctx, "mydb")
+	require.NoError(t, err)
+	names, err := db.GetTableNames(ctx)
+	require.NoError(t, err)
+	for _, name := range names {
+		table, _, err := db.GetTableInsensitive(ctx, name)
+		require.NoError(t, err)
+		table.(*memory.Table).DisableUniqueKeyEnforcement()
+	}
+}
+
 func TestReplaceIntoErrors(t *testing.T) {
 	enginetest.TestReplaceIntoErrors(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -719,6 +807,22 @@ func TestChecksOnUpdate(t *testing.T) {
 	enginetest.TestChecksOnUpdate(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestGeneratedColumns(t *testing.T) {
+	enginetest.TestGeneratedColumns(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestGeneratedColumnsPrepared(t *testing.T) {
+	enginetest.TestGeneratedColumnsPrepared(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestFunctionalIndexes(t *testing.T) {
+	enginetest.TestFunctionalIndexes(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestFunctionalIndexesPrepared(t *testing.T) {
+	enginetest.TestFunctionalIndexesPrepared(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestDisallowedCheckConstraints(t *testing.T) {
 	enginetest.TestDisallowedCheckConstraints(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -967,3 +1071,1822 @@ func mergeSetupScripts(scripts ...setup.SetupScript) []string {
 	}
 	return all
 }
+
+func TestJoinPushdown(t *testing.T) {
+	db := memory.NewJoinPushdownDatabase("db")
+	a := memory.NewTable("a", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "x", Type: types.Int64, Source: "a", PrimaryKey: true},
+		{Name: "y", Type: types.Int64, Source: "a", Nullable: true},
+	}), db.GetForeignKeyCollection())
+	b := memory.NewTable("b", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "x", Type: types.Int64, Source: "b", PrimaryKey: true},
+		{Name: "z", Type: types.Int64, Source: "b", Nullable: true},
+	}), db.GetForeignKeyCollection())
+	db.AddTable("a", a)
+	db.AddTable("b", b)
+
+	other := memory.NewDatabase("other")
+	c := memory.NewTable("c", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "x", Type: types.Int64, Source: "c", PrimaryKey: true},
+	}), other.GetForeignKeyCollection())
+	other.AddTable("c", c)
+
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	for _, row := range []sql.Row{{int64(1), int64(10)}, {int64(2), int64(20)}, {int64(3), int64(30)}} {
+		require.NoError(t, a.Insert(ctx, row))
+	}
+	for _, row := range []sql.Row{{int64(1), int64(100)}, {int64(3), int64(300)}} {
+		require.NoError(t, b.Insert(ctx, row))
+	}
+	for _, row := range []sql.Row{{int64(1)}, {int64(2)}} {
+		require.NoError(t, c.Insert(ctx, row))
+	}
+
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db, other)), new(sqle.Config))
+
+	tests := []struct {
+		query    string
+		expected []sql.Row
+		pushed   bool
+	}{
+		{
+			query:    "select a.y, b.z from a join b on a.x = b.x where a.y > 10 order by 1",
+			expected: []sql.Row{{int64(30), int64(300)}},
+			pushed:   true,
+		},
+		{
+			query:    "select t1.x, t2.z from a t1 left join b t2 on t1.x = t2.x order by 1",
+			expected: []sql.Row{{int64(1), int64(100)}, {int64(2), nil}, {int64(3), int64(300)}},
+			pushed:   true,
+		},
+		{
+			query:    "select a.x from a join other.c on a.x = c.x order by 1",
+			expected: []sql.Row{{int64(1)}, {int64(2)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			require := require.New(t)
+			ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+			ctx.SetCurrentDatabase("db")
+
+			sch, iter, err := e.Query(ctx, tt.query)
+			require.NoError(err)
+			rows, err := sql.RowIterToRows(ctx, sch, iter)
+			require.NoError(err)
+			require.Equal(tt.expected, rows)
+
+			analyzed, err := e.AnalyzeQuery(ctx, tt.query)
+			require.NoError(err)
+			var pushed bool
+			transform.Inspect(analyzed, func(n sql.Node) bool {
+				if rt, ok := n.(*plan.ResolvedTable); ok {
+					table := rt.Table
+					if wrapper, ok := table.(sql.TableWrapper); ok {
+						table = wrapper.Underlying()
+					}
+					_, isJoin := table.(*memory.JoinTable)
+					pushed = pushed || isJoin
+				}
+				return true
+			})
+			require.Equal(tt.pushed, pushed)
+		})
+	}
+}
+
+func TestPointLookups(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	harness.Setup(setup.MydbData, setup.MytableData)
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	tests := []struct {
+		query    string
+		expected []sql.Row
+		point    bool
+	}{
+		{
+			query:    "select * from mytable where i = 2",
+			expected: []sql.Row{{int64(2), "second row"}},
+			point:    true,
+		},
+		{
+			query:    "select i from mytable where s = 'third row'",
+			expected: []sql.Row{{int64(3)}},
+			point:    true,
+		},
+		{
+			query: "select * from mytable where i = 5",
+			point: true,
+		},
+		{
+			query: "select * from mytable where i = 2 and s = 'first row'",
+		},
+		{
+			query:    "select * from mytable where i > 2 order by i",
+			expected: []sql.Row{{int64(3), "third row"}},
+		},
+		{
+			query:    "select * from mytable where i in (1, 2) order by i",
+			expected: []sql.Row{{int64(1), "first row"}, {int64(2), "second row"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			require := require.New(t)
+			ctx := enginetest.NewContext(harness)
+
+			sch, iter, err := e.Query(ctx, tt.query)
+			require.NoError(err)
+			rows, err := sql.RowIterToRows(ctx, sch, iter)
+			require.NoError(err)
+			require.Equal(tt.expected, rows)
+
+			analyzed, err := e.AnalyzeQuery(ctx, tt.query)
+			require.NoError(err)
+			var point bool
+			transform.Inspect(analyzed, func(n sql.Node) bool {
+				if ita, ok := n.(*plan.IndexedTableAccess); ok {
+					point = point || ita.IsPointLookup()
+				}
+				return true
+			})
+			require.Equal(tt.point, point)
+		})
+	}
+}
+
+// conflictingTable is a table whose inserts fail with a serialization failure while it has conflicts left.
+type conflictingTable struct {
+	*memory.Table
+	conflicts *int
+}
+
+func (t conflictingTable) Inserter(ctx *sql.Context) sql.RowInserter {
+	return t.BulkInserter(ctx)
+}
+
+func (t conflictingTable) BulkInserter(ctx *sql.Context) sql.BulkRowInserter {
+	return conflictingInserter{BulkRowInserter: t.Table.BulkInserter(ctx), conflicts: t.conflicts}
+}
+
+type conflictingInserter struct {
+	sql.BulkRowInserter
+	conflicts *int
+}
+
+func (i conflictingInserter) Insert(ctx *sql.Context, row sql.Row) error {
+	return i.InsertBatch(ctx, []sql.Row{row})
+}
+
+func (i conflictingInserter) InsertBatch(ctx *sql.Context, rows []sql.Row) error {
+	if *i.conflicts > 0 {
+		*i.conflicts--
+		return sql.ErrLockDeadlock.New("conflicting write")
+	}
+	return i.BulkRowInserter.InsertBatch(ctx, rows)
+}
+
+func TestStatementRetries(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	conflicts := 0
+	db.AddTable("t", conflictingTable{
+		Table: memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "pk", Type: types.Int64, Source: "t", PrimaryKey: true},
+		}), db.GetForeignKeyCollection()),
+		conflicts: &conflicts,
+	})
+
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db)), &sqle.Config{
+		MaxStatementRetries:   3,
+		StatementRetryBackoff: time.Millisecond,
+	})
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	query := func(q string) ([]sql.Row, error) {
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, sch, iter)
+	}
+
+	// a statement that conflicts fewer times than the retry limit succeeds
+	conflicts = 2
+	_, err := query("insert into t values (1)")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), e.StatementRetries())
+
+	// a statement that keeps conflicting fails after its retries
+	conflicts = 5
+	_, err = query("insert into t values (2)")
+	require.True(t, sql.ErrLockDeadlock.Is(sql.UnwrapError(err)))
+	require.Equal(t, uint64(5), e.StatementRetries())
+	require.Equal(t, 1, conflicts)
+
+	// a statement in an explicit transaction isn't retried
+	conflicts = 1
+	_, err = query("set autocommit = 0")
+	require.NoError(t, err)
+	_, err = query("insert into t values (3)")
+	require.True(t, sql.ErrLockDeadlock.Is(sql.UnwrapError(err)))
+	require.Equal(t, uint64(5), e.StatementRetries())
+	_, err = query("set autocommit = 1")
+	require.NoError(t, err)
+
+	// a statement calling a stored procedure isn't retried, as the procedure may have effects outside the transaction
+	_, err = query("create procedure p() insert into t values (4)")
+	require.NoError(t, err)
+	conflicts = 1
+	_, err = query("call p()")
+	require.True(t, sql.ErrLockDeadlock.Is(sql.UnwrapError(err)))
+	require.Equal(t, uint64(5), e.StatementRetries())
+
+	rows, err := query("select * from t")
+	require.NoError(t, err)
+	require.Equal(t, []sql.Row{{int64(1)}}, rows)
+}
+
+// twoPhaseDatabase is a database that commits in two phases, which records the operations of its transactions.
+type twoPhaseDatabase struct {
+	*memory.Database
+	log         *[]string
+	failPrepare bool
+}
+
+var _ sql.TwoPhaseCommitDatabase = (*twoPhaseDatabase)(nil)
+
+func (d *twoPhaseDatabase) StartTwoPhaseTransaction(ctx *sql.Context) (sql.TwoPhaseTransaction, error) {
+	*d.log = append(*d.log, "start "+d.Name())
+	return &twoPhaseTransaction{db: d}, nil
+}
+
+// twoPhaseTransaction is a transaction of a twoPhaseDatabase.
+type twoPhaseTransaction struct {
+	db *twoPhaseDatabase
+}
+
+var _ sql.TwoPhaseTransaction = (*twoPhaseTransaction)(nil)
+
+func (tx *twoPhaseTransaction) String() string {
+	return tx.db.Name()
+}
+
+func (tx *twoPhaseTransaction) IsReadOnly() bool {
+	return false
+}
+
+func (tx *twoPhaseTransaction) Prepare(ctx *sql.Context) error {
+	*tx.db.log = append(*tx.db.log, "prepare "+tx.db.Name())
+	if tx.db.failPrepare {
+		return fmt.Errorf("disk full")
+	}
+	return nil
+}
+
+func (tx *twoPhaseTransaction) Commit(ctx *sql.Context) error {
+	*tx.db.log = append(*tx.db.log, "commit "+tx.db.Name())
+	return nil
+}
+
+func (tx *twoPhaseTransaction) Rollback(ctx *sql.Context) error {
+	*tx.db.log = append(*tx.db.log, "rollback "+tx.db.Name())
+	return nil
+}
+
+func TestTwoPhaseCommit(t *testing.T) {
+	var log []string
+	db1 := &twoPhaseDatabase{Database: memory.NewDatabase("db1"), log: &log}
+	db2 := &twoPhaseDatabase{Database: memory.NewDatabase("db2"), log: &log}
+
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db1, db2)), &sqle.Config{})
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	query := func(q string) error {
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return err
+		}
+		_, err = sql.RowIterToRows(ctx, sch, iter)
+		return err
+	}
+	for _, q := range []string{
+		"create table db1.t (pk int primary key)",
+		"create table db2.t2 (pk int primary key)",
+		"create table db2.u (pk int primary key)",
+		"create trigger db1.trig after insert on db1.t for each row insert into db2.t2 values (new.pk)",
+	} {
+		require.NoError(t, query(q))
+	}
+
+	t.Run("reads don't start transactions", func(t *testing.T) {
+		log = nil
+		require.NoError(t, query("select * from db1.t join db2.t2"))
+		require.Empty(t, log)
+	})
+
+	t.Run("single database", func(t *testing.T) {
+		log = nil
+		require.NoError(t, query("insert into db2.u values (1)"))
+		require.Equal(t, []string{"start db2", "prepare db2", "commit db2"}, log)
+	})
+
+	t.Run("databases written by a trigger", func(t *testing.T) {
+		log = nil
+		require.NoError(t, query("insert into db1.t values (1)"))
+		require.Equal(t, []string{
+			"start db1", "start db2",
+			"prepare db1", "prepare db2",
+			"commit db1", "commit db2",
+		}, log)
+	})
+
+	t.Run("failed prepare rolls back every database", func(t *testing.T) {
+		log = nil
+		db2.failPrepare = true
+		defer func() { db2.failPrepare = false }()
+		err := query("insert into db1.t values (2)")
+		require.True(t, sql.ErrTwoPhaseCommitPrepare.Is(err), "unexpected error %v", err)
+		require.Equal(t, []string{
+			"start db1", "start db2",
+			"prepare db1", "prepare db2",
+			"rollback db1", "rollback db2",
+		}, log)
+	})
+
+	// BaseSession doesn't implement sql.TransactionSession, so transactions only span several statements without
+	// autocommit
+	require.NoError(t, query("set autocommit = 0"))
+	defer query("set autocommit = 1")
+
+	t.Run("transaction of several statements", func(t *testing.T) {
+		log = nil
+		require.NoError(t, query("insert into db2.u values (2)"))
+		require.NoError(t, query("insert into db2.u values (3)"))
+		require.Equal(t, []string{"start db2"}, log)
+		require.NoError(t, query("insert into db1.t values (3)"))
+		require.Equal(t, []string{"start db2", "start db1"}, log)
+		require.NoError(t, query("commit"))
+		require.Equal(t, []string{
+			"start db2", "start db1",
+			"prepare db1", "prepare db2",
+			"commit db1", "commit db2",
+		}, log)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		log = nil
+		require.NoError(t, query("insert into db2.u values (4)"))
+		require.NoError(t, query("rollback"))
+		require.Equal(t, []string{"start db2", "rollback db2"}, log)
+	})
+
+	t.Run("start transaction commits", func(t *testing.T) {
+		log = nil
+		require.NoError(t, query("insert into db2.u values (5)"))
+		require.NoError(t, query("start transaction"))
+		require.Equal(t, []string{"start db2", "prepare db2", "commit db2"}, log)
+	})
+}
+
+func TestXATwoPhaseCommit(t *testing.T) {
+	var log []string
+	db1 := &twoPhaseDatabase{Database: memory.NewDatabase("db1"), log: &log}
+	db2 := &twoPhaseDatabase{Database: memory.NewDatabase("db2"), log: &log}
+
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db1, db2)), &sqle.Config{})
+	newSession := func(id uint32) *sql.Context {
+		return sql.NewContext(context.Background(), sql.WithSession(
+			sql.NewBaseSessionWithClientServer("address", sql.Client{Address: "localhost", User: "root"}, id)))
+	}
+	ctxA, ctxB := newSession(1), newSession(2)
+	query := func(ctx *sql.Context, q string) error {
+		sch, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return err
+		}
+		_, err = sql.RowIterToRows(ctx, sch, iter)
+		return err
+	}
+	for _, q := range []string{
+		"create table db1.t (pk int primary key)",
+		"create table db2.t (pk int primary key)",
+	} {
+		require.NoError(t, query(ctxA, q))
+	}
+
+	t.Run("prepared transaction committed by another session", func(t *testing.T) {
+		log = nil
+		for _, q := range []string{
+			"xa start 'a'",
+			"insert into db1.t values (1)",
+			"insert into db2.t values (1)",
+			"xa end 'a'",
+		} {
+			require.NoError(t, query(ctxA, q))
+		}
+		require.Equal(t, []string{"start db1", "start db2"}, log)
+		require.NoError(t, query(ctxA, "xa prepare 'a'"))
+		require.Equal(t, []string{"start db1", "start db2", "prepare db1", "prepare db2"}, log)
+
+		require.NoError(t, query(ctxB, "xa commit 'a'"))
+		require.Equal(t, []string{
+			"start db1", "start db2",
+			"prepare db1", "prepare db2",
+			"commit db1", "commit db2",
+		}, log)
+	})
+
+	t.Run("failed prepare rolls back the transaction", func(t *testing.T) {
+		log = nil
+		db1.failPrepare = true
+		defer func() { db1.failPrepare = false }()
+		for _, q := range []string{
+			"xa start 'b'",
+			"insert into db1.t values (2)",
+			"insert into db2.t values (2)",
+			"xa end 'b'",
+		} {
+			require.NoError(t, query(ctxA, q))
+		}
+		err := query(ctxA, "xa prepare 'b'")
+		require.True(t, sql.ErrXARollback.Is(err), "unexpected error %v", err)
+		require.Equal(t, []string{
+			"start db1", "start db2",
+			"prepare db1",
+			"rollback db1", "rollback db2",
+		}, log)
+		require.True(t, sql.ErrXAUnknownXID.Is(query(ctxB, "xa rollback 'b'")))
+	})
+
+	t.Run("prepared transaction rolled back", func(t *testing.T) {
+		log = nil
+		for _, q := range []string{
+			"xa start 'c'",
+			"insert into db2.t values (3)",
+			"xa end 'c'",
+			"xa prepare 'c'",
+			"xa rollback 'c'",
+		} {
+			require.NoError(t, query(ctxA, q))
+		}
+		require.Equal(t, []string{"start db2", "prepare db2", "rollback db2"}, log)
+	})
+
+	t.Run("unprepared transaction ended with its session", func(t *testing.T) {
+		require.NoError(t, query(ctxB, "xa start 'd'"))
+		e.CloseSession(ctxB.ID())
+		require.NoError(t, query(ctxA, "xa start 'd'"))
+		require.NoError(t, query(ctxA, "xa end 'd'"))
+		require.NoError(t, query(ctxA, "xa rollback 'd'"))
+	})
+}
+
+// flushProvider is a database provider that records the FLUSH statements it handles
+type flushProvider struct {
+	sql.DatabaseProvider
+	flushes []string
+}
+
+var _ sql.FlushHandler = (*flushProvider)(nil)
+
+func (p *flushProvider) Flush(ctx *sql.Context, option sql.FlushOption, tables []sql.DbTable) error {
+	flush := string(option)
+	for _, table := range tables {
+		flush += " " + table.String()
+	}
+	p.flushes = append(p.flushes, flush)
+	return nil
+}
+
+func TestFlush(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t", memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: types.Int64, Source: "t", PrimaryKey: true},
+	}), db.GetForeignKeyCollection()))
+	conflicts := 0
+	db.AddTable("c", conflictingTable{
+		Table: memory.NewTable("c", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "pk", Type: types.Int64, Source: "c", PrimaryKey: true},
+		}), db.GetForeignKeyCollection()),
+		conflicts: &conflicts,
+	})
+
+	pro := &flushProvider{DatabaseProvider: sql.NewDatabaseProvider(db)}
+	e := sqle.New(analyzer.NewDefault(pro), &sqle.Config{
+		MaxStatementRetries:   3,
+		StatementRetryBackoff: time.Millisecond,
+	})
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	ctx.SetCurrentDatabase("mydb")
+	query := func(q string) []sql.Row {
+		sch, iter, err := e.Query(ctx, q)
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, sch, iter)
+		require.NoError(t, err)
+		return rows
+	}
+
+	t.Run("the provider handles flushes", func(t *testing.T) {
+		pro.flushes = nil
+		query("flush logs")
+		query("flush local binary logs")
+		query("flush tables t, otherdb.u")
+		query("flush tables")
+		require.Equal(t, []string{"LOGS", "BINARY LOGS", "TABLES mydb.t otherdb.u", "TABLES"}, pro.flushes)
+	})
+
+	t.Run("flush tables discards prepared plans", func(t *testing.T) {
+		query("insert into t values (1)")
+		query("prepare s from 'select * from t where pk = ?'")
+		query("set @v = 1")
+		require.Equal(t, []sql.Row{{int64(1)}}, query("execute s using @v"))
+		_, err := e.PrepareQuery(ctx, "select pk from t")
+		require.NoError(t, err)
+
+		query("flush tables")
+		_, ok := e.PreparedDataCache.GetCachedStmt(ctx.ID(), "s")
+		require.False(t, ok)
+		_, ok = e.PreparedDataCache.GetCachedStmt(ctx.ID(), "select pk from t")
+		require.False(t, ok)
+
+		require.Equal(t, []sql.Row{{int64(1)}}, query("execute s using @v"))
+		require.Equal(t, []sql.Row{{int64(1)}}, query("select pk from t"))
+		query("flush tables")
+		query("deallocate prepare s")
+		_, _, err = e.Query(ctx, "execute s using @v")
+		require.True(t, sql.ErrUnknownPreparedStatement.Is(err))
+	})
+
+	t.Run("flush status resets counters", func(t *testing.T) {
+		conflicts = 2
+		query("insert into c values (1)")
+		require.Equal(t, uint64(2), e.StatementRetries())
+		query("flush status")
+		require.Equal(t, uint64(0), e.StatementRetries())
+	})
+}
+
+// loadablePersister is a privilege persister that keeps the data it persists, so that it can be changed and loaded
+type loadablePersister struct {
+	data []byte
+}
+
+var _ mysql_db.LoadableMySQLDbPersistence = (*loadablePersister)(nil)
+
+func (p *loadablePersister) Persist(ctx *sql.Context, data []byte) error {
+	p.data = append([]byte(nil), data...)
+	return nil
+}
+
+func (p *loadablePersister) Load(ctx *sql.Context) ([]byte, error) {
+	return p.data, nil
+}
+
+func TestFlushPrivilegesReload(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	persister := &loadablePersister{}
+	e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	e.Analyzer.Catalog.MySQLDb.SetPersister(persister)
+	ctx := enginetest.NewContextWithClient(harness, sql.Client{User: "root", Address: "localhost"})
+	users := func() []sql.Row {
+		_, rows := enginetest.MustQuery(ctx, e, "select user from mysql.user order by user")
+		return rows
+	}
+
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "create user u1@localhost")
+	persisted := persister.data
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "create user u2@localhost")
+	require.Equal(t, []sql.Row{{"root"}, {"u1"}, {"u2"}}, users())
+
+	// changes made to the persisted data outside the engine take effect, and super users are kept
+	persister.data = persisted
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "flush privileges")
+	require.Equal(t, []sql.Row{{"root"}, {"u1"}}, users())
+
+	// without persisted data, changes made directly to the grant tables are persisted
+	persister.data = nil
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "insert into mysql.user (Host, User) values ('localhost', 'u3')")
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "flush privileges")
+	require.Equal(t, []sql.Row{{"root"}, {"u1"}, {"u3"}}, users())
+	require.NotEmpty(t, persister.data)
+}
+
+func TestFlushPrivilegesWithoutPersister(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	ctx := enginetest.NewContextWithClient(harness, sql.Client{User: "root", Address: "localhost"})
+
+	// the grant tables are kept as they are, since there's nothing to persist them to or reload them from
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "create user u1@localhost")
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "insert into mysql.user (Host, User) values ('localhost', 'u2')")
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "flush privileges")
+	_, rows := enginetest.MustQuery(ctx, e, "select user from mysql.user order by user")
+	require.Equal(t, []sql.Row{{"root"}, {"u1"}, {"u2"}}, rows)
+}
+
+// incrementalPersister is a privilege persister that records the changes it persists, and fails when told to
+type incrementalPersister struct {
+	changes []mysql_db.MySQLDbChanges
+	fail    bool
+}
+
+var _ mysql_db.IncrementalMySQLDbPersistence = (*incrementalPersister)(nil)
+
+func (p *incrementalPersister) Persist(ctx *sql.Context, data []byte) error {
+	return fmt.Errorf("expected changes to be persisted incrementally")
+}
+
+func (p *incrementalPersister) PersistChanges(ctx *sql.Context, changes mysql_db.MySQLDbChanges) error {
+	if p.fail {
+		return fmt.Errorf("unable to persist")
+	}
+	p.changes = append(p.changes, changes)
+	return nil
+}
+
+func TestIncrementalPrivilegePersistence(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	e, err := harness.NewEngine(t)
+	require.NoError(t, err)
+	defer e.Close()
+
+	persister := &incrementalPersister{}
+	e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	e.Analyzer.Catalog.MySQLDb.SetPersister(persister)
+	ctx := enginetest.NewContextWithClient(harness, sql.Client{User: "root", Address: "localhost"})
+	lastChanges := func() mysql_db.MySQLDbChanges {
+		require.NotEmpty(t, persister.changes)
+		return persister.changes[len(persister.changes)-1]
+	}
+	userNames := func(users []*mysql_db.User) []string {
+		var names []string
+		for _, user := range users {
+			names = append(names, user.User)
+		}
+		return names
+	}
+
+	// nothing was loaded, so the first changes replace everything
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "CREATE USER u1@localhost")
+	require.True(t, lastChanges().Reset)
+	require.Equal(t, []string{"u1"}, userNames(lastChanges().Users))
+
+	// later changes only hold what each statement changed
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "CREATE USER u2@localhost")
+	require.False(t, lastChanges().Reset)
+	require.Equal(t, []string{"u2"}, userNames(lastChanges().Users))
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "GRANT SELECT ON *.* TO u1@localhost")
+	require.Equal(t, []string{"u1"}, userNames(lastChanges().Users))
+	require.True(t, lastChanges().Users[0].PrivilegeSet.Has(sql.PrivilegeType_Select))
+
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "CREATE ROLE r1")
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "GRANT r1 TO u2@localhost")
+	require.Empty(t, lastChanges().Users)
+	require.Len(t, lastChanges().RoleEdges, 1)
+	require.Equal(t, "u2", lastChanges().RoleEdges[0].ToUser)
+
+	enginetest.RunQueryWithContext(t, e, harness, ctx, "DROP USER u2@localhost")
+	require.Equal(t, []mysql_db.UserPrimaryKey{{Host: "localhost", User: "u2"}}, lastChanges().RemovedUsers)
+	require.Len(t, lastChanges().RemovedRoleEdges, 1)
+
+	// a statement whose changes can't be persisted fails, and its changes are reverted
+	persister.fail = true
+	_, _, err = e.Query(ctx, "GRANT INSERT ON *.* TO u1@localhost")
+	require.Error(t, err)
+	_, _, err = e.Query(ctx, "CREATE USER u3@localhost")
+	require.Error(t, err)
+	persister.fail = false
+	_, rows := enginetest.MustQuery(ctx, e, "SELECT user, insert_priv FROM mysql.user WHERE user LIKE 'u%' ORDER BY user")
+	require.Equal(t, []sql.Row{{"u1", uint16(1)}}, rows)
+}
+
+func TestRowPolicies(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	e := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb")))
+	defer e.Close()
+
+	// every user but root only has access to the rows of their own tenant
+	e.Analyzer.Catalog.RegisterRowPolicy("mydb", "docs", func(ctx *sql.Context, db, table string) (sql.Expression, error) {
+		user := ctx.Session.Client().User
+		if user == "root" {
+			return nil, nil
+		}
+		return expression.NewEquals(expression.NewUnresolvedColumn("tenant"), expression.NewLiteral(user, types.LongText)), nil
+	})
+	as := func(user string) *sql.Context {
+		return enginetest.NewContextWithClient(harness, sql.Client{User: user, Address: "localhost"})
+	}
+	query := func(user, q string) []sql.Row {
+		_, rows := enginetest.MustQuery(as(user), e, q)
+		return rows
+	}
+	assertErr := func(user, q string, kind *errors.Kind) {
+		enginetest.AssertErrWithCtx(t, e, harness, as(user), q, kind)
+	}
+
+	query("root", "create table docs (id int primary key, tenant varchar(20), body varchar(20))")
+	query("root", "insert into docs values (1, 'alice', 'a'), (2, 'bob', 'b'), (3, 'alice', 'c'), (4, 'bob', 'd')")
+
+	t.Run("reads are restricted", func(t *testing.T) {
+		require.Equal(t, []sql.Row{{int32(1)}, {int32(3)}}, query("alice", "select id from docs order by id"))
+		require.Equal(t, []sql.Row{{int32(2)}, {int32(4)}}, query("bob", "select id from docs order by id"))
+		require.Equal(t, []sql.Row{{int64(4)}}, query("root", "select count(*) from docs"))
+		require.Equal(t, []sql.Row{{int32(1)}, {int32(3)}}, query("alice", "select a.id from docs a join docs b on a.id = b.id order by 1"))
+		require.Equal(t, []sql.Row{{int32(3)}}, query("alice", "select id from (select id from docs) s where id > 1"))
+		require.Equal(t, []sql.Row{{int64(2)}}, query("alice", "select (select count(*) from docs) from dual"))
+		require.Equal(t, []sql.Row{{int64(2)}}, query("alice", "select c from (select count(*) c from docs) s"))
+		require.Equal(t, []sql.Row{{int32(1)}}, query("alice", "select id from docs where id in (select min(id) from docs)"))
+		require.Empty(t, query("alice", "select * from docs where id = 2"))
+	})
+
+	t.Run("writes are restricted", func(t *testing.T) {
+		query("alice", "update docs set body = 'x'")
+		require.Equal(t, []sql.Row{{int32(1), "x"}, {int32(2), "b"}, {int32(3), "x"}, {int32(4), "d"}},
+			query("root", "select id, body from docs order by id"))
+		assertErr("alice", "update docs set tenant = 'bob' where id = 1", sql.ErrRowPolicyViolated)
+
+		query("alice", "insert into docs values (5, 'alice', 'e')")
+		assertErr("alice", "insert into docs values (6, 'bob', 'f')", sql.ErrRowPolicyViolated)
+		assertErr("alice", "insert into docs values (6, null, 'f')", sql.ErrRowPolicyViolated)
+		assertErr("alice", "replace into docs values (2, 'alice', 'f')", sql.ErrRowPolicyUnsupported)
+		assertErr("alice", "insert into docs values (2, 'alice', 'f') on duplicate key update body = 'f'", sql.ErrRowPolicyUnsupported)
+		assertErr("alice", "truncate docs", sql.ErrRowPolicyUnsupported)
+
+		assertErr("alice", "handler docs open", sql.ErrRowPolicyUnsupported)
+		query("root", "handler docs open")
+		assertErr("alice", "checksum table docs", sql.ErrRowPolicyUnsupported)
+		assertErr("alice", "checksum table mydb.docs extended", sql.ErrRowPolicyUnsupported)
+		require.Len(t, query("root", "checksum table docs"), 1)
+
+		query("alice", "delete from docs")
+		require.Equal(t, []sql.Row{{int32(2)}, {int32(4)}}, query("root", "select id from docs order by id"))
+		query("bob", "insert into docs select id + 10, tenant, body from docs")
+		require.Equal(t, []sql.Row{{int32(2)}, {int32(4)}, {int32(12)}, {int32(14)}}, query("root", "select id from docs order by id"))
+	})
+}
+
+func TestColumnMasks(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	e := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb")))
+	defer e.Close()
+
+	// every user but root only sees the domains of email addresses
+	e.Analyzer.Catalog.RegisterColumnMask("mydb", "users", "email", func(ctx *sql.Context, column sql.Expression) (sql.Expression, error) {
+		if ctx.Session.Client().User == "root" {
+			return nil, nil
+		}
+		return function.NewConcat(expression.NewLiteral("***@", types.LongText),
+			function.NewSubstringIndex(column, expression.NewLiteral("@", types.LongText), expression.NewLiteral(-1, types.Int64)))
+	})
+	query := func(user, q string) []sql.Row {
+		_, rows := enginetest.MustQuery(enginetest.NewContextWithClient(harness, sql.Client{User: user, Address: "localhost"}), e, q)
+		return rows
+	}
+
+	query("root", "create table users (id int primary key, email varchar(50), name varchar(20))")
+	query("root", "insert into users values (1, 'alice@a.com', 'alice'), (2, 'bob@b.com', 'bob'), (3, 'carol@a.com', 'carol')")
+
+	require.Equal(t, []sql.Row{{int32(1), "alice@a.com"}}, query("root", "select id, email from users where id = 1"))
+	require.Equal(t, []sql.Row{{int32(1), "***@a.com"}}, query("alice", "select id, email from users where id = 1"))
+	require.Equal(t, []sql.Row{{int32(1), "***@a.com"}}, query("alice", "select u.id, u.email from users u where u.id = 1"))
+	require.Equal(t, []sql.Row{{"***@a.com"}}, query("alice", "select e from (select email e from users where id = 3) s"))
+	require.Equal(t, []sql.Row{{"***@A.COM"}}, query("alice", "select upper(email) from users where id = 1"))
+
+	// filters, groupings and sorts use the masked values too
+	require.Empty(t, query("alice", "select id from users where email = 'alice@a.com'"))
+	require.Equal(t, []sql.Row{{int32(1)}, {int32(3)}}, query("alice", "select id from users where email = '***@a.com' order by id"))
+	require.Equal(t, []sql.Row{{"***@a.com", int64(2)}, {"***@b.com", int64(1)}}, query("alice", "select email, count(*) from users group by email order by email"))
+	require.Equal(t, []sql.Row{{int32(3)}, {int32(1)}, {int32(2)}}, query("alice", "select id from users order by email, id desc"))
+	require.Equal(t, []sql.Row{{int64(3)}}, query("alice", "select count(*) from users a join users b on a.email = b.email and a.id = b.id"))
+	require.Equal(t, []sql.Row{{int32(2)}}, query("alice", "select id from users where exists (select 1 from dual where users.email = '***@b.com')"))
+
+	// HANDLER can't mask the columns it reads
+	_, _, err := e.Query(enginetest.NewContextWithClient(harness, sql.Client{User: "alice", Address: "localhost"}), "handler users open")
+	require.True(t, sql.ErrColumnMaskUnsupported.Is(err), "unexpected error %v", err)
+	query("root", "handler users open")
+	_, _, err = e.Query(enginetest.NewContextWithClient(harness, sql.Client{User: "alice", Address: "localhost"}), "checksum table users")
+	require.True(t, sql.ErrColumnMaskUnsupported.Is(err), "unexpected error %v", err)
+	require.Len(t, query("root", "checksum table users"), 1)
+
+	// assigned columns aren't masked
+	query("alice", "update users set name = email where id = 2")
+	query("alice", "update users set email = 'bob@c.com' where id = 2")
+	require.Equal(t, []sql.Row{{"bob@c.com", "***@b.com"}}, query("root", "select email, name from users where id = 2"))
+}
+
+func TestHandlerPrivileges(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	e := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb")))
+	defer e.Close()
+	e.Analyzer.Catalog.MySQLDb.AddRootAccount()
+	root := enginetest.NewContextWithClient(harness, sql.Client{User: "root", Address: "localhost"})
+	user := enginetest.NewContextWithClient(harness, sql.Client{User: "u1", Address: "localhost"})
+
+	enginetest.RunQueryWithContext(t, e, harness, root, "create table t (pk int primary key)")
+	enginetest.RunQueryWithContext(t, e, harness, root, "insert into t values (1), (2)")
+	enginetest.RunQueryWithContext(t, e, harness, root, "create user u1@localhost")
+	enginetest.AssertErrWithCtx(t, e, harness, user, "handler t open", sql.ErrPrivilegeCheckFailed)
+
+	enginetest.RunQueryWithContext(t, e, harness, root, "grant select on mydb.t to u1@localhost")
+	enginetest.RunQueryWithContext(t, e, harness, user, "handler t open")
+	enginetest.TestQueryWithContext(t, user, e, harness, "handler t read first", []sql.Row{{1}}, nil, nil)
+
+	// the privileges are checked again for every read
+	enginetest.RunQueryWithContext(t, e, harness, root, "revoke select on mydb.t from u1@localhost")
+	enginetest.AssertErrWithCtx(t, e, harness, user, "handler t read next", sql.ErrPrivilegeCheckFailed)
+}
+
+func TestQueryRewriters(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	enginetest.MustQuery(ctx, e, "create table orders (id int primary key, customer int)")
+	enginetest.MustQuery(ctx, e, "insert into orders values (1, 10), (2, 10), (3, 20)")
+	enginetest.MustQuery(ctx, e, "create table rewrite_rules (id int primary key, pattern text, pattern_database text, replacement text, enabled varchar(3))")
+	enginetest.MustQuery(ctx, e, `insert into rewrite_rules values
+		(1, 'select id from orders where customer = ?', null, 'select id from orders where customer = ? order by id desc limit 1', 'YES'),
+		(2, 'select count(*) from orders', 'mydb', 'select 42', 'NO')`)
+
+	rules, err := parse.NewRewriteRules()
+	require.NoError(t, err)
+	table, ok, err := db.GetTableInsensitive(ctx, "rewrite_rules")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, rules.LoadRules(ctx, table))
+	require.Len(t, rules.Rules(), 1)
+
+	e.PreParseRewriters = append(e.PreParseRewriters, rules.Rewrite)
+	// queries of the legacy name of the orders table read the orders table
+	e.PostParseRewriters = append(e.PostParseRewriters, func(ctx *sql.Context, query string, parsed sql.Node) (sql.Node, error) {
+		n, _, err := transform.Node(parsed, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+			if t, ok := n.(*plan.UnresolvedTable); ok && strings.EqualFold(t.Name(), "legacy_orders") {
+				return plan.NewUnresolvedTable("orders", t.Database()), transform.NewTree, nil
+			}
+			return n, transform.SameTree, nil
+		})
+		return n, err
+	})
+
+	_, rows := enginetest.MustQuery(ctx, e, "SELECT id FROM orders WHERE customer = 10")
+	require.Equal(t, []sql.Row{{int32(2)}}, rows)
+	_, rows = enginetest.MustQuery(ctx, e, "select count(*) from orders")
+	require.Equal(t, []sql.Row{{int64(3)}}, rows)
+	_, rows = enginetest.MustQuery(ctx, e, "select id from legacy_orders where customer = 20")
+	require.Equal(t, []sql.Row{{int32(3)}}, rows)
+
+	_, err = e.PrepareQuery(ctx, "select id from legacy_orders where customer = 10")
+	require.NoError(t, err)
+}
+
+func TestQueryThrottling(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	enginetest.MustQuery(ctx, e, "create table t (i int primary key)")
+	enginetest.MustQuery(ctx, e, "insert into t values (1), (2), (3)")
+
+	digest, err := sql.StatementDigest("select * from t where i > 1")
+	require.NoError(t, err)
+	e.Throttler = sql.NewQueryThrottler(sql.ThrottleRule{Digest: digest, MaxConcurrency: 1})
+
+	// a query runs until its iterator is closed, so the same statement is rejected until then
+	_, iter, err := e.Query(ctx, "SELECT * FROM t WHERE i > 2")
+	require.NoError(t, err)
+	_, _, err = e.Query(ctx, "select * from t where i > 0")
+	require.Error(t, err)
+	require.True(t, sql.ErrQueryThrottled.Is(err))
+
+	// other statements aren't limited by the rule
+	_, rows := enginetest.MustQuery(ctx, e, "select count(*) from t")
+	require.Equal(t, []sql.Row{{int64(3)}}, rows)
+
+	rows, err = sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(t, err)
+	require.Equal(t, []sql.Row{{int32(3)}}, rows)
+	_, rows = enginetest.MustQuery(ctx, e, "select * from t where i > 1")
+	require.Equal(t, []sql.Row{{int32(2)}, {int32(3)}}, rows)
+}
+
+func TestProcedureResultSets(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	enginetest.MustQuery(ctx, e, "create table t (i int primary key)")
+	enginetest.MustQuery(ctx, e, "insert into t values (1), (2), (3)")
+	enginetest.MustQuery(ctx, e, "create procedure inner_proc() begin select 'inner'; end")
+	enginetest.MustQuery(ctx, e, `create procedure p(x int)
+begin
+	select i from t where i < x;
+	insert into t values (x + 10);
+	if x > 1 then
+		select 'if';
+	end if;
+	call inner_proc();
+	select count(*) from t;
+end`)
+
+	var resultSets [][]sql.Row
+	ctx = ctx.WithResultSetWriter(func(schema sql.Schema, rows []sql.Row) error {
+		resultSets = append(resultSets, rows)
+		return nil
+	})
+
+	// every result set is written, and the last one is also the result of the CALL statement
+	_, rows := enginetest.MustQuery(ctx, e, "call p(3)")
+	require.Equal(t, []sql.Row{{int64(4)}}, rows)
+	require.Equal(t, [][]sql.Row{
+		{{int32(1)}, {int32(2)}},
+		{{"if"}},
+		{{"inner"}},
+		{{int64(4)}},
+	}, resultSets)
+
+	// queries other than CALL statements don't write result sets
+	resultSets = nil
+	enginetest.MustQuery(ctx, e, "select * from t")
+	require.Empty(t, resultSets)
+}
+
+var greetingServiceKey = sql.NewServiceKey[func(string) string]("greeting")
+
+// greetingFunc greets the current user with the greeting service of the engine.
+type greetingFunc struct {
+	function.NoArgFunc
+}
+
+func (f greetingFunc) Eval(ctx *sql.Context, _ sql.Row) (interface{}, error) {
+	greet, err := sql.MustGetService(ctx, greetingServiceKey)
+	if err != nil {
+		return nil, err
+	}
+	return greet(ctx.Client().User), nil
+}
+
+func (f greetingFunc) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return function.NoArgFuncWithChildren(f, children)
+}
+
+func TestServiceRegistry(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+	e.Analyzer.Catalog.RegisterFunction(ctx, sql.Function0{
+		Name: "greeting",
+		Fn: func() sql.Expression {
+			return greetingFunc{NoArgFunc: function.NoArgFunc{Name: "greeting", SQLType: types.LongText}}
+		},
+	})
+
+	_, iter, err := e.Query(ctx, "select greeting()")
+	require.NoError(t, err)
+	_, err = sql.RowIterToRows(ctx, nil, iter)
+	require.True(t, sql.ErrServiceNotRegistered.Is(err))
+
+	sql.RegisterService(e.Services, greetingServiceKey, func(user string) string { return "hello " + user })
+	_, rows := enginetest.MustQuery(ctx, e, "select greeting()")
+	require.Equal(t, []sql.Row{{"hello root"}}, rows)
+
+	// a context can override the services of the engine
+	overrides := e.Services.NewChild()
+	sql.RegisterService(overrides, greetingServiceKey, func(user string) string { return "bye " + user })
+	ctx = enginetest.NewContext(harness)
+	ctx.ApplyOpts(sql.WithServiceRegistry(overrides))
+	_, rows = enginetest.MustQuery(ctx, e, "select greeting()")
+	require.Equal(t, []sql.Row{{"bye root"}}, rows)
+}
+
+func TestQueryListeners(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	enginetest.MustQuery(ctx, e, "create table t (i int primary key)")
+	enginetest.MustQuery(ctx, e, "insert into t values (1), (2), (3), (4), (5)")
+
+	var events []string
+	errVetoed := errors.NewKind("vetoed")
+	e.QueryEventRowInterval = 2
+	e.QueryListeners = []sql.QueryListener{
+		sql.QueryListenerFunc(func(ctx *sql.Context, event sql.QueryEvent) error {
+			events = append(events, fmt.Sprintf("%s %d", event.Type, event.Rows))
+			if event.Type == sql.QueryExecuteBegin && strings.Contains(event.Query, "vetoed") {
+				return errVetoed.New()
+			}
+			return nil
+		}),
+		sql.QueryListenerFunc(func(ctx *sql.Context, event sql.QueryEvent) error {
+			if event.Type == sql.QueryFailed {
+				events = append(events, "error: "+event.Err.Error())
+			}
+			return nil
+		}),
+	}
+
+	_, rows := enginetest.MustQuery(ctx, e, "select * from t")
+	require.Len(t, rows, 5)
+	require.Equal(t, []string{"parsed 0", "analyzed 0", "execute begin 0", "rows read 2", "rows read 4", "completed 5"}, events)
+
+	// a listener vetoes queries by returning an error before they're executed
+	events = nil
+	_, _, err := e.Query(ctx, "select * from t where 'vetoed' = 'vetoed'")
+	require.True(t, errVetoed.Is(err))
+	require.Equal(t, []string{"parsed 0", "analyzed 0", "execute begin 0", "failed 0", "error: vetoed"}, events)
+
+	// every query ends with a single event, even if it fails before being parsed
+	events = nil
+	_, _, err = e.Query(ctx, "select * frm t")
+	require.Error(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, "failed 0", events[0])
+
+	// queries failing during their execution fail once
+	events = nil
+	_, iter, err := e.Query(ctx, "select * from t where i = (select i from t)")
+	require.NoError(t, err)
+	_, err = sql.RowIterToRows(ctx, nil, iter)
+	require.Error(t, err)
+	require.Equal(t, []string{"parsed 0", "analyzed 0", "execute begin 0", "failed 0", "error: " + err.Error()}, events)
+}
+
+func TestResultCache(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+	e.ResultCache = sql.NewResultCache(10, 2)
+
+	enginetest.MustQuery(ctx, e, "create table t (i int primary key, s varchar(10))")
+	enginetest.MustQuery(ctx, e, "insert into t values (1, 'a'), (2, 'b')")
+	enginetest.MustQuery(ctx, e, "create table u (i int primary key)")
+
+	_, rows := enginetest.MustQuery(ctx, e, "select * from t where i > 0 order by i")
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), "b"}}, rows)
+	require.Equal(t, 1, e.ResultCache.Len())
+
+	// the same statement is returned from the cache, but not the same statement with other values
+	_, rows = enginetest.MustQuery(ctx, e, "SELECT * FROM t WHERE i > 0 ORDER BY i")
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), "b"}}, rows)
+	hits, _ := e.ResultCache.Stats()
+	require.Equal(t, uint64(1), hits)
+	_, rows = enginetest.MustQuery(ctx, e, "select * from t where i > 1 order by i")
+	require.Equal(t, []sql.Row{{int32(2), "b"}}, rows)
+	hits, _ = e.ResultCache.Stats()
+	require.Equal(t, uint64(1), hits)
+
+	// writing a table invalidates the results reading it, but not the others
+	enginetest.MustQuery(ctx, e, "select count(*) from u")
+	enginetest.MustQuery(ctx, e, "update t set s = 'c' where i = 2")
+	_, rows = enginetest.MustQuery(ctx, e, "select * from t where i > 0 order by i")
+	require.Equal(t, []sql.Row{{int32(1), "a"}, {int32(2), "c"}}, rows)
+	_, rows = enginetest.MustQuery(ctx, e, "select count(*) from u")
+	require.Equal(t, []sql.Row{{int64(0)}}, rows)
+	hits, _ = e.ResultCache.Stats()
+	require.Equal(t, uint64(2), hits)
+
+	// results with too many rows, and results of non-deterministic queries aren't cached
+	enginetest.MustQuery(ctx, e, "insert into t values (3, 'd')")
+	for _, query := range []string{
+		"select * from t where i > 0 order by i",
+		"select i, rand() from t where i = 1",
+		"select i, @@autocommit from t where i = 1",
+	} {
+		enginetest.MustQuery(ctx, e, query)
+		enginetest.MustQuery(ctx, e, query)
+	}
+	hits, _ = e.ResultCache.Stats()
+	require.Equal(t, uint64(2), hits)
+
+	// a dropped table created again doesn't return the results of the dropped table
+	enginetest.MustQuery(ctx, e, "select * from u")
+	enginetest.MustQuery(ctx, e, "drop table u")
+	enginetest.MustQuery(ctx, e, "create table u (i int primary key)")
+	enginetest.MustQuery(ctx, e, "insert into u values (1)")
+	_, rows = enginetest.MustQuery(ctx, e, "select * from u")
+	require.Equal(t, []sql.Row{{int32(1)}}, rows)
+}
+
+func TestMaterializedViews(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	enginetest.MustQuery(ctx, e, "create table t (i int primary key, s varchar(10))")
+	enginetest.MustQuery(ctx, e, "insert into t values (1, 'a'), (2, 'b'), (3, 'a')")
+
+	// views with a HAVING clause aren't maintained incrementally, so they're only updated when they're refreshed
+	const definition = "select s, count(*) as n from t group by s having n > 0 order by s"
+	_, _, err := e.Query(ctx, "create materialized view mv as "+definition)
+	require.True(t, sql.ErrServiceNotRegistered.Is(err))
+
+	registry := e.EnableMaterializedViews()
+	enginetest.MustQuery(ctx, e, "create materialized view mv refresh every 1 minute max staleness 1 hour as "+definition)
+	_, rows := enginetest.MustQuery(ctx, e, "select * from mv")
+	require.Equal(t, []sql.Row{{"a", int64(2)}, {"b", int64(1)}}, rows)
+	_, _, err = e.Query(ctx, "create materialized view mv as "+definition)
+	require.True(t, sql.ErrMaterializedViewExists.Is(err))
+	enginetest.MustQuery(ctx, e, "create materialized view if not exists mv as "+definition)
+
+	// queries with the shape of the definition read the view while it's fresh, other queries read the table
+	enginetest.MustQuery(ctx, e, "insert into t values (4, 'b')")
+	_, rows = enginetest.MustQuery(ctx, e, "SELECT s, COUNT(*) AS n FROM t GROUP BY s HAVING n > 0 ORDER BY s")
+	require.Equal(t, []sql.Row{{"a", int64(2)}, {"b", int64(1)}}, rows)
+	_, rows = enginetest.MustQuery(ctx, e, "select s, count(*) from t group by s order by s")
+	require.Equal(t, []sql.Row{{"a", int64(2)}, {"b", int64(2)}}, rows)
+
+	enginetest.MustQuery(ctx, e, "refresh materialized view mv")
+	_, rows = enginetest.MustQuery(ctx, e, "select * from mv")
+	require.Equal(t, []sql.Row{{"a", int64(2)}, {"b", int64(2)}}, rows)
+
+	// views are refreshed once their refresh interval has elapsed
+	enginetest.MustQuery(ctx, e, "insert into t values (5, 'c')")
+	require.NoError(t, e.RefreshMaterializedViews(ctx))
+	_, rows = enginetest.MustQuery(ctx, e, "select * from mv")
+	require.Equal(t, []sql.Row{{"a", int64(2)}, {"b", int64(2)}}, rows)
+	require.NoError(t, registry.SetRefreshed("mydb", "mv", time.Now().Add(-2*time.Minute)))
+	require.NoError(t, e.RefreshMaterializedViews(ctx))
+	_, rows = enginetest.MustQuery(ctx, e, "select * from mv")
+	require.Equal(t, []sql.Row{{"a", int64(2)}, {"b", int64(2)}, {"c", int64(1)}}, rows)
+
+	// stale views aren't read by queries with the shape of their definition
+	enginetest.MustQuery(ctx, e, "delete from t where s = 'c'")
+	require.NoError(t, registry.SetRefreshed("mydb", "mv", time.Now().Add(-2*time.Hour)))
+	_, rows = enginetest.MustQuery(ctx, e, definition)
+	require.Equal(t, []sql.Row{{"a", int64(2)}, {"b", int64(2)}}, rows)
+
+	enginetest.MustQuery(ctx, e, "drop materialized view mv")
+	_, _, err = e.Query(ctx, "select * from mv")
+	require.True(t, sql.ErrTableNotFound.Is(err))
+	_, _, err = e.Query(ctx, "refresh materialized view mv")
+	require.True(t, sql.ErrMaterializedViewDoesNotExist.Is(err))
+	enginetest.MustQuery(ctx, e, "drop materialized view if exists mv")
+}
+
+func TestIncrementalMaterializedViews(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+	registry := e.EnableMaterializedViews()
+
+	enginetest.MustQuery(ctx, e, "create table t (i int primary key, s varchar(10), v int)")
+	enginetest.MustQuery(ctx, e, "insert into t values (1, 'a', 10), (2, 'b', 20), (3, 'a', 30), (4, 'c', -5)")
+
+	const grouped = "select s, count(*) as n, count(v) as c, sum(v) as total, min(v) as lo, max(v) as hi from t where i < 100 group by s"
+	const global = "select count(*), sum(v), max(v) from t"
+	enginetest.MustQuery(ctx, e, "create materialized view grouped as "+grouped)
+	enginetest.MustQuery(ctx, e, "create materialized view global as "+global)
+	view, ok := registry.View("mydb", "grouped")
+	require.True(t, ok)
+	require.NotNil(t, view.Maintainer)
+
+	requireView := func(view, definition string) {
+		t.Helper()
+		_, expected := enginetest.MustQuery(ctx, e, definition)
+		sch, rows := enginetest.MustQuery(ctx, e, "select * from "+view)
+		// the columns of the backing table have the types of the columns of the definition, which SUM doesn't return
+		for _, row := range expected {
+			for i := range row {
+				var err error
+				row[i], err = sch[i].Type.Convert(row[i])
+				require.NoError(t, err)
+			}
+		}
+		require.ElementsMatch(t, expected, rows)
+	}
+	requireViews := func() {
+		t.Helper()
+		requireView("grouped", grouped)
+		requireView("global", global)
+	}
+	requireViews()
+
+	enginetest.MustQuery(ctx, e, "insert into t values (5, 'b', 5), (6, 'd', null), (100, 'a', 1000)")
+	requireViews()
+	enginetest.MustQuery(ctx, e, "update t set v = v + 1 where s = 'a'")
+	requireViews()
+	enginetest.MustQuery(ctx, e, "update t set s = 'c' where i = 2")
+	requireViews()
+
+	// removing the MIN or MAX of a group computes it again from the rows left
+	enginetest.MustQuery(ctx, e, "delete from t where i = 3")
+	requireViews()
+	enginetest.MustQuery(ctx, e, "delete from t where s = 'd'")
+	requireViews()
+	_, rows := enginetest.MustQuery(ctx, e, "select * from grouped where s = 'c'")
+	require.Equal(t, []sql.Row{{"c", int64(2), int64(2), int32(15), int32(-5), int32(20)}}, rows)
+
+	// views without grouping expressions keep their row when the table is empty
+	enginetest.MustQuery(ctx, e, "delete from t")
+	requireViews()
+	_, rows = enginetest.MustQuery(ctx, e, "select * from global")
+	require.Equal(t, []sql.Row{{int64(0), nil, nil}}, rows)
+	enginetest.MustQuery(ctx, e, "insert into t values (1, 'a', 1)")
+	requireViews()
+
+	// refreshing a view maintained incrementally doesn't recompute it
+	_, rows = enginetest.MustQuery(ctx, e, "refresh materialized view grouped")
+	require.Equal(t, []sql.Row{{types.NewOkResult(0)}}, rows)
+
+	enginetest.MustQuery(ctx, e, "drop materialized view grouped")
+	enginetest.MustQuery(ctx, e, "insert into t values (2, 'a', 2)")
+	requireView("global", global)
+
+	// views stop being maintained incrementally once the schema of their table changes
+	enginetest.MustQuery(ctx, e, "alter table t add column w int")
+	view, ok = registry.View("mydb", "global")
+	require.True(t, ok)
+	require.False(t, view.Maintainer.IsCurrent())
+	enginetest.MustQuery(ctx, e, "insert into t values (3, 'a', 3, 0)")
+	enginetest.MustQuery(ctx, e, "refresh materialized view global")
+	view, ok = registry.View("mydb", "global")
+	require.True(t, ok)
+	require.Nil(t, view.Maintainer)
+	requireView("global", global)
+	enginetest.MustQuery(ctx, e, "drop materialized view global")
+}
+
+func TestChangeDataCapture(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	enginetest.MustQuery(ctx, e, "create table t (i int primary key, s varchar(10))")
+	enginetest.MustQuery(ctx, e, "create table other (i int primary key)")
+	sub, err := e.SubscribeChanges(ctx, 10, sql.NewDbTable("mydb", "t"))
+	require.NoError(t, err)
+	defer sub.Close()
+
+	next := func() sql.ChangeEvent {
+		t.Helper()
+		nextCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		event, err := sub.Next(nextCtx)
+		require.NoError(t, err)
+		return event
+	}
+
+	enginetest.MustQuery(ctx, e, "insert into t values (1, 'a'), (2, 'b')")
+	enginetest.MustQuery(ctx, e, "insert into other values (1)")
+	enginetest.MustQuery(ctx, e, "update t set s = 'c' where i = 2")
+	enginetest.MustQuery(ctx, e, "delete from t where i = 1")
+
+	insert1, insert2 := next(), next()
+	require.Equal(t, sql.ChangeInsert, insert1.Type)
+	require.Equal(t, "mydb", insert1.Database)
+	require.Equal(t, "t", insert1.Table)
+	require.Equal(t, sql.Row{int32(1), "a"}, insert1.After)
+	require.Nil(t, insert1.Before)
+	require.Equal(t, sql.Row{int32(2), "b"}, insert2.After)
+	require.Equal(t, insert1.Sequence, insert2.Sequence)
+
+	update := next()
+	require.Equal(t, sql.ChangeUpdate, update.Type)
+	require.Equal(t, sql.Row{int32(2), "b"}, update.Before)
+	require.Equal(t, sql.Row{int32(2), "c"}, update.After)
+	require.Greater(t, update.Sequence, insert1.Sequence)
+
+	deleted := next()
+	require.Equal(t, sql.ChangeDelete, deleted.Type)
+	require.Equal(t, sql.Row{int32(1), "a"}, deleted.Before)
+	require.Nil(t, deleted.After)
+	require.Equal(t, 0, sub.Buffered())
+
+	// changes of sessions that turned off sql_log_bin aren't captured
+	enginetest.MustQuery(ctx, e, "set session sql_log_bin = 0")
+	enginetest.MustQuery(ctx, e, "insert into t values (10, 'x')")
+	enginetest.MustQuery(ctx, e, "set session sql_log_bin = 1")
+	enginetest.MustQuery(ctx, e, "delete from t where i = 10")
+	require.Equal(t, sql.ChangeDelete, next().Type)
+	require.Equal(t, 0, sub.Buffered())
+
+	// changes are delivered once their transaction is committed, and discarded if it's rolled back
+	enginetest.MustQuery(ctx, e, "xa start 'x'")
+	enginetest.MustQuery(ctx, e, "insert into t values (3, 'd')")
+	enginetest.MustQuery(ctx, e, "xa end 'x'")
+	enginetest.MustQuery(ctx, e, "xa prepare 'x'")
+	require.Equal(t, 0, sub.Buffered())
+	enginetest.MustQuery(enginetest.NewContext(harness), e, "xa commit 'x'")
+	require.Equal(t, sql.Row{int32(3), "d"}, next().After)
+	enginetest.MustQuery(ctx, e, "xa start 'y'")
+	enginetest.MustQuery(ctx, e, "insert into t values (4, 'e')")
+	enginetest.MustQuery(ctx, e, "xa end 'y'")
+	enginetest.MustQuery(ctx, e, "xa rollback 'y'")
+	require.Equal(t, 0, sub.Buffered())
+
+	// transactions wait for subscriptions whose buffer is full to read their changes
+	small, err := e.SubscribeChanges(ctx, 1, sql.NewDbTable("mydb", "t"))
+	require.NoError(t, err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		writeCtx := enginetest.NewContext(harness)
+		enginetest.MustQuery(writeCtx, e, "insert into t values (5, 'f'), (6, 'g'), (7, 'h')")
+	}()
+	for _, i := range []int32{5, 6, 7} {
+		nextCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		event, err := small.Next(nextCtx)
+		cancel()
+		require.NoError(t, err)
+		require.Equal(t, i, event.After[0])
+	}
+	<-done
+	for range []int32{5, 6, 7} {
+		next()
+	}
+
+	// subscriptions miss the changes of transactions canceled while waiting for them
+	queryCtx, cancel := context.WithCancel(context.Background())
+	done = make(chan struct{})
+	go func() {
+		defer close(done)
+		enginetest.MustQuery(ctx.WithContext(queryCtx), e, "insert into t values (8, 'i'), (9, 'j')")
+	}()
+	require.Eventually(t, func() bool { return small.Buffered() == 1 }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+	event, err := small.Next(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int32(8), event.After[0])
+	_, err = small.Next(context.Background())
+	require.True(t, sql.ErrChangeSubscriptionLagged.Is(err))
+	require.NoError(t, small.Close())
+	_, err = small.Next(context.Background())
+	require.Error(t, err)
+
+	_, err = e.SubscribeChanges(ctx, 1, sql.NewDbTable("mydb", "missing"))
+	require.True(t, sql.ErrTableNotFound.Is(err))
+}
+
+func TestTableChangeCallbacks(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	enginetest.MustQuery(ctx, e, "create table t (i int primary key, s varchar(10))")
+	enginetest.MustQuery(ctx, e, "create table other (i int primary key)")
+
+	var events []sql.ChangeEvent
+	sub, err := e.OnTableChanges(ctx, func(ctx *sql.Context, event sql.ChangeEvent) error {
+		events = append(events, event)
+		return nil
+	}, sql.NewDbTable("mydb", "t"))
+	require.NoError(t, err)
+	failing, err := e.OnTableChanges(ctx, func(ctx *sql.Context, event sql.ChangeEvent) error {
+		return fmt.Errorf("failed")
+	}, sql.NewDbTable("mydb", "t"))
+	require.NoError(t, err)
+	defer failing.Close()
+
+	enginetest.MustQuery(ctx, e, "insert into t values (1, 'a')")
+	enginetest.MustQuery(ctx, e, "insert into other values (1)")
+	enginetest.MustQuery(ctx, e, "update t set s = 'b' where i = 1")
+	require.Len(t, events, 2)
+	require.Equal(t, sql.ChangeInsert, events[0].Type)
+	require.Equal(t, sql.Row{int32(1), "a"}, events[0].After)
+	require.Equal(t, sql.ChangeUpdate, events[1].Type)
+	require.Equal(t, sql.Row{int32(1), "a"}, events[1].Before)
+	require.Equal(t, sql.Row{int32(1), "b"}, events[1].After)
+
+	// callbacks are called once the transaction is committed, and not if it's rolled back
+	enginetest.MustQuery(ctx, e, "xa start 'x'")
+	enginetest.MustQuery(ctx, e, "delete from t where i = 1")
+	enginetest.MustQuery(ctx, e, "xa end 'x'")
+	require.Len(t, events, 2)
+	enginetest.MustQuery(ctx, e, "xa commit 'x' one phase")
+	require.Len(t, events, 3)
+	require.Equal(t, sql.ChangeDelete, events[2].Type)
+	require.Equal(t, sql.Row{int32(1), "b"}, events[2].Before)
+	enginetest.MustQuery(ctx, e, "xa start 'y'")
+	enginetest.MustQuery(ctx, e, "insert into t values (2, 'c')")
+	enginetest.MustQuery(ctx, e, "xa end 'y'")
+	enginetest.MustQuery(ctx, e, "xa rollback 'y'")
+	require.Len(t, events, 3)
+
+	require.NoError(t, sub.Close())
+	enginetest.MustQuery(ctx, e, "insert into t values (3, 'd')")
+	require.Len(t, events, 3)
+
+	_, err = e.OnTableChanges(ctx, func(ctx *sql.Context, event sql.ChangeEvent) error {
+		return nil
+	}, sql.NewDbTable("mydb", "missing"))
+	require.True(t, sql.ErrTableNotFound.Is(err))
+}
+
+func TestSystemVersionedTables(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	enginetest.MustQuery(ctx, e, `create table t (
+		i int primary key,
+		s varchar(10),
+		row_start datetime(6) generated always as row start,
+		row_end datetime(6) generated always as row end,
+		period for system_time (row_start, row_end)
+	) with system versioning`)
+	enginetest.MustQuery(ctx, e, "create table plain (i int primary key)")
+
+	at := func(day int) time.Time {
+		return time.Date(2023, time.January, day, 0, 0, 0, 0, time.UTC)
+	}
+	ctx.SetQueryTime(at(1))
+	enginetest.MustQuery(ctx, e, "insert into t (i, s) values (1, 'a'), (2, 'b')")
+	ctx.SetQueryTime(at(2))
+	enginetest.MustQuery(ctx, e, "update t set s = 'c' where i = 2")
+	ctx.SetQueryTime(at(3))
+	enginetest.MustQuery(ctx, e, "delete from t where i = 1")
+
+	_, rows := enginetest.MustQuery(ctx, e, "select i, s, row_start from t")
+	require.Equal(t, []sql.Row{{int32(2), "c", at(2)}}, rows)
+
+	for _, tt := range []struct {
+		query    string
+		expected []sql.Row
+	}{
+		{"select i, s from t for system_time as of '2022-12-31 00:00:00' order by i", nil},
+		{"select i, s from t for system_time as of '2023-01-01 12:00:00' order by i", []sql.Row{{int32(1), "a"}, {int32(2), "b"}}},
+		{"select i, s from t for system_time as of '2023-01-02 12:00:00' order by i", []sql.Row{{int32(1), "a"}, {int32(2), "c"}}},
+		{"select i, s from t as of '2023-01-03 00:00:00' order by i", []sql.Row{{int32(2), "c"}}},
+		{"select i, row_end from t for system_time as of '2023-01-01 00:00:00' where i = 2", []sql.Row{{int32(2), at(2)}}},
+	} {
+		t.Run(tt.query, func(t *testing.T) {
+			_, rows := enginetest.MustQuery(ctx, e, tt.query)
+			require.Equal(t, tt.expected, rows)
+		})
+	}
+
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "select * from plain for system_time as of '2023-01-01 00:00:00'", sql.ErrAsOfNotSupported)
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "alter table t add column j int", sql.ErrSystemVersionedTableAlter)
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "create table u (i int primary key, row_start datetime generated always as row start, row_end datetime generated always as row end)", sql.ErrInvalidSystemVersioning)
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "create table u (i int primary key, row_start int generated always as row start, row_end datetime generated always as row end) with system versioning", sql.ErrInvalidSystemVersioning)
+}
+
+func TestMemoryDatabaseDumpRestore(t *testing.T) {
+	setupQueries := []string{
+		"create table parent (id int primary key auto_increment, name varchar(20) not null default 'none', unique key name (name))",
+		`create table child (
+			id bigint primary key,
+			parent_id int,
+			note text,
+			data blob,
+			doc json,
+			pt point,
+			flags bit(4),
+			size enum('s','m','l'),
+			tags set('a','b','c'),
+			price decimal(10,2),
+			created datetime,
+			duration time,
+			yr year,
+			score double,
+			key parent_price (parent_id, price desc),
+			key note_prefix (note(5)),
+			constraint child_parent foreign key (parent_id) references parent (id) on delete cascade,
+			constraint positive_price check (price > 0)
+		)`,
+		"create table keyless (a int, b varchar(10))",
+		"insert into parent (name) values ('a'), ('b'), ('c')",
+		"delete from parent where id = 3",
+		`insert into child values
+			(1, 1, 'it''s a "note"; with\nlines and \\ slashes', x'00ff10', '{"a": [1, 2]}', point(1, 2), b'1010', 'm', 'a,c', 12.5, '2023-01-02 03:04:05', '12:34:56', 2023, 1.5),
+			(2, 2, null, null, null, null, null, null, null, null, null, null, null, null)`,
+		"insert into keyless values (1, 'x'), (1, 'x'), (null, null)",
+		"create view parent_names as select name from parent",
+		"create view a_view as select upper(name) as name from parent_names",
+		"create function double_it(x int) returns int deterministic return x * 2",
+		"create procedure add_parent(in n varchar(20)) begin insert into parent (name) values (n); select count(*) from parent; end",
+		"create trigger child_note before insert on child for each row begin if new.note is null then set new.note = 'default; note'; end if; end",
+	}
+	checkQueries := []string{
+		"show create table parent",
+		"show create table child",
+		"show create table keyless",
+		"select * from parent order by id",
+		"select id, parent_id, note, hex(data), doc, st_astext(pt), flags + 0, size, tags, format(price, 2), created, duration, yr, score from child order by id",
+		"select * from keyless order by a, b",
+		"select * from a_view order by name",
+		"select double_it(21)",
+		"call add_parent('d')",
+		"select * from parent order by id",
+		"insert into child (id, parent_id, price) values (3, 1, 1)",
+		"select note from child where id = 3",
+	}
+
+	newEngine := func(db *memory.Database) (*sqle.Engine, *sql.Context) {
+		db.EnablePrimaryKeyIndexes()
+		e := sqle.NewDefault(memory.NewDBProvider(db))
+		ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+		ctx.SetCurrentDatabase(db.Name())
+		return e, ctx
+	}
+	exec := func(e *sqle.Engine) func(ctx *sql.Context, query string) error {
+		return func(ctx *sql.Context, query string) error {
+			sch, iter, err := e.Query(ctx, query)
+			if err != nil {
+				return err
+			}
+			_, err = sql.RowIterToRows(ctx, sch, iter)
+			return err
+		}
+	}
+	results := func(e *sqle.Engine, ctx *sql.Context) [][]sql.Row {
+		var results [][]sql.Row
+		for _, query := range checkQueries {
+			sch, iter, err := e.Query(ctx, query)
+			require.NoError(t, err, query)
+			rows, err := sql.RowIterToRows(ctx, sch, iter)
+			require.NoError(t, err, query)
+			results = append(results, rows)
+		}
+		return results
+	}
+
+	for name, format := range map[string]memory.DumpFormat{"sql": memory.SQLDump, "binary": memory.BinaryDump} {
+		t.Run(name, func(t *testing.T) {
+			db := memory.NewDatabase("mydb")
+			e, ctx := newEngine(db)
+			for _, query := range setupQueries {
+				require.NoError(t, exec(e)(ctx, query), query)
+			}
+			var dump bytes.Buffer
+			require.NoError(t, db.Export(ctx, &dump, format))
+
+			restored := memory.NewDatabase("mydb")
+			re, rctx := newEngine(restored)
+			require.NoError(t, restored.Import(rctx, bytes.NewReader(dump.Bytes()), format, exec(re)))
+
+			// A snapshot of the restored database is the same as the snapshot it was restored from
+			var redump bytes.Buffer
+			require.NoError(t, restored.Export(rctx, &redump, format))
+			require.Equal(t, dump.String(), redump.String())
+
+			require.Equal(t, results(e, ctx), results(re, rctx))
+		})
+	}
+}
+
+func TestInitScripts(t *testing.T) {
+	scripts, err := sqle.InitScriptsFromFS(fstest.MapFS{
+		"init/01_schema.sql": {Data: []byte(`
+create table mydb.t (i int primary key, s varchar(10));
+create procedure mydb.add_row(i int, s varchar(10))
+begin
+	insert into mydb.t values (i, s);
+end;
+-- seeded below
+`)},
+		"init/02_seed.sql": {Data: []byte("call mydb.add_row(1, 'a'); insert into mydb.t values (2, 'b')")},
+		"other.sql":        {Data: []byte("insert into mydb.t values (3, 'c')")},
+	}, "init/*.sql")
+	require.NoError(t, err)
+	require.Len(t, scripts, 2)
+	require.Equal(t, "init/01_schema.sql", scripts[0].Name)
+	scripts[0].Guard = "select 1 from information_schema.tables where table_schema = 'mydb' and table_name = 't'"
+
+	newEngine := func(db *memory.Database, scripts ...sqle.InitScript) *sqle.Engine {
+		return sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db)), &sqle.Config{InitScripts: scripts})
+	}
+
+	db := memory.NewDatabase("mydb")
+	e := newEngine(db, scripts...)
+	defer e.Close()
+	require.NoError(t, e.RunInitScripts(e.NewBootstrapContext()))
+	// Scripts are only run once by an engine
+	require.NoError(t, e.RunInitScripts(e.NewBootstrapContext()))
+	harness := enginetest.NewDefaultMemoryHarness()
+	ctx := enginetest.NewContext(harness)
+	enginetest.TestQueryWithContext(t, ctx, e, harness, "select * from t order by i", []sql.Row{{1, "a"}, {2, "b"}}, nil, nil)
+
+	// The guard of the first script skips it on the database it already ran on
+	e2 := newEngine(db, scripts[0], sqle.InitScript{Name: "more", SQL: "insert into mydb.t values (3, 'c')"})
+	defer e2.Close()
+	require.NoError(t, e2.RunInitScripts(e2.NewBootstrapContext()))
+	enginetest.TestQueryWithContext(t, ctx, e2, harness, "select count(*) from t", []sql.Row{{3}}, nil, nil)
+
+	// A failing statement is reported with its script and position, and the scripts are resumed from the failed one
+	failing := sqle.InitScript{Name: "failing", SQL: "insert into mydb.t values (4, 'd'); insert into mydb.missing values (1)"}
+	e3 := newEngine(db, failing)
+	defer e3.Close()
+	err = e3.RunInitScripts(e3.NewBootstrapContext())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "init script failing failed at statement 2")
+	require.Contains(t, err.Error(), "table not found: missing")
+	err = e3.RunInitScripts(e3.NewBootstrapContext())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "at statement 1")
+
+	// Servers run the init scripts before accepting connections
+	e4 := newEngine(memory.NewDatabase("mydb"), scripts...)
+	defer e4.Close()
+	s, err := server.NewDefaultServer(server.Config{Protocol: "tcp", Address: "localhost:0"}, e4)
+	require.NoError(t, err)
+	defer s.Close()
+	enginetest.TestQueryWithContext(t, ctx, e4, harness, "select count(*) from t", []sql.Row{{2}}, nil, nil)
+}
+
+func TestEngineDefaults(t *testing.T) {
+	defer variables.InitSystemVariables()
+
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db)), &sqle.Config{
+		Defaults: sqle.Defaults{
+			SQLMode:                 "ANSI_QUOTES",
+			TimeZone:                "+02:00",
+			MaxConnections:          42,
+			DisableForeignKeyChecks: true,
+			SystemVariables:         map[string]interface{}{"autocommit": 0},
+			MaxMemory:               1 << 30,
+			Parallelism:             3,
+			PlanCacheSize:           2,
+		},
+	})
+	defer e.Close()
+
+	require.Equal(t, 3, e.Analyzer.Parallelism)
+	require.True(t, e.MemoryManager.HasAvailable())
+
+	ctx := enginetest.NewContext(harness)
+	enginetest.TestQueryWithContext(t, ctx, e, harness,
+		"select @@sql_mode, @@time_zone, @@max_connections, @@foreign_key_checks, @@autocommit",
+		[]sql.Row{{"ANSI_QUOTES", "+02:00", 42, 0, 0}}, nil, nil)
+
+	// Only the most recently prepared plans of a session are cached
+	for _, q := range []string{"select 1", "select 2", "select 3"} {
+		_, err := e.PrepareQuery(ctx, q)
+		require.NoError(t, err)
+	}
+	plans := e.PreparedDataCache.GetSessionData(ctx.Session.ID())
+	require.Len(t, plans, 2)
+	require.Contains(t, plans, "select 2")
+	require.Contains(t, plans, "select 3")
+
+	require.Panics(t, func() {
+		sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db)), &sqle.Config{
+			Defaults: sqle.Defaults{SystemVariables: map[string]interface{}{"no_such_variable": 1}},
+		})
+	})
+}
+
+func TestShowEngineStatus(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	stop := make(chan struct{})
+	require.NoError(t, e.BackgroundThreads.Add("worker", func(ctx context.Context) {
+		select {
+		case <-ctx.Done():
+		case <-stop:
+		}
+	}))
+
+	enginetest.MustQuery(ctx, e, "create table t (i int primary key)")
+	_, err := e.PrepareQuery(ctx, "select * from t where i = ?")
+	require.NoError(t, err)
+	enginetest.MustQuery(ctx, e, "prepare s from 'select 1'")
+
+	status := func() map[string]string {
+		t.Helper()
+		_, rows := enginetest.MustQuery(ctx, e, "show engine gms status")
+		values := make(map[string]string)
+		for _, row := range rows {
+			require.Equal(t, "GMS", row[0])
+			values[row[1].(string)] = row[2].(string)
+		}
+		return values
+	}
+
+	values := status()
+	require.Equal(t, "1", values["plan_cache.sessions"])
+	require.Equal(t, "2", values["plan_cache.plans"])
+	require.Equal(t, "1", values["plan_cache.named_statements"])
+	require.Equal(t, "0", values["plan_cache.max_plans_per_session"])
+	require.Equal(t, "false", values["replication.configured"])
+	require.Equal(t, "running", values["background_threads.worker"])
+	require.Equal(t, "0", values["transactions.active"])
+	require.Contains(t, values, "memory.used_bytes")
+	require.Contains(t, values, "analyzer.average_time")
+
+	status1, err := e.EngineStatus(ctx)
+	require.NoError(t, err)
+	require.Greater(t, status1.Analyzer.Queries, uint64(0))
+	require.GreaterOrEqual(t, status1.Analyzer.TotalTime, status1.Analyzer.MaxTime)
+
+	close(stop)
+	require.Eventually(t, func() bool {
+		return status()["background_threads.worker"] == "stopped"
+	}, time.Second, 10*time.Millisecond)
+
+	enginetest.AssertErrWithCtx(t, e, harness, ctx, "show engine innodb status", sql.ErrUnknownStorageEngine)
+}
+
+func TestQueryProfiling(t *testing.T) {
+	harness := enginetest.NewDefaultMemoryHarness()
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	defer e.Close()
+	ctx := enginetest.NewContext(harness)
+
+	enginetest.MustQuery(ctx, e, "create table t (i int primary key)")
+	enginetest.MustQuery(ctx, e, "set profiling = 1")
+	enginetest.MustQuery(ctx, e, "insert into t values (1), (2)")
+	enginetest.MustQuery(ctx, e, "select * from t")
+	_, _, err := e.Query(ctx, "select * from missing")
+	require.Error(t, err)
+
+	_, rows := enginetest.MustQuery(ctx, e, "show profiles")
+	require.Len(t, rows, 3)
+	for i, query := range []string{"insert into t values (1), (2)", "select * from t", "select * from missing"} {
+		require.Equal(t, int64(i+1), rows[i][0])
+		require.GreaterOrEqual(t, rows[i][1].(float64), 0.0)
+		require.Equal(t, query, rows[i][2])
+	}
+
+	stages := func(rows []sql.Row) []string {
+		var names []string
+		for _, row := range rows {
+			names = append(names, row[0].(string))
+		}
+		return names
+	}
+	_, rows = enginetest.MustQuery(ctx, e, "show profile for query 2")
+	require.Equal(t, []string{
+		sql.ProfileStageParsing,
+		sql.ProfileStageAnalyzing,
+		sql.ProfileStageOptimizing,
+		sql.ProfileStageExecuting,
+		sql.ProfileStageSendingData,
+	}, stages(rows))
+
+	// The latest query failed to analyze
+	_, rows = enginetest.MustQuery(ctx, e, "show profile cpu")
+	require.Equal(t, []string{sql.ProfileStageParsing, sql.ProfileStageAnalyzing}, stages(rows))
+
+	_, rows = enginetest.MustQuery(ctx, e, "show profile for query 2 limit 2 offset 3")
+	require.Equal(t, []string{sql.ProfileStageExecuting, sql.ProfileStageSendingData}, stages(rows))
+
+	_, rows = enginetest.MustQuery(ctx, e, "select query_id, seq, state from information_schema.profiling where query_id = 1 order by seq")
+	require.Equal(t, []sql.Row{
+		{int32(1), int32(1), sql.ProfileStageParsing},
+		{int32(1), int32(2), sql.ProfileStageAnalyzing},
+		{int32(1), int32(3), sql.ProfileStageOptimizing},
+		{int32(1), int32(4), sql.ProfileStageExecuting},
+		{int32(1), int32(5), sql.ProfileStageSendingData},
+	}, rows)
+
+	// The history is limited by profiling_history_size, and includes the query selecting from information_schema
+	enginetest.MustQuery(ctx, e, "set profiling_history_size = 2")
+	enginetest.MustQuery(ctx, e, "select 1")
+	_, rows = enginetest.MustQuery(ctx, e, "show profiles")
+	require.Len(t, rows, 2)
+	require.Equal(t, int64(5), rows[0][0])
+	require.Equal(t, "set profiling_history_size = 2", rows[0][2])
+	require.Equal(t, "select 1", rows[1][2])
+
+	enginetest.MustQuery(ctx, e, "set profiling = 0")
+	enginetest.MustQuery(ctx, e, "select 2")
+	_, rows = enginetest.MustQuery(ctx, e, "show profiles")
+	require.Len(t, rows, 2)
+	require.Equal(t, "set profiling = 0", rows[1][2])
+
+	e.CloseSession(ctx.ID())
+	_, rows = enginetest.MustQuery(ctx, e, "show profiles")
+	require.Empty(t, rows)
+}
@@ -35,6 +35,7 @@ var _ sql.TableDropper = Database{}
 var _ sql.TableRenamer = Database{}
 var _ sql.TriggerDatabase = Database{}
 var _ sql.StoredProcedureDatabase = Database{}
+var _ sql.StoredFunctionDatabase = Database{}
 var _ sql.ViewDatabase = Database{}
 
 // Name implements the interface sql.Database.
@@ -199,6 +200,55 @@ func (d Database) DropStoredProcedure(ctx *sql.Context, name string) error {
 	return d.shim.Exec(d.name, fmt.Sprintf("DROP PROCEDURE `%s`;", name))
 }
 
+// GetStoredFunction implements the interface sql.StoredFunctionDatabase.
+func (d Database) GetStoredFunction(ctx *sql.Context, name string) (sql.StoredProcedureDetails, bool, error) {
+	name = strings.ToLower(name)
+	functions, err := d.GetStoredFunctions(ctx)
+	if err != nil {
+		return sql.StoredProcedureDetails{}, false, err
+	}
+	for _, function := range functions {
+		if name == strings.ToLower(function.Name) {
+			return function, true, nil
+		}
+	}
+	return sql.StoredProcedureDetails{}, false, nil
+}
+
+// GetStoredFunctions implements the interface sql.StoredFunctionDatabase.
+func (d Database) GetStoredFunctions(ctx *sql.Context) ([]sql.StoredProcedureDetails, error) {
+	functions, err := d.shim.QueryRows("", fmt.Sprintf("SHOW FUNCTION STATUS WHERE Db = '%s';", d.name))
+	if err != nil {
+		return nil, err
+	}
+	storedFunctionDetails := make([]sql.StoredProcedureDetails, len(functions))
+	for i, function := range functions {
+		// Db, Name, Type, Definer, Modified, Created, Security_type, Comment, ...
+		functionStatement, err := d.shim.QueryRows("", fmt.Sprintf("SHOW CREATE FUNCTION `%s`.`%s`;", d.name, function[1]))
+		if err != nil {
+			return nil, err
+		}
+		// Function, sql_mode, Create Function, ...
+		storedFunctionDetails[i] = sql.StoredProcedureDetails{
+			Name:            functionStatement[0][0].(string),
+			CreateStatement: functionStatement[0][2].(string),
+			CreatedAt:       time.Time{}, // these should be added someday
+			ModifiedAt:      time.Time{},
+		}
+	}
+	return storedFunctionDetails, nil
+}
+
+// SaveStoredFunction implements the interface sql.StoredFunctionDatabase.
+func (d Database) SaveStoredFunction(ctx *sql.Context, sfd sql.StoredProcedureDetails) error {
+	return d.shim.Exec(d.name, sfd.CreateStatement)
+}
+
+// DropStoredFunction implements the interface sql.StoredFunctionDatabase.
+func (d Database) DropStoredFunction(ctx *sql.Context, name string) error {
+	return d.shim.Exec(d.name, fmt.Sprintf("DROP FUNCTION `%s`;", name))
+}
+
 // CreateView implements the interface sql.ViewDatabase.
 func (d Database) CreateView(ctx *sql.Context, name string, selectStatement, createViewStmt string) error {
 	return d.shim.Exec(d.name, createViewStmt)
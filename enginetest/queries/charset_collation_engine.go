@@ -1082,6 +1082,42 @@ var CharsetCollationEngineTests = []CharsetCollationEngineTest{
 			},
 		},
 	},
+	{
+		Name: "Columns with non-utf8mb4 character sets",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 VARCHAR(3) CHARACTER SET latin1, v2 TINYTEXT CHARACTER SET latin1, v3 VARCHAR(3) CHARACTER SET utf8mb3);",
+		},
+		Queries: []CharsetCollationEngineTestQuery{
+			{
+				Query:    "INSERT INTO test VALUES (1, 'été', REPEAT('é', 255), 'été');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:   "INSERT INTO test VALUES (2, 'étés', 'a', 'a');",
+				ErrKind: types.ErrLengthBeyondLimit,
+			},
+			{
+				Query:   "INSERT INTO test VALUES (2, 'a', REPEAT('é', 256), 'a');",
+				ErrKind: types.ErrLengthBeyondLimit,
+			},
+			{
+				Query:   "INSERT INTO test VALUES (2, 'ā', 'a', 'a');",
+				ErrKind: types.ErrIncorrectStringValue,
+			},
+			{
+				Query:   "INSERT INTO test VALUES (2, 'a', 'a', '😀');",
+				ErrKind: types.ErrIncorrectStringValue,
+			},
+			{
+				Query:   "UPDATE test SET v1 = '😀' WHERE pk = 1;",
+				ErrKind: types.ErrIncorrectStringValue,
+			},
+			{
+				Query:    "SELECT v1, CHAR_LENGTH(v2), v3 FROM test;",
+				Expected: []sql.Row{{"été", int32(255), "été"}},
+			},
+		},
+	},
 	{
 		Name: "Issue #5482",
 		Queries: []CharsetCollationEngineTestQuery{
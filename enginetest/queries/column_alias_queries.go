@@ -160,6 +160,89 @@ var ColumnAliasQueries = []ScriptTest{
 				Query:    "select t1.i as a from mytable as t1 having a = t1.i;",
 				Expected: []sql.Row{{1}, {2}, {3}},
 			},
+			{
+				// A group by clause prefers a table column over an expression alias with the same name
+				Query:    "select if(u < 2, 0, 1) as u, count(*) from uv group by u order by 1, 2;",
+				Expected: []sql.Row{{0, 1}, {0, 1}, {1, 1}, {1, 1}},
+			},
+			{
+				// A group by ordinal refers to the select expression, even if its alias is also a table column name
+				Query:    "select if(u < 2, 0, 1) as u, count(*) from uv group by 1 order by 1;",
+				Expected: []sql.Row{{0, 2}, {1, 2}},
+			},
+			{
+				// If there is ambiguity between multiple aliases in a group by clause, it is an error
+				Query:       "select u as a, v as a from uv group by a;",
+				ExpectedErr: sql.ErrAmbiguousColumnOrAliasName,
+			},
+			{
+				// A having clause prefers a column that's grouped by over an expression alias with the same name
+				Query:    "select count(*) as u from uv group by u having u > 1 order by 1;",
+				Expected: []sql.Row{{1}, {1}},
+			},
+			{
+				// Otherwise, a having clause prefers an expression alias over a table column
+				Query:    "select v as u from uv having u > 1 order by u;",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				// Multiple aliases of the same expression aren't ambiguous
+				Query:    "select u as a, u as a from uv order by a;",
+				Expected: []sql.Row{{0, 0}, {1, 1}, {2, 2}, {3, 3}},
+			},
+		},
+	},
+	{
+		Name: "column aliases with the names of table columns in each clause",
+		SetUpScript: []string{
+			"create table ab (a int primary key, b int);",
+			"insert into ab values (1,10),(2,20),(3,30);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// A where clause refers to the table column, since it can't reference the aliases of its own scope
+				Query:    "select b as a from ab where a > 1 order by 1;",
+				Expected: []sql.Row{{20}, {30}},
+			},
+			{
+				Query:    "select a as b from ab where b > 15 order by 1;",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:       "select b as z from ab where z > 15;",
+				ExpectedErr: sql.ErrColumnNotFound,
+			},
+			{
+				// A group by clause refers to the table column
+				Query:    "select if(a < 2, 0, 1) as b, count(*) from ab group by b order by 1, 2;",
+				Expected: []sql.Row{{0, 1}, {1, 1}, {1, 1}},
+			},
+			{
+				Query:    "select if(a < 2, 0, 1) as z, count(*) from ab group by z order by 1;",
+				Expected: []sql.Row{{0, 1}, {1, 2}},
+			},
+			{
+				// A having clause refers to the alias, unless the table column is grouped by
+				Query:    "select b as a from ab having a > 15 order by 1;",
+				Expected: []sql.Row{{20}, {30}},
+			},
+			{
+				Query:    "select a * 10 as a, count(*) from ab group by a having a > 1 order by 1;",
+				Expected: []sql.Row{{20, 1}, {30, 1}},
+			},
+			{
+				// An order by clause refers to the alias, unless the column is qualified
+				Query:    "select -a as a from ab order by a;",
+				Expected: []sql.Row{{-3}, {-2}, {-1}},
+			},
+			{
+				Query:    "select -a as a from ab order by ab.a;",
+				Expected: []sql.Row{{-1}, {-2}, {-3}},
+			},
+			{
+				Query:    "select b as a, a as b from ab where a > 1 group by a, b having b < 30 order by a desc;",
+				Expected: []sql.Row{{20, 2}},
+			},
 		},
 	},
 	{
@@ -209,6 +292,16 @@ var ColumnAliasQueries = []ScriptTest{
 				Query:    "select x, (select 1) as y from xy;",
 				Expected: []sql.Row{{0, 1}, {1, 1}, {2, 1}, {3, 1}},
 			},
+			{
+				// An expression alias can't be referenced in the select list it's defined in, so a subquery's select
+				// list refers to the outer scope's column instead
+				Query:    "select u, (select -u as u) from uv order by u;",
+				Expected: []sql.Row{{0, 0}, {1, -1}, {2, -2}, {3, -3}},
+			},
+			{
+				Query:    "select u, (select -u as u having u < -1) from uv order by u;",
+				Expected: []sql.Row{{0, nil}, {1, nil}, {2, -2}, {3, -3}},
+			},
 			{
 				Query:    "SELECT 1 as a, (select a) from xy;",
 				Expected: []sql.Row{{1, 1}, {1, 1}, {1, 1}, {1, 1}},
@@ -313,22 +313,22 @@ var DeleteErrorTests = []ScriptTest{
 			{
 				// negative limit
 				Query:          "DELETE FROM mytable LIMIT -1;",
-				ExpectedErrStr: "syntax error at position 28 near 'LIMIT'",
+				ExpectedErrStr: "syntax error at line 1, column 28 near 'LIMIT'",
 			},
 			{
 				// negative offset
 				Query:          "DELETE FROM mytable LIMIT 1 OFFSET -1;",
-				ExpectedErrStr: "syntax error at position 37 near 'OFFSET'",
+				ExpectedErrStr: "syntax error at line 1, column 37 near 'OFFSET'",
 			},
 			{
 				// missing keyword from
 				Query:          "DELETE mytable WHERE i = 1;",
-				ExpectedErrStr: "syntax error at position 21 near 'WHERE'",
+				ExpectedErrStr: "syntax error at line 1, column 21 near 'WHERE'",
 			},
 			{
 				// targets subquery alias
 				Query:          "DELETE FROM (SELECT * FROM mytable) mytable WHERE i = 1;",
-				ExpectedErrStr: "syntax error at position 14 near 'FROM'",
+				ExpectedErrStr: "syntax error at line 1, column 14 near 'FROM'",
 			},
 		},
 	},
@@ -358,7 +358,7 @@ var DeleteErrorTests = []ScriptTest{
 			{
 				// targets join with no explicit target tables
 				Query:          "DELETE FROM mytable one, mytable two WHERE one.i = 1;",
-				ExpectedErrStr: "syntax error at position 24 near 'one'",
+				ExpectedErrStr: "syntax error at line 1, column 24 near 'one'",
 			},
 			{
 				// targets table function alias
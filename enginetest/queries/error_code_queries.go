@@ -0,0 +1,276 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/vitess/go/mysql"
+)
+
+// ErrorCodeScripts are failing statements along with the error number and SQLSTATE that MySQL 8.0 reports for them,
+// which the engine is expected to report as well.
+var ErrorCodeScripts = []ScriptTest{
+	{
+		Name: "name resolution errors",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(10))",
+			"create table u (pk int primary key, v varchar(10))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:            "selec 1",
+				ExpectedErrCode:  mysql.ERParseError,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "select * from nope",
+				ExpectedErrCode:  mysql.ERNoSuchTable,
+				ExpectedSQLState: "42S02",
+			},
+			{
+				Query:            "select nope from t",
+				ExpectedErrCode:  mysql.ERBadFieldError,
+				ExpectedSQLState: "42S22",
+			},
+			{
+				Query:            "select v from t join u on t.pk = u.pk",
+				ExpectedErrCode:  mysql.ERNonUniq,
+				ExpectedSQLState: "23000",
+			},
+			{
+				Query:            "select * from t join t",
+				ExpectedErrCode:  mysql.ERNonUniqTable,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "select * from nodb.t",
+				ExpectedErrCode:  mysql.ERBadDb,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "use nodb",
+				ExpectedErrCode:  mysql.ERBadDb,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "select nope(1)",
+				ExpectedErrCode:  mysql.ERSPDoesNotExist,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "select abs(1, 2)",
+				ExpectedErrCode:  1582,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "select @@nope",
+				ExpectedErrCode:  mysql.ERUnknownSystemVariable,
+				ExpectedSQLState: "HY000",
+			},
+			{
+				Query:            "drop view nope",
+				ExpectedErrCode:  mysql.ERBadTable,
+				ExpectedSQLState: "42S02",
+			},
+		},
+	},
+	{
+		Name: "query evaluation errors",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v int)",
+			"insert into t values (1, 1), (2, 2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:            "select (select pk from t)",
+				ExpectedErrCode:  mysql.ERSubqueryNo1Row,
+				ExpectedSQLState: "21000",
+			},
+			{
+				Query:            "select * from t where (pk, v) = 1",
+				ExpectedErrCode:  mysql.EROperandColumns,
+				ExpectedSQLState: "21000",
+			},
+			{
+				Query:            "select pk from t union select pk, v from t",
+				ExpectedErrCode:  mysql.ERWrongNumberOfColumnsInSelect,
+				ExpectedSQLState: "21000",
+			},
+			{
+				Query:            "select cast('{' as json)",
+				ExpectedErrCode:  mysql.ERInvalidJSONText,
+				ExpectedSQLState: "22032",
+			},
+			{
+				Query:            "select 'a' collate nope",
+				ExpectedErrCode:  mysql.ERUnknownCollation,
+				ExpectedSQLState: "HY000",
+			},
+		},
+	},
+	{
+		Name: "write and constraint errors",
+		SetUpScript: []string{
+			"create table parent (pk int primary key)",
+			"create table child (pk int primary key, p int, foreign key (p) references parent (pk))",
+			"create table t (pk int primary key, v varchar(2) not null, c int, unique key (c), check (c < 10))",
+			"insert into parent values (1)",
+			"insert into child values (1, 1)",
+			"insert into t values (1, 'a', 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:            "insert into t values (1, 'b', 2)",
+				ExpectedErrCode:  mysql.ERDupEntry,
+				ExpectedSQLState: "23000",
+			},
+			{
+				Query:            "insert into t values (2, 'b', 1)",
+				ExpectedErrCode:  mysql.ERDupEntry,
+				ExpectedSQLState: "23000",
+			},
+			{
+				Query:            "insert into t values (2, null, 2)",
+				ExpectedErrCode:  mysql.ERBadNullError,
+				ExpectedSQLState: "23000",
+			},
+			{
+				Query:            "insert into t (pk, c) values (2, 2)",
+				ExpectedErrCode:  mysql.ERNoDefaultForField,
+				ExpectedSQLState: "HY000",
+			},
+			{
+				Query:            "insert into t values (2, 'abc', 2)",
+				ExpectedErrCode:  mysql.ERDataTooLong,
+				ExpectedSQLState: "22001",
+			},
+			{
+				Query:            "insert into t values (2, 'b', 20)",
+				ExpectedErrCode:  3819,
+				ExpectedSQLState: "HY000",
+			},
+			{
+				Query:            "insert into t values (2, 'b')",
+				ExpectedErrCode:  mysql.ERWrongValueCountOnRow,
+				ExpectedSQLState: "21S01",
+			},
+			{
+				Query:            "insert into t (pk, nope) values (2, 2)",
+				ExpectedErrCode:  mysql.ERBadFieldError,
+				ExpectedSQLState: "42S22",
+			},
+			{
+				Query:            "insert into child values (2, 2)",
+				ExpectedErrCode:  mysql.ErNoReferencedRow2,
+				ExpectedSQLState: "23000",
+			},
+			{
+				Query:            "delete from parent",
+				ExpectedErrCode:  mysql.ERRowIsReferenced2,
+				ExpectedSQLState: "23000",
+			},
+		},
+	},
+	{
+		Name: "schema change errors",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v int)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:            "create database mydb",
+				ExpectedErrCode:  mysql.ERDbCreateExists,
+				ExpectedSQLState: "HY000",
+			},
+			{
+				Query:            "create table t (pk int primary key)",
+				ExpectedErrCode:  mysql.ERTableExists,
+				ExpectedSQLState: "42S01",
+			},
+			{
+				Query:            "alter table t add column v int",
+				ExpectedErrCode:  mysql.ERDupFieldName,
+				ExpectedSQLState: "42S21",
+			},
+			{
+				Query:            "alter table t drop index nope",
+				ExpectedErrCode:  mysql.ERCantDropFieldOrKey,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "alter table t add primary key (v)",
+				ExpectedErrCode:  mysql.ERMultiplePriKey,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "create table u (a int auto_increment, b int auto_increment, primary key (a))",
+				ExpectedErrCode:  mysql.ERWrongAutoKey,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "create table u (a int, primary key (nope))",
+				ExpectedErrCode:  mysql.ERKeyColumnDoesNotExist,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "create table u (a int primary key, b text, key (b))",
+				ExpectedErrCode:  mysql.ERBlobKeyWithoutLength,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "create table u (a int primary key, b int default 'x')",
+				ExpectedErrCode:  mysql.ERInvalidDefault,
+				ExpectedSQLState: "42000",
+			},
+		},
+	},
+	{
+		Name: "stored routine errors",
+		SetUpScript: []string{
+			"create procedure p(x int) select x",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:            "call nope()",
+				ExpectedErrCode:  mysql.ERSPDoesNotExist,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "call p()",
+				ExpectedErrCode:  1318,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "create procedure p() select 1",
+				ExpectedErrCode:  1304,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "create procedure q() begin declare x int; declare x int; end",
+				ExpectedErrCode:  1331,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "create procedure q() begin leave nope; end",
+				ExpectedErrCode:  1308,
+				ExpectedSQLState: "42000",
+			},
+			{
+				Query:            "execute nope",
+				ExpectedErrCode:  1243,
+				ExpectedSQLState: "HY000",
+			},
+		},
+	},
+}
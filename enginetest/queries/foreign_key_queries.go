@@ -674,6 +674,40 @@ var ForeignKeyTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "DELETE IGNORE skips rows with children",
+		SetUpScript: []string{
+			"CREATE TABLE one (pk BIGINT PRIMARY KEY);",
+			"CREATE TABLE two (pk BIGINT PRIMARY KEY, v1 BIGINT, CONSTRAINT fk_name_1 FOREIGN KEY (v1) REFERENCES one(pk));",
+			"INSERT INTO one VALUES (1), (2), (3);",
+			"INSERT INTO two VALUES (1, 2);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "DELETE FROM one;",
+				ExpectedErr: sql.ErrForeignKeyParentViolation,
+			},
+			{
+				Query:    "DELETE IGNORE FROM one;",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:                 "SHOW WARNINGS;",
+				Expected:              []sql.Row{{"Note", 1451, "cannot delete or update a parent row - Foreign key violation on fk: `fk_name_1`, table: `two`, referenced table: `one`, key: `[2]`"}},
+				ExpectedWarningsCount: 1,
+			},
+			{
+				Query:    "SELECT * FROM one;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:                 "DELETE LOW_PRIORITY QUICK FROM two;",
+				Expected:              []sql.Row{{types.NewOkResult(1)}},
+				ExpectedWarning:       1235,
+				ExpectedWarningsCount: 2,
+			},
+		},
+	},
 	{
 		Name: "Multi-table DELETE FROM JOIN with multiple foreign keys",
 		SetUpScript: []string{
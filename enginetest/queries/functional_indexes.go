@@ -0,0 +1,186 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var FunctionalIndexTests = []ScriptTest{
+	{
+		Name: "functional key parts",
+		SetUpScript: []string{
+			"create table t (a int primary key, n varchar(20), b int, c int, index ((b + c)), key k ((lower(n)) desc, a))",
+			"insert into t values (1, 'ABC', 1, 2), (2, 'Def', 3, 4), (3, 'ghi', 5, 6)",
+			"create index bc on t ((b * c))",
+			"alter table t add index ((c - b))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `a` int NOT NULL,\n" +
+					"  `n` varchar(20),\n" +
+					"  `b` int,\n" +
+					"  `c` int,\n" +
+					"  PRIMARY KEY (`a`),\n" +
+					"  KEY `bc` (((b * c))),\n" +
+					"  KEY `functional_index` (((b + c))),\n" +
+					"  KEY `functional_index_2` (((c - b))),\n" +
+					"  KEY `k` ((lower(n)) DESC,`a`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "select a from t where b + c = 7",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query: "explain select a from t where b + c = 7",
+				Expected: []sql.Row{
+					{1, "SIMPLE", "t", nil, "range", "functional_index", "functional_index", "8", nil, 1, 100.0, nil},
+				},
+			},
+			{
+				Query:    "select a from t where lower(n) = 'def'",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query: "explain select a from t where lower(n) = 'def'",
+				Expected: []sql.Row{
+					{1, "SIMPLE", "t", nil, "range", "k", "k", "82", nil, 1, 10.0, "Using where"},
+				},
+			},
+			{
+				Query:    "select a from t where b * c > 2 order by a",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "update t set c = 10 where c - b = 1 and a = 2",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "select a from t where c - b = 7",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query: "show indexes from t",
+				Expected: []sql.Row{
+					{"t", 0, "PRIMARY", 1, "a", nil, int64(0), nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"t", 1, "bc", 1, nil, nil, int64(0), nil, nil, "YES", "BTREE", "", "", "YES", "(b * c)"},
+					{"t", 1, "functional_index", 1, nil, nil, int64(0), nil, nil, "YES", "BTREE", "", "", "YES", "(b + c)"},
+					{"t", 1, "functional_index_2", 1, nil, nil, int64(0), nil, nil, "YES", "BTREE", "", "", "YES", "(c - b)"},
+					{"t", 1, "k", 1, nil, nil, int64(0), nil, nil, "YES", "BTREE", "", "", "YES", "lower(n)"},
+					{"t", 1, "k", 2, "a", nil, int64(0), nil, nil, "", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query: "select index_name, seq_in_index, column_name, collation, nullable, expression from information_schema.statistics where table_name = 't' and index_name <> 'PRIMARY' order by 1, 2",
+				Expected: []sql.Row{
+					{"bc", 1, nil, "A", "YES", "(b * c)"},
+					{"functional_index", 1, nil, "A", "YES", "(b + c)"},
+					{"functional_index_2", 1, nil, "A", "YES", "(c - b)"},
+					{"k", 1, nil, "D", "YES", "lower(n)"},
+					{"k", 2, "a", "A", "", nil},
+				},
+			},
+		},
+	},
+	{
+		Name: "functional indexes follow the columns of their tables",
+		SetUpScript: []string{
+			"create table t (a int primary key, n varchar(20), b int, index ((lower(n))))",
+			"insert into t values (1, 'ABC', 1), (2, 'Def', 2)",
+			"alter table t add column z int first",
+			"alter table t drop column b",
+			"alter table t modify column n varchar(30)",
+			"rename table t to u",
+			"create table v like u",
+			"insert into v select * from u",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select a from u where lower(n) = 'abc'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select a from v where lower(n) = 'def'",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query: "show create table v",
+				Expected: []sql.Row{{"v", "CREATE TABLE `v` (\n" +
+					"  `z` int,\n" +
+					"  `a` int NOT NULL,\n" +
+					"  `n` varchar(30),\n" +
+					"  PRIMARY KEY (`a`),\n" +
+					"  KEY `functional_index` ((lower(n)))\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "alter table u drop index functional_index",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter table u drop column n",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "invalid functional key parts",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int, c int, index ((b + c)))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "create index i on t ((b))",
+				ExpectedErr: sql.ErrFunctionalIndexOnField,
+			},
+			{
+				Query:       "create index i on t ((b + rand()))",
+				ExpectedErr: sql.ErrFunctionalIndexFunction,
+			},
+			{
+				Query:       "create index i on t ((b + x))",
+				ExpectedErr: sql.ErrTableColumnNotFound,
+			},
+			{
+				Query:       "create table t1 (a int, primary key ((a + 1)))",
+				ExpectedErr: sql.ErrFunctionalIndexPrimaryKey,
+			},
+			{
+				Query:       "alter table t drop primary key, add primary key ((a + 1))",
+				ExpectedErr: sql.ErrFunctionalIndexPrimaryKey,
+			},
+			{
+				Query:       "create unique index i on t ((b * 2))",
+				ExpectedErr: sql.ErrFunctionalIndexNotSupported,
+			},
+			{
+				Query:       "alter table t drop column c",
+				ExpectedErr: sql.ErrColumnReferencedInFunctionalIndex,
+			},
+			{
+				Query:       "alter table t rename column b to d",
+				ExpectedErr: sql.ErrColumnReferencedInFunctionalIndex,
+			},
+			{
+				Query:       "alter table t change column b d int",
+				ExpectedErr: sql.ErrColumnReferencedInFunctionalIndex,
+			},
+		},
+	},
+}
@@ -0,0 +1,221 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var GeneratedColumnTests = []ScriptTest{
+	{
+		Name: "stored and virtual generated columns",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int generated always as (a * 2) stored, c int as (b + 1), d date, m int as (month(d)) stored)",
+			"insert into t (a, d) values (1, '2023-05-01'), (2, '2023-06-02')",
+			"insert into t values (3, default, default, '2023-05-09', default)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `a` int NOT NULL,\n" +
+					"  `b` int GENERATED ALWAYS AS ((a * 2)) STORED,\n" +
+					"  `c` int GENERATED ALWAYS AS ((b + 1)) VIRTUAL,\n" +
+					"  `d` date,\n" +
+					"  `m` int GENERATED ALWAYS AS (month(d)) STORED,\n" +
+					"  PRIMARY KEY (`a`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "select a, b, c, m from t order by a",
+				Expected: []sql.Row{{1, 2, 3, 5}, {2, 4, 5, 6}, {3, 6, 7, 5}},
+			},
+			{
+				Query:    "update t set a = a + 10, d = '2023-07-01' where a = 1",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "update t set b = default where a = 2",
+				Expected: []sql.Row{{newUpdateResult(1, 0)}},
+			},
+			{
+				Query:    "insert into t (a, d) values (2, '2023-01-01') on duplicate key update d = '2023-03-03'",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select a, b, c, m from t order by a",
+				Expected: []sql.Row{{2, 4, 5, 3}, {3, 6, 7, 5}, {11, 22, 23, 7}},
+			},
+			{
+				Query: "select column_name, extra, generation_expression from information_schema.columns where table_name = 't' order by ordinal_position",
+				Expected: []sql.Row{
+					{"a", "", ""},
+					{"b", "STORED GENERATED", "(a * 2)"},
+					{"c", "VIRTUAL GENERATED", "(b + 1)"},
+					{"d", "", ""},
+					{"m", "STORED GENERATED", "month(d)"},
+				},
+			},
+		},
+	},
+	{
+		Name: "generated columns can only be inserted and assigned DEFAULT",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int as (a + 1))",
+			"insert into t (a) values (1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "insert into t values (2, 3)",
+				ExpectedErr: sql.ErrGeneratedColumnValue,
+			},
+			{
+				Query:       "insert into t (a, b) select 2, 3",
+				ExpectedErr: sql.ErrGeneratedColumnValue,
+			},
+			{
+				Query:       "update t set b = 3",
+				ExpectedErr: sql.ErrGeneratedColumnValue,
+			},
+			{
+				Query:       "insert into t (a) values (1) on duplicate key update b = 3",
+				ExpectedErr: sql.ErrGeneratedColumnValue,
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{1, 2}},
+			},
+		},
+	},
+	{
+		Name: "invalid generated columns",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int as (a + 1))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "create table t1 (a int, b int as (rand()))",
+				ExpectedErr: sql.ErrGeneratedColumnFunction,
+			},
+			{
+				Query:       "create table t1 (a int primary key auto_increment, b int as (a))",
+				ExpectedErr: sql.ErrGeneratedColumnRefAutoIncrement,
+			},
+			{
+				Query:       "create table t1 (a int, b int as (c), c int as (a))",
+				ExpectedErr: sql.ErrGeneratedColumnOrder,
+			},
+			{
+				Query:       "create table t1 (a int, b int as (x))",
+				ExpectedErr: sql.ErrTableColumnNotFound,
+			},
+			{
+				Query:       "create table t1 (a int, b int as (a) default 1)",
+				ExpectedErr: sql.ErrGeneratedColumnWithDefault,
+			},
+			{
+				Query:       "alter table t drop column a",
+				ExpectedErr: sql.ErrColumnReferencedInGenerated,
+			},
+			{
+				Query:       "alter table t rename column a to z",
+				ExpectedErr: sql.ErrColumnReferencedInGenerated,
+			},
+		},
+	},
+	{
+		Name: "generated columns are computed after before triggers",
+		SetUpScript: []string{
+			"create table t (a int primary key, b int as (a * 2) stored)",
+			"create trigger ins before insert on t for each row set new.a = new.a + 1",
+			"create trigger upd before update on t for each row set new.a = new.a + 1",
+			"insert into t (a) values (1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{2, 4}},
+			},
+			{
+				Query:    "update t set a = 5",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{6, 12}},
+			},
+		},
+	},
+	{
+		Name: "filters on the expressions of indexed generated columns use their indexes",
+		SetUpScript: []string{
+			"create table t (a int primary key, d date, m int as (month(d)) stored, n varchar(20), l varchar(20) as (lower(n)), index (m), index (l))",
+			"insert into t (a, d, n) values (1, '2023-05-01', 'ABC'), (2, '2023-06-02', 'Def'), (3, '2023-05-09', 'ghi')",
+			"create table u (x int primary key)",
+			"insert into u values (1), (2), (3)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select a from t where month(d) = 5 order by a",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query: "explain select a from t where month(d) = 5",
+				Expected: []sql.Row{
+					{1, "SIMPLE", "t", nil, "range", "m", "m", "5", nil, 1, 100.0, nil},
+				},
+			},
+			{
+				Query:    "select a from t where lower(n) = 'def'",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query: "explain select a from t where lower(n) = 'def'",
+				Expected: []sql.Row{
+					{1, "SIMPLE", "t", nil, "range", "l", "l", "83", nil, 1, 100.0, "Using index condition"},
+				},
+			},
+			{
+				Query:    "select x from u join t on u.x = t.a where month(t.d) = 6",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query: "explain select x from u join t on u.x = t.a where month(t.d) = 6",
+				Expected: []sql.Row{
+					{1, "SIMPLE", "t", nil, "range", "m", "m", "5", nil, 1, 100.0, "Using index condition"},
+					{1, "SIMPLE", "u", nil, "index", "PRIMARY", "PRIMARY", "4", nil, 3, 10.0, "Using where"},
+				},
+			},
+		},
+	},
+	{
+		Name: "generated columns of another type aren't substituted",
+		SetUpScript: []string{
+			"create table t (a int primary key, b double, c int as (b * 2), index (c))",
+			"insert into t (a, b) values (1, 1.2), (2, 1.6)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select a from t where b * 2 = 3.2",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select a, c from t order by a",
+				Expected: []sql.Row{{1, 2}, {2, 3}},
+			},
+		},
+	},
+}
@@ -0,0 +1,220 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var HandlerScripts = []ScriptTest{
+	{
+		Name: "HANDLER reads in index order",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(10), key v_idx (v))",
+			"insert into t values (1, 'c'), (2, 'a'), (3, 'd'), (4, 'b'), (5, null)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "handler t open",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "handler t read v_idx first",
+				Expected: []sql.Row{{5, nil}},
+			},
+			{
+				Query:    "handler t read v_idx next limit 2",
+				Expected: []sql.Row{{2, "a"}, {4, "b"}},
+			},
+			{
+				Query:    "handler t read v_idx prev",
+				Expected: []sql.Row{{2, "a"}},
+			},
+			{
+				Query:    "handler t read v_idx last",
+				Expected: []sql.Row{{3, "d"}},
+			},
+			{
+				Query:    "handler t read v_idx next",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "handler t read v_idx >= ('b') limit 1, 10",
+				Expected: []sql.Row{{1, "c"}, {3, "d"}},
+			},
+			{
+				Query:    "handler t read v_idx = ('c')",
+				Expected: []sql.Row{{1, "c"}},
+			},
+			{
+				Query:    "handler t read v_idx < ('c') limit 5",
+				Expected: []sql.Row{{4, "b"}, {2, "a"}, {5, nil}},
+			},
+			{
+				Query:    "handler t read `PRIMARY` = (4)",
+				Expected: []sql.Row{{4, "b"}},
+			},
+			{
+				Query:    "handler t read `PRIMARY` next",
+				Expected: []sql.Row{{5, nil}},
+			},
+			{
+				Query:    "handler t read first limit 2",
+				Expected: []sql.Row{{1, "c"}, {2, "a"}},
+			},
+			{
+				Query:    "handler t read next limit 2",
+				Expected: []sql.Row{{3, "d"}, {4, "b"}},
+			},
+			{
+				Query:    "handler t close",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "handler t read first",
+				ExpectedErr: sql.ErrUnknownHandler,
+			},
+		},
+	},
+	{
+		Name: "HANDLER names and errors",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v int)",
+			"insert into t values (1, 10), (2, 20)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "handler missing open",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:    "handler mydb.t open as h",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "handler t open h",
+				ExpectedErr: sql.ErrHandlerAlreadyOpen,
+			},
+			{
+				Query:       "handler t read first",
+				ExpectedErr: sql.ErrUnknownHandler,
+			},
+			{
+				Query:       "handler h read v_idx first",
+				ExpectedErr: sql.ErrHandlerIndexNotFound,
+			},
+			{
+				Query:       "handler h read first where v > 10",
+				ExpectedErr: sql.ErrUnsupportedFeature,
+			},
+			{
+				Query:    "handler H read first",
+				Expected: []sql.Row{{1, 10}},
+			},
+			{
+				Query:    "insert into t values (3, 30)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "handler h read next limit 5",
+				Expected: []sql.Row{{2, 20}, {3, 30}},
+			},
+			{
+				Query:    "handler h close",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "handler h close",
+				ExpectedErr: sql.ErrUnknownHandler,
+			},
+		},
+	},
+	{
+		Name: "HANDLER positions",
+		SetUpScript: []string{
+			"create table d (pk int primary key, v int, key v_idx (v), key v_desc (v desc))",
+			"insert into d values (1, 10), (2, 20), (3, 10), (4, 20), (5, 10)",
+			"create table k (v int)",
+			"insert into k values (3), (1), (2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "handler d open",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "handler d read v_idx first limit 2",
+				Expected: []sql.Row{{1, 10}, {3, 10}},
+			},
+			{
+				Query:    "handler d read v_idx next",
+				Expected: []sql.Row{{5, 10}},
+			},
+			{
+				Query:    "handler d read v_idx prev",
+				Expected: []sql.Row{{3, 10}},
+			},
+			{
+				Query:    "handler d read v_idx next limit 2",
+				Expected: []sql.Row{{5, 10}, {2, 20}},
+			},
+			{
+				Query:    "handler d read v_desc first",
+				Expected: []sql.Row{{2, 20}},
+			},
+			{
+				Query:    "handler d read v_desc >= (15) limit 5",
+				Expected: []sql.Row{{1, 10}, {3, 10}, {5, 10}},
+			},
+			{
+				Query:    "handler d read v_desc < (15) limit 5",
+				Expected: []sql.Row{{4, 20}, {2, 20}},
+			},
+			{
+				Query:    "handler d read first",
+				Expected: []sql.Row{{1, 10}},
+			},
+			{
+				Query:    "delete from d where pk = 2",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "handler d read next",
+				Expected: []sql.Row{{3, 10}},
+			},
+			{
+				Query:    "handler k open",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "handler k read first",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "handler k read next limit 5",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "handler k read next",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "handler k read first limit 1, 1",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+}
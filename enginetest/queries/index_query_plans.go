@@ -445,57 +445,46 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>75) OR (v1<=11));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 75 (tinyint)\n" +
-			" │   └─ LessThanOrEqual\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 11 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, 75), [NULL, ∞)}, {(75, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, 75), [NULL, ∞)}, {(75, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t0.v1:1\n" +
+			"     │       └─ 75 (tinyint)\n" +
+			"     └─ LessThanOrEqual\n" +
+			"         ├─ comp_index_t0.v1:1\n" +
+			"         └─ 11 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<=86) OR (v1<>9)) AND (v1=87 AND v2<=45);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ LessThanOrEqual\n" +
-			" │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   └─ 86 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t0.v1:1\n" +
-			" │           └─ 9 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{[87, 87], (NULL, 45]}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{[87, 87], (NULL, 45]}]\n" +
+			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<=5) OR (v1=71)) OR (v1<>96));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   └─ 5 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 71 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t0.v1:1\n" +
-			" │           └─ 96 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, 96), [NULL, ∞)}, {(96, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, 96), [NULL, ∞)}, {(96, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ LessThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   └─ 5 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t0.v1:1\n" +
+			"     │       └─ 71 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t0.v1:1\n" +
+			"             └─ 96 (tinyint)\n" +
 			"",
 	},
 	{
@@ -516,28 +505,27 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<>22 AND v2>18) OR (v1<>12)) OR (v1<=34));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │       └─ 22 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t0.v2:2\n" +
-			" │   │   │       └─ 18 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t0.v1:1\n" +
-			" │   │           └─ 12 (tinyint)\n" +
-			" │   └─ LessThanOrEqual\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 34 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ NOT\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │       └─ 22 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t0.v2:2\n" +
+			"     │   │       └─ 18 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t0.v1:1\n" +
+			"     │           └─ 12 (tinyint)\n" +
+			"     └─ LessThanOrEqual\n" +
+			"         ├─ comp_index_t0.v1:1\n" +
+			"         └─ 34 (tinyint)\n" +
 			"",
 	},
 	{
@@ -590,32 +578,31 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1>=46) AND (v1>=28 AND v2<>68) OR (v1>=33 AND v2<>39));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   └─ 46 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │       │   └─ 28 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t0.v2:2\n" +
-			" │   │               └─ 68 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t0.v1:1\n" +
-			" │       │   └─ 33 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t0.v2:2\n" +
-			" │               └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{[33, 46), (NULL, 39)}, {[33, 46), (39, ∞)}, {[46, ∞), (NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{[33, 46), (NULL, 39)}, {[33, 46), (39, ∞)}, {[46, ∞), (NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   └─ 46 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ GreaterThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │       │   └─ 28 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t0.v2:2\n" +
+			"     │               └─ 68 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t0.v1:1\n" +
+			"         │   └─ 33 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t0.v2:2\n" +
+			"                 └─ 39 (tinyint)\n" +
 			"",
 	},
 	{
@@ -660,24 +647,23 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>74) OR (v1<>40 AND v2>=54));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 74 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t0.v1:1\n" +
-			" │       │       └─ 40 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t0.v2:2\n" +
-			" │           └─ 54 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, 74), [NULL, ∞)}, {[74, 74], [54, ∞)}, {(74, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, 74), [NULL, ∞)}, {[74, 74], [54, ∞)}, {(74, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t0.v1:1\n" +
+			"     │       └─ 74 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t0.v1:1\n" +
+			"         │       └─ 40 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t0.v2:2\n" +
+			"             └─ 54 (tinyint)\n" +
 			"",
 	},
 	{
@@ -698,19 +684,18 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>94) OR (v1<=52));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 94 (tinyint)\n" +
-			" │   └─ LessThanOrEqual\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 52 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, 94), [NULL, ∞)}, {(94, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, 94), [NULL, ∞)}, {(94, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t0.v1:1\n" +
+			"     │       └─ 94 (tinyint)\n" +
+			"     └─ LessThanOrEqual\n" +
+			"         ├─ comp_index_t0.v1:1\n" +
+			"         └─ 52 (tinyint)\n" +
 			"",
 	},
 	{
@@ -755,21 +740,20 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1>35) OR (v1 BETWEEN 11 AND 21)) OR (v1<>98));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   └─ 35 (tinyint)\n" +
-			" │   │   └─ (comp_index_t0.v1:1 BETWEEN 11 (tinyint) AND 21 (tinyint))\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t0.v1:1\n" +
-			" │           └─ 98 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ GreaterThan\n" +
+			"     │   │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   └─ 35 (tinyint)\n" +
+			"     │   └─ (comp_index_t0.v1:1 BETWEEN 11 (tinyint) AND 21 (tinyint))\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t0.v1:1\n" +
+			"             └─ 98 (tinyint)\n" +
 			"",
 	},
 	{
@@ -782,63 +766,61 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<53 AND v2<10) AND (v1<>37) OR (v1>23));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   └─ 53 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t0.v2:2\n" +
-			" │   │   │       └─ 10 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t0.v1:1\n" +
-			" │   │           └─ 37 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 23 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, 23], (NULL, 10)}, {(23, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, 23], (NULL, 10)}, {(23, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThan\n" +
+			"     │   │   │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │   └─ 53 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t0.v2:2\n" +
+			"     │   │       └─ 10 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t0.v1:1\n" +
+			"     │           └─ 37 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t0.v1:1\n" +
+			"         └─ 23 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((((v1<>30) OR (v1>=6 AND v2 BETWEEN 62 AND 65)) OR (v1<>89)) OR (v1<=40 AND v2>=73)) OR (v1<99));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │       └─ 30 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │       │   └─ 6 (tinyint)\n" +
-			" │   │   │   │       └─ (comp_index_t0.v2:2 BETWEEN 62 (tinyint) AND 65 (tinyint))\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t0.v1:1\n" +
-			" │   │   │           └─ 89 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │       │   └─ 40 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t0.v2:2\n" +
-			" │   │           └─ 73 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │   │       └─ 30 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ GreaterThanOrEqual\n" +
+			"     │   │   │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │       │   └─ 6 (tinyint)\n" +
+			"     │   │   │       └─ (comp_index_t0.v2:2 BETWEEN 62 (tinyint) AND 65 (tinyint))\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t0.v1:1\n" +
+			"     │   │           └─ 89 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │       │   └─ 40 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t0.v2:2\n" +
+			"     │           └─ 73 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t0.v1:1\n" +
+			"         └─ 99 (tinyint)\n" +
 			"",
 	},
 	{
@@ -859,29 +841,28 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1 BETWEEN 17 AND 54 AND v2>=37) AND (v1<42 AND v2=96) OR (v1<>50));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t0.v1:1 BETWEEN 17 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t0.v2:2\n" +
-			" │   │   │       └─ 37 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThan\n" +
-			" │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │       │   └─ 42 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t0.v2:2\n" +
-			" │   │           └─ 96 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t0.v1:1\n" +
-			" │           └─ 50 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, 50), [NULL, ∞)}, {(50, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, 50), [NULL, ∞)}, {(50, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ (comp_index_t0.v1:1 BETWEEN 17 (tinyint) AND 54 (tinyint))\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t0.v2:2\n" +
+			"     │   │       └─ 37 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThan\n" +
+			"     │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │       │   └─ 42 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t0.v2:2\n" +
+			"     │           └─ 96 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t0.v1:1\n" +
+			"             └─ 50 (tinyint)\n" +
 			"",
 	},
 	{
@@ -942,38 +923,37 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<=52 AND v2<40) AND (v1<30) OR (v1<=75 AND v2 BETWEEN 54 AND 54)) OR (v1<>31 AND v2<>56));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │   └─ 52 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t0.v2:2\n" +
-			" │   │   │   │       └─ 40 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │   │       └─ 30 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │       │   └─ 75 (tinyint)\n" +
-			" │   │       └─ (comp_index_t0.v2:2 BETWEEN 54 (tinyint) AND 54 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t0.v1:1\n" +
-			" │       │       └─ 31 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t0.v2:2\n" +
-			" │               └─ 56 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, 31), (NULL, 56)}, {(NULL, 31), (56, ∞)}, {[31, 31], [54, 54]}, {(31, ∞), (NULL, 56)}, {(31, ∞), (56, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, 31), (NULL, 56)}, {(NULL, 31), (56, ∞)}, {[31, 31], [54, 54]}, {(31, ∞), (NULL, 56)}, {(31, ∞), (56, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │   │   └─ 52 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t0.v2:2\n" +
+			"     │   │   │       └─ 40 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t0.v1:1\n" +
+			"     │   │       └─ 30 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │       │   └─ 75 (tinyint)\n" +
+			"     │       └─ (comp_index_t0.v2:2 BETWEEN 54 (tinyint) AND 54 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t0.v1:1\n" +
+			"         │       └─ 31 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t0.v2:2\n" +
+			"                 └─ 56 (tinyint)\n" +
 			"",
 	},
 	{
@@ -1018,19 +998,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>39) OR (v1=55)) AND (v1=67);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 39 (tinyint)\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 55 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{[67, 67], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{[67, 67], [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
@@ -1051,56 +1022,55 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((((((v1<>99 AND v2 BETWEEN 12 AND 31) OR (v1<56 AND v2<>69)) OR (v1>=37 AND v2<47)) OR (v1<=98 AND v2=50)) AND (v1 BETWEEN 15 AND 47) OR (v1>55 AND v2>85)) OR (v1>86));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ Or\n" +
-			" │   │   │   │   │   ├─ Or\n" +
-			" │   │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │   │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │   │   │   │       └─ 99 (tinyint)\n" +
-			" │   │   │   │   │   │   │   └─ (comp_index_t0.v2:2 BETWEEN 12 (tinyint) AND 31 (tinyint))\n" +
-			" │   │   │   │   │   │   └─ AND\n" +
-			" │   │   │   │   │   │       ├─ LessThan\n" +
-			" │   │   │   │   │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │   │       │   └─ 56 (tinyint)\n" +
-			" │   │   │   │   │   │       └─ NOT\n" +
-			" │   │   │   │   │   │           └─ Eq\n" +
-			" │   │   │   │   │   │               ├─ comp_index_t0.v2:2\n" +
-			" │   │   │   │   │   │               └─ 69 (tinyint)\n" +
-			" │   │   │   │   │   └─ AND\n" +
-			" │   │   │   │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │       │   └─ 37 (tinyint)\n" +
-			" │   │   │   │   │       └─ LessThan\n" +
-			" │   │   │   │   │           ├─ comp_index_t0.v2:2\n" +
-			" │   │   │   │   │           └─ 47 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ LessThanOrEqual\n" +
-			" │   │   │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │       │   └─ 98 (tinyint)\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t0.v2:2\n" +
-			" │   │   │   │           └─ 50 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t0.v1:1 BETWEEN 15 (tinyint) AND 47 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThan\n" +
-			" │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │       │   └─ 55 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t0.v2:2\n" +
-			" │   │           └─ 85 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 86 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{[15, 47], (NULL, 69)}, {[15, 47], (69, ∞)}, {(55, 86], (85, ∞)}, {(86, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{[15, 47], (NULL, 69)}, {[15, 47], (69, ∞)}, {(55, 86], (85, ∞)}, {(86, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ Or\n" +
+			"     │   │   │   │   ├─ Or\n" +
+			"     │   │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │   │   │   │       ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │   │   │   │   │       └─ 99 (tinyint)\n" +
+			"     │   │   │   │   │   │   └─ (comp_index_t0.v2:2 BETWEEN 12 (tinyint) AND 31 (tinyint))\n" +
+			"     │   │   │   │   │   └─ AND\n" +
+			"     │   │   │   │   │       ├─ LessThan\n" +
+			"     │   │   │   │   │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │   │   │       │   └─ 56 (tinyint)\n" +
+			"     │   │   │   │   │       └─ NOT\n" +
+			"     │   │   │   │   │           └─ Eq\n" +
+			"     │   │   │   │   │               ├─ comp_index_t0.v2:2\n" +
+			"     │   │   │   │   │               └─ 69 (tinyint)\n" +
+			"     │   │   │   │   └─ AND\n" +
+			"     │   │   │   │       ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │   │       │   └─ 37 (tinyint)\n" +
+			"     │   │   │   │       └─ LessThan\n" +
+			"     │   │   │   │           ├─ comp_index_t0.v2:2\n" +
+			"     │   │   │   │           └─ 47 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ LessThanOrEqual\n" +
+			"     │   │   │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │       │   └─ 98 (tinyint)\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t0.v2:2\n" +
+			"     │   │   │           └─ 50 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t0.v1:1 BETWEEN 15 (tinyint) AND 47 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ GreaterThan\n" +
+			"     │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │       │   └─ 55 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t0.v2:2\n" +
+			"     │           └─ 85 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t0.v1:1\n" +
+			"         └─ 86 (tinyint)\n" +
 			"",
 	},
 	{
@@ -1113,66 +1083,63 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<>31) OR (v1<>43)) OR (v1>37 AND v2>5));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │   │       └─ 31 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t0.v1:1\n" +
-			" │   │           └─ 43 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t0.v1:1\n" +
-			" │       │   └─ 37 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t0.v2:2\n" +
-			" │           └─ 5 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t0.v1:1\n" +
+			"     │   │       └─ 31 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t0.v1:1\n" +
+			"     │           └─ 43 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t0.v1:1\n" +
+			"         │   └─ 37 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t0.v2:2\n" +
+			"             └─ 5 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<=91) OR (v1<>79)) OR (v1<64));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   └─ 91 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t0.v1:1\n" +
-			" │   │           └─ 79 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 64 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ LessThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   └─ 91 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t0.v1:1\n" +
+			"     │           └─ 79 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t0.v1:1\n" +
+			"         └─ 64 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>48) OR (v1>11));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 48 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 11 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t0.v1:1\n" +
+			"     │       └─ 48 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t0.v1:1\n" +
+			"         └─ 11 (tinyint)\n" +
 			"",
 	},
 	{
@@ -1185,30 +1152,10 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((((v1<40) OR (v1<=59)) OR (v1<99)) AND (v1>=83) OR (v1>9));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │   └─ 40 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │       └─ 59 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │   │       └─ 99 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 83 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 9 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(9, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(9, ∞), [NULL, ∞)}]\n" +
+			" └─ columns: [pk v1 v2]\n" +
 			"",
 	},
 	{
@@ -1221,25 +1168,24 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1 BETWEEN 27 AND 84) OR (v1<98 AND v2>38)) OR (v1<>30));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ (comp_index_t0.v1:1 BETWEEN 27 (tinyint) AND 84 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThan\n" +
-			" │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │       │   └─ 98 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t0.v2:2\n" +
-			" │   │           └─ 38 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t0.v1:1\n" +
-			" │           └─ 30 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ (comp_index_t0.v1:1 BETWEEN 27 (tinyint) AND 84 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThan\n" +
+			"     │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │       │   └─ 98 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t0.v2:2\n" +
+			"     │           └─ 38 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t0.v1:1\n" +
+			"             └─ 30 (tinyint)\n" +
 			"",
 	},
 	{
@@ -1276,19 +1222,18 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1=30) OR (v1<>67));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Eq\n" +
-			" │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   └─ 30 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t0.v1:1\n" +
-			" │           └─ 67 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, 67), [NULL, ∞)}, {(67, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, 67), [NULL, ∞)}, {(67, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ comp_index_t0.v1:1\n" +
+			"     │   └─ 30 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t0.v1:1\n" +
+			"             └─ 67 (tinyint)\n" +
 			"",
 	},
 	{
@@ -1325,24 +1270,23 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1=15 AND v2=8) AND (v1>2) OR (v1 BETWEEN 50 AND 97));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   └─ 15 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t0.v2:2\n" +
-			" │   │   │       └─ 8 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 2 (tinyint)\n" +
-			" │   └─ (comp_index_t0.v1:1 BETWEEN 50 (tinyint) AND 97 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{[15, 15], [8, 8]}, {[50, 97], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{[15, 15], [8, 8]}, {[50, 97], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │   └─ 15 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t0.v2:2\n" +
+			"     │   │       └─ 8 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t0.v1:1\n" +
+			"     │       └─ 2 (tinyint)\n" +
+			"     └─ (comp_index_t0.v1:1 BETWEEN 50 (tinyint) AND 97 (tinyint))\n" +
 			"",
 	},
 	{
@@ -1355,19 +1299,18 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE ((v1<>66) OR (v1<50));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 66 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t0.v1:1\n" +
-			" │       └─ 50 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, 66), [NULL, ∞)}, {(66, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, 66), [NULL, ∞)}, {(66, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t0.v1:1\n" +
+			"     │       └─ 66 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t0.v1:1\n" +
+			"         └─ 50 (tinyint)\n" +
 			"",
 	},
 	{
@@ -1404,73 +1347,71 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((((v1=37 AND v2>32) OR (v1>13 AND v2>51)) AND (v1 BETWEEN 8 AND 19) OR (v1<>4)) OR (v1<=58 AND v2<>70)) OR (v1<87 AND v2>=24));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Or\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 37 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t0.v2:2\n" +
-			" │   │   │   │   │   │       └─ 32 (tinyint)\n" +
-			" │   │   │   │   │   └─ AND\n" +
-			" │   │   │   │   │       ├─ GreaterThan\n" +
-			" │   │   │   │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │   │   │   │       │   └─ 13 (tinyint)\n" +
-			" │   │   │   │   │       └─ GreaterThan\n" +
-			" │   │   │   │   │           ├─ comp_index_t0.v2:2\n" +
-			" │   │   │   │   │           └─ 51 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t0.v1:1 BETWEEN 8 (tinyint) AND 19 (tinyint))\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t0.v1:1\n" +
-			" │   │   │           └─ 4 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t0.v1:1\n" +
-			" │   │       │   └─ 58 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t0.v2:2\n" +
-			" │   │               └─ 70 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t0.v1:1\n" +
-			" │       │   └─ 87 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t0.v2:2\n" +
-			" │           └─ 24 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, 4), [NULL, ∞)}, {[4, 4], (NULL, ∞)}, {(4, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, 4), [NULL, ∞)}, {[4, 4], (NULL, ∞)}, {(4, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Or\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │   │   │   │   └─ 37 (tinyint)\n" +
+			"     │   │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │   │       ├─ comp_index_t0.v2:2\n" +
+			"     │   │   │   │   │       └─ 32 (tinyint)\n" +
+			"     │   │   │   │   └─ AND\n" +
+			"     │   │   │   │       ├─ GreaterThan\n" +
+			"     │   │   │   │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │   │   │   │       │   └─ 13 (tinyint)\n" +
+			"     │   │   │   │       └─ GreaterThan\n" +
+			"     │   │   │   │           ├─ comp_index_t0.v2:2\n" +
+			"     │   │   │   │           └─ 51 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t0.v1:1 BETWEEN 8 (tinyint) AND 19 (tinyint))\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t0.v1:1\n" +
+			"     │   │           └─ 4 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t0.v1:1\n" +
+			"     │       │   └─ 58 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t0.v2:2\n" +
+			"     │               └─ 70 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThan\n" +
+			"         │   ├─ comp_index_t0.v1:1\n" +
+			"         │   └─ 87 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t0.v2:2\n" +
+			"             └─ 24 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t0 WHERE (((v1<>50) OR (v1<=88)) OR (v1>=28 AND v2 BETWEEN 30 AND 85));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │   │       └─ 50 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t0.v1:1\n" +
-			" │   │       └─ 88 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t0.v1:1\n" +
-			" │       │   └─ 28 (tinyint)\n" +
-			" │       └─ (comp_index_t0.v2:2 BETWEEN 30 (tinyint) AND 85 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t0)\n" +
-			"     ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t0)\n" +
+			" ├─ index: [comp_index_t0.v1,comp_index_t0.v2]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t0.v1:1\n" +
+			"     │   │       └─ 50 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t0.v1:1\n" +
+			"     │       └─ 88 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t0.v1:1\n" +
+			"         │   └─ 28 (tinyint)\n" +
+			"         └─ (comp_index_t0.v2:2 BETWEEN 30 (tinyint) AND 85 (tinyint))\n" +
 			"",
 	},
 	{
@@ -1619,54 +1560,52 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>87 AND v2 BETWEEN 8 AND 33) OR (v1 BETWEEN 39 AND 69 AND v3<4));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 87 (tinyint)\n" +
-			" │   │   └─ (comp_index_t1.v2:2 BETWEEN 8 (tinyint) AND 33 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t1.v1:1 BETWEEN 39 (tinyint) AND 69 (tinyint))\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 4 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 39), [8, 33], [NULL, ∞)}, {[39, 69], [NULL, ∞), [NULL, ∞)}, {(69, 87), [8, 33], [NULL, ∞)}, {(87, ∞), [8, 33], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 39), [8, 33], [NULL, ∞)}, {[39, 69], [NULL, ∞), [NULL, ∞)}, {(69, 87), [8, 33], [NULL, ∞)}, {(87, ∞), [8, 33], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 87 (tinyint)\n" +
+			"     │   └─ (comp_index_t1.v2:2 BETWEEN 8 (tinyint) AND 33 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t1.v1:1 BETWEEN 39 (tinyint) AND 69 (tinyint))\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 4 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=55 AND v2>=72 AND v3=63) AND (v1<>54 AND v2 BETWEEN 3 AND 80) OR (v1=15)) AND (v1<>50);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 55 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 72 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │       └─ 63 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ NOT\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       │       └─ 54 (tinyint)\n" +
-			" │   │       └─ (comp_index_t1.v2:2 BETWEEN 3 (tinyint) AND 80 (tinyint))\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 15 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[15, 15], [NULL, ∞), [NULL, ∞)}, {[55, ∞), [72, 80], [63, 63]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[15, 15], [NULL, ∞), [NULL, ∞)}, {[55, ∞), [72, 80], [63, 63]}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 55 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 72 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │       └─ 63 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ NOT\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t1.v1:1\n" +
+			"     │       │       └─ 54 (tinyint)\n" +
+			"     │       └─ (comp_index_t1.v2:2 BETWEEN 3 (tinyint) AND 80 (tinyint))\n" +
+			"     └─ Eq\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 15 (tinyint)\n" +
 			"",
 	},
 	{
@@ -1687,59 +1626,57 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<3 AND v2<>23 AND v3<>11) OR (v1<>49)) AND (v1<=41 AND v2>40);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 3 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │   │           └─ 23 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 11 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v1:1\n" +
-			" │           └─ 49 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 41], (40, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 41], (40, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThan\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 3 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t1.v2:2\n" +
+			"     │   │           └─ 23 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 11 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v1:1\n" +
+			"             └─ 49 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1 BETWEEN 28 AND 38 AND v3<33) OR (v1 BETWEEN 75 AND 85)) AND (v1>=60) OR (v1>=53 AND v2 BETWEEN 36 AND 53 AND v3>48));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t1.v1:1 BETWEEN 28 (tinyint) AND 38 (tinyint))\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │       └─ 33 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t1.v1:1 BETWEEN 75 (tinyint) AND 85 (tinyint))\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 60 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 53 (tinyint)\n" +
-			" │       │   └─ (comp_index_t1.v2:2 BETWEEN 36 (tinyint) AND 53 (tinyint))\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 48 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[53, 75), [36, 53], (48, ∞)}, {[75, 85], [NULL, ∞), [NULL, ∞)}, {(85, ∞), [36, 53], (48, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[53, 75), [36, 53], (48, ∞)}, {[75, 85], [NULL, ∞), [NULL, ∞)}, {(85, ∞), [36, 53], (48, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t1.v1:1 BETWEEN 28 (tinyint) AND 38 (tinyint))\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │       └─ 33 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t1.v1:1 BETWEEN 75 (tinyint) AND 85 (tinyint))\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 60 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 53 (tinyint)\n" +
+			"         │   └─ (comp_index_t1.v2:2 BETWEEN 36 (tinyint) AND 53 (tinyint))\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 48 (tinyint)\n" +
 			"",
 	},
 	{
@@ -1760,202 +1697,196 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>6 AND v2 BETWEEN 0 AND 97) OR (v1<>40 AND v3<10 AND v2<>10));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 6 (tinyint)\n" +
-			" │   │   └─ (comp_index_t1.v2:2 BETWEEN 0 (tinyint) AND 97 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t1.v1:1\n" +
-			" │       │   │       └─ 40 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t1.v3:3\n" +
-			" │       │       └─ 10 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t1.v2:2\n" +
-			" │               └─ 10 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 6), (NULL, 0), (NULL, 10)}, {(NULL, 6), [0, 97], [NULL, ∞)}, {(NULL, 6), (97, ∞), (NULL, 10)}, {[6, 6], (NULL, 10), (NULL, 10)}, {[6, 6], (10, ∞), (NULL, 10)}, {(6, 40), (NULL, 0), (NULL, 10)}, {(6, 40), (97, ∞), (NULL, 10)}, {(6, ∞), [0, 97], [NULL, ∞)}, {(40, ∞), (NULL, 0), (NULL, 10)}, {(40, ∞), (97, ∞), (NULL, 10)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 6), (NULL, 0), (NULL, 10)}, {(NULL, 6), [0, 97], [NULL, ∞)}, {(NULL, 6), (97, ∞), (NULL, 10)}, {[6, 6], (NULL, 10), (NULL, 10)}, {[6, 6], (10, ∞), (NULL, 10)}, {(6, 40), (NULL, 0), (NULL, 10)}, {(6, 40), (97, ∞), (NULL, 10)}, {(6, ∞), [0, 97], [NULL, ∞)}, {(40, ∞), (NULL, 0), (NULL, 10)}, {(40, ∞), (97, ∞), (NULL, 10)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 6 (tinyint)\n" +
+			"     │   └─ (comp_index_t1.v2:2 BETWEEN 0 (tinyint) AND 97 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t1.v1:1\n" +
+			"         │   │       └─ 40 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t1.v3:3\n" +
+			"         │       └─ 10 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t1.v2:2\n" +
+			"                 └─ 10 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1>=35) OR (v1=86)) OR (v1>41 AND v2>=92)) OR (v1<>28));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 35 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 86 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThan\n" +
-			" │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   └─ 41 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 92 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v1:1\n" +
-			" │           └─ 28 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 28), [NULL, ∞), [NULL, ∞)}, {(28, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 28), [NULL, ∞), [NULL, ∞)}, {(28, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 35 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 86 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ GreaterThan\n" +
+			"     │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   └─ 41 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 92 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v1:1\n" +
+			"             └─ 28 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<16 AND v3=63 AND v2>=20) OR (v1<>41)) OR (v1<=74 AND v3 BETWEEN 14 AND 74 AND v2<>13));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 16 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │       └─ 63 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       └─ 20 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v1:1\n" +
-			" │   │           └─ 41 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 74 (tinyint)\n" +
-			" │       │   └─ (comp_index_t1.v3:3 BETWEEN 14 (tinyint) AND 74 (tinyint))\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t1.v2:2\n" +
-			" │               └─ 13 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 41), [NULL, ∞), [NULL, ∞)}, {[41, 41], (NULL, 13), [14, 74]}, {[41, 41], (13, ∞), [14, 74]}, {(41, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 41), [NULL, ∞), [NULL, ∞)}, {[41, 41], (NULL, 13), [14, 74]}, {[41, 41], (13, ∞), [14, 74]}, {(41, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 16 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │       └─ 63 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       └─ 20 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v1:1\n" +
+			"     │           └─ 41 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 74 (tinyint)\n" +
+			"         │   └─ (comp_index_t1.v3:3 BETWEEN 14 (tinyint) AND 74 (tinyint))\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t1.v2:2\n" +
+			"                 └─ 13 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1 BETWEEN 1 AND 11) OR (v1>2 AND v3<=93 AND v2 BETWEEN 28 AND 84)) OR (v1 BETWEEN 34 AND 52 AND v2=73)) OR (v1<>80 AND v2<=32 AND v3 BETWEEN 3 AND 7));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ (comp_index_t1.v1:1 BETWEEN 1 (tinyint) AND 11 (tinyint))\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │   └─ 2 (tinyint)\n" +
-			" │   │   │       │   └─ LessThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │       │       └─ 93 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t1.v2:2 BETWEEN 28 (tinyint) AND 84 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t1.v1:1 BETWEEN 34 (tinyint) AND 52 (tinyint))\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 73 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t1.v1:1\n" +
-			" │       │   │       └─ 80 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t1.v2:2\n" +
-			" │       │       └─ 32 (tinyint)\n" +
-			" │       └─ (comp_index_t1.v3:3 BETWEEN 3 (tinyint) AND 7 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 1), (NULL, 32], [3, 7]}, {[1, 11], [NULL, ∞), [NULL, ∞)}, {(11, 34), [28, 84], (NULL, 93]}, {(11, 80), (NULL, 28), [3, 7]}, {[34, 52], [28, 73), (NULL, 93]}, {[34, 52], [73, 73], [NULL, ∞)}, {[34, 52], (73, 84], (NULL, 93]}, {(52, ∞), [28, 84], (NULL, 93]}, {(80, ∞), (NULL, 28), [3, 7]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 1), (NULL, 32], [3, 7]}, {[1, 11], [NULL, ∞), [NULL, ∞)}, {(11, 34), [28, 84], (NULL, 93]}, {(11, 80), (NULL, 28), [3, 7]}, {[34, 52], [28, 73), (NULL, 93]}, {[34, 52], [73, 73], [NULL, ∞)}, {[34, 52], (73, 84], (NULL, 93]}, {(52, ∞), [28, 84], (NULL, 93]}, {(80, ∞), (NULL, 28), [3, 7]}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ (comp_index_t1.v1:1 BETWEEN 1 (tinyint) AND 11 (tinyint))\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ GreaterThan\n" +
+			"     │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │   └─ 2 (tinyint)\n" +
+			"     │   │       │   └─ LessThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │       │       └─ 93 (tinyint)\n" +
+			"     │   │       └─ (comp_index_t1.v2:2 BETWEEN 28 (tinyint) AND 84 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ (comp_index_t1.v1:1 BETWEEN 34 (tinyint) AND 52 (tinyint))\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 73 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t1.v1:1\n" +
+			"         │   │       └─ 80 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t1.v2:2\n" +
+			"         │       └─ 32 (tinyint)\n" +
+			"         └─ (comp_index_t1.v3:3 BETWEEN 3 (tinyint) AND 7 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1<45) OR (v1<>72)) OR (v1 BETWEEN 10 AND 86 AND v2=92)) OR (v1 BETWEEN 32 AND 81 AND v2>59));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 45 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t1.v1:1\n" +
-			" │   │   │           └─ 72 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t1.v1:1 BETWEEN 10 (tinyint) AND 86 (tinyint))\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 92 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t1.v1:1 BETWEEN 32 (tinyint) AND 81 (tinyint))\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 72), [NULL, ∞), [NULL, ∞)}, {[72, 72], (59, ∞), [NULL, ∞)}, {(72, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 72), [NULL, ∞), [NULL, ∞)}, {[72, 72], (59, ∞), [NULL, ∞)}, {(72, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ LessThan\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 45 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t1.v1:1\n" +
+			"     │   │           └─ 72 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ (comp_index_t1.v1:1 BETWEEN 10 (tinyint) AND 86 (tinyint))\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 92 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t1.v1:1 BETWEEN 32 (tinyint) AND 81 (tinyint))\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 59 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=11 AND v2>50 AND v3 BETWEEN 5 AND 67) AND (v1>74 AND v2 BETWEEN 6 AND 63 AND v3<=1) OR (v1>=53 AND v2>69 AND v3>54));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 11 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 50 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t1.v3:3 BETWEEN 5 (tinyint) AND 67 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 74 (tinyint)\n" +
-			" │   │       │   └─ (comp_index_t1.v2:2 BETWEEN 6 (tinyint) AND 63 (tinyint))\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 1 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 53 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t1.v2:2\n" +
-			" │       │       └─ 69 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 54 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[53, ∞), (69, ∞), (54, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[53, ∞), (69, ∞), (54, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 11 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 50 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t1.v3:3 BETWEEN 5 (tinyint) AND 67 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThan\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 74 (tinyint)\n" +
+			"     │       │   └─ (comp_index_t1.v2:2 BETWEEN 6 (tinyint) AND 63 (tinyint))\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 1 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 53 (tinyint)\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t1.v2:2\n" +
+			"         │       └─ 69 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 54 (tinyint)\n" +
 			"",
 	},
 	{
@@ -1968,62 +1899,60 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<=39 AND v2 BETWEEN 17 AND 34) OR (v1=89 AND v3>49 AND v2>58)) OR (v1>97));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 39 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 17 (tinyint) AND 34 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ Eq\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 89 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       │       └─ 49 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 58 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 39], [17, 34], [NULL, ∞)}, {[89, 89], (58, ∞), (49, ∞)}, {(97, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 39], [17, 34], [NULL, ∞)}, {[89, 89], (58, ∞), (49, ∞)}, {(97, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 39 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t1.v2:2 BETWEEN 17 (tinyint) AND 34 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ Eq\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 89 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t1.v3:3\n" +
+			"     │       │       └─ 49 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 58 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 97 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<7 AND v2<>43) OR (v1<>5 AND v3<0 AND v2<1));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 7 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 43 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t1.v1:1\n" +
-			" │       │   │       └─ 5 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t1.v3:3\n" +
-			" │       │       └─ 0 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 1 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 7), (NULL, 43), [NULL, ∞)}, {(NULL, 7), (43, ∞), [NULL, ∞)}, {[7, ∞), (NULL, 1), (NULL, 0)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 7), (NULL, 43), [NULL, ∞)}, {(NULL, 7), (43, ∞), [NULL, ∞)}, {[7, ∞), (NULL, 1), (NULL, 0)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ LessThan\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 7 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 43 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t1.v1:1\n" +
+			"         │   │       └─ 5 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t1.v3:3\n" +
+			"         │       └─ 0 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 1 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2052,68 +1981,66 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>12 AND v2<60 AND v3=91) OR (v1>63 AND v2>=8 AND v3<>32)) OR (v1>35 AND v3>=98));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │       └─ 12 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 60 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │       └─ 91 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 63 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       │       └─ 8 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t1.v3:3\n" +
-			" │   │               └─ 32 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 35 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 98 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 12), (NULL, 60), [91, 91]}, {(12, 35], (NULL, 60), [91, 91]}, {(35, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 12), (NULL, 60), [91, 91]}, {(12, 35], (NULL, 60), [91, 91]}, {(35, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │       └─ 12 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 60 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │       └─ 91 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThan\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 63 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │       │       └─ 8 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t1.v3:3\n" +
+			"     │               └─ 32 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 35 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 98 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>27 AND v3=10) OR (v1>=25 AND v2<26)) AND (v1>=62 AND v2<=96 AND v3>28);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 27 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       └─ 10 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 25 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 26 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[62, ∞), (NULL, 96], (28, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[62, ∞), (NULL, 96], (28, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThan\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 27 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t1.v3:3\n" +
+			"     │       └─ 10 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 25 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 26 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2126,53 +2053,52 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((((v1<=92 AND v3=0 AND v2>=9) OR (v1 BETWEEN 48 AND 79)) OR (v1>70 AND v2<=26 AND v3 BETWEEN 14 AND 82)) OR (v1>=29 AND v2<>21 AND v3 BETWEEN 37 AND 55)) OR (v1>=6 AND v3<=47));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 92 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │   │   │       └─ 0 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │   │       └─ 9 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t1.v1:1 BETWEEN 48 (tinyint) AND 79 (tinyint))\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │   └─ 70 (tinyint)\n" +
-			" │   │   │       │   └─ LessThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       │       └─ 26 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t1.v3:3 BETWEEN 14 (tinyint) AND 82 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 29 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t1.v2:2\n" +
-			" │   │       │           └─ 21 (tinyint)\n" +
-			" │   │       └─ (comp_index_t1.v3:3 BETWEEN 37 (tinyint) AND 55 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 6 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 47 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 6), [9, ∞), [0, 0]}, {[6, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 6), [9, ∞), [0, 0]}, {[6, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   │   │   └─ 92 (tinyint)\n" +
+			"     │   │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │   │   │       └─ 0 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │   │       └─ 9 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t1.v1:1 BETWEEN 48 (tinyint) AND 79 (tinyint))\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ GreaterThan\n" +
+			"     │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │   └─ 70 (tinyint)\n" +
+			"     │   │       │   └─ LessThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       │       └─ 26 (tinyint)\n" +
+			"     │   │       └─ (comp_index_t1.v3:3 BETWEEN 14 (tinyint) AND 82 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 29 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t1.v2:2\n" +
+			"     │       │           └─ 21 (tinyint)\n" +
+			"     │       └─ (comp_index_t1.v3:3 BETWEEN 37 (tinyint) AND 55 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 6 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 47 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2211,25 +2137,24 @@ var IndexPlanTests = []QueryPlanTest{
 		Query: `SELECT * FROM comp_index_t1 WHERE (v1<=99 AND v2<>86) AND (v1>=21 AND v2>36);`,
 		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
 			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			" ├─ static: [{[21, 99], (86, ∞), [NULL, ∞)}, {[21, 99], (36, 86), [NULL, ∞)}]\n" +
+			" ├─ static: [{[21, 99], (36, 86), [NULL, ∞)}, {[21, 99], (86, ∞), [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>43) OR (v1=14));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 43 (tinyint)\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 14 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 43), [NULL, ∞), [NULL, ∞)}, {(43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 43), [NULL, ∞), [NULL, ∞)}, {(43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 43 (tinyint)\n" +
+			"     └─ Eq\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 14 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2266,50 +2191,49 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>79) OR (v1>66)) AND (v1<>81 AND v2<34 AND v3>=25) AND (v1<42) OR (v1<>12 AND v2<>17 AND v3<=23));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │       └─ 79 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │       └─ 66 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ NOT\n" +
-			" │   │   │       │   │   └─ Eq\n" +
-			" │   │   │       │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │       └─ 81 (tinyint)\n" +
-			" │   │   │       │   └─ LessThan\n" +
-			" │   │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       │       └─ 34 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │           └─ 25 (tinyint)\n" +
-			" │   │   └─ LessThan\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 42 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t1.v1:1\n" +
-			" │       │   │       └─ 12 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t1.v2:2\n" +
-			" │       │           └─ 17 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 23 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 12), (NULL, 17), (NULL, 23]}, {(NULL, 12), (17, ∞), (NULL, 23]}, {(NULL, 42), (NULL, 34), [25, ∞)}, {(12, ∞), (NULL, 17), (NULL, 23]}, {(12, ∞), (17, ∞), (NULL, 23]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 12), (NULL, 17), (NULL, 23]}, {(NULL, 12), (17, ∞), (NULL, 23]}, {(NULL, 42), (NULL, 34), [25, ∞)}, {(12, ∞), (NULL, 17), (NULL, 23]}, {(12, ∞), (17, ∞), (NULL, 23]}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │       └─ 79 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │       └─ 66 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ NOT\n" +
+			"     │   │       │   │   └─ Eq\n" +
+			"     │   │       │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │       └─ 81 (tinyint)\n" +
+			"     │   │       │   └─ LessThan\n" +
+			"     │   │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       │       └─ 34 (tinyint)\n" +
+			"     │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │           └─ 25 (tinyint)\n" +
+			"     │   └─ LessThan\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 42 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t1.v1:1\n" +
+			"         │   │       └─ 12 (tinyint)\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t1.v2:2\n" +
+			"         │           └─ 17 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 23 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2330,19 +2254,18 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>47) OR (v1<>25));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ GreaterThan\n" +
-			" │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   └─ 47 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v1:1\n" +
-			" │           └─ 25 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 25), [NULL, ∞), [NULL, ∞)}, {(25, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 25), [NULL, ∞), [NULL, ∞)}, {(25, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ GreaterThan\n" +
+			"     │   ├─ comp_index_t1.v1:1\n" +
+			"     │   └─ 47 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v1:1\n" +
+			"             └─ 25 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2355,35 +2278,34 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>65 AND v2>=52) OR (v1<=85)) OR (v1<=64 AND v3=9 AND v2>=36));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │       └─ 65 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       └─ 52 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 85 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 64 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t1.v3:3\n" +
-			" │       │       └─ 9 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 36 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 85], [NULL, ∞), [NULL, ∞)}, {(85, ∞), [52, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 85], [NULL, ∞), [NULL, ∞)}, {(85, ∞), [52, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ NOT\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │       └─ 65 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       └─ 52 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 85 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 64 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t1.v3:3\n" +
+			"         │       └─ 9 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 36 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2404,49 +2326,47 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>0) OR (v1<81 AND v2>=70)) OR (v1>=52));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 0 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThan\n" +
-			" │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   └─ 81 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 70 (tinyint)\n" +
-			" │   └─ GreaterThanOrEqual\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 52 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 0), [NULL, ∞), [NULL, ∞)}, {[0, 0], [70, ∞), [NULL, ∞)}, {(0, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 0), [NULL, ∞), [NULL, ∞)}, {[0, 0], [70, ∞), [NULL, ∞)}, {(0, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 0 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThan\n" +
+			"     │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   └─ 81 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 70 (tinyint)\n" +
+			"     └─ GreaterThanOrEqual\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 52 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>5 AND v3<=32) OR (v1 BETWEEN 77 AND 85 AND v3 BETWEEN 16 AND 21 AND v2 BETWEEN 10 AND 42));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 5 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       └─ 32 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t1.v1:1 BETWEEN 77 (tinyint) AND 85 (tinyint))\n" +
-			" │       │   └─ (comp_index_t1.v3:3 BETWEEN 16 (tinyint) AND 21 (tinyint))\n" +
-			" │       └─ (comp_index_t1.v2:2 BETWEEN 10 (tinyint) AND 42 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(5, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(5, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThan\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 5 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v3:3\n" +
+			"     │       └─ 32 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t1.v1:1 BETWEEN 77 (tinyint) AND 85 (tinyint))\n" +
+			"         │   └─ (comp_index_t1.v3:3 BETWEEN 16 (tinyint) AND 21 (tinyint))\n" +
+			"         └─ (comp_index_t1.v2:2 BETWEEN 10 (tinyint) AND 42 (tinyint))\n" +
 			"",
 	},
 	{
@@ -2467,110 +2387,108 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<77 AND v2<35 AND v3=73) OR (v1=85 AND v2>0 AND v3<65)) AND (v1>=20 AND v3<23 AND v2<=81) OR (v1<34 AND v2<=21 AND v3<=45));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   │   └─ 77 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │   │       └─ 35 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │       └─ 73 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ Eq\n" +
-			" │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │   └─ 85 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       │       └─ 0 (tinyint)\n" +
-			" │   │   │       └─ LessThan\n" +
-			" │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │           └─ 65 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 20 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       │       └─ 23 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 81 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 34 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t1.v2:2\n" +
-			" │       │       └─ 21 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 45 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 34), (NULL, 21], (NULL, 45]}, {[85, 85], (0, 81], (NULL, 23)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 34), (NULL, 21], (NULL, 45]}, {[85, 85], (0, 81], (NULL, 23)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   │   └─ 77 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │   │       └─ 35 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │       └─ 73 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ Eq\n" +
+			"     │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │   └─ 85 (tinyint)\n" +
+			"     │   │       │   └─ GreaterThan\n" +
+			"     │   │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       │       └─ 0 (tinyint)\n" +
+			"     │   │       └─ LessThan\n" +
+			"     │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │           └─ 65 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 20 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t1.v3:3\n" +
+			"     │       │       └─ 23 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 81 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThan\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 34 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t1.v2:2\n" +
+			"         │       └─ 21 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 45 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((((v1<=69) AND (v1>=60 AND v2<18 AND v3=15) OR (v1<=75)) OR (v1>=52 AND v2<10)) OR (v1<37 AND v2<=64)) OR (v1>38 AND v2=27));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   │   └─ 69 (tinyint)\n" +
-			" │   │   │   │   │   └─ AND\n" +
-			" │   │   │   │   │       ├─ AND\n" +
-			" │   │   │   │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │       │   │   └─ 60 (tinyint)\n" +
-			" │   │   │   │   │       │   └─ LessThan\n" +
-			" │   │   │   │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │   │       │       └─ 18 (tinyint)\n" +
-			" │   │   │   │   │       └─ Eq\n" +
-			" │   │   │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │   │           └─ 15 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │       └─ 75 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   └─ 52 (tinyint)\n" +
-			" │   │   │       └─ LessThan\n" +
-			" │   │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │   │           └─ 10 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThan\n" +
-			" │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   └─ 37 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 64 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 38 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 27 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 75], [NULL, ∞), [NULL, ∞)}, {(75, ∞), (NULL, 10), [NULL, ∞)}, {(75, ∞), [27, 27], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 75], [NULL, ∞), [NULL, ∞)}, {(75, ∞), (NULL, 10), [NULL, ∞)}, {(75, ∞), [27, 27], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   │   └─ 69 (tinyint)\n" +
+			"     │   │   │   │   └─ AND\n" +
+			"     │   │   │   │       ├─ AND\n" +
+			"     │   │   │   │       │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │       │   │   └─ 60 (tinyint)\n" +
+			"     │   │   │   │       │   └─ LessThan\n" +
+			"     │   │   │   │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │   │       │       └─ 18 (tinyint)\n" +
+			"     │   │   │   │       └─ Eq\n" +
+			"     │   │   │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │   │           └─ 15 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │       └─ 75 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ GreaterThanOrEqual\n" +
+			"     │   │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   └─ 52 (tinyint)\n" +
+			"     │   │       └─ LessThan\n" +
+			"     │   │           ├─ comp_index_t1.v2:2\n" +
+			"     │   │           └─ 10 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThan\n" +
+			"     │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   └─ 37 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 64 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 38 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 27 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2631,30 +2549,29 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>3 AND v2>32) OR (v1<=26 AND v3>=27 AND v2>=5));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 3 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       └─ 32 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 26 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t1.v3:3\n" +
-			" │       │       └─ 27 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 5 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 3], [5, ∞), [27, ∞)}, {(3, 26], [5, 32], [27, ∞)}, {(3, ∞), (32, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 3], [5, ∞), [27, ∞)}, {(3, 26], [5, 32], [27, ∞)}, {(3, ∞), (32, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThan\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 3 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t1.v2:2\n" +
+			"     │       └─ 32 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 26 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t1.v3:3\n" +
+			"         │       └─ 27 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 5 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2675,45 +2592,44 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>68 AND v2<=57) AND (v1<>84 AND v3 BETWEEN 24 AND 98 AND v2 BETWEEN 28 AND 45) OR (v1>0 AND v2<>47 AND v3>=69)) OR (v1>=44));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │       └─ 68 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 57 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ NOT\n" +
-			" │   │   │       │   │   └─ Eq\n" +
-			" │   │   │       │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │       └─ 84 (tinyint)\n" +
-			" │   │   │       │   └─ (comp_index_t1.v3:3 BETWEEN 24 (tinyint) AND 98 (tinyint))\n" +
-			" │   │   │       └─ (comp_index_t1.v2:2 BETWEEN 28 (tinyint) AND 45 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 0 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t1.v2:2\n" +
-			" │   │       │           └─ 47 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 69 (tinyint)\n" +
-			" │   └─ GreaterThanOrEqual\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 44 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 0], [28, 45], [24, 98]}, {(0, 44), (NULL, 28), [69, ∞)}, {(0, 44), [28, 45], [24, ∞)}, {(0, 44), (45, 47), [69, ∞)}, {(0, 44), (47, ∞), [69, ∞)}, {[44, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 0], [28, 45], [24, 98]}, {(0, 44), (NULL, 28), [69, ∞)}, {(0, 44), [28, 45], [24, ∞)}, {(0, 44), (45, 47), [69, ∞)}, {(0, 44), (47, ∞), [69, ∞)}, {[44, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │       └─ 68 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 57 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ NOT\n" +
+			"     │   │       │   │   └─ Eq\n" +
+			"     │   │       │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │       └─ 84 (tinyint)\n" +
+			"     │   │       │   └─ (comp_index_t1.v3:3 BETWEEN 24 (tinyint) AND 98 (tinyint))\n" +
+			"     │   │       └─ (comp_index_t1.v2:2 BETWEEN 28 (tinyint) AND 45 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThan\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 0 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t1.v2:2\n" +
+			"     │       │           └─ 47 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 69 (tinyint)\n" +
+			"     └─ GreaterThanOrEqual\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 44 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2726,41 +2642,40 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1 BETWEEN 17 AND 52 AND v2<96) OR (v1<=12 AND v2<>4 AND v3>53)) OR (v1<98 AND v3<94 AND v2=5));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t1.v1:1 BETWEEN 17 (tinyint) AND 52 (tinyint))\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       └─ 96 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 12 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t1.v2:2\n" +
-			" │   │       │           └─ 4 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 53 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 98 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t1.v3:3\n" +
-			" │       │       └─ 94 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 5 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 12], (NULL, 4), (53, ∞)}, {(NULL, 12], (4, 5), (53, ∞)}, {(NULL, 12], [5, 5], (NULL, ∞)}, {(NULL, 12], (5, ∞), (53, ∞)}, {(12, 17), [5, 5], (NULL, 94)}, {[17, 52], (NULL, 96), [NULL, ∞)}, {(52, 98), [5, 5], (NULL, 94)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 12], (NULL, 4), (53, ∞)}, {(NULL, 12], (4, 5), (53, ∞)}, {(NULL, 12], [5, 5], (NULL, ∞)}, {(NULL, 12], (5, ∞), (53, ∞)}, {(12, 17), [5, 5], (NULL, 94)}, {[17, 52], (NULL, 96), [NULL, ∞)}, {(52, 98), [5, 5], (NULL, 94)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ (comp_index_t1.v1:1 BETWEEN 17 (tinyint) AND 52 (tinyint))\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       └─ 96 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 12 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t1.v2:2\n" +
+			"     │       │           └─ 4 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 53 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThan\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 98 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t1.v3:3\n" +
+			"         │       └─ 94 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 5 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2821,28 +2736,27 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1=95 AND v3<47 AND v2>=97) OR (v1 BETWEEN 11 AND 36 AND v2<=83));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 95 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │       └─ 47 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       └─ 97 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t1.v1:1 BETWEEN 11 (tinyint) AND 36 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 83 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[11, 36], (NULL, 83], [NULL, ∞)}, {[95, 95], [97, ∞), (NULL, 47)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[11, 36], (NULL, 83], [NULL, ∞)}, {[95, 95], [97, ∞), (NULL, 47)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 95 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │       └─ 47 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v2:2\n" +
+			"     │       └─ 97 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t1.v1:1 BETWEEN 11 (tinyint) AND 36 (tinyint))\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 83 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2855,76 +2769,74 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1>71 AND v2=33) OR (v1<>85 AND v2<>50 AND v3 BETWEEN 34 AND 67)) OR (v1 BETWEEN 5 AND 47 AND v3 BETWEEN 13 AND 76 AND v2=4)) OR (v1=16 AND v2>=29 AND v3<>80));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 71 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 33 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ NOT\n" +
-			" │   │   │       │   │   └─ Eq\n" +
-			" │   │   │       │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │       └─ 85 (tinyint)\n" +
-			" │   │   │       │   └─ NOT\n" +
-			" │   │   │       │       └─ Eq\n" +
-			" │   │   │       │           ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       │           └─ 50 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t1.v3:3 BETWEEN 34 (tinyint) AND 67 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ (comp_index_t1.v1:1 BETWEEN 5 (tinyint) AND 47 (tinyint))\n" +
-			" │   │       │   └─ (comp_index_t1.v3:3 BETWEEN 13 (tinyint) AND 76 (tinyint))\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 4 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ Eq\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 16 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t1.v2:2\n" +
-			" │       │       └─ 29 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t1.v3:3\n" +
-			" │               └─ 80 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 5), (NULL, 50), [34, 67]}, {(NULL, 16), (50, ∞), [34, 67]}, {[5, 16), (4, 50), [34, 67]}, {[5, 47], (NULL, 4), [34, 67]}, {[5, 47], [4, 4], [13, 76]}, {[16, 16], (4, 29), [34, 67]}, {[16, 16], [29, ∞), (NULL, 80)}, {[16, 16], [29, ∞), (80, ∞)}, {(16, 47], (4, 50), [34, 67]}, {(16, 85), (50, ∞), [34, 67]}, {(47, 71], (NULL, 50), [34, 67]}, {(71, 85), (NULL, 33), [34, 67]}, {(71, 85), (33, 50), [34, 67]}, {(71, ∞), [33, 33], [NULL, ∞)}, {(85, ∞), (NULL, 33), [34, 67]}, {(85, ∞), (33, 50), [34, 67]}, {(85, ∞), (50, ∞), [34, 67]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 5), (NULL, 50), [34, 67]}, {(NULL, 16), (50, ∞), [34, 67]}, {[5, 16), (4, 50), [34, 67]}, {[5, 47], (NULL, 4), [34, 67]}, {[5, 47], [4, 4], [13, 76]}, {[16, 16], (4, 29), [34, 67]}, {[16, 16], [29, ∞), (NULL, 80)}, {[16, 16], [29, ∞), (80, ∞)}, {(16, 47], (4, 50), [34, 67]}, {(16, 85), (50, ∞), [34, 67]}, {(47, 71], (NULL, 50), [34, 67]}, {(71, 85), (NULL, 33), [34, 67]}, {(71, 85), (33, 50), [34, 67]}, {(71, ∞), [33, 33], [NULL, ∞)}, {(85, ∞), (NULL, 33), [34, 67]}, {(85, ∞), (33, 50), [34, 67]}, {(85, ∞), (50, ∞), [34, 67]}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 71 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 33 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ NOT\n" +
+			"     │   │       │   │   └─ Eq\n" +
+			"     │   │       │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │       └─ 85 (tinyint)\n" +
+			"     │   │       │   └─ NOT\n" +
+			"     │   │       │       └─ Eq\n" +
+			"     │   │       │           ├─ comp_index_t1.v2:2\n" +
+			"     │   │       │           └─ 50 (tinyint)\n" +
+			"     │   │       └─ (comp_index_t1.v3:3 BETWEEN 34 (tinyint) AND 67 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ (comp_index_t1.v1:1 BETWEEN 5 (tinyint) AND 47 (tinyint))\n" +
+			"     │       │   └─ (comp_index_t1.v3:3 BETWEEN 13 (tinyint) AND 76 (tinyint))\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 4 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ Eq\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 16 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t1.v2:2\n" +
+			"         │       └─ 29 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t1.v3:3\n" +
+			"                 └─ 80 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<=17 AND v2>38) AND (v1>=79) OR (v1<>38));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 17 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       └─ 38 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 79 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v1:1\n" +
-			" │           └─ 38 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 38), [NULL, ∞), [NULL, ∞)}, {(38, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 38), [NULL, ∞), [NULL, ∞)}, {(38, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 17 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       └─ 38 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 79 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v1:1\n" +
+			"             └─ 38 (tinyint)\n" +
 			"",
 	},
 	{
@@ -2937,27 +2849,26 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>50) OR (v1<=58 AND v2<=95)) OR (v1=10));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 50 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   └─ 58 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 95 (tinyint)\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 10 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 50), [NULL, ∞), [NULL, ∞)}, {[50, 50], (NULL, 95], [NULL, ∞)}, {(50, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 50), [NULL, ∞), [NULL, ∞)}, {[50, 50], (NULL, 95], [NULL, ∞)}, {(50, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 50 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   └─ 58 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 95 (tinyint)\n" +
+			"     └─ Eq\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 10 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3034,28 +2945,27 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>48 AND v2<=80) OR (v1=72 AND v3 BETWEEN 45 AND 52 AND v2=98));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 48 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       └─ 80 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ Eq\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 72 (tinyint)\n" +
-			" │       │   └─ (comp_index_t1.v3:3 BETWEEN 45 (tinyint) AND 52 (tinyint))\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 98 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(48, ∞), (NULL, 80], [NULL, ∞)}, {[72, 72], [98, 98], [45, 52]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(48, ∞), (NULL, 80], [NULL, ∞)}, {[72, 72], [98, 98], [45, 52]}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThan\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 48 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v2:2\n" +
+			"     │       └─ 80 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ Eq\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 72 (tinyint)\n" +
+			"         │   └─ (comp_index_t1.v3:3 BETWEEN 45 (tinyint) AND 52 (tinyint))\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 98 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3076,20 +2986,19 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>19) OR (v1<>48));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 19 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v1:1\n" +
-			" │           └─ 48 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 19 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v1:1\n" +
+			"             └─ 48 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3126,31 +3035,30 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<37 AND v3>77) OR (v1>38 AND v3<>57 AND v2=87));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 37 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       └─ 77 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThan\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 38 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t1.v3:3\n" +
-			" │       │           └─ 57 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 87 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 37), [NULL, ∞), [NULL, ∞)}, {(38, ∞), [87, 87], (NULL, 57)}, {(38, ∞), [87, 87], (57, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 37), [NULL, ∞), [NULL, ∞)}, {(38, ∞), [87, 87], (NULL, 57)}, {(38, ∞), [87, 87], (57, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ LessThan\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 37 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t1.v3:3\n" +
+			"     │       └─ 77 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThan\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 38 (tinyint)\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t1.v3:3\n" +
+			"         │           └─ 57 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 87 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3163,28 +3071,27 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>23 AND v3<=52) OR (v1<>19 AND v2=25));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 23 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       └─ 52 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t1.v1:1\n" +
-			" │       │       └─ 19 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 25 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 23), [NULL, ∞), [NULL, ∞)}, {[23, 23], [25, 25], [NULL, ∞)}, {(23, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 23), [NULL, ∞), [NULL, ∞)}, {[23, 23], [25, 25], [NULL, ∞)}, {(23, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 23 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v3:3\n" +
+			"     │       └─ 52 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t1.v1:1\n" +
+			"         │       └─ 19 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 25 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3213,34 +3120,33 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1=61 AND v2 BETWEEN 10 AND 22 AND v3<34) OR (v1=68)) OR (v1<=97 AND v3 BETWEEN 7 AND 63 AND v2<67));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 61 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 10 (tinyint) AND 22 (tinyint))\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │       └─ 34 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 68 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 97 (tinyint)\n" +
-			" │       │   └─ (comp_index_t1.v3:3 BETWEEN 7 (tinyint) AND 63 (tinyint))\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 67 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 61), (NULL, 67), [7, 63]}, {[61, 61], (NULL, 10), [7, 63]}, {[61, 61], [10, 22], (NULL, 63]}, {[61, 61], (22, 67), [7, 63]}, {(61, 68), (NULL, 67), [7, 63]}, {[68, 68], [NULL, ∞), [NULL, ∞)}, {(68, 97], (NULL, 67), [7, 63]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 61), (NULL, 67), [7, 63]}, {[61, 61], (NULL, 10), [7, 63]}, {[61, 61], [10, 22], (NULL, 63]}, {[61, 61], (22, 67), [7, 63]}, {(61, 68), (NULL, 67), [7, 63]}, {[68, 68], [NULL, ∞), [NULL, ∞)}, {(68, 97], (NULL, 67), [7, 63]}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 61 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 10 (tinyint) AND 22 (tinyint))\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │       └─ 34 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 68 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 97 (tinyint)\n" +
+			"         │   └─ (comp_index_t1.v3:3 BETWEEN 7 (tinyint) AND 63 (tinyint))\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 67 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3269,50 +3175,49 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((((v1>=26) OR (v1>=13 AND v2 BETWEEN 35 AND 95 AND v3>=29)) OR (v1<>54 AND v2 BETWEEN 0 AND 54)) OR (v1 BETWEEN 17 AND 17 AND v2<=71)) OR (v1>50 AND v3>=42)) OR (v1<>0));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ Or\n" +
-			" │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   │   └─ 26 (tinyint)\n" +
-			" │   │   │   │   │   └─ AND\n" +
-			" │   │   │   │   │       ├─ AND\n" +
-			" │   │   │   │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │       │   │   └─ 13 (tinyint)\n" +
-			" │   │   │   │   │       │   └─ (comp_index_t1.v2:2 BETWEEN 35 (tinyint) AND 95 (tinyint))\n" +
-			" │   │   │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │   │           └─ 29 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ NOT\n" +
-			" │   │   │   │       │   └─ Eq\n" +
-			" │   │   │   │       │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │       │       └─ 54 (tinyint)\n" +
-			" │   │   │   │       └─ (comp_index_t1.v2:2 BETWEEN 0 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ (comp_index_t1.v1:1 BETWEEN 17 (tinyint) AND 17 (tinyint))\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │   │           └─ 71 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThan\n" +
-			" │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   └─ 50 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 42 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v1:1\n" +
-			" │           └─ 0 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 0), [NULL, ∞), [NULL, ∞)}, {[0, 0], [0, 54], [NULL, ∞)}, {(0, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 0), [NULL, ∞), [NULL, ∞)}, {[0, 0], [0, 54], [NULL, ∞)}, {(0, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ Or\n" +
+			"     │   │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   │   └─ 26 (tinyint)\n" +
+			"     │   │   │   │   └─ AND\n" +
+			"     │   │   │   │       ├─ AND\n" +
+			"     │   │   │   │       │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │       │   │   └─ 13 (tinyint)\n" +
+			"     │   │   │   │       │   └─ (comp_index_t1.v2:2 BETWEEN 35 (tinyint) AND 95 (tinyint))\n" +
+			"     │   │   │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │   │           └─ 29 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ NOT\n" +
+			"     │   │   │       │   └─ Eq\n" +
+			"     │   │   │       │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │       │       └─ 54 (tinyint)\n" +
+			"     │   │   │       └─ (comp_index_t1.v2:2 BETWEEN 0 (tinyint) AND 54 (tinyint))\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ (comp_index_t1.v1:1 BETWEEN 17 (tinyint) AND 17 (tinyint))\n" +
+			"     │   │       └─ LessThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t1.v2:2\n" +
+			"     │   │           └─ 71 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ GreaterThan\n" +
+			"     │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   └─ 50 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 42 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v1:1\n" +
+			"             └─ 0 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3325,31 +3230,30 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>9 AND v2<74) AND (v1<=63 AND v2=18) OR (v1<46));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │       └─ 9 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       └─ 74 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   └─ 63 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 18 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 46), [NULL, ∞), [NULL, ∞)}, {[46, 63], [18, 18], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 46), [NULL, ∞), [NULL, ∞)}, {[46, 63], [18, 18], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ NOT\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │       └─ 9 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       └─ 74 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   └─ 63 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 18 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 46 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3362,142 +3266,138 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1<=55 AND v2 BETWEEN 82 AND 96 AND v3>=13) OR (v1>=89 AND v2<18 AND v3<19)) OR (v1=98 AND v3>=40)) OR (v1 BETWEEN 7 AND 74 AND v2<=73));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   │   └─ 55 (tinyint)\n" +
-			" │   │   │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 82 (tinyint) AND 96 (tinyint))\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │       └─ 13 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │   └─ 89 (tinyint)\n" +
-			" │   │   │       │   └─ LessThan\n" +
-			" │   │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       │       └─ 18 (tinyint)\n" +
-			" │   │   │       └─ LessThan\n" +
-			" │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │           └─ 19 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ Eq\n" +
-			" │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   └─ 98 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 40 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t1.v1:1 BETWEEN 7 (tinyint) AND 74 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 73 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 55], [82, 96], [13, ∞)}, {[7, 74], (NULL, 73], [NULL, ∞)}, {[89, 98), (NULL, 18), (NULL, 19)}, {[98, 98], [NULL, ∞), [NULL, ∞)}, {(98, ∞), (NULL, 18), (NULL, 19)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
-			"",
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 55], [82, 96], [13, ∞)}, {[7, 74], (NULL, 73], [NULL, ∞)}, {[89, 98), (NULL, 18), (NULL, 19)}, {[98, 98], [NULL, ∞), [NULL, ∞)}, {(98, ∞), (NULL, 18), (NULL, 19)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   │   └─ 55 (tinyint)\n" +
+			"     │   │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 82 (tinyint) AND 96 (tinyint))\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │       └─ 13 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ GreaterThanOrEqual\n" +
+			"     │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │   └─ 89 (tinyint)\n" +
+			"     │   │       │   └─ LessThan\n" +
+			"     │   │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       │       └─ 18 (tinyint)\n" +
+			"     │   │       └─ LessThan\n" +
+			"     │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │           └─ 19 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ Eq\n" +
+			"     │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   └─ 98 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 40 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t1.v1:1 BETWEEN 7 (tinyint) AND 74 (tinyint))\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 73 (tinyint)\n" +
+			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=26 AND v2 BETWEEN 6 AND 80) AND (v1=47 AND v2<67 AND v3<7) OR (v1>63));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 26 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 6 (tinyint) AND 80 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ Eq\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 47 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       │       └─ 67 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 7 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 63 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[47, 47], [6, 67), (NULL, 7)}, {(63, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[47, 47], [6, 67), (NULL, 7)}, {(63, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 26 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t1.v2:2 BETWEEN 6 (tinyint) AND 80 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ Eq\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 47 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │       │       └─ 67 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 7 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 63 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<11) OR (v1<>33));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ LessThan\n" +
-			" │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   └─ 11 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v1:1\n" +
-			" │           └─ 33 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 33), [NULL, ∞), [NULL, ∞)}, {(33, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 33), [NULL, ∞), [NULL, ∞)}, {(33, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ LessThan\n" +
+			"     │   ├─ comp_index_t1.v1:1\n" +
+			"     │   └─ 11 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v1:1\n" +
+			"             └─ 33 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1<=35) AND (v1=44 AND v2<78 AND v3>=40) OR (v1<>88 AND v2=8)) AND (v1>=99 AND v2=62) OR (v1<=94)) OR (v1 BETWEEN 22 AND 23 AND v2 BETWEEN 14 AND 46));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   │   └─ 35 (tinyint)\n" +
-			" │   │   │   │   │   └─ AND\n" +
-			" │   │   │   │   │       ├─ AND\n" +
-			" │   │   │   │   │       │   ├─ Eq\n" +
-			" │   │   │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │       │   │   └─ 44 (tinyint)\n" +
-			" │   │   │   │   │       │   └─ LessThan\n" +
-			" │   │   │   │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │   │       │       └─ 78 (tinyint)\n" +
-			" │   │   │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │   │           └─ 40 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ NOT\n" +
-			" │   │   │   │       │   └─ Eq\n" +
-			" │   │   │   │       │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │       │       └─ 88 (tinyint)\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │           └─ 8 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   └─ 99 (tinyint)\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │   │           └─ 62 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 94 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t1.v1:1 BETWEEN 22 (tinyint) AND 23 (tinyint))\n" +
-			" │       └─ (comp_index_t1.v2:2 BETWEEN 14 (tinyint) AND 46 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 94], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 94], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   │   └─ 35 (tinyint)\n" +
+			"     │   │   │   │   └─ AND\n" +
+			"     │   │   │   │       ├─ AND\n" +
+			"     │   │   │   │       │   ├─ Eq\n" +
+			"     │   │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │       │   │   └─ 44 (tinyint)\n" +
+			"     │   │   │   │       │   └─ LessThan\n" +
+			"     │   │   │   │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │   │       │       └─ 78 (tinyint)\n" +
+			"     │   │   │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │   │           └─ 40 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ NOT\n" +
+			"     │   │   │       │   └─ Eq\n" +
+			"     │   │   │       │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │       │       └─ 88 (tinyint)\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │           └─ 8 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ GreaterThanOrEqual\n" +
+			"     │   │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   └─ 99 (tinyint)\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t1.v2:2\n" +
+			"     │   │           └─ 62 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 94 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t1.v1:1 BETWEEN 22 (tinyint) AND 23 (tinyint))\n" +
+			"         └─ (comp_index_t1.v2:2 BETWEEN 14 (tinyint) AND 46 (tinyint))\n" +
 			"",
 	},
 	{
@@ -3526,39 +3426,38 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>77) OR (v1<=54 AND v2<=71 AND v3>=49)) OR (v1>54 AND v2<30 AND v3=6));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 77 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 54 (tinyint)\n" +
-			" │   │       │   └─ LessThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       │       └─ 71 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 49 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThan\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 54 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t1.v2:2\n" +
-			" │       │       └─ 30 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 6 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 77), [NULL, ∞), [NULL, ∞)}, {[77, 77], (NULL, 30), [6, 6]}, {(77, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 77), [NULL, ∞), [NULL, ∞)}, {[77, 77], (NULL, 30), [6, 6]}, {(77, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 77 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 54 (tinyint)\n" +
+			"     │       │   └─ LessThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │       │       └─ 71 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 49 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThan\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 54 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t1.v2:2\n" +
+			"         │       └─ 30 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 6 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3571,19 +3470,18 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>50) OR (v1<=71));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 50 (tinyint)\n" +
-			" │   └─ LessThanOrEqual\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 50 (tinyint)\n" +
+			"     └─ LessThanOrEqual\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 71 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3596,105 +3494,102 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<88 AND v2<91 AND v3>9) AND (v1>=5 AND v2 BETWEEN 21 AND 29 AND v3>18) OR (v1>=40));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 88 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 91 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │       └─ 9 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 5 (tinyint)\n" +
-			" │   │       │   └─ (comp_index_t1.v2:2 BETWEEN 21 (tinyint) AND 29 (tinyint))\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 18 (tinyint)\n" +
-			" │   └─ GreaterThanOrEqual\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 40 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[5, 40), [21, 29], (18, ∞)}, {[40, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[5, 40), [21, 29], (18, ∞)}, {[40, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 88 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 91 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │       └─ 9 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 5 (tinyint)\n" +
+			"     │       │   └─ (comp_index_t1.v2:2 BETWEEN 21 (tinyint) AND 29 (tinyint))\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 18 (tinyint)\n" +
+			"     └─ GreaterThanOrEqual\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 40 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>2 AND v2<76 AND v3<=35) OR (v1<=12 AND v3 BETWEEN 25 AND 30));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 2 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       └─ 76 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       └─ 35 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThanOrEqual\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 12 (tinyint)\n" +
-			" │       └─ (comp_index_t1.v3:3 BETWEEN 25 (tinyint) AND 30 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 12], [NULL, ∞), [NULL, ∞)}, {(12, ∞), (NULL, 76), (NULL, 35]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 12], [NULL, ∞), [NULL, ∞)}, {(12, ∞), (NULL, 76), (NULL, 35]}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThan\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 2 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       └─ 76 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v3:3\n" +
+			"     │       └─ 35 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThanOrEqual\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 12 (tinyint)\n" +
+			"         └─ (comp_index_t1.v3:3 BETWEEN 25 (tinyint) AND 30 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1 BETWEEN 25 AND 84 AND v2<=94) OR (v1>66 AND v2>4 AND v3>=57)) OR (v1=78 AND v2>66 AND v3=19)) OR (v1<>48));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t1.v1:1 BETWEEN 25 (tinyint) AND 84 (tinyint))\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 94 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │   └─ 66 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       │       └─ 4 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │           └─ 57 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ Eq\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 78 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       │       └─ 66 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 19 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v1:1\n" +
-			" │           └─ 48 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 48), [NULL, ∞), [NULL, ∞)}, {[48, 48], (NULL, 94], [NULL, ∞)}, {(48, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 48), [NULL, ∞), [NULL, ∞)}, {[48, 48], (NULL, 94], [NULL, ∞)}, {(48, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t1.v1:1 BETWEEN 25 (tinyint) AND 84 (tinyint))\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 94 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ GreaterThan\n" +
+			"     │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │   └─ 66 (tinyint)\n" +
+			"     │   │       │   └─ GreaterThan\n" +
+			"     │   │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       │       └─ 4 (tinyint)\n" +
+			"     │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │           └─ 57 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ Eq\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 78 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │       │       └─ 66 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 19 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v1:1\n" +
+			"             └─ 48 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3731,38 +3626,37 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=43 AND v2<>39) AND (v1<=32 AND v2<=15 AND v3>=54) OR (v1<>68 AND v2 BETWEEN 42 AND 46));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 43 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │   │           └─ 39 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 32 (tinyint)\n" +
-			" │   │       │   └─ LessThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       │       └─ 15 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 54 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t1.v1:1\n" +
-			" │       │       └─ 68 (tinyint)\n" +
-			" │       └─ (comp_index_t1.v2:2 BETWEEN 42 (tinyint) AND 46 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 68), [42, 46], [NULL, ∞)}, {(68, ∞), [42, 46], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 68), [42, 46], [NULL, ∞)}, {(68, ∞), [42, 46], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 43 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t1.v2:2\n" +
+			"     │   │           └─ 39 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 32 (tinyint)\n" +
+			"     │       │   └─ LessThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │       │       └─ 15 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 54 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t1.v1:1\n" +
+			"         │       └─ 68 (tinyint)\n" +
+			"         └─ (comp_index_t1.v2:2 BETWEEN 42 (tinyint) AND 46 (tinyint))\n" +
 			"",
 	},
 	{
@@ -3791,41 +3685,40 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>45) OR (v1>=91 AND v2>=8 AND v3<=38)) OR (v1<>58 AND v3<=32 AND v2<>45));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 45 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 91 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       │       └─ 8 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 38 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t1.v1:1\n" +
-			" │       │   │       └─ 58 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t1.v3:3\n" +
-			" │       │       └─ 32 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t1.v2:2\n" +
-			" │               └─ 45 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 45), [NULL, ∞), [NULL, ∞)}, {[45, 45], (NULL, 45), (NULL, 32]}, {[45, 45], (45, ∞), (NULL, 32]}, {(45, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 45), [NULL, ∞), [NULL, ∞)}, {[45, 45], (NULL, 45), (NULL, 32]}, {[45, 45], (45, ∞), (NULL, 32]}, {(45, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 45 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 91 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │       │       └─ 8 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 38 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t1.v1:1\n" +
+			"         │   │       └─ 58 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t1.v3:3\n" +
+			"         │       └─ 32 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t1.v2:2\n" +
+			"                 └─ 45 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3886,25 +3779,24 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<1 AND v3<=34) OR (v1 BETWEEN 2 AND 57 AND v2<>70));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 1 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       └─ 34 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t1.v1:1 BETWEEN 2 (tinyint) AND 57 (tinyint))\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t1.v2:2\n" +
-			" │               └─ 70 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 1), [NULL, ∞), [NULL, ∞)}, {[2, 57], (NULL, 70), [NULL, ∞)}, {[2, 57], (70, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 1), [NULL, ∞), [NULL, ∞)}, {[2, 57], (NULL, 70), [NULL, ∞)}, {[2, 57], (70, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ LessThan\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 1 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v3:3\n" +
+			"     │       └─ 34 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t1.v1:1 BETWEEN 2 (tinyint) AND 57 (tinyint))\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t1.v2:2\n" +
+			"                 └─ 70 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3917,39 +3809,38 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1<=93 AND v3<>47) OR (v1>=93 AND v2 BETWEEN 15 AND 42 AND v3<=6)) OR (v1>15)) OR (v1 BETWEEN 0 AND 1 AND v2>33));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 93 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │           └─ 47 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │   └─ 93 (tinyint)\n" +
-			" │   │   │       │   └─ (comp_index_t1.v2:2 BETWEEN 15 (tinyint) AND 42 (tinyint))\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │           └─ 6 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 15 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t1.v1:1 BETWEEN 0 (tinyint) AND 1 (tinyint))\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 33 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 93 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │           └─ 47 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ GreaterThanOrEqual\n" +
+			"     │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │   └─ 93 (tinyint)\n" +
+			"     │   │       │   └─ (comp_index_t1.v2:2 BETWEEN 15 (tinyint) AND 42 (tinyint))\n" +
+			"     │   │       └─ LessThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │           └─ 6 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 15 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t1.v1:1 BETWEEN 0 (tinyint) AND 1 (tinyint))\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 33 (tinyint)\n" +
 			"",
 	},
 	{
@@ -3994,35 +3885,34 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>63) AND (v1<=44 AND v2<>43 AND v3=29) OR (v1=38 AND v2>45));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 63 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 44 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t1.v2:2\n" +
-			" │   │       │           └─ 43 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 29 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 38 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 45 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[38, 38], (45, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[38, 38], (45, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThan\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 63 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 44 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t1.v2:2\n" +
+			"     │       │           └─ 43 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 29 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 38 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 45 (tinyint)\n" +
 			"",
 	},
 	{
@@ -4043,72 +3933,70 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<50) AND (v1<19 AND v2>=10) OR (v1<36 AND v2>10 AND v3<>65));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 50 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThan\n" +
-			" │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   └─ 19 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 10 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 36 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t1.v2:2\n" +
-			" │       │       └─ 10 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t1.v3:3\n" +
-			" │               └─ 65 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 19), [10, ∞), [NULL, ∞)}, {[19, 36), (10, ∞), (NULL, 65)}, {[19, 36), (10, ∞), (65, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 19), [10, ∞), [NULL, ∞)}, {[19, 36), (10, ∞), (NULL, 65)}, {[19, 36), (10, ∞), (65, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ LessThan\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 50 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThan\n" +
+			"     │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   └─ 19 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 10 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThan\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 36 (tinyint)\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t1.v2:2\n" +
+			"         │       └─ 10 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t1.v3:3\n" +
+			"                 └─ 65 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1=56 AND v3<=4 AND v2=46) OR (v1 BETWEEN 21 AND 53 AND v2<>63)) OR (v1 BETWEEN 10 AND 62 AND v2>=62)) OR (v1>31));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   │   └─ 56 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │   │       └─ 4 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 46 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ (comp_index_t1.v1:1 BETWEEN 21 (tinyint) AND 53 (tinyint))\n" +
-			" │   │   │       └─ NOT\n" +
-			" │   │   │           └─ Eq\n" +
-			" │   │   │               ├─ comp_index_t1.v2:2\n" +
-			" │   │   │               └─ 63 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t1.v1:1 BETWEEN 10 (tinyint) AND 62 (tinyint))\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 62 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[10, 21), [62, ∞), [NULL, ∞)}, {[21, 31], (NULL, ∞), [NULL, ∞)}, {(31, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[10, 21), [62, ∞), [NULL, ∞)}, {[21, 31], (NULL, ∞), [NULL, ∞)}, {(31, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   │   └─ 56 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │   │       └─ 4 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 46 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ (comp_index_t1.v1:1 BETWEEN 21 (tinyint) AND 53 (tinyint))\n" +
+			"     │   │       └─ NOT\n" +
+			"     │   │           └─ Eq\n" +
+			"     │   │               ├─ comp_index_t1.v2:2\n" +
+			"     │   │               └─ 63 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ (comp_index_t1.v1:1 BETWEEN 10 (tinyint) AND 62 (tinyint))\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 62 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 31 (tinyint)\n" +
 			"",
 	},
 	{
@@ -4121,36 +4009,35 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<>51) AND (v1<>4 AND v2<47 AND v3>=77) OR (v1>41 AND v3>62));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 51 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ NOT\n" +
-			" │   │       │   │   └─ Eq\n" +
-			" │   │       │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │       └─ 4 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       │       └─ 47 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │           └─ 77 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 41 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 4), (NULL, 47), [77, ∞)}, {(4, 41], (NULL, 47), [77, ∞)}, {(41, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 4), (NULL, 47), [77, ∞)}, {(4, 41], (NULL, 47), [77, ∞)}, {(41, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 51 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ NOT\n" +
+			"     │       │   │   └─ Eq\n" +
+			"     │       │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │       └─ 4 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │       │       └─ 47 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v3:3\n" +
+			"     │           └─ 77 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 41 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 62 (tinyint)\n" +
 			"",
 	},
 	{
@@ -4195,34 +4082,33 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=46) AND (v1<22 AND v2<>42 AND v3<>54) OR (v1>=55 AND v2 BETWEEN 11 AND 84));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 46 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 22 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t1.v2:2\n" +
-			" │   │       │           └─ 42 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t1.v3:3\n" +
-			" │   │               └─ 54 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 55 (tinyint)\n" +
-			" │       └─ (comp_index_t1.v2:2 BETWEEN 11 (tinyint) AND 84 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[55, ∞), [11, 84], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[55, ∞), [11, 84], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 46 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 22 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t1.v2:2\n" +
+			"     │       │           └─ 42 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t1.v3:3\n" +
+			"     │               └─ 54 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 55 (tinyint)\n" +
+			"         └─ (comp_index_t1.v2:2 BETWEEN 11 (tinyint) AND 84 (tinyint))\n" +
 			"",
 	},
 	{
@@ -4243,42 +4129,41 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1=51 AND v2<=9) OR (v1<>50)) OR (v1<>4 AND v2>56)) OR (v1 BETWEEN 3 AND 18 AND v2>10 AND v3=12));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 51 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 9 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t1.v1:1\n" +
-			" │   │   │           └─ 50 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ NOT\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       │       └─ 4 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 56 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t1.v1:1 BETWEEN 3 (tinyint) AND 18 (tinyint))\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t1.v2:2\n" +
-			" │       │       └─ 10 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 12 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 50), [NULL, ∞), [NULL, ∞)}, {[50, 50], (56, ∞), [NULL, ∞)}, {(50, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 50), [NULL, ∞), [NULL, ∞)}, {[50, 50], (56, ∞), [NULL, ∞)}, {(50, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 51 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 9 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t1.v1:1\n" +
+			"     │   │           └─ 50 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ NOT\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t1.v1:1\n" +
+			"     │       │       └─ 4 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 56 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t1.v1:1 BETWEEN 3 (tinyint) AND 18 (tinyint))\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t1.v2:2\n" +
+			"         │       └─ 10 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 12 (tinyint)\n" +
 			"",
 	},
 	{
@@ -4291,25 +4176,24 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1 BETWEEN 50 AND 59) OR (v1>=23 AND v3>=87 AND v2<>46));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ (comp_index_t1.v1:1 BETWEEN 50 (tinyint) AND 59 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 23 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t1.v3:3\n" +
-			" │       │       └─ 87 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t1.v2:2\n" +
-			" │               └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[23, 50), (NULL, 46), [87, ∞)}, {[23, 50), (46, ∞), [87, ∞)}, {[50, 59], [NULL, ∞), [NULL, ∞)}, {(59, ∞), (NULL, 46), [87, ∞)}, {(59, ∞), (46, ∞), [87, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[23, 50), (NULL, 46), [87, ∞)}, {[23, 50), (46, ∞), [87, ∞)}, {[50, 59], [NULL, ∞), [NULL, ∞)}, {(59, ∞), (NULL, 46), [87, ∞)}, {(59, ∞), (46, ∞), [87, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ (comp_index_t1.v1:1 BETWEEN 50 (tinyint) AND 59 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 23 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t1.v3:3\n" +
+			"         │       └─ 87 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t1.v2:2\n" +
+			"                 └─ 46 (tinyint)\n" +
 			"",
 	},
 	{
@@ -4322,140 +4206,135 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>=16 AND v2 BETWEEN 66 AND 94) OR (v1>70 AND v2<=3)) AND (v1<>91) OR (v1=17 AND v2>=7));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 16 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 66 (tinyint) AND 94 (tinyint))\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ GreaterThan\n" +
-			" │   │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   └─ 70 (tinyint)\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │   │           └─ 3 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v1:1\n" +
-			" │   │           └─ 91 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 17 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 7 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[16, 17), [66, 94], [NULL, ∞)}, {[17, 17], [7, ∞), [NULL, ∞)}, {(17, 91), [66, 94], [NULL, ∞)}, {(70, 91), (NULL, 3], [NULL, ∞)}, {(91, ∞), (NULL, 3], [NULL, ∞)}, {(91, ∞), [66, 94], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[16, 17), [66, 94], [NULL, ∞)}, {[17, 17], [7, ∞), [NULL, ∞)}, {(17, 91), [66, 94], [NULL, ∞)}, {(70, 91), (NULL, 3], [NULL, ∞)}, {(91, ∞), (NULL, 3], [NULL, ∞)}, {(91, ∞), [66, 94], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 16 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 66 (tinyint) AND 94 (tinyint))\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ GreaterThan\n" +
+			"     │   │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   └─ 70 (tinyint)\n" +
+			"     │   │       └─ LessThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t1.v2:2\n" +
+			"     │   │           └─ 3 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v1:1\n" +
+			"     │           └─ 91 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 17 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 7 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1<29 AND v3>=33 AND v2=43) OR (v1<59));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 29 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │       └─ 33 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       └─ 43 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 59), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 59), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThan\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 29 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │       └─ 33 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t1.v2:2\n" +
+			"     │       └─ 43 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 59 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>19 AND v2>84 AND v3>94) OR (v1>=42 AND v3=41));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 19 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       └─ 84 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       └─ 94 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 42 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(19, 42), (84, ∞), (94, ∞)}, {[42, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(19, 42), (84, ∞), (94, ∞)}, {[42, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThan\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 19 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       └─ 84 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t1.v3:3\n" +
+			"     │       └─ 94 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 42 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 41 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>=8 AND v2<=97 AND v3>=77) OR (v1<>4)) OR (v1<=41));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 8 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 97 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │       └─ 77 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v1:1\n" +
-			" │   │           └─ 4 (tinyint)\n" +
-			" │   └─ LessThanOrEqual\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 8 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 97 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │       └─ 77 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v1:1\n" +
+			"     │           └─ 4 (tinyint)\n" +
+			"     └─ LessThanOrEqual\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 41 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>33) OR (v1<=28)) OR (v1<>68));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 33 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 28 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v1:1\n" +
-			" │           └─ 68 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 33 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 28 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v1:1\n" +
+			"             └─ 68 (tinyint)\n" +
 			"",
 	},
 	{
@@ -4484,28 +4363,27 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1=60 AND v3 BETWEEN 2 AND 13 AND v2 BETWEEN 10 AND 69) OR (v1 BETWEEN 1 AND 49)) OR (v1=8 AND v2<26));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 60 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t1.v3:3 BETWEEN 2 (tinyint) AND 13 (tinyint))\n" +
-			" │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 10 (tinyint) AND 69 (tinyint))\n" +
-			" │   │   └─ (comp_index_t1.v1:1 BETWEEN 1 (tinyint) AND 49 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 8 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 26 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[1, 49], [NULL, ∞), [NULL, ∞)}, {[60, 60], [10, 69], [2, 13]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[1, 49], [NULL, ∞), [NULL, ∞)}, {[60, 60], [10, 69], [2, 13]}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 60 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t1.v3:3 BETWEEN 2 (tinyint) AND 13 (tinyint))\n" +
+			"     │   │   └─ (comp_index_t1.v2:2 BETWEEN 10 (tinyint) AND 69 (tinyint))\n" +
+			"     │   └─ (comp_index_t1.v1:1 BETWEEN 1 (tinyint) AND 49 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 8 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 26 (tinyint)\n" +
 			"",
 	},
 	{
@@ -4526,96 +4404,93 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1>=24 AND v2=62) OR (v1<=24 AND v3<>22 AND v2 BETWEEN 12 AND 25)) OR (v1 BETWEEN 48 AND 49 AND v3>=90)) AND (v1<15 AND v2<>55 AND v3=51);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 24 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       └─ 62 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 24 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t1.v3:3\n" +
-			" │   │       │           └─ 22 (tinyint)\n" +
-			" │   │       └─ (comp_index_t1.v2:2 BETWEEN 12 (tinyint) AND 25 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t1.v1:1 BETWEEN 48 (tinyint) AND 49 (tinyint))\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 90 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 15), [12, 25], [51, 51]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 15), [12, 25], [51, 51]}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 24 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       └─ 62 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 24 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t1.v3:3\n" +
+			"     │       │           └─ 22 (tinyint)\n" +
+			"     │       └─ (comp_index_t1.v2:2 BETWEEN 12 (tinyint) AND 25 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t1.v1:1 BETWEEN 48 (tinyint) AND 49 (tinyint))\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 90 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<66 AND v2>=11 AND v3<90) OR (v1<>90)) OR (v1<=7 AND v2=52));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 66 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 11 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │       └─ 90 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v1:1\n" +
-			" │   │           └─ 90 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThanOrEqual\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 7 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 52 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 90), [NULL, ∞), [NULL, ∞)}, {(90, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 90), [NULL, ∞), [NULL, ∞)}, {(90, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 66 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 11 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │       └─ 90 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v1:1\n" +
+			"     │           └─ 90 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThanOrEqual\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 7 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 52 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1 BETWEEN 6 AND 74 AND v2=52) OR (v1>44 AND v3>=15 AND v2 BETWEEN 17 AND 94)) OR (v1>84));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t1.v1:1 BETWEEN 6 (tinyint) AND 74 (tinyint))\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       └─ 52 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 44 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       │       └─ 15 (tinyint)\n" +
-			" │   │       └─ (comp_index_t1.v2:2 BETWEEN 17 (tinyint) AND 94 (tinyint))\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 84 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[6, 74], [52, 52], [NULL, ∞)}, {(44, 74], [17, 52), [15, ∞)}, {(44, 74], (52, 94], [15, ∞)}, {(74, 84], [17, 94], [15, ∞)}, {(84, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[6, 74], [52, 52], [NULL, ∞)}, {(44, 74], [17, 52), [15, ∞)}, {(44, 74], (52, 94], [15, ∞)}, {(74, 84], [17, 94], [15, ∞)}, {(84, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ (comp_index_t1.v1:1 BETWEEN 6 (tinyint) AND 74 (tinyint))\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       └─ 52 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThan\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 44 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t1.v3:3\n" +
+			"     │       │       └─ 15 (tinyint)\n" +
+			"     │       └─ (comp_index_t1.v2:2 BETWEEN 17 (tinyint) AND 94 (tinyint))\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 84 (tinyint)\n" +
 			"",
 	},
 	{
@@ -4660,40 +4535,39 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<>1 AND v2=88 AND v3<33) OR (v1<=38)) OR (v1>74 AND v3<>55 AND v2>=9));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │       └─ 1 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       └─ 88 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │       └─ 33 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 38 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThan\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 74 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t1.v3:3\n" +
-			" │       │           └─ 55 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 9 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 38], [NULL, ∞), [NULL, ∞)}, {(38, 74], [88, 88], (NULL, 33)}, {(74, ∞), [9, ∞), (NULL, 55)}, {(74, ∞), [9, ∞), (55, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 38], [NULL, ∞), [NULL, ∞)}, {(38, 74], [88, 88], (NULL, 33)}, {(74, ∞), [9, ∞), (NULL, 55)}, {(74, ∞), [9, ∞), (55, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │       └─ 1 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       └─ 88 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │       └─ 33 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 38 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThan\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 74 (tinyint)\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t1.v3:3\n" +
+			"         │           └─ 55 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 9 (tinyint)\n" +
 			"",
 	},
 	{
@@ -4714,70 +4588,68 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1>28 AND v2>=73 AND v3=79) AND (v1<=70 AND v2 BETWEEN 5 AND 36) OR (v1<=31)) OR (v1<36)) OR (v1=47 AND v2 BETWEEN 0 AND 92 AND v3<=43));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 28 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │   │   │       └─ 73 (tinyint)\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │   │       └─ 79 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ LessThanOrEqual\n" +
-			" │   │   │   │       │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │       │   └─ 70 (tinyint)\n" +
-			" │   │   │   │       └─ (comp_index_t1.v2:2 BETWEEN 5 (tinyint) AND 36 (tinyint))\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 31 (tinyint)\n" +
-			" │   │   └─ LessThan\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 36 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ Eq\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 47 (tinyint)\n" +
-			" │       │   └─ (comp_index_t1.v2:2 BETWEEN 0 (tinyint) AND 92 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 43 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 36), [NULL, ∞), [NULL, ∞)}, {[47, 47], [0, 92], (NULL, 43]}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 36), [NULL, ∞), [NULL, ∞)}, {[47, 47], [0, 92], (NULL, 43]}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   │   │   └─ 28 (tinyint)\n" +
+			"     │   │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │   │   │       └─ 73 (tinyint)\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │   │       └─ 79 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ LessThanOrEqual\n" +
+			"     │   │   │       │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │       │   └─ 70 (tinyint)\n" +
+			"     │   │   │       └─ (comp_index_t1.v2:2 BETWEEN 5 (tinyint) AND 36 (tinyint))\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 31 (tinyint)\n" +
+			"     │   └─ LessThan\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 36 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ Eq\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 47 (tinyint)\n" +
+			"         │   └─ (comp_index_t1.v2:2 BETWEEN 0 (tinyint) AND 92 (tinyint))\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 43 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>24) AND (v1>68 AND v2 BETWEEN 1 AND 79 AND v3 BETWEEN 23 AND 44) OR (v1>78));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 24 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 68 (tinyint)\n" +
-			" │   │       │   └─ (comp_index_t1.v2:2 BETWEEN 1 (tinyint) AND 79 (tinyint))\n" +
-			" │   │       └─ (comp_index_t1.v3:3 BETWEEN 23 (tinyint) AND 44 (tinyint))\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 78 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(68, 78], [1, 79], [23, 44]}, {(78, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(68, 78], [1, 79], [23, 44]}, {(78, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThan\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 24 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThan\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 68 (tinyint)\n" +
+			"     │       │   └─ (comp_index_t1.v2:2 BETWEEN 1 (tinyint) AND 79 (tinyint))\n" +
+			"     │       └─ (comp_index_t1.v3:3 BETWEEN 23 (tinyint) AND 44 (tinyint))\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 78 (tinyint)\n" +
 			"",
 	},
 	{
@@ -4790,56 +4662,55 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1>=49 AND v2>53 AND v3<>12) OR (v1=95 AND v2<1 AND v3<>89)) OR (v1=62 AND v3>=37 AND v2<=22)) OR (v1>30 AND v2>=66));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   │   └─ 49 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │   │       └─ 53 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │           └─ 12 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ Eq\n" +
-			" │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │   └─ 95 (tinyint)\n" +
-			" │   │   │       │   └─ LessThan\n" +
-			" │   │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       │       └─ 1 (tinyint)\n" +
-			" │   │   │       └─ NOT\n" +
-			" │   │   │           └─ Eq\n" +
-			" │   │   │               ├─ comp_index_t1.v3:3\n" +
-			" │   │   │               └─ 89 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ Eq\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 62 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t1.v3:3\n" +
-			" │   │       │       └─ 37 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t1.v2:2\n" +
-			" │   │           └─ 22 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 30 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 66 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(30, ∞), [66, ∞), [NULL, ∞)}, {[49, ∞), (53, 66), (NULL, 12)}, {[49, ∞), (53, 66), (12, ∞)}, {[62, 62], (NULL, 22], [37, ∞)}, {[95, 95], (NULL, 1), (NULL, 89)}, {[95, 95], (NULL, 1), (89, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(30, ∞), [66, ∞), [NULL, ∞)}, {[49, ∞), (53, 66), (NULL, 12)}, {[49, ∞), (53, 66), (12, ∞)}, {[62, 62], (NULL, 22], [37, ∞)}, {[95, 95], (NULL, 1), (NULL, 89)}, {[95, 95], (NULL, 1), (89, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   │   └─ 49 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │   │       └─ 53 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │           └─ 12 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ Eq\n" +
+			"     │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │   └─ 95 (tinyint)\n" +
+			"     │   │       │   └─ LessThan\n" +
+			"     │   │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       │       └─ 1 (tinyint)\n" +
+			"     │   │       └─ NOT\n" +
+			"     │   │           └─ Eq\n" +
+			"     │   │               ├─ comp_index_t1.v3:3\n" +
+			"     │   │               └─ 89 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ Eq\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 62 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t1.v3:3\n" +
+			"     │       │       └─ 37 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t1.v2:2\n" +
+			"     │           └─ 22 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 30 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 66 (tinyint)\n" +
 			"",
 	},
 	{
@@ -4860,79 +4731,77 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((((v1>40 AND v2 BETWEEN 26 AND 30) OR (v1<3 AND v2>=62 AND v3<=8)) OR (v1<>57)) OR (v1=16 AND v2>92 AND v3<=74));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   └─ 40 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 26 (tinyint) AND 30 (tinyint))\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ LessThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │   └─ 3 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       │       └─ 62 (tinyint)\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │           └─ 8 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t1.v1:1\n" +
-			" │   │           └─ 57 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ Eq\n" +
-			" │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   │   └─ 16 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t1.v2:2\n" +
-			" │       │       └─ 92 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t1.v3:3\n" +
-			" │           └─ 74 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 57), [NULL, ∞), [NULL, ∞)}, {[57, 57], [26, 30], [NULL, ∞)}, {(57, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 57), [NULL, ∞), [NULL, ∞)}, {[57, 57], [26, 30], [NULL, ∞)}, {(57, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   └─ 40 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 26 (tinyint) AND 30 (tinyint))\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ LessThan\n" +
+			"     │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │   └─ 3 (tinyint)\n" +
+			"     │   │       │   └─ GreaterThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       │       └─ 62 (tinyint)\n" +
+			"     │   │       └─ LessThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │           └─ 8 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t1.v1:1\n" +
+			"     │           └─ 57 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ Eq\n" +
+			"         │   │   ├─ comp_index_t1.v1:1\n" +
+			"         │   │   └─ 16 (tinyint)\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t1.v2:2\n" +
+			"         │       └─ 92 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t1.v3:3\n" +
+			"             └─ 74 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<=34 AND v2 BETWEEN 29 AND 35 AND v3>=64) OR (v1<>47)) AND (v1>=11) OR (v1<>46 AND v2 BETWEEN 4 AND 26));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │   │   └─ 34 (tinyint)\n" +
-			" │   │   │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 29 (tinyint) AND 35 (tinyint))\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │       └─ 64 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t1.v1:1\n" +
-			" │   │   │           └─ 47 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │       └─ 11 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t1.v1:1\n" +
-			" │       │       └─ 46 (tinyint)\n" +
-			" │       └─ (comp_index_t1.v2:2 BETWEEN 4 (tinyint) AND 26 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 11), [4, 26], [NULL, ∞)}, {[11, 47), [NULL, ∞), [NULL, ∞)}, {[47, 47], [4, 26], [NULL, ∞)}, {(47, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 11), [4, 26], [NULL, ∞)}, {[11, 47), [NULL, ∞), [NULL, ∞)}, {[47, 47], [4, 26], [NULL, ∞)}, {(47, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │   │   └─ 34 (tinyint)\n" +
+			"     │   │   │   │   └─ (comp_index_t1.v2:2 BETWEEN 29 (tinyint) AND 35 (tinyint))\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │       └─ 64 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t1.v1:1\n" +
+			"     │   │           └─ 47 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t1.v1:1\n" +
+			"     │       └─ 11 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t1.v1:1\n" +
+			"         │       └─ 46 (tinyint)\n" +
+			"         └─ (comp_index_t1.v2:2 BETWEEN 4 (tinyint) AND 26 (tinyint))\n" +
 			"",
 	},
 	{
@@ -4945,62 +4814,60 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE ((v1>=79 AND v3 BETWEEN 9 AND 95) OR (v1 BETWEEN 50 AND 50 AND v2 BETWEEN 16 AND 38 AND v3<>94));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   └─ 79 (tinyint)\n" +
-			" │   │   └─ (comp_index_t1.v3:3 BETWEEN 9 (tinyint) AND 95 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t1.v1:1 BETWEEN 50 (tinyint) AND 50 (tinyint))\n" +
-			" │       │   └─ (comp_index_t1.v2:2 BETWEEN 16 (tinyint) AND 38 (tinyint))\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t1.v3:3\n" +
-			" │               └─ 94 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{[50, 50], [16, 38], (NULL, 94)}, {[50, 50], [16, 38], (94, ∞)}, {[79, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{[50, 50], [16, 38], (NULL, 94)}, {[50, 50], [16, 38], (94, ∞)}, {[79, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   └─ 79 (tinyint)\n" +
+			"     │   └─ (comp_index_t1.v3:3 BETWEEN 9 (tinyint) AND 95 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t1.v1:1 BETWEEN 50 (tinyint) AND 50 (tinyint))\n" +
+			"         │   └─ (comp_index_t1.v2:2 BETWEEN 16 (tinyint) AND 38 (tinyint))\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t1.v3:3\n" +
+			"                 └─ 94 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((((v1<>79) OR (v1 BETWEEN 9 AND 11 AND v2<48 AND v3<=73)) OR (v1<=46)) OR (v1 BETWEEN 66 AND 67)) OR (v1<=86 AND v2<4));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   │       └─ 79 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ AND\n" +
-			" │   │   │   │       │   ├─ (comp_index_t1.v1:1 BETWEEN 9 (tinyint) AND 11 (tinyint))\n" +
-			" │   │   │   │       │   └─ LessThan\n" +
-			" │   │   │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │   │       │       └─ 48 (tinyint)\n" +
-			" │   │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │   │           ├─ comp_index_t1.v3:3\n" +
-			" │   │   │   │           └─ 73 (tinyint)\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       └─ 46 (tinyint)\n" +
-			" │   │   └─ (comp_index_t1.v1:1 BETWEEN 66 (tinyint) AND 67 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThanOrEqual\n" +
-			" │       │   ├─ comp_index_t1.v1:1\n" +
-			" │       │   └─ 86 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t1.v2:2\n" +
-			" │           └─ 4 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 79), [NULL, ∞), [NULL, ∞)}, {[79, 79], (NULL, 4), [NULL, ∞)}, {(79, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 79), [NULL, ∞), [NULL, ∞)}, {[79, 79], (NULL, 4), [NULL, ∞)}, {(79, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   │       └─ 79 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ AND\n" +
+			"     │   │   │       │   ├─ (comp_index_t1.v1:1 BETWEEN 9 (tinyint) AND 11 (tinyint))\n" +
+			"     │   │   │       │   └─ LessThan\n" +
+			"     │   │   │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │   │       │       └─ 48 (tinyint)\n" +
+			"     │   │   │       └─ LessThanOrEqual\n" +
+			"     │   │   │           ├─ comp_index_t1.v3:3\n" +
+			"     │   │   │           └─ 73 (tinyint)\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t1.v1:1\n" +
+			"     │   │       └─ 46 (tinyint)\n" +
+			"     │   └─ (comp_index_t1.v1:1 BETWEEN 66 (tinyint) AND 67 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThanOrEqual\n" +
+			"         │   ├─ comp_index_t1.v1:1\n" +
+			"         │   └─ 86 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t1.v2:2\n" +
+			"             └─ 4 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5021,41 +4888,40 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t1 WHERE (((v1<62) AND (v1<=57 AND v2>51 AND v3 BETWEEN 29 AND 30) OR (v1>=28 AND v2<=62 AND v3<>76)) OR (v1>=94));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │   │   └─ 62 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │   │       │   │   └─ 57 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │   │       │       └─ 51 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t1.v3:3 BETWEEN 29 (tinyint) AND 30 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t1.v1:1\n" +
-			" │   │       │   │   └─ 28 (tinyint)\n" +
-			" │   │       │   └─ LessThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t1.v2:2\n" +
-			" │   │       │       └─ 62 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t1.v3:3\n" +
-			" │   │               └─ 76 (tinyint)\n" +
-			" │   └─ GreaterThanOrEqual\n" +
-			" │       ├─ comp_index_t1.v1:1\n" +
-			" │       └─ 94 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t1)\n" +
-			"     ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
-			"     ├─ static: [{(NULL, 28), (51, ∞), [29, 30]}, {[28, 57], (62, ∞), [29, 30]}, {[28, 94), (NULL, 62], (NULL, 76)}, {[28, 94), (NULL, 62], (76, ∞)}, {[94, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t1)\n" +
+			" ├─ index: [comp_index_t1.v1,comp_index_t1.v2,comp_index_t1.v3]\n" +
+			" ├─ static: [{(NULL, 28), (51, ∞), [29, 30]}, {[28, 57], (62, ∞), [29, 30]}, {[28, 94), (NULL, 62], (NULL, 76)}, {[28, 94), (NULL, 62], (76, ∞)}, {[94, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThan\n" +
+			"     │   │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │   │   └─ 62 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ LessThanOrEqual\n" +
+			"     │   │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │   │       │   │   └─ 57 (tinyint)\n" +
+			"     │   │       │   └─ GreaterThan\n" +
+			"     │   │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │   │       │       └─ 51 (tinyint)\n" +
+			"     │   │       └─ (comp_index_t1.v3:3 BETWEEN 29 (tinyint) AND 30 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t1.v1:1\n" +
+			"     │       │   │   └─ 28 (tinyint)\n" +
+			"     │       │   └─ LessThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t1.v2:2\n" +
+			"     │       │       └─ 62 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t1.v3:3\n" +
+			"     │               └─ 76 (tinyint)\n" +
+			"     └─ GreaterThanOrEqual\n" +
+			"         ├─ comp_index_t1.v1:1\n" +
+			"         └─ 94 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5108,80 +4974,78 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=51 AND v4 BETWEEN 36 AND 55 AND v2>62 AND v3<43) OR (v1 BETWEEN 5 AND 60 AND v2<1)) OR (v1=51 AND v2>=98 AND v3>=94));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 51 (tinyint)\n" +
-			" │   │   │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 36 (tinyint) AND 55 (tinyint))\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 62 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 43 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 5 (tinyint) AND 60 (tinyint))\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 1 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ Eq\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 51 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 98 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 94 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[5, 60], (NULL, 1), [NULL, ∞), [NULL, ∞)}, {[51, 51], (62, ∞), (NULL, 43), [36, 55]}, {[51, 51], [98, ∞), [94, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[5, 60], (NULL, 1), [NULL, ∞), [NULL, ∞)}, {[51, 51], (62, ∞), (NULL, 43), [36, 55]}, {[51, 51], [98, ∞), [94, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 51 (tinyint)\n" +
+			"     │   │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 36 (tinyint) AND 55 (tinyint))\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 62 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 43 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ (comp_index_t2.v1:1 BETWEEN 5 (tinyint) AND 60 (tinyint))\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 1 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ Eq\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 51 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 98 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 94 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=6 AND v4<95 AND v2<41 AND v3<=4) AND (v1>=81 AND v4>44 AND v2 BETWEEN 6 AND 11) OR (v1<=98));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 6 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │       └─ 95 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 41 (tinyint)\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 4 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 81 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 44 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v2:2 BETWEEN 6 (tinyint) AND 11 (tinyint))\n" +
-			" │   └─ LessThanOrEqual\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 98 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 98], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(98, ∞), [6, 11], (NULL, 4], (44, 95)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 98], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(98, ∞), [6, 11], (NULL, 4], (44, 95)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 6 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │       └─ 95 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 41 (tinyint)\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 4 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 81 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 44 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v2:2 BETWEEN 6 (tinyint) AND 11 (tinyint))\n" +
+			"     └─ LessThanOrEqual\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 98 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5202,45 +5066,43 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=70) OR (v1>=38 AND v3 BETWEEN 25 AND 30));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Eq\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 70 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 38 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v3:3 BETWEEN 25 (tinyint) AND 30 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[38, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[38, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ comp_index_t2.v1:1\n" +
+			"     │   └─ 70 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 38 (tinyint)\n" +
+			"         └─ (comp_index_t2.v3:3 BETWEEN 25 (tinyint) AND 30 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=33) OR (v1<=31 AND v4<>35 AND v2=38));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ LessThanOrEqual\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 33 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 31 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v4:4\n" +
-			" │       │           └─ 35 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 38 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 33], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 33], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ LessThanOrEqual\n" +
+			"     │   ├─ comp_index_t2.v1:1\n" +
+			"     │   └─ 33 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 31 (tinyint)\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t2.v4:4\n" +
+			"         │           └─ 35 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 38 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5261,52 +5123,51 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((((v1>=91 AND v4<=47 AND v2>=43) OR (v1=75)) OR (v1<41 AND v4>=64 AND v2>83)) OR (v1 BETWEEN 72 AND 88 AND v2=48 AND v3<=10)) OR (v1<=44));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 91 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │   │       └─ 47 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 43 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 75 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ LessThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 41 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │       └─ 64 (tinyint)\n" +
-			" │   │   │       └─ GreaterThan\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 83 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ (comp_index_t2.v1:1 BETWEEN 72 (tinyint) AND 88 (tinyint))\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 48 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 10 (tinyint)\n" +
-			" │   └─ LessThanOrEqual\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 44 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 44], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[72, 75), [48, 48], (NULL, 10], [NULL, ∞)}, {[75, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, 88], [48, 48], (NULL, 10], [NULL, ∞)}, {[91, ∞), [43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 44], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[72, 75), [48, 48], (NULL, 10], [NULL, ∞)}, {[75, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, 88], [48, 48], (NULL, 10], [NULL, ∞)}, {[91, ∞), [43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   │   └─ 91 (tinyint)\n" +
+			"     │   │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │   │       └─ 47 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 43 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 75 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ LessThan\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 41 (tinyint)\n" +
+			"     │   │       │   └─ GreaterThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       │       └─ 64 (tinyint)\n" +
+			"     │   │       └─ GreaterThan\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 83 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ (comp_index_t2.v1:1 BETWEEN 72 (tinyint) AND 88 (tinyint))\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 48 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 10 (tinyint)\n" +
+			"     └─ LessThanOrEqual\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 44 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5327,47 +5188,46 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<52 AND v3 BETWEEN 39 AND 57 AND v4 BETWEEN 13 AND 13 AND v2 BETWEEN 76 AND 99) OR (v1>44)) OR (v1<71 AND v4>7 AND v2<98)) OR (v1<>5 AND v2 BETWEEN 35 AND 40 AND v3<=10));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 52 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 39 (tinyint) AND 57 (tinyint))\n" +
-			" │   │   │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 13 (tinyint) AND 13 (tinyint))\n" +
-			" │   │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 76 (tinyint) AND 99 (tinyint))\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 44 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 71 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 7 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 98 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 5 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v2:2 BETWEEN 35 (tinyint) AND 40 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 10 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 44], (NULL, 98), [NULL, ∞), [NULL, ∞)}, {(NULL, 44], [98, 99], [39, 57], [13, 13]}, {(44, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 44], (NULL, 98), [NULL, ∞), [NULL, ∞)}, {(NULL, 44], [98, 99], [39, 57], [13, 13]}, {(44, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   │   └─ 52 (tinyint)\n" +
+			"     │   │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 39 (tinyint) AND 57 (tinyint))\n" +
+			"     │   │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 13 (tinyint) AND 13 (tinyint))\n" +
+			"     │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 76 (tinyint) AND 99 (tinyint))\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       └─ 44 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 71 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 7 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 98 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │       └─ 5 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v2:2 BETWEEN 35 (tinyint) AND 40 (tinyint))\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 10 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5380,12 +5240,11 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1<=92 AND v4 BETWEEN 8 AND 90) AND (v1 BETWEEN 39 AND 42);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ (comp_index_t2.v4:4 BETWEEN 8 (tinyint) AND 90 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[39, 42], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[39, 42], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: (comp_index_t2.v4:4 BETWEEN 8 (tinyint) AND 90 (tinyint))\n" +
 			"",
 	},
 	{
@@ -5406,192 +5265,186 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>77 AND v4>82 AND v2>=96) OR (v1 BETWEEN 41 AND 80 AND v2<>21 AND v3>60));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 77 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 82 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 96 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 41 (tinyint) AND 80 (tinyint))\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v2:2\n" +
-			" │       │           └─ 21 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 60 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[41, 77], (21, ∞), (60, ∞), [NULL, ∞)}, {[41, 80], (NULL, 21), (60, ∞), [NULL, ∞)}, {(77, 80], (21, 96), (60, ∞), [NULL, ∞)}, {(77, ∞), [96, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[41, 77], (21, ∞), (60, ∞), [NULL, ∞)}, {[41, 80], (NULL, 21), (60, ∞), [NULL, ∞)}, {(77, 80], (21, 96), (60, ∞), [NULL, ∞)}, {(77, ∞), [96, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 77 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 82 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v2:2\n" +
+			"     │       └─ 96 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t2.v1:1 BETWEEN 41 (tinyint) AND 80 (tinyint))\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t2.v2:2\n" +
+			"         │           └─ 21 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 60 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1=28 AND v4 BETWEEN 44 AND 50) AND (v1>=49);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ (comp_index_t2.v4:4 BETWEEN 44 (tinyint) AND 50 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: (comp_index_t2.v4:4 BETWEEN 44 (tinyint) AND 50 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 81 AND 87 AND v3<>81 AND v4<30) AND (v1=17) OR (v1<27 AND v2<>8 AND v3>35)) OR (v1>28 AND v2<62));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 81 (tinyint) AND 87 (tinyint))\n" +
-			" │   │   │   │   │   └─ NOT\n" +
-			" │   │   │   │   │       └─ Eq\n" +
-			" │   │   │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │           └─ 81 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 30 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 17 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 27 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │           └─ 8 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 35 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 28 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 27), (NULL, 8), (35, ∞), [NULL, ∞)}, {(NULL, 27), (8, ∞), (35, ∞), [NULL, ∞)}, {(28, ∞), (NULL, 62), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 27), (NULL, 8), (35, ∞), [NULL, ∞)}, {(NULL, 27), (8, ∞), (35, ∞), [NULL, ∞)}, {(28, ∞), (NULL, 62), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 81 (tinyint) AND 87 (tinyint))\n" +
+			"     │   │   │   │   └─ NOT\n" +
+			"     │   │   │   │       └─ Eq\n" +
+			"     │   │   │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │           └─ 81 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 30 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       └─ 17 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 27 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t2.v2:2\n" +
+			"     │       │           └─ 8 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 35 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 28 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 62 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>65 AND v2=64) OR (v1=82 AND v3<>99)) OR (v1>=68 AND v2=3 AND v3 BETWEEN 1 AND 51 AND v4<=73));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 65 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 64 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ Eq\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 82 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │               └─ 99 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 68 (tinyint)\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 3 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v3:3 BETWEEN 1 (tinyint) AND 51 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 73 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(65, 82), [64, 64], [NULL, ∞), [NULL, ∞)}, {[68, 82), [3, 3], [1, 51], (NULL, 73]}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(82, ∞), [3, 3], [1, 51], (NULL, 73]}, {(82, ∞), [64, 64], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(65, 82), [64, 64], [NULL, ∞), [NULL, ∞)}, {[68, 82), [3, 3], [1, 51], (NULL, 73]}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(82, ∞), [3, 3], [1, 51], (NULL, 73]}, {(82, ∞), [64, 64], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 65 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 64 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ Eq\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 82 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v3:3\n" +
+			"     │               └─ 99 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 68 (tinyint)\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 3 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v3:3 BETWEEN 1 (tinyint) AND 51 (tinyint))\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 73 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=27 AND v3>23) OR (v1<70 AND v2<>43));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 27 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 23 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 70 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v2:2\n" +
-			" │               └─ 43 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 27], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(27, 70), (NULL, 43), [NULL, ∞), [NULL, ∞)}, {(27, 70), (43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 27], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(27, 70), (NULL, 43), [NULL, ∞), [NULL, ∞)}, {(27, 70), (43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ LessThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 27 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 23 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 70 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v2:2\n" +
+			"                 └─ 43 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>34 AND v2>=89 AND v3>=14) OR (v1<=42 AND v3<1)) OR (v1<59 AND v2>=23 AND v3 BETWEEN 17 AND 37 AND v4 BETWEEN 21 AND 38));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │       └─ 34 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 89 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 14 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 42 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 1 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 59 (tinyint)\n" +
-			" │       │   │   └─ GreaterThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 23 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v3:3 BETWEEN 17 (tinyint) AND 37 (tinyint))\n" +
-			" │       └─ (comp_index_t2.v4:4 BETWEEN 21 (tinyint) AND 38 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 42], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(42, 59), [23, 89), [17, 37], [21, 38]}, {(42, ∞), [89, ∞), [14, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 42], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(42, 59), [23, 89), [17, 37], [21, 38]}, {(42, ∞), [89, ∞), [14, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │       └─ 34 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 89 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 14 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 42 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 1 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 59 (tinyint)\n" +
+			"         │   │   └─ GreaterThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 23 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v3:3 BETWEEN 17 (tinyint) AND 37 (tinyint))\n" +
+			"         └─ (comp_index_t2.v4:4 BETWEEN 21 (tinyint) AND 38 (tinyint))\n" +
 			"",
 	},
 	{
@@ -5612,23 +5465,22 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<83 AND v4>51) OR (v1<>30));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 83 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 51 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 30 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ LessThan\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 83 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 51 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v1:1\n" +
+			"             └─ 30 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5649,52 +5501,51 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>29 AND v4=40 AND v2>=63) OR (v1<70 AND v2<70 AND v3<=20)) OR (v1 BETWEEN 7 AND 61 AND v2>=33 AND v3>78)) OR (v1>=4 AND v2<=22));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 29 (tinyint)\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │       └─ 40 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 63 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ LessThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 70 (tinyint)\n" +
-			" │   │   │       │   └─ LessThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 70 (tinyint)\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 20 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ (comp_index_t2.v1:1 BETWEEN 7 (tinyint) AND 61 (tinyint))\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 33 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 78 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 4 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 22 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 4), (NULL, 70), (NULL, 20], [NULL, ∞)}, {[4, 29], (22, 70), (NULL, 20], [NULL, ∞)}, {[4, ∞), (NULL, 22], [NULL, ∞), [NULL, ∞)}, {[7, 29], [33, ∞), (78, ∞), [NULL, ∞)}, {(29, 61], [33, 63), (78, ∞), [NULL, ∞)}, {(29, 70), (22, 63), (NULL, 20], [NULL, ∞)}, {(29, ∞), [63, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 4), (NULL, 70), (NULL, 20], [NULL, ∞)}, {[4, 29], (22, 70), (NULL, 20], [NULL, ∞)}, {[4, ∞), (NULL, 22], [NULL, ∞), [NULL, ∞)}, {[7, 29], [33, ∞), (78, ∞), [NULL, ∞)}, {(29, 61], [33, 63), (78, ∞), [NULL, ∞)}, {(29, 70), (22, 63), (NULL, 20], [NULL, ∞)}, {(29, ∞), [63, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 29 (tinyint)\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │       └─ 40 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 63 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ LessThan\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 70 (tinyint)\n" +
+			"     │   │       │   └─ LessThan\n" +
+			"     │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │       └─ 70 (tinyint)\n" +
+			"     │   │       └─ LessThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 20 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ (comp_index_t2.v1:1 BETWEEN 7 (tinyint) AND 61 (tinyint))\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 33 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 78 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 4 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 22 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5707,42 +5558,41 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=94 AND v2>=13 AND v3<=46 AND v4<>36) AND (v1=84) OR (v1 BETWEEN 52 AND 98 AND v2<71 AND v3<>45));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 94 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 13 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 46 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 36 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 84 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 52 (tinyint) AND 98 (tinyint))\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 71 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v3:3\n" +
-			" │               └─ 45 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[52, 98], (NULL, 71), (NULL, 45), [NULL, ∞)}, {[52, 98], (NULL, 71), (45, ∞), [NULL, ∞)}, {[84, 84], [13, 71), [45, 45], (NULL, 36)}, {[84, 84], [13, 71), [45, 45], (36, ∞)}, {[84, 84], [71, ∞), (NULL, 46], (NULL, 36)}, {[84, 84], [71, ∞), (NULL, 46], (36, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[52, 98], (NULL, 71), (NULL, 45), [NULL, ∞)}, {[52, 98], (NULL, 71), (45, ∞), [NULL, ∞)}, {[84, 84], [13, 71), [45, 45], (NULL, 36)}, {[84, 84], [13, 71), [45, 45], (36, ∞)}, {[84, 84], [71, ∞), (NULL, 46], (NULL, 36)}, {[84, 84], [71, ∞), (NULL, 46], (36, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 94 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 13 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 46 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 36 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 84 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t2.v1:1 BETWEEN 52 (tinyint) AND 98 (tinyint))\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 71 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v3:3\n" +
+			"                 └─ 45 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5755,43 +5605,42 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1 BETWEEN 54 AND 87 AND v2<78 AND v3<33) OR (v1<>52)) OR (v1 BETWEEN 3 AND 61 AND v4<=49)) OR (v1>3 AND v2<73 AND v3>59));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 54 (tinyint) AND 87 (tinyint))\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 78 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 33 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │   │           └─ 52 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 3 (tinyint) AND 61 (tinyint))\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 49 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 3 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 73 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 54 (tinyint) AND 87 (tinyint))\n" +
+			"     │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 78 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 33 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v1:1\n" +
+			"     │   │           └─ 52 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ (comp_index_t2.v1:1 BETWEEN 3 (tinyint) AND 61 (tinyint))\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 49 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 3 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 73 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 59 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5804,46 +5653,45 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=31 AND v2>44) OR (v1<44 AND v4<>6 AND v2<>10 AND v3<>14)) AND (v1=96 AND v3>25 AND v4<>32);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 31 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 44 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ LessThan\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 44 (tinyint)\n" +
-			" │   │   │       │   │   └─ NOT\n" +
-			" │   │   │       │   │       └─ Eq\n" +
-			" │   │   │       │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │   │           └─ 6 (tinyint)\n" +
-			" │   │   │       │   └─ NOT\n" +
-			" │   │   │       │       └─ Eq\n" +
-			" │   │   │       │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │           └─ 10 (tinyint)\n" +
-			" │   │   │       └─ NOT\n" +
-			" │   │   │           └─ Eq\n" +
-			" │   │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │   │               └─ 14 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 25 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 32 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: AND\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 31 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 44 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ LessThan\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 44 (tinyint)\n" +
+			"     │   │       │   │   └─ NOT\n" +
+			"     │   │       │   │       └─ Eq\n" +
+			"     │   │       │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │       │   │           └─ 6 (tinyint)\n" +
+			"     │   │       │   └─ NOT\n" +
+			"     │   │       │       └─ Eq\n" +
+			"     │   │       │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │           └─ 10 (tinyint)\n" +
+			"     │   │       └─ NOT\n" +
+			"     │   │           └─ Eq\n" +
+			"     │   │               ├─ comp_index_t2.v3:3\n" +
+			"     │   │               └─ 14 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 25 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 32 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5856,33 +5704,32 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=0) OR (v1=31)) OR (v1<>73 AND v4>9 AND v2 BETWEEN 27 AND 69 AND v3=14));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Eq\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 0 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 31 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ NOT\n" +
-			" │       │   │   │   └─ Eq\n" +
-			" │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │       └─ 73 (tinyint)\n" +
-			" │       │   │   └─ GreaterThan\n" +
-			" │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │       │   │       └─ 9 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v2:2 BETWEEN 27 (tinyint) AND 69 (tinyint))\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 14 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 0), [27, 69], [14, 14], (9, ∞)}, {[0, 0], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(0, 31), [27, 69], [14, 14], (9, ∞)}, {[31, 31], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(31, 73), [27, 69], [14, 14], (9, ∞)}, {(73, ∞), [27, 69], [14, 14], (9, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 0), [27, 69], [14, 14], (9, ∞)}, {[0, 0], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(0, 31), [27, 69], [14, 14], (9, ∞)}, {[31, 31], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(31, 73), [27, 69], [14, 14], (9, ∞)}, {(73, ∞), [27, 69], [14, 14], (9, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 0 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 31 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ NOT\n" +
+			"         │   │   │   └─ Eq\n" +
+			"         │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │       └─ 73 (tinyint)\n" +
+			"         │   │   └─ GreaterThan\n" +
+			"         │   │       ├─ comp_index_t2.v4:4\n" +
+			"         │   │       └─ 9 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v2:2 BETWEEN 27 (tinyint) AND 69 (tinyint))\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 14 (tinyint)\n" +
 			"",
 	},
 	{
@@ -5895,156 +5742,152 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=3 AND v2<57 AND v3<>74 AND v4>=69) OR (v1<>66 AND v2=16)) OR (v1=44 AND v3=58));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 3 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 57 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │           └─ 74 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 69 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ NOT\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │       └─ 66 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 16 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 44 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 58 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 3], (NULL, 16), (NULL, 74), [69, ∞)}, {(NULL, 3], (NULL, 16), (74, ∞), [69, ∞)}, {(NULL, 3], (16, 57), (NULL, 74), [69, ∞)}, {(NULL, 3], (16, 57), (74, ∞), [69, ∞)}, {(NULL, 44), [16, 16], [NULL, ∞), [NULL, ∞)}, {[44, 44], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(44, 66), [16, 16], [NULL, ∞), [NULL, ∞)}, {(66, ∞), [16, 16], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 3], (NULL, 16), (NULL, 74), [69, ∞)}, {(NULL, 3], (NULL, 16), (74, ∞), [69, ∞)}, {(NULL, 3], (16, 57), (NULL, 74), [69, ∞)}, {(NULL, 3], (16, 57), (74, ∞), [69, ∞)}, {(NULL, 44), [16, 16], [NULL, ∞), [NULL, ∞)}, {[44, 44], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(44, 66), [16, 16], [NULL, ∞), [NULL, ∞)}, {(66, ∞), [16, 16], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 3 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 57 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │           └─ 74 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 69 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ NOT\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │       └─ 66 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 16 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 44 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 58 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<=22 AND v2<=41) OR (v1=61 AND v2>21)) OR (v1<>10)) OR (v1 BETWEEN 43 AND 44 AND v2>=35 AND v3<>87));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 22 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 41 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ Eq\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 61 (tinyint)\n" +
-			" │   │   │       └─ GreaterThan\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 21 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │           └─ 10 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 43 (tinyint) AND 44 (tinyint))\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 35 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v3:3\n" +
-			" │               └─ 87 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 10), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[10, 10], (NULL, 41], [NULL, ∞), [NULL, ∞)}, {(10, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 10), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[10, 10], (NULL, 41], [NULL, ∞), [NULL, ∞)}, {(10, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 22 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 41 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ Eq\n" +
+			"     │   │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   └─ 61 (tinyint)\n" +
+			"     │   │       └─ GreaterThan\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 21 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v1:1\n" +
+			"     │           └─ 10 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t2.v1:1 BETWEEN 43 (tinyint) AND 44 (tinyint))\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 35 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v3:3\n" +
+			"                 └─ 87 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<=13 AND v3>20) OR (v1 BETWEEN 18 AND 26 AND v2>11 AND v3>22)) OR (v1<18 AND v2>=47 AND v3<11)) OR (v1>19));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 13 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 20 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 26 (tinyint))\n" +
-			" │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 11 (tinyint)\n" +
-			" │   │   │       └─ GreaterThan\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 22 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 18 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 47 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 11 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 19 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 13], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(13, 18), [47, ∞), (NULL, 11), [NULL, ∞)}, {[18, 19], (11, ∞), (22, ∞), [NULL, ∞)}, {(19, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 13], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(13, 18), [47, ∞), (NULL, 11), [NULL, ∞)}, {[18, 19], (11, ∞), (22, ∞), [NULL, ∞)}, {(19, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 13 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 20 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 26 (tinyint))\n" +
+			"     │   │       │   └─ GreaterThan\n" +
+			"     │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │       └─ 11 (tinyint)\n" +
+			"     │   │       └─ GreaterThan\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 22 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 18 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 47 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 11 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 19 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 42 AND 54 AND v2>20) OR (v1<>68 AND v3>32));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ (comp_index_t2.v1:1 BETWEEN 42 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 20 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 68 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 32 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 68), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(68, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 68), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(68, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ (comp_index_t2.v1:1 BETWEEN 42 (tinyint) AND 54 (tinyint))\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v2:2\n" +
+			"     │       └─ 20 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v1:1\n" +
+			"         │       └─ 68 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 32 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 20 AND 93) AND (v1=66 AND v2<>21 AND v3 BETWEEN 43 AND 94);`,
 		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
 			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{[66, 66], (21, ∞), [43, 94], [NULL, ∞)}, {[66, 66], (NULL, 21), [43, 94], [NULL, ∞)}]\n" +
+			" ├─ static: [{[66, 66], (NULL, 21), [43, 94], [NULL, ∞)}, {[66, 66], (21, ∞), [43, 94], [NULL, ∞)}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
@@ -6058,134 +5901,131 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=44 AND v2<=98) AND (v1>15) OR (v1<=45 AND v2=1 AND v3<>54));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 44 (tinyint)\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 98 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 15 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 45 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 1 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v3:3\n" +
-			" │               └─ 54 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 44), [1, 1], (NULL, 54), [NULL, ∞)}, {(NULL, 44), [1, 1], (54, ∞), [NULL, ∞)}, {[44, 44], (NULL, 98], [NULL, ∞), [NULL, ∞)}, {(44, 45], [1, 1], (NULL, 54), [NULL, ∞)}, {(44, 45], [1, 1], (54, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 44), [1, 1], (NULL, 54), [NULL, ∞)}, {(NULL, 44), [1, 1], (54, ∞), [NULL, ∞)}, {[44, 44], (NULL, 98], [NULL, ∞), [NULL, ∞)}, {(44, 45], [1, 1], (NULL, 54), [NULL, ∞)}, {(44, 45], [1, 1], (54, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 44 (tinyint)\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 98 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 15 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 45 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 1 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v3:3\n" +
+			"                 └─ 54 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<38 AND v2>24) OR (v1<20 AND v3>=3 AND v4 BETWEEN 59 AND 81)) OR (v1<31 AND v4 BETWEEN 2 AND 16 AND v2=6 AND v3<=69));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 38 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 24 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 20 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 3 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v4:4 BETWEEN 59 (tinyint) AND 81 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 31 (tinyint)\n" +
-			" │       │   │   └─ (comp_index_t2.v4:4 BETWEEN 2 (tinyint) AND 16 (tinyint))\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 6 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 69 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 20), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[20, 31), [6, 6], (NULL, 69], [2, 16]}, {[20, 38), (24, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 20), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[20, 31), [6, 6], (NULL, 69], [2, 16]}, {[20, 38), (24, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 38 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 24 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 20 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 3 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v4:4 BETWEEN 59 (tinyint) AND 81 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 31 (tinyint)\n" +
+			"         │   │   └─ (comp_index_t2.v4:4 BETWEEN 2 (tinyint) AND 16 (tinyint))\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 6 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 69 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((((v1<43 AND v4<=22) OR (v1<=72 AND v2>=35 AND v3>=96)) OR (v1=63 AND v2=55 AND v3<>46)) OR (v1>=9 AND v2=52 AND v3=86 AND v4<=27)) OR (v1 BETWEEN 37 AND 62));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 43 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │       └─ 22 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ AND\n" +
-			" │   │   │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       │   │   └─ 72 (tinyint)\n" +
-			" │   │   │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       │       └─ 35 (tinyint)\n" +
-			" │   │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │           └─ 96 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ Eq\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 63 (tinyint)\n" +
-			" │   │   │       │   └─ Eq\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 55 (tinyint)\n" +
-			" │   │   │       └─ NOT\n" +
-			" │   │   │           └─ Eq\n" +
-			" │   │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │   │               └─ 46 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 9 (tinyint)\n" +
-			" │   │       │   │   └─ Eq\n" +
-			" │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │       └─ 52 (tinyint)\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 86 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 27 (tinyint)\n" +
-			" │   └─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 62 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 62], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(62, 63), [35, ∞), [96, ∞), [NULL, ∞)}, {(62, ∞), [52, 52], [86, 86], (NULL, 27]}, {[63, 63], [35, 55), [96, ∞), [NULL, ∞)}, {[63, 63], [55, 55], (NULL, 46), [NULL, ∞)}, {[63, 63], [55, 55], (46, ∞), [NULL, ∞)}, {[63, 63], (55, ∞), [96, ∞), [NULL, ∞)}, {(63, 72], [35, ∞), [96, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 62], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(62, 63), [35, ∞), [96, ∞), [NULL, ∞)}, {(62, ∞), [52, 52], [86, 86], (NULL, 27]}, {[63, 63], [35, 55), [96, ∞), [NULL, ∞)}, {[63, 63], [55, 55], (NULL, 46), [NULL, ∞)}, {[63, 63], [55, 55], (46, ∞), [NULL, ∞)}, {[63, 63], (55, ∞), [96, ∞), [NULL, ∞)}, {(63, 72], [35, ∞), [96, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 43 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │       └─ 22 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ AND\n" +
+			"     │   │   │       │   ├─ LessThanOrEqual\n" +
+			"     │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       │   │   └─ 72 (tinyint)\n" +
+			"     │   │   │       │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       │       └─ 35 (tinyint)\n" +
+			"     │   │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │           └─ 96 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ Eq\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 63 (tinyint)\n" +
+			"     │   │       │   └─ Eq\n" +
+			"     │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │       └─ 55 (tinyint)\n" +
+			"     │   │       └─ NOT\n" +
+			"     │   │           └─ Eq\n" +
+			"     │   │               ├─ comp_index_t2.v3:3\n" +
+			"     │   │               └─ 46 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 9 (tinyint)\n" +
+			"     │       │   │   └─ Eq\n" +
+			"     │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │       └─ 52 (tinyint)\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 86 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 27 (tinyint)\n" +
+			"     └─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 62 (tinyint))\n" +
 			"",
 	},
 	{
@@ -6198,211 +6038,206 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=37 AND v3>=74 AND v4=54) OR (v1>=36 AND v3<=42 AND v4<=94)) AND (v1=59 AND v2<=56) OR (v1>=83 AND v2<=11));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 37 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 74 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 54 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 36 (tinyint)\n" +
-			" │   │   │       │   └─ LessThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 42 (tinyint)\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 94 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ Eq\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 59 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 56 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 83 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 11 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[59, 59], (NULL, 56], [NULL, ∞), [NULL, ∞)}, {[83, ∞), (NULL, 11], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[59, 59], (NULL, 56], [NULL, ∞), [NULL, ∞)}, {[83, ∞), (NULL, 11], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 37 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │       └─ 74 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 54 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ GreaterThanOrEqual\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 36 (tinyint)\n" +
+			"     │   │       │   └─ LessThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │       └─ 42 (tinyint)\n" +
+			"     │   │       └─ LessThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 94 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ Eq\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 59 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 56 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 83 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 11 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>39 AND v3<44 AND v4 BETWEEN 3 AND 31 AND v2>16) OR (v1>72 AND v2=73 AND v3<37 AND v4<=43)) OR (v1=9 AND v2<50));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │       └─ 39 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 44 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 3 (tinyint) AND 31 (tinyint))\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 16 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ GreaterThan\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 72 (tinyint)\n" +
-			" │   │       │   │   └─ Eq\n" +
-			" │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │       └─ 73 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 37 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 43 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 9 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 50 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 9), (16, ∞), (NULL, 44), [3, 31]}, {[9, 9], (NULL, 50), [NULL, ∞), [NULL, ∞)}, {[9, 9], [50, ∞), (NULL, 44), [3, 31]}, {(9, 39), (16, ∞), (NULL, 44), [3, 31]}, {(39, 72], (16, ∞), (NULL, 44), [3, 31]}, {(72, ∞), (16, 73), (NULL, 44), [3, 31]}, {(72, ∞), [73, 73], (NULL, 37), (NULL, 43]}, {(72, ∞), [73, 73], [37, 44), [3, 31]}, {(72, ∞), (73, ∞), (NULL, 44), [3, 31]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 9), (16, ∞), (NULL, 44), [3, 31]}, {[9, 9], (NULL, 50), [NULL, ∞), [NULL, ∞)}, {[9, 9], [50, ∞), (NULL, 44), [3, 31]}, {(9, 39), (16, ∞), (NULL, 44), [3, 31]}, {(39, 72], (16, ∞), (NULL, 44), [3, 31]}, {(72, ∞), (16, 73), (NULL, 44), [3, 31]}, {(72, ∞), [73, 73], (NULL, 37), (NULL, 43]}, {(72, ∞), [73, 73], [37, 44), [3, 31]}, {(72, ∞), (73, ∞), (NULL, 44), [3, 31]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │       └─ 39 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │       └─ 44 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 3 (tinyint) AND 31 (tinyint))\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 16 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ GreaterThan\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 72 (tinyint)\n" +
+			"     │       │   │   └─ Eq\n" +
+			"     │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │       └─ 73 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 37 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 43 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 9 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 50 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<31 AND v2<>14 AND v3 BETWEEN 0 AND 10 AND v4>=95) OR (v1<>91)) OR (v1<>35));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 31 (tinyint)\n" +
-			" │   │   │   │   │   └─ NOT\n" +
-			" │   │   │   │   │       └─ Eq\n" +
-			" │   │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │           └─ 14 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 0 (tinyint) AND 10 (tinyint))\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 95 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │           └─ 91 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 35 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 31 (tinyint)\n" +
+			"     │   │   │   │   └─ NOT\n" +
+			"     │   │   │   │       └─ Eq\n" +
+			"     │   │   │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │           └─ 14 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 0 (tinyint) AND 10 (tinyint))\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 95 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v1:1\n" +
+			"     │           └─ 91 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v1:1\n" +
+			"             └─ 35 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>13) OR (v1<>3 AND v4<=42 AND v2 BETWEEN 89 AND 94));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ GreaterThan\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 13 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 3 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 42 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v2:2 BETWEEN 89 (tinyint) AND 94 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 3), [89, 94], [NULL, ∞), [NULL, ∞)}, {(3, 13], [89, 94], [NULL, ∞), [NULL, ∞)}, {(13, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 3), [89, 94], [NULL, ∞), [NULL, ∞)}, {(3, 13], [89, 94], [NULL, ∞), [NULL, ∞)}, {(13, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ GreaterThan\n" +
+			"     │   ├─ comp_index_t2.v1:1\n" +
+			"     │   └─ 13 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │       └─ 3 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v4:4\n" +
+			"         │       └─ 42 (tinyint)\n" +
+			"         └─ (comp_index_t2.v2:2 BETWEEN 89 (tinyint) AND 94 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<29 AND v2<=19) AND (v1>=26) OR (v1>=87 AND v2<=12 AND v3=36 AND v4<20)) AND (v1<=24 AND v4>85 AND v2 BETWEEN 1 AND 64) OR (v1>27 AND v2>=8 AND v3<24));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 29 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 19 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 26 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 87 (tinyint)\n" +
-			" │   │   │       │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │   │       └─ 12 (tinyint)\n" +
-			" │   │   │       │   └─ Eq\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 36 (tinyint)\n" +
-			" │   │   │       └─ LessThan\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 20 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 24 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 85 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v2:2 BETWEEN 1 (tinyint) AND 64 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 27 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 8 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 24 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(27, ∞), [8, ∞), (NULL, 24), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(27, ∞), [8, ∞), (NULL, 24), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 29 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 19 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 26 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 87 (tinyint)\n" +
+			"     │   │       │   │   └─ LessThanOrEqual\n" +
+			"     │   │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │   │       └─ 12 (tinyint)\n" +
+			"     │   │       │   └─ Eq\n" +
+			"     │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │       └─ 36 (tinyint)\n" +
+			"     │   │       └─ LessThan\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 20 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 24 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 85 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v2:2 BETWEEN 1 (tinyint) AND 64 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 27 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 8 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 24 (tinyint)\n" +
 			"",
 	},
 	{
@@ -6423,161 +6258,157 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>61 AND v2 BETWEEN 46 AND 51) OR (v1 BETWEEN 32 AND 75 AND v4<=32)) AND (v1>97) OR (v1<97));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │       └─ 61 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 46 (tinyint) AND 51 (tinyint))\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ (comp_index_t2.v1:1 BETWEEN 32 (tinyint) AND 75 (tinyint))\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 32 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 97 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 97), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(97, ∞), [46, 51], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 97), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(97, ∞), [46, 51], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │       └─ 61 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 46 (tinyint) AND 51 (tinyint))\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ (comp_index_t2.v1:1 BETWEEN 32 (tinyint) AND 75 (tinyint))\n" +
+			"     │   │       └─ LessThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 32 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 97 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 97 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 4 AND 71 AND v2<=70) AND (v1<>47 AND v2 BETWEEN 19 AND 65) OR (v1=59 AND v2 BETWEEN 25 AND 58));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 4 (tinyint) AND 71 (tinyint))\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 70 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ NOT\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │       └─ 47 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v2:2 BETWEEN 19 (tinyint) AND 65 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 59 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v2:2 BETWEEN 25 (tinyint) AND 58 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[4, 47), [19, 65], [NULL, ∞), [NULL, ∞)}, {(47, 71], [19, 65], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[4, 47), [19, 65], [NULL, ∞), [NULL, ∞)}, {(47, 71], [19, 65], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ (comp_index_t2.v1:1 BETWEEN 4 (tinyint) AND 71 (tinyint))\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 70 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ NOT\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │       └─ 47 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v2:2 BETWEEN 19 (tinyint) AND 65 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 59 (tinyint)\n" +
+			"         └─ (comp_index_t2.v2:2 BETWEEN 25 (tinyint) AND 58 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<70 AND v2<=90) OR (v1<5 AND v2<>13 AND v3 BETWEEN 20 AND 96 AND v4>92)) OR (v1<>76)) OR (v1 BETWEEN 12 AND 88 AND v2 BETWEEN 53 AND 67 AND v3>=39));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 70 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 90 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ LessThan\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 5 (tinyint)\n" +
-			" │   │   │       │   │   └─ NOT\n" +
-			" │   │   │       │   │       └─ Eq\n" +
-			" │   │   │       │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │   │           └─ 13 (tinyint)\n" +
-			" │   │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 20 (tinyint) AND 96 (tinyint))\n" +
-			" │   │   │       └─ GreaterThan\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 92 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │           └─ 76 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 12 (tinyint) AND 88 (tinyint))\n" +
-			" │       │   └─ (comp_index_t2.v2:2 BETWEEN 53 (tinyint) AND 67 (tinyint))\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 76), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[76, 76], [53, 67], [39, ∞), [NULL, ∞)}, {(76, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 76), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[76, 76], [53, 67], [39, ∞), [NULL, ∞)}, {(76, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 70 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 90 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ LessThan\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 5 (tinyint)\n" +
+			"     │   │       │   │   └─ NOT\n" +
+			"     │   │       │   │       └─ Eq\n" +
+			"     │   │       │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │   │           └─ 13 (tinyint)\n" +
+			"     │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 20 (tinyint) AND 96 (tinyint))\n" +
+			"     │   │       └─ GreaterThan\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 92 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v1:1\n" +
+			"     │           └─ 76 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t2.v1:1 BETWEEN 12 (tinyint) AND 88 (tinyint))\n" +
+			"         │   └─ (comp_index_t2.v2:2 BETWEEN 53 (tinyint) AND 67 (tinyint))\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 39 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1 BETWEEN 8 AND 38 AND v2<=31 AND v3 BETWEEN 30 AND 46 AND v4>=28) OR (v1<=22 AND v4<>40 AND v2>76 AND v3 BETWEEN 38 AND 42)) OR (v1<=52 AND v2<93 AND v3>=83)) OR (v1>=33 AND v3>13 AND v4>34));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 8 (tinyint) AND 38 (tinyint))\n" +
-			" │   │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │   │       └─ 31 (tinyint)\n" +
-			" │   │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 30 (tinyint) AND 46 (tinyint))\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 28 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 22 (tinyint)\n" +
-			" │   │   │       │   │   └─ NOT\n" +
-			" │   │   │       │   │       └─ Eq\n" +
-			" │   │   │       │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │   │           └─ 40 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 76 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t2.v3:3 BETWEEN 38 (tinyint) AND 42 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 52 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 93 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 83 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 33 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 13 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 34 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 22], (76, ∞), [38, 42], (NULL, 40)}, {(NULL, 22], (76, ∞), [38, 42], (40, ∞)}, {(NULL, 33), (NULL, 93), [83, ∞), [NULL, ∞)}, {[8, 33), (NULL, 31], [30, 46], [28, ∞)}, {[33, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 22], (76, ∞), [38, 42], (NULL, 40)}, {(NULL, 22], (76, ∞), [38, 42], (40, ∞)}, {(NULL, 33), (NULL, 93), [83, ∞), [NULL, ∞)}, {[8, 33), (NULL, 31], [30, 46], [28, ∞)}, {[33, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 8 (tinyint) AND 38 (tinyint))\n" +
+			"     │   │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │   │       └─ 31 (tinyint)\n" +
+			"     │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 30 (tinyint) AND 46 (tinyint))\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 28 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ LessThanOrEqual\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 22 (tinyint)\n" +
+			"     │   │       │   │   └─ NOT\n" +
+			"     │   │       │   │       └─ Eq\n" +
+			"     │   │       │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │       │   │           └─ 40 (tinyint)\n" +
+			"     │   │       │   └─ GreaterThan\n" +
+			"     │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │       └─ 76 (tinyint)\n" +
+			"     │   │       └─ (comp_index_t2.v3:3 BETWEEN 38 (tinyint) AND 42 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 52 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 93 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 83 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 33 (tinyint)\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 13 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 34 (tinyint)\n" +
 			"",
 	},
 	{
@@ -6590,133 +6421,130 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>35 AND v2<>26) OR (v1<=30 AND v2 BETWEEN 6 AND 61 AND v3<=95 AND v4>5)) AND (v1<>97) OR (v1>31));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 35 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │           └─ 26 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 30 (tinyint)\n" +
-			" │   │   │       │   │   └─ (comp_index_t2.v2:2 BETWEEN 6 (tinyint) AND 61 (tinyint))\n" +
-			" │   │   │       │   └─ LessThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 95 (tinyint)\n" +
-			" │   │   │       └─ GreaterThan\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 5 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │           └─ 97 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 30], [6, 61], (NULL, 95], (5, ∞)}, {(31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 30], [6, 61], (NULL, 95], (5, ∞)}, {(31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 35 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │           └─ 26 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ LessThanOrEqual\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 30 (tinyint)\n" +
+			"     │   │       │   │   └─ (comp_index_t2.v2:2 BETWEEN 6 (tinyint) AND 61 (tinyint))\n" +
+			"     │   │       │   └─ LessThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │       └─ 95 (tinyint)\n" +
+			"     │   │       └─ GreaterThan\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 5 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v1:1\n" +
+			"     │           └─ 97 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 31 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1=43 AND v2>=64) OR (v1>6 AND v3=92 AND v4>=15)) OR (v1<=55 AND v3=6 AND v4<=77 AND v2<=3)) OR (v1=96 AND v3<=80 AND v4<=13));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 43 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 64 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 6 (tinyint)\n" +
-			" │   │   │       │   └─ Eq\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 92 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 15 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 55 (tinyint)\n" +
-			" │   │       │   │   └─ Eq\n" +
-			" │   │       │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │   │       └─ 6 (tinyint)\n" +
-			" │   │       │   └─ LessThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 77 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 3 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ Eq\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 96 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 80 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 13 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 6], (NULL, 3], [6, 6], (NULL, 77]}, {(6, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 6], (NULL, 3], [6, 6], (NULL, 77]}, {(6, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 43 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 64 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ GreaterThan\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 6 (tinyint)\n" +
+			"     │   │       │   └─ Eq\n" +
+			"     │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │       └─ 92 (tinyint)\n" +
+			"     │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 15 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 55 (tinyint)\n" +
+			"     │       │   │   └─ Eq\n" +
+			"     │       │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │   │       └─ 6 (tinyint)\n" +
+			"     │       │   └─ LessThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 77 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 3 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ Eq\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 96 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 80 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 13 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>8 AND v3 BETWEEN 14 AND 75 AND v4=28) AND (v1>=95 AND v2<>72 AND v3=22) OR (v1=5));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 8 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 14 (tinyint) AND 75 (tinyint))\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 28 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 95 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │           └─ 72 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 22 (tinyint)\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 5 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[5, 5], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[95, ∞), (NULL, 72), [22, 22], [28, 28]}, {[95, ∞), (72, ∞), [22, 22], [28, 28]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[5, 5], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[95, ∞), (NULL, 72), [22, 22], [28, 28]}, {[95, ∞), (72, ∞), [22, 22], [28, 28]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 8 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 14 (tinyint) AND 75 (tinyint))\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 28 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 95 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t2.v2:2\n" +
+			"     │       │           └─ 72 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 22 (tinyint)\n" +
+			"     └─ Eq\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 5 (tinyint)\n" +
 			"",
 	},
 	{
@@ -6737,34 +6565,33 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=89 AND v2<=1 AND v3<=7 AND v4>=4) AND (v1<=87) OR (v1 BETWEEN 10 AND 46 AND v2 BETWEEN 18 AND 76));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 89 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 1 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 7 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 4 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 87 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t2.v1:1 BETWEEN 10 (tinyint) AND 46 (tinyint))\n" +
-			" │       └─ (comp_index_t2.v2:2 BETWEEN 18 (tinyint) AND 76 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[10, 46], [18, 76], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[10, 46], [18, 76], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 89 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 1 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 7 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 4 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 87 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t2.v1:1 BETWEEN 10 (tinyint) AND 46 (tinyint))\n" +
+			"         └─ (comp_index_t2.v2:2 BETWEEN 18 (tinyint) AND 76 (tinyint))\n" +
 			"",
 	},
 	{
@@ -6785,39 +6612,38 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 49 AND 53 AND v4 BETWEEN 22 AND 96) OR (v1 BETWEEN 7 AND 79)) AND (v1<=45 AND v2<=11) OR (v1 BETWEEN 16 AND 65 AND v2<53 AND v3<>15 AND v4>22));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 49 (tinyint) AND 53 (tinyint))\n" +
-			" │   │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 22 (tinyint) AND 96 (tinyint))\n" +
-			" │   │   │   └─ (comp_index_t2.v1:1 BETWEEN 7 (tinyint) AND 79 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 45 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 11 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 16 (tinyint) AND 65 (tinyint))\n" +
-			" │       │   │   └─ LessThan\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 53 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v3:3\n" +
-			" │       │           └─ 15 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 22 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[7, 45], (NULL, 11], [NULL, ∞), [NULL, ∞)}, {[16, 45], (11, 53), (NULL, 15), (22, ∞)}, {[16, 45], (11, 53), (15, ∞), (22, ∞)}, {(45, 65], (NULL, 53), (NULL, 15), (22, ∞)}, {(45, 65], (NULL, 53), (15, ∞), (22, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[7, 45], (NULL, 11], [NULL, ∞), [NULL, ∞)}, {[16, 45], (11, 53), (NULL, 15), (22, ∞)}, {[16, 45], (11, 53), (15, ∞), (22, ∞)}, {(45, 65], (NULL, 53), (NULL, 15), (22, ∞)}, {(45, 65], (NULL, 53), (15, ∞), (22, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 49 (tinyint) AND 53 (tinyint))\n" +
+			"     │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 22 (tinyint) AND 96 (tinyint))\n" +
+			"     │   │   └─ (comp_index_t2.v1:1 BETWEEN 7 (tinyint) AND 79 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 45 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 11 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ (comp_index_t2.v1:1 BETWEEN 16 (tinyint) AND 65 (tinyint))\n" +
+			"         │   │   └─ LessThan\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 53 (tinyint)\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t2.v3:3\n" +
+			"         │           └─ 15 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 22 (tinyint)\n" +
 			"",
 	},
 	{
@@ -6830,58 +6656,56 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=97 AND v3<>2) OR (v1=49 AND v2 BETWEEN 29 AND 30 AND v3<>97));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 97 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 2 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ Eq\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 49 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v2:2 BETWEEN 29 (tinyint) AND 30 (tinyint))\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v3:3\n" +
-			" │               └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 97], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 97], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ LessThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 97 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 2 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ Eq\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 49 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v2:2 BETWEEN 29 (tinyint) AND 30 (tinyint))\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v3:3\n" +
+			"                 └─ 97 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=64) OR (v1>21 AND v2 BETWEEN 0 AND 58)) OR (v1<15 AND v4 BETWEEN 63 AND 76 AND v2>84));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 64 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThan\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 21 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v2:2 BETWEEN 0 (tinyint) AND 58 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 15 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v4:4 BETWEEN 63 (tinyint) AND 76 (tinyint))\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 84 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 64], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(64, ∞), [0, 58], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 64], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(64, ∞), [0, 58], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ LessThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 64 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ GreaterThan\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 21 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v2:2 BETWEEN 0 (tinyint) AND 58 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 15 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v4:4 BETWEEN 63 (tinyint) AND 76 (tinyint))\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 84 (tinyint)\n" +
 			"",
 	},
 	{
@@ -6894,52 +6718,51 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<>37) OR (v1<=94 AND v2 BETWEEN 53 AND 65 AND v3>=9)) OR (v1<10 AND v3<>26 AND v4<91)) OR (v1<>21 AND v2<>24 AND v3<46));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 37 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 94 (tinyint)\n" +
-			" │   │   │       │   └─ (comp_index_t2.v2:2 BETWEEN 53 (tinyint) AND 65 (tinyint))\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 9 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 10 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v3:3\n" +
-			" │   │       │           └─ 26 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 91 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 21 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v2:2\n" +
-			" │       │           └─ 24 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 37), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[37, 37], (NULL, 24), (NULL, 46), [NULL, ∞)}, {[37, 37], (24, 53), (NULL, 46), [NULL, ∞)}, {[37, 37], [53, 65], (NULL, ∞), [NULL, ∞)}, {[37, 37], (65, ∞), (NULL, 46), [NULL, ∞)}, {(37, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 37), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[37, 37], (NULL, 24), (NULL, 46), [NULL, ∞)}, {[37, 37], (24, 53), (NULL, 46), [NULL, ∞)}, {[37, 37], [53, 65], (NULL, ∞), [NULL, ∞)}, {[37, 37], (65, ∞), (NULL, 46), [NULL, ∞)}, {(37, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ NOT\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 37 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ LessThanOrEqual\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 94 (tinyint)\n" +
+			"     │   │       │   └─ (comp_index_t2.v2:2 BETWEEN 53 (tinyint) AND 65 (tinyint))\n" +
+			"     │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 9 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 10 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t2.v3:3\n" +
+			"     │       │           └─ 26 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 91 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │       └─ 21 (tinyint)\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t2.v2:2\n" +
+			"         │           └─ 24 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 46 (tinyint)\n" +
 			"",
 	},
 	{
@@ -6960,105 +6783,102 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>91 AND v2=91 AND v3>=15) OR (v1 BETWEEN 16 AND 30)) OR (v1<>27 AND v4=62));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │       └─ 91 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 91 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 15 (tinyint)\n" +
-			" │   │   └─ (comp_index_t2.v1:1 BETWEEN 16 (tinyint) AND 30 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 27 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │       └─ 91 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 91 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 15 (tinyint)\n" +
+			"     │   └─ (comp_index_t2.v1:1 BETWEEN 16 (tinyint) AND 30 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v1:1\n" +
+			"         │       └─ 27 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 62 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=54 AND v3>26 AND v4>30 AND v2 BETWEEN 3 AND 8) OR (v1>8 AND v2<=43 AND v3<>97));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 54 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 26 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 30 (tinyint)\n" +
-			" │   │   └─ (comp_index_t2.v2:2 BETWEEN 3 (tinyint) AND 8 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 8 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 43 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v3:3\n" +
-			" │               └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(8, ∞), (NULL, 43], (NULL, 97), [NULL, ∞)}, {(8, ∞), (NULL, 43], (97, ∞), [NULL, ∞)}, {[54, 54], [3, 8], [97, 97], (30, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(8, ∞), (NULL, 43], (NULL, 97), [NULL, ∞)}, {(8, ∞), (NULL, 43], (97, ∞), [NULL, ∞)}, {[54, 54], [3, 8], [97, 97], (30, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 54 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 26 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 30 (tinyint)\n" +
+			"     │   └─ (comp_index_t2.v2:2 BETWEEN 3 (tinyint) AND 8 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 8 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 43 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v3:3\n" +
+			"                 └─ 97 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=38 AND v2<>11 AND v3>=26) OR (v1 BETWEEN 37 AND 90 AND v4<85 AND v2<0)) OR (v1<>23));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 38 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │           └─ 11 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 26 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 90 (tinyint))\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 85 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 0 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 23 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 23), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(23, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 23), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(23, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 38 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │           └─ 11 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 26 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 90 (tinyint))\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 85 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 0 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v1:1\n" +
+			"             └─ 23 (tinyint)\n" +
 			"",
 	},
 	{
@@ -7087,69 +6907,68 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>5 AND v2>8) OR (v1>78 AND v2<=39 AND v3>=41 AND v4<=35)) AND (v1<=11 AND v2<35 AND v3<=10 AND v4<76) OR (v1>=22)) OR (v1=1 AND v4<>29 AND v2 BETWEEN 64 AND 81 AND v3>46));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 5 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 8 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ AND\n" +
-			" │   │   │   │       │   ├─ AND\n" +
-			" │   │   │   │       │   │   ├─ GreaterThan\n" +
-			" │   │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       │   │   │   └─ 78 (tinyint)\n" +
-			" │   │   │   │       │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       │   │       └─ 39 (tinyint)\n" +
-			" │   │   │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       │       └─ 41 (tinyint)\n" +
-			" │   │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │           └─ 35 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 11 (tinyint)\n" +
-			" │   │   │       │   │   └─ LessThan\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │   │       └─ 35 (tinyint)\n" +
-			" │   │   │       │   └─ LessThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 10 (tinyint)\n" +
-			" │   │   │       └─ LessThan\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 76 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 22 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ Eq\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 1 (tinyint)\n" +
-			" │       │   │   └─ NOT\n" +
-			" │       │   │       └─ Eq\n" +
-			" │       │   │           ├─ comp_index_t2.v4:4\n" +
-			" │       │   │           └─ 29 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v2:2 BETWEEN 64 (tinyint) AND 81 (tinyint))\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[1, 1], [64, 81], (46, ∞), (NULL, 29)}, {[1, 1], [64, 81], (46, ∞), (29, ∞)}, {(5, 11], (8, 35), (NULL, 10], (NULL, 76)}, {[22, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[1, 1], [64, 81], (46, ∞), (NULL, 29)}, {[1, 1], [64, 81], (46, ∞), (29, ∞)}, {(5, 11], (8, 35), (NULL, 10], (NULL, 76)}, {[22, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 5 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 8 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ AND\n" +
+			"     │   │   │       │   ├─ AND\n" +
+			"     │   │   │       │   │   ├─ GreaterThan\n" +
+			"     │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       │   │   │   └─ 78 (tinyint)\n" +
+			"     │   │   │       │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       │   │       └─ 39 (tinyint)\n" +
+			"     │   │   │       │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       │       └─ 41 (tinyint)\n" +
+			"     │   │   │       └─ LessThanOrEqual\n" +
+			"     │   │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │           └─ 35 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ LessThanOrEqual\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 11 (tinyint)\n" +
+			"     │   │       │   │   └─ LessThan\n" +
+			"     │   │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │   │       └─ 35 (tinyint)\n" +
+			"     │   │       │   └─ LessThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │       └─ 10 (tinyint)\n" +
+			"     │   │       └─ LessThan\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 76 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 22 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 1 (tinyint)\n" +
+			"         │   │   └─ NOT\n" +
+			"         │   │       └─ Eq\n" +
+			"         │   │           ├─ comp_index_t2.v4:4\n" +
+			"         │   │           └─ 29 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v2:2 BETWEEN 64 (tinyint) AND 81 (tinyint))\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 46 (tinyint)\n" +
 			"",
 	},
 	{
@@ -7162,46 +6981,45 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>=72) OR (v1<>17)) OR (v1=47 AND v2<>1 AND v3 BETWEEN 75 AND 78 AND v4 BETWEEN 10 AND 44)) OR (v1>=64 AND v2>=74 AND v3=10 AND v4 BETWEEN 11 AND 93));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 72 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │   │           └─ 17 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ Eq\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 47 (tinyint)\n" +
-			" │   │       │   │   └─ NOT\n" +
-			" │   │       │   │       └─ Eq\n" +
-			" │   │       │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │           └─ 1 (tinyint)\n" +
-			" │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 75 (tinyint) AND 78 (tinyint))\n" +
-			" │   │       └─ (comp_index_t2.v4:4 BETWEEN 10 (tinyint) AND 44 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 64 (tinyint)\n" +
-			" │       │   │   └─ GreaterThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 74 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 10 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v4:4 BETWEEN 11 (tinyint) AND 93 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 17), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(17, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 17), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(17, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 72 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v1:1\n" +
+			"     │   │           └─ 17 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ Eq\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 47 (tinyint)\n" +
+			"     │       │   │   └─ NOT\n" +
+			"     │       │   │       └─ Eq\n" +
+			"     │       │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │           └─ 1 (tinyint)\n" +
+			"     │       │   └─ (comp_index_t2.v3:3 BETWEEN 75 (tinyint) AND 78 (tinyint))\n" +
+			"     │       └─ (comp_index_t2.v4:4 BETWEEN 10 (tinyint) AND 44 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 64 (tinyint)\n" +
+			"         │   │   └─ GreaterThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 74 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 10 (tinyint)\n" +
+			"         └─ (comp_index_t2.v4:4 BETWEEN 11 (tinyint) AND 93 (tinyint))\n" +
 			"",
 	},
 	{
@@ -7214,176 +7032,172 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<>11 AND v2>47 AND v3>=67 AND v4=29) OR (v1>=59 AND v3 BETWEEN 4 AND 29 AND v4>=65 AND v2<>96)) OR (v1<=62)) OR (v1<61 AND v2<>28 AND v3<>8 AND v4<>30));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │       └─ 11 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │   │       └─ 47 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 67 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 29 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 59 (tinyint)\n" +
-			" │   │   │       │   │   └─ (comp_index_t2.v3:3 BETWEEN 4 (tinyint) AND 29 (tinyint))\n" +
-			" │   │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │       └─ 65 (tinyint)\n" +
-			" │   │   │       └─ NOT\n" +
-			" │   │   │           └─ Eq\n" +
-			" │   │   │               ├─ comp_index_t2.v2:2\n" +
-			" │   │   │               └─ 96 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 62 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 61 (tinyint)\n" +
-			" │       │   │   └─ NOT\n" +
-			" │       │   │       └─ Eq\n" +
-			" │       │   │           ├─ comp_index_t2.v2:2\n" +
-			" │       │   │           └─ 28 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v3:3\n" +
-			" │       │           └─ 8 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v4:4\n" +
-			" │               └─ 30 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 62], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(62, ∞), (NULL, 96), [4, 29], [65, ∞)}, {(62, ∞), (47, ∞), [67, ∞), [29, 29]}, {(62, ∞), (96, ∞), [4, 29], [65, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 62], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(62, ∞), (NULL, 96), [4, 29], [65, ∞)}, {(62, ∞), (47, ∞), [67, ∞), [29, 29]}, {(62, ∞), (96, ∞), [4, 29], [65, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   │       └─ 11 (tinyint)\n" +
+			"     │   │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │   │       └─ 47 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │       └─ 67 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 29 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 59 (tinyint)\n" +
+			"     │   │       │   │   └─ (comp_index_t2.v3:3 BETWEEN 4 (tinyint) AND 29 (tinyint))\n" +
+			"     │   │       │   └─ GreaterThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       │       └─ 65 (tinyint)\n" +
+			"     │   │       └─ NOT\n" +
+			"     │   │           └─ Eq\n" +
+			"     │   │               ├─ comp_index_t2.v2:2\n" +
+			"     │   │               └─ 96 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 62 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 61 (tinyint)\n" +
+			"         │   │   └─ NOT\n" +
+			"         │   │       └─ Eq\n" +
+			"         │   │           ├─ comp_index_t2.v2:2\n" +
+			"         │   │           └─ 28 (tinyint)\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t2.v3:3\n" +
+			"         │           └─ 8 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v4:4\n" +
+			"                 └─ 30 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 36 AND 72) OR (v1<>48 AND v4>91 AND v2<5 AND v3>=38)) OR (v1<>17 AND v3=50));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ (comp_index_t2.v1:1 BETWEEN 36 (tinyint) AND 72 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ NOT\n" +
-			" │   │       │   │   │   └─ Eq\n" +
-			" │   │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │       └─ 48 (tinyint)\n" +
-			" │   │       │   │   └─ GreaterThan\n" +
-			" │   │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │   │       └─ 91 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 5 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 38 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 17 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 50 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 17), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[17, 17], (NULL, 5), [38, ∞), (91, ∞)}, {(17, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 17), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[17, 17], (NULL, 5), [38, ∞), (91, ∞)}, {(17, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ (comp_index_t2.v1:1 BETWEEN 36 (tinyint) AND 72 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ NOT\n" +
+			"     │       │   │   │   └─ Eq\n" +
+			"     │       │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │       └─ 48 (tinyint)\n" +
+			"     │       │   │   └─ GreaterThan\n" +
+			"     │       │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │   │       └─ 91 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 5 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 38 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v1:1\n" +
+			"         │       └─ 17 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 50 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<86) OR (v1<=5 AND v2<25 AND v3<>24)) OR (v1<32 AND v3 BETWEEN 51 AND 54 AND v4<=70));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 86 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 5 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 25 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │               └─ 24 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 32 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v3:3 BETWEEN 51 (tinyint) AND 54 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 70 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 86), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 86), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ LessThan\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 86 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 5 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 25 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v3:3\n" +
+			"     │               └─ 24 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 32 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v3:3 BETWEEN 51 (tinyint) AND 54 (tinyint))\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 70 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<=6) OR (v1 BETWEEN 24 AND 89)) OR (v1<87 AND v2=35 AND v3=19)) AND (v1>94 AND v2=33 AND v3>28) OR (v1 BETWEEN 36 AND 40));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 6 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t2.v1:1 BETWEEN 24 (tinyint) AND 89 (tinyint))\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ LessThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 87 (tinyint)\n" +
-			" │   │   │       │   └─ Eq\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 35 (tinyint)\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 19 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 94 (tinyint)\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 33 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 28 (tinyint)\n" +
-			" │   └─ (comp_index_t2.v1:1 BETWEEN 36 (tinyint) AND 40 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[36, 40], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[36, 40], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 6 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t2.v1:1 BETWEEN 24 (tinyint) AND 89 (tinyint))\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ LessThan\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 87 (tinyint)\n" +
+			"     │   │       │   └─ Eq\n" +
+			"     │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │       └─ 35 (tinyint)\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 19 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 94 (tinyint)\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 33 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 28 (tinyint)\n" +
+			"     └─ (comp_index_t2.v1:1 BETWEEN 36 (tinyint) AND 40 (tinyint))\n" +
 			"",
 	},
 	{
@@ -7396,42 +7210,41 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 0 AND 87 AND v2>=44 AND v3<>68 AND v4=50) OR (v1<1 AND v4<66 AND v2<11 AND v3<>44));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 0 (tinyint) AND 87 (tinyint))\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 44 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 68 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 50 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 1 (tinyint)\n" +
-			" │       │   │   └─ LessThan\n" +
-			" │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │       │   │       └─ 66 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 11 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v3:3\n" +
-			" │               └─ 44 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 1), (NULL, 11), (NULL, 44), (NULL, 66)}, {(NULL, 1), (NULL, 11), (44, ∞), (NULL, 66)}, {[0, 87], [44, ∞), (NULL, 68), [50, 50]}, {[0, 87], [44, ∞), (68, ∞), [50, 50]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 1), (NULL, 11), (NULL, 44), (NULL, 66)}, {(NULL, 1), (NULL, 11), (44, ∞), (NULL, 66)}, {[0, 87], [44, ∞), (NULL, 68), [50, 50]}, {[0, 87], [44, ∞), (68, ∞), [50, 50]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 0 (tinyint) AND 87 (tinyint))\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 44 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 68 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 50 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 1 (tinyint)\n" +
+			"         │   │   └─ LessThan\n" +
+			"         │   │       ├─ comp_index_t2.v4:4\n" +
+			"         │   │       └─ 66 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 11 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v3:3\n" +
+			"                 └─ 44 (tinyint)\n" +
 			"",
 	},
 	{
@@ -7452,57 +7265,55 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 0 AND 39) OR (v1<18 AND v4>=90));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ (comp_index_t2.v1:1 BETWEEN 0 (tinyint) AND 39 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 18 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 90 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 39], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 39], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ (comp_index_t2.v1:1 BETWEEN 0 (tinyint) AND 39 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 18 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 90 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<99 AND v2>1 AND v3<=56) OR (v1>36 AND v2=53 AND v3>17)) OR (v1<>71)) AND (v1 BETWEEN 2 AND 86 AND v2<>78 AND v3<>29 AND v4<>63);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 99 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 1 (tinyint)\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 56 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 36 (tinyint)\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 53 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 17 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[2, 71), (NULL, 78), (NULL, 29), (NULL, 63)}, {[2, 71), (NULL, 78), (NULL, 29), (63, ∞)}, {[2, 71), (NULL, 78), (29, ∞), (NULL, 63)}, {[2, 71), (NULL, 78), (29, ∞), (63, ∞)}, {[2, 71), (78, ∞), (29, ∞), (NULL, 63)}, {[2, 71), (78, ∞), (29, ∞), (63, ∞)}, {[2, 86], (78, ∞), (NULL, 29), (NULL, 63)}, {[2, 86], (78, ∞), (NULL, 29), (63, ∞)}, {[71, 71], (1, 53), (29, 56], (NULL, 63)}, {[71, 71], (1, 53), (29, 56], (63, ∞)}, {[71, 71], (1, 78), (NULL, 29), (NULL, 63)}, {[71, 71], (1, 78), (NULL, 29), (63, ∞)}, {[71, 71], [53, 53], (29, ∞), (NULL, 63)}, {[71, 71], [53, 53], (29, ∞), (63, ∞)}, {[71, 71], (53, 78), (29, 56], (NULL, 63)}, {[71, 71], (53, 78), (29, 56], (63, ∞)}, {[71, 71], (78, ∞), (29, 56], (NULL, 63)}, {[71, 71], (78, ∞), (29, 56], (63, ∞)}, {(71, 86], (NULL, 78), (NULL, 29), (NULL, 63)}, {(71, 86], (NULL, 78), (NULL, 29), (63, ∞)}, {(71, 86], (NULL, 78), (29, ∞), (NULL, 63)}, {(71, 86], (NULL, 78), (29, ∞), (63, ∞)}, {(71, 86], (78, ∞), (29, ∞), (NULL, 63)}, {(71, 86], (78, ∞), (29, ∞), (63, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[2, 71), (NULL, 78), (NULL, 29), (NULL, 63)}, {[2, 71), (NULL, 78), (NULL, 29), (63, ∞)}, {[2, 71), (NULL, 78), (29, ∞), (NULL, 63)}, {[2, 71), (NULL, 78), (29, ∞), (63, ∞)}, {[2, 71), (78, ∞), (29, ∞), (NULL, 63)}, {[2, 71), (78, ∞), (29, ∞), (63, ∞)}, {[2, 86], (78, ∞), (NULL, 29), (NULL, 63)}, {[2, 86], (78, ∞), (NULL, 29), (63, ∞)}, {[71, 71], (1, 53), (29, 56], (NULL, 63)}, {[71, 71], (1, 53), (29, 56], (63, ∞)}, {[71, 71], (1, 78), (NULL, 29), (NULL, 63)}, {[71, 71], (1, 78), (NULL, 29), (63, ∞)}, {[71, 71], [53, 53], (29, ∞), (NULL, 63)}, {[71, 71], [53, 53], (29, ∞), (63, ∞)}, {[71, 71], (53, 78), (29, 56], (NULL, 63)}, {[71, 71], (53, 78), (29, 56], (63, ∞)}, {[71, 71], (78, ∞), (29, 56], (NULL, 63)}, {[71, 71], (78, ∞), (29, 56], (63, ∞)}, {(71, 86], (NULL, 78), (NULL, 29), (NULL, 63)}, {(71, 86], (NULL, 78), (NULL, 29), (63, ∞)}, {(71, 86], (NULL, 78), (29, ∞), (NULL, 63)}, {(71, 86], (NULL, 78), (29, ∞), (63, ∞)}, {(71, 86], (78, ∞), (29, ∞), (NULL, 63)}, {(71, 86], (78, ∞), (29, ∞), (63, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 99 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 1 (tinyint)\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 56 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 36 (tinyint)\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 53 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 17 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v1:1\n" +
+			"             └─ 71 (tinyint)\n" +
 			"",
 	},
 	{
@@ -7515,14 +7326,13 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<>12 AND v2 BETWEEN 27 AND 46 AND v3 BETWEEN 19 AND 27 AND v4>=50) OR (v1 BETWEEN 17 AND 88)) OR (v1<=36 AND v2<=37 AND v3<64)) OR (v1<>82 AND v2>84 AND v3>=90)) AND (v1>34 AND v3>4);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ GreaterThan\n" +
-			" │   ├─ comp_index_t2.v3:3\n" +
-			" │   └─ 4 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(34, 88], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(88, ∞), [27, 46], [19, 27], [50, ∞)}, {(88, ∞), (84, ∞), [90, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(34, 88], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(88, ∞), [27, 46], [19, 27], [50, ∞)}, {(88, ∞), (84, ∞), [90, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: GreaterThan\n" +
+			"     ├─ comp_index_t2.v3:3\n" +
+			"     └─ 4 (tinyint)\n" +
 			"",
 	},
 	{
@@ -7535,425 +7345,414 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=51) AND (v1=55 AND v2>=59 AND v3>=49) OR (v1>5 AND v2<34));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Eq\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 51 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ Eq\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 55 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 59 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 49 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 5 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 34 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(5, ∞), (NULL, 34), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(5, ∞), (NULL, 34), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 51 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ Eq\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 55 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 59 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 49 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 5 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 34 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>4 AND v2<=21 AND v3>=15) OR (v1=93 AND v2>=1 AND v3<>63)) OR (v1 BETWEEN 24 AND 86 AND v3<=5));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 4 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 21 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 15 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ Eq\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 93 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 1 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │               └─ 63 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t2.v1:1 BETWEEN 24 (tinyint) AND 86 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 5 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(4, 24), (NULL, 21], [15, ∞), [NULL, ∞)}, {[24, 86], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(86, 93), (NULL, 21], [15, ∞), [NULL, ∞)}, {[93, 93], (NULL, 1), [15, ∞), [NULL, ∞)}, {[93, 93], [1, 21], (NULL, ∞), [NULL, ∞)}, {[93, 93], (21, ∞), (NULL, 63), [NULL, ∞)}, {[93, 93], (21, ∞), (63, ∞), [NULL, ∞)}, {(93, ∞), (NULL, 21], [15, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(4, 24), (NULL, 21], [15, ∞), [NULL, ∞)}, {[24, 86], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(86, 93), (NULL, 21], [15, ∞), [NULL, ∞)}, {[93, 93], (NULL, 1), [15, ∞), [NULL, ∞)}, {[93, 93], [1, 21], (NULL, ∞), [NULL, ∞)}, {[93, 93], (21, ∞), (NULL, 63), [NULL, ∞)}, {[93, 93], (21, ∞), (63, ∞), [NULL, ∞)}, {(93, ∞), (NULL, 21], [15, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 4 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 21 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 15 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ Eq\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 93 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 1 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v3:3\n" +
+			"     │               └─ 63 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t2.v1:1 BETWEEN 24 (tinyint) AND 86 (tinyint))\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 5 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<63 AND v2<>32 AND v3>=14) OR (v1=18 AND v3 BETWEEN 4 AND 42 AND v4>10)) OR (v1<23 AND v2>=21));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 63 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │           └─ 32 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 14 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ Eq\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 18 (tinyint)\n" +
-			" │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 4 (tinyint) AND 42 (tinyint))\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 10 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 23 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 21 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 18), (NULL, 21), [14, ∞), [NULL, ∞)}, {(NULL, 18), [21, ∞), [NULL, ∞), [NULL, ∞)}, {[18, 18], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(18, 23), (NULL, 21), [14, ∞), [NULL, ∞)}, {(18, 23), [21, ∞), [NULL, ∞), [NULL, ∞)}, {[23, 63), (NULL, 32), [14, ∞), [NULL, ∞)}, {[23, 63), (32, ∞), [14, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 18), (NULL, 21), [14, ∞), [NULL, ∞)}, {(NULL, 18), [21, ∞), [NULL, ∞), [NULL, ∞)}, {[18, 18], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(18, 23), (NULL, 21), [14, ∞), [NULL, ∞)}, {(18, 23), [21, ∞), [NULL, ∞), [NULL, ∞)}, {[23, 63), (NULL, 32), [14, ∞), [NULL, ∞)}, {[23, 63), (32, ∞), [14, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 63 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │           └─ 32 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 14 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ Eq\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 18 (tinyint)\n" +
+			"     │       │   └─ (comp_index_t2.v3:3 BETWEEN 4 (tinyint) AND 42 (tinyint))\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 10 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 23 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 21 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>34 AND v3 BETWEEN 27 AND 48 AND v4<=11 AND v2>42) AND (v1<>47 AND v2<48 AND v3<=47 AND v4<>12) OR (v1<=36 AND v2<>17));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │       └─ 34 (tinyint)\n" +
-			" │   │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 27 (tinyint) AND 48 (tinyint))\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 11 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 42 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ NOT\n" +
-			" │   │       │   │   │   └─ Eq\n" +
-			" │   │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │       └─ 47 (tinyint)\n" +
-			" │   │       │   │   └─ LessThan\n" +
-			" │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │       └─ 48 (tinyint)\n" +
-			" │   │       │   └─ LessThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 47 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v4:4\n" +
-			" │   │               └─ 12 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 36 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v2:2\n" +
-			" │               └─ 17 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 36], (NULL, 17), [NULL, ∞), [NULL, ∞)}, {(NULL, 36], (17, ∞), [NULL, ∞), [NULL, ∞)}, {(36, 47), (42, 48), [27, 47], (NULL, 11]}, {(47, ∞), (42, 48), [27, 47], (NULL, 11]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 36], (NULL, 17), [NULL, ∞), [NULL, ∞)}, {(NULL, 36], (17, ∞), [NULL, ∞), [NULL, ∞)}, {(36, 47), (42, 48), [27, 47], (NULL, 11]}, {(47, ∞), (42, 48), [27, 47], (NULL, 11]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │       └─ 34 (tinyint)\n" +
+			"     │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 27 (tinyint) AND 48 (tinyint))\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 11 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 42 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ NOT\n" +
+			"     │       │   │   │   └─ Eq\n" +
+			"     │       │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │       └─ 47 (tinyint)\n" +
+			"     │       │   │   └─ LessThan\n" +
+			"     │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │       └─ 48 (tinyint)\n" +
+			"     │       │   └─ LessThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 47 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v4:4\n" +
+			"     │               └─ 12 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 36 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v2:2\n" +
+			"                 └─ 17 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=34 AND v2<=80 AND v3<=27) AND (v1 BETWEEN 0 AND 33) OR (v1<=56 AND v2=50 AND v3 BETWEEN 0 AND 5 AND v4<>31));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 34 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 80 (tinyint)\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 27 (tinyint)\n" +
-			" │   │   └─ (comp_index_t2.v1:1 BETWEEN 0 (tinyint) AND 33 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThanOrEqual\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 56 (tinyint)\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 50 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v3:3 BETWEEN 0 (tinyint) AND 5 (tinyint))\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v4:4\n" +
-			" │               └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 56], [50, 50], [0, 5], (NULL, 31)}, {(NULL, 56], [50, 50], [0, 5], (31, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 56], [50, 50], [0, 5], (NULL, 31)}, {(NULL, 56], [50, 50], [0, 5], (31, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 34 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 80 (tinyint)\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 27 (tinyint)\n" +
+			"     │   └─ (comp_index_t2.v1:1 BETWEEN 0 (tinyint) AND 33 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThanOrEqual\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 56 (tinyint)\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 50 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v3:3 BETWEEN 0 (tinyint) AND 5 (tinyint))\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v4:4\n" +
+			"                 └─ 31 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=93 AND v2<>5) OR (v1>=81 AND v4=9 AND v2>33 AND v3<99));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 93 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 5 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 81 (tinyint)\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │       │   │       └─ 9 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 33 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 93], (NULL, 5), [NULL, ∞), [NULL, ∞)}, {(NULL, 93], (5, ∞), [NULL, ∞), [NULL, ∞)}, {(93, ∞), (33, ∞), (NULL, 99), [9, 9]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 93], (NULL, 5), [NULL, ∞), [NULL, ∞)}, {(NULL, 93], (5, ∞), [NULL, ∞), [NULL, ∞)}, {(93, ∞), (33, ∞), (NULL, 99), [9, 9]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ LessThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 93 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 5 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 81 (tinyint)\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v4:4\n" +
+			"         │   │       └─ 9 (tinyint)\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 33 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 99 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=37 AND v2=4 AND v3=3) AND (v1=12 AND v2>9 AND v3<89 AND v4<>12) OR (v1=1 AND v2=43 AND v3<=2));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 37 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 4 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 3 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ Eq\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 12 (tinyint)\n" +
-			" │   │       │   │   └─ GreaterThan\n" +
-			" │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │       └─ 9 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 89 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v4:4\n" +
-			" │   │               └─ 12 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ Eq\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 1 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 43 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 2 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[1, 1], [43, 43], (NULL, 2], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[1, 1], [43, 43], (NULL, 2], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 37 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 4 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 3 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ Eq\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 12 (tinyint)\n" +
+			"     │       │   │   └─ GreaterThan\n" +
+			"     │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │       └─ 9 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 89 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v4:4\n" +
+			"     │               └─ 12 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ Eq\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 1 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 43 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 2 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=82) OR (v1<=4 AND v2>=51)) OR (v1=58 AND v4<86));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Eq\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 82 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 4 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 51 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 58 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 86 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 4], [51, ∞), [NULL, ∞), [NULL, ∞)}, {[58, 58], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 4], [51, ∞), [NULL, ∞), [NULL, ∞)}, {[58, 58], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 82 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 4 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 51 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 58 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 86 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>=42 AND v4<85 AND v2<8 AND v3<3) OR (v1>=78 AND v2<>28 AND v3<52)) OR (v1<8 AND v2<>76 AND v3 BETWEEN 36 AND 70)) OR (v1=70));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 42 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │   │       └─ 85 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 8 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 3 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 78 (tinyint)\n" +
-			" │   │   │       │   └─ NOT\n" +
-			" │   │   │       │       └─ Eq\n" +
-			" │   │   │       │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │           └─ 28 (tinyint)\n" +
-			" │   │   │       └─ LessThan\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 52 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 8 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │           └─ 76 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v3:3 BETWEEN 36 (tinyint) AND 70 (tinyint))\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 70 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 8), (NULL, 76), [36, 70], [NULL, ∞)}, {(NULL, 8), (76, ∞), [36, 70], [NULL, ∞)}, {[42, 70), (NULL, 8), (NULL, 3), (NULL, 85)}, {[70, 70], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(70, 78), (NULL, 8), (NULL, 3), (NULL, 85)}, {[78, ∞), (NULL, 28), (NULL, 52), [NULL, ∞)}, {[78, ∞), (28, ∞), (NULL, 52), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 8), (NULL, 76), [36, 70], [NULL, ∞)}, {(NULL, 8), (76, ∞), [36, 70], [NULL, ∞)}, {[42, 70), (NULL, 8), (NULL, 3), (NULL, 85)}, {[70, 70], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(70, 78), (NULL, 8), (NULL, 3), (NULL, 85)}, {[78, ∞), (NULL, 28), (NULL, 52), [NULL, ∞)}, {[78, ∞), (28, ∞), (NULL, 52), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   │   └─ 42 (tinyint)\n" +
+			"     │   │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │   │       └─ 85 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 8 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 3 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ GreaterThanOrEqual\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 78 (tinyint)\n" +
+			"     │   │       │   └─ NOT\n" +
+			"     │   │       │       └─ Eq\n" +
+			"     │   │       │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │           └─ 28 (tinyint)\n" +
+			"     │   │       └─ LessThan\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 52 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 8 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t2.v2:2\n" +
+			"     │       │           └─ 76 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v3:3 BETWEEN 36 (tinyint) AND 70 (tinyint))\n" +
+			"     └─ Eq\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 70 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>69) OR (v1>=43));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 69 (tinyint)\n" +
-			" │   └─ GreaterThanOrEqual\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 43 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 69 (tinyint)\n" +
+			"     └─ GreaterThanOrEqual\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 43 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 39 AND 76 AND v4>16 AND v2<>15 AND v3<>35) AND (v1<>50 AND v2>21 AND v3 BETWEEN 27 AND 90 AND v4>18) OR (v1<25 AND v4=58));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 39 (tinyint) AND 76 (tinyint))\n" +
-			" │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │       └─ 16 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │           └─ 15 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 35 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ NOT\n" +
-			" │   │       │   │   │   └─ Eq\n" +
-			" │   │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │       └─ 50 (tinyint)\n" +
-			" │   │       │   │   └─ GreaterThan\n" +
-			" │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │       └─ 21 (tinyint)\n" +
-			" │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 27 (tinyint) AND 90 (tinyint))\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 18 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 25 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 58 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 25), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[39, 50), (21, ∞), [27, 35), (18, ∞)}, {[39, 50), (21, ∞), (35, 90], (18, ∞)}, {(50, 76], (21, ∞), [27, 35), (18, ∞)}, {(50, 76], (21, ∞), (35, 90], (18, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 25), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[39, 50), (21, ∞), [27, 35), (18, ∞)}, {[39, 50), (21, ∞), (35, 90], (18, ∞)}, {(50, 76], (21, ∞), [27, 35), (18, ∞)}, {(50, 76], (21, ∞), (35, 90], (18, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 39 (tinyint) AND 76 (tinyint))\n" +
+			"     │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │       └─ 16 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │           └─ 15 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 35 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ NOT\n" +
+			"     │       │   │   │   └─ Eq\n" +
+			"     │       │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │       └─ 50 (tinyint)\n" +
+			"     │       │   │   └─ GreaterThan\n" +
+			"     │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │       └─ 21 (tinyint)\n" +
+			"     │       │   └─ (comp_index_t2.v3:3 BETWEEN 27 (tinyint) AND 90 (tinyint))\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 18 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 25 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 58 (tinyint)\n" +
 			"",
 	},
 	{
@@ -7990,169 +7789,164 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=19) AND (v1<=20 AND v2>=2) OR (v1 BETWEEN 12 AND 53 AND v4>=1 AND v2<43 AND v3<59));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Eq\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 19 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 20 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 2 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 12 (tinyint) AND 53 (tinyint))\n" +
-			" │       │   │   └─ GreaterThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │       │   │       └─ 1 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 43 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[12, 19), (NULL, 43), (NULL, 59), [1, ∞)}, {[19, 19], (NULL, 2), (NULL, 59), [1, ∞)}, {[19, 19], [2, ∞), [NULL, ∞), [NULL, ∞)}, {(19, 53], (NULL, 43), (NULL, 59), [1, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[12, 19), (NULL, 43), (NULL, 59), [1, ∞)}, {[19, 19], (NULL, 2), (NULL, 59), [1, ∞)}, {[19, 19], [2, ∞), [NULL, ∞), [NULL, ∞)}, {(19, 53], (NULL, 43), (NULL, 59), [1, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 19 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 20 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 2 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ (comp_index_t2.v1:1 BETWEEN 12 (tinyint) AND 53 (tinyint))\n" +
+			"         │   │   └─ GreaterThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v4:4\n" +
+			"         │   │       └─ 1 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 43 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 59 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=42 AND v2<=65) AND (v1<=21) OR (v1<=14 AND v2<>1 AND v3<62));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 42 (tinyint)\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 65 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 21 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 14 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v2:2\n" +
-			" │       │           └─ 1 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 14], (NULL, 1), (NULL, 62), [NULL, ∞)}, {(NULL, 14], (1, ∞), (NULL, 62), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 14], (NULL, 1), (NULL, 62), [NULL, ∞)}, {(NULL, 14], (1, ∞), (NULL, 62), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 42 (tinyint)\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 65 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 21 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 14 (tinyint)\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t2.v2:2\n" +
+			"         │           └─ 1 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 62 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>5) OR (v1<96 AND v2>=14)) OR (v1<>96)) AND (v1<>51 AND v3>41);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 5 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ LessThan\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 96 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 14 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │           └─ 96 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t2.v3:3\n" +
-			" │       └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 51), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(51, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 51), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(51, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: AND\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ NOT\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 5 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ LessThan\n" +
+			"     │   │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   └─ 96 (tinyint)\n" +
+			"     │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 14 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v1:1\n" +
+			"     │           └─ 96 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t2.v3:3\n" +
+			"         └─ 41 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>97 AND v3<>77 AND v4=30 AND v2<>45) OR (v1=36 AND v2<77 AND v3>94)) OR (v1=26));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 97 (tinyint)\n" +
-			" │   │   │   │   │   └─ NOT\n" +
-			" │   │   │   │   │       └─ Eq\n" +
-			" │   │   │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │           └─ 77 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 30 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 45 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ Eq\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 36 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 77 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 94 (tinyint)\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 26 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[26, 26], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[36, 36], (NULL, 77), (94, ∞), [NULL, ∞)}, {(97, ∞), (NULL, 45), (NULL, 77), [30, 30]}, {(97, ∞), (NULL, 45), (77, ∞), [30, 30]}, {(97, ∞), (45, ∞), (NULL, 77), [30, 30]}, {(97, ∞), (45, ∞), (77, ∞), [30, 30]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[26, 26], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[36, 36], (NULL, 77), (94, ∞), [NULL, ∞)}, {(97, ∞), (NULL, 45), (NULL, 77), [30, 30]}, {(97, ∞), (NULL, 45), (77, ∞), [30, 30]}, {(97, ∞), (45, ∞), (NULL, 77), [30, 30]}, {(97, ∞), (45, ∞), (77, ∞), [30, 30]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 97 (tinyint)\n" +
+			"     │   │   │   │   └─ NOT\n" +
+			"     │   │   │   │       └─ Eq\n" +
+			"     │   │   │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │           └─ 77 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 30 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 45 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ Eq\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 36 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 77 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 94 (tinyint)\n" +
+			"     └─ Eq\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 26 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 34 AND 37 AND v3>23 AND v4>31) OR (v1 BETWEEN 43 AND 81 AND v3>=54 AND v4>=72));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 34 (tinyint) AND 37 (tinyint))\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 23 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 31 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 43 (tinyint) AND 81 (tinyint))\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 54 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 72 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[34, 37], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[43, 81], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[34, 37], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[43, 81], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ (comp_index_t2.v1:1 BETWEEN 34 (tinyint) AND 37 (tinyint))\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 23 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 31 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t2.v1:1 BETWEEN 43 (tinyint) AND 81 (tinyint))\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 54 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 72 (tinyint)\n" +
 			"",
 	},
 	{
@@ -8173,179 +7967,175 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=23 AND v2<=48) OR (v1>41 AND v2>=46 AND v3 BETWEEN 11 AND 29)) AND (v1<>11) OR (v1=70 AND v3<54 AND v4<=47 AND v2<>62));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 23 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 48 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 41 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 46 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t2.v3:3 BETWEEN 11 (tinyint) AND 29 (tinyint))\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │           └─ 11 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ Eq\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 70 (tinyint)\n" +
-			" │       │   │   └─ LessThan\n" +
-			" │       │   │       ├─ comp_index_t2.v3:3\n" +
-			" │       │   │       └─ 54 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 47 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v2:2\n" +
-			" │               └─ 62 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[23, 23], (NULL, 48], [NULL, ∞), [NULL, ∞)}, {(41, ∞), [46, ∞), [11, 29], [NULL, ∞)}, {[70, 70], (NULL, 46), (NULL, 54), (NULL, 47]}, {[70, 70], [46, 62), (NULL, 11), (NULL, 47]}, {[70, 70], [46, 62), (29, 54), (NULL, 47]}, {[70, 70], (62, ∞), (NULL, 11), (NULL, 47]}, {[70, 70], (62, ∞), (29, 54), (NULL, 47]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[23, 23], (NULL, 48], [NULL, ∞), [NULL, ∞)}, {(41, ∞), [46, ∞), [11, 29], [NULL, ∞)}, {[70, 70], (NULL, 46), (NULL, 54), (NULL, 47]}, {[70, 70], [46, 62), (NULL, 11), (NULL, 47]}, {[70, 70], [46, 62), (29, 54), (NULL, 47]}, {[70, 70], (62, ∞), (NULL, 11), (NULL, 47]}, {[70, 70], (62, ∞), (29, 54), (NULL, 47]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 23 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 48 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ GreaterThan\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 41 (tinyint)\n" +
+			"     │   │       │   └─ GreaterThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │       └─ 46 (tinyint)\n" +
+			"     │   │       └─ (comp_index_t2.v3:3 BETWEEN 11 (tinyint) AND 29 (tinyint))\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v1:1\n" +
+			"     │           └─ 11 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 70 (tinyint)\n" +
+			"         │   │   └─ LessThan\n" +
+			"         │   │       ├─ comp_index_t2.v3:3\n" +
+			"         │   │       └─ 54 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v4:4\n" +
+			"         │       └─ 47 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v2:2\n" +
+			"                 └─ 62 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>73) OR (v1>5 AND v2>=7 AND v3>=43 AND v4<=53)) OR (v1<34 AND v2<95 AND v3 BETWEEN 9 AND 81 AND v4<>8)) AND (v1<=68 AND v4>48 AND v2>11 AND v3 BETWEEN 17 AND 89) OR (v1=41 AND v2 BETWEEN 56 AND 93));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 73 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ AND\n" +
-			" │   │   │   │       │   ├─ AND\n" +
-			" │   │   │   │       │   │   ├─ GreaterThan\n" +
-			" │   │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       │   │   │   └─ 5 (tinyint)\n" +
-			" │   │   │   │       │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       │   │       └─ 7 (tinyint)\n" +
-			" │   │   │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       │       └─ 43 (tinyint)\n" +
-			" │   │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │           └─ 53 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ LessThan\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 34 (tinyint)\n" +
-			" │   │   │       │   │   └─ LessThan\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │   │       └─ 95 (tinyint)\n" +
-			" │   │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 9 (tinyint) AND 81 (tinyint))\n" +
-			" │   │   │       └─ NOT\n" +
-			" │   │   │           └─ Eq\n" +
-			" │   │   │               ├─ comp_index_t2.v4:4\n" +
-			" │   │   │               └─ 8 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 68 (tinyint)\n" +
-			" │   │       │   │   └─ GreaterThan\n" +
-			" │   │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │   │       └─ 48 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 11 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v3:3 BETWEEN 17 (tinyint) AND 89 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 41 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v2:2 BETWEEN 56 (tinyint) AND 93 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 34), (11, 95), [17, 81], (48, ∞)}, {(5, 34), (11, 95), (81, 89], (48, 53]}, {(5, 34), [95, ∞), [43, 89], (48, 53]}, {[34, 41), (11, ∞), [43, 89], (48, 53]}, {[41, 41], (11, 56), [43, 89], (48, 53]}, {[41, 41], [56, 93], [NULL, ∞), [NULL, ∞)}, {[41, 41], (93, ∞), [43, 89], (48, 53]}, {(41, 68], (11, ∞), [43, 89], (48, 53]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 34), (11, 95), [17, 81], (48, ∞)}, {(5, 34), (11, 95), (81, 89], (48, 53]}, {(5, 34), [95, ∞), [43, 89], (48, 53]}, {[34, 41), (11, ∞), [43, 89], (48, 53]}, {[41, 41], (11, 56), [43, 89], (48, 53]}, {[41, 41], [56, 93], [NULL, ∞), [NULL, ∞)}, {[41, 41], (93, ∞), [43, 89], (48, 53]}, {(41, 68], (11, ∞), [43, 89], (48, 53]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 73 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ AND\n" +
+			"     │   │   │       │   ├─ AND\n" +
+			"     │   │   │       │   │   ├─ GreaterThan\n" +
+			"     │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       │   │   │   └─ 5 (tinyint)\n" +
+			"     │   │   │       │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       │   │       └─ 7 (tinyint)\n" +
+			"     │   │   │       │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       │       └─ 43 (tinyint)\n" +
+			"     │   │   │       └─ LessThanOrEqual\n" +
+			"     │   │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │           └─ 53 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ LessThan\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 34 (tinyint)\n" +
+			"     │   │       │   │   └─ LessThan\n" +
+			"     │   │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │   │       └─ 95 (tinyint)\n" +
+			"     │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 9 (tinyint) AND 81 (tinyint))\n" +
+			"     │   │       └─ NOT\n" +
+			"     │   │           └─ Eq\n" +
+			"     │   │               ├─ comp_index_t2.v4:4\n" +
+			"     │   │               └─ 8 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 68 (tinyint)\n" +
+			"     │       │   │   └─ GreaterThan\n" +
+			"     │       │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │   │       └─ 48 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 11 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v3:3 BETWEEN 17 (tinyint) AND 89 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 41 (tinyint)\n" +
+			"         └─ (comp_index_t2.v2:2 BETWEEN 56 (tinyint) AND 93 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>3 AND v3>=34) OR (v1<>31 AND v2<16 AND v3<8));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 3 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 34 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 31 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 16 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 8 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 3), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[3, 3], (NULL, 16), (NULL, 8), [NULL, ∞)}, {(3, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 3), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[3, 3], (NULL, 16), (NULL, 8), [NULL, ∞)}, {(3, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       └─ 3 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 34 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │       └─ 31 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 16 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 8 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 88 AND 97) OR (v1>67 AND v4<=27 AND v2<5 AND v3>40)) OR (v1 BETWEEN 5 AND 83 AND v2>=34 AND v3=59));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ (comp_index_t2.v1:1 BETWEEN 88 (tinyint) AND 97 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ GreaterThan\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 67 (tinyint)\n" +
-			" │   │       │   │   └─ LessThanOrEqual\n" +
-			" │   │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │   │       └─ 27 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 5 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 40 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 5 (tinyint) AND 83 (tinyint))\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 34 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[5, 83], [34, ∞), [59, 59], [NULL, ∞)}, {(67, 88), (NULL, 5), (40, ∞), (NULL, 27]}, {[88, 97], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(97, ∞), (NULL, 5), (40, ∞), (NULL, 27]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[5, 83], [34, ∞), [59, 59], [NULL, ∞)}, {(67, 88), (NULL, 5), (40, ∞), (NULL, 27]}, {[88, 97], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(97, ∞), (NULL, 5), (40, ∞), (NULL, 27]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ (comp_index_t2.v1:1 BETWEEN 88 (tinyint) AND 97 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ GreaterThan\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 67 (tinyint)\n" +
+			"     │       │   │   └─ LessThanOrEqual\n" +
+			"     │       │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │   │       └─ 27 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 5 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 40 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t2.v1:1 BETWEEN 5 (tinyint) AND 83 (tinyint))\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 34 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 59 (tinyint)\n" +
 			"",
 	},
 	{
@@ -8382,118 +8172,115 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>70) OR (v1<>2 AND v2>79 AND v3<>6 AND v4<>42));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 70 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ NOT\n" +
-			" │       │   │   │   └─ Eq\n" +
-			" │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │       └─ 2 (tinyint)\n" +
-			" │       │   │   └─ GreaterThan\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 79 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v3:3\n" +
-			" │       │           └─ 6 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v4:4\n" +
-			" │               └─ 42 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 70), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[70, 70], (79, ∞), (NULL, 6), (NULL, 42)}, {[70, 70], (79, ∞), (NULL, 6), (42, ∞)}, {[70, 70], (79, ∞), (6, ∞), (NULL, 42)}, {[70, 70], (79, ∞), (6, ∞), (42, ∞)}, {(70, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 70), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[70, 70], (79, ∞), (NULL, 6), (NULL, 42)}, {[70, 70], (79, ∞), (NULL, 6), (42, ∞)}, {[70, 70], (79, ∞), (6, ∞), (NULL, 42)}, {[70, 70], (79, ∞), (6, ∞), (42, ∞)}, {(70, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 70 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ NOT\n" +
+			"         │   │   │   └─ Eq\n" +
+			"         │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │       └─ 2 (tinyint)\n" +
+			"         │   │   └─ GreaterThan\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 79 (tinyint)\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t2.v3:3\n" +
+			"         │           └─ 6 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v4:4\n" +
+			"                 └─ 42 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>46 AND v2>93 AND v3>19) AND (v1<51 AND v2=39) OR (v1<61)) AND (v1<>22);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │       └─ 46 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 93 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 19 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThan\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 51 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 39 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 61 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 22), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(22, 61), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 22), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(22, 61), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │       └─ 46 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 93 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 19 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThan\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 51 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 39 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 61 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=53 AND v2>0 AND v3=95 AND v4<=2) OR (v1<41 AND v4<10 AND v2 BETWEEN 11 AND 35)) OR (v1=11 AND v2<20 AND v3=51 AND v4<>30));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 53 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 0 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 95 (tinyint)\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 2 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 41 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 10 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v2:2 BETWEEN 11 (tinyint) AND 35 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ Eq\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 11 (tinyint)\n" +
-			" │       │   │   └─ LessThan\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 20 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 51 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v4:4\n" +
-			" │               └─ 30 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 41), (0, 11), [95, 95], (NULL, 2]}, {(NULL, 41), [11, 35], [NULL, ∞), [NULL, ∞)}, {(NULL, 41), (35, ∞), [95, 95], (NULL, 2]}, {[11, 11], (NULL, 11), [51, 51], (NULL, 30)}, {[11, 11], (NULL, 11), [51, 51], (30, ∞)}, {[41, 53], (0, ∞), [95, 95], (NULL, 2]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 41), (0, 11), [95, 95], (NULL, 2]}, {(NULL, 41), [11, 35], [NULL, ∞), [NULL, ∞)}, {(NULL, 41), (35, ∞), [95, 95], (NULL, 2]}, {[11, 11], (NULL, 11), [51, 51], (NULL, 30)}, {[11, 11], (NULL, 11), [51, 51], (30, ∞)}, {[41, 53], (0, ∞), [95, 95], (NULL, 2]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 53 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 0 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 95 (tinyint)\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 2 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 41 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 10 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v2:2 BETWEEN 11 (tinyint) AND 35 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 11 (tinyint)\n" +
+			"         │   │   └─ LessThan\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 20 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 51 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v4:4\n" +
+			"                 └─ 30 (tinyint)\n" +
 			"",
 	},
 	{
@@ -8522,61 +8309,59 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=59) OR (v1<>85 AND v4<6 AND v2 BETWEEN 14 AND 82));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Eq\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 59 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 85 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 6 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v2:2 BETWEEN 14 (tinyint) AND 82 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 59), [14, 82], [NULL, ∞), [NULL, ∞)}, {[59, 59], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(59, 85), [14, 82], [NULL, ∞), [NULL, ∞)}, {(85, ∞), [14, 82], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 59), [14, 82], [NULL, ∞), [NULL, ∞)}, {[59, 59], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(59, 85), [14, 82], [NULL, ∞), [NULL, ∞)}, {(85, ∞), [14, 82], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ comp_index_t2.v1:1\n" +
+			"     │   └─ 59 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │       └─ 85 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v4:4\n" +
+			"         │       └─ 6 (tinyint)\n" +
+			"         └─ (comp_index_t2.v2:2 BETWEEN 14 (tinyint) AND 82 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=94 AND v2>32 AND v3>61) OR (v1>51 AND v4>84 AND v2>=46)) OR (v1=39));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 94 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 32 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 61 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 51 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 84 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 46 (tinyint)\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[39, 39], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(51, ∞), [46, ∞), [NULL, ∞), [NULL, ∞)}, {[94, ∞), (32, 46), (61, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[39, 39], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(51, ∞), [46, ∞), [NULL, ∞), [NULL, ∞)}, {[94, ∞), (32, 46), (61, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 94 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 32 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 61 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 51 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 84 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 46 (tinyint)\n" +
+			"     └─ Eq\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 39 (tinyint)\n" +
 			"",
 	},
 	{
@@ -8597,140 +8382,137 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=31 AND v4>30 AND v2<>38) OR (v1<>35)) OR (v1<=8 AND v2<43 AND v3<=50 AND v4<=33));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 31 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 30 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 38 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │           └─ 35 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThanOrEqual\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 8 (tinyint)\n" +
-			" │       │   │   └─ LessThan\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 43 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 50 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 33 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 35), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(35, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 35), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(35, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 31 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 30 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 38 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v1:1\n" +
+			"     │           └─ 35 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThanOrEqual\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 8 (tinyint)\n" +
+			"         │   │   └─ LessThan\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 43 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 50 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 33 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((((v1>65 AND v2=89 AND v3>12) OR (v1 BETWEEN 37 AND 75 AND v2=42 AND v3<=14)) OR (v1>=87 AND v2=85)) OR (v1<>48 AND v4 BETWEEN 32 AND 33 AND v2>21 AND v3<=25)) OR (v1 BETWEEN 51 AND 88 AND v2<>67));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 65 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │   │       └─ 89 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 12 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ AND\n" +
-			" │   │   │   │       │   ├─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 75 (tinyint))\n" +
-			" │   │   │   │       │   └─ Eq\n" +
-			" │   │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       │       └─ 42 (tinyint)\n" +
-			" │   │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │           └─ 14 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 87 (tinyint)\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 85 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ NOT\n" +
-			" │   │       │   │   │   └─ Eq\n" +
-			" │   │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │       └─ 48 (tinyint)\n" +
-			" │   │       │   │   └─ (comp_index_t2.v4:4 BETWEEN 32 (tinyint) AND 33 (tinyint))\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 21 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 25 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t2.v1:1 BETWEEN 51 (tinyint) AND 88 (tinyint))\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v2:2\n" +
-			" │               └─ 67 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 37), (21, ∞), (NULL, 25], [32, 33]}, {[37, 48), (21, 42), (NULL, 25], [32, 33]}, {[37, 48), [42, 42], (14, 25], [32, 33]}, {[37, 48), (42, ∞), (NULL, 25], [32, 33]}, {[37, 51), [42, 42], (NULL, 14], [NULL, ∞)}, {(48, 51), (21, 42), (NULL, 25], [32, 33]}, {(48, 51), [42, 42], (14, 25], [32, 33]}, {(48, 51), (42, ∞), (NULL, 25], [32, 33]}, {[51, 88], (NULL, 67), [NULL, ∞), [NULL, ∞)}, {[51, 88], [67, 67], (NULL, 25], [32, 33]}, {[51, 88], (67, ∞), [NULL, ∞), [NULL, ∞)}, {(88, ∞), (21, 85), (NULL, 25], [32, 33]}, {(88, ∞), [85, 85], [NULL, ∞), [NULL, ∞)}, {(88, ∞), (85, 89), (NULL, 25], [32, 33]}, {(88, ∞), [89, 89], (NULL, 12], [32, 33]}, {(88, ∞), [89, 89], (12, ∞), [NULL, ∞)}, {(88, ∞), (89, ∞), (NULL, 25], [32, 33]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 37), (21, ∞), (NULL, 25], [32, 33]}, {[37, 48), (21, 42), (NULL, 25], [32, 33]}, {[37, 48), [42, 42], (14, 25], [32, 33]}, {[37, 48), (42, ∞), (NULL, 25], [32, 33]}, {[37, 51), [42, 42], (NULL, 14], [NULL, ∞)}, {(48, 51), (21, 42), (NULL, 25], [32, 33]}, {(48, 51), [42, 42], (14, 25], [32, 33]}, {(48, 51), (42, ∞), (NULL, 25], [32, 33]}, {[51, 88], (NULL, 67), [NULL, ∞), [NULL, ∞)}, {[51, 88], [67, 67], (NULL, 25], [32, 33]}, {[51, 88], (67, ∞), [NULL, ∞), [NULL, ∞)}, {(88, ∞), (21, 85), (NULL, 25], [32, 33]}, {(88, ∞), [85, 85], [NULL, ∞), [NULL, ∞)}, {(88, ∞), (85, 89), (NULL, 25], [32, 33]}, {(88, ∞), [89, 89], (NULL, 12], [32, 33]}, {(88, ∞), [89, 89], (12, ∞), [NULL, ∞)}, {(88, ∞), (89, ∞), (NULL, 25], [32, 33]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   │   └─ 65 (tinyint)\n" +
+			"     │   │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │   │       └─ 89 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │       └─ 12 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ AND\n" +
+			"     │   │   │       │   ├─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 75 (tinyint))\n" +
+			"     │   │   │       │   └─ Eq\n" +
+			"     │   │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       │       └─ 42 (tinyint)\n" +
+			"     │   │   │       └─ LessThanOrEqual\n" +
+			"     │   │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │           └─ 14 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ GreaterThanOrEqual\n" +
+			"     │   │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   └─ 87 (tinyint)\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 85 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ NOT\n" +
+			"     │       │   │   │   └─ Eq\n" +
+			"     │       │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │       └─ 48 (tinyint)\n" +
+			"     │       │   │   └─ (comp_index_t2.v4:4 BETWEEN 32 (tinyint) AND 33 (tinyint))\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 21 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 25 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t2.v1:1 BETWEEN 51 (tinyint) AND 88 (tinyint))\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v2:2\n" +
+			"                 └─ 67 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>82) OR (v1<1 AND v3>=22)) AND (v1=4) OR (v1>27 AND v2 BETWEEN 7 AND 79 AND v3 BETWEEN 9 AND 29 AND v4<85));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 82 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ LessThan\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 1 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 22 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 4 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 27 (tinyint)\n" +
-			" │       │   │   └─ (comp_index_t2.v2:2 BETWEEN 7 (tinyint) AND 79 (tinyint))\n" +
-			" │       │   └─ (comp_index_t2.v3:3 BETWEEN 9 (tinyint) AND 29 (tinyint))\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 85 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(27, ∞), [7, 79], [9, 29], (NULL, 85)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(27, ∞), [7, 79], [9, 29], (NULL, 85)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ GreaterThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 82 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ LessThan\n" +
+			"     │   │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   └─ 1 (tinyint)\n" +
+			"     │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 22 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 4 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ GreaterThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 27 (tinyint)\n" +
+			"         │   │   └─ (comp_index_t2.v2:2 BETWEEN 7 (tinyint) AND 79 (tinyint))\n" +
+			"         │   └─ (comp_index_t2.v3:3 BETWEEN 9 (tinyint) AND 29 (tinyint))\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 85 (tinyint)\n" +
 			"",
 	},
 	{
@@ -8751,33 +8533,32 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<59) OR (v1 BETWEEN 6 AND 86 AND v4<97)) OR (v1<>90 AND v2=43 AND v3=29));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 59 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 6 (tinyint) AND 86 (tinyint))\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 97 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 90 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 43 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 29 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 86], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(86, 90), [43, 43], [29, 29], [NULL, ∞)}, {(90, ∞), [43, 43], [29, 29], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 86], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(86, 90), [43, 43], [29, 29], [NULL, ∞)}, {(90, ∞), [43, 43], [29, 29], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ LessThan\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 59 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ (comp_index_t2.v1:1 BETWEEN 6 (tinyint) AND 86 (tinyint))\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 97 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │       └─ 90 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 43 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 29 (tinyint)\n" +
 			"",
 	},
 	{
@@ -8798,79 +8579,77 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>35 AND v2>=14 AND v3<65 AND v4<>9) OR (v1<>14 AND v3<51 AND v4<32)) OR (v1>=21 AND v3<>25 AND v4<>16));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │       └─ 35 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 14 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 65 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 9 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ NOT\n" +
-			" │   │       │   │   └─ Eq\n" +
-			" │   │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │       └─ 14 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 51 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 32 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 21 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v3:3\n" +
-			" │       │           └─ 25 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v4:4\n" +
-			" │               └─ 16 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 14), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[14, 14], [14, ∞), (NULL, 65), (NULL, 9)}, {[14, 14], [14, ∞), (NULL, 65), (9, ∞)}, {(14, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 14), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[14, 14], [14, ∞), (NULL, 65), (NULL, 9)}, {[14, 14], [14, ∞), (NULL, 65), (9, ∞)}, {(14, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │       └─ 35 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 14 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 65 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 9 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ NOT\n" +
+			"     │       │   │   └─ Eq\n" +
+			"     │       │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │       └─ 14 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 51 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 32 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 21 (tinyint)\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t2.v3:3\n" +
+			"         │           └─ 25 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v4:4\n" +
+			"                 └─ 16 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>12 AND v2<0) OR (v1=36 AND v3<37));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 12 (tinyint)\n" +
-			" │   │   └─ LessThan\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 0 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 36 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(12, 36), (NULL, 0), [NULL, ∞), [NULL, ∞)}, {[36, 36], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(36, ∞), (NULL, 0), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(12, 36), (NULL, 0), [NULL, ∞), [NULL, ∞)}, {[36, 36], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(36, ∞), (NULL, 0), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThan\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 12 (tinyint)\n" +
+			"     │   └─ LessThan\n" +
+			"     │       ├─ comp_index_t2.v2:2\n" +
+			"     │       └─ 0 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 36 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 37 (tinyint)\n" +
 			"",
 	},
 	{
@@ -8907,37 +8686,36 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=19 AND v4<>62 AND v2<>19 AND v3<>29) OR (v1 BETWEEN 37 AND 75 AND v4<23 AND v2 BETWEEN 6 AND 43));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 19 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │           └─ 62 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 19 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 29 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 75 (tinyint))\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 23 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v2:2 BETWEEN 6 (tinyint) AND 43 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[19, 37), (NULL, 19), (NULL, 29), (NULL, 62)}, {[19, 37), (NULL, 19), (NULL, 29), (62, ∞)}, {[19, 37), (NULL, 19), (29, ∞), (NULL, 62)}, {[19, 37), (NULL, 19), (29, ∞), (62, ∞)}, {[19, 37), (19, ∞), (NULL, 29), (NULL, 62)}, {[19, 37), (19, ∞), (NULL, 29), (62, ∞)}, {[19, 37), (19, ∞), (29, ∞), (NULL, 62)}, {[19, 37), (19, ∞), (29, ∞), (62, ∞)}, {[37, 75], (NULL, 6), (NULL, 29), (NULL, 62)}, {[37, 75], (NULL, 6), (NULL, 29), (62, ∞)}, {[37, 75], (NULL, 6), (29, ∞), (NULL, 62)}, {[37, 75], (NULL, 6), (29, ∞), (62, ∞)}, {[37, 75], [6, 43], [NULL, ∞), [NULL, ∞)}, {[37, 75], (43, ∞), (NULL, 29), (NULL, 62)}, {[37, 75], (43, ∞), (NULL, 29), (62, ∞)}, {[37, 75], (43, ∞), (29, ∞), (NULL, 62)}, {[37, 75], (43, ∞), (29, ∞), (62, ∞)}, {(75, ∞), (NULL, 19), (NULL, 29), (NULL, 62)}, {(75, ∞), (NULL, 19), (NULL, 29), (62, ∞)}, {(75, ∞), (NULL, 19), (29, ∞), (NULL, 62)}, {(75, ∞), (NULL, 19), (29, ∞), (62, ∞)}, {(75, ∞), (19, ∞), (NULL, 29), (NULL, 62)}, {(75, ∞), (19, ∞), (NULL, 29), (62, ∞)}, {(75, ∞), (19, ∞), (29, ∞), (NULL, 62)}, {(75, ∞), (19, ∞), (29, ∞), (62, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[19, 37), (NULL, 19), (NULL, 29), (NULL, 62)}, {[19, 37), (NULL, 19), (NULL, 29), (62, ∞)}, {[19, 37), (NULL, 19), (29, ∞), (NULL, 62)}, {[19, 37), (NULL, 19), (29, ∞), (62, ∞)}, {[19, 37), (19, ∞), (NULL, 29), (NULL, 62)}, {[19, 37), (19, ∞), (NULL, 29), (62, ∞)}, {[19, 37), (19, ∞), (29, ∞), (NULL, 62)}, {[19, 37), (19, ∞), (29, ∞), (62, ∞)}, {[37, 75], (NULL, 6), (NULL, 29), (NULL, 62)}, {[37, 75], (NULL, 6), (NULL, 29), (62, ∞)}, {[37, 75], (NULL, 6), (29, ∞), (NULL, 62)}, {[37, 75], (NULL, 6), (29, ∞), (62, ∞)}, {[37, 75], [6, 43], [NULL, ∞), [NULL, ∞)}, {[37, 75], (43, ∞), (NULL, 29), (NULL, 62)}, {[37, 75], (43, ∞), (NULL, 29), (62, ∞)}, {[37, 75], (43, ∞), (29, ∞), (NULL, 62)}, {[37, 75], (43, ∞), (29, ∞), (62, ∞)}, {(75, ∞), (NULL, 19), (NULL, 29), (NULL, 62)}, {(75, ∞), (NULL, 19), (NULL, 29), (62, ∞)}, {(75, ∞), (NULL, 19), (29, ∞), (NULL, 62)}, {(75, ∞), (NULL, 19), (29, ∞), (62, ∞)}, {(75, ∞), (19, ∞), (NULL, 29), (NULL, 62)}, {(75, ∞), (19, ∞), (NULL, 29), (62, ∞)}, {(75, ∞), (19, ∞), (29, ∞), (NULL, 62)}, {(75, ∞), (19, ∞), (29, ∞), (62, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 19 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │           └─ 62 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 19 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 29 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 75 (tinyint))\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v4:4\n" +
+			"         │       └─ 23 (tinyint)\n" +
+			"         └─ (comp_index_t2.v2:2 BETWEEN 6 (tinyint) AND 43 (tinyint))\n" +
 			"",
 	},
 	{
@@ -8950,67 +8728,65 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=69 AND v2<8) AND (v1>=34 AND v2>=99 AND v3>96 AND v4 BETWEEN 36 AND 99) OR (v1=0 AND v2>=71));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 69 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 8 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 34 (tinyint)\n" +
-			" │   │       │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │       └─ 99 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 96 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v4:4 BETWEEN 36 (tinyint) AND 99 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 0 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[0, 0], [71, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[0, 0], [71, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 69 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 8 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 34 (tinyint)\n" +
+			"     │       │   │   └─ GreaterThanOrEqual\n" +
+			"     │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │       └─ 99 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 96 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v4:4 BETWEEN 36 (tinyint) AND 99 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 0 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 71 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 20 AND 54 AND v2<>31 AND v3 BETWEEN 15 AND 21) OR (v1<=46 AND v3>76)) OR (v1 BETWEEN 31 AND 71));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 20 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │           └─ 31 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 15 (tinyint) AND 21 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 46 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 76 (tinyint)\n" +
-			" │   └─ (comp_index_t2.v1:1 BETWEEN 31 (tinyint) AND 71 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 71], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 71], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 20 (tinyint) AND 54 (tinyint))\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │           └─ 31 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t2.v3:3 BETWEEN 15 (tinyint) AND 21 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 46 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 76 (tinyint)\n" +
+			"     └─ (comp_index_t2.v1:1 BETWEEN 31 (tinyint) AND 71 (tinyint))\n" +
 			"",
 	},
 	{
@@ -9031,40 +8807,39 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=99 AND v2>=85) AND (v1<=83 AND v2=99) OR (v1<=6 AND v2 BETWEEN 36 AND 68 AND v3>62 AND v4=79));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 99 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 85 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 83 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 99 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThanOrEqual\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 6 (tinyint)\n" +
-			" │       │   │   └─ (comp_index_t2.v2:2 BETWEEN 36 (tinyint) AND 68 (tinyint))\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 62 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 79 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 6], [36, 68], (62, ∞), [79, 79]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 6], [36, 68], (62, ∞), [79, 79]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 99 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 85 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 83 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 99 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThanOrEqual\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 6 (tinyint)\n" +
+			"         │   │   └─ (comp_index_t2.v2:2 BETWEEN 36 (tinyint) AND 68 (tinyint))\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 62 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 79 (tinyint)\n" +
 			"",
 	},
 	{
@@ -9093,31 +8868,30 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>18) OR (v1>=42 AND v2<=65 AND v3=87 AND v4=80));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 18 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 42 (tinyint)\n" +
-			" │       │   │   └─ LessThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 65 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 87 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 80 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 18), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(18, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 18), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(18, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 18 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 42 (tinyint)\n" +
+			"         │   │   └─ LessThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 65 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 87 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 80 (tinyint)\n" +
 			"",
 	},
 	{
@@ -9130,91 +8904,89 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>71 AND v4>0) OR (v1<48 AND v2=89 AND v3>=46 AND v4<=32)) OR (v1<62 AND v2>=33 AND v3>58)) OR (v1>=31 AND v3<>71));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 71 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 0 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ LessThan\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 48 (tinyint)\n" +
-			" │   │   │       │   │   └─ Eq\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │   │       └─ 89 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 46 (tinyint)\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 32 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 62 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 33 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 58 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 31 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v3:3\n" +
-			" │               └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 31), [33, ∞), (58, ∞), [NULL, ∞)}, {(NULL, 31), [89, 89], [46, 58], (NULL, 32]}, {[31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 31), [33, ∞), (58, ∞), [NULL, ∞)}, {(NULL, 31), [89, 89], [46, 58], (NULL, 32]}, {[31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 71 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 0 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ LessThan\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 48 (tinyint)\n" +
+			"     │   │       │   │   └─ Eq\n" +
+			"     │   │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │   │       └─ 89 (tinyint)\n" +
+			"     │   │       │   └─ GreaterThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │       └─ 46 (tinyint)\n" +
+			"     │   │       └─ LessThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 32 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 62 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 33 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 58 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 31 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v3:3\n" +
+			"                 └─ 71 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 9 AND 40 AND v3<=43 AND v4=62 AND v2>=43) OR (v1=61 AND v2>12 AND v3 BETWEEN 0 AND 13 AND v4>=8));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 9 (tinyint) AND 40 (tinyint))\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 43 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 62 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 43 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ Eq\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 61 (tinyint)\n" +
-			" │       │   │   └─ GreaterThan\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 12 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v3:3 BETWEEN 0 (tinyint) AND 13 (tinyint))\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 8 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[9, 40], [43, ∞), (NULL, 43], [62, 62]}, {[61, 61], (12, ∞), [0, 13], [8, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[9, 40], [43, ∞), (NULL, 43], [62, 62]}, {[61, 61], (12, ∞), [0, 13], [8, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 9 (tinyint) AND 40 (tinyint))\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 43 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 62 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v2:2\n" +
+			"     │       └─ 43 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 61 (tinyint)\n" +
+			"         │   │   └─ GreaterThan\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 12 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v3:3 BETWEEN 0 (tinyint) AND 13 (tinyint))\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 8 (tinyint)\n" +
 			"",
 	},
 	{
@@ -9235,84 +9007,81 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=9 AND v4=22 AND v2>=95) OR (v1>96));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 9 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 22 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 95 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 96 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 9], [95, ∞), [NULL, ∞), [NULL, ∞)}, {(96, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 9], [95, ∞), [NULL, ∞), [NULL, ∞)}, {(96, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 9 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 22 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v2:2\n" +
+			"     │       └─ 95 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 96 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<=56) OR (v1>=31 AND v4<38 AND v2>20)) OR (v1=91 AND v2<48));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 56 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 31 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 38 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 20 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 91 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 48 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 56], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(56, 91), (20, ∞), [NULL, ∞), [NULL, ∞)}, {[91, 91], (NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(91, ∞), (20, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 56], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(56, 91), (20, ∞), [NULL, ∞), [NULL, ∞)}, {[91, 91], (NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(91, ∞), (20, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ LessThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 56 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 31 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 38 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 20 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 91 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 48 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=75 AND v4<=30) OR (v1>=41 AND v2 BETWEEN 16 AND 25 AND v3>=99));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 75 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 30 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 41 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v2:2 BETWEEN 16 (tinyint) AND 25 (tinyint))\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, ∞), [16, 25], [99, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, ∞), [16, 25], [99, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ LessThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 75 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 30 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 41 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v2:2 BETWEEN 16 (tinyint) AND 25 (tinyint))\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 99 (tinyint)\n" +
 			"",
 	},
 	{
@@ -9365,67 +9134,65 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>84 AND v4<=53 AND v2=77 AND v3>=40) OR (v1>78 AND v2<>1 AND v3=98 AND v4>=76));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 84 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 53 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 77 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 40 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 78 (tinyint)\n" +
-			" │       │   │   └─ NOT\n" +
-			" │       │   │       └─ Eq\n" +
-			" │       │   │           ├─ comp_index_t2.v2:2\n" +
-			" │       │   │           └─ 1 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 98 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 76 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(78, ∞), (NULL, 1), [98, 98], [76, ∞)}, {(78, ∞), (1, ∞), [98, 98], [76, ∞)}, {(84, ∞), [77, 77], [40, ∞), (NULL, 53]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(78, ∞), (NULL, 1), [98, 98], [76, ∞)}, {(78, ∞), (1, ∞), [98, 98], [76, ∞)}, {(84, ∞), [77, 77], [40, ∞), (NULL, 53]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 84 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 53 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 77 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 40 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ GreaterThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 78 (tinyint)\n" +
+			"         │   │   └─ NOT\n" +
+			"         │   │       └─ Eq\n" +
+			"         │   │           ├─ comp_index_t2.v2:2\n" +
+			"         │   │           └─ 1 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 98 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 76 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>79 AND v2<=85) OR (v1<>13)) OR (v1 BETWEEN 4 AND 67));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 79 (tinyint)\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 85 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │           └─ 13 (tinyint)\n" +
-			" │   └─ (comp_index_t2.v1:1 BETWEEN 4 (tinyint) AND 67 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ NOT\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 79 (tinyint)\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 85 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v1:1\n" +
+			"     │           └─ 13 (tinyint)\n" +
+			"     └─ (comp_index_t2.v1:1 BETWEEN 4 (tinyint) AND 67 (tinyint))\n" +
 			"",
 	},
 	{
@@ -9438,139 +9205,135 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((((v1<65) OR (v1<>44)) OR (v1<=39 AND v3>=14)) OR (v1<=33 AND v2<>11)) OR (v1=75 AND v2=0 AND v3<28));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 65 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │           └─ 44 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ LessThanOrEqual\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 39 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 14 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 33 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v2:2\n" +
-			" │   │               └─ 11 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ Eq\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 75 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 0 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 28 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 65 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │           └─ 44 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ LessThanOrEqual\n" +
+			"     │   │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   └─ 39 (tinyint)\n" +
+			"     │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 14 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 33 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v2:2\n" +
+			"     │               └─ 11 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ Eq\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 75 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 0 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 28 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>50 AND v2>=46) AND (v1<>17 AND v2=45 AND v3<=79) OR (v1=10 AND v2>=35)) AND (v1=44 AND v2=38);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 50 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 46 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ NOT\n" +
-			" │   │       │   │   └─ Eq\n" +
-			" │   │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │       └─ 17 (tinyint)\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 45 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 79 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 10 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 35 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ NOT\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 50 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 46 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ NOT\n" +
+			"     │       │   │   └─ Eq\n" +
+			"     │       │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │       └─ 17 (tinyint)\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 45 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 79 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 10 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 35 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<34) OR (v1<=62 AND v4<>18 AND v2 BETWEEN 1 AND 41)) OR (v1>=65 AND v2>=93 AND v3 BETWEEN 34 AND 41));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 34 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 62 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v4:4\n" +
-			" │   │       │           └─ 18 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v2:2 BETWEEN 1 (tinyint) AND 41 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 65 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 93 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v3:3 BETWEEN 34 (tinyint) AND 41 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 34), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[34, 62], [1, 41], [NULL, ∞), [NULL, ∞)}, {[65, ∞), [93, ∞), [34, 41], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 34), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[34, 62], [1, 41], [NULL, ∞), [NULL, ∞)}, {[65, ∞), [93, ∞), [34, 41], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ LessThan\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 34 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 62 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t2.v4:4\n" +
+			"     │       │           └─ 18 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v2:2 BETWEEN 1 (tinyint) AND 41 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 65 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 93 (tinyint)\n" +
+			"         └─ (comp_index_t2.v3:3 BETWEEN 34 (tinyint) AND 41 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>8) OR (v1>20 AND v4>=99));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ GreaterThan\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 8 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 20 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(8, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(8, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ GreaterThan\n" +
+			"     │   ├─ comp_index_t2.v1:1\n" +
+			"     │   └─ 8 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 20 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 99 (tinyint)\n" +
 			"",
 	},
 	{
@@ -9599,32 +9362,31 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>5 AND v3<>53 AND v4>=49) OR (v1<18 AND v2<94));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 5 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 53 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 49 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 18 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 94 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 5), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[5, 5], (NULL, 94), [NULL, ∞), [NULL, ∞)}, {(5, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 5), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[5, 5], (NULL, 94), [NULL, ∞), [NULL, ∞)}, {(5, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ NOT\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 5 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 53 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 49 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 18 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 94 (tinyint)\n" +
 			"",
 	},
 	{
@@ -9637,49 +9399,48 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>28 AND v4>57 AND v2<62 AND v3 BETWEEN 14 AND 41) AND (v1<>72 AND v2>=13 AND v3>29 AND v4>38) OR (v1<=22 AND v2>58));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 28 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │       └─ 57 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 62 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 14 (tinyint) AND 41 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ NOT\n" +
-			" │   │       │   │   │   └─ Eq\n" +
-			" │   │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │       └─ 72 (tinyint)\n" +
-			" │   │       │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │       └─ 13 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 29 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 38 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 22 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 58 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 22], (58, ∞), [NULL, ∞), [NULL, ∞)}, {(28, 72), [13, 62), (29, 41], (57, ∞)}, {(72, ∞), [13, 62), (29, 41], (57, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 22], (58, ∞), [NULL, ∞), [NULL, ∞)}, {(28, 72), [13, 62), (29, 41], (57, ∞)}, {(72, ∞), [13, 62), (29, 41], (57, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 28 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │       └─ 57 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 62 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t2.v3:3 BETWEEN 14 (tinyint) AND 41 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ NOT\n" +
+			"     │       │   │   │   └─ Eq\n" +
+			"     │       │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │       └─ 72 (tinyint)\n" +
+			"     │       │   │   └─ GreaterThanOrEqual\n" +
+			"     │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │       └─ 13 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 29 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 38 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 22 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 58 (tinyint)\n" +
 			"",
 	},
 	{
@@ -9692,35 +9453,34 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>35 AND v4<>20 AND v2<81 AND v3=27) OR (v1>13 AND v3=27));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 35 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │           └─ 20 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 81 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 27 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 13 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 27 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(13, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(13, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 35 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │           └─ 20 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 81 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 27 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 13 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 27 (tinyint)\n" +
 			"",
 	},
 	{
@@ -9749,45 +9509,44 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=86 AND v2<5 AND v3<36 AND v4<81) OR (v1>=52 AND v2>24 AND v3<5)) OR (v1 BETWEEN 5 AND 80 AND v3<>80));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 86 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 5 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 36 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 81 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 52 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 24 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 5 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t2.v1:1 BETWEEN 5 (tinyint) AND 80 (tinyint))\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v3:3\n" +
-			" │               └─ 80 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[5, 80], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(80, ∞), (24, ∞), (NULL, 5), [NULL, ∞)}, {[86, 86], (NULL, 5), (NULL, 36), (NULL, 81)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[5, 80], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(80, ∞), (24, ∞), (NULL, 5), [NULL, ∞)}, {[86, 86], (NULL, 5), (NULL, 36), (NULL, 81)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 86 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 5 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 36 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 81 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 52 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 24 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 5 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t2.v1:1 BETWEEN 5 (tinyint) AND 80 (tinyint))\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v3:3\n" +
+			"                 └─ 80 (tinyint)\n" +
 			"",
 	},
 	{
@@ -9800,121 +9559,117 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>31) OR (v1 BETWEEN 27 AND 87 AND v2=71 AND v3=38 AND v4=1));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 31 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 27 (tinyint) AND 87 (tinyint))\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 71 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 38 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 1 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 31), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[31, 31], [71, 71], [38, 38], [1, 1]}, {(31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 31), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[31, 31], [71, 71], [38, 38], [1, 1]}, {(31, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 31 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ (comp_index_t2.v1:1 BETWEEN 27 (tinyint) AND 87 (tinyint))\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 71 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 38 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 1 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>2 AND v4=0 AND v2 BETWEEN 6 AND 23 AND v3 BETWEEN 46 AND 52) OR (v1<=63 AND v2>=71 AND v3=28)) AND (v1<=52);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 2 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 0 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 6 (tinyint) AND 23 (tinyint))\n" +
-			" │   │   └─ (comp_index_t2.v3:3 BETWEEN 46 (tinyint) AND 52 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 63 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 71 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 28 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 52], [71, ∞), [28, 28], [NULL, ∞)}, {(2, 52], [6, 23], [46, 52], [0, 0]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 52], [71, ∞), [28, 28], [NULL, ∞)}, {(2, 52], [6, 23], [46, 52], [0, 0]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 2 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 0 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t2.v2:2 BETWEEN 6 (tinyint) AND 23 (tinyint))\n" +
+			"     │   └─ (comp_index_t2.v3:3 BETWEEN 46 (tinyint) AND 52 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 63 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 71 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 28 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 10 AND 90) AND (v1=86 AND v4>=4) AND (v1 BETWEEN 6 AND 58 AND v2=85);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ GreaterThanOrEqual\n" +
-			" │   ├─ comp_index_t2.v4:4\n" +
-			" │   └─ 4 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: GreaterThanOrEqual\n" +
+			"     ├─ comp_index_t2.v4:4\n" +
+			"     └─ 4 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=46 AND v4>41 AND v2<>12) OR (v1>17 AND v2>=34 AND v3<>68 AND v4<=13)) OR (v1>=98 AND v4 BETWEEN 3 AND 62 AND v2=39));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 46 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 41 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 12 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ GreaterThan\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 17 (tinyint)\n" +
-			" │   │       │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │       └─ 34 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v3:3\n" +
-			" │   │       │           └─ 68 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 13 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 98 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v4:4 BETWEEN 3 (tinyint) AND 62 (tinyint))\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(17, 46), [34, ∞), (NULL, 68), (NULL, 13]}, {(17, 46), [34, ∞), (68, ∞), (NULL, 13]}, {[46, 46], (NULL, 12), [NULL, ∞), [NULL, ∞)}, {[46, 46], (12, ∞), [NULL, ∞), [NULL, ∞)}, {(46, 98), [34, ∞), (NULL, 68), (NULL, 13]}, {(46, 98), [34, ∞), (68, ∞), (NULL, 13]}, {[98, ∞), [34, 39), (NULL, 68), (NULL, 13]}, {[98, ∞), [34, 39), (68, ∞), (NULL, 13]}, {[98, ∞), [39, 39], [NULL, ∞), [NULL, ∞)}, {[98, ∞), (39, ∞), (NULL, 68), (NULL, 13]}, {[98, ∞), (39, ∞), (68, ∞), (NULL, 13]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(17, 46), [34, ∞), (NULL, 68), (NULL, 13]}, {(17, 46), [34, ∞), (68, ∞), (NULL, 13]}, {[46, 46], (NULL, 12), [NULL, ∞), [NULL, ∞)}, {[46, 46], (12, ∞), [NULL, ∞), [NULL, ∞)}, {(46, 98), [34, ∞), (NULL, 68), (NULL, 13]}, {(46, 98), [34, ∞), (68, ∞), (NULL, 13]}, {[98, ∞), [34, 39), (NULL, 68), (NULL, 13]}, {[98, ∞), [34, 39), (68, ∞), (NULL, 13]}, {[98, ∞), [39, 39], [NULL, ∞), [NULL, ∞)}, {[98, ∞), (39, ∞), (NULL, 68), (NULL, 13]}, {[98, ∞), (39, ∞), (68, ∞), (NULL, 13]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 46 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 41 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 12 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ GreaterThan\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 17 (tinyint)\n" +
+			"     │       │   │   └─ GreaterThanOrEqual\n" +
+			"     │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │       └─ 34 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t2.v3:3\n" +
+			"     │       │           └─ 68 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 13 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 98 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v4:4 BETWEEN 3 (tinyint) AND 62 (tinyint))\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 39 (tinyint)\n" +
 			"",
 	},
 	{
@@ -9935,47 +9690,46 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 66 AND 76 AND v2>=84 AND v3>1 AND v4 BETWEEN 71 AND 95) AND (v1>36 AND v2<>41) OR (v1<44 AND v2<=50 AND v3=36 AND v4<=42));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 66 (tinyint) AND 76 (tinyint))\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 84 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 1 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 71 (tinyint) AND 95 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThan\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 36 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v2:2\n" +
-			" │   │               └─ 41 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 44 (tinyint)\n" +
-			" │       │   │   └─ LessThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 50 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 36 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 42 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 44), (NULL, 50], [36, 36], (NULL, 42]}, {[66, 76], [84, ∞), (1, ∞), [71, 95]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 44), (NULL, 50], [36, 36], (NULL, 42]}, {[66, 76], [84, ∞), (1, ∞), [71, 95]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 66 (tinyint) AND 76 (tinyint))\n" +
+			"     │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 84 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 1 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t2.v4:4 BETWEEN 71 (tinyint) AND 95 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ GreaterThan\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 36 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v2:2\n" +
+			"     │               └─ 41 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 44 (tinyint)\n" +
+			"         │   │   └─ LessThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 50 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 36 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 42 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10004,60 +9758,58 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>22 AND v3<>49) OR (v1>=41 AND v2<=74 AND v3<=46));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 22 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 49 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 41 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 74 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 22), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(22, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 22), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(22, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       └─ 22 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 49 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 41 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 74 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 46 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=82 AND v4<=67 AND v2=40) OR (v1>63)) OR (v1<=16));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 82 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 67 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 40 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 63 (tinyint)\n" +
-			" │   └─ LessThanOrEqual\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 16 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 16], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(63, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 16], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(63, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 82 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 67 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 40 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 63 (tinyint)\n" +
+			"     └─ LessThanOrEqual\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 16 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10086,28 +9838,27 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 4 AND 8 AND v3>=12) OR (v1>=12 AND v2>=0 AND v3=18));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ (comp_index_t2.v1:1 BETWEEN 4 (tinyint) AND 8 (tinyint))\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 12 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 12 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 0 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 18 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[4, 8], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[12, ∞), [0, ∞), [18, 18], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[4, 8], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[12, ∞), [0, ∞), [18, 18], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ (comp_index_t2.v1:1 BETWEEN 4 (tinyint) AND 8 (tinyint))\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 12 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 12 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 0 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 18 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10128,34 +9879,33 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>9 AND v4<>61 AND v2=98 AND v3<1) OR (v1<2 AND v2 BETWEEN 3 AND 70));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │       └─ 9 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │           └─ 61 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 98 (tinyint)\n" +
-			" │   │   └─ LessThan\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 1 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 2 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v2:2 BETWEEN 3 (tinyint) AND 70 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 2), [3, 70], [NULL, ∞), [NULL, ∞)}, {(NULL, 9), [98, 98], (NULL, 1), (NULL, 61)}, {(NULL, 9), [98, 98], (NULL, 1), (61, ∞)}, {(9, ∞), [98, 98], (NULL, 1), (NULL, 61)}, {(9, ∞), [98, 98], (NULL, 1), (61, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 2), [3, 70], [NULL, ∞), [NULL, ∞)}, {(NULL, 9), [98, 98], (NULL, 1), (NULL, 61)}, {(NULL, 9), [98, 98], (NULL, 1), (61, ∞)}, {(9, ∞), [98, 98], (NULL, 1), (NULL, 61)}, {(9, ∞), [98, 98], (NULL, 1), (61, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │       └─ 9 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │           └─ 61 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 98 (tinyint)\n" +
+			"     │   └─ LessThan\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 1 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 2 (tinyint)\n" +
+			"         └─ (comp_index_t2.v2:2 BETWEEN 3 (tinyint) AND 70 (tinyint))\n" +
 			"",
 	},
 	{
@@ -10184,63 +9934,61 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>53 AND v4<99 AND v2<>31) OR (v1<>5 AND v2>70 AND v3>=71));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 53 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 99 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 31 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 5 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 70 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 71 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 53), (NULL, 31), [NULL, ∞), [NULL, ∞)}, {(NULL, 53), (31, ∞), [NULL, ∞), [NULL, ∞)}, {[53, 53], (70, ∞), [71, ∞), [NULL, ∞)}, {(53, ∞), (NULL, 31), [NULL, ∞), [NULL, ∞)}, {(53, ∞), (31, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 53), (NULL, 31), [NULL, ∞), [NULL, ∞)}, {(NULL, 53), (31, ∞), [NULL, ∞), [NULL, ∞)}, {[53, 53], (70, ∞), [71, ∞), [NULL, ∞)}, {(53, ∞), (NULL, 31), [NULL, ∞), [NULL, ∞)}, {(53, ∞), (31, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ NOT\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 53 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 99 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 31 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │       └─ 5 (tinyint)\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 70 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 71 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>1 AND v4=93) OR (v1<10 AND v2 BETWEEN 40 AND 74 AND v3>=27));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 1 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 93 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 10 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v2:2 BETWEEN 40 (tinyint) AND 74 (tinyint))\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 27 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 1], [40, 74], [27, ∞), [NULL, ∞)}, {(1, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 1], [40, 74], [27, ∞), [NULL, ∞)}, {(1, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThan\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 1 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 93 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 10 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v2:2 BETWEEN 40 (tinyint) AND 74 (tinyint))\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 27 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10253,68 +10001,66 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 16 AND 31 AND v4 BETWEEN 18 AND 96) OR (v1=40 AND v2<=35 AND v3>=51 AND v4>=83));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ (comp_index_t2.v1:1 BETWEEN 16 (tinyint) AND 31 (tinyint))\n" +
-			" │   │   └─ (comp_index_t2.v4:4 BETWEEN 18 (tinyint) AND 96 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ Eq\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 40 (tinyint)\n" +
-			" │       │   │   └─ LessThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 35 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 51 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 83 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[16, 31], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[40, 40], (NULL, 35], [51, ∞), [83, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[16, 31], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[40, 40], (NULL, 35], [51, ∞), [83, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ (comp_index_t2.v1:1 BETWEEN 16 (tinyint) AND 31 (tinyint))\n" +
+			"     │   └─ (comp_index_t2.v4:4 BETWEEN 18 (tinyint) AND 96 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 40 (tinyint)\n" +
+			"         │   │   └─ LessThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 35 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 51 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 83 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 68 AND 78 AND v2>96 AND v3<58 AND v4<14) OR (v1=71)) AND (v1>15 AND v2>=19) OR (v1>36));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 68 (tinyint) AND 78 (tinyint))\n" +
-			" │   │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │   │       └─ 96 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 58 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 14 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 71 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThan\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 15 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 19 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 36 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(36, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(36, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 68 (tinyint) AND 78 (tinyint))\n" +
+			"     │   │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │   │       └─ 96 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │       └─ 58 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 14 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       └─ 71 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ GreaterThan\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 15 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 19 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 36 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10335,64 +10081,62 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=47 AND v4=13) AND (v1<=27 AND v3<54 AND v4 BETWEEN 27 AND 40) OR (v1>=40 AND v4=98 AND v2=25 AND v3>66));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 47 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 13 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 27 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 54 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v4:4 BETWEEN 27 (tinyint) AND 40 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 40 (tinyint)\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │       │   │       └─ 98 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 25 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 66 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[40, ∞), [25, 25], (66, ∞), [98, 98]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[40, ∞), [25, 25], (66, ∞), [98, 98]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 47 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 13 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 27 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 54 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v4:4 BETWEEN 27 (tinyint) AND 40 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 40 (tinyint)\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v4:4\n" +
+			"         │   │       └─ 98 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 25 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 66 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<98 AND v3 BETWEEN 80 AND 82) OR (v1 BETWEEN 31 AND 38 AND v2=39));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 98 (tinyint)\n" +
-			" │   │   └─ (comp_index_t2.v3:3 BETWEEN 80 (tinyint) AND 82 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t2.v1:1 BETWEEN 31 (tinyint) AND 38 (tinyint))\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 98), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 98), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ LessThan\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 98 (tinyint)\n" +
+			"     │   └─ (comp_index_t2.v3:3 BETWEEN 80 (tinyint) AND 82 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t2.v1:1 BETWEEN 31 (tinyint) AND 38 (tinyint))\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 39 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10405,49 +10149,47 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=40) OR (v1<>32 AND v4<=37));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ GreaterThanOrEqual\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 40 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 32 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 32), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(32, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 32), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(32, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ GreaterThanOrEqual\n" +
+			"     │   ├─ comp_index_t2.v1:1\n" +
+			"     │   └─ 40 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v1:1\n" +
+			"         │       └─ 32 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 37 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>63 AND v3 BETWEEN 43 AND 50 AND v4<29 AND v2>=89) OR (v1>80));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 63 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 43 (tinyint) AND 50 (tinyint))\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 29 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 89 (tinyint)\n" +
-			" │   └─ GreaterThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 80 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(63, 80], [89, ∞), [43, 50], (NULL, 29)}, {(80, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(63, 80], [89, ∞), [43, 50], (NULL, 29)}, {(80, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 63 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 43 (tinyint) AND 50 (tinyint))\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 29 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v2:2\n" +
+			"     │       └─ 89 (tinyint)\n" +
+			"     └─ GreaterThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 80 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10476,103 +10218,100 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 15 AND 69 AND v4=83 AND v2<=43) OR (v1<51 AND v2<24 AND v3<>27 AND v4<>50)) OR (v1<>37));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 15 (tinyint) AND 69 (tinyint))\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 83 (tinyint)\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 43 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ LessThan\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 51 (tinyint)\n" +
-			" │   │       │   │   └─ LessThan\n" +
-			" │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │       └─ 24 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v3:3\n" +
-			" │   │       │           └─ 27 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v4:4\n" +
-			" │   │               └─ 50 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 37), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[37, 37], (NULL, 43], [NULL, ∞), [NULL, ∞)}, {(37, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 37), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[37, 37], (NULL, 43], [NULL, ∞), [NULL, ∞)}, {(37, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 15 (tinyint) AND 69 (tinyint))\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 83 (tinyint)\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 43 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ LessThan\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 51 (tinyint)\n" +
+			"     │       │   │   └─ LessThan\n" +
+			"     │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │       └─ 24 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t2.v3:3\n" +
+			"     │       │           └─ 27 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v4:4\n" +
+			"     │               └─ 50 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v1:1\n" +
+			"             └─ 37 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 55 AND 66 AND v2<>81 AND v3=6 AND v4<=19) OR (v1<>91));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 55 (tinyint) AND 66 (tinyint))\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │           └─ 81 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 6 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 19 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 91 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 91), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(91, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 91), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(91, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 55 (tinyint) AND 66 (tinyint))\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │           └─ 81 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 6 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 19 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v1:1\n" +
+			"             └─ 91 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=21 AND v2<50 AND v3>=39) OR (v1<=79 AND v4>62 AND v2=31));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 21 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 50 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 39 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 79 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 62 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 79], [31, 31], [NULL, ∞), [NULL, ∞)}, {[21, 21], (NULL, 31), [39, ∞), [NULL, ∞)}, {[21, 21], (31, 50), [39, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 79], [31, 31], [NULL, ∞), [NULL, ∞)}, {[21, 21], (NULL, 31), [39, ∞), [NULL, ∞)}, {[21, 21], (31, 50), [39, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 21 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 50 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 39 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 79 (tinyint)\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t2.v4:4\n" +
+			"         │       └─ 62 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 31 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10585,27 +10324,26 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>16 AND v3>=29) OR (v1>=47 AND v2<>63));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 16 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 29 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 47 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v2:2\n" +
-			" │               └─ 63 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(16, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(16, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThan\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 16 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 29 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 47 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v2:2\n" +
+			"                 └─ 63 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10618,50 +10356,48 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=35 AND v2>67) OR (v1<>55));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Eq\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 35 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 67 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 55 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 55), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(55, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 55), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(55, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 35 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v2:2\n" +
+			"     │       └─ 67 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v1:1\n" +
+			"             └─ 55 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<89 AND v2<5 AND v3 BETWEEN 53 AND 61) OR (v1<>72 AND v3<20));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 89 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 5 (tinyint)\n" +
-			" │   │   └─ (comp_index_t2.v3:3 BETWEEN 53 (tinyint) AND 61 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 72 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 20 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 72), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[72, 72], (NULL, 5), [53, 61], [NULL, ∞)}, {(72, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 72), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[72, 72], (NULL, 5), [53, 61], [NULL, ∞)}, {(72, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 89 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 5 (tinyint)\n" +
+			"     │   └─ (comp_index_t2.v3:3 BETWEEN 53 (tinyint) AND 61 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v1:1\n" +
+			"         │       └─ 72 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 20 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10706,63 +10442,61 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<47 AND v2 BETWEEN 22 AND 85) AND (v1=73) OR (v1<42));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 47 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 22 (tinyint) AND 85 (tinyint))\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 73 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 42 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 42), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 42), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 47 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t2.v2:2 BETWEEN 22 (tinyint) AND 85 (tinyint))\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 73 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 42 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<29) AND (v1<41 AND v2>52 AND v3<>55) OR (v1 BETWEEN 16 AND 28 AND v2>=9 AND v3=43 AND v4<6));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 29 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 41 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 52 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │               └─ 55 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 16 (tinyint) AND 28 (tinyint))\n" +
-			" │       │   │   └─ GreaterThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 9 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 43 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 6 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 29), (52, ∞), (NULL, 55), [NULL, ∞)}, {(NULL, 29), (52, ∞), (55, ∞), [NULL, ∞)}, {[16, 28], [9, 52], [43, 43], (NULL, 6)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 29), (52, ∞), (NULL, 55), [NULL, ∞)}, {(NULL, 29), (52, ∞), (55, ∞), [NULL, ∞)}, {[16, 28], [9, 52], [43, 43], (NULL, 6)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ LessThan\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 29 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 41 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 52 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v3:3\n" +
+			"     │               └─ 55 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ (comp_index_t2.v1:1 BETWEEN 16 (tinyint) AND 28 (tinyint))\n" +
+			"         │   │   └─ GreaterThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 9 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 43 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 6 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10791,54 +10525,52 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>86) OR (v1>=48 AND v4>9));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ GreaterThan\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 86 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 48 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 9 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[48, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[48, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ GreaterThan\n" +
+			"     │   ├─ comp_index_t2.v1:1\n" +
+			"     │   └─ 86 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 48 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 9 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=41 AND v2=79 AND v3<16 AND v4>=2) OR (v1<16 AND v4>59));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 41 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 79 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 16 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 2 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 16 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 59 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 16), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[41, 41], [79, 79], (NULL, 16), [2, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 16), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[41, 41], [79, 79], (NULL, 16), [2, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 41 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 79 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 16 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 2 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 16 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 59 (tinyint)\n" +
 			"",
 	},
 	{
@@ -10851,115 +10583,112 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=93 AND v2<=10 AND v3 BETWEEN 21 AND 83) AND (v1<>5 AND v2>59 AND v3<>17) OR (v1<69 AND v3<>65 AND v4>=51 AND v2<=48)) OR (v1 BETWEEN 37 AND 57 AND v2 BETWEEN 44 AND 57 AND v3<40 AND v4=98));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 93 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 10 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 21 (tinyint) AND 83 (tinyint))\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ NOT\n" +
-			" │   │   │       │   │   └─ Eq\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │       └─ 5 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 59 (tinyint)\n" +
-			" │   │   │       └─ NOT\n" +
-			" │   │   │           └─ Eq\n" +
-			" │   │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │   │               └─ 17 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ LessThan\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 69 (tinyint)\n" +
-			" │   │       │   │   └─ NOT\n" +
-			" │   │       │   │       └─ Eq\n" +
-			" │   │       │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │       │   │           └─ 65 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 51 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 48 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 57 (tinyint))\n" +
-			" │       │   │   └─ (comp_index_t2.v2:2 BETWEEN 44 (tinyint) AND 57 (tinyint))\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 40 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 98 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 69), (NULL, 48], (NULL, 65), [51, ∞)}, {(NULL, 69), (NULL, 48], (65, ∞), [51, ∞)}, {[37, 57], (48, 57], (NULL, 40), [98, 98]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 69), (NULL, 48], (NULL, 65), [51, ∞)}, {(NULL, 69), (NULL, 48], (65, ∞), [51, ∞)}, {[37, 57], (48, 57], (NULL, 40), [98, 98]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 93 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 10 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 21 (tinyint) AND 83 (tinyint))\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ NOT\n" +
+			"     │   │       │   │   └─ Eq\n" +
+			"     │   │       │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │       └─ 5 (tinyint)\n" +
+			"     │   │       │   └─ GreaterThan\n" +
+			"     │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │       └─ 59 (tinyint)\n" +
+			"     │   │       └─ NOT\n" +
+			"     │   │           └─ Eq\n" +
+			"     │   │               ├─ comp_index_t2.v3:3\n" +
+			"     │   │               └─ 17 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ LessThan\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 69 (tinyint)\n" +
+			"     │       │   │   └─ NOT\n" +
+			"     │       │   │       └─ Eq\n" +
+			"     │       │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │       │   │           └─ 65 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 51 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 48 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ (comp_index_t2.v1:1 BETWEEN 37 (tinyint) AND 57 (tinyint))\n" +
+			"         │   │   └─ (comp_index_t2.v2:2 BETWEEN 44 (tinyint) AND 57 (tinyint))\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 40 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 98 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<46) OR (v1<>60));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ LessThan\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 46 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 60 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 60), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(60, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 60), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(60, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ LessThan\n" +
+			"     │   ├─ comp_index_t2.v1:1\n" +
+			"     │   └─ 46 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v1:1\n" +
+			"             └─ 60 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<97 AND v2<=47 AND v3=91) OR (v1=74 AND v4>72 AND v2<>44 AND v3 BETWEEN 4 AND 51));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 97 (tinyint)\n" +
-			" │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 47 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 91 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ Eq\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 74 (tinyint)\n" +
-			" │       │   │   └─ GreaterThan\n" +
-			" │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │       │   │       └─ 72 (tinyint)\n" +
-			" │       │   └─ NOT\n" +
-			" │       │       └─ Eq\n" +
-			" │       │           ├─ comp_index_t2.v2:2\n" +
-			" │       │           └─ 44 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v3:3 BETWEEN 4 (tinyint) AND 51 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 97), (NULL, 47], [91, 91], [NULL, ∞)}, {[74, 74], (NULL, 44), [4, 51], (72, ∞)}, {[74, 74], (44, ∞), [4, 51], (72, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 97), (NULL, 47], [91, 91], [NULL, ∞)}, {[74, 74], (NULL, 44), [4, 51], (72, ∞)}, {[74, 74], (44, ∞), [4, 51], (72, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 97 (tinyint)\n" +
+			"     │   │   └─ LessThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 47 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 91 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 74 (tinyint)\n" +
+			"         │   │   └─ GreaterThan\n" +
+			"         │   │       ├─ comp_index_t2.v4:4\n" +
+			"         │   │       └─ 72 (tinyint)\n" +
+			"         │   └─ NOT\n" +
+			"         │       └─ Eq\n" +
+			"         │           ├─ comp_index_t2.v2:2\n" +
+			"         │           └─ 44 (tinyint)\n" +
+			"         └─ (comp_index_t2.v3:3 BETWEEN 4 (tinyint) AND 51 (tinyint))\n" +
 			"",
 	},
 	{
@@ -10972,49 +10701,48 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=33 AND v2<2 AND v3<>63) OR (v1 BETWEEN 20 AND 95 AND v2<>7 AND v3 BETWEEN 95 AND 96 AND v4 BETWEEN 34 AND 41)) OR (v1 BETWEEN 27 AND 44 AND v4<>28 AND v2<=43 AND v3<=64));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 33 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 2 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 63 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 20 (tinyint) AND 95 (tinyint))\n" +
-			" │   │       │   │   └─ NOT\n" +
-			" │   │       │   │       └─ Eq\n" +
-			" │   │       │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │           └─ 7 (tinyint)\n" +
-			" │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 95 (tinyint) AND 96 (tinyint))\n" +
-			" │   │       └─ (comp_index_t2.v4:4 BETWEEN 34 (tinyint) AND 41 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 27 (tinyint) AND 44 (tinyint))\n" +
-			" │       │   │   └─ NOT\n" +
-			" │       │   │       └─ Eq\n" +
-			" │       │   │           ├─ comp_index_t2.v4:4\n" +
-			" │       │   │           └─ 28 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 43 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 64 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[20, 33), (NULL, 7), [95, 96], [34, 41]}, {[20, 95], (7, ∞), [95, 96], [34, 41]}, {[27, 33), (NULL, 43], (NULL, 64], (NULL, 28)}, {[27, 33), (NULL, 43], (NULL, 64], (28, ∞)}, {[33, 33], (NULL, 2), (NULL, 63), [NULL, ∞)}, {[33, 33], (NULL, 2), [63, 63], (NULL, 28)}, {[33, 33], (NULL, 2), [63, 63], (28, ∞)}, {[33, 33], (NULL, 2), (63, ∞), [NULL, ∞)}, {[33, 33], [2, 7), [95, 96], [34, 41]}, {[33, 33], [2, 43], (NULL, 64], (NULL, 28)}, {[33, 33], [2, 43], (NULL, 64], (28, ∞)}, {(33, 44], (NULL, 43], (NULL, 64], (NULL, 28)}, {(33, 44], (NULL, 43], (NULL, 64], (28, ∞)}, {(33, 95], (NULL, 7), [95, 96], [34, 41]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[20, 33), (NULL, 7), [95, 96], [34, 41]}, {[20, 95], (7, ∞), [95, 96], [34, 41]}, {[27, 33), (NULL, 43], (NULL, 64], (NULL, 28)}, {[27, 33), (NULL, 43], (NULL, 64], (28, ∞)}, {[33, 33], (NULL, 2), (NULL, 63), [NULL, ∞)}, {[33, 33], (NULL, 2), [63, 63], (NULL, 28)}, {[33, 33], (NULL, 2), [63, 63], (28, ∞)}, {[33, 33], (NULL, 2), (63, ∞), [NULL, ∞)}, {[33, 33], [2, 7), [95, 96], [34, 41]}, {[33, 33], [2, 43], (NULL, 64], (NULL, 28)}, {[33, 33], [2, 43], (NULL, 64], (28, ∞)}, {(33, 44], (NULL, 43], (NULL, 64], (NULL, 28)}, {(33, 44], (NULL, 43], (NULL, 64], (28, ∞)}, {(33, 95], (NULL, 7), [95, 96], [34, 41]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 33 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 2 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 63 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 20 (tinyint) AND 95 (tinyint))\n" +
+			"     │       │   │   └─ NOT\n" +
+			"     │       │   │       └─ Eq\n" +
+			"     │       │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │           └─ 7 (tinyint)\n" +
+			"     │       │   └─ (comp_index_t2.v3:3 BETWEEN 95 (tinyint) AND 96 (tinyint))\n" +
+			"     │       └─ (comp_index_t2.v4:4 BETWEEN 34 (tinyint) AND 41 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ (comp_index_t2.v1:1 BETWEEN 27 (tinyint) AND 44 (tinyint))\n" +
+			"         │   │   └─ NOT\n" +
+			"         │   │       └─ Eq\n" +
+			"         │   │           ├─ comp_index_t2.v4:4\n" +
+			"         │   │           └─ 28 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 43 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 64 (tinyint)\n" +
 			"",
 	},
 	{
@@ -11035,26 +10763,25 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>33) OR (v1<23 AND v4<=23 AND v2>=41));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ GreaterThan\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 33 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 23 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 23 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 23), [41, ∞), [NULL, ∞), [NULL, ∞)}, {(33, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 23), [41, ∞), [NULL, ∞), [NULL, ∞)}, {(33, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ GreaterThan\n" +
+			"     │   ├─ comp_index_t2.v1:1\n" +
+			"     │   └─ 33 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 23 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v4:4\n" +
+			"         │       └─ 23 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 41 (tinyint)\n" +
 			"",
 	},
 	{
@@ -11067,143 +10794,139 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=51 AND v2=3 AND v3>48 AND v4>=49) OR (v1>25 AND v3=37));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 51 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 3 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 48 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 49 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 25 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(25, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(25, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 51 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 3 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 48 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 49 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 25 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 37 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<4 AND v2<>1 AND v3<=34) OR (v1>=63)) OR (v1<58 AND v2=33)) AND (v1<=55) OR (v1 BETWEEN 1 AND 80 AND v2<=51));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 4 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ NOT\n" +
-			" │   │   │   │   │   │       └─ Eq\n" +
-			" │   │   │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │   │           └─ 1 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 34 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 63 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ LessThan\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 58 (tinyint)\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 33 (tinyint)\n" +
-			" │   │   └─ LessThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 55 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t2.v1:1 BETWEEN 1 (tinyint) AND 80 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 51 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 1), (NULL, 1), (NULL, 34], [NULL, ∞)}, {(NULL, 1), (1, 33), (NULL, 34], [NULL, ∞)}, {(NULL, 1), [33, 33], [NULL, ∞), [NULL, ∞)}, {(NULL, 1), (33, ∞), (NULL, 34], [NULL, ∞)}, {[1, 4), (51, ∞), (NULL, 34], [NULL, ∞)}, {[1, 80], (NULL, 51], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 1), (NULL, 1), (NULL, 34], [NULL, ∞)}, {(NULL, 1), (1, 33), (NULL, 34], [NULL, ∞)}, {(NULL, 1), [33, 33], [NULL, ∞), [NULL, ∞)}, {(NULL, 1), (33, ∞), (NULL, 34], [NULL, ∞)}, {[1, 4), (51, ∞), (NULL, 34], [NULL, ∞)}, {[1, 80], (NULL, 51], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   │   └─ 4 (tinyint)\n" +
+			"     │   │   │   │   │   └─ NOT\n" +
+			"     │   │   │   │   │       └─ Eq\n" +
+			"     │   │   │   │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │   │           └─ 1 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │       └─ 34 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 63 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ LessThan\n" +
+			"     │   │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   └─ 58 (tinyint)\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 33 (tinyint)\n" +
+			"     │   └─ LessThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 55 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t2.v1:1 BETWEEN 1 (tinyint) AND 80 (tinyint))\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 51 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 33 AND 82 AND v2<26) OR (v1>=98 AND v4>30 AND v2 BETWEEN 47 AND 67 AND v3 BETWEEN 9 AND 54)) OR (v1>=5)) AND (v1<>85 AND v4<>31);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 33 (tinyint) AND 82 (tinyint))\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 26 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 98 (tinyint)\n" +
-			" │   │   │       │   │   └─ GreaterThan\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │   │       └─ 30 (tinyint)\n" +
-			" │   │   │       │   └─ (comp_index_t2.v2:2 BETWEEN 47 (tinyint) AND 67 (tinyint))\n" +
-			" │   │   │       └─ (comp_index_t2.v3:3 BETWEEN 9 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 5 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 31 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[5, 85), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(85, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[5, 85), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(85, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: AND\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 33 (tinyint) AND 82 (tinyint))\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 26 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 98 (tinyint)\n" +
+			"     │   │       │   │   └─ GreaterThan\n" +
+			"     │   │       │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       │   │       └─ 30 (tinyint)\n" +
+			"     │   │       │   └─ (comp_index_t2.v2:2 BETWEEN 47 (tinyint) AND 67 (tinyint))\n" +
+			"     │   │       └─ (comp_index_t2.v3:3 BETWEEN 9 (tinyint) AND 54 (tinyint))\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 5 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 31 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=82 AND v3<>55 AND v4>26) OR (v1=35)) OR (v1 BETWEEN 18 AND 70 AND v2>=17));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 82 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │           └─ 55 (tinyint)\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 26 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 35 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 70 (tinyint))\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 17 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[18, 35), [17, ∞), [NULL, ∞), [NULL, ∞)}, {[35, 35], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(35, 70], [17, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[18, 35), [17, ∞), [NULL, ∞), [NULL, ∞)}, {[35, 35], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(35, 70], [17, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 82 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │           └─ 55 (tinyint)\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 26 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 35 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 70 (tinyint))\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 17 (tinyint)\n" +
 			"",
 	},
 	{
@@ -11224,122 +10947,118 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<90 AND v4=77) OR (v1<>32 AND v2<=17 AND v3=68)) OR (v1<41));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 90 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 77 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ NOT\n" +
-			" │   │       │   │   └─ Eq\n" +
-			" │   │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │       └─ 32 (tinyint)\n" +
-			" │   │       │   └─ LessThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 17 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 68 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 90), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[90, ∞), (NULL, 17], [68, 68], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 90), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[90, ∞), (NULL, 17], [68, 68], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 90 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 77 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ NOT\n" +
+			"     │       │   │   └─ Eq\n" +
+			"     │       │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │       └─ 32 (tinyint)\n" +
+			"     │       │   └─ LessThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 17 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 68 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 41 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=2) AND (v1>=13 AND v2<=23 AND v3<=23) OR (v1 BETWEEN 18 AND 57));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Eq\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 2 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 13 (tinyint)\n" +
-			" │   │       │   └─ LessThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 23 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 23 (tinyint)\n" +
-			" │   └─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 57 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[18, 57], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[18, 57], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 2 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 13 (tinyint)\n" +
+			"     │       │   └─ LessThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 23 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 23 (tinyint)\n" +
+			"     └─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 57 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 32 AND 72 AND v2<>89 AND v3>=39) OR (v1>50 AND v4>80));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 32 (tinyint) AND 72 (tinyint))\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 89 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 39 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 50 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 80 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[32, 50], (NULL, 89), [39, ∞), [NULL, ∞)}, {[32, 50], (89, ∞), [39, ∞), [NULL, ∞)}, {(50, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[32, 50], (NULL, 89), [39, ∞), [NULL, ∞)}, {[32, 50], (89, ∞), [39, ∞), [NULL, ∞)}, {(50, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ (comp_index_t2.v1:1 BETWEEN 32 (tinyint) AND 72 (tinyint))\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 89 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 39 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 50 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 80 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<44) OR (v1<>37 AND v2<=12 AND v3>65 AND v4<47)) OR (v1<>76));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ LessThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 44 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ NOT\n" +
-			" │   │       │   │   │   └─ Eq\n" +
-			" │   │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │       └─ 37 (tinyint)\n" +
-			" │   │       │   │   └─ LessThanOrEqual\n" +
-			" │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │       └─ 12 (tinyint)\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 65 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 47 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 76 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 76), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[76, 76], (NULL, 12], (65, ∞), (NULL, 47)}, {(76, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 76), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[76, 76], (NULL, 12], (65, ∞), (NULL, 47)}, {(76, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ LessThan\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 44 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ NOT\n" +
+			"     │       │   │   │   └─ Eq\n" +
+			"     │       │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │       └─ 37 (tinyint)\n" +
+			"     │       │   │   └─ LessThanOrEqual\n" +
+			"     │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │       └─ 12 (tinyint)\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 65 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 47 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v1:1\n" +
+			"             └─ 76 (tinyint)\n" +
 			"",
 	},
 	{
@@ -11360,174 +11079,169 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>10 AND v2<43 AND v3<>15) OR (v1<=71 AND v4<>22));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 10 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 43 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 15 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 71 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v4:4\n" +
-			" │               └─ 22 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 71], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(71, ∞), (NULL, 43), (NULL, 15), [NULL, ∞)}, {(71, ∞), (NULL, 43), (15, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 71], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(71, ∞), (NULL, 43), (NULL, 15), [NULL, ∞)}, {(71, ∞), (NULL, 43), (15, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 10 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 43 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 15 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 71 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v4:4\n" +
+			"                 └─ 22 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 18 AND 36 AND v4<>87 AND v2>=13) OR (v1>=63 AND v3<=89)) AND (v1<76 AND v4<49 AND v2<=96);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 36 (tinyint))\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │           └─ 87 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 13 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 63 (tinyint)\n" +
-			" │   │       └─ LessThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 89 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v4:4\n" +
-			" │       └─ 49 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[18, 36], [13, 96], [NULL, ∞), [NULL, ∞)}, {[63, 76), (NULL, 96], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[18, 36], [13, 96], [NULL, ∞), [NULL, ∞)}, {[63, 76), (NULL, 96], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: AND\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 18 (tinyint) AND 36 (tinyint))\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │           └─ 87 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 13 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ GreaterThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 63 (tinyint)\n" +
+			"     │       └─ LessThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 89 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t2.v4:4\n" +
+			"         └─ 49 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<93 AND v2<>16) OR (v1>=23 AND v4>=19)) OR (v1<48 AND v2<=45 AND v3<>46 AND v4>76)) AND (v1=22 AND v3=41) OR (v1<=17 AND v2>=41));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 93 (tinyint)\n" +
-			" │   │   │   │   │   └─ NOT\n" +
-			" │   │   │   │   │       └─ Eq\n" +
-			" │   │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │           └─ 16 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       │   └─ 23 (tinyint)\n" +
-			" │   │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │           └─ 19 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ LessThan\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 48 (tinyint)\n" +
-			" │   │   │       │   │   └─ LessThanOrEqual\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │   │       └─ 45 (tinyint)\n" +
-			" │   │   │       │   └─ NOT\n" +
-			" │   │   │       │       └─ Eq\n" +
-			" │   │   │       │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │           └─ 46 (tinyint)\n" +
-			" │   │   │       └─ GreaterThan\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 76 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ Eq\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 22 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 41 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 17 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 41 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 17], [41, ∞), [NULL, ∞), [NULL, ∞)}, {[22, 22], (NULL, 16), [NULL, ∞), [NULL, ∞)}, {[22, 22], [16, 16], (NULL, 46), (76, ∞)}, {[22, 22], [16, 16], (46, ∞), (76, ∞)}, {[22, 22], (16, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 17], [41, ∞), [NULL, ∞), [NULL, ∞)}, {[22, 22], (NULL, 16), [NULL, ∞), [NULL, ∞)}, {[22, 22], [16, 16], (NULL, 46), (76, ∞)}, {[22, 22], [16, 16], (46, ∞), (76, ∞)}, {[22, 22], (16, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 93 (tinyint)\n" +
+			"     │   │   │   │   └─ NOT\n" +
+			"     │   │   │   │       └─ Eq\n" +
+			"     │   │   │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │           └─ 16 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ GreaterThanOrEqual\n" +
+			"     │   │   │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       │   └─ 23 (tinyint)\n" +
+			"     │   │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │           └─ 19 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ LessThan\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 48 (tinyint)\n" +
+			"     │   │       │   │   └─ LessThanOrEqual\n" +
+			"     │   │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │   │       └─ 45 (tinyint)\n" +
+			"     │   │       │   └─ NOT\n" +
+			"     │   │       │       └─ Eq\n" +
+			"     │   │       │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │           └─ 46 (tinyint)\n" +
+			"     │   │       └─ GreaterThan\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 76 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ Eq\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 22 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 41 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 17 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 41 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>17 AND v4>50 AND v2 BETWEEN 11 AND 23 AND v3=23) OR (v1<73));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 17 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 50 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 11 (tinyint) AND 23 (tinyint))\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 23 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 73 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 73), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[73, ∞), [11, 23], [23, 23], (50, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 73), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[73, ∞), [11, 23], [23, 23], (50, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 17 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 50 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t2.v2:2 BETWEEN 11 (tinyint) AND 23 (tinyint))\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 23 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 73 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 5 AND 41 AND v3<78 AND v4<41) OR (v1>84 AND v2<>43));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 5 (tinyint) AND 41 (tinyint))\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 78 (tinyint)\n" +
-			" │   │   └─ LessThan\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 41 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 84 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v2:2\n" +
-			" │               └─ 43 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[5, 41], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(84, ∞), (NULL, 43), [NULL, ∞), [NULL, ∞)}, {(84, ∞), (43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[5, 41], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(84, ∞), (NULL, 43), [NULL, ∞), [NULL, ∞)}, {(84, ∞), (43, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ (comp_index_t2.v1:1 BETWEEN 5 (tinyint) AND 41 (tinyint))\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 78 (tinyint)\n" +
+			"     │   └─ LessThan\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 41 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 84 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v2:2\n" +
+			"                 └─ 43 (tinyint)\n" +
 			"",
 	},
 	{
@@ -11540,56 +11254,55 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<=18 AND v2<=70) OR (v1>55 AND v2>52 AND v3<>70)) OR (v1=58)) AND (v1<>22 AND v4>76) OR (v1>14 AND v2<32 AND v3>97));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 18 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 70 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ AND\n" +
-			" │   │   │   │       │   ├─ GreaterThan\n" +
-			" │   │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       │   │   └─ 55 (tinyint)\n" +
-			" │   │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       │       └─ 52 (tinyint)\n" +
-			" │   │   │   │       └─ NOT\n" +
-			" │   │   │   │           └─ Eq\n" +
-			" │   │   │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │               └─ 70 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 58 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ NOT\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │       └─ 22 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 76 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 14 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 32 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 18], (NULL, 70], [NULL, ∞), [NULL, ∞)}, {(18, 58), (NULL, 32), (97, ∞), [NULL, ∞)}, {(55, 58), (52, ∞), (NULL, 70), [NULL, ∞)}, {(55, 58), (52, ∞), (70, ∞), [NULL, ∞)}, {[58, 58], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(58, ∞), (NULL, 32), (97, ∞), [NULL, ∞)}, {(58, ∞), (52, ∞), (NULL, 70), [NULL, ∞)}, {(58, ∞), (52, ∞), (70, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 18], (NULL, 70], [NULL, ∞), [NULL, ∞)}, {(18, 58), (NULL, 32), (97, ∞), [NULL, ∞)}, {(55, 58), (52, ∞), (NULL, 70), [NULL, ∞)}, {(55, 58), (52, ∞), (70, ∞), [NULL, ∞)}, {[58, 58], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(58, ∞), (NULL, 32), (97, ∞), [NULL, ∞)}, {(58, ∞), (52, ∞), (NULL, 70), [NULL, ∞)}, {(58, ∞), (52, ∞), (70, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 18 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 70 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ AND\n" +
+			"     │   │   │       │   ├─ GreaterThan\n" +
+			"     │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       │   │   └─ 55 (tinyint)\n" +
+			"     │   │   │       │   └─ GreaterThan\n" +
+			"     │   │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       │       └─ 52 (tinyint)\n" +
+			"     │   │   │       └─ NOT\n" +
+			"     │   │   │           └─ Eq\n" +
+			"     │   │   │               ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │               └─ 70 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       └─ 58 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ NOT\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │       └─ 22 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 76 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 14 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 32 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 97 (tinyint)\n" +
 			"",
 	},
 	{
@@ -11610,38 +11323,37 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=9 AND v4>=68 AND v2>21) OR (v1=5 AND v2<69 AND v3<=15 AND v4>=61));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 9 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 68 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 21 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ Eq\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 5 (tinyint)\n" +
-			" │       │   │   └─ LessThan\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 69 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 15 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 61 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[5, 5], (NULL, 69), (NULL, 15], [61, ∞)}, {[9, 9], (21, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[5, 5], (NULL, 69), (NULL, 15], [61, ∞)}, {[9, 9], (21, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 9 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 68 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v2:2\n" +
+			"     │       └─ 21 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 5 (tinyint)\n" +
+			"         │   │   └─ LessThan\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 69 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 15 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 61 (tinyint)\n" +
 			"",
 	},
 	{
@@ -11678,56 +11390,54 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>74) OR (v1<>86 AND v2<=91)) AND (v1>=8);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 74 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 86 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 91 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[8, 74), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[74, 74], (NULL, 91], [NULL, ∞), [NULL, ∞)}, {(74, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[8, 74), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[74, 74], (NULL, 91], [NULL, ∞), [NULL, ∞)}, {(74, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 74 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v1:1\n" +
+			"         │       └─ 86 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 91 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>25 AND v2 BETWEEN 23 AND 54) OR (v1<>40 AND v3>90)) OR (v1<>7 AND v4<=78));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 25 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 23 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ NOT\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │       └─ 40 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 90 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 7 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 78 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 25 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t2.v2:2 BETWEEN 23 (tinyint) AND 54 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ NOT\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │       └─ 40 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 90 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v1:1\n" +
+			"         │       └─ 7 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 78 (tinyint)\n" +
 			"",
 	},
 	{
@@ -11772,22 +11482,21 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>75) OR (v1<>74 AND v3 BETWEEN 29 AND 73));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 75 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ NOT\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v1:1\n" +
-			" │       │       └─ 74 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v3:3 BETWEEN 29 (tinyint) AND 73 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 75 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v1:1\n" +
+			"         │       └─ 74 (tinyint)\n" +
+			"         └─ (comp_index_t2.v3:3 BETWEEN 29 (tinyint) AND 73 (tinyint))\n" +
 			"",
 	},
 	{
@@ -11800,91 +11509,89 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<>18 AND v2<>90 AND v3>95) OR (v1>=44)) OR (v1<4 AND v3<=26 AND v4<>67 AND v2>=37)) OR (v1<36 AND v2<=15 AND v3 BETWEEN 25 AND 36 AND v4<=14));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │       └─ 18 (tinyint)\n" +
-			" │   │   │   │   │   └─ NOT\n" +
-			" │   │   │   │   │       └─ Eq\n" +
-			" │   │   │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │           └─ 90 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 95 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 44 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ LessThan\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 4 (tinyint)\n" +
-			" │   │       │   │   └─ LessThanOrEqual\n" +
-			" │   │       │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │   │       └─ 26 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v4:4\n" +
-			" │   │       │           └─ 67 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 37 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 36 (tinyint)\n" +
-			" │       │   │   └─ LessThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 15 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v3:3 BETWEEN 25 (tinyint) AND 36 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 14 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 4), [37, ∞), (NULL, 26], (NULL, 67)}, {(NULL, 4), [37, ∞), (NULL, 26], (67, ∞)}, {(NULL, 18), (NULL, 90), (95, ∞), [NULL, ∞)}, {(NULL, 18), (90, ∞), (95, ∞), [NULL, ∞)}, {(NULL, 36), (NULL, 15], [25, 36], (NULL, 14]}, {(18, 44), (NULL, 90), (95, ∞), [NULL, ∞)}, {(18, 44), (90, ∞), (95, ∞), [NULL, ∞)}, {[44, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 4), [37, ∞), (NULL, 26], (NULL, 67)}, {(NULL, 4), [37, ∞), (NULL, 26], (67, ∞)}, {(NULL, 18), (NULL, 90), (95, ∞), [NULL, ∞)}, {(NULL, 18), (90, ∞), (95, ∞), [NULL, ∞)}, {(NULL, 36), (NULL, 15], [25, 36], (NULL, 14]}, {(18, 44), (NULL, 90), (95, ∞), [NULL, ∞)}, {(18, 44), (90, ∞), (95, ∞), [NULL, ∞)}, {[44, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │       └─ 18 (tinyint)\n" +
+			"     │   │   │   │   └─ NOT\n" +
+			"     │   │   │   │       └─ Eq\n" +
+			"     │   │   │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │           └─ 90 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 95 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       └─ 44 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ LessThan\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 4 (tinyint)\n" +
+			"     │       │   │   └─ LessThanOrEqual\n" +
+			"     │       │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │   │       └─ 26 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t2.v4:4\n" +
+			"     │       │           └─ 67 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 37 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 36 (tinyint)\n" +
+			"         │   │   └─ LessThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 15 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v3:3 BETWEEN 25 (tinyint) AND 36 (tinyint))\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 14 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 44 AND 87 AND v2<52 AND v3<52 AND v4<1) OR (v1<30 AND v4 BETWEEN 8 AND 97 AND v2<=24));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 44 (tinyint) AND 87 (tinyint))\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 52 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 52 (tinyint)\n" +
-			" │   │   └─ LessThan\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 1 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 30 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v4:4 BETWEEN 8 (tinyint) AND 97 (tinyint))\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 24 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 30), (NULL, 24], [NULL, ∞), [NULL, ∞)}, {[44, 87], (NULL, 52), (NULL, 52), (NULL, 1)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 30), (NULL, 24], [NULL, ∞), [NULL, ∞)}, {[44, 87], (NULL, 52), (NULL, 52), (NULL, 1)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 44 (tinyint) AND 87 (tinyint))\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 52 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 52 (tinyint)\n" +
+			"     │   └─ LessThan\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 1 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 30 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v4:4 BETWEEN 8 (tinyint) AND 97 (tinyint))\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 24 (tinyint)\n" +
 			"",
 	},
 	{
@@ -11897,22 +11604,21 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>8 AND v2 BETWEEN 34 AND 48) OR (v1<>54));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 8 (tinyint)\n" +
-			" │   │   └─ (comp_index_t2.v2:2 BETWEEN 34 (tinyint) AND 48 (tinyint))\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 54 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 54), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[54, 54], [34, 48], [NULL, ∞), [NULL, ∞)}, {(54, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 54), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[54, 54], [34, 48], [NULL, ∞), [NULL, ∞)}, {(54, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       └─ 8 (tinyint)\n" +
+			"     │   └─ (comp_index_t2.v2:2 BETWEEN 34 (tinyint) AND 48 (tinyint))\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v1:1\n" +
+			"             └─ 54 (tinyint)\n" +
 			"",
 	},
 	{
@@ -11927,7 +11633,7 @@ var IndexPlanTests = []QueryPlanTest{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1 BETWEEN 9 AND 35 AND v4<=69 AND v2 BETWEEN 34 AND 53 AND v3<>28) AND (v1 BETWEEN 12 AND 48);`,
 		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
 			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			" ├─ static: [{[12, 35], [34, 53], (28, ∞), (NULL, 69]}, {[12, 35], [34, 53], (NULL, 28), (NULL, 69]}]\n" +
+			" ├─ static: [{[12, 35], [34, 53], (NULL, 28), (NULL, 69]}, {[12, 35], [34, 53], (28, ∞), (NULL, 69]}]\n" +
 			" └─ columns: [pk v1 v2 v3 v4]\n" +
 			"",
 	},
@@ -11941,85 +11647,83 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>47 AND v3>47 AND v4 BETWEEN 51 AND 86 AND v2=26) OR (v1<82 AND v2<=17 AND v3<17 AND v4>=46));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 47 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 47 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 51 (tinyint) AND 86 (tinyint))\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 26 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 82 (tinyint)\n" +
-			" │       │   │   └─ LessThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 17 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 17 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 46 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 82), (NULL, 17], (NULL, 17), [46, ∞)}, {(47, ∞), [26, 26], (47, ∞), [51, 86]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 82), (NULL, 17], (NULL, 17), [46, ∞)}, {(47, ∞), [26, 26], (47, ∞), [51, 86]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 47 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 47 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t2.v4:4 BETWEEN 51 (tinyint) AND 86 (tinyint))\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v2:2\n" +
+			"     │       └─ 26 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 82 (tinyint)\n" +
+			"         │   │   └─ LessThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 17 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 17 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 46 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>87) OR (v1>82 AND v4>=22)) OR (v1>=52 AND v2<>47 AND v3=37)) OR (v1<=14 AND v2<57 AND v3<10));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 87 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ GreaterThan\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 82 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 22 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 52 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │           └─ 47 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 37 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 14 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 57 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 10 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 14], (NULL, 57), (NULL, 10), [NULL, ∞)}, {[52, 82], (NULL, 47), [37, 37], [NULL, ∞)}, {[52, 82], (47, ∞), [37, 37], [NULL, ∞)}, {(82, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 14], (NULL, 57), (NULL, 10), [NULL, ∞)}, {[52, 82], (NULL, 47), [37, 37], [NULL, ∞)}, {[52, 82], (47, ∞), [37, 37], [NULL, ∞)}, {(82, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ GreaterThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 87 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ GreaterThan\n" +
+			"     │   │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   └─ 82 (tinyint)\n" +
+			"     │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 22 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 52 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t2.v2:2\n" +
+			"     │       │           └─ 47 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 37 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 14 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 57 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 10 (tinyint)\n" +
 			"",
 	},
 	{
@@ -12056,50 +11760,49 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>=12 AND v3>=45 AND v4<98) OR (v1<>51 AND v3=79 AND v4<=24)) OR (v1 BETWEEN 4 AND 59 AND v4<82)) OR (v1>=29 AND v2<>21));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 12 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 45 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 98 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ NOT\n" +
-			" │   │   │       │   │   └─ Eq\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │       └─ 51 (tinyint)\n" +
-			" │   │   │       │   └─ Eq\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 79 (tinyint)\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 24 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 4 (tinyint) AND 59 (tinyint))\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 82 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 29 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v2:2\n" +
-			" │               └─ 21 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 12 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │       └─ 45 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 98 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ NOT\n" +
+			"     │   │       │   │   └─ Eq\n" +
+			"     │   │       │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │       └─ 51 (tinyint)\n" +
+			"     │   │       │   └─ Eq\n" +
+			"     │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │       └─ 79 (tinyint)\n" +
+			"     │   │       └─ LessThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 24 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ (comp_index_t2.v1:1 BETWEEN 4 (tinyint) AND 59 (tinyint))\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 82 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 29 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v2:2\n" +
+			"                 └─ 21 (tinyint)\n" +
 			"",
 	},
 	{
@@ -12112,32 +11815,31 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=15) OR (v1=15)) OR (v1 BETWEEN 14 AND 25 AND v4>55 AND v2<53 AND v3=95));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 15 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 15 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 14 (tinyint) AND 25 (tinyint))\n" +
-			" │       │   │   └─ GreaterThan\n" +
-			" │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │       │   │       └─ 55 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 53 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 95 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[14, 15), (NULL, 53), [95, 95], (55, ∞)}, {[15, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[14, 15), (NULL, 53), [95, 95], (55, ∞)}, {[15, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 15 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 15 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ (comp_index_t2.v1:1 BETWEEN 14 (tinyint) AND 25 (tinyint))\n" +
+			"         │   │   └─ GreaterThan\n" +
+			"         │   │       ├─ comp_index_t2.v4:4\n" +
+			"         │   │       └─ 55 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 53 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 95 (tinyint)\n" +
 			"",
 	},
 	{
@@ -12150,98 +11852,96 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<41 AND v4=9 AND v2>77 AND v3=41) OR (v1>62 AND v2>=48 AND v3=13 AND v4>61)) OR (v1 BETWEEN 33 AND 75)) OR (v1 BETWEEN 45 AND 65 AND v4 BETWEEN 4 AND 68));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 41 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │   │       └─ 9 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 77 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 41 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ GreaterThan\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 62 (tinyint)\n" +
-			" │   │   │       │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │   │       └─ 48 (tinyint)\n" +
-			" │   │   │       │   └─ Eq\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 13 (tinyint)\n" +
-			" │   │   │       └─ GreaterThan\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 61 (tinyint)\n" +
-			" │   │   └─ (comp_index_t2.v1:1 BETWEEN 33 (tinyint) AND 75 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ (comp_index_t2.v1:1 BETWEEN 45 (tinyint) AND 65 (tinyint))\n" +
-			" │       └─ (comp_index_t2.v4:4 BETWEEN 4 (tinyint) AND 68 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 33), (77, ∞), [41, 41], [9, 9]}, {[33, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, ∞), [48, ∞), [13, 13], (61, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 33), (77, ∞), [41, 41], [9, 9]}, {[33, 75], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(75, ∞), [48, ∞), [13, 13], (61, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   │   └─ 41 (tinyint)\n" +
+			"     │   │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │   │       └─ 9 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 77 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 41 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ GreaterThan\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 62 (tinyint)\n" +
+			"     │   │       │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │   │       └─ 48 (tinyint)\n" +
+			"     │   │       │   └─ Eq\n" +
+			"     │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │       └─ 13 (tinyint)\n" +
+			"     │   │       └─ GreaterThan\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 61 (tinyint)\n" +
+			"     │   └─ (comp_index_t2.v1:1 BETWEEN 33 (tinyint) AND 75 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ (comp_index_t2.v1:1 BETWEEN 45 (tinyint) AND 65 (tinyint))\n" +
+			"         └─ (comp_index_t2.v4:4 BETWEEN 4 (tinyint) AND 68 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>20) OR (v1>=71 AND v4 BETWEEN 12 AND 20 AND v2<=30 AND v3 BETWEEN 14 AND 44)) AND (v1>97 AND v2=91 AND v3>=5) OR (v1>7 AND v2<34 AND v3<55 AND v4 BETWEEN 88 AND 97)) AND (v1 BETWEEN 2 AND 16 AND v2<>23 AND v3=75 AND v4>99);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 20 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 71 (tinyint)\n" +
-			" │   │   │       │   │   └─ (comp_index_t2.v4:4 BETWEEN 12 (tinyint) AND 20 (tinyint))\n" +
-			" │   │   │       │   └─ LessThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 30 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t2.v3:3 BETWEEN 14 (tinyint) AND 44 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 97 (tinyint)\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 91 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 5 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 7 (tinyint)\n" +
-			" │       │   │   └─ LessThan\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 34 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 55 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v4:4 BETWEEN 88 (tinyint) AND 97 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(∞, ∞), (∞, ∞), (∞, ∞), (∞, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ GreaterThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 20 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 71 (tinyint)\n" +
+			"     │   │       │   │   └─ (comp_index_t2.v4:4 BETWEEN 12 (tinyint) AND 20 (tinyint))\n" +
+			"     │   │       │   └─ LessThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │       └─ 30 (tinyint)\n" +
+			"     │   │       └─ (comp_index_t2.v3:3 BETWEEN 14 (tinyint) AND 44 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 97 (tinyint)\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 91 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 5 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ GreaterThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 7 (tinyint)\n" +
+			"         │   │   └─ LessThan\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 34 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 55 (tinyint)\n" +
+			"         └─ (comp_index_t2.v4:4 BETWEEN 88 (tinyint) AND 97 (tinyint))\n" +
 			"",
 	},
 	{
@@ -12262,48 +11962,47 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1=76) OR (v1>22 AND v3<49 AND v4=2)) OR (v1=85 AND v4>79)) OR (v1=10 AND v2=47 AND v3 BETWEEN 6 AND 21 AND v4>97));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 76 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 22 (tinyint)\n" +
-			" │   │   │       │   └─ LessThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 49 (tinyint)\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 2 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ Eq\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 85 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 79 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ Eq\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 10 (tinyint)\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 47 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v3:3 BETWEEN 6 (tinyint) AND 21 (tinyint))\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 97 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[10, 10], [47, 47], [6, 21], (97, ∞)}, {(22, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[10, 10], [47, 47], [6, 21], (97, ∞)}, {(22, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 76 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ GreaterThan\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 22 (tinyint)\n" +
+			"     │   │       │   └─ LessThan\n" +
+			"     │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │       └─ 49 (tinyint)\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 2 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ Eq\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 85 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 79 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 10 (tinyint)\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 47 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v3:3 BETWEEN 6 (tinyint) AND 21 (tinyint))\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 97 (tinyint)\n" +
 			"",
 	},
 	{
@@ -12316,41 +12015,40 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>82 AND v4=74 AND v2=8 AND v3>=43) OR (v1=1 AND v2>=54 AND v3 BETWEEN 41 AND 91 AND v4>=0));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │       └─ 82 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 74 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 8 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 43 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ Eq\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 1 (tinyint)\n" +
-			" │       │   │   └─ GreaterThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 54 (tinyint)\n" +
-			" │       │   └─ (comp_index_t2.v3:3 BETWEEN 41 (tinyint) AND 91 (tinyint))\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 0 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 82), [8, 8], [43, ∞), [74, 74]}, {[1, 1], [54, ∞), [41, 91], [0, ∞)}, {(82, ∞), [8, 8], [43, ∞), [74, 74]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 82), [8, 8], [43, ∞), [74, 74]}, {[1, 1], [54, ∞), [41, 91], [0, ∞)}, {(82, ∞), [8, 8], [43, ∞), [74, 74]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │       └─ 82 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 74 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 8 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 43 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 1 (tinyint)\n" +
+			"         │   │   └─ GreaterThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 54 (tinyint)\n" +
+			"         │   └─ (comp_index_t2.v3:3 BETWEEN 41 (tinyint) AND 91 (tinyint))\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 0 (tinyint)\n" +
 			"",
 	},
 	{
@@ -12371,73 +12069,72 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<=10 AND v2 BETWEEN 29 AND 83 AND v3<30 AND v4=54) OR (v1=68 AND v2=9 AND v3<=31)) AND (v1=87 AND v2>=91) OR (v1<=3 AND v2<>65 AND v3<8 AND v4<54)) OR (v1<7 AND v2>=4 AND v3<=47));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │   │   └─ 10 (tinyint)\n" +
-			" │   │   │   │   │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 29 (tinyint) AND 83 (tinyint))\n" +
-			" │   │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │   │       └─ 30 (tinyint)\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │       └─ 54 (tinyint)\n" +
-			" │   │   │   │   └─ AND\n" +
-			" │   │   │   │       ├─ AND\n" +
-			" │   │   │   │       │   ├─ Eq\n" +
-			" │   │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       │   │   └─ 68 (tinyint)\n" +
-			" │   │   │   │       │   └─ Eq\n" +
-			" │   │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       │       └─ 9 (tinyint)\n" +
-			" │   │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │           └─ 31 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ Eq\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 87 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 91 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 3 (tinyint)\n" +
-			" │   │       │   │   └─ NOT\n" +
-			" │   │       │   │       └─ Eq\n" +
-			" │   │       │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │           └─ 65 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 8 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 54 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 7 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 4 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 47 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 3], (NULL, 4), (NULL, 8), (NULL, 54)}, {(NULL, 7), [4, ∞), (NULL, 47], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 3], (NULL, 4), (NULL, 8), (NULL, 54)}, {(NULL, 7), [4, ∞), (NULL, 47], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   │   │   └─ 10 (tinyint)\n" +
+			"     │   │   │   │   │   │   └─ (comp_index_t2.v2:2 BETWEEN 29 (tinyint) AND 83 (tinyint))\n" +
+			"     │   │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │   │       └─ 30 (tinyint)\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │       └─ 54 (tinyint)\n" +
+			"     │   │   │   └─ AND\n" +
+			"     │   │   │       ├─ AND\n" +
+			"     │   │   │       │   ├─ Eq\n" +
+			"     │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       │   │   └─ 68 (tinyint)\n" +
+			"     │   │   │       │   └─ Eq\n" +
+			"     │   │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       │       └─ 9 (tinyint)\n" +
+			"     │   │   │       └─ LessThanOrEqual\n" +
+			"     │   │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │           └─ 31 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ Eq\n" +
+			"     │   │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   └─ 87 (tinyint)\n" +
+			"     │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 91 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 3 (tinyint)\n" +
+			"     │       │   │   └─ NOT\n" +
+			"     │       │   │       └─ Eq\n" +
+			"     │       │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │           └─ 65 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 8 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 54 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 7 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 4 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 47 (tinyint)\n" +
 			"",
 	},
 	{
@@ -12458,78 +12155,76 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>52) OR (v1<21 AND v2<61 AND v3=13)) OR (v1=89 AND v3>33));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ GreaterThan\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 52 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 21 (tinyint)\n" +
-			" │   │       │   └─ LessThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 61 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 13 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 89 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 33 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 21), (NULL, 61), [13, 13], [NULL, ∞)}, {(52, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 21), (NULL, 61), [13, 13], [NULL, ∞)}, {(52, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ GreaterThan\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 52 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 21 (tinyint)\n" +
+			"     │       │   └─ LessThan\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 61 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 13 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 89 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 33 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<30 AND v4>11 AND v2<=11) OR (v1<>19 AND v2<>47 AND v3 BETWEEN 38 AND 77 AND v4>31)) OR (v1 BETWEEN 0 AND 27 AND v2 BETWEEN 33 AND 34)) OR (v1<32)) AND (v1<9 AND v3=54 AND v4<>31 AND v2<>95);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 30 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │       └─ 11 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 11 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ NOT\n" +
-			" │   │   │       │   │   │   └─ Eq\n" +
-			" │   │   │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │       └─ 19 (tinyint)\n" +
-			" │   │   │       │   │   └─ NOT\n" +
-			" │   │   │       │   │       └─ Eq\n" +
-			" │   │   │       │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │   │           └─ 47 (tinyint)\n" +
-			" │   │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 38 (tinyint) AND 77 (tinyint))\n" +
-			" │   │   │       └─ GreaterThan\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 31 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 0 (tinyint) AND 27 (tinyint))\n" +
-			" │   │       └─ (comp_index_t2.v2:2 BETWEEN 33 (tinyint) AND 34 (tinyint))\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 32 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 9), (NULL, 95), [54, 54], (NULL, 31)}, {(NULL, 9), (NULL, 95), [54, 54], (31, ∞)}, {(NULL, 9), (95, ∞), [54, 54], (NULL, 31)}, {(NULL, 9), (95, ∞), [54, 54], (31, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 9), (NULL, 95), [54, 54], (NULL, 31)}, {(NULL, 9), (NULL, 95), [54, 54], (31, ∞)}, {(NULL, 9), (95, ∞), [54, 54], (NULL, 31)}, {(NULL, 9), (95, ∞), [54, 54], (31, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 30 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │       └─ 11 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 11 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ NOT\n" +
+			"     │   │       │   │   │   └─ Eq\n" +
+			"     │   │       │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │       └─ 19 (tinyint)\n" +
+			"     │   │       │   │   └─ NOT\n" +
+			"     │   │       │   │       └─ Eq\n" +
+			"     │   │       │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │   │           └─ 47 (tinyint)\n" +
+			"     │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 38 (tinyint) AND 77 (tinyint))\n" +
+			"     │   │       └─ GreaterThan\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 31 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ (comp_index_t2.v1:1 BETWEEN 0 (tinyint) AND 27 (tinyint))\n" +
+			"     │       └─ (comp_index_t2.v2:2 BETWEEN 33 (tinyint) AND 34 (tinyint))\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 32 (tinyint)\n" +
 			"",
 	},
 	{
@@ -12542,128 +12237,125 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<>36) OR (v1<>70 AND v2 BETWEEN 23 AND 39)) OR (v1>51 AND v2>=57)) OR (v1<50 AND v2<=3 AND v3 BETWEEN 1 AND 74));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ NOT\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 36 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ NOT\n" +
-			" │   │   │       │   └─ Eq\n" +
-			" │   │   │       │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │       └─ 70 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t2.v2:2 BETWEEN 23 (tinyint) AND 39 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThan\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 51 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 57 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ LessThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 50 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 3 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v3:3 BETWEEN 1 (tinyint) AND 74 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 36), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[36, 36], (NULL, 3], [1, 74], [NULL, ∞)}, {[36, 36], [23, 39], [NULL, ∞), [NULL, ∞)}, {(36, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 36), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[36, 36], (NULL, 3], [1, 74], [NULL, ∞)}, {[36, 36], [23, 39], [NULL, ∞), [NULL, ∞)}, {(36, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ NOT\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 36 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ NOT\n" +
+			"     │   │       │   └─ Eq\n" +
+			"     │   │       │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │       └─ 70 (tinyint)\n" +
+			"     │   │       └─ (comp_index_t2.v2:2 BETWEEN 23 (tinyint) AND 39 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ GreaterThan\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 51 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 57 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ LessThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 50 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 3 (tinyint)\n" +
+			"         └─ (comp_index_t2.v3:3 BETWEEN 1 (tinyint) AND 74 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1>30) OR (v1>98 AND v4>43 AND v2<>80)) OR (v1 BETWEEN 2 AND 23 AND v2>=34)) OR (v1>=42));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 30 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ GreaterThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 98 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │       └─ 43 (tinyint)\n" +
-			" │   │   │       └─ NOT\n" +
-			" │   │   │           └─ Eq\n" +
-			" │   │   │               ├─ comp_index_t2.v2:2\n" +
-			" │   │   │               └─ 80 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 2 (tinyint) AND 23 (tinyint))\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 34 (tinyint)\n" +
-			" │   └─ GreaterThanOrEqual\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 42 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[2, 23], [34, ∞), [NULL, ∞), [NULL, ∞)}, {(30, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[2, 23], [34, ∞), [NULL, ∞), [NULL, ∞)}, {(30, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ GreaterThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 30 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ GreaterThan\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 98 (tinyint)\n" +
+			"     │   │       │   └─ GreaterThan\n" +
+			"     │   │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       │       └─ 43 (tinyint)\n" +
+			"     │   │       └─ NOT\n" +
+			"     │   │           └─ Eq\n" +
+			"     │   │               ├─ comp_index_t2.v2:2\n" +
+			"     │   │               └─ 80 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ (comp_index_t2.v1:1 BETWEEN 2 (tinyint) AND 23 (tinyint))\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 34 (tinyint)\n" +
+			"     └─ GreaterThanOrEqual\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 42 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<68 AND v2<81 AND v3<34 AND v4<>33) OR (v1<=78 AND v4 BETWEEN 34 AND 99 AND v2>=79 AND v3>=9)) OR (v1=27 AND v4 BETWEEN 20 AND 41 AND v2<98 AND v3>=15));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 68 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 81 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 34 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 33 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 78 (tinyint)\n" +
-			" │   │       │   │   └─ (comp_index_t2.v4:4 BETWEEN 34 (tinyint) AND 99 (tinyint))\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 79 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 9 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ Eq\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 27 (tinyint)\n" +
-			" │       │   │   └─ (comp_index_t2.v4:4 BETWEEN 20 (tinyint) AND 41 (tinyint))\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 98 (tinyint)\n" +
-			" │       └─ GreaterThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 15 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 27), (NULL, 81), (NULL, 34), (NULL, 33)}, {(NULL, 27), (NULL, 81), (NULL, 34), (33, ∞)}, {(NULL, 27), [79, 81), [34, ∞), [34, 99]}, {(NULL, 27), [81, ∞), [9, ∞), [34, 99]}, {[27, 27], (NULL, 79), [34, ∞), [20, 41]}, {[27, 27], (NULL, 81), (NULL, 15), (NULL, 33)}, {[27, 27], (NULL, 81), (NULL, 15), (33, ∞)}, {[27, 27], (NULL, 81), [15, 34), (NULL, ∞)}, {[27, 27], [79, 81), [34, ∞), [20, 99]}, {[27, 27], [81, 98), [9, 15), [34, 99]}, {[27, 27], [81, 98), [15, ∞), [20, 99]}, {[27, 27], [98, ∞), [9, ∞), [34, 99]}, {(27, 68), (NULL, 81), (NULL, 34), (NULL, 33)}, {(27, 68), (NULL, 81), (NULL, 34), (33, ∞)}, {(27, 68), [79, 81), [34, ∞), [34, 99]}, {(27, 68), [81, ∞), [9, ∞), [34, 99]}, {[68, 78], [79, ∞), [9, ∞), [34, 99]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 27), (NULL, 81), (NULL, 34), (NULL, 33)}, {(NULL, 27), (NULL, 81), (NULL, 34), (33, ∞)}, {(NULL, 27), [79, 81), [34, ∞), [34, 99]}, {(NULL, 27), [81, ∞), [9, ∞), [34, 99]}, {[27, 27], (NULL, 79), [34, ∞), [20, 41]}, {[27, 27], (NULL, 81), (NULL, 15), (NULL, 33)}, {[27, 27], (NULL, 81), (NULL, 15), (33, ∞)}, {[27, 27], (NULL, 81), [15, 34), (NULL, ∞)}, {[27, 27], [79, 81), [34, ∞), [20, 99]}, {[27, 27], [81, 98), [9, 15), [34, 99]}, {[27, 27], [81, 98), [15, ∞), [20, 99]}, {[27, 27], [98, ∞), [9, ∞), [34, 99]}, {(27, 68), (NULL, 81), (NULL, 34), (NULL, 33)}, {(27, 68), (NULL, 81), (NULL, 34), (33, ∞)}, {(27, 68), [79, 81), [34, ∞), [34, 99]}, {(27, 68), [81, ∞), [9, ∞), [34, 99]}, {[68, 78], [79, ∞), [9, ∞), [34, 99]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 68 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 81 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 34 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 33 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 78 (tinyint)\n" +
+			"     │       │   │   └─ (comp_index_t2.v4:4 BETWEEN 34 (tinyint) AND 99 (tinyint))\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 79 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 9 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 27 (tinyint)\n" +
+			"         │   │   └─ (comp_index_t2.v4:4 BETWEEN 20 (tinyint) AND 41 (tinyint))\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 98 (tinyint)\n" +
+			"         └─ GreaterThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 15 (tinyint)\n" +
 			"",
 	},
 	{
@@ -12708,104 +12400,102 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<=5 AND v2>=14 AND v3<=2) OR (v1<53 AND v4=99 AND v2=72)) OR (v1<>49 AND v2<>39 AND v3>=70 AND v4<>24)) OR (v1<79));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 5 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 14 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 2 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ LessThan\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 53 (tinyint)\n" +
-			" │   │   │       │   └─ Eq\n" +
-			" │   │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       │       └─ 99 (tinyint)\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 72 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ NOT\n" +
-			" │   │       │   │   │   └─ Eq\n" +
-			" │   │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │       └─ 49 (tinyint)\n" +
-			" │   │       │   │   └─ NOT\n" +
-			" │   │       │   │       └─ Eq\n" +
-			" │   │       │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │   │           └─ 39 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 70 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v4:4\n" +
-			" │   │               └─ 24 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 79 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 79), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[79, ∞), (NULL, 39), [70, ∞), (NULL, 24)}, {[79, ∞), (NULL, 39), [70, ∞), (24, ∞)}, {[79, ∞), (39, ∞), [70, ∞), (NULL, 24)}, {[79, ∞), (39, ∞), [70, ∞), (24, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 79), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[79, ∞), (NULL, 39), [70, ∞), (NULL, 24)}, {[79, ∞), (NULL, 39), [70, ∞), (24, ∞)}, {[79, ∞), (39, ∞), [70, ∞), (NULL, 24)}, {[79, ∞), (39, ∞), [70, ∞), (24, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 5 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 14 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 2 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ LessThan\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 53 (tinyint)\n" +
+			"     │   │       │   └─ Eq\n" +
+			"     │   │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       │       └─ 99 (tinyint)\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 72 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ NOT\n" +
+			"     │       │   │   │   └─ Eq\n" +
+			"     │       │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │       └─ 49 (tinyint)\n" +
+			"     │       │   │   └─ NOT\n" +
+			"     │       │   │       └─ Eq\n" +
+			"     │       │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │       │   │           └─ 39 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 70 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v4:4\n" +
+			"     │               └─ 24 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 79 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1<99 AND v2<=42) OR (v1=47 AND v4 BETWEEN 33 AND 63 AND v2>=10 AND v3<=57)) OR (v1>44)) OR (v1<>87 AND v2>42 AND v3<69));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 99 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 42 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ Eq\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 47 (tinyint)\n" +
-			" │   │   │       │   │   └─ (comp_index_t2.v4:4 BETWEEN 33 (tinyint) AND 63 (tinyint))\n" +
-			" │   │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 10 (tinyint)\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 57 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 44 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 87 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 42 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 69 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 44], (NULL, 42], [NULL, ∞), [NULL, ∞)}, {(NULL, 44], (42, ∞), (NULL, 69), [NULL, ∞)}, {(44, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 44], (NULL, 42], [NULL, ∞), [NULL, ∞)}, {(NULL, 44], (42, ∞), (NULL, 69), [NULL, ∞)}, {(44, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 99 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 42 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ Eq\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 47 (tinyint)\n" +
+			"     │   │       │   │   └─ (comp_index_t2.v4:4 BETWEEN 33 (tinyint) AND 63 (tinyint))\n" +
+			"     │   │       │   └─ GreaterThanOrEqual\n" +
+			"     │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │       └─ 10 (tinyint)\n" +
+			"     │   │       └─ LessThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 57 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 44 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │       └─ 87 (tinyint)\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 42 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 69 (tinyint)\n" +
 			"",
 	},
 	{
@@ -12818,90 +12508,88 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=15) OR (v1>=59 AND v2<18)) OR (v1 BETWEEN 23 AND 31 AND v3>50 AND v4 BETWEEN 15 AND 54));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 15 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ GreaterThanOrEqual\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 59 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 18 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 23 (tinyint) AND 31 (tinyint))\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 50 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v4:4 BETWEEN 15 (tinyint) AND 54 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[15, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[15, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 15 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ GreaterThanOrEqual\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 59 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 18 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t2.v1:1 BETWEEN 23 (tinyint) AND 31 (tinyint))\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 50 (tinyint)\n" +
+			"         └─ (comp_index_t2.v4:4 BETWEEN 15 (tinyint) AND 54 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=50 AND v2<=84 AND v3 BETWEEN 22 AND 26) OR (v1<=18 AND v2<49 AND v3>19 AND v4 BETWEEN 61 AND 75)) AND (v1>48 AND v2>=56 AND v3=6) OR (v1<=88 AND v2>=76 AND v3<40 AND v4<=18));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 50 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 84 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 22 (tinyint) AND 26 (tinyint))\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   │   └─ 18 (tinyint)\n" +
-			" │   │   │       │   │   └─ LessThan\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │   │       └─ 49 (tinyint)\n" +
-			" │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 19 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t2.v4:4 BETWEEN 61 (tinyint) AND 75 (tinyint))\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 48 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 56 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │           └─ 6 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThanOrEqual\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 88 (tinyint)\n" +
-			" │       │   │   └─ GreaterThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 76 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 40 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 18 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 88], [76, ∞), (NULL, 40), (NULL, 18]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 88], [76, ∞), (NULL, 40), (NULL, 18]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 50 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 84 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 22 (tinyint) AND 26 (tinyint))\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ LessThanOrEqual\n" +
+			"     │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   │   └─ 18 (tinyint)\n" +
+			"     │   │       │   │   └─ LessThan\n" +
+			"     │   │       │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │   │       └─ 49 (tinyint)\n" +
+			"     │   │       │   └─ GreaterThan\n" +
+			"     │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │       └─ 19 (tinyint)\n" +
+			"     │   │       └─ (comp_index_t2.v4:4 BETWEEN 61 (tinyint) AND 75 (tinyint))\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 48 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 56 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v3:3\n" +
+			"     │           └─ 6 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThanOrEqual\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 88 (tinyint)\n" +
+			"         │   │   └─ GreaterThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 76 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 40 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 18 (tinyint)\n" +
 			"",
 	},
 	{
@@ -12930,34 +12618,33 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>=10 AND v2<12 AND v3=54 AND v4>89) OR (v1=99 AND v4=37));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 10 (tinyint)\n" +
-			" │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 12 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 54 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 89 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ Eq\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 99 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 37 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[10, 99), (NULL, 12), [54, 54], (89, ∞)}, {[99, 99], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(99, ∞), (NULL, 12), [54, 54], (89, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[10, 99), (NULL, 12), [54, 54], (89, ∞)}, {[99, 99], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(99, ∞), (NULL, 12), [54, 54], (89, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 10 (tinyint)\n" +
+			"     │   │   │   └─ LessThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 12 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 54 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 89 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 99 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 37 (tinyint)\n" +
 			"",
 	},
 	{
@@ -12970,34 +12657,33 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=62 AND v2<89) AND (v1<90 AND v2>=19) OR (v1<=1 AND v2>49));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 62 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 89 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ LessThan\n" +
-			" │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   └─ 90 (tinyint)\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 19 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 1 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 49 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 1], (49, ∞), [NULL, ∞), [NULL, ∞)}, {[62, 62], [19, 89), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 1], (49, ∞), [NULL, ∞), [NULL, ∞)}, {[62, 62], [19, 89), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 62 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 89 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ LessThan\n" +
+			"     │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   └─ 90 (tinyint)\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 19 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 1 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 49 (tinyint)\n" +
 			"",
 	},
 	{
@@ -13010,28 +12696,27 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 5 AND 69) OR (v1<52 AND v4<14 AND v2>=25 AND v3=63));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ (comp_index_t2.v1:1 BETWEEN 5 (tinyint) AND 69 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 52 (tinyint)\n" +
-			" │       │   │   └─ LessThan\n" +
-			" │       │   │       ├─ comp_index_t2.v4:4\n" +
-			" │       │   │       └─ 14 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 25 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 63 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 5), [25, ∞), [63, 63], (NULL, 14)}, {[5, 69], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 5), [25, ∞), [63, 63], (NULL, 14)}, {[5, 69], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ (comp_index_t2.v1:1 BETWEEN 5 (tinyint) AND 69 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 52 (tinyint)\n" +
+			"         │   │   └─ LessThan\n" +
+			"         │   │       ├─ comp_index_t2.v4:4\n" +
+			"         │   │       └─ 14 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 25 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 63 (tinyint)\n" +
 			"",
 	},
 	{
@@ -13052,98 +12737,95 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=19 AND v3<72 AND v4=23) OR (v1<=36 AND v2>99));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 19 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 72 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 23 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThanOrEqual\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 36 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 99 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 19), (99, ∞), [NULL, ∞), [NULL, ∞)}, {[19, 19], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(19, 36], (99, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 19), (99, ∞), [NULL, ∞), [NULL, ∞)}, {[19, 19], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(19, 36], (99, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 19 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 72 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 23 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThanOrEqual\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 36 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 99 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>43) OR (v1>=41 AND v4=32 AND v2<=66)) AND (v1>43 AND v2 BETWEEN 83 AND 97);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 43 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 41 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 32 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 66 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(43, ∞), [83, 97], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(43, ∞), [83, 97], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 43 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 41 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v4:4\n" +
+			"         │       └─ 32 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 66 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=8 AND v4>=44) AND (v1=84 AND v2=41 AND v3 BETWEEN 5 AND 81) OR (v1<>31 AND v2<=96 AND v3<=20 AND v4<=14));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 8 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 44 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ Eq\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 84 (tinyint)\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 41 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v3:3 BETWEEN 5 (tinyint) AND 81 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ NOT\n" +
-			" │       │   │   │   └─ Eq\n" +
-			" │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │       └─ 31 (tinyint)\n" +
-			" │       │   │   └─ LessThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 96 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 20 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 14 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 31), (NULL, 96], (NULL, 20], (NULL, 14]}, {(31, ∞), (NULL, 96], (NULL, 20], (NULL, 14]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 31), (NULL, 96], (NULL, 20], (NULL, 14]}, {(31, ∞), (NULL, 96], (NULL, 20], (NULL, 14]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 8 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 44 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ Eq\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 84 (tinyint)\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 41 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v3:3 BETWEEN 5 (tinyint) AND 81 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ NOT\n" +
+			"         │   │   │   └─ Eq\n" +
+			"         │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │       └─ 31 (tinyint)\n" +
+			"         │   │   └─ LessThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 96 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 20 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 14 (tinyint)\n" +
 			"",
 	},
 	{
@@ -13156,44 +12838,43 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<=11 AND v2>=41 AND v3=9) AND (v1<>41 AND v3<>69 AND v4<24) OR (v1>48 AND v4<79));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ LessThanOrEqual\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 11 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 41 (tinyint)\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 9 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ NOT\n" +
-			" │   │       │   │   └─ Eq\n" +
-			" │   │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │       └─ 41 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v3:3\n" +
-			" │   │       │           └─ 69 (tinyint)\n" +
-			" │   │       └─ LessThan\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 24 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 48 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 79 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 11], [41, ∞), [9, 9], (NULL, 24)}, {(48, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 11], [41, ∞), [9, 9], (NULL, 24)}, {(48, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ LessThanOrEqual\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 11 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 41 (tinyint)\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 9 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ NOT\n" +
+			"     │       │   │   └─ Eq\n" +
+			"     │       │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │       └─ 41 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t2.v3:3\n" +
+			"     │       │           └─ 69 (tinyint)\n" +
+			"     │       └─ LessThan\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 24 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ GreaterThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 48 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 79 (tinyint)\n" +
 			"",
 	},
 	{
@@ -13206,38 +12887,37 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1 BETWEEN 32 AND 51 AND v4 BETWEEN 5 AND 14 AND v2=46 AND v3>=31) OR (v1>=32 AND v2<=26 AND v3>52 AND v4>55));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 32 (tinyint) AND 51 (tinyint))\n" +
-			" │   │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 5 (tinyint) AND 14 (tinyint))\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 46 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 31 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 32 (tinyint)\n" +
-			" │       │   │   └─ LessThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 26 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 52 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 55 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[32, 51], [46, 46], [31, ∞), [5, 14]}, {[32, ∞), (NULL, 26], (52, ∞), (55, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[32, 51], [46, 46], [31, ∞), [5, 14]}, {[32, ∞), (NULL, 26], (52, ∞), (55, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 32 (tinyint) AND 51 (tinyint))\n" +
+			"     │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 5 (tinyint) AND 14 (tinyint))\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 46 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 31 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 32 (tinyint)\n" +
+			"         │   │   └─ LessThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 26 (tinyint)\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 52 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 55 (tinyint)\n" +
 			"",
 	},
 	{
@@ -13250,132 +12930,129 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1=42 AND v4=47) OR (v1>=28)) AND (v1<>10) OR (v1 BETWEEN 20 AND 60 AND v2>96 AND v3<>28)) OR (v1=99 AND v2<=62 AND v3=30 AND v4 BETWEEN 92 AND 93));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 42 (tinyint)\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │       └─ 47 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 28 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v1:1\n" +
-			" │   │   │           └─ 10 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ (comp_index_t2.v1:1 BETWEEN 20 (tinyint) AND 60 (tinyint))\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 96 (tinyint)\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v3:3\n" +
-			" │   │               └─ 28 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ Eq\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 99 (tinyint)\n" +
-			" │       │   │   └─ LessThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 62 (tinyint)\n" +
-			" │       │   └─ Eq\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 30 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v4:4 BETWEEN 92 (tinyint) AND 93 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[20, 28), (96, ∞), (NULL, 28), [NULL, ∞)}, {[20, 28), (96, ∞), (28, ∞), [NULL, ∞)}, {[28, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[20, 28), (96, ∞), (NULL, 28), [NULL, ∞)}, {[20, 28), (96, ∞), (28, ∞), [NULL, ∞)}, {[28, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 42 (tinyint)\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │       └─ 47 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 28 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v1:1\n" +
+			"     │   │           └─ 10 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ (comp_index_t2.v1:1 BETWEEN 20 (tinyint) AND 60 (tinyint))\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 96 (tinyint)\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v3:3\n" +
+			"     │               └─ 28 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ Eq\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 99 (tinyint)\n" +
+			"         │   │   └─ LessThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 62 (tinyint)\n" +
+			"         │   └─ Eq\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 30 (tinyint)\n" +
+			"         └─ (comp_index_t2.v4:4 BETWEEN 92 (tinyint) AND 93 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=50 AND v3=4 AND v4=53 AND v2>=80) OR (v1<54 AND v4<=76 AND v2>48)) OR (v1>=38 AND v4<76 AND v2=56));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 50 (tinyint)\n" +
-			" │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 4 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 53 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       └─ 80 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThan\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 54 (tinyint)\n" +
-			" │   │       │   └─ LessThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 76 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 48 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 38 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 76 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 56 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 54), (48, ∞), [NULL, ∞), [NULL, ∞)}, {[54, ∞), [56, 56], [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 54), (48, ∞), [NULL, ∞), [NULL, ∞)}, {[54, ∞), [56, 56], [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 50 (tinyint)\n" +
+			"     │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │       └─ 4 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 53 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       └─ 80 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThan\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 54 (tinyint)\n" +
+			"     │       │   └─ LessThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 76 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 48 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 38 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v4:4\n" +
+			"         │       └─ 76 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 56 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=79 AND v2>24) OR (v1<76 AND v3<=59 AND v4<=36 AND v2=39));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Eq\n" +
-			" │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   └─ 79 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 24 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ LessThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 76 (tinyint)\n" +
-			" │       │   │   └─ LessThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v3:3\n" +
-			" │       │   │       └─ 59 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 36 (tinyint)\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 76), [39, 39], (NULL, 59], (NULL, 36]}, {[79, 79], (24, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 76), [39, 39], (NULL, 59], (NULL, 36]}, {[79, 79], (24, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Eq\n" +
+			"     │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   └─ 79 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v2:2\n" +
+			"     │       └─ 24 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ LessThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 76 (tinyint)\n" +
+			"         │   │   └─ LessThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v3:3\n" +
+			"         │   │       └─ 59 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v4:4\n" +
+			"         │       └─ 36 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 39 (tinyint)\n" +
 			"",
 	},
 	{
@@ -13388,202 +13065,197 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=85 AND v2>37 AND v3<=57 AND v4 BETWEEN 12 AND 49) AND (v1>10) OR (v1>56)) OR (v1>=57));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 85 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │   │       └─ 37 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 57 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 12 (tinyint) AND 49 (tinyint))\n" +
-			" │   │   │   └─ GreaterThan\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 10 (tinyint)\n" +
-			" │   │   └─ GreaterThan\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 56 (tinyint)\n" +
-			" │   └─ GreaterThanOrEqual\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 57 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(56, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(56, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   │   └─ 85 (tinyint)\n" +
+			"     │   │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │   │       └─ 37 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │       └─ 57 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 12 (tinyint) AND 49 (tinyint))\n" +
+			"     │   │   └─ GreaterThan\n" +
+			"     │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       └─ 10 (tinyint)\n" +
+			"     │   └─ GreaterThan\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 56 (tinyint)\n" +
+			"     └─ GreaterThanOrEqual\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 57 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((((v1<>89 AND v2>=75) OR (v1<=5)) OR (v1=5 AND v2<19 AND v3>=1)) OR (v1>=18 AND v2>=17 AND v3 BETWEEN 78 AND 83)) OR (v1>=11 AND v3<=9 AND v4>39));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ Or\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ NOT\n" +
-			" │   │   │   │   │   │   └─ Eq\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │       └─ 89 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 75 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │       └─ 5 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ Eq\n" +
-			" │   │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │   └─ 5 (tinyint)\n" +
-			" │   │   │       │   └─ LessThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 19 (tinyint)\n" +
-			" │   │   │       └─ GreaterThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 1 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 18 (tinyint)\n" +
-			" │   │       │   └─ GreaterThanOrEqual\n" +
-			" │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       │       └─ 17 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v3:3 BETWEEN 78 (tinyint) AND 83 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 11 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 9 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 39 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 5], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(5, 11), [75, ∞), [NULL, ∞), [NULL, ∞)}, {[11, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 5], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(5, 11), [75, ∞), [NULL, ∞), [NULL, ∞)}, {[11, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ Or\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ NOT\n" +
+			"     │   │   │   │   │   └─ Eq\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │       └─ 89 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 75 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │       └─ 5 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ Eq\n" +
+			"     │   │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │   └─ 5 (tinyint)\n" +
+			"     │   │       │   └─ LessThan\n" +
+			"     │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │       └─ 19 (tinyint)\n" +
+			"     │   │       └─ GreaterThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 1 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 18 (tinyint)\n" +
+			"     │       │   └─ GreaterThanOrEqual\n" +
+			"     │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │       │       └─ 17 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v3:3 BETWEEN 78 (tinyint) AND 83 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 11 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 9 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 39 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1 BETWEEN 36 AND 48 AND v4<97 AND v2>=99 AND v3=3) OR (v1<>84 AND v2=46 AND v3=4)) OR (v1>73 AND v2 BETWEEN 34 AND 39 AND v3 BETWEEN 34 AND 71 AND v4>=15)) OR (v1<>82));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 36 (tinyint) AND 48 (tinyint))\n" +
-			" │   │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │   │   │       └─ 97 (tinyint)\n" +
-			" │   │   │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 99 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │       └─ 3 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ NOT\n" +
-			" │   │   │       │   │   └─ Eq\n" +
-			" │   │   │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   │       └─ 84 (tinyint)\n" +
-			" │   │   │       │   └─ Eq\n" +
-			" │   │   │       │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │       │       └─ 46 (tinyint)\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v3:3\n" +
-			" │   │   │           └─ 4 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ GreaterThan\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 73 (tinyint)\n" +
-			" │   │       │   │   └─ (comp_index_t2.v2:2 BETWEEN 34 (tinyint) AND 39 (tinyint))\n" +
-			" │   │       │   └─ (comp_index_t2.v3:3 BETWEEN 34 (tinyint) AND 71 (tinyint))\n" +
-			" │   │       └─ GreaterThanOrEqual\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 15 (tinyint)\n" +
-			" │   └─ NOT\n" +
-			" │       └─ Eq\n" +
-			" │           ├─ comp_index_t2.v1:1\n" +
-			" │           └─ 82 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 82), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [34, 39], [34, 71], [15, ∞)}, {[82, 82], [46, 46], [4, 4], [NULL, ∞)}, {(82, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 82), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, 82], [34, 39], [34, 71], [15, ∞)}, {[82, 82], [46, 46], [4, 4], [NULL, ∞)}, {(82, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 36 (tinyint) AND 48 (tinyint))\n" +
+			"     │   │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │   │   │       └─ 97 (tinyint)\n" +
+			"     │   │   │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 99 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │       └─ 3 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ NOT\n" +
+			"     │   │       │   │   └─ Eq\n" +
+			"     │   │       │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   │       └─ 84 (tinyint)\n" +
+			"     │   │       │   └─ Eq\n" +
+			"     │   │       │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │       │       └─ 46 (tinyint)\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v3:3\n" +
+			"     │   │           └─ 4 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ GreaterThan\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 73 (tinyint)\n" +
+			"     │       │   │   └─ (comp_index_t2.v2:2 BETWEEN 34 (tinyint) AND 39 (tinyint))\n" +
+			"     │       │   └─ (comp_index_t2.v3:3 BETWEEN 34 (tinyint) AND 71 (tinyint))\n" +
+			"     │       └─ GreaterThanOrEqual\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 15 (tinyint)\n" +
+			"     └─ NOT\n" +
+			"         └─ Eq\n" +
+			"             ├─ comp_index_t2.v1:1\n" +
+			"             └─ 82 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (v1<=50 AND v3>=51 AND v4<>69) AND (v1>1 AND v3<24);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ AND\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   └─ 51 (tinyint)\n" +
-			" │   │   └─ NOT\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 69 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v3:3\n" +
-			" │       └─ 24 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(1, 50], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(1, 50], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: AND\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   ├─ comp_index_t2.v3:3\n" +
+			"     │   │   └─ 51 (tinyint)\n" +
+			"     │   └─ NOT\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 69 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t2.v3:3\n" +
+			"         └─ 24 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>10 AND v2=72 AND v3<31) OR (v1<67 AND v3 BETWEEN 13 AND 70 AND v4>66 AND v2>39)) OR (v1<82)) AND (v1>=66);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 10 (tinyint)\n" +
-			" │   │   │   │   └─ Eq\n" +
-			" │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │       └─ 72 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 31 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ LessThan\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 67 (tinyint)\n" +
-			" │   │       │   │   └─ (comp_index_t2.v3:3 BETWEEN 13 (tinyint) AND 70 (tinyint))\n" +
-			" │   │       │   └─ GreaterThan\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 66 (tinyint)\n" +
-			" │   │       └─ GreaterThan\n" +
-			" │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │           └─ 39 (tinyint)\n" +
-			" │   └─ LessThan\n" +
-			" │       ├─ comp_index_t2.v1:1\n" +
-			" │       └─ 82 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[66, 82), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, ∞), [72, 72], (NULL, 31), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[66, 82), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[82, ∞), [72, 72], (NULL, 31), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 10 (tinyint)\n" +
+			"     │   │   │   └─ Eq\n" +
+			"     │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │       └─ 72 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 31 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ LessThan\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 67 (tinyint)\n" +
+			"     │       │   │   └─ (comp_index_t2.v3:3 BETWEEN 13 (tinyint) AND 70 (tinyint))\n" +
+			"     │       │   └─ GreaterThan\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 66 (tinyint)\n" +
+			"     │       └─ GreaterThan\n" +
+			"     │           ├─ comp_index_t2.v2:2\n" +
+			"     │           └─ 39 (tinyint)\n" +
+			"     └─ LessThan\n" +
+			"         ├─ comp_index_t2.v1:1\n" +
+			"         └─ 82 (tinyint)\n" +
 			"",
 	},
 	{
@@ -13636,137 +13308,133 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1=85 AND v2<81 AND v3 BETWEEN 14 AND 61 AND v4<>99) OR (v1 BETWEEN 31 AND 86 AND v4<>43)) OR (v1 BETWEEN 15 AND 67)) AND (v1 BETWEEN 37 AND 55);`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   └─ 85 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │       └─ 81 (tinyint)\n" +
-			" │   │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 14 (tinyint) AND 61 (tinyint))\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 99 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ (comp_index_t2.v1:1 BETWEEN 31 (tinyint) AND 86 (tinyint))\n" +
-			" │   │       └─ NOT\n" +
-			" │   │           └─ Eq\n" +
-			" │   │               ├─ comp_index_t2.v4:4\n" +
-			" │   │               └─ 43 (tinyint)\n" +
-			" │   └─ (comp_index_t2.v1:1 BETWEEN 15 (tinyint) AND 67 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[37, 55], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[37, 55], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   └─ 85 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │       └─ 81 (tinyint)\n" +
+			"     │   │   │   └─ (comp_index_t2.v3:3 BETWEEN 14 (tinyint) AND 61 (tinyint))\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 99 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ (comp_index_t2.v1:1 BETWEEN 31 (tinyint) AND 86 (tinyint))\n" +
+			"     │       └─ NOT\n" +
+			"     │           └─ Eq\n" +
+			"     │               ├─ comp_index_t2.v4:4\n" +
+			"     │               └─ 43 (tinyint)\n" +
+			"     └─ (comp_index_t2.v1:1 BETWEEN 15 (tinyint) AND 67 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1>=52 AND v4>=86) OR (v1>=86 AND v3=79 AND v4=9 AND v2 BETWEEN 2 AND 6)) OR (v1>98 AND v2<=44 AND v3<>53));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 52 (tinyint)\n" +
-			" │   │   │   └─ GreaterThanOrEqual\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 86 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ AND\n" +
-			" │   │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   │   └─ 86 (tinyint)\n" +
-			" │   │       │   │   └─ Eq\n" +
-			" │   │       │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │   │       └─ 79 (tinyint)\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       │       └─ 9 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v2:2 BETWEEN 2 (tinyint) AND 6 (tinyint))\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 98 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 44 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v3:3\n" +
-			" │               └─ 53 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[52, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[52, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThanOrEqual\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 52 (tinyint)\n" +
+			"     │   │   └─ GreaterThanOrEqual\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 86 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ AND\n" +
+			"     │       │   │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   │   └─ 86 (tinyint)\n" +
+			"     │       │   │   └─ Eq\n" +
+			"     │       │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │   │       └─ 79 (tinyint)\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v4:4\n" +
+			"     │       │       └─ 9 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v2:2 BETWEEN 2 (tinyint) AND 6 (tinyint))\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 98 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 44 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v3:3\n" +
+			"                 └─ 53 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>5 AND v4 BETWEEN 14 AND 43 AND v2>=62) OR (v1>=91 AND v2>=28 AND v3>=83 AND v4<>91));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 5 (tinyint)\n" +
-			" │   │   │   └─ (comp_index_t2.v4:4 BETWEEN 14 (tinyint) AND 43 (tinyint))\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │       └─ 62 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThanOrEqual\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 91 (tinyint)\n" +
-			" │       │   │   └─ GreaterThanOrEqual\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 28 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 83 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v4:4\n" +
-			" │               └─ 91 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(5, ∞), [62, ∞), [NULL, ∞), [NULL, ∞)}, {[91, ∞), [28, 62), [83, ∞), (NULL, 91)}, {[91, ∞), [28, 62), [83, ∞), (91, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(5, ∞), [62, ∞), [NULL, ∞), [NULL, ∞)}, {[91, ∞), [28, 62), [83, ∞), (NULL, 91)}, {[91, ∞), [28, 62), [83, ∞), (91, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ GreaterThan\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 5 (tinyint)\n" +
+			"     │   │   └─ (comp_index_t2.v4:4 BETWEEN 14 (tinyint) AND 43 (tinyint))\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v2:2\n" +
+			"     │       └─ 62 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ GreaterThanOrEqual\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 91 (tinyint)\n" +
+			"         │   │   └─ GreaterThanOrEqual\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 28 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 83 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v4:4\n" +
+			"                 └─ 91 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1<>87) OR (v1>91 AND v2>23 AND v3<74));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ NOT\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 87 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ GreaterThan\n" +
-			" │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   └─ 91 (tinyint)\n" +
-			" │       │   └─ GreaterThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 23 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 74 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 87), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(87, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 87), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(87, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 87 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ GreaterThan\n" +
+			"         │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   └─ 91 (tinyint)\n" +
+			"         │   └─ GreaterThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 23 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 74 (tinyint)\n" +
 			"",
 	},
 	{
@@ -13779,66 +13447,65 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((((v1=76 AND v2>35 AND v3<=59 AND v4>25) OR (v1 BETWEEN 35 AND 82 AND v2 BETWEEN 8 AND 37 AND v3>18 AND v4<=70)) OR (v1<=95 AND v3=70 AND v4=11)) OR (v1 BETWEEN 15 AND 23 AND v2<>24 AND v3<=50 AND v4<>84));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ Eq\n" +
-			" │   │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   │   │   └─ 76 (tinyint)\n" +
-			" │   │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │   │       └─ 35 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 59 (tinyint)\n" +
-			" │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 25 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ AND\n" +
-			" │   │   │       │   ├─ AND\n" +
-			" │   │   │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 35 (tinyint) AND 82 (tinyint))\n" +
-			" │   │   │       │   │   └─ (comp_index_t2.v2:2 BETWEEN 8 (tinyint) AND 37 (tinyint))\n" +
-			" │   │   │       │   └─ GreaterThan\n" +
-			" │   │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       │       └─ 18 (tinyint)\n" +
-			" │   │   │       └─ LessThanOrEqual\n" +
-			" │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │           └─ 70 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ LessThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 95 (tinyint)\n" +
-			" │   │       │   └─ Eq\n" +
-			" │   │       │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       │       └─ 70 (tinyint)\n" +
-			" │   │       └─ Eq\n" +
-			" │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │           └─ 11 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 15 (tinyint) AND 23 (tinyint))\n" +
-			" │       │   │   └─ NOT\n" +
-			" │       │   │       └─ Eq\n" +
-			" │       │   │           ├─ comp_index_t2.v2:2\n" +
-			" │       │   │           └─ 24 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 50 (tinyint)\n" +
-			" │       └─ NOT\n" +
-			" │           └─ Eq\n" +
-			" │               ├─ comp_index_t2.v4:4\n" +
-			" │               └─ 84 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 95], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 95], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ Eq\n" +
+			"     │   │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   │   │   └─ 76 (tinyint)\n" +
+			"     │   │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │   │       └─ 35 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │       └─ 59 (tinyint)\n" +
+			"     │   │   │   └─ GreaterThan\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 25 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ AND\n" +
+			"     │   │       │   ├─ AND\n" +
+			"     │   │       │   │   ├─ (comp_index_t2.v1:1 BETWEEN 35 (tinyint) AND 82 (tinyint))\n" +
+			"     │   │       │   │   └─ (comp_index_t2.v2:2 BETWEEN 8 (tinyint) AND 37 (tinyint))\n" +
+			"     │   │       │   └─ GreaterThan\n" +
+			"     │   │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       │       └─ 18 (tinyint)\n" +
+			"     │   │       └─ LessThanOrEqual\n" +
+			"     │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │           └─ 70 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ LessThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 95 (tinyint)\n" +
+			"     │       │   └─ Eq\n" +
+			"     │       │       ├─ comp_index_t2.v3:3\n" +
+			"     │       │       └─ 70 (tinyint)\n" +
+			"     │       └─ Eq\n" +
+			"     │           ├─ comp_index_t2.v4:4\n" +
+			"     │           └─ 11 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ (comp_index_t2.v1:1 BETWEEN 15 (tinyint) AND 23 (tinyint))\n" +
+			"         │   │   └─ NOT\n" +
+			"         │   │       └─ Eq\n" +
+			"         │   │           ├─ comp_index_t2.v2:2\n" +
+			"         │   │           └─ 24 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 50 (tinyint)\n" +
+			"         └─ NOT\n" +
+			"             └─ Eq\n" +
+			"                 ├─ comp_index_t2.v4:4\n" +
+			"                 └─ 84 (tinyint)\n" +
 			"",
 	},
 	{
@@ -13851,198 +13518,192 @@ var IndexPlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=79 AND v3<89 AND v4>=3) OR (v1<63 AND v2<66));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ Eq\n" +
-			" │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   └─ 79 (tinyint)\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │       └─ 89 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │       └─ 3 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ LessThan\n" +
-			" │       │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   └─ 63 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v2:2\n" +
-			" │           └─ 66 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 63), (NULL, 66), [NULL, ∞), [NULL, ∞)}, {[79, 79], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 63), (NULL, 66), [NULL, ∞), [NULL, ∞)}, {[79, 79], [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ Eq\n" +
+			"     │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   └─ 79 (tinyint)\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │       └─ 89 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v4:4\n" +
+			"     │       └─ 3 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ LessThan\n" +
+			"         │   ├─ comp_index_t2.v1:1\n" +
+			"         │   └─ 63 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v2:2\n" +
+			"             └─ 66 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1<>66) OR (v1=33)) OR (v1<>39 AND v2>53 AND v3<73 AND v4<75));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ NOT\n" +
-			" │   │   │   └─ Eq\n" +
-			" │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       └─ 66 (tinyint)\n" +
-			" │   │   └─ Eq\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 33 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ NOT\n" +
-			" │       │   │   │   └─ Eq\n" +
-			" │       │   │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │       └─ 39 (tinyint)\n" +
-			" │       │   │   └─ GreaterThan\n" +
-			" │       │   │       ├─ comp_index_t2.v2:2\n" +
-			" │       │   │       └─ 53 (tinyint)\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v3:3\n" +
-			" │       │       └─ 73 (tinyint)\n" +
-			" │       └─ LessThan\n" +
-			" │           ├─ comp_index_t2.v4:4\n" +
-			" │           └─ 75 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 66), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[66, 66], (53, ∞), (NULL, 73), (NULL, 75)}, {(66, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 66), [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {[66, 66], (53, ∞), (NULL, 73), (NULL, 75)}, {(66, ∞), [NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ NOT\n" +
+			"     │   │   └─ Eq\n" +
+			"     │   │       ├─ comp_index_t2.v1:1\n" +
+			"     │   │       └─ 66 (tinyint)\n" +
+			"     │   └─ Eq\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 33 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ NOT\n" +
+			"         │   │   │   └─ Eq\n" +
+			"         │   │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │       └─ 39 (tinyint)\n" +
+			"         │   │   └─ GreaterThan\n" +
+			"         │   │       ├─ comp_index_t2.v2:2\n" +
+			"         │   │       └─ 53 (tinyint)\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v3:3\n" +
+			"         │       └─ 73 (tinyint)\n" +
+			"         └─ LessThan\n" +
+			"             ├─ comp_index_t2.v4:4\n" +
+			"             └─ 75 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1=15) OR (v1>36 AND v3=13 AND v4<=98 AND v2 BETWEEN 70 AND 85));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Eq\n" +
-			" │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   └─ 15 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ AND\n" +
-			" │       │   │   ├─ GreaterThan\n" +
-			" │       │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │       │   │   │   └─ 36 (tinyint)\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v3:3\n" +
-			" │       │   │       └─ 13 (tinyint)\n" +
-			" │       │   └─ LessThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v4:4\n" +
-			" │       │       └─ 98 (tinyint)\n" +
-			" │       └─ (comp_index_t2.v2:2 BETWEEN 70 (tinyint) AND 85 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[15, 15], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(36, ∞), [70, 85], [13, 13], (NULL, 98]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[15, 15], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(36, ∞), [70, 85], [13, 13], (NULL, 98]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Eq\n" +
+			"     │   ├─ comp_index_t2.v1:1\n" +
+			"     │   └─ 15 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ AND\n" +
+			"         │   │   ├─ GreaterThan\n" +
+			"         │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"         │   │   │   └─ 36 (tinyint)\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v3:3\n" +
+			"         │   │       └─ 13 (tinyint)\n" +
+			"         │   └─ LessThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v4:4\n" +
+			"         │       └─ 98 (tinyint)\n" +
+			"         └─ (comp_index_t2.v2:2 BETWEEN 70 (tinyint) AND 85 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 23 AND 45 AND v4<30) OR (v1>=36 AND v2<>6 AND v3 BETWEEN 30 AND 53)) OR (v1 BETWEEN 41 AND 95));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ Or\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 23 (tinyint) AND 45 (tinyint))\n" +
-			" │   │   │   └─ LessThan\n" +
-			" │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │       └─ 30 (tinyint)\n" +
-			" │   │   └─ AND\n" +
-			" │   │       ├─ AND\n" +
-			" │   │       │   ├─ GreaterThanOrEqual\n" +
-			" │   │       │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │       │   │   └─ 36 (tinyint)\n" +
-			" │   │       │   └─ NOT\n" +
-			" │   │       │       └─ Eq\n" +
-			" │   │       │           ├─ comp_index_t2.v2:2\n" +
-			" │   │       │           └─ 6 (tinyint)\n" +
-			" │   │       └─ (comp_index_t2.v3:3 BETWEEN 30 (tinyint) AND 53 (tinyint))\n" +
-			" │   └─ (comp_index_t2.v1:1 BETWEEN 41 (tinyint) AND 95 (tinyint))\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[23, 95], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(95, ∞), (NULL, 6), [30, 53], [NULL, ∞)}, {(95, ∞), (6, ∞), [30, 53], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[23, 95], [NULL, ∞), [NULL, ∞), [NULL, ∞)}, {(95, ∞), (NULL, 6), [30, 53], [NULL, ∞)}, {(95, ∞), (6, ∞), [30, 53], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ Or\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ (comp_index_t2.v1:1 BETWEEN 23 (tinyint) AND 45 (tinyint))\n" +
+			"     │   │   └─ LessThan\n" +
+			"     │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │       └─ 30 (tinyint)\n" +
+			"     │   └─ AND\n" +
+			"     │       ├─ AND\n" +
+			"     │       │   ├─ GreaterThanOrEqual\n" +
+			"     │       │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │       │   │   └─ 36 (tinyint)\n" +
+			"     │       │   └─ NOT\n" +
+			"     │       │       └─ Eq\n" +
+			"     │       │           ├─ comp_index_t2.v2:2\n" +
+			"     │       │           └─ 6 (tinyint)\n" +
+			"     │       └─ (comp_index_t2.v3:3 BETWEEN 30 (tinyint) AND 53 (tinyint))\n" +
+			"     └─ (comp_index_t2.v1:1 BETWEEN 41 (tinyint) AND 95 (tinyint))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE ((v1>6 AND v4<>9 AND v2<>77 AND v3>=81) OR (v1<>21 AND v2>=17 AND v3<=3));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ AND\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ GreaterThan\n" +
-			" │   │   │   │   │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │   │   │   └─ 6 (tinyint)\n" +
-			" │   │   │   │   └─ NOT\n" +
-			" │   │   │   │       └─ Eq\n" +
-			" │   │   │   │           ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │           └─ 9 (tinyint)\n" +
-			" │   │   │   └─ NOT\n" +
-			" │   │   │       └─ Eq\n" +
-			" │   │   │           ├─ comp_index_t2.v2:2\n" +
-			" │   │   │           └─ 77 (tinyint)\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │       └─ 81 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ NOT\n" +
-			" │       │   │   └─ Eq\n" +
-			" │       │   │       ├─ comp_index_t2.v1:1\n" +
-			" │       │   │       └─ 21 (tinyint)\n" +
-			" │       │   └─ GreaterThanOrEqual\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 17 (tinyint)\n" +
-			" │       └─ LessThanOrEqual\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 3 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{(NULL, 21), [17, ∞), (NULL, 3], [NULL, ∞)}, {(6, ∞), (NULL, 77), [81, ∞), (NULL, 9)}, {(6, ∞), (NULL, 77), [81, ∞), (9, ∞)}, {(6, ∞), (77, ∞), [81, ∞), (NULL, 9)}, {(6, ∞), (77, ∞), [81, ∞), (9, ∞)}, {(21, ∞), [17, ∞), (NULL, 3], [NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{(NULL, 21), [17, ∞), (NULL, 3], [NULL, ∞)}, {(6, ∞), (NULL, 77), [81, ∞), (NULL, 9)}, {(6, ∞), (NULL, 77), [81, ∞), (9, ∞)}, {(6, ∞), (77, ∞), [81, ∞), (NULL, 9)}, {(6, ∞), (77, ∞), [81, ∞), (9, ∞)}, {(21, ∞), [17, ∞), (NULL, 3], [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ AND\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ GreaterThan\n" +
+			"     │   │   │   │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │   │   │   └─ 6 (tinyint)\n" +
+			"     │   │   │   └─ NOT\n" +
+			"     │   │   │       └─ Eq\n" +
+			"     │   │   │           ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │           └─ 9 (tinyint)\n" +
+			"     │   │   └─ NOT\n" +
+			"     │   │       └─ Eq\n" +
+			"     │   │           ├─ comp_index_t2.v2:2\n" +
+			"     │   │           └─ 77 (tinyint)\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v3:3\n" +
+			"     │       └─ 81 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ NOT\n" +
+			"         │   │   └─ Eq\n" +
+			"         │   │       ├─ comp_index_t2.v1:1\n" +
+			"         │   │       └─ 21 (tinyint)\n" +
+			"         │   └─ GreaterThanOrEqual\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 17 (tinyint)\n" +
+			"         └─ LessThanOrEqual\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 3 (tinyint)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM comp_index_t2 WHERE (((v1 BETWEEN 94 AND 99 AND v2>4 AND v3<94 AND v4<=59) OR (v1=19 AND v2 BETWEEN 47 AND 54)) AND (v1>=83) OR (v1 BETWEEN 50 AND 97 AND v2<12 AND v3>23));`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Or\n" +
-			" │   ├─ AND\n" +
-			" │   │   ├─ Or\n" +
-			" │   │   │   ├─ AND\n" +
-			" │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   ├─ AND\n" +
-			" │   │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 94 (tinyint) AND 99 (tinyint))\n" +
-			" │   │   │   │   │   │   └─ GreaterThan\n" +
-			" │   │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
-			" │   │   │   │   │   │       └─ 4 (tinyint)\n" +
-			" │   │   │   │   │   └─ LessThan\n" +
-			" │   │   │   │   │       ├─ comp_index_t2.v3:3\n" +
-			" │   │   │   │   │       └─ 94 (tinyint)\n" +
-			" │   │   │   │   └─ LessThanOrEqual\n" +
-			" │   │   │   │       ├─ comp_index_t2.v4:4\n" +
-			" │   │   │   │       └─ 59 (tinyint)\n" +
-			" │   │   │   └─ AND\n" +
-			" │   │   │       ├─ Eq\n" +
-			" │   │   │       │   ├─ comp_index_t2.v1:1\n" +
-			" │   │   │       │   └─ 19 (tinyint)\n" +
-			" │   │   │       └─ (comp_index_t2.v2:2 BETWEEN 47 (tinyint) AND 54 (tinyint))\n" +
-			" │   │   └─ GreaterThanOrEqual\n" +
-			" │   │       ├─ comp_index_t2.v1:1\n" +
-			" │   │       └─ 83 (tinyint)\n" +
-			" │   └─ AND\n" +
-			" │       ├─ AND\n" +
-			" │       │   ├─ (comp_index_t2.v1:1 BETWEEN 50 (tinyint) AND 97 (tinyint))\n" +
-			" │       │   └─ LessThan\n" +
-			" │       │       ├─ comp_index_t2.v2:2\n" +
-			" │       │       └─ 12 (tinyint)\n" +
-			" │       └─ GreaterThan\n" +
-			" │           ├─ comp_index_t2.v3:3\n" +
-			" │           └─ 23 (tinyint)\n" +
-			" └─ IndexedTableAccess(comp_index_t2)\n" +
-			"     ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
-			"     ├─ static: [{[50, 97], (NULL, 12), (23, ∞), [NULL, ∞)}, {[94, 97], (4, 12), (NULL, 23], (NULL, 59]}, {[94, 97], [12, ∞), (NULL, 94), (NULL, 59]}, {(97, 99], (4, ∞), (NULL, 94), (NULL, 59]}]\n" +
-			"     └─ columns: [pk v1 v2 v3 v4]\n" +
+		ExpectedPlan: "IndexedTableAccess(comp_index_t2)\n" +
+			" ├─ index: [comp_index_t2.v1,comp_index_t2.v2,comp_index_t2.v3,comp_index_t2.v4]\n" +
+			" ├─ static: [{[50, 97], (NULL, 12), (23, ∞), [NULL, ∞)}, {[94, 97], (4, 12), (NULL, 23], (NULL, 59]}, {[94, 97], [12, ∞), (NULL, 94), (NULL, 59]}, {(97, 99], (4, ∞), (NULL, 94), (NULL, 59]}]\n" +
+			" ├─ columns: [pk v1 v2 v3 v4]\n" +
+			" └─ index condition: Or\n" +
+			"     ├─ AND\n" +
+			"     │   ├─ Or\n" +
+			"     │   │   ├─ AND\n" +
+			"     │   │   │   ├─ AND\n" +
+			"     │   │   │   │   ├─ AND\n" +
+			"     │   │   │   │   │   ├─ (comp_index_t2.v1:1 BETWEEN 94 (tinyint) AND 99 (tinyint))\n" +
+			"     │   │   │   │   │   └─ GreaterThan\n" +
+			"     │   │   │   │   │       ├─ comp_index_t2.v2:2\n" +
+			"     │   │   │   │   │       └─ 4 (tinyint)\n" +
+			"     │   │   │   │   └─ LessThan\n" +
+			"     │   │   │   │       ├─ comp_index_t2.v3:3\n" +
+			"     │   │   │   │       └─ 94 (tinyint)\n" +
+			"     │   │   │   └─ LessThanOrEqual\n" +
+			"     │   │   │       ├─ comp_index_t2.v4:4\n" +
+			"     │   │   │       └─ 59 (tinyint)\n" +
+			"     │   │   └─ AND\n" +
+			"     │   │       ├─ Eq\n" +
+			"     │   │       │   ├─ comp_index_t2.v1:1\n" +
+			"     │   │       │   └─ 19 (tinyint)\n" +
+			"     │   │       └─ (comp_index_t2.v2:2 BETWEEN 47 (tinyint) AND 54 (tinyint))\n" +
+			"     │   └─ GreaterThanOrEqual\n" +
+			"     │       ├─ comp_index_t2.v1:1\n" +
+			"     │       └─ 83 (tinyint)\n" +
+			"     └─ AND\n" +
+			"         ├─ AND\n" +
+			"         │   ├─ (comp_index_t2.v1:1 BETWEEN 50 (tinyint) AND 97 (tinyint))\n" +
+			"         │   └─ LessThan\n" +
+			"         │       ├─ comp_index_t2.v2:2\n" +
+			"         │       └─ 12 (tinyint)\n" +
+			"         └─ GreaterThan\n" +
+			"             ├─ comp_index_t2.v3:3\n" +
+			"             └─ 23 (tinyint)\n" +
 			"",
 	},
 	{
@@ -14108,9 +13769,18 @@ var IndexPlanTests = []QueryPlanTest{
 	{
 		Query: `select * from comp_index_t3 where v1 like 'a%'`,
 		ExpectedPlan: "Filter\n" +
-			" ├─ comp_index_t3.v1 LIKE 'a%'\n" +
-			" └─ Table\n" +
-			"     ├─ name: comp_index_t3\n" +
+			" ├─ AND\n" +
+			" │   ├─ AND\n" +
+			" │   │   ├─ GreaterThanOrEqual\n" +
+			" │   │   │   ├─ comp_index_t3.v1:1\n" +
+			" │   │   │   └─ BLOB(a)\n" +
+			" │   │   └─ LessThan\n" +
+			" │   │       ├─ comp_index_t3.v1:1\n" +
+			" │   │       └─ BLOB(b)\n" +
+			" │   └─ comp_index_t3.v1 LIKE 'a%'\n" +
+			" └─ IndexedTableAccess(comp_index_t3)\n" +
+			"     ├─ index: [comp_index_t3.v1]\n" +
+			"     ├─ static: [{[[97], [98])}]\n" +
 			"     └─ columns: [pk v1 v2]\n" +
 			"",
 	},
@@ -14128,7 +13798,15 @@ var IndexPlanTests = []QueryPlanTest{
 	{
 		Query: `select * from comp_index_t3 where v2 like 'a%'`,
 		ExpectedPlan: "Filter\n" +
-			" ├─ comp_index_t3.v2 LIKE 'a%'\n" +
+			" ├─ AND\n" +
+			" │   ├─ AND\n" +
+			" │   │   ├─ GreaterThanOrEqual\n" +
+			" │   │   │   ├─ comp_index_t3.v2:2\n" +
+			" │   │   │   └─ BLOB(a)\n" +
+			" │   │   └─ LessThan\n" +
+			" │   │       ├─ comp_index_t3.v2:2\n" +
+			" │   │       └─ BLOB(b)\n" +
+			" │   └─ comp_index_t3.v2 LIKE 'a%'\n" +
 			" └─ Table\n" +
 			"     ├─ name: comp_index_t3\n" +
 			"     └─ columns: [pk v1 v2]\n" +
@@ -20,6 +20,7 @@ import (
 	"github.com/dolthub/vitess/go/mysql"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -827,6 +828,68 @@ var SpatialInsertQueries = []WriteQueryTest{
 }
 
 var InsertScripts = []ScriptTest{
+	{
+		Name: "insert results report records, duplicates and the first generated id",
+		SetUpScript: []string{
+			"create table t (id int auto_increment primary key, v int, unique key (v))",
+			"create table src (v int)",
+			"insert into src values (100), (200)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t (v) values (1)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 1}}},
+			},
+			{
+				Query: "insert into t (v) values (2), (3)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 2, Info: plan.InsertInfo{
+					Records: 2,
+				}}}},
+			},
+			{
+				Query: "insert into t values (10, 4), (null, 5)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 11, Info: plan.InsertInfo{
+					Records: 2,
+				}}}},
+			},
+			{
+				Query:    "select last_insert_id()",
+				Expected: []sql.Row{{11}},
+			},
+			{
+				Query: "insert ignore into t (v) values (5), (6)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 12, Info: plan.InsertInfo{
+					Records:    2,
+					Duplicates: 1,
+					Warnings:   1,
+				}}}},
+			},
+			{
+				Query: "replace into t (id, v) values (1, 1), (2, 20), (30, 30)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 5, InsertID: 1, Info: plan.InsertInfo{
+					Records:    3,
+					Duplicates: 2,
+				}}}},
+			},
+			{
+				Query: "insert into t (id, v) values (1, 1), (2, 20), (40, 40) on duplicate key update v = v + 100",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 5, InsertID: 40, Info: plan.InsertInfo{
+					Records:    3,
+					Duplicates: 2,
+				}}}},
+			},
+			{
+				Query: "insert into t (v) select v from src",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 41, Info: plan.InsertInfo{
+					Records: 2,
+				}}}},
+			},
+			{
+				Query:    "select * from t order by id",
+				Expected: []sql.Row{{1, 101}, {2, 120}, {3, 3}, {10, 4}, {11, 5}, {12, 6}, {30, 30}, {40, 40}, {41, 100}, {42, 200}},
+			},
+		},
+	},
 	{
 		// https://github.com/dolthub/dolt/issues/4857
 		Name: "issue 4857: insert cte column alias with table alias qualify panic",
@@ -1868,6 +1931,59 @@ var InsertScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "inserts of many rows",
+		SetUpScript: []string{
+			"create table t (pk int primary key, u int, unique key (u))",
+			"create table a (pk int auto_increment primary key, v int)",
+			"insert into t values (0, 0)",
+			"create table n (i int primary key)",
+			"insert into n with recursive c (i) as (select 0 union all select i + 1 from c where i < 99) select i from c",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t select a.i * 100 + b.i + 1, a.i * 100 + b.i + 1 from n a, n b where a.i < 30",
+				Expected: []sql.Row{{types.NewOkResult(3000)}},
+			},
+			{
+				Query:    "select count(*), min(pk), max(pk), sum(u) from t",
+				Expected: []sql.Row{{3001, 0, 3000, float64(4501500)}},
+			},
+			{
+				// the rows with duplicate keys are in different batches
+				Query:          "insert into t select a.i * 100 + b.i + 3001, if(a.i * 100 + b.i = 2999, 3500, a.i * 100 + b.i + 3001) from n a, n b where a.i < 30",
+				ExpectedErrStr: "duplicate unique key given: [3500]",
+			},
+			{
+				Query:          "insert into t select if(a.i * 100 + b.i = 2999, 3001, a.i * 100 + b.i + 3001), a.i * 100 + b.i + 3001 from n a, n b where a.i < 30",
+				ExpectedErrStr: "duplicate primary key given: [3001]",
+			},
+			{
+				Query:    "select count(*) from t",
+				Expected: []sql.Row{{3001}},
+			},
+			{
+				Query:    "insert into t values (3001, null), (3002, null), (3003, 3003)",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "select * from t where pk > 3000 order by pk",
+				Expected: []sql.Row{{3001, nil}, {3002, nil}, {3003, 3003}},
+			},
+			{
+				Query:    "insert into a (v) values (1), (2), (3)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 3, InsertID: 1}}},
+			},
+			{
+				Query:    "insert into a (v) select pk from t where pk between 1 and 2000",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2000, InsertID: 4}}},
+			},
+			{
+				Query:    "select count(*), count(distinct pk), max(pk) from a",
+				Expected: []sql.Row{{2003, 2003, 2003}},
+			},
+		},
+	},
 }
 
 var InsertDuplicateKeyKeyless = []ScriptTest{
@@ -2284,7 +2400,7 @@ var InsertIgnoreScripts = []ScriptTest{
 				Expected: []sql.Row{
 					{types.OkResult{RowsAffected: 1}},
 				},
-				ExpectedWarning: mysql.ERUnknownError,
+				ExpectedWarning: mysql.ERDataTooLong,
 			},
 			{
 				Query: "SELECT * FROM t2",
@@ -2321,7 +2437,7 @@ var InsertIgnoreScripts = []ScriptTest{
 				Expected: []sql.Row{
 					{types.OkResult{RowsAffected: 1}},
 				},
-				ExpectedWarning: mysql.ERUnknownError,
+				ExpectedWarning: mysql.ERDataTooLong,
 			},
 			{
 				Query: "SELECT * FROM t2",
@@ -2478,7 +2594,7 @@ var IgnoreWithDuplicateUniqueKeyKeylessScripts = []ScriptTest{
 			},
 			{
 				Query:           "UPDATE IGNORE keyless SET val = 3 where pk = 1",
-				Expected:        []sql.Row{{newUpdateResult(1, 0)}},
+				Expected:        []sql.Row{{newUpdateResultWithWarnings(1, 0, 1)}},
 				ExpectedWarning: mysql.ERDupEntry,
 			},
 			{
@@ -2487,7 +2603,7 @@ var IgnoreWithDuplicateUniqueKeyKeylessScripts = []ScriptTest{
 			},
 			{
 				Query:           "UPDATE IGNORE keyless SET val = val + 1 ORDER BY pk",
-				Expected:        []sql.Row{{newUpdateResult(3, 1)}},
+				Expected:        []sql.Row{{newUpdateResultWithWarnings(3, 1, 2)}},
 				ExpectedWarning: mysql.ERDupEntry,
 			},
 			{
@@ -2549,3 +2665,103 @@ var InsertBrokenScripts = []ScriptTest{
 		},
 	},
 }
+
+var UniqueKeyScripts = []ScriptTest{
+	{
+		Name: "unique keys allow multiple NULL values",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int, unique key ab (a, b))",
+			"insert into t values (1, 1, null), (2, 1, null), (3, null, null), (4, null, null)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t values (5, 1, null), (6, null, 1)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "update t set b = null where pk = 6",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1, nil}, {2, 1, nil}, {3, nil, nil}, {4, nil, nil}, {5, 1, nil}, {6, nil, nil}},
+			},
+			{
+				Query:          "insert into t values (7, 1, 1), (8, 1, 1)",
+				ExpectedErrStr: "duplicate unique key given: [1,1]",
+			},
+			{
+				Query:    "select count(*) from t",
+				Expected: []sql.Row{{6}},
+			},
+		},
+	},
+	{
+		Name: "unique keys are checked against the rows inserted by the same statement",
+		SetUpScript: []string{
+			"create table t (pk int primary key, u int, unique key (u))",
+			"insert into t values (1, 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:          "insert into t values (2, 2), (3, 3), (4, 2)",
+				ExpectedErrStr: "duplicate unique key given: [2]",
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				Query:    "insert ignore into t values (2, 2), (3, 3), (4, 2)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}},
+			},
+			{
+				Query:          "update t set u = 4 where pk > 1",
+				ExpectedErrStr: "duplicate unique key given: [4]",
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}},
+			},
+		},
+	},
+	{
+		Name: "replace and on duplicate key update with multiple unique keys",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int, c int, unique key ua (a), unique key ub (b))",
+			"insert into t values (1, 1, 1, 0), (2, 2, 2, 0), (3, 3, 3, 0)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// the first conflicting key in index order is ua, on the row with pk 2
+				Query:    "insert into t values (4, 2, 3, 0) on duplicate key update c = c + 1",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1, 1, 0}, {2, 2, 2, 1}, {3, 3, 3, 0}},
+			},
+			{
+				// replaces the rows with pk 2 and 3, which conflict on ua and ub
+				Query:            "replace into t values (4, 2, 3, 5)",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1, 1, 0}, {4, 2, 3, 5}},
+			},
+			{
+				Query:          "insert into t values (5, 1, 9, 0) on duplicate key update b = 3",
+				ExpectedErrStr: "duplicate unique key given: [3]",
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1, 1, 0}, {4, 2, 3, 5}},
+			},
+		},
+	},
+}
@@ -16,6 +16,7 @@ package queries
 
 import (
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 var JoinQueryTests = []QueryTest{
@@ -728,6 +729,131 @@ var JoinScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "natural and using joins return the joined columns once",
+		SetUpScript: []string{
+			"create table t1 (a int, b int, c int);",
+			"create table t2 (d int, c int, b int);",
+			"create table t3 (c int, e int);",
+			"insert into t1 values (1,1,1), (2,2,2), (3,3,null);",
+			"insert into t2 values (10,1,1), (20,2,5), (30,4,4);",
+			"insert into t3 values (1,100), (4,400);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select * from t1 join t2 using (c) order by c;",
+				ExpectedColumns: sql.Schema{
+					{Name: "c", Type: types.Int32},
+					{Name: "a", Type: types.Int32},
+					{Name: "b", Type: types.Int32},
+					{Name: "d", Type: types.Int32},
+					{Name: "b", Type: types.Int32},
+				},
+				Expected: []sql.Row{{1, 1, 1, 10, 1}, {2, 2, 2, 20, 5}},
+			},
+			{
+				Query:    "select * from t1 join t2 using (c, b);",
+				Expected: []sql.Row{{1, 1, 1, 10}},
+			},
+			{
+				Query:    "select * from t1 left join t2 using (c) order by a;",
+				Expected: []sql.Row{{1, 1, 1, 10, 1}, {2, 2, 2, 20, 5}, {nil, 3, 3, nil, nil}},
+			},
+			{
+				Query:    "select * from t1 right join t2 using (c) order by d;",
+				Expected: []sql.Row{{1, 10, 1, 1, 1}, {2, 20, 5, 2, 2}, {4, 30, 4, nil, nil}},
+			},
+			{
+				Query:    "select * from t1 natural left join t2 order by a;",
+				Expected: []sql.Row{{1, 1, 1, 10}, {2, 2, 2, nil}, {3, nil, 3, nil}},
+			},
+			{
+				Query:    "select * from t1 natural right join t2 order by d;",
+				Expected: []sql.Row{{1, 1, 10, 1}, {2, 5, 20, nil}, {4, 4, 30, nil}},
+			},
+			{
+				Query:    "select c, t1.c, t2.c from t1 join t2 using (c) order by c;",
+				Expected: []sql.Row{{1, 1, 1}, {2, 2, 2}},
+			},
+			{
+				Query:    "select c, t1.c from t1 left join t2 using (c) where c is null or c > 1 order by c;",
+				Expected: []sql.Row{{nil, nil}, {2, 2}},
+			},
+			{
+				Query:    "select c, t2.c from t1 right join t2 using (c) order by c;",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {4, 4}},
+			},
+			{
+				Query:    "select * from t1 right join t2 using (c) left join t3 using (c) order by d;",
+				Expected: []sql.Row{{1, 10, 1, 1, 1, 100}, {2, 20, 5, 2, 2, nil}, {4, 30, 4, nil, nil, 400}},
+			},
+			{
+				Query:    "select c, sum(a) from t1 left join t2 using (c) group by c order by c;",
+				Expected: []sql.Row{{nil, float64(3)}, {1, float64(1)}, {2, float64(2)}},
+			},
+			{
+				Query:       "select * from t1 join t2 using (a);",
+				ExpectedErr: sql.ErrUnknownColumn,
+			},
+			{
+				Query:       "select c from t1 join t2 using (b);",
+				ExpectedErr: sql.ErrAmbiguousColumnName,
+			},
+		},
+	},
+	{
+		Name: "outer joins under null-rejecting filters",
+		SetUpScript: []string{
+			"create table l (a int primary key, b int);",
+			"create table r (c int primary key, d int);",
+			"create table s (e int primary key, f int);",
+			"insert into l values (1,1), (2,2), (3,3), (4,null);",
+			"insert into r values (1,10), (2,null), (5,50);",
+			"insert into s values (10,100), (50,500);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select a, c, d from l left join r on b = c where d is not null order by a;",
+				Expected: []sql.Row{{1, 1, 10}},
+			},
+			{
+				Query:    "select a, c, d from l left join r on b = c where d > 5 order by a;",
+				Expected: []sql.Row{{1, 1, 10}},
+			},
+			{
+				Query:    "select a, c from l right join r on b = c where a in (1, 2) order by c;",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+			{
+				Query:    "select a, c, d from l left join r on b = c where d + 1 between 0 and 20 order by a;",
+				Expected: []sql.Row{{1, 1, 10}},
+			},
+			{
+				Query:    "select a, c, e from l left join r on b = c left join s on d = e where f = 100 order by a;",
+				Expected: []sql.Row{{1, 1, 10}},
+			},
+			{
+				Query:    "select a, c, d from l left join r on b = c where d is null order by a;",
+				Expected: []sql.Row{{2, 2, nil}, {3, nil, nil}, {4, nil, nil}},
+			},
+			{
+				Query:    "select a, c from l left join r on b = c where c <=> null order by a;",
+				Expected: []sql.Row{{3, nil}, {4, nil}},
+			},
+			{
+				Query:    "select a, c, d from l left join r on b = c where d > 5 or a = 3 order by a;",
+				Expected: []sql.Row{{1, 1, 10}, {3, nil, nil}},
+			},
+			{
+				Query:    "select a, c, e from l left join r on b = c left join s on d = e where d = 10 order by a;",
+				Expected: []sql.Row{{1, 1, 10}},
+			},
+			{
+				Query:    "select a, c, e from l left join r on b = c left join s on d = e where c is not null order by a;",
+				Expected: []sql.Row{{1, 1, 10}, {2, 2, nil}},
+			},
+		},
+	},
 }
 
 var SkippedJoinQueryTests = []QueryTest{
@@ -249,6 +249,41 @@ var OrderByGroupByScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "group by with strict functional dependencies",
+		SetUpScript: []string{
+			"create table members (id bigint primary key, team text, name text);",
+			"create table teams (name varchar(10) primary key, color text);",
+			"insert into members values (3,'red','a'), (4,'red','b'), (5,'orange','c'), (6,'orange','d');",
+			"insert into teams values ('red', 'r'), ('orange', 'o');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select id, team, name from members group by id",
+				Expected: []sql.Row{{3, "red", "a"}, {4, "red", "b"}, {5, "orange", "c"}, {6, "orange", "d"}},
+			},
+			{
+				Query:    "select team, name from members where name = 'c' group by team",
+				Expected: []sql.Row{{"orange", "c"}},
+			},
+			{
+				Query:    "select m.id, t.color from members m join teams t on m.team = t.name group by m.id",
+				Expected: []sql.Row{{3, "r"}, {4, "r"}, {5, "o"}, {6, "o"}},
+			},
+			{
+				Query:    "select m.team, t.color, count(*) from members m join teams t on m.team = t.name group by m.team",
+				Expected: []sql.Row{{"red", "r", 2}, {"orange", "o", 2}},
+			},
+			{
+				Query:       "select team, name from members group by team",
+				ExpectedErr: analyzer.ErrValidationGroupBy,
+			},
+			{
+				Query:       "select t.name, m.id from members m join teams t on m.team = t.name group by t.name",
+				ExpectedErr: analyzer.ErrValidationGroupBy,
+			},
+		},
+	},
 	{
 		Name: "Group by null handling",
 		// https://github.com/dolthub/go-mysql-server/issues/1503
@@ -1436,6 +1436,199 @@ var UserPrivTests = []UserPrivilegeTest{
 			},
 		},
 	},
+	{
+		Name: "View SQL SECURITY uses the definer's privileges",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY, v1 BIGINT);",
+			"INSERT INTO mydb.test VALUES (0, 0), (1, 1);",
+			"CREATE VIEW mydb.definer_view AS SELECT pk FROM mydb.test WHERE v1 > 0;",
+			"CREATE SQL SECURITY INVOKER VIEW mydb.invoker_view AS SELECT pk FROM mydb.test;",
+			"CREATE VIEW mydb.nested_view AS SELECT * FROM mydb.definer_view;",
+			"CREATE USER 'definer_user'@'localhost';",
+			"CREATE DEFINER = 'definer_user'@'localhost' VIEW mydb.unprivileged_view AS SELECT pk FROM mydb.test;",
+			"CREATE DEFINER = 'missing_user'@'localhost' VIEW mydb.orphaned_view AS SELECT pk FROM mydb.test;",
+			"CREATE /* definer */ DEFINER = `root`@`localhost` VIEW mydb.commented_view AS SELECT pk FROM mydb.test WHERE v1 = 0;",
+			"CREATE USER 'rand_user'@'localhost';",
+			"GRANT SELECT ON mydb.definer_view TO 'rand_user'@'localhost';",
+			"GRANT SELECT ON mydb.invoker_view TO 'rand_user'@'localhost';",
+			"GRANT SELECT ON mydb.nested_view TO 'rand_user'@'localhost';",
+			"GRANT SELECT ON mydb.unprivileged_view TO 'rand_user'@'localhost';",
+			"GRANT SELECT ON mydb.orphaned_view TO 'rand_user'@'localhost';",
+			"GRANT SELECT ON mydb.commented_view TO 'rand_user'@'localhost';",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "rand_user",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.test;",
+				ExpectedErr: sql.ErrTableAccessDeniedForUser,
+			},
+			{
+				User:     "rand_user",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.definer_view;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:     "rand_user",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.nested_view;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:        "rand_user",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.invoker_view;",
+				ExpectedErr: sql.ErrTableAccessDeniedForUser,
+			},
+			{
+				User:        "rand_user",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.unprivileged_view;",
+				ExpectedErr: sql.ErrDatabaseAccessDeniedForUser,
+			},
+			{
+				User:        "rand_user",
+				Host:        "localhost",
+				Query:       "SELECT * FROM mydb.orphaned_view;",
+				ExpectedErr: sql.ErrDatabaseAccessDeniedForUser,
+			},
+			{
+				User:     "rand_user",
+				Host:     "localhost",
+				Query:    "SELECT * FROM mydb.commented_view;",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SELECT * FROM mydb.invoker_view ORDER BY pk;",
+				Expected: []sql.Row{
+					{0},
+					{1},
+				},
+			},
+		},
+	},
+	{
+		Name: "Creating objects with another definer requires SET_USER_ID",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+			"CREATE USER 'rand_user'@'localhost';",
+			"CREATE USER 'other_user'@'localhost';",
+			"GRANT CREATE VIEW, SELECT, TRIGGER, CREATE ROUTINE ON mydb.* TO 'rand_user'@'localhost';",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:     "rand_user",
+				Host:     "localhost",
+				Query:    "CREATE DEFINER = 'rand_user'@'localhost' VIEW mydb.own_view AS SELECT pk FROM mydb.test;",
+				Expected: []sql.Row{},
+			},
+			{
+				User:        "rand_user",
+				Host:        "localhost",
+				Query:       "CREATE DEFINER = 'other_user'@'localhost' VIEW mydb.other_view AS SELECT pk FROM mydb.test;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "rand_user",
+				Host:        "localhost",
+				Query:       "CREATE DEFINER = 'other_user'@'localhost' TRIGGER mydb.other_trig BEFORE INSERT ON mydb.test FOR EACH ROW SET NEW.pk = NEW.pk;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "rand_user",
+				Host:        "localhost",
+				Query:       "CREATE DEFINER = 'other_user'@'localhost' PROCEDURE mydb.other_proc() SELECT 1;",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:     "rand_user",
+				Host:     "localhost",
+				Query:    "CREATE PROCEDURE mydb.own_proc() SELECT 1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "GRANT SET_USER_ID ON *.* TO 'rand_user'@'localhost';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "rand_user",
+				Host:     "localhost",
+				Query:    "CREATE DEFINER = 'other_user'@'localhost' PROCEDURE mydb.other_proc() SELECT 1;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT routine_name, definer FROM information_schema.routines WHERE routine_schema = 'mydb' ORDER BY routine_name;",
+				Expected: []sql.Row{{"other_proc", "other_user@localhost"}, {"own_proc", "rand_user@localhost"}},
+			},
+		},
+	},
+	{
+		Name: "Triggers and procedures run with their definer's privileges",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.test (pk BIGINT PRIMARY KEY);",
+			"CREATE TABLE mydb.audit (pk BIGINT PRIMARY KEY);",
+			"INSERT INTO mydb.audit VALUES (100);",
+			"CREATE USER 'definer_user'@'localhost';",
+			"CREATE USER 'rand_user'@'localhost';",
+			"GRANT INSERT ON mydb.test TO 'rand_user'@'localhost';",
+			"GRANT EXECUTE ON mydb.* TO 'rand_user'@'localhost';",
+			"CREATE TRIGGER mydb.audit_trig AFTER INSERT ON mydb.test FOR EACH ROW INSERT INTO mydb.audit VALUES (NEW.pk);",
+			"CREATE TABLE mydb.test2 (pk BIGINT PRIMARY KEY);",
+			"GRANT INSERT ON mydb.test2 TO 'rand_user'@'localhost';",
+			"CREATE DEFINER = 'definer_user'@'localhost' TRIGGER mydb.audit_trig2 AFTER INSERT ON mydb.test2 FOR EACH ROW INSERT INTO mydb.audit VALUES (NEW.pk);",
+			"CREATE PROCEDURE mydb.definer_proc() SELECT pk FROM mydb.audit ORDER BY pk;",
+			"CREATE PROCEDURE mydb.invoker_proc() SQL SECURITY INVOKER SELECT pk FROM mydb.audit ORDER BY pk;",
+			"CREATE DEFINER = 'definer_user'@'localhost' PROCEDURE mydb.unprivileged_proc() SELECT pk FROM mydb.audit ORDER BY pk;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:     "rand_user",
+				Host:     "localhost",
+				Query:    "INSERT INTO mydb.test VALUES (1);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				User:        "rand_user",
+				Host:        "localhost",
+				Query:       "INSERT INTO mydb.test2 VALUES (2);",
+				ExpectedErr: sql.ErrDatabaseAccessDeniedForUser,
+			},
+			{
+				User:     "rand_user",
+				Host:     "localhost",
+				Query:    "CALL mydb.definer_proc();",
+				Expected: []sql.Row{{1}, {100}},
+			},
+			{
+				User:        "rand_user",
+				Host:        "localhost",
+				Query:       "CALL mydb.invoker_proc();",
+				ExpectedErr: sql.ErrPrivilegeCheckFailed,
+			},
+			{
+				User:        "rand_user",
+				Host:        "localhost",
+				Query:       "CALL mydb.unprivileged_proc();",
+				ExpectedErr: sql.ErrDatabaseAccessDeniedForUser,
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SELECT trigger_name, definer FROM information_schema.triggers WHERE trigger_schema = 'mydb' ORDER BY trigger_name;",
+				Expected: []sql.Row{
+					{"audit_trig", "root@localhost"},
+					{"audit_trig2", "definer_user@localhost"},
+				},
+			},
+		},
+	},
 	{
 		Name: "Anonymous User",
 		SetUpScript: []string{
@@ -1997,7 +2190,7 @@ var ServerAuthTests = []ServerAuthenticationTest{
 				Username:       "root",
 				Password:       "",
 				Query:          "DROP USER xyz;",
-				ExpectedErrStr: "Error 1105: Operation DROP USER failed for 'xyz'@'%'",
+				ExpectedErrStr: "Error 1396: Operation DROP USER failed for 'xyz'@'%'",
 			},
 		},
 	},
@@ -2811,4 +3004,18 @@ var QuickPrivTests = []QuickPrivilegeTest{
 			"FLUSH PRIVILEGES;",
 		},
 	},
+	{
+		Queries: []string{
+			"FLUSH TABLES;",
+		},
+		ExpectingErr: true,
+	},
+	{
+		Queries: []string{
+			"GRANT RELOAD ON *.* TO tester@localhost",
+			"FLUSH TABLES mydb.test;",
+			"FLUSH LOGS;",
+			"FLUSH STATUS;",
+		},
+	},
 }
@@ -685,7 +685,7 @@ END`,
 			{
 				Query: "CALL add_item(6);",
 				Expected: []sql.Row{
-					{types.NewOkResult(3)},
+					{types.OkResult{RowsAffected: 3, InsertID: 1}},
 				},
 			},
 			{
@@ -1886,6 +1886,165 @@ END;`,
 			},
 		},
 	},
+	{
+		Name: "GET DIAGNOSTICS in a condition handler",
+		SetUpScript: []string{
+			"CREATE TABLE t1 (pk BIGINT PRIMARY KEY)",
+			"INSERT INTO t1 VALUES (1), (2)",
+			`CREATE PROCEDURE p1()
+BEGIN
+	DECLARE total, b INT DEFAULT 0;
+	DECLARE done BOOL DEFAULT FALSE;
+	DECLARE state VARCHAR(5);
+	DECLARE msg TEXT;
+	DECLARE errno, conditions INT;
+	DECLARE cur1 CURSOR FOR SELECT * FROM t1;
+	DECLARE CONTINUE HANDLER FOR NOT FOUND
+	BEGIN
+		GET STACKED DIAGNOSTICS CONDITION 1 state = RETURNED_SQLSTATE, msg = MESSAGE_TEXT, errno = MYSQL_ERRNO;
+		GET DIAGNOSTICS conditions = NUMBER;
+		SET done = TRUE;
+	END;
+	OPEN cur1;
+	REPEAT
+		FETCH cur1 INTO b;
+		IF NOT done THEN
+			SET total = total + b;
+		END IF;
+	UNTIL done END REPEAT;
+	CLOSE cur1;
+	SELECT total, state, msg, errno, conditions;
+END`,
+			`CREATE PROCEDURE p2()
+BEGIN
+	DECLARE n INT;
+	INSERT INTO t1 VALUES (10), (11), (12);
+	GET CURRENT DIAGNOSTICS n = ROW_COUNT;
+	SELECT n;
+END`,
+			`CREATE PROCEDURE p3()
+BEGIN
+	DECLARE msg TEXT;
+	GET STACKED DIAGNOSTICS CONDITION 1 msg = MESSAGE_TEXT;
+END`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CALL p1()",
+				Expected: []sql.Row{{3, "02000", "No data - zero rows fetched, selected, or processed", 1329, 1}},
+			},
+			{
+				Query:    "CALL p2()",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:       "CALL p3()",
+				ExpectedErr: sql.ErrStackedDiagnosticsWithoutHandler,
+			},
+		},
+	},
+	{
+		Name: "GET DIAGNOSTICS outside of stored procedures",
+		SetUpScript: []string{
+			"CREATE TABLE t1 (pk BIGINT PRIMARY KEY)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO t1 VALUES (1), (2)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "GET DIAGNOSTICS @rows = ROW_COUNT, @conditions = NUMBER",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @rows, @conditions",
+				Expected: []sql.Row{{2, 0}},
+			},
+			{
+				Query:    "SELECT 1/0",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:    "GET DIAGNOSTICS CONDITION 1 @errno = MYSQL_ERRNO, @msg = MESSAGE_TEXT, @origin = CLASS_ORIGIN",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @errno, @msg, @origin",
+				Expected: []sql.Row{{1365, "Division by 0", "ISO 9075"}},
+			},
+			{
+				Query:       "GET DIAGNOSTICS CONDITION 5 @msg = MESSAGE_TEXT",
+				ExpectedErr: sql.ErrInvalidConditionNumber,
+			},
+			{
+				Query:       "GET STACKED DIAGNOSTICS @rows = ROW_COUNT",
+				ExpectedErr: sql.ErrStackedDiagnosticsWithoutHandler,
+			},
+			{
+				Query:       "GET DIAGNOSTICS @msg = MESSAGE_TEXT",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+		},
+	},
+	{
+		Name: "PREPARE, EXECUTE and DEALLOCATE PREPARE in stored procedures",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v int)",
+			"insert into t values (1, 10), (2, 20)",
+			`create procedure dyn_select(col varchar(10), minpk int)
+begin
+	set @sql = concat('select pk, ', col, ' from t where pk >= ? order by pk');
+	set @minpk = minpk;
+	prepare stmt from @sql;
+	execute stmt using @minpk;
+	deallocate prepare stmt;
+end`,
+			`create procedure dyn_insert(x int)
+begin
+	prepare ins from 'insert into t values (?, ?)';
+	execute ins using x, x;
+end`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "call dyn_select('v', 2)",
+				Expected: []sql.Row{{2, 20}},
+			},
+			{
+				Query:    "call dyn_select('pk', 1)",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+			{
+				Query:       "execute stmt",
+				ExpectedErr: sql.ErrUnknownPreparedStatement,
+			},
+			{
+				Query:            "call dyn_insert(3)",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "select * from t where pk = 3",
+				Expected: []sql.Row{{3, 3}},
+			},
+			{
+				Query:    "set @x = 4",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:            "execute ins using @x, @x",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "select * from t where pk = 4",
+				Expected: []sql.Row{{4, 4}},
+			},
+			{
+				Query:       "create function dyn() returns int begin prepare s from 'select 1'; return 1; end",
+				ExpectedErr: sql.ErrDynamicSQLInStoredFunction,
+			},
+		},
+	},
 }
 
 var ProcedureCallTests = []ScriptTest{
@@ -2198,7 +2357,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p1",                  // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2224,7 +2383,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2255,7 +2414,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2277,7 +2436,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p1",                  // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2303,7 +2462,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2321,7 +2480,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p1",                  // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2334,7 +2493,7 @@ var ProcedureShowStatus = []ScriptTest{
 						"mydb",                // Db
 						"p21",                 // Name
 						"PROCEDURE",           // Type
-						"",                    // Definer
+						"root@localhost",      // Definer
 						time.Unix(0, 0).UTC(), // Modified
 						time.Unix(0, 0).UTC(), // Created
 						"DEFINER",             // Security_type
@@ -2382,7 +2541,7 @@ var ProcedureShowCreate = []ScriptTest{
 					{
 						"p1", // Procedure
 						"",   // sql_mode
-						"CREATE PROCEDURE p1() COMMENT 'hi' DETERMINISTIC SELECT 6", // Create Procedure
+						"CREATE DEFINER=`root`@`localhost` PROCEDURE p1() COMMENT 'hi' DETERMINISTIC SELECT 6", // Create Procedure
 						"utf8mb4",          // character_set_client
 						"utf8mb4_0900_bin", // collation_connection
 						"utf8mb4_0900_bin", // Database Collation
@@ -2408,7 +2567,7 @@ var ProcedureShowCreate = []ScriptTest{
 					{
 						"p21", // Procedure
 						"",    // sql_mode
-						"CREATE PROCEDURE p21() SQL SECURITY DEFINER SELECT 8", // Create Procedure
+						"CREATE DEFINER=`root`@`localhost` PROCEDURE p21() SQL SECURITY DEFINER SELECT 8", // Create Procedure
 						"utf8mb4",          // character_set_client
 						"utf8mb4_0900_bin", // collation_connection
 						"utf8mb4_0900_bin", // Database Collation
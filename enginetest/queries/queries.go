@@ -3636,6 +3636,14 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT NOT 2 BETWEEN NULL AND 2",
 		Expected: []sql.Row{{nil}},
 	},
+	{
+		Query:    "SELECT SQL_SMALL_RESULT i > 1, COUNT(*) FROM mytable GROUP BY 1 ORDER BY 1",
+		Expected: []sql.Row{{false, 1}, {true, 2}},
+	},
+	{
+		Query:    "SELECT DISTINCT SQL_BIG_RESULT SQL_NO_CACHE i > 1 AS x FROM mytable ORDER BY x",
+		Expected: []sql.Row{{false}, {true}},
+	},
 	{
 		Query: "SELECT DISTINCT * FROM (values row(7,31,27), row(79,17,38), row(78,59,26)) a (col0, col1, col2) WHERE ( + col1 + + col2 ) NOT BETWEEN NULL AND col1",
 		Expected: []sql.Row{{7, 31, 27},
@@ -3947,6 +3955,54 @@ var QueryTests = []QueryTest{
 			{7, 7, nil, 1, 7, float64(4)},
 		},
 	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 = 0 AND v2 IN (0, 2) AND v3 > 0 ORDER BY pk`,
+		Expected: []sql.Row{
+			{1},
+			{3},
+		},
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 IN (0, 1) AND v2 IN (0, 0, 1) ORDER BY pk`,
+		Expected: []sql.Row{
+			{0},
+			{1},
+			{2},
+			{4},
+		},
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 = 0 AND (v2 = 1 OR v2 > 1) AND v3 < 2 ORDER BY pk`,
+		Expected: []sql.Row{
+			{2},
+		},
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 IN (0, 3, 4) AND (v2 IN (0, 4) OR v2 BETWEEN 2 AND 3) AND v3 >= 0 ORDER BY pk`,
+		Expected: []sql.Row{
+			{0},
+			{1},
+			{3},
+			{6},
+			{7},
+		},
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE (v1 = 0 OR v1 = 1) AND (v2 < 1 OR v2 > 1) ORDER BY pk`,
+		Expected: []sql.Row{
+			{0},
+			{1},
+			{3},
+			{4},
+		},
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 = 0 AND (v2 = 1 OR v3 = 2) ORDER BY pk`,
+		Expected: []sql.Row{
+			{2},
+			{3},
+		},
+	},
 	{
 		Query: `SELECT s2, i2 FROM othertable WHERE s2 >= "first" AND i2 >= 2 ORDER BY 1`,
 		Expected: []sql.Row{
@@ -5362,6 +5418,14 @@ var QueryTests = []QueryTest{
 		Query:    "select date_format(da, '%s') from typestable order by 1",
 		Expected: []sql.Row{{"00"}},
 	},
+	{
+		Query:    "select statement_digest_text('SELECT * FROM mytable WHERE i IN (1, 2) AND s = \\'a\\'')",
+		Expected: []sql.Row{{"SELECT * FROM `mytable` WHERE `i` IN (...) AND `s` = ?"}},
+	},
+	{
+		Query:    "select statement_digest('select * from mytable where i = 1') = statement_digest('SELECT * FROM mytable WHERE i = 2')",
+		Expected: []sql.Row{{true}},
+	},
 	{
 		Query: "select md5(i) from mytable order by 1",
 		Expected: []sql.Row{
@@ -9795,6 +9859,89 @@ var IndexPrefixQueries = []ScriptTest{
 				Query:       "create table bad(c blob, index (c(3073)))",
 				ExpectedErr: sql.ErrKeyTooLong,
 			},
+			{
+				Query:    "create table varchar_key_limit(c varchar(768) primary key, d varbinary(3072), index (d))",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "create table bad(c varchar(769) primary key)",
+				ExpectedErr: sql.ErrKeyTooLong,
+			},
+			{
+				Query:       "create table bad(c varchar(1000), index (c))",
+				ExpectedErr: sql.ErrKeyTooLong,
+			},
+			{
+				Query:       "create table bad(c varchar(700), d varchar(700), index (c, d))",
+				ExpectedErr: sql.ErrKeyTooLong,
+			},
+			{
+				Query:       "create table bad(c varchar(1000), d varchar(1000), index (c(400), d(400)))",
+				ExpectedErr: sql.ErrKeyTooLong,
+			},
+			{
+				Query:       "alter table varchar_limit add index (c)",
+				ExpectedErr: sql.ErrKeyTooLong,
+			},
+			{
+				Query:       "create index bad on varchar_limit (c(769))",
+				ExpectedErr: sql.ErrKeyTooLong,
+			},
+		},
+	},
+	{
+		Name: "prefix index range scans",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(100), t text, b blob, index v3 (v(3)), index t2 (t(2)), index b2 (b(2)))",
+			"insert into t values (1, 'abcdef', 'xyz', 'abcd'), (2, 'abcxyz', 'xy', 'ab'), (3, 'abd', 'x', 'abc'), (4, 'ab', null, null), (5, null, 'xyzz', 'b')",
+			"create table u (pk int primary key, w varchar(100))",
+			"insert into u values (1, 'abcdef'), (2, 'abd'), (3, 'zzz'), (4, 'abc')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select pk from t where v = 'abcdef'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select pk from t where v > 'abc' order by pk",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    "select pk from t where v >= 'abcdef' and v < 'abd' order by pk",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "select pk from t where v < 'abcxyz' order by pk",
+				Expected: []sql.Row{{1}, {4}},
+			},
+			{
+				Query:    "select pk from t where v <= 'abc' order by pk",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "select pk from t where v in ('ab', 'abd', 'abcd') order by pk",
+				Expected: []sql.Row{{3}, {4}},
+			},
+			{
+				Query:    "select pk from t where v is null",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "select pk from t where t > 'xy' order by pk",
+				Expected: []sql.Row{{1}, {5}},
+			},
+			{
+				Query:    "select pk from t where b > 'ab' order by pk",
+				Expected: []sql.Row{{1}, {3}, {5}},
+			},
+			{
+				Query:    "select pk from t where b = 'abc'",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select t.pk, u.pk from u join t on t.v = u.w order by 1, 2",
+				Expected: []sql.Row{{1, 1}, {3, 2}},
+			},
 		},
 	},
 }
@@ -120,11 +120,10 @@ var PlanTests = []QueryPlanTest{
 			"         ├─ Eq\n" +
 			"         │   ├─ mytable.i:1!null\n" +
 			"         │   └─ applySubq0.i2:0!null\n" +
-			"         ├─ Distinct\n" +
-			"         │   └─ TableAlias(applySubq0)\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: othertable\n" +
-			"         │           └─ columns: [i2]\n" +
+			"         ├─ TableAlias(applySubq0)\n" +
+			"         │   └─ Table\n" +
+			"         │       ├─ name: othertable\n" +
+			"         │       └─ columns: [i2]\n" +
 			"         └─ IndexedTableAccess(mytable)\n" +
 			"             ├─ index: [mytable.i]\n" +
 			"             └─ columns: [i s]\n" +
@@ -535,61 +534,65 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `with cte1 as (select u, v from cte2 join ab on cte2.u = b), cte2 as (select u,v from uv join ab on u = b where u in (2,3)) select * from xy where (x) not in (select u from cte1) order by 1`,
 		ExpectedPlan: "Sort(xy.x:0!null ASC nullsFirst)\n" +
-			" └─ AntiJoin\n" +
+			" └─ AntiHashJoin\n" +
 			"     ├─ Eq\n" +
 			"     │   ├─ xy.x:0!null\n" +
 			"     │   └─ applySubq0.u:2!null\n" +
 			"     ├─ Table\n" +
 			"     │   ├─ name: xy\n" +
 			"     │   └─ columns: [x y]\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: applySubq0\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         └─ Project\n" +
-			"             ├─ columns: [cte1.u:0!null]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ source: TUPLE(xy.x:0!null)\n" +
+			"         ├─ target: TUPLE(applySubq0.u:0!null)\n" +
+			"         └─ CachedResults\n" +
 			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: cte1\n" +
-			"                 ├─ outerVisibility: true\n" +
+			"                 ├─ name: applySubq0\n" +
+			"                 ├─ outerVisibility: false\n" +
 			"                 ├─ cacheable: true\n" +
 			"                 └─ Project\n" +
-			"                     ├─ columns: [cte2.u:1!null, cte2.v:2]\n" +
-			"                     └─ HashJoin\n" +
-			"                         ├─ Eq\n" +
-			"                         │   ├─ cte2.u:1!null\n" +
-			"                         │   └─ ab.b:0\n" +
-			"                         ├─ Table\n" +
-			"                         │   ├─ name: ab\n" +
-			"                         │   └─ columns: [b]\n" +
-			"                         └─ HashLookup\n" +
-			"                             ├─ source: TUPLE(ab.b:0)\n" +
-			"                             ├─ target: TUPLE(cte2.u:0!null)\n" +
-			"                             └─ CachedResults\n" +
-			"                                 └─ SubqueryAlias\n" +
-			"                                     ├─ name: cte2\n" +
-			"                                     ├─ outerVisibility: false\n" +
-			"                                     ├─ cacheable: true\n" +
-			"                                     └─ Project\n" +
-			"                                         ├─ columns: [uv.u:1!null, uv.v:2]\n" +
-			"                                         └─ HashJoin\n" +
-			"                                             ├─ Eq\n" +
-			"                                             │   ├─ uv.u:1!null\n" +
-			"                                             │   └─ ab.b:0\n" +
-			"                                             ├─ Table\n" +
-			"                                             │   ├─ name: ab\n" +
-			"                                             │   └─ columns: [b]\n" +
-			"                                             └─ HashLookup\n" +
-			"                                                 ├─ source: TUPLE(ab.b:0)\n" +
-			"                                                 ├─ target: TUPLE(uv.u:0!null)\n" +
-			"                                                 └─ CachedResults\n" +
-			"                                                     └─ Filter\n" +
-			"                                                         ├─ HashIn\n" +
-			"                                                         │   ├─ uv.u:0!null\n" +
-			"                                                         │   └─ TUPLE(2 (tinyint), 3 (tinyint))\n" +
-			"                                                         └─ IndexedTableAccess(uv)\n" +
-			"                                                             ├─ index: [uv.u]\n" +
-			"                                                             ├─ static: [{[2, 2]}, {[3, 3]}]\n" +
-			"                                                             └─ columns: [u v]\n" +
+			"                     ├─ columns: [cte1.u:0!null]\n" +
+			"                     └─ SubqueryAlias\n" +
+			"                         ├─ name: cte1\n" +
+			"                         ├─ outerVisibility: true\n" +
+			"                         ├─ cacheable: true\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [cte2.u:1!null, cte2.v:2]\n" +
+			"                             └─ HashJoin\n" +
+			"                                 ├─ Eq\n" +
+			"                                 │   ├─ cte2.u:1!null\n" +
+			"                                 │   └─ ab.b:0\n" +
+			"                                 ├─ Table\n" +
+			"                                 │   ├─ name: ab\n" +
+			"                                 │   └─ columns: [b]\n" +
+			"                                 └─ HashLookup\n" +
+			"                                     ├─ source: TUPLE(ab.b:0)\n" +
+			"                                     ├─ target: TUPLE(cte2.u:0!null)\n" +
+			"                                     └─ CachedResults\n" +
+			"                                         └─ SubqueryAlias\n" +
+			"                                             ├─ name: cte2\n" +
+			"                                             ├─ outerVisibility: false\n" +
+			"                                             ├─ cacheable: true\n" +
+			"                                             └─ Project\n" +
+			"                                                 ├─ columns: [uv.u:1!null, uv.v:2]\n" +
+			"                                                 └─ HashJoin\n" +
+			"                                                     ├─ Eq\n" +
+			"                                                     │   ├─ uv.u:1!null\n" +
+			"                                                     │   └─ ab.b:0\n" +
+			"                                                     ├─ Table\n" +
+			"                                                     │   ├─ name: ab\n" +
+			"                                                     │   └─ columns: [b]\n" +
+			"                                                     └─ HashLookup\n" +
+			"                                                         ├─ source: TUPLE(ab.b:0)\n" +
+			"                                                         ├─ target: TUPLE(uv.u:0!null)\n" +
+			"                                                         └─ CachedResults\n" +
+			"                                                             └─ Filter\n" +
+			"                                                                 ├─ HashIn\n" +
+			"                                                                 │   ├─ uv.u:0!null\n" +
+			"                                                                 │   └─ TUPLE(2 (tinyint), 3 (tinyint))\n" +
+			"                                                                 └─ IndexedTableAccess(uv)\n" +
+			"                                                                     ├─ index: [uv.u]\n" +
+			"                                                                     ├─ static: [{[2, 2]}, {[3, 3]}]\n" +
+			"                                                                     └─ columns: [u v]\n" +
 			"",
 	},
 	{
@@ -772,10 +775,9 @@ var PlanTests = []QueryPlanTest{
 			" │               ├─ Eq\n" +
 			" │               │   ├─ ab.a:4!null\n" +
 			" │               │   └─ uv.u:2!null\n" +
-			" │               ├─ Distinct\n" +
-			" │               │   └─ Table\n" +
-			" │               │       ├─ name: uv\n" +
-			" │               │       └─ columns: [u v]\n" +
+			" │               ├─ Table\n" +
+			" │               │   ├─ name: uv\n" +
+			" │               │   └─ columns: [u v]\n" +
 			" │               └─ IndexedTableAccess(ab)\n" +
 			" │                   ├─ index: [ab.a]\n" +
 			" │                   └─ columns: [a b]\n" +
@@ -875,10 +877,9 @@ var PlanTests = []QueryPlanTest{
 			"         ├─ Eq\n" +
 			"         │   ├─ xy.x:0!null\n" +
 			"         │   └─ ab.a:2!null\n" +
-			"         ├─ Distinct\n" +
-			"         │   └─ Table\n" +
-			"         │       ├─ name: xy\n" +
-			"         │       └─ columns: [x y]\n" +
+			"         ├─ Table\n" +
+			"         │   ├─ name: xy\n" +
+			"         │   └─ columns: [x y]\n" +
 			"         └─ IndexedTableAccess(ab)\n" +
 			"             ├─ index: [ab.a]\n" +
 			"             └─ columns: [a b]\n" +
@@ -910,10 +911,9 @@ var PlanTests = []QueryPlanTest{
 			"     ├─ Eq\n" +
 			"     │   ├─ ab.a:0!null\n" +
 			"     │   └─ xy.x:2!null\n" +
-			"     ├─ Distinct\n" +
-			"     │   └─ Table\n" +
-			"     │       ├─ name: ab\n" +
-			"     │       └─ columns: [a b]\n" +
+			"     ├─ Table\n" +
+			"     │   ├─ name: ab\n" +
+			"     │   └─ columns: [a b]\n" +
 			"     └─ IndexedTableAccess(xy)\n" +
 			"         ├─ index: [xy.x]\n" +
 			"         └─ columns: [x y]\n" +
@@ -927,10 +927,9 @@ var PlanTests = []QueryPlanTest{
 			"         ├─ Eq\n" +
 			"         │   ├─ ab.a:0!null\n" +
 			"         │   └─ xy.x:2!null\n" +
-			"         ├─ Distinct\n" +
-			"         │   └─ Table\n" +
-			"         │       ├─ name: ab\n" +
-			"         │       └─ columns: [a b]\n" +
+			"         ├─ Table\n" +
+			"         │   ├─ name: ab\n" +
+			"         │   └─ columns: [a b]\n" +
 			"         └─ IndexedTableAccess(xy)\n" +
 			"             ├─ index: [xy.x]\n" +
 			"             └─ columns: [x y]\n" +
@@ -977,12 +976,11 @@ inner join xy on a = x;`,
 			" │                   ├─ source: TUPLE(uv.u:2)\n" +
 			" │                   ├─ target: TUPLE(pq.p:0!null)\n" +
 			" │                   └─ CachedResults\n" +
-			" │                       └─ Distinct\n" +
-			" │                           └─ Project\n" +
-			" │                               ├─ columns: [pq.p:0!null]\n" +
-			" │                               └─ Table\n" +
-			" │                                   ├─ name: pq\n" +
-			" │                                   └─ columns: [p q]\n" +
+			" │                       └─ Project\n" +
+			" │                           ├─ columns: [pq.p:0!null]\n" +
+			" │                           └─ Table\n" +
+			" │                               ├─ name: pq\n" +
+			" │                               └─ columns: [p q]\n" +
 			" └─ IndexedTableAccess(xy)\n" +
 			"     ├─ index: [xy.x]\n" +
 			"     └─ columns: [x y]\n" +
@@ -1038,26 +1036,29 @@ where exists (select * from pq where a = p)
 			"     │   ├─ name: alias1\n" +
 			"     │   ├─ outerVisibility: false\n" +
 			"     │   ├─ cacheable: true\n" +
-			"     │   └─ AntiLookupJoin\n" +
+			"     │   └─ AntiHashJoin\n" +
 			"     │       ├─ Eq\n" +
 			"     │       │   ├─ ab.a:0!null\n" +
 			"     │       │   └─ uv.u:2!null\n" +
 			"     │       ├─ Table\n" +
 			"     │       │   ├─ name: ab\n" +
 			"     │       │   └─ columns: [a b]\n" +
-			"     │       └─ IndexedTableAccess(uv)\n" +
-			"     │           ├─ index: [uv.u]\n" +
-			"     │           └─ columns: [u v]\n" +
+			"     │       └─ HashLookup\n" +
+			"     │           ├─ source: TUPLE(ab.a:0!null)\n" +
+			"     │           ├─ target: TUPLE(uv.u:0!null)\n" +
+			"     │           └─ CachedResults\n" +
+			"     │               └─ Table\n" +
+			"     │                   ├─ name: uv\n" +
+			"     │                   └─ columns: [u v]\n" +
 			"     └─ HashLookup\n" +
 			"         ├─ source: TUPLE(alias1.a:0!null)\n" +
 			"         ├─ target: TUPLE(pq.p:0!null)\n" +
 			"         └─ CachedResults\n" +
-			"             └─ Distinct\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [pq.p:0!null]\n" +
-			"                     └─ Table\n" +
-			"                         ├─ name: pq\n" +
-			"                         └─ columns: [p q]\n" +
+			"             └─ Project\n" +
+			"                 ├─ columns: [pq.p:0!null]\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: pq\n" +
+			"                     └─ columns: [p q]\n" +
 			"",
 	},
 	{
@@ -1165,12 +1166,11 @@ inner join pq on true
 			"             ├─ source: TUPLE(alias1.a:0!null)\n" +
 			"             ├─ target: TUPLE(uv.u:0!null)\n" +
 			"             └─ CachedResults\n" +
-			"                 └─ Distinct\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [uv.u:0!null]\n" +
-			"                         └─ Table\n" +
-			"                             ├─ name: uv\n" +
-			"                             └─ columns: [u v]\n" +
+			"                 └─ Project\n" +
+			"                     ├─ columns: [uv.u:0!null]\n" +
+			"                     └─ Table\n" +
+			"                         ├─ name: uv\n" +
+			"                         └─ columns: [u v]\n" +
 			"",
 	},
 	{
@@ -1181,11 +1181,10 @@ inner join pq on true
 			"     ├─ Eq\n" +
 			"     │   ├─ a.i:1!null\n" +
 			"     │   └─ b.i:0!null\n" +
-			"     ├─ Distinct\n" +
-			"     │   └─ TableAlias(b)\n" +
-			"     │       └─ Table\n" +
-			"     │           ├─ name: mytable\n" +
-			"     │           └─ columns: [i]\n" +
+			"     ├─ TableAlias(b)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: mytable\n" +
+			"     │       └─ columns: [i]\n" +
 			"     └─ TableAlias(a)\n" +
 			"         └─ IndexedTableAccess(mytable)\n" +
 			"             ├─ index: [mytable.i]\n" +
@@ -1196,7 +1195,7 @@ inner join pq on true
 		Query: `select i from mytable a where not exists (select 1 from mytable b where a.i = b.i)`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [a.i:0!null]\n" +
-			" └─ AntiLookupJoin\n" +
+			" └─ AntiHashJoin\n" +
 			"     ├─ Eq\n" +
 			"     │   ├─ a.i:0!null\n" +
 			"     │   └─ b.i:2!null\n" +
@@ -1204,10 +1203,14 @@ inner join pq on true
 			"     │   └─ Table\n" +
 			"     │       ├─ name: mytable\n" +
 			"     │       └─ columns: [i s]\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable)\n" +
-			"             ├─ index: [mytable.i]\n" +
-			"             └─ columns: [i]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ source: TUPLE(a.i:0!null)\n" +
+			"         ├─ target: TUPLE(b.i:0!null)\n" +
+			"         └─ CachedResults\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: mytable\n" +
+			"                     └─ columns: [i]\n" +
 			"",
 	},
 	{
@@ -1350,25 +1353,23 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT * FROM one_pk_two_idx WHERE v1 < 2 AND v2 IS NOT NULL`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ NOT\n" +
-			" │   └─ one_pk_two_idx.v2:2 IS NULL\n" +
-			" └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"     ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
-			"     ├─ static: [{(NULL, 2), (NULL, ∞)}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(one_pk_two_idx)\n" +
+			" ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			" ├─ static: [{(NULL, 2), (NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: NOT\n" +
+			"     └─ one_pk_two_idx.v2:2 IS NULL\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM one_pk_two_idx WHERE v1 IN (1, 2) AND v2 <= 2`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ HashIn\n" +
-			" │   ├─ one_pk_two_idx.v1:1\n" +
-			" │   └─ TUPLE(1 (tinyint), 2 (tinyint))\n" +
-			" └─ IndexedTableAccess(one_pk_two_idx)\n" +
-			"     ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
-			"     ├─ static: [{[2, 2], (NULL, 2]}, {[1, 1], (NULL, 2]}]\n" +
-			"     └─ columns: [pk v1 v2]\n" +
+		ExpectedPlan: "IndexedTableAccess(one_pk_two_idx)\n" +
+			" ├─ index: [one_pk_two_idx.v1,one_pk_two_idx.v2]\n" +
+			" ├─ static: [{[1, 1], (NULL, 2]}, {[2, 2], (NULL, 2]}]\n" +
+			" ├─ columns: [pk v1 v2]\n" +
+			" └─ index condition: IN\n" +
+			"     ├─ left: one_pk_two_idx.v1:1\n" +
+			"     └─ right: TUPLE(1 (tinyint), 2 (tinyint))\n" +
 			"",
 	},
 	{
@@ -1381,16 +1382,101 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT * FROM one_pk_three_idx WHERE v1 > 2 AND v3 = 3`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Eq\n" +
-			" │   ├─ one_pk_three_idx.v3:3\n" +
-			" │   └─ 3 (tinyint)\n" +
+		ExpectedPlan: "IndexedTableAccess(one_pk_three_idx)\n" +
+			" ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			" ├─ static: [{(2, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			" ├─ columns: [pk v1 v2 v3]\n" +
+			" └─ index condition: Eq\n" +
+			"     ├─ one_pk_three_idx.v3:3\n" +
+			"     └─ 3 (tinyint)\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 = 0 AND v2 IN (0, 2) AND v3 > 0`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_three_idx.pk:0!null]\n" +
+			" └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"     ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"     ├─ static: [{[0, 0], [0, 0], (0, ∞)}, {[0, 0], [2, 2], (0, ∞)}]\n" +
+			"     ├─ columns: [pk v1 v2 v3]\n" +
+			"     └─ index condition: IN\n" +
+			"         ├─ left: one_pk_three_idx.v2:2\n" +
+			"         └─ right: TUPLE(0 (tinyint), 2 (tinyint))\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 IN (0, 1) AND v2 IN (0, 0, 1)`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_three_idx.pk:0!null]\n" +
 			" └─ IndexedTableAccess(one_pk_three_idx)\n" +
 			"     ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
-			"     ├─ static: [{(2, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			"     ├─ static: [{[0, 0], [0, 0], [NULL, ∞)}, {[0, 0], [1, 1], [NULL, ∞)}, {[1, 1], [0, 0], [NULL, ∞)}, {[1, 1], [1, 1], [NULL, ∞)}]\n" +
+			"     ├─ columns: [pk v1 v2]\n" +
+			"     └─ index condition: AND\n" +
+			"         ├─ IN\n" +
+			"         │   ├─ left: one_pk_three_idx.v1:1\n" +
+			"         │   └─ right: TUPLE(0 (tinyint), 1 (tinyint))\n" +
+			"         └─ IN\n" +
+			"             ├─ left: one_pk_three_idx.v2:2\n" +
+			"             └─ right: TUPLE(0 (tinyint), 0 (tinyint), 1 (tinyint))\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 = 0 AND (v2 = 1 OR v2 > 1) AND v3 < 2`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_three_idx.pk:0!null]\n" +
+			" └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"     ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"     ├─ static: [{[0, 0], [1, ∞), (NULL, 2)}]\n" +
 			"     └─ columns: [pk v1 v2 v3]\n" +
 			"",
 	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 IN (0, 3, 4) AND (v2 IN (0, 4) OR v2 BETWEEN 2 AND 3) AND v3 >= 0`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_three_idx.pk:0!null]\n" +
+			" └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"     ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"     ├─ static: [{[0, 0], [0, 0], [0, ∞)}, {[0, 0], [2, 3], [0, ∞)}, {[0, 0], [4, 4], [0, ∞)}, {[3, 3], [0, 0], [0, ∞)}, {[3, 3], [2, 3], [0, ∞)}, {[3, 3], [4, 4], [0, ∞)}, {[4, 4], [0, 0], [0, ∞)}, {[4, 4], [2, 3], [0, ∞)}, {[4, 4], [4, 4], [0, ∞)}]\n" +
+			"     ├─ columns: [pk v1 v2 v3]\n" +
+			"     └─ index condition: AND\n" +
+			"         ├─ IN\n" +
+			"         │   ├─ left: one_pk_three_idx.v1:1\n" +
+			"         │   └─ right: TUPLE(0 (tinyint), 3 (tinyint), 4 (tinyint))\n" +
+			"         └─ Or\n" +
+			"             ├─ IN\n" +
+			"             │   ├─ left: one_pk_three_idx.v2:2\n" +
+			"             │   └─ right: TUPLE(0 (tinyint), 4 (tinyint))\n" +
+			"             └─ (one_pk_three_idx.v2:2 BETWEEN 2 (tinyint) AND 3 (tinyint))\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE (v1 = 0 OR v1 = 1) AND (v2 < 1 OR v2 > 1)`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_three_idx.pk:0!null]\n" +
+			" └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"     ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"     ├─ static: [{[0, 0], (NULL, 1), [NULL, ∞)}, {[0, 0], (1, ∞), [NULL, ∞)}, {[1, 1], (NULL, 1), [NULL, ∞)}, {[1, 1], (1, ∞), [NULL, ∞)}]\n" +
+			"     └─ columns: [pk v1 v2]\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk_three_idx WHERE v1 = 0 AND (v2 = 1 OR v3 = 2)`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk_three_idx.pk:0!null]\n" +
+			" └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"     ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"     ├─ static: [{[0, 0], [NULL, ∞), [NULL, ∞)}]\n" +
+			"     ├─ columns: [pk v1 v2 v3]\n" +
+			"     └─ index condition: Or\n" +
+			"         ├─ Eq\n" +
+			"         │   ├─ one_pk_three_idx.v2:2\n" +
+			"         │   └─ 1 (tinyint)\n" +
+			"         └─ Eq\n" +
+			"             ├─ one_pk_three_idx.v3:3\n" +
+			"             └─ 2 (tinyint)\n" +
+			"",
+	},
 	{
 		Query: `select row_number() over (order by i desc), mytable.i as i2 
 				from mytable join othertable on i = i2
@@ -2202,88 +2288,69 @@ inner join pq on true
 		Query: `SELECT /*+ JOIN_ORDER(mytable, othertable) */ s2, i2, i FROM mytable INNER JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [othertable.s2:1!null, othertable.i2:2!null, mytable.i:0!null]\n" +
-			" └─ HashJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ othertable.i2:2!null\n" +
-			"     │   └─ mytable.i:0!null\n" +
-			"     ├─ Table\n" +
-			"     │   ├─ name: mytable\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
+			"     │   ├─ mytable.i:0!null\n" +
+			"     │   └─ othertable.i2:2!null\n" +
+			"     ├─ IndexedTableAccess(mytable)\n" +
+			"     │   ├─ index: [mytable.i]\n" +
+			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [i]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ source: TUPLE(mytable.i:0!null)\n" +
-			"         ├─ target: TUPLE(othertable.i2:1!null)\n" +
-			"         └─ CachedResults\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: othertable\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
-			"                     └─ columns: [s2 i2]\n" +
+			"     └─ TableAlias(othertable)\n" +
+			"         └─ IndexedTableAccess(othertable)\n" +
+			"             ├─ index: [othertable.i2]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM mytable LEFT JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [othertable.s2:1, othertable.i2:2, mytable.i:0!null]\n" +
-			" └─ LeftOuterHashJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ othertable.i2:2!null\n" +
-			"     │   └─ mytable.i:0!null\n" +
-			"     ├─ Table\n" +
-			"     │   ├─ name: mytable\n" +
+			" └─ LeftOuterMergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
+			"     │   ├─ mytable.i:0!null\n" +
+			"     │   └─ othertable.i2:2!null\n" +
+			"     ├─ IndexedTableAccess(mytable)\n" +
+			"     │   ├─ index: [mytable.i]\n" +
+			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [i]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ source: TUPLE(mytable.i:0!null)\n" +
-			"         ├─ target: TUPLE(othertable.i2:1!null)\n" +
-			"         └─ CachedResults\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: othertable\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: othertable\n" +
-			"                     └─ columns: [s2 i2]\n" +
+			"     └─ TableAlias(othertable)\n" +
+			"         └─ IndexedTableAccess(othertable)\n" +
+			"             ├─ index: [othertable.i2]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM (SELECT * FROM mytable) mytable RIGHT JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [othertable.s2:0!null, othertable.i2:1!null, mytable.i:2]\n" +
-			" └─ LeftOuterHashJoin\n" +
-			"     ├─ Eq\n" +
+			" └─ LeftOuterMergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
 			"     │   ├─ othertable.i2:1!null\n" +
 			"     │   └─ mytable.i:2!null\n" +
-			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: othertable\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ cacheable: true\n" +
-			"     │   └─ Table\n" +
-			"     │       ├─ name: othertable\n" +
+			"     ├─ TableAlias(othertable)\n" +
+			"     │   └─ IndexedTableAccess(othertable)\n" +
+			"     │       ├─ index: [othertable.i2]\n" +
+			"     │       ├─ static: [{[NULL, ∞)}]\n" +
 			"     │       └─ columns: [s2 i2]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ source: TUPLE(othertable.i2:1!null)\n" +
-			"         ├─ target: TUPLE(mytable.i:0!null)\n" +
-			"         └─ CachedResults\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: mytable\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ Table\n" +
-			"                     ├─ name: mytable\n" +
-			"                     └─ columns: [i s]\n" +
+			"     └─ TableAlias(mytable)\n" +
+			"         └─ IndexedTableAccess(mytable)\n" +
+			"             ├─ index: [mytable.i]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [i s]\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.* FROM mytable a WHERE a.s is not null`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ NOT\n" +
-			" │   └─ a.s:1!null IS NULL\n" +
-			" └─ TableAlias(a)\n" +
-			"     └─ IndexedTableAccess(mytable)\n" +
-			"         ├─ index: [mytable.s]\n" +
-			"         ├─ static: [{(NULL, ∞)}]\n" +
-			"         └─ columns: [i s]\n" +
+		ExpectedPlan: "TableAlias(a)\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.s]\n" +
+			"     ├─ static: [{(NULL, ∞)}]\n" +
+			"     ├─ columns: [i s]\n" +
+			"     └─ index condition: NOT\n" +
+			"         └─ mytable.s:1!null IS NULL\n" +
 			"",
 	},
 	{
@@ -2558,26 +2625,24 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT * from mytable WHERE s IN (cast('first row' AS CHAR))`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ HashIn\n" +
-			" │   ├─ mytable.s:1!null\n" +
-			" │   └─ TUPLE(first row (longtext))\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.s]\n" +
-			"     ├─ static: [{[first row, first row]}]\n" +
-			"     └─ columns: [i s]\n" +
+		ExpectedPlan: "IndexedTableAccess(mytable)\n" +
+			" ├─ index: [mytable.s]\n" +
+			" ├─ static: [{[first row, first row]}]\n" +
+			" ├─ columns: [i s]\n" +
+			" └─ index condition: IN\n" +
+			"     ├─ left: mytable.s:1!null\n" +
+			"     └─ right: TUPLE(first row (longtext))\n" +
 			"",
 	},
 	{
 		Query: `SELECT * from mytable WHERE s IN (lower('SECOND ROW'), 'FIRST ROW')`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ HashIn\n" +
-			" │   ├─ mytable.s:1!null\n" +
-			" │   └─ TUPLE(second row (longtext), FIRST ROW (longtext))\n" +
-			" └─ IndexedTableAccess(mytable)\n" +
-			"     ├─ index: [mytable.s]\n" +
-			"     ├─ static: [{[FIRST ROW, FIRST ROW]}, {[second row, second row]}]\n" +
-			"     └─ columns: [i s]\n" +
+		ExpectedPlan: "IndexedTableAccess(mytable)\n" +
+			" ├─ index: [mytable.s]\n" +
+			" ├─ static: [{[FIRST ROW, FIRST ROW]}, {[second row, second row]}]\n" +
+			" ├─ columns: [i s]\n" +
+			" └─ index condition: IN\n" +
+			"     ├─ left: mytable.s:1!null\n" +
+			"     └─ right: TUPLE(second row (longtext), FIRST ROW (longtext))\n" +
 			"",
 	},
 	{
@@ -3113,143 +3178,102 @@ inner join pq on true
 		ExpectedPlan: "Sort(lefttable.i:0!null ASC nullsFirst)\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [lefttable.i:2!null, righttable.s:1!null]\n" +
-			"     └─ HashJoin\n" +
-			"         ├─ AND\n" +
-			"         │   ├─ Eq\n" +
-			"         │   │   ├─ lefttable.i:2!null\n" +
-			"         │   │   └─ righttable.i:0!null\n" +
-			"         │   └─ Eq\n" +
-			"         │       ├─ righttable.s:1!null\n" +
-			"         │       └─ lefttable.s:3!null\n" +
-			"         ├─ SubqueryAlias\n" +
-			"         │   ├─ name: righttable\n" +
-			"         │   ├─ outerVisibility: false\n" +
-			"         │   ├─ cacheable: true\n" +
-			"         │   └─ Table\n" +
-			"         │       ├─ name: mytable\n" +
+			"     └─ MergeJoin\n" +
+			"         ├─ cmp: Eq\n" +
+			"         │   ├─ righttable.i:0!null\n" +
+			"         │   └─ lefttable.i:2!null\n" +
+			"         ├─ sel: Eq\n" +
+			"         │   ├─ righttable.s:1!null\n" +
+			"         │   └─ lefttable.s:3!null\n" +
+			"         ├─ TableAlias(righttable)\n" +
+			"         │   └─ IndexedTableAccess(mytable)\n" +
+			"         │       ├─ index: [mytable.i]\n" +
+			"         │       ├─ static: [{[NULL, ∞)}]\n" +
 			"         │       └─ columns: [i s]\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ source: TUPLE(righttable.i:0!null, righttable.s:1!null)\n" +
-			"             ├─ target: TUPLE(lefttable.i:0!null, lefttable.s:1!null)\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias\n" +
-			"                     ├─ name: lefttable\n" +
-			"                     ├─ outerVisibility: false\n" +
-			"                     ├─ cacheable: true\n" +
-			"                     └─ Table\n" +
-			"                         ├─ name: mytable\n" +
-			"                         └─ columns: [i s]\n" +
+			"         └─ TableAlias(lefttable)\n" +
+			"             └─ IndexedTableAccess(mytable)\n" +
+			"                 ├─ index: [mytable.i]\n" +
+			"                 ├─ static: [{[NULL, ∞)}]\n" +
+			"                 └─ columns: [i s]\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM mytable RIGHT JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
-		ExpectedPlan: "LeftOuterHashJoin\n" +
-			" ├─ Eq\n" +
+		ExpectedPlan: "LeftOuterMergeJoin\n" +
+			" ├─ cmp: Eq\n" +
 			" │   ├─ othertable.i2:1!null\n" +
 			" │   └─ mytable.i:2!null\n" +
-			" ├─ SubqueryAlias\n" +
-			" │   ├─ name: othertable\n" +
-			" │   ├─ outerVisibility: false\n" +
-			" │   ├─ cacheable: true\n" +
-			" │   └─ Table\n" +
-			" │       ├─ name: othertable\n" +
+			" ├─ TableAlias(othertable)\n" +
+			" │   └─ IndexedTableAccess(othertable)\n" +
+			" │       ├─ index: [othertable.i2]\n" +
+			" │       ├─ static: [{[NULL, ∞)}]\n" +
 			" │       └─ columns: [s2 i2]\n" +
-			" └─ HashLookup\n" +
-			"     ├─ source: TUPLE(othertable.i2:1!null)\n" +
-			"     ├─ target: TUPLE(mytable.i:0!null)\n" +
-			"     └─ CachedResults\n" +
-			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
-			"             └─ columns: [i]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     └─ columns: [i]\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM mytable INNER JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
-		ExpectedPlan: "HashJoin\n" +
-			" ├─ Eq\n" +
+		ExpectedPlan: "MergeJoin\n" +
+			" ├─ cmp: Eq\n" +
 			" │   ├─ othertable.i2:1!null\n" +
 			" │   └─ mytable.i:2!null\n" +
-			" ├─ SubqueryAlias\n" +
-			" │   ├─ name: othertable\n" +
-			" │   ├─ outerVisibility: false\n" +
-			" │   ├─ cacheable: true\n" +
-			" │   └─ Table\n" +
-			" │       ├─ name: othertable\n" +
+			" ├─ TableAlias(othertable)\n" +
+			" │   └─ IndexedTableAccess(othertable)\n" +
+			" │       ├─ index: [othertable.i2]\n" +
+			" │       ├─ static: [{[NULL, ∞)}]\n" +
 			" │       └─ columns: [s2 i2]\n" +
-			" └─ HashLookup\n" +
-			"     ├─ source: TUPLE(othertable.i2:1!null)\n" +
-			"     ├─ target: TUPLE(mytable.i:0!null)\n" +
-			"     └─ CachedResults\n" +
-			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
-			"             └─ columns: [i]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     └─ columns: [i]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM (SELECT * FROM othertable) othertable_alias WHERE s2 = 'a'`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: othertable_alias\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ cacheable: true\n" +
-			" └─ Filter\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ othertable.s2:0!null\n" +
-			"     │   └─ a (longtext)\n" +
-			"     └─ IndexedTableAccess(othertable)\n" +
-			"         ├─ index: [othertable.s2]\n" +
-			"         ├─ static: [{[a, a]}]\n" +
-			"         └─ columns: [s2 i2]\n" +
+		ExpectedPlan: "TableAlias(othertable_alias)\n" +
+			" └─ IndexedTableAccess(othertable)\n" +
+			"     ├─ index: [othertable.s2]\n" +
+			"     ├─ static: [{[a, a]}]\n" +
+			"     ├─ columns: [s2 i2]\n" +
+			"     └─ index condition: Eq\n" +
+			"         ├─ othertable.s2:0!null\n" +
+			"         └─ a (longtext)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM (SELECT * FROM (SELECT * FROM (SELECT * FROM othertable) othertable_one) othertable_two) othertable_three WHERE s2 = 'a'`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: othertable_three\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ cacheable: true\n" +
-			" └─ SubqueryAlias\n" +
-			"     ├─ name: othertable_two\n" +
-			"     ├─ outerVisibility: false\n" +
-			"     ├─ cacheable: true\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: othertable_one\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         └─ Filter\n" +
-			"             ├─ Eq\n" +
-			"             │   ├─ othertable.s2:0!null\n" +
-			"             │   └─ a (longtext)\n" +
-			"             └─ IndexedTableAccess(othertable)\n" +
-			"                 ├─ index: [othertable.s2]\n" +
-			"                 ├─ static: [{[a, a]}]\n" +
-			"                 └─ columns: [s2 i2]\n" +
+		ExpectedPlan: "TableAlias(othertable_three)\n" +
+			" └─ IndexedTableAccess(othertable)\n" +
+			"     ├─ index: [othertable.s2]\n" +
+			"     ├─ static: [{[a, a]}]\n" +
+			"     ├─ columns: [s2 i2]\n" +
+			"     └─ index condition: Eq\n" +
+			"         ├─ othertable.s2:0!null\n" +
+			"         └─ a (longtext)\n" +
 			"",
 	},
 	{
 		Query: `SELECT othertable.s2, othertable.i2, mytable.i FROM mytable INNER JOIN (SELECT * FROM othertable) othertable ON othertable.i2 = mytable.i WHERE othertable.s2 > 'a'`,
-		ExpectedPlan: "HashJoin\n" +
-			" ├─ Eq\n" +
+		ExpectedPlan: "MergeJoin\n" +
+			" ├─ cmp: Eq\n" +
 			" │   ├─ othertable.i2:1!null\n" +
 			" │   └─ mytable.i:2!null\n" +
-			" ├─ SubqueryAlias\n" +
-			" │   ├─ name: othertable\n" +
-			" │   ├─ outerVisibility: false\n" +
-			" │   ├─ cacheable: true\n" +
-			" │   └─ Filter\n" +
-			" │       ├─ GreaterThan\n" +
-			" │       │   ├─ othertable.s2:0!null\n" +
-			" │       │   └─ a (longtext)\n" +
+			" ├─ Filter\n" +
+			" │   ├─ GreaterThan\n" +
+			" │   │   ├─ othertable.s2:0!null\n" +
+			" │   │   └─ a (longtext)\n" +
+			" │   └─ TableAlias(othertable)\n" +
 			" │       └─ IndexedTableAccess(othertable)\n" +
-			" │           ├─ index: [othertable.s2]\n" +
-			" │           ├─ static: [{(a, ∞)}]\n" +
+			" │           ├─ index: [othertable.i2]\n" +
+			" │           ├─ static: [{[NULL, ∞)}]\n" +
 			" │           └─ columns: [s2 i2]\n" +
-			" └─ HashLookup\n" +
-			"     ├─ source: TUPLE(othertable.i2:1!null)\n" +
-			"     ├─ target: TUPLE(mytable.i:0!null)\n" +
-			"     └─ CachedResults\n" +
-			"         └─ Table\n" +
-			"             ├─ name: mytable\n" +
-			"             └─ columns: [i]\n" +
+			" └─ IndexedTableAccess(mytable)\n" +
+			"     ├─ index: [mytable.i]\n" +
+			"     ├─ static: [{[NULL, ∞)}]\n" +
+			"     └─ columns: [i]\n" +
 			"",
 	},
 	{
@@ -3278,11 +3302,10 @@ inner join pq on true
 			" ├─ Eq\n" +
 			" │   ├─ mytable.i:1!null\n" +
 			" │   └─ applySubq0.i2:0!null\n" +
-			" ├─ Distinct\n" +
-			" │   └─ TableAlias(applySubq0)\n" +
-			" │       └─ Table\n" +
-			" │           ├─ name: othertable\n" +
-			" │           └─ columns: [i2]\n" +
+			" ├─ TableAlias(applySubq0)\n" +
+			" │   └─ Table\n" +
+			" │       ├─ name: othertable\n" +
+			" │       └─ columns: [i2]\n" +
 			" └─ IndexedTableAccess(mytable)\n" +
 			"     ├─ index: [mytable.i]\n" +
 			"     └─ columns: [i s]\n" +
@@ -3569,125 +3592,125 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT * FROM (SELECT * FROM othertable) othertable_alias WHERE othertable_alias.i2 = 1`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: othertable_alias\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ cacheable: true\n" +
-			" └─ IndexedTableAccess(othertable)\n" +
-			"     ├─ index: [othertable.i2]\n" +
-			"     ├─ static: [{[1, 1]}]\n" +
-			"     └─ columns: [s2 i2]\n" +
+		ExpectedPlan: "Filter\n" +
+			" ├─ Eq\n" +
+			" │   ├─ othertable_alias.i2:1!null\n" +
+			" │   └─ 1 (tinyint)\n" +
+			" └─ TableAlias(othertable_alias)\n" +
+			"     └─ IndexedTableAccess(othertable)\n" +
+			"         ├─ index: [othertable.i2]\n" +
+			"         ├─ static: [{[1, 1]}]\n" +
+			"         └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM (SELECT * FROM othertable WHERE i2 = 1) othertable_alias WHERE othertable_alias.i2 = 1`,
-		ExpectedPlan: "SubqueryAlias\n" +
-			" ├─ name: othertable_alias\n" +
-			" ├─ outerVisibility: false\n" +
-			" ├─ cacheable: true\n" +
-			" └─ IndexedTableAccess(othertable)\n" +
-			"     ├─ index: [othertable.i2]\n" +
-			"     ├─ static: [{[1, 1]}]\n" +
-			"     └─ columns: [s2 i2]\n" +
+		ExpectedPlan: "Filter\n" +
+			" ├─ AND\n" +
+			" │   ├─ Eq\n" +
+			" │   │   ├─ othertable_alias.i2:1!null\n" +
+			" │   │   └─ 1 (tinyint)\n" +
+			" │   └─ Eq\n" +
+			" │       ├─ othertable_alias.i2:1!null\n" +
+			" │       └─ 1 (tinyint)\n" +
+			" └─ TableAlias(othertable_alias)\n" +
+			"     └─ IndexedTableAccess(othertable)\n" +
+			"         ├─ index: [othertable.i2]\n" +
+			"         ├─ static: [{[1, 1]}]\n" +
+			"         └─ columns: [s2 i2]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table ORDER BY date_col ASC`,
-		ExpectedPlan: "Sort(datetime_table.date_col:1 ASC nullsFirst)\n" +
-			" └─ Table\n" +
-			"     ├─ name: datetime_table\n" +
-			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+		ExpectedPlan: "IndexedTableAccess(datetime_table)\n" +
+			" ├─ index: [datetime_table.date_col]\n" +
+			" ├─ static: [{[NULL, ∞)}]\n" +
+			" └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table ORDER BY date_col ASC LIMIT 100`,
-		ExpectedPlan: "Limit(100)\n" +
-			" └─ TopN(Limit: [100 (tinyint)]; datetime_table.date_col:1 ASC nullsFirst)\n" +
-			"     └─ Table\n" +
-			"         ├─ name: datetime_table\n" +
-			"         └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+		ExpectedPlan: "IndexedTableAccess(datetime_table)\n" +
+			" ├─ index: [datetime_table.date_col]\n" +
+			" ├─ static: [{[NULL, ∞)}]\n" +
+			" ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			" └─ limit: 100\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table ORDER BY date_col ASC LIMIT 100 OFFSET 100`,
-		ExpectedPlan: "Limit(100)\n" +
-			" └─ Offset(100)\n" +
-			"     └─ TopN(Limit: [(100 + 100)]; datetime_table.date_col ASC)\n" +
-			"         └─ Table\n" +
-			"             ├─ name: datetime_table\n" +
-			"             └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+		ExpectedPlan: "IndexedTableAccess(datetime_table)\n" +
+			" ├─ index: [datetime_table.date_col]\n" +
+			" ├─ static: [{[NULL, ∞)}]\n" +
+			" ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			" ├─ limit: 100\n" +
+			" └─ offset: 100\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table where date_col = '2020-01-01'`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Eq\n" +
-			" │   ├─ datetime_table.date_col:1\n" +
-			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.date_col]\n" +
-			"     ├─ static: [{[2020-01-01, 2020-01-01]}]\n" +
-			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+		ExpectedPlan: "IndexedTableAccess(datetime_table)\n" +
+			" ├─ index: [datetime_table.date_col]\n" +
+			" ├─ static: [{[2020-01-01, 2020-01-01]}]\n" +
+			" ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			" └─ index condition: Eq\n" +
+			"     ├─ datetime_table.date_col:1\n" +
+			"     └─ 2020-01-01 (longtext)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table where date_col > '2020-01-01'`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ GreaterThan\n" +
-			" │   ├─ datetime_table.date_col:1\n" +
-			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.date_col]\n" +
-			"     ├─ static: [{(2020-01-01, ∞)}]\n" +
-			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+		ExpectedPlan: "IndexedTableAccess(datetime_table)\n" +
+			" ├─ index: [datetime_table.date_col]\n" +
+			" ├─ static: [{(2020-01-01, ∞)}]\n" +
+			" ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			" └─ index condition: GreaterThan\n" +
+			"     ├─ datetime_table.date_col:1\n" +
+			"     └─ 2020-01-01 (longtext)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table where datetime_col = '2020-01-01'`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Eq\n" +
-			" │   ├─ datetime_table.datetime_col:2\n" +
-			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.datetime_col]\n" +
-			"     ├─ static: [{[2020-01-01, 2020-01-01]}]\n" +
-			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+		ExpectedPlan: "IndexedTableAccess(datetime_table)\n" +
+			" ├─ index: [datetime_table.datetime_col]\n" +
+			" ├─ static: [{[2020-01-01, 2020-01-01]}]\n" +
+			" ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			" └─ index condition: Eq\n" +
+			"     ├─ datetime_table.datetime_col:2\n" +
+			"     └─ 2020-01-01 (longtext)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table where datetime_col > '2020-01-01'`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ GreaterThan\n" +
-			" │   ├─ datetime_table.datetime_col:2\n" +
-			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.datetime_col]\n" +
-			"     ├─ static: [{(2020-01-01, ∞)}]\n" +
-			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+		ExpectedPlan: "IndexedTableAccess(datetime_table)\n" +
+			" ├─ index: [datetime_table.datetime_col]\n" +
+			" ├─ static: [{(2020-01-01, ∞)}]\n" +
+			" ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			" └─ index condition: GreaterThan\n" +
+			"     ├─ datetime_table.datetime_col:2\n" +
+			"     └─ 2020-01-01 (longtext)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table where timestamp_col = '2020-01-01'`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Eq\n" +
-			" │   ├─ datetime_table.timestamp_col:3\n" +
-			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.timestamp_col]\n" +
-			"     ├─ static: [{[2020-01-01, 2020-01-01]}]\n" +
-			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+		ExpectedPlan: "IndexedTableAccess(datetime_table)\n" +
+			" ├─ index: [datetime_table.timestamp_col]\n" +
+			" ├─ static: [{[2020-01-01, 2020-01-01]}]\n" +
+			" ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			" └─ index condition: Eq\n" +
+			"     ├─ datetime_table.timestamp_col:3\n" +
+			"     └─ 2020-01-01 (longtext)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table where timestamp_col > '2020-01-01'`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ GreaterThan\n" +
-			" │   ├─ datetime_table.timestamp_col:3\n" +
-			" │   └─ 2020-01-01 (longtext)\n" +
-			" └─ IndexedTableAccess(datetime_table)\n" +
-			"     ├─ index: [datetime_table.timestamp_col]\n" +
-			"     ├─ static: [{(2020-01-01, ∞)}]\n" +
-			"     └─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+		ExpectedPlan: "IndexedTableAccess(datetime_table)\n" +
+			" ├─ index: [datetime_table.timestamp_col]\n" +
+			" ├─ static: [{(2020-01-01, ∞)}]\n" +
+			" ├─ columns: [i date_col datetime_col timestamp_col time_col]\n" +
+			" └─ index condition: GreaterThan\n" +
+			"     ├─ datetime_table.timestamp_col:3\n" +
+			"     └─ 2020-01-01 (longtext)\n" +
 			"",
 	},
 	{
@@ -4252,10 +4275,9 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i WHERE f IS NOT NULL`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ NOT\n" +
-			" │   └─ niltable.f:2 IS NULL\n" +
-			" └─ LeftOuterMergeJoin\n" +
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk.pk:0!null, niltable.i:1, niltable.f:2]\n" +
+			" └─ MergeJoin\n" +
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ one_pk.pk:0!null\n" +
 			"     │   └─ niltable.i:1!null\n" +
@@ -4263,41 +4285,38 @@ inner join pq on true
 			"     │   ├─ index: [one_pk.pk]\n" +
 			"     │   ├─ static: [{[NULL, ∞)}]\n" +
 			"     │   └─ columns: [pk]\n" +
-			"     └─ IndexedTableAccess(niltable)\n" +
-			"         ├─ index: [niltable.i]\n" +
-			"         ├─ static: [{[NULL, ∞)}]\n" +
-			"         └─ columns: [i f]\n" +
+			"     └─ Filter\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ niltable.f:1 IS NULL\n" +
+			"         └─ IndexedTableAccess(niltable)\n" +
+			"             ├─ index: [niltable.i]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [i f]\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i WHERE i2 > 1`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [one_pk.pk:0!null, niltable.i:1, niltable.f:3]\n" +
-			" └─ Filter\n" +
-			"     ├─ GreaterThan\n" +
-			"     │   ├─ niltable.i2:2\n" +
-			"     │   └─ 1 (tinyint)\n" +
-			"     └─ LeftOuterMergeJoin\n" +
-			"         ├─ cmp: Eq\n" +
-			"         │   ├─ one_pk.pk:0!null\n" +
-			"         │   └─ niltable.i:1!null\n" +
-			"         ├─ IndexedTableAccess(one_pk)\n" +
-			"         │   ├─ index: [one_pk.pk]\n" +
-			"         │   ├─ static: [{[NULL, ∞)}]\n" +
-			"         │   └─ columns: [pk]\n" +
-			"         └─ IndexedTableAccess(niltable)\n" +
-			"             ├─ index: [niltable.i]\n" +
-			"             ├─ static: [{[NULL, ∞)}]\n" +
-			"             └─ columns: [i i2 f]\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
+			"     │   ├─ one_pk.pk:0!null\n" +
+			"     │   └─ niltable.i:1!null\n" +
+			"     ├─ IndexedTableAccess(one_pk)\n" +
+			"     │   ├─ index: [one_pk.pk]\n" +
+			"     │   ├─ static: [{[NULL, ∞)}]\n" +
+			"     │   └─ columns: [pk]\n" +
+			"     └─ IndexedTableAccess(niltable)\n" +
+			"         ├─ index: [niltable.i2]\n" +
+			"         ├─ static: [{(1, ∞)}]\n" +
+			"         └─ columns: [i i2 f]\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i WHERE i > 1`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ GreaterThan\n" +
-			" │   ├─ niltable.i:1\n" +
-			" │   └─ 1 (tinyint)\n" +
-			" └─ LeftOuterMergeJoin\n" +
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [one_pk.pk:0!null, niltable.i:1, niltable.f:2]\n" +
+			" └─ MergeJoin\n" +
 			"     ├─ cmp: Eq\n" +
 			"     │   ├─ one_pk.pk:0!null\n" +
 			"     │   └─ niltable.i:1!null\n" +
@@ -4307,7 +4326,7 @@ inner join pq on true
 			"     │   └─ columns: [pk]\n" +
 			"     └─ IndexedTableAccess(niltable)\n" +
 			"         ├─ index: [niltable.i]\n" +
-			"         ├─ static: [{[NULL, ∞)}]\n" +
+			"         ├─ static: [{(1, ∞)}]\n" +
 			"         └─ columns: [i f]\n" +
 			"",
 	},
@@ -4390,23 +4409,19 @@ inner join pq on true
 	{
 		Query: `SELECT pk,i,f FROM one_pk RIGHT JOIN niltable ON pk=i WHERE pk > 0`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [one_pk.pk:2, niltable.i:0!null, niltable.f:1]\n" +
-			" └─ Filter\n" +
-			"     ├─ GreaterThan\n" +
-			"     │   ├─ one_pk.pk:2\n" +
-			"     │   └─ 0 (tinyint)\n" +
-			"     └─ LeftOuterMergeJoin\n" +
-			"         ├─ cmp: Eq\n" +
-			"         │   ├─ niltable.i:0!null\n" +
-			"         │   └─ one_pk.pk:2!null\n" +
-			"         ├─ IndexedTableAccess(niltable)\n" +
-			"         │   ├─ index: [niltable.i]\n" +
-			"         │   ├─ static: [{[NULL, ∞)}]\n" +
-			"         │   └─ columns: [i f]\n" +
-			"         └─ IndexedTableAccess(one_pk)\n" +
-			"             ├─ index: [one_pk.pk]\n" +
-			"             ├─ static: [{[NULL, ∞)}]\n" +
-			"             └─ columns: [pk]\n" +
+			" ├─ columns: [one_pk.pk:0, niltable.i:1!null, niltable.f:2]\n" +
+			" └─ MergeJoin\n" +
+			"     ├─ cmp: Eq\n" +
+			"     │   ├─ one_pk.pk:0!null\n" +
+			"     │   └─ niltable.i:1!null\n" +
+			"     ├─ IndexedTableAccess(one_pk)\n" +
+			"     │   ├─ index: [one_pk.pk]\n" +
+			"     │   ├─ static: [{(0, ∞)}]\n" +
+			"     │   └─ columns: [pk]\n" +
+			"     └─ IndexedTableAccess(niltable)\n" +
+			"         ├─ index: [niltable.i]\n" +
+			"         ├─ static: [{[NULL, ∞)}]\n" +
+			"         └─ columns: [i f]\n" +
 			"",
 	},
 	{
@@ -4690,49 +4705,45 @@ inner join pq on true
 	},
 	{
 		Query: `SELECT * FROM niltable WHERE i2 = NULL`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ Eq\n" +
-			" │   ├─ niltable.i2:1\n" +
-			" │   └─ NULL (null)\n" +
-			" └─ IndexedTableAccess(niltable)\n" +
-			"     ├─ index: [niltable.i2]\n" +
-			"     ├─ static: [{(∞, ∞)}]\n" +
-			"     └─ columns: [i i2 b f]\n" +
+		ExpectedPlan: "IndexedTableAccess(niltable)\n" +
+			" ├─ index: [niltable.i2]\n" +
+			" ├─ static: [{(∞, ∞)}]\n" +
+			" ├─ columns: [i i2 b f]\n" +
+			" └─ index condition: Eq\n" +
+			"     ├─ niltable.i2:1\n" +
+			"     └─ NULL (null)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM niltable WHERE i2 <> NULL`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ NOT\n" +
-			" │   └─ Eq\n" +
-			" │       ├─ niltable.i2:1\n" +
-			" │       └─ NULL (null)\n" +
-			" └─ IndexedTableAccess(niltable)\n" +
-			"     ├─ index: [niltable.i2]\n" +
-			"     ├─ static: [{(∞, ∞)}]\n" +
-			"     └─ columns: [i i2 b f]\n" +
+		ExpectedPlan: "IndexedTableAccess(niltable)\n" +
+			" ├─ index: [niltable.i2]\n" +
+			" ├─ static: [{(∞, ∞)}]\n" +
+			" ├─ columns: [i i2 b f]\n" +
+			" └─ index condition: NOT\n" +
+			"     └─ Eq\n" +
+			"         ├─ niltable.i2:1\n" +
+			"         └─ NULL (null)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM niltable WHERE i2 > NULL`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ GreaterThan\n" +
-			" │   ├─ niltable.i2:1\n" +
-			" │   └─ NULL (null)\n" +
-			" └─ IndexedTableAccess(niltable)\n" +
-			"     ├─ index: [niltable.i2]\n" +
-			"     ├─ static: [{(∞, ∞)}]\n" +
-			"     └─ columns: [i i2 b f]\n" +
+		ExpectedPlan: "IndexedTableAccess(niltable)\n" +
+			" ├─ index: [niltable.i2]\n" +
+			" ├─ static: [{(∞, ∞)}]\n" +
+			" ├─ columns: [i i2 b f]\n" +
+			" └─ index condition: GreaterThan\n" +
+			"     ├─ niltable.i2:1\n" +
+			"     └─ NULL (null)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM niltable WHERE i2 <=> NULL`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ (niltable.i2:1 <=> NULL (null))\n" +
-			" └─ IndexedTableAccess(niltable)\n" +
-			"     ├─ index: [niltable.i2]\n" +
-			"     ├─ static: [{[NULL, NULL]}]\n" +
-			"     └─ columns: [i i2 b f]\n" +
+		ExpectedPlan: "IndexedTableAccess(niltable)\n" +
+			" ├─ index: [niltable.i2]\n" +
+			" ├─ static: [{[NULL, NULL]}]\n" +
+			" ├─ columns: [i i2 b f]\n" +
+			" └─ index condition: (niltable.i2:1 <=> NULL (null))\n" +
 			"",
 	},
 	{
@@ -4755,10 +4766,9 @@ inner join pq on true
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i WHERE f IS NOT NULL ORDER BY 1`,
 		ExpectedPlan: "Sort(one_pk.pk:0!null ASC nullsFirst)\n" +
-			" └─ Filter\n" +
-			"     ├─ NOT\n" +
-			"     │   └─ niltable.f:2 IS NULL\n" +
-			"     └─ LeftOuterMergeJoin\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [one_pk.pk:0!null, niltable.i:1, niltable.f:2]\n" +
+			"     └─ MergeJoin\n" +
 			"         ├─ cmp: Eq\n" +
 			"         │   ├─ one_pk.pk:0!null\n" +
 			"         │   └─ niltable.i:1!null\n" +
@@ -4766,10 +4776,13 @@ inner join pq on true
 			"         │   ├─ index: [one_pk.pk]\n" +
 			"         │   ├─ static: [{[NULL, ∞)}]\n" +
 			"         │   └─ columns: [pk]\n" +
-			"         └─ IndexedTableAccess(niltable)\n" +
-			"             ├─ index: [niltable.i]\n" +
-			"             ├─ static: [{[NULL, ∞)}]\n" +
-			"             └─ columns: [i f]\n" +
+			"         └─ Filter\n" +
+			"             ├─ NOT\n" +
+			"             │   └─ niltable.f:1 IS NULL\n" +
+			"             └─ IndexedTableAccess(niltable)\n" +
+			"                 ├─ index: [niltable.i]\n" +
+			"                 ├─ static: [{[NULL, ∞)}]\n" +
+			"                 └─ columns: [i f]\n" +
 			"",
 	},
 	{
@@ -4834,23 +4847,19 @@ inner join pq on true
 		Query: `SELECT pk,i,f FROM one_pk RIGHT JOIN niltable ON pk=i WHERE pk > 0 ORDER BY 2,3`,
 		ExpectedPlan: "Sort(niltable.i:1!null ASC nullsFirst, niltable.f:2 ASC nullsFirst)\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [one_pk.pk:2, niltable.i:0!null, niltable.f:1]\n" +
-			"     └─ Filter\n" +
-			"         ├─ GreaterThan\n" +
-			"         │   ├─ one_pk.pk:2\n" +
-			"         │   └─ 0 (tinyint)\n" +
-			"         └─ LeftOuterMergeJoin\n" +
-			"             ├─ cmp: Eq\n" +
-			"             │   ├─ niltable.i:0!null\n" +
-			"             │   └─ one_pk.pk:2!null\n" +
-			"             ├─ IndexedTableAccess(niltable)\n" +
-			"             │   ├─ index: [niltable.i]\n" +
-			"             │   ├─ static: [{[NULL, ∞)}]\n" +
-			"             │   └─ columns: [i f]\n" +
-			"             └─ IndexedTableAccess(one_pk)\n" +
-			"                 ├─ index: [one_pk.pk]\n" +
-			"                 ├─ static: [{[NULL, ∞)}]\n" +
-			"                 └─ columns: [pk]\n" +
+			"     ├─ columns: [one_pk.pk:0, niltable.i:1!null, niltable.f:2]\n" +
+			"     └─ MergeJoin\n" +
+			"         ├─ cmp: Eq\n" +
+			"         │   ├─ one_pk.pk:0!null\n" +
+			"         │   └─ niltable.i:1!null\n" +
+			"         ├─ IndexedTableAccess(one_pk)\n" +
+			"         │   ├─ index: [one_pk.pk]\n" +
+			"         │   ├─ static: [{(0, ∞)}]\n" +
+			"         │   └─ columns: [pk]\n" +
+			"         └─ IndexedTableAccess(niltable)\n" +
+			"             ├─ index: [niltable.i]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [i f]\n" +
 			"",
 	},
 	{
@@ -5234,11 +5243,11 @@ inner join pq on true
 			" └─ Project\n" +
 			"     ├─ columns: [t1.pk:0!null, t2.pk2:7!null, Subquery\n" +
 			"     │   ├─ cacheable: true\n" +
-			"     │   └─ Limit(1)\n" +
-			"     │       └─ IndexedTableAccess(one_pk)\n" +
-			"     │           ├─ index: [one_pk.pk]\n" +
-			"     │           ├─ static: [{[1, 1]}]\n" +
-			"     │           └─ columns: [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk)\n" +
+			"     │       ├─ index: [one_pk.pk]\n" +
+			"     │       ├─ static: [{[1, 1]}]\n" +
+			"     │       ├─ columns: [pk]\n" +
+			"     │       └─ limit: 1\n" +
 			"     │   as (SELECT pk from one_pk where pk = 1 limit 1)]\n" +
 			"     └─ CrossJoin\n" +
 			"         ├─ Filter\n" +
@@ -5269,15 +5278,14 @@ inner join pq on true
 			"         ├─ row_number() over ( order by othertable.s2 ASC)\n" +
 			"         ├─ othertable.i2:1!null\n" +
 			"         ├─ othertable.s2:0!null\n" +
-			"         └─ Filter\n" +
-			"             ├─ NOT\n" +
-			"             │   └─ Eq\n" +
-			"             │       ├─ othertable.s2:0!null\n" +
-			"             │       └─ second (longtext)\n" +
-			"             └─ IndexedTableAccess(othertable)\n" +
-			"                 ├─ index: [othertable.s2]\n" +
-			"                 ├─ static: [{(second, ∞)}, {(NULL, second)}]\n" +
-			"                 └─ columns: [s2 i2]\n" +
+			"         └─ IndexedTableAccess(othertable)\n" +
+			"             ├─ index: [othertable.s2]\n" +
+			"             ├─ static: [{(second, ∞)}, {(NULL, second)}]\n" +
+			"             ├─ columns: [s2 i2]\n" +
+			"             └─ index condition: NOT\n" +
+			"                 └─ Eq\n" +
+			"                     ├─ othertable.s2:0!null\n" +
+			"                     └─ second (longtext)\n" +
 			"",
 	},
 	{
@@ -5666,31 +5674,26 @@ inner join pq on true
 	{
 		Query: `SELECT a.* FROM one_pk a CROSS JOIN one_pk c INNER JOIN (select * from one_pk) b ON b.pk = c.pk`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.pk:6!null, a.c1:7, a.c2:8, a.c3:9, a.c4:10, a.c5:11]\n" +
-			" └─ HashJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ b.pk:0!null\n" +
-			"     │   └─ c.pk:12!null\n" +
-			"     ├─ SubqueryAlias\n" +
-			"     │   ├─ name: b\n" +
-			"     │   ├─ outerVisibility: false\n" +
-			"     │   ├─ cacheable: true\n" +
+			" ├─ columns: [a.pk:0!null, a.c1:1, a.c2:2, a.c3:3, a.c4:4, a.c5:5]\n" +
+			" └─ CrossJoin\n" +
+			"     ├─ TableAlias(a)\n" +
 			"     │   └─ Table\n" +
 			"     │       ├─ name: one_pk\n" +
 			"     │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
-			"     └─ HashLookup\n" +
-			"         ├─ source: TUPLE(b.pk:0!null)\n" +
-			"         ├─ target: TUPLE(c.pk:6!null)\n" +
-			"         └─ CachedResults\n" +
-			"             └─ CrossJoin\n" +
-			"                 ├─ TableAlias(a)\n" +
-			"                 │   └─ Table\n" +
-			"                 │       ├─ name: one_pk\n" +
-			"                 │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
-			"                 └─ TableAlias(c)\n" +
-			"                     └─ Table\n" +
-			"                         ├─ name: one_pk\n" +
-			"                         └─ columns: [pk]\n" +
+			"     └─ MergeJoin\n" +
+			"         ├─ cmp: Eq\n" +
+			"         │   ├─ b.pk:6!null\n" +
+			"         │   └─ c.pk:12!null\n" +
+			"         ├─ TableAlias(b)\n" +
+			"         │   └─ IndexedTableAccess(one_pk)\n" +
+			"         │       ├─ index: [one_pk.pk]\n" +
+			"         │       ├─ static: [{[NULL, ∞)}]\n" +
+			"         │       └─ columns: [pk c1 c2 c3 c4 c5]\n" +
+			"         └─ TableAlias(c)\n" +
+			"             └─ IndexedTableAccess(one_pk)\n" +
+			"                 ├─ index: [one_pk.pk]\n" +
+			"                 ├─ static: [{[NULL, ∞)}]\n" +
+			"                 └─ columns: [pk]\n" +
 			"",
 	},
 	{
@@ -5717,36 +5720,33 @@ inner join pq on true
 	{
 		Query: `select a.pk, c.v2 from one_pk_three_idx a cross join one_pk_three_idx b right join one_pk_three_idx c on b.pk = c.v1 where b.pk = 0 and c.v2 = 0;`,
 		ExpectedPlan: "Project\n" +
-			" ├─ columns: [a.pk:2, c.v2:1]\n" +
-			" └─ Filter\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ b.pk:3\n" +
-			"     │   └─ 0 (tinyint)\n" +
-			"     └─ LeftOuterHashJoin\n" +
-			"         ├─ Eq\n" +
-			"         │   ├─ b.pk:3!null\n" +
-			"         │   └─ c.v1:0\n" +
+			" ├─ columns: [a.pk:0, c.v2:3]\n" +
+			" └─ CrossJoin\n" +
+			"     ├─ TableAlias(a)\n" +
+			"     │   └─ Table\n" +
+			"     │       ├─ name: one_pk_three_idx\n" +
+			"     │       └─ columns: [pk]\n" +
+			"     └─ MergeJoin\n" +
+			"         ├─ cmp: Eq\n" +
+			"         │   ├─ b.pk:1!null\n" +
+			"         │   └─ c.v1:2\n" +
 			"         ├─ Filter\n" +
 			"         │   ├─ Eq\n" +
-			"         │   │   ├─ c.v2:1\n" +
+			"         │   │   ├─ b.pk:0\n" +
 			"         │   │   └─ 0 (tinyint)\n" +
-			"         │   └─ TableAlias(c)\n" +
-			"         │       └─ Table\n" +
-			"         │           ├─ name: one_pk_three_idx\n" +
-			"         │           └─ columns: [v1 v2]\n" +
-			"         └─ HashLookup\n" +
-			"             ├─ source: TUPLE(c.v1:0)\n" +
-			"             ├─ target: TUPLE(b.pk:1!null)\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ CrossJoin\n" +
-			"                     ├─ TableAlias(a)\n" +
-			"                     │   └─ Table\n" +
-			"                     │       ├─ name: one_pk_three_idx\n" +
-			"                     │       └─ columns: [pk]\n" +
-			"                     └─ TableAlias(b)\n" +
-			"                         └─ Table\n" +
-			"                             ├─ name: one_pk_three_idx\n" +
-			"                             └─ columns: [pk]\n" +
+			"         │   └─ TableAlias(b)\n" +
+			"         │       └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"         │           ├─ index: [one_pk_three_idx.pk]\n" +
+			"         │           ├─ static: [{[NULL, ∞)}]\n" +
+			"         │           └─ columns: [pk]\n" +
+			"         └─ TableAlias(c)\n" +
+			"             └─ IndexedTableAccess(one_pk_three_idx)\n" +
+			"                 ├─ index: [one_pk_three_idx.v1,one_pk_three_idx.v2,one_pk_three_idx.v3]\n" +
+			"                 ├─ static: [{[NULL, ∞), [NULL, ∞), [NULL, ∞)}]\n" +
+			"                 ├─ columns: [v1 v2]\n" +
+			"                 └─ index condition: Eq\n" +
+			"                     ├─ one_pk_three_idx.v2:2\n" +
+			"                     └─ 0 (tinyint)\n" +
 			"",
 	},
 	{
@@ -7190,52 +7190,127 @@ With c as (
 			"     ├─ cacheable: true\n" +
 			"     └─ Project\n" +
 			"         ├─ columns: [a.s:5!null]\n" +
-			"         └─ HashJoin\n" +
+			"         └─ LookupJoin\n" +
 			"             ├─ Eq\n" +
-			"             │   ├─ b.i:2!null\n" +
-			"             │   └─ e.i:0!null\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: e\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ cacheable: true\n" +
+			"             │   ├─ a.i:4!null\n" +
+			"             │   └─ b.i:2!null\n" +
+			"             ├─ MergeJoin\n" +
+			"             │   ├─ cmp: Eq\n" +
+			"             │   │   ├─ e.i:0!null\n" +
+			"             │   │   └─ b.i:2!null\n" +
+			"             │   ├─ Filter\n" +
+			"             │   │   ├─ HashIn\n" +
+			"             │   │   │   ├─ e.i:0!null\n" +
+			"             │   │   │   └─ TUPLE(2 (tinyint), 3 (tinyint))\n" +
+			"             │   │   └─ TableAlias(e)\n" +
+			"             │   │       └─ IndexedTableAccess(mytable)\n" +
+			"             │   │           ├─ index: [mytable.i]\n" +
+			"             │   │           ├─ static: [{[NULL, ∞)}]\n" +
+			"             │   │           └─ columns: [i s]\n" +
 			"             │   └─ Filter\n" +
 			"             │       ├─ HashIn\n" +
-			"             │       │   ├─ t1.i:0!null\n" +
-			"             │       │   └─ TUPLE(2 (tinyint), 3 (tinyint))\n" +
-			"             │       └─ TableAlias(t1)\n" +
+			"             │       │   ├─ b.i:0!null\n" +
+			"             │       │   └─ TUPLE(1 (tinyint), 2 (tinyint))\n" +
+			"             │       └─ TableAlias(b)\n" +
 			"             │           └─ IndexedTableAccess(mytable)\n" +
 			"             │               ├─ index: [mytable.i]\n" +
-			"             │               ├─ static: [{[2, 2]}, {[3, 3]}]\n" +
+			"             │               ├─ static: [{[NULL, ∞)}]\n" +
 			"             │               └─ columns: [i s]\n" +
-			"             └─ HashLookup\n" +
-			"                 ├─ source: TUPLE(e.i:0!null)\n" +
-			"                 ├─ target: TUPLE(b.i:0!null)\n" +
-			"                 └─ CachedResults\n" +
-			"                     └─ HashJoin\n" +
-			"                         ├─ Eq\n" +
-			"                         │   ├─ a.i:4!null\n" +
-			"                         │   └─ b.i:2!null\n" +
-			"                         ├─ SubqueryAlias\n" +
-			"                         │   ├─ name: b\n" +
-			"                         │   ├─ outerVisibility: false\n" +
-			"                         │   ├─ cacheable: true\n" +
-			"                         │   └─ Filter\n" +
-			"                         │       ├─ HashIn\n" +
-			"                         │       │   ├─ t2.i:0!null\n" +
-			"                         │       │   └─ TUPLE(1 (tinyint), 2 (tinyint))\n" +
-			"                         │       └─ TableAlias(t2)\n" +
-			"                         │           └─ IndexedTableAccess(mytable)\n" +
-			"                         │               ├─ index: [mytable.i]\n" +
-			"                         │               ├─ static: [{[1, 1]}, {[2, 2]}]\n" +
-			"                         │               └─ columns: [i s]\n" +
-			"                         └─ HashLookup\n" +
-			"                             ├─ source: TUPLE(b.i:2!null)\n" +
-			"                             ├─ target: TUPLE(a.i:0!null)\n" +
-			"                             └─ CachedResults\n" +
-			"                                 └─ TableAlias(a)\n" +
-			"                                     └─ Table\n" +
-			"                                         ├─ name: mytable\n" +
-			"                                         └─ columns: [i s]\n" +
+			"             └─ TableAlias(a)\n" +
+			"                 └─ IndexedTableAccess(mytable)\n" +
+			"                     ├─ index: [mytable.i]\n" +
+			"                     └─ columns: [i s]\n" +
+			"",
+	},
+	{
+		Query: `select count(*) from mytable`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [COUNT(1):0!null as count(*)]\n" +
+			" └─ TableCount\n" +
+			"     └─ Table\n" +
+			"         ├─ name: mytable\n" +
+			"         └─ columns: []\n" +
+			"",
+	},
+	{
+		Query: `select count(*) c from mytable t`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [COUNT(1):0!null as c]\n" +
+			" └─ TableCount\n" +
+			"     └─ TableAlias(t)\n" +
+			"         └─ Table\n" +
+			"             ├─ name: mytable\n" +
+			"             └─ columns: []\n" +
+			"",
+	},
+	{
+		Query: `select * from xy where not exists (select distinct u from uv where u = x order by v)`,
+		ExpectedPlan: "AntiHashJoin\n" +
+			" ├─ Eq\n" +
+			" │   ├─ uv.u:2!null\n" +
+			" │   └─ xy.x:0!null\n" +
+			" ├─ Table\n" +
+			" │   ├─ name: xy\n" +
+			" │   └─ columns: [x y]\n" +
+			" └─ HashLookup\n" +
+			"     ├─ source: TUPLE(xy.x:0!null)\n" +
+			"     ├─ target: TUPLE(uv.u:0!null)\n" +
+			"     └─ CachedResults\n" +
+			"         └─ Table\n" +
+			"             ├─ name: uv\n" +
+			"             └─ columns: [u v]\n" +
+			"",
+	},
+	{
+		Query: `select * from xy where exists (select u from uv union select p from pq order by 1)`,
+		ExpectedPlan: "SemiJoin\n" +
+			" ├─ true (tinyint)\n" +
+			" ├─ Table\n" +
+			" │   ├─ name: xy\n" +
+			" │   └─ columns: [x y]\n" +
+			" └─ Limit(1)\n" +
+			"     └─ Union distinct\n" +
+			"         ├─ Table\n" +
+			"         │   ├─ name: uv\n" +
+			"         │   └─ columns: [u]\n" +
+			"         └─ Table\n" +
+			"             ├─ name: pq\n" +
+			"             └─ columns: [p]\n" +
+			"",
+	},
+	{
+		Query: `select distinct y from xy`,
+		ExpectedPlan: "IndexDistinct\n" +
+			" ├─ index: [xy.y]\n" +
+			" └─ Table\n" +
+			"     ├─ name: xy\n" +
+			"     └─ columns: [x y]\n" +
+			"",
+	},
+	{
+		Query: `select count(distinct y) from xy`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [COUNTDISTINCT([xy.y]):0!null as count(distinct y)]\n" +
+			" └─ GroupBy\n" +
+			"     ├─ select: COUNTDISTINCT([xy.y])\n" +
+			"     ├─ group: \n" +
+			"     └─ IndexDistinct\n" +
+			"         ├─ index: [xy.y]\n" +
+			"         └─ Table\n" +
+			"             ├─ name: xy\n" +
+			"             └─ columns: [y]\n" +
+			"",
+	},
+	{
+		Query: `select distinct t.y as z from xy t`,
+		ExpectedPlan: "Project\n" +
+			" ├─ columns: [t.y:0 as z]\n" +
+			" └─ IndexDistinct\n" +
+			"     ├─ index: [xy.y]\n" +
+			"     └─ TableAlias(t)\n" +
+			"         └─ Table\n" +
+			"             ├─ name: xy\n" +
+			"             └─ columns: [x y]\n" +
 			"",
 	},
 }
@@ -7245,13 +7320,24 @@ var QueryPlanTODOs = []QueryPlanTest{
 	{
 		// TODO: this should use an index. Extra join condition should get moved out of the join clause into a filter
 		Query: `SELECT pk,i,f FROM one_pk RIGHT JOIN niltable ON pk=i and pk > 0 ORDER BY 2,3`,
-		ExpectedPlan: "Sort(niltable.i ASC, niltable.f ASC)\n" +
-			" └─ Project(one_pk.pk, niltable.i, niltable.f)\n" +
-			"     └─ RightJoin((one_pk.pk = niltable.i) AND (one_pk.pk > 0))\n" +
-			"         ├─ Projected table access on [pk]\n" +
-			"         │   └─ Table(one_pk)\n" +
-			"         └─ Projected table access on [i f]\n" +
-			"             └─ Table(niltable)\n" +
+		ExpectedPlan: "Sort(niltable.i:1!null ASC nullsFirst, niltable.f:2 ASC nullsFirst)\n" +
+			" └─ Project\n" +
+			"     ├─ columns: [one_pk.pk:2, niltable.i:0!null, niltable.f:1]\n" +
+			"     └─ LeftOuterMergeJoin\n" +
+			"         ├─ cmp: Eq\n" +
+			"         │   ├─ niltable.i:0!null\n" +
+			"         │   └─ one_pk.pk:2!null\n" +
+			"         ├─ sel: GreaterThan\n" +
+			"         │   ├─ one_pk.pk:2!null\n" +
+			"         │   └─ 0 (tinyint)\n" +
+			"         ├─ IndexedTableAccess(niltable)\n" +
+			"         │   ├─ index: [niltable.i]\n" +
+			"         │   ├─ static: [{[NULL, ∞)}]\n" +
+			"         │   └─ columns: [i f]\n" +
+			"         └─ IndexedTableAccess(one_pk)\n" +
+			"             ├─ index: [one_pk.pk]\n" +
+			"             ├─ static: [{[NULL, ∞)}]\n" +
+			"             └─ columns: [pk]\n" +
 			"",
 	},
 }
@@ -7272,17 +7358,21 @@ WHERE
 ;`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [YK2GW.id:0!null, YK2GW.FTQLQ:1!null]\n" +
-			" └─ AntiLookupJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ YK2GW.id:0!null\n" +
-			"     │   └─ applySubq0.IXUXU:30\n" +
+			" └─ AntiHashJoin\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ (YK2GW.id = applySubq0.IXUXU) IS FALSE\n" +
 			"     ├─ Table\n" +
 			"     │   ├─ name: YK2GW\n" +
 			"     │   └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
-			"     └─ TableAlias(applySubq0)\n" +
-			"         └─ IndexedTableAccess(THNTS)\n" +
-			"             ├─ index: [THNTS.IXUXU]\n" +
-			"             └─ columns: [ixuxu]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ source: TUPLE(YK2GW.id:0!null)\n" +
+			"         ├─ target: TUPLE(applySubq0.IXUXU:0)\n" +
+			"         ├─ null-aware: true\n" +
+			"         └─ CachedResults\n" +
+			"             └─ TableAlias(applySubq0)\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: THNTS\n" +
+			"                     └─ columns: [ixuxu]\n" +
 			"",
 	},
 	{
@@ -7347,38 +7437,35 @@ WHERE
 			"     │           └─ Project\n" +
 			"     │               ├─ columns: [ZH72S:0, COUNT(CCEFL.ZH72S):1!null as JTOA7, MIN(CCEFL.WGBRL):2!null as TTDPM, SUM(CCEFL.WGBRL):3!null as FBSRS]\n" +
 			"     │               └─ GroupBy\n" +
-			"     │                   ├─ select: ZH72S:0, COUNT(CCEFL.ZH72S:2), MIN(CCEFL.WGBRL:1), SUM(CCEFL.WGBRL:1)\n" +
-			"     │                   ├─ group: ZH72S:0\n" +
-			"     │                   └─ Project\n" +
-			"     │                       ├─ columns: [CCEFL.ZH72S:1 as ZH72S, CCEFL.WGBRL:2, CCEFL.ZH72S:1]\n" +
-			"     │                       └─ SubqueryAlias\n" +
-			"     │                           ├─ name: CCEFL\n" +
-			"     │                           ├─ outerVisibility: false\n" +
-			"     │                           ├─ cacheable: true\n" +
-			"     │                           └─ Project\n" +
-			"     │                               ├─ columns: [nd.id:0!null as id, nd.ZH72S:7 as ZH72S, Subquery\n" +
-			"     │                               │   ├─ cacheable: false\n" +
-			"     │                               │   └─ Project\n" +
-			"     │                               │       ├─ columns: [COUNT(1):17!null as COUNT(*)]\n" +
-			"     │                               │       └─ GroupBy\n" +
-			"     │                               │           ├─ select: COUNT(1 (bigint))\n" +
-			"     │                               │           ├─ group: \n" +
-			"     │                               │           └─ Filter\n" +
-			"     │                               │               ├─ Eq\n" +
-			"     │                               │               │   ├─ HDDVB.UJ6XY:17!null\n" +
-			"     │                               │               │   └─ nd.id:0!null\n" +
-			"     │                               │               └─ Table\n" +
-			"     │                               │                   ├─ name: HDDVB\n" +
-			"     │                               │                   └─ columns: [uj6xy]\n" +
-			"     │                               │   as WGBRL]\n" +
-			"     │                               └─ Filter\n" +
-			"     │                                   ├─ NOT\n" +
-			"     │                                   │   └─ nd.ZH72S:7 IS NULL\n" +
-			"     │                                   └─ TableAlias(nd)\n" +
-			"     │                                       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │                                           ├─ index: [E2I7U.ZH72S]\n" +
-			"     │                                           ├─ static: [{(NULL, ∞)}]\n" +
-			"     │                                           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │                   ├─ select: CCEFL.ZH72S:1 as ZH72S, COUNT(CCEFL.ZH72S:1), MIN(CCEFL.WGBRL:2), SUM(CCEFL.WGBRL:2)\n" +
+			"     │                   ├─ group: CCEFL.ZH72S:1\n" +
+			"     │                   └─ SubqueryAlias\n" +
+			"     │                       ├─ name: CCEFL\n" +
+			"     │                       ├─ outerVisibility: false\n" +
+			"     │                       ├─ cacheable: true\n" +
+			"     │                       └─ Project\n" +
+			"     │                           ├─ columns: [nd.id:0!null as id, nd.ZH72S:7 as ZH72S, Subquery\n" +
+			"     │                           │   ├─ cacheable: false\n" +
+			"     │                           │   └─ Project\n" +
+			"     │                           │       ├─ columns: [COUNT(1):17!null as COUNT(*)]\n" +
+			"     │                           │       └─ GroupBy\n" +
+			"     │                           │           ├─ select: COUNT(1 (bigint))\n" +
+			"     │                           │           ├─ group: \n" +
+			"     │                           │           └─ Filter\n" +
+			"     │                           │               ├─ Eq\n" +
+			"     │                           │               │   ├─ HDDVB.UJ6XY:17!null\n" +
+			"     │                           │               │   └─ nd.id:0!null\n" +
+			"     │                           │               └─ Table\n" +
+			"     │                           │                   ├─ name: HDDVB\n" +
+			"     │                           │                   └─ columns: [uj6xy]\n" +
+			"     │                           │   as WGBRL]\n" +
+			"     │                           └─ TableAlias(nd)\n" +
+			"     │                               └─ IndexedTableAccess(E2I7U)\n" +
+			"     │                                   ├─ index: [E2I7U.ZH72S]\n" +
+			"     │                                   ├─ static: [{(NULL, ∞)}]\n" +
+			"     │                                   ├─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │                                   └─ index condition: NOT\n" +
+			"     │                                       └─ E2I7U.ZH72S:7 IS NULL\n" +
 			"     └─ Filter\n" +
 			"         ├─ NOT\n" +
 			"         │   └─ PBMRX.ZH72S:2 IS NULL\n" +
@@ -7537,47 +7624,43 @@ WHERE
 			" │               ├─ cacheable: true\n" +
 			" │               └─ Project\n" +
 			" │                   ├─ columns: [TIZHK.id:19!null as FWATE]\n" +
-			" │                   └─ Filter\n" +
-			" │                       ├─ NOT\n" +
-			" │                       │   └─ InSubquery\n" +
-			" │                       │       ├─ left: NHMXW.id:9!null\n" +
-			" │                       │       └─ right: Subquery\n" +
-			" │                       │           ├─ cacheable: true\n" +
-			" │                       │           └─ Filter\n" +
-			" │                       │               ├─ NOT\n" +
-			" │                       │               │   └─ HDDVB.PRUV2:29 IS NULL\n" +
-			" │                       │               └─ IndexedTableAccess(HDDVB)\n" +
-			" │                       │                   ├─ index: [HDDVB.PRUV2]\n" +
-			" │                       │                   ├─ static: [{(NULL, ∞)}]\n" +
-			" │                       │                   └─ columns: [pruv2]\n" +
-			" │                       └─ LookupJoin\n" +
-			" │                           ├─ AND\n" +
-			" │                           │   ├─ AND\n" +
-			" │                           │   │   ├─ AND\n" +
-			" │                           │   │   │   ├─ Eq\n" +
-			" │                           │   │   │   │   ├─ TIZHK.TVNW2:20\n" +
-			" │                           │   │   │   │   └─ NHMXW.NOHHR:10!null\n" +
-			" │                           │   │   │   └─ Eq\n" +
-			" │                           │   │   │       ├─ TIZHK.ZHITY:21\n" +
-			" │                           │   │   │       └─ NHMXW.AVPYF:11!null\n" +
-			" │                           │   │   └─ Eq\n" +
-			" │                           │   │       ├─ TIZHK.SYPKF:22\n" +
-			" │                           │   │       └─ NHMXW.SYPKF:12!null\n" +
-			" │                           │   └─ Eq\n" +
-			" │                           │       ├─ TIZHK.IDUT2:23\n" +
-			" │                           │       └─ NHMXW.IDUT2:13!null\n" +
-			" │                           ├─ Filter\n" +
-			" │                           │   ├─ Eq\n" +
-			" │                           │   │   ├─ NHMXW.SWCQV:16!null\n" +
-			" │                           │   │   └─ 0 (tinyint)\n" +
-			" │                           │   └─ TableAlias(NHMXW)\n" +
-			" │                           │       └─ Table\n" +
-			" │                           │           ├─ name: WGSDC\n" +
-			" │                           │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			" │                           └─ TableAlias(TIZHK)\n" +
-			" │                               └─ IndexedTableAccess(WRZVO)\n" +
-			" │                                   ├─ index: [WRZVO.TVNW2]\n" +
-			" │                                   └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
+			" │                   └─ AntiJoin\n" +
+			" │                       ├─ AND\n" +
+			" │                       │   ├─ NOT\n" +
+			" │                       │   │   └─ HDDVB.PRUV2:29 IS NULL\n" +
+			" │                       │   └─ NOT\n" +
+			" │                       │       └─ (NHMXW.id = HDDVB.PRUV2) IS FALSE\n" +
+			" │                       ├─ LookupJoin\n" +
+			" │                       │   ├─ AND\n" +
+			" │                       │   │   ├─ AND\n" +
+			" │                       │   │   │   ├─ AND\n" +
+			" │                       │   │   │   │   ├─ Eq\n" +
+			" │                       │   │   │   │   │   ├─ TIZHK.TVNW2:20\n" +
+			" │                       │   │   │   │   │   └─ NHMXW.NOHHR:10!null\n" +
+			" │                       │   │   │   │   └─ Eq\n" +
+			" │                       │   │   │   │       ├─ TIZHK.ZHITY:21\n" +
+			" │                       │   │   │   │       └─ NHMXW.AVPYF:11!null\n" +
+			" │                       │   │   │   └─ Eq\n" +
+			" │                       │   │   │       ├─ TIZHK.SYPKF:22\n" +
+			" │                       │   │   │       └─ NHMXW.SYPKF:12!null\n" +
+			" │                       │   │   └─ Eq\n" +
+			" │                       │   │       ├─ TIZHK.IDUT2:23\n" +
+			" │                       │   │       └─ NHMXW.IDUT2:13!null\n" +
+			" │                       │   ├─ Filter\n" +
+			" │                       │   │   ├─ Eq\n" +
+			" │                       │   │   │   ├─ NHMXW.SWCQV:16!null\n" +
+			" │                       │   │   │   └─ 0 (tinyint)\n" +
+			" │                       │   │   └─ TableAlias(NHMXW)\n" +
+			" │                       │   │       └─ Table\n" +
+			" │                       │   │           ├─ name: WGSDC\n" +
+			" │                       │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			" │                       │   └─ TableAlias(TIZHK)\n" +
+			" │                       │       └─ IndexedTableAccess(WRZVO)\n" +
+			" │                       │           ├─ index: [WRZVO.TVNW2]\n" +
+			" │                       │           └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
+			" │                       └─ Table\n" +
+			" │                           ├─ name: HDDVB\n" +
+			" │                           └─ columns: [pruv2]\n" +
 			" └─ TableAlias(ism)\n" +
 			"     └─ Table\n" +
 			"         ├─ name: HDDVB\n" +
@@ -7614,10 +7697,9 @@ WHERE
 	   AND
 	       TIZHK.id NOT IN (SELECT ETPQV FROM HDDVB)
 	`,
-		ExpectedPlan: "AntiLookupJoin\n" +
-			" ├─ Eq\n" +
-			" │   ├─ TIZHK.id:0!null\n" +
-			" │   └─ applySubq1.ETPQV:10\n" +
+		ExpectedPlan: "AntiHashJoin\n" +
+			" ├─ NOT\n" +
+			" │   └─ (TIZHK.id = applySubq1.ETPQV) IS FALSE\n" +
 			" ├─ RightSemiLookupJoin\n" +
 			" │   ├─ Eq\n" +
 			" │   │   ├─ TIZHK.id:1!null\n" +
@@ -7674,10 +7756,15 @@ WHERE
 			" │       └─ IndexedTableAccess(WRZVO)\n" +
 			" │           ├─ index: [WRZVO.id]\n" +
 			" │           └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			" └─ TableAlias(applySubq1)\n" +
-			"     └─ IndexedTableAccess(HDDVB)\n" +
-			"         ├─ index: [HDDVB.ETPQV]\n" +
-			"         └─ columns: [etpqv]\n" +
+			" └─ HashLookup\n" +
+			"     ├─ source: TUPLE(TIZHK.id:0!null)\n" +
+			"     ├─ target: TUPLE(applySubq1.ETPQV:0)\n" +
+			"     ├─ null-aware: true\n" +
+			"     └─ CachedResults\n" +
+			"         └─ TableAlias(applySubq1)\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: HDDVB\n" +
+			"                 └─ columns: [etpqv]\n" +
 			"",
 	},
 	{
@@ -7710,10 +7797,9 @@ WHERE
 	   AND
 	       TIZHK.id NOT IN (SELECT ETPQV FROM HDDVB)
 	`,
-		ExpectedPlan: "AntiLookupJoin\n" +
-			" ├─ Eq\n" +
-			" │   ├─ TIZHK.id:0!null\n" +
-			" │   └─ applySubq1.ETPQV:10\n" +
+		ExpectedPlan: "AntiHashJoin\n" +
+			" ├─ NOT\n" +
+			" │   └─ (TIZHK.id = applySubq1.ETPQV) IS FALSE\n" +
 			" ├─ RightSemiLookupJoin\n" +
 			" │   ├─ Eq\n" +
 			" │   │   ├─ TIZHK.id:1!null\n" +
@@ -7770,10 +7856,15 @@ WHERE
 			" │       └─ IndexedTableAccess(WRZVO)\n" +
 			" │           ├─ index: [WRZVO.id]\n" +
 			" │           └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			" └─ TableAlias(applySubq1)\n" +
-			"     └─ IndexedTableAccess(HDDVB)\n" +
-			"         ├─ index: [HDDVB.ETPQV]\n" +
-			"         └─ columns: [etpqv]\n" +
+			" └─ HashLookup\n" +
+			"     ├─ source: TUPLE(TIZHK.id:0!null)\n" +
+			"     ├─ target: TUPLE(applySubq1.ETPQV:0)\n" +
+			"     ├─ null-aware: true\n" +
+			"     └─ CachedResults\n" +
+			"         └─ TableAlias(applySubq1)\n" +
+			"             └─ Table\n" +
+			"                 ├─ name: HDDVB\n" +
+			"                 └─ columns: [etpqv]\n" +
 			"",
 	},
 	{
@@ -7838,38 +7929,35 @@ WHERE
 			"     │           └─ Project\n" +
 			"     │               ├─ columns: [ZH72S:0, COUNT(WOOJ5.ZH72S):1!null as JTOA7, MIN(WOOJ5.LEA4J):2!null as BADTB, SUM(WOOJ5.LEA4J):3!null as FLHXH]\n" +
 			"     │               └─ GroupBy\n" +
-			"     │                   ├─ select: ZH72S:0, COUNT(WOOJ5.ZH72S:2), MIN(WOOJ5.LEA4J:1), SUM(WOOJ5.LEA4J:1)\n" +
-			"     │                   ├─ group: ZH72S:0\n" +
-			"     │                   └─ Project\n" +
-			"     │                       ├─ columns: [WOOJ5.ZH72S:1 as ZH72S, WOOJ5.LEA4J:2, WOOJ5.ZH72S:1]\n" +
-			"     │                       └─ SubqueryAlias\n" +
-			"     │                           ├─ name: WOOJ5\n" +
-			"     │                           ├─ outerVisibility: false\n" +
-			"     │                           ├─ cacheable: true\n" +
-			"     │                           └─ Project\n" +
-			"     │                               ├─ columns: [nd.id:0!null as id, nd.ZH72S:7 as ZH72S, Subquery\n" +
-			"     │                               │   ├─ cacheable: false\n" +
-			"     │                               │   └─ Project\n" +
-			"     │                               │       ├─ columns: [COUNT(1):17!null as COUNT(*)]\n" +
-			"     │                               │       └─ GroupBy\n" +
-			"     │                               │           ├─ select: COUNT(1 (bigint))\n" +
-			"     │                               │           ├─ group: \n" +
-			"     │                               │           └─ Filter\n" +
-			"     │                               │               ├─ Eq\n" +
-			"     │                               │               │   ├─ FLQLP.LUEVY:17!null\n" +
-			"     │                               │               │   └─ nd.id:0!null\n" +
-			"     │                               │               └─ Table\n" +
-			"     │                               │                   ├─ name: FLQLP\n" +
-			"     │                               │                   └─ columns: [luevy]\n" +
-			"     │                               │   as LEA4J]\n" +
-			"     │                               └─ Filter\n" +
-			"     │                                   ├─ NOT\n" +
-			"     │                                   │   └─ nd.ZH72S:7 IS NULL\n" +
-			"     │                                   └─ TableAlias(nd)\n" +
-			"     │                                       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │                                           ├─ index: [E2I7U.ZH72S]\n" +
-			"     │                                           ├─ static: [{(NULL, ∞)}]\n" +
-			"     │                                           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │                   ├─ select: WOOJ5.ZH72S:1 as ZH72S, COUNT(WOOJ5.ZH72S:1), MIN(WOOJ5.LEA4J:2), SUM(WOOJ5.LEA4J:2)\n" +
+			"     │                   ├─ group: WOOJ5.ZH72S:1\n" +
+			"     │                   └─ SubqueryAlias\n" +
+			"     │                       ├─ name: WOOJ5\n" +
+			"     │                       ├─ outerVisibility: false\n" +
+			"     │                       ├─ cacheable: true\n" +
+			"     │                       └─ Project\n" +
+			"     │                           ├─ columns: [nd.id:0!null as id, nd.ZH72S:7 as ZH72S, Subquery\n" +
+			"     │                           │   ├─ cacheable: false\n" +
+			"     │                           │   └─ Project\n" +
+			"     │                           │       ├─ columns: [COUNT(1):17!null as COUNT(*)]\n" +
+			"     │                           │       └─ GroupBy\n" +
+			"     │                           │           ├─ select: COUNT(1 (bigint))\n" +
+			"     │                           │           ├─ group: \n" +
+			"     │                           │           └─ Filter\n" +
+			"     │                           │               ├─ Eq\n" +
+			"     │                           │               │   ├─ FLQLP.LUEVY:17!null\n" +
+			"     │                           │               │   └─ nd.id:0!null\n" +
+			"     │                           │               └─ Table\n" +
+			"     │                           │                   ├─ name: FLQLP\n" +
+			"     │                           │                   └─ columns: [luevy]\n" +
+			"     │                           │   as LEA4J]\n" +
+			"     │                           └─ TableAlias(nd)\n" +
+			"     │                               └─ IndexedTableAccess(E2I7U)\n" +
+			"     │                                   ├─ index: [E2I7U.ZH72S]\n" +
+			"     │                                   ├─ static: [{(NULL, ∞)}]\n" +
+			"     │                                   ├─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │                                   └─ index condition: NOT\n" +
+			"     │                                       └─ E2I7U.ZH72S:7 IS NULL\n" +
 			"     └─ Filter\n" +
 			"         ├─ NOT\n" +
 			"         │   └─ PBMRX.ZH72S:2 IS NULL\n" +
@@ -7998,43 +8086,39 @@ WHERE
 			"     │               ├─ cacheable: true\n" +
 			"     │               └─ Project\n" +
 			"     │                   ├─ columns: [uct.id:45!null as FDL23]\n" +
-			"     │                   └─ Filter\n" +
-			"     │                       ├─ NOT\n" +
-			"     │                       │   └─ InSubquery\n" +
-			"     │                       │       ├─ left: I7HCR.id:37!null\n" +
-			"     │                       │       └─ right: Subquery\n" +
-			"     │                       │           ├─ cacheable: true\n" +
-			"     │                       │           └─ Filter\n" +
-			"     │                       │               ├─ NOT\n" +
-			"     │                       │               │   └─ FLQLP.OCA7E:58 IS NULL\n" +
-			"     │                       │               └─ IndexedTableAccess(FLQLP)\n" +
-			"     │                       │                   ├─ index: [FLQLP.OCA7E]\n" +
-			"     │                       │                   ├─ static: [{(NULL, ∞)}]\n" +
-			"     │                       │                   └─ columns: [oca7e]\n" +
-			"     │                       └─ LookupJoin\n" +
-			"     │                           ├─ AND\n" +
-			"     │                           │   ├─ AND\n" +
-			"     │                           │   │   ├─ Eq\n" +
-			"     │                           │   │   │   ├─ uct.FTQLQ:46\n" +
-			"     │                           │   │   │   └─ I7HCR.TOFPN:38!null\n" +
-			"     │                           │   │   └─ Eq\n" +
-			"     │                           │   │       ├─ uct.ZH72S:47\n" +
-			"     │                           │   │       └─ I7HCR.SJYN2:39!null\n" +
-			"     │                           │   └─ Eq\n" +
-			"     │                           │       ├─ uct.LJLUM:50\n" +
-			"     │                           │       └─ I7HCR.BTXC5:40!null\n" +
-			"     │                           ├─ Filter\n" +
-			"     │                           │   ├─ Eq\n" +
-			"     │                           │   │   ├─ I7HCR.SWCQV:42!null\n" +
-			"     │                           │   │   └─ 0 (tinyint)\n" +
-			"     │                           │   └─ TableAlias(I7HCR)\n" +
-			"     │                           │       └─ Table\n" +
-			"     │                           │           ├─ name: EPZU6\n" +
-			"     │                           │           └─ columns: [id tofpn sjyn2 btxc5 fvucx swcqv ykssu fhcyt]\n" +
-			"     │                           └─ TableAlias(uct)\n" +
-			"     │                               └─ IndexedTableAccess(OUBDL)\n" +
-			"     │                                   ├─ index: [OUBDL.ZH72S]\n" +
-			"     │                                   └─ columns: [id ftqlq zh72s sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e ykssu fhcyt qz6vt]\n" +
+			"     │                   └─ AntiJoin\n" +
+			"     │                       ├─ AND\n" +
+			"     │                       │   ├─ NOT\n" +
+			"     │                       │   │   └─ FLQLP.OCA7E:58 IS NULL\n" +
+			"     │                       │   └─ NOT\n" +
+			"     │                       │       └─ (I7HCR.id = FLQLP.OCA7E) IS FALSE\n" +
+			"     │                       ├─ LookupJoin\n" +
+			"     │                       │   ├─ AND\n" +
+			"     │                       │   │   ├─ AND\n" +
+			"     │                       │   │   │   ├─ Eq\n" +
+			"     │                       │   │   │   │   ├─ uct.FTQLQ:46\n" +
+			"     │                       │   │   │   │   └─ I7HCR.TOFPN:38!null\n" +
+			"     │                       │   │   │   └─ Eq\n" +
+			"     │                       │   │   │       ├─ uct.ZH72S:47\n" +
+			"     │                       │   │   │       └─ I7HCR.SJYN2:39!null\n" +
+			"     │                       │   │   └─ Eq\n" +
+			"     │                       │   │       ├─ uct.LJLUM:50\n" +
+			"     │                       │   │       └─ I7HCR.BTXC5:40!null\n" +
+			"     │                       │   ├─ Filter\n" +
+			"     │                       │   │   ├─ Eq\n" +
+			"     │                       │   │   │   ├─ I7HCR.SWCQV:42!null\n" +
+			"     │                       │   │   │   └─ 0 (tinyint)\n" +
+			"     │                       │   │   └─ TableAlias(I7HCR)\n" +
+			"     │                       │   │       └─ Table\n" +
+			"     │                       │   │           ├─ name: EPZU6\n" +
+			"     │                       │   │           └─ columns: [id tofpn sjyn2 btxc5 fvucx swcqv ykssu fhcyt]\n" +
+			"     │                       │   └─ TableAlias(uct)\n" +
+			"     │                       │       └─ IndexedTableAccess(OUBDL)\n" +
+			"     │                       │           ├─ index: [OUBDL.ZH72S]\n" +
+			"     │                       │           └─ columns: [id ftqlq zh72s sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e ykssu fhcyt qz6vt]\n" +
+			"     │                       └─ Table\n" +
+			"     │                           ├─ name: FLQLP\n" +
+			"     │                           └─ columns: [oca7e]\n" +
 			"     └─ LookupJoin\n" +
 			"         ├─ Eq\n" +
 			"         │   ├─ nd.id:20!null\n" +
@@ -8110,10 +8194,9 @@ WHERE
 			"     │   └─ Distinct\n" +
 			"     │       └─ Project\n" +
 			"     │           ├─ columns: [YLKSY.id:5!null as FDL23]\n" +
-			"     │           └─ AntiLookupJoin\n" +
-			"     │               ├─ Eq\n" +
-			"     │               │   ├─ YLKSY.id:5!null\n" +
-			"     │               │   └─ applySubq0.NRURT:38\n" +
+			"     │           └─ AntiHashJoin\n" +
+			"     │               ├─ NOT\n" +
+			"     │               │   └─ (YLKSY.id = applySubq0.NRURT) IS FALSE\n" +
 			"     │               ├─ LookupJoin\n" +
 			"     │               │   ├─ Eq\n" +
 			"     │               │   │   ├─ nd.ZH72S:28\n" +
@@ -8145,13 +8228,18 @@ WHERE
 			"     │               │       └─ IndexedTableAccess(E2I7U)\n" +
 			"     │               │           ├─ index: [E2I7U.ZH72S]\n" +
 			"     │               │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"     │               └─ Filter\n" +
-			"     │                   ├─ NOT\n" +
-			"     │                   │   └─ applySubq0.NRURT:0 IS NULL\n" +
-			"     │                   └─ TableAlias(applySubq0)\n" +
-			"     │                       └─ IndexedTableAccess(FLQLP)\n" +
-			"     │                           ├─ index: [FLQLP.NRURT]\n" +
-			"     │                           └─ columns: [nrurt]\n" +
+			"     │               └─ HashLookup\n" +
+			"     │                   ├─ source: TUPLE(YLKSY.id:5!null)\n" +
+			"     │                   ├─ target: TUPLE(applySubq0.NRURT:0)\n" +
+			"     │                   ├─ null-aware: true\n" +
+			"     │                   └─ CachedResults\n" +
+			"     │                       └─ Filter\n" +
+			"     │                           ├─ NOT\n" +
+			"     │                           │   └─ applySubq0.NRURT:0 IS NULL\n" +
+			"     │                           └─ TableAlias(applySubq0)\n" +
+			"     │                               └─ Table\n" +
+			"     │                                   ├─ name: FLQLP\n" +
+			"     │                                   └─ columns: [nrurt]\n" +
 			"     └─ TableAlias(uct)\n" +
 			"         └─ IndexedTableAccess(OUBDL)\n" +
 			"             ├─ index: [OUBDL.id]\n" +
@@ -8251,10 +8339,9 @@ WHERE
 	   AND
 	       SWCQV = 0
 	`,
-		ExpectedPlan: "AntiLookupJoin\n" +
-			" ├─ Eq\n" +
-			" │   ├─ HU5A5.id:0!null\n" +
-			" │   └─ applySubq0.XMM6Q:13\n" +
+		ExpectedPlan: "AntiHashJoin\n" +
+			" ├─ NOT\n" +
+			" │   └─ (HU5A5.id = applySubq0.XMM6Q) IS FALSE\n" +
 			" ├─ Filter\n" +
 			" │   ├─ Eq\n" +
 			" │   │   ├─ HU5A5.SWCQV:10!null\n" +
@@ -8262,13 +8349,18 @@ WHERE
 			" │   └─ Table\n" +
 			" │       ├─ name: HU5A5\n" +
 			" │       └─ columns: [id tofpn i3vta sfj6l v5dpx ljlum idpk7 no52d zrv3b vyo5e swcqv ykssu fhcyt]\n" +
-			" └─ Filter\n" +
-			"     ├─ NOT\n" +
-			"     │   └─ applySubq0.XMM6Q:0 IS NULL\n" +
-			"     └─ TableAlias(applySubq0)\n" +
-			"         └─ IndexedTableAccess(FLQLP)\n" +
-			"             ├─ index: [FLQLP.XMM6Q]\n" +
-			"             └─ columns: [xmm6q]\n" +
+			" └─ HashLookup\n" +
+			"     ├─ source: TUPLE(HU5A5.id:0!null)\n" +
+			"     ├─ target: TUPLE(applySubq0.XMM6Q:0)\n" +
+			"     ├─ null-aware: true\n" +
+			"     └─ CachedResults\n" +
+			"         └─ Filter\n" +
+			"             ├─ NOT\n" +
+			"             │   └─ applySubq0.XMM6Q:0 IS NULL\n" +
+			"             └─ TableAlias(applySubq0)\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: FLQLP\n" +
+			"                     └─ columns: [xmm6q]\n" +
 			"",
 	},
 	{
@@ -8523,16 +8615,16 @@ WHERE
 			"     │           ├─ columns: [Subquery\n" +
 			"     │           │   ├─ cacheable: false\n" +
 			"     │           │   └─ Limit(1)\n" +
-			"     │           │       └─ TopN(Limit: [1 (tinyint)]; TDRVG.id:2!null ASC nullsFirst)\n" +
-			"     │           │           └─ Project\n" +
-			"     │           │               ├─ columns: [TDRVG.id:2!null]\n" +
-			"     │           │               └─ Filter\n" +
-			"     │           │                   ├─ Eq\n" +
-			"     │           │                   │   ├─ TDRVG.SSHPJ:3!null\n" +
-			"     │           │                   │   └─ S7BYT.SSHPJ:0!null\n" +
-			"     │           │                   └─ Table\n" +
-			"     │           │                       ├─ name: TDRVG\n" +
-			"     │           │                       └─ columns: [id sshpj]\n" +
+			"     │           │       └─ Project\n" +
+			"     │           │           ├─ columns: [TDRVG.id:2!null]\n" +
+			"     │           │           └─ Filter\n" +
+			"     │           │               ├─ Eq\n" +
+			"     │           │               │   ├─ TDRVG.SSHPJ:3!null\n" +
+			"     │           │               │   └─ S7BYT.SSHPJ:0!null\n" +
+			"     │           │               └─ IndexedTableAccess(TDRVG)\n" +
+			"     │           │                   ├─ index: [TDRVG.id]\n" +
+			"     │           │                   ├─ static: [{[NULL, ∞)}]\n" +
+			"     │           │                   └─ columns: [id sshpj]\n" +
 			"     │           │   as id]\n" +
 			"     │           └─ AntiLookupJoin\n" +
 			"     │               ├─ Eq\n" +
@@ -8628,38 +8720,35 @@ WHERE
 			"     │           └─ Project\n" +
 			"     │               ├─ columns: [ZH72S:0, COUNT(TQ57W.ZH72S):1!null as JTOA7, MIN(TQ57W.TJ66D):2!null as B4OVH, SUM(TQ57W.TJ66D):3!null as R5CKX]\n" +
 			"     │               └─ GroupBy\n" +
-			"     │                   ├─ select: ZH72S:0, COUNT(TQ57W.ZH72S:2), MIN(TQ57W.TJ66D:1), SUM(TQ57W.TJ66D:1)\n" +
-			"     │                   ├─ group: ZH72S:0\n" +
-			"     │                   └─ Project\n" +
-			"     │                       ├─ columns: [TQ57W.ZH72S:1 as ZH72S, TQ57W.TJ66D:2, TQ57W.ZH72S:1]\n" +
-			"     │                       └─ SubqueryAlias\n" +
-			"     │                           ├─ name: TQ57W\n" +
-			"     │                           ├─ outerVisibility: false\n" +
-			"     │                           ├─ cacheable: true\n" +
-			"     │                           └─ Project\n" +
-			"     │                               ├─ columns: [nd.id:0!null as id, nd.ZH72S:7 as ZH72S, Subquery\n" +
-			"     │                               │   ├─ cacheable: false\n" +
-			"     │                               │   └─ Project\n" +
-			"     │                               │       ├─ columns: [COUNT(1):17!null as COUNT(*)]\n" +
-			"     │                               │       └─ GroupBy\n" +
-			"     │                               │           ├─ select: COUNT(1 (bigint))\n" +
-			"     │                               │           ├─ group: \n" +
-			"     │                               │           └─ Filter\n" +
-			"     │                               │               ├─ Eq\n" +
-			"     │                               │               │   ├─ AMYXQ.LUEVY:17!null\n" +
-			"     │                               │               │   └─ nd.id:0!null\n" +
-			"     │                               │               └─ Table\n" +
-			"     │                               │                   ├─ name: AMYXQ\n" +
-			"     │                               │                   └─ columns: [luevy]\n" +
-			"     │                               │   as TJ66D]\n" +
-			"     │                               └─ Filter\n" +
-			"     │                                   ├─ NOT\n" +
-			"     │                                   │   └─ nd.ZH72S:7 IS NULL\n" +
-			"     │                                   └─ TableAlias(nd)\n" +
-			"     │                                       └─ IndexedTableAccess(E2I7U)\n" +
-			"     │                                           ├─ index: [E2I7U.ZH72S]\n" +
-			"     │                                           ├─ static: [{(NULL, ∞)}]\n" +
-			"     │                                           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │                   ├─ select: TQ57W.ZH72S:1 as ZH72S, COUNT(TQ57W.ZH72S:1), MIN(TQ57W.TJ66D:2), SUM(TQ57W.TJ66D:2)\n" +
+			"     │                   ├─ group: TQ57W.ZH72S:1\n" +
+			"     │                   └─ SubqueryAlias\n" +
+			"     │                       ├─ name: TQ57W\n" +
+			"     │                       ├─ outerVisibility: false\n" +
+			"     │                       ├─ cacheable: true\n" +
+			"     │                       └─ Project\n" +
+			"     │                           ├─ columns: [nd.id:0!null as id, nd.ZH72S:7 as ZH72S, Subquery\n" +
+			"     │                           │   ├─ cacheable: false\n" +
+			"     │                           │   └─ Project\n" +
+			"     │                           │       ├─ columns: [COUNT(1):17!null as COUNT(*)]\n" +
+			"     │                           │       └─ GroupBy\n" +
+			"     │                           │           ├─ select: COUNT(1 (bigint))\n" +
+			"     │                           │           ├─ group: \n" +
+			"     │                           │           └─ Filter\n" +
+			"     │                           │               ├─ Eq\n" +
+			"     │                           │               │   ├─ AMYXQ.LUEVY:17!null\n" +
+			"     │                           │               │   └─ nd.id:0!null\n" +
+			"     │                           │               └─ Table\n" +
+			"     │                           │                   ├─ name: AMYXQ\n" +
+			"     │                           │                   └─ columns: [luevy]\n" +
+			"     │                           │   as TJ66D]\n" +
+			"     │                           └─ TableAlias(nd)\n" +
+			"     │                               └─ IndexedTableAccess(E2I7U)\n" +
+			"     │                                   ├─ index: [E2I7U.ZH72S]\n" +
+			"     │                                   ├─ static: [{(NULL, ∞)}]\n" +
+			"     │                                   ├─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"     │                                   └─ index condition: NOT\n" +
+			"     │                                       └─ E2I7U.ZH72S:7 IS NULL\n" +
 			"     └─ Filter\n" +
 			"         ├─ NOT\n" +
 			"         │   └─ PBMRX.ZH72S:2 IS NULL\n" +
@@ -8691,14 +8780,13 @@ WHERE
 		ExpectedPlan: "Distinct\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [ufc.id:30!null, ufc.T4IBQ:31, ufc.ZH72S:32, ufc.AMYXQ:33, ufc.KTNZ2:34, ufc.HIID2:35, ufc.DN3OQ:36, ufc.VVKNB:37, ufc.SH7TP:38, ufc.SRZZO:39, ufc.QZ6VT:40]\n" +
-			"     └─ LookupJoin\n" +
-			"         ├─ Eq\n" +
-			"         │   ├─ nd.ZH72S:48\n" +
-			"         │   └─ ufc.ZH72S:32\n" +
-			"         ├─ AntiLookupJoin\n" +
+			"     └─ AntiHashJoin\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ (ufc.id = applySubq0.KKGN5) IS FALSE\n" +
+			"         ├─ LookupJoin\n" +
 			"         │   ├─ Eq\n" +
-			"         │   │   ├─ ufc.id:30!null\n" +
-			"         │   │   └─ applySubq0.KKGN5:41\n" +
+			"         │   │   ├─ nd.ZH72S:48\n" +
+			"         │   │   └─ ufc.ZH72S:32\n" +
 			"         │   ├─ LookupJoin\n" +
 			"         │   │   ├─ Eq\n" +
 			"         │   │   │   ├─ cla.FTQLQ:1!null\n" +
@@ -8711,17 +8799,22 @@ WHERE
 			"         │   │       └─ IndexedTableAccess(SISUT)\n" +
 			"         │   │           ├─ index: [SISUT.T4IBQ]\n" +
 			"         │   │           └─ columns: [id t4ibq zh72s amyxq ktnz2 hiid2 dn3oq vvknb sh7tp srzzo qz6vt]\n" +
-			"         │   └─ TableAlias(applySubq0)\n" +
-			"         │       └─ IndexedTableAccess(AMYXQ)\n" +
-			"         │           ├─ index: [AMYXQ.KKGN5]\n" +
-			"         │           └─ columns: [kkgn5]\n" +
-			"         └─ Filter\n" +
-			"             ├─ NOT\n" +
-			"             │   └─ nd.ZH72S:7 IS NULL\n" +
-			"             └─ TableAlias(nd)\n" +
-			"                 └─ IndexedTableAccess(E2I7U)\n" +
-			"                     ├─ index: [E2I7U.ZH72S]\n" +
-			"                     └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"         │   └─ Filter\n" +
+			"         │       ├─ NOT\n" +
+			"         │       │   └─ nd.ZH72S:7 IS NULL\n" +
+			"         │       └─ TableAlias(nd)\n" +
+			"         │           └─ IndexedTableAccess(E2I7U)\n" +
+			"         │               ├─ index: [E2I7U.ZH72S]\n" +
+			"         │               └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"         └─ HashLookup\n" +
+			"             ├─ source: TUPLE(ufc.id:30!null)\n" +
+			"             ├─ target: TUPLE(applySubq0.KKGN5:0)\n" +
+			"             ├─ null-aware: true\n" +
+			"             └─ CachedResults\n" +
+			"                 └─ TableAlias(applySubq0)\n" +
+			"                     └─ Table\n" +
+			"                         ├─ name: AMYXQ\n" +
+			"                         └─ columns: [kkgn5]\n" +
 			"",
 	},
 	{
@@ -8746,14 +8839,13 @@ WHERE
 		ExpectedPlan: "Distinct\n" +
 			" └─ Project\n" +
 			"     ├─ columns: [ufc.id:30!null, ufc.T4IBQ:31, ufc.ZH72S:32, ufc.AMYXQ:33, ufc.KTNZ2:34, ufc.HIID2:35, ufc.DN3OQ:36, ufc.VVKNB:37, ufc.SH7TP:38, ufc.SRZZO:39, ufc.QZ6VT:40]\n" +
-			"     └─ LookupJoin\n" +
-			"         ├─ Eq\n" +
-			"         │   ├─ nd.ZH72S:48\n" +
-			"         │   └─ ufc.ZH72S:32\n" +
-			"         ├─ AntiLookupJoin\n" +
+			"     └─ AntiHashJoin\n" +
+			"         ├─ NOT\n" +
+			"         │   └─ (ufc.id = applySubq0.KKGN5) IS FALSE\n" +
+			"         ├─ LookupJoin\n" +
 			"         │   ├─ Eq\n" +
-			"         │   │   ├─ ufc.id:30!null\n" +
-			"         │   │   └─ applySubq0.KKGN5:41\n" +
+			"         │   │   ├─ nd.ZH72S:48\n" +
+			"         │   │   └─ ufc.ZH72S:32\n" +
 			"         │   ├─ LookupJoin\n" +
 			"         │   │   ├─ Eq\n" +
 			"         │   │   │   ├─ cla.FTQLQ:1!null\n" +
@@ -8766,17 +8858,22 @@ WHERE
 			"         │   │       └─ IndexedTableAccess(SISUT)\n" +
 			"         │   │           ├─ index: [SISUT.T4IBQ]\n" +
 			"         │   │           └─ columns: [id t4ibq zh72s amyxq ktnz2 hiid2 dn3oq vvknb sh7tp srzzo qz6vt]\n" +
-			"         │   └─ TableAlias(applySubq0)\n" +
-			"         │       └─ IndexedTableAccess(AMYXQ)\n" +
-			"         │           ├─ index: [AMYXQ.KKGN5]\n" +
-			"         │           └─ columns: [kkgn5]\n" +
-			"         └─ Filter\n" +
-			"             ├─ NOT\n" +
-			"             │   └─ nd.ZH72S:7 IS NULL\n" +
-			"             └─ TableAlias(nd)\n" +
-			"                 └─ IndexedTableAccess(E2I7U)\n" +
-			"                     ├─ index: [E2I7U.ZH72S]\n" +
-			"                     └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"         │   └─ Filter\n" +
+			"         │       ├─ NOT\n" +
+			"         │       │   └─ nd.ZH72S:7 IS NULL\n" +
+			"         │       └─ TableAlias(nd)\n" +
+			"         │           └─ IndexedTableAccess(E2I7U)\n" +
+			"         │               ├─ index: [E2I7U.ZH72S]\n" +
+			"         │               └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"         └─ HashLookup\n" +
+			"             ├─ source: TUPLE(ufc.id:30!null)\n" +
+			"             ├─ target: TUPLE(applySubq0.KKGN5:0)\n" +
+			"             ├─ null-aware: true\n" +
+			"             └─ CachedResults\n" +
+			"                 └─ TableAlias(applySubq0)\n" +
+			"                     └─ Table\n" +
+			"                         ├─ name: AMYXQ\n" +
+			"                         └─ columns: [kkgn5]\n" +
 			"",
 	},
 	{
@@ -8794,10 +8891,9 @@ WHERE
 	`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [ums.id:0!null, ums.T4IBQ:1, ums.ner:2, ums.ber:3, ums.hr:4, ums.mmr:5, ums.QZ6VT:6]\n" +
-			" └─ AntiLookupJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ ums.id:0!null\n" +
-			"     │   └─ applySubq0.JOGI6:37\n" +
+			" └─ AntiHashJoin\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ (ums.id = applySubq0.JOGI6) IS FALSE\n" +
 			"     ├─ LookupJoin\n" +
 			"     │   ├─ Eq\n" +
 			"     │   │   ├─ cla.FTQLQ:8!null\n" +
@@ -8810,10 +8906,15 @@ WHERE
 			"     │       └─ IndexedTableAccess(YK2GW)\n" +
 			"     │           ├─ index: [YK2GW.FTQLQ]\n" +
 			"     │           └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
-			"     └─ TableAlias(applySubq0)\n" +
-			"         └─ IndexedTableAccess(SZQWJ)\n" +
-			"             ├─ index: [SZQWJ.JOGI6]\n" +
-			"             └─ columns: [jogi6]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ source: TUPLE(ums.id:0!null)\n" +
+			"         ├─ target: TUPLE(applySubq0.JOGI6:0)\n" +
+			"         ├─ null-aware: true\n" +
+			"         └─ CachedResults\n" +
+			"             └─ TableAlias(applySubq0)\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: SZQWJ\n" +
+			"                     └─ columns: [jogi6]\n" +
 			"",
 	},
 	{
@@ -8937,43 +9038,39 @@ WHERE
 			"     │               ├─ cacheable: true\n" +
 			"     │               └─ Project\n" +
 			"     │                   ├─ columns: [umf.id:79!null as ORB3K]\n" +
-			"     │                   └─ Filter\n" +
-			"     │                       ├─ NOT\n" +
-			"     │                       │   └─ InSubquery\n" +
-			"     │                       │       ├─ left: TJ5D2.id:71!null\n" +
-			"     │                       │       └─ right: Subquery\n" +
-			"     │                       │           ├─ cacheable: true\n" +
-			"     │                       │           └─ Filter\n" +
-			"     │                       │               ├─ NOT\n" +
-			"     │                       │               │   └─ HGMQ6.QQV4M:104 IS NULL\n" +
-			"     │                       │               └─ IndexedTableAccess(HGMQ6)\n" +
-			"     │                       │                   ├─ index: [HGMQ6.QQV4M]\n" +
-			"     │                       │                   ├─ static: [{(NULL, ∞)}]\n" +
-			"     │                       │                   └─ columns: [qqv4m]\n" +
-			"     │                       └─ LookupJoin\n" +
-			"     │                           ├─ AND\n" +
-			"     │                           │   ├─ AND\n" +
-			"     │                           │   │   ├─ Eq\n" +
-			"     │                           │   │   │   ├─ umf.T4IBQ:80\n" +
-			"     │                           │   │   │   └─ TJ5D2.T4IBQ:72!null\n" +
-			"     │                           │   │   └─ Eq\n" +
-			"     │                           │   │       ├─ umf.FGG57:81\n" +
-			"     │                           │   │       └─ TJ5D2.V7UFH:73!null\n" +
-			"     │                           │   └─ Eq\n" +
-			"     │                           │       ├─ umf.SYPKF:87\n" +
-			"     │                           │       └─ TJ5D2.SYPKF:74!null\n" +
-			"     │                           ├─ Filter\n" +
-			"     │                           │   ├─ Eq\n" +
-			"     │                           │   │   ├─ TJ5D2.SWCQV:76!null\n" +
-			"     │                           │   │   └─ 0 (tinyint)\n" +
-			"     │                           │   └─ TableAlias(TJ5D2)\n" +
-			"     │                           │       └─ Table\n" +
-			"     │                           │           ├─ name: SZW6V\n" +
-			"     │                           │           └─ columns: [id t4ibq v7ufh sypkf h4dmt swcqv ykssu fhcyt]\n" +
-			"     │                           └─ TableAlias(umf)\n" +
-			"     │                               └─ IndexedTableAccess(NZKPM)\n" +
-			"     │                                   ├─ index: [NZKPM.FGG57]\n" +
-			"     │                                   └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
+			"     │                   └─ AntiJoin\n" +
+			"     │                       ├─ AND\n" +
+			"     │                       │   ├─ NOT\n" +
+			"     │                       │   │   └─ HGMQ6.QQV4M:104 IS NULL\n" +
+			"     │                       │   └─ NOT\n" +
+			"     │                       │       └─ (TJ5D2.id = HGMQ6.QQV4M) IS FALSE\n" +
+			"     │                       ├─ LookupJoin\n" +
+			"     │                       │   ├─ AND\n" +
+			"     │                       │   │   ├─ AND\n" +
+			"     │                       │   │   │   ├─ Eq\n" +
+			"     │                       │   │   │   │   ├─ umf.T4IBQ:80\n" +
+			"     │                       │   │   │   │   └─ TJ5D2.T4IBQ:72!null\n" +
+			"     │                       │   │   │   └─ Eq\n" +
+			"     │                       │   │   │       ├─ umf.FGG57:81\n" +
+			"     │                       │   │   │       └─ TJ5D2.V7UFH:73!null\n" +
+			"     │                       │   │   └─ Eq\n" +
+			"     │                       │   │       ├─ umf.SYPKF:87\n" +
+			"     │                       │   │       └─ TJ5D2.SYPKF:74!null\n" +
+			"     │                       │   ├─ Filter\n" +
+			"     │                       │   │   ├─ Eq\n" +
+			"     │                       │   │   │   ├─ TJ5D2.SWCQV:76!null\n" +
+			"     │                       │   │   │   └─ 0 (tinyint)\n" +
+			"     │                       │   │   └─ TableAlias(TJ5D2)\n" +
+			"     │                       │   │       └─ Table\n" +
+			"     │                       │   │           ├─ name: SZW6V\n" +
+			"     │                       │   │           └─ columns: [id t4ibq v7ufh sypkf h4dmt swcqv ykssu fhcyt]\n" +
+			"     │                       │   └─ TableAlias(umf)\n" +
+			"     │                       │       └─ IndexedTableAccess(NZKPM)\n" +
+			"     │                       │           ├─ index: [NZKPM.FGG57]\n" +
+			"     │                       │           └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
+			"     │                       └─ Table\n" +
+			"     │                           ├─ name: HGMQ6\n" +
+			"     │                           └─ columns: [qqv4m]\n" +
 			"     └─ LookupJoin\n" +
 			"         ├─ Eq\n" +
 			"         │   ├─ aac.id:68!null\n" +
@@ -9035,10 +9132,9 @@ WHERE
 	`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [umf.id:30!null, umf.T4IBQ:31, umf.FGG57:32, umf.SSHPJ:33, umf.NLA6O:34, umf.SFJ6L:35, umf.TJPT7:36, umf.ARN5P:37, umf.SYPKF:38, umf.IVFMK:39, umf.IDE43:40, umf.AZ6SP:41, umf.FSDY2:42, umf.XOSD4:43, umf.HMW4H:44, umf.S76OM:45, umf.vaf:46, umf.ZROH6:47, umf.QCGTS:48, umf.LNFM6:49, umf.TVAWL:50, umf.HDLCL:51, umf.BHHW6:52, umf.FHCYT:53, umf.QZ6VT:54]\n" +
-			" └─ AntiLookupJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ umf.id:30!null\n" +
-			"     │   └─ applySubq0.TEUJA:72\n" +
+			" └─ AntiHashJoin\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ (umf.id = applySubq0.TEUJA) IS FALSE\n" +
 			"     ├─ LookupJoin\n" +
 			"     │   ├─ Eq\n" +
 			"     │   │   ├─ nd.FGG57:61\n" +
@@ -9067,10 +9163,15 @@ WHERE
 			"     │           └─ IndexedTableAccess(E2I7U)\n" +
 			"     │               ├─ index: [E2I7U.FGG57]\n" +
 			"     │               └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"     └─ TableAlias(applySubq0)\n" +
-			"         └─ IndexedTableAccess(HGMQ6)\n" +
-			"             ├─ index: [HGMQ6.TEUJA]\n" +
-			"             └─ columns: [teuja]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ source: TUPLE(umf.id:30!null)\n" +
+			"         ├─ target: TUPLE(applySubq0.TEUJA:0)\n" +
+			"         ├─ null-aware: true\n" +
+			"         └─ CachedResults\n" +
+			"             └─ TableAlias(applySubq0)\n" +
+			"                 └─ Table\n" +
+			"                     ├─ name: HGMQ6\n" +
+			"                     └─ columns: [teuja]\n" +
 			"",
 	},
 	{
@@ -9191,232 +9292,226 @@ WHERE
 			" ├─ Project\n" +
 			" │   ├─ columns: [convert\n" +
 			" │   │   ├─ type: char\n" +
-			" │   │   └─ T4IBQ:0!null\n" +
-			" │   │   as T4IBQ, DL754:1!null, BDNYB:2!null, ADURZ:3!null, TPXBU:4, NO52D:5!null, IDPK7:6!null]\n" +
-			" │   └─ Project\n" +
-			" │       ├─ columns: [cla.FTQLQ:38!null as T4IBQ, SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
-			" │       │   ├─ cacheable: false\n" +
-			" │       │   └─ Project\n" +
-			" │       │       ├─ columns: [aac.BTXC5:68]\n" +
-			" │       │       └─ Filter\n" +
-			" │       │           ├─ Eq\n" +
-			" │       │           │   ├─ aac.id:67!null\n" +
-			" │       │           │   └─ SL3S5.M22QN:2!null\n" +
-			" │       │           └─ TableAlias(aac)\n" +
-			" │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			" │       │                   ├─ index: [TPXBU.id]\n" +
-			" │       │                   └─ columns: [id btxc5]\n" +
-			" │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
-			" │       └─ LookupJoin\n" +
-			" │           ├─ Eq\n" +
-			" │           │   ├─ cla.id:37!null\n" +
-			" │           │   └─ bs.IXUXU:35\n" +
-			" │           ├─ LookupJoin\n" +
-			" │           │   ├─ Eq\n" +
-			" │           │   │   ├─ bs.id:33!null\n" +
-			" │           │   │   └─ mf.GXLUB:17!null\n" +
-			" │           │   ├─ LookupJoin\n" +
-			" │           │   │   ├─ AND\n" +
-			" │           │   │   │   ├─ Eq\n" +
-			" │           │   │   │   │   ├─ sn.BRQP2:7!null\n" +
-			" │           │   │   │   │   └─ mf.LUEVY:18!null\n" +
-			" │           │   │   │   └─ Eq\n" +
-			" │           │   │   │       ├─ SL3S5.M22QN:2!null\n" +
-			" │           │   │   │       └─ mf.M22QN:19!null\n" +
-			" │           │   │   ├─ LookupJoin\n" +
-			" │           │   │   │   ├─ Eq\n" +
-			" │           │   │   │   │   ├─ SL3S5.BDNYB:0!null\n" +
-			" │           │   │   │   │   └─ sn.id:6!null\n" +
-			" │           │   │   │   ├─ SubqueryAlias\n" +
-			" │           │   │   │   │   ├─ name: SL3S5\n" +
-			" │           │   │   │   │   ├─ outerVisibility: false\n" +
-			" │           │   │   │   │   ├─ cacheable: true\n" +
-			" │           │   │   │   │   └─ Project\n" +
-			" │           │   │   │   │       ├─ columns: [KHJJO.BDNYB:12!null as BDNYB, ci.FTQLQ:1!null as TOFPN, ct.M22QN:4!null as M22QN, cec.ADURZ:10!null as ADURZ, cec.NO52D:9!null as NO52D, ct.S3Q3Y:6!null as IDPK7]\n" +
-			" │           │   │   │   │       └─ HashJoin\n" +
-			" │           │   │   │   │           ├─ AND\n" +
-			" │           │   │   │   │           │   ├─ Eq\n" +
-			" │           │   │   │   │           │   │   ├─ ct.M22QN:4!null\n" +
-			" │           │   │   │   │           │   │   └─ KHJJO.M22QN:11!null\n" +
-			" │           │   │   │   │           │   └─ Eq\n" +
-			" │           │   │   │   │           │       ├─ ct.LUEVY:3!null\n" +
-			" │           │   │   │   │           │       └─ KHJJO.LUEVY:13!null\n" +
-			" │           │   │   │   │           ├─ LookupJoin\n" +
-			" │           │   │   │   │           │   ├─ Eq\n" +
-			" │           │   │   │   │           │   │   ├─ cec.id:8!null\n" +
-			" │           │   │   │   │           │   │   └─ ct.OVE3E:5!null\n" +
-			" │           │   │   │   │           │   ├─ LookupJoin\n" +
-			" │           │   │   │   │           │   │   ├─ Eq\n" +
-			" │           │   │   │   │           │   │   │   ├─ ci.id:0!null\n" +
-			" │           │   │   │   │           │   │   │   └─ ct.FZ2R5:2!null\n" +
-			" │           │   │   │   │           │   │   ├─ Filter\n" +
-			" │           │   │   │   │           │   │   │   ├─ HashIn\n" +
-			" │           │   │   │   │           │   │   │   │   ├─ ci.FTQLQ:1!null\n" +
-			" │           │   │   │   │           │   │   │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			" │           │   │   │   │           │   │   │   └─ TableAlias(ci)\n" +
-			" │           │   │   │   │           │   │   │       └─ IndexedTableAccess(JDLNA)\n" +
-			" │           │   │   │   │           │   │   │           ├─ index: [JDLNA.FTQLQ]\n" +
-			" │           │   │   │   │           │   │   │           ├─ static: [{[SQ1, SQ1]}]\n" +
-			" │           │   │   │   │           │   │   │           └─ columns: [id ftqlq]\n" +
-			" │           │   │   │   │           │   │   └─ Filter\n" +
-			" │           │   │   │   │           │   │       ├─ Eq\n" +
-			" │           │   │   │   │           │   │       │   ├─ ct.ZRV3B:5!null\n" +
-			" │           │   │   │   │           │   │       │   └─ = (longtext)\n" +
-			" │           │   │   │   │           │   │       └─ TableAlias(ct)\n" +
-			" │           │   │   │   │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			" │           │   │   │   │           │   │               ├─ index: [FLQLP.FZ2R5]\n" +
-			" │           │   │   │   │           │   │               └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
-			" │           │   │   │   │           │   └─ TableAlias(cec)\n" +
-			" │           │   │   │   │           │       └─ IndexedTableAccess(SFEGG)\n" +
-			" │           │   │   │   │           │           ├─ index: [SFEGG.id]\n" +
-			" │           │   │   │   │           │           └─ columns: [id no52d adurz]\n" +
-			" │           │   │   │   │           └─ HashLookup\n" +
-			" │           │   │   │   │               ├─ source: TUPLE(ct.M22QN:4!null, ct.LUEVY:3!null)\n" +
-			" │           │   │   │   │               ├─ target: TUPLE(KHJJO.M22QN:0!null, KHJJO.LUEVY:2!null)\n" +
-			" │           │   │   │   │               └─ CachedResults\n" +
-			" │           │   │   │   │                   └─ SubqueryAlias\n" +
-			" │           │   │   │   │                       ├─ name: KHJJO\n" +
-			" │           │   │   │   │                       ├─ outerVisibility: false\n" +
-			" │           │   │   │   │                       ├─ cacheable: true\n" +
-			" │           │   │   │   │                       └─ Distinct\n" +
-			" │           │   │   │   │                           └─ Project\n" +
-			" │           │   │   │   │                               ├─ columns: [mf.M22QN:13!null as M22QN, sn.id:0!null as BDNYB, mf.LUEVY:12!null as LUEVY]\n" +
-			" │           │   │   │   │                               └─ LookupJoin\n" +
-			" │           │   │   │   │                                   ├─ Eq\n" +
-			" │           │   │   │   │                                   │   ├─ sn.BRQP2:1!null\n" +
-			" │           │   │   │   │                                   │   └─ mf.LUEVY:12!null\n" +
-			" │           │   │   │   │                                   ├─ TableAlias(sn)\n" +
-			" │           │   │   │   │                                   │   └─ Table\n" +
-			" │           │   │   │   │                                   │       ├─ name: NOXN3\n" +
-			" │           │   │   │   │                                   │       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			" │           │   │   │   │                                   └─ TableAlias(mf)\n" +
-			" │           │   │   │   │                                       └─ IndexedTableAccess(HGMQ6)\n" +
-			" │           │   │   │   │                                           ├─ index: [HGMQ6.LUEVY]\n" +
-			" │           │   │   │   │                                           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
-			" │           │   │   │   └─ TableAlias(sn)\n" +
-			" │           │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
-			" │           │   │   │           ├─ index: [NOXN3.id]\n" +
-			" │           │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			" │           │   │   └─ TableAlias(mf)\n" +
-			" │           │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			" │           │   │           ├─ index: [HGMQ6.LUEVY]\n" +
-			" │           │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
-			" │           │   └─ TableAlias(bs)\n" +
-			" │           │       └─ IndexedTableAccess(THNTS)\n" +
-			" │           │           ├─ index: [THNTS.id]\n" +
-			" │           │           └─ columns: [id nfryn ixuxu fhcyt]\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ HashIn\n" +
-			" │               │   ├─ cla.FTQLQ:1!null\n" +
-			" │               │   └─ TUPLE(SQ1 (longtext))\n" +
-			" │               └─ TableAlias(cla)\n" +
-			" │                   └─ IndexedTableAccess(YK2GW)\n" +
-			" │                       ├─ index: [YK2GW.id]\n" +
-			" │                       └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
+			" │   │   └─ cla.FTQLQ:38!null\n" +
+			" │   │   as T4IBQ, SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
+			" │   │   ├─ cacheable: false\n" +
+			" │   │   └─ Project\n" +
+			" │   │       ├─ columns: [aac.BTXC5:68]\n" +
+			" │   │       └─ Filter\n" +
+			" │   │           ├─ Eq\n" +
+			" │   │           │   ├─ aac.id:67!null\n" +
+			" │   │           │   └─ SL3S5.M22QN:2!null\n" +
+			" │   │           └─ TableAlias(aac)\n" +
+			" │   │               └─ IndexedTableAccess(TPXBU)\n" +
+			" │   │                   ├─ index: [TPXBU.id]\n" +
+			" │   │                   └─ columns: [id btxc5]\n" +
+			" │   │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
+			" │   └─ LookupJoin\n" +
+			" │       ├─ Eq\n" +
+			" │       │   ├─ cla.id:37!null\n" +
+			" │       │   └─ bs.IXUXU:35\n" +
+			" │       ├─ LookupJoin\n" +
+			" │       │   ├─ Eq\n" +
+			" │       │   │   ├─ bs.id:33!null\n" +
+			" │       │   │   └─ mf.GXLUB:17!null\n" +
+			" │       │   ├─ LookupJoin\n" +
+			" │       │   │   ├─ AND\n" +
+			" │       │   │   │   ├─ Eq\n" +
+			" │       │   │   │   │   ├─ sn.BRQP2:7!null\n" +
+			" │       │   │   │   │   └─ mf.LUEVY:18!null\n" +
+			" │       │   │   │   └─ Eq\n" +
+			" │       │   │   │       ├─ SL3S5.M22QN:2!null\n" +
+			" │       │   │   │       └─ mf.M22QN:19!null\n" +
+			" │       │   │   ├─ LookupJoin\n" +
+			" │       │   │   │   ├─ Eq\n" +
+			" │       │   │   │   │   ├─ SL3S5.BDNYB:0!null\n" +
+			" │       │   │   │   │   └─ sn.id:6!null\n" +
+			" │       │   │   │   ├─ SubqueryAlias\n" +
+			" │       │   │   │   │   ├─ name: SL3S5\n" +
+			" │       │   │   │   │   ├─ outerVisibility: false\n" +
+			" │       │   │   │   │   ├─ cacheable: true\n" +
+			" │       │   │   │   │   └─ Project\n" +
+			" │       │   │   │   │       ├─ columns: [KHJJO.BDNYB:12!null as BDNYB, ci.FTQLQ:1!null as TOFPN, ct.M22QN:4!null as M22QN, cec.ADURZ:10!null as ADURZ, cec.NO52D:9!null as NO52D, ct.S3Q3Y:6!null as IDPK7]\n" +
+			" │       │   │   │   │       └─ HashJoin\n" +
+			" │       │   │   │   │           ├─ AND\n" +
+			" │       │   │   │   │           │   ├─ Eq\n" +
+			" │       │   │   │   │           │   │   ├─ ct.M22QN:4!null\n" +
+			" │       │   │   │   │           │   │   └─ KHJJO.M22QN:11!null\n" +
+			" │       │   │   │   │           │   └─ Eq\n" +
+			" │       │   │   │   │           │       ├─ ct.LUEVY:3!null\n" +
+			" │       │   │   │   │           │       └─ KHJJO.LUEVY:13!null\n" +
+			" │       │   │   │   │           ├─ LookupJoin\n" +
+			" │       │   │   │   │           │   ├─ Eq\n" +
+			" │       │   │   │   │           │   │   ├─ cec.id:8!null\n" +
+			" │       │   │   │   │           │   │   └─ ct.OVE3E:5!null\n" +
+			" │       │   │   │   │           │   ├─ LookupJoin\n" +
+			" │       │   │   │   │           │   │   ├─ Eq\n" +
+			" │       │   │   │   │           │   │   │   ├─ ci.id:0!null\n" +
+			" │       │   │   │   │           │   │   │   └─ ct.FZ2R5:2!null\n" +
+			" │       │   │   │   │           │   │   ├─ TableAlias(ci)\n" +
+			" │       │   │   │   │           │   │   │   └─ IndexedTableAccess(JDLNA)\n" +
+			" │       │   │   │   │           │   │   │       ├─ index: [JDLNA.FTQLQ]\n" +
+			" │       │   │   │   │           │   │   │       ├─ static: [{[SQ1, SQ1]}]\n" +
+			" │       │   │   │   │           │   │   │       ├─ columns: [id ftqlq]\n" +
+			" │       │   │   │   │           │   │   │       └─ index condition: IN\n" +
+			" │       │   │   │   │           │   │   │           ├─ left: JDLNA.FTQLQ:1!null\n" +
+			" │       │   │   │   │           │   │   │           └─ right: TUPLE(SQ1 (longtext))\n" +
+			" │       │   │   │   │           │   │   └─ Filter\n" +
+			" │       │   │   │   │           │   │       ├─ Eq\n" +
+			" │       │   │   │   │           │   │       │   ├─ ct.ZRV3B:5!null\n" +
+			" │       │   │   │   │           │   │       │   └─ = (longtext)\n" +
+			" │       │   │   │   │           │   │       └─ TableAlias(ct)\n" +
+			" │       │   │   │   │           │   │           └─ IndexedTableAccess(FLQLP)\n" +
+			" │       │   │   │   │           │   │               ├─ index: [FLQLP.FZ2R5]\n" +
+			" │       │   │   │   │           │   │               └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
+			" │       │   │   │   │           │   └─ TableAlias(cec)\n" +
+			" │       │   │   │   │           │       └─ IndexedTableAccess(SFEGG)\n" +
+			" │       │   │   │   │           │           ├─ index: [SFEGG.id]\n" +
+			" │       │   │   │   │           │           └─ columns: [id no52d adurz]\n" +
+			" │       │   │   │   │           └─ HashLookup\n" +
+			" │       │   │   │   │               ├─ source: TUPLE(ct.M22QN:4!null, ct.LUEVY:3!null)\n" +
+			" │       │   │   │   │               ├─ target: TUPLE(KHJJO.M22QN:0!null, KHJJO.LUEVY:2!null)\n" +
+			" │       │   │   │   │               └─ CachedResults\n" +
+			" │       │   │   │   │                   └─ SubqueryAlias\n" +
+			" │       │   │   │   │                       ├─ name: KHJJO\n" +
+			" │       │   │   │   │                       ├─ outerVisibility: false\n" +
+			" │       │   │   │   │                       ├─ cacheable: true\n" +
+			" │       │   │   │   │                       └─ Distinct\n" +
+			" │       │   │   │   │                           └─ Project\n" +
+			" │       │   │   │   │                               ├─ columns: [mf.M22QN:13!null as M22QN, sn.id:0!null as BDNYB, mf.LUEVY:12!null as LUEVY]\n" +
+			" │       │   │   │   │                               └─ LookupJoin\n" +
+			" │       │   │   │   │                                   ├─ Eq\n" +
+			" │       │   │   │   │                                   │   ├─ sn.BRQP2:1!null\n" +
+			" │       │   │   │   │                                   │   └─ mf.LUEVY:12!null\n" +
+			" │       │   │   │   │                                   ├─ TableAlias(sn)\n" +
+			" │       │   │   │   │                                   │   └─ Table\n" +
+			" │       │   │   │   │                                   │       ├─ name: NOXN3\n" +
+			" │       │   │   │   │                                   │       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			" │       │   │   │   │                                   └─ TableAlias(mf)\n" +
+			" │       │   │   │   │                                       └─ IndexedTableAccess(HGMQ6)\n" +
+			" │       │   │   │   │                                           ├─ index: [HGMQ6.LUEVY]\n" +
+			" │       │   │   │   │                                           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
+			" │       │   │   │   └─ TableAlias(sn)\n" +
+			" │       │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
+			" │       │   │   │           ├─ index: [NOXN3.id]\n" +
+			" │       │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			" │       │   │   └─ TableAlias(mf)\n" +
+			" │       │   │       └─ IndexedTableAccess(HGMQ6)\n" +
+			" │       │   │           ├─ index: [HGMQ6.LUEVY]\n" +
+			" │       │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
+			" │       │   └─ TableAlias(bs)\n" +
+			" │       │       └─ IndexedTableAccess(THNTS)\n" +
+			" │       │           ├─ index: [THNTS.id]\n" +
+			" │       │           └─ columns: [id nfryn ixuxu fhcyt]\n" +
+			" │       └─ Filter\n" +
+			" │           ├─ HashIn\n" +
+			" │           │   ├─ cla.FTQLQ:1!null\n" +
+			" │           │   └─ TUPLE(SQ1 (longtext))\n" +
+			" │           └─ TableAlias(cla)\n" +
+			" │               └─ IndexedTableAccess(YK2GW)\n" +
+			" │                   ├─ index: [YK2GW.id]\n" +
+			" │                   └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [AOEV5.T4IBQ:0!null as T4IBQ, VUMUY.DL754:1!null, VUMUY.BDNYB:2!null, VUMUY.ADURZ:3!null, VUMUY.TPXBU:4, VUMUY.NO52D:5!null, VUMUY.IDPK7:6!null]\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [AOEV5.T4IBQ:6!null, VUMUY.DL754:0!null, VUMUY.BDNYB:1!null, VUMUY.ADURZ:2!null, VUMUY.TPXBU:3, VUMUY.NO52D:4!null, VUMUY.IDPK7:5!null]\n" +
-			"         └─ CrossJoin\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: VUMUY\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ cacheable: true\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
-			"             │       │   ├─ cacheable: false\n" +
-			"             │       │   └─ Project\n" +
-			"             │       │       ├─ columns: [aac.BTXC5:17]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ Eq\n" +
-			"             │       │           │   ├─ aac.id:16!null\n" +
-			"             │       │           │   └─ SL3S5.M22QN:2!null\n" +
-			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.id]\n" +
-			"             │       │                   └─ columns: [id btxc5]\n" +
-			"             │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
-			"             │       └─ LookupJoin\n" +
-			"             │           ├─ Eq\n" +
-			"             │           │   ├─ SL3S5.BDNYB:0!null\n" +
-			"             │           │   └─ sn.id:6!null\n" +
-			"             │           ├─ SubqueryAlias\n" +
-			"             │           │   ├─ name: SL3S5\n" +
-			"             │           │   ├─ outerVisibility: false\n" +
-			"             │           │   ├─ cacheable: true\n" +
-			"             │           │   └─ Project\n" +
-			"             │           │       ├─ columns: [sn.id:17!null as BDNYB, ci.FTQLQ:16!null as TOFPN, ct.M22QN:6!null as M22QN, cec.ADURZ:2!null as ADURZ, cec.NO52D:1!null as NO52D, ct.S3Q3Y:12!null as IDPK7]\n" +
-			"             │           │       └─ Filter\n" +
-			"             │           │           ├─ Eq\n" +
-			"             │           │           │   ├─ ct.M22QN:6!null\n" +
-			"             │           │           │   └─ Subquery\n" +
-			"             │           │           │       ├─ cacheable: true\n" +
-			"             │           │           │       └─ Project\n" +
-			"             │           │           │           ├─ columns: [aac.id:27!null]\n" +
-			"             │           │           │           └─ Filter\n" +
-			"             │           │           │               ├─ Eq\n" +
-			"             │           │           │               │   ├─ aac.BTXC5:28\n" +
-			"             │           │           │               │   └─ WT (longtext)\n" +
-			"             │           │           │               └─ TableAlias(aac)\n" +
-			"             │           │           │                   └─ IndexedTableAccess(TPXBU)\n" +
-			"             │           │           │                       ├─ index: [TPXBU.BTXC5]\n" +
-			"             │           │           │                       ├─ static: [{[WT, WT]}]\n" +
-			"             │           │           │                       └─ columns: [id btxc5]\n" +
-			"             │           │           └─ LookupJoin\n" +
-			"             │           │               ├─ Eq\n" +
-			"             │           │               │   ├─ ct.LUEVY:5!null\n" +
-			"             │           │               │   └─ sn.BRQP2:18!null\n" +
-			"             │           │               ├─ LookupJoin\n" +
-			"             │           │               │   ├─ Eq\n" +
-			"             │           │               │   │   ├─ ci.id:15!null\n" +
-			"             │           │               │   │   └─ ct.FZ2R5:4!null\n" +
-			"             │           │               │   ├─ LookupJoin\n" +
-			"             │           │               │   │   ├─ Eq\n" +
-			"             │           │               │   │   │   ├─ cec.id:0!null\n" +
-			"             │           │               │   │   │   └─ ct.OVE3E:7!null\n" +
-			"             │           │               │   │   ├─ TableAlias(cec)\n" +
-			"             │           │               │   │   │   └─ Table\n" +
-			"             │           │               │   │   │       ├─ name: SFEGG\n" +
-			"             │           │               │   │   │       └─ columns: [id no52d adurz]\n" +
-			"             │           │               │   │   └─ Filter\n" +
-			"             │           │               │   │       ├─ Eq\n" +
-			"             │           │               │   │       │   ├─ ct.ZRV3B:10!null\n" +
-			"             │           │               │   │       │   └─ = (longtext)\n" +
-			"             │           │               │   │       └─ TableAlias(ct)\n" +
-			"             │           │               │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			"             │           │               │   │               ├─ index: [FLQLP.OVE3E]\n" +
-			"             │           │               │   │               └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
-			"             │           │               │   └─ Filter\n" +
-			"             │           │               │       ├─ HashIn\n" +
-			"             │           │               │       │   ├─ ci.FTQLQ:1!null\n" +
-			"             │           │               │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"             │           │               │       └─ TableAlias(ci)\n" +
-			"             │           │               │           └─ IndexedTableAccess(JDLNA)\n" +
-			"             │           │               │               ├─ index: [JDLNA.id]\n" +
-			"             │           │               │               └─ columns: [id ftqlq]\n" +
-			"             │           │               └─ TableAlias(sn)\n" +
-			"             │           │                   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │           │                       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │           │                       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             │           └─ TableAlias(sn)\n" +
-			"             │               └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                   ├─ index: [NOXN3.id]\n" +
-			"             │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: AOEV5\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ Values() as temp_AOEV5\n" +
-			"                     ├─ Row(\n" +
-			"                     │  1 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  2 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  3 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  4 (longtext))\n" +
-			"                     └─ Row(\n" +
-			"                        5 (longtext))\n" +
+			"     ├─ columns: [AOEV5.T4IBQ:6!null, VUMUY.DL754:0!null, VUMUY.BDNYB:1!null, VUMUY.ADURZ:2!null, VUMUY.TPXBU:3, VUMUY.NO52D:4!null, VUMUY.IDPK7:5!null]\n" +
+			"     └─ CrossJoin\n" +
+			"         ├─ SubqueryAlias\n" +
+			"         │   ├─ name: VUMUY\n" +
+			"         │   ├─ outerVisibility: false\n" +
+			"         │   ├─ cacheable: true\n" +
+			"         │   └─ Project\n" +
+			"         │       ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
+			"         │       │   ├─ cacheable: false\n" +
+			"         │       │   └─ Project\n" +
+			"         │       │       ├─ columns: [aac.BTXC5:17]\n" +
+			"         │       │       └─ Filter\n" +
+			"         │       │           ├─ Eq\n" +
+			"         │       │           │   ├─ aac.id:16!null\n" +
+			"         │       │           │   └─ SL3S5.M22QN:2!null\n" +
+			"         │       │           └─ TableAlias(aac)\n" +
+			"         │       │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │       │                   ├─ index: [TPXBU.id]\n" +
+			"         │       │                   └─ columns: [id btxc5]\n" +
+			"         │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
+			"         │       └─ LookupJoin\n" +
+			"         │           ├─ Eq\n" +
+			"         │           │   ├─ SL3S5.BDNYB:0!null\n" +
+			"         │           │   └─ sn.id:6!null\n" +
+			"         │           ├─ SubqueryAlias\n" +
+			"         │           │   ├─ name: SL3S5\n" +
+			"         │           │   ├─ outerVisibility: false\n" +
+			"         │           │   ├─ cacheable: true\n" +
+			"         │           │   └─ Project\n" +
+			"         │           │       ├─ columns: [sn.id:17!null as BDNYB, ci.FTQLQ:16!null as TOFPN, ct.M22QN:6!null as M22QN, cec.ADURZ:2!null as ADURZ, cec.NO52D:1!null as NO52D, ct.S3Q3Y:12!null as IDPK7]\n" +
+			"         │           │       └─ Filter\n" +
+			"         │           │           ├─ Eq\n" +
+			"         │           │           │   ├─ ct.M22QN:6!null\n" +
+			"         │           │           │   └─ Subquery\n" +
+			"         │           │           │       ├─ cacheable: true\n" +
+			"         │           │           │       └─ Project\n" +
+			"         │           │           │           ├─ columns: [aac.id:27!null]\n" +
+			"         │           │           │           └─ TableAlias(aac)\n" +
+			"         │           │           │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │           │           │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"         │           │           │                   ├─ static: [{[WT, WT]}]\n" +
+			"         │           │           │                   ├─ columns: [id btxc5]\n" +
+			"         │           │           │                   └─ index condition: Eq\n" +
+			"         │           │           │                       ├─ TPXBU.BTXC5:1\n" +
+			"         │           │           │                       └─ WT (longtext)\n" +
+			"         │           │           └─ LookupJoin\n" +
+			"         │           │               ├─ Eq\n" +
+			"         │           │               │   ├─ ct.LUEVY:5!null\n" +
+			"         │           │               │   └─ sn.BRQP2:18!null\n" +
+			"         │           │               ├─ LookupJoin\n" +
+			"         │           │               │   ├─ Eq\n" +
+			"         │           │               │   │   ├─ ci.id:15!null\n" +
+			"         │           │               │   │   └─ ct.FZ2R5:4!null\n" +
+			"         │           │               │   ├─ LookupJoin\n" +
+			"         │           │               │   │   ├─ Eq\n" +
+			"         │           │               │   │   │   ├─ cec.id:0!null\n" +
+			"         │           │               │   │   │   └─ ct.OVE3E:7!null\n" +
+			"         │           │               │   │   ├─ TableAlias(cec)\n" +
+			"         │           │               │   │   │   └─ Table\n" +
+			"         │           │               │   │   │       ├─ name: SFEGG\n" +
+			"         │           │               │   │   │       └─ columns: [id no52d adurz]\n" +
+			"         │           │               │   │   └─ Filter\n" +
+			"         │           │               │   │       ├─ Eq\n" +
+			"         │           │               │   │       │   ├─ ct.ZRV3B:10!null\n" +
+			"         │           │               │   │       │   └─ = (longtext)\n" +
+			"         │           │               │   │       └─ TableAlias(ct)\n" +
+			"         │           │               │   │           └─ IndexedTableAccess(FLQLP)\n" +
+			"         │           │               │   │               ├─ index: [FLQLP.OVE3E]\n" +
+			"         │           │               │   │               └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"         │           │               │   └─ Filter\n" +
+			"         │           │               │       ├─ HashIn\n" +
+			"         │           │               │       │   ├─ ci.FTQLQ:1!null\n" +
+			"         │           │               │       │   └─ TUPLE(SQ1 (longtext))\n" +
+			"         │           │               │       └─ TableAlias(ci)\n" +
+			"         │           │               │           └─ IndexedTableAccess(JDLNA)\n" +
+			"         │           │               │               ├─ index: [JDLNA.id]\n" +
+			"         │           │               │               └─ columns: [id ftqlq]\n" +
+			"         │           │               └─ TableAlias(sn)\n" +
+			"         │           │                   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │           │                       ├─ index: [NOXN3.BRQP2]\n" +
+			"         │           │                       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         │           └─ TableAlias(sn)\n" +
+			"         │               └─ IndexedTableAccess(NOXN3)\n" +
+			"         │                   ├─ index: [NOXN3.id]\n" +
+			"         │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         └─ SubqueryAlias\n" +
+			"             ├─ name: AOEV5\n" +
+			"             ├─ outerVisibility: false\n" +
+			"             ├─ cacheable: true\n" +
+			"             └─ Values() as temp_AOEV5\n" +
+			"                 ├─ Row(\n" +
+			"                 │  1 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  2 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  3 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  4 (longtext))\n" +
+			"                 └─ Row(\n" +
+			"                    5 (longtext))\n" +
 			"",
 	},
 	{
@@ -9523,231 +9618,226 @@ WHERE
 			" ├─ Project\n" +
 			" │   ├─ columns: [convert\n" +
 			" │   │   ├─ type: char\n" +
-			" │   │   └─ T4IBQ:0!null\n" +
-			" │   │   as T4IBQ, DL754:1!null, BDNYB:2!null, ADURZ:3!null, TPXBU:4, NO52D:5!null, IDPK7:6!null]\n" +
-			" │   └─ Project\n" +
-			" │       ├─ columns: [cla.FTQLQ:38!null as T4IBQ, SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
-			" │       │   ├─ cacheable: false\n" +
-			" │       │   └─ Project\n" +
-			" │       │       ├─ columns: [aac.BTXC5:68]\n" +
-			" │       │       └─ Filter\n" +
-			" │       │           ├─ Eq\n" +
-			" │       │           │   ├─ aac.id:67!null\n" +
-			" │       │           │   └─ SL3S5.M22QN:2!null\n" +
-			" │       │           └─ TableAlias(aac)\n" +
-			" │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			" │       │                   ├─ index: [TPXBU.id]\n" +
-			" │       │                   └─ columns: [id btxc5]\n" +
-			" │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
-			" │       └─ LookupJoin\n" +
-			" │           ├─ Eq\n" +
-			" │           │   ├─ cla.id:37!null\n" +
-			" │           │   └─ bs.IXUXU:35\n" +
-			" │           ├─ LookupJoin\n" +
-			" │           │   ├─ Eq\n" +
-			" │           │   │   ├─ bs.id:33!null\n" +
-			" │           │   │   └─ mf.GXLUB:17!null\n" +
-			" │           │   ├─ LookupJoin\n" +
-			" │           │   │   ├─ AND\n" +
-			" │           │   │   │   ├─ Eq\n" +
-			" │           │   │   │   │   ├─ sn.BRQP2:7!null\n" +
-			" │           │   │   │   │   └─ mf.LUEVY:18!null\n" +
-			" │           │   │   │   └─ Eq\n" +
-			" │           │   │   │       ├─ SL3S5.M22QN:2!null\n" +
-			" │           │   │   │       └─ mf.M22QN:19!null\n" +
-			" │           │   │   ├─ LookupJoin\n" +
-			" │           │   │   │   ├─ Eq\n" +
-			" │           │   │   │   │   ├─ SL3S5.BDNYB:0!null\n" +
-			" │           │   │   │   │   └─ sn.id:6!null\n" +
-			" │           │   │   │   ├─ SubqueryAlias\n" +
-			" │           │   │   │   │   ├─ name: SL3S5\n" +
-			" │           │   │   │   │   ├─ outerVisibility: false\n" +
-			" │           │   │   │   │   ├─ cacheable: true\n" +
-			" │           │   │   │   │   └─ Project\n" +
-			" │           │   │   │   │       ├─ columns: [KHJJO.BDNYB:1!null as BDNYB, ci.FTQLQ:13!null as TOFPN, ct.M22QN:8!null as M22QN, cec.ADURZ:5!null as ADURZ, cec.NO52D:4!null as NO52D, ct.S3Q3Y:10!null as IDPK7]\n" +
-			" │           │   │   │   │       └─ HashJoin\n" +
-			" │           │   │   │   │           ├─ AND\n" +
-			" │           │   │   │   │           │   ├─ Eq\n" +
-			" │           │   │   │   │           │   │   ├─ ct.M22QN:8!null\n" +
-			" │           │   │   │   │           │   │   └─ KHJJO.M22QN:0!null\n" +
-			" │           │   │   │   │           │   └─ Eq\n" +
-			" │           │   │   │   │           │       ├─ ct.LUEVY:7!null\n" +
-			" │           │   │   │   │           │       └─ KHJJO.LUEVY:2!null\n" +
-			" │           │   │   │   │           ├─ SubqueryAlias\n" +
-			" │           │   │   │   │           │   ├─ name: KHJJO\n" +
-			" │           │   │   │   │           │   ├─ outerVisibility: false\n" +
-			" │           │   │   │   │           │   ├─ cacheable: true\n" +
-			" │           │   │   │   │           │   └─ Distinct\n" +
-			" │           │   │   │   │           │       └─ Project\n" +
-			" │           │   │   │   │           │           ├─ columns: [mf.M22QN:13!null as M22QN, sn.id:0!null as BDNYB, mf.LUEVY:12!null as LUEVY]\n" +
-			" │           │   │   │   │           │           └─ LookupJoin\n" +
-			" │           │   │   │   │           │               ├─ Eq\n" +
-			" │           │   │   │   │           │               │   ├─ sn.BRQP2:1!null\n" +
-			" │           │   │   │   │           │               │   └─ mf.LUEVY:12!null\n" +
-			" │           │   │   │   │           │               ├─ TableAlias(sn)\n" +
-			" │           │   │   │   │           │               │   └─ Table\n" +
-			" │           │   │   │   │           │               │       ├─ name: NOXN3\n" +
-			" │           │   │   │   │           │               │       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			" │           │   │   │   │           │               └─ TableAlias(mf)\n" +
-			" │           │   │   │   │           │                   └─ IndexedTableAccess(HGMQ6)\n" +
-			" │           │   │   │   │           │                       ├─ index: [HGMQ6.LUEVY]\n" +
-			" │           │   │   │   │           │                       └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
-			" │           │   │   │   │           └─ HashLookup\n" +
-			" │           │   │   │   │               ├─ source: TUPLE(KHJJO.M22QN:0!null, KHJJO.LUEVY:2!null)\n" +
-			" │           │   │   │   │               ├─ target: TUPLE(ct.M22QN:5!null, ct.LUEVY:4!null)\n" +
-			" │           │   │   │   │               └─ CachedResults\n" +
-			" │           │   │   │   │                   └─ LookupJoin\n" +
-			" │           │   │   │   │                       ├─ Eq\n" +
-			" │           │   │   │   │                       │   ├─ ci.id:12!null\n" +
-			" │           │   │   │   │                       │   └─ ct.FZ2R5:6!null\n" +
-			" │           │   │   │   │                       ├─ LookupJoin\n" +
-			" │           │   │   │   │                       │   ├─ Eq\n" +
-			" │           │   │   │   │                       │   │   ├─ cec.id:3!null\n" +
-			" │           │   │   │   │                       │   │   └─ ct.OVE3E:9!null\n" +
-			" │           │   │   │   │                       │   ├─ TableAlias(cec)\n" +
-			" │           │   │   │   │                       │   │   └─ Table\n" +
-			" │           │   │   │   │                       │   │       ├─ name: SFEGG\n" +
-			" │           │   │   │   │                       │   │       └─ columns: [id no52d adurz]\n" +
-			" │           │   │   │   │                       │   └─ Filter\n" +
-			" │           │   │   │   │                       │       ├─ Eq\n" +
-			" │           │   │   │   │                       │       │   ├─ ct.ZRV3B:5!null\n" +
-			" │           │   │   │   │                       │       │   └─ = (longtext)\n" +
-			" │           │   │   │   │                       │       └─ TableAlias(ct)\n" +
-			" │           │   │   │   │                       │           └─ IndexedTableAccess(FLQLP)\n" +
-			" │           │   │   │   │                       │               ├─ index: [FLQLP.OVE3E]\n" +
-			" │           │   │   │   │                       │               └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
-			" │           │   │   │   │                       └─ Filter\n" +
-			" │           │   │   │   │                           ├─ HashIn\n" +
-			" │           │   │   │   │                           │   ├─ ci.FTQLQ:1!null\n" +
-			" │           │   │   │   │                           │   └─ TUPLE(SQ1 (longtext))\n" +
-			" │           │   │   │   │                           └─ TableAlias(ci)\n" +
-			" │           │   │   │   │                               └─ IndexedTableAccess(JDLNA)\n" +
-			" │           │   │   │   │                                   ├─ index: [JDLNA.id]\n" +
-			" │           │   │   │   │                                   └─ columns: [id ftqlq]\n" +
-			" │           │   │   │   └─ TableAlias(sn)\n" +
-			" │           │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
-			" │           │   │   │           ├─ index: [NOXN3.id]\n" +
-			" │           │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			" │           │   │   └─ TableAlias(mf)\n" +
-			" │           │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			" │           │   │           ├─ index: [HGMQ6.LUEVY]\n" +
-			" │           │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
-			" │           │   └─ TableAlias(bs)\n" +
-			" │           │       └─ IndexedTableAccess(THNTS)\n" +
-			" │           │           ├─ index: [THNTS.id]\n" +
-			" │           │           └─ columns: [id nfryn ixuxu fhcyt]\n" +
-			" │           └─ Filter\n" +
-			" │               ├─ HashIn\n" +
-			" │               │   ├─ cla.FTQLQ:1!null\n" +
-			" │               │   └─ TUPLE(SQ1 (longtext))\n" +
-			" │               └─ TableAlias(cla)\n" +
-			" │                   └─ IndexedTableAccess(YK2GW)\n" +
-			" │                       ├─ index: [YK2GW.id]\n" +
-			" │                       └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
+			" │   │   └─ cla.FTQLQ:38!null\n" +
+			" │   │   as T4IBQ, SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
+			" │   │   ├─ cacheable: false\n" +
+			" │   │   └─ Project\n" +
+			" │   │       ├─ columns: [aac.BTXC5:68]\n" +
+			" │   │       └─ Filter\n" +
+			" │   │           ├─ Eq\n" +
+			" │   │           │   ├─ aac.id:67!null\n" +
+			" │   │           │   └─ SL3S5.M22QN:2!null\n" +
+			" │   │           └─ TableAlias(aac)\n" +
+			" │   │               └─ IndexedTableAccess(TPXBU)\n" +
+			" │   │                   ├─ index: [TPXBU.id]\n" +
+			" │   │                   └─ columns: [id btxc5]\n" +
+			" │   │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
+			" │   └─ LookupJoin\n" +
+			" │       ├─ Eq\n" +
+			" │       │   ├─ cla.id:37!null\n" +
+			" │       │   └─ bs.IXUXU:35\n" +
+			" │       ├─ LookupJoin\n" +
+			" │       │   ├─ Eq\n" +
+			" │       │   │   ├─ bs.id:33!null\n" +
+			" │       │   │   └─ mf.GXLUB:17!null\n" +
+			" │       │   ├─ LookupJoin\n" +
+			" │       │   │   ├─ AND\n" +
+			" │       │   │   │   ├─ Eq\n" +
+			" │       │   │   │   │   ├─ sn.BRQP2:7!null\n" +
+			" │       │   │   │   │   └─ mf.LUEVY:18!null\n" +
+			" │       │   │   │   └─ Eq\n" +
+			" │       │   │   │       ├─ SL3S5.M22QN:2!null\n" +
+			" │       │   │   │       └─ mf.M22QN:19!null\n" +
+			" │       │   │   ├─ LookupJoin\n" +
+			" │       │   │   │   ├─ Eq\n" +
+			" │       │   │   │   │   ├─ SL3S5.BDNYB:0!null\n" +
+			" │       │   │   │   │   └─ sn.id:6!null\n" +
+			" │       │   │   │   ├─ SubqueryAlias\n" +
+			" │       │   │   │   │   ├─ name: SL3S5\n" +
+			" │       │   │   │   │   ├─ outerVisibility: false\n" +
+			" │       │   │   │   │   ├─ cacheable: true\n" +
+			" │       │   │   │   │   └─ Project\n" +
+			" │       │   │   │   │       ├─ columns: [KHJJO.BDNYB:1!null as BDNYB, ci.FTQLQ:13!null as TOFPN, ct.M22QN:8!null as M22QN, cec.ADURZ:5!null as ADURZ, cec.NO52D:4!null as NO52D, ct.S3Q3Y:10!null as IDPK7]\n" +
+			" │       │   │   │   │       └─ HashJoin\n" +
+			" │       │   │   │   │           ├─ AND\n" +
+			" │       │   │   │   │           │   ├─ Eq\n" +
+			" │       │   │   │   │           │   │   ├─ ct.M22QN:8!null\n" +
+			" │       │   │   │   │           │   │   └─ KHJJO.M22QN:0!null\n" +
+			" │       │   │   │   │           │   └─ Eq\n" +
+			" │       │   │   │   │           │       ├─ ct.LUEVY:7!null\n" +
+			" │       │   │   │   │           │       └─ KHJJO.LUEVY:2!null\n" +
+			" │       │   │   │   │           ├─ SubqueryAlias\n" +
+			" │       │   │   │   │           │   ├─ name: KHJJO\n" +
+			" │       │   │   │   │           │   ├─ outerVisibility: false\n" +
+			" │       │   │   │   │           │   ├─ cacheable: true\n" +
+			" │       │   │   │   │           │   └─ Distinct\n" +
+			" │       │   │   │   │           │       └─ Project\n" +
+			" │       │   │   │   │           │           ├─ columns: [mf.M22QN:13!null as M22QN, sn.id:0!null as BDNYB, mf.LUEVY:12!null as LUEVY]\n" +
+			" │       │   │   │   │           │           └─ LookupJoin\n" +
+			" │       │   │   │   │           │               ├─ Eq\n" +
+			" │       │   │   │   │           │               │   ├─ sn.BRQP2:1!null\n" +
+			" │       │   │   │   │           │               │   └─ mf.LUEVY:12!null\n" +
+			" │       │   │   │   │           │               ├─ TableAlias(sn)\n" +
+			" │       │   │   │   │           │               │   └─ Table\n" +
+			" │       │   │   │   │           │               │       ├─ name: NOXN3\n" +
+			" │       │   │   │   │           │               │       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			" │       │   │   │   │           │               └─ TableAlias(mf)\n" +
+			" │       │   │   │   │           │                   └─ IndexedTableAccess(HGMQ6)\n" +
+			" │       │   │   │   │           │                       ├─ index: [HGMQ6.LUEVY]\n" +
+			" │       │   │   │   │           │                       └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
+			" │       │   │   │   │           └─ HashLookup\n" +
+			" │       │   │   │   │               ├─ source: TUPLE(KHJJO.M22QN:0!null, KHJJO.LUEVY:2!null)\n" +
+			" │       │   │   │   │               ├─ target: TUPLE(ct.M22QN:5!null, ct.LUEVY:4!null)\n" +
+			" │       │   │   │   │               └─ CachedResults\n" +
+			" │       │   │   │   │                   └─ LookupJoin\n" +
+			" │       │   │   │   │                       ├─ Eq\n" +
+			" │       │   │   │   │                       │   ├─ ci.id:12!null\n" +
+			" │       │   │   │   │                       │   └─ ct.FZ2R5:6!null\n" +
+			" │       │   │   │   │                       ├─ LookupJoin\n" +
+			" │       │   │   │   │                       │   ├─ Eq\n" +
+			" │       │   │   │   │                       │   │   ├─ cec.id:3!null\n" +
+			" │       │   │   │   │                       │   │   └─ ct.OVE3E:9!null\n" +
+			" │       │   │   │   │                       │   ├─ TableAlias(cec)\n" +
+			" │       │   │   │   │                       │   │   └─ Table\n" +
+			" │       │   │   │   │                       │   │       ├─ name: SFEGG\n" +
+			" │       │   │   │   │                       │   │       └─ columns: [id no52d adurz]\n" +
+			" │       │   │   │   │                       │   └─ Filter\n" +
+			" │       │   │   │   │                       │       ├─ Eq\n" +
+			" │       │   │   │   │                       │       │   ├─ ct.ZRV3B:5!null\n" +
+			" │       │   │   │   │                       │       │   └─ = (longtext)\n" +
+			" │       │   │   │   │                       │       └─ TableAlias(ct)\n" +
+			" │       │   │   │   │                       │           └─ IndexedTableAccess(FLQLP)\n" +
+			" │       │   │   │   │                       │               ├─ index: [FLQLP.OVE3E]\n" +
+			" │       │   │   │   │                       │               └─ columns: [fz2r5 luevy m22qn ove3e s3q3y zrv3b]\n" +
+			" │       │   │   │   │                       └─ Filter\n" +
+			" │       │   │   │   │                           ├─ HashIn\n" +
+			" │       │   │   │   │                           │   ├─ ci.FTQLQ:1!null\n" +
+			" │       │   │   │   │                           │   └─ TUPLE(SQ1 (longtext))\n" +
+			" │       │   │   │   │                           └─ TableAlias(ci)\n" +
+			" │       │   │   │   │                               └─ IndexedTableAccess(JDLNA)\n" +
+			" │       │   │   │   │                                   ├─ index: [JDLNA.id]\n" +
+			" │       │   │   │   │                                   └─ columns: [id ftqlq]\n" +
+			" │       │   │   │   └─ TableAlias(sn)\n" +
+			" │       │   │   │       └─ IndexedTableAccess(NOXN3)\n" +
+			" │       │   │   │           ├─ index: [NOXN3.id]\n" +
+			" │       │   │   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			" │       │   │   └─ TableAlias(mf)\n" +
+			" │       │   │       └─ IndexedTableAccess(HGMQ6)\n" +
+			" │       │   │           ├─ index: [HGMQ6.LUEVY]\n" +
+			" │       │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
+			" │       │   └─ TableAlias(bs)\n" +
+			" │       │       └─ IndexedTableAccess(THNTS)\n" +
+			" │       │           ├─ index: [THNTS.id]\n" +
+			" │       │           └─ columns: [id nfryn ixuxu fhcyt]\n" +
+			" │       └─ Filter\n" +
+			" │           ├─ HashIn\n" +
+			" │           │   ├─ cla.FTQLQ:1!null\n" +
+			" │           │   └─ TUPLE(SQ1 (longtext))\n" +
+			" │           └─ TableAlias(cla)\n" +
+			" │               └─ IndexedTableAccess(YK2GW)\n" +
+			" │                   ├─ index: [YK2GW.id]\n" +
+			" │                   └─ columns: [id ftqlq tuxml paef5 rucy4 tpnj6 lbl53 nb3qs eo7iv muhjf fm34l ty5rf zhtlh npb7w sx3hh isbnf ya7yb c5ykb qk7kt ffge6 fiigj sh3nc ntena m4aub x5air sab6m g5qi5 zvqvd ykssu fhcyt]\n" +
 			" └─ Project\n" +
-			"     ├─ columns: [AOEV5.T4IBQ:0!null as T4IBQ, VUMUY.DL754:1!null, VUMUY.BDNYB:2!null, VUMUY.ADURZ:3!null, VUMUY.TPXBU:4, VUMUY.NO52D:5!null, VUMUY.IDPK7:6!null]\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [AOEV5.T4IBQ:6!null, VUMUY.DL754:0!null, VUMUY.BDNYB:1!null, VUMUY.ADURZ:2!null, VUMUY.TPXBU:3, VUMUY.NO52D:4!null, VUMUY.IDPK7:5!null]\n" +
-			"         └─ CrossJoin\n" +
-			"             ├─ SubqueryAlias\n" +
-			"             │   ├─ name: VUMUY\n" +
-			"             │   ├─ outerVisibility: false\n" +
-			"             │   ├─ cacheable: true\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
-			"             │       │   ├─ cacheable: false\n" +
-			"             │       │   └─ Project\n" +
-			"             │       │       ├─ columns: [aac.BTXC5:17]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ Eq\n" +
-			"             │       │           │   ├─ aac.id:16!null\n" +
-			"             │       │           │   └─ SL3S5.M22QN:2!null\n" +
-			"             │       │           └─ TableAlias(aac)\n" +
-			"             │       │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │                   ├─ index: [TPXBU.id]\n" +
-			"             │       │                   └─ columns: [id btxc5]\n" +
-			"             │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
-			"             │       └─ LookupJoin\n" +
-			"             │           ├─ Eq\n" +
-			"             │           │   ├─ SL3S5.BDNYB:0!null\n" +
-			"             │           │   └─ sn.id:6!null\n" +
-			"             │           ├─ SubqueryAlias\n" +
-			"             │           │   ├─ name: SL3S5\n" +
-			"             │           │   ├─ outerVisibility: false\n" +
-			"             │           │   ├─ cacheable: true\n" +
-			"             │           │   └─ Project\n" +
-			"             │           │       ├─ columns: [sn.id:17!null as BDNYB, ci.FTQLQ:16!null as TOFPN, ct.M22QN:6!null as M22QN, cec.ADURZ:2!null as ADURZ, cec.NO52D:1!null as NO52D, ct.S3Q3Y:12!null as IDPK7]\n" +
-			"             │           │       └─ Filter\n" +
-			"             │           │           ├─ Eq\n" +
-			"             │           │           │   ├─ ct.M22QN:6!null\n" +
-			"             │           │           │   └─ Subquery\n" +
-			"             │           │           │       ├─ cacheable: true\n" +
-			"             │           │           │       └─ Project\n" +
-			"             │           │           │           ├─ columns: [aac.id:27!null]\n" +
-			"             │           │           │           └─ Filter\n" +
-			"             │           │           │               ├─ Eq\n" +
-			"             │           │           │               │   ├─ aac.BTXC5:28\n" +
-			"             │           │           │               │   └─ WT (longtext)\n" +
-			"             │           │           │               └─ TableAlias(aac)\n" +
-			"             │           │           │                   └─ IndexedTableAccess(TPXBU)\n" +
-			"             │           │           │                       ├─ index: [TPXBU.BTXC5]\n" +
-			"             │           │           │                       ├─ static: [{[WT, WT]}]\n" +
-			"             │           │           │                       └─ columns: [id btxc5]\n" +
-			"             │           │           └─ LookupJoin\n" +
-			"             │           │               ├─ Eq\n" +
-			"             │           │               │   ├─ ct.LUEVY:5!null\n" +
-			"             │           │               │   └─ sn.BRQP2:18!null\n" +
-			"             │           │               ├─ LookupJoin\n" +
-			"             │           │               │   ├─ Eq\n" +
-			"             │           │               │   │   ├─ ci.id:15!null\n" +
-			"             │           │               │   │   └─ ct.FZ2R5:4!null\n" +
-			"             │           │               │   ├─ LookupJoin\n" +
-			"             │           │               │   │   ├─ Eq\n" +
-			"             │           │               │   │   │   ├─ cec.id:0!null\n" +
-			"             │           │               │   │   │   └─ ct.OVE3E:7!null\n" +
-			"             │           │               │   │   ├─ TableAlias(cec)\n" +
-			"             │           │               │   │   │   └─ Table\n" +
-			"             │           │               │   │   │       ├─ name: SFEGG\n" +
-			"             │           │               │   │   │       └─ columns: [id no52d adurz]\n" +
-			"             │           │               │   │   └─ Filter\n" +
-			"             │           │               │   │       ├─ Eq\n" +
-			"             │           │               │   │       │   ├─ ct.ZRV3B:10!null\n" +
-			"             │           │               │   │       │   └─ = (longtext)\n" +
-			"             │           │               │   │       └─ TableAlias(ct)\n" +
-			"             │           │               │   │           └─ IndexedTableAccess(FLQLP)\n" +
-			"             │           │               │   │               ├─ index: [FLQLP.OVE3E]\n" +
-			"             │           │               │   │               └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
-			"             │           │               │   └─ Filter\n" +
-			"             │           │               │       ├─ HashIn\n" +
-			"             │           │               │       │   ├─ ci.FTQLQ:1!null\n" +
-			"             │           │               │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"             │           │               │       └─ TableAlias(ci)\n" +
-			"             │           │               │           └─ IndexedTableAccess(JDLNA)\n" +
-			"             │           │               │               ├─ index: [JDLNA.id]\n" +
-			"             │           │               │               └─ columns: [id ftqlq]\n" +
-			"             │           │               └─ TableAlias(sn)\n" +
-			"             │           │                   └─ IndexedTableAccess(NOXN3)\n" +
-			"             │           │                       ├─ index: [NOXN3.BRQP2]\n" +
-			"             │           │                       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             │           └─ TableAlias(sn)\n" +
-			"             │               └─ IndexedTableAccess(NOXN3)\n" +
-			"             │                   ├─ index: [NOXN3.id]\n" +
-			"             │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"             └─ SubqueryAlias\n" +
-			"                 ├─ name: AOEV5\n" +
-			"                 ├─ outerVisibility: false\n" +
-			"                 ├─ cacheable: true\n" +
-			"                 └─ Values() as temp_AOEV5\n" +
-			"                     ├─ Row(\n" +
-			"                     │  1 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  2 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  3 (longtext))\n" +
-			"                     ├─ Row(\n" +
-			"                     │  4 (longtext))\n" +
-			"                     └─ Row(\n" +
-			"                        5 (longtext))\n" +
+			"     ├─ columns: [AOEV5.T4IBQ:6!null, VUMUY.DL754:0!null, VUMUY.BDNYB:1!null, VUMUY.ADURZ:2!null, VUMUY.TPXBU:3, VUMUY.NO52D:4!null, VUMUY.IDPK7:5!null]\n" +
+			"     └─ CrossJoin\n" +
+			"         ├─ SubqueryAlias\n" +
+			"         │   ├─ name: VUMUY\n" +
+			"         │   ├─ outerVisibility: false\n" +
+			"         │   ├─ cacheable: true\n" +
+			"         │   └─ Project\n" +
+			"         │       ├─ columns: [SL3S5.TOFPN:1!null as DL754, sn.id:6!null as BDNYB, SL3S5.ADURZ:3!null as ADURZ, Subquery\n" +
+			"         │       │   ├─ cacheable: false\n" +
+			"         │       │   └─ Project\n" +
+			"         │       │       ├─ columns: [aac.BTXC5:17]\n" +
+			"         │       │       └─ Filter\n" +
+			"         │       │           ├─ Eq\n" +
+			"         │       │           │   ├─ aac.id:16!null\n" +
+			"         │       │           │   └─ SL3S5.M22QN:2!null\n" +
+			"         │       │           └─ TableAlias(aac)\n" +
+			"         │       │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │       │                   ├─ index: [TPXBU.id]\n" +
+			"         │       │                   └─ columns: [id btxc5]\n" +
+			"         │       │   as TPXBU, SL3S5.NO52D:4!null as NO52D, SL3S5.IDPK7:5!null as IDPK7]\n" +
+			"         │       └─ LookupJoin\n" +
+			"         │           ├─ Eq\n" +
+			"         │           │   ├─ SL3S5.BDNYB:0!null\n" +
+			"         │           │   └─ sn.id:6!null\n" +
+			"         │           ├─ SubqueryAlias\n" +
+			"         │           │   ├─ name: SL3S5\n" +
+			"         │           │   ├─ outerVisibility: false\n" +
+			"         │           │   ├─ cacheable: true\n" +
+			"         │           │   └─ Project\n" +
+			"         │           │       ├─ columns: [sn.id:17!null as BDNYB, ci.FTQLQ:16!null as TOFPN, ct.M22QN:6!null as M22QN, cec.ADURZ:2!null as ADURZ, cec.NO52D:1!null as NO52D, ct.S3Q3Y:12!null as IDPK7]\n" +
+			"         │           │       └─ Filter\n" +
+			"         │           │           ├─ Eq\n" +
+			"         │           │           │   ├─ ct.M22QN:6!null\n" +
+			"         │           │           │   └─ Subquery\n" +
+			"         │           │           │       ├─ cacheable: true\n" +
+			"         │           │           │       └─ Project\n" +
+			"         │           │           │           ├─ columns: [aac.id:27!null]\n" +
+			"         │           │           │           └─ TableAlias(aac)\n" +
+			"         │           │           │               └─ IndexedTableAccess(TPXBU)\n" +
+			"         │           │           │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"         │           │           │                   ├─ static: [{[WT, WT]}]\n" +
+			"         │           │           │                   ├─ columns: [id btxc5]\n" +
+			"         │           │           │                   └─ index condition: Eq\n" +
+			"         │           │           │                       ├─ TPXBU.BTXC5:1\n" +
+			"         │           │           │                       └─ WT (longtext)\n" +
+			"         │           │           └─ LookupJoin\n" +
+			"         │           │               ├─ Eq\n" +
+			"         │           │               │   ├─ ct.LUEVY:5!null\n" +
+			"         │           │               │   └─ sn.BRQP2:18!null\n" +
+			"         │           │               ├─ LookupJoin\n" +
+			"         │           │               │   ├─ Eq\n" +
+			"         │           │               │   │   ├─ ci.id:15!null\n" +
+			"         │           │               │   │   └─ ct.FZ2R5:4!null\n" +
+			"         │           │               │   ├─ LookupJoin\n" +
+			"         │           │               │   │   ├─ Eq\n" +
+			"         │           │               │   │   │   ├─ cec.id:0!null\n" +
+			"         │           │               │   │   │   └─ ct.OVE3E:7!null\n" +
+			"         │           │               │   │   ├─ TableAlias(cec)\n" +
+			"         │           │               │   │   │   └─ Table\n" +
+			"         │           │               │   │   │       ├─ name: SFEGG\n" +
+			"         │           │               │   │   │       └─ columns: [id no52d adurz]\n" +
+			"         │           │               │   │   └─ Filter\n" +
+			"         │           │               │   │       ├─ Eq\n" +
+			"         │           │               │   │       │   ├─ ct.ZRV3B:10!null\n" +
+			"         │           │               │   │       │   └─ = (longtext)\n" +
+			"         │           │               │   │       └─ TableAlias(ct)\n" +
+			"         │           │               │   │           └─ IndexedTableAccess(FLQLP)\n" +
+			"         │           │               │   │               ├─ index: [FLQLP.OVE3E]\n" +
+			"         │           │               │   │               └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"         │           │               │   └─ Filter\n" +
+			"         │           │               │       ├─ HashIn\n" +
+			"         │           │               │       │   ├─ ci.FTQLQ:1!null\n" +
+			"         │           │               │       │   └─ TUPLE(SQ1 (longtext))\n" +
+			"         │           │               │       └─ TableAlias(ci)\n" +
+			"         │           │               │           └─ IndexedTableAccess(JDLNA)\n" +
+			"         │           │               │               ├─ index: [JDLNA.id]\n" +
+			"         │           │               │               └─ columns: [id ftqlq]\n" +
+			"         │           │               └─ TableAlias(sn)\n" +
+			"         │           │                   └─ IndexedTableAccess(NOXN3)\n" +
+			"         │           │                       ├─ index: [NOXN3.BRQP2]\n" +
+			"         │           │                       └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         │           └─ TableAlias(sn)\n" +
+			"         │               └─ IndexedTableAccess(NOXN3)\n" +
+			"         │                   ├─ index: [NOXN3.id]\n" +
+			"         │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"         └─ SubqueryAlias\n" +
+			"             ├─ name: AOEV5\n" +
+			"             ├─ outerVisibility: false\n" +
+			"             ├─ cacheable: true\n" +
+			"             └─ Values() as temp_AOEV5\n" +
+			"                 ├─ Row(\n" +
+			"                 │  1 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  2 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  3 (longtext))\n" +
+			"                 ├─ Row(\n" +
+			"                 │  4 (longtext))\n" +
+			"                 └─ Row(\n" +
+			"                    5 (longtext))\n" +
 			"",
 	},
 	{
@@ -9755,9 +9845,7 @@ WHERE
 	SELECT COUNT(*) FROM NOXN3`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [COUNT(1):0!null as COUNT(*)]\n" +
-			" └─ GroupBy\n" +
-			"     ├─ select: COUNT(1 (bigint))\n" +
-			"     ├─ group: \n" +
+			" └─ TableCount\n" +
 			"     └─ Table\n" +
 			"         ├─ name: NOXN3\n" +
 			"         └─ columns: []\n" +
@@ -9969,16 +10057,15 @@ WHERE
 			"         │   ├─ Eq\n" +
 			"         │   │   ├─ nd.DKCAJ:3!null\n" +
 			"         │   │   └─ nt.id:0!null\n" +
-			"         │   ├─ Filter\n" +
-			"         │   │   ├─ NOT\n" +
-			"         │   │   │   └─ Eq\n" +
-			"         │   │   │       ├─ nt.DZLIM:1!null\n" +
-			"         │   │   │       └─ SUZTA (longtext)\n" +
-			"         │   │   └─ TableAlias(nt)\n" +
-			"         │   │       └─ IndexedTableAccess(F35MI)\n" +
-			"         │   │           ├─ index: [F35MI.DZLIM]\n" +
-			"         │   │           ├─ static: [{(SUZTA, ∞)}, {(NULL, SUZTA)}]\n" +
-			"         │   │           └─ columns: [id dzlim]\n" +
+			"         │   ├─ TableAlias(nt)\n" +
+			"         │   │   └─ IndexedTableAccess(F35MI)\n" +
+			"         │   │       ├─ index: [F35MI.DZLIM]\n" +
+			"         │   │       ├─ static: [{(SUZTA, ∞)}, {(NULL, SUZTA)}]\n" +
+			"         │   │       ├─ columns: [id dzlim]\n" +
+			"         │   │       └─ index condition: NOT\n" +
+			"         │   │           └─ Eq\n" +
+			"         │   │               ├─ F35MI.DZLIM:1!null\n" +
+			"         │   │               └─ SUZTA (longtext)\n" +
 			"         │   └─ TableAlias(nd)\n" +
 			"         │       └─ IndexedTableAccess(E2I7U)\n" +
 			"         │           ├─ index: [E2I7U.DKCAJ]\n" +
@@ -9995,14 +10082,13 @@ WHERE
 	   FTQLQ, TPNJ6
 	FROM YK2GW
 	WHERE FTQLQ IN ('SQ1')`,
-		ExpectedPlan: "Filter\n" +
-			" ├─ HashIn\n" +
-			" │   ├─ YK2GW.FTQLQ:0!null\n" +
-			" │   └─ TUPLE(SQ1 (longtext))\n" +
-			" └─ IndexedTableAccess(YK2GW)\n" +
-			"     ├─ index: [YK2GW.FTQLQ]\n" +
-			"     ├─ static: [{[SQ1, SQ1]}]\n" +
-			"     └─ columns: [ftqlq tpnj6]\n" +
+		ExpectedPlan: "IndexedTableAccess(YK2GW)\n" +
+			" ├─ index: [YK2GW.FTQLQ]\n" +
+			" ├─ static: [{[SQ1, SQ1]}]\n" +
+			" ├─ columns: [ftqlq tpnj6]\n" +
+			" └─ index condition: IN\n" +
+			"     ├─ left: YK2GW.FTQLQ:1!null\n" +
+			"     └─ right: TUPLE(SQ1 (longtext))\n" +
 			"",
 	},
 	{
@@ -10244,301 +10330,296 @@ WHERE
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [T4IBQ:0!null, ECUWU:1, SUM(XPRW6.B5OUF):2!null as B5OUF, SUM(XPRW6.SP4SI):3!null as SP4SI]\n" +
 			" └─ GroupBy\n" +
-			"     ├─ select: T4IBQ:0!null, ECUWU:1, SUM(XPRW6.B5OUF:2), SUM(XPRW6.SP4SI:3!null)\n" +
-			"     ├─ group: T4IBQ:0!null, ECUWU:1\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [XPRW6.T4IBQ:0!null as T4IBQ, XPRW6.ECUWU:1 as ECUWU, XPRW6.B5OUF:3, XPRW6.SP4SI:4!null]\n" +
-			"         └─ SubqueryAlias\n" +
-			"             ├─ name: XPRW6\n" +
-			"             ├─ outerVisibility: false\n" +
-			"             ├─ cacheable: true\n" +
-			"             └─ Project\n" +
-			"                 ├─ columns: [T4IBQ:0!null, ECUWU:1, GSTQA:2, B5OUF:3, SUM(CASE  WHEN ((NRFJ3.OZTQF < 0.5) OR (NRFJ3.YHYLK = 0)) THEN 1 ELSE 0 END):4!null as SP4SI]\n" +
-			"                 └─ GroupBy\n" +
-			"                     ├─ select: T4IBQ:0!null, ECUWU:1, GSTQA:2, NRFJ3.B5OUF:3 as B5OUF, SUM(CASE  WHEN Or\n" +
-			"                     │   ├─ LessThan\n" +
-			"                     │   │   ├─ NRFJ3.OZTQF:4\n" +
-			"                     │   │   └─ 0.500000 (double)\n" +
-			"                     │   └─ Eq\n" +
-			"                     │       ├─ NRFJ3.YHYLK:5\n" +
-			"                     │       └─ 0 (tinyint)\n" +
-			"                     │   THEN 1 (tinyint) ELSE 0 (tinyint) END)\n" +
-			"                     ├─ group: T4IBQ:0!null, ECUWU:1, GSTQA:2\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [NRFJ3.T4IBQ:0!null as T4IBQ, NRFJ3.ECUWU:1 as ECUWU, NRFJ3.GSTQA:2 as GSTQA, NRFJ3.B5OUF:3, NRFJ3.OZTQF:5, NRFJ3.YHYLK:6]\n" +
-			"                         └─ SubqueryAlias\n" +
-			"                             ├─ name: NRFJ3\n" +
-			"                             ├─ outerVisibility: false\n" +
-			"                             ├─ cacheable: true\n" +
-			"                             └─ Distinct\n" +
+			"     ├─ select: XPRW6.T4IBQ:0!null as T4IBQ, XPRW6.ECUWU:1 as ECUWU, SUM(XPRW6.B5OUF:3), SUM(XPRW6.SP4SI:4!null)\n" +
+			"     ├─ group: XPRW6.T4IBQ:0!null, XPRW6.ECUWU:1\n" +
+			"     └─ SubqueryAlias\n" +
+			"         ├─ name: XPRW6\n" +
+			"         ├─ outerVisibility: false\n" +
+			"         ├─ cacheable: true\n" +
+			"         └─ Project\n" +
+			"             ├─ columns: [T4IBQ:0!null, ECUWU:1, GSTQA:2, B5OUF:3, SUM(CASE  WHEN ((NRFJ3.OZTQF < 0.5) OR (NRFJ3.YHYLK = 0)) THEN 1 ELSE 0 END):4!null as SP4SI]\n" +
+			"             └─ GroupBy\n" +
+			"                 ├─ select: NRFJ3.T4IBQ:0!null as T4IBQ, NRFJ3.ECUWU:1 as ECUWU, NRFJ3.GSTQA:2 as GSTQA, NRFJ3.B5OUF:3 as B5OUF, SUM(CASE  WHEN Or\n" +
+			"                 │   ├─ LessThan\n" +
+			"                 │   │   ├─ NRFJ3.OZTQF:5\n" +
+			"                 │   │   └─ 0.500000 (double)\n" +
+			"                 │   └─ Eq\n" +
+			"                 │       ├─ NRFJ3.YHYLK:6\n" +
+			"                 │       └─ 0 (tinyint)\n" +
+			"                 │   THEN 1 (tinyint) ELSE 0 (tinyint) END)\n" +
+			"                 ├─ group: NRFJ3.T4IBQ:0!null, NRFJ3.ECUWU:1, NRFJ3.GSTQA:2\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: NRFJ3\n" +
+			"                     ├─ outerVisibility: false\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [AX7FV.T4IBQ:0!null, AX7FV.ECUWU:1, AX7FV.GSTQA:2, AX7FV.B5OUF:3, AX7FV.TW55N:6, AX7FV.OZTQF:4, AX7FV.YHYLK:5]\n" +
+			"                             └─ SubqueryAlias\n" +
+			"                                 ├─ name: AX7FV\n" +
+			"                                 ├─ outerVisibility: false\n" +
+			"                                 ├─ cacheable: true\n" +
 			"                                 └─ Project\n" +
-			"                                     ├─ columns: [AX7FV.T4IBQ:0!null, AX7FV.ECUWU:1, AX7FV.GSTQA:2, AX7FV.B5OUF:3, AX7FV.TW55N:6, AX7FV.OZTQF:4, AX7FV.YHYLK:5]\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: AX7FV\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [bs.T4IBQ:1!null as T4IBQ, pa.DZLIM:3 as ECUWU, pga.DZLIM:12 as GSTQA, pog.B5OUF:10, fc.OZTQF:20, F26ZW.YHYLK:24, nd.TW55N:16 as TW55N]\n" +
-			"                                             └─ Filter\n" +
-			"                                                 ├─ Eq\n" +
-			"                                                 │   ├─ ms.D237E:6\n" +
-			"                                                 │   └─ true (tinyint)\n" +
-			"                                                 └─ LeftOuterLookupJoin\n" +
-			"                                                     ├─ Eq\n" +
-			"                                                     │   ├─ nd.HPCMS:17\n" +
-			"                                                     │   └─ nma.id:25!null\n" +
-			"                                                     ├─ LeftOuterJoin\n" +
-			"                                                     │   ├─ AND\n" +
-			"                                                     │   │   ├─ Eq\n" +
-			"                                                     │   │   │   ├─ F26ZW.T4IBQ:21!null\n" +
-			"                                                     │   │   │   └─ bs.T4IBQ:1!null\n" +
-			"                                                     │   │   └─ Eq\n" +
-			"                                                     │   │       ├─ F26ZW.BRQP2:22!null\n" +
-			"                                                     │   │       └─ nd.id:15\n" +
-			"                                                     │   ├─ LeftOuterLookupJoin\n" +
-			"                                                     │   │   ├─ AND\n" +
-			"                                                     │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │   │   ├─ bs.id:0!null\n" +
-			"                                                     │   │   │   │   └─ fc.GXLUB:18!null\n" +
-			"                                                     │   │   │   └─ Eq\n" +
-			"                                                     │   │   │       ├─ nd.id:15\n" +
-			"                                                     │   │   │       └─ fc.LUEVY:19!null\n" +
-			"                                                     │   │   ├─ LeftOuterJoin\n" +
-			"                                                     │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │   │   ├─ ms.GXLUB:4!null\n" +
-			"                                                     │   │   │   │   └─ bs.id:0!null\n" +
-			"                                                     │   │   │   ├─ SubqueryAlias\n" +
-			"                                                     │   │   │   │   ├─ name: bs\n" +
-			"                                                     │   │   │   │   ├─ outerVisibility: false\n" +
-			"                                                     │   │   │   │   ├─ cacheable: true\n" +
-			"                                                     │   │   │   │   └─ Filter\n" +
-			"                                                     │   │   │   │       ├─ HashIn\n" +
-			"                                                     │   │   │   │       │   ├─ T4IBQ:1!null\n" +
-			"                                                     │   │   │   │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                                                     │   │   │   │       └─ Project\n" +
-			"                                                     │   │   │   │           ├─ columns: [THNTS.id:2!null, YK2GW.FTQLQ:1!null as T4IBQ]\n" +
-			"                                                     │   │   │   │           └─ MergeJoin\n" +
-			"                                                     │   │   │   │               ├─ cmp: Eq\n" +
-			"                                                     │   │   │   │               │   ├─ YK2GW.id:0!null\n" +
-			"                                                     │   │   │   │               │   └─ THNTS.IXUXU:3\n" +
-			"                                                     │   │   │   │               ├─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │   │   │   │               │   ├─ index: [YK2GW.id]\n" +
-			"                                                     │   │   │   │               │   ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │   │               │   └─ columns: [id ftqlq]\n" +
-			"                                                     │   │   │   │               └─ IndexedTableAccess(THNTS)\n" +
-			"                                                     │   │   │   │                   ├─ index: [THNTS.IXUXU]\n" +
-			"                                                     │   │   │   │                   ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │   │                   └─ columns: [id ixuxu]\n" +
-			"                                                     │   │   │   └─ LookupJoin\n" +
-			"                                                     │   │   │       ├─ Eq\n" +
-			"                                                     │   │   │       │   ├─ GZ7Z4.LUEVY:13!null\n" +
-			"                                                     │   │   │       │   └─ nd.id:15!null\n" +
-			"                                                     │   │   │       ├─ LookupJoin\n" +
-			"                                                     │   │   │       │   ├─ Eq\n" +
-			"                                                     │   │   │       │   │   ├─ pog.id:7\n" +
-			"                                                     │   │   │       │   │   └─ GZ7Z4.GMSGA:14!null\n" +
-			"                                                     │   │   │       │   ├─ LookupJoin\n" +
-			"                                                     │   │   │       │   │   ├─ Eq\n" +
-			"                                                     │   │   │       │   │   │   ├─ pog.XVSBH:9\n" +
-			"                                                     │   │   │       │   │   │   └─ pga.id:11!null\n" +
-			"                                                     │   │   │       │   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                     │   │   │       │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │       │   │   │   │   ├─ pa.id:2!null\n" +
-			"                                                     │   │   │       │   │   │   │   └─ pog.CH3FR:8!null\n" +
-			"                                                     │   │   │       │   │   │   ├─ LookupJoin\n" +
-			"                                                     │   │   │       │   │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │       │   │   │   │   │   ├─ ms.CH3FR:5!null\n" +
-			"                                                     │   │   │       │   │   │   │   │   └─ pa.id:2!null\n" +
-			"                                                     │   │   │       │   │   │   │   ├─ TableAlias(pa)\n" +
-			"                                                     │   │   │       │   │   │   │   │   └─ Table\n" +
-			"                                                     │   │   │       │   │   │   │   │       ├─ name: XOAOP\n" +
-			"                                                     │   │   │       │   │   │   │   │       └─ columns: [id dzlim]\n" +
-			"                                                     │   │   │       │   │   │   │   └─ TableAlias(ms)\n" +
-			"                                                     │   │   │       │   │   │   │       └─ IndexedTableAccess(SZQWJ)\n" +
-			"                                                     │   │   │       │   │   │   │           ├─ index: [SZQWJ.CH3FR]\n" +
-			"                                                     │   │   │       │   │   │   │           └─ columns: [gxlub ch3fr d237e]\n" +
-			"                                                     │   │   │       │   │   │   └─ TableAlias(pog)\n" +
-			"                                                     │   │   │       │   │   │       └─ IndexedTableAccess(NPCYY)\n" +
-			"                                                     │   │   │       │   │   │           ├─ index: [NPCYY.CH3FR,NPCYY.XVSBH]\n" +
-			"                                                     │   │   │       │   │   │           └─ columns: [id ch3fr xvsbh b5ouf]\n" +
-			"                                                     │   │   │       │   │   └─ TableAlias(pga)\n" +
-			"                                                     │   │   │       │   │       └─ IndexedTableAccess(PG27A)\n" +
-			"                                                     │   │   │       │   │           ├─ index: [PG27A.id]\n" +
-			"                                                     │   │   │       │   │           └─ columns: [id dzlim]\n" +
-			"                                                     │   │   │       │   └─ TableAlias(GZ7Z4)\n" +
-			"                                                     │   │   │       │       └─ IndexedTableAccess(FEIOE)\n" +
-			"                                                     │   │   │       │           ├─ index: [FEIOE.GMSGA]\n" +
-			"                                                     │   │   │       │           └─ columns: [luevy gmsga]\n" +
-			"                                                     │   │   │       └─ TableAlias(nd)\n" +
-			"                                                     │   │   │           └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │   │   │               ├─ index: [E2I7U.id]\n" +
-			"                                                     │   │   │               └─ columns: [id tw55n hpcms]\n" +
-			"                                                     │   │   └─ TableAlias(fc)\n" +
-			"                                                     │   │       └─ IndexedTableAccess(AMYXQ)\n" +
-			"                                                     │   │           ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
-			"                                                     │   │           └─ columns: [gxlub luevy oztqf]\n" +
-			"                                                     │   └─ SubqueryAlias\n" +
-			"                                                     │       ├─ name: F26ZW\n" +
-			"                                                     │       ├─ outerVisibility: false\n" +
-			"                                                     │       ├─ cacheable: true\n" +
-			"                                                     │       └─ Project\n" +
-			"                                                     │           ├─ columns: [iq.T4IBQ:0!null, iq.BRQP2:1!null, iq.Z7CP5:2!null, CASE  WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ Eq\n" +
-			"                                                     │           │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ KAOAS (longtext)\n" +
-			"                                                     │           │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ Eq\n" +
-			"                                                     │           │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ OG (longtext)\n" +
-			"                                                     │           │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ Eq\n" +
-			"                                                     │           │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ TSG (longtext)\n" +
-			"                                                     │           │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ NOT\n" +
-			"                                                     │           │   │       └─ Eq\n" +
-			"                                                     │           │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ W6W24 (longtext)\n" +
-			"                                                     │           │   THEN 1 (tinyint) WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ NOT\n" +
-			"                                                     │           │   │       └─ Eq\n" +
-			"                                                     │           │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ OG (longtext)\n" +
-			"                                                     │           │   THEN 1 (tinyint) WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ NOT\n" +
-			"                                                     │           │   │       └─ Eq\n" +
-			"                                                     │           │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ TSG (longtext)\n" +
-			"                                                     │           │   THEN 0 (tinyint) ELSE NULL (null) END as YHYLK]\n" +
-			"                                                     │           └─ LeftOuterHashJoin\n" +
-			"                                                     │               ├─ Eq\n" +
-			"                                                     │               │   ├─ W2MAO.YH4XB:6\n" +
-			"                                                     │               │   └─ vc.id:7!null\n" +
-			"                                                     │               ├─ LeftOuterLookupJoin\n" +
-			"                                                     │               │   ├─ Eq\n" +
-			"                                                     │               │   │   ├─ iq.Z7CP5:2!null\n" +
-			"                                                     │               │   │   └─ W2MAO.Z7CP5:5!null\n" +
-			"                                                     │               │   ├─ SubqueryAlias\n" +
-			"                                                     │               │   │   ├─ name: iq\n" +
-			"                                                     │               │   │   ├─ outerVisibility: false\n" +
-			"                                                     │               │   │   ├─ cacheable: true\n" +
-			"                                                     │               │   │   └─ Project\n" +
-			"                                                     │               │   │       ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.BRQP2:12!null, mf.id:4!null as Z7CP5, mf.FSDY2:7!null, nma.DZLIM:11!null as IDWIO]\n" +
-			"                                                     │               │   │       └─ HashJoin\n" +
-			"                                                     │               │   │           ├─ Eq\n" +
-			"                                                     │               │   │           │   ├─ bs.IXUXU:3\n" +
-			"                                                     │               │   │           │   └─ cla.id:0!null\n" +
-			"                                                     │               │   │           ├─ Filter\n" +
-			"                                                     │               │   │           │   ├─ HashIn\n" +
-			"                                                     │               │   │           │   │   ├─ cla.FTQLQ:1!null\n" +
-			"                                                     │               │   │           │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                                                     │               │   │           │   └─ TableAlias(cla)\n" +
-			"                                                     │               │   │           │       └─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │               │   │           │           ├─ index: [YK2GW.FTQLQ]\n" +
-			"                                                     │               │   │           │           ├─ static: [{[SQ1, SQ1]}]\n" +
-			"                                                     │               │   │           │           └─ columns: [id ftqlq]\n" +
-			"                                                     │               │   │           └─ HashLookup\n" +
-			"                                                     │               │   │               ├─ source: TUPLE(cla.id:0!null)\n" +
-			"                                                     │               │   │               ├─ target: TUPLE(bs.IXUXU:1)\n" +
-			"                                                     │               │   │               └─ CachedResults\n" +
-			"                                                     │               │   │                   └─ LookupJoin\n" +
-			"                                                     │               │   │                       ├─ Eq\n" +
-			"                                                     │               │   │                       │   ├─ sn.BRQP2:12!null\n" +
-			"                                                     │               │   │                       │   └─ nd.id:8!null\n" +
-			"                                                     │               │   │                       ├─ HashJoin\n" +
-			"                                                     │               │   │                       │   ├─ Eq\n" +
-			"                                                     │               │   │                       │   │   ├─ nd.HPCMS:9!null\n" +
-			"                                                     │               │   │                       │   │   └─ nma.id:10!null\n" +
-			"                                                     │               │   │                       │   ├─ LookupJoin\n" +
-			"                                                     │               │   │                       │   │   ├─ Eq\n" +
-			"                                                     │               │   │                       │   │   │   ├─ mf.LUEVY:6!null\n" +
-			"                                                     │               │   │                       │   │   │   └─ nd.id:8!null\n" +
-			"                                                     │               │   │                       │   │   ├─ LookupJoin\n" +
-			"                                                     │               │   │                       │   │   │   ├─ Eq\n" +
-			"                                                     │               │   │                       │   │   │   │   ├─ mf.GXLUB:5!null\n" +
-			"                                                     │               │   │                       │   │   │   │   └─ bs.id:2!null\n" +
-			"                                                     │               │   │                       │   │   │   ├─ TableAlias(bs)\n" +
-			"                                                     │               │   │                       │   │   │   │   └─ Table\n" +
-			"                                                     │               │   │                       │   │   │   │       ├─ name: THNTS\n" +
-			"                                                     │               │   │                       │   │   │   │       └─ columns: [id ixuxu]\n" +
-			"                                                     │               │   │                       │   │   │   └─ TableAlias(mf)\n" +
-			"                                                     │               │   │                       │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"                                                     │               │   │                       │   │   │           ├─ index: [HGMQ6.GXLUB]\n" +
-			"                                                     │               │   │                       │   │   │           └─ columns: [id gxlub luevy fsdy2]\n" +
-			"                                                     │               │   │                       │   │   └─ TableAlias(nd)\n" +
-			"                                                     │               │   │                       │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │               │   │                       │   │           ├─ index: [E2I7U.id]\n" +
-			"                                                     │               │   │                       │   │           └─ columns: [id hpcms]\n" +
-			"                                                     │               │   │                       │   └─ HashLookup\n" +
-			"                                                     │               │   │                       │       ├─ source: TUPLE(nd.HPCMS:9!null)\n" +
-			"                                                     │               │   │                       │       ├─ target: TUPLE(nma.id:0!null)\n" +
-			"                                                     │               │   │                       │       └─ CachedResults\n" +
-			"                                                     │               │   │                       │           └─ TableAlias(nma)\n" +
-			"                                                     │               │   │                       │               └─ Table\n" +
-			"                                                     │               │   │                       │                   ├─ name: TNMXI\n" +
-			"                                                     │               │   │                       │                   └─ columns: [id dzlim]\n" +
-			"                                                     │               │   │                       └─ TableAlias(sn)\n" +
-			"                                                     │               │   │                           └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                     │               │   │                               ├─ index: [NOXN3.BRQP2]\n" +
-			"                                                     │               │   │                               └─ columns: [brqp2]\n" +
-			"                                                     │               │   └─ TableAlias(W2MAO)\n" +
-			"                                                     │               │       └─ IndexedTableAccess(SEQS3)\n" +
-			"                                                     │               │           ├─ index: [SEQS3.Z7CP5,SEQS3.YH4XB]\n" +
-			"                                                     │               │           └─ columns: [z7cp5 yh4xb]\n" +
-			"                                                     │               └─ HashLookup\n" +
-			"                                                     │                   ├─ source: TUPLE(W2MAO.YH4XB:6)\n" +
-			"                                                     │                   ├─ target: TUPLE(vc.id:0!null)\n" +
-			"                                                     │                   └─ CachedResults\n" +
-			"                                                     │                       └─ TableAlias(vc)\n" +
-			"                                                     │                           └─ Table\n" +
-			"                                                     │                               ├─ name: D34QP\n" +
-			"                                                     │                               └─ columns: [id znp4p]\n" +
-			"                                                     └─ TableAlias(nma)\n" +
-			"                                                         └─ IndexedTableAccess(TNMXI)\n" +
-			"                                                             ├─ index: [TNMXI.id]\n" +
-			"                                                             └─ columns: [id]\n" +
+			"                                     ├─ columns: [bs.T4IBQ:1!null as T4IBQ, pa.DZLIM:3 as ECUWU, pga.DZLIM:12 as GSTQA, pog.B5OUF:10, fc.OZTQF:20, F26ZW.YHYLK:24, nd.TW55N:16 as TW55N]\n" +
+			"                                     └─ LeftOuterLookupJoin\n" +
+			"                                         ├─ Eq\n" +
+			"                                         │   ├─ nd.HPCMS:17\n" +
+			"                                         │   └─ nma.id:25!null\n" +
+			"                                         ├─ LeftOuterJoin\n" +
+			"                                         │   ├─ AND\n" +
+			"                                         │   │   ├─ Eq\n" +
+			"                                         │   │   │   ├─ F26ZW.T4IBQ:21!null\n" +
+			"                                         │   │   │   └─ bs.T4IBQ:1!null\n" +
+			"                                         │   │   └─ Eq\n" +
+			"                                         │   │       ├─ F26ZW.BRQP2:22!null\n" +
+			"                                         │   │       └─ nd.id:15\n" +
+			"                                         │   ├─ LeftOuterLookupJoin\n" +
+			"                                         │   │   ├─ AND\n" +
+			"                                         │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   ├─ bs.id:0!null\n" +
+			"                                         │   │   │   │   └─ fc.GXLUB:18!null\n" +
+			"                                         │   │   │   └─ Eq\n" +
+			"                                         │   │   │       ├─ nd.id:15\n" +
+			"                                         │   │   │       └─ fc.LUEVY:19!null\n" +
+			"                                         │   │   ├─ InnerJoin\n" +
+			"                                         │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   ├─ ms.GXLUB:4!null\n" +
+			"                                         │   │   │   │   └─ bs.id:0!null\n" +
+			"                                         │   │   │   ├─ SubqueryAlias\n" +
+			"                                         │   │   │   │   ├─ name: bs\n" +
+			"                                         │   │   │   │   ├─ outerVisibility: false\n" +
+			"                                         │   │   │   │   ├─ cacheable: true\n" +
+			"                                         │   │   │   │   └─ Filter\n" +
+			"                                         │   │   │   │       ├─ HashIn\n" +
+			"                                         │   │   │   │       │   ├─ T4IBQ:1!null\n" +
+			"                                         │   │   │   │       │   └─ TUPLE(SQ1 (longtext))\n" +
+			"                                         │   │   │   │       └─ Project\n" +
+			"                                         │   │   │   │           ├─ columns: [THNTS.id:2!null, YK2GW.FTQLQ:1!null as T4IBQ]\n" +
+			"                                         │   │   │   │           └─ MergeJoin\n" +
+			"                                         │   │   │   │               ├─ cmp: Eq\n" +
+			"                                         │   │   │   │               │   ├─ YK2GW.id:0!null\n" +
+			"                                         │   │   │   │               │   └─ THNTS.IXUXU:3\n" +
+			"                                         │   │   │   │               ├─ IndexedTableAccess(YK2GW)\n" +
+			"                                         │   │   │   │               │   ├─ index: [YK2GW.id]\n" +
+			"                                         │   │   │   │               │   ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │   │   │               │   └─ columns: [id ftqlq]\n" +
+			"                                         │   │   │   │               └─ IndexedTableAccess(THNTS)\n" +
+			"                                         │   │   │   │                   ├─ index: [THNTS.IXUXU]\n" +
+			"                                         │   │   │   │                   ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │   │   │                   └─ columns: [id ixuxu]\n" +
+			"                                         │   │   │   └─ LookupJoin\n" +
+			"                                         │   │   │       ├─ Eq\n" +
+			"                                         │   │   │       │   ├─ GZ7Z4.LUEVY:13!null\n" +
+			"                                         │   │   │       │   └─ nd.id:15!null\n" +
+			"                                         │   │   │       ├─ LookupJoin\n" +
+			"                                         │   │   │       │   ├─ Eq\n" +
+			"                                         │   │   │       │   │   ├─ pog.id:7\n" +
+			"                                         │   │   │       │   │   └─ GZ7Z4.GMSGA:14!null\n" +
+			"                                         │   │   │       │   ├─ LookupJoin\n" +
+			"                                         │   │   │       │   │   ├─ Eq\n" +
+			"                                         │   │   │       │   │   │   ├─ pog.XVSBH:9\n" +
+			"                                         │   │   │       │   │   │   └─ pga.id:11!null\n" +
+			"                                         │   │   │       │   │   ├─ LeftOuterLookupJoin\n" +
+			"                                         │   │   │       │   │   │   ├─ Eq\n" +
+			"                                         │   │   │       │   │   │   │   ├─ pa.id:2!null\n" +
+			"                                         │   │   │       │   │   │   │   └─ pog.CH3FR:8!null\n" +
+			"                                         │   │   │       │   │   │   ├─ LookupJoin\n" +
+			"                                         │   │   │       │   │   │   │   ├─ Eq\n" +
+			"                                         │   │   │       │   │   │   │   │   ├─ ms.CH3FR:5!null\n" +
+			"                                         │   │   │       │   │   │   │   │   └─ pa.id:2!null\n" +
+			"                                         │   │   │       │   │   │   │   ├─ TableAlias(pa)\n" +
+			"                                         │   │   │       │   │   │   │   │   └─ Table\n" +
+			"                                         │   │   │       │   │   │   │   │       ├─ name: XOAOP\n" +
+			"                                         │   │   │       │   │   │   │   │       └─ columns: [id dzlim]\n" +
+			"                                         │   │   │       │   │   │   │   └─ Filter\n" +
+			"                                         │   │   │       │   │   │   │       ├─ Eq\n" +
+			"                                         │   │   │       │   │   │   │       │   ├─ ms.D237E:2\n" +
+			"                                         │   │   │       │   │   │   │       │   └─ true (tinyint)\n" +
+			"                                         │   │   │       │   │   │   │       └─ TableAlias(ms)\n" +
+			"                                         │   │   │       │   │   │   │           └─ IndexedTableAccess(SZQWJ)\n" +
+			"                                         │   │   │       │   │   │   │               ├─ index: [SZQWJ.CH3FR]\n" +
+			"                                         │   │   │       │   │   │   │               └─ columns: [gxlub ch3fr d237e]\n" +
+			"                                         │   │   │       │   │   │   └─ TableAlias(pog)\n" +
+			"                                         │   │   │       │   │   │       └─ IndexedTableAccess(NPCYY)\n" +
+			"                                         │   │   │       │   │   │           ├─ index: [NPCYY.CH3FR,NPCYY.XVSBH]\n" +
+			"                                         │   │   │       │   │   │           └─ columns: [id ch3fr xvsbh b5ouf]\n" +
+			"                                         │   │   │       │   │   └─ TableAlias(pga)\n" +
+			"                                         │   │   │       │   │       └─ IndexedTableAccess(PG27A)\n" +
+			"                                         │   │   │       │   │           ├─ index: [PG27A.id]\n" +
+			"                                         │   │   │       │   │           └─ columns: [id dzlim]\n" +
+			"                                         │   │   │       │   └─ TableAlias(GZ7Z4)\n" +
+			"                                         │   │   │       │       └─ IndexedTableAccess(FEIOE)\n" +
+			"                                         │   │   │       │           ├─ index: [FEIOE.GMSGA]\n" +
+			"                                         │   │   │       │           └─ columns: [luevy gmsga]\n" +
+			"                                         │   │   │       └─ TableAlias(nd)\n" +
+			"                                         │   │   │           └─ IndexedTableAccess(E2I7U)\n" +
+			"                                         │   │   │               ├─ index: [E2I7U.id]\n" +
+			"                                         │   │   │               └─ columns: [id tw55n hpcms]\n" +
+			"                                         │   │   └─ TableAlias(fc)\n" +
+			"                                         │   │       └─ IndexedTableAccess(AMYXQ)\n" +
+			"                                         │   │           ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
+			"                                         │   │           └─ columns: [gxlub luevy oztqf]\n" +
+			"                                         │   └─ SubqueryAlias\n" +
+			"                                         │       ├─ name: F26ZW\n" +
+			"                                         │       ├─ outerVisibility: false\n" +
+			"                                         │       ├─ cacheable: true\n" +
+			"                                         │       └─ Project\n" +
+			"                                         │           ├─ columns: [iq.T4IBQ:0!null, iq.BRQP2:1!null, iq.Z7CP5:2!null, CASE  WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ Eq\n" +
+			"                                         │           │   │       ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │       └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ KAOAS (longtext)\n" +
+			"                                         │           │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ Eq\n" +
+			"                                         │           │   │       ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │       └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ OG (longtext)\n" +
+			"                                         │           │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ Eq\n" +
+			"                                         │           │   │       ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │       └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ TSG (longtext)\n" +
+			"                                         │           │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ NOT\n" +
+			"                                         │           │   │       └─ Eq\n" +
+			"                                         │           │   │           ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │           └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ W6W24 (longtext)\n" +
+			"                                         │           │   THEN 1 (tinyint) WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ NOT\n" +
+			"                                         │           │   │       └─ Eq\n" +
+			"                                         │           │   │           ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │           └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ OG (longtext)\n" +
+			"                                         │           │   THEN 1 (tinyint) WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ NOT\n" +
+			"                                         │           │   │       └─ Eq\n" +
+			"                                         │           │   │           ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │           └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ TSG (longtext)\n" +
+			"                                         │           │   THEN 0 (tinyint) ELSE NULL (null) END as YHYLK]\n" +
+			"                                         │           └─ LeftOuterHashJoin\n" +
+			"                                         │               ├─ Eq\n" +
+			"                                         │               │   ├─ W2MAO.YH4XB:6\n" +
+			"                                         │               │   └─ vc.id:7!null\n" +
+			"                                         │               ├─ LeftOuterLookupJoin\n" +
+			"                                         │               │   ├─ Eq\n" +
+			"                                         │               │   │   ├─ iq.Z7CP5:2!null\n" +
+			"                                         │               │   │   └─ W2MAO.Z7CP5:5!null\n" +
+			"                                         │               │   ├─ SubqueryAlias\n" +
+			"                                         │               │   │   ├─ name: iq\n" +
+			"                                         │               │   │   ├─ outerVisibility: false\n" +
+			"                                         │               │   │   ├─ cacheable: true\n" +
+			"                                         │               │   │   └─ Project\n" +
+			"                                         │               │   │       ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.BRQP2:12!null, mf.id:4!null as Z7CP5, mf.FSDY2:7!null, nma.DZLIM:11!null as IDWIO]\n" +
+			"                                         │               │   │       └─ HashJoin\n" +
+			"                                         │               │   │           ├─ Eq\n" +
+			"                                         │               │   │           │   ├─ bs.IXUXU:3\n" +
+			"                                         │               │   │           │   └─ cla.id:0!null\n" +
+			"                                         │               │   │           ├─ TableAlias(cla)\n" +
+			"                                         │               │   │           │   └─ IndexedTableAccess(YK2GW)\n" +
+			"                                         │               │   │           │       ├─ index: [YK2GW.FTQLQ]\n" +
+			"                                         │               │   │           │       ├─ static: [{[SQ1, SQ1]}]\n" +
+			"                                         │               │   │           │       ├─ columns: [id ftqlq]\n" +
+			"                                         │               │   │           │       └─ index condition: IN\n" +
+			"                                         │               │   │           │           ├─ left: YK2GW.FTQLQ:1!null\n" +
+			"                                         │               │   │           │           └─ right: TUPLE(SQ1 (longtext))\n" +
+			"                                         │               │   │           └─ HashLookup\n" +
+			"                                         │               │   │               ├─ source: TUPLE(cla.id:0!null)\n" +
+			"                                         │               │   │               ├─ target: TUPLE(bs.IXUXU:1)\n" +
+			"                                         │               │   │               └─ CachedResults\n" +
+			"                                         │               │   │                   └─ LookupJoin\n" +
+			"                                         │               │   │                       ├─ Eq\n" +
+			"                                         │               │   │                       │   ├─ sn.BRQP2:12!null\n" +
+			"                                         │               │   │                       │   └─ nd.id:8!null\n" +
+			"                                         │               │   │                       ├─ HashJoin\n" +
+			"                                         │               │   │                       │   ├─ Eq\n" +
+			"                                         │               │   │                       │   │   ├─ nd.HPCMS:9!null\n" +
+			"                                         │               │   │                       │   │   └─ nma.id:10!null\n" +
+			"                                         │               │   │                       │   ├─ LookupJoin\n" +
+			"                                         │               │   │                       │   │   ├─ Eq\n" +
+			"                                         │               │   │                       │   │   │   ├─ mf.LUEVY:6!null\n" +
+			"                                         │               │   │                       │   │   │   └─ nd.id:8!null\n" +
+			"                                         │               │   │                       │   │   ├─ LookupJoin\n" +
+			"                                         │               │   │                       │   │   │   ├─ Eq\n" +
+			"                                         │               │   │                       │   │   │   │   ├─ mf.GXLUB:5!null\n" +
+			"                                         │               │   │                       │   │   │   │   └─ bs.id:2!null\n" +
+			"                                         │               │   │                       │   │   │   ├─ TableAlias(bs)\n" +
+			"                                         │               │   │                       │   │   │   │   └─ Table\n" +
+			"                                         │               │   │                       │   │   │   │       ├─ name: THNTS\n" +
+			"                                         │               │   │                       │   │   │   │       └─ columns: [id ixuxu]\n" +
+			"                                         │               │   │                       │   │   │   └─ TableAlias(mf)\n" +
+			"                                         │               │   │                       │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
+			"                                         │               │   │                       │   │   │           ├─ index: [HGMQ6.GXLUB]\n" +
+			"                                         │               │   │                       │   │   │           └─ columns: [id gxlub luevy fsdy2]\n" +
+			"                                         │               │   │                       │   │   └─ TableAlias(nd)\n" +
+			"                                         │               │   │                       │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"                                         │               │   │                       │   │           ├─ index: [E2I7U.id]\n" +
+			"                                         │               │   │                       │   │           └─ columns: [id hpcms]\n" +
+			"                                         │               │   │                       │   └─ HashLookup\n" +
+			"                                         │               │   │                       │       ├─ source: TUPLE(nd.HPCMS:9!null)\n" +
+			"                                         │               │   │                       │       ├─ target: TUPLE(nma.id:0!null)\n" +
+			"                                         │               │   │                       │       └─ CachedResults\n" +
+			"                                         │               │   │                       │           └─ TableAlias(nma)\n" +
+			"                                         │               │   │                       │               └─ Table\n" +
+			"                                         │               │   │                       │                   ├─ name: TNMXI\n" +
+			"                                         │               │   │                       │                   └─ columns: [id dzlim]\n" +
+			"                                         │               │   │                       └─ TableAlias(sn)\n" +
+			"                                         │               │   │                           └─ IndexedTableAccess(NOXN3)\n" +
+			"                                         │               │   │                               ├─ index: [NOXN3.BRQP2]\n" +
+			"                                         │               │   │                               └─ columns: [brqp2]\n" +
+			"                                         │               │   └─ TableAlias(W2MAO)\n" +
+			"                                         │               │       └─ IndexedTableAccess(SEQS3)\n" +
+			"                                         │               │           ├─ index: [SEQS3.Z7CP5,SEQS3.YH4XB]\n" +
+			"                                         │               │           └─ columns: [z7cp5 yh4xb]\n" +
+			"                                         │               └─ HashLookup\n" +
+			"                                         │                   ├─ source: TUPLE(W2MAO.YH4XB:6)\n" +
+			"                                         │                   ├─ target: TUPLE(vc.id:0!null)\n" +
+			"                                         │                   └─ CachedResults\n" +
+			"                                         │                       └─ TableAlias(vc)\n" +
+			"                                         │                           └─ Table\n" +
+			"                                         │                               ├─ name: D34QP\n" +
+			"                                         │                               └─ columns: [id znp4p]\n" +
+			"                                         └─ TableAlias(nma)\n" +
+			"                                             └─ IndexedTableAccess(TNMXI)\n" +
+			"                                                 ├─ index: [TNMXI.id]\n" +
+			"                                                 └─ columns: [id]\n" +
 			"",
 	},
 	{
@@ -10657,292 +10738,288 @@ WHERE
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [T4IBQ:0!null, ECUWU:1, SUM(XPRW6.B5OUF):2!null as B5OUF, SUM(XPRW6.SP4SI):3!null as SP4SI]\n" +
 			" └─ GroupBy\n" +
-			"     ├─ select: T4IBQ:0!null, ECUWU:1, SUM(XPRW6.B5OUF:2), SUM(XPRW6.SP4SI:3!null)\n" +
-			"     ├─ group: T4IBQ:0!null, ECUWU:1\n" +
-			"     └─ Project\n" +
-			"         ├─ columns: [XPRW6.T4IBQ:0!null as T4IBQ, XPRW6.ECUWU:1 as ECUWU, XPRW6.B5OUF:3, XPRW6.SP4SI:4!null]\n" +
-			"         └─ SubqueryAlias\n" +
-			"             ├─ name: XPRW6\n" +
-			"             ├─ outerVisibility: false\n" +
-			"             ├─ cacheable: true\n" +
-			"             └─ Project\n" +
-			"                 ├─ columns: [T4IBQ:0!null, ECUWU:1, GSTQA:2, B5OUF:3, SUM(CASE  WHEN ((NRFJ3.OZTQF < 0.5) OR (NRFJ3.YHYLK = 0)) THEN 1 ELSE 0 END):4!null as SP4SI]\n" +
-			"                 └─ GroupBy\n" +
-			"                     ├─ select: T4IBQ:0!null, ECUWU:1, GSTQA:2, NRFJ3.B5OUF:3 as B5OUF, SUM(CASE  WHEN Or\n" +
-			"                     │   ├─ LessThan\n" +
-			"                     │   │   ├─ NRFJ3.OZTQF:4\n" +
-			"                     │   │   └─ 0.500000 (double)\n" +
-			"                     │   └─ Eq\n" +
-			"                     │       ├─ NRFJ3.YHYLK:5\n" +
-			"                     │       └─ 0 (tinyint)\n" +
-			"                     │   THEN 1 (tinyint) ELSE 0 (tinyint) END)\n" +
-			"                     ├─ group: T4IBQ:0!null, ECUWU:1, GSTQA:2\n" +
-			"                     └─ Project\n" +
-			"                         ├─ columns: [NRFJ3.T4IBQ:0!null as T4IBQ, NRFJ3.ECUWU:1 as ECUWU, NRFJ3.GSTQA:2 as GSTQA, NRFJ3.B5OUF:3, NRFJ3.OZTQF:5, NRFJ3.YHYLK:6]\n" +
-			"                         └─ SubqueryAlias\n" +
-			"                             ├─ name: NRFJ3\n" +
-			"                             ├─ outerVisibility: false\n" +
-			"                             ├─ cacheable: true\n" +
-			"                             └─ Distinct\n" +
+			"     ├─ select: XPRW6.T4IBQ:0!null as T4IBQ, XPRW6.ECUWU:1 as ECUWU, SUM(XPRW6.B5OUF:3), SUM(XPRW6.SP4SI:4!null)\n" +
+			"     ├─ group: XPRW6.T4IBQ:0!null, XPRW6.ECUWU:1\n" +
+			"     └─ SubqueryAlias\n" +
+			"         ├─ name: XPRW6\n" +
+			"         ├─ outerVisibility: false\n" +
+			"         ├─ cacheable: true\n" +
+			"         └─ Project\n" +
+			"             ├─ columns: [T4IBQ:0!null, ECUWU:1, GSTQA:2, B5OUF:3, SUM(CASE  WHEN ((NRFJ3.OZTQF < 0.5) OR (NRFJ3.YHYLK = 0)) THEN 1 ELSE 0 END):4!null as SP4SI]\n" +
+			"             └─ GroupBy\n" +
+			"                 ├─ select: NRFJ3.T4IBQ:0!null as T4IBQ, NRFJ3.ECUWU:1 as ECUWU, NRFJ3.GSTQA:2 as GSTQA, NRFJ3.B5OUF:3 as B5OUF, SUM(CASE  WHEN Or\n" +
+			"                 │   ├─ LessThan\n" +
+			"                 │   │   ├─ NRFJ3.OZTQF:5\n" +
+			"                 │   │   └─ 0.500000 (double)\n" +
+			"                 │   └─ Eq\n" +
+			"                 │       ├─ NRFJ3.YHYLK:6\n" +
+			"                 │       └─ 0 (tinyint)\n" +
+			"                 │   THEN 1 (tinyint) ELSE 0 (tinyint) END)\n" +
+			"                 ├─ group: NRFJ3.T4IBQ:0!null, NRFJ3.ECUWU:1, NRFJ3.GSTQA:2\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: NRFJ3\n" +
+			"                     ├─ outerVisibility: false\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [AX7FV.T4IBQ:0!null, AX7FV.ECUWU:1, AX7FV.GSTQA:2, AX7FV.B5OUF:3, AX7FV.TW55N:6, AX7FV.OZTQF:4, AX7FV.YHYLK:5]\n" +
+			"                             └─ SubqueryAlias\n" +
+			"                                 ├─ name: AX7FV\n" +
+			"                                 ├─ outerVisibility: false\n" +
+			"                                 ├─ cacheable: true\n" +
 			"                                 └─ Project\n" +
-			"                                     ├─ columns: [AX7FV.T4IBQ:0!null, AX7FV.ECUWU:1, AX7FV.GSTQA:2, AX7FV.B5OUF:3, AX7FV.TW55N:6, AX7FV.OZTQF:4, AX7FV.YHYLK:5]\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: AX7FV\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [bs.T4IBQ:1!null as T4IBQ, pa.DZLIM:3 as ECUWU, pga.DZLIM:12 as GSTQA, pog.B5OUF:10, fc.OZTQF:20, F26ZW.YHYLK:24, nd.TW55N:16 as TW55N]\n" +
-			"                                             └─ Filter\n" +
-			"                                                 ├─ Eq\n" +
-			"                                                 │   ├─ ms.D237E:6\n" +
-			"                                                 │   └─ true (tinyint)\n" +
-			"                                                 └─ LeftOuterLookupJoin\n" +
-			"                                                     ├─ Eq\n" +
-			"                                                     │   ├─ nd.HPCMS:17\n" +
-			"                                                     │   └─ nma.id:25!null\n" +
-			"                                                     ├─ LeftOuterJoin\n" +
-			"                                                     │   ├─ AND\n" +
-			"                                                     │   │   ├─ Eq\n" +
-			"                                                     │   │   │   ├─ F26ZW.T4IBQ:21!null\n" +
-			"                                                     │   │   │   └─ bs.T4IBQ:1!null\n" +
-			"                                                     │   │   └─ Eq\n" +
-			"                                                     │   │       ├─ F26ZW.BRQP2:22!null\n" +
-			"                                                     │   │       └─ nd.id:15\n" +
-			"                                                     │   ├─ LeftOuterLookupJoin\n" +
-			"                                                     │   │   ├─ AND\n" +
-			"                                                     │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │   │   ├─ bs.id:0!null\n" +
-			"                                                     │   │   │   │   └─ fc.GXLUB:18!null\n" +
-			"                                                     │   │   │   └─ Eq\n" +
-			"                                                     │   │   │       ├─ nd.id:15\n" +
-			"                                                     │   │   │       └─ fc.LUEVY:19!null\n" +
-			"                                                     │   │   ├─ LeftOuterJoin\n" +
-			"                                                     │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │   │   ├─ ms.GXLUB:4!null\n" +
-			"                                                     │   │   │   │   └─ bs.id:0!null\n" +
-			"                                                     │   │   │   ├─ SubqueryAlias\n" +
-			"                                                     │   │   │   │   ├─ name: bs\n" +
-			"                                                     │   │   │   │   ├─ outerVisibility: false\n" +
-			"                                                     │   │   │   │   ├─ cacheable: true\n" +
-			"                                                     │   │   │   │   └─ Filter\n" +
-			"                                                     │   │   │   │       ├─ HashIn\n" +
-			"                                                     │   │   │   │       │   ├─ T4IBQ:1!null\n" +
-			"                                                     │   │   │   │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                                                     │   │   │   │       └─ Project\n" +
-			"                                                     │   │   │   │           ├─ columns: [THNTS.id:2!null, YK2GW.FTQLQ:1!null as T4IBQ]\n" +
-			"                                                     │   │   │   │           └─ MergeJoin\n" +
-			"                                                     │   │   │   │               ├─ cmp: Eq\n" +
-			"                                                     │   │   │   │               │   ├─ YK2GW.id:0!null\n" +
-			"                                                     │   │   │   │               │   └─ THNTS.IXUXU:3\n" +
-			"                                                     │   │   │   │               ├─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │   │   │   │               │   ├─ index: [YK2GW.id]\n" +
-			"                                                     │   │   │   │               │   ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │   │               │   └─ columns: [id ftqlq]\n" +
-			"                                                     │   │   │   │               └─ IndexedTableAccess(THNTS)\n" +
-			"                                                     │   │   │   │                   ├─ index: [THNTS.IXUXU]\n" +
-			"                                                     │   │   │   │                   ├─ static: [{[NULL, ∞)}]\n" +
-			"                                                     │   │   │   │                   └─ columns: [id ixuxu]\n" +
-			"                                                     │   │   │   └─ LookupJoin\n" +
-			"                                                     │   │   │       ├─ Eq\n" +
-			"                                                     │   │   │       │   ├─ GZ7Z4.LUEVY:13!null\n" +
-			"                                                     │   │   │       │   └─ nd.id:15!null\n" +
-			"                                                     │   │   │       ├─ LookupJoin\n" +
-			"                                                     │   │   │       │   ├─ Eq\n" +
-			"                                                     │   │   │       │   │   ├─ pog.id:7\n" +
-			"                                                     │   │   │       │   │   └─ GZ7Z4.GMSGA:14!null\n" +
-			"                                                     │   │   │       │   ├─ LookupJoin\n" +
-			"                                                     │   │   │       │   │   ├─ Eq\n" +
-			"                                                     │   │   │       │   │   │   ├─ pog.XVSBH:9\n" +
-			"                                                     │   │   │       │   │   │   └─ pga.id:11!null\n" +
-			"                                                     │   │   │       │   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                     │   │   │       │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │       │   │   │   │   ├─ pa.id:2!null\n" +
-			"                                                     │   │   │       │   │   │   │   └─ pog.CH3FR:8!null\n" +
-			"                                                     │   │   │       │   │   │   ├─ LookupJoin\n" +
-			"                                                     │   │   │       │   │   │   │   ├─ Eq\n" +
-			"                                                     │   │   │       │   │   │   │   │   ├─ ms.CH3FR:5!null\n" +
-			"                                                     │   │   │       │   │   │   │   │   └─ pa.id:2!null\n" +
-			"                                                     │   │   │       │   │   │   │   ├─ TableAlias(pa)\n" +
-			"                                                     │   │   │       │   │   │   │   │   └─ Table\n" +
-			"                                                     │   │   │       │   │   │   │   │       ├─ name: XOAOP\n" +
-			"                                                     │   │   │       │   │   │   │   │       └─ columns: [id dzlim]\n" +
-			"                                                     │   │   │       │   │   │   │   └─ TableAlias(ms)\n" +
-			"                                                     │   │   │       │   │   │   │       └─ IndexedTableAccess(SZQWJ)\n" +
-			"                                                     │   │   │       │   │   │   │           ├─ index: [SZQWJ.CH3FR]\n" +
-			"                                                     │   │   │       │   │   │   │           └─ columns: [gxlub ch3fr d237e]\n" +
-			"                                                     │   │   │       │   │   │   └─ TableAlias(pog)\n" +
-			"                                                     │   │   │       │   │   │       └─ IndexedTableAccess(NPCYY)\n" +
-			"                                                     │   │   │       │   │   │           ├─ index: [NPCYY.CH3FR,NPCYY.XVSBH]\n" +
-			"                                                     │   │   │       │   │   │           └─ columns: [id ch3fr xvsbh b5ouf]\n" +
-			"                                                     │   │   │       │   │   └─ TableAlias(pga)\n" +
-			"                                                     │   │   │       │   │       └─ IndexedTableAccess(PG27A)\n" +
-			"                                                     │   │   │       │   │           ├─ index: [PG27A.id]\n" +
-			"                                                     │   │   │       │   │           └─ columns: [id dzlim]\n" +
-			"                                                     │   │   │       │   └─ TableAlias(GZ7Z4)\n" +
-			"                                                     │   │   │       │       └─ IndexedTableAccess(FEIOE)\n" +
-			"                                                     │   │   │       │           ├─ index: [FEIOE.GMSGA]\n" +
-			"                                                     │   │   │       │           └─ columns: [luevy gmsga]\n" +
-			"                                                     │   │   │       └─ TableAlias(nd)\n" +
-			"                                                     │   │   │           └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │   │   │               ├─ index: [E2I7U.id]\n" +
-			"                                                     │   │   │               └─ columns: [id tw55n hpcms]\n" +
-			"                                                     │   │   └─ TableAlias(fc)\n" +
-			"                                                     │   │       └─ IndexedTableAccess(AMYXQ)\n" +
-			"                                                     │   │           ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
-			"                                                     │   │           └─ columns: [gxlub luevy oztqf]\n" +
-			"                                                     │   └─ SubqueryAlias\n" +
-			"                                                     │       ├─ name: F26ZW\n" +
-			"                                                     │       ├─ outerVisibility: false\n" +
-			"                                                     │       ├─ cacheable: true\n" +
-			"                                                     │       └─ Project\n" +
-			"                                                     │           ├─ columns: [iq.T4IBQ:0!null, iq.BRQP2:1!null, iq.Z7CP5:2!null, CASE  WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ Eq\n" +
-			"                                                     │           │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ KAOAS (longtext)\n" +
-			"                                                     │           │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ Eq\n" +
-			"                                                     │           │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ OG (longtext)\n" +
-			"                                                     │           │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ Eq\n" +
-			"                                                     │           │   │       ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │       └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ TSG (longtext)\n" +
-			"                                                     │           │   THEN 0 (tinyint) WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ NOT\n" +
-			"                                                     │           │   │       └─ Eq\n" +
-			"                                                     │           │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ W6W24 (longtext)\n" +
-			"                                                     │           │   THEN 1 (tinyint) WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ NOT\n" +
-			"                                                     │           │   │       └─ Eq\n" +
-			"                                                     │           │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ OG (longtext)\n" +
-			"                                                     │           │   THEN 1 (tinyint) WHEN AND\n" +
-			"                                                     │           │   ├─ AND\n" +
-			"                                                     │           │   │   ├─ IN\n" +
-			"                                                     │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
-			"                                                     │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
-			"                                                     │           │   │   └─ NOT\n" +
-			"                                                     │           │   │       └─ Eq\n" +
-			"                                                     │           │   │           ├─ vc.ZNP4P:8\n" +
-			"                                                     │           │   │           └─ L5Q44 (longtext)\n" +
-			"                                                     │           │   └─ Eq\n" +
-			"                                                     │           │       ├─ iq.IDWIO:4!null\n" +
-			"                                                     │           │       └─ TSG (longtext)\n" +
-			"                                                     │           │   THEN 0 (tinyint) ELSE NULL (null) END as YHYLK]\n" +
-			"                                                     │           └─ LeftOuterHashJoin\n" +
-			"                                                     │               ├─ Eq\n" +
-			"                                                     │               │   ├─ W2MAO.YH4XB:6\n" +
-			"                                                     │               │   └─ vc.id:7!null\n" +
-			"                                                     │               ├─ LeftOuterLookupJoin\n" +
-			"                                                     │               │   ├─ Eq\n" +
-			"                                                     │               │   │   ├─ iq.Z7CP5:2!null\n" +
-			"                                                     │               │   │   └─ W2MAO.Z7CP5:5!null\n" +
-			"                                                     │               │   ├─ SubqueryAlias\n" +
-			"                                                     │               │   │   ├─ name: iq\n" +
-			"                                                     │               │   │   ├─ outerVisibility: false\n" +
-			"                                                     │               │   │   ├─ cacheable: true\n" +
-			"                                                     │               │   │   └─ Project\n" +
-			"                                                     │               │   │       ├─ columns: [cla.FTQLQ:11!null as T4IBQ, sn.BRQP2:12!null, mf.id:4!null as Z7CP5, mf.FSDY2:7!null, nma.DZLIM:1!null as IDWIO]\n" +
-			"                                                     │               │   │       └─ LookupJoin\n" +
-			"                                                     │               │   │           ├─ Eq\n" +
-			"                                                     │               │   │           │   ├─ sn.BRQP2:12!null\n" +
-			"                                                     │               │   │           │   └─ nd.id:2!null\n" +
-			"                                                     │               │   │           ├─ LookupJoin\n" +
-			"                                                     │               │   │           │   ├─ Eq\n" +
-			"                                                     │               │   │           │   │   ├─ bs.IXUXU:9\n" +
-			"                                                     │               │   │           │   │   └─ cla.id:10!null\n" +
-			"                                                     │               │   │           │   ├─ LookupJoin\n" +
-			"                                                     │               │   │           │   │   ├─ Eq\n" +
-			"                                                     │               │   │           │   │   │   ├─ mf.GXLUB:5!null\n" +
-			"                                                     │               │   │           │   │   │   └─ bs.id:8!null\n" +
-			"                                                     │               │   │           │   │   ├─ LookupJoin\n" +
-			"                                                     │               │   │           │   │   │   ├─ Eq\n" +
-			"                                                     │               │   │           │   │   │   │   ├─ mf.LUEVY:6!null\n" +
-			"                                                     │               │   │           │   │   │   │   └─ nd.id:2!null\n" +
-			"                                                     │               │   │           │   │   │   ├─ LookupJoin\n" +
-			"                                                     │               │   │           │   │   │   │   ├─ Eq\n" +
-			"                                                     │               │   │           │   │   │   │   │   ├─ nd.HPCMS:3!null\n" +
-			"                                                     │               │   │           │   │   │   │   │   └─ nma.id:0!null\n" +
-			"                                                     │               │   │           │   │   │   │   ├─ TableAlias(nma)\n" +
-			"                                                     │               │   │           │   │   │   │   │   └─ Table\n" +
-			"                                                     │               │   │           │   │   │   │   │       ├─ name: TNMXI\n" +
-			"                                                     │               │   │           │   │   │   │   │       └─ columns: [id dzlim]\n" +
-			"                                                     │               │   │           │   │   │   │   └─ TableAlias(nd)\n" +
-			"                                                     │               │   │           │   │   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                     │               │   │           │   │   │   │           ├─ index: [E2I7U.HPCMS]\n" +
-			"                                                     │               │   │           │   │   │   │           └─ columns: [id hpcms]\n" +
-			"                                                     │               │   │           │   │   │   └─ TableAlias(mf)\n" +
-			"                                                     │               │   │           │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"                                                     │               │   │           │   │   │           ├─ index: [HGMQ6.LUEVY]\n" +
-			"                                                     │               │   │           │   │   │           └─ columns: [id gxlub luevy fsdy2]\n" +
-			"                                                     │               │   │           │   │   └─ TableAlias(bs)\n" +
-			"                                                     │               │   │           │   │       └─ IndexedTableAccess(THNTS)\n" +
-			"                                                     │               │   │           │   │           ├─ index: [THNTS.id]\n" +
-			"                                                     │               │   │           │   │           └─ columns: [id ixuxu]\n" +
-			"                                                     │               │   │           │   └─ Filter\n" +
-			"                                                     │               │   │           │       ├─ HashIn\n" +
-			"                                                     │               │   │           │       │   ├─ cla.FTQLQ:1!null\n" +
-			"                                                     │               │   │           │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                                                     │               │   │           │       └─ TableAlias(cla)\n" +
-			"                                                     │               │   │           │           └─ IndexedTableAccess(YK2GW)\n" +
-			"                                                     │               │   │           │               ├─ index: [YK2GW.id]\n" +
-			"                                                     │               │   │           │               └─ columns: [id ftqlq]\n" +
-			"                                                     │               │   │           └─ TableAlias(sn)\n" +
-			"                                                     │               │   │               └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                     │               │   │                   ├─ index: [NOXN3.BRQP2]\n" +
-			"                                                     │               │   │                   └─ columns: [brqp2]\n" +
-			"                                                     │               │   └─ TableAlias(W2MAO)\n" +
-			"                                                     │               │       └─ IndexedTableAccess(SEQS3)\n" +
-			"                                                     │               │           ├─ index: [SEQS3.Z7CP5,SEQS3.YH4XB]\n" +
-			"                                                     │               │           └─ columns: [z7cp5 yh4xb]\n" +
-			"                                                     │               └─ HashLookup\n" +
-			"                                                     │                   ├─ source: TUPLE(W2MAO.YH4XB:6)\n" +
-			"                                                     │                   ├─ target: TUPLE(vc.id:0!null)\n" +
-			"                                                     │                   └─ CachedResults\n" +
-			"                                                     │                       └─ TableAlias(vc)\n" +
-			"                                                     │                           └─ Table\n" +
-			"                                                     │                               ├─ name: D34QP\n" +
-			"                                                     │                               └─ columns: [id znp4p]\n" +
-			"                                                     └─ TableAlias(nma)\n" +
-			"                                                         └─ IndexedTableAccess(TNMXI)\n" +
-			"                                                             ├─ index: [TNMXI.id]\n" +
-			"                                                             └─ columns: [id]\n" +
+			"                                     ├─ columns: [bs.T4IBQ:1!null as T4IBQ, pa.DZLIM:3 as ECUWU, pga.DZLIM:12 as GSTQA, pog.B5OUF:10, fc.OZTQF:20, F26ZW.YHYLK:24, nd.TW55N:16 as TW55N]\n" +
+			"                                     └─ LeftOuterLookupJoin\n" +
+			"                                         ├─ Eq\n" +
+			"                                         │   ├─ nd.HPCMS:17\n" +
+			"                                         │   └─ nma.id:25!null\n" +
+			"                                         ├─ LeftOuterJoin\n" +
+			"                                         │   ├─ AND\n" +
+			"                                         │   │   ├─ Eq\n" +
+			"                                         │   │   │   ├─ F26ZW.T4IBQ:21!null\n" +
+			"                                         │   │   │   └─ bs.T4IBQ:1!null\n" +
+			"                                         │   │   └─ Eq\n" +
+			"                                         │   │       ├─ F26ZW.BRQP2:22!null\n" +
+			"                                         │   │       └─ nd.id:15\n" +
+			"                                         │   ├─ LeftOuterLookupJoin\n" +
+			"                                         │   │   ├─ AND\n" +
+			"                                         │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   ├─ bs.id:0!null\n" +
+			"                                         │   │   │   │   └─ fc.GXLUB:18!null\n" +
+			"                                         │   │   │   └─ Eq\n" +
+			"                                         │   │   │       ├─ nd.id:15\n" +
+			"                                         │   │   │       └─ fc.LUEVY:19!null\n" +
+			"                                         │   │   ├─ InnerJoin\n" +
+			"                                         │   │   │   ├─ Eq\n" +
+			"                                         │   │   │   │   ├─ ms.GXLUB:4!null\n" +
+			"                                         │   │   │   │   └─ bs.id:0!null\n" +
+			"                                         │   │   │   ├─ SubqueryAlias\n" +
+			"                                         │   │   │   │   ├─ name: bs\n" +
+			"                                         │   │   │   │   ├─ outerVisibility: false\n" +
+			"                                         │   │   │   │   ├─ cacheable: true\n" +
+			"                                         │   │   │   │   └─ Filter\n" +
+			"                                         │   │   │   │       ├─ HashIn\n" +
+			"                                         │   │   │   │       │   ├─ T4IBQ:1!null\n" +
+			"                                         │   │   │   │       │   └─ TUPLE(SQ1 (longtext))\n" +
+			"                                         │   │   │   │       └─ Project\n" +
+			"                                         │   │   │   │           ├─ columns: [THNTS.id:2!null, YK2GW.FTQLQ:1!null as T4IBQ]\n" +
+			"                                         │   │   │   │           └─ MergeJoin\n" +
+			"                                         │   │   │   │               ├─ cmp: Eq\n" +
+			"                                         │   │   │   │               │   ├─ YK2GW.id:0!null\n" +
+			"                                         │   │   │   │               │   └─ THNTS.IXUXU:3\n" +
+			"                                         │   │   │   │               ├─ IndexedTableAccess(YK2GW)\n" +
+			"                                         │   │   │   │               │   ├─ index: [YK2GW.id]\n" +
+			"                                         │   │   │   │               │   ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │   │   │               │   └─ columns: [id ftqlq]\n" +
+			"                                         │   │   │   │               └─ IndexedTableAccess(THNTS)\n" +
+			"                                         │   │   │   │                   ├─ index: [THNTS.IXUXU]\n" +
+			"                                         │   │   │   │                   ├─ static: [{[NULL, ∞)}]\n" +
+			"                                         │   │   │   │                   └─ columns: [id ixuxu]\n" +
+			"                                         │   │   │   └─ LookupJoin\n" +
+			"                                         │   │   │       ├─ Eq\n" +
+			"                                         │   │   │       │   ├─ GZ7Z4.LUEVY:13!null\n" +
+			"                                         │   │   │       │   └─ nd.id:15!null\n" +
+			"                                         │   │   │       ├─ LookupJoin\n" +
+			"                                         │   │   │       │   ├─ Eq\n" +
+			"                                         │   │   │       │   │   ├─ pog.id:7\n" +
+			"                                         │   │   │       │   │   └─ GZ7Z4.GMSGA:14!null\n" +
+			"                                         │   │   │       │   ├─ LookupJoin\n" +
+			"                                         │   │   │       │   │   ├─ Eq\n" +
+			"                                         │   │   │       │   │   │   ├─ pog.XVSBH:9\n" +
+			"                                         │   │   │       │   │   │   └─ pga.id:11!null\n" +
+			"                                         │   │   │       │   │   ├─ LeftOuterLookupJoin\n" +
+			"                                         │   │   │       │   │   │   ├─ Eq\n" +
+			"                                         │   │   │       │   │   │   │   ├─ pa.id:2!null\n" +
+			"                                         │   │   │       │   │   │   │   └─ pog.CH3FR:8!null\n" +
+			"                                         │   │   │       │   │   │   ├─ LookupJoin\n" +
+			"                                         │   │   │       │   │   │   │   ├─ Eq\n" +
+			"                                         │   │   │       │   │   │   │   │   ├─ ms.CH3FR:5!null\n" +
+			"                                         │   │   │       │   │   │   │   │   └─ pa.id:2!null\n" +
+			"                                         │   │   │       │   │   │   │   ├─ TableAlias(pa)\n" +
+			"                                         │   │   │       │   │   │   │   │   └─ Table\n" +
+			"                                         │   │   │       │   │   │   │   │       ├─ name: XOAOP\n" +
+			"                                         │   │   │       │   │   │   │   │       └─ columns: [id dzlim]\n" +
+			"                                         │   │   │       │   │   │   │   └─ Filter\n" +
+			"                                         │   │   │       │   │   │   │       ├─ Eq\n" +
+			"                                         │   │   │       │   │   │   │       │   ├─ ms.D237E:2\n" +
+			"                                         │   │   │       │   │   │   │       │   └─ true (tinyint)\n" +
+			"                                         │   │   │       │   │   │   │       └─ TableAlias(ms)\n" +
+			"                                         │   │   │       │   │   │   │           └─ IndexedTableAccess(SZQWJ)\n" +
+			"                                         │   │   │       │   │   │   │               ├─ index: [SZQWJ.CH3FR]\n" +
+			"                                         │   │   │       │   │   │   │               └─ columns: [gxlub ch3fr d237e]\n" +
+			"                                         │   │   │       │   │   │   └─ TableAlias(pog)\n" +
+			"                                         │   │   │       │   │   │       └─ IndexedTableAccess(NPCYY)\n" +
+			"                                         │   │   │       │   │   │           ├─ index: [NPCYY.CH3FR,NPCYY.XVSBH]\n" +
+			"                                         │   │   │       │   │   │           └─ columns: [id ch3fr xvsbh b5ouf]\n" +
+			"                                         │   │   │       │   │   └─ TableAlias(pga)\n" +
+			"                                         │   │   │       │   │       └─ IndexedTableAccess(PG27A)\n" +
+			"                                         │   │   │       │   │           ├─ index: [PG27A.id]\n" +
+			"                                         │   │   │       │   │           └─ columns: [id dzlim]\n" +
+			"                                         │   │   │       │   └─ TableAlias(GZ7Z4)\n" +
+			"                                         │   │   │       │       └─ IndexedTableAccess(FEIOE)\n" +
+			"                                         │   │   │       │           ├─ index: [FEIOE.GMSGA]\n" +
+			"                                         │   │   │       │           └─ columns: [luevy gmsga]\n" +
+			"                                         │   │   │       └─ TableAlias(nd)\n" +
+			"                                         │   │   │           └─ IndexedTableAccess(E2I7U)\n" +
+			"                                         │   │   │               ├─ index: [E2I7U.id]\n" +
+			"                                         │   │   │               └─ columns: [id tw55n hpcms]\n" +
+			"                                         │   │   └─ TableAlias(fc)\n" +
+			"                                         │   │       └─ IndexedTableAccess(AMYXQ)\n" +
+			"                                         │   │           ├─ index: [AMYXQ.GXLUB,AMYXQ.LUEVY]\n" +
+			"                                         │   │           └─ columns: [gxlub luevy oztqf]\n" +
+			"                                         │   └─ SubqueryAlias\n" +
+			"                                         │       ├─ name: F26ZW\n" +
+			"                                         │       ├─ outerVisibility: false\n" +
+			"                                         │       ├─ cacheable: true\n" +
+			"                                         │       └─ Project\n" +
+			"                                         │           ├─ columns: [iq.T4IBQ:0!null, iq.BRQP2:1!null, iq.Z7CP5:2!null, CASE  WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ Eq\n" +
+			"                                         │           │   │       ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │       └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ KAOAS (longtext)\n" +
+			"                                         │           │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ Eq\n" +
+			"                                         │           │   │       ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │       └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ OG (longtext)\n" +
+			"                                         │           │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ Eq\n" +
+			"                                         │           │   │       ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │       └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ TSG (longtext)\n" +
+			"                                         │           │   THEN 0 (tinyint) WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ NOT\n" +
+			"                                         │           │   │       └─ Eq\n" +
+			"                                         │           │   │           ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │           └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ W6W24 (longtext)\n" +
+			"                                         │           │   THEN 1 (tinyint) WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ NOT\n" +
+			"                                         │           │   │       └─ Eq\n" +
+			"                                         │           │   │           ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │           └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ OG (longtext)\n" +
+			"                                         │           │   THEN 1 (tinyint) WHEN AND\n" +
+			"                                         │           │   ├─ AND\n" +
+			"                                         │           │   │   ├─ IN\n" +
+			"                                         │           │   │   │   ├─ left: iq.FSDY2:3!null\n" +
+			"                                         │           │   │   │   └─ right: TUPLE(SRARY (longtext), UBQWG (longtext))\n" +
+			"                                         │           │   │   └─ NOT\n" +
+			"                                         │           │   │       └─ Eq\n" +
+			"                                         │           │   │           ├─ vc.ZNP4P:8\n" +
+			"                                         │           │   │           └─ L5Q44 (longtext)\n" +
+			"                                         │           │   └─ Eq\n" +
+			"                                         │           │       ├─ iq.IDWIO:4!null\n" +
+			"                                         │           │       └─ TSG (longtext)\n" +
+			"                                         │           │   THEN 0 (tinyint) ELSE NULL (null) END as YHYLK]\n" +
+			"                                         │           └─ LeftOuterHashJoin\n" +
+			"                                         │               ├─ Eq\n" +
+			"                                         │               │   ├─ W2MAO.YH4XB:6\n" +
+			"                                         │               │   └─ vc.id:7!null\n" +
+			"                                         │               ├─ LeftOuterLookupJoin\n" +
+			"                                         │               │   ├─ Eq\n" +
+			"                                         │               │   │   ├─ iq.Z7CP5:2!null\n" +
+			"                                         │               │   │   └─ W2MAO.Z7CP5:5!null\n" +
+			"                                         │               │   ├─ SubqueryAlias\n" +
+			"                                         │               │   │   ├─ name: iq\n" +
+			"                                         │               │   │   ├─ outerVisibility: false\n" +
+			"                                         │               │   │   ├─ cacheable: true\n" +
+			"                                         │               │   │   └─ Project\n" +
+			"                                         │               │   │       ├─ columns: [cla.FTQLQ:11!null as T4IBQ, sn.BRQP2:12!null, mf.id:4!null as Z7CP5, mf.FSDY2:7!null, nma.DZLIM:1!null as IDWIO]\n" +
+			"                                         │               │   │       └─ LookupJoin\n" +
+			"                                         │               │   │           ├─ Eq\n" +
+			"                                         │               │   │           │   ├─ sn.BRQP2:12!null\n" +
+			"                                         │               │   │           │   └─ nd.id:2!null\n" +
+			"                                         │               │   │           ├─ LookupJoin\n" +
+			"                                         │               │   │           │   ├─ Eq\n" +
+			"                                         │               │   │           │   │   ├─ bs.IXUXU:9\n" +
+			"                                         │               │   │           │   │   └─ cla.id:10!null\n" +
+			"                                         │               │   │           │   ├─ LookupJoin\n" +
+			"                                         │               │   │           │   │   ├─ Eq\n" +
+			"                                         │               │   │           │   │   │   ├─ mf.GXLUB:5!null\n" +
+			"                                         │               │   │           │   │   │   └─ bs.id:8!null\n" +
+			"                                         │               │   │           │   │   ├─ LookupJoin\n" +
+			"                                         │               │   │           │   │   │   ├─ Eq\n" +
+			"                                         │               │   │           │   │   │   │   ├─ mf.LUEVY:6!null\n" +
+			"                                         │               │   │           │   │   │   │   └─ nd.id:2!null\n" +
+			"                                         │               │   │           │   │   │   ├─ LookupJoin\n" +
+			"                                         │               │   │           │   │   │   │   ├─ Eq\n" +
+			"                                         │               │   │           │   │   │   │   │   ├─ nd.HPCMS:3!null\n" +
+			"                                         │               │   │           │   │   │   │   │   └─ nma.id:0!null\n" +
+			"                                         │               │   │           │   │   │   │   ├─ TableAlias(nma)\n" +
+			"                                         │               │   │           │   │   │   │   │   └─ Table\n" +
+			"                                         │               │   │           │   │   │   │   │       ├─ name: TNMXI\n" +
+			"                                         │               │   │           │   │   │   │   │       └─ columns: [id dzlim]\n" +
+			"                                         │               │   │           │   │   │   │   └─ TableAlias(nd)\n" +
+			"                                         │               │   │           │   │   │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"                                         │               │   │           │   │   │   │           ├─ index: [E2I7U.HPCMS]\n" +
+			"                                         │               │   │           │   │   │   │           └─ columns: [id hpcms]\n" +
+			"                                         │               │   │           │   │   │   └─ TableAlias(mf)\n" +
+			"                                         │               │   │           │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
+			"                                         │               │   │           │   │   │           ├─ index: [HGMQ6.LUEVY]\n" +
+			"                                         │               │   │           │   │   │           └─ columns: [id gxlub luevy fsdy2]\n" +
+			"                                         │               │   │           │   │   └─ TableAlias(bs)\n" +
+			"                                         │               │   │           │   │       └─ IndexedTableAccess(THNTS)\n" +
+			"                                         │               │   │           │   │           ├─ index: [THNTS.id]\n" +
+			"                                         │               │   │           │   │           └─ columns: [id ixuxu]\n" +
+			"                                         │               │   │           │   └─ Filter\n" +
+			"                                         │               │   │           │       ├─ HashIn\n" +
+			"                                         │               │   │           │       │   ├─ cla.FTQLQ:1!null\n" +
+			"                                         │               │   │           │       │   └─ TUPLE(SQ1 (longtext))\n" +
+			"                                         │               │   │           │       └─ TableAlias(cla)\n" +
+			"                                         │               │   │           │           └─ IndexedTableAccess(YK2GW)\n" +
+			"                                         │               │   │           │               ├─ index: [YK2GW.id]\n" +
+			"                                         │               │   │           │               └─ columns: [id ftqlq]\n" +
+			"                                         │               │   │           └─ TableAlias(sn)\n" +
+			"                                         │               │   │               └─ IndexedTableAccess(NOXN3)\n" +
+			"                                         │               │   │                   ├─ index: [NOXN3.BRQP2]\n" +
+			"                                         │               │   │                   └─ columns: [brqp2]\n" +
+			"                                         │               │   └─ TableAlias(W2MAO)\n" +
+			"                                         │               │       └─ IndexedTableAccess(SEQS3)\n" +
+			"                                         │               │           ├─ index: [SEQS3.Z7CP5,SEQS3.YH4XB]\n" +
+			"                                         │               │           └─ columns: [z7cp5 yh4xb]\n" +
+			"                                         │               └─ HashLookup\n" +
+			"                                         │                   ├─ source: TUPLE(W2MAO.YH4XB:6)\n" +
+			"                                         │                   ├─ target: TUPLE(vc.id:0!null)\n" +
+			"                                         │                   └─ CachedResults\n" +
+			"                                         │                       └─ TableAlias(vc)\n" +
+			"                                         │                           └─ Table\n" +
+			"                                         │                               ├─ name: D34QP\n" +
+			"                                         │                               └─ columns: [id znp4p]\n" +
+			"                                         └─ TableAlias(nma)\n" +
+			"                                             └─ IndexedTableAccess(TNMXI)\n" +
+			"                                                 ├─ index: [TNMXI.id]\n" +
+			"                                                 └─ columns: [id]\n" +
 			"",
 	},
 	{
@@ -11242,15 +11319,14 @@ WHERE
 			"             │                       ├─ Eq\n" +
 			"             │                       │   ├─ bs.IXUXU:3\n" +
 			"             │                       │   └─ cla.id:0!null\n" +
-			"             │                       ├─ Filter\n" +
-			"             │                       │   ├─ HashIn\n" +
-			"             │                       │   │   ├─ cla.FTQLQ:1!null\n" +
-			"             │                       │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"             │                       │   └─ TableAlias(cla)\n" +
-			"             │                       │       └─ IndexedTableAccess(YK2GW)\n" +
-			"             │                       │           ├─ index: [YK2GW.FTQLQ]\n" +
-			"             │                       │           ├─ static: [{[SQ1, SQ1]}]\n" +
-			"             │                       │           └─ columns: [id ftqlq]\n" +
+			"             │                       ├─ TableAlias(cla)\n" +
+			"             │                       │   └─ IndexedTableAccess(YK2GW)\n" +
+			"             │                       │       ├─ index: [YK2GW.FTQLQ]\n" +
+			"             │                       │       ├─ static: [{[SQ1, SQ1]}]\n" +
+			"             │                       │       ├─ columns: [id ftqlq]\n" +
+			"             │                       │       └─ index condition: IN\n" +
+			"             │                       │           ├─ left: YK2GW.FTQLQ:1!null\n" +
+			"             │                       │           └─ right: TUPLE(SQ1 (longtext))\n" +
 			"             │                       └─ HashLookup\n" +
 			"             │                           ├─ source: TUPLE(cla.id:0!null)\n" +
 			"             │                           ├─ target: TUPLE(bs.IXUXU:1)\n" +
@@ -11317,37 +11393,37 @@ WHERE
 			"                                 │   ├─ nd.TCE7A:4\n" +
 			"                                 │   └─ 0.900000 (double)\n" +
 			"                                 │   THEN 1 (tinyint) ELSE 0 (tinyint) END as YAZ4X]\n" +
-			"                                 └─ Filter\n" +
-			"                                     ├─ NOT\n" +
-			"                                     │   └─ Eq\n" +
-			"                                     │       ├─ nma.DZLIM:7\n" +
-			"                                     │       └─ Q5I4E (longtext)\n" +
-			"                                     └─ LeftOuterHashJoin\n" +
-			"                                         ├─ Eq\n" +
-			"                                         │   ├─ nd.HPCMS:5\n" +
-			"                                         │   └─ nma.id:6!null\n" +
-			"                                         ├─ LeftOuterMergeJoin\n" +
-			"                                         │   ├─ cmp: Eq\n" +
-			"                                         │   │   ├─ sn.BRQP2:1!null\n" +
-			"                                         │   │   └─ nd.id:2!null\n" +
-			"                                         │   ├─ TableAlias(sn)\n" +
-			"                                         │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"                                         │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"                                         │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │   │       └─ columns: [id brqp2]\n" +
-			"                                         │   └─ TableAlias(nd)\n" +
-			"                                         │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                         │           ├─ index: [E2I7U.id]\n" +
-			"                                         │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │           └─ columns: [id tw55n tce7a hpcms]\n" +
-			"                                         └─ HashLookup\n" +
-			"                                             ├─ source: TUPLE(nd.HPCMS:5)\n" +
-			"                                             ├─ target: TUPLE(nma.id:0!null)\n" +
-			"                                             └─ CachedResults\n" +
-			"                                                 └─ TableAlias(nma)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: TNMXI\n" +
-			"                                                         └─ columns: [id dzlim]\n" +
+			"                                 └─ HashJoin\n" +
+			"                                     ├─ Eq\n" +
+			"                                     │   ├─ nd.HPCMS:5\n" +
+			"                                     │   └─ nma.id:6!null\n" +
+			"                                     ├─ LeftOuterMergeJoin\n" +
+			"                                     │   ├─ cmp: Eq\n" +
+			"                                     │   │   ├─ sn.BRQP2:1!null\n" +
+			"                                     │   │   └─ nd.id:2!null\n" +
+			"                                     │   ├─ TableAlias(sn)\n" +
+			"                                     │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"                                     │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"                                     │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                     │   │       └─ columns: [id brqp2]\n" +
+			"                                     │   └─ TableAlias(nd)\n" +
+			"                                     │       └─ IndexedTableAccess(E2I7U)\n" +
+			"                                     │           ├─ index: [E2I7U.id]\n" +
+			"                                     │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                     │           └─ columns: [id tw55n tce7a hpcms]\n" +
+			"                                     └─ HashLookup\n" +
+			"                                         ├─ source: TUPLE(nd.HPCMS:5)\n" +
+			"                                         ├─ target: TUPLE(nma.id:0!null)\n" +
+			"                                         └─ CachedResults\n" +
+			"                                             └─ TableAlias(nma)\n" +
+			"                                                 └─ IndexedTableAccess(TNMXI)\n" +
+			"                                                     ├─ index: [TNMXI.DZLIM]\n" +
+			"                                                     ├─ static: [{(Q5I4E, ∞)}, {(NULL, Q5I4E)}]\n" +
+			"                                                     ├─ columns: [id dzlim]\n" +
+			"                                                     └─ index condition: NOT\n" +
+			"                                                         └─ Eq\n" +
+			"                                                             ├─ TNMXI.DZLIM:1\n" +
+			"                                                             └─ Q5I4E (longtext)\n" +
 			"",
 	},
 	{
@@ -11531,37 +11607,37 @@ WHERE
 			"                                 │   ├─ nd.TCE7A:4\n" +
 			"                                 │   └─ 0.900000 (double)\n" +
 			"                                 │   THEN 1 (tinyint) ELSE 0 (tinyint) END as YAZ4X]\n" +
-			"                                 └─ Filter\n" +
-			"                                     ├─ NOT\n" +
-			"                                     │   └─ Eq\n" +
-			"                                     │       ├─ nma.DZLIM:7\n" +
-			"                                     │       └─ Q5I4E (longtext)\n" +
-			"                                     └─ LeftOuterHashJoin\n" +
-			"                                         ├─ Eq\n" +
-			"                                         │   ├─ nd.HPCMS:5\n" +
-			"                                         │   └─ nma.id:6!null\n" +
-			"                                         ├─ LeftOuterMergeJoin\n" +
-			"                                         │   ├─ cmp: Eq\n" +
-			"                                         │   │   ├─ sn.BRQP2:1!null\n" +
-			"                                         │   │   └─ nd.id:2!null\n" +
-			"                                         │   ├─ TableAlias(sn)\n" +
-			"                                         │   │   └─ IndexedTableAccess(NOXN3)\n" +
-			"                                         │   │       ├─ index: [NOXN3.BRQP2]\n" +
-			"                                         │   │       ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │   │       └─ columns: [id brqp2]\n" +
-			"                                         │   └─ TableAlias(nd)\n" +
-			"                                         │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                         │           ├─ index: [E2I7U.id]\n" +
-			"                                         │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │           └─ columns: [id tw55n tce7a hpcms]\n" +
-			"                                         └─ HashLookup\n" +
-			"                                             ├─ source: TUPLE(nd.HPCMS:5)\n" +
-			"                                             ├─ target: TUPLE(nma.id:0!null)\n" +
-			"                                             └─ CachedResults\n" +
-			"                                                 └─ TableAlias(nma)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: TNMXI\n" +
-			"                                                         └─ columns: [id dzlim]\n" +
+			"                                 └─ HashJoin\n" +
+			"                                     ├─ Eq\n" +
+			"                                     │   ├─ nd.HPCMS:5\n" +
+			"                                     │   └─ nma.id:6!null\n" +
+			"                                     ├─ LeftOuterMergeJoin\n" +
+			"                                     │   ├─ cmp: Eq\n" +
+			"                                     │   │   ├─ sn.BRQP2:1!null\n" +
+			"                                     │   │   └─ nd.id:2!null\n" +
+			"                                     │   ├─ TableAlias(sn)\n" +
+			"                                     │   │   └─ IndexedTableAccess(NOXN3)\n" +
+			"                                     │   │       ├─ index: [NOXN3.BRQP2]\n" +
+			"                                     │   │       ├─ static: [{[NULL, ∞)}]\n" +
+			"                                     │   │       └─ columns: [id brqp2]\n" +
+			"                                     │   └─ TableAlias(nd)\n" +
+			"                                     │       └─ IndexedTableAccess(E2I7U)\n" +
+			"                                     │           ├─ index: [E2I7U.id]\n" +
+			"                                     │           ├─ static: [{[NULL, ∞)}]\n" +
+			"                                     │           └─ columns: [id tw55n tce7a hpcms]\n" +
+			"                                     └─ HashLookup\n" +
+			"                                         ├─ source: TUPLE(nd.HPCMS:5)\n" +
+			"                                         ├─ target: TUPLE(nma.id:0!null)\n" +
+			"                                         └─ CachedResults\n" +
+			"                                             └─ TableAlias(nma)\n" +
+			"                                                 └─ IndexedTableAccess(TNMXI)\n" +
+			"                                                     ├─ index: [TNMXI.DZLIM]\n" +
+			"                                                     ├─ static: [{(Q5I4E, ∞)}, {(NULL, Q5I4E)}]\n" +
+			"                                                     ├─ columns: [id dzlim]\n" +
+			"                                                     └─ index condition: NOT\n" +
+			"                                                         └─ Eq\n" +
+			"                                                             ├─ TNMXI.DZLIM:1\n" +
+			"                                                             └─ Q5I4E (longtext)\n" +
 			"",
 	},
 	{
@@ -11872,7 +11948,7 @@ WHERE
 			"     │   │   │       │   ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, convert\n" +
 			"     │   │   │       │   │   ├─ type: char\n" +
 			"     │   │   │       │   │   └─ JCHIR.QNI57:9\n" +
-			"     │   │   │       │   │   as QNI57, TDEIU:10 as TDEIU]\n" +
+			"     │   │   │       │   │   as QNI57, TDEIU:10]\n" +
 			"     │   │   │       │   └─ Union distinct\n" +
 			"     │   │   │       │       ├─ Project\n" +
 			"     │   │   │       │       │   ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, convert\n" +
@@ -11971,196 +12047,186 @@ WHERE
 			"     │   │   │       │       │                               ├─ name: NOXN3\n" +
 			"     │   │   │       │       │                               └─ columns: [id brqp2 fftbj]\n" +
 			"     │   │   │       │       └─ Project\n" +
-			"     │   │   │       │           ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, convert\n" +
-			"     │   │   │       │           │   ├─ type: char\n" +
-			"     │   │   │       │           │   └─ TDEIU:10\n" +
-			"     │   │   │       │           │   as TDEIU]\n" +
-			"     │   │   │       │           └─ Project\n" +
-			"     │   │   │       │               ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, NULL (null) as TDEIU]\n" +
-			"     │   │   │       │               └─ SubqueryAlias\n" +
-			"     │   │   │       │                   ├─ name: JCHIR\n" +
-			"     │   │   │       │                   ├─ outerVisibility: false\n" +
-			"     │   │   │       │                   ├─ cacheable: true\n" +
-			"     │   │   │       │                   └─ Filter\n" +
-			"     │   │   │       │                       ├─ AND\n" +
-			"     │   │   │       │                       │   ├─ NOT\n" +
-			"     │   │   │       │                       │   │   └─ QNI57:9 IS NULL\n" +
-			"     │   │   │       │                       │   └─ NOT\n" +
-			"     │   │   │       │                       │       └─ TDEIU:10 IS NULL\n" +
-			"     │   │   │       │                       └─ Project\n" +
-			"     │   │   │       │                           ├─ columns: [ism.FV24E:0!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:2!null as M22QN, G3YXS.GE5EL:8, G3YXS.F7A4Q:9, G3YXS.ESFVY:6!null, CASE  WHEN IN\n" +
-			"     │   │   │       │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │                           │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
-			"     │   │   │       │                           │   THEN 0 (tinyint) WHEN IN\n" +
-			"     │   │   │       │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │                           │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
-			"     │   │   │       │                           │   THEN 1 (tinyint) WHEN IN\n" +
-			"     │   │   │       │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │                           │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
-			"     │   │   │       │                           │   THEN 2 (tinyint) WHEN IN\n" +
-			"     │   │   │       │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │       │                           │   └─ right: TUPLE(Y62X7 (longtext))\n" +
-			"     │   │   │       │                           │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:7!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
-			"     │   │   │       │                           └─ Filter\n" +
-			"     │   │   │       │                               ├─ Or\n" +
-			"     │   │   │       │                               │   ├─ NOT\n" +
-			"     │   │   │       │                               │   │   └─ YQIF4.id:15 IS NULL\n" +
-			"     │   │   │       │                               │   └─ NOT\n" +
-			"     │   │   │       │                               │       └─ YVHJZ.id:18 IS NULL\n" +
-			"     │   │   │       │                               └─ LeftOuterJoin\n" +
-			"     │   │   │       │                                   ├─ AND\n" +
-			"     │   │   │       │                                   │   ├─ Eq\n" +
-			"     │   │   │       │                                   │   │   ├─ YVHJZ.BRQP2:19!null\n" +
-			"     │   │   │       │                                   │   │   └─ ism.UJ6XY:1!null\n" +
-			"     │   │   │       │                                   │   └─ Eq\n" +
-			"     │   │   │       │                                   │       ├─ YVHJZ.FFTBJ:20!null\n" +
-			"     │   │   │       │                                   │       └─ ism.FV24E:0!null\n" +
-			"     │   │   │       │                                   ├─ LeftOuterJoin\n" +
-			"     │   │   │       │                                   │   ├─ AND\n" +
-			"     │   │   │       │                                   │   │   ├─ Eq\n" +
-			"     │   │   │       │                                   │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
-			"     │   │   │       │                                   │   │   │   └─ ism.FV24E:0!null\n" +
-			"     │   │   │       │                                   │   │   └─ Eq\n" +
-			"     │   │   │       │                                   │   │       ├─ YQIF4.FFTBJ:17!null\n" +
-			"     │   │   │       │                                   │   │       └─ ism.UJ6XY:1!null\n" +
-			"     │   │   │       │                                   │   ├─ LeftOuterJoin\n" +
-			"     │   │   │       │                                   │   │   ├─ AND\n" +
-			"     │   │   │       │                                   │   │   │   ├─ Eq\n" +
-			"     │   │   │       │                                   │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
-			"     │   │   │       │                                   │   │   │   │   └─ NHMXW.NOHHR:11\n" +
-			"     │   │   │       │                                   │   │   │   └─ NOT\n" +
-			"     │   │   │       │                                   │   │   │       └─ Eq\n" +
-			"     │   │   │       │                                   │   │   │           ├─ CPMFE.id:12!null\n" +
-			"     │   │   │       │                                   │   │   │           └─ ism.FV24E:0!null\n" +
-			"     │   │   │       │                                   │   │   ├─ LeftOuterJoin\n" +
-			"     │   │   │       │                                   │   │   │   ├─ Eq\n" +
-			"     │   │   │       │                                   │   │   │   │   ├─ NHMXW.id:10!null\n" +
-			"     │   │   │       │                                   │   │   │   │   └─ ism.PRUV2:4\n" +
-			"     │   │   │       │                                   │   │   │   ├─ InnerJoin\n" +
-			"     │   │   │       │                                   │   │   │   │   ├─ Eq\n" +
-			"     │   │   │       │                                   │   │   │   │   │   ├─ G3YXS.id:5!null\n" +
-			"     │   │   │       │                                   │   │   │   │   │   └─ ism.NZ4MQ:3!null\n" +
-			"     │   │   │       │                                   │   │   │   │   ├─ TableAlias(ism)\n" +
-			"     │   │   │       │                                   │   │   │   │   │   └─ Table\n" +
-			"     │   │   │       │                                   │   │   │   │   │       ├─ name: HDDVB\n" +
-			"     │   │   │       │                                   │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"     │   │   │       │                                   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"     │   │   │       │                                   │   │   │   │       └─ Table\n" +
-			"     │   │   │       │                                   │   │   │   │           ├─ name: YYBCX\n" +
-			"     │   │   │       │                                   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"     │   │   │       │                                   │   │   │   └─ TableAlias(NHMXW)\n" +
-			"     │   │   │       │                                   │   │   │       └─ Table\n" +
-			"     │   │   │       │                                   │   │   │           ├─ name: WGSDC\n" +
-			"     │   │   │       │                                   │   │   │           └─ columns: [id nohhr]\n" +
-			"     │   │   │       │                                   │   │   └─ TableAlias(CPMFE)\n" +
-			"     │   │   │       │                                   │   │       └─ Table\n" +
-			"     │   │   │       │                                   │   │           ├─ name: E2I7U\n" +
-			"     │   │   │       │                                   │   │           └─ columns: [id tw55n zh72s]\n" +
-			"     │   │   │       │                                   │   └─ TableAlias(YQIF4)\n" +
-			"     │   │   │       │                                   │       └─ Table\n" +
-			"     │   │   │       │                                   │           ├─ name: NOXN3\n" +
-			"     │   │   │       │                                   │           └─ columns: [id brqp2 fftbj]\n" +
-			"     │   │   │       │                                   └─ TableAlias(YVHJZ)\n" +
-			"     │   │   │       │                                       └─ Table\n" +
-			"     │   │   │       │                                           ├─ name: NOXN3\n" +
-			"     │   │   │       │                                           └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │   │       │           ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, JCHIR.QNI57:9, NULL (longtext) as TDEIU]\n" +
+			"     │   │   │       │           └─ SubqueryAlias\n" +
+			"     │   │   │       │               ├─ name: JCHIR\n" +
+			"     │   │   │       │               ├─ outerVisibility: false\n" +
+			"     │   │   │       │               ├─ cacheable: true\n" +
+			"     │   │   │       │               └─ Filter\n" +
+			"     │   │   │       │                   ├─ AND\n" +
+			"     │   │   │       │                   │   ├─ NOT\n" +
+			"     │   │   │       │                   │   │   └─ QNI57:9 IS NULL\n" +
+			"     │   │   │       │                   │   └─ NOT\n" +
+			"     │   │   │       │                   │       └─ TDEIU:10 IS NULL\n" +
+			"     │   │   │       │                   └─ Project\n" +
+			"     │   │   │       │                       ├─ columns: [ism.FV24E:0!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:2!null as M22QN, G3YXS.GE5EL:8, G3YXS.F7A4Q:9, G3YXS.ESFVY:6!null, CASE  WHEN IN\n" +
+			"     │   │   │       │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │                       │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
+			"     │   │   │       │                       │   THEN 0 (tinyint) WHEN IN\n" +
+			"     │   │   │       │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │                       │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
+			"     │   │   │       │                       │   THEN 1 (tinyint) WHEN IN\n" +
+			"     │   │   │       │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │                       │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
+			"     │   │   │       │                       │   THEN 2 (tinyint) WHEN IN\n" +
+			"     │   │   │       │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │       │                       │   └─ right: TUPLE(Y62X7 (longtext))\n" +
+			"     │   │   │       │                       │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:7!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
+			"     │   │   │       │                       └─ Filter\n" +
+			"     │   │   │       │                           ├─ Or\n" +
+			"     │   │   │       │                           │   ├─ NOT\n" +
+			"     │   │   │       │                           │   │   └─ YQIF4.id:15 IS NULL\n" +
+			"     │   │   │       │                           │   └─ NOT\n" +
+			"     │   │   │       │                           │       └─ YVHJZ.id:18 IS NULL\n" +
+			"     │   │   │       │                           └─ LeftOuterJoin\n" +
+			"     │   │   │       │                               ├─ AND\n" +
+			"     │   │   │       │                               │   ├─ Eq\n" +
+			"     │   │   │       │                               │   │   ├─ YVHJZ.BRQP2:19!null\n" +
+			"     │   │   │       │                               │   │   └─ ism.UJ6XY:1!null\n" +
+			"     │   │   │       │                               │   └─ Eq\n" +
+			"     │   │   │       │                               │       ├─ YVHJZ.FFTBJ:20!null\n" +
+			"     │   │   │       │                               │       └─ ism.FV24E:0!null\n" +
+			"     │   │   │       │                               ├─ LeftOuterJoin\n" +
+			"     │   │   │       │                               │   ├─ AND\n" +
+			"     │   │   │       │                               │   │   ├─ Eq\n" +
+			"     │   │   │       │                               │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
+			"     │   │   │       │                               │   │   │   └─ ism.FV24E:0!null\n" +
+			"     │   │   │       │                               │   │   └─ Eq\n" +
+			"     │   │   │       │                               │   │       ├─ YQIF4.FFTBJ:17!null\n" +
+			"     │   │   │       │                               │   │       └─ ism.UJ6XY:1!null\n" +
+			"     │   │   │       │                               │   ├─ LeftOuterJoin\n" +
+			"     │   │   │       │                               │   │   ├─ AND\n" +
+			"     │   │   │       │                               │   │   │   ├─ Eq\n" +
+			"     │   │   │       │                               │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
+			"     │   │   │       │                               │   │   │   │   └─ NHMXW.NOHHR:11\n" +
+			"     │   │   │       │                               │   │   │   └─ NOT\n" +
+			"     │   │   │       │                               │   │   │       └─ Eq\n" +
+			"     │   │   │       │                               │   │   │           ├─ CPMFE.id:12!null\n" +
+			"     │   │   │       │                               │   │   │           └─ ism.FV24E:0!null\n" +
+			"     │   │   │       │                               │   │   ├─ LeftOuterJoin\n" +
+			"     │   │   │       │                               │   │   │   ├─ Eq\n" +
+			"     │   │   │       │                               │   │   │   │   ├─ NHMXW.id:10!null\n" +
+			"     │   │   │       │                               │   │   │   │   └─ ism.PRUV2:4\n" +
+			"     │   │   │       │                               │   │   │   ├─ InnerJoin\n" +
+			"     │   │   │       │                               │   │   │   │   ├─ Eq\n" +
+			"     │   │   │       │                               │   │   │   │   │   ├─ G3YXS.id:5!null\n" +
+			"     │   │   │       │                               │   │   │   │   │   └─ ism.NZ4MQ:3!null\n" +
+			"     │   │   │       │                               │   │   │   │   ├─ TableAlias(ism)\n" +
+			"     │   │   │       │                               │   │   │   │   │   └─ Table\n" +
+			"     │   │   │       │                               │   │   │   │   │       ├─ name: HDDVB\n" +
+			"     │   │   │       │                               │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"     │   │   │       │                               │   │   │   │   └─ TableAlias(G3YXS)\n" +
+			"     │   │   │       │                               │   │   │   │       └─ Table\n" +
+			"     │   │   │       │                               │   │   │   │           ├─ name: YYBCX\n" +
+			"     │   │   │       │                               │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"     │   │   │       │                               │   │   │   └─ TableAlias(NHMXW)\n" +
+			"     │   │   │       │                               │   │   │       └─ Table\n" +
+			"     │   │   │       │                               │   │   │           ├─ name: WGSDC\n" +
+			"     │   │   │       │                               │   │   │           └─ columns: [id nohhr]\n" +
+			"     │   │   │       │                               │   │   └─ TableAlias(CPMFE)\n" +
+			"     │   │   │       │                               │   │       └─ Table\n" +
+			"     │   │   │       │                               │   │           ├─ name: E2I7U\n" +
+			"     │   │   │       │                               │   │           └─ columns: [id tw55n zh72s]\n" +
+			"     │   │   │       │                               │   └─ TableAlias(YQIF4)\n" +
+			"     │   │   │       │                               │       └─ Table\n" +
+			"     │   │   │       │                               │           ├─ name: NOXN3\n" +
+			"     │   │   │       │                               │           └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │   │       │                               └─ TableAlias(YVHJZ)\n" +
+			"     │   │   │       │                                   └─ Table\n" +
+			"     │   │   │       │                                       ├─ name: NOXN3\n" +
+			"     │   │   │       │                                       └─ columns: [id brqp2 fftbj]\n" +
 			"     │   │   │       └─ Project\n" +
-			"     │   │   │           ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, convert\n" +
-			"     │   │   │           │   ├─ type: char\n" +
-			"     │   │   │           │   └─ QNI57:9\n" +
-			"     │   │   │           │   as QNI57, convert\n" +
+			"     │   │   │           ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, NULL (longtext) as QNI57, convert\n" +
 			"     │   │   │           │   ├─ type: char\n" +
 			"     │   │   │           │   └─ JCHIR.TDEIU:10\n" +
 			"     │   │   │           │   as TDEIU]\n" +
-			"     │   │   │           └─ Project\n" +
-			"     │   │   │               ├─ columns: [JCHIR.FJDP5:0!null, JCHIR.BJUF2:1, JCHIR.PSMU6:2, JCHIR.M22QN:3!null, JCHIR.GE5EL:4, JCHIR.F7A4Q:5, JCHIR.ESFVY:6!null, JCHIR.CC4AX:7, JCHIR.SL76B:8!null, NULL (null) as QNI57, JCHIR.TDEIU:10]\n" +
-			"     │   │   │               └─ SubqueryAlias\n" +
-			"     │   │   │                   ├─ name: JCHIR\n" +
-			"     │   │   │                   ├─ outerVisibility: false\n" +
-			"     │   │   │                   ├─ cacheable: true\n" +
-			"     │   │   │                   └─ Filter\n" +
-			"     │   │   │                       ├─ AND\n" +
-			"     │   │   │                       │   ├─ NOT\n" +
-			"     │   │   │                       │   │   └─ QNI57:9 IS NULL\n" +
-			"     │   │   │                       │   └─ NOT\n" +
-			"     │   │   │                       │       └─ TDEIU:10 IS NULL\n" +
-			"     │   │   │                       └─ Project\n" +
-			"     │   │   │                           ├─ columns: [ism.FV24E:0!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:2!null as M22QN, G3YXS.GE5EL:8, G3YXS.F7A4Q:9, G3YXS.ESFVY:6!null, CASE  WHEN IN\n" +
-			"     │   │   │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │                           │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
-			"     │   │   │                           │   THEN 0 (tinyint) WHEN IN\n" +
-			"     │   │   │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │                           │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
-			"     │   │   │                           │   THEN 1 (tinyint) WHEN IN\n" +
-			"     │   │   │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │                           │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
-			"     │   │   │                           │   THEN 2 (tinyint) WHEN IN\n" +
-			"     │   │   │                           │   ├─ left: G3YXS.SL76B:7!null\n" +
-			"     │   │   │                           │   └─ right: TUPLE(Y62X7 (longtext))\n" +
-			"     │   │   │                           │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:7!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
-			"     │   │   │                           └─ Filter\n" +
-			"     │   │   │                               ├─ Or\n" +
-			"     │   │   │                               │   ├─ NOT\n" +
-			"     │   │   │                               │   │   └─ YQIF4.id:15 IS NULL\n" +
-			"     │   │   │                               │   └─ NOT\n" +
-			"     │   │   │                               │       └─ YVHJZ.id:18 IS NULL\n" +
-			"     │   │   │                               └─ LeftOuterJoin\n" +
-			"     │   │   │                                   ├─ AND\n" +
-			"     │   │   │                                   │   ├─ Eq\n" +
-			"     │   │   │                                   │   │   ├─ YVHJZ.BRQP2:19!null\n" +
-			"     │   │   │                                   │   │   └─ ism.UJ6XY:1!null\n" +
-			"     │   │   │                                   │   └─ Eq\n" +
-			"     │   │   │                                   │       ├─ YVHJZ.FFTBJ:20!null\n" +
-			"     │   │   │                                   │       └─ ism.FV24E:0!null\n" +
-			"     │   │   │                                   ├─ LeftOuterJoin\n" +
-			"     │   │   │                                   │   ├─ AND\n" +
-			"     │   │   │                                   │   │   ├─ Eq\n" +
-			"     │   │   │                                   │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
-			"     │   │   │                                   │   │   │   └─ ism.FV24E:0!null\n" +
-			"     │   │   │                                   │   │   └─ Eq\n" +
-			"     │   │   │                                   │   │       ├─ YQIF4.FFTBJ:17!null\n" +
-			"     │   │   │                                   │   │       └─ ism.UJ6XY:1!null\n" +
-			"     │   │   │                                   │   ├─ LeftOuterJoin\n" +
-			"     │   │   │                                   │   │   ├─ AND\n" +
-			"     │   │   │                                   │   │   │   ├─ Eq\n" +
-			"     │   │   │                                   │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
-			"     │   │   │                                   │   │   │   │   └─ NHMXW.NOHHR:11\n" +
-			"     │   │   │                                   │   │   │   └─ NOT\n" +
-			"     │   │   │                                   │   │   │       └─ Eq\n" +
-			"     │   │   │                                   │   │   │           ├─ CPMFE.id:12!null\n" +
-			"     │   │   │                                   │   │   │           └─ ism.FV24E:0!null\n" +
-			"     │   │   │                                   │   │   ├─ LeftOuterJoin\n" +
-			"     │   │   │                                   │   │   │   ├─ Eq\n" +
-			"     │   │   │                                   │   │   │   │   ├─ NHMXW.id:10!null\n" +
-			"     │   │   │                                   │   │   │   │   └─ ism.PRUV2:4\n" +
-			"     │   │   │                                   │   │   │   ├─ InnerJoin\n" +
-			"     │   │   │                                   │   │   │   │   ├─ Eq\n" +
-			"     │   │   │                                   │   │   │   │   │   ├─ G3YXS.id:5!null\n" +
-			"     │   │   │                                   │   │   │   │   │   └─ ism.NZ4MQ:3!null\n" +
-			"     │   │   │                                   │   │   │   │   ├─ TableAlias(ism)\n" +
-			"     │   │   │                                   │   │   │   │   │   └─ Table\n" +
-			"     │   │   │                                   │   │   │   │   │       ├─ name: HDDVB\n" +
-			"     │   │   │                                   │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
-			"     │   │   │                                   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"     │   │   │                                   │   │   │   │       └─ Table\n" +
-			"     │   │   │                                   │   │   │   │           ├─ name: YYBCX\n" +
-			"     │   │   │                                   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
-			"     │   │   │                                   │   │   │   └─ TableAlias(NHMXW)\n" +
-			"     │   │   │                                   │   │   │       └─ Table\n" +
-			"     │   │   │                                   │   │   │           ├─ name: WGSDC\n" +
-			"     │   │   │                                   │   │   │           └─ columns: [id nohhr]\n" +
-			"     │   │   │                                   │   │   └─ TableAlias(CPMFE)\n" +
-			"     │   │   │                                   │   │       └─ Table\n" +
-			"     │   │   │                                   │   │           ├─ name: E2I7U\n" +
-			"     │   │   │                                   │   │           └─ columns: [id tw55n zh72s]\n" +
-			"     │   │   │                                   │   └─ TableAlias(YQIF4)\n" +
-			"     │   │   │                                   │       └─ Table\n" +
-			"     │   │   │                                   │           ├─ name: NOXN3\n" +
-			"     │   │   │                                   │           └─ columns: [id brqp2 fftbj]\n" +
-			"     │   │   │                                   └─ TableAlias(YVHJZ)\n" +
-			"     │   │   │                                       └─ Table\n" +
-			"     │   │   │                                           ├─ name: NOXN3\n" +
-			"     │   │   │                                           └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │   │           └─ SubqueryAlias\n" +
+			"     │   │   │               ├─ name: JCHIR\n" +
+			"     │   │   │               ├─ outerVisibility: false\n" +
+			"     │   │   │               ├─ cacheable: true\n" +
+			"     │   │   │               └─ Filter\n" +
+			"     │   │   │                   ├─ AND\n" +
+			"     │   │   │                   │   ├─ NOT\n" +
+			"     │   │   │                   │   │   └─ QNI57:9 IS NULL\n" +
+			"     │   │   │                   │   └─ NOT\n" +
+			"     │   │   │                   │       └─ TDEIU:10 IS NULL\n" +
+			"     │   │   │                   └─ Project\n" +
+			"     │   │   │                       ├─ columns: [ism.FV24E:0!null as FJDP5, CPMFE.id:12 as BJUF2, CPMFE.TW55N:13 as PSMU6, ism.M22QN:2!null as M22QN, G3YXS.GE5EL:8, G3YXS.F7A4Q:9, G3YXS.ESFVY:6!null, CASE  WHEN IN\n" +
+			"     │   │   │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │                       │   └─ right: TUPLE(FO422 (longtext), SJ53H (longtext))\n" +
+			"     │   │   │                       │   THEN 0 (tinyint) WHEN IN\n" +
+			"     │   │   │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │                       │   └─ right: TUPLE(DCV4Z (longtext), UOSM4 (longtext), FUGIP (longtext), H5MCC (longtext), YKEQE (longtext), D3AKL (longtext))\n" +
+			"     │   │   │                       │   THEN 1 (tinyint) WHEN IN\n" +
+			"     │   │   │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │                       │   └─ right: TUPLE(QJEXM (longtext), J6S7P (longtext), VT7FI (longtext))\n" +
+			"     │   │   │                       │   THEN 2 (tinyint) WHEN IN\n" +
+			"     │   │   │                       │   ├─ left: G3YXS.SL76B:7!null\n" +
+			"     │   │   │                       │   └─ right: TUPLE(Y62X7 (longtext))\n" +
+			"     │   │   │                       │   THEN 3 (tinyint) END as CC4AX, G3YXS.SL76B:7!null as SL76B, YQIF4.id:15 as QNI57, YVHJZ.id:18 as TDEIU]\n" +
+			"     │   │   │                       └─ Filter\n" +
+			"     │   │   │                           ├─ Or\n" +
+			"     │   │   │                           │   ├─ NOT\n" +
+			"     │   │   │                           │   │   └─ YQIF4.id:15 IS NULL\n" +
+			"     │   │   │                           │   └─ NOT\n" +
+			"     │   │   │                           │       └─ YVHJZ.id:18 IS NULL\n" +
+			"     │   │   │                           └─ LeftOuterJoin\n" +
+			"     │   │   │                               ├─ AND\n" +
+			"     │   │   │                               │   ├─ Eq\n" +
+			"     │   │   │                               │   │   ├─ YVHJZ.BRQP2:19!null\n" +
+			"     │   │   │                               │   │   └─ ism.UJ6XY:1!null\n" +
+			"     │   │   │                               │   └─ Eq\n" +
+			"     │   │   │                               │       ├─ YVHJZ.FFTBJ:20!null\n" +
+			"     │   │   │                               │       └─ ism.FV24E:0!null\n" +
+			"     │   │   │                               ├─ LeftOuterJoin\n" +
+			"     │   │   │                               │   ├─ AND\n" +
+			"     │   │   │                               │   │   ├─ Eq\n" +
+			"     │   │   │                               │   │   │   ├─ YQIF4.BRQP2:16!null\n" +
+			"     │   │   │                               │   │   │   └─ ism.FV24E:0!null\n" +
+			"     │   │   │                               │   │   └─ Eq\n" +
+			"     │   │   │                               │   │       ├─ YQIF4.FFTBJ:17!null\n" +
+			"     │   │   │                               │   │       └─ ism.UJ6XY:1!null\n" +
+			"     │   │   │                               │   ├─ LeftOuterJoin\n" +
+			"     │   │   │                               │   │   ├─ AND\n" +
+			"     │   │   │                               │   │   │   ├─ Eq\n" +
+			"     │   │   │                               │   │   │   │   ├─ CPMFE.ZH72S:14\n" +
+			"     │   │   │                               │   │   │   │   └─ NHMXW.NOHHR:11\n" +
+			"     │   │   │                               │   │   │   └─ NOT\n" +
+			"     │   │   │                               │   │   │       └─ Eq\n" +
+			"     │   │   │                               │   │   │           ├─ CPMFE.id:12!null\n" +
+			"     │   │   │                               │   │   │           └─ ism.FV24E:0!null\n" +
+			"     │   │   │                               │   │   ├─ LeftOuterJoin\n" +
+			"     │   │   │                               │   │   │   ├─ Eq\n" +
+			"     │   │   │                               │   │   │   │   ├─ NHMXW.id:10!null\n" +
+			"     │   │   │                               │   │   │   │   └─ ism.PRUV2:4\n" +
+			"     │   │   │                               │   │   │   ├─ InnerJoin\n" +
+			"     │   │   │                               │   │   │   │   ├─ Eq\n" +
+			"     │   │   │                               │   │   │   │   │   ├─ G3YXS.id:5!null\n" +
+			"     │   │   │                               │   │   │   │   │   └─ ism.NZ4MQ:3!null\n" +
+			"     │   │   │                               │   │   │   │   ├─ TableAlias(ism)\n" +
+			"     │   │   │                               │   │   │   │   │   └─ Table\n" +
+			"     │   │   │                               │   │   │   │   │       ├─ name: HDDVB\n" +
+			"     │   │   │                               │   │   │   │   │       └─ columns: [fv24e uj6xy m22qn nz4mq pruv2]\n" +
+			"     │   │   │                               │   │   │   │   └─ TableAlias(G3YXS)\n" +
+			"     │   │   │                               │   │   │   │       └─ Table\n" +
+			"     │   │   │                               │   │   │   │           ├─ name: YYBCX\n" +
+			"     │   │   │                               │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q]\n" +
+			"     │   │   │                               │   │   │   └─ TableAlias(NHMXW)\n" +
+			"     │   │   │                               │   │   │       └─ Table\n" +
+			"     │   │   │                               │   │   │           ├─ name: WGSDC\n" +
+			"     │   │   │                               │   │   │           └─ columns: [id nohhr]\n" +
+			"     │   │   │                               │   │   └─ TableAlias(CPMFE)\n" +
+			"     │   │   │                               │   │       └─ Table\n" +
+			"     │   │   │                               │   │           ├─ name: E2I7U\n" +
+			"     │   │   │                               │   │           └─ columns: [id tw55n zh72s]\n" +
+			"     │   │   │                               │   └─ TableAlias(YQIF4)\n" +
+			"     │   │   │                               │       └─ Table\n" +
+			"     │   │   │                               │           ├─ name: NOXN3\n" +
+			"     │   │   │                               │           └─ columns: [id brqp2 fftbj]\n" +
+			"     │   │   │                               └─ TableAlias(YVHJZ)\n" +
+			"     │   │   │                                   └─ Table\n" +
+			"     │   │   │                                       ├─ name: NOXN3\n" +
+			"     │   │   │                                       └─ columns: [id brqp2 fftbj]\n" +
 			"     │   │   └─ TableAlias(sn)\n" +
 			"     │   │       └─ Table\n" +
 			"     │   │           ├─ name: NOXN3\n" +
@@ -12367,10 +12433,9 @@ WHERE
     )`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [fs.T4IBQ:0!null as T4IBQ, fs.M6T2N:1 as M6T2N, fs.TUV25:3 as TUV25, fs.BTXC5:2 as YEBDJ]\n" +
-			" └─ AntiJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ TUPLE(fs.T4IBQ:0!null, fs.M6T2N:1, fs.BTXC5:2, fs.TUV25:3)\n" +
-			"     │   └─ TUPLE(applySubq0.T4IBQ:4!null, applySubq0.M6T2N:5, applySubq0.BTXC5:6, applySubq0.TUV25:7)\n" +
+			" └─ AntiHashJoin\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ ((fs.T4IBQ, fs.M6T2N, fs.BTXC5, fs.TUV25) = (applySubq0.T4IBQ, applySubq0.M6T2N, applySubq0.BTXC5, applySubq0.TUV25)) IS FALSE\n" +
 			"     ├─ SubqueryAlias\n" +
 			"     │   ├─ name: fs\n" +
 			"     │   ├─ outerVisibility: false\n" +
@@ -12608,15 +12673,14 @@ WHERE
 			"     │                                   ├─ Eq\n" +
 			"     │                                   │   ├─ cla.id:0!null\n" +
 			"     │                                   │   └─ bs.IXUXU:3\n" +
-			"     │                                   ├─ Filter\n" +
-			"     │                                   │   ├─ HashIn\n" +
-			"     │                                   │   │   ├─ cla.FTQLQ:1!null\n" +
-			"     │                                   │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"     │                                   │   └─ TableAlias(cla)\n" +
-			"     │                                   │       └─ IndexedTableAccess(YK2GW)\n" +
-			"     │                                   │           ├─ index: [YK2GW.FTQLQ]\n" +
-			"     │                                   │           ├─ static: [{[SQ1, SQ1]}]\n" +
-			"     │                                   │           └─ columns: [id ftqlq]\n" +
+			"     │                                   ├─ TableAlias(cla)\n" +
+			"     │                                   │   └─ IndexedTableAccess(YK2GW)\n" +
+			"     │                                   │       ├─ index: [YK2GW.FTQLQ]\n" +
+			"     │                                   │       ├─ static: [{[SQ1, SQ1]}]\n" +
+			"     │                                   │       ├─ columns: [id ftqlq]\n" +
+			"     │                                   │       └─ index condition: IN\n" +
+			"     │                                   │           ├─ left: YK2GW.FTQLQ:1!null\n" +
+			"     │                                   │           └─ right: TUPLE(SQ1 (longtext))\n" +
 			"     │                                   └─ HashLookup\n" +
 			"     │                                       ├─ source: TUPLE(cla.id:0!null)\n" +
 			"     │                                       ├─ target: TUPLE(bs.IXUXU:1)\n" +
@@ -12641,281 +12705,285 @@ WHERE
 			"     │                                                   └─ IndexedTableAccess(NOXN3)\n" +
 			"     │                                                       ├─ index: [NOXN3.BRQP2]\n" +
 			"     │                                                       └─ columns: [id brqp2]\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: applySubq0\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         └─ SubqueryAlias\n" +
-			"             ├─ name: ZMSPR\n" +
-			"             ├─ outerVisibility: true\n" +
-			"             ├─ cacheable: true\n" +
-			"             └─ Distinct\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [cld.T4IBQ:0!null as T4IBQ, P4PJZ.M6T2N:3 as M6T2N, P4PJZ.BTXC5:4 as BTXC5, P4PJZ.TUV25:7 as TUV25]\n" +
-			"                     └─ Filter\n" +
-			"                         ├─ NOT\n" +
-			"                         │   └─ P4PJZ.M6T2N:3 IS NULL\n" +
-			"                         └─ LeftOuterHashJoin\n" +
-			"                             ├─ AND\n" +
-			"                             │   ├─ Eq\n" +
-			"                             │   │   ├─ P4PJZ.LWQ6O:6\n" +
-			"                             │   │   └─ cld.BDNYB:1!null\n" +
-			"                             │   └─ Eq\n" +
-			"                             │       ├─ P4PJZ.NTOFG:5!null\n" +
-			"                             │       └─ cld.M22QN:2!null\n" +
-			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: cld\n" +
-			"                             │   ├─ outerVisibility: false\n" +
-			"                             │   ├─ cacheable: true\n" +
-			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:6!null as M22QN]\n" +
-			"                             │       └─ HashJoin\n" +
-			"                             │           ├─ Eq\n" +
-			"                             │           │   ├─ cla.id:0!null\n" +
-			"                             │           │   └─ bs.IXUXU:3\n" +
-			"                             │           ├─ Filter\n" +
-			"                             │           │   ├─ HashIn\n" +
-			"                             │           │   │   ├─ cla.FTQLQ:1!null\n" +
-			"                             │           │   │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                             │           │   └─ TableAlias(cla)\n" +
-			"                             │           │       └─ IndexedTableAccess(YK2GW)\n" +
-			"                             │           │           ├─ index: [YK2GW.FTQLQ]\n" +
-			"                             │           │           ├─ static: [{[SQ1, SQ1]}]\n" +
-			"                             │           │           └─ columns: [id ftqlq]\n" +
-			"                             │           └─ HashLookup\n" +
-			"                             │               ├─ source: TUPLE(cla.id:0!null)\n" +
-			"                             │               ├─ target: TUPLE(bs.IXUXU:1)\n" +
-			"                             │               └─ CachedResults\n" +
-			"                             │                   └─ LookupJoin\n" +
-			"                             │                       ├─ Eq\n" +
-			"                             │                       │   ├─ sn.BRQP2:8!null\n" +
-			"                             │                       │   └─ mf.LUEVY:5!null\n" +
-			"                             │                       ├─ LookupJoin\n" +
-			"                             │                       │   ├─ Eq\n" +
-			"                             │                       │   │   ├─ bs.id:2!null\n" +
-			"                             │                       │   │   └─ mf.GXLUB:4!null\n" +
-			"                             │                       │   ├─ TableAlias(bs)\n" +
-			"                             │                       │   │   └─ Table\n" +
-			"                             │                       │   │       ├─ name: THNTS\n" +
-			"                             │                       │   │       └─ columns: [id ixuxu]\n" +
-			"                             │                       │   └─ TableAlias(mf)\n" +
-			"                             │                       │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"                             │                       │           ├─ index: [HGMQ6.GXLUB]\n" +
-			"                             │                       │           └─ columns: [gxlub luevy m22qn]\n" +
-			"                             │                       └─ TableAlias(sn)\n" +
-			"                             │                           └─ IndexedTableAccess(NOXN3)\n" +
-			"                             │                               ├─ index: [NOXN3.BRQP2]\n" +
-			"                             │                               └─ columns: [id brqp2]\n" +
-			"                             └─ HashLookup\n" +
-			"                                 ├─ source: TUPLE(cld.BDNYB:1!null, cld.M22QN:2!null)\n" +
-			"                                 ├─ target: TUPLE(P4PJZ.LWQ6O:3, P4PJZ.NTOFG:2!null)\n" +
-			"                                 └─ CachedResults\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: P4PJZ\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [CASE  WHEN NOT\n" +
-			"                                             │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.QNI57:5\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   WHEN NOT\n" +
-			"                                             │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.TDEIU:6\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
-			"                                             └─ LeftOuterJoin\n" +
-			"                                                 ├─ Or\n" +
-			"                                                 │   ├─ Or\n" +
-			"                                                 │   │   ├─ Or\n" +
-			"                                                 │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │   │   │   └─ Eq\n" +
-			"                                                 │   │   │   │   │       ├─ sn.id:10!null\n" +
-			"                                                 │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
-			"                                                 │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   │   └─ AND\n" +
-			"                                                 │   │   │       ├─ AND\n" +
-			"                                                 │   │   │       │   ├─ NOT\n" +
-			"                                                 │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │       │   └─ InSubquery\n" +
-			"                                                 │   │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │   │       │           └─ Project\n" +
-			"                                                 │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
-			"                                                 │   │   │       │               └─ Filter\n" +
-			"                                                 │   │   │       │                   ├─ Eq\n" +
-			"                                                 │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
-			"                                                 │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │   │   │       │                   └─ TableAlias(JTEHG)\n" +
-			"                                                 │   │   │       │                       └─ Table\n" +
-			"                                                 │   │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │   │       └─ NOT\n" +
-			"                                                 │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   └─ AND\n" +
-			"                                                 │   │       ├─ AND\n" +
-			"                                                 │   │       │   ├─ NOT\n" +
-			"                                                 │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │   │       │   └─ InSubquery\n" +
-			"                                                 │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │       │           └─ Project\n" +
-			"                                                 │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │   │       │               └─ Filter\n" +
-			"                                                 │   │       │                   ├─ Eq\n" +
-			"                                                 │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
-			"                                                 │   │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │   │       │                       └─ Table\n" +
-			"                                                 │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   └─ AND\n" +
-			"                                                 │       ├─ AND\n" +
-			"                                                 │       │   ├─ NOT\n" +
-			"                                                 │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │       │   └─ InSubquery\n" +
-			"                                                 │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │       │       └─ right: Subquery\n" +
-			"                                                 │       │           ├─ cacheable: false\n" +
-			"                                                 │       │           └─ Project\n" +
-			"                                                 │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │       │               └─ Filter\n" +
-			"                                                 │       │                   ├─ Eq\n" +
-			"                                                 │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │       │                       └─ Table\n" +
-			"                                                 │       │                           ├─ name: NOXN3\n" +
-			"                                                 │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │       └─ NOT\n" +
-			"                                                 │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 ├─ LookupJoin\n" +
-			"                                                 │   ├─ Eq\n" +
-			"                                                 │   │   ├─ aac.id:7!null\n" +
-			"                                                 │   │   └─ MJR3D.M22QN:2!null\n" +
-			"                                                 │   ├─ SubqueryAlias\n" +
-			"                                                 │   │   ├─ name: MJR3D\n" +
-			"                                                 │   │   ├─ outerVisibility: false\n" +
-			"                                                 │   │   ├─ cacheable: true\n" +
-			"                                                 │   │   └─ Distinct\n" +
-			"                                                 │   │       └─ Project\n" +
-			"                                                 │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
-			"                                                 │   │           └─ Filter\n" +
-			"                                                 │   │               ├─ Or\n" +
-			"                                                 │   │               │   ├─ NOT\n" +
-			"                                                 │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
-			"                                                 │   │               │   └─ NOT\n" +
-			"                                                 │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
-			"                                                 │   │               └─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   ├─ AND\n" +
-			"                                                 │   │                   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
-			"                                                 │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   └─ Eq\n" +
-			"                                                 │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
-			"                                                 │   │                   │       └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
-			"                                                 │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   └─ Eq\n" +
-			"                                                 │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
-			"                                                 │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
-			"                                                 │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
-			"                                                 │   │                   │   │   │   └─ NOT\n" +
-			"                                                 │   │                   │   │   │       └─ Eq\n" +
-			"                                                 │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
-			"                                                 │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
-			"                                                 │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
-			"                                                 │   │                   │   │   │   ├─ LookupJoin\n" +
-			"                                                 │   │                   │   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
-			"                                                 │   │                   │   │   │   │   ├─ Filter\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"                                                 │   │                   │   │   │   │   │       └─ Table\n" +
-			"                                                 │   │                   │   │   │   │   │           ├─ name: YYBCX\n" +
-			"                                                 │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"                                                 │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"                                                 │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"                                                 │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   └─ TableAlias(NHMXW)\n" +
-			"                                                 │   │                   │   │   │       └─ IndexedTableAccess(WGSDC)\n" +
-			"                                                 │   │                   │   │   │           ├─ index: [WGSDC.id]\n" +
-			"                                                 │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   └─ TableAlias(CPMFE)\n" +
-			"                                                 │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                 │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                 │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"                                                 │   │                   │   └─ TableAlias(YQIF4)\n" +
-			"                                                 │   │                   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                 │   │                   │           ├─ index: [NOXN3.BRQP2]\n" +
-			"                                                 │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   │                   └─ TableAlias(YVHJZ)\n" +
-			"                                                 │   │                       └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                 │   │                           ├─ index: [NOXN3.BRQP2]\n" +
-			"                                                 │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   └─ TableAlias(aac)\n" +
-			"                                                 │       └─ IndexedTableAccess(TPXBU)\n" +
-			"                                                 │           ├─ index: [TPXBU.id]\n" +
-			"                                                 │           └─ columns: [id btxc5 fhcyt]\n" +
-			"                                                 └─ TableAlias(sn)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: NOXN3\n" +
-			"                                                         └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ source: TUPLE(TUPLE(fs.T4IBQ:0!null, fs.M6T2N:1, fs.BTXC5:2, fs.TUV25:3))\n" +
+			"         ├─ target: TUPLE(TUPLE(applySubq0.T4IBQ:0!null, applySubq0.M6T2N:1, applySubq0.BTXC5:2, applySubq0.TUV25:3))\n" +
+			"         ├─ null-aware: true\n" +
+			"         └─ CachedResults\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: applySubq0\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: ZMSPR\n" +
+			"                     ├─ outerVisibility: true\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [cld.T4IBQ:5!null as T4IBQ, P4PJZ.M6T2N:0 as M6T2N, P4PJZ.BTXC5:1 as BTXC5, P4PJZ.TUV25:4 as TUV25]\n" +
+			"                             └─ Filter\n" +
+			"                                 ├─ NOT\n" +
+			"                                 │   └─ P4PJZ.M6T2N:0 IS NULL\n" +
+			"                                 └─ HashJoin\n" +
+			"                                     ├─ AND\n" +
+			"                                     │   ├─ Eq\n" +
+			"                                     │   │   ├─ P4PJZ.LWQ6O:3\n" +
+			"                                     │   │   └─ cld.BDNYB:6!null\n" +
+			"                                     │   └─ Eq\n" +
+			"                                     │       ├─ P4PJZ.NTOFG:2!null\n" +
+			"                                     │       └─ cld.M22QN:7!null\n" +
+			"                                     ├─ SubqueryAlias\n" +
+			"                                     │   ├─ name: P4PJZ\n" +
+			"                                     │   ├─ outerVisibility: false\n" +
+			"                                     │   ├─ cacheable: true\n" +
+			"                                     │   └─ Project\n" +
+			"                                     │       ├─ columns: [CASE  WHEN NOT\n" +
+			"                                     │       │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                                     │       │   THEN Subquery\n" +
+			"                                     │       │   ├─ cacheable: false\n" +
+			"                                     │       │   └─ Project\n" +
+			"                                     │       │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                                     │       │       └─ Filter\n" +
+			"                                     │       │           ├─ Eq\n" +
+			"                                     │       │           │   ├─ ei.id:20!null\n" +
+			"                                     │       │           │   └─ MJR3D.QNI57:5\n" +
+			"                                     │       │           └─ SubqueryAlias\n" +
+			"                                     │       │               ├─ name: ei\n" +
+			"                                     │       │               ├─ outerVisibility: true\n" +
+			"                                     │       │               ├─ cacheable: true\n" +
+			"                                     │       │               └─ Project\n" +
+			"                                     │       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                                     │       │                   └─ Window\n" +
+			"                                     │       │                       ├─ NOXN3.id:20!null\n" +
+			"                                     │       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                                     │       │                       └─ Table\n" +
+			"                                     │       │                           ├─ name: NOXN3\n" +
+			"                                     │       │                           └─ columns: [id]\n" +
+			"                                     │       │   WHEN NOT\n" +
+			"                                     │       │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                                     │       │   THEN Subquery\n" +
+			"                                     │       │   ├─ cacheable: false\n" +
+			"                                     │       │   └─ Project\n" +
+			"                                     │       │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                                     │       │       └─ Filter\n" +
+			"                                     │       │           ├─ Eq\n" +
+			"                                     │       │           │   ├─ ei.id:20!null\n" +
+			"                                     │       │           │   └─ MJR3D.TDEIU:6\n" +
+			"                                     │       │           └─ SubqueryAlias\n" +
+			"                                     │       │               ├─ name: ei\n" +
+			"                                     │       │               ├─ outerVisibility: true\n" +
+			"                                     │       │               ├─ cacheable: true\n" +
+			"                                     │       │               └─ Project\n" +
+			"                                     │       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                                     │       │                   └─ Window\n" +
+			"                                     │       │                       ├─ NOXN3.id:20!null\n" +
+			"                                     │       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                                     │       │                       └─ Table\n" +
+			"                                     │       │                           ├─ name: NOXN3\n" +
+			"                                     │       │                           └─ columns: [id]\n" +
+			"                                     │       │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
+			"                                     │       └─ LeftOuterJoin\n" +
+			"                                     │           ├─ Or\n" +
+			"                                     │           │   ├─ Or\n" +
+			"                                     │           │   │   ├─ Or\n" +
+			"                                     │           │   │   │   ├─ AND\n" +
+			"                                     │           │   │   │   │   ├─ AND\n" +
+			"                                     │           │   │   │   │   │   ├─ NOT\n" +
+			"                                     │           │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                                     │           │   │   │   │   │   └─ Eq\n" +
+			"                                     │           │   │   │   │   │       ├─ sn.id:10!null\n" +
+			"                                     │           │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
+			"                                     │           │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                                     │           │   │   │   └─ AND\n" +
+			"                                     │           │   │   │       ├─ AND\n" +
+			"                                     │           │   │   │       │   ├─ NOT\n" +
+			"                                     │           │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                                     │           │   │   │       │   └─ InSubquery\n" +
+			"                                     │           │   │   │       │       ├─ left: sn.id:10!null\n" +
+			"                                     │           │   │   │       │       └─ right: Subquery\n" +
+			"                                     │           │   │   │       │           ├─ cacheable: false\n" +
+			"                                     │           │   │   │       │           └─ Project\n" +
+			"                                     │           │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
+			"                                     │           │   │   │       │               └─ Filter\n" +
+			"                                     │           │   │   │       │                   ├─ Eq\n" +
+			"                                     │           │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
+			"                                     │           │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                                     │           │   │   │       │                   └─ TableAlias(JTEHG)\n" +
+			"                                     │           │   │   │       │                       └─ Table\n" +
+			"                                     │           │   │   │       │                           ├─ name: NOXN3\n" +
+			"                                     │           │   │   │       │                           └─ columns: [id brqp2]\n" +
+			"                                     │           │   │   │       └─ NOT\n" +
+			"                                     │           │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                                     │           │   │   └─ AND\n" +
+			"                                     │           │   │       ├─ AND\n" +
+			"                                     │           │   │       │   ├─ NOT\n" +
+			"                                     │           │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                                     │           │   │       │   └─ InSubquery\n" +
+			"                                     │           │   │       │       ├─ left: sn.id:10!null\n" +
+			"                                     │           │   │       │       └─ right: Subquery\n" +
+			"                                     │           │   │       │           ├─ cacheable: false\n" +
+			"                                     │           │   │       │           └─ Project\n" +
+			"                                     │           │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                                     │           │   │       │               └─ Filter\n" +
+			"                                     │           │   │       │                   ├─ Eq\n" +
+			"                                     │           │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                                     │           │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
+			"                                     │           │   │       │                   └─ TableAlias(XMAFZ)\n" +
+			"                                     │           │   │       │                       └─ Table\n" +
+			"                                     │           │   │       │                           ├─ name: NOXN3\n" +
+			"                                     │           │   │       │                           └─ columns: [id brqp2]\n" +
+			"                                     │           │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                                     │           │   └─ AND\n" +
+			"                                     │           │       ├─ AND\n" +
+			"                                     │           │       │   ├─ NOT\n" +
+			"                                     │           │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                                     │           │       │   └─ InSubquery\n" +
+			"                                     │           │       │       ├─ left: sn.id:10!null\n" +
+			"                                     │           │       │       └─ right: Subquery\n" +
+			"                                     │           │       │           ├─ cacheable: false\n" +
+			"                                     │           │       │           └─ Project\n" +
+			"                                     │           │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                                     │           │       │               └─ Filter\n" +
+			"                                     │           │       │                   ├─ Eq\n" +
+			"                                     │           │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                                     │           │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                                     │           │       │                   └─ TableAlias(XMAFZ)\n" +
+			"                                     │           │       │                       └─ Table\n" +
+			"                                     │           │       │                           ├─ name: NOXN3\n" +
+			"                                     │           │       │                           └─ columns: [id brqp2]\n" +
+			"                                     │           │       └─ NOT\n" +
+			"                                     │           │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                                     │           ├─ LookupJoin\n" +
+			"                                     │           │   ├─ Eq\n" +
+			"                                     │           │   │   ├─ aac.id:7!null\n" +
+			"                                     │           │   │   └─ MJR3D.M22QN:2!null\n" +
+			"                                     │           │   ├─ SubqueryAlias\n" +
+			"                                     │           │   │   ├─ name: MJR3D\n" +
+			"                                     │           │   │   ├─ outerVisibility: false\n" +
+			"                                     │           │   │   ├─ cacheable: true\n" +
+			"                                     │           │   │   └─ Distinct\n" +
+			"                                     │           │   │       └─ Project\n" +
+			"                                     │           │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
+			"                                     │           │   │           └─ Filter\n" +
+			"                                     │           │   │               ├─ Or\n" +
+			"                                     │           │   │               │   ├─ NOT\n" +
+			"                                     │           │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
+			"                                     │           │   │               │   └─ NOT\n" +
+			"                                     │           │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
+			"                                     │           │   │               └─ LeftOuterLookupJoin\n" +
+			"                                     │           │   │                   ├─ AND\n" +
+			"                                     │           │   │                   │   ├─ Eq\n" +
+			"                                     │           │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
+			"                                     │           │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
+			"                                     │           │   │                   │   └─ Eq\n" +
+			"                                     │           │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
+			"                                     │           │   │                   │       └─ ism.FV24E:9!null\n" +
+			"                                     │           │   │                   ├─ LeftOuterLookupJoin\n" +
+			"                                     │           │   │                   │   ├─ AND\n" +
+			"                                     │           │   │                   │   │   ├─ Eq\n" +
+			"                                     │           │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
+			"                                     │           │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
+			"                                     │           │   │                   │   │   └─ Eq\n" +
+			"                                     │           │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
+			"                                     │           │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
+			"                                     │           │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"                                     │           │   │                   │   │   ├─ AND\n" +
+			"                                     │           │   │                   │   │   │   ├─ Eq\n" +
+			"                                     │           │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
+			"                                     │           │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
+			"                                     │           │   │                   │   │   │   └─ NOT\n" +
+			"                                     │           │   │                   │   │   │       └─ Eq\n" +
+			"                                     │           │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
+			"                                     │           │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
+			"                                     │           │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
+			"                                     │           │   │                   │   │   │   ├─ Eq\n" +
+			"                                     │           │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
+			"                                     │           │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
+			"                                     │           │   │                   │   │   │   ├─ LookupJoin\n" +
+			"                                     │           │   │                   │   │   │   │   ├─ Eq\n" +
+			"                                     │           │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"                                     │           │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
+			"                                     │           │   │                   │   │   │   │   ├─ Filter\n" +
+			"                                     │           │   │                   │   │   │   │   │   ├─ NOT\n" +
+			"                                     │           │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
+			"                                     │           │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
+			"                                     │           │   │                   │   │   │   │   │       └─ Table\n" +
+			"                                     │           │   │                   │   │   │   │   │           ├─ name: YYBCX\n" +
+			"                                     │           │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
+			"                                     │           │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
+			"                                     │           │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
+			"                                     │           │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
+			"                                     │           │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
+			"                                     │           │   │                   │   │   │   └─ TableAlias(NHMXW)\n" +
+			"                                     │           │   │                   │   │   │       └─ IndexedTableAccess(WGSDC)\n" +
+			"                                     │           │   │                   │   │   │           ├─ index: [WGSDC.id]\n" +
+			"                                     │           │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"                                     │           │   │                   │   │   └─ TableAlias(CPMFE)\n" +
+			"                                     │           │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"                                     │           │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"                                     │           │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                                     │           │   │                   │   └─ TableAlias(YQIF4)\n" +
+			"                                     │           │   │                   │       └─ IndexedTableAccess(NOXN3)\n" +
+			"                                     │           │   │                   │           ├─ index: [NOXN3.BRQP2]\n" +
+			"                                     │           │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                                     │           │   │                   └─ TableAlias(YVHJZ)\n" +
+			"                                     │           │   │                       └─ IndexedTableAccess(NOXN3)\n" +
+			"                                     │           │   │                           ├─ index: [NOXN3.BRQP2]\n" +
+			"                                     │           │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                                     │           │   └─ TableAlias(aac)\n" +
+			"                                     │           │       └─ IndexedTableAccess(TPXBU)\n" +
+			"                                     │           │           ├─ index: [TPXBU.id]\n" +
+			"                                     │           │           └─ columns: [id btxc5 fhcyt]\n" +
+			"                                     │           └─ TableAlias(sn)\n" +
+			"                                     │               └─ Table\n" +
+			"                                     │                   ├─ name: NOXN3\n" +
+			"                                     │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                                     └─ HashLookup\n" +
+			"                                         ├─ source: TUPLE(P4PJZ.LWQ6O:3, P4PJZ.NTOFG:2!null)\n" +
+			"                                         ├─ target: TUPLE(cld.BDNYB:1!null, cld.M22QN:2!null)\n" +
+			"                                         └─ CachedResults\n" +
+			"                                             └─ SubqueryAlias\n" +
+			"                                                 ├─ name: cld\n" +
+			"                                                 ├─ outerVisibility: false\n" +
+			"                                                 ├─ cacheable: true\n" +
+			"                                                 └─ Project\n" +
+			"                                                     ├─ columns: [cla.FTQLQ:1!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:6!null as M22QN]\n" +
+			"                                                     └─ HashJoin\n" +
+			"                                                         ├─ Eq\n" +
+			"                                                         │   ├─ cla.id:0!null\n" +
+			"                                                         │   └─ bs.IXUXU:3\n" +
+			"                                                         ├─ TableAlias(cla)\n" +
+			"                                                         │   └─ IndexedTableAccess(YK2GW)\n" +
+			"                                                         │       ├─ index: [YK2GW.FTQLQ]\n" +
+			"                                                         │       ├─ static: [{[SQ1, SQ1]}]\n" +
+			"                                                         │       ├─ columns: [id ftqlq]\n" +
+			"                                                         │       └─ index condition: IN\n" +
+			"                                                         │           ├─ left: YK2GW.FTQLQ:1!null\n" +
+			"                                                         │           └─ right: TUPLE(SQ1 (longtext))\n" +
+			"                                                         └─ HashLookup\n" +
+			"                                                             ├─ source: TUPLE(cla.id:0!null)\n" +
+			"                                                             ├─ target: TUPLE(bs.IXUXU:1)\n" +
+			"                                                             └─ CachedResults\n" +
+			"                                                                 └─ LookupJoin\n" +
+			"                                                                     ├─ Eq\n" +
+			"                                                                     │   ├─ sn.BRQP2:8!null\n" +
+			"                                                                     │   └─ mf.LUEVY:5!null\n" +
+			"                                                                     ├─ LookupJoin\n" +
+			"                                                                     │   ├─ Eq\n" +
+			"                                                                     │   │   ├─ bs.id:2!null\n" +
+			"                                                                     │   │   └─ mf.GXLUB:4!null\n" +
+			"                                                                     │   ├─ TableAlias(bs)\n" +
+			"                                                                     │   │   └─ Table\n" +
+			"                                                                     │   │       ├─ name: THNTS\n" +
+			"                                                                     │   │       └─ columns: [id ixuxu]\n" +
+			"                                                                     │   └─ TableAlias(mf)\n" +
+			"                                                                     │       └─ IndexedTableAccess(HGMQ6)\n" +
+			"                                                                     │           ├─ index: [HGMQ6.GXLUB]\n" +
+			"                                                                     │           └─ columns: [gxlub luevy m22qn]\n" +
+			"                                                                     └─ TableAlias(sn)\n" +
+			"                                                                         └─ IndexedTableAccess(NOXN3)\n" +
+			"                                                                             ├─ index: [NOXN3.BRQP2]\n" +
+			"                                                                             └─ columns: [id brqp2]\n" +
 			"",
 	},
 	{
@@ -13074,10 +13142,9 @@ WHERE
     )`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [fs.T4IBQ:0!null as T4IBQ, fs.M6T2N:1 as M6T2N, fs.TUV25:3 as TUV25, fs.BTXC5:2 as YEBDJ]\n" +
-			" └─ AntiJoin\n" +
-			"     ├─ Eq\n" +
-			"     │   ├─ TUPLE(fs.T4IBQ:0!null, fs.M6T2N:1, fs.BTXC5:2, fs.TUV25:3)\n" +
-			"     │   └─ TUPLE(applySubq0.T4IBQ:4!null, applySubq0.M6T2N:5, applySubq0.BTXC5:6, applySubq0.TUV25:7)\n" +
+			" └─ AntiHashJoin\n" +
+			"     ├─ NOT\n" +
+			"     │   └─ ((fs.T4IBQ, fs.M6T2N, fs.BTXC5, fs.TUV25) = (applySubq0.T4IBQ, applySubq0.M6T2N, applySubq0.BTXC5, applySubq0.TUV25)) IS FALSE\n" +
 			"     ├─ SubqueryAlias\n" +
 			"     │   ├─ name: fs\n" +
 			"     │   ├─ outerVisibility: false\n" +
@@ -13343,276 +13410,281 @@ WHERE
 			"     │                                       └─ IndexedTableAccess(NOXN3)\n" +
 			"     │                                           ├─ index: [NOXN3.BRQP2]\n" +
 			"     │                                           └─ columns: [id brqp2]\n" +
-			"     └─ SubqueryAlias\n" +
-			"         ├─ name: applySubq0\n" +
-			"         ├─ outerVisibility: false\n" +
-			"         ├─ cacheable: true\n" +
-			"         └─ SubqueryAlias\n" +
-			"             ├─ name: ZMSPR\n" +
-			"             ├─ outerVisibility: true\n" +
-			"             ├─ cacheable: true\n" +
-			"             └─ Distinct\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [cld.T4IBQ:0!null as T4IBQ, P4PJZ.M6T2N:3 as M6T2N, P4PJZ.BTXC5:4 as BTXC5, P4PJZ.TUV25:7 as TUV25]\n" +
-			"                     └─ Filter\n" +
-			"                         ├─ NOT\n" +
-			"                         │   └─ P4PJZ.M6T2N:3 IS NULL\n" +
-			"                         └─ LeftOuterHashJoin\n" +
-			"                             ├─ AND\n" +
-			"                             │   ├─ Eq\n" +
-			"                             │   │   ├─ P4PJZ.LWQ6O:6\n" +
-			"                             │   │   └─ cld.BDNYB:1!null\n" +
-			"                             │   └─ Eq\n" +
-			"                             │       ├─ P4PJZ.NTOFG:5!null\n" +
-			"                             │       └─ cld.M22QN:2!null\n" +
-			"                             ├─ SubqueryAlias\n" +
-			"                             │   ├─ name: cld\n" +
-			"                             │   ├─ outerVisibility: false\n" +
-			"                             │   ├─ cacheable: true\n" +
-			"                             │   └─ Project\n" +
-			"                             │       ├─ columns: [cla.FTQLQ:6!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:4!null as M22QN]\n" +
-			"                             │       └─ LookupJoin\n" +
-			"                             │           ├─ Eq\n" +
-			"                             │           │   ├─ sn.BRQP2:8!null\n" +
-			"                             │           │   └─ mf.LUEVY:3!null\n" +
-			"                             │           ├─ LookupJoin\n" +
-			"                             │           │   ├─ Eq\n" +
-			"                             │           │   │   ├─ cla.id:5!null\n" +
-			"                             │           │   │   └─ bs.IXUXU:1\n" +
-			"                             │           │   ├─ LookupJoin\n" +
-			"                             │           │   │   ├─ Eq\n" +
-			"                             │           │   │   │   ├─ bs.id:0!null\n" +
-			"                             │           │   │   │   └─ mf.GXLUB:2!null\n" +
-			"                             │           │   │   ├─ TableAlias(bs)\n" +
-			"                             │           │   │   │   └─ Table\n" +
-			"                             │           │   │   │       ├─ name: THNTS\n" +
-			"                             │           │   │   │       └─ columns: [id ixuxu]\n" +
-			"                             │           │   │   └─ TableAlias(mf)\n" +
-			"                             │           │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"                             │           │   │           ├─ index: [HGMQ6.GXLUB]\n" +
-			"                             │           │   │           └─ columns: [gxlub luevy m22qn]\n" +
-			"                             │           │   └─ Filter\n" +
-			"                             │           │       ├─ HashIn\n" +
-			"                             │           │       │   ├─ cla.FTQLQ:1!null\n" +
-			"                             │           │       │   └─ TUPLE(SQ1 (longtext))\n" +
-			"                             │           │       └─ TableAlias(cla)\n" +
-			"                             │           │           └─ IndexedTableAccess(YK2GW)\n" +
-			"                             │           │               ├─ index: [YK2GW.id]\n" +
-			"                             │           │               └─ columns: [id ftqlq]\n" +
-			"                             │           └─ TableAlias(sn)\n" +
-			"                             │               └─ IndexedTableAccess(NOXN3)\n" +
-			"                             │                   ├─ index: [NOXN3.BRQP2]\n" +
-			"                             │                   └─ columns: [id brqp2]\n" +
-			"                             └─ HashLookup\n" +
-			"                                 ├─ source: TUPLE(cld.BDNYB:1!null, cld.M22QN:2!null)\n" +
-			"                                 ├─ target: TUPLE(P4PJZ.LWQ6O:3, P4PJZ.NTOFG:2!null)\n" +
-			"                                 └─ CachedResults\n" +
-			"                                     └─ SubqueryAlias\n" +
-			"                                         ├─ name: P4PJZ\n" +
-			"                                         ├─ outerVisibility: false\n" +
-			"                                         ├─ cacheable: true\n" +
-			"                                         └─ Project\n" +
-			"                                             ├─ columns: [CASE  WHEN NOT\n" +
-			"                                             │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.QNI57:5\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   WHEN NOT\n" +
-			"                                             │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                             │   THEN Subquery\n" +
-			"                                             │   ├─ cacheable: false\n" +
-			"                                             │   └─ Project\n" +
-			"                                             │       ├─ columns: [ei.M6T2N:21!null]\n" +
-			"                                             │       └─ Filter\n" +
-			"                                             │           ├─ Eq\n" +
-			"                                             │           │   ├─ ei.id:20!null\n" +
-			"                                             │           │   └─ MJR3D.TDEIU:6\n" +
-			"                                             │           └─ SubqueryAlias\n" +
-			"                                             │               ├─ name: ei\n" +
-			"                                             │               ├─ outerVisibility: true\n" +
-			"                                             │               ├─ cacheable: true\n" +
-			"                                             │               └─ Project\n" +
-			"                                             │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
-			"                                             │                   └─ Window\n" +
-			"                                             │                       ├─ NOXN3.id:20!null\n" +
-			"                                             │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
-			"                                             │                       └─ Table\n" +
-			"                                             │                           ├─ name: NOXN3\n" +
-			"                                             │                           └─ columns: [id]\n" +
-			"                                             │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
-			"                                             └─ LeftOuterJoin\n" +
-			"                                                 ├─ Or\n" +
-			"                                                 │   ├─ Or\n" +
-			"                                                 │   │   ├─ Or\n" +
-			"                                                 │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   ├─ AND\n" +
-			"                                                 │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │   │   │   └─ Eq\n" +
-			"                                                 │   │   │   │   │       ├─ sn.id:10!null\n" +
-			"                                                 │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
-			"                                                 │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   │   └─ AND\n" +
-			"                                                 │   │   │       ├─ AND\n" +
-			"                                                 │   │   │       │   ├─ NOT\n" +
-			"                                                 │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
-			"                                                 │   │   │       │   └─ InSubquery\n" +
-			"                                                 │   │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │   │       │           └─ Project\n" +
-			"                                                 │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
-			"                                                 │   │   │       │               └─ Filter\n" +
-			"                                                 │   │   │       │                   ├─ Eq\n" +
-			"                                                 │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
-			"                                                 │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │   │   │       │                   └─ TableAlias(JTEHG)\n" +
-			"                                                 │   │   │       │                       └─ Table\n" +
-			"                                                 │   │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │   │       └─ NOT\n" +
-			"                                                 │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   │   └─ AND\n" +
-			"                                                 │   │       ├─ AND\n" +
-			"                                                 │   │       │   ├─ NOT\n" +
-			"                                                 │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │   │       │   └─ InSubquery\n" +
-			"                                                 │   │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │   │       │       └─ right: Subquery\n" +
-			"                                                 │   │       │           ├─ cacheable: false\n" +
-			"                                                 │   │       │           └─ Project\n" +
-			"                                                 │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │   │       │               └─ Filter\n" +
-			"                                                 │   │       │                   ├─ Eq\n" +
-			"                                                 │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
-			"                                                 │   │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │   │       │                       └─ Table\n" +
-			"                                                 │   │       │                           ├─ name: NOXN3\n" +
-			"                                                 │   │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 │   └─ AND\n" +
-			"                                                 │       ├─ AND\n" +
-			"                                                 │       │   ├─ NOT\n" +
-			"                                                 │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
-			"                                                 │       │   └─ InSubquery\n" +
-			"                                                 │       │       ├─ left: sn.id:10!null\n" +
-			"                                                 │       │       └─ right: Subquery\n" +
-			"                                                 │       │           ├─ cacheable: false\n" +
-			"                                                 │       │           └─ Project\n" +
-			"                                                 │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
-			"                                                 │       │               └─ Filter\n" +
-			"                                                 │       │                   ├─ Eq\n" +
-			"                                                 │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
-			"                                                 │       │                   │   └─ MJR3D.BJUF2:1\n" +
-			"                                                 │       │                   └─ TableAlias(XMAFZ)\n" +
-			"                                                 │       │                       └─ Table\n" +
-			"                                                 │       │                           ├─ name: NOXN3\n" +
-			"                                                 │       │                           └─ columns: [id brqp2]\n" +
-			"                                                 │       └─ NOT\n" +
-			"                                                 │           └─ MJR3D.BJUF2:1 IS NULL\n" +
-			"                                                 ├─ LookupJoin\n" +
-			"                                                 │   ├─ Eq\n" +
-			"                                                 │   │   ├─ aac.id:7!null\n" +
-			"                                                 │   │   └─ MJR3D.M22QN:2!null\n" +
-			"                                                 │   ├─ SubqueryAlias\n" +
-			"                                                 │   │   ├─ name: MJR3D\n" +
-			"                                                 │   │   ├─ outerVisibility: false\n" +
-			"                                                 │   │   ├─ cacheable: true\n" +
-			"                                                 │   │   └─ Distinct\n" +
-			"                                                 │   │       └─ Project\n" +
-			"                                                 │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
-			"                                                 │   │           └─ Filter\n" +
-			"                                                 │   │               ├─ Or\n" +
-			"                                                 │   │               │   ├─ NOT\n" +
-			"                                                 │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
-			"                                                 │   │               │   └─ NOT\n" +
-			"                                                 │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
-			"                                                 │   │               └─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   ├─ AND\n" +
-			"                                                 │   │                   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
-			"                                                 │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   └─ Eq\n" +
-			"                                                 │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
-			"                                                 │   │                   │       └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
-			"                                                 │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   └─ Eq\n" +
-			"                                                 │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
-			"                                                 │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
-			"                                                 │   │                   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   │   ├─ AND\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
-			"                                                 │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
-			"                                                 │   │                   │   │   │   └─ NOT\n" +
-			"                                                 │   │                   │   │   │       └─ Eq\n" +
-			"                                                 │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
-			"                                                 │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
-			"                                                 │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
-			"                                                 │   │                   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
-			"                                                 │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
-			"                                                 │   │                   │   │   │   ├─ LookupJoin\n" +
-			"                                                 │   │                   │   │   │   │   ├─ Eq\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
-			"                                                 │   │                   │   │   │   │   ├─ Filter\n" +
-			"                                                 │   │                   │   │   │   │   │   ├─ NOT\n" +
-			"                                                 │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
-			"                                                 │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
-			"                                                 │   │                   │   │   │   │   │       └─ Table\n" +
-			"                                                 │   │                   │   │   │   │   │           ├─ name: YYBCX\n" +
-			"                                                 │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
-			"                                                 │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
-			"                                                 │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
-			"                                                 │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   │   └─ TableAlias(NHMXW)\n" +
-			"                                                 │   │                   │   │   │       └─ IndexedTableAccess(WGSDC)\n" +
-			"                                                 │   │                   │   │   │           ├─ index: [WGSDC.id]\n" +
-			"                                                 │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"                                                 │   │                   │   │   └─ TableAlias(CPMFE)\n" +
-			"                                                 │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
-			"                                                 │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
-			"                                                 │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"                                                 │   │                   │   └─ TableAlias(YQIF4)\n" +
-			"                                                 │   │                   │       └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                 │   │                   │           ├─ index: [NOXN3.BRQP2]\n" +
-			"                                                 │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   │                   └─ TableAlias(YVHJZ)\n" +
-			"                                                 │   │                       └─ IndexedTableAccess(NOXN3)\n" +
-			"                                                 │   │                           ├─ index: [NOXN3.BRQP2]\n" +
-			"                                                 │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
-			"                                                 │   └─ TableAlias(aac)\n" +
-			"                                                 │       └─ IndexedTableAccess(TPXBU)\n" +
-			"                                                 │           ├─ index: [TPXBU.id]\n" +
-			"                                                 │           └─ columns: [id btxc5 fhcyt]\n" +
-			"                                                 └─ TableAlias(sn)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: NOXN3\n" +
-			"                                                         └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"     └─ HashLookup\n" +
+			"         ├─ source: TUPLE(TUPLE(fs.T4IBQ:0!null, fs.M6T2N:1, fs.BTXC5:2, fs.TUV25:3))\n" +
+			"         ├─ target: TUPLE(TUPLE(applySubq0.T4IBQ:0!null, applySubq0.M6T2N:1, applySubq0.BTXC5:2, applySubq0.TUV25:3))\n" +
+			"         ├─ null-aware: true\n" +
+			"         └─ CachedResults\n" +
+			"             └─ SubqueryAlias\n" +
+			"                 ├─ name: applySubq0\n" +
+			"                 ├─ outerVisibility: false\n" +
+			"                 ├─ cacheable: true\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: ZMSPR\n" +
+			"                     ├─ outerVisibility: true\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [cld.T4IBQ:5!null as T4IBQ, P4PJZ.M6T2N:0 as M6T2N, P4PJZ.BTXC5:1 as BTXC5, P4PJZ.TUV25:4 as TUV25]\n" +
+			"                             └─ Filter\n" +
+			"                                 ├─ NOT\n" +
+			"                                 │   └─ P4PJZ.M6T2N:0 IS NULL\n" +
+			"                                 └─ HashJoin\n" +
+			"                                     ├─ AND\n" +
+			"                                     │   ├─ Eq\n" +
+			"                                     │   │   ├─ P4PJZ.LWQ6O:3\n" +
+			"                                     │   │   └─ cld.BDNYB:6!null\n" +
+			"                                     │   └─ Eq\n" +
+			"                                     │       ├─ P4PJZ.NTOFG:2!null\n" +
+			"                                     │       └─ cld.M22QN:7!null\n" +
+			"                                     ├─ SubqueryAlias\n" +
+			"                                     │   ├─ name: P4PJZ\n" +
+			"                                     │   ├─ outerVisibility: false\n" +
+			"                                     │   ├─ cacheable: true\n" +
+			"                                     │   └─ Project\n" +
+			"                                     │       ├─ columns: [CASE  WHEN NOT\n" +
+			"                                     │       │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                                     │       │   THEN Subquery\n" +
+			"                                     │       │   ├─ cacheable: false\n" +
+			"                                     │       │   └─ Project\n" +
+			"                                     │       │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                                     │       │       └─ Filter\n" +
+			"                                     │       │           ├─ Eq\n" +
+			"                                     │       │           │   ├─ ei.id:20!null\n" +
+			"                                     │       │           │   └─ MJR3D.QNI57:5\n" +
+			"                                     │       │           └─ SubqueryAlias\n" +
+			"                                     │       │               ├─ name: ei\n" +
+			"                                     │       │               ├─ outerVisibility: true\n" +
+			"                                     │       │               ├─ cacheable: true\n" +
+			"                                     │       │               └─ Project\n" +
+			"                                     │       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                                     │       │                   └─ Window\n" +
+			"                                     │       │                       ├─ NOXN3.id:20!null\n" +
+			"                                     │       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                                     │       │                       └─ Table\n" +
+			"                                     │       │                           ├─ name: NOXN3\n" +
+			"                                     │       │                           └─ columns: [id]\n" +
+			"                                     │       │   WHEN NOT\n" +
+			"                                     │       │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                                     │       │   THEN Subquery\n" +
+			"                                     │       │   ├─ cacheable: false\n" +
+			"                                     │       │   └─ Project\n" +
+			"                                     │       │       ├─ columns: [ei.M6T2N:21!null]\n" +
+			"                                     │       │       └─ Filter\n" +
+			"                                     │       │           ├─ Eq\n" +
+			"                                     │       │           │   ├─ ei.id:20!null\n" +
+			"                                     │       │           │   └─ MJR3D.TDEIU:6\n" +
+			"                                     │       │           └─ SubqueryAlias\n" +
+			"                                     │       │               ├─ name: ei\n" +
+			"                                     │       │               ├─ outerVisibility: true\n" +
+			"                                     │       │               ├─ cacheable: true\n" +
+			"                                     │       │               └─ Project\n" +
+			"                                     │       │                   ├─ columns: [NOXN3.id:20!null, (row_number() over ( order by NOXN3.id ASC):21!null - 1 (tinyint)) as M6T2N]\n" +
+			"                                     │       │                   └─ Window\n" +
+			"                                     │       │                       ├─ NOXN3.id:20!null\n" +
+			"                                     │       │                       ├─ row_number() over ( order by NOXN3.id ASC)\n" +
+			"                                     │       │                       └─ Table\n" +
+			"                                     │       │                           ├─ name: NOXN3\n" +
+			"                                     │       │                           └─ columns: [id]\n" +
+			"                                     │       │   END as M6T2N, aac.BTXC5:8 as BTXC5, aac.id:7!null as NTOFG, sn.id:10 as LWQ6O, MJR3D.TUV25:3 as TUV25]\n" +
+			"                                     │       └─ LeftOuterJoin\n" +
+			"                                     │           ├─ Or\n" +
+			"                                     │           │   ├─ Or\n" +
+			"                                     │           │   │   ├─ Or\n" +
+			"                                     │           │   │   │   ├─ AND\n" +
+			"                                     │           │   │   │   │   ├─ AND\n" +
+			"                                     │           │   │   │   │   │   ├─ NOT\n" +
+			"                                     │           │   │   │   │   │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                                     │           │   │   │   │   │   └─ Eq\n" +
+			"                                     │           │   │   │   │   │       ├─ sn.id:10!null\n" +
+			"                                     │           │   │   │   │   │       └─ MJR3D.QNI57:5\n" +
+			"                                     │           │   │   │   │   └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                                     │           │   │   │   └─ AND\n" +
+			"                                     │           │   │   │       ├─ AND\n" +
+			"                                     │           │   │   │       │   ├─ NOT\n" +
+			"                                     │           │   │   │       │   │   └─ MJR3D.QNI57:5 IS NULL\n" +
+			"                                     │           │   │   │       │   └─ InSubquery\n" +
+			"                                     │           │   │   │       │       ├─ left: sn.id:10!null\n" +
+			"                                     │           │   │   │       │       └─ right: Subquery\n" +
+			"                                     │           │   │   │       │           ├─ cacheable: false\n" +
+			"                                     │           │   │   │       │           └─ Project\n" +
+			"                                     │           │   │   │       │               ├─ columns: [JTEHG.id:20!null]\n" +
+			"                                     │           │   │   │       │               └─ Filter\n" +
+			"                                     │           │   │   │       │                   ├─ Eq\n" +
+			"                                     │           │   │   │       │                   │   ├─ JTEHG.BRQP2:21!null\n" +
+			"                                     │           │   │   │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                                     │           │   │   │       │                   └─ TableAlias(JTEHG)\n" +
+			"                                     │           │   │   │       │                       └─ Table\n" +
+			"                                     │           │   │   │       │                           ├─ name: NOXN3\n" +
+			"                                     │           │   │   │       │                           └─ columns: [id brqp2]\n" +
+			"                                     │           │   │   │       └─ NOT\n" +
+			"                                     │           │   │   │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                                     │           │   │   └─ AND\n" +
+			"                                     │           │   │       ├─ AND\n" +
+			"                                     │           │   │       │   ├─ NOT\n" +
+			"                                     │           │   │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                                     │           │   │       │   └─ InSubquery\n" +
+			"                                     │           │   │       │       ├─ left: sn.id:10!null\n" +
+			"                                     │           │   │       │       └─ right: Subquery\n" +
+			"                                     │           │   │       │           ├─ cacheable: false\n" +
+			"                                     │           │   │       │           └─ Project\n" +
+			"                                     │           │   │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                                     │           │   │       │               └─ Filter\n" +
+			"                                     │           │   │       │                   ├─ Eq\n" +
+			"                                     │           │   │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                                     │           │   │       │                   │   └─ MJR3D.FJDP5:0!null\n" +
+			"                                     │           │   │       │                   └─ TableAlias(XMAFZ)\n" +
+			"                                     │           │   │       │                       └─ Table\n" +
+			"                                     │           │   │       │                           ├─ name: NOXN3\n" +
+			"                                     │           │   │       │                           └─ columns: [id brqp2]\n" +
+			"                                     │           │   │       └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                                     │           │   └─ AND\n" +
+			"                                     │           │       ├─ AND\n" +
+			"                                     │           │       │   ├─ NOT\n" +
+			"                                     │           │       │   │   └─ MJR3D.TDEIU:6 IS NULL\n" +
+			"                                     │           │       │   └─ InSubquery\n" +
+			"                                     │           │       │       ├─ left: sn.id:10!null\n" +
+			"                                     │           │       │       └─ right: Subquery\n" +
+			"                                     │           │       │           ├─ cacheable: false\n" +
+			"                                     │           │       │           └─ Project\n" +
+			"                                     │           │       │               ├─ columns: [XMAFZ.id:20!null]\n" +
+			"                                     │           │       │               └─ Filter\n" +
+			"                                     │           │       │                   ├─ Eq\n" +
+			"                                     │           │       │                   │   ├─ XMAFZ.BRQP2:21!null\n" +
+			"                                     │           │       │                   │   └─ MJR3D.BJUF2:1\n" +
+			"                                     │           │       │                   └─ TableAlias(XMAFZ)\n" +
+			"                                     │           │       │                       └─ Table\n" +
+			"                                     │           │       │                           ├─ name: NOXN3\n" +
+			"                                     │           │       │                           └─ columns: [id brqp2]\n" +
+			"                                     │           │       └─ NOT\n" +
+			"                                     │           │           └─ MJR3D.BJUF2:1 IS NULL\n" +
+			"                                     │           ├─ LookupJoin\n" +
+			"                                     │           │   ├─ Eq\n" +
+			"                                     │           │   │   ├─ aac.id:7!null\n" +
+			"                                     │           │   │   └─ MJR3D.M22QN:2!null\n" +
+			"                                     │           │   ├─ SubqueryAlias\n" +
+			"                                     │           │   │   ├─ name: MJR3D\n" +
+			"                                     │           │   │   ├─ outerVisibility: false\n" +
+			"                                     │           │   │   ├─ cacheable: true\n" +
+			"                                     │           │   │   └─ Distinct\n" +
+			"                                     │           │   │       └─ Project\n" +
+			"                                     │           │   │           ├─ columns: [ism.FV24E:9!null as FJDP5, CPMFE.id:27 as BJUF2, ism.M22QN:11!null as M22QN, G3YXS.TUV25:5 as TUV25, G3YXS.ESFVY:1!null as ESFVY, YQIF4.id:44 as QNI57, YVHJZ.id:54 as TDEIU]\n" +
+			"                                     │           │   │           └─ Filter\n" +
+			"                                     │           │   │               ├─ Or\n" +
+			"                                     │           │   │               │   ├─ NOT\n" +
+			"                                     │           │   │               │   │   └─ YQIF4.id:44 IS NULL\n" +
+			"                                     │           │   │               │   └─ NOT\n" +
+			"                                     │           │   │               │       └─ YVHJZ.id:54 IS NULL\n" +
+			"                                     │           │   │               └─ LeftOuterLookupJoin\n" +
+			"                                     │           │   │                   ├─ AND\n" +
+			"                                     │           │   │                   │   ├─ Eq\n" +
+			"                                     │           │   │                   │   │   ├─ YVHJZ.BRQP2:55!null\n" +
+			"                                     │           │   │                   │   │   └─ ism.UJ6XY:10!null\n" +
+			"                                     │           │   │                   │   └─ Eq\n" +
+			"                                     │           │   │                   │       ├─ YVHJZ.FFTBJ:56!null\n" +
+			"                                     │           │   │                   │       └─ ism.FV24E:9!null\n" +
+			"                                     │           │   │                   ├─ LeftOuterLookupJoin\n" +
+			"                                     │           │   │                   │   ├─ AND\n" +
+			"                                     │           │   │                   │   │   ├─ Eq\n" +
+			"                                     │           │   │                   │   │   │   ├─ YQIF4.BRQP2:45!null\n" +
+			"                                     │           │   │                   │   │   │   └─ ism.FV24E:9!null\n" +
+			"                                     │           │   │                   │   │   └─ Eq\n" +
+			"                                     │           │   │                   │   │       ├─ YQIF4.FFTBJ:46!null\n" +
+			"                                     │           │   │                   │   │       └─ ism.UJ6XY:10!null\n" +
+			"                                     │           │   │                   │   ├─ LeftOuterLookupJoin\n" +
+			"                                     │           │   │                   │   │   ├─ AND\n" +
+			"                                     │           │   │                   │   │   │   ├─ Eq\n" +
+			"                                     │           │   │                   │   │   │   │   ├─ CPMFE.ZH72S:34\n" +
+			"                                     │           │   │                   │   │   │   │   └─ NHMXW.NOHHR:18\n" +
+			"                                     │           │   │                   │   │   │   └─ NOT\n" +
+			"                                     │           │   │                   │   │   │       └─ Eq\n" +
+			"                                     │           │   │                   │   │   │           ├─ CPMFE.id:27!null\n" +
+			"                                     │           │   │                   │   │   │           └─ ism.FV24E:9!null\n" +
+			"                                     │           │   │                   │   │   ├─ LeftOuterLookupJoin\n" +
+			"                                     │           │   │                   │   │   │   ├─ Eq\n" +
+			"                                     │           │   │                   │   │   │   │   ├─ NHMXW.id:17!null\n" +
+			"                                     │           │   │                   │   │   │   │   └─ ism.PRUV2:14\n" +
+			"                                     │           │   │                   │   │   │   ├─ LookupJoin\n" +
+			"                                     │           │   │                   │   │   │   │   ├─ Eq\n" +
+			"                                     │           │   │                   │   │   │   │   │   ├─ G3YXS.id:0!null\n" +
+			"                                     │           │   │                   │   │   │   │   │   └─ ism.NZ4MQ:12!null\n" +
+			"                                     │           │   │                   │   │   │   │   ├─ Filter\n" +
+			"                                     │           │   │                   │   │   │   │   │   ├─ NOT\n" +
+			"                                     │           │   │                   │   │   │   │   │   │   └─ G3YXS.TUV25:5 IS NULL\n" +
+			"                                     │           │   │                   │   │   │   │   │   └─ TableAlias(G3YXS)\n" +
+			"                                     │           │   │                   │   │   │   │   │       └─ Table\n" +
+			"                                     │           │   │                   │   │   │   │   │           ├─ name: YYBCX\n" +
+			"                                     │           │   │                   │   │   │   │   │           └─ columns: [id esfvy sl76b ge5el f7a4q tuv25 ykssu fhcyt]\n" +
+			"                                     │           │   │                   │   │   │   │   └─ TableAlias(ism)\n" +
+			"                                     │           │   │                   │   │   │   │       └─ IndexedTableAccess(HDDVB)\n" +
+			"                                     │           │   │                   │   │   │   │           ├─ index: [HDDVB.NZ4MQ]\n" +
+			"                                     │           │   │                   │   │   │   │           └─ columns: [id fv24e uj6xy m22qn nz4mq etpqv pruv2 ykssu fhcyt]\n" +
+			"                                     │           │   │                   │   │   │   └─ TableAlias(NHMXW)\n" +
+			"                                     │           │   │                   │   │   │       └─ IndexedTableAccess(WGSDC)\n" +
+			"                                     │           │   │                   │   │   │           ├─ index: [WGSDC.id]\n" +
+			"                                     │           │   │                   │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"                                     │           │   │                   │   │   └─ TableAlias(CPMFE)\n" +
+			"                                     │           │   │                   │   │       └─ IndexedTableAccess(E2I7U)\n" +
+			"                                     │           │   │                   │   │           ├─ index: [E2I7U.ZH72S]\n" +
+			"                                     │           │   │                   │   │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                                     │           │   │                   │   └─ TableAlias(YQIF4)\n" +
+			"                                     │           │   │                   │       └─ IndexedTableAccess(NOXN3)\n" +
+			"                                     │           │   │                   │           ├─ index: [NOXN3.BRQP2]\n" +
+			"                                     │           │   │                   │           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                                     │           │   │                   └─ TableAlias(YVHJZ)\n" +
+			"                                     │           │   │                       └─ IndexedTableAccess(NOXN3)\n" +
+			"                                     │           │   │                           ├─ index: [NOXN3.BRQP2]\n" +
+			"                                     │           │   │                           └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                                     │           │   └─ TableAlias(aac)\n" +
+			"                                     │           │       └─ IndexedTableAccess(TPXBU)\n" +
+			"                                     │           │           ├─ index: [TPXBU.id]\n" +
+			"                                     │           │           └─ columns: [id btxc5 fhcyt]\n" +
+			"                                     │           └─ TableAlias(sn)\n" +
+			"                                     │               └─ Table\n" +
+			"                                     │                   ├─ name: NOXN3\n" +
+			"                                     │                   └─ columns: [id brqp2 fftbj a7xo2 kbo7r ecdkm numk2 letoe ykssu fhcyt]\n" +
+			"                                     └─ HashLookup\n" +
+			"                                         ├─ source: TUPLE(P4PJZ.LWQ6O:3, P4PJZ.NTOFG:2!null)\n" +
+			"                                         ├─ target: TUPLE(cld.BDNYB:1!null, cld.M22QN:2!null)\n" +
+			"                                         └─ CachedResults\n" +
+			"                                             └─ SubqueryAlias\n" +
+			"                                                 ├─ name: cld\n" +
+			"                                                 ├─ outerVisibility: false\n" +
+			"                                                 ├─ cacheable: true\n" +
+			"                                                 └─ Project\n" +
+			"                                                     ├─ columns: [cla.FTQLQ:6!null as T4IBQ, sn.id:7!null as BDNYB, mf.M22QN:4!null as M22QN]\n" +
+			"                                                     └─ LookupJoin\n" +
+			"                                                         ├─ Eq\n" +
+			"                                                         │   ├─ sn.BRQP2:8!null\n" +
+			"                                                         │   └─ mf.LUEVY:3!null\n" +
+			"                                                         ├─ LookupJoin\n" +
+			"                                                         │   ├─ Eq\n" +
+			"                                                         │   │   ├─ cla.id:5!null\n" +
+			"                                                         │   │   └─ bs.IXUXU:1\n" +
+			"                                                         │   ├─ LookupJoin\n" +
+			"                                                         │   │   ├─ Eq\n" +
+			"                                                         │   │   │   ├─ bs.id:0!null\n" +
+			"                                                         │   │   │   └─ mf.GXLUB:2!null\n" +
+			"                                                         │   │   ├─ TableAlias(bs)\n" +
+			"                                                         │   │   │   └─ Table\n" +
+			"                                                         │   │   │       ├─ name: THNTS\n" +
+			"                                                         │   │   │       └─ columns: [id ixuxu]\n" +
+			"                                                         │   │   └─ TableAlias(mf)\n" +
+			"                                                         │   │       └─ IndexedTableAccess(HGMQ6)\n" +
+			"                                                         │   │           ├─ index: [HGMQ6.GXLUB]\n" +
+			"                                                         │   │           └─ columns: [gxlub luevy m22qn]\n" +
+			"                                                         │   └─ Filter\n" +
+			"                                                         │       ├─ HashIn\n" +
+			"                                                         │       │   ├─ cla.FTQLQ:1!null\n" +
+			"                                                         │       │   └─ TUPLE(SQ1 (longtext))\n" +
+			"                                                         │       └─ TableAlias(cla)\n" +
+			"                                                         │           └─ IndexedTableAccess(YK2GW)\n" +
+			"                                                         │               ├─ index: [YK2GW.id]\n" +
+			"                                                         │               └─ columns: [id ftqlq]\n" +
+			"                                                         └─ TableAlias(sn)\n" +
+			"                                                             └─ IndexedTableAccess(NOXN3)\n" +
+			"                                                                 ├─ index: [NOXN3.BRQP2]\n" +
+			"                                                                 └─ columns: [id brqp2]\n" +
 			"",
 	},
 	{
@@ -13650,9 +13722,7 @@ ORDER BY id ASC`,
 SELECT COUNT(*) FROM E2I7U`,
 		ExpectedPlan: "Project\n" +
 			" ├─ columns: [COUNT(1):0!null as COUNT(*)]\n" +
-			" └─ GroupBy\n" +
-			"     ├─ select: COUNT(1 (bigint))\n" +
-			"     ├─ group: \n" +
+			" └─ TableCount\n" +
 			"     └─ Table\n" +
 			"         ├─ name: E2I7U\n" +
 			"         └─ columns: []\n" +
@@ -14546,14 +14616,13 @@ DELETE FROM AMYXQ
 WHERE LUEVY IN ('1', '2', '3')`,
 		ExpectedPlan: "RowUpdateAccumulator\n" +
 			" └─ Delete\n" +
-			"     └─ Filter\n" +
-			"         ├─ HashIn\n" +
-			"         │   ├─ AMYXQ.LUEVY:2!null\n" +
-			"         │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"         └─ IndexedTableAccess(AMYXQ)\n" +
-			"             ├─ index: [AMYXQ.LUEVY]\n" +
-			"             ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
-			"             └─ columns: [id gxlub luevy xqdyt amyxq oztqf z35gy kkgn5]\n" +
+			"     └─ IndexedTableAccess(AMYXQ)\n" +
+			"         ├─ index: [AMYXQ.LUEVY]\n" +
+			"         ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
+			"         ├─ columns: [id gxlub luevy xqdyt amyxq oztqf z35gy kkgn5]\n" +
+			"         └─ index condition: IN\n" +
+			"             ├─ left: AMYXQ.LUEVY:2!null\n" +
+			"             └─ right: TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
 			"",
 	},
 	{
@@ -14594,14 +14663,13 @@ DELETE FROM FLQLP
 WHERE LUEVY IN ('1', '2', '3')`,
 		ExpectedPlan: "RowUpdateAccumulator\n" +
 			" └─ Delete\n" +
-			"     └─ Filter\n" +
-			"         ├─ HashIn\n" +
-			"         │   ├─ FLQLP.LUEVY:2!null\n" +
-			"         │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"         └─ IndexedTableAccess(FLQLP)\n" +
-			"             ├─ index: [FLQLP.LUEVY]\n" +
-			"             ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
-			"             └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"     └─ IndexedTableAccess(FLQLP)\n" +
+			"         ├─ index: [FLQLP.LUEVY]\n" +
+			"         ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
+			"         ├─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
+			"         └─ index condition: IN\n" +
+			"             ├─ left: FLQLP.LUEVY:2!null\n" +
+			"             └─ right: TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
 			"",
 	},
 	{
@@ -14666,15 +14734,14 @@ WHERE nd.FGG57 IS NOT NULL AND nd.KNG7T IS NULL`,
 			"                             └─ columns: [fgg57 sshpj]\n" +
 			"        )\n" +
 			"         └─ Filter\n" +
-			"             ├─ AND\n" +
-			"             │   ├─ NOT\n" +
-			"             │   │   └─ nd.FGG57:6 IS NULL\n" +
-			"             │   └─ nd.KNG7T:2 IS NULL\n" +
+			"             ├─ nd.KNG7T:2 IS NULL\n" +
 			"             └─ TableAlias(nd)\n" +
 			"                 └─ IndexedTableAccess(E2I7U)\n" +
 			"                     ├─ index: [E2I7U.FGG57]\n" +
 			"                     ├─ static: [{(NULL, ∞)}]\n" +
-			"                     └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                     ├─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                     └─ index condition: NOT\n" +
+			"                         └─ E2I7U.FGG57:6 IS NULL\n" +
 			"",
 	},
 	{
@@ -14684,7 +14751,7 @@ UPDATE S3FQX SET ADWYM = 0, FPUYA = 0`,
 		ExpectedPlan: "TriggerRollback\n" +
 			" └─ RowUpdateAccumulator\n" +
 			"     └─ Update\n" +
-			"         └─ Trigger(CREATE TRIGGER S3FQX_on_update BEFORE UPDATE ON S3FQX\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER S3FQX_on_update BEFORE UPDATE ON S3FQX\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF NEW.ADWYM NOT IN (0, 1)\n" +
@@ -14744,7 +14811,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: THNTS\n" +
 			"         │       └─ columns: [id nfryn ixuxu fhcyt]\n" +
-			"         └─ Trigger(CREATE TRIGGER THNTS_on_insert BEFORE INSERT ON THNTS\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER THNTS_on_insert BEFORE INSERT ON THNTS\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -14760,14 +14827,13 @@ WHERE
 			"             │       │   ├─ cacheable: true\n" +
 			"             │       │   └─ Project\n" +
 			"             │       │       ├─ columns: [JMRQL.id:30!null]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ Eq\n" +
-			"             │       │           │   ├─ JMRQL.DZLIM:31!null\n" +
-			"             │       │           │   └─ T4IBQ (longtext)\n" +
-			"             │       │           └─ IndexedTableAccess(JMRQL)\n" +
-			"             │       │               ├─ index: [JMRQL.DZLIM]\n" +
-			"             │       │               ├─ static: [{[T4IBQ, T4IBQ]}]\n" +
-			"             │       │               └─ columns: [id dzlim]\n" +
+			"             │       │       └─ IndexedTableAccess(JMRQL)\n" +
+			"             │       │           ├─ index: [JMRQL.DZLIM]\n" +
+			"             │       │           ├─ static: [{[T4IBQ, T4IBQ]}]\n" +
+			"             │       │           ├─ columns: [id dzlim]\n" +
+			"             │       │           └─ index condition: Eq\n" +
+			"             │       │               ├─ JMRQL.DZLIM:1!null\n" +
+			"             │       │               └─ T4IBQ (longtext)\n" +
 			"             │       │   as NFRYN, YK2GW.id:0!null as IXUXU, NULL (null) as FHCYT]\n" +
 			"             │       └─ Filter\n" +
 			"             │           ├─ HashIn\n" +
@@ -14825,7 +14891,7 @@ FROM
 			"         │   └─ Table\n" +
 			"         │       ├─ name: QYWQD\n" +
 			"         │       └─ columns: [id wnunu hhvlx hvhrz ykssu fhcyt]\n" +
-			"         └─ Trigger(CREATE TRIGGER QYWQD_on_insert BEFORE INSERT ON QYWQD\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER QYWQD_on_insert BEFORE INSERT ON QYWQD\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -14935,7 +15001,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: WE72E\n" +
 			"         │       └─ columns: [id qz7e7 sshpj fhcyt]\n" +
-			"         └─ Trigger(CREATE TRIGGER WE72E_on_insert BEFORE INSERT ON WE72E\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER WE72E_on_insert BEFORE INSERT ON WE72E\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -15031,7 +15097,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: AMYXQ\n" +
 			"         │       └─ columns: [id gxlub luevy xqdyt amyxq oztqf z35gy kkgn5]\n" +
-			"         └─ Trigger(CREATE TRIGGER AMYXQ_on_insert BEFORE INSERT ON AMYXQ\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER AMYXQ_on_insert BEFORE INSERT ON AMYXQ\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -15179,7 +15245,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: SZQWJ\n" +
 			"         │       └─ columns: [id gxlub ch3fr d237e jogi6]\n" +
-			"         └─ Trigger(CREATE TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -15226,14 +15292,13 @@ WHERE
 			"             │       │   ├─ cacheable: true\n" +
 			"             │       │   └─ Project\n" +
 			"             │       │       ├─ columns: [XOAOP.id:7!null]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ Eq\n" +
-			"             │       │           │   ├─ XOAOP.DZLIM:8!null\n" +
-			"             │       │           │   └─ NER (longtext)\n" +
-			"             │       │           └─ IndexedTableAccess(XOAOP)\n" +
-			"             │       │               ├─ index: [XOAOP.DZLIM]\n" +
-			"             │       │               ├─ static: [{[NER, NER]}]\n" +
-			"             │       │               └─ columns: [id dzlim]\n" +
+			"             │       │       └─ IndexedTableAccess(XOAOP)\n" +
+			"             │       │           ├─ index: [XOAOP.DZLIM]\n" +
+			"             │       │           ├─ static: [{[NER, NER]}]\n" +
+			"             │       │           ├─ columns: [id dzlim]\n" +
+			"             │       │           └─ index condition: Eq\n" +
+			"             │       │               ├─ XOAOP.DZLIM:1!null\n" +
+			"             │       │               └─ NER (longtext)\n" +
 			"             │       │   as CH3FR, CASE  WHEN GreaterThan\n" +
 			"             │       │   ├─ ums.ner:2\n" +
 			"             │       │   └─ 0.500000 (double)\n" +
@@ -15304,7 +15369,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: SZQWJ\n" +
 			"         │       └─ columns: [id gxlub ch3fr d237e jogi6]\n" +
-			"         └─ Trigger(CREATE TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -15351,14 +15416,13 @@ WHERE
 			"             │       │   ├─ cacheable: true\n" +
 			"             │       │   └─ Project\n" +
 			"             │       │       ├─ columns: [XOAOP.id:7!null]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ Eq\n" +
-			"             │       │           │   ├─ XOAOP.DZLIM:8!null\n" +
-			"             │       │           │   └─ BER (longtext)\n" +
-			"             │       │           └─ IndexedTableAccess(XOAOP)\n" +
-			"             │       │               ├─ index: [XOAOP.DZLIM]\n" +
-			"             │       │               ├─ static: [{[BER, BER]}]\n" +
-			"             │       │               └─ columns: [id dzlim]\n" +
+			"             │       │       └─ IndexedTableAccess(XOAOP)\n" +
+			"             │       │           ├─ index: [XOAOP.DZLIM]\n" +
+			"             │       │           ├─ static: [{[BER, BER]}]\n" +
+			"             │       │           ├─ columns: [id dzlim]\n" +
+			"             │       │           └─ index condition: Eq\n" +
+			"             │       │               ├─ XOAOP.DZLIM:1!null\n" +
+			"             │       │               └─ BER (longtext)\n" +
 			"             │       │   as CH3FR, CASE  WHEN GreaterThan\n" +
 			"             │       │   ├─ ums.ber:3\n" +
 			"             │       │   └─ 0.500000 (double)\n" +
@@ -15429,7 +15493,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: SZQWJ\n" +
 			"         │       └─ columns: [id gxlub ch3fr d237e jogi6]\n" +
-			"         └─ Trigger(CREATE TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -15476,14 +15540,13 @@ WHERE
 			"             │       │   ├─ cacheable: true\n" +
 			"             │       │   └─ Project\n" +
 			"             │       │       ├─ columns: [XOAOP.id:7!null]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ Eq\n" +
-			"             │       │           │   ├─ XOAOP.DZLIM:8!null\n" +
-			"             │       │           │   └─ HR (longtext)\n" +
-			"             │       │           └─ IndexedTableAccess(XOAOP)\n" +
-			"             │       │               ├─ index: [XOAOP.DZLIM]\n" +
-			"             │       │               ├─ static: [{[HR, HR]}]\n" +
-			"             │       │               └─ columns: [id dzlim]\n" +
+			"             │       │       └─ IndexedTableAccess(XOAOP)\n" +
+			"             │       │           ├─ index: [XOAOP.DZLIM]\n" +
+			"             │       │           ├─ static: [{[HR, HR]}]\n" +
+			"             │       │           ├─ columns: [id dzlim]\n" +
+			"             │       │           └─ index condition: Eq\n" +
+			"             │       │               ├─ XOAOP.DZLIM:1!null\n" +
+			"             │       │               └─ HR (longtext)\n" +
 			"             │       │   as CH3FR, CASE  WHEN GreaterThan\n" +
 			"             │       │   ├─ ums.hr:4\n" +
 			"             │       │   └─ 0.500000 (double)\n" +
@@ -15554,7 +15617,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: SZQWJ\n" +
 			"         │       └─ columns: [id gxlub ch3fr d237e jogi6]\n" +
-			"         └─ Trigger(CREATE TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER SZQWJ_on_insert BEFORE INSERT ON SZQWJ\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -15601,14 +15664,13 @@ WHERE
 			"             │       │   ├─ cacheable: true\n" +
 			"             │       │   └─ Project\n" +
 			"             │       │       ├─ columns: [XOAOP.id:7!null]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ Eq\n" +
-			"             │       │           │   ├─ XOAOP.DZLIM:8!null\n" +
-			"             │       │           │   └─ MMR (longtext)\n" +
-			"             │       │           └─ IndexedTableAccess(XOAOP)\n" +
-			"             │       │               ├─ index: [XOAOP.DZLIM]\n" +
-			"             │       │               ├─ static: [{[MMR, MMR]}]\n" +
-			"             │       │               └─ columns: [id dzlim]\n" +
+			"             │       │       └─ IndexedTableAccess(XOAOP)\n" +
+			"             │       │           ├─ index: [XOAOP.DZLIM]\n" +
+			"             │       │           ├─ static: [{[MMR, MMR]}]\n" +
+			"             │       │           ├─ columns: [id dzlim]\n" +
+			"             │       │           └─ index condition: Eq\n" +
+			"             │       │               ├─ XOAOP.DZLIM:1!null\n" +
+			"             │       │               └─ MMR (longtext)\n" +
 			"             │       │   as CH3FR, CASE  WHEN GreaterThan\n" +
 			"             │       │   ├─ ums.mmr:5\n" +
 			"             │       │   └─ 0.500000 (double)\n" +
@@ -15684,7 +15746,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: TPXBU\n" +
 			"         │       └─ columns: [id btxc5 fhcyt]\n" +
-			"         └─ Trigger(CREATE TRIGGER TPXBU_on_insert BEFORE INSERT ON TPXBU\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER TPXBU_on_insert BEFORE INSERT ON TPXBU\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -15706,36 +15768,36 @@ WHERE
 			"             │           └─ Distinct\n" +
 			"             │               └─ Project\n" +
 			"             │                   ├─ columns: [umf.SYPKF:8 as BTXC5]\n" +
-			"             │                   └─ Filter\n" +
-			"             │                       ├─ AND\n" +
+			"             │                   └─ AntiHashJoin\n" +
+			"             │                       ├─ NOT\n" +
+			"             │                       │   └─ (umf.SYPKF = TPXBU.BTXC5) IS FALSE\n" +
+			"             │                       ├─ Filter\n" +
 			"             │                       │   ├─ AND\n" +
 			"             │                       │   │   ├─ AND\n" +
 			"             │                       │   │   │   ├─ NOT\n" +
-			"             │                       │   │   │   │   └─ InSubquery\n" +
-			"             │                       │   │   │   │       ├─ left: umf.SYPKF:8\n" +
-			"             │                       │   │   │   │       └─ right: Subquery\n" +
-			"             │                       │   │   │   │           ├─ cacheable: true\n" +
-			"             │                       │   │   │   │           └─ Filter\n" +
-			"             │                       │   │   │   │               ├─ NOT\n" +
-			"             │                       │   │   │   │               │   └─ TPXBU.BTXC5:25 IS NULL\n" +
-			"             │                       │   │   │   │               └─ IndexedTableAccess(TPXBU)\n" +
-			"             │                       │   │   │   │                   ├─ index: [TPXBU.BTXC5]\n" +
-			"             │                       │   │   │   │                   ├─ static: [{(NULL, ∞)}]\n" +
-			"             │                       │   │   │   │                   └─ columns: [btxc5]\n" +
+			"             │                       │   │   │   │   └─ umf.SYPKF:8 IS NULL\n" +
 			"             │                       │   │   │   └─ NOT\n" +
-			"             │                       │   │   │       └─ umf.SYPKF:8 IS NULL\n" +
-			"             │                       │   │   └─ NOT\n" +
-			"             │                       │   │       └─ Eq\n" +
-			"             │                       │   │           ├─ umf.SYPKF:8\n" +
-			"             │                       │   │           └─ N/A (longtext)\n" +
-			"             │                       │   └─ HashIn\n" +
-			"             │                       │       ├─ umf.id:0!null\n" +
-			"             │                       │       └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"             │                       └─ TableAlias(umf)\n" +
-			"             │                           └─ IndexedTableAccess(NZKPM)\n" +
-			"             │                               ├─ index: [NZKPM.id]\n" +
-			"             │                               ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
-			"             │                               └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
+			"             │                       │   │   │       └─ Eq\n" +
+			"             │                       │   │   │           ├─ umf.SYPKF:8\n" +
+			"             │                       │   │   │           └─ N/A (longtext)\n" +
+			"             │                       │   │   └─ HashIn\n" +
+			"             │                       │   │       ├─ umf.id:0!null\n" +
+			"             │                       │   │       └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
+			"             │                       │   └─ TableAlias(umf)\n" +
+			"             │                       │       └─ Table\n" +
+			"             │                       │           ├─ name: NZKPM\n" +
+			"             │                       │           └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
+			"             │                       └─ HashLookup\n" +
+			"             │                           ├─ source: TUPLE(umf.SYPKF:8)\n" +
+			"             │                           ├─ target: TUPLE(TPXBU.BTXC5:0)\n" +
+			"             │                           ├─ null-aware: true\n" +
+			"             │                           └─ CachedResults\n" +
+			"             │                               └─ Filter\n" +
+			"             │                                   ├─ NOT\n" +
+			"             │                                   │   └─ TPXBU.BTXC5:0 IS NULL\n" +
+			"             │                                   └─ Table\n" +
+			"             │                                       ├─ name: TPXBU\n" +
+			"             │                                       └─ columns: [btxc5]\n" +
 			"             └─ BEGIN .. END\n" +
 			"                 └─ IF BLOCK\n" +
 			"                     └─ IF(InSubquery\n" +
@@ -15826,7 +15888,7 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"         │   └─ Table\n" +
 			"         │       ├─ name: HGMQ6\n" +
 			"         │       └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
-			"         └─ Trigger(CREATE TRIGGER HGMQ6_on_insert BEFORE INSERT ON HGMQ6\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER HGMQ6_on_insert BEFORE INSERT ON HGMQ6\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -15886,17 +15948,16 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"             │       │   └─ Project\n" +
 			"             │       │       ├─ columns: [nd_for_id.id:67!null]\n" +
 			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ AND\n" +
-			"             │       │           │   ├─ NOT\n" +
-			"             │       │           │   │   └─ nd_for_id.FGG57:68 IS NULL\n" +
-			"             │       │           │   └─ Eq\n" +
-			"             │       │           │       ├─ nd_for_id.FGG57:68\n" +
-			"             │       │           │       └─ umf.FGG57:2\n" +
+			"             │       │           ├─ Eq\n" +
+			"             │       │           │   ├─ nd_for_id.FGG57:68\n" +
+			"             │       │           │   └─ umf.FGG57:2\n" +
 			"             │       │           └─ TableAlias(nd_for_id)\n" +
 			"             │       │               └─ IndexedTableAccess(E2I7U)\n" +
 			"             │       │                   ├─ index: [E2I7U.FGG57]\n" +
 			"             │       │                   ├─ static: [{(NULL, ∞)}]\n" +
-			"             │       │                   └─ columns: [id fgg57]\n" +
+			"             │       │                   ├─ columns: [id fgg57]\n" +
+			"             │       │                   └─ index condition: NOT\n" +
+			"             │       │                       └─ E2I7U.FGG57:6 IS NULL\n" +
 			"             │       │   END as LUEVY, CASE  WHEN Eq\n" +
 			"             │       │   ├─ umf.SYPKF:8\n" +
 			"             │       │   └─ N/A (longtext)\n" +
@@ -15904,12 +15965,11 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"             │       │   ├─ cacheable: true\n" +
 			"             │       │   └─ Project\n" +
 			"             │       │       ├─ columns: [TPXBU.id:67!null]\n" +
-			"             │       │       └─ Filter\n" +
-			"             │       │           ├─ TPXBU.BTXC5:68 IS NULL\n" +
-			"             │       │           └─ IndexedTableAccess(TPXBU)\n" +
-			"             │       │               ├─ index: [TPXBU.BTXC5]\n" +
-			"             │       │               ├─ static: [{[NULL, NULL]}]\n" +
-			"             │       │               └─ columns: [id btxc5]\n" +
+			"             │       │       └─ IndexedTableAccess(TPXBU)\n" +
+			"             │       │           ├─ index: [TPXBU.BTXC5]\n" +
+			"             │       │           ├─ static: [{[NULL, NULL]}]\n" +
+			"             │       │           ├─ columns: [id btxc5]\n" +
+			"             │       │           └─ index condition: TPXBU.BTXC5:1 IS NULL\n" +
 			"             │       │   ELSE Subquery\n" +
 			"             │       │   ├─ cacheable: false\n" +
 			"             │       │   └─ Project\n" +
@@ -15997,16 +16057,15 @@ INNER JOIN THNTS bs ON cla.id = bs.IXUXU`,
 			"             │           │   │   │       │       ├─ left: NZKPM.FGG57:2\n" +
 			"             │           │   │   │       │       └─ right: Subquery\n" +
 			"             │           │   │   │       │           ├─ cacheable: true\n" +
-			"             │           │   │   │       │           └─ Filter\n" +
-			"             │           │   │   │       │               ├─ NOT\n" +
-			"             │           │   │   │       │               │   └─ E2I7U.FGG57:25 IS NULL\n" +
-			"             │           │   │   │       │               └─ IndexedTableAccess(E2I7U)\n" +
-			"             │           │   │   │       │                   ├─ index: [E2I7U.FGG57]\n" +
-			"             │           │   │   │       │                   ├─ static: [{(NULL, ∞)}]\n" +
-			"             │           │   │   │       │                   └─ columns: [fgg57]\n" +
+			"             │           │   │   │       │           └─ IndexedTableAccess(E2I7U)\n" +
+			"             │           │   │   │       │               ├─ index: [E2I7U.FGG57]\n" +
+			"             │           │   │   │       │               ├─ static: [{(NULL, ∞)}]\n" +
+			"             │           │   │   │       │               ├─ columns: [fgg57]\n" +
+			"             │           │   │   │       │               └─ index condition: NOT\n" +
+			"             │           │   │   │       │                   └─ E2I7U.FGG57:6 IS NULL\n" +
 			"             │           │   │   │       └─ IndexedTableAccess(NZKPM)\n" +
 			"             │           │   │   │           ├─ index: [NZKPM.id]\n" +
-			"             │           │   │   │           ├─ static: [{[2, 2]}, {[3, 3]}, {[1, 1]}]\n" +
+			"             │           │   │   │           ├─ static: [{[1, 1]}, {[2, 2]}, {[3, 3]}]\n" +
 			"             │           │   │   │           └─ columns: [id t4ibq fgg57 sshpj nla6o sfj6l tjpt7 arn5p sypkf ivfmk ide43 az6sp fsdy2 xosd4 hmw4h s76om vaf zroh6 qcgts lnfm6 tvawl hdlcl bhhw6 fhcyt qz6vt]\n" +
 			"             │           │   │   └─ TableAlias(TJ5D2)\n" +
 			"             │           │   │       └─ Table\n" +
@@ -16271,233 +16330,218 @@ FROM
 			"         ├─ columns: [id:0!null, FV24E:1!null, UJ6XY:2!null, M22QN:3!null, NZ4MQ:4!null, ETPQV:5, PRUV2:6, YKSSU:7, FHCYT:8]\n" +
 			"         └─ Union distinct\n" +
 			"             ├─ Project\n" +
-			"             │   ├─ columns: [id:0!null, convert\n" +
+			"             │   ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, convert\n" +
 			"             │   │   ├─ type: char\n" +
-			"             │   │   └─ FV24E:1!null\n" +
+			"             │   │   └─ BPNW2.FV24E:1!null\n" +
 			"             │   │   as FV24E, convert\n" +
 			"             │   │   ├─ type: char\n" +
-			"             │   │   └─ UJ6XY:2!null\n" +
-			"             │   │   as UJ6XY, M22QN:3!null, NZ4MQ:4, ETPQV:5!null, convert\n" +
-			"             │   │   ├─ type: char\n" +
-			"             │   │   └─ PRUV2:6\n" +
-			"             │   │   as PRUV2, YKSSU:7, FHCYT:8]\n" +
-			"             │   └─ Project\n" +
-			"             │       ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, BPNW2.FV24E:1!null as FV24E, BPNW2.UJ6XY:2!null as UJ6XY, BPNW2.M22QN:3!null as M22QN, BPNW2.NZ4MQ:4 as NZ4MQ, BPNW2.MU3KG:0!null as ETPQV, NULL (null) as PRUV2, BPNW2.YKSSU:6 as YKSSU, BPNW2.FHCYT:5 as FHCYT]\n" +
-			"             │       └─ SubqueryAlias\n" +
-			"             │           ├─ name: BPNW2\n" +
-			"             │           ├─ outerVisibility: false\n" +
-			"             │           ├─ cacheable: true\n" +
-			"             │           └─ Distinct\n" +
-			"             │               └─ Project\n" +
-			"             │                   ├─ columns: [TIZHK.id:37!null as MU3KG, J4JYP.id:0!null as FV24E, RHUZN.id:47!null as UJ6XY, aac.id:34!null as M22QN, Subquery\n" +
-			"             │                   │   ├─ cacheable: false\n" +
-			"             │                   │   └─ Project\n" +
-			"             │                   │       ├─ columns: [G3YXS.id:74!null]\n" +
-			"             │                   │       └─ Filter\n" +
-			"             │                   │           ├─ Eq\n" +
-			"             │                   │           │   ├─ concat(G3YXS.ESFVY:75!null,(MI: (longtext),G3YXS.SL76B:76!null,) (longtext))\n" +
-			"             │                   │           │   └─ TIZHK.IDUT2:41\n" +
-			"             │                   │           └─ TableAlias(G3YXS)\n" +
-			"             │                   │               └─ Table\n" +
-			"             │                   │                   ├─ name: YYBCX\n" +
-			"             │                   │                   └─ columns: [id esfvy sl76b]\n" +
-			"             │                   │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU]\n" +
-			"             │                   └─ Filter\n" +
+			"             │   │   └─ BPNW2.UJ6XY:2!null\n" +
+			"             │   │   as UJ6XY, BPNW2.M22QN:3!null as M22QN, BPNW2.NZ4MQ:4 as NZ4MQ, BPNW2.MU3KG:0!null as ETPQV, NULL (longtext) as PRUV2, BPNW2.YKSSU:6 as YKSSU, BPNW2.FHCYT:5 as FHCYT]\n" +
+			"             │   └─ SubqueryAlias\n" +
+			"             │       ├─ name: BPNW2\n" +
+			"             │       ├─ outerVisibility: false\n" +
+			"             │       ├─ cacheable: true\n" +
+			"             │       └─ Distinct\n" +
+			"             │           └─ Project\n" +
+			"             │               ├─ columns: [TIZHK.id:37!null as MU3KG, J4JYP.id:0!null as FV24E, RHUZN.id:47!null as UJ6XY, aac.id:34!null as M22QN, Subquery\n" +
+			"             │               │   ├─ cacheable: false\n" +
+			"             │               │   └─ Project\n" +
+			"             │               │       ├─ columns: [G3YXS.id:74!null]\n" +
+			"             │               │       └─ Filter\n" +
+			"             │               │           ├─ Eq\n" +
+			"             │               │           │   ├─ concat(G3YXS.ESFVY:75!null,(MI: (longtext),G3YXS.SL76B:76!null,) (longtext))\n" +
+			"             │               │           │   └─ TIZHK.IDUT2:41\n" +
+			"             │               │           └─ TableAlias(G3YXS)\n" +
+			"             │               │               └─ Table\n" +
+			"             │               │                   ├─ name: YYBCX\n" +
+			"             │               │                   └─ columns: [id esfvy sl76b]\n" +
+			"             │               │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU]\n" +
+			"             │               └─ Filter\n" +
+			"             │                   ├─ AND\n" +
+			"             │                   │   ├─ Eq\n" +
+			"             │                   │   │   ├─ aac.BTXC5:35\n" +
+			"             │                   │   │   └─ TIZHK.SYPKF:40\n" +
+			"             │                   │   └─ NHMXW.id:64 IS NULL\n" +
+			"             │                   └─ LeftOuterLookupJoin\n" +
 			"             │                       ├─ AND\n" +
+			"             │                       │   ├─ AND\n" +
+			"             │                       │   │   ├─ AND\n" +
+			"             │                       │   │   │   ├─ AND\n" +
+			"             │                       │   │   │   │   ├─ Eq\n" +
+			"             │                       │   │   │   │   │   ├─ NHMXW.SWCQV:71!null\n" +
+			"             │                       │   │   │   │   │   └─ 0 (tinyint)\n" +
+			"             │                       │   │   │   │   └─ Eq\n" +
+			"             │                       │   │   │   │       ├─ NHMXW.NOHHR:65!null\n" +
+			"             │                       │   │   │   │       └─ TIZHK.TVNW2:38\n" +
+			"             │                       │   │   │   └─ Eq\n" +
+			"             │                       │   │   │       ├─ NHMXW.AVPYF:66!null\n" +
+			"             │                       │   │   │       └─ TIZHK.ZHITY:39\n" +
+			"             │                       │   │   └─ Eq\n" +
+			"             │                       │   │       ├─ NHMXW.SYPKF:67!null\n" +
+			"             │                       │   │       └─ TIZHK.SYPKF:40\n" +
+			"             │                       │   └─ Eq\n" +
+			"             │                       │       ├─ NHMXW.IDUT2:68!null\n" +
+			"             │                       │       └─ TIZHK.IDUT2:41\n" +
+			"             │                       ├─ LookupJoin\n" +
 			"             │                       │   ├─ Eq\n" +
-			"             │                       │   │   ├─ aac.BTXC5:35\n" +
-			"             │                       │   │   └─ TIZHK.SYPKF:40\n" +
-			"             │                       │   └─ NHMXW.id:64 IS NULL\n" +
-			"             │                       └─ LeftOuterLookupJoin\n" +
-			"             │                           ├─ AND\n" +
-			"             │                           │   ├─ AND\n" +
-			"             │                           │   │   ├─ AND\n" +
-			"             │                           │   │   │   ├─ AND\n" +
-			"             │                           │   │   │   │   ├─ Eq\n" +
-			"             │                           │   │   │   │   │   ├─ NHMXW.SWCQV:71!null\n" +
-			"             │                           │   │   │   │   │   └─ 0 (tinyint)\n" +
-			"             │                           │   │   │   │   └─ Eq\n" +
-			"             │                           │   │   │   │       ├─ NHMXW.NOHHR:65!null\n" +
-			"             │                           │   │   │   │       └─ TIZHK.TVNW2:38\n" +
-			"             │                           │   │   │   └─ Eq\n" +
-			"             │                           │   │   │       ├─ NHMXW.AVPYF:66!null\n" +
-			"             │                           │   │   │       └─ TIZHK.ZHITY:39\n" +
-			"             │                           │   │   └─ Eq\n" +
-			"             │                           │   │       ├─ NHMXW.SYPKF:67!null\n" +
-			"             │                           │   │       └─ TIZHK.SYPKF:40\n" +
-			"             │                           │   └─ Eq\n" +
-			"             │                           │       ├─ NHMXW.IDUT2:68!null\n" +
-			"             │                           │       └─ TIZHK.IDUT2:41\n" +
-			"             │                           ├─ LookupJoin\n" +
-			"             │                           │   ├─ Eq\n" +
-			"             │                           │   │   ├─ RHUZN.ZH72S:54\n" +
-			"             │                           │   │   └─ TIZHK.ZHITY:39\n" +
-			"             │                           │   ├─ LookupJoin\n" +
-			"             │                           │   │   ├─ Eq\n" +
-			"             │                           │   │   │   ├─ J4JYP.ZH72S:7\n" +
-			"             │                           │   │   │   └─ TIZHK.TVNW2:38\n" +
-			"             │                           │   │   ├─ LookupJoin\n" +
-			"             │                           │   │   │   ├─ Eq\n" +
-			"             │                           │   │   │   │   ├─ aac.id:34!null\n" +
-			"             │                           │   │   │   │   └─ mf.M22QN:20!null\n" +
-			"             │                           │   │   │   ├─ LookupJoin\n" +
-			"             │                           │   │   │   │   ├─ Eq\n" +
-			"             │                           │   │   │   │   │   ├─ mf.LUEVY:19!null\n" +
-			"             │                           │   │   │   │   │   └─ J4JYP.id:0!null\n" +
-			"             │                           │   │   │   │   ├─ TableAlias(J4JYP)\n" +
-			"             │                           │   │   │   │   │   └─ Table\n" +
-			"             │                           │   │   │   │   │       ├─ name: E2I7U\n" +
-			"             │                           │   │   │   │   │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"             │                           │   │   │   │   └─ TableAlias(mf)\n" +
-			"             │                           │   │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
-			"             │                           │   │   │   │           ├─ index: [HGMQ6.LUEVY]\n" +
-			"             │                           │   │   │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
-			"             │                           │   │   │   └─ TableAlias(aac)\n" +
-			"             │                           │   │   │       └─ IndexedTableAccess(TPXBU)\n" +
-			"             │                           │   │   │           ├─ index: [TPXBU.id]\n" +
-			"             │                           │   │   │           └─ columns: [id btxc5 fhcyt]\n" +
-			"             │                           │   │   └─ Filter\n" +
-			"             │                           │   │       ├─ HashIn\n" +
-			"             │                           │   │       │   ├─ TIZHK.id:0!null\n" +
-			"             │                           │   │       │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"             │                           │   │       └─ TableAlias(TIZHK)\n" +
-			"             │                           │   │           └─ IndexedTableAccess(WRZVO)\n" +
-			"             │                           │   │               ├─ index: [WRZVO.TVNW2]\n" +
-			"             │                           │   │               └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			"             │                           │   └─ TableAlias(RHUZN)\n" +
-			"             │                           │       └─ IndexedTableAccess(E2I7U)\n" +
-			"             │                           │           ├─ index: [E2I7U.ZH72S]\n" +
-			"             │                           │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"             │                           └─ TableAlias(NHMXW)\n" +
-			"             │                               └─ IndexedTableAccess(WGSDC)\n" +
-			"             │                                   ├─ index: [WGSDC.AVPYF]\n" +
-			"             │                                   └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"             │                       │   │   ├─ RHUZN.ZH72S:54\n" +
+			"             │                       │   │   └─ TIZHK.ZHITY:39\n" +
+			"             │                       │   ├─ LookupJoin\n" +
+			"             │                       │   │   ├─ Eq\n" +
+			"             │                       │   │   │   ├─ J4JYP.ZH72S:7\n" +
+			"             │                       │   │   │   └─ TIZHK.TVNW2:38\n" +
+			"             │                       │   │   ├─ LookupJoin\n" +
+			"             │                       │   │   │   ├─ Eq\n" +
+			"             │                       │   │   │   │   ├─ aac.id:34!null\n" +
+			"             │                       │   │   │   │   └─ mf.M22QN:20!null\n" +
+			"             │                       │   │   │   ├─ LookupJoin\n" +
+			"             │                       │   │   │   │   ├─ Eq\n" +
+			"             │                       │   │   │   │   │   ├─ mf.LUEVY:19!null\n" +
+			"             │                       │   │   │   │   │   └─ J4JYP.id:0!null\n" +
+			"             │                       │   │   │   │   ├─ TableAlias(J4JYP)\n" +
+			"             │                       │   │   │   │   │   └─ Table\n" +
+			"             │                       │   │   │   │   │       ├─ name: E2I7U\n" +
+			"             │                       │   │   │   │   │       └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"             │                       │   │   │   │   └─ TableAlias(mf)\n" +
+			"             │                       │   │   │   │       └─ IndexedTableAccess(HGMQ6)\n" +
+			"             │                       │   │   │   │           ├─ index: [HGMQ6.LUEVY]\n" +
+			"             │                       │   │   │   │           └─ columns: [id gxlub luevy m22qn tjpt7 arn5p xosd4 ide43 hmw4h zbt6r fsdy2 lt7k6 sppyd qcgts teuja qqv4m fhcyt]\n" +
+			"             │                       │   │   │   └─ TableAlias(aac)\n" +
+			"             │                       │   │   │       └─ IndexedTableAccess(TPXBU)\n" +
+			"             │                       │   │   │           ├─ index: [TPXBU.id]\n" +
+			"             │                       │   │   │           └─ columns: [id btxc5 fhcyt]\n" +
+			"             │                       │   │   └─ Filter\n" +
+			"             │                       │   │       ├─ HashIn\n" +
+			"             │                       │   │       │   ├─ TIZHK.id:0!null\n" +
+			"             │                       │   │       │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
+			"             │                       │   │       └─ TableAlias(TIZHK)\n" +
+			"             │                       │   │           └─ IndexedTableAccess(WRZVO)\n" +
+			"             │                       │   │               ├─ index: [WRZVO.TVNW2]\n" +
+			"             │                       │   │               └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
+			"             │                       │   └─ TableAlias(RHUZN)\n" +
+			"             │                       │       └─ IndexedTableAccess(E2I7U)\n" +
+			"             │                       │           ├─ index: [E2I7U.ZH72S]\n" +
+			"             │                       │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"             │                       └─ TableAlias(NHMXW)\n" +
+			"             │                           └─ IndexedTableAccess(WGSDC)\n" +
+			"             │                               ├─ index: [WGSDC.AVPYF]\n" +
+			"             │                               └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
 			"             └─ Project\n" +
-			"                 ├─ columns: [id:0!null, FV24E:1 as FV24E, UJ6XY:2 as UJ6XY, M22QN:3, NZ4MQ:4, ETPQV:5!null, convert\n" +
+			"                 ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, BPNW2.FV24E:1 as FV24E, BPNW2.UJ6XY:2 as UJ6XY, Subquery\n" +
+			"                 │   ├─ cacheable: false\n" +
+			"                 │   └─ Project\n" +
+			"                 │       ├─ columns: [aac.id:8!null]\n" +
+			"                 │       └─ Filter\n" +
+			"                 │           ├─ Eq\n" +
+			"                 │           │   ├─ aac.BTXC5:9\n" +
+			"                 │           │   └─ BPNW2.SYPKF:3\n" +
+			"                 │           └─ TableAlias(aac)\n" +
+			"                 │               └─ IndexedTableAccess(TPXBU)\n" +
+			"                 │                   ├─ index: [TPXBU.BTXC5]\n" +
+			"                 │                   └─ columns: [id btxc5]\n" +
+			"                 │   as M22QN, BPNW2.NZ4MQ:4 as NZ4MQ, BPNW2.MU3KG:0!null as ETPQV, convert\n" +
 			"                 │   ├─ type: char\n" +
-			"                 │   └─ PRUV2:6\n" +
-			"                 │   as PRUV2, YKSSU:7, FHCYT:8]\n" +
-			"                 └─ Project\n" +
-			"                     ├─ columns: [lpad(lower(concat(concat(hex((rand() * 4294967296)),lower(hex((rand() * 4294967296))),lower(hex((rand() * 4294967296)))))), 24, '0') as id, BPNW2.FV24E:1 as FV24E, BPNW2.UJ6XY:2 as UJ6XY, Subquery\n" +
-			"                     │   ├─ cacheable: false\n" +
-			"                     │   └─ Project\n" +
-			"                     │       ├─ columns: [aac.id:8!null]\n" +
-			"                     │       └─ Filter\n" +
-			"                     │           ├─ Eq\n" +
-			"                     │           │   ├─ aac.BTXC5:9\n" +
-			"                     │           │   └─ BPNW2.SYPKF:3\n" +
-			"                     │           └─ TableAlias(aac)\n" +
-			"                     │               └─ IndexedTableAccess(TPXBU)\n" +
-			"                     │                   ├─ index: [TPXBU.BTXC5]\n" +
-			"                     │                   └─ columns: [id btxc5]\n" +
-			"                     │   as M22QN, BPNW2.NZ4MQ:4 as NZ4MQ, BPNW2.MU3KG:0!null as ETPQV, BPNW2.I4NDZ:7 as PRUV2, BPNW2.YKSSU:6 as YKSSU, BPNW2.FHCYT:5 as FHCYT]\n" +
-			"                     └─ SubqueryAlias\n" +
-			"                         ├─ name: BPNW2\n" +
-			"                         ├─ outerVisibility: false\n" +
-			"                         ├─ cacheable: true\n" +
-			"                         └─ Distinct\n" +
-			"                             └─ Project\n" +
-			"                                 ├─ columns: [TIZHK.id:0!null as MU3KG, CASE  WHEN NOT\n" +
-			"                                 │   └─ NHMXW.FZXV5:15 IS NULL\n" +
-			"                                 │   THEN Subquery\n" +
-			"                                 │   ├─ cacheable: false\n" +
-			"                                 │   └─ Project\n" +
-			"                                 │       ├─ columns: [overridden_nd_mutant.id:54!null]\n" +
-			"                                 │       └─ Filter\n" +
-			"                                 │           ├─ Eq\n" +
-			"                                 │           │   ├─ overridden_nd_mutant.TW55N:55!null\n" +
-			"                                 │           │   └─ NHMXW.FZXV5:15\n" +
-			"                                 │           └─ TableAlias(overridden_nd_mutant)\n" +
-			"                                 │               └─ IndexedTableAccess(E2I7U)\n" +
-			"                                 │                   ├─ index: [E2I7U.TW55N]\n" +
-			"                                 │                   └─ columns: [id tw55n]\n" +
-			"                                 │   ELSE J4JYP.id:20 END as FV24E, CASE  WHEN NOT\n" +
-			"                                 │   └─ NHMXW.DQYGV:16 IS NULL\n" +
-			"                                 │   THEN Subquery\n" +
-			"                                 │   ├─ cacheable: false\n" +
-			"                                 │   └─ Project\n" +
-			"                                 │       ├─ columns: [overridden_QI2IEner.id:54!null]\n" +
-			"                                 │       └─ Filter\n" +
-			"                                 │           ├─ Eq\n" +
-			"                                 │           │   ├─ overridden_QI2IEner.TW55N:55!null\n" +
-			"                                 │           │   └─ NHMXW.DQYGV:16\n" +
-			"                                 │           └─ TableAlias(overridden_QI2IEner)\n" +
-			"                                 │               └─ Table\n" +
-			"                                 │                   ├─ name: E2I7U\n" +
-			"                                 │                   └─ columns: [id tw55n]\n" +
-			"                                 │   ELSE RHUZN.id:37 END as UJ6XY, TIZHK.SYPKF:3 as SYPKF, Subquery\n" +
-			"                                 │   ├─ cacheable: false\n" +
-			"                                 │   └─ Project\n" +
-			"                                 │       ├─ columns: [G3YXS.id:54!null]\n" +
-			"                                 │       └─ Filter\n" +
-			"                                 │           ├─ Eq\n" +
-			"                                 │           │   ├─ concat(G3YXS.ESFVY:55!null,(MI: (longtext),G3YXS.SL76B:56!null,) (longtext))\n" +
-			"                                 │           │   └─ TIZHK.IDUT2:4\n" +
-			"                                 │           └─ TableAlias(G3YXS)\n" +
-			"                                 │               └─ Table\n" +
-			"                                 │                   ├─ name: YYBCX\n" +
-			"                                 │                   └─ columns: [id esfvy sl76b]\n" +
-			"                                 │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU, NHMXW.id:10 as I4NDZ]\n" +
-			"                                 └─ Filter\n" +
-			"                                     ├─ NOT\n" +
-			"                                     │   └─ NHMXW.id:10 IS NULL\n" +
-			"                                     └─ LeftOuterHashJoin\n" +
-			"                                         ├─ Eq\n" +
-			"                                         │   ├─ RHUZN.ZH72S:44\n" +
-			"                                         │   └─ TIZHK.ZHITY:2\n" +
-			"                                         ├─ LeftOuterHashJoin\n" +
-			"                                         │   ├─ Eq\n" +
-			"                                         │   │   ├─ J4JYP.ZH72S:27\n" +
-			"                                         │   │   └─ TIZHK.TVNW2:1\n" +
-			"                                         │   ├─ LeftOuterMergeJoin\n" +
-			"                                         │   │   ├─ cmp: Eq\n" +
-			"                                         │   │   │   ├─ TIZHK.TVNW2:1\n" +
-			"                                         │   │   │   └─ NHMXW.NOHHR:11!null\n" +
-			"                                         │   │   ├─ sel: AND\n" +
-			"                                         │   │   │   ├─ AND\n" +
-			"                                         │   │   │   │   ├─ AND\n" +
-			"                                         │   │   │   │   │   ├─ Eq\n" +
-			"                                         │   │   │   │   │   │   ├─ NHMXW.SWCQV:17!null\n" +
-			"                                         │   │   │   │   │   │   └─ 0 (tinyint)\n" +
-			"                                         │   │   │   │   │   └─ Eq\n" +
-			"                                         │   │   │   │   │       ├─ NHMXW.AVPYF:12!null\n" +
-			"                                         │   │   │   │   │       └─ TIZHK.ZHITY:2\n" +
-			"                                         │   │   │   │   └─ Eq\n" +
-			"                                         │   │   │   │       ├─ NHMXW.SYPKF:13!null\n" +
-			"                                         │   │   │   │       └─ TIZHK.SYPKF:3\n" +
-			"                                         │   │   │   └─ Eq\n" +
-			"                                         │   │   │       ├─ NHMXW.IDUT2:14!null\n" +
-			"                                         │   │   │       └─ TIZHK.IDUT2:4\n" +
-			"                                         │   │   ├─ Filter\n" +
-			"                                         │   │   │   ├─ HashIn\n" +
-			"                                         │   │   │   │   ├─ TIZHK.id:0!null\n" +
-			"                                         │   │   │   │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
-			"                                         │   │   │   └─ TableAlias(TIZHK)\n" +
-			"                                         │   │   │       └─ IndexedTableAccess(WRZVO)\n" +
-			"                                         │   │   │           ├─ index: [WRZVO.TVNW2]\n" +
-			"                                         │   │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │   │   │           └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
-			"                                         │   │   └─ TableAlias(NHMXW)\n" +
-			"                                         │   │       └─ IndexedTableAccess(WGSDC)\n" +
-			"                                         │   │           ├─ index: [WGSDC.NOHHR]\n" +
-			"                                         │   │           ├─ static: [{[NULL, ∞)}]\n" +
-			"                                         │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
-			"                                         │   └─ HashLookup\n" +
-			"                                         │       ├─ source: TUPLE(TIZHK.TVNW2:1)\n" +
-			"                                         │       ├─ target: TUPLE(J4JYP.ZH72S:7)\n" +
-			"                                         │       └─ CachedResults\n" +
-			"                                         │           └─ TableAlias(J4JYP)\n" +
-			"                                         │               └─ Table\n" +
-			"                                         │                   ├─ name: E2I7U\n" +
-			"                                         │                   └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
-			"                                         └─ HashLookup\n" +
-			"                                             ├─ source: TUPLE(TIZHK.ZHITY:2)\n" +
-			"                                             ├─ target: TUPLE(RHUZN.ZH72S:7)\n" +
-			"                                             └─ CachedResults\n" +
-			"                                                 └─ TableAlias(RHUZN)\n" +
-			"                                                     └─ Table\n" +
-			"                                                         ├─ name: E2I7U\n" +
-			"                                                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                 │   └─ BPNW2.I4NDZ:7\n" +
+			"                 │   as PRUV2, BPNW2.YKSSU:6 as YKSSU, BPNW2.FHCYT:5 as FHCYT]\n" +
+			"                 └─ SubqueryAlias\n" +
+			"                     ├─ name: BPNW2\n" +
+			"                     ├─ outerVisibility: false\n" +
+			"                     ├─ cacheable: true\n" +
+			"                     └─ Distinct\n" +
+			"                         └─ Project\n" +
+			"                             ├─ columns: [TIZHK.id:10!null as MU3KG, CASE  WHEN NOT\n" +
+			"                             │   └─ NHMXW.FZXV5:5 IS NULL\n" +
+			"                             │   THEN Subquery\n" +
+			"                             │   ├─ cacheable: false\n" +
+			"                             │   └─ Project\n" +
+			"                             │       ├─ columns: [overridden_nd_mutant.id:54!null]\n" +
+			"                             │       └─ Filter\n" +
+			"                             │           ├─ Eq\n" +
+			"                             │           │   ├─ overridden_nd_mutant.TW55N:55!null\n" +
+			"                             │           │   └─ NHMXW.FZXV5:5\n" +
+			"                             │           └─ TableAlias(overridden_nd_mutant)\n" +
+			"                             │               └─ IndexedTableAccess(E2I7U)\n" +
+			"                             │                   ├─ index: [E2I7U.TW55N]\n" +
+			"                             │                   └─ columns: [id tw55n]\n" +
+			"                             │   ELSE J4JYP.id:20 END as FV24E, CASE  WHEN NOT\n" +
+			"                             │   └─ NHMXW.DQYGV:6 IS NULL\n" +
+			"                             │   THEN Subquery\n" +
+			"                             │   ├─ cacheable: false\n" +
+			"                             │   └─ Project\n" +
+			"                             │       ├─ columns: [overridden_QI2IEner.id:54!null]\n" +
+			"                             │       └─ Filter\n" +
+			"                             │           ├─ Eq\n" +
+			"                             │           │   ├─ overridden_QI2IEner.TW55N:55!null\n" +
+			"                             │           │   └─ NHMXW.DQYGV:6\n" +
+			"                             │           └─ TableAlias(overridden_QI2IEner)\n" +
+			"                             │               └─ Table\n" +
+			"                             │                   ├─ name: E2I7U\n" +
+			"                             │                   └─ columns: [id tw55n]\n" +
+			"                             │   ELSE RHUZN.id:37 END as UJ6XY, TIZHK.SYPKF:13 as SYPKF, Subquery\n" +
+			"                             │   ├─ cacheable: false\n" +
+			"                             │   └─ Project\n" +
+			"                             │       ├─ columns: [G3YXS.id:54!null]\n" +
+			"                             │       └─ Filter\n" +
+			"                             │           ├─ Eq\n" +
+			"                             │           │   ├─ concat(G3YXS.ESFVY:55!null,(MI: (longtext),G3YXS.SL76B:56!null,) (longtext))\n" +
+			"                             │           │   └─ TIZHK.IDUT2:14\n" +
+			"                             │           └─ TableAlias(G3YXS)\n" +
+			"                             │               └─ Table\n" +
+			"                             │                   ├─ name: YYBCX\n" +
+			"                             │                   └─ columns: [id esfvy sl76b]\n" +
+			"                             │   as NZ4MQ, NULL (null) as FHCYT, NULL (null) as YKSSU, NHMXW.id:0 as I4NDZ]\n" +
+			"                             └─ LeftOuterLookupJoin\n" +
+			"                                 ├─ Eq\n" +
+			"                                 │   ├─ RHUZN.ZH72S:44\n" +
+			"                                 │   └─ TIZHK.ZHITY:12\n" +
+			"                                 ├─ LeftOuterLookupJoin\n" +
+			"                                 │   ├─ Eq\n" +
+			"                                 │   │   ├─ J4JYP.ZH72S:27\n" +
+			"                                 │   │   └─ TIZHK.TVNW2:11\n" +
+			"                                 │   ├─ LookupJoin\n" +
+			"                                 │   │   ├─ AND\n" +
+			"                                 │   │   │   ├─ AND\n" +
+			"                                 │   │   │   │   ├─ AND\n" +
+			"                                 │   │   │   │   │   ├─ AND\n" +
+			"                                 │   │   │   │   │   │   ├─ Eq\n" +
+			"                                 │   │   │   │   │   │   │   ├─ NHMXW.SWCQV:7!null\n" +
+			"                                 │   │   │   │   │   │   │   └─ 0 (tinyint)\n" +
+			"                                 │   │   │   │   │   │   └─ Eq\n" +
+			"                                 │   │   │   │   │   │       ├─ NHMXW.NOHHR:1!null\n" +
+			"                                 │   │   │   │   │   │       └─ TIZHK.TVNW2:11\n" +
+			"                                 │   │   │   │   │   └─ Eq\n" +
+			"                                 │   │   │   │   │       ├─ NHMXW.AVPYF:2!null\n" +
+			"                                 │   │   │   │   │       └─ TIZHK.ZHITY:12\n" +
+			"                                 │   │   │   │   └─ Eq\n" +
+			"                                 │   │   │   │       ├─ NHMXW.SYPKF:3!null\n" +
+			"                                 │   │   │   │       └─ TIZHK.SYPKF:13\n" +
+			"                                 │   │   │   └─ Eq\n" +
+			"                                 │   │   │       ├─ NHMXW.IDUT2:4!null\n" +
+			"                                 │   │   │       └─ TIZHK.IDUT2:14\n" +
+			"                                 │   │   ├─ Filter\n" +
+			"                                 │   │   │   ├─ NOT\n" +
+			"                                 │   │   │   │   └─ NHMXW.id:0 IS NULL\n" +
+			"                                 │   │   │   └─ TableAlias(NHMXW)\n" +
+			"                                 │   │   │       └─ IndexedTableAccess(WGSDC)\n" +
+			"                                 │   │   │           ├─ index: [WGSDC.id]\n" +
+			"                                 │   │   │           ├─ static: [{(NULL, ∞)}]\n" +
+			"                                 │   │   │           └─ columns: [id nohhr avpyf sypkf idut2 fzxv5 dqygv swcqv ykssu fhcyt]\n" +
+			"                                 │   │   └─ Filter\n" +
+			"                                 │   │       ├─ HashIn\n" +
+			"                                 │   │       │   ├─ TIZHK.id:0!null\n" +
+			"                                 │   │       │   └─ TUPLE(1 (longtext), 2 (longtext), 3 (longtext))\n" +
+			"                                 │   │       └─ TableAlias(TIZHK)\n" +
+			"                                 │   │           └─ IndexedTableAccess(WRZVO)\n" +
+			"                                 │   │               ├─ index: [WRZVO.TVNW2]\n" +
+			"                                 │   │               └─ columns: [id tvnw2 zhity sypkf idut2 o6qj3 no2ja ykssu fhcyt qz6vt]\n" +
+			"                                 │   └─ TableAlias(J4JYP)\n" +
+			"                                 │       └─ IndexedTableAccess(E2I7U)\n" +
+			"                                 │           ├─ index: [E2I7U.ZH72S]\n" +
+			"                                 │           └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
+			"                                 └─ TableAlias(RHUZN)\n" +
+			"                                     └─ IndexedTableAccess(E2I7U)\n" +
+			"                                         ├─ index: [E2I7U.ZH72S]\n" +
+			"                                         └─ columns: [id dkcaj kng7t tw55n qrqxw ecxaj fgg57 zh72s fsk67 xqdyt tce7a iwv2h hpcms n5cc2 fhcyt etaq7 a75x7]\n" +
 			"",
 	},
 	{
@@ -16589,7 +16633,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: SFEGG\n" +
 			"         │       └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
-			"         └─ Trigger(CREATE TRIGGER SFEGG_on_insert BEFORE INSERT ON SFEGG\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER SFEGG_on_insert BEFORE INSERT ON SFEGG\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -16878,7 +16922,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: FLQLP\n" +
 			"         │       └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
-			"         └─ Trigger(CREATE TRIGGER FLQLP_on_insert BEFORE INSERT ON FLQLP\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER FLQLP_on_insert BEFORE INSERT ON FLQLP\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -17122,7 +17166,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: SFEGG\n" +
 			"         │       └─ columns: [id no52d vyo5e dkcaj adurz fhcyt]\n" +
-			"         └─ Trigger(CREATE TRIGGER SFEGG_on_insert BEFORE INSERT ON SFEGG\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER SFEGG_on_insert BEFORE INSERT ON SFEGG\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -17317,7 +17361,7 @@ WHERE
 			"         │   └─ Table\n" +
 			"         │       ├─ name: FLQLP\n" +
 			"         │       └─ columns: [id fz2r5 luevy m22qn ove3e nrurt oca7e xmm6q v5dpx s3q3y zrv3b fhcyt]\n" +
-			"         └─ Trigger(CREATE TRIGGER FLQLP_on_insert BEFORE INSERT ON FLQLP\n" +
+			"         └─ Trigger(CREATE DEFINER=`root`@`localhost` TRIGGER FLQLP_on_insert BEFORE INSERT ON FLQLP\n" +
 			"            FOR EACH ROW\n" +
 			"            BEGIN\n" +
 			"              IF\n" +
@@ -51,6 +51,11 @@ type ScriptTestAssertion struct {
 	// such as the use of the SIGNAL statement.
 	ExpectedErrStr string
 
+	// ExpectedErrCode is the MySQL error number that the error of the query is expected to be reported to clients
+	// with, and ExpectedSQLState is its expected SQLSTATE, which is only checked if set.
+	ExpectedErrCode  int
+	ExpectedSQLState string
+
 	// ExpectedWarning contains the expected warning code when a query generates warnings but not errors.
 	ExpectedWarning int
 
@@ -610,11 +615,11 @@ var ScriptTests = []ScriptTest{
 			},
 			{
 				Query:          `SELECT id, v1 INTO @myFirstVar FROM tab1 ORDER BY id DESC LIMIT 1 INTO @mySecondVar`,
-				ExpectedErrStr: "Multiple INTO clauses in one query block at position 84 near '@mySecondVar'",
+				ExpectedErrStr: "Multiple INTO clauses in one query block at line 1, column 84 near '@mySecondVar'",
 			},
 			{
 				Query:          `SELECT id FROM tab1 WHERE id > 3 UNION select s INTO @mustSingleVar FROM tab2 WHERE s < 'f' ORDER BY s DESC`,
-				ExpectedErrStr: "INTO clause is not allowed at position 98 near 'ORDER'",
+				ExpectedErrStr: "INTO clause is not allowed at line 1, column 98 near 'ORDER'",
 			},
 		},
 	},
@@ -1447,1408 +1452,2886 @@ var ScriptTests = []ScriptTest{
 		},
 	},
 	{
-		Name: "Issue #499", // https://github.com/dolthub/go-mysql-server/issues/499
+		Name: "CREATE TABLE SELECT derives column types from the select list",
 		SetUpScript: []string{
-			"set time_zone = '+0:00';",
-			"CREATE TABLE test (time TIMESTAMP, value DOUBLE);",
-			`INSERT INTO test VALUES 
-			("2021-07-04 10:00:00", 1.0),
-			("2021-07-03 10:00:00", 2.0),
-			("2021-07-02 10:00:00", 3.0),
-			("2021-07-01 10:00:00", 4.0);`,
+			"CREATE TABLE t1 (pk int PRIMARY KEY, v1 varchar(10) CHARACTER SET latin1)",
+			"INSERT INTO t1 VALUES (1, 'a'), (2, 'b')",
+			"CREATE TABLE t2 AS SELECT pk, 1 AS one, 'abc' AS str, NULL AS nothing, CONCAT(v1, 'x') AS cat FROM t1",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				// In the original, reported issue, the order by clause did not qualify the table name
-				// for `test.time`. When there is ambiguity between a column name and an expression
-				// alias name in the order by clause, MySQL choose the alias; however, if the reference
-				// is used in a function call, MySQL instead seems to resolve to the column name.
-				// Until we determine the exact rule for this behavior, we've qualified the reference
-				// in the order by clause to ensure it selects the table column and not the alias.
-				// TODO: Waiting to hear back from MySQL on whether this is intended behavior or not:
-				//       https://bugs.mysql.com/bug.php?id=109020
-				Query: `SELECT UNIX_TIMESTAMP(time) DIV 60 * 60 AS "time", avg(value) AS "value"
-				FROM test GROUP BY 1 ORDER BY UNIX_TIMESTAMP(test.time) DIV 60 * 60`,
-				Expected: []sql.Row{
-					{"1625133600", 4.0},
-					{"1625220000", 3.0},
-					{"1625306400", 2.0},
-					{"1625392800", 1.0},
-				},
+				Query: "SHOW CREATE TABLE t2",
+				Expected: []sql.Row{{"t2", "CREATE TABLE `t2` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `one` int NOT NULL,\n" +
+					"  `str` varchar(3) NOT NULL,\n" +
+					"  `nothing` binary(0),\n" +
+					"  `cat` longtext CHARACTER SET latin1 COLLATE latin1_swedish_ci\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "SELECT * FROM t2 ORDER BY pk",
+				Expected: []sql.Row{{1, 1, "abc", nil, "ax"}, {2, 1, "abc", nil, "bx"}},
+			},
+			{
+				Query:       "CREATE TABLE t3 AS SELECT * FROM t1 WHERE pk = (SELECT 1 UNION SELECT 2)",
+				ExpectedErr: sql.ErrExpectedSingleRow,
+			},
+			{
+				Query:    "SHOW TABLES LIKE 't3'",
+				Expected: []sql.Row{},
 			},
 		},
-		//todo(max): fix arithmatic on bindvar typing
-		SkipPrepared: true,
 	},
 	{
-		Name: "WHERE clause considers ENUM/SET types for comparisons",
+		Name: "CREATE TABLE LIKE copies indexes, checks, and comments",
 		SetUpScript: []string{
-			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 ENUM('a', 'b', 'c'), v2 SET('a', 'b', 'c'));",
-			"INSERT INTO test VALUES (1, 2, 2), (2, 1, 1);",
+			"CREATE TABLE t1 (pk int AUTO_INCREMENT PRIMARY KEY, v1 varchar(20) COMMENT 'the value', v2 text, CONSTRAINT named CHECK (pk > 0), CHECK (pk < 100), UNIQUE KEY v2idx (v2(10)) COMMENT 'prefix')",
+			"INSERT INTO t1 (v1, v2) VALUES ('a', 'a'), ('b', 'b')",
+			"CREATE TABLE t2 LIKE t1",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT * FROM test;",
-				Expected: []sql.Row{{1, uint16(2), uint64(2)}, {2, uint16(1), uint64(1)}},
+				Query: "SHOW CREATE TABLE t2",
+				Expected: []sql.Row{{"t2", "CREATE TABLE `t2` (\n" +
+					"  `pk` int NOT NULL AUTO_INCREMENT,\n" +
+					"  `v1` varchar(20) COMMENT 'the value',\n" +
+					"  `v2` text,\n" +
+					"  PRIMARY KEY (`pk`),\n" +
+					"  UNIQUE KEY `v2idx` (`v2`(10)) COMMENT 'prefix',\n" +
+					"  CONSTRAINT `named` CHECK ((`pk` > 0)),\n" +
+					"  CONSTRAINT `t2_chk_1` CHECK ((`pk` < 100))\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 			{
-				Query:    "UPDATE test SET v1 = 3 WHERE v1 = 2;",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 0, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+				Query:    "INSERT INTO t2 (v1, v2) VALUES ('c', 'c')",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 1}}},
 			},
 			{
-				Query:    "SELECT * FROM test;",
-				Expected: []sql.Row{{1, uint16(3), uint64(2)}, {2, uint16(1), uint64(1)}},
+				Query:       "INSERT INTO t2 VALUES (100, 'd', 'd')",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
 			},
 			{
-				Query:    "UPDATE test SET v2 = 3 WHERE 2 = v2;",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 0, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+				Query:       "INSERT INTO t2 (v1, v2) VALUES ('e', 'c')",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
 			},
+		},
+	},
+	{
+		Name: "RENAME DATABASE",
+		SetUpScript: []string{
+			"CREATE DATABASE olddb",
+			"CREATE TABLE olddb.parent (pk int primary key)",
+			"CREATE TABLE olddb.child (pk int primary key, fk int, FOREIGN KEY (fk) REFERENCES olddb.parent (pk))",
+			"INSERT INTO olddb.parent VALUES (1)",
+			"INSERT INTO olddb.child VALUES (1, 1)",
+			"CREATE DATABASE otherdb",
+		},
+		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT * FROM test;",
-				Expected: []sql.Row{{1, uint16(3), uint64(3)}, {2, uint16(1), uint64(1)}},
+				Query:    "RENAME DATABASE olddb TO newdb",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "SHOW DATABASES LIKE '%db'",
+				Expected: []sql.Row{{"mydb"}, {"newdb"}, {"otherdb"}},
+			},
+			{
+				Query:    "SELECT * FROM newdb.child",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				Query:       "INSERT INTO newdb.child VALUES (2, 2)",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				Query:       "RENAME DATABASE newdb TO otherdb",
+				ExpectedErr: sql.ErrDatabaseExists,
+			},
+			{
+				Query:       "RENAME DATABASE olddb TO newerdb",
+				ExpectedErr: sql.ErrDatabaseNotFound,
+			},
+			{
+				Query:    "USE newdb",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "RENAME SCHEMA newdb TO `renamed db`",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "SELECT DATABASE(), COUNT(*) FROM parent",
+				Expected: []sql.Row{{"renamed db", 1}},
 			},
 		},
 	},
 	{
-		Name: "Slightly more complex example for the Exists Clause",
+		Name: "database defaults for character set, collation, and encryption",
 		SetUpScript: []string{
-			"create table store(store_id int, item_id int, primary key (store_id, item_id))",
-			"create table items(item_id int primary key, price int)",
-			"insert into store values (0, 1), (0,2),(0,3),(1,2),(1,4),(2,1)",
-			"insert into items values (1, 10), (2, 20), (3, 30),(4,40)",
+			"CREATE DATABASE latindb DEFAULT CHARSET latin1 DEFAULT ENCRYPTION 'Y'",
+			"CREATE TABLE latindb.t (pk int primary key, v varchar(10))",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT * from store WHERE EXISTS (SELECT price from items where price > 10 and store.item_id = items.item_id)",
-				Expected: []sql.Row{{0, 2}, {0, 3}, {1, 2}, {1, 4}},
+				Query:    "SHOW CREATE DATABASE latindb",
+				Expected: []sql.Row{{"latindb", "CREATE DATABASE `latindb` /*!40100 DEFAULT CHARACTER SET latin1 COLLATE latin1_swedish_ci */ /*!80016 DEFAULT ENCRYPTION='Y' */"}},
+			},
+			{
+				Query:    "SELECT DEFAULT_CHARACTER_SET_NAME, DEFAULT_COLLATION_NAME, DEFAULT_ENCRYPTION FROM information_schema.SCHEMATA WHERE SCHEMA_NAME = 'latindb'",
+				Expected: []sql.Row{{"latin1", "latin1_swedish_ci", "YES"}},
+			},
+			{
+				Query:    "SELECT TABLE_COLLATION FROM information_schema.TABLES WHERE TABLE_SCHEMA = 'latindb' AND TABLE_NAME = 't'",
+				Expected: []sql.Row{{"latin1_swedish_ci"}},
+			},
+			{
+				Query:    "ALTER DATABASE latindb DEFAULT ENCRYPTION = 'N'",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "SHOW CREATE DATABASE latindb",
+				Expected: []sql.Row{{"latindb", "CREATE DATABASE `latindb` /*!40100 DEFAULT CHARACTER SET latin1 COLLATE latin1_swedish_ci */"}},
+			},
+			{
+				Query:    "ALTER DATABASE latindb COLLATE latin1_general_ci",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "CREATE TABLE latindb.t2 (pk int primary key, v varchar(10))",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT TABLE_NAME, TABLE_COLLATION FROM information_schema.TABLES WHERE TABLE_SCHEMA = 'latindb' ORDER BY 1",
+				Expected: []sql.Row{{"t", "latin1_swedish_ci"}, {"t2", "latin1_general_ci"}},
+			},
+			{
+				Query:    "SELECT DEFAULT_CHARACTER_SET_NAME, DEFAULT_COLLATION_NAME, DEFAULT_ENCRYPTION FROM information_schema.SCHEMATA WHERE SCHEMA_NAME = 'latindb'",
+				Expected: []sql.Row{{"latin1", "latin1_general_ci", "NO"}},
+			},
+			{
+				Query:       "CREATE DATABASE baddb DEFAULT ENCRYPTION 'maybe'",
+				ExpectedErr: sql.ErrInvalidEncryptionOption,
 			},
 		},
 	},
 	{
-		Name: "Simple Update Join test that manipulates two tables",
+		Name: "EXPLAIN in the traditional format",
 		SetUpScript: []string{
-			"CREATE TABLE test (pk int primary key);",
-			`CREATE TABLE test2 (pk int primary key, val int);`,
-			`INSERT into test values (0),(1),(2),(3)`,
-			`INSERT into test2 values (0, 0),(1, 1),(2, 2),(3, 3)`,
-			`CREATE TABLE test3(k int, val int, primary key (k, val))`,
-			`INSERT into test3 values (1,2),(1,3),(1,4)`,
+			"CREATE TABLE xy (x int primary key, y int, z varchar(10), index (z))",
+			"CREATE TABLE uv (u int primary key, v int)",
+			"INSERT INTO xy VALUES (1, 1, 'a'), (2, 2, 'b'), (3, 3, 'c'), (4, 4, 'd')",
+			"INSERT INTO uv VALUES (1, 1), (2, 2)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query: `update test2 inner join (select * from test3 order by val) as t3 on test2.pk = t3.k SET test2.val=t3.val`,
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{
-					Matched:  1,
-					Updated:  1,
-					Warnings: 0,
-				}}}},
+				Query:    "EXPLAIN SELECT 1",
+				Expected: []sql.Row{{1, "SIMPLE", nil, nil, nil, nil, nil, nil, nil, nil, nil, "No tables used"}},
 			},
 			{
-				Query: "SELECT val FROM test2 where pk = 1",
+				Query: "EXPLAIN FORMAT=TRADITIONAL SELECT y FROM xy WHERE x = 2",
 				Expected: []sql.Row{
-					{2},
+					{1, "SIMPLE", "xy", nil, "const", "PRIMARY", "PRIMARY", "4", "const", 1, 100.0, nil},
 				},
 			},
 			{
-				Query: `update test inner join test2 on test.pk = test2.pk SET test.pk=test.pk*10, test2.pk = test2.pk * 4 where test.pk < 10;`,
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 6, Info: plan.UpdateInfo{
-					Matched:  6, // TODO: The answer should be 8
-					Updated:  6,
-					Warnings: 0,
-				}}}},
+				Query: "EXPLAIN SELECT * FROM xy WHERE z > 'b' ORDER BY y",
+				Expected: []sql.Row{
+					{1, "SIMPLE", "xy", nil, "range", "z", "z", "43", nil, 1, 100.0, "Using index condition; Using filesort"},
+				},
 			},
 			{
-				Query: "SELECT * FROM test",
+				Query: "EXPLAIN SELECT * FROM xy WHERE z > 'b' AND y > 1",
 				Expected: []sql.Row{
-					{0},
-					{10},
-					{20},
-					{30},
+					{1, "SIMPLE", "xy", nil, "range", "z", "z", "43", nil, 1, 33.33, "Using index condition; Using where"},
 				},
 			},
 			{
-				Query: "SELECT * FROM test2",
+				Query: "EXPLAIN SELECT /*+ LOOKUP_JOIN(uv,xy) JOIN_ORDER(uv,xy) */ * FROM uv JOIN xy ON u = x WHERE y > 1",
 				Expected: []sql.Row{
-					{0, 0},
-					{4, 2},
-					{8, 2},
-					{12, 3},
+					{1, "SIMPLE", "uv", nil, "ALL", nil, nil, nil, nil, 2, 100.0, nil},
+					{1, "SIMPLE", "xy", nil, "eq_ref", "PRIMARY", "PRIMARY", "4", "uv.u", 1, 33.33, "Using where"},
+				},
+			},
+			{
+				Query: "EXPLAIN SELECT /*+ NO_MERGE(dt) */ * FROM (SELECT y FROM xy) dt",
+				Expected: []sql.Row{
+					{1, "PRIMARY", "<derived2>", nil, "ALL", nil, nil, nil, nil, 4, 100.0, nil},
+					{2, "DERIVED", "xy", nil, "ALL", nil, nil, nil, nil, 4, 100.0, nil},
+				},
+			},
+			{
+				Query: "EXPLAIN SELECT u FROM uv UNION SELECT x FROM xy",
+				Expected: []sql.Row{
+					{1, "PRIMARY", "uv", nil, "ALL", nil, nil, nil, nil, 2, 100.0, nil},
+					{2, "UNION", "xy", nil, "ALL", nil, nil, nil, nil, 4, 100.0, nil},
+					{nil, "UNION RESULT", "<union1,2>", nil, "ALL", nil, nil, nil, nil, nil, nil, "Using temporary"},
 				},
 			},
 		},
 	},
 	{
-		Name: "Partial indexes are used and return the expected result",
+		Name: "derived tables and views are merged into the outer query",
 		SetUpScript: []string{
-			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 BIGINT, v2 BIGINT, v3 BIGINT, INDEX vx (v3, v2, v1));",
-			"INSERT INTO test VALUES (1,2,3,4), (2,3,4,5), (3,4,5,6), (4,5,6,7), (5,6,7,8);",
+			"CREATE TABLE xy (x int primary key, y int, z varchar(10), index (z))",
+			"CREATE TABLE uv (u int primary key, v int)",
+			"INSERT INTO xy VALUES (1, 1, 'a'), (2, 2, 'b'), (3, 3, 'c'), (4, 4, 'd')",
+			"INSERT INTO uv VALUES (1, 1), (2, 2)",
+			"CREATE VIEW xy_view AS SELECT x, z FROM xy WHERE y > 1",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT * FROM test WHERE v3 = 4;",
-				Expected: []sql.Row{{1, 2, 3, 4}},
+				Query:    "SELECT * FROM (SELECT x, y FROM xy WHERE y > 1) dt WHERE dt.x = 3",
+				Expected: []sql.Row{{3, 3}},
 			},
 			{
-				Query:    "SELECT * FROM test WHERE v3 = 8 AND v2 = 7;",
-				Expected: []sql.Row{{5, 6, 7, 8}},
+				Query: "EXPLAIN SELECT * FROM (SELECT x, y FROM xy WHERE y > 1) dt WHERE dt.x = 3",
+				Expected: []sql.Row{
+					{1, "SIMPLE", "dt", nil, "const", "PRIMARY", "PRIMARY", "4", "const", 1, 3.33, "Using where"},
+				},
 			},
 			{
-				Query:    "SELECT * FROM test WHERE v3 >= 6 AND v2 >= 6;",
-				Expected: []sql.Row{{4, 5, 6, 7}, {5, 6, 7, 8}},
+				Query: "EXPLAIN SELECT /*+ NO_MERGE(dt) */ * FROM (SELECT x, y FROM xy WHERE y > 1) dt WHERE dt.x = 3",
+				Expected: []sql.Row{
+					{1, "PRIMARY", "<derived2>", nil, "ALL", nil, nil, nil, nil, 1, 100.0, nil},
+					{2, "DERIVED", "xy", nil, "const", "PRIMARY", "PRIMARY", "4", "const", 1, 33.33, "Using where"},
+				},
 			},
 			{
-				Query:    "SELECT * FROM test WHERE v3 = 7 AND v2 >= 6;",
-				Expected: []sql.Row{{4, 5, 6, 7}},
+				Query: "EXPLAIN SELECT /*+ NO_MERGE() */ * FROM (SELECT x, y FROM xy WHERE y > 1) dt WHERE dt.x = 3",
+				Expected: []sql.Row{
+					{1, "PRIMARY", "<derived2>", nil, "ALL", nil, nil, nil, nil, 1, 100.0, nil},
+					{2, "DERIVED", "xy", nil, "const", "PRIMARY", "PRIMARY", "4", "const", 1, 33.33, "Using where"},
+				},
+			},
+			{
+				Query:    "SELECT z FROM xy_view WHERE x = 4",
+				Expected: []sql.Row{{"d"}},
+			},
+			{
+				Query: "EXPLAIN SELECT z FROM xy_view WHERE x = 4",
+				Expected: []sql.Row{
+					{1, "SIMPLE", "xy_view", nil, "const", "PRIMARY", "PRIMARY", "4", "const", 1, 3.33, "Using where"},
+				},
+			},
+			{
+				Query:    "SELECT u, dt.y FROM uv LEFT JOIN (SELECT x, y FROM xy WHERE y > 1) dt ON u = dt.x ORDER BY u",
+				Expected: []sql.Row{{1, nil}, {2, 2}},
+			},
+			{
+				Query:    "SELECT dt.x, u FROM (SELECT x FROM xy WHERE y > 1) dt LEFT JOIN uv ON u = dt.x ORDER BY 1",
+				Expected: []sql.Row{{2, 2}, {3, nil}, {4, nil}},
+			},
+			{
+				Query:    "SELECT x FROM (SELECT x, y FROM xy) dt WHERE x IN (SELECT u FROM uv) ORDER BY x",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				// Derived tables with a LIMIT or computed columns are materialized
+				Query: "EXPLAIN SELECT * FROM (SELECT x FROM xy LIMIT 2) dt",
+				Expected: []sql.Row{
+					{1, "PRIMARY", "<derived2>", nil, "ALL", nil, nil, nil, nil, 4, 100.0, nil},
+					{2, "DERIVED", "xy", nil, "ALL", nil, nil, nil, nil, 4, 100.0, nil},
+				},
+			},
+			{
+				Query: "EXPLAIN SELECT * FROM (SELECT x, y + 1 AS y1 FROM xy) dt WHERE x = 1",
+				Expected: []sql.Row{
+					{1, "PRIMARY", "<derived2>", nil, "ALL", nil, nil, nil, nil, 1, 100.0, nil},
+					{2, "DERIVED", "xy", nil, "const", "PRIMARY", "PRIMARY", "4", "const", 1, 100.0, nil},
+				},
 			},
 		},
 	},
 	{
-		Name: "Multiple indexes on the same columns in a different order",
+		Name: "tables are read ahead with partition_read_ahead",
 		SetUpScript: []string{
-			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 BIGINT, v2 BIGINT, v3 BIGINT, INDEX v123 (v1, v2, v3), INDEX v321 (v3, v2, v1), INDEX v132 (v1, v3, v2));",
-			"INSERT INTO test VALUES (1,2,3,4), (2,3,4,5), (3,4,5,6), (4,5,6,7), (5,6,7,8);",
+			"CREATE TABLE t (pk int primary key, v int)",
+			"INSERT INTO t WITH RECURSIVE n (i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 1000) SELECT i, i % 10 FROM n",
+			"CREATE TABLE u (pk int primary key)",
+			"INSERT INTO u VALUES (1), (500), (1000)",
+			"SET @@partition_read_ahead = 2",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT * FROM test WHERE v1 = 2 AND v2 > 1;",
-				Expected: []sql.Row{{1, 2, 3, 4}},
+				Query:    "SELECT @@partition_read_ahead",
+				Expected: []sql.Row{{2}},
 			},
 			{
-				Query:    "SELECT * FROM test WHERE v2 = 4 AND v3 > 1;",
-				Expected: []sql.Row{{2, 3, 4, 5}},
+				Query:    "SELECT count(*), sum(pk), sum(v) FROM t",
+				Expected: []sql.Row{{1000, float64(500500), float64(4500)}},
 			},
 			{
-				Query:    "SELECT * FROM test WHERE v3 = 6 AND v1 > 1;",
-				Expected: []sql.Row{{3, 4, 5, 6}},
+				Query:    "SELECT pk FROM t WHERE v = 3 ORDER BY pk LIMIT 3",
+				Expected: []sql.Row{{3}, {13}, {23}},
 			},
 			{
-				Query:    "SELECT * FROM test WHERE v1 = 5 AND v3 <= 10 AND v2 >= 1;",
-				Expected: []sql.Row{{4, 5, 6, 7}},
+				Query:    "SELECT t.pk, t.v FROM t JOIN u ON t.pk = u.pk + 1 ORDER BY 1",
+				Expected: []sql.Row{{2, 2}, {501, 1}},
+			},
+			{
+				Query:       "SET @@partition_read_ahead = 2000",
+				ExpectedErr: sql.ErrInvalidSystemVariableValue,
 			},
 		},
 	},
 	{
-		Name: "Ensure proper DECIMAL support (found by fuzzer)",
+		Name: "distinct and group by compare values with the collation and type of their columns",
 		SetUpScript: []string{
-			"CREATE TABLE `GzaKtwgIya` (`K7t5WY` DECIMAL(64,5), `qBjVrN` VARBINARY(1000), `PvqQtc` SET('c3q6y','kxMqhfkK','XlRI8','dF0N63H','hMPjt0KXRLwCGRr','27fi2s','1FSJ','NcPzIN','Za18lbIgxmZ','on4BKKXykVTbJ','WBfO','RMNG','Sd7','FDzbEO','cLRdLOj1y','syo4','Ul','jfsfDCx6s','yEW3','JyQcWFDl'), `1kv7el` FLOAT, `Y3vfRG` BLOB, `Ijq8CK` TINYTEXT, `tzeStN` MEDIUMINT, `Ak83FQ` BINARY(64), `8Nbp3L` DOUBLE, PRIMARY KEY (`K7t5WY`));",
-			"REPLACE INTO `GzaKtwgIya` VALUES ('58567047399981325523662211357420045483361289734772861386428.89028','bvo5~Tt8%kMW2nm2!8HghaeulI6!pMadE+j-J2LeU1O1*-#@Lm8Ibh00bTYiA*H1Q8P1_kQq 24Rrd4@HeF%#7#C#U7%mqOMrQ0%!HVrGV1li.XyYa:7#3V^DtAMDTQ9 cY=07T4|DStrwy4.MAQxOG#1d#fcq+7675$y0e96-2@8-WlQ^p|%E!a^TV!Yj2_eqZZys1z:883l5I%zAT:i56K^T!cx#us $60Tb#gH$1#$P.709E#VrH9FbQ5QZK2hZUH!qUa4Xl8*I*0fT~oAha$8jU5AoWs+Uv!~:14Yq%pLXpP9RlZ:Gd1g|*$Qa.9*^K~YlYWVaxwY~_g6zOMpU$YijT+!_*m3=||cMNn#uN0!!OyCg~GTQlJ11+#@Ohqc7b#2|Jp2Aei56GOmq^I=7cQ=sQh~V.D^HzwK5~4E$QzFXfWNVN5J_w2b4dkR~bB~7F%=@R@9qE~e:-_RnoJcOLfBS@0:*hTIP$5ui|5Ea-l+qU4nx98X6rV2bLBxn8am@p~:xLF#T^_9kJVN76q^18=i *FJo.v-xA2GP==^C^Jz3yBF0OY4bIxC59Y#6G=$w:xh71kMxBcYJKf3+$Ci_uWx0P*AfFNne0_1E0Lwv#3J8vm:. 8Wo~F3VT:@w.t@w .JZz$bok9Tls7RGo=~4 Y$~iELr$s@53YuTPM8oqu!x*1%GswpJR=0K#qs00nW-1MqEUc:0wZv#X4qY^pzVDb:!:!yDhjhh+KIT%2%w@+t8c!f~o!%EnwBIr_OyzL6e1$-R8n0nWPU.toODd*|fW3H$9ZLc9!dMS:QfjI0M$nK 8aGvUVP@9kS~W#Y=Q%=37$@pAUkDTXkJo~-DRvCG6phPp*Xji@9|AEODHi+-6p%X4YM5Y3WasPHcZQ8QgTwi9 N=2RQD_MtVU~0J~3SAx*HrMlKvCPTswZq#q_96ny_A@7g!E2jyaxWFJD:C233onBdchW$WdAc.LZdZHYDR^uwZb9B9p-q.BkD1I',608583,'-7.276514330627342e-28','FN3O_E:$ 5S40T7^Vu1g!Ktn^N|4RE!9GnZiW5dG:%SJb5|SNuuI.d2^qnMY.Xn*_fRfk Eo7OhqY8OZ~pA0^ !2P.uN~r@pZ2!A0+4b*%nxO.tm%S6=$CZ9+c1zu-p $b:7:fOkC%@E3951st@2Q93~8hj:ZGeJ6S@nw-TAG+^lad37aB#xN*rD^9TO0|hleA#.Nh28S2PB72L*TxD0$|XE3S5eVVmbI*pkzE~lPecopX1fUyFj#LC+%~pjmab7^ Kdd4B%8I!ohOCQV.oiw++N|#W2=D4:_sK0@~kTTeNA8_+FMKRwro.M0| LdKHf-McKm0Z-R9+H%!9r l6%7UEB50yNH-ld%eW8!f=LKgZLc*TuTP2DA_o0izvzZokNp3ShR+PA7Fk* 1RcSt5KXe+8tLc+WGP','3RvfN2N.Q1tIffE965#2r=u_-4!u:9w!F1p7+mSsO8ckio|ib 1t@~GtgUkJX',1858932,'DJMaQcI=vS-Jk2L#^2N8qZcRpMJ2Ga!30A+@I!+35d-9bwVEVi5-~i.a%!KdoF5h','1.0354401044541863e+255');",
-			"INSERT INTO `GzaKtwgIya` VALUES ('91198031969464085142628031466155813748261645250257051732159.65596','96Lu=focmodq4otVAUN6TD-F$@k^4443higo=KH!1WBDH9|vpEGdO* 1uF6yWjT4:7G|altXnWSv+d:c8Km8vL!b%-nuB8mAxO9E|a5N5#v@z!ij5ifeIEoZGXrhBJl.m*Rx-@%g~t:y$3Pp3Q7Bd3y$=YG%6yibqXWO9$SS+g=*6QzdSCzuR~@v!:.ATye0A@y~DG=uq!PaZd6wN7.2S Aq868-RN3RM61V#N+Qywqo=%iYV*554@h6GPKZ| pmNwQw=PywuyBhr*MHAOXV+u9_-#imKI-wT4gEcA1~lGg1cfL2IvhkwOXRhrjAx-8+R3#4!Ai J6SYP|YUuuGalJ_N8k_8K^~h!JyiH$0JbGQ4AOxO3-eW=BaopOd8FF1.cfFMK!tXR ^I15g:npOuZZO$Vq3yQ4bl4s$E9:t2^.4f.:I4_@u9_UI1ApBthJZNiv~o#*uhs9K@ufZ1YPJQY-pMj$v-lQ2#%=Uu!iEAO3%vQ^5YITKcWRk~$kd1H#F675r@P5#M%*F_xP3Js7$YuEC4YuQjZ A74tMw:KwQ8dR:k_ Sa85G~42-K3%:jk5G9csC@iW3nY|@-:_dg~5@J!FWF5F+nyBgz4fDpdkdk9^:_.t$A3W-C@^Ax.~o|Rq96_i%HeG*7jBjOGhY-e1k@aD@WW.@GmpGAI|T-84gZFG3BU9@#9lpL|U2YCEA.BEA%sxDZ Kw:n+d$Y!SZw0Iml$Bdtyr:02Np=DZpiI%$N9*U=%Jq#$P5BI60WOTK+UynVx9Dd**5q8y9^v+I|PPa#_2XheV5YQU.ONdQQNJxsiRaEl!*=xv4bTWj1wBH#_-eM3T',490529,'-8.419238802182018e+25','|WD!NpWJOfN+_Au 1y!|XF8l38#%%R5%$TRUEaFt%4ywKQ8 O1LD-3qRDrnHAXboH~0uivbo87f+V%=q9~Mvz1EIxsU!whSmPqtb9r*11346R_@L+H#@@Z9H-Dc6j%.D0o##m@B9o7jO#~N81ACI|f#J3z4dho:jc54Xws$8r%cxuov^1$w_58Fv2*.8qbAW$TF153A:8wwj4YIhkd#^Q7 |g7I0iQG0p+yE64rk!Pu!SA-z=ELtLNOCJBk_4!lV$izn%sB6JwM+uq~ 49I7','v|eUA_h2@%t~bn26ci8Ngjm@Lk*G=l2MhxhceV2V|ka#c',8150267,'nX-=1Q$3riw_jlukGuHmjodT_Y_SM$xRbEt$%$%hlIUF1+GpRp~U6JvRX^: k@n#','7.956726808353253e+267');",
+			"CREATE TABLE t (pk int primary key, s varchar(20) COLLATE utf8mb4_0900_ai_ci, d datetime, f double)",
+			"INSERT INTO t VALUES (1, 'a', '2020-01-01 00:00:00', 0), (2, 'A', '2020-01-01', -0.0), (3, 'b', '2021-01-01', 1.5)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "DELETE FROM `GzaKtwgIya` WHERE `K7t5WY` = '58567047399981325523662211357420045483361289734772861386428.89028';",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "SELECT DISTINCT s FROM t ORDER BY 1",
+				Expected: []sql.Row{{"a"}, {"b"}},
 			},
 			{
-				Query:    "SELECT COUNT(*) FROM GzaKtwgIya",
-				Expected: []sql.Row{{1}},
+				Query:    "SELECT DISTINCT d FROM t ORDER BY 1",
+				Expected: []sql.Row{{time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}, {time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    "SELECT f, count(*) FROM t GROUP BY f ORDER BY 1",
+				Expected: []sql.Row{{float64(0), 2}, {1.5, 1}},
+			},
+			{
+				Query:    "SELECT a.pk, b.pk FROM t a JOIN t b ON a.d = b.d AND a.pk < b.pk",
+				Expected: []sql.Row{{1, 2}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE s = 'A' ORDER BY 1",
+				Expected: []sql.Row{{1}, {2}},
 			},
 		},
 	},
 	{
-		Name: "Ensure scale is not rounded when inserting to DECIMAL type through float64",
+		Name: "distinct spills to disk past tmp_table_size, and is optimized for unique and sorted rows",
 		SetUpScript: []string{
-			"create table test (number decimal(40,16));",
-			"insert into test values ('11981.5923291839784651');",
-			"create table small_test (n decimal(3,2));",
+			"CREATE TABLE t (pk int primary key, v int, w int, index (v))",
+			"INSERT INTO t WITH RECURSIVE n (i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 1000) SELECT i, i % 300, i % 7 FROM n",
+			"SET tmp_table_size = 1024",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT COUNT(*) FROM test WHERE number = CONVERT('11981.5923291839784651', DECIMAL)",
-				Expected: []sql.Row{{1}},
-			},
-			{
-				Query:    "INSERT INTO test VALUES (11981.5923291839784651);",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "SELECT count(*), sum(w) FROM (SELECT DISTINCT pk % 30 a, w FROM t) d",
+				Expected: []sql.Row{{210, float64(630)}},
 			},
 			{
-				Query:    "SELECT COUNT(*) FROM test WHERE number = CONVERT('11981.5923291839784651', DECIMAL)",
-				Expected: []sql.Row{{2}},
+				Query:    "SELECT DISTINCT v FROM t WHERE v > 296",
+				Expected: []sql.Row{{297}, {298}, {299}},
 			},
 			{
-				Query:    "INSERT INTO test VALUES (119815923291839784651.11981592329183978465111981592329183978465144);",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "SELECT DISTINCT pk, w FROM t WHERE w = 3 ORDER BY pk LIMIT 2",
+				Expected: []sql.Row{{3, 3}, {10, 3}},
 			},
 			{
-				Query:    "SELECT COUNT(*) FROM test WHERE number = CONVERT('119815923291839784651.1198159232918398', DECIMAL)",
-				Expected: []sql.Row{{1}},
+				Query:    "SELECT count(v), sum(v) FROM (SELECT DISTINCT v FROM t) d",
+				Expected: []sql.Row{{300, float64(44850)}},
 			},
+		},
+	},
+	{
+		Name: "large offsets skip rows before projections and in index lookups",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, v int, w varchar(10), index (v))",
+			"INSERT INTO t WITH RECURSIVE n (i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 500) SELECT i, 500 - i, concat('w', i) FROM n",
+		},
+		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "INSERT INTO test VALUES (1.1981592329183978465111981592329183978465111981592329183978465144);",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "SELECT pk, upper(w) FROM t ORDER BY pk LIMIT 3 OFFSET 400",
+				Expected: []sql.Row{{401, "W401"}, {402, "W402"}, {403, "W403"}},
 			},
 			{
-				Query:    "SELECT COUNT(*) FROM test WHERE number = CONVERT('1.1981592329183978', DECIMAL)",
-				Expected: []sql.Row{{1}},
+				Query:    "SELECT pk * 2 FROM t WHERE pk > 100 ORDER BY pk LIMIT 2 OFFSET 250",
+				Expected: []sql.Row{{702}, {704}},
 			},
 			{
-				Query:    "INSERT INTO test VALUES (1.1981592329183978545111981592329183978465111981592329183978465144);",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "SELECT pk FROM t WHERE pk BETWEEN 10 AND 20 ORDER BY pk LIMIT 5 OFFSET 8",
+				Expected: []sql.Row{{18}, {19}, {20}},
 			},
 			{
-				Query:    "SELECT COUNT(*) FROM test WHERE number = CONVERT('1.1981592329183979', DECIMAL)",
-				Expected: []sql.Row{{1}},
+				Query:    "SELECT pk FROM t ORDER BY pk LIMIT 5 OFFSET 500",
+				Expected: []sql.Row{},
 			},
 			{
-				Query:       "INSERT INTO small_test VALUES (12.1);",
-				ExpectedErr: types.ErrConvertToDecimalLimit,
+				Query:    "SELECT v, w FROM t WHERE v < 10 ORDER BY v LIMIT 2 OFFSET 5",
+				Expected: []sql.Row{{5, "w495"}, {6, "w494"}},
 			},
 		},
 	},
 	{
-		Name: "JOIN on non-index-prefix columns do not panic (Dolt Issue #2366)",
+		Name: "correlated subqueries cache their results for each value of the outer columns they reference",
 		SetUpScript: []string{
-			"CREATE TABLE `player_season_stat_totals` (`player_id` int NOT NULL, `team_id` int NOT NULL, `season_id` int NOT NULL, `minutes` int, `games_started` int, `games_played` int, `2pm` int, `2pa` int, `3pm` int, `3pa` int, `ftm` int, `fta` int, `ast` int, `stl` int, `blk` int, `tov` int, `pts` int, `orb` int, `drb` int, `trb` int, `pf` int, `season_type_id` int NOT NULL, `league_id` int NOT NULL DEFAULT 0, PRIMARY KEY (`player_id`,`team_id`,`season_id`,`season_type_id`,`league_id`));",
-			"CREATE TABLE `team_seasons` (`team_id` int NOT NULL, `league_id` int NOT NULL, `season_id` int NOT NULL, `prefix` varchar(100), `nickname` varchar(100), `abbreviation` varchar(100), `city` varchar(100), `state` varchar(100), `country` varchar(100), PRIMARY KEY (`team_id`,`league_id`,`season_id`));",
-			"INSERT INTO player_season_stat_totals VALUES (1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1);",
-			"INSERT INTO team_seasons VALUES (1,1,1,'','','','','','');",
+			"CREATE TABLE t (pk int primary key, x int)",
+			"CREATE TABLE u (x int, y int)",
+			"INSERT INTO t WITH RECURSIVE n (i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 1000) SELECT i, i % 5 FROM n",
+			"INSERT INTO t SELECT pk + 1000, x FROM t",
+			"INSERT INTO u VALUES (0, 10), (1, 20), (1, 21), (2, 30), (4, NULL)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT stats.* FROM player_season_stat_totals stats LEFT JOIN team_seasons ON team_seasons.team_id = stats.team_id AND team_seasons.season_id = stats.season_id;",
-				Expected: []sql.Row{{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+				Query:    "SELECT sum((SELECT max(y) FROM u WHERE u.x = t.x)), count((SELECT max(y) FROM u WHERE u.x = t.x)) FROM t",
+				Expected: []sql.Row{{float64(24400), 1200}},
+			},
+			{
+				Query:    "SELECT count(*) FROM t WHERE t.x IN (SELECT x FROM u WHERE u.y > t.x * 15)",
+				Expected: []sql.Row{{800}},
+			},
+			{
+				Query:    "SELECT sum(EXISTS (SELECT 1 FROM u WHERE u.x = t.x AND u.y > 15)) FROM t",
+				Expected: []sql.Row{{float64(800)}},
+			},
+			{
+				// More distinct values than the results are cached for
+				Query:    "SELECT sum((SELECT count(*) FROM u WHERE u.x < t.pk % 1500)) FROM t",
+				Expected: []sql.Row{{float64(9979)}},
+			},
+			{
+				Query:    "SELECT sum((SELECT count(*) FROM u WHERE u.x < t.pk - 1996)) FROM t",
+				Expected: []sql.Row{{float64(12)}},
 			},
 		},
 	},
 	{
-		Name: "Issue #709",
+		Name: "unions stream the rows of all their branches, and deduplicate them once with a set that spills to disk",
 		SetUpScript: []string{
-			"create table a(id int primary key, v int , key (v));",
+			"CREATE TABLE t (pk int primary key, x int)",
+			"INSERT INTO t WITH RECURSIVE n (i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 1000) SELECT i, i % 5 FROM n",
+			"INSERT INTO t SELECT pk + 1000, x FROM t",
+			"SET tmp_table_size = 1024",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT count(*), sum(pk) FROM (SELECT pk FROM t UNION SELECT pk + 1000 FROM t) u",
+				Expected: []sql.Row{{3000, float64(4501500)}},
+			},
+			{
+				Query:    "SELECT count(*) FROM (SELECT x FROM t UNION SELECT x FROM t UNION SELECT x + 1 FROM t) u",
+				Expected: []sql.Row{{6}},
+			},
+			{
+				Query:    "SELECT count(*) FROM (SELECT x FROM t UNION SELECT x FROM t UNION ALL SELECT x FROM t) u",
+				Expected: []sql.Row{{2005}},
+			},
+			{
+				Query:    "SELECT count(*) FROM (SELECT x FROM t UNION ALL SELECT x FROM t UNION SELECT x FROM t) u",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "SELECT count(*) FROM (SELECT x FROM t UNION ALL (SELECT x FROM t UNION SELECT x FROM t)) u",
+				Expected: []sql.Row{{2005}},
+			},
+			{
+				Query:    "SELECT * FROM (SELECT pk, x FROM t WHERE pk < 3 UNION ALL SELECT concat('a', x), pk FROM t WHERE pk = 4) u",
+				Expected: []sql.Row{{"1", 1}, {"2", 2}, {"a4", 4}},
+			},
+			{
+				Query:    "SELECT x FROM t WHERE pk < 3 UNION ALL SELECT x FROM t WHERE pk = 0 UNION ALL SELECT x FROM t WHERE pk > 1998",
+				Expected: []sql.Row{{1}, {2}, {4}, {0}},
+			},
+			{
+				Query:    "SELECT x FROM t WHERE pk < 3 UNION ALL SELECT x FROM t WHERE pk > 1998 UNION ALL SELECT 10 LIMIT 3",
+				Expected: []sql.Row{{1}, {2}, {4}},
+			},
 		},
 	},
 	{
-		Name: "Show create table with various keys and constraints",
+		Name: "VALUES statements and table value constructors",
 		SetUpScript: []string{
-			"create table t1(a int primary key, b varchar(10) not null default 'abc')",
-			"alter table t1 add constraint ck1 check (b like '%abc%')",
-			"create index t1b on t1(b)",
-			"create table t2(c int primary key, d varchar(10))",
-			"alter table t2 add constraint t2du unique (d)",
-			"alter table t2 add constraint fk1 foreign key (d) references t1 (b)",
-			"create table t3 (a int, b varchar(100), c datetime, primary key (b,a))",
-			"create table t4 (a int default (floor(1)), b int default (coalesce(a, 10)))",
+			"CREATE TABLE t (a int primary key, b varchar(10))",
+			"INSERT INTO t VALUES ROW(1, 'x'), ROW(2, 'y')",
+			"INSERT INTO t (b, a) VALUES ROW('z', 3)",
+			"INSERT INTO t SELECT * FROM (VALUES ROW(4, 'w')) AS v (a, b)",
+			"CREATE VIEW v AS VALUES ROW(1, 'a'), ROW(2, 'b')",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query: "show create table t1",
-				Expected: []sql.Row{
-					{"t1", "CREATE TABLE `t1` (\n" +
-						"  `a` int NOT NULL,\n" +
-						"  `b` varchar(10) NOT NULL DEFAULT 'abc',\n" +
-						"  PRIMARY KEY (`a`),\n" +
-						"  KEY `t1b` (`b`),\n" +
-						"  CONSTRAINT `ck1` CHECK (`b` LIKE '%abc%')\n" +
-						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
-				},
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{{1, "x"}, {2, "y"}, {3, "z"}, {4, "w"}},
 			},
 			{
-				Query: "show create table t2",
-				Expected: []sql.Row{
-					{"t2", "CREATE TABLE `t2` (\n" +
-						"  `c` int NOT NULL,\n" +
-						"  `d` varchar(10),\n" +
-						"  PRIMARY KEY (`c`),\n" +
-						"  UNIQUE KEY `t2du` (`d`),\n" +
-						"  CONSTRAINT `fk1` FOREIGN KEY (`d`) REFERENCES `t1` (`b`)\n" +
-						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
-				},
+				Query:    "VALUES ROW(1, 'a'), ROW(2, 'b')",
+				Expected: []sql.Row{{1, "a"}, {2, "b"}},
 			},
 			{
-				Query: "show create table t3",
-				Expected: []sql.Row{
-					{"t3", "CREATE TABLE `t3` (\n" +
-						"  `a` int NOT NULL,\n" +
-						"  `b` varchar(100) NOT NULL,\n" +
-						"  `c` datetime,\n" +
-						"  PRIMARY KEY (`b`,`a`)\n" +
-						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
-				},
+				Query:    "VALUES ROW(1, 'a'), ROW(2, 'b') ORDER BY column_0 DESC LIMIT 1",
+				Expected: []sql.Row{{2, "b"}},
 			},
 			{
-				Query: "show create table t4",
-				Expected: []sql.Row{
-					{"t4", "CREATE TABLE `t4` (\n" +
-						"  `a` int DEFAULT (floor(1)),\n" +
-						"  `b` int DEFAULT (coalesce(a,10))\n" +
-						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
-				},
+				Query:    "SELECT a FROM t WHERE a < 2 UNION VALUES ROW(2), ROW(1) UNION ALL VALUES ROW(1)",
+				Expected: []sql.Row{{1}, {2}, {1}},
+			},
+			{
+				Query:    "SELECT * FROM t WHERE a IN (VALUES ROW(2), ROW(4))",
+				Expected: []sql.Row{{2, "y"}, {4, "w"}},
+			},
+			{
+				Query:    "SELECT t.b, v.y FROM t JOIN (VALUES ROW(1, 'one'), ROW(3, 'three')) AS v (x, y) ON t.a = v.x",
+				Expected: []sql.Row{{"x", "one"}, {"z", "three"}},
+			},
+			{
+				Query:    "WITH c (x) AS (VALUES ROW(10), ROW(20)) SELECT sum(x) FROM c",
+				Expected: []sql.Row{{float64(30)}},
+			},
+			{
+				Query:    "SELECT * FROM v",
+				Expected: []sql.Row{{1, "a"}, {2, "b"}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES ROW(1, 'v') ON DUPLICATE KEY UPDATE b = VALUES(b)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT b FROM t WHERE a = 1",
+				Expected: []sql.Row{{"v"}},
 			},
 		},
 	},
 	{
-		Name: "table with defaults, insert with on duplicate key update",
+		Name: "EXPLAIN FORMAT=JSON",
 		SetUpScript: []string{
-			"create table t (a int primary key, b int default 100);",
-			"insert into t values (1, 1), (2, 2)",
+			"CREATE TABLE xy (x int primary key, y int)",
+			"INSERT INTO xy VALUES (1, 1), (2, 2), (3, 3), (4, 4)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "insert into t values (1, 10) on duplicate key update b = 10",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query: "EXPLAIN FORMAT=JSON SELECT 1",
+				Expected: []sql.Row{{`{
+  "query_block": {
+    "select_id": 1,
+    "message": "No tables used"
+  }
+}`}},
+			},
+			{
+				Query: "EXPLAIN FORMAT=JSON SELECT y FROM xy WHERE x = 2",
+				Expected: []sql.Row{{`{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {
+      "query_cost": "1.10"
+    },
+    "table": {
+      "table_name": "xy",
+      "access_type": "const",
+      "possible_keys": [
+        "PRIMARY"
+      ],
+      "key": "PRIMARY",
+      "used_key_parts": [
+        "x"
+      ],
+      "key_length": "4",
+      "rows_examined_per_scan": 1,
+      "rows_produced_per_join": 1,
+      "filtered": "100.00",
+      "cost_info": {
+        "read_cost": "1.00",
+        "eval_cost": "0.10",
+        "prefix_cost": "1.10"
+      },
+      "used_columns": [
+        "x",
+        "y"
+      ]
+    }
+  }
+}`}},
 			},
 		},
 	},
 	{
-		Name: "delete from table with misordered pks",
+		Name: "Issue #499", // https://github.com/dolthub/go-mysql-server/issues/499
 		SetUpScript: []string{
-			"create table a (x int, y int, z int, primary key (z,x))",
-			"insert into a values (0,1,2), (3,4,5)",
+			"set time_zone = '+0:00';",
+			"CREATE TABLE test (time TIMESTAMP, value DOUBLE);",
+			`INSERT INTO test VALUES 
+			("2021-07-04 10:00:00", 1.0),
+			("2021-07-03 10:00:00", 2.0),
+			("2021-07-02 10:00:00", 3.0),
+			("2021-07-01 10:00:00", 4.0);`,
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query: "SELECT count(*) FROM a where x = 0",
+				// In the original, reported issue, the order by clause did not qualify the table name
+				// for `test.time`. When there is ambiguity between a column name and an expression
+				// alias name in the order by clause, MySQL choose the alias; however, if the reference
+				// is used in a function call, MySQL instead seems to resolve to the column name.
+				// Until we determine the exact rule for this behavior, we've qualified the reference
+				// in the order by clause to ensure it selects the table column and not the alias.
+				// TODO: Waiting to hear back from MySQL on whether this is intended behavior or not:
+				//       https://bugs.mysql.com/bug.php?id=109020
+				Query: `SELECT UNIX_TIMESTAMP(time) DIV 60 * 60 AS "time", avg(value) AS "value"
+				FROM test GROUP BY 1 ORDER BY UNIX_TIMESTAMP(test.time) DIV 60 * 60`,
 				Expected: []sql.Row{
-					{1},
+					{"1625133600", 4.0},
+					{"1625220000", 3.0},
+					{"1625306400", 2.0},
+					{"1625392800", 1.0},
 				},
 			},
-			{
-				Query:    "delete from a where x = 0",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
-			},
-			{
-				Query:    "SELECT * FROM a where x = 0",
-				Expected: []sql.Row{},
-			},
 		},
+		//todo(max): fix arithmatic on bindvar typing
+		SkipPrepared: true,
 	},
 	{
-		Name: "recreate primary key rebuilds secondary indexes",
+		Name: "WHERE clause considers ENUM/SET types for comparisons",
 		SetUpScript: []string{
-			"create table a (x int, y int, z int, primary key (x,y,z), index idx1 (y))",
-			"insert into a values (1,2,3), (4,5,6), (7,8,9)",
-			"alter table a drop primary key",
-			"alter table a add primary key (y,z,x)",
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 ENUM('a', 'b', 'c'), v2 SET('a', 'b', 'c'));",
+			"INSERT INTO test VALUES (1, 2, 2), (2, 1, 1);",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "delete from a where y = 2",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "SELECT * FROM test;",
+				Expected: []sql.Row{{1, uint16(2), uint64(2)}, {2, uint16(1), uint64(1)}},
 			},
 			{
-				Query:    "delete from a where y = 2",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "UPDATE test SET v1 = 3 WHERE v1 = 2;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 0, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
 			},
 			{
-				Query:    "select * from a where y = 2",
-				Expected: []sql.Row{},
+				Query:    "SELECT * FROM test;",
+				Expected: []sql.Row{{1, uint16(3), uint64(2)}, {2, uint16(1), uint64(1)}},
 			},
 			{
-				Query:    "select * from a where y = 5",
-				Expected: []sql.Row{{4, 5, 6}},
+				Query:    "UPDATE test SET v2 = 3 WHERE 2 = v2;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 0, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "SELECT * FROM test;",
+				Expected: []sql.Row{{1, uint16(3), uint64(3)}, {2, uint16(1), uint64(1)}},
 			},
 		},
 	},
 	{
-		Name: "Handle hex number to binary conversion",
+		Name: "Slightly more complex example for the Exists Clause",
 		SetUpScript: []string{
-			"CREATE TABLE hex_nums1 (pk BIGINT PRIMARY KEY, v1 INT, v2 BIGINT UNSIGNED, v3 DOUBLE, v4 BINARY(32));",
-			"INSERT INTO hex_nums1 values (1, 0x7ED0599B, 0x765a8ce4ce74b187, 0xF753AD20B0C4, 0x148aa875c3cdb9af8919493926a3d7c6862fec7f330152f400c0aecb4467508a);",
-			"CREATE TABLE hex_nums2 (pk BIGINT PRIMARY KEY, v1 VARBINARY(255), v2 BLOB);",
-			"INSERT INTO hex_nums2 values (1, 0x765a8ce4ce74b187, 0x148aa875c3cdb9af8919493926a3d7c6862fec7f330152f400c0aecb4467508a);",
+			"create table store(store_id int, item_id int, primary key (store_id, item_id))",
+			"create table items(item_id int primary key, price int)",
+			"insert into store values (0, 1), (0,2),(0,3),(1,2),(1,4),(2,1)",
+			"insert into items values (1, 10), (2, 20), (3, 30),(4,40)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT v1, v2, v3, hex(v4) FROM hex_nums1;",
-				Expected: []sql.Row{{2127583643, uint64(8528283758723641735), float64(271938758947012), "148AA875C3CDB9AF8919493926A3D7C6862FEC7F330152F400C0AECB4467508A"}},
-			},
-			{
-				Query:    "SELECT hex(v1), hex(v2), hex(v3), hex(v4) FROM hex_nums1;",
-				Expected: []sql.Row{{"7ED0599B", "765A8CE4CE74B187", "F753AD20B0C4", "148AA875C3CDB9AF8919493926A3D7C6862FEC7F330152F400C0AECB4467508A"}},
-			},
-			{
-				Query:    "SELECT hex(v1), hex(v2) FROM hex_nums2;",
-				Expected: []sql.Row{{"765A8CE4CE74B187", "148AA875C3CDB9AF8919493926A3D7C6862FEC7F330152F400C0AECB4467508A"}},
+				Query:    "SELECT * from store WHERE EXISTS (SELECT price from items where price > 10 and store.item_id = items.item_id)",
+				Expected: []sql.Row{{0, 2}, {0, 3}, {1, 2}, {1, 4}},
 			},
 		},
 	},
 	{
-		Name: "Multialter DDL with ADD/DROP Primary Key",
+		Name: "Simple Update Join test that manipulates two tables",
 		SetUpScript: []string{
-			"CREATE TABLE t(pk int primary key, v1 int)",
+			"CREATE TABLE test (pk int primary key);",
+			`CREATE TABLE test2 (pk int primary key, val int);`,
+			`INSERT into test values (0),(1),(2),(3)`,
+			`INSERT into test2 values (0, 0),(1, 1),(2, 2),(3, 3)`,
+			`CREATE TABLE test3(k int, val int, primary key (k, val))`,
+			`INSERT into test3 values (1,2),(1,3),(1,4)`,
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "ALTER TABLE t ADD COLUMN (v2 int), drop primary key, add primary key (v2)",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query: `update test2 inner join (select * from test3 order by val) as t3 on test2.pk = t3.k SET test2.val=t3.val`,
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{
+					Matched:  1,
+					Updated:  1,
+					Warnings: 0,
+				}}}},
 			},
 			{
-				Query: "DESCRIBE t",
+				Query: "SELECT val FROM test2 where pk = 1",
 				Expected: []sql.Row{
-					{"pk", "int", "NO", "", "NULL", ""},
-					{"v1", "int", "YES", "", "NULL", ""},
-					{"v2", "int", "NO", "PRI", "NULL", ""},
+					{2},
 				},
 			},
 			{
-				Query:       "ALTER TABLE t ADD COLUMN (v3 int), drop primary key, add primary key (notacolumn)",
-				ExpectedErr: sql.ErrKeyColumnDoesNotExist,
+				Query: `update test inner join test2 on test.pk = test2.pk SET test.pk=test.pk*10, test2.pk = test2.pk * 4 where test.pk < 10;`,
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 6, Info: plan.UpdateInfo{
+					Matched:  8,
+					Updated:  6,
+					Warnings: 0,
+				}}}},
 			},
 			{
-				Query: "DESCRIBE t",
+				Query: "SELECT * FROM test",
 				Expected: []sql.Row{
-					{"pk", "int", "NO", "", "NULL", ""},
-					{"v1", "int", "YES", "", "NULL", ""},
-					{"v2", "int", "NO", "PRI", "NULL", ""},
+					{0},
+					{10},
+					{20},
+					{30},
+				},
+			},
+			{
+				Query: "SELECT * FROM test2",
+				Expected: []sql.Row{
+					{0, 0},
+					{4, 2},
+					{8, 2},
+					{12, 3},
 				},
 			},
 		},
 	},
 	{
-		Name: "Multialter DDL with ADD/DROP INDEX",
+		Name: "Partial indexes are used and return the expected result",
 		SetUpScript: []string{
-			"CREATE TABLE t(pk int primary key, v1 int)",
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 BIGINT, v2 BIGINT, v3 BIGINT, INDEX vx (v3, v2, v1));",
+			"INSERT INTO test VALUES (1,2,3,4), (2,3,4,5), (3,4,5,6), (4,5,6,7), (5,6,7,8);",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:       "ALTER TABLE t DROP COLUMN v1, ADD INDEX myidx (v1)",
-				ExpectedErr: sql.ErrKeyColumnDoesNotExist,
+				Query:    "SELECT * FROM test WHERE v3 = 4;",
+				Expected: []sql.Row{{1, 2, 3, 4}},
 			},
 			{
-				Query: "DESCRIBE t",
-				Expected: []sql.Row{
-					{"pk", "int", "NO", "PRI", "NULL", ""},
-					{"v1", "int", "YES", "", "NULL", ""}, // should not be dropped
-				},
+				Query:    "SELECT * FROM test WHERE v3 = 8 AND v2 = 7;",
+				Expected: []sql.Row{{5, 6, 7, 8}},
 			},
 			{
-				Query:    "ALTER TABLE t ADD COLUMN (v2 int), ADD INDEX myidx (v2)",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "SELECT * FROM test WHERE v3 >= 6 AND v2 >= 6;",
+				Expected: []sql.Row{{4, 5, 6, 7}, {5, 6, 7, 8}},
 			},
 			{
-				Query: "DESCRIBE t",
-				Expected: []sql.Row{
-					{"pk", "int", "NO", "PRI", "NULL", ""},
-					{"v1", "int", "YES", "", "NULL", ""},
-					{"v2", "int", "YES", "MUL", "NULL", ""},
-				},
+				Query:    "SELECT * FROM test WHERE v3 = 7 AND v2 >= 6;",
+				Expected: []sql.Row{{4, 5, 6, 7}},
 			},
+		},
+	},
+	{
+		Name: "Multiple indexes on the same columns in a different order",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 BIGINT, v2 BIGINT, v3 BIGINT, INDEX v123 (v1, v2, v3), INDEX v321 (v3, v2, v1), INDEX v132 (v1, v3, v2));",
+			"INSERT INTO test VALUES (1,2,3,4), (2,3,4,5), (3,4,5,6), (4,5,6,7), (5,6,7,8);",
+		},
+		Assertions: []ScriptTestAssertion{
 			{
-				Query:       "ALTER TABLE t ADD COLUMN (v3 int), DROP INDEX notanindex",
-				ExpectedErr: sql.ErrCantDropFieldOrKey,
+				Query:    "SELECT * FROM test WHERE v1 = 2 AND v2 > 1;",
+				Expected: []sql.Row{{1, 2, 3, 4}},
 			},
 			{
-				Query: "DESCRIBE t",
-				Expected: []sql.Row{
-					{"pk", "int", "NO", "PRI", "NULL", ""},
-					{"v1", "int", "YES", "", "NULL", ""},
-					{"v2", "int", "YES", "MUL", "NULL", ""},
-				},
+				Query:    "SELECT * FROM test WHERE v2 = 4 AND v3 > 1;",
+				Expected: []sql.Row{{2, 3, 4, 5}},
 			},
 			{
-				Query:       "ALTER TABLE t ADD COLUMN (v4 int), ADD INDEX myidx (notacolumn)",
-				ExpectedErr: sql.ErrKeyColumnDoesNotExist,
+				Query:    "SELECT * FROM test WHERE v3 = 6 AND v1 > 1;",
+				Expected: []sql.Row{{3, 4, 5, 6}},
 			},
 			{
-				Query: "DESCRIBE t",
-				Expected: []sql.Row{
-					{"pk", "int", "NO", "PRI", "NULL", ""},
-					{"v1", "int", "YES", "", "NULL", ""},
-					{"v2", "int", "YES", "MUL", "NULL", ""},
-				},
+				Query:    "SELECT * FROM test WHERE v1 = 5 AND v3 <= 10 AND v2 >= 1;",
+				Expected: []sql.Row{{4, 5, 6, 7}},
 			},
+		},
+	},
+	{
+		Name: "Ensure proper DECIMAL support (found by fuzzer)",
+		SetUpScript: []string{
+			"CREATE TABLE `GzaKtwgIya` (`K7t5WY` DECIMAL(64,5), `qBjVrN` VARBINARY(1000), `PvqQtc` SET('c3q6y','kxMqhfkK','XlRI8','dF0N63H','hMPjt0KXRLwCGRr','27fi2s','1FSJ','NcPzIN','Za18lbIgxmZ','on4BKKXykVTbJ','WBfO','RMNG','Sd7','FDzbEO','cLRdLOj1y','syo4','Ul','jfsfDCx6s','yEW3','JyQcWFDl'), `1kv7el` FLOAT, `Y3vfRG` BLOB, `Ijq8CK` TINYTEXT, `tzeStN` MEDIUMINT, `Ak83FQ` BINARY(64), `8Nbp3L` DOUBLE, PRIMARY KEY (`K7t5WY`));",
+			"REPLACE INTO `GzaKtwgIya` VALUES ('58567047399981325523662211357420045483361289734772861386428.89028','bvo5~Tt8%kMW2nm2!8HghaeulI6!pMadE+j-J2LeU1O1*-#@Lm8Ibh00bTYiA*H1Q8P1_kQq 24Rrd4@HeF%#7#C#U7%mqOMrQ0%!HVrGV1li.XyYa:7#3V^DtAMDTQ9 cY=07T4|DStrwy4.MAQxOG#1d#fcq+7675$y0e96-2@8-WlQ^p|%E!a^TV!Yj2_eqZZys1z:883l5I%zAT:i56K^T!cx#us $60Tb#gH$1#$P.709E#VrH9FbQ5QZK2hZUH!qUa4Xl8*I*0fT~oAha$8jU5AoWs+Uv!~:14Yq%pLXpP9RlZ:Gd1g|*$Qa.9*^K~YlYWVaxwY~_g6zOMpU$YijT+!_*m3=||cMNn#uN0!!OyCg~GTQlJ11+#@Ohqc7b#2|Jp2Aei56GOmq^I=7cQ=sQh~V.D^HzwK5~4E$QzFXfWNVN5J_w2b4dkR~bB~7F%=@R@9qE~e:-_RnoJcOLfBS@0:*hTIP$5ui|5Ea-l+qU4nx98X6rV2bLBxn8am@p~:xLF#T^_9kJVN76q^18=i *FJo.v-xA2GP==^C^Jz3yBF0OY4bIxC59Y#6G=$w:xh71kMxBcYJKf3+$Ci_uWx0P*AfFNne0_1E0Lwv#3J8vm:. 8Wo~F3VT:@w.t@w .JZz$bok9Tls7RGo=~4 Y$~iELr$s@53YuTPM8oqu!x*1%GswpJR=0K#qs00nW-1MqEUc:0wZv#X4qY^pzVDb:!:!yDhjhh+KIT%2%w@+t8c!f~o!%EnwBIr_OyzL6e1$-R8n0nWPU.toODd*|fW3H$9ZLc9!dMS:QfjI0M$nK 8aGvUVP@9kS~W#Y=Q%=37$@pAUkDTXkJo~-DRvCG6phPp*Xji@9|AEODHi+-6p%X4YM5Y3WasPHcZQ8QgTwi9 N=2RQD_MtVU~0J~3SAx*HrMlKvCPTswZq#q_96ny_A@7g!E2jyaxWFJD:C233onBdchW$WdAc.LZdZHYDR^uwZb9B9p-q.BkD1I',608583,'-7.276514330627342e-28','FN3O_E:$ 5S40T7^Vu1g!Ktn^N|4RE!9GnZiW5dG:%SJb5|SNuuI.d2^qnMY.Xn*_fRfk Eo7OhqY8OZ~pA0^ !2P.uN~r@pZ2!A0+4b*%nxO.tm%S6=$CZ9+c1zu-p $b:7:fOkC%@E3951st@2Q93~8hj:ZGeJ6S@nw-TAG+^lad37aB#xN*rD^9TO0|hleA#.Nh28S2PB72L*TxD0$|XE3S5eVVmbI*pkzE~lPecopX1fUyFj#LC+%~pjmab7^ Kdd4B%8I!ohOCQV.oiw++N|#W2=D4:_sK0@~kTTeNA8_+FMKRwro.M0| LdKHf-McKm0Z-R9+H%!9r l6%7UEB50yNH-ld%eW8!f=LKgZLc*TuTP2DA_o0izvzZokNp3ShR+PA7Fk* 1RcSt5KXe+8tLc+WGP','3RvfN2N.Q1tIffE965#2r=u_-4!u:9w!F1p7+mSsO8ckio|ib 1t@~GtgUkJX',1858932,'DJMaQcI=vS-Jk2L#^2N8qZcRpMJ2Ga!30A+@I!+35d-9bwVEVi5-~i.a%!KdoF5h','1.0354401044541863e+255');",
+			"INSERT INTO `GzaKtwgIya` VALUES ('91198031969464085142628031466155813748261645250257051732159.65596','96Lu=focmodq4otVAUN6TD-F$@k^4443higo=KH!1WBDH9|vpEGdO* 1uF6yWjT4:7G|altXnWSv+d:c8Km8vL!b%-nuB8mAxO9E|a5N5#v@z!ij5ifeIEoZGXrhBJl.m*Rx-@%g~t:y$3Pp3Q7Bd3y$=YG%6yibqXWO9$SS+g=*6QzdSCzuR~@v!:.ATye0A@y~DG=uq!PaZd6wN7.2S Aq868-RN3RM61V#N+Qywqo=%iYV*554@h6GPKZ| pmNwQw=PywuyBhr*MHAOXV+u9_-#imKI-wT4gEcA1~lGg1cfL2IvhkwOXRhrjAx-8+R3#4!Ai J6SYP|YUuuGalJ_N8k_8K^~h!JyiH$0JbGQ4AOxO3-eW=BaopOd8FF1.cfFMK!tXR ^I15g:npOuZZO$Vq3yQ4bl4s$E9:t2^.4f.:I4_@u9_UI1ApBthJZNiv~o#*uhs9K@ufZ1YPJQY-pMj$v-lQ2#%=Uu!iEAO3%vQ^5YITKcWRk~$kd1H#F675r@P5#M%*F_xP3Js7$YuEC4YuQjZ A74tMw:KwQ8dR:k_ Sa85G~42-K3%:jk5G9csC@iW3nY|@-:_dg~5@J!FWF5F+nyBgz4fDpdkdk9^:_.t$A3W-C@^Ax.~o|Rq96_i%HeG*7jBjOGhY-e1k@aD@WW.@GmpGAI|T-84gZFG3BU9@#9lpL|U2YCEA.BEA%sxDZ Kw:n+d$Y!SZw0Iml$Bdtyr:02Np=DZpiI%$N9*U=%Jq#$P5BI60WOTK+UynVx9Dd**5q8y9^v+I|PPa#_2XheV5YQU.ONdQQNJxsiRaEl!*=xv4bTWj1wBH#_-eM3T',490529,'-8.419238802182018e+25','|WD!NpWJOfN+_Au 1y!|XF8l38#%%R5%$TRUEaFt%4ywKQ8 O1LD-3qRDrnHAXboH~0uivbo87f+V%=q9~Mvz1EIxsU!whSmPqtb9r*11346R_@L+H#@@Z9H-Dc6j%.D0o##m@B9o7jO#~N81ACI|f#J3z4dho:jc54Xws$8r%cxuov^1$w_58Fv2*.8qbAW$TF153A:8wwj4YIhkd#^Q7 |g7I0iQG0p+yE64rk!Pu!SA-z=ELtLNOCJBk_4!lV$izn%sB6JwM+uq~ 49I7','v|eUA_h2@%t~bn26ci8Ngjm@Lk*G=l2MhxhceV2V|ka#c',8150267,'nX-=1Q$3riw_jlukGuHmjodT_Y_SM$xRbEt$%$%hlIUF1+GpRp~U6JvRX^: k@n#','7.956726808353253e+267');",
+		},
+		Assertions: []ScriptTestAssertion{
 			{
-				Query:       "ALTER TABLE t ADD COLUMN (v4 int), ADD INDEX myidx2 (v4), DROP INDEX notanindex;",
-				ExpectedErr: sql.ErrCantDropFieldOrKey,
+				Query:    "DELETE FROM `GzaKtwgIya` WHERE `K7t5WY` = '58567047399981325523662211357420045483361289734772861386428.89028';",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query: "DESCRIBE t",
-				Expected: []sql.Row{
-					{"pk", "int", "NO", "PRI", "NULL", ""},
-					{"v1", "int", "YES", "", "NULL", ""},
-					{"v2", "int", "YES", "MUL", "NULL", ""},
-				},
+				Query:    "SELECT COUNT(*) FROM GzaKtwgIya",
+				Expected: []sql.Row{{1}},
 			},
+		},
+	},
+	{
+		Name: "Ensure scale is not rounded when inserting to DECIMAL type through float64",
+		SetUpScript: []string{
+			"create table test (number decimal(40,16));",
+			"insert into test values ('11981.5923291839784651');",
+			"create table small_test (n decimal(3,2));",
+		},
+		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "ALTER TABLE t ADD COLUMN (v4 int), ADD INDEX myidx2 (v4)",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "SELECT COUNT(*) FROM test WHERE number = CONVERT('11981.5923291839784651', DECIMAL)",
+				Expected: []sql.Row{{1}},
 			},
 			{
-				Query: "DESCRIBE t",
+				Query:    "INSERT INTO test VALUES (11981.5923291839784651);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM test WHERE number = CONVERT('11981.5923291839784651', DECIMAL)",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "INSERT INTO test VALUES (119815923291839784651.11981592329183978465111981592329183978465144);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM test WHERE number = CONVERT('119815923291839784651.1198159232918398', DECIMAL)",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "INSERT INTO test VALUES (1.1981592329183978465111981592329183978465111981592329183978465144);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM test WHERE number = CONVERT('1.1981592329183978', DECIMAL)",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "INSERT INTO test VALUES (1.1981592329183978545111981592329183978465111981592329183978465144);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM test WHERE number = CONVERT('1.1981592329183979', DECIMAL)",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:       "INSERT INTO small_test VALUES (12.1);",
+				ExpectedErr: types.ErrConvertToDecimalLimit,
+			},
+		},
+	},
+	{
+		Name: "JOIN on non-index-prefix columns do not panic (Dolt Issue #2366)",
+		SetUpScript: []string{
+			"CREATE TABLE `player_season_stat_totals` (`player_id` int NOT NULL, `team_id` int NOT NULL, `season_id` int NOT NULL, `minutes` int, `games_started` int, `games_played` int, `2pm` int, `2pa` int, `3pm` int, `3pa` int, `ftm` int, `fta` int, `ast` int, `stl` int, `blk` int, `tov` int, `pts` int, `orb` int, `drb` int, `trb` int, `pf` int, `season_type_id` int NOT NULL, `league_id` int NOT NULL DEFAULT 0, PRIMARY KEY (`player_id`,`team_id`,`season_id`,`season_type_id`,`league_id`));",
+			"CREATE TABLE `team_seasons` (`team_id` int NOT NULL, `league_id` int NOT NULL, `season_id` int NOT NULL, `prefix` varchar(100), `nickname` varchar(100), `abbreviation` varchar(100), `city` varchar(100), `state` varchar(100), `country` varchar(100), PRIMARY KEY (`team_id`,`league_id`,`season_id`));",
+			"INSERT INTO player_season_stat_totals VALUES (1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1);",
+			"INSERT INTO team_seasons VALUES (1,1,1,'','','','','','');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT stats.* FROM player_season_stat_totals stats LEFT JOIN team_seasons ON team_seasons.team_id = stats.team_id AND team_seasons.season_id = stats.season_id;",
+				Expected: []sql.Row{{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+			},
+		},
+	},
+	{
+		Name: "Issue #709",
+		SetUpScript: []string{
+			"create table a(id int primary key, v int , key (v));",
+		},
+	},
+	{
+		Name: "Show create table with various keys and constraints",
+		SetUpScript: []string{
+			"create table t1(a int primary key, b varchar(10) not null default 'abc')",
+			"alter table t1 add constraint ck1 check (b like '%abc%')",
+			"create index t1b on t1(b)",
+			"create table t2(c int primary key, d varchar(10))",
+			"alter table t2 add constraint t2du unique (d)",
+			"alter table t2 add constraint fk1 foreign key (d) references t1 (b)",
+			"create table t3 (a int, b varchar(100), c datetime, primary key (b,a))",
+			"create table t4 (a int default (floor(1)), b int default (coalesce(a, 10)))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show create table t1",
 				Expected: []sql.Row{
-					{"pk", "int", "NO", "PRI", "NULL", ""},
-					{"v1", "int", "YES", "", "NULL", ""},
-					{"v2", "int", "YES", "MUL", "NULL", ""},
-					{"v4", "int", "YES", "MUL", "NULL", ""},
+					{"t1", "CREATE TABLE `t1` (\n" +
+						"  `a` int NOT NULL,\n" +
+						"  `b` varchar(10) NOT NULL DEFAULT 'abc',\n" +
+						"  PRIMARY KEY (`a`),\n" +
+						"  KEY `t1b` (`b`),\n" +
+						"  CONSTRAINT `ck1` CHECK (`b` LIKE '%abc%')\n" +
+						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 				},
 			},
 			{
-				Query:    "ALTER TABLE t ADD COLUMN (v5 int), RENAME INDEX myidx2 TO myidx3",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query: "show create table t2",
+				Expected: []sql.Row{
+					{"t2", "CREATE TABLE `t2` (\n" +
+						"  `c` int NOT NULL,\n" +
+						"  `d` varchar(10),\n" +
+						"  PRIMARY KEY (`c`),\n" +
+						"  UNIQUE KEY `t2du` (`d`),\n" +
+						"  CONSTRAINT `fk1` FOREIGN KEY (`d`) REFERENCES `t1` (`b`)\n" +
+						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+				},
 			},
 			{
-				Query:    "ALTER TABLE t DROP INDEX myidx, ADD INDEX v5idx (v5)",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query: "show create table t3",
+				Expected: []sql.Row{
+					{"t3", "CREATE TABLE `t3` (\n" +
+						"  `a` int NOT NULL,\n" +
+						"  `b` varchar(100) NOT NULL,\n" +
+						"  `c` datetime,\n" +
+						"  PRIMARY KEY (`b`,`a`)\n" +
+						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+				},
 			},
 			{
-				Query: "DESCRIBE t",
+				Query: "show create table t4",
 				Expected: []sql.Row{
-					{"pk", "int", "NO", "PRI", "NULL", ""},
-					{"v1", "int", "YES", "", "NULL", ""},
-					{"v2", "int", "YES", "", "NULL", ""},
-					{"v4", "int", "YES", "MUL", "NULL", ""},
-					{"v5", "int", "YES", "MUL", "NULL", ""},
+					{"t4", "CREATE TABLE `t4` (\n" +
+						"  `a` int DEFAULT (floor(1)),\n" +
+						"  `b` int DEFAULT (coalesce(a,10))\n" +
+						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 				},
 			},
 		},
 	},
 	{
-		Name: "ALTER AUTO INCREMENT TABLE ADD column",
+		Name: "table with defaults, insert with on duplicate key update",
 		SetUpScript: []string{
-			"CREATE TABLE test (pk int primary key, uk int UNIQUE KEY auto_increment);",
+			"create table t (a int primary key, b int default 100);",
+			"insert into t values (1, 1), (2, 2)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "alter table test add column j int;",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "insert into t values (1, 10) on duplicate key update b = 10",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
 			},
 		},
 	},
 	{
-		Name: "alter json column default; from scorewarrior: https://github.com/dolthub/dolt/issues/4543",
+		Name: "delete from table with misordered pks",
 		SetUpScript: []string{
-			"CREATE TABLE test (i int default 999, j json);",
+			"create table a (x int, y int, z int, primary key (z,x))",
+			"insert into a values (0,1,2), (3,4,5)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "alter table test alter column j set default ('[]');",
-				Expected: []sql.Row{},
-			},
-			{
-				Query: "show create table test",
+				Query: "SELECT count(*) FROM a where x = 0",
 				Expected: []sql.Row{
-					{"test", "CREATE TABLE `test` (\n  `i` int DEFAULT '999',\n  `j` json DEFAULT ('[]')\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+					{1},
 				},
 			},
+			{
+				Query:    "delete from a where x = 0",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM a where x = 0",
+				Expected: []sql.Row{},
+			},
 		},
 	},
 	{
-		Name: "ALTER TABLE MULTI ADD/DROP COLUMN",
+		Name: "recreate primary key rebuilds secondary indexes",
 		SetUpScript: []string{
-			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 BIGINT NOT NULL DEFAULT 88);",
+			"create table a (x int, y int, z int, primary key (x,y,z), index idx1 (y))",
+			"insert into a values (1,2,3), (4,5,6), (7,8,9)",
+			"alter table a drop primary key",
+			"alter table a add primary key (y,z,x)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "INSERT INTO test (pk) VALUES (1);",
+				Query:    "delete from a where y = 2",
 				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query:    "ALTER TABLE test DROP COLUMN v1, ADD COLUMN v2 INT NOT NULL DEFAULT 100",
+				Query:    "delete from a where y = 2",
 				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query: "describe test",
-				Expected: []sql.Row{
-					{"pk", "bigint", "NO", "PRI", "NULL", ""},
-					{"v2", "int", "NO", "", "100", ""},
-				},
+				Query:    "select * from a where y = 2",
+				Expected: []sql.Row{},
 			},
 			{
-				Query:    "ALTER TABLE TEST MODIFY COLUMN pk BIGINT AUTO_INCREMENT, AUTO_INCREMENT = 100",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "select * from a where y = 5",
+				Expected: []sql.Row{{4, 5, 6}},
 			},
+		},
+	},
+	{
+		Name: "Handle hex number to binary conversion",
+		SetUpScript: []string{
+			"CREATE TABLE hex_nums1 (pk BIGINT PRIMARY KEY, v1 INT, v2 BIGINT UNSIGNED, v3 DOUBLE, v4 BINARY(32));",
+			"INSERT INTO hex_nums1 values (1, 0x7ED0599B, 0x765a8ce4ce74b187, 0xF753AD20B0C4, 0x148aa875c3cdb9af8919493926a3d7c6862fec7f330152f400c0aecb4467508a);",
+			"CREATE TABLE hex_nums2 (pk BIGINT PRIMARY KEY, v1 VARBINARY(255), v2 BLOB);",
+			"INSERT INTO hex_nums2 values (1, 0x765a8ce4ce74b187, 0x148aa875c3cdb9af8919493926a3d7c6862fec7f330152f400c0aecb4467508a);",
+		},
+		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "INSERT INTO test (v2) values (11)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 100}}},
+				Query:    "SELECT v1, v2, v3, hex(v4) FROM hex_nums1;",
+				Expected: []sql.Row{{2127583643, uint64(8528283758723641735), float64(271938758947012), "148AA875C3CDB9AF8919493926A3D7C6862FEC7F330152F400C0AECB4467508A"}},
 			},
 			{
-				Query:    "SELECT * from test where pk = 100",
-				Expected: []sql.Row{{100, 11}},
+				Query:    "SELECT hex(v1), hex(v2), hex(v3), hex(v4) FROM hex_nums1;",
+				Expected: []sql.Row{{"7ED0599B", "765A8CE4CE74B187", "F753AD20B0C4", "148AA875C3CDB9AF8919493926A3D7C6862FEC7F330152F400C0AECB4467508A"}},
 			},
 			{
-				Query:       "ALTER TABLE test DROP COLUMN v2, ADD COLUMN v3 int NOT NULL after v2",
-				ExpectedErr: sql.ErrTableColumnNotFound,
+				Query:    "SELECT hex(v1), hex(v2) FROM hex_nums2;",
+				Expected: []sql.Row{{"765A8CE4CE74B187", "148AA875C3CDB9AF8919493926A3D7C6862FEC7F330152F400C0AECB4467508A"}},
 			},
-			{
-				Query: "describe test",
+		},
+	},
+	{
+		Name: "Multialter DDL with ADD/DROP Primary Key",
+		SetUpScript: []string{
+			"CREATE TABLE t(pk int primary key, v1 int)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "ALTER TABLE t ADD COLUMN (v2 int), drop primary key, add primary key (v2)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "DESCRIBE t",
+				Expected: []sql.Row{
+					{"pk", "int", "NO", "", "NULL", ""},
+					{"v1", "int", "YES", "", "NULL", ""},
+					{"v2", "int", "NO", "PRI", "NULL", ""},
+				},
+			},
+			{
+				Query:       "ALTER TABLE t ADD COLUMN (v3 int), drop primary key, add primary key (notacolumn)",
+				ExpectedErr: sql.ErrKeyColumnDoesNotExist,
+			},
+			{
+				Query: "DESCRIBE t",
+				Expected: []sql.Row{
+					{"pk", "int", "NO", "", "NULL", ""},
+					{"v1", "int", "YES", "", "NULL", ""},
+					{"v2", "int", "NO", "PRI", "NULL", ""},
+				},
+			},
+		},
+	},
+	{
+		Name: "Multialter DDL with ADD/DROP INDEX",
+		SetUpScript: []string{
+			"CREATE TABLE t(pk int primary key, v1 int)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "ALTER TABLE t DROP COLUMN v1, ADD INDEX myidx (v1)",
+				ExpectedErr: sql.ErrKeyColumnDoesNotExist,
+			},
+			{
+				Query: "DESCRIBE t",
+				Expected: []sql.Row{
+					{"pk", "int", "NO", "PRI", "NULL", ""},
+					{"v1", "int", "YES", "", "NULL", ""}, // should not be dropped
+				},
+			},
+			{
+				Query:    "ALTER TABLE t ADD COLUMN (v2 int), ADD INDEX myidx (v2)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "DESCRIBE t",
+				Expected: []sql.Row{
+					{"pk", "int", "NO", "PRI", "NULL", ""},
+					{"v1", "int", "YES", "", "NULL", ""},
+					{"v2", "int", "YES", "MUL", "NULL", ""},
+				},
+			},
+			{
+				Query:       "ALTER TABLE t ADD COLUMN (v3 int), DROP INDEX notanindex",
+				ExpectedErr: sql.ErrCantDropFieldOrKey,
+			},
+			{
+				Query: "DESCRIBE t",
+				Expected: []sql.Row{
+					{"pk", "int", "NO", "PRI", "NULL", ""},
+					{"v1", "int", "YES", "", "NULL", ""},
+					{"v2", "int", "YES", "MUL", "NULL", ""},
+				},
+			},
+			{
+				Query:       "ALTER TABLE t ADD COLUMN (v4 int), ADD INDEX myidx (notacolumn)",
+				ExpectedErr: sql.ErrKeyColumnDoesNotExist,
+			},
+			{
+				Query: "DESCRIBE t",
+				Expected: []sql.Row{
+					{"pk", "int", "NO", "PRI", "NULL", ""},
+					{"v1", "int", "YES", "", "NULL", ""},
+					{"v2", "int", "YES", "MUL", "NULL", ""},
+				},
+			},
+			{
+				Query:       "ALTER TABLE t ADD COLUMN (v4 int), ADD INDEX myidx2 (v4), DROP INDEX notanindex;",
+				ExpectedErr: sql.ErrCantDropFieldOrKey,
+			},
+			{
+				Query: "DESCRIBE t",
+				Expected: []sql.Row{
+					{"pk", "int", "NO", "PRI", "NULL", ""},
+					{"v1", "int", "YES", "", "NULL", ""},
+					{"v2", "int", "YES", "MUL", "NULL", ""},
+				},
+			},
+			{
+				Query:    "ALTER TABLE t ADD COLUMN (v4 int), ADD INDEX myidx2 (v4)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "DESCRIBE t",
+				Expected: []sql.Row{
+					{"pk", "int", "NO", "PRI", "NULL", ""},
+					{"v1", "int", "YES", "", "NULL", ""},
+					{"v2", "int", "YES", "MUL", "NULL", ""},
+					{"v4", "int", "YES", "MUL", "NULL", ""},
+				},
+			},
+			{
+				Query:    "ALTER TABLE t ADD COLUMN (v5 int), RENAME INDEX myidx2 TO myidx3",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "ALTER TABLE t DROP INDEX myidx, ADD INDEX v5idx (v5)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "DESCRIBE t",
+				Expected: []sql.Row{
+					{"pk", "int", "NO", "PRI", "NULL", ""},
+					{"v1", "int", "YES", "", "NULL", ""},
+					{"v2", "int", "YES", "", "NULL", ""},
+					{"v4", "int", "YES", "MUL", "NULL", ""},
+					{"v5", "int", "YES", "MUL", "NULL", ""},
+				},
+			},
+		},
+	},
+	{
+		Name: "ALTER AUTO INCREMENT TABLE ADD column",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk int primary key, uk int UNIQUE KEY auto_increment);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table test add column j int;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
+	{
+		Name: "alter json column default; from scorewarrior: https://github.com/dolthub/dolt/issues/4543",
+		SetUpScript: []string{
+			"CREATE TABLE test (i int default 999, j json);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "alter table test alter column j set default ('[]');",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "show create table test",
+				Expected: []sql.Row{
+					{"test", "CREATE TABLE `test` (\n  `i` int DEFAULT '999',\n  `j` json DEFAULT ('[]')\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+				},
+			},
+		},
+	},
+	{
+		Name: "ALTER TABLE MULTI ADD/DROP COLUMN",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 BIGINT NOT NULL DEFAULT 88);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO test (pk) VALUES (1);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "ALTER TABLE test DROP COLUMN v1, ADD COLUMN v2 INT NOT NULL DEFAULT 100",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "describe test",
+				Expected: []sql.Row{
+					{"pk", "bigint", "NO", "PRI", "NULL", ""},
+					{"v2", "int", "NO", "", "100", ""},
+				},
+			},
+			{
+				Query:    "ALTER TABLE TEST MODIFY COLUMN pk BIGINT AUTO_INCREMENT, AUTO_INCREMENT = 100",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "INSERT INTO test (v2) values (11)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 100}}},
+			},
+			{
+				Query:    "SELECT * from test where pk = 100",
+				Expected: []sql.Row{{100, 11}},
+			},
+			{
+				Query:       "ALTER TABLE test DROP COLUMN v2, ADD COLUMN v3 int NOT NULL after v2",
+				ExpectedErr: sql.ErrTableColumnNotFound,
+			},
+			{
+				Query: "describe test",
+				Expected: []sql.Row{
+					{"pk", "bigint", "NO", "PRI", "NULL", "auto_increment"},
+					{"v2", "int", "NO", "", "100", ""},
+				},
+			},
+			{
+				Query:       "ALTER TABLE test DROP COLUMN v2, RENAME COLUMN v2 to v3",
+				ExpectedErr: sql.ErrTableColumnNotFound,
+			},
+			{
+				Query: "describe test",
+				Expected: []sql.Row{
+					{"pk", "bigint", "NO", "PRI", "NULL", "auto_increment"},
+					{"v2", "int", "NO", "", "100", ""},
+				},
+			},
+			{
+				Query:       "ALTER TABLE test RENAME COLUMN v2 to v3, DROP COLUMN v2",
+				ExpectedErr: sql.ErrTableColumnNotFound,
+			},
+			{
+				Query: "describe test",
 				Expected: []sql.Row{
 					{"pk", "bigint", "NO", "PRI", "NULL", "auto_increment"},
 					{"v2", "int", "NO", "", "100", ""},
 				},
 			},
 			{
-				Query:       "ALTER TABLE test DROP COLUMN v2, RENAME COLUMN v2 to v3",
-				ExpectedErr: sql.ErrTableColumnNotFound,
+				Query:    "ALTER TABLE test ADD COLUMN (v3 int NOT NULL), add column (v4 int), drop column v2, add column (v5 int NOT NULL)",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "DESCRIBE test",
+				Expected: []sql.Row{
+					{"pk", "bigint", "NO", "PRI", "NULL", "auto_increment"},
+					{"v3", "int", "NO", "", "NULL", ""},
+					{"v4", "int", "YES", "", "NULL", ""},
+					{"v5", "int", "NO", "", "NULL", ""},
+				},
+			},
+			{
+				Query:    "ALTER TABLE test ADD COLUMN (v6 int not null), RENAME COLUMN v5 TO mycol, DROP COLUMN v4, ADD COLUMN (v7 int);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "describe test",
+				Expected: []sql.Row{
+					{"pk", "bigint", "NO", "PRI", "NULL", "auto_increment"},
+					{"v3", "int", "NO", "", "NULL", ""},
+					{"mycol", "int", "NO", "", "NULL", ""},
+					{"v6", "int", "NO", "", "NULL", ""},
+					{"v7", "int", "YES", "", "NULL", ""},
+				},
+			},
+			// TODO: Does not include tests with column renames and defaults.
+		},
+	},
+	{
+		// https://github.com/dolthub/dolt/issues/3065
+		Name: "join index lookups do not handle filters",
+		SetUpScript: []string{
+			"create table a (x int primary key)",
+			"create table b (y int primary key, x int, index idx_x(x))",
+			"create table c (z int primary key, x int, y int, index idx_x(x))",
+			"insert into a values (0),(1),(2),(3)",
+			"insert into b values (0,1), (1,1), (2,2), (3,2)",
+			"insert into c values (0,1,0), (1,1,0), (2,2,1), (3,2,1)",
+		},
+		Query: "select a.* from a join b on a.x = b.x join c where c.x = a.x and b.x = 1",
+		Expected: []sql.Row{
+			{1},
+			{1},
+			{1},
+			{1},
+		},
+	},
+	{
+		Name: "failed conversion shows warning",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:                           "SELECT CONVERT('10000-12-31 23:59:59', DATETIME)",
+				ExpectedWarning:                 1292,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Incorrect datetime value: 10000-12-31 23:59:59",
+				SkipResultsCheck:                true,
+			},
+			{
+				Query:                           "SELECT CONVERT('this is not a datetime', DATETIME)",
+				ExpectedWarning:                 1292,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Incorrect datetime value: this is not a datetime",
+				SkipResultsCheck:                true,
+			},
+			{
+				Query:                           "SELECT CAST('this is not a datetime' as DATETIME)",
+				ExpectedWarning:                 1292,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Incorrect datetime value: this is not a datetime",
+				SkipResultsCheck:                true,
+			},
+			{
+				Query:                           "SELECT CONVERT('this is not a date', DATE)",
+				ExpectedWarning:                 1292,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Incorrect date value: this is not a date",
+				SkipResultsCheck:                true,
+			},
+			{
+				Query:                           "SELECT CAST('this is not a date' as DATE)",
+				ExpectedWarning:                 1292,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Incorrect date value: this is not a date",
+				SkipResultsCheck:                true,
+			},
+		},
+	},
+	{
+		Name: "Describe with expressions and views work correctly",
+		SetUpScript: []string{
+			"CREATE TABLE t(pk int primary key, val int DEFAULT (pk * 2))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "DESCRIBE t",
+				Expected: []sql.Row{
+					{"pk", "int", "NO", "PRI", "NULL", ""},
+					{"val", "int", "YES", "", "((pk * 2))", "DEFAULT_GENERATED"}, // TODO: MySQL would return (`pk` * 2)
+				},
+			},
+		},
+	},
+	{
+		Name: "Check support for deprecated BINARY attribute after character set",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 VARCHAR(255) COLLATE utf8mb4_0900_bin);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SHOW CREATE TABLE test;",
+				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255),\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "ALTER TABLE test CHANGE v1 v2 VARCHAR(255) CHARACTER SET utf8mb4 BINARY NOT NULL;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE test;",
+				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v2` varchar(255) COLLATE utf8mb4_bin NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "CREATE TABLE test2 (pk BIGINT PRIMARY KEY, v1 VARCHAR(255) CHARACTER SET utf8mb4 BINARY);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE test2;",
+				Expected: []sql.Row{{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) COLLATE utf8mb4_bin,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+		},
+	},
+	{
+		Name: "sum() and avg() on DECIMAL type column returns the DECIMAL type result",
+		SetUpScript: []string{
+			"create table decimal_table (id int, val decimal(18,16));",
+			"insert into decimal_table values (1,-2.5633000000000384);",
+			"insert into decimal_table values (2,2.5633000000000370);",
+			"insert into decimal_table values (3,0.0000000000000004);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT val FROM decimal_table;",
+				Expected: []sql.Row{{"-2.5633000000000384"}, {"2.5633000000000370"}, {"0.0000000000000004"}},
+			},
+			{
+				Query:    "SELECT sum(val) FROM decimal_table;",
+				Expected: []sql.Row{{"-0.0000000000000010"}},
+			},
+			{
+				Query:    "SELECT avg(val) FROM decimal_table;",
+				Expected: []sql.Row{{"-0.00000000000000033333"}},
+			},
+		},
+	},
+	{
+		Name: "sum() and avg() on non-DECIMAL type column returns the DOUBLE type result",
+		SetUpScript: []string{
+			"create table float_table (id int, val1 double, val2 float);",
+			"insert into float_table values (1,-2.5633000000000384, 2.3);",
+			"insert into float_table values (2,2.5633000000000370, 2.4);",
+			"insert into float_table values (3,0.0000000000000004, 5.3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT sum(id), sum(val1), sum(val2) FROM float_table ORDER BY id;",
+				Expected: []sql.Row{{float64(6), -9.322676295501879e-16, 10.000000238418579}},
+			},
+			{
+				Query:    "SELECT avg(id), avg(val1), avg(val2) FROM float_table ORDER BY id;;",
+				Expected: []sql.Row{{float64(2), -3.107558765167293e-16, 3.333333412806193}},
+			},
+		},
+	},
+	{
+		Name: "compare DECIMAL type columns with different precision and scale",
+		SetUpScript: []string{
+			"create table t (id int primary key, val1 decimal(2, 1), val2 decimal(3, 1));",
+			"insert into t values (1, 1.2, 1.1), (2, 1.2, 10.1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select if(val1 < val2, 'YES', 'NO') from t order by id;",
+				Expected: []sql.Row{{"NO"}, {"YES"}},
+			},
+		},
+	},
+	{
+		Name: "basic test on tables dual and `dual`",
+		SetUpScript: []string{
+			"CREATE TABLE `dual` (id int)",
+			"INSERT INTO `dual` VALUES (2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * from `dual`;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT 3 from dual;",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:       "SELECT * from dual;",
+				ExpectedErr: sql.ErrNoTablesUsed,
+			},
+		},
+	},
+	{
+		Name: "having clause without groupby clause, all rows implicitly form a single aggregate group",
+		SetUpScript: []string{
+			"create table numbers (val int);",
+			"insert into numbers values (1), (2), (3);",
+			"insert into numbers values (2), (4);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select val from numbers;",
+				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+			},
+			{
+				Query:    "select val as a from numbers having a = val;",
+				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+			},
+			{
+				Query:    "select val as a from numbers group by val having a = val;",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "select val as a from numbers as t1 group by t1.val having a = t1.val;",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "select t1.val as a from numbers as t1 group by 1 having a = t1.val;",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "select t1.val as a from numbers as t1 having a = t1.val;",
+				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+			},
+			{
+				Query:    "select count(*) from numbers having count(*) = 5;",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				// MySQL returns `Unknown column 'val' in 'having clause'` error for this query,
+				// but GMS builds GroupBy for any aggregate function.
+				Skip:  true,
+				Query: "select count(*) from numbers having count(*) > val;",
+				//ExpectedErrStr:   "found HAVING clause with no GROUP BY", // not the exact error we want
+			},
+			{
+				Query:    "select count(*) from numbers group by val having count(*) < val;",
+				Expected: []sql.Row{{1}, {1}},
+			},
+		},
+	},
+	{
+		Name: "using having and group by clauses in subquery ",
+		SetUpScript: []string{
+			"CREATE TABLE t (i int, t varchar(2));",
+			"insert into t values (1, 'a'), (1, 'a2'), (2, 'b'), (3, 'c'), (3, 'c2'), (4, 'd'), (5, 'e'), (5, 'e2');", //, (6, 'f'), (7, 'g'), (7, 'g2')
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select i from t group by i having count(1) = 1 order by i asc",
+				Expected: []sql.Row{{2}, {4}},
+			},
+			{
+				Query:    "select i from t group by i having count(1) != 1 order by i asc",
+				Expected: []sql.Row{{1}, {3}, {5}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t group by i having count(1) = 1) order by i, t asc;",
+				Expected: []sql.Row{{2, "b"}, {4, "d"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t group by i having count(1) != 1) order by i, t asc;",
+				Expected: []sql.Row{{1, "a"}, {1, "a2"}, {3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t where i = 2 group by i having count(1) = 1) order by i, t asc;",
+				Expected: []sql.Row{{2, "b"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t where i = 3 group by i having count(1) != 1) order by i, t asc;",
+				Expected: []sql.Row{{3, "c"}, {3, "c2"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1) order by i, t asc;",
+				Expected: []sql.Row{{3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1 order by i desc) order by i, t asc;",
+				Expected: []sql.Row{{3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+			},
+			{
+				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1) order by i desc, t asc;",
+				Expected: []sql.Row{{5, "e"}, {5, "e2"}, {3, "c"}, {3, "c2"}},
+			},
+		},
+	},
+	{
+		Name: "can't create view with same name as existing table",
+		SetUpScript: []string{
+			"create table t (i int);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "create view t as select 1 from dual",
+				ExpectedErr: sql.ErrTableAlreadyExists,
+			},
+		},
+	},
+	{
+		Name: "can't create table with same name as existing view",
+		SetUpScript: []string{
+			"create view t as select 1 from dual",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "create table t (i int);",
+				ExpectedErr: sql.ErrTableAlreadyExists,
+			},
+		},
+	},
+	{
+		Name: "'/' division operation result in decimal or float",
+		SetUpScript: []string{
+			"create table floats (f float);",
+			"insert into floats values (1.1), (1.2), (1.3);",
+			"create table decimals (d decimal(2,1));",
+			"insert into decimals values (1.0), (2.0), (2.5);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select f/2 from floats;",
+				Expected: []sql.Row{{0.550000011920929}, {0.6000000238418579}, {0.6499999761581421}},
+			},
+			{
+				Query:    "select 2/f from floats;",
+				Expected: []sql.Row{{1.8181817787737895}, {1.6666666004392863}, {1.5384615948919735}},
+			},
+			{
+				Query:    "select d/2 from decimals;",
+				Expected: []sql.Row{{"0.50000"}, {"1.00000"}, {"1.25000"}},
+			},
+			{
+				Query:    "select 2/d from decimals;",
+				Expected: []sql.Row{{"2.0000"}, {"1.0000"}, {"0.8000"}},
+			},
+			{
+				Query: "select f/d from floats, decimals;",
+				Expected: []sql.Row{{1.2999999523162842}, {1.2000000476837158}, {1.100000023841858},
+					{0.6499999761581421}, {0.6000000238418579}, {0.550000011920929},
+					{0.5199999809265137}, {0.48000001907348633}, {0.4400000095367432}},
+			},
+			{
+				Query: "select d/f from floats, decimals;",
+				Expected: []sql.Row{{0.7692307974459868}, {0.8333333002196431}, {0.9090908893868948},
+					{1.5384615948919735}, {1.6666666004392863}, {1.8181817787737895},
+					{1.9230769936149668}, {2.083333250549108}, {2.272727223467237}},
+			},
+			{
+				Query:    `select f/'a' from floats;`,
+				Expected: []sql.Row{{nil}, {nil}, {nil}},
+			},
+		},
+	},
+	{
+		Name: "'%' mod operation result in decimal or float",
+		SetUpScript: []string{
+			"create table a (pk int primary key, c1 int, c2 double, c3 decimal(5,3));",
+			"insert into a values (1, 1, 1.111, 1.111), (2, 2, 2.111, 2.111);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select c1 % 2, c2 % 2, c3 % 2 from a;",
+				Expected: []sql.Row{{"1", 1.111, "1.111"}, {"0", 0.11100000000000021, "0.111"}},
+			},
+			{
+				Query:    "select c1 % 0.5, c2 % 0.5, c3 % 0.5 from a;",
+				Expected: []sql.Row{{"0.0", 0.11099999999999999, "0.111"}, {"0.0", 0.11100000000000021, "0.111"}},
+			},
+			{
+				Query:    "select 20 % c1, 20 % c2, 20 % c3 from a;",
+				Expected: []sql.Row{{"0", 0.002000000000000224, "0.002"}, {"0", 1.0009999999999981, "1.001"}},
+			},
+		},
+	},
+	{
+		Name: "arithmetic bit operations on int, float and decimal types",
+		SetUpScript: []string{
+			"CREATE TABLE num_types (pk int primary key, a int, b float, c decimal(5,3));",
+			"insert into num_types values (1,1,1.1,1.1), (2,2,1.2,2.2), (3,3,1.6,3.7), (4,4,1.7,4.0);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select a & 2.4, a | 2.4, a ^ 2.4 from num_types;",
+				Expected: []sql.Row{
+					{uint64(0), uint64(3), uint64(3)},
+					{uint64(2), uint64(2), uint64(0)},
+					{uint64(2), uint64(3), uint64(1)},
+					{uint64(0), uint64(6), uint64(6)},
+				},
+			},
+			{
+				Query: "select b & 2.4, b | 2.4, b ^ 2.4 from num_types;",
+				Expected: []sql.Row{
+					{uint64(0), uint64(3), uint64(3)},
+					{uint64(0), uint64(3), uint64(3)},
+					{uint64(2), uint64(2), uint64(0)},
+					{uint64(2), uint64(2), uint64(0)},
+				},
+			},
+			{
+				Query: "select c & 2.4, c | 2.4, c ^ 2.4 from num_types;",
+				Expected: []sql.Row{
+					{uint64(0), uint64(3), uint64(3)},
+					{uint64(2), uint64(2), uint64(0)},
+					{uint64(0), uint64(6), uint64(6)},
+					{uint64(0), uint64(6), uint64(6)},
+				},
+			},
+		},
+	},
+	{
+		Name: "year type behavior",
+		SetUpScript: []string{
+			"create table t (pk int primary key, col1 year);",
+		},
+		Assertions: []ScriptTestAssertion{
+			// 1901 - 2155 are interpreted as 1901 - 2155
+			{
+				Query:    "INSERT INTO t VALUES (1, '1901'), (2, 1901);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (3, '2000'), (4, 2000);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (5, '2155'), (6, 2155);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			// 1 - 69 are interpreted as 2001 - 2069
+			{
+				Query:    "INSERT INTO t VALUES (7, '1'), (8, 1);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (9, '35'), (10, 35);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (11, '69'), (12, 69);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			// 70 - 99 are interpreted as 1970 - 1999
+			{
+				Query:    "INSERT INTO t VALUES (13, '70'), (14, 70);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (15, '85'), (16, 85);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (17, '99'), (18, 99);",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			// '0', and '00' are interpreted as 2000
+			{
+				Query:    "INSERT INTO t VALUES (19, '0'), (20, '00');",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			// 0 is interpreted as 0000
+			{
+				Query:    "INSERT INTO t VALUES (21, 0)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			// Assert that returned values are correct.
+			{
+				Query: "SELECT * from t order by pk;",
+				Expected: []sql.Row{
+					{1, int16(1901)},
+					{2, int16(1901)},
+					{3, int16(2000)},
+					{4, int16(2000)},
+					{5, int16(2155)},
+					{6, int16(2155)},
+					{7, int16(2001)},
+					{8, int16(2001)},
+					{9, int16(2035)},
+					{10, int16(2035)},
+					{11, int16(2069)},
+					{12, int16(2069)},
+					{13, int16(1970)},
+					{14, int16(1970)},
+					{15, int16(1985)},
+					{16, int16(1985)},
+					{17, int16(1999)},
+					{18, int16(1999)},
+					{19, int16(2000)},
+					{20, int16(2000)},
+					{21, int16(0)},
+				},
+			},
+		},
+	},
+	{
+		Name: "INSERT IGNORE correctly truncates column data",
+		SetUpScript: []string{
+			`
+			CREATE TABLE t (
+				pk int primary key,
+				col1 boolean,
+				col2 integer,
+				col3 tinyint,
+				col4 smallint,
+				col5 mediumint,
+				col6 int,
+				col7 bigint,
+				col8 decimal,
+				col9 float,
+				col10 double,
+				col11 date,
+				col12 time,
+				col13 datetime,
+				col14 timestamp,
+				col15 year,
+				col16 ENUM('first', 'second'),
+				col17 SET('a', 'b')
+			);
+			`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: `
+					INSERT IGNORE INTO t VALUES (
+						1, 'val1', 'val2', 'val3', 'val4', 'val5', 'val6', 'val7', 'val8', 'val9', 'val10',
+						'val11', 'val12', 'val13', 'val14', 'val15', 'val16', 'val17'
+					);
+				`,
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query: "SELECT * from t",
+				Expected: []sql.Row{
+					{
+						1,
+						0,
+						0,
+						0,
+						0,
+						0,
+						0,
+						0,
+						"0",
+						float64(0),
+						float64(0),
+						time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
+						types.Timespan(0),
+						time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
+						time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
+						0,
+						uint64(1), // 'first' value in enum
+						uint64(0), // empty set
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "INSERT IGNORE throws an error when json is badly formatted",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, col1 json);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT IGNORE into t VALUES (1, 'val1');",
+				ExpectedErr: sql.ErrInvalidJson,
+			},
+		},
+	},
+	{
+		Name: "hash lookup for joins works with binary",
+		SetUpScript: []string{
+			"create table uv (u int primary key, v int);",
+			"create table xy (x int primary key, y int);",
+			"insert into uv values (0,0), (1,1), (2,2);",
+			"insert into xy values (0,0), (1,1), (2,2);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select uv.u from uv join xy on binary xy.x = binary uv.u;",
+				Expected: []sql.Row{
+					{0},
+					{1},
+					{2},
+				},
+			},
+		},
+	},
+	{
+		Name: "enum columns work as expected in when clauses",
+		SetUpScript: []string{
+			"create table enums (e enum('a'));",
+			"insert into enums values ('a');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select (case e when 'a' then 42 end) from enums",
+				Expected: []sql.Row{{42}},
+			},
+			{
+				Query:    "select (case 'a' when e then 42 end) from enums",
+				Expected: []sql.Row{{42}},
+			},
+		},
+	},
+	{
+		Name: "SET and ENUM properly handle integers using UPDATE and DELETE statements",
+		SetUpScript: []string{
+			"CREATE TABLE setenumtest (pk INT PRIMARY KEY, v1 ENUM('a', 'b', 'c'), v2 SET('a', 'b', 'c'));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO setenumtest VALUES (1, 1, 1), (2, 1, 1), (3, 3, 1), (4, 1, 3);",
+				Expected: []sql.Row{{types.NewOkResult(4)}},
+			},
+			{
+				Query: "UPDATE setenumtest SET v1 = 2, v2 = 2 WHERE pk = 2;",
+				Expected: []sql.Row{{types.OkResult{
+					RowsAffected: 1,
+					Info: plan.UpdateInfo{
+						Matched:  1,
+						Updated:  1,
+						Warnings: 0,
+					},
+				}}},
+			},
+			{
+				Query: "SELECT * FROM setenumtest ORDER BY pk;",
+				Expected: []sql.Row{
+					{1, uint16(1), uint64(1)},
+					{2, uint16(2), uint64(2)},
+					{3, uint16(3), uint64(1)},
+					{4, uint16(1), uint64(3)},
+				},
+			},
+			{
+				Query:    "DELETE FROM setenumtest WHERE v1 = 3;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "DELETE FROM setenumtest WHERE v2 = 3;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query: "SELECT * FROM setenumtest ORDER BY pk;",
+				Expected: []sql.Row{
+					{1, uint16(1), uint64(1)},
+					{2, uint16(2), uint64(2)},
+				},
+			},
+		},
+	},
+	{
+		Name: "identical expressions over different windows should produce different results",
+		SetUpScript: []string{
+			"CREATE TABLE t(a INT, b INT);",
+			"INSERT INTO t(a, b) VALUES (1, 1), (1, 2), (1, 3), (2, 4), (2, 5), (2, 6);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT SUM(b) OVER (PARTITION BY a ORDER BY b) FROM t ORDER BY 1;",
+				Expected: []sql.Row{{float64(1)}, {float64(3)}, {float64(4)}, {float64(6)}, {float64(9)}, {float64(15)}},
+			},
+			{
+				Query:    "SELECT SUM(b) OVER (ORDER BY b) FROM t ORDER BY 1;",
+				Expected: []sql.Row{{float64(1)}, {float64(3)}, {float64(6)}, {float64(10)}, {float64(15)}, {float64(21)}},
+			},
+			{
+				Query: "SELECT SUM(b) OVER (PARTITION BY a ORDER BY b), SUM(b) OVER (ORDER BY b) FROM t ORDER BY 1;",
+				Expected: []sql.Row{
+					{float64(1), float64(1)},
+					{float64(3), float64(3)},
+					{float64(4), float64(10)},
+					{float64(6), float64(6)},
+					{float64(9), float64(15)},
+					{float64(15), float64(21)},
+				},
+			},
+		},
+	},
+	{
+		Name: "windows without ORDER BY should be treated as RANGE BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING",
+		SetUpScript: []string{
+			"CREATE TABLE t(a INT, b INT);",
+			"INSERT INTO t(a, b) VALUES (1, 1), (1, 2), (1, 3), (2, 4), (2, 5), (2, 6);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT SUM(b) OVER (PARTITION BY a) FROM t ORDER BY 1;",
+				Expected: []sql.Row{{float64(6)}, {float64(6)}, {float64(6)}, {float64(15)}, {float64(15)}, {float64(15)}},
+			},
+			{
+				Query:    "SELECT SUM(b) OVER () FROM t ORDER BY 1;",
+				Expected: []sql.Row{{float64(21)}, {float64(21)}, {float64(21)}, {float64(21)}, {float64(21)}, {float64(21)}},
+			},
+			{
+				Query: "SELECT SUM(b) OVER (PARTITION BY a), SUM(b) OVER () FROM t;",
+				Expected: []sql.Row{
+					{float64(6), float64(21)},
+					{float64(6), float64(21)},
+					{float64(6), float64(21)},
+					{float64(15), float64(21)},
+					{float64(15), float64(21)},
+					{float64(15), float64(21)},
+				},
+			},
+		},
+	},
+	{
+		Name: "decimal literals should be parsed correctly",
+		SetUpScript: []string{
+			"SET @testValue = 809826404100301269648758758005707100;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT @testValue;",
+				Expected: []sql.Row{{"809826404100301269648758758005707100"}},
+			},
+		},
+	},
+	{
+		Name: "division and int division operation on negative, small and big value for decimal type column of table",
+		SetUpScript: []string{
+			"create table t (d decimal(25,10) primary key);",
+			"insert into t values (-4990), (2), (22336578);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select d div 314990 from t order by d;",
+				Expected: []sql.Row{{0}, {0}, {70}},
+			},
+			{
+				Query:    "select d / 314990 from t order by d;",
+				Expected: []sql.Row{{"-0.01584177275469"}, {"0.00000634940792"}, {"70.91202260389219"}},
+			},
+		},
+	},
+	{
+		Name: "descending index columns",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int, key ab (a desc, b));",
+			"insert into t values (1, 1, 1), (2, 1, 2), (3, 2, 1), (4, 2, null), (5, null, 3), (6, 3, 3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "show create table t;",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `a` int,\n" +
+					"  `b` int,\n" +
+					"  PRIMARY KEY (`pk`),\n" +
+					"  KEY `ab` (`a` DESC,`b`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "select index_name, column_name, collation from information_schema.statistics where table_name = 't' order by index_name, seq_in_index;",
+				Expected: []sql.Row{{"ab", "a", "D"}, {"ab", "b", "A"}, {"PRIMARY", "pk", "A"}},
 			},
 			{
-				Query: "describe test",
-				Expected: []sql.Row{
-					{"pk", "bigint", "NO", "PRI", "NULL", "auto_increment"},
-					{"v2", "int", "NO", "", "100", ""},
-				},
+				Query:    "select pk from t order by pk desc;",
+				Expected: []sql.Row{{6}, {5}, {4}, {3}, {2}, {1}},
 			},
 			{
-				Query:       "ALTER TABLE test RENAME COLUMN v2 to v3, DROP COLUMN v2",
-				ExpectedErr: sql.ErrTableColumnNotFound,
+				Query:    "select pk from t order by a desc, b;",
+				Expected: []sql.Row{{6}, {4}, {3}, {1}, {2}, {5}},
 			},
 			{
-				Query: "describe test",
-				Expected: []sql.Row{
-					{"pk", "bigint", "NO", "PRI", "NULL", "auto_increment"},
-					{"v2", "int", "NO", "", "100", ""},
-				},
+				Query:    "select pk from t order by a, b desc;",
+				Expected: []sql.Row{{5}, {2}, {1}, {3}, {4}, {6}},
 			},
 			{
-				Query:    "ALTER TABLE test ADD COLUMN (v3 int NOT NULL), add column (v4 int), drop column v2, add column (v5 int NOT NULL)",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "select pk from t where a = 2 order by b desc;",
+				Expected: []sql.Row{{3}, {4}},
 			},
 			{
-				Query: "DESCRIBE test",
-				Expected: []sql.Row{
-					{"pk", "bigint", "NO", "PRI", "NULL", "auto_increment"},
-					{"v3", "int", "NO", "", "NULL", ""},
-					{"v4", "int", "YES", "", "NULL", ""},
-					{"v5", "int", "NO", "", "NULL", ""},
-				},
+				Query:    "select pk from t where a = 1 order by b desc;",
+				Expected: []sql.Row{{2}, {1}},
 			},
 			{
-				Query:    "ALTER TABLE test ADD COLUMN (v6 int not null), RENAME COLUMN v5 TO mycol, DROP COLUMN v4, ADD COLUMN (v7 int);",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "select pk from t order by pk desc limit 2 offset 1;",
+				Expected: []sql.Row{{5}, {4}},
 			},
 			{
-				Query: "describe test",
+				Query: "explain select * from t where a > 1 order by a;",
 				Expected: []sql.Row{
-					{"pk", "bigint", "NO", "PRI", "NULL", "auto_increment"},
-					{"v3", "int", "NO", "", "NULL", ""},
-					{"mycol", "int", "NO", "", "NULL", ""},
-					{"v6", "int", "NO", "", "NULL", ""},
-					{"v7", "int", "YES", "", "NULL", ""},
+					{1, "SIMPLE", "t", nil, "range", "ab", "ab", "5", nil, 2, float64(100), "Backward index scan"},
 				},
 			},
-			// TODO: Does not include tests with column renames and defaults.
 		},
 	},
 	{
-		// https://github.com/dolthub/dolt/issues/3065
-		Name: "join index lookups do not handle filters",
-		SetUpScript: []string{
-			"create table a (x int primary key)",
-			"create table b (y int primary key, x int, index idx_x(x))",
-			"create table c (z int primary key, x int, y int, index idx_x(x))",
-			"insert into a values (0),(1),(2),(3)",
-			"insert into b values (0,1), (1,1), (2,2), (3,2)",
-			"insert into c values (0,1,0), (1,1,0), (2,2,1), (3,2,1)",
-		},
-		Query: "select a.* from a join b on a.x = b.x join c where c.x = a.x and b.x = 1",
-		Expected: []sql.Row{
-			{1},
-			{1},
-			{1},
-			{1},
+		Name: "drop table if exists on unknown table shows warning",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:                           "DROP TABLE IF EXISTS non_existent_table;",
+				ExpectedWarning:                 1051,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Unknown table 'non_existent_table'",
+				SkipResultsCheck:                true,
+			},
 		},
 	},
 	{
-		Name: "failed conversion shows warning",
+		Name: "group by with rollup and cube",
+		SetUpScript: []string{
+			"create table sales (year int, country varchar(20), profit int)",
+			"insert into sales values (2000, 'Finland', 1500), (2000, 'Finland', 100), (2001, 'Finland', 10), (2000, 'India', 1200), (2001, 'USA', 1500), (2001, 'USA', 150)",
+		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:                           "SELECT CONVERT('10000-12-31 23:59:59', DATETIME)",
-				ExpectedWarning:                 1292,
-				ExpectedWarningsCount:           1,
-				ExpectedWarningMessageSubstring: "Incorrect datetime value: 10000-12-31 23:59:59",
-				SkipResultsCheck:                true,
+				Query:    "select year, sum(profit) from sales group by year with rollup",
+				Expected: []sql.Row{{2000, float64(2800)}, {2001, float64(1660)}, {nil, float64(4460)}},
 			},
 			{
-				Query:                           "SELECT CONVERT('this is not a datetime', DATETIME)",
-				ExpectedWarning:                 1292,
-				ExpectedWarningsCount:           1,
-				ExpectedWarningMessageSubstring: "Incorrect datetime value: this is not a datetime",
-				SkipResultsCheck:                true,
+				Query: "select year, country, count(*), grouping(year), grouping(year, country) from sales group by year, country with rollup",
+				Expected: []sql.Row{
+					{2000, "Finland", 2, 0, 0},
+					{2000, "India", 1, 0, 0},
+					{2000, nil, 3, 0, 1},
+					{2001, "Finland", 1, 0, 0},
+					{2001, "USA", 2, 0, 0},
+					{2001, nil, 3, 0, 1},
+					{nil, nil, 6, 1, 3},
+				},
 			},
 			{
-				Query:                           "SELECT CAST('this is not a datetime' as DATETIME)",
-				ExpectedWarning:                 1292,
-				ExpectedWarningsCount:           1,
-				ExpectedWarningMessageSubstring: "Incorrect datetime value: this is not a datetime",
-				SkipResultsCheck:                true,
+				Query:    "select if(grouping(year), 'total', year) as y, count(*) from sales group by year with rollup",
+				Expected: []sql.Row{{2000, 3}, {2001, 3}, {"total", 6}},
 			},
 			{
-				Query:                           "SELECT CONVERT('this is not a date', DATE)",
-				ExpectedWarning:                 1292,
-				ExpectedWarningsCount:           1,
-				ExpectedWarningMessageSubstring: "Incorrect date value: this is not a date",
-				SkipResultsCheck:                true,
+				Query:    "select year, country, count(*) from sales group by year, country with rollup having grouping(country) = 1",
+				Expected: []sql.Row{{2000, nil, 3}, {2001, nil, 3}, {nil, nil, 6}},
 			},
 			{
-				Query:                           "SELECT CAST('this is not a date' as DATE)",
-				ExpectedWarning:                 1292,
-				ExpectedWarningsCount:           1,
-				ExpectedWarningMessageSubstring: "Incorrect date value: this is not a date",
-				SkipResultsCheck:                true,
+				Query:    "select year, count(*) as c from sales group by year with rollup order by c desc, year",
+				Expected: []sql.Row{{nil, 6}, {2000, 3}, {2001, 3}},
+			},
+			{
+				Query: "select year, country, count(*) from sales group by year, country with cube",
+				Expected: []sql.Row{
+					{2000, "Finland", 2},
+					{2000, "India", 1},
+					{2000, nil, 3},
+					{2001, "Finland", 1},
+					{2001, "USA", 2},
+					{2001, nil, 3},
+					{nil, "Finland", 3},
+					{nil, "India", 1},
+					{nil, "USA", 2},
+					{nil, nil, 6},
+				},
+			},
+			{
+				Query:       "select year, grouping(country) from sales group by year with rollup",
+				ExpectedErr: sql.ErrGroupingArgumentNotGrouped,
 			},
 		},
 	},
 	{
-		Name: "Describe with expressions and views work correctly",
+		Name: "sliding window frames",
 		SetUpScript: []string{
-			"CREATE TABLE t(pk int primary key, val int DEFAULT (pk * 2))",
+			"create table t (i int primary key, v int)",
+			"insert into t values (1, 5), (2, 3), (3, null), (4, 9), (5, 3), (6, 12)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query: "DESCRIBE t",
+				Query: `select i,
+max(v) over (order by i rows between 1 preceding and 1 following),
+min(v) over (order by i rows between current row and 2 following),
+bit_or(v) over (order by i rows between 1 preceding and current row)
+from t order by i`,
 				Expected: []sql.Row{
-					{"pk", "int", "NO", "PRI", "NULL", ""},
-					{"val", "int", "YES", "", "((pk * 2))", "DEFAULT_GENERATED"}, // TODO: MySQL would return (`pk` * 2)
+					{1, 5, 3, uint64(5)},
+					{2, 5, 3, uint64(7)},
+					{3, 9, 3, uint64(3)},
+					{4, 9, 3, uint64(9)},
+					{5, 12, 3, uint64(11)},
+					{6, 12, 12, uint64(15)},
+				},
+			},
+			{
+				Query: `select i,
+sum(v) over (partition by i % 2 order by i),
+max(v) over (partition by i % 2 order by i rows between 1 preceding and current row),
+row_number() over (partition by i % 2 order by i desc)
+from t order by i`,
+				Expected: []sql.Row{
+					{1, float64(5), 5, 3},
+					{2, float64(3), 3, 3},
+					{3, float64(5), 5, 2},
+					{4, float64(12), 9, 2},
+					{5, float64(8), 3, 1},
+					{6, float64(24), 12, 1},
 				},
 			},
 		},
 	},
 	{
-		Name: "Check support for deprecated BINARY attribute after character set",
+		Name: "having without group by and aggregations only in having",
 		SetUpScript: []string{
-			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 VARCHAR(255) COLLATE utf8mb4_0900_bin);",
+			"create table t (i int primary key, v int, s varchar(10))",
+			"insert into t values (1, 5, 'a'), (2, 3, 'b'), (3, null, 'a'), (4, 9, 'b'), (5, 3, 'a')",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SHOW CREATE TABLE test;",
-				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255),\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:    "select 1 from t having count(*) > 2",
+				Expected: []sql.Row{{1}},
 			},
 			{
-				Query:    "ALTER TABLE test CHANGE v1 v2 VARCHAR(255) CHARACTER SET utf8mb4 BINARY NOT NULL;",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "select 1 from t having count(*) > 5",
+				Expected: []sql.Row{},
 			},
 			{
-				Query:    "SHOW CREATE TABLE test;",
-				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` bigint NOT NULL,\n  `v2` varchar(255) COLLATE utf8mb4_bin NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:    "select max(v) from t having min(v) < 5",
+				Expected: []sql.Row{{9}},
 			},
 			{
-				Query:    "CREATE TABLE test2 (pk BIGINT PRIMARY KEY, v1 VARCHAR(255) CHARACTER SET utf8mb4 BINARY);",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "select s from t group by s having max(v) > 5",
+				Expected: []sql.Row{{"b"}},
 			},
 			{
-				Query:    "SHOW CREATE TABLE test2;",
-				Expected: []sql.Row{{"test2", "CREATE TABLE `test2` (\n  `pk` bigint NOT NULL,\n  `v1` varchar(255) COLLATE utf8mb4_bin,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:    "select s from t group by s having max(v) - min(v) > 1 order by s",
+				Expected: []sql.Row{{"a"}, {"b"}},
 			},
-		},
-	},
-	{
-		Name: "sum() and avg() on DECIMAL type column returns the DECIMAL type result",
-		SetUpScript: []string{
-			"create table decimal_table (id int, val decimal(18,16));",
-			"insert into decimal_table values (1,-2.5633000000000384);",
-			"insert into decimal_table values (2,2.5633000000000370);",
-			"insert into decimal_table values (3,0.0000000000000004);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT val FROM decimal_table;",
-				Expected: []sql.Row{{"-2.5633000000000384"}, {"2.5633000000000370"}, {"0.0000000000000004"}},
+				Query:    "select a.s from t a join t b on a.i = b.i group by a.s having max(b.v) > 5",
+				Expected: []sql.Row{{"b"}},
 			},
 			{
-				Query:    "SELECT sum(val) FROM decimal_table;",
-				Expected: []sql.Row{{"-0.0000000000000010"}},
+				Query:    "select i, (select count(*) from t t2 where t2.s = t.s having count(*) > 2) from t order by i",
+				Expected: []sql.Row{{1, 3}, {2, nil}, {3, 3}, {4, nil}, {5, 3}},
 			},
 			{
-				Query:    "SELECT avg(val) FROM decimal_table;",
-				Expected: []sql.Row{{"-0.00000000000000033333"}},
+				Query:    "select i from t where exists (select 1 from t t2 where t2.s = t.s having max(t2.v) > 8) order by i",
+				Expected: []sql.Row{{2}, {4}},
+			},
+			{
+				Query:       "select 1 from t having max(nosuch) > 1",
+				ExpectedErr: sql.ErrColumnNotFound,
 			},
 		},
 	},
 	{
-		Name: "sum() and avg() on non-DECIMAL type column returns the DOUBLE type result",
+		Name: "scalar subqueries in update, insert and order by clauses",
 		SetUpScript: []string{
-			"create table float_table (id int, val1 double, val2 float);",
-			"insert into float_table values (1,-2.5633000000000384, 2.3);",
-			"insert into float_table values (2,2.5633000000000370, 2.4);",
-			"insert into float_table values (3,0.0000000000000004, 5.3);",
+			"create table t (i int primary key, v int)",
+			"create table u (i int primary key, w int)",
+			"insert into t values (1, 5), (2, 3), (3, 7)",
+			"insert into u values (1, 10), (2, 20), (3, 30)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT sum(id), sum(val1), sum(val2) FROM float_table ORDER BY id;",
-				Expected: []sql.Row{{float64(6), -9.322676295501879e-16, 10.000000238418579}},
+				Query:    "update t set v = (select max(w) from u) where i = 1",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
 			},
 			{
-				Query:    "SELECT avg(id), avg(val1), avg(val2) FROM float_table ORDER BY id;;",
-				Expected: []sql.Row{{float64(2), -3.107558765167293e-16, 3.333333412806193}},
+				Query:    "update t set v = (select w from u where u.i = t.i) + 1 where i > 1",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
 			},
-		},
-	},
-	{
-		Name: "compare DECIMAL type columns with different precision and scale",
-		SetUpScript: []string{
-			"create table t (id int primary key, val1 decimal(2, 1), val2 decimal(3, 1));",
-			"insert into t values (1, 1.2, 1.1), (2, 1.2, 10.1);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "select if(val1 < val2, 'YES', 'NO') from t order by id;",
-				Expected: []sql.Row{{"NO"}, {"YES"}},
+				Query:       "update t set v = (select w from u)",
+				ExpectedErr: sql.ErrExpectedSingleRow,
 			},
-		},
-	},
-	{
-		Name: "basic test on tables dual and `dual`",
-		SetUpScript: []string{
-			"CREATE TABLE `dual` (id int)",
-			"INSERT INTO `dual` VALUES (2)",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT * from `dual`;",
-				Expected: []sql.Row{{2}},
+				Query:    "insert into t values (4, (select min(w) from u)), ((select max(i) from u) + 2, null)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
 			},
 			{
-				Query:    "SELECT 3 from dual;",
-				Expected: []sql.Row{{3}},
+				Query:       "insert into t values (6, (select w from u))",
+				ExpectedErr: sql.ErrExpectedSingleRow,
 			},
 			{
-				Query:       "SELECT * from dual;",
-				ExpectedErr: sql.ErrNoTablesUsed,
+				Query:    "insert into t values (1, 0) on duplicate key update v = (select w from u where u.i = 2)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
 			},
-		},
-	},
-	{
-		Name: "having clause without groupby clause, all rows implicitly form a single aggregate group",
-		SetUpScript: []string{
-			"create table numbers (val int);",
-			"insert into numbers values (1), (2), (3);",
-			"insert into numbers values (2), (4);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "select val from numbers;",
-				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+				Query:    "select * from t order by i",
+				Expected: []sql.Row{{1, 20}, {2, 21}, {3, 31}, {4, 10}, {5, nil}},
 			},
 			{
-				Query:    "select val as a from numbers having a = val;",
-				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+				Query:    "select i from t order by (select w from u where u.i = t.i) desc, i",
+				Expected: []sql.Row{{3}, {2}, {1}, {4}, {5}},
 			},
 			{
-				Query:    "select val as a from numbers group by val having a = val;",
-				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+				Query:       "select i from t order by (select w from u)",
+				ExpectedErr: sql.ErrExpectedSingleRow,
 			},
 			{
-				Query:    "select val as a from numbers as t1 group by t1.val having a = t1.val;",
-				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+				Query:    "prepare s1 from 'update t set v = (select w from u where u.i = ?) where i = ?'",
+				Expected: []sql.Row{{types.OkResult{Info: plan.PrepareInfo{}}}},
 			},
 			{
-				Query:    "select t1.val as a from numbers as t1 group by 1 having a = t1.val;",
-				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+				Query:    "set @a = 3, @b = 5",
+				Expected: []sql.Row{{}},
 			},
 			{
-				Query:    "select t1.val as a from numbers as t1 having a = t1.val;",
-				Expected: []sql.Row{{1}, {2}, {3}, {2}, {4}},
+				Query:    "execute s1 using @a, @b",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
 			},
 			{
-				Query:    "select count(*) from numbers having count(*) = 5;",
-				Expected: []sql.Row{{5}},
+				Query:    "prepare s2 from 'insert into t values (?, (select w from u where u.i = ?))'",
+				Expected: []sql.Row{{types.OkResult{Info: plan.PrepareInfo{}}}},
 			},
 			{
-				// MySQL returns `Unknown column 'val' in 'having clause'` error for this query,
-				// but GMS builds GroupBy for any aggregate function.
-				Skip:  true,
-				Query: "select count(*) from numbers having count(*) > val;",
-				//ExpectedErrStr:   "found HAVING clause with no GROUP BY", // not the exact error we want
+				Query:    "set @c = 6",
+				Expected: []sql.Row{{}},
 			},
 			{
-				Query:    "select count(*) from numbers group by val having count(*) < val;",
-				Expected: []sql.Row{{1}, {1}},
+				Query:    "execute s2 using @c, @a",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "prepare s3 from 'select i, (select w from u where u.i < ? order by w desc limit ?) from t order by (select w from u where u.i = t.i) desc, i limit ?'",
+				Expected: []sql.Row{{types.OkResult{Info: plan.PrepareInfo{}}}},
+			},
+			{
+				Query:    "set @one = 1",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "execute s3 using @a, @one, @a",
+				Expected: []sql.Row{{3, 20}, {2, 20}, {1, 20}},
+			},
+			{
+				Query:    "select * from t where i > 4 order by i",
+				Expected: []sql.Row{{5, 30}, {6, 30}},
 			},
 		},
 	},
 	{
-		Name: "using having and group by clauses in subquery ",
+		Name: "default values in insert and update assignments",
 		SetUpScript: []string{
-			"CREATE TABLE t (i int, t varchar(2));",
-			"insert into t values (1, 'a'), (1, 'a2'), (2, 'b'), (3, 'c'), (3, 'c2'), (4, 'd'), (5, 'e'), (5, 'e2');", //, (6, 'f'), (7, 'g'), (7, 'g2')
+			"create table t (a int primary key, b int default (a * 10), c varchar(10) default 'x', d int, e int not null)",
+			"create table u (a int primary key, b int default 9)",
+			"insert into u values (1, 1), (2, 2)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "select i from t group by i having count(1) = 1 order by i asc",
-				Expected: []sql.Row{{2}, {4}},
+				Query:    "insert into t values (1, default, default, default, 0)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query:    "select i from t group by i having count(1) != 1 order by i asc",
-				Expected: []sql.Row{{1}, {3}, {5}},
+				Query:    "insert into t (e, b, a) values (0, default, 2), (0, default(b), 3)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
 			},
 			{
-				Query:    "select * from t where i in (select i from t group by i having count(1) = 1) order by i, t asc;",
-				Expected: []sql.Row{{2, "b"}, {4, "d"}},
+				Query:    "insert into t (e, c, a) values (0, default(b), 4)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query:    "select * from t where i in (select i from t group by i having count(1) != 1) order by i, t asc;",
-				Expected: []sql.Row{{1, "a"}, {1, "a2"}, {3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+				Query:    "insert into t set a = 5, b = default, c = default(c), e = 0",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query:    "select * from t where i in (select i from t where i = 2 group by i having count(1) = 1) order by i, t asc;",
-				Expected: []sql.Row{{2, "b"}},
+				Query:    "select * from t order by a",
+				Expected: []sql.Row{{1, 10, "x", nil, 0}, {2, 20, "x", nil, 0}, {3, 30, "x", nil, 0}, {4, 40, "40", nil, 0}, {5, 50, "x", nil, 0}},
 			},
 			{
-				Query:    "select * from t where i in (select i from t where i = 3 group by i having count(1) != 1) order by i, t asc;",
-				Expected: []sql.Row{{3, "c"}, {3, "c2"}},
+				Query:       "insert into t values (6, default(nosuch), default, default, 0)",
+				ExpectedErr: sql.ErrColumnNotFound,
 			},
 			{
-				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1) order by i, t asc;",
-				Expected: []sql.Row{{3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+				Query:    "update t set b = 0, c = 'y', d = 1 where a > 3",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "update t set b = default, d = default(d) where a = 4",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "update t as x set x.c = default(c) where x.a = 4",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "insert into t values (5, 0, 'z', 0, 0) on duplicate key update b = default, c = default(c)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select * from t where a > 3 order by a",
+				Expected: []sql.Row{{4, 40, "x", nil, 0}, {5, 50, "x", 1, 0}},
+			},
+			{
+				Query:    "update t join u on t.a = u.a set t.b = 0, u.b = default",
+				Expected: []sql.Row{{newUpdateResult(4, 4)}},
+			},
+			{
+				Query:    "update t join u on t.a = u.a set t.b = default",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "select t.a, t.b, u.b from t join u on t.a = u.a order by t.a",
+				Expected: []sql.Row{{1, 10, 9}, {2, 20, 9}},
 			},
 			{
-				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1 order by i desc) order by i, t asc;",
-				Expected: []sql.Row{{3, "c"}, {3, "c2"}, {5, "e"}, {5, "e2"}},
+				Query:       "update t join u on t.a = u.a set t.b = default(a)",
+				ExpectedErr: sql.ErrAmbiguousColumnName,
 			},
 			{
-				Query:    "select * from t where i in (select i from t where i > 2 group by i having count(1) != 1) order by i desc, t asc;",
-				Expected: []sql.Row{{5, "e"}, {5, "e2"}, {3, "c"}, {3, "c2"}},
+				Query:       "update t set e = default",
+				ExpectedErr: sql.ErrInsertIntoNonNullableDefaultNullColumn,
 			},
 		},
 	},
 	{
-		Name: "can't create view with same name as existing table",
+		Name: "implicit type coercion in comparisons",
 		SetUpScript: []string{
-			"create table t (i int);",
+			"create table t (i int primary key, s varchar(20), d date, dt datetime, b varbinary(10))",
+			"insert into t values (123, '123abc', '2020-01-02', '2020-01-02 03:04:05', 'abc'), (1, '1.5', '2021-05-06', '2021-05-06 00:00:00', 'a')",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:       "create view t as select 1 from dual",
-				ExpectedErr: sql.ErrTableAlreadyExists,
+				Query:    "select '123abc' = 123, '1.5' = 1, '1.5' > 1, ' 12' = 12, '12 ' = 12, '1e2' = 100, '-5x' < 0, '0x10' = 16",
+				Expected: []sql.Row{{true, false, true, true, true, true, true, false}},
 			},
-		},
-	},
-	{
-		Name: "can't create table with same name as existing view",
-		SetUpScript: []string{
-			"create view t as select 1 from dual",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:       "create table t (i int);",
-				ExpectedErr: sql.ErrTableAlreadyExists,
+				Query:                           "select i from t where s = 123",
+				Expected:                        []sql.Row{{123}},
+				ExpectedWarning:                 1292,
+				ExpectedWarningsCount:           1,
+				ExpectedWarningMessageSubstring: "Truncated incorrect DOUBLE value: '123abc'",
 			},
-		},
-	},
-	{
-		Name: "'/' division operation result in decimal or float",
-		SetUpScript: []string{
-			"create table floats (f float);",
-			"insert into floats values (1.1), (1.2), (1.3);",
-			"create table decimals (d decimal(2,1));",
-			"insert into decimals values (1.0), (2.0), (2.5);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "select f/2 from floats;",
-				Expected: []sql.Row{{0.550000011920929}, {0.6000000238418579}, {0.6499999761581421}},
+				Query:    "select i from t where s = 1",
+				Expected: []sql.Row{},
 			},
 			{
-				Query:    "select 2/f from floats;",
-				Expected: []sql.Row{{1.8181817787737895}, {1.6666666004392863}, {1.5384615948919735}},
+				Query:    "select i from t where i = '123abc'",
+				Expected: []sql.Row{{123}},
 			},
 			{
-				Query:    "select d/2 from decimals;",
-				Expected: []sql.Row{{"0.50000"}, {"1.00000"}, {"1.25000"}},
+				Query:    "select i from t where i = '1.5'",
+				Expected: []sql.Row{},
 			},
 			{
-				Query:    "select 2/d from decimals;",
-				Expected: []sql.Row{{"2.0000"}, {"1.0000"}, {"0.8000"}},
+				Query:    "select i from t where d = '2020-1-2' or d = '20210506'",
+				Expected: []sql.Row{{1}, {123}},
 			},
 			{
-				Query: "select f/d from floats, decimals;",
-				Expected: []sql.Row{{1.2999999523162842}, {1.2000000476837158}, {1.100000023841858},
-					{0.6499999761581421}, {0.6000000238418579}, {0.550000011920929},
-					{0.5199999809265137}, {0.48000001907348633}, {0.4400000095367432}},
+				Query:    "select i from t where d = 20200102",
+				Expected: []sql.Row{{123}},
 			},
 			{
-				Query: "select d/f from floats, decimals;",
-				Expected: []sql.Row{{0.7692307974459868}, {0.8333333002196431}, {0.9090908893868948},
-					{1.5384615948919735}, {1.6666666004392863}, {1.8181817787737895},
-					{1.9230769936149668}, {2.083333250549108}, {2.272727223467237}},
+				Query:    "select i from t where dt = 20200102030405",
+				Expected: []sql.Row{{123}},
 			},
 			{
-				Query:    `select f/'a' from floats;`,
-				Expected: []sql.Row{{nil}, {nil}, {nil}},
+				Query:    "select i from t where d > 5",
+				Expected: []sql.Row{{1}, {123}},
 			},
-		},
-	},
-	{
-		Name: "'%' mod operation result in decimal or float",
-		SetUpScript: []string{
-			"create table a (pk int primary key, c1 int, c2 double, c3 decimal(5,3));",
-			"insert into a values (1, 1, 1.111, 1.111), (2, 2, 2.111, 2.111);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "select c1 % 2, c2 % 2, c3 % 2 from a;",
-				Expected: []sql.Row{{"1", 1.111, "1.111"}, {"0", 0.11100000000000021, "0.111"}},
+				Query:    "select 0x61 = 'a', 0x61 = 97, x'61' = 97, 0x10 > 9, 0x3130 = 10, 0x3130 = '10'",
+				Expected: []sql.Row{{true, true, true, true, false, true}},
 			},
 			{
-				Query:    "select c1 % 0.5, c2 % 0.5, c3 % 0.5 from a;",
-				Expected: []sql.Row{{"0.0", 0.11099999999999999, "0.111"}, {"0.0", 0.11100000000000021, "0.111"}},
+				Query:    "select i from t where i = 0x7b",
+				Expected: []sql.Row{{123}},
 			},
 			{
-				Query:    "select 20 % c1, 20 % c2, 20 % c3 from a;",
-				Expected: []sql.Row{{"0", 0.002000000000000224, "0.002"}, {"0", 1.0009999999999981, "1.001"}},
+				Query:    "select i from t where b = 0x616263",
+				Expected: []sql.Row{{123}},
+			},
+			{
+				Query:    "set strict_comparisons = 1",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select i from t where i = '123'",
+				Expected: []sql.Row{{123}},
+			},
+			{
+				Query:       "select i from t where s = 123",
+				ExpectedErr: sql.ErrTruncatedIncorrectValue,
 			},
 		},
 	},
 	{
-		Name: "arithmetic bit operations on int, float and decimal types",
+		Name: "hexadecimal and bit-value literals",
 		SetUpScript: []string{
-			"CREATE TABLE num_types (pk int primary key, a int, b float, c decimal(5,3));",
-			"insert into num_types values (1,1,1.1,1.1), (2,2,1.2,2.2), (3,3,1.6,3.7), (4,4,1.7,4.0);",
+			"create table t (i int primary key, b binary(2), vb varbinary(10), s varchar(10), n int, u bigint unsigned, bt bit(8))",
+			"insert into t values (1, 0x6162, X'616263', 0x616263, 0x10, b'101', b'11')",
+			"insert into t values (2, b'0110000101100010', b'01100001', b'0110000101100010', b'1111', 0xFF, 0x0F)",
+			"insert into t values (3, 'cd', 'c', b'', 0b11, 0b11, 0b1)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query: "select a & 2.4, a | 2.4, a ^ 2.4 from num_types;",
+				Query: "select i, b, vb, s, n, u, bt from t order by i",
 				Expected: []sql.Row{
-					{uint64(0), uint64(3), uint64(3)},
-					{uint64(2), uint64(2), uint64(0)},
-					{uint64(2), uint64(3), uint64(1)},
-					{uint64(0), uint64(6), uint64(6)},
+					{1, []byte("ab"), []byte("abc"), "abc", 16, uint64(5), uint64(3)},
+					{2, []byte("ab"), []byte("a"), "ab", 15, uint64(255), uint64(15)},
+					{3, []byte("cd"), []byte("c"), "", 3, uint64(3), uint64(1)},
 				},
 			},
 			{
-				Query: "select b & 2.4, b | 2.4, b ^ 2.4 from num_types;",
-				Expected: []sql.Row{
-					{uint64(0), uint64(3), uint64(3)},
-					{uint64(0), uint64(3), uint64(3)},
-					{uint64(2), uint64(2), uint64(0)},
-					{uint64(2), uint64(2), uint64(0)},
-				},
+				Query:    "select i from t where b = 0x6162 order by i",
+				Expected: []sql.Row{{1}, {2}},
 			},
 			{
-				Query: "select c & 2.4, c | 2.4, c ^ 2.4 from num_types;",
-				Expected: []sql.Row{
-					{uint64(0), uint64(3), uint64(3)},
-					{uint64(2), uint64(2), uint64(0)},
-					{uint64(0), uint64(6), uint64(6)},
-					{uint64(0), uint64(6), uint64(6)},
-				},
+				Query:    "select i from t where vb = b'01100001'",
+				Expected: []sql.Row{{2}},
 			},
-		},
-	},
-	{
-		Name: "year type behavior",
-		SetUpScript: []string{
-			"create table t (pk int primary key, col1 year);",
-		},
-		Assertions: []ScriptTestAssertion{
-			// 1901 - 2155 are interpreted as 1901 - 2155
 			{
-				Query:    "INSERT INTO t VALUES (1, '1901'), (2, 1901);",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "select i from t where bt = b'1111' or u = 0xff",
+				Expected: []sql.Row{{2}},
 			},
 			{
-				Query:    "INSERT INTO t VALUES (3, '2000'), (4, 2000);",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "select b'01100001', 0b01100001, X'41', b'', hex(b'1'), hex(0b0000000001)",
+				Expected: []sql.Row{{[]byte("a"), []byte("a"), []byte("A"), []byte{}, "01", "0001"}},
 			},
 			{
-				Query:    "INSERT INTO t VALUES (5, '2155'), (6, 2155);",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "select b'1' + 0, 0b11 + 1, 0x41 + 1, b'01100001' = 'a', b'1010' = 10, b'1010' = '10'",
+				Expected: []sql.Row{{float64(1), float64(4), float64(66), true, true, false}},
 			},
-			// 1 - 69 are interpreted as 2001 - 2069
 			{
-				Query:    "INSERT INTO t VALUES (7, '1'), (8, 1);",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "select concat(0x41, b'01000010'), cast(b'01000001' as char), length(b'1111111111'), cast(b'1010' as unsigned)",
+				Expected: []sql.Row{{"AB", "A", 2, uint64(10)}},
 			},
+		},
+	},
+	{
+		Name: "binary strings padding, comparisons and LIKE",
+		SetUpScript: []string{
+			"create table t (i int primary key, b binary(4), vb varbinary(10), bl blob, unique key(b), key(vb), key(bl(4)))",
+			"insert into t values (1, 'a', 'a ', 0xFF61), (2, 'ab', 'a', 'ABC'), (3, 0x00, 0xC3A9, 'abc')",
+		},
+		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "INSERT INTO t VALUES (9, '35'), (10, 35);",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "select i, hex(b), length(b) from t order by b",
+				Expected: []sql.Row{{3, "00000000", 4}, {1, "61000000", 4}, {2, "61620000", 4}},
 			},
 			{
-				Query:    "INSERT INTO t VALUES (11, '69'), (12, 69);",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "select i from t where b = 'a'",
+				Expected: []sql.Row{},
 			},
-			// 70 - 99 are interpreted as 1970 - 1999
 			{
-				Query:    "INSERT INTO t VALUES (13, '70'), (14, 70);",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "select i from t where b = 'a\\0\\0\\0' or b = 0x61620000 order by i",
+				Expected: []sql.Row{{1}, {2}},
 			},
 			{
-				Query:    "INSERT INTO t VALUES (15, '85'), (16, 85);",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:       "insert into t values (4, 'a\\0', 'x', 'x')",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
 			},
 			{
-				Query:    "INSERT INTO t VALUES (17, '99'), (18, 99);",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "select i from t where vb = 'a'",
+				Expected: []sql.Row{{2}},
 			},
-			// '0', and '00' are interpreted as 2000
 			{
-				Query:    "INSERT INTO t VALUES (19, '0'), (20, '00');",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "select i from t order by vb, i",
+				Expected: []sql.Row{{2}, {1}, {3}},
 			},
-			// 0 is interpreted as 0000
 			{
-				Query:    "INSERT INTO t VALUES (21, 0)",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "select i from t where vb like '__' order by i",
+				Expected: []sql.Row{{1}, {3}},
 			},
-			// Assert that returned values are correct.
 			{
-				Query: "SELECT * from t order by pk;",
-				Expected: []sql.Row{
-					{1, int16(1901)},
-					{2, int16(1901)},
-					{3, int16(2000)},
-					{4, int16(2000)},
-					{5, int16(2155)},
-					{6, int16(2155)},
-					{7, int16(2001)},
-					{8, int16(2001)},
-					{9, int16(2035)},
-					{10, int16(2035)},
-					{11, int16(2069)},
-					{12, int16(2069)},
-					{13, int16(1970)},
-					{14, int16(1970)},
-					{15, int16(1985)},
-					{16, int16(1985)},
-					{17, int16(1999)},
-					{18, int16(1999)},
-					{19, int16(2000)},
-					{20, int16(2000)},
-					{21, int16(0)},
-				},
+				Query:    "select i from t where bl like 'a%'",
+				Expected: []sql.Row{{3}},
 			},
-		},
-	},
-	{
-		Name: "INSERT IGNORE correctly truncates column data",
-		SetUpScript: []string{
-			`
-			CREATE TABLE t (
-				pk int primary key,
-				col1 boolean,
-				col2 integer,
-				col3 tinyint,
-				col4 smallint,
-				col5 mediumint,
-				col6 int,
-				col7 bigint,
-				col8 decimal,
-				col9 float,
-				col10 double,
-				col11 date,
-				col12 time,
-				col13 datetime,
-				col14 timestamp,
-				col15 year,
-				col16 ENUM('first', 'second'),
-				col17 SET('a', 'b')
-			);
-			`,
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query: `
-					INSERT IGNORE INTO t VALUES (
-						1, 'val1', 'val2', 'val3', 'val4', 'val5', 'val6', 'val7', 'val8', 'val9', 'val10',
-						'val11', 'val12', 'val13', 'val14', 'val15', 'val16', 'val17'
-					);
-				`,
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "select i from t where bl like '%a'",
+				Expected: []sql.Row{{1}},
 			},
 			{
-				Query: "SELECT * from t",
-				Expected: []sql.Row{
-					{
-						1,
-						0,
-						0,
-						0,
-						0,
-						0,
-						0,
-						0,
-						"0",
-						float64(0),
-						float64(0),
-						time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
-						types.Timespan(0),
-						time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
-						time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
-						0,
-						uint64(1), // 'first' value in enum
-						uint64(0), // empty set
-					},
-				},
+				Query:    "select 0xFF61 like '%a', 0xC3A9 like '_', 0xC3A9 like '__', 0x61 like 'A'",
+				Expected: []sql.Row{{true, false, true, false}},
 			},
-		},
-	},
-	{
-		Name: "INSERT IGNORE throws an error when json is badly formatted",
-		SetUpScript: []string{
-			"CREATE TABLE t (pk int primary key, col1 json);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:       "INSERT IGNORE into t VALUES (1, 'val1');",
-				ExpectedErr: sql.ErrInvalidJson,
+				Query:    "select hex(cast('a' as binary(3))), cast('a' as binary(3)) = 'a', hex(convert('abcd', binary(2))), cast('abcd' as char(2))",
+				Expected: []sql.Row{{"610000", false, "6162", "ab"}},
 			},
-		},
-	},
-	{
-		Name: "hash lookup for joins works with binary",
-		SetUpScript: []string{
-			"create table uv (u int primary key, v int);",
-			"create table xy (x int primary key, y int);",
-			"insert into uv values (0,0), (1,1), (2,2);",
-			"insert into xy values (0,0), (1,1), (2,2);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query: "select uv.u from uv join xy on binary xy.x = binary uv.u;",
-				Expected: []sql.Row{
-					{0},
-					{1},
-					{2},
-				},
+				Query:       "create table t2 (bl blob, key(bl))",
+				ExpectedErr: sql.ErrInvalidBlobTextKey,
+			},
+			{
+				Query:       "create table t2 (bl blob, key(bl(3073)))",
+				ExpectedErr: sql.ErrKeyTooLong,
+			},
+			{
+				Query:       "create table t2 (vb varbinary(4000), key(vb))",
+				ExpectedErr: sql.ErrKeyTooLong,
 			},
 		},
 	},
 	{
-		Name: "enum columns work as expected in when clauses",
+		Name: "LIKE prefixes and DATE() comparisons as index ranges",
 		SetUpScript: []string{
-			"create table enums (e enum('a'));",
-			"insert into enums values ('a');",
+			"create table t (pk int primary key, s varchar(20), b varbinary(20), d datetime, dd date, index (s), index (b), index (d), index (dd))",
+			"insert into t values (1, 'abc', 'abc', '2023-01-01 10:00:00', '2023-01-01'), (2, 'abd', 'ab_x', '2023-01-02 00:00:00', '2023-01-02'), (3, 'ab_x', 'abz', '2022-12-31 23:59:59', '2022-12-31'), (4, 'xyz', 'xyz', '2023-01-01 00:00:00', '2023-01-01'), (5, 'ab😀c', 'ab', null, null)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "select (case e when 'a' then 42 end) from enums",
-				Expected: []sql.Row{{42}},
+				Query:    "select pk from t where s like 'ab_%' order by pk",
+				Expected: []sql.Row{{1}, {2}, {3}, {5}},
 			},
 			{
-				Query:    "select (case 'a' when e then 42 end) from enums",
-				Expected: []sql.Row{{42}},
+				Query:    "select pk from t where s like 'ab%c' order by pk",
+				Expected: []sql.Row{{1}, {5}},
+			},
+			{
+				Query:    "select pk from t where s like 'ab|_%' escape '|' order by pk",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select pk from t where s like 'ab😀%' order by pk",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "select pk from t where b like 'ab_%' order by pk",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    "select pk from t where b like 'ab\\\\_%' order by pk",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "explain select pk from t where s like 'ab_%'",
+				Expected: []sql.Row{{1, "SIMPLE", "t", nil, "range", "s", "s", "83", nil, 2, float64(100), "Using index condition"}},
+			},
+			{
+				Query:    "select pk from t where date(d) = '2023-01-01' order by pk",
+				Expected: []sql.Row{{1}, {4}},
+			},
+			{
+				Query:    "select pk from t where date(d) > '2023-01-01' order by pk",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select pk from t where date(d) >= '2023-01-01' order by pk",
+				Expected: []sql.Row{{1}, {2}, {4}},
+			},
+			{
+				Query:    "select pk from t where date(d) < '2023-01-01' order by pk",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select pk from t where '2023-01-01' >= date(d) order by pk",
+				Expected: []sql.Row{{1}, {3}, {4}},
+			},
+			{
+				Query:    "select pk from t where date(dd) = '2023-01-02' order by pk",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select pk from t where date(d) = '2023-01-01 10:00:00' order by pk",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "explain select pk from t where date(d) = '2023-01-01'",
+				Expected: []sql.Row{{1, "SIMPLE", "t", nil, "range", "d", "d", "6", nil, 2, float64(100), nil}},
 			},
 		},
 	},
 	{
-		Name: "SET and ENUM properly handle integers using UPDATE and DELETE statements",
+		Name: "optimizer_switch flags",
 		SetUpScript: []string{
-			"CREATE TABLE setenumtest (pk INT PRIMARY KEY, v1 ENUM('a', 'b', 'c'), v2 SET('a', 'b', 'c'));",
+			"create table a (x int primary key, y int)",
+			"create table b (u int primary key, v int)",
+			"insert into a values (1, 1), (2, 2)",
+			"insert into b values (1, 1), (2, 3)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "INSERT INTO setenumtest VALUES (1, 1, 1), (2, 1, 1), (3, 3, 1), (4, 1, 3);",
-				Expected: []sql.Row{{types.NewOkResult(4)}},
+				Query:    "select @@optimizer_switch",
+				Expected: []sql.Row{{"index_merge=on,index_merge_union=on,index_merge_sort_union=on,index_merge_intersection=on,engine_condition_pushdown=on,index_condition_pushdown=on,mrr=on,mrr_cost_based=on,block_nested_loop=on,batched_key_access=off,materialization=on,semijoin=on,loosescan=on,firstmatch=on,duplicateweedout=on,subquery_materialization_cost_based=on,use_index_extensions=on,condition_fanout_filter=on,derived_merge=on,use_invisible_indexes=off,skip_scan=on,hash_join=on,subquery_to_derived=off,prefer_ordering_index=on,derived_condition_pushdown=on"}},
 			},
 			{
-				Query: "UPDATE setenumtest SET v1 = 2, v2 = 2 WHERE pk = 2;",
-				Expected: []sql.Row{{types.OkResult{
-					RowsAffected: 1,
-					Info: plan.UpdateInfo{
-						Matched:  1,
-						Updated:  1,
-						Warnings: 0,
-					},
-				}}},
+				Query:    "set optimizer_switch = 'hash_join=off,DERIVED_MERGE=off'",
+				Expected: []sql.Row{{}},
 			},
 			{
-				Query: "SELECT * FROM setenumtest ORDER BY pk;",
+				Query:    "select @@optimizer_switch",
+				Expected: []sql.Row{{"index_merge=on,index_merge_union=on,index_merge_sort_union=on,index_merge_intersection=on,engine_condition_pushdown=on,index_condition_pushdown=on,mrr=on,mrr_cost_based=on,block_nested_loop=on,batched_key_access=off,materialization=on,semijoin=on,loosescan=on,firstmatch=on,duplicateweedout=on,subquery_materialization_cost_based=on,use_index_extensions=on,condition_fanout_filter=on,derived_merge=off,use_invisible_indexes=off,skip_scan=on,hash_join=off,subquery_to_derived=off,prefer_ordering_index=on,derived_condition_pushdown=on"}},
+			},
+			{
+				Query:    "set optimizer_switch = 'derived_merge=default'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@optimizer_switch like '%derived_merge=on%hash_join=off%'",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:       "set optimizer_switch = 'no_such_flag=on'",
+				ExpectedErr: sql.ErrInvalidSystemVariableValue,
+			},
+			{
+				Query:       "set optimizer_switch = 'hash_join=maybe'",
+				ExpectedErr: sql.ErrInvalidSystemVariableValue,
+			},
+			{
+				Query: "explain select /*+ HASH_JOIN(a,b) */ * from a join b on a.y = b.v",
 				Expected: []sql.Row{
-					{1, uint16(1), uint64(1)},
-					{2, uint16(2), uint64(2)},
-					{3, uint16(3), uint64(1)},
-					{4, uint16(1), uint64(3)},
+					{1, "SIMPLE", "a", nil, "ALL", nil, nil, nil, nil, 2, float64(100), nil},
+					{1, "SIMPLE", "b", nil, "ALL", nil, nil, nil, nil, 2, float64(10), "Using where"},
 				},
 			},
 			{
-				Query:    "DELETE FROM setenumtest WHERE v1 = 3;",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "select /*+ HASH_JOIN(a,b) */ x, u from a join b on a.y = b.v",
+				Expected: []sql.Row{{1, 1}},
 			},
 			{
-				Query:    "DELETE FROM setenumtest WHERE v2 = 3;",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "set optimizer_switch = 'default,derived_merge=off,semijoin=off'",
+				Expected: []sql.Row{{}},
 			},
 			{
-				Query: "SELECT * FROM setenumtest ORDER BY pk;",
+				Query: "explain select * from (select * from a) t where x = 1",
 				Expected: []sql.Row{
-					{1, uint16(1), uint64(1)},
-					{2, uint16(2), uint64(2)},
+					{1, "PRIMARY", "<derived2>", nil, "ALL", nil, nil, nil, nil, 1, float64(100), nil},
+					{2, "DERIVED", "a", nil, "const", "PRIMARY", "PRIMARY", "4", "const", 1, float64(100), nil},
 				},
 			},
+			{
+				Query:    "explain select x from a where y in (select v from b)",
+				Expected: []sql.Row{{1, "SIMPLE", "a", nil, "ALL", nil, nil, nil, nil, 2, float64(100), "Using where"}},
+			},
+			{
+				Query:    "select x from a where y in (select v from b)",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "set optimizer_switch = default",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "explain select * from (select * from a) t where x = 1",
+				Expected: []sql.Row{{1, "SIMPLE", "t", nil, "const", "PRIMARY", "PRIMARY", "4", "const", 1, float64(10), "Using where"}},
+			},
 		},
 	},
 	{
-		Name: "identical expressions over different windows should produce different results",
+		Name: "table maintenance statements",
 		SetUpScript: []string{
-			"CREATE TABLE t(a INT, b INT);",
-			"INSERT INTO t(a, b) VALUES (1, 1), (1, 2), (1, 3), (2, 4), (2, 5), (2, 6);",
+			"create table a (x int primary key, y varchar(10))",
+			"create table b (x int primary key, y varchar(10))",
+			"create table e (x int primary key)",
+			"insert into a values (1, 'one'), (2, null), (3, 'three')",
+			"insert into b values (3, 'three'), (2, null), (1, 'one')",
+			"create table parent (id int primary key)",
+			"create table child (id int primary key, pid int not null, u int, unique key (u), constraint fk_parent foreign key (pid) references parent (id), constraint chk_u check (u > 0))",
+			"insert into parent values (1)",
+			"insert into child values (1, 1, 1)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT SUM(b) OVER (PARTITION BY a ORDER BY b) FROM t ORDER BY 1;",
-				Expected: []sql.Row{{float64(1)}, {float64(3)}, {float64(4)}, {float64(6)}, {float64(9)}, {float64(15)}},
+				Query:    "checksum table a, b, e",
+				Expected: []sql.Row{{"mydb.a", int64(4023780364)}, {"mydb.b", int64(4023780364)}, {"mydb.e", int64(0)}},
 			},
 			{
-				Query:    "SELECT SUM(b) OVER (ORDER BY b) FROM t ORDER BY 1;",
-				Expected: []sql.Row{{float64(1)}, {float64(3)}, {float64(6)}, {float64(10)}, {float64(15)}, {float64(21)}},
+				Query:    "checksum table mydb.a extended",
+				Expected: []sql.Row{{"mydb.a", int64(4023780364)}},
 			},
 			{
-				Query: "SELECT SUM(b) OVER (PARTITION BY a ORDER BY b), SUM(b) OVER (ORDER BY b) FROM t ORDER BY 1;",
+				Query:    "checksum table a quick",
+				Expected: []sql.Row{{"mydb.a", nil}},
+			},
+			{
+				Query:    "checksum table nope",
+				Expected: []sql.Row{{"mydb.nope", nil}},
+			},
+			{
+				Query:    "show warnings",
+				Expected: []sql.Row{{"Error", 1146, "Table 'mydb.nope' doesn't exist"}},
+			},
+			{
+				Query:    "check table a, child",
+				Expected: []sql.Row{{"mydb.a", "check", "status", "OK"}, {"mydb.child", "check", "status", "OK"}},
+			},
+			{
+				Query: "check table nope quick",
 				Expected: []sql.Row{
-					{float64(1), float64(1)},
-					{float64(3), float64(3)},
-					{float64(4), float64(10)},
-					{float64(6), float64(6)},
-					{float64(9), float64(15)},
-					{float64(15), float64(21)},
+					{"mydb.nope", "check", "Error", "Table 'mydb.nope' doesn't exist"},
+					{"mydb.nope", "check", "status", "Operation failed"},
 				},
 			},
-		},
-	},
-	{
-		Name: "windows without ORDER BY should be treated as RANGE BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING",
-		SetUpScript: []string{
-			"CREATE TABLE t(a INT, b INT);",
-			"INSERT INTO t(a, b) VALUES (1, 1), (1, 2), (1, 3), (2, 4), (2, 5), (2, 6);",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT SUM(b) OVER (PARTITION BY a) FROM t ORDER BY 1;",
-				Expected: []sql.Row{{float64(6)}, {float64(6)}, {float64(6)}, {float64(15)}, {float64(15)}, {float64(15)}},
+				Query:    "set foreign_key_checks = 0",
+				Expected: []sql.Row{{}},
 			},
 			{
-				Query:    "SELECT SUM(b) OVER () FROM t ORDER BY 1;",
-				Expected: []sql.Row{{float64(21)}, {float64(21)}, {float64(21)}, {float64(21)}, {float64(21)}, {float64(21)}},
+				Query:    "insert into child values (2, 5, 2)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query: "SELECT SUM(b) OVER (PARTITION BY a), SUM(b) OVER () FROM t;",
+				Query: "check table child for upgrade",
 				Expected: []sql.Row{
-					{float64(6), float64(21)},
-					{float64(6), float64(21)},
-					{float64(6), float64(21)},
-					{float64(15), float64(21)},
-					{float64(15), float64(21)},
-					{float64(15), float64(21)},
+					{"mydb.child", "check", "error", "Foreign key constraint 'fk_parent' is violated"},
+					{"mydb.child", "check", "error", "Corrupt"},
 				},
 			},
-		},
-	},
-	{
-		Name: "decimal literals should be parsed correctly",
-		SetUpScript: []string{
-			"SET @testValue = 809826404100301269648758758005707100;",
-		},
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "SELECT @testValue;",
-				Expected: []sql.Row{{"809826404100301269648758758005707100"}},
+				Query:    "optimize local table a",
+				Expected: []sql.Row{{"mydb.a", "optimize", "note", "The storage engine for the table doesn't support optimize"}},
+			},
+			{
+				Query:       "checksum table a medium",
+				ExpectedErr: sql.ErrSyntaxError,
 			},
 		},
 	},
 	{
-		Name: "division and int division operation on negative, small and big value for decimal type column of table",
+		// The statements of a gh-ost migration of table t adding column b, as recorded from its general log
+		Name: "gh-ost migration",
 		SetUpScript: []string{
-			"create table t (d decimal(25,10) primary key);",
-			"insert into t values (-4990), (2), (22336578);",
+			"create table t (id int primary key, a varchar(10))",
+			"insert into t values (1, 'one'), (2, 'two'), (3, 'three')",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query:    "select d div 314990 from t order by d;",
-				Expected: []sql.Row{{0}, {0}, {70}},
+				Query:    "show /* gh-ost */ slave status",
+				Expected: []sql.Row{},
 			},
 			{
-				Query:    "select d / 314990 from t order by d;",
-				Expected: []sql.Row{{"-0.01584177275469"}, {"0.00000634940792"}, {"70.91202260389219"}},
+				Query:    "select @@global.log_bin, @@global.binlog_format, @@global.binlog_row_image, @@global.log_slave_updates",
+				Expected: []sql.Row{{0, "ROW", "FULL", 1}},
 			},
-		},
-	},
-	{
-		Name: "drop table if exists on unknown table shows warning",
-		Assertions: []ScriptTestAssertion{
 			{
-				Query:                           "DROP TABLE IF EXISTS non_existent_table;",
-				ExpectedWarning:                 1051,
-				ExpectedWarningsCount:           1,
-				ExpectedWarningMessageSubstring: "Unknown table 'non_existent_table'",
-				SkipResultsCheck:                true,
+				Query:    "set session sql_log_bin = 0",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "set session sql_log_bin = 1",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "set session innodb_lock_wait_timeout = 3, session lock_wait_timeout = 6",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "select @@session.sql_log_bin, @@session.innodb_lock_wait_timeout, @@session.lock_wait_timeout",
+				Expected: []sql.Row{{1, 3, 6}},
+			},
+			{
+				Query:    "create /* gh-ost */ table `mydb`.`_t_gho` like `mydb`.`t`",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "alter /* gh-ost */ table `mydb`.`_t_gho` add column b int not null default 0",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: `create /* gh-ost */ table ` + "`mydb`.`_t_ghc`" + ` (
+			id bigint unsigned auto_increment,
+			last_update timestamp not null DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+			hint varchar(64) charset ascii not null,
+			value varchar(4096) charset ascii not null,
+			primary key(id),
+			unique key hint_uidx(hint)
+		) auto_increment=256 comment='gh-ost changelog'`,
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert /* gh-ost */ into `mydb`.`_t_ghc` (id, hint, value) values (NULLIF(2, 0), 'state', 'GhostTableMigrated') on duplicate key update last_update=NOW(), value=VALUES(value)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 2}}},
+			},
+			{
+				Query:    "insert /* gh-ost */ into `mydb`.`_t_ghc` (id, hint, value) values (NULLIF(2, 0), 'state', 'AllEventsUpToLockProcessed') on duplicate key update last_update=NOW(), value=VALUES(value)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "select id, hint, value from `mydb`.`_t_ghc`",
+				Expected: []sql.Row{{uint64(2), "state", "AllEventsUpToLockProcessed"}},
+			},
+			{
+				Query:    "select /* gh-ost `mydb`.`t` */ `id` from `mydb`.`t` order by `id` asc limit 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select /* gh-ost `mydb`.`t` */ `id` from `mydb`.`t` order by `id` desc limit 1",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "insert /* gh-ost `mydb`.`t` */ ignore into `mydb`.`_t_gho` (`id`, `a`) (select `id`, `a` from `mydb`.`t` force index (`PRIMARY`) where (((`id` > 1)) or ((`id` = 1))) and (((`id` < 2)) or ((`id` = 2))) lock in share mode)",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				// A row inserted into t while the rows are copied, applied from the binary log
+				Query:    "replace /* gh-ost `mydb`.`_t_gho` */ into `mydb`.`_t_gho` (`id`, `a`) values (4, 'four')",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				// A row updated before it was copied
+				Query:    "replace /* gh-ost `mydb`.`_t_gho` */ into `mydb`.`_t_gho` (`id`, `a`) values (1, 'uno')",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "insert /* gh-ost `mydb`.`t` */ ignore into `mydb`.`_t_gho` (`id`, `a`) (select `id`, `a` from `mydb`.`t` force index (`PRIMARY`) where (((`id` > 2))) and (((`id` < 3)) or ((`id` = 3))) lock in share mode)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "update /* gh-ost `mydb`.`_t_gho` */ `mydb`.`_t_gho` set `id`=3, `a`='tres' where ((`id` = 3))",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "update /* gh-ost `mydb`.`_t_gho` */ `mydb`.`_t_gho` set `id`=3, `a`='tres' where ((`id` = 3))",
+				Expected: []sql.Row{{newUpdateResult(1, 0)}},
+			},
+			{
+				Query:    "delete /* gh-ost `mydb`.`_t_gho` */ from `mydb`.`_t_gho` where ((`id` = 2))",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "delete /* gh-ost `mydb`.`_t_gho` */ from `mydb`.`_t_gho` where ((`id` = 2))",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "create /* gh-ost */ table `mydb`.`_t_del` (id int auto_increment primary key) engine=InnoDB comment='ghost-cut-over-sentry'",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "lock /* gh-ost */ tables `mydb`.`t` write, `mydb`.`_t_del` write",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "drop /* gh-ost */ table if exists `mydb`.`_t_del`",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "unlock /* gh-ost */ tables",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "rename /* gh-ost */ table `mydb`.`t` to `mydb`.`_t_del`, `mydb`.`_t_gho` to `mydb`.`t`",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "drop /* gh-ost */ table if exists `mydb`.`_t_ghc`",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "drop /* gh-ost */ table if exists `mydb`.`_t_del`",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select * from t order by id",
+				Expected: []sql.Row{{1, "uno", 0}, {3, "tres", 0}, {4, "four", 0}},
 			},
 		},
 	},
@@ -3605,11 +5088,11 @@ var PreparedScriptTests = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:          "prepare s from 'prepare t from ?'",
-				ExpectedErrStr: "syntax error at position 17 near ':v1'",
+				ExpectedErrStr: "syntax error at line 1, column 17 near ':v1'",
 			},
 			{
 				Query:          "prepare s from 'a very real query'",
-				ExpectedErrStr: "syntax error at position 2 near 'a'",
+				ExpectedErrStr: "syntax error at line 1, column 2 near 'a'",
 			},
 			{
 				Query:       "deallocate prepare idontexist",
@@ -3765,15 +5248,15 @@ var PreparedScriptTests = []ScriptTest{
 			{
 				// non-existent vars is the same as preparing with NULL
 				Query:          "prepare stmt from @asdf",
-				ExpectedErrStr: "syntax error at position 5 near 'NULL'",
+				ExpectedErrStr: "syntax error at line 1, column 5 near 'NULL'",
 			},
 			{
 				Query:          "prepare stmt from @num",
-				ExpectedErrStr: "syntax error at position 4 near '123'",
+				ExpectedErrStr: "syntax error at line 1, column 4 near '123'",
 			},
 			{
 				Query:          "prepare stmt from @bad",
-				ExpectedErrStr: "syntax error at position 4 near 'bad'",
+				ExpectedErrStr: "syntax error at line 1, column 4 near 'bad'",
 			},
 			{
 				Query: "prepare stmt from @a",
@@ -3827,6 +5310,36 @@ var PreparedScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "prepared statements are prepared again after the tables they read change",
+		SetUpScript: []string{
+			"create table t (i int primary key)",
+			"insert into t values (1)",
+			"prepare s from 'select * from t'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "execute s",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "alter table t add column j int default 2",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "execute s",
+				Expected: []sql.Row{{1, 2}},
+			},
+			{
+				Query:    "drop table t",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:       "execute s",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+		},
+	},
 	{
 		Name: "Complex join query with foreign key constraints",
 		SetUpScript: []string{
@@ -0,0 +1,213 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var StoredFunctionScripts = []ScriptTest{
+	{
+		Name: "stored functions are called in expressions",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(20))",
+			"insert into t values (1, 'a'), (2, 'bb'), (3, 'ccc')",
+			"create function add_one(x int) returns int deterministic return x + 1",
+			`create function greet(name varchar(20)) returns varchar(30) charset utf8mb4 deterministic no sql
+begin
+	declare greeting varchar(30);
+	set greeting = concat('hello, ', name);
+	return greeting;
+end`,
+			`create function sign_of(x int) returns varchar(10)
+begin
+	if x < 0 then
+		return 'negative';
+	elseif x = 0 then
+		return 'zero';
+	end if;
+	return 'positive';
+end`,
+			"create function row_total() returns bigint reads sql data return (select sum(pk) from t)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select add_one(1), add_one(add_one(1))",
+				Expected: []sql.Row{{int32(2), int32(3)}},
+			},
+			{
+				Query:    "select pk, add_one(pk), greet(v) from t where add_one(pk) > 2 order by pk",
+				Expected: []sql.Row{{2, int32(3), "hello, bb"}, {3, int32(4), "hello, ccc"}},
+			},
+			{
+				Query:    "select sign_of(-5), sign_of(0), sign_of(5), mydb.sign_of(null)",
+				Expected: []sql.Row{{"negative", "zero", "positive", "positive"}},
+			},
+			{
+				Query:    "select row_total()",
+				Expected: []sql.Row{{int64(6)}},
+			},
+			{
+				Query:    "insert into t values (4, 'dddd')",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select row_total()",
+				Expected: []sql.Row{{int64(10)}},
+			},
+			{
+				Query:    "select add_one(row_total())",
+				Expected: []sql.Row{{int32(11)}},
+			},
+			{
+				Query:       "select add_one(1, 2)",
+				ExpectedErr: sql.ErrCallIncorrectParameterCount,
+			},
+			{
+				Query:       "select mydb.no_such_function(1)",
+				ExpectedErr: sql.ErrStoredFunctionDoesNotExist,
+			},
+			{
+				Query:       "create function add_one(x int) returns int return x",
+				ExpectedErr: sql.ErrStoredFunctionAlreadyExists,
+			},
+		},
+	},
+	{
+		Name: "stored functions and procedures have separate namespaces",
+		SetUpScript: []string{
+			"create function f() returns int return 1",
+			"create procedure f() select 2",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select f()",
+				Expected: []sql.Row{{int32(1)}},
+			},
+			{
+				Query:    "call f()",
+				Expected: []sql.Row{{int64(2)}},
+			},
+			{
+				Query:    "drop function f",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "select f()",
+				ExpectedErr: sql.ErrFunctionNotFound,
+			},
+			{
+				Query:    "call f()",
+				Expected: []sql.Row{{int64(2)}},
+			},
+			{
+				Query:       "drop function f",
+				ExpectedErr: sql.ErrStoredFunctionDoesNotExist,
+			},
+			{
+				Query:    "drop function if exists f",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "invalid stored functions",
+		SetUpScript: []string{
+			"create table t (pk int primary key)",
+			"create function ends_early(x int) returns int begin if x > 0 then return x; end if; end",
+			"create function calls_back(x int) returns int return x",
+			"create function calls_other(x int) returns int return calls_back(x)",
+			"drop function calls_back",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select ends_early(1)",
+				Expected: []sql.Row{{int32(1)}},
+			},
+			{
+				Query:       "select ends_early(0)",
+				ExpectedErr: sql.ErrStoredFunctionEndedWithoutReturn,
+			},
+			{
+				Query:       "create function calls_self(x int) returns int return calls_self(x - 1)",
+				ExpectedErr: sql.ErrStoredFunctionRecursion,
+			},
+			{
+				Query:       "create function calls_back(x int) returns int return calls_other(x)",
+				ExpectedErr: sql.ErrStoredFunctionRecursion,
+			},
+			{
+				Query:       "create function no_return() returns int begin declare x int; set x = 1; end",
+				ExpectedErr: sql.ErrStoredFunctionNoReturn,
+			},
+			{
+				Query:       "create function result_set() returns int begin select * from t; return 1; end",
+				ExpectedErr: sql.ErrStoredFunctionResultSet,
+			},
+			{
+				Query:       "create procedure p() return 1",
+				ExpectedErr: sql.ErrReturnOutsideFunction,
+			},
+			{
+				Query:       "create function f(x int) int return x",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+		},
+	},
+	{
+		Name: "stored functions in information_schema and SHOW statements",
+		SetUpScript: []string{
+			"create function add_one(x int) returns int deterministic return x + 1",
+			"create function name_of(x int) returns varchar(20) reads sql data comment 'names' return 'name'",
+			"create procedure p(in y int) select y",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select routine_name, routine_type, data_type, dtd_identifier, is_deterministic, sql_data_access, routine_comment from information_schema.routines where routine_schema = 'mydb' order by routine_name",
+				Expected: []sql.Row{
+					{"add_one", "FUNCTION", "int", "int", "YES", "CONTAINS SQL", ""},
+					{"name_of", "FUNCTION", "varchar", "varchar(20)", "NO", "READS SQL DATA", "names"},
+					{"p", "PROCEDURE", "", nil, "NO", "CONTAINS SQL", ""},
+				},
+			},
+			{
+				Query: "select specific_name, ordinal_position, parameter_mode, parameter_name, data_type, routine_type from information_schema.parameters where specific_schema = 'mydb' order by specific_name, ordinal_position",
+				Expected: []sql.Row{
+					{"add_one", uint64(0), nil, nil, "int", "FUNCTION"},
+					{"add_one", uint64(1), nil, "x", "int", "FUNCTION"},
+					{"name_of", uint64(0), nil, nil, "varchar", "FUNCTION"},
+					{"name_of", uint64(1), nil, "x", "int", "FUNCTION"},
+					{"p", uint64(1), "IN", "y", "int", "PROCEDURE"},
+				},
+			},
+			{
+				Query: "show create function add_one",
+				Expected: []sql.Row{{
+					"add_one",
+					"",
+					"create DEFINER=`root`@`localhost` function add_one(x int) returns int deterministic return x + 1",
+					"utf8mb4",
+					"utf8mb4_0900_bin",
+					"utf8mb4_0900_bin",
+				}},
+			},
+			{
+				Query:       "show create function p",
+				ExpectedErr: sql.ErrStoredFunctionDoesNotExist,
+			},
+		},
+	},
+}
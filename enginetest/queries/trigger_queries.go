@@ -1401,6 +1401,98 @@ end;`,
 			},
 		},
 	},
+	{
+		Name: "replace and insert on duplicate key update run the after triggers of the rows they delete and update",
+		SetUpScript: []string{
+			"create table t (id int primary key, a int)",
+			"create table log (n int primary key, ev varchar(100))",
+			"create trigger bi before insert on t for each row insert into log values ((select count(*) from log), concat('bi ', new.id, ' ', new.a))",
+			"create trigger ai after insert on t for each row insert into log values ((select count(*) from log), concat('ai ', new.id, ' ', new.a))",
+			"create trigger ad after delete on t for each row insert into log values ((select count(*) from log), concat('ad ', old.id, ' ', old.a))",
+			"create trigger au after update on t for each row insert into log values ((select count(*) from log), concat('au ', old.id, ' ', old.a, ' ', new.a))",
+			"insert into t values (1, 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "replace into t values (1, 2), (2, 2)",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "insert into t values (1, 5), (3, 3) on duplicate key update a = 9",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query: "select ev from log order by n",
+				Expected: []sql.Row{
+					{"bi 1 1"}, {"ai 1 1"},
+					{"bi 1 2"}, {"ad 1 1"}, {"ai 1 2"}, {"bi 2 2"}, {"ai 2 2"},
+					{"bi 1 5"}, {"au 1 2 9"}, {"bi 3 3"}, {"ai 3 3"},
+				},
+			},
+			{
+				Query:    "select * from t order by id",
+				Expected: []sql.Row{{1, 9}, {2, 2}, {3, 3}},
+			},
+		},
+	},
+	{
+		Name: "pt-online-schema-change copies a table through triggers",
+		SetUpScript: []string{
+			"create table t (id int primary key, a int)",
+			"insert into t values (1, 10), (2, 20), (3, 30)",
+			"create table `mydb`.`_t_new` like `mydb`.`t`",
+			"alter table `mydb`.`_t_new` add column b int default 0",
+			"CREATE TRIGGER `pt_osc_mydb_t_del` AFTER DELETE ON `mydb`.`t` FOR EACH ROW DELETE IGNORE FROM `mydb`.`_t_new` WHERE `mydb`.`_t_new`.`id` <=> OLD.`id`",
+			"CREATE TRIGGER `pt_osc_mydb_t_upd` AFTER UPDATE ON `mydb`.`t` FOR EACH ROW BEGIN DELETE IGNORE FROM `mydb`.`_t_new` WHERE !(OLD.`id` <=> NEW.`id`) AND `mydb`.`_t_new`.`id` <=> OLD.`id`; REPLACE INTO `mydb`.`_t_new` (`id`, `a`) VALUES (NEW.`id`, NEW.`a`); END",
+			"CREATE TRIGGER `pt_osc_mydb_t_ins` AFTER INSERT ON `mydb`.`t` FOR EACH ROW REPLACE INTO `mydb`.`_t_new` (`id`, `a`) VALUES (NEW.`id`, NEW.`a`)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t values (4, 40)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "INSERT LOW_PRIORITY IGNORE INTO `mydb`.`_t_new` (`id`, `a`) SELECT `id`, `a` FROM `mydb`.`t` FORCE INDEX(`PRIMARY`) WHERE ((`id` >= '1')) AND ((`id` <= '3')) LOCK IN SHARE MODE /*pt-online-schema-change 1234 copy nibble*/",
+				Expected: []sql.Row{{types.NewOkResult(3)}},
+			},
+			{
+				Query:    "update t set id = 5, a = 50 where id = 1",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "delete from t where id = 2",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "select * from _t_new order by id",
+				Expected: []sql.Row{{3, 30, 0}, {4, 40, 0}, {5, 50, 0}},
+			},
+			{
+				Query:    "RENAME TABLE `mydb`.`t` TO `mydb`.`_t_old`, `mydb`.`_t_new` TO `mydb`.`t`",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select trigger_name, event_object_table from information_schema.triggers order by 1",
+				Expected: []sql.Row{{"pt_osc_mydb_t_del", "_t_old"}, {"pt_osc_mydb_t_ins", "_t_old"}, {"pt_osc_mydb_t_upd", "_t_old"}},
+			},
+			{
+				Query:    "DROP TABLE IF EXISTS `mydb`.`_t_old`",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "DROP TRIGGER IF EXISTS `mydb`.`pt_osc_mydb_t_del`",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "show triggers",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from t order by id",
+				Expected: []sql.Row{{3, 30, 0}, {4, 40, 0}, {5, 50, 0}},
+			},
+		},
+	},
 	// Trigger with subquery
 	{
 		Name: "trigger before insert with subquery expressions",
@@ -1553,11 +1645,11 @@ end;`,
 					{
 						"a1", // Trigger
 						"",   // sql_mode
-						"create trigger a1 before insert on a for each row set new.x = new.x + 1", // SQL Original Statement
-						sql.Collation_Default.CharacterSet().String(),                             // character_set_client
-						sql.Collation_Default.String(),                                            // collation_connection
-						sql.Collation_Default.String(),                                            // Database Collation
-						time.Unix(0, 0).UTC(),                                                     // Created
+						"create DEFINER=`root`@`localhost` trigger a1 before insert on a for each row set new.x = new.x + 1", // SQL Original Statement
+						sql.Collation_Default.CharacterSet().String(),                                                        // character_set_client
+						sql.Collation_Default.String(),                                                                       // collation_connection
+						sql.Collation_Default.String(),                                                                       // Database Collation
+						time.Unix(0, 0).UTC(),                                                                                // Created
 					},
 				},
 			},
@@ -1567,11 +1659,11 @@ end;`,
 					{
 						"b1", // Trigger
 						"",   // sql_mode
-						"create trigger b1 before insert on b for each row set new.y = new.y + 2", // SQL Original Statement
-						sql.Collation_Default.CharacterSet().String(),                             // character_set_client
-						sql.Collation_Default.String(),                                            // collation_connection
-						sql.Collation_Default.String(),                                            // Database Collation
-						time.Unix(0, 0).UTC(),                                                     // Created
+						"create DEFINER=`root`@`localhost` trigger b1 before insert on b for each row set new.y = new.y + 2", // SQL Original Statement
+						sql.Collation_Default.CharacterSet().String(),                                                        // character_set_client
+						sql.Collation_Default.String(),                                                                       // collation_connection
+						sql.Collation_Default.String(),                                                                       // Database Collation
+						time.Unix(0, 0).UTC(),                                                                                // Created
 					},
 				},
 			},
@@ -1604,7 +1696,7 @@ end;`,
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
 						"",                      // sql_mode
-						"",                      // Definer
+						"root@localhost",        // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1617,7 +1709,7 @@ end;`,
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
 						"",                      // sql_mode
-						"",                      // Definer
+						"root@localhost",        // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1630,7 +1722,7 @@ end;`,
 						"AFTER",                          // Timing
 						time.Unix(0, 0).UTC(),            // Created
 						"",                               // sql_mode
-						"",                               // Definer
+						"root@localhost",                 // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1643,7 +1735,7 @@ end;`,
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
 						"",                      // sql_mode
-						"",                      // Definer
+						"root@localhost",        // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1661,7 +1753,7 @@ end;`,
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
 						"",                      // sql_mode
-						"",                      // Definer
+						"root@localhost",        // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1674,7 +1766,7 @@ end;`,
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
 						"",                      // sql_mode
-						"",                      // Definer
+						"root@localhost",        // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1687,7 +1779,7 @@ end;`,
 						"AFTER",                          // Timing
 						time.Unix(0, 0).UTC(),            // Created
 						"",                               // sql_mode
-						"",                               // Definer
+						"root@localhost",                 // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1700,7 +1792,7 @@ end;`,
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
 						"",                      // sql_mode
-						"",                      // Definer
+						"root@localhost",        // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1718,7 +1810,7 @@ end;`,
 						"AFTER",                          // Timing
 						time.Unix(0, 0).UTC(),            // Created
 						"",                               // sql_mode
-						"",                               // Definer
+						"root@localhost",                 // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1731,7 +1823,7 @@ end;`,
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
 						"",                      // sql_mode
-						"",                      // Definer
+						"root@localhost",        // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1749,7 +1841,7 @@ end;`,
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
 						"",                      // sql_mode
-						"",                      // Definer
+						"root@localhost",        // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1762,7 +1854,7 @@ end;`,
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
 						"",                      // sql_mode
-						"",                      // Definer
+						"root@localhost",        // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1775,7 +1867,7 @@ end;`,
 						"AFTER",                          // Timing
 						time.Unix(0, 0).UTC(),            // Created
 						"",                               // sql_mode
-						"",                               // Definer
+						"root@localhost",                 // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1793,7 +1885,7 @@ end;`,
 						"AFTER",                          // Timing
 						time.Unix(0, 0).UTC(),            // Created
 						"",                               // sql_mode
-						"",                               // Definer
+						"root@localhost",                 // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1811,7 +1903,7 @@ end;`,
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
 						"",                      // sql_mode
-						"",                      // Definer
+						"root@localhost",        // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -1824,7 +1916,7 @@ end;`,
 						"BEFORE",                // Timing
 						time.Unix(0, 0).UTC(),   // Created
 						"",                      // sql_mode
-						"",                      // Definer
+						"root@localhost",        // Definer
 						sql.Collation_Default.CharacterSet().String(), // character_set_client
 						sql.Collation_Default.String(),                // collation_connection
 						sql.Collation_Default.String(),                // Database Collation
@@ -258,7 +258,7 @@ var UpdateTests = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          `UPDATE one_pk INNER JOIN two_pk on one_pk.pk = two_pk.pk1 SET one_pk.c1 = one_pk.c1 + 1, two_pk.c1 = two_pk.c2 + 1`,
-		ExpectedWriteResult: []sql.Row{{newUpdateResult(8, 6)}}, // TODO: Should be matched = 6
+		ExpectedWriteResult: []sql.Row{{newUpdateResult(6, 6)}},
 		SelectQuery:         "SELECT * FROM two_pk;",
 		ExpectedSelect: []sql.Row{
 			sql.NewRow(0, 0, 2, 1, 2, 3, 4),
@@ -475,7 +475,7 @@ var SpatialUpdateTests = []WriteQueryTest{
 var SkippedUpdateTests = []WriteQueryTest{
 	{
 		WriteQuery:          `UPDATE one_pk INNER JOIN two_pk on one_pk.pk = two_pk.pk1 SET one_pk.c1 = one_pk.c1 + 1, two_pk.c1 = two_pk.c2 + 1`,
-		ExpectedWriteResult: []sql.Row{{newUpdateResult(8, 6)}}, // TODO: Should be matched = 6
+		ExpectedWriteResult: []sql.Row{{newUpdateResult(6, 6)}},
 		SelectQuery:         "SELECT * FROM two_pk;",
 		ExpectedSelect: []sql.Row{
 			sql.NewRow(0, 0, 2, 1, 2, 3, 4),
@@ -503,6 +503,13 @@ func newUpdateResult(matched, updated int) types.OkResult {
 	}
 }
 
+func newUpdateResultWithWarnings(matched, updated, warnings int) types.OkResult {
+	return types.OkResult{
+		RowsAffected: uint64(updated),
+		Info:         plan.UpdateInfo{matched, updated, warnings},
+	}
+}
+
 var GenericUpdateErrorTests = []GenericErrorQueryTest{
 	{
 		Name:  "invalid table",
@@ -569,7 +576,7 @@ var GenericUpdateErrorTests = []GenericErrorQueryTest{
 var UpdateIgnoreTests = []WriteQueryTest{
 	{
 		WriteQuery:          "UPDATE IGNORE mytable SET i = 2 where i = 1",
-		ExpectedWriteResult: []sql.Row{{newUpdateResult(1, 0)}},
+		ExpectedWriteResult: []sql.Row{{newUpdateResultWithWarnings(1, 0, 1)}},
 		SelectQuery:         "SELECT * FROM mytable order by i",
 		ExpectedSelect: []sql.Row{
 			sql.NewRow(1, "first row"),
@@ -579,7 +586,7 @@ var UpdateIgnoreTests = []WriteQueryTest{
 	},
 	{
 		WriteQuery:          "UPDATE IGNORE mytable SET i = i+1 where i = 1",
-		ExpectedWriteResult: []sql.Row{{newUpdateResult(1, 0)}},
+		ExpectedWriteResult: []sql.Row{{newUpdateResultWithWarnings(1, 0, 1)}},
 		SelectQuery:         "SELECT * FROM mytable order by i",
 		ExpectedSelect: []sql.Row{
 			sql.NewRow(1, "first row"),
@@ -601,7 +608,7 @@ var UpdateIgnoreScripts = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:           "UPDATE IGNORE pkTable set pk = pk + 1, val = val + 1",
-				Expected:        []sql.Row{{newUpdateResult(3, 1)}},
+				Expected:        []sql.Row{{newUpdateResultWithWarnings(3, 1, 2)}},
 				ExpectedWarning: mysql.ERDupEntry,
 			},
 			{
@@ -610,7 +617,7 @@ var UpdateIgnoreScripts = []ScriptTest{
 			},
 			{
 				Query:           "UPDATE IGNORE idxTable set val = val + 1",
-				Expected:        []sql.Row{{newUpdateResult(3, 1)}},
+				Expected:        []sql.Row{{newUpdateResultWithWarnings(3, 1, 2)}},
 				ExpectedWarning: mysql.ERDupEntry,
 			},
 			{
@@ -627,7 +634,7 @@ var UpdateIgnoreScripts = []ScriptTest{
 			},
 			{
 				Query:           "UPDATE IGNORE pkTable SET pk = NULL",
-				Expected:        []sql.Row{{newUpdateResult(3, 3)}},
+				Expected:        []sql.Row{{newUpdateResultWithWarnings(3, 3, 3)}},
 				ExpectedWarning: mysql.ERBadNullError,
 			},
 			{
@@ -636,7 +643,7 @@ var UpdateIgnoreScripts = []ScriptTest{
 			},
 			{
 				Query:    "UPDATE IGNORE pkTable SET val = NULL",
-				Expected: []sql.Row{{newUpdateResult(3, 1)}},
+				Expected: []sql.Row{{newUpdateResultWithWarnings(3, 1, 5)}},
 			},
 			{
 				Query:    "SELECT * FROM pkTable order by pk",
@@ -644,7 +651,7 @@ var UpdateIgnoreScripts = []ScriptTest{
 			},
 			{
 				Query:           "UPDATE IGNORE idxTable set pk = pk + 1, val = val + 1", // two bad updates
-				Expected:        []sql.Row{{newUpdateResult(3, 1)}},
+				Expected:        []sql.Row{{newUpdateResultWithWarnings(3, 1, 2)}},
 				ExpectedWarning: mysql.ERDupEntry,
 			},
 			{
@@ -662,7 +669,7 @@ var UpdateIgnoreScripts = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:           "UPDATE IGNORE t1 SET v1 = 'dsddads'",
-				Expected:        []sql.Row{{newUpdateResult(1, 1)}},
+				Expected:        []sql.Row{{newUpdateResultWithWarnings(1, 1, 1)}},
 				ExpectedWarning: mysql.ERTruncatedWrongValueForField,
 			},
 			{
@@ -671,7 +678,7 @@ var UpdateIgnoreScripts = []ScriptTest{
 			},
 			{
 				Query:           "UPDATE IGNORE t1 SET pk = 'dasda', v2 = 'dsddads'",
-				Expected:        []sql.Row{{newUpdateResult(1, 1)}},
+				Expected:        []sql.Row{{newUpdateResultWithWarnings(1, 1, 2)}},
 				ExpectedWarning: mysql.ERTruncatedWrongValueForField,
 			},
 			{
@@ -691,7 +698,7 @@ var UpdateIgnoreScripts = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:           "UPDATE IGNORE objects SET color = 'orange' where id = 2",
-				Expected:        []sql.Row{{newUpdateResult(1, 0)}},
+				Expected:        []sql.Row{{newUpdateResultWithWarnings(1, 0, 1)}},
 				ExpectedWarning: mysql.ErNoReferencedRow2,
 			},
 			{
@@ -710,8 +717,8 @@ var UpdateIgnoreScripts = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:           "UPDATE IGNORE checksTable SET pk = pk + 1 where pk = 4",
-				Expected:        []sql.Row{{newUpdateResult(1, 0)}},
-				ExpectedWarning: mysql.ERUnknownError,
+				Expected:        []sql.Row{{newUpdateResultWithWarnings(1, 0, 1)}},
+				ExpectedWarning: 3819,
 			},
 			{
 				Query:    "SELECT * from checksTable ORDER BY pk",
@@ -14,7 +14,10 @@
 
 package queries
 
-import "github.com/dolthub/go-mysql-server/sql"
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
 
 var ViewScripts = []ScriptTest{
 	{
@@ -47,4 +50,180 @@ var ViewScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "insert, update and delete through updatable views",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int primary key, b int, c varchar(10));",
+			"INSERT INTO t VALUES (1, 1, 'one'), (2, 2, 'two'), (3, -3, 'neg');",
+			"CREATE VIEW v AS SELECT a, b AS x FROM t WHERE b > 0;",
+			"CREATE VIEW vexpr AS SELECT a, b + 1 AS bb FROM t;",
+			"CREATE VIEW vagg AS SELECT count(*) AS cnt FROM t;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO v VALUES (4, 4);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "INSERT INTO v (x, a) VALUES (5, 5);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "UPDATE v SET x = x * 10 WHERE a < 4;",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "UPDATE v AS alias SET alias.x = 0 WHERE alias.a = 5;",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				// rows not visible through the view aren't deleted
+				Query:    "DELETE FROM v WHERE a > 2;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query: "SELECT * FROM t ORDER BY a;",
+				Expected: []sql.Row{
+					{1, 10, "one"},
+					{2, 20, "two"},
+					{3, -3, "neg"},
+					{5, 0, nil},
+				},
+			},
+			{
+				Query:    "INSERT INTO v VALUES (1, 1) ON DUPLICATE KEY UPDATE x = x + 1;",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT * FROM v WHERE a = 1;",
+				Expected: []sql.Row{{1, 11}},
+			},
+			{
+				Query:       "INSERT INTO vexpr VALUES (6, 6);",
+				ExpectedErr: sql.ErrViewColumnNotUpdatable,
+			},
+			{
+				Query:       "UPDATE vexpr SET bb = 1;",
+				ExpectedErr: sql.ErrViewColumnNotUpdatable,
+			},
+			{
+				Query:    "UPDATE vexpr SET a = 6 WHERE bb = 1;",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:       "INSERT INTO vagg VALUES (1);",
+				ExpectedErr: sql.ErrViewNotUpdatable,
+			},
+			{
+				Query:       "DELETE FROM vagg;",
+				ExpectedErr: sql.ErrViewNotUpdatable,
+			},
+		},
+	},
+	{
+		Name: "ALTER VIEW",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int primary key, b int)",
+			"INSERT INTO t VALUES (1, 10), (2, 20)",
+			"CREATE VIEW v AS SELECT a FROM t",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "ALTER VIEW v AS SELECT a, b FROM t WHERE a > 1",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM v",
+				Expected: []sql.Row{{2, 20}},
+			},
+			{
+				Query:    "SHOW CREATE VIEW v",
+				Expected: []sql.Row{{"v", "CREATE VIEW `v` AS SELECT a, b FROM t WHERE a > 1", "utf8mb4", "utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "ALTER SQL SECURITY INVOKER VIEW v AS SELECT b FROM t WHERE b < 20 WITH CHECK OPTION",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM v",
+				Expected: []sql.Row{{10}},
+			},
+			{
+				Query:    "SELECT security_type, check_option FROM information_schema.views WHERE table_name = 'v'",
+				Expected: []sql.Row{{"INVOKER", "CASCADED"}},
+			},
+			{
+				Query:       "ALTER VIEW missing AS SELECT 1",
+				ExpectedErr: sql.ErrViewDoesNotExist,
+			},
+		},
+	},
+	{
+		Name: "views WITH CHECK OPTION",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int primary key, b int);",
+			"CREATE VIEW vpos AS SELECT * FROM t WHERE b > 0 WITH CHECK OPTION;",
+			"CREATE VIEW vlocal AS SELECT * FROM vpos WHERE a < 100 WITH LOCAL CHECK OPTION;",
+			"CREATE VIEW vcascaded AS SELECT * FROM vpos WHERE a < 100 WITH CASCADED CHECK OPTION;",
+			"CREATE VIEW vnone AS SELECT * FROM vpos WHERE a < 100;",
+			"CREATE VIEW vunchecked AS SELECT * FROM t WHERE b > 0;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO vpos VALUES (1, 1);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:       "INSERT INTO vpos VALUES (2, -2);",
+				ExpectedErr: sql.ErrViewCheckOptionFailed,
+			},
+			{
+				Query:       "UPDATE vpos SET b = -1 WHERE a = 1;",
+				ExpectedErr: sql.ErrViewCheckOptionFailed,
+			},
+			{
+				Query:       "INSERT INTO vlocal VALUES (200, 1);",
+				ExpectedErr: sql.ErrViewCheckOptionFailed,
+			},
+			{
+				// the underlying view's own check option still applies
+				Query:       "INSERT INTO vlocal VALUES (3, -3);",
+				ExpectedErr: sql.ErrViewCheckOptionFailed,
+			},
+			{
+				Query:       "INSERT INTO vcascaded VALUES (200, 1);",
+				ExpectedErr: sql.ErrViewCheckOptionFailed,
+			},
+			{
+				Query:    "INSERT INTO vnone VALUES (200, 1);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:       "INSERT INTO vnone VALUES (300, -1);",
+				ExpectedErr: sql.ErrViewCheckOptionFailed,
+			},
+			{
+				Query:    "INSERT INTO vunchecked VALUES (4, -4);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "INSERT IGNORE INTO vpos VALUES (5, -5);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY a;",
+				Expected: []sql.Row{{1, 1}, {4, -4}, {200, 1}},
+			},
+			{
+				Query: "SELECT table_name, check_option, is_updatable FROM information_schema.views WHERE table_name LIKE 'v%' ORDER BY table_name;",
+				Expected: []sql.Row{
+					{"vcascaded", "CASCADED", "YES"},
+					{"vlocal", "LOCAL", "YES"},
+					{"vnone", "NONE", "YES"},
+					{"vpos", "CASCADED", "YES"},
+					{"vunchecked", "NONE", "YES"},
+				},
+			},
+		},
+	},
 }
@@ -0,0 +1,176 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queries
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+var XATransactionScripts = []ScriptTest{
+	{
+		Name: "XA transaction lifecycle",
+		SetUpScript: []string{
+			"create table t (pk int primary key)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "xa start 'xatest'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "insert into t values (1)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:       "xa start 'other'",
+				ExpectedErr: sql.ErrXAInvalidState,
+			},
+			{
+				Query:       "commit",
+				ExpectedErr: sql.ErrXAInvalidState,
+			},
+			{
+				Query:       "xa prepare 'xatest'",
+				ExpectedErr: sql.ErrXAInvalidState,
+			},
+			{
+				Query:       "xa end 'other'",
+				ExpectedErr: sql.ErrXAUnknownXID,
+			},
+			{
+				Query:    "xa end 'xatest'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "select * from t",
+				ExpectedErr: sql.ErrXAInvalidState,
+			},
+			{
+				Query:       "xa commit 'xatest'",
+				ExpectedErr: sql.ErrXAInvalidState,
+			},
+			{
+				Query:    "xa prepare 'xatest'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "xa recover",
+				Expected: []sql.Row{{int64(1), int64(6), int64(0), "xatest"}},
+			},
+			{
+				Query:    "xa recover convert xid",
+				Expected: []sql.Row{{int64(1), int64(6), int64(0), "0x786174657374"}},
+			},
+			{
+				// A prepared transaction is no longer associated with the session
+				Query:    "select * from t",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:       "xa start 'xatest'",
+				ExpectedErr: sql.ErrXADuplicateXID,
+			},
+			{
+				Query:    "xa commit 'xatest'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "xa recover",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "xa commit 'xatest'",
+				ExpectedErr: sql.ErrXAUnknownXID,
+			},
+		},
+	},
+	{
+		Name: "XA one phase commit and rollback",
+		SetUpScript: []string{
+			"create table t (pk int primary key)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "xa begin 'g', 'b', 2",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "insert into t values (1)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:       "xa commit 'g', 'b', 2 one phase",
+				ExpectedErr: sql.ErrXAInvalidState,
+			},
+			{
+				Query:    "xa end 'g', 'b', 2",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "xa rollback 'g', 'b'",
+				ExpectedErr: sql.ErrXAUnknownXID,
+			},
+			{
+				Query:    "xa commit 'g', 'b', 2 one phase",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "xa start 'r'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "xa rollback 'r'",
+				ExpectedErr: sql.ErrXAInvalidState,
+			},
+			{
+				Query:    "xa end 'r'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "xa rollback 'r'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "xa start x'01ff', 'b', 3",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "xa end x'01ff', 'b', 3",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "xa prepare x'01ff', 'b', 3",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "xa recover convert xid",
+				Expected: []sql.Row{{int64(3), int64(2), int64(1), "0x01FF62"}},
+			},
+			{
+				Query:       "xa commit x'01ff', 'b', 3 one phase",
+				ExpectedErr: sql.ErrXAUnknownXID,
+			},
+			{
+				Query:    "xa rollback x'01ff', 'b', 3",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "xa recover",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+}
@@ -384,7 +384,7 @@ func evalSpatialIndexPlanTest(t *testing.T, harness Harness, e *sqle.Engine, que
 		a, err := e.AnalyzeQuery(ctx, query)
 		require.NoError(t, err)
 
-		hasFilter, hasIndex, hasRightOrder := false, false, false
+		hasFilter, hasIndex, hasSpatialIndex, hasRightOrder := false, false, false, false
 		transform.Inspect(a, func(n sql.Node) bool {
 			if n == nil {
 				return false
@@ -392,16 +392,18 @@ func evalSpatialIndexPlanTest(t *testing.T, harness Harness, e *sqle.Engine, que
 			if _, ok := n.(*plan.Filter); ok {
 				hasFilter = true
 			}
-			if _, ok := n.(*plan.IndexedTableAccess); ok {
+			if ita, ok := n.(*plan.IndexedTableAccess); ok {
 				hasRightOrder = hasFilter
 				hasIndex = true
+				hasSpatialIndex = hasSpatialIndex || ita.Index().IsSpatial()
 			}
 			return true
 		})
 
 		require.True(t, hasFilter, fmt.Sprintf("filter node was missing from plan"))
 		if noIdx {
-			require.False(t, hasIndex, fmt.Sprintf("indextableaccess should not be in plan"))
+			// Another index may still be read to return rows in order
+			require.False(t, hasSpatialIndex, fmt.Sprintf("indextableaccess should not be in plan"))
 		} else {
 			require.True(t, hasIndex, fmt.Sprintf("indextableaccess node was missing from plan"))
 			require.True(t, hasRightOrder, fmt.Sprintf("filter node was not above indextableaccess"))
@@ -508,7 +510,7 @@ func evalSpatialIndexPlanTestPrepared(t *testing.T, harness Harness, e *sqle.Eng
 		a, _, err := e.Analyzer.AnalyzePrepared(ctx, p, nil)
 		require.NoError(t, err)
 
-		hasFilter, hasIndex, hasRightOrder := false, false, false
+		hasFilter, hasIndex, hasSpatialIndex, hasRightOrder := false, false, false, false
 		transform.Inspect(a, func(n sql.Node) bool {
 			if n == nil {
 				return false
@@ -516,16 +518,18 @@ func evalSpatialIndexPlanTestPrepared(t *testing.T, harness Harness, e *sqle.Eng
 			if _, ok := n.(*plan.Filter); ok {
 				hasFilter = true
 			}
-			if _, ok := n.(*plan.IndexedTableAccess); ok {
+			if ita, ok := n.(*plan.IndexedTableAccess); ok {
 				hasRightOrder = hasFilter
 				hasIndex = true
+				hasSpatialIndex = hasSpatialIndex || ita.Index().IsSpatial()
 			}
 			return true
 		})
 
 		require.True(t, hasFilter, fmt.Sprintf("filter node was missing from plan"))
 		if noIdx {
-			require.False(t, hasIndex, fmt.Sprintf("indextableaccess should not be in plan"))
+			// Another index may still be read to return rows in order
+			require.False(t, hasSpatialIndex, fmt.Sprintf("indextableaccess should not be in plan"))
 		} else {
 			require.True(t, hasIndex, fmt.Sprintf("indextableaccess node was missing from plan"))
 			require.True(t, hasRightOrder, fmt.Sprintf("filter node was not above indextableaccess"))
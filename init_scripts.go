@@ -0,0 +1,123 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+)
+
+// InitScript is a script of SQL statements run by the engine at startup, before it serves any client, to create and
+// seed the databases of the integrator.
+type InitScript struct {
+	// Name identifies the script in the errors of its statements.
+	Name string
+	// SQL is the text of the script, holding statements separated by semicolons. Compound statements like CREATE
+	// PROCEDURE may hold semicolons in their BEGIN ... END blocks, as with multi-statement queries sent by clients.
+	SQL string
+	// Guard is a query run before the script, which is skipped if the query returns any rows. It keeps a script from
+	// being applied twice to databases that persist across restarts of the engine, e.g. a query of
+	// information_schema.tables for a table the script creates. Scripts without a guard always run.
+	Guard string
+}
+
+// InitScriptsFromFS returns the scripts of the files of the file system given matching the pattern given, in the
+// lexical order of their paths, which name the scripts. The pattern has the syntax of fs.Glob.
+func InitScriptsFromFS(fsys fs.FS, pattern string) ([]InitScript, error) {
+	paths, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+	scripts := make([]InitScript, 0, len(paths))
+	for _, path := range paths {
+		contents, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return nil, err
+		}
+		scripts = append(scripts, InitScript{Name: path, SQL: string(contents)})
+	}
+	return scripts, nil
+}
+
+// NewBootstrapContext returns the context of a new session to run the init scripts of the engine in, whose client is
+// root@localhost, so that the scripts run with full privileges when authentication is enabled.
+func (e *Engine) NewBootstrapContext() *sql.Context {
+	session := sql.NewBaseSessionWithClientServer("", sql.Client{User: "root", Address: "localhost"}, 0)
+	return sql.NewContext(context.Background(), sql.WithSession(session), sql.WithProcessList(e.ProcessList),
+		sql.WithServiceRegistry(e.Services))
+}
+
+// RunInitScripts runs the init scripts of the engine in the context given, in order. Scripts are only run once by the
+// engine: after a script fails, the following call runs the scripts from the one that failed, whose statements that
+// succeeded aren't rolled back, and later calls do nothing once they've all run. The error of a failed statement names
+// its script and position in the script.
+func (e *Engine) RunInitScripts(ctx *sql.Context) error {
+	e.initScriptsMu.Lock()
+	defer e.initScriptsMu.Unlock()
+
+	for e.initScriptsRun < len(e.InitScripts) {
+		script := e.InitScripts[e.initScriptsRun]
+		if err := e.runInitScript(ctx, script); err != nil {
+			return err
+		}
+		e.initScriptsRun++
+	}
+	return nil
+}
+
+// runInitScript runs the statements of the script given, unless its guard returns rows.
+func (e *Engine) runInitScript(ctx *sql.Context, script InitScript) error {
+	if strings.TrimSpace(script.Guard) != "" {
+		rows, err := e.runInitStatement(ctx, script.Guard, nil)
+		if err != nil {
+			return fmt.Errorf("guard of init script %s failed: %w", script.Name, err)
+		}
+		if len(rows) > 0 {
+			ctx.GetLogger().Debugf("skipping init script %s, whose guard returned rows", script.Name)
+			return nil
+		}
+	}
+
+	remainder := script.SQL
+	for i := 1; strings.TrimSpace(remainder) != ""; i++ {
+		parsed, query, rest, err := parse.ParseOne(ctx, remainder)
+		if err == nil {
+			if query == "" {
+				query = remainder
+			}
+			_, err = e.runInitStatement(ctx, query, parsed)
+		}
+		if err != nil {
+			return fmt.Errorf("init script %s failed at statement %d: %w", script.Name, i, err)
+		}
+		remainder = rest
+	}
+	return nil
+}
+
+// runInitStatement runs the statement given to completion and returns its rows.
+func (e *Engine) runInitStatement(ctx *sql.Context, query string, parsed sql.Node) ([]sql.Row, error) {
+	ctx = ctx.WithQuery(query)
+	sch, iter, err := e.QueryNodeWithBindings(ctx, query, parsed, nil)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowIterToRows(ctx, sch, iter)
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// EnableMaterializedViews registers a new registry of materialized views in the engine's services, which enables the
+// CREATE, REFRESH and DROP MATERIALIZED VIEW statements, and returns it. The registry already registered is returned
+// if there's one.
+func (e *Engine) EnableMaterializedViews() *sql.MaterializedViewRegistry {
+	if registry, ok := sql.LookupService(e.Services, sql.MaterializedViewsService); ok {
+		return registry
+	}
+	registry := sql.NewMaterializedViewRegistry()
+	sql.RegisterService(e.Services, sql.MaterializedViewsService, registry)
+	return registry
+}
+
+// RefreshMaterializedViews refreshes the materialized views whose REFRESH EVERY interval has elapsed since they were
+// last refreshed, running a REFRESH MATERIALIZED VIEW statement for each of them in the context given. It returns the
+// first error refreshing a view, after trying to refresh the others.
+func (e *Engine) RefreshMaterializedViews(ctx *sql.Context) error {
+	registry, ok := sql.LookupService(e.Services, sql.MaterializedViewsService)
+	if !ok {
+		return nil
+	}
+
+	var firstErr error
+	now := time.Now()
+	for _, view := range registry.AllViews() {
+		if !view.IsRefreshDue(now) {
+			continue
+		}
+		query := fmt.Sprintf("REFRESH MATERIALIZED VIEW %s.%s", quoteMaterializedViewIdentifier(view.Database),
+			quoteMaterializedViewIdentifier(view.Name))
+		sch, iter, err := e.Query(ctx, query)
+		if err == nil {
+			_, err = sql.RowIterToRows(ctx, sch, iter)
+		}
+		if err != nil {
+			ctx.GetLogger().WithError(err).Warnf("scheduled refresh of materialized view %s.%s failed", view.Database, view.Name)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// ScheduleMaterializedViewRefreshes starts a background thread of the engine checking for due refreshes of
+// materialized views every interval given, and refreshing them with RefreshMaterializedViews in a context returned by
+// the function given. The thread stops when the engine is closed.
+func (e *Engine) ScheduleMaterializedViewRefreshes(interval time.Duration, newContext func(context.Context) (*sql.Context, error)) error {
+	return e.BackgroundThreads.Add("materialized view refresh", func(ctx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				sqlCtx, err := newContext(ctx)
+				if err != nil {
+					logrus.WithError(err).Warn("cannot refresh materialized views")
+					continue
+				}
+				e.RefreshMaterializedViews(sqlCtx)
+			}
+		}
+	})
+}
+
+func quoteMaterializedViewIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
@@ -16,8 +16,8 @@ package memory
 
 import (
 	"strings"
+	"sync"
 
-	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -41,18 +41,26 @@ var _ sql.TableDropper = (*Database)(nil)
 var _ sql.TableRenamer = (*Database)(nil)
 var _ sql.TriggerDatabase = (*Database)(nil)
 var _ sql.StoredProcedureDatabase = (*Database)(nil)
+var _ sql.StoredFunctionDatabase = (*Database)(nil)
 var _ sql.ViewDatabase = (*Database)(nil)
 var _ sql.CollatedDatabase = (*Database)(nil)
+var _ sql.EncryptedDatabase = (*Database)(nil)
+var _ sql.TemporaryTableCreator = (*Database)(nil)
+var _ sql.TemporaryTableDatabase = (*Database)(nil)
 
 // BaseDatabase is an in-memory database that can't store views, only for testing the engine
 type BaseDatabase struct {
 	name              string
 	tables            map[string]sql.Table
+	tempTables        map[uint32]map[string]sql.Table
+	tempTablesMu      *sync.RWMutex
 	fkColl            *ForeignKeyCollection
 	triggers          []sql.TriggerDefinition
 	storedProcedures  []sql.StoredProcedureDetails
+	storedFunctions   []sql.StoredProcedureDetails
 	primaryKeyIndexes bool
 	collation         sql.CollationID
+	encrypted         bool
 }
 
 var _ MemoryDatabase = (*Database)(nil)
@@ -69,9 +77,11 @@ func NewDatabase(name string) *Database {
 // NewViewlessDatabase creates a new database that doesn't persist views. Used only for testing. Use NewDatabase.
 func NewViewlessDatabase(name string) *BaseDatabase {
 	return &BaseDatabase{
-		name:   name,
-		tables: map[string]sql.Table{},
-		fkColl: newForeignKeyCollection(),
+		name:         name,
+		tables:       map[string]sql.Table{},
+		tempTables:   map[uint32]map[string]sql.Table{},
+		tempTablesMu: &sync.RWMutex{},
+		fkColl:       newForeignKeyCollection(),
 	}
 }
 
@@ -90,7 +100,12 @@ func (d *BaseDatabase) Tables() map[string]sql.Table {
 	return d.tables
 }
 
+// GetTableInsensitive implements the interface sql.Database. Temporary tables belonging to the session shadow permanent
+// tables with the same name.
 func (d *BaseDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	if tbl, ok := d.getTemporaryTable(ctx, tblName); ok {
+		return tbl, true, nil
+	}
 	tbl, ok := sql.GetTableInsensitive(tblName, d.tables)
 	return tbl, ok, nil
 }
@@ -104,6 +119,95 @@ func (d *BaseDatabase) GetTableNames(ctx *sql.Context) ([]string, error) {
 	return tblNames, nil
 }
 
+// getTemporaryTable returns the temporary table with the name given, if the session of the context given has one.
+func (d *BaseDatabase) getTemporaryTable(ctx *sql.Context, tblName string) (sql.Table, bool) {
+	if ctx == nil || ctx.Session == nil {
+		return nil, false
+	}
+	d.tempTablesMu.RLock()
+	defer d.tempTablesMu.RUnlock()
+	return sql.GetTableInsensitive(tblName, d.tempTables[ctx.Session.ID()])
+}
+
+// dropTemporaryTable drops the temporary table with the name given, returning whether the session of the context
+// given had one. The session's tables are forgotten along with its last one.
+func (d *BaseDatabase) dropTemporaryTable(ctx *sql.Context, tblName string) bool {
+	if ctx == nil || ctx.Session == nil {
+		return false
+	}
+	d.tempTablesMu.Lock()
+	defer d.tempTablesMu.Unlock()
+
+	sessionID := ctx.Session.ID()
+	tbl, ok := sql.GetTableInsensitive(tblName, d.tempTables[sessionID])
+	if !ok {
+		return false
+	}
+	delete(d.tempTables[sessionID], tbl.Name())
+	if len(d.tempTables[sessionID]) == 0 {
+		delete(d.tempTables, sessionID)
+	}
+	return true
+}
+
+// CreateTemporaryTable implements the interface sql.TemporaryTableCreator. Temporary tables are visible only to the
+// session that created them.
+func (d *BaseDatabase) CreateTemporaryTable(ctx *sql.Context, name string, schema sql.PrimaryKeySchema, collation sql.CollationID) error {
+	d.tempTablesMu.Lock()
+	defer d.tempTablesMu.Unlock()
+
+	sessionID := ctx.Session.ID()
+	if _, ok := sql.GetTableInsensitive(name, d.tempTables[sessionID]); ok {
+		return sql.ErrTableAlreadyExists.New(name)
+	}
+
+	table := NewTableWithCollation(name, schema, d.fkColl, collation)
+	table.temporary = true
+	if d.primaryKeyIndexes {
+		table.EnablePrimaryKeyIndexes()
+	}
+
+	if d.tempTables[sessionID] == nil {
+		d.tempTables[sessionID] = make(map[string]sql.Table)
+	}
+	d.tempTables[sessionID][name] = table
+	return nil
+}
+
+// GetAllTemporaryTables implements the interface sql.TemporaryTableDatabase.
+func (d *BaseDatabase) GetAllTemporaryTables(ctx *sql.Context) ([]sql.Table, error) {
+	if ctx == nil || ctx.Session == nil {
+		return nil, nil
+	}
+	d.tempTablesMu.RLock()
+	defer d.tempTablesMu.RUnlock()
+
+	tempTables := d.tempTables[ctx.Session.ID()]
+	tables := make([]sql.Table, 0, len(tempTables))
+	for _, table := range tempTables {
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// renamableDatabase is a database whose name can be changed by the DbProvider that holds it.
+type renamableDatabase interface {
+	setName(name string)
+}
+
+// foreignKeyDatabase is a database that stores the foreign keys of its tables.
+type foreignKeyDatabase interface {
+	GetForeignKeyCollection() *ForeignKeyCollection
+}
+
+var _ renamableDatabase = (*BaseDatabase)(nil)
+var _ foreignKeyDatabase = (*BaseDatabase)(nil)
+
+// setName changes the name of this database.
+func (d *BaseDatabase) setName(name string) {
+	d.name = name
+}
+
 func (d *BaseDatabase) GetForeignKeyCollection() *ForeignKeyCollection {
 	return d.fkColl
 }
@@ -202,8 +306,13 @@ func (d *BaseDatabase) CreateIndexedTable(ctx *sql.Context, name string, sch sql
 	return nil
 }
 
-// DropTable drops the table with the given name
+// DropTable drops the table with the given name. A temporary table of the session is dropped in preference to a
+// permanent table with the same name.
 func (d *BaseDatabase) DropTable(ctx *sql.Context, name string) error {
+	if d.dropTemporaryTable(ctx, name) {
+		return nil
+	}
+
 	_, ok := d.tables[name]
 	if !ok {
 		return sql.ErrTableNotFound.New(name)
@@ -214,13 +323,23 @@ func (d *BaseDatabase) DropTable(ctx *sql.Context, name string) error {
 }
 
 func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) error {
-	tbl, ok := d.tables[oldName]
+	tables := d.tables
+	if ctx != nil && ctx.Session != nil {
+		d.tempTablesMu.Lock()
+		defer d.tempTablesMu.Unlock()
+		if tbl, ok := sql.GetTableInsensitive(oldName, d.tempTables[ctx.Session.ID()]); ok {
+			tables = d.tempTables[ctx.Session.ID()]
+			oldName = tbl.Name()
+		}
+	}
+
+	tbl, ok := tables[oldName]
 	if !ok {
 		// Should be impossible (engine already checks this condition)
 		return sql.ErrTableNotFound.New(oldName)
 	}
 
-	_, ok = d.tables[newName]
+	_, ok = tables[newName]
 	if ok {
 		return sql.ErrTableAlreadyExists.New(newName)
 	}
@@ -230,15 +349,10 @@ func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) er
 	for _, col := range memTbl.schema.Schema {
 		col.Source = newName
 	}
-	for _, index := range memTbl.indexes {
-		memIndex := index.(*Index)
-		for i, expr := range memIndex.Exprs {
-			getField := expr.(*expression.GetField)
-			memIndex.Exprs[i] = expression.NewGetFieldWithTable(i, getField.Type(), newName, getField.Name(), getField.IsNullable())
-		}
-	}
-	d.tables[newName] = tbl
-	delete(d.tables, oldName)
+	memTbl.updateIndexExpressions("", "")
+	memTbl.orderedIndexes.reset()
+	tables[newName] = tbl
+	delete(tables, oldName)
 
 	return nil
 }
@@ -320,6 +434,50 @@ func (d *BaseDatabase) DropStoredProcedure(ctx *sql.Context, name string) error
 	return nil
 }
 
+// GetStoredFunction implements sql.StoredFunctionDatabase
+func (d *BaseDatabase) GetStoredFunction(ctx *sql.Context, name string) (sql.StoredProcedureDetails, bool, error) {
+	name = strings.ToLower(name)
+	for _, sfd := range d.storedFunctions {
+		if name == strings.ToLower(sfd.Name) {
+			return sfd, true, nil
+		}
+	}
+	return sql.StoredProcedureDetails{}, false, nil
+}
+
+// GetStoredFunctions implements sql.StoredFunctionDatabase
+func (d *BaseDatabase) GetStoredFunctions(ctx *sql.Context) ([]sql.StoredProcedureDetails, error) {
+	var sfds []sql.StoredProcedureDetails
+	for _, sfd := range d.storedFunctions {
+		sfds = append(sfds, sfd)
+	}
+	return sfds, nil
+}
+
+// SaveStoredFunction implements sql.StoredFunctionDatabase
+func (d *BaseDatabase) SaveStoredFunction(ctx *sql.Context, sfd sql.StoredProcedureDetails) error {
+	loweredName := strings.ToLower(sfd.Name)
+	for _, existingSfd := range d.storedFunctions {
+		if strings.ToLower(existingSfd.Name) == loweredName {
+			return sql.ErrStoredFunctionAlreadyExists.New(sfd.Name)
+		}
+	}
+	d.storedFunctions = append(d.storedFunctions, sfd)
+	return nil
+}
+
+// DropStoredFunction implements sql.StoredFunctionDatabase
+func (d *BaseDatabase) DropStoredFunction(ctx *sql.Context, name string) error {
+	loweredName := strings.ToLower(name)
+	for i, sfd := range d.storedFunctions {
+		if strings.ToLower(sfd.Name) == loweredName {
+			d.storedFunctions = append(d.storedFunctions[:i], d.storedFunctions[i+1:]...)
+			return nil
+		}
+	}
+	return sql.ErrStoredFunctionDoesNotExist.New(name)
+}
+
 // GetCollation implements sql.CollatedDatabase.
 func (d *BaseDatabase) GetCollation(ctx *sql.Context) sql.CollationID {
 	return d.collation
@@ -331,6 +489,17 @@ func (d *BaseDatabase) SetCollation(ctx *sql.Context, collation sql.CollationID)
 	return nil
 }
 
+// GetDefaultEncryption implements sql.EncryptedDatabase.
+func (d *BaseDatabase) GetDefaultEncryption(ctx *sql.Context) bool {
+	return d.encrypted
+}
+
+// SetDefaultEncryption implements sql.EncryptedDatabase.
+func (d *BaseDatabase) SetDefaultEncryption(ctx *sql.Context, encrypted bool) error {
+	d.encrypted = encrypted
+	return nil
+}
+
 // CreateView implements the interface sql.ViewDatabase.
 func (d *Database) CreateView(ctx *sql.Context, name string, selectStatement, createViewStmt string) error {
 	_, ok := d.views[name]
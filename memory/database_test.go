@@ -15,6 +15,8 @@
 package memory_test
 
 import (
+	"context"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -47,3 +49,46 @@ func TestDatabase_AddTable(t *testing.T) {
 	err = db.CreateTable(sql.NewEmptyContext(), "test_table", sql.PrimaryKeySchema{}, sql.Collation_Default)
 	require.Error(err)
 }
+
+func TestDatabase_TemporaryTables(t *testing.T) {
+	require := require.New(t)
+	db := memory.NewDatabase("test")
+
+	// Sessions create and drop their temporary tables concurrently
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 1; i <= 8; i++ {
+		wg.Add(1)
+		go func(id uint32) {
+			defer wg.Done()
+			ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSessionWithClientServer("", sql.Client{}, id)))
+			for j := 0; j < 100; j++ {
+				if err := db.CreateTemporaryTable(ctx, "t", sql.PrimaryKeySchema{}, sql.Collation_Default); err != nil {
+					errs <- err
+					return
+				}
+				if err := db.RenameTable(ctx, "t", "u"); err != nil {
+					errs <- err
+					return
+				}
+				if err := db.DropTable(ctx, "u"); err != nil {
+					errs <- err
+					return
+				}
+			}
+			tables, err := db.GetAllTemporaryTables(ctx)
+			if err == nil && len(tables) > 0 {
+				err = sql.ErrTableAlreadyExists.New(tables[0].Name())
+			}
+			if err != nil {
+				errs <- err
+			}
+		}(uint32(i))
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(err)
+	}
+	require.Empty(db.Tables())
+}
@@ -0,0 +1,676 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	querypb "github.com/dolthub/vitess/go/vt/proto/query"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// DumpFormat is the format of the snapshots of databases written by Database.Export and read by Database.Import.
+type DumpFormat byte
+
+const (
+	// SQLDump is a script of SQL statements, which MySQL clients can run as well.
+	SQLDump DumpFormat = iota
+	// BinaryDump defines the schema of the database with SQL statements as well, but holds the rows of its tables in a
+	// compact binary encoding, which is faster to write and read.
+	BinaryDump
+)
+
+// ErrInvalidDump is returned by Database.Import for snapshots it can't read.
+var ErrInvalidDump = errors.NewKind("invalid database dump: %s")
+
+// dumpInsertBatchSize is the number of rows of each INSERT statement of SQL dumps, and of each batch of rows inserted
+// when importing binary dumps.
+const dumpInsertBatchSize = 100
+
+// binaryDumpMagic starts binary dumps, and ends with the version of the format.
+var binaryDumpMagic = []byte("GMSDUMP\x01")
+
+// The kinds of the records of binary dumps.
+const (
+	// binaryDumpStatement is a record of a statement to run: its length and text.
+	binaryDumpStatement byte = 's'
+	// binaryDumpRows is a record of the rows of a table: the table name, the number of columns, the number of rows, and
+	// the values of the rows. Values are encoded as their length plus one followed by their bytes, with 0 for NULL.
+	binaryDumpRows byte = 'r'
+)
+
+const (
+	dumpDisableForeignKeyChecks = "SET @OLD_FOREIGN_KEY_CHECKS=@@FOREIGN_KEY_CHECKS, FOREIGN_KEY_CHECKS=0"
+	dumpRestoreForeignKeyChecks = "SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS"
+)
+
+// Export writes a snapshot of this database to the writer given, in the format given: its tables with their indexes,
+// foreign keys and checks, their rows, and its views, stored functions, stored procedures and triggers. Temporary
+// tables and the history of system-versioned tables aren't part of snapshots.
+func (d *Database) Export(ctx *sql.Context, w io.Writer, format DumpFormat) error {
+	var dw dumpWriter
+	switch format {
+	case SQLDump:
+		dw = &sqlDumpWriter{w: bufio.NewWriter(w), database: d.name}
+	case BinaryDump:
+		dw = &binaryDumpWriter{w: bufio.NewWriter(w)}
+	default:
+		return fmt.Errorf("unknown dump format %d", format)
+	}
+
+	if err := dw.begin(); err != nil {
+		return err
+	}
+	if err := dw.statement(dumpDisableForeignKeyChecks, false); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(d.tables))
+	for name := range d.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tables := make([]*Table, len(names))
+	for i, name := range names {
+		table, ok := d.tables[name].(*Table)
+		if !ok {
+			return fmt.Errorf("cannot export table %s of type %T", name, d.tables[name])
+		}
+		tables[i] = table
+		stmt, err := table.createTableStatement(ctx)
+		if err != nil {
+			return err
+		}
+		if err := dw.statement(stmt, false); err != nil {
+			return err
+		}
+	}
+
+	for _, table := range tables {
+		if err := dw.rows(ctx, table, table.allRows()); err != nil {
+			return err
+		}
+		if table.autoColIdx >= 0 {
+			next, err := table.PeekNextAutoIncrementValue(ctx)
+			if err != nil {
+				return err
+			}
+			stmt := fmt.Sprintf("ALTER TABLE %s AUTO_INCREMENT = %d", sql.QuoteIdentifier(table.name), next)
+			if err := dw.statement(stmt, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, view := range d.viewsInDependencyOrder() {
+		stmt := view.CreateViewStatement
+		if stmt == "" {
+			stmt = fmt.Sprintf("CREATE VIEW %s AS %s", sql.QuoteIdentifier(view.Name), view.TextDefinition)
+		}
+		if err := dw.statement(stmt, false); err != nil {
+			return err
+		}
+	}
+	for _, routines := range [][]sql.StoredProcedureDetails{d.storedFunctions, d.storedProcedures} {
+		for _, routine := range routines {
+			if err := dw.statement(routine.CreateStatement, true); err != nil {
+				return err
+			}
+		}
+	}
+	for _, trigger := range d.triggers {
+		if err := dw.statement(trigger.CreateStatement, true); err != nil {
+			return err
+		}
+	}
+
+	if err := dw.statement(dumpRestoreForeignKeyChecks, false); err != nil {
+		return err
+	}
+	return dw.end()
+}
+
+// Import restores a snapshot written by Export into this database, which shouldn't hold any of the objects in it yet.
+// Creating the objects of a snapshot takes an engine, which this database doesn't have, so its statements are run with
+// the function given, with this database as the current database of the context given. The rows of binary snapshots
+// are inserted into the tables directly.
+func (d *Database) Import(ctx *sql.Context, r io.Reader, format DumpFormat, exec func(ctx *sql.Context, query string) error) error {
+	currentDB := ctx.GetCurrentDatabase()
+	ctx.SetCurrentDatabase(d.name)
+	defer ctx.SetCurrentDatabase(currentDB)
+
+	switch format {
+	case SQLDump:
+		script, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		for _, stmt := range splitDumpStatements(string(script)) {
+			if err := exec(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	case BinaryDump:
+		return d.importBinaryDump(ctx, bufio.NewReader(r), exec)
+	default:
+		return fmt.Errorf("unknown dump format %d", format)
+	}
+}
+
+// importBinaryDump restores the binary snapshot read from the reader given into this database.
+func (d *Database) importBinaryDump(ctx *sql.Context, r *bufio.Reader, exec func(ctx *sql.Context, query string) error) error {
+	magic := make([]byte, len(binaryDumpMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, binaryDumpMagic) {
+		return ErrInvalidDump.New("not a binary dump")
+	}
+
+	for {
+		kind, err := r.ReadByte()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch kind {
+		case binaryDumpStatement:
+			stmt, err := readDumpBytes(r)
+			if err != nil {
+				return err
+			}
+			if err := exec(ctx, string(stmt)); err != nil {
+				return err
+			}
+		case binaryDumpRows:
+			if err := d.importBinaryDumpRows(ctx, r); err != nil {
+				return err
+			}
+		default:
+			return ErrInvalidDump.New(fmt.Sprintf("unknown record kind %q", kind))
+		}
+	}
+}
+
+// importBinaryDumpRows inserts the rows of a record of rows of a binary snapshot into their table.
+func (d *Database) importBinaryDumpRows(ctx *sql.Context, r *bufio.Reader) error {
+	name, err := readDumpBytes(r)
+	if err != nil {
+		return err
+	}
+	tbl, ok := sql.GetTableInsensitive(string(name), d.tables)
+	if !ok {
+		return sql.ErrTableNotFound.New(string(name))
+	}
+	table, ok := tbl.(*Table)
+	if !ok {
+		return fmt.Errorf("cannot import rows into table %s of type %T", name, tbl)
+	}
+	sch := table.schema.Schema
+
+	numCols, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if numCols != uint64(len(sch)) {
+		return ErrInvalidDump.New(fmt.Sprintf("table %s has %d columns, but its rows have %d", name, len(sch), numCols))
+	}
+	numRows, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+
+	inserter := table.BulkInserter(ctx)
+	inserter.StatementBegin(ctx)
+	batch := make([]sql.Row, 0, dumpInsertBatchSize)
+	for i := uint64(0); i < numRows; i++ {
+		row := make(sql.Row, len(sch))
+		for j, col := range sch {
+			n, err := binary.ReadUvarint(r)
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				continue
+			}
+			val := make([]byte, n-1)
+			if _, err := io.ReadFull(r, val); err != nil {
+				return err
+			}
+			if row[j], err = convertDumpValue(col.Type, val); err != nil {
+				return err
+			}
+		}
+		batch = append(batch, row)
+		if len(batch) == dumpInsertBatchSize || i == numRows-1 {
+			if err := inserter.InsertBatch(ctx, batch); err != nil {
+				_ = inserter.DiscardChanges(ctx, err)
+				return err
+			}
+			batch = make([]sql.Row, 0, dumpInsertBatchSize)
+		}
+	}
+	if err := inserter.StatementComplete(ctx); err != nil {
+		return err
+	}
+	return inserter.Close(ctx)
+}
+
+// readDumpBytes reads bytes prefixed by their length from the reader given.
+func readDumpBytes(r *bufio.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(r, b)
+	return b, err
+}
+
+// dumpValue returns the value given of a column of the type given as it's sent to clients, which is how it's dumped.
+func dumpValue(ctx *sql.Context, typ sql.Type, v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	return typ.SQL(ctx, nil, v)
+}
+
+// convertDumpValue returns the value of a column of the type given from its dumped bytes.
+func convertDumpValue(typ sql.Type, val []byte) (interface{}, error) {
+	if dumpedAsBytes(typ.Type()) {
+		return typ.Convert(val)
+	}
+	return typ.Convert(string(val))
+}
+
+// dumpedAsBytes returns whether the values of the SQL type given are dumped as bytes rather than text.
+func dumpedAsBytes(typ querypb.Type) bool {
+	return sqltypes.IsBinary(typ) || typ == sqltypes.Geometry || typ == sqltypes.Bit
+}
+
+// allRows returns the rows of this table, in the order of its partitions.
+func (t *Table) allRows() []sql.Row {
+	t.dataLock.RLock()
+	defer t.dataLock.RUnlock()
+	var rows []sql.Row
+	for _, k := range t.partitionKeys {
+		rows = append(rows, t.partitions[string(k)]...)
+	}
+	return rows
+}
+
+// createTableStatement returns the CREATE TABLE statement of this table, as SHOW CREATE TABLE writes it.
+func (t *Table) createTableStatement(ctx *sql.Context) (string, error) {
+	sch := t.schema.Schema
+	period, versioned := t.SystemTimePeriod()
+	var stmts []string
+	for _, col := range sch {
+		var colDefault string
+		if col.Default != nil {
+			colDefault = col.Default.String()
+			if colDefault != "NULL" && col.Default.IsLiteral() && !types.IsTime(col.Default.Type()) && !types.IsText(col.Default.Type()) {
+				v, err := col.Default.Eval(ctx, nil)
+				if err != nil {
+					return "", err
+				}
+				colDefault = fmt.Sprintf("'%v'", v)
+			}
+		}
+		stmt := sql.GenerateCreateTableColumnDefinition(col.Name, col.Type, col.Nullable, col.AutoIncrement, col.Default != nil, colDefault, col.Comment)
+		if versioned && strings.EqualFold(col.Name, period.Start) {
+			stmt += " GENERATED ALWAYS AS ROW START"
+		} else if versioned && strings.EqualFold(col.Name, period.End) {
+			stmt += " GENERATED ALWAYS AS ROW END"
+		}
+		stmts = append(stmts, stmt)
+	}
+
+	if len(t.schema.PkOrdinals) > 0 {
+		pkCols := make([]string, len(t.schema.PkOrdinals))
+		for i, ord := range t.schema.PkOrdinals {
+			pkCols[i] = sch[ord].Name
+		}
+		stmts = append(stmts, sql.GenerateCreateTablePrimaryKeyDefinition(pkCols))
+	}
+
+	indexes := make([]*Index, 0, len(t.indexes))
+	for _, idx := range t.indexes {
+		indexes = append(indexes, idx.(*Index))
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i].ID() < indexes[j].ID()
+	})
+	for _, idx := range indexes {
+		descending := idx.Descending()
+		cols := make([]string, len(idx.Exprs))
+		keyParts := idx.FunctionalKeyParts()
+		for i, expr := range idx.Exprs {
+			if keyParts != nil && keyParts[i] != nil {
+				cols[i] = "(" + keyParts[i].String() + ")"
+			} else {
+				cols[i] = sql.QuoteIdentifier(expr.(*expression.GetField).Name())
+			}
+			if len(idx.PrefixLens) > i && idx.PrefixLens[i] != 0 {
+				cols[i] += fmt.Sprintf("(%v)", idx.PrefixLens[i])
+			}
+			if descending[i] {
+				cols[i] += " DESC"
+			}
+		}
+		stmts = append(stmts, sql.GenerateCreateTableIndexDefinition(idx.IsUnique(), idx.IsSpatial(), idx.ID(), cols, idx.Comment()))
+	}
+
+	fks, err := t.GetDeclaredForeignKeys(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, fk := range fks {
+		var onDelete, onUpdate string
+		if len(fk.OnDelete) > 0 && fk.OnDelete != sql.ForeignKeyReferentialAction_DefaultAction {
+			onDelete = string(fk.OnDelete)
+		}
+		if len(fk.OnUpdate) > 0 && fk.OnUpdate != sql.ForeignKeyReferentialAction_DefaultAction {
+			onUpdate = string(fk.OnUpdate)
+		}
+		stmts = append(stmts, sql.GenerateCreateTableForiegnKeyDefinition(fk.Name, fk.Columns, fk.ParentTable, fk.ParentColumns, onDelete, onUpdate))
+	}
+
+	for _, check := range t.checks {
+		stmts = append(stmts, sql.GenerateCreateTableCheckConstraintClause(check.Name, check.CheckExpression, check.Enforced))
+	}
+
+	if versioned {
+		stmts = append(stmts, fmt.Sprintf("  PERIOD FOR SYSTEM_TIME (%s, %s)", sql.QuoteIdentifier(period.Start), sql.QuoteIdentifier(period.End)))
+	}
+
+	stmt := sql.GenerateCreateTableStatement(t.name, stmts, t.collation.CharacterSet().Name(), t.collation.Name())
+	if versioned {
+		stmt += " WITH SYSTEM VERSIONING"
+	}
+	return stmt, nil
+}
+
+// viewsInDependencyOrder returns the views of this database sorted on their names, except that views come after the
+// other views they refer to.
+func (d *Database) viewsInDependencyOrder() []sql.ViewDefinition {
+	views := make([]sql.ViewDefinition, 0, len(d.views))
+	for _, view := range d.views {
+		views = append(views, view)
+	}
+	sort.Slice(views, func(i, j int) bool {
+		return views[i].Name < views[j].Name
+	})
+
+	// refersTo returns whether the definition of a view refers to the view with the name given, by looking for the name
+	// as a word of its text, which may find references that aren't there, but never misses one
+	refersTo := func(view sql.ViewDefinition, name string) bool {
+		re := regexp.MustCompile(`(?i)(^|[^0-9a-z_$])` + regexp.QuoteMeta(name) + `($|[^0-9a-z_$])`)
+		return re.MatchString(view.TextDefinition)
+	}
+
+	var sorted []sql.ViewDefinition
+	for len(views) > 0 {
+		next := 0
+		for i, view := range views {
+			ready := true
+			for j, other := range views {
+				if i != j && refersTo(view, other.Name) {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				next = i
+				break
+			}
+		}
+		sorted = append(sorted, views[next])
+		views = append(views[:next], views[next+1:]...)
+	}
+	return sorted
+}
+
+// dumpWriter writes snapshots of databases in a dump format.
+type dumpWriter interface {
+	// begin writes the start of the snapshot.
+	begin() error
+	// statement writes a statement to run to restore the snapshot. The statements of stored routines and triggers may
+	// hold semicolons.
+	statement(stmt string, routine bool) error
+	// rows writes the rows given of the table given.
+	rows(ctx *sql.Context, table *Table, rows []sql.Row) error
+	// end writes the end of the snapshot, and flushes it.
+	end() error
+}
+
+// sqlDumpWriter writes snapshots as SQL scripts. Statements that may hold semicolons are delimited by ;; instead, as
+// mysqldump does.
+type sqlDumpWriter struct {
+	w        *bufio.Writer
+	database string
+}
+
+var _ dumpWriter = (*sqlDumpWriter)(nil)
+
+func (s *sqlDumpWriter) begin() error {
+	_, err := fmt.Fprintf(s.w, "-- Dump of database %s\n\n", sql.QuoteIdentifier(s.database))
+	return err
+}
+
+func (s *sqlDumpWriter) statement(stmt string, routine bool) error {
+	var err error
+	if routine {
+		_, err = fmt.Fprintf(s.w, "DELIMITER ;;\n%s ;;\nDELIMITER ;\n", stmt)
+	} else {
+		_, err = fmt.Fprintf(s.w, "%s;\n", stmt)
+	}
+	return err
+}
+
+func (s *sqlDumpWriter) rows(ctx *sql.Context, table *Table, rows []sql.Row) error {
+	sch := table.schema.Schema
+	cols := make([]string, len(sch))
+	for i, col := range sch {
+		cols[i] = sql.QuoteIdentifier(col.Name)
+	}
+	insert := fmt.Sprintf("INSERT INTO %s (%s) VALUES ", sql.QuoteIdentifier(table.name), strings.Join(cols, ","))
+
+	var sb strings.Builder
+	for i, row := range rows {
+		if i%dumpInsertBatchSize == 0 {
+			sb.WriteString(insert)
+		} else {
+			sb.WriteString(",")
+		}
+		sb.WriteString("(")
+		for j, col := range sch {
+			if j > 0 {
+				sb.WriteString(",")
+			}
+			val, err := dumpValue(ctx, col.Type, row[j])
+			if err != nil {
+				return err
+			}
+			switch {
+			case val.IsNull():
+				sb.WriteString("NULL")
+			case val.IsIntegral() || val.IsFloat() || val.Type() == sqltypes.Decimal:
+				sb.WriteString(val.ToString())
+			case dumpedAsBytes(val.Type()):
+				fmt.Fprintf(&sb, "X'%x'", val.Raw())
+			default:
+				var buf bytes.Buffer
+				val.EncodeSQL(&buf)
+				sb.Write(buf.Bytes())
+			}
+		}
+		sb.WriteString(")")
+		if (i+1)%dumpInsertBatchSize == 0 || i == len(rows)-1 {
+			if err := s.statement(sb.String(), false); err != nil {
+				return err
+			}
+			sb.Reset()
+		}
+	}
+	return nil
+}
+
+func (s *sqlDumpWriter) end() error {
+	return s.w.Flush()
+}
+
+// binaryDumpWriter writes snapshots in the binary format.
+type binaryDumpWriter struct {
+	w *bufio.Writer
+}
+
+var _ dumpWriter = (*binaryDumpWriter)(nil)
+
+func (b *binaryDumpWriter) begin() error {
+	_, err := b.w.Write(binaryDumpMagic)
+	return err
+}
+
+func (b *binaryDumpWriter) statement(stmt string, _ bool) error {
+	if err := b.w.WriteByte(binaryDumpStatement); err != nil {
+		return err
+	}
+	return b.writeBytes([]byte(stmt))
+}
+
+func (b *binaryDumpWriter) rows(ctx *sql.Context, table *Table, rows []sql.Row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	sch := table.schema.Schema
+	if err := b.w.WriteByte(binaryDumpRows); err != nil {
+		return err
+	}
+	if err := b.writeBytes([]byte(table.name)); err != nil {
+		return err
+	}
+	b.writeUvarint(uint64(len(sch)))
+	b.writeUvarint(uint64(len(rows)))
+	for _, row := range rows {
+		for i, col := range sch {
+			val, err := dumpValue(ctx, col.Type, row[i])
+			if err != nil {
+				return err
+			}
+			if val.IsNull() {
+				b.writeUvarint(0)
+				continue
+			}
+			b.writeUvarint(uint64(len(val.Raw())) + 1)
+			if _, err := b.w.Write(val.Raw()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (b *binaryDumpWriter) end() error {
+	return b.w.Flush()
+}
+
+func (b *binaryDumpWriter) writeUvarint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	// Errors of a bufio.Writer are returned by its later writes and its flush
+	_, _ = b.w.Write(buf[:n])
+}
+
+func (b *binaryDumpWriter) writeBytes(p []byte) error {
+	b.writeUvarint(uint64(len(p)))
+	_, err := b.w.Write(p)
+	return err
+}
+
+// splitDumpStatements returns the statements of the SQL script given, without their delimiters or comments. Like the
+// MySQL client, DELIMITER commands change the delimiter of the statements after them.
+func splitDumpStatements(script string) []string {
+	var statements []string
+	delimiter := ";"
+	var sb strings.Builder
+	for i := 0; i < len(script); {
+		c := script[i]
+		rest := script[i:]
+		switch {
+		case strings.TrimSpace(sb.String()) == "" && len(rest) > 10 && strings.EqualFold(rest[:10], "delimiter "):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			delimiter = strings.TrimSpace(rest[10:end])
+			sb.Reset()
+			i += end
+		case c == '#' || strings.HasPrefix(rest, "-- ") || strings.HasPrefix(rest, "--\n"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			i += end
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest, "*/")
+			if end < 0 {
+				end = len(rest)
+			} else {
+				end += 2
+			}
+			sb.WriteString(rest[:end])
+			i += end
+		case c == '\'' || c == '"' || c == '`':
+			end := 1
+			for end < len(rest) && rest[end] != c {
+				if rest[end] == '\\' && c != '`' {
+					end++
+				}
+				end++
+			}
+			if end < len(rest) {
+				end++
+			}
+			sb.WriteString(rest[:end])
+			i += end
+		case strings.HasPrefix(rest, delimiter):
+			if stmt := strings.TrimSpace(sb.String()); stmt != "" {
+				statements = append(statements, stmt)
+			}
+			sb.Reset()
+			i += len(delimiter)
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	if stmt := strings.TrimSpace(sb.String()); stmt != "" {
+		statements = append(statements, stmt)
+	}
+	return statements
+}
@@ -68,6 +68,21 @@ func (fkc *ForeignKeyCollection) SetResolved(fkName string) bool {
 	return false
 }
 
+// renameDatabase updates the foreign keys that refer to the database |oldName| to refer to |newName| instead.
+func (fkc *ForeignKeyCollection) renameDatabase(oldName, newName string) {
+	if fkc == nil {
+		return
+	}
+	for i := range fkc.fks {
+		if strings.ToLower(fkc.fks[i].Database) == strings.ToLower(oldName) {
+			fkc.fks[i].Database = newName
+		}
+		if strings.ToLower(fkc.fks[i].ParentDatabase) == strings.ToLower(oldName) {
+			fkc.fks[i].ParentDatabase = newName
+		}
+	}
+}
+
 // Keys returns all of the foreign keys.
 func (fkc *ForeignKeyCollection) Keys() []sql.ForeignKeyConstraint {
 	if fkc == nil {
@@ -37,11 +37,20 @@ type Index struct {
 	Spatial    bool
 	CommentStr string
 	PrefixLens []uint16
+	// DescendingCols is whether each of the expressions of the index is sorted in descending order, or nil if none are
+	DescendingCols []bool
+	// KeyParts are the expressions of the functional key parts of the index, which only reference the columns of the
+	// table by name, or nil for the key parts that are columns. Exprs holds the same expressions with the columns at
+	// their places in the schema of the table, which are evaluated on its rows. KeyParts is nil if the index has no
+	// functional key parts.
+	KeyParts []sql.Expression
 }
 
 var _ sql.Index = (*Index)(nil)
 var _ sql.FilteredIndex = (*Index)(nil)
 var _ sql.OrderedIndex = (*Index)(nil)
+var _ sql.DescendingIndex = (*Index)(nil)
+var _ sql.FunctionalIndex = (*Index)(nil)
 
 func (idx *Index) Database() string                    { return idx.DB }
 func (idx *Index) Driver() string                      { return idx.DriverName }
@@ -57,6 +66,11 @@ func (idx *Index) Expressions() []string {
 	return exprs
 }
 
+// FunctionalKeyParts implements the sql.FunctionalIndex interface.
+func (idx *Index) FunctionalKeyParts() []sql.Expression {
+	return idx.KeyParts
+}
+
 func (idx *Index) CanSupport(...sql.Range) bool {
 	return true
 }
@@ -100,6 +114,11 @@ func (idx *Index) rangeFilterExpr(ranges ...sql.Range) (sql.Expression, error) {
 	for _, rang := range ranges {
 		var rangeExpr sql.Expression
 		for i, rce := range rang {
+			colExpr := idx.Exprs[i]
+			if i < len(idx.PrefixLens) && idx.PrefixLens[i] > 0 {
+				colExpr = newPrefixExpression(colExpr, idx.PrefixLens[i])
+				rce = prefixRange(rce, idx.PrefixLens[i])
+			}
 			var rangeColumnExpr sql.Expression
 			switch rce.Type() {
 			// Both Empty and All may seem like strange inclusions, but if only one range is given we need some
@@ -109,54 +128,54 @@ func (idx *Index) rangeFilterExpr(ranges ...sql.Range) (sql.Expression, error) {
 			case sql.RangeType_All:
 				rangeColumnExpr = expression.NewEquals(expression.NewLiteral(1, types.Int8), expression.NewLiteral(1, types.Int8))
 			case sql.RangeType_EqualNull:
-				rangeColumnExpr = expression.NewIsNull(idx.Exprs[i])
+				rangeColumnExpr = expression.NewIsNull(colExpr)
 			case sql.RangeType_GreaterThan:
 				if sql.RangeCutIsBinding(rce.LowerBound) {
-					rangeColumnExpr = expression.NewGreaterThan(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote()))
+					rangeColumnExpr = expression.NewGreaterThan(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote()))
 				} else {
-					rangeColumnExpr = expression.NewNot(expression.NewIsNull(idx.Exprs[i]))
+					rangeColumnExpr = expression.NewNot(expression.NewIsNull(colExpr))
 				}
 			case sql.RangeType_GreaterOrEqual:
-				rangeColumnExpr = expression.NewGreaterThanOrEqual(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote()))
+				rangeColumnExpr = expression.NewGreaterThanOrEqual(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote()))
 			case sql.RangeType_LessThanOrNull:
 				rangeColumnExpr = or(
-					expression.NewLessThan(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
-					expression.NewIsNull(idx.Exprs[i]),
+					expression.NewLessThan(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
+					expression.NewIsNull(colExpr),
 				)
 			case sql.RangeType_LessOrEqualOrNull:
 				rangeColumnExpr = or(
-					expression.NewLessThanOrEqual(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
-					expression.NewIsNull(idx.Exprs[i]),
+					expression.NewLessThanOrEqual(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
+					expression.NewIsNull(colExpr),
 				)
 			case sql.RangeType_ClosedClosed:
 				rangeColumnExpr = and(
-					expression.NewGreaterThanOrEqual(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote())),
-					expression.NewLessThanOrEqual(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
+					expression.NewGreaterThanOrEqual(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote())),
+					expression.NewLessThanOrEqual(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
 				)
 			case sql.RangeType_OpenOpen:
 				if sql.RangeCutIsBinding(rce.LowerBound) {
 					rangeColumnExpr = and(
-						expression.NewGreaterThan(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote())),
-						expression.NewLessThan(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
+						expression.NewGreaterThan(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote())),
+						expression.NewLessThan(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
 					)
 				} else {
 					// Lower bound is (NULL, ...)
-					rangeColumnExpr = expression.NewLessThan(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote()))
+					rangeColumnExpr = expression.NewLessThan(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote()))
 				}
 			case sql.RangeType_OpenClosed:
 				if sql.RangeCutIsBinding(rce.LowerBound) {
 					rangeColumnExpr = and(
-						expression.NewGreaterThan(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote())),
-						expression.NewLessThanOrEqual(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
+						expression.NewGreaterThan(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote())),
+						expression.NewLessThanOrEqual(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
 					)
 				} else {
 					// Lower bound is (NULL, ...]
-					rangeColumnExpr = expression.NewLessThanOrEqual(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote()))
+					rangeColumnExpr = expression.NewLessThanOrEqual(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote()))
 				}
 			case sql.RangeType_ClosedOpen:
 				rangeColumnExpr = and(
-					expression.NewGreaterThanOrEqual(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote())),
-					expression.NewLessThan(idx.Exprs[i], expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
+					expression.NewGreaterThanOrEqual(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.LowerBound), rce.Typ.Promote())),
+					expression.NewLessThan(colExpr, expression.NewLiteral(sql.GetRangeCutKey(rce.UpperBound), rce.Typ.Promote())),
 				)
 			}
 			rangeExpr = and(rangeExpr, rangeColumnExpr)
@@ -202,7 +221,7 @@ func (idx *Index) HandledFilters(filters []sql.Expression) []sql.Expression {
 		return handled
 	}
 	for _, expr := range filters {
-		if expression.ContainsImpreciseComparison(expr) {
+		if expression.ContainsImpreciseComparison(expr) || idx.referencesPrefixColumn(expr) {
 			continue
 		}
 		handled = append(handled, expr)
@@ -210,6 +229,102 @@ func (idx *Index) HandledFilters(filters []sql.Expression) []sql.Expression {
 	return handled
 }
 
+// referencesPrefixColumn returns whether the expression given references a column of which this index only has a
+// prefix. A lookup of such an index returns rows whose values only match the expression on the prefix.
+func (idx *Index) referencesPrefixColumn(e sql.Expression) bool {
+	prefixCols := make(map[string]bool)
+	for i, expr := range idx.Exprs {
+		if gf, ok := expr.(*expression.GetField); ok && i < len(idx.PrefixLens) && idx.PrefixLens[i] > 0 {
+			prefixCols[strings.ToLower(gf.Name())] = true
+		}
+	}
+	if len(prefixCols) == 0 {
+		return false
+	}
+	var found bool
+	sql.Inspect(e, func(expr sql.Expression) bool {
+		if gf, ok := expr.(*expression.GetField); ok && prefixCols[strings.ToLower(gf.Name())] {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// prefixRange returns the range given widened to the prefixes of the given length of the values in it. The prefix of a
+// value that's greater than a key can be equal to the prefix of the key, so the bounds of the range become inclusive.
+func prefixRange(rce sql.RangeColumnExpr, length uint16) sql.RangeColumnExpr {
+	switch lower := rce.LowerBound.(type) {
+	case sql.Above:
+		rce.LowerBound = sql.Below{Key: prefixValue(lower.Key, length)}
+	case sql.Below:
+		rce.LowerBound = sql.Below{Key: prefixValue(lower.Key, length)}
+	}
+	switch upper := rce.UpperBound.(type) {
+	case sql.Above:
+		rce.UpperBound = sql.Above{Key: prefixValue(upper.Key, length)}
+	case sql.Below:
+		rce.UpperBound = sql.Above{Key: prefixValue(upper.Key, length)}
+	}
+	return rce
+}
+
+// prefixValue returns the prefix of the given length of a value, in characters for a string and in bytes for binary
+// data. Values of other types are returned as is.
+func prefixValue(val interface{}, length uint16) interface{} {
+	switch val := val.(type) {
+	case string:
+		if runes := []rune(val); len(runes) > int(length) {
+			return string(runes[:length])
+		}
+	case []byte:
+		if len(val) > int(length) {
+			return val[:length]
+		}
+	}
+	return val
+}
+
+// prefixExpression evaluates to the prefix of the given length of the value of its child, which is an indexed column.
+type prefixExpression struct {
+	expression.UnaryExpression
+	length uint16
+}
+
+var _ sql.Expression = (*prefixExpression)(nil)
+var _ sql.CollationCoercible = (*prefixExpression)(nil)
+
+func newPrefixExpression(child sql.Expression, length uint16) *prefixExpression {
+	return &prefixExpression{UnaryExpression: expression.UnaryExpression{Child: child}, length: length}
+}
+
+func (p *prefixExpression) Type() sql.Type {
+	return p.Child.Type()
+}
+
+func (p *prefixExpression) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, p.Child)
+}
+
+func (p *prefixExpression) String() string {
+	return fmt.Sprintf("%s(%d)", p.Child, p.length)
+}
+
+func (p *prefixExpression) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := p.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return prefixValue(val, p.length), nil
+}
+
+func (p *prefixExpression) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 1)
+	}
+	return newPrefixExpression(children[0], p.length), nil
+}
+
 // validateIndexType returns the best comparison type between the two given types, as it takes into consideration
 // whether the types contain collations.
 func (idx *Index) validateIndexType(valType sql.Type, rangeType sql.Type) sql.Type {
@@ -267,6 +382,34 @@ func (idx *Index) Order() sql.IndexOrder {
 	return sql.IndexOrderAsc
 }
 
+// Reversible implements the interface sql.OrderedIndex.
+func (idx *Index) Reversible() bool {
+	return true
+}
+
+// Descending implements the interface sql.DescendingIndex.
+func (idx *Index) Descending() []bool {
+	if idx.DescendingCols == nil {
+		return make([]bool, len(idx.Exprs))
+	}
+	return idx.DescendingCols
+}
+
+// sortFields returns the sort fields that the rows of a lookup on this index are sorted on, which are the reverse of
+// the order of the index if reverse is true.
+func (idx *Index) sortFields(reverse bool) sql.SortFields {
+	descending := idx.Descending()
+	sf := make(sql.SortFields, len(idx.Exprs))
+	for i, e := range idx.Exprs {
+		order := sql.Ascending
+		if descending[i] != reverse {
+			order = sql.Descending
+		}
+		sf[i] = sql.SortField{Column: e, Order: order}
+	}
+	return sf
+}
+
 func or(expressions ...sql.Expression) sql.Expression {
 	if len(expressions) == 1 {
 		return expressions[0]
@@ -0,0 +1,95 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// JoinPushdownDatabase is a database that executes the joins of its tables that the analyzer pushes down to it. It's
+// used to test the pushdown of joins to a sql.JoinPushdownDatabase.
+type JoinPushdownDatabase struct {
+	*Database
+}
+
+var _ sql.JoinPushdownDatabase = (*JoinPushdownDatabase)(nil)
+
+func NewJoinPushdownDatabase(name string) *JoinPushdownDatabase {
+	return &JoinPushdownDatabase{
+		Database: NewDatabase(name),
+	}
+}
+
+// PushdownJoin implements the sql.JoinPushdownDatabase interface.
+func (d *JoinPushdownDatabase) PushdownJoin(ctx *sql.Context, join sql.Node) (sql.Table, bool, error) {
+	return &JoinTable{join: join}, true, nil
+}
+
+// JoinTable is a table that returns the rows of a join pushed down to a JoinPushdownDatabase.
+type JoinTable struct {
+	join sql.Node
+}
+
+var _ sql.Table = (*JoinTable)(nil)
+
+// Join returns the join this table returns the rows of.
+func (t *JoinTable) Join() sql.Node {
+	return t.join
+}
+
+// Name implements the sql.Nameable interface. The name of the table lists the names of the tables that are joined.
+func (t *JoinTable) Name() string {
+	return fmt.Sprintf("join(%s)", strings.Join(joinedTableNames(t.join), ","))
+}
+
+// String implements the fmt.Stringer interface.
+func (t *JoinTable) String() string {
+	return t.Name()
+}
+
+// Schema implements the sql.Table interface.
+func (t *JoinTable) Schema() sql.Schema {
+	return t.join.Schema()
+}
+
+// Collation implements the sql.Table interface.
+func (t *JoinTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions implements the sql.Table interface.
+func (t *JoinTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return &partitionIter{keys: [][]byte{[]byte("join")}}, nil
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *JoinTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	return t.join.RowIter(ctx, nil)
+}
+
+// joinedTableNames returns the names of the tables read by the join given, in order.
+func joinedTableNames(n sql.Node) []string {
+	if nameable, ok := n.(sql.Nameable); ok {
+		return []string{nameable.Name()}
+	}
+	var names []string
+	for _, child := range n.Children() {
+		names = append(names, joinedTableNames(child)...)
+	}
+	return names
+}
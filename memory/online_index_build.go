@@ -0,0 +1,115 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// indexBuildProgressInterval is the number of rows an index build copies between reports of its progress.
+const indexBuildProgressInterval = 1000
+
+// EnableOnlineIndexBuilds makes this table build the indexes added to it online, as a sql.OnlineIndexBuilder.
+// Otherwise, StartIndexBuild returns sql.ErrOnlineIndexBuildNotSupported and indexes are added with CreateIndex.
+func (t *Table) EnableOnlineIndexBuilds() {
+	t.onlineIndexBuilds = true
+}
+
+// StartIndexBuild implements sql.OnlineIndexBuilder. The indexes of memory tables don't hold rows, so a build only
+// checks the keys of a unique index for duplicates in the rows of the table, and adds the index once it's caught up.
+func (t *Table) StartIndexBuild(ctx *sql.Context, idx sql.IndexDef) (sql.IndexBuild, error) {
+	if !t.onlineIndexBuilds {
+		return nil, sql.ErrOnlineIndexBuildNotSupported.New(idx.Name, t.name)
+	}
+	if t.indexes[idx.Name] != nil {
+		return nil, fmt.Errorf("Error: index already exists")
+	}
+	return &indexBuild{table: t, def: idx}, nil
+}
+
+// indexBuild is the build of an index of a memory table.
+type indexBuild struct {
+	table *Table
+	def   sql.IndexDef
+}
+
+var _ sql.IndexBuild = (*indexBuild)(nil)
+
+// Copy implements sql.IndexBuild. The rows of the table are read from a copy of its partitions, so that writes to the
+// table aren't blocked while they're checked.
+func (b *indexBuild) Copy(ctx *sql.Context, progress func(rows int64)) error {
+	b.table.dataLock.RLock()
+	var rows []sql.Row
+	for _, partition := range b.table.partitions {
+		rows = append(rows, partition...)
+	}
+	b.table.dataLock.RUnlock()
+
+	var columns []int
+	unique := make(map[uint64]struct{})
+	if b.def.Constraint == sql.IndexConstraint_Unique {
+		colNames := make([]string, len(b.def.Columns))
+		for i, column := range b.def.Columns {
+			colNames[i] = column.Name
+		}
+		var err error
+		if columns, err = b.table.columnIndexes(colNames); err != nil {
+			return err
+		}
+	}
+
+	var copied int64
+	for _, row := range rows {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if columns != nil {
+			key := projectOnRow(columns, row)
+			if !hasNulls(key) {
+				h, err := sql.HashOf(key)
+				if err != nil {
+					return err
+				}
+				if _, ok := unique[h]; ok {
+					return sql.NewUniqueKeyErr(formatRow(row, columns), false, nil)
+				}
+				unique[h] = struct{}{}
+			}
+		}
+		copied++
+		if copied == indexBuildProgressInterval {
+			progress(copied)
+			copied = 0
+		}
+	}
+	if copied > 0 {
+		progress(copied)
+	}
+	return nil
+}
+
+// CatchUp implements sql.IndexBuild. Adding the index checks the keys of a unique index again, including those of
+// the rows written since the start of the build.
+func (b *indexBuild) CatchUp(ctx *sql.Context) error {
+	return b.table.CreateIndex(ctx, b.def)
+}
+
+// Abort implements sql.IndexBuild. The index isn't added to the table until the build has caught up, so there's
+// nothing to drop.
+func (b *indexBuild) Abort(ctx *sql.Context) error {
+	return nil
+}
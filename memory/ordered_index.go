@@ -0,0 +1,516 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// orderedIndexMaxLevel is the maximum number of levels of the skip list of an orderedIndex, which keeps lookups
+// logarithmic for up to 2^orderedIndexMaxLevel rows.
+const orderedIndexMaxLevel = 24
+
+// orderedIndex holds the rows of a table sorted on the expressions of an index, in a skip list. Rows with the same
+// values for the expressions of the index are sorted on the primary key of the table, or in the order they were
+// added to the index for keyless tables.
+type orderedIndex struct {
+	exprs      []sql.Expression
+	descending []bool
+	pkOrdinals []int
+	pkTypes    []sql.Type
+
+	head   *orderedIndexNode
+	last   *orderedIndexNode
+	levels int
+	seq    uint64
+	rnd    *rand.Rand
+}
+
+// orderedIndexNode is an entry of an orderedIndex, for a row of its table.
+type orderedIndexNode struct {
+	key  []interface{}
+	seq  uint64
+	row  sql.Row
+	next []*orderedIndexNode
+	prev *orderedIndexNode
+}
+
+// newOrderedIndex returns an empty orderedIndex for the index given of the table given.
+func newOrderedIndex(t *Table, idx *Index) *orderedIndex {
+	oi := &orderedIndex{
+		exprs:      idx.Exprs,
+		descending: idx.Descending(),
+		pkOrdinals: t.schema.PkOrdinals,
+		head:       &orderedIndexNode{next: make([]*orderedIndexNode, orderedIndexMaxLevel)},
+		levels:     1,
+		rnd:        rand.New(rand.NewSource(1)),
+	}
+	for _, ord := range oi.pkOrdinals {
+		oi.pkTypes = append(oi.pkTypes, t.schema.Schema[ord].Type)
+	}
+	return oi
+}
+
+// compare compares the entries given in the order of this index.
+func (oi *orderedIndex) compare(l, r *orderedIndexNode) (int, error) {
+	for i, expr := range oi.exprs {
+		cmp, err := compareNullsFirst(expr.Type(), l.key[i], r.key[i])
+		if err != nil {
+			return 0, err
+		}
+		if cmp != 0 {
+			if oi.descending[i] {
+				cmp = -cmp
+			}
+			return cmp, nil
+		}
+	}
+	for i, ord := range oi.pkOrdinals {
+		cmp, err := compareNullsFirst(oi.pkTypes[i], l.row[ord], r.row[ord])
+		if err != nil {
+			return 0, err
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	switch {
+	case l.seq < r.seq:
+		return -1, nil
+	case l.seq > r.seq:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// compareNullsFirst compares the values given with the type given, sorting NULL before every other value.
+func compareNullsFirst(typ sql.Type, l, r interface{}) (int, error) {
+	switch {
+	case l == nil && r == nil:
+		return 0, nil
+	case l == nil:
+		return -1, nil
+	case r == nil:
+		return 1, nil
+	default:
+		return typ.Compare(l, r)
+	}
+}
+
+// newNode returns a new entry of this index for the row given.
+func (oi *orderedIndex) newNode(ctx *sql.Context, row sql.Row) (*orderedIndexNode, error) {
+	key := make([]interface{}, len(oi.exprs))
+	for i, expr := range oi.exprs {
+		v, err := expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		key[i] = v
+	}
+	oi.seq++
+	return &orderedIndexNode{key: key, seq: oi.seq, row: row}, nil
+}
+
+// insert adds the row given to this index.
+func (oi *orderedIndex) insert(ctx *sql.Context, row sql.Row) error {
+	n, err := oi.newNode(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	var preds [orderedIndexMaxLevel]*orderedIndexNode
+	x := oi.head
+	for level := oi.levels - 1; level >= 0; level-- {
+		for x.next[level] != nil {
+			cmp, err := oi.compare(x.next[level], n)
+			if err != nil {
+				return err
+			}
+			if cmp >= 0 {
+				break
+			}
+			x = x.next[level]
+		}
+		preds[level] = x
+	}
+
+	levels := 1
+	for levels < orderedIndexMaxLevel && oi.rnd.Intn(4) == 0 {
+		levels++
+	}
+	for ; oi.levels < levels; oi.levels++ {
+		preds[oi.levels] = oi.head
+	}
+
+	n.next = make([]*orderedIndexNode, levels)
+	for level := 0; level < levels; level++ {
+		n.next[level] = preds[level].next[level]
+		preds[level].next[level] = n
+	}
+	if preds[0] != oi.head {
+		n.prev = preds[0]
+	}
+	if n.next[0] != nil {
+		n.next[0].prev = n
+	} else {
+		oi.last = n
+	}
+	return nil
+}
+
+// delete removes the row given from this index. Rows are identified by their identity, rather than their values,
+// since keyless tables may have several rows with the same values.
+func (oi *orderedIndex) delete(ctx *sql.Context, row sql.Row) error {
+	search, err := oi.newNode(ctx, row)
+	if err != nil {
+		return err
+	}
+	// The new entry sorts after every existing entry for the same row, so the entry for the row is found by walking
+	// back from the last entry before it
+	search.seq = ^uint64(0)
+	var target *orderedIndexNode
+	for n := oi.seekLast(func(n *orderedIndexNode) (bool, error) {
+		cmp, err := oi.compare(n, search)
+		return cmp < 0, err
+	}); n != nil; n = n.prev {
+		if sameRow(n.row, row) {
+			target = n
+			break
+		}
+		equal := true
+		for i, expr := range oi.exprs {
+			cmp, err := compareNullsFirst(expr.Type(), n.key[i], search.key[i])
+			if err != nil {
+				return err
+			}
+			if cmp != 0 {
+				equal = false
+				break
+			}
+		}
+		if !equal {
+			break
+		}
+	}
+	if target == nil {
+		return nil
+	}
+
+	x := oi.head
+	for level := oi.levels - 1; level >= 0; level-- {
+		for x.next[level] != nil && x.next[level] != target {
+			cmp, err := oi.compare(x.next[level], target)
+			if err != nil {
+				return err
+			}
+			if cmp >= 0 {
+				break
+			}
+			x = x.next[level]
+		}
+		if level < len(target.next) && x.next[level] == target {
+			x.next[level] = target.next[level]
+		}
+	}
+	if target.next[0] != nil {
+		target.next[0].prev = target.prev
+	} else {
+		oi.last = target.prev
+	}
+	for oi.levels > 1 && oi.head.next[oi.levels-1] == nil {
+		oi.levels--
+	}
+	return nil
+}
+
+// sameRow returns whether the rows given are the same row of a table, rather than rows with the same values.
+func sameRow(l, r sql.Row) bool {
+	return len(l) == len(r) && (len(l) == 0 || &l[0] == &r[0])
+}
+
+// seekFirst returns the first entry of this index that |atOrAfter| returns true for, which must return false for
+// every entry before it and true for every entry after it, or nil if there's none.
+func (oi *orderedIndex) seekFirst(atOrAfter func(n *orderedIndexNode) (bool, error)) (*orderedIndexNode, error) {
+	x := oi.head
+	for level := oi.levels - 1; level >= 0; level-- {
+		for x.next[level] != nil {
+			ok, err := atOrAfter(x.next[level])
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				break
+			}
+			x = x.next[level]
+		}
+	}
+	return x.next[0], nil
+}
+
+// seekLast returns the last entry of this index that |atOrBefore| returns true for, which must return true for
+// every entry before it and false for every entry after it, or nil if there's none. Errors are returned as false.
+func (oi *orderedIndex) seekLast(atOrBefore func(n *orderedIndexNode) (bool, error)) *orderedIndexNode {
+	x := oi.head
+	for level := oi.levels - 1; level >= 0; level-- {
+		for x.next[level] != nil {
+			if ok, err := atOrBefore(x.next[level]); err != nil || !ok {
+				break
+			}
+			x = x.next[level]
+		}
+	}
+	if x == oi.head {
+		return nil
+	}
+	return x
+}
+
+// rows returns the rows of this index whose first expression is within the cuts given, in the order of the index, or
+// in the reverse order if |reverse| is true. A nil cut doesn't bound the rows.
+func (oi *orderedIndex) rows(lower, upper sql.RangeCut, typ sql.Type, reverse bool) ([]sql.Row, error) {
+	// atOrAboveLower and atOrBelowUpper return whether the first expression of an entry is within each cut. A value
+	// is at the position between Below[value] and Above[value], and NULL between BelowNull and AboveNull.
+	atOrAboveLower := func(n *orderedIndexNode) (bool, error) {
+		if lower == nil {
+			return true, nil
+		}
+		var pos sql.RangeCut = sql.BelowNull{}
+		if n.key[0] != nil {
+			pos = sql.Below{Key: n.key[0]}
+		}
+		cmp, err := lower.Compare(pos, typ)
+		return cmp <= 0, err
+	}
+	atOrBelowUpper := func(n *orderedIndexNode) (bool, error) {
+		if upper == nil {
+			return true, nil
+		}
+		var pos sql.RangeCut = sql.AboveNull{}
+		if n.key[0] != nil {
+			pos = sql.Above{Key: n.key[0]}
+		}
+		cmp, err := upper.Compare(pos, typ)
+		return cmp >= 0, err
+	}
+
+	// In the order of the index, the entries start at one cut and end at the other
+	start, end := atOrAboveLower, atOrBelowUpper
+	if oi.descending[0] {
+		start, end = end, start
+	}
+
+	var n *orderedIndexNode
+	var err error
+	if reverse {
+		n = oi.seekLast(end)
+	} else {
+		n, err = oi.seekFirst(start)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var rows []sql.Row
+	for ; n != nil; n = oi.step(n, reverse) {
+		var ok bool
+		if reverse {
+			ok, err = start(n)
+		} else {
+			ok, err = end(n)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		rows = append(rows, n.row)
+	}
+	return rows, nil
+}
+
+// keyRows returns the rows of this index whose key is the key given, in the order of the index.
+func (oi *orderedIndex) keyRows(key []interface{}) ([]sql.Row, error) {
+	compareKey := func(n *orderedIndexNode) (int, error) {
+		for i, expr := range oi.exprs {
+			cmp, err := compareNullsFirst(expr.Type(), n.key[i], key[i])
+			if err != nil {
+				return 0, err
+			}
+			if cmp != 0 {
+				if oi.descending[i] {
+					cmp = -cmp
+				}
+				return cmp, nil
+			}
+		}
+		return 0, nil
+	}
+
+	n, err := oi.seekFirst(func(n *orderedIndexNode) (bool, error) {
+		cmp, err := compareKey(n)
+		return cmp >= 0, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	var rows []sql.Row
+	for ; n != nil; n = n.next[0] {
+		cmp, err := compareKey(n)
+		if err != nil {
+			return nil, err
+		}
+		if cmp != 0 {
+			break
+		}
+		rows = append(rows, n.row)
+	}
+	return rows, nil
+}
+
+// step returns the entry after the one given, or before it if |reverse| is true.
+func (oi *orderedIndex) step(n *orderedIndexNode, reverse bool) *orderedIndexNode {
+	if reverse {
+		return n.prev
+	}
+	return n.next[0]
+}
+
+// orderedIndexes are the ordered indexes of a table, shared by the copies of the table that share its rows. They're
+// built from the rows of the table the first time they're read, and kept up to date with the rows added to and
+// removed from the table by its editors afterward. Changes that replace the rows of the table altogether drop them,
+// to be built again.
+type orderedIndexes struct {
+	mu      sync.Mutex
+	indexes map[string]*orderedIndex
+}
+
+// indexKey returns the key of the ordered index of the index given, which changes if the definition of an index with
+// the same name changes.
+func indexKey(idx *Index) string {
+	return fmt.Sprintf("%s\x00%s\x00%v\x00%v", idx.ID(), strings.Join(idx.Expressions(), ","), idx.Descending(), idx.PrefixLens)
+}
+
+// get returns the ordered index of the index given of the table given, building it if needed. Must be called with
+// the lock held, and the data lock of the table held.
+func (o *orderedIndexes) get(ctx *sql.Context, t *Table, idx *Index) (*orderedIndex, error) {
+	key := indexKey(idx)
+	if oi, ok := o.indexes[key]; ok {
+		return oi, nil
+	}
+
+	oi := newOrderedIndex(t, idx)
+	for _, k := range t.partitionKeys {
+		for _, row := range t.partitions[string(k)] {
+			if err := oi.insert(ctx, row); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if o.indexes == nil {
+		o.indexes = make(map[string]*orderedIndex)
+	}
+	o.indexes[key] = oi
+	return oi, nil
+}
+
+// rowAdded adds the row given, which was added to the table, to the ordered indexes that have been built.
+func (o *orderedIndexes) rowAdded(ctx *sql.Context, row sql.Row) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, oi := range o.indexes {
+		if err := oi.insert(ctx, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rowRemoved removes the row given, which was removed from the table, from the ordered indexes that have been built.
+func (o *orderedIndexes) rowRemoved(ctx *sql.Context, row sql.Row) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, oi := range o.indexes {
+		if err := oi.delete(ctx, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reset drops the ordered indexes, after the rows of the table were replaced.
+func (o *orderedIndexes) reset() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.indexes = nil
+}
+
+// indexRows returns the rows of this table in the order of the index given, or in the reverse order if |reverse| is
+// true. Only the rows within the ranges given of the first expression of the index are returned, but rows outside the
+// ranges of the other expressions may be.
+func (t *Table) indexRows(ctx *sql.Context, idx *Index, ranges []sql.Range, reverse bool) ([]sql.Row, error) {
+	var lower, upper sql.RangeCut
+	var typ sql.Type
+	// Prefix indexes are sorted on their full values, which are above the ranges of their prefixes
+	bounded := len(ranges) > 0 && len(ranges[0]) > 0 && idx.CommentStr != CommentPreventingIndexBuilding &&
+		(len(idx.PrefixLens) == 0 || idx.PrefixLens[0] == 0)
+	if bounded {
+		typ = ranges[0][0].Typ
+		lowers := make([]sql.RangeCut, len(ranges))
+		uppers := make([]sql.RangeCut, len(ranges))
+		for i, rang := range ranges {
+			lowers[i] = rang[0].LowerBound
+			uppers[i] = rang[0].UpperBound
+		}
+		var err error
+		if lower, err = sql.GetRangeCutMin(typ, lowers...); err != nil {
+			return nil, err
+		}
+		if upper, err = sql.GetRangeCutMax(typ, uppers...); err != nil {
+			return nil, err
+		}
+	}
+
+	// Ordered indexes only change while the data lock is held for writing, so readers holding it for reading can walk
+	// them at the same time
+	t.dataLock.RLock()
+	defer t.dataLock.RUnlock()
+	t.orderedIndexes.mu.Lock()
+	oi, err := t.orderedIndexes.get(ctx, t, idx)
+	t.orderedIndexes.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return oi.rows(lower, upper, typ, reverse)
+}
+
+// keyRows returns the rows of this table whose key in the index given is the key given, in the order of the index.
+func (t *Table) keyRows(ctx *sql.Context, idx *Index, key []interface{}) ([]sql.Row, error) {
+	t.dataLock.RLock()
+	defer t.dataLock.RUnlock()
+	t.orderedIndexes.mu.Lock()
+	oi, err := t.orderedIndexes.get(ctx, t, idx)
+	t.orderedIndexes.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return oi.keyRows(key)
+}
@@ -11,6 +11,7 @@ import (
 
 var _ sql.DatabaseProvider = (*DbProvider)(nil)
 var _ sql.MutableDatabaseProvider = (*DbProvider)(nil)
+var _ sql.DatabaseRenamer = (*DbProvider)(nil)
 var _ sql.TableFunctionProvider = (*DbProvider)(nil)
 var _ sql.ExternalStoredProcedureProvider = (*DbProvider)(nil)
 
@@ -173,6 +174,35 @@ func (pro *DbProvider) DropDatabase(_ *sql.Context, name string) (err error) {
 	return
 }
 
+// RenameDatabase implements sql.DatabaseRenamer.
+func (pro *DbProvider) RenameDatabase(_ *sql.Context, oldName, newName string) error {
+	pro.mu.Lock()
+	defer pro.mu.Unlock()
+
+	db, ok := pro.dbs[strings.ToLower(oldName)]
+	if !ok {
+		return sql.ErrDatabaseNotFound.New(oldName)
+	}
+	if _, ok = pro.dbs[strings.ToLower(newName)]; ok && strings.ToLower(oldName) != strings.ToLower(newName) {
+		return sql.ErrDatabaseExists.New(newName)
+	}
+	renamable, ok := db.(renamableDatabase)
+	if !ok {
+		return sql.ErrRenameDatabaseNotSupported.New(oldName)
+	}
+
+	oldName = db.Name()
+	renamable.setName(newName)
+	for _, otherDb := range pro.dbs {
+		if fkDb, ok := otherDb.(foreignKeyDatabase); ok {
+			fkDb.GetForeignKeyCollection().renameDatabase(oldName, newName)
+		}
+	}
+	delete(pro.dbs, strings.ToLower(oldName))
+	pro.dbs[strings.ToLower(newName)] = db
+	return nil
+}
+
 // ExternalStoredProcedure implements sql.ExternalStoredProcedureProvider
 func (pro *DbProvider) ExternalStoredProcedure(_ *sql.Context, name string, numOfParams int) (*sql.ExternalStoredProcedureDetails, error) {
 	return pro.externalProcedureRegistry.LookupByNameAndParamCount(name, numOfParams)
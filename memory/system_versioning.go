@@ -0,0 +1,157 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// systemTimeHistory is the history of a system-versioned table: the versions of its rows that were updated or deleted,
+// whose ROW END column holds the time they stopped being current.
+type systemTimeHistory struct {
+	period sql.SystemTimePeriod
+	mu     sync.Mutex
+	rows   []sql.Row
+}
+
+// SystemTimePeriod implements the sql.VersionedTable interface.
+func (t *Table) SystemTimePeriod() (sql.SystemTimePeriod, bool) {
+	if t.systemTime == nil {
+		return sql.SystemTimePeriod{}, false
+	}
+	return t.systemTime.period, true
+}
+
+// EnableSystemVersioning implements the sql.SystemVersionableTable interface.
+func (t *Table) EnableSystemVersioning(ctx *sql.Context, period sql.SystemTimePeriod) error {
+	for _, name := range []string{period.Start, period.End} {
+		idx := t.schema.Schema.IndexOfColName(name)
+		if idx < 0 {
+			return sql.ErrInvalidSystemVersioning.New(fmt.Sprintf("column %s does not exist", name))
+		}
+		if _, ok := t.schema.Schema[idx].Type.(sql.DatetimeType); !ok {
+			return sql.ErrInvalidSystemVersioning.New(fmt.Sprintf("column %s must be a DATETIME or TIMESTAMP column", name))
+		}
+	}
+	t.systemTime = &systemTimeHistory{period: period}
+	return nil
+}
+
+// AsOf implements the sql.VersionedTable interface. The table returned holds the versions of the rows that were
+// current at the time given, and can't be written.
+func (t *Table) AsOf(ctx *sql.Context, asOf time.Time) (sql.Table, error) {
+	if t.systemTime == nil {
+		return nil, sql.ErrAsOfNotSupported.New(t.name)
+	}
+	startIdx, endIdx := t.systemTimeColumns()
+
+	visible := func(row sql.Row, current bool) bool {
+		start, ok := row[startIdx].(time.Time)
+		if !ok || start.After(asOf) {
+			return false
+		}
+		if current {
+			return true
+		}
+		end, ok := row[endIdx].(time.Time)
+		return ok && end.After(asOf)
+	}
+
+	nt := *t
+	nt.systemTime = nil
+	nt.changeListeners = &changeListeners{}
+	nt.orderedIndexes = &orderedIndexes{}
+	nt.dataLock = &sync.RWMutex{}
+	nt.editAccumulators = &editAccumulators{}
+	t.dataLock.RLock()
+	nt.partitions = make(map[string][]sql.Row, len(t.partitions))
+	for key, rows := range t.partitions {
+		var visibleRows []sql.Row
+		for _, row := range rows {
+			if visible(row, true) {
+				visibleRows = append(visibleRows, row)
+			}
+		}
+		nt.partitions[key] = visibleRows
+	}
+	t.dataLock.RUnlock()
+
+	t.systemTime.mu.Lock()
+	defer t.systemTime.mu.Unlock()
+	key := string(t.partitionKeys[0])
+	for _, row := range t.systemTime.rows {
+		if visible(row, false) {
+			nt.partitions[key] = append(nt.partitions[key], row)
+		}
+	}
+	return &nt, nil
+}
+
+// systemTimeColumns returns the indexes of the ROW START and ROW END columns of this system-versioned table.
+func (t *Table) systemTimeColumns() (int, int) {
+	return t.schema.Schema.IndexOfColName(t.systemTime.period.Start), t.schema.Schema.IndexOfColName(t.systemTime.period.End)
+}
+
+// stampSystemTime returns the row given with its ROW START column set to the time of the current query, and its ROW
+// END column set to the latest time of its type, if this table is system-versioned.
+func (t *Table) stampSystemTime(ctx *sql.Context, row sql.Row) (sql.Row, error) {
+	if t.systemTime == nil {
+		return row, nil
+	}
+	startIdx, endIdx := t.systemTimeColumns()
+	start, err := t.schema.Schema[startIdx].Type.Convert(ctx.QueryTime())
+	if err != nil {
+		return nil, err
+	}
+	endType := t.schema.Schema[endIdx].Type.(sql.DatetimeType)
+	end, err := endType.Convert(endType.MaximumTime())
+	if err != nil {
+		return nil, err
+	}
+
+	row = row.Copy()
+	row[startIdx] = start
+	row[endIdx] = end
+	return row, nil
+}
+
+// addSystemTimeHistory adds the versions of the rows replaced or deleted by the changes given to the history of this
+// table, if it's system-versioned, ending them at the time of the current query.
+func (t *Table) addSystemTimeHistory(ctx *sql.Context, changes []sql.RowChange) error {
+	if t.systemTime == nil {
+		return nil
+	}
+	_, endIdx := t.systemTimeColumns()
+	end, err := t.schema.Schema[endIdx].Type.Convert(ctx.QueryTime())
+	if err != nil {
+		return err
+	}
+
+	t.systemTime.mu.Lock()
+	defer t.systemTime.mu.Unlock()
+	for _, change := range changes {
+		if change.Old == nil {
+			continue
+		}
+		row := change.Old.Copy()
+		row[endIdx] = end
+		t.systemTime.rows = append(t.systemTime.rows, row)
+	}
+	return nil
+}
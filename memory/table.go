@@ -24,6 +24,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
@@ -45,7 +47,12 @@ type Table struct {
 	checks           []sql.CheckDefinition
 	collation        sql.CollationID
 	pkIndexesEnabled bool
-	ed               tableEditAccumulator
+	temporary        bool
+
+	// uniqueKeysUnenforced leaves the enforcement of unique indexes to the engine
+	uniqueKeysUnenforced bool
+	// onlineIndexBuilds makes the table build the indexes added to it online
+	onlineIndexBuilds bool
 
 	// pushdown info
 	filters         []sql.Expression // currently unused, filter pushdown is significantly broken right now
@@ -54,8 +61,9 @@ type Table struct {
 	columns         []int
 
 	// Data storage
-	partitions    map[string][]sql.Row
-	partitionKeys [][]byte
+	partitions        map[string][]sql.Row
+	partitionKeys     [][]byte
+	partitionStrategy PartitionStrategy
 
 	// Insert bookkeeping
 	insertPartIdx int
@@ -68,11 +76,25 @@ type Table struct {
 	autoColIdx int
 
 	tableStats *sql.TableStatistics
+
+	// dataVersion is the data version token of the table, shared by its copies
+	dataVersion *uint64
+	// changeListeners are notified of the changes to the rows of the table, shared by its copies
+	changeListeners *changeListeners
+	// systemTime is the history of the table if it's system-versioned, shared by its copies
+	systemTime *systemTimeHistory
+	// orderedIndexes are the rows of the table sorted on each of its indexes, shared by its copies
+	orderedIndexes *orderedIndexes
+	// dataLock guards the rows of the table along with its insert and AUTO_INCREMENT bookkeeping, shared by its copies
+	dataLock *sync.RWMutex
+	// editAccumulators are the pending edits of the sessions writing the table, shared by its copies
+	editAccumulators *editAccumulators
 }
 
 var _ sql.Table = (*Table)(nil)
 var _ sql.Table2 = (*Table)(nil)
 var _ sql.InsertableTable = (*Table)(nil)
+var _ sql.BulkInsertTable = (*Table)(nil)
 var _ sql.UpdatableTable = (*Table)(nil)
 var _ sql.DeletableTable = (*Table)(nil)
 var _ sql.ReplaceableTable = (*Table)(nil)
@@ -90,6 +112,19 @@ var _ sql.StatisticsTable = (*Table)(nil)
 var _ sql.ProjectedTable = (*Table)(nil)
 var _ sql.PrimaryKeyAlterableTable = (*Table)(nil)
 var _ sql.PrimaryKeyTable = (*Table)(nil)
+var _ sql.TemporaryTable = (*Table)(nil)
+var _ sql.UnenforcedUniqueKeyTable = (*Table)(nil)
+var _ sql.OnlineIndexBuilder = (*Table)(nil)
+var _ sql.DataVersionedTable = (*Table)(nil)
+var _ sql.ChangeNotifyingTable = (*Table)(nil)
+var _ sql.SystemVersionableTable = (*Table)(nil)
+var _ sql.RowCounter = (*Table)(nil)
+var _ sql.IndexDistinctTable = (*Table)(nil)
+
+// dataVersions is the last data version token given to any table. Tables start with the token 0, since they're all
+// empty then, and are given unique tokens once they're written, so that a table created with the name of a dropped
+// table doesn't report the tokens of the dropped table.
+var dataVersions uint64
 
 // NewTable creates a new Table with the given name and schema. Assigns the default collation, therefore if a different
 // collation is desired, please use NewTableWithCollation.
@@ -110,18 +145,7 @@ func NewPartitionedTable(name string, schema sql.PrimaryKeySchema, fkColl *Forei
 
 // NewPartitionedTableWithCollation creates a new Table with the given name, schema, number of partitions, and collation.
 func NewPartitionedTableWithCollation(name string, schema sql.PrimaryKeySchema, fkColl *ForeignKeyCollection, numPartitions int, collation sql.CollationID) *Table {
-	var keys [][]byte
-	var partitions = map[string][]sql.Row{}
-
-	if numPartitions < 1 {
-		numPartitions = 1
-	}
-
-	for i := 0; i < numPartitions; i++ {
-		key := strconv.Itoa(i)
-		keys = append(keys, []byte(key))
-		partitions[key] = []sql.Row{}
-	}
+	keys, partitions := newPartitions(numPartitions)
 
 	var autoIncVal uint64
 	autoIncIdx := -1
@@ -134,14 +158,167 @@ func NewPartitionedTableWithCollation(name string, schema sql.PrimaryKeySchema,
 	}
 
 	return &Table{
-		name:          name,
-		schema:        schema,
-		fkColl:        fkColl,
-		collation:     collation,
-		partitions:    partitions,
-		partitionKeys: keys,
-		autoIncVal:    autoIncVal,
-		autoColIdx:    autoIncIdx,
+		name:             name,
+		schema:           schema,
+		fkColl:           fkColl,
+		collation:        collation,
+		partitions:       partitions,
+		partitionKeys:    keys,
+		autoIncVal:       autoIncVal,
+		autoColIdx:       autoIncIdx,
+		dataVersion:      new(uint64),
+		changeListeners:  &changeListeners{},
+		orderedIndexes:   &orderedIndexes{},
+		dataLock:         &sync.RWMutex{},
+		editAccumulators: &editAccumulators{},
+	}
+}
+
+// PartitionStrategy is how the rows of a Table are distributed over its partitions.
+type PartitionStrategy byte
+
+const (
+	// RoundRobinPartitioning puts each row inserted in the partition after the one the previous row was inserted in.
+	// The rows of a table with a primary key are kept sorted on it across partitions, so that each partition holds a
+	// range of keys. This is the strategy of tables unless another one is given.
+	RoundRobinPartitioning PartitionStrategy = iota
+	// HashPartitioning puts each row in the partition given by the hash of its primary key, or of all of its values if
+	// the table has none, so that the rows with the same key are always in the same partition. The rows of a table with
+	// a primary key are kept sorted on it within each partition.
+	HashPartitioning
+)
+
+// NewPartitionedTableWithStrategy creates a new Table with the given name, schema, number of partitions and
+// partitioning strategy. Assigns the default collation.
+func NewPartitionedTableWithStrategy(name string, schema sql.PrimaryKeySchema, fkColl *ForeignKeyCollection, numPartitions int, strategy PartitionStrategy) *Table {
+	t := NewPartitionedTableWithCollation(name, schema, fkColl, numPartitions, sql.Collation_Default)
+	t.partitionStrategy = strategy
+	return t
+}
+
+// newPartitions returns the keys of the number of partitions given, at least one, along with the empty partitions.
+func newPartitions(numPartitions int) ([][]byte, map[string][]sql.Row) {
+	if numPartitions < 1 {
+		numPartitions = 1
+	}
+	keys := make([][]byte, numPartitions)
+	partitions := make(map[string][]sql.Row, numPartitions)
+	for i := range keys {
+		key := strconv.Itoa(i)
+		keys[i] = []byte(key)
+		partitions[key] = []sql.Row{}
+	}
+	return keys, partitions
+}
+
+// Repartition distributes the rows of the table over the number of partitions given, with the partitioning strategy
+// given, which is used for the rows inserted afterward as well.
+func (t *Table) Repartition(ctx *sql.Context, numPartitions int, strategy PartitionStrategy) error {
+	defer t.bumpDataVersion()
+	t.dataLock.Lock()
+	defer t.dataLock.Unlock()
+
+	var rows []sql.Row
+	for _, k := range t.partitionKeys {
+		rows = append(rows, t.partitions[string(k)]...)
+	}
+
+	// The partitions are replaced in the map shared by the copies of the table
+	keys, partitions := newPartitions(numPartitions)
+	for k := range t.partitions {
+		delete(t.partitions, k)
+	}
+	for k, p := range partitions {
+		t.partitions[k] = p
+	}
+	t.partitionKeys = keys
+	t.partitionStrategy = strategy
+	t.insertPartIdx = 0
+
+	for _, row := range rows {
+		key, err := t.partitionFor(row)
+		if err != nil {
+			return err
+		}
+		t.partitions[key] = append(t.partitions[key], row)
+	}
+	if !sql.IsKeyless(t.schema.Schema) {
+		t.sortRows()
+	}
+	t.orderedIndexes.reset()
+	return nil
+}
+
+// partitionFor returns the key of the partition to insert the row given in, according to the partitioning strategy
+// of the table. Must be called with the data lock held for writing.
+func (t *Table) partitionFor(row sql.Row) (string, error) {
+	if t.partitionStrategy == HashPartitioning {
+		h, err := sql.HashOf(projectOnRow(t.schema.PkOrdinals, row))
+		if err != nil {
+			return "", err
+		}
+		return string(t.partitionKeys[h%uint64(len(t.partitionKeys))]), nil
+	}
+
+	key := string(t.partitionKeys[t.insertPartIdx])
+	t.insertPartIdx++
+	if t.insertPartIdx == len(t.partitionKeys) {
+		t.insertPartIdx = 0
+	}
+	return key, nil
+}
+
+// DataVersion implements the sql.DataVersionedTable interface. The version of a table changes every time a statement
+// writing it completes, and every time its schema changes.
+func (t *Table) DataVersion(*sql.Context) (string, error) {
+	return strconv.FormatUint(atomic.LoadUint64(t.dataVersion), 10), nil
+}
+
+// bumpDataVersion gives the table a new data version token.
+func (t *Table) bumpDataVersion() {
+	atomic.StoreUint64(t.dataVersion, atomic.AddUint64(&dataVersions, 1))
+}
+
+// AddChangeListener implements the sql.ChangeNotifyingTable interface.
+func (t *Table) AddChangeListener(listener sql.TableChangeListener) {
+	t.changeListeners.add(listener)
+}
+
+// RemoveChangeListener implements the sql.ChangeNotifyingTable interface.
+func (t *Table) RemoveChangeListener(listener sql.TableChangeListener) {
+	t.changeListeners.remove(listener)
+}
+
+// changeListeners are the listeners notified of the changes to the rows of a table.
+type changeListeners struct {
+	mu        sync.Mutex
+	listeners []sql.TableChangeListener
+}
+
+func (c *changeListeners) add(listener sql.TableChangeListener) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners = append(c.listeners, listener)
+}
+
+func (c *changeListeners) remove(listener sql.TableChangeListener) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, l := range c.listeners {
+		if l == listener {
+			c.listeners = append(c.listeners[:i:i], c.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+// notify reports the changes given to the rows of the table given to the listeners.
+func (c *changeListeners) notify(ctx *sql.Context, table *Table, changes []sql.RowChange) {
+	c.mu.Lock()
+	listeners := c.listeners
+	c.mu.Unlock()
+	for _, listener := range listeners {
+		listener.RowsChanged(ctx, table, changes)
 	}
 }
 
@@ -163,7 +340,14 @@ func (t *Table) Collation() sql.CollationID {
 	return t.collation
 }
 
+// IsTemporary implements the sql.TemporaryTable interface.
+func (t *Table) IsTemporary() bool {
+	return t.temporary
+}
+
 func (t *Table) GetPartition(key string) []sql.Row {
+	t.dataLock.RLock()
+	defer t.dataLock.RUnlock()
 	rows, ok := t.partitions[string(key)]
 	if ok {
 		return rows
@@ -174,6 +358,8 @@ func (t *Table) GetPartition(key string) []sql.Row {
 
 // Partitions implements the sql.Table interface.
 func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	t.dataLock.RLock()
+	defer t.dataLock.RUnlock()
 	var keys [][]byte
 	for _, k := range t.partitionKeys {
 		if rows, ok := t.partitions[string(k)]; ok && len(rows) > 0 {
@@ -183,34 +369,6 @@ func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 	return &partitionIter{keys: keys}, nil
 }
 
-// rangePartitionIter returns a partition that has range and table data access
-type rangePartitionIter struct {
-	child  *partitionIter
-	ranges sql.Expression
-}
-
-var _ sql.PartitionIter = (*rangePartitionIter)(nil)
-
-func (i rangePartitionIter) Close(ctx *sql.Context) error {
-	return i.child.Close(ctx)
-}
-
-func (i rangePartitionIter) Next(ctx *sql.Context) (sql.Partition, error) {
-	part, err := i.child.Next(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return &rangePartition{
-		Partition: part.(*Partition),
-		rang:      i.ranges,
-	}, nil
-}
-
-type rangePartition struct {
-	*Partition
-	rang sql.Expression
-}
-
 // spatialRangePartitionIter returns a partition that has range and table data access
 type spatialRangePartitionIter struct {
 	child                  *partitionIter
@@ -247,25 +405,24 @@ type spatialRangePartition struct {
 
 // PartitionCount implements the sql.PartitionCounter interface.
 func (t *Table) PartitionCount(ctx *sql.Context) (int64, error) {
+	t.dataLock.RLock()
+	defer t.dataLock.RUnlock()
 	return int64(len(t.partitions)), nil
 }
 
 // PartitionRows implements the sql.PartitionRows interface.
 func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-	filters := t.filters
-	if r, ok := partition.(*rangePartition); ok {
-		// index lookup is currently a single filter applied to a full table scan
-		filters = append(t.filters, r.rang)
-	}
-
+	t.dataLock.RLock()
 	rows, ok := t.partitions[string(partition.Key())]
 	if !ok {
+		t.dataLock.RUnlock()
 		return nil, sql.ErrPartitionNotFound.New(partition.Key())
 	}
 	// The slice could be altered by other operations taking place during iteration (such as deletion or insertion), so
 	// make a copy of the values as they exist when execution begins.
 	rowsCopy := make([]sql.Row, len(rows))
 	copy(rowsCopy, rows)
+	t.dataLock.RUnlock()
 
 	if r, ok := partition.(*spatialRangePartition); ok {
 		return &spatialTableIter{
@@ -282,11 +439,13 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 	return &tableIter{
 		rows:    rowsCopy,
 		columns: t.columns,
-		filters: filters,
+		filters: t.filters,
 	}, nil
 }
 
 func (t *Table) numRows(ctx *sql.Context) (uint64, error) {
+	t.dataLock.RLock()
+	defer t.dataLock.RUnlock()
 	var count uint64
 	for _, rows := range t.partitions {
 		count += uint64(len(rows))
@@ -357,6 +516,11 @@ func (t *Table) RowCount(ctx *sql.Context) (uint64, error) {
 	return t.numRows(ctx)
 }
 
+// ExactRowCount implements the sql.RowCounter interface.
+func (t *Table) ExactRowCount(ctx *sql.Context) (uint64, error) {
+	return t.numRows(ctx)
+}
+
 func NewPartition(key []byte) *Partition {
 	return &Partition{key: key}
 }
@@ -604,31 +768,44 @@ func EncodeIndexValue(value *IndexValue) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func (t *Table) Inserter(*sql.Context) sql.RowInserter {
-	return t.getTableEditor()
+func (t *Table) Inserter(ctx *sql.Context) sql.RowInserter {
+	return t.getTableEditor(ctx)
 }
 
-func (t *Table) Updater(*sql.Context) sql.RowUpdater {
-	return t.getTableEditor()
+// BulkInserter implements the sql.BulkInsertTable interface.
+func (t *Table) BulkInserter(ctx *sql.Context) sql.BulkRowInserter {
+	return t.getTableEditor(ctx)
 }
 
-func (t *Table) Replacer(*sql.Context) sql.RowReplacer {
-	return t.getTableEditor()
+func (t *Table) Updater(ctx *sql.Context) sql.RowUpdater {
+	return t.getTableEditor(ctx)
 }
 
-func (t *Table) Deleter(*sql.Context) sql.RowDeleter {
-	return t.getTableEditor()
+func (t *Table) Replacer(ctx *sql.Context) sql.RowReplacer {
+	return t.getTableEditor(ctx)
 }
 
-func (t *Table) AutoIncrementSetter(*sql.Context) sql.AutoIncrementSetter {
-	return t.getTableEditor()
+func (t *Table) Deleter(ctx *sql.Context) sql.RowDeleter {
+	return t.getTableEditor(ctx)
 }
 
-func (t *Table) getTableEditor() *tableEditor {
+func (t *Table) AutoIncrementSetter(ctx *sql.Context) sql.AutoIncrementSetter {
+	return t.getTableEditor(ctx)
+}
+
+func (t *Table) getTableEditor(ctx *sql.Context) *tableEditor {
 	var uniqIdxCols [][]int
 	var prefixLengths [][]uint16
+	// Unique keys are checked in index order, so that the row in conflict with an insert is deterministic
+	idxs := make([]sql.Index, 0, len(t.indexes))
 	for _, idx := range t.indexes {
-		if !idx.IsUnique() {
+		idxs = append(idxs, idx)
+	}
+	sort.Slice(idxs, func(i, j int) bool {
+		return idxs[i].ID() < idxs[j].ID()
+	})
+	for _, idx := range idxs {
+		if !idx.IsUnique() || t.uniqueKeysUnenforced {
 			continue
 		}
 		var colNames []string
@@ -644,15 +821,10 @@ func (t *Table) getTableEditor() *tableEditor {
 		prefixLengths = append(prefixLengths, idx.PrefixLengths())
 	}
 
-	if t.ed == nil {
-		t.ed = NewTableEditAccumulator(t)
-	}
-
 	return &tableEditor{
 		table:             t,
-		initialAutoIncVal: 1,
 		initialPartitions: nil,
-		ea:                t.ed,
+		ea:                t.editAccumulators.get(ctx, t),
 		initialInsert:     0,
 		uniqueIdxCols:     uniqIdxCols,
 		prefixLengths:     prefixLengths,
@@ -660,12 +832,24 @@ func (t *Table) getTableEditor() *tableEditor {
 }
 
 func (t *Table) Truncate(ctx *sql.Context) (int, error) {
-	count := 0
+	defer t.bumpDataVersion()
+	var changes []sql.RowChange
+	t.dataLock.Lock()
 	for key := range t.partitions {
-		count += len(t.partitions[key])
+		for _, row := range t.partitions[key] {
+			changes = append(changes, sql.RowChange{Old: row})
+		}
 		t.partitions[key] = nil
 	}
-	return count, nil
+	t.orderedIndexes.reset()
+	t.dataLock.Unlock()
+	if err := t.addSystemTimeHistory(ctx, changes); err != nil {
+		return 0, err
+	}
+	if len(changes) > 0 {
+		t.changeListeners.notify(ctx, t, changes)
+	}
+	return len(changes), nil
 }
 
 // Convenience method to avoid having to create an inserter in test setup
@@ -735,29 +919,29 @@ func rowsAreEqual(ctx *sql.Context, schema sql.Schema, left, right sql.Row) (boo
 
 // PeekNextAutoIncrementValue peeks at the next AUTO_INCREMENT value
 func (t *Table) PeekNextAutoIncrementValue(*sql.Context) (uint64, error) {
+	t.dataLock.RLock()
+	defer t.dataLock.RUnlock()
 	return t.autoIncVal, nil
 }
 
-// GetNextAutoIncrementValue gets the next auto increment value for the memory table the increment.
+// GetNextAutoIncrementValue gets the next auto increment value for the memory table the increment. Like MySQL, values
+// given for the AUTO_INCREMENT column only move the sequence once their rows are inserted, so rows that fail their
+// checks don't.
 func (t *Table) GetNextAutoIncrementValue(ctx *sql.Context, insertVal interface{}) (uint64, error) {
-	cmp, err := types.Uint64.Compare(insertVal, t.autoIncVal)
-	if err != nil {
-		return 0, err
-	}
-
-	if cmp > 0 && insertVal != nil {
-		v, err := types.Uint64.Convert(insertVal)
-		if err != nil {
-			return 0, err
-		}
-		t.autoIncVal = v.(uint64)
-	}
-
+	t.dataLock.RLock()
+	defer t.dataLock.RUnlock()
 	return t.autoIncVal, nil
 }
 
 func (t *Table) AddColumn(ctx *sql.Context, column *sql.Column, order *sql.ColumnOrder) error {
+	if t.systemTime != nil {
+		return sql.ErrSystemVersionedTableAlter.New(t.name)
+	}
+	defer t.bumpDataVersion()
+	t.dataLock.Lock()
+	defer t.dataLock.Unlock()
 	newColIdx := t.addColumnToSchema(ctx, column, order)
+	t.updateIndexExpressions("", "")
 	return t.insertValueInRows(ctx, newColIdx, column.Default)
 }
 
@@ -869,11 +1053,19 @@ func (t *Table) insertValueInRows(ctx *sql.Context, idx int, colDefault *sql.Col
 		}
 		t.partitions[k] = newP
 	}
+	t.orderedIndexes.reset()
 	return nil
 }
 
 func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
+	if t.systemTime != nil {
+		return sql.ErrSystemVersionedTableAlter.New(t.name)
+	}
+	defer t.bumpDataVersion()
+	t.dataLock.Lock()
+	defer t.dataLock.Unlock()
 	droppedCol := t.dropColumnFromSchema(ctx, columnName)
+	t.updateIndexExpressions("", "")
 	for k, p := range t.partitions {
 		newP := make([]sql.Row, len(p))
 		for i, row := range p {
@@ -884,6 +1076,7 @@ func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
 		}
 		t.partitions[k] = newP
 	}
+	t.orderedIndexes.reset()
 	return nil
 }
 
@@ -915,6 +1108,12 @@ func (t *Table) dropColumnFromSchema(ctx *sql.Context, columnName string) int {
 }
 
 func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Column, order *sql.ColumnOrder) error {
+	if t.systemTime != nil {
+		return sql.ErrSystemVersionedTableAlter.New(t.name)
+	}
+	defer t.bumpDataVersion()
+	t.dataLock.Lock()
+	defer t.dataLock.Unlock()
 	oldIdx := -1
 	newIdx := 0
 	for i, col := range t.schema.Schema {
@@ -969,6 +1168,7 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 		}
 		t.partitions[k] = newP
 	}
+	t.orderedIndexes.reset()
 
 	pkNameToOrdIdx := make(map[string]int)
 	for i, ord := range t.schema.PkOrdinals {
@@ -988,16 +1188,7 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 
 	t.schema.PkOrdinals = newPkOrds
 
-	for _, index := range t.indexes {
-		memIndex := index.(*Index)
-		nameLowercase := strings.ToLower(columnName)
-		for i, expr := range memIndex.Exprs {
-			getField := expr.(*expression.GetField)
-			if strings.ToLower(getField.Name()) == nameLowercase {
-				memIndex.Exprs[i] = expression.NewGetFieldWithTable(newIdx, column.Type, getField.Table(), column.Name, column.Nullable)
-			}
-		}
-	}
+	t.updateIndexExpressions(columnName, column.Name)
 
 	return nil
 }
@@ -1119,24 +1310,149 @@ func (t *FilteredTable) Projections() []string {
 	return t.projection
 }
 
-// IndexedTable is a table that expects to return one or more partitions
-// for range lookups.
-type IndexedTable struct {
+// SortedLimitedTable is a table that sorts and limits its rows itself when the analyzer pushes an ORDER BY or LIMIT down
+// to it. Like FilteredTable, it's used to test the pushdown of sql.SortedTable and sql.LimitedTable.
+type SortedLimitedTable struct {
 	*Table
-	Idx *Index
+	sortFields sql.SortFields
+	limit      int64
+	offset     int64
+	hasLimit   bool
 }
 
-func (t *IndexedTable) LookupPartitions(ctx *sql.Context, lookup sql.IndexLookup) (sql.PartitionIter, error) {
-	filter, err := lookup.Index.(*Index).rangeFilterExpr(lookup.Ranges...)
-	if err != nil {
-		return nil, err
+var _ sql.SortedTable = (*SortedLimitedTable)(nil)
+var _ sql.LimitedTable = (*SortedLimitedTable)(nil)
+
+func NewSortedLimitedTable(name string, schema sql.PrimaryKeySchema, fkColl *ForeignKeyCollection) *SortedLimitedTable {
+	return &SortedLimitedTable{
+		Table: NewTable(name, schema, fkColl),
 	}
-	child, err := t.Table.Partitions(ctx)
+}
+
+// SortFields implements the sql.SortedTable interface.
+func (t *SortedLimitedTable) SortFields() sql.SortFields {
+	return t.sortFields
+}
+
+// CanSort implements the sql.SortedTable interface.
+func (t *SortedLimitedTable) CanSort(sortFields sql.SortFields) bool {
+	return true
+}
+
+// WithSortFields implements the sql.SortedTable interface.
+func (t *SortedLimitedTable) WithSortFields(sortFields sql.SortFields) sql.Table {
+	nt := *t
+	nt.sortFields = sortFields
+	return &nt
+}
+
+// Limit implements the sql.LimitedTable interface.
+func (t *SortedLimitedTable) Limit() (int64, int64, bool) {
+	return t.limit, t.offset, t.hasLimit
+}
+
+// WithLimit implements the sql.LimitedTable interface.
+func (t *SortedLimitedTable) WithLimit(limit, offset int64) sql.Table {
+	nt := *t
+	nt.limit = limit
+	nt.offset = offset
+	nt.hasLimit = true
+	return &nt
+}
+
+// WithProjections implements sql.ProjectedTable
+func (t *SortedLimitedTable) WithProjections(schema []string) sql.Table {
+	table := t.Table.WithProjections(schema)
+
+	nt := *t
+	nt.Table = table.(*Table)
+	return &nt
+}
+
+// Partitions implements the sql.Table interface. The rows of all partitions are returned in a single partition, since
+// the sort and limit apply to the whole table.
+func (t *SortedLimitedTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return &partitionIter{keys: [][]byte{[]byte("sorted")}}, nil
+}
+
+// PartitionCount implements the sql.PartitionCounter interface.
+func (t *SortedLimitedTable) PartitionCount(ctx *sql.Context) (int64, error) {
+	return 1, nil
+}
+
+// PartitionRows implements the sql.PartitionRows interface.
+func (t *SortedLimitedTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	partitions, err := t.Table.Partitions(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	var rows []sql.Row
+	for {
+		p, err := partitions.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		iter, err := t.Table.PartitionRows(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		partitionRows, err := sql.RowIterToRows(ctx, nil, iter)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, partitionRows...)
+	}
+
+	if len(t.sortFields) > 0 {
+		sorter := &expression.Sorter{
+			SortFields: t.sortFields,
+			Rows:       rows,
+			Ctx:        ctx,
+		}
+		sort.Stable(sorter)
+		if sorter.LastError != nil {
+			return nil, sorter.LastError
+		}
+	}
+
+	if t.hasLimit {
+		if t.offset >= int64(len(rows)) {
+			rows = nil
+		} else {
+			rows = rows[t.offset:]
+		}
+		if t.limit < int64(len(rows)) {
+			rows = rows[:t.limit]
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// IndexedTable is a table that expects to return one or more partitions
+// for range lookups.
+type IndexedTable struct {
+	*Table
+	Idx       *Index
+	limit     int64
+	offset    int64
+	hasLimit  bool
+	indexCond sql.Expression
+}
+
+var _ sql.LimitedTable = (*IndexedTable)(nil)
+var _ sql.IndexConditionTable = (*IndexedTable)(nil)
+var _ sql.PointLookupTable = (*IndexedTable)(nil)
+
+func (t *IndexedTable) LookupPartitions(ctx *sql.Context, lookup sql.IndexLookup) (sql.PartitionIter, error) {
 	if lookup.Index.IsSpatial() {
+		child, err := t.Table.Partitions(ctx)
+		if err != nil {
+			return nil, err
+		}
 		lower := sql.GetRangeCutKey(lookup.Ranges[0][0].LowerBound)
 		upper := sql.GetRangeCutKey(lookup.Ranges[0][0].UpperBound)
 		minPoint, ok := lower.(types.Point)
@@ -1159,20 +1475,93 @@ func (t *IndexedTable) LookupPartitions(ctx *sql.Context, lookup sql.IndexLookup
 		}, nil
 	}
 
-	return rangePartitionIter{child: child.(*partitionIter), ranges: filter}, nil
+	p, err := t.lookupPartition(lookup)
+	if err != nil {
+		return nil, err
+	}
+	// The rows of all partitions are read from the ordered index together, in the order of the index
+	return sql.PartitionsToPartitionIter(p), nil
+}
+
+// lookupPartition returns the partition of the rows of all partitions of this table that match the lookup given.
+func (t *IndexedTable) lookupPartition(lookup sql.IndexLookup) (*indexLookupPartition, error) {
+	idx := lookup.Index.(*Index)
+	filter, err := idx.rangeFilterExpr(lookup.Ranges...)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.indexCond != nil {
+		// The rows of this table are the entries of its indexes, so the index condition is another range filter
+		if filter == nil {
+			filter = t.indexCond
+		} else {
+			filter = expression.NewAnd(filter, t.indexCond)
+		}
+	}
+
+	return &indexLookupPartition{
+		idx:     idx,
+		ranges:  lookup.Ranges,
+		rang:    filter,
+		reverse: lookup.IsReverse,
+	}, nil
+}
+
+// PointLookup implements the sql.PointLookupTable interface. The rows with the key of the lookup are found in the
+// ordered index of the lookup by their key, rather than by the ranges of the lookup.
+func (t *IndexedTable) PointLookup(ctx *sql.Context, lookup sql.IndexLookup) ([]sql.Row, error) {
+	idx := lookup.Index.(*Index)
+	var iter sql.RowIter
+	if len(lookup.Ranges) != 1 || len(idx.PrefixLens) > 0 || idx.CommentStr == CommentPreventingIndexBuilding {
+		// The ranges of prefixes and of unbuilt indexes are filtered after they're read, like other lookups
+		p, err := t.lookupPartition(lookup)
+		if err != nil {
+			return nil, err
+		}
+		iter, err = t.lookupRows(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		key := make([]interface{}, len(lookup.Ranges[0]))
+		for i, rce := range lookup.Ranges[0] {
+			key[i] = sql.GetRangeCutKey(rce.LowerBound)
+		}
+		rows, err := t.keyRows(ctx, idx, key)
+		if err != nil {
+			return nil, err
+		}
+		iter, err = t.rowIter(ctx, rows, t.indexCond)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowIterToRows(ctx, nil, iter)
 }
 
 // PartitionRows implements the sql.PartitionRows interface.
+// PartitionRows2 implements the sql.Table2 interface.
+func (t *IndexedTable) PartitionRows2(ctx *sql.Context, partition sql.Partition) (sql.RowIter2, error) {
+	iter, err := t.PartitionRows(ctx, partition)
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.RowIterToRowIter2(iter), nil
+}
+
 func (t *IndexedTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	if p, ok := partition.(*indexLookupPartition); ok {
+		return t.lookupRows(ctx, p)
+	}
+
 	iter, err := t.Table.PartitionRows(ctx, partition)
 	if err != nil {
 		return nil, err
 	}
 	if t.Idx != nil {
-		sf := make(sql.SortFields, len(t.Idx.Exprs))
-		for i, e := range t.Idx.Exprs {
-			sf[i] = sql.SortField{Column: e}
-		}
+		sf := t.Idx.sortFields(false)
 		var sorter *expression.Sorter
 		if i, ok := iter.(*tableIter); ok {
 			sorter = &expression.Sorter{
@@ -1195,10 +1584,148 @@ func (t *IndexedTable) PartitionRows(ctx *sql.Context, partition sql.Partition)
 	return iter, nil
 }
 
+// Limit implements the sql.LimitedTable interface.
+func (t *IndexedTable) Limit() (int64, int64, bool) {
+	return t.limit, t.offset, t.hasLimit
+}
+
+// WithLimit implements the sql.LimitedTable interface. The limit and offset apply to the rows of each lookup, in the
+// order of the index.
+func (t *IndexedTable) WithLimit(limit, offset int64) sql.Table {
+	nt := *t
+	nt.limit = limit
+	nt.offset = offset
+	nt.hasLimit = true
+	return &nt
+}
+
+// IndexCondition implements the sql.IndexConditionTable interface.
+func (t *IndexedTable) IndexCondition() sql.Expression {
+	return t.indexCond
+}
+
+// WithIndexCondition implements the sql.IndexConditionTable interface. The condition is evaluated on the rows of the
+// table before they're projected, so its columns are moved to their place in the full schema.
+func (t *IndexedTable) WithIndexCondition(cond sql.Expression) sql.Table {
+	cond, _, err := transform.Expr(cond, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		idx := t.schema.Schema.IndexOfColName(gf.Name())
+		if idx < 0 {
+			return nil, transform.SameTree, errColumnNotFound.New(gf.Name())
+		}
+		return gf.WithIndex(idx), transform.NewTree, nil
+	})
+	if err != nil {
+		// Without an index condition, the condition is left to the Filter node that it was pushed down from
+		return t
+	}
+	nt := *t
+	nt.indexCond = cond
+	return &nt
+}
+
+// indexLookupPartition is the single partition of a lookup on an IndexedTable.
+type indexLookupPartition struct {
+	idx    *Index
+	ranges []sql.Range
+	// rang is the filter of the rows within the ranges of the lookup
+	rang sql.Expression
+	// reverse is whether the rows are returned in the reverse order of the index
+	reverse bool
+}
+
+func (p *indexLookupPartition) Key() []byte {
+	return []byte("lookup")
+}
+
+// lookupRows returns the rows of the table that match the lookup of the partition given, in the order of the index,
+// after skipping the offset of this table and up to its limit if it has one.
+func (t *IndexedTable) lookupRows(ctx *sql.Context, p *indexLookupPartition) (sql.RowIter, error) {
+	rows, err := t.indexRows(ctx, p.idx, p.ranges, p.reverse)
+	if err != nil {
+		return nil, err
+	}
+	return t.rowIter(ctx, rows, p.rang)
+}
+
+// rowIter returns an iterator over the rows given, rows of this table, that match the filters of this table and the
+// filter given if it's not nil, after skipping the offset of this table and up to its limit if it has one.
+func (t *IndexedTable) rowIter(ctx *sql.Context, rows []sql.Row, filter sql.Expression) (sql.RowIter, error) {
+	filters := t.filters
+	if filter != nil {
+		filters = append(t.filters[:len(t.filters):len(t.filters)], filter)
+	}
+	iter := &tableIter{
+		rows:    rows,
+		columns: t.columns,
+		filters: filters,
+	}
+	if !t.hasLimit {
+		return iter, nil
+	}
+
+	rows, err := sql.RowIterToRows(ctx, nil, iter)
+	if err != nil {
+		return nil, err
+	}
+	if t.offset >= int64(len(rows)) {
+		rows = nil
+	} else {
+		rows = rows[t.offset:]
+	}
+	if t.limit < int64(len(rows)) {
+		rows = rows[:t.limit]
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
 func (t *Table) IndexedAccess(i sql.IndexLookup) sql.IndexedTable {
 	return &IndexedTable{Table: t, Idx: i.Index.(*Index)}
 }
 
+// DistinctIndexValues implements the sql.IndexDistinctTable interface. The rows of this table are the entries of its
+// indexes, so they're sorted on the first expression of the index, and only the first row with each value is kept.
+func (t *Table) DistinctIndexValues(ctx *sql.Context, idx sql.Index) (sql.RowIter, error) {
+	memIdx, ok := idx.(*Index)
+	if !ok {
+		return nil, fmt.Errorf("index %s is not an index of table %s", idx.ID(), t.name)
+	}
+
+	rows, err := t.indexRows(ctx, memIdx, nil, false)
+	if err != nil {
+		return nil, err
+	}
+
+	expr := memIdx.Exprs[0]
+	var values []sql.Row
+	for _, row := range rows {
+		v, err := expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) > 0 {
+			last := values[len(values)-1][0]
+			if last == nil && v == nil {
+				continue
+			}
+			if last != nil && v != nil {
+				cmp, err := expr.Type().Compare(last, v)
+				if err != nil {
+					return nil, err
+				}
+				if cmp == 0 {
+					continue
+				}
+			}
+		}
+		values = append(values, sql.Row{v})
+	}
+	return sql.RowsToRowIter(values...), nil
+}
+
 // WithProjections implements sql.ProjectedTable
 func (t *Table) WithProjections(cols []string) sql.Table {
 	nt := *t
@@ -1244,6 +1771,17 @@ func (t *Table) EnablePrimaryKeyIndexes() {
 	t.pkIndexesEnabled = true
 }
 
+// DisableUniqueKeyEnforcement stops this table from enforcing the unique keys of its unique indexes, which leaves
+// them to the engine as for a backend without native unique indexes. The primary key is still enforced.
+func (t *Table) DisableUniqueKeyEnforcement() {
+	t.uniqueKeysUnenforced = true
+}
+
+// UnenforcedUniqueKeys implements sql.UnenforcedUniqueKeyTable
+func (t *Table) UnenforcedUniqueKeys() bool {
+	return t.uniqueKeysUnenforced
+}
+
 // GetIndexes implements sql.IndexedTable
 func (t *Table) GetIndexes(ctx *sql.Context) ([]sql.Index, error) {
 	indexes := make([]sql.Index, 0)
@@ -1348,7 +1886,7 @@ func (t *Table) SetForeignKeyResolved(ctx *sql.Context, fkName string) error {
 
 // GetForeignKeyEditor implements sql.ForeignKeyTable.
 func (t *Table) GetForeignKeyEditor(ctx *sql.Context) sql.ForeignKeyEditor {
-	return t.getTableEditor()
+	return t.getTableEditor(ctx)
 }
 
 // GetChecks implements sql.CheckTable
@@ -1401,10 +1939,28 @@ func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint s
 
 	exprs := make([]sql.Expression, len(columns))
 	colNames := make([]string, len(columns))
+	var keyParts []sql.Expression
 	for i, column := range columns {
-		idx, field := t.getField(column.Name)
-		exprs[i] = expression.NewGetFieldWithTable(idx, field.Type, t.name, field.Name, field.Nullable)
 		colNames[i] = column.Name
+		if column.Expression == nil {
+			idx, field := t.getField(column.Name)
+			exprs[i] = expression.NewGetFieldWithTable(idx, field.Type, t.name, field.Name, field.Nullable)
+			continue
+		}
+
+		// The table editor enforces unique keys on the values of columns, which functional key parts don't have
+		if constraint == sql.IndexConstraint_Unique {
+			return nil, sql.ErrFunctionalIndexNotSupported.New("unique")
+		}
+		expr, err := t.tableExpression(column.Expression)
+		if err != nil {
+			return nil, err
+		}
+		exprs[i] = expr
+		if keyParts == nil {
+			keyParts = make([]sql.Expression, len(columns))
+		}
+		keyParts[i] = keyPartExpression(expr)
 	}
 
 	var hasNonZeroLengthColumn bool
@@ -1422,6 +1978,16 @@ func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint s
 		}
 	}
 
+	var descendingCols []bool
+	for i, column := range columns {
+		if column.Descending {
+			if descendingCols == nil {
+				descendingCols = make([]bool, len(columns))
+			}
+			descendingCols[i] = true
+		}
+	}
+
 	if constraint == sql.IndexConstraint_Unique {
 		err := t.errIfDuplicateEntryExist(colNames, name)
 		if err != nil {
@@ -1430,19 +1996,81 @@ func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint s
 	}
 
 	return &Index{
-		DB:         "",
-		DriverName: "",
-		Tbl:        t,
-		TableName:  t.name,
-		Exprs:      exprs,
-		Name:       name,
-		Unique:     constraint == sql.IndexConstraint_Unique,
-		Spatial:    constraint == sql.IndexConstraint_Spatial,
-		CommentStr: comment,
-		PrefixLens: prefixLengths,
+		DB:             "",
+		DriverName:     "",
+		Tbl:            t,
+		TableName:      t.name,
+		Exprs:          exprs,
+		Name:           name,
+		Unique:         constraint == sql.IndexConstraint_Unique,
+		Spatial:        constraint == sql.IndexConstraint_Spatial,
+		CommentStr:     comment,
+		PrefixLens:     prefixLengths,
+		DescendingCols: descendingCols,
+		KeyParts:       keyParts,
 	}, nil
 }
 
+// tableExpression returns the expression given with its columns replaced with the columns of this table, at their
+// places in its schema.
+func (t *Table) tableExpression(expr sql.Expression) (sql.Expression, error) {
+	expr, _, err := transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		idx := t.schema.Schema.IndexOfColName(gf.Name())
+		if idx < 0 {
+			return nil, transform.SameTree, errColumnNotFound.New(gf.Name())
+		}
+		col := t.schema.Schema[idx]
+		return expression.NewGetFieldWithTable(idx, col.Type, t.name, col.Name, col.Nullable), transform.NewTree, nil
+	})
+	return expr, err
+}
+
+// keyPartExpression returns the expression of the functional key part given, which is an expression of the columns of
+// this table, with its columns referenced by name only.
+func keyPartExpression(expr sql.Expression) sql.Expression {
+	expr, _, _ = transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		if gf, ok := e.(*expression.GetField); ok {
+			return expression.NewGetField(gf.Index(), gf.Type(), gf.Name(), gf.IsNullable()), transform.NewTree, nil
+		}
+		return e, transform.SameTree, nil
+	})
+	return expr
+}
+
+// updateIndexExpressions replaces the columns referenced by the expressions of the indexes of this table with the
+// columns of its schema, after its columns were added, dropped or modified. The column named |oldName| is replaced
+// with the column named |newName|. Columns that are no longer in the schema are left as they are.
+func (t *Table) updateIndexExpressions(oldName, newName string) {
+	for _, index := range t.indexes {
+		memIndex := index.(*Index)
+		for i, expr := range memIndex.Exprs {
+			memIndex.Exprs[i], _, _ = transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+				gf, ok := e.(*expression.GetField)
+				if !ok {
+					return e, transform.SameTree, nil
+				}
+				name := gf.Name()
+				if strings.EqualFold(name, oldName) {
+					name = newName
+				}
+				idx := t.schema.Schema.IndexOfColName(name)
+				if idx < 0 {
+					return e, transform.SameTree, nil
+				}
+				col := t.schema.Schema[idx]
+				return expression.NewGetFieldWithTable(idx, col.Type, t.name, col.Name, col.Nullable), transform.NewTree, nil
+			})
+			if memIndex.KeyParts != nil && memIndex.KeyParts[i] != nil {
+				memIndex.KeyParts[i] = keyPartExpression(memIndex.Exprs[i])
+			}
+		}
+	}
+}
+
 // throws an error if any two or more rows share the same |cols| values.
 func (t *Table) errIfDuplicateEntryExist(cols []string, idxName string) error {
 	columnMapping, err := t.columnIndexes(cols)
@@ -1450,6 +2078,8 @@ func (t *Table) errIfDuplicateEntryExist(cols []string, idxName string) error {
 		return err
 	}
 	unique := make(map[uint64]struct{})
+	t.dataLock.RLock()
+	defer t.dataLock.RUnlock()
 	for _, partition := range t.partitions {
 		for _, row := range partition {
 			idxPrefixKey := projectOnRow(columnMapping, row)
@@ -1519,6 +2149,7 @@ func (t *Table) DropIndex(ctx *sql.Context, indexName string) error {
 			delete(t.indexes, name)
 		}
 	}
+	t.orderedIndexes.reset()
 	return nil
 }
 
@@ -1601,6 +2232,7 @@ Top:
 
 // CreatePrimaryKey implements the PrimaryKeyAlterableTable
 func (t *Table) CreatePrimaryKey(ctx *sql.Context, columns []sql.IndexColumn) error {
+	defer t.bumpDataVersion()
 	// First check that a primary key already exists
 	for _, col := range t.schema.Schema {
 		if col.PrimaryKey {
@@ -1632,6 +2264,8 @@ func (t *Table) CreatePrimaryKey(ctx *sql.Context, columns []sql.IndexColumn) er
 	}
 
 	pkSchema := sql.NewPrimaryKeySchema(potentialSchema, pkOrdinals...)
+	t.dataLock.Lock()
+	defer t.dataLock.Unlock()
 	newTable, err := newTable(t, pkSchema)
 	if err != nil {
 		return err
@@ -1640,11 +2274,14 @@ func (t *Table) CreatePrimaryKey(ctx *sql.Context, columns []sql.IndexColumn) er
 	t.schema = pkSchema
 	t.partitions = newTable.partitions
 	t.partitionKeys = newTable.partitionKeys
+	t.orderedIndexes.reset()
+	t.editAccumulators.reset()
 
 	return nil
 }
 
-// Sorts the rows in the partitions of the table to be in primary key order.
+// Sorts the rows in the partitions of the table to be in primary key order, across partitions or within each of them
+// depending on the partitioning strategy. Must be called with the data lock held.
 func (t *Table) sortRows() {
 	type pkfield struct {
 		i int
@@ -1671,6 +2308,15 @@ func (t *Table) sortRows() {
 		return false
 	}
 
+	if t.partitionStrategy == HashPartitioning {
+		for _, p := range t.partitions {
+			sort.SliceStable(p, func(i, j int) bool {
+				return less(p[i], p[j])
+			})
+		}
+		return
+	}
+
 	var idx []partidx
 	for _, k := range t.partitionKeys {
 		p := t.partitions[string(k)]
@@ -1725,14 +2371,18 @@ func copyschema(sch sql.Schema) sql.Schema {
 			PrimaryKey:    c.PrimaryKey,
 			Comment:       c.Comment,
 			Extra:         c.Extra,
+			Generated:     c.Generated,
+			Virtual:       c.Virtual,
 		}
 	}
 
 	return potentialSchema
 }
 
+// newTable returns a copy of the table given with the schema given. Must be called with the data lock of the table held.
 func newTable(t *Table, newSch sql.PrimaryKeySchema) (*Table, error) {
 	newTable := NewPartitionedTableWithCollation(t.name, newSch, t.fkColl, len(t.partitions), t.collation)
+	newTable.partitionStrategy = t.partitionStrategy
 	for _, partition := range t.partitions {
 		for _, partitionRow := range partition {
 			err := newTable.Insert(sql.NewEmptyContext(), partitionRow)
@@ -1747,6 +2397,7 @@ func newTable(t *Table, newSch sql.PrimaryKeySchema) (*Table, error) {
 
 // DropPrimaryKey implements the PrimaryKeyAlterableTable
 func (t *Table) DropPrimaryKey(ctx *sql.Context) error {
+	defer t.bumpDataVersion()
 	// Must drop auto increment property before dropping primary key
 	if t.schema.HasAutoIncrement() {
 		return sql.ErrWrongAutoKey.New()
@@ -1775,7 +2426,7 @@ func (t *Table) DropPrimaryKey(ctx *sql.Context) error {
 	}
 
 	delete(t.indexes, "PRIMARY")
-	t.ed = nil
+	t.editAccumulators.reset()
 
 	t.schema.PkOrdinals = []int{}
 
@@ -1858,7 +2509,7 @@ func (t *Table) PartitionRows2(ctx *sql.Context, partition sql.Partition) (sql.R
 		return nil, err
 	}
 
-	return iter.(*tableIter), nil
+	return sql.RowIterToRowIter2(iter), nil
 }
 
 func (t *Table) verifyRowTypes(row sql.Row) {
@@ -17,6 +17,7 @@ package memory
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -25,7 +26,6 @@ import (
 // tableEditor manages the edits that a table receives.
 type tableEditor struct {
 	table             *Table
-	initialAutoIncVal uint64
 	initialPartitions map[string][]sql.Row
 	ea                tableEditAccumulator
 	initialInsert     int
@@ -33,12 +33,18 @@ type tableEditor struct {
 	uniqueIdxCols [][]int
 	prefixLengths [][]uint16
 	fkTable       *Table
+	// bulk holds the rows inserted in batches by the current statement
+	bulk *bulkInsert
+	// changes are the changes to the rows of the table made by the current statement, reported to the table's change
+	// listeners once it's complete
+	changes []sql.RowChange
 }
 
 var _ sql.Table = (*tableEditor)(nil)
 var _ sql.RowReplacer = (*tableEditor)(nil)
 var _ sql.RowUpdater = (*tableEditor)(nil)
 var _ sql.RowInserter = (*tableEditor)(nil)
+var _ sql.BulkRowInserter = (*tableEditor)(nil)
 var _ sql.RowDeleter = (*tableEditor)(nil)
 var _ sql.ForeignKeyEditor = (*tableEditor)(nil)
 
@@ -79,47 +85,72 @@ func (t *tableEditor) Close(ctx *sql.Context) error {
 }
 
 func (t *tableEditor) StatementBegin(ctx *sql.Context) {
+	t.table.dataLock.RLock()
+	defer t.table.dataLock.RUnlock()
 	t.initialInsert = t.table.insertPartIdx
-	t.initialAutoIncVal = t.table.autoIncVal
-	t.initialPartitions = make(map[string][]sql.Row)
-	for partStr, rowSlice := range t.table.partitions {
-		newRowSlice := make([]sql.Row, len(rowSlice))
-		for i, row := range rowSlice {
-			newRowSlice[i] = row.Copy()
-		}
-		t.initialPartitions[partStr] = newRowSlice
-	}
+	t.initialPartitions = nil
 }
 
+// DiscardChanges implements the sql.EditOpenerCloser interface. The pending edits of the statement are dropped. The
+// rows of the table only need to be restored if the statement applied edits before completing, which leaves the
+// changes made by other sessions in the meantime alone otherwise. Like MySQL, the AUTO_INCREMENT values that the
+// statement allocated aren't given back, since other sessions may have allocated the following ones.
 func (t *tableEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
-	t.table.insertPartIdx = t.initialInsert
-	t.table.autoIncVal = t.initialAutoIncVal
-	t.table.partitions = t.initialPartitions
+	defer t.table.bumpDataVersion()
+	t.table.dataLock.Lock()
+	if t.initialPartitions != nil {
+		t.table.insertPartIdx = t.initialInsert
+		t.table.partitions = t.initialPartitions
+		t.table.orderedIndexes.reset()
+		t.initialPartitions = nil
+	}
+	t.table.dataLock.Unlock()
 	t.ea.Clear()
+	t.table.editAccumulators.release(ctx, t.ea)
+	t.bulk = nil
+	t.changes = nil
 	return nil
 }
 
+// StatementComplete implements the sql.EditOpenerCloser interface. The pending edits of the statement are applied to
+// the rows of the table, holding its data lock for writing only while they're applied.
 func (t *tableEditor) StatementComplete(ctx *sql.Context) error {
+	defer t.table.bumpDataVersion()
+	t.table.dataLock.Lock()
 	err := t.ea.ApplyEdits(ctx)
 	if err != nil {
+		t.table.dataLock.Unlock()
 		return nil
 	}
 	t.ea.Clear()
+	if t.bulk != nil {
+		if err := t.bulk.apply(ctx, t.table); err != nil {
+			t.table.dataLock.Unlock()
+			return err
+		}
+		t.bulk = nil
+	}
 	t.initialInsert = t.table.insertPartIdx
-	t.initialAutoIncVal = t.table.autoIncVal
-	t.initialPartitions = make(map[string][]sql.Row)
-	for partStr, rowSlice := range t.table.partitions {
-		newRowSlice := make([]sql.Row, len(rowSlice))
-		for i, row := range rowSlice {
-			newRowSlice[i] = row.Copy()
+	t.initialPartitions = nil
+	t.table.dataLock.Unlock()
+	t.table.editAccumulators.release(ctx, t.ea)
+	if len(t.changes) > 0 {
+		changes := t.changes
+		t.changes = nil
+		if err := t.table.addSystemTimeHistory(ctx, changes); err != nil {
+			return err
 		}
-		t.initialPartitions[partStr] = newRowSlice
+		t.table.changeListeners.notify(ctx, t.table, changes)
 	}
 	return nil
 }
 
 // Insert a new row into the table.
 func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
+	row, err := t.table.stampSystemTime(ctx, row)
+	if err != nil {
+		return err
+	}
 	if err := checkRow(t.table.schema.Schema, row); err != nil {
 		return err
 	}
@@ -154,9 +185,63 @@ func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
 	if err != nil {
 		return err
 	}
+	t.changes = append(t.changes, sql.RowChange{New: row})
+
+	return t.updateAutoIncrement(row)
+}
+
+// InsertBatch implements the sql.BulkRowInserter interface. The keys of the rows are checked against hashes of the keys
+// of the rows of the table, which are built on the first batch of a statement, and the rows are only added to the table
+// when the statement is complete.
+func (t *tableEditor) InsertBatch(ctx *sql.Context, rows []sql.Row) error {
+	if t.bulk == nil {
+		// The hashes are built from the rows of the table, so any edits made by the statement so far are applied first,
+		// after saving the rows of the table to restore if the statement's changes are discarded
+		t.table.dataLock.Lock()
+		if t.initialPartitions == nil {
+			t.initialPartitions = make(map[string][]sql.Row, len(t.table.partitions))
+			for partStr, rowSlice := range t.table.partitions {
+				t.initialPartitions[partStr] = append([]sql.Row(nil), rowSlice...)
+			}
+		}
+		err := t.ea.ApplyEdits(ctx)
+		if err == nil {
+			t.bulk = newBulkInsert(t)
+		}
+		t.table.dataLock.Unlock()
+		if err != nil {
+			return err
+		}
+		t.ea.Clear()
+	}
+
+	for _, row := range rows {
+		row, err := t.table.stampSystemTime(ctx, row)
+		if err != nil {
+			return err
+		}
+		if err := checkRow(t.table.schema.Schema, row); err != nil {
+			return err
+		}
+		t.table.verifyRowTypes(row)
+
+		if err := t.bulk.add(row); err != nil {
+			return err
+		}
+		t.changes = append(t.changes, sql.RowChange{New: row})
+		if err := t.updateAutoIncrement(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+// updateAutoIncrement moves the AUTO_INCREMENT value of the table past the value of the row given, if it's larger.
+func (t *tableEditor) updateAutoIncrement(row sql.Row) error {
 	idx := t.table.autoColIdx
 	if idx >= 0 {
+		t.table.dataLock.Lock()
+		defer t.table.dataLock.Unlock()
 		autoCol := t.table.schema.Schema[idx]
 		cmp, err := autoCol.Type.Compare(row[idx], t.table.autoIncVal)
 		if err != nil {
@@ -190,6 +275,7 @@ func (t *tableEditor) Delete(ctx *sql.Context, row sql.Row) error {
 	if err != nil {
 		return err
 	}
+	t.changes = append(t.changes, sql.RowChange{Old: row})
 
 	return nil
 }
@@ -204,8 +290,12 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 	}
 	t.table.verifyRowTypes(oldRow)
 	t.table.verifyRowTypes(newRow)
+	newRow, err := t.table.stampSystemTime(ctx, newRow)
+	if err != nil {
+		return err
+	}
 
-	err := t.ea.Delete(oldRow)
+	err = t.ea.Delete(oldRow)
 	if err != nil {
 		return err
 	}
@@ -246,12 +336,15 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 	if err != nil {
 		return err
 	}
+	t.changes = append(t.changes, sql.RowChange{Old: oldRow, New: newRow})
 
 	return nil
 }
 
 // SetAutoIncrementValue sets a new AUTO_INCREMENT value
 func (t *tableEditor) SetAutoIncrementValue(ctx *sql.Context, val uint64) error {
+	t.table.dataLock.Lock()
+	defer t.table.dataLock.Unlock()
 	t.table.autoIncVal = val
 	return nil
 }
@@ -259,7 +352,9 @@ func (t *tableEditor) SetAutoIncrementValue(ctx *sql.Context, val uint64) error
 func (t *tableEditor) IndexedAccess(i sql.IndexLookup) sql.IndexedTable {
 	//TODO: optimize this, should create some a struct that encloses the tableEditor and filters based on the lookup
 	if pkTea, ok := t.ea.(*pkTableEditAccumulator); ok {
+		pkTea.table.dataLock.RLock()
 		newTable, err := newTable(pkTea.table, pkTea.table.schema)
+		pkTea.table.dataLock.RUnlock()
 		if err != nil {
 			panic(err)
 		}
@@ -282,7 +377,9 @@ func (t *tableEditor) IndexedAccess(i sql.IndexLookup) sql.IndexedTable {
 		return &IndexedTable{Table: newTable, Idx: i.Index.(*Index)}
 	} else {
 		nonPkTea := t.ea.(*keylessTableEditAccumulator)
+		nonPkTea.table.dataLock.RLock()
 		newTable, err := newTable(nonPkTea.table, nonPkTea.table.schema)
+		nonPkTea.table.dataLock.RUnlock()
 		if err != nil {
 			panic(err)
 		}
@@ -378,7 +475,7 @@ type tableEditAccumulator interface {
 	// is true if a row was deleted.
 	Get(value sql.Row) (sql.Row, bool, error)
 	// ApplyEdits takes a initialTable and runs through a sequence of inserts and deletes that have been stored in the
-	// accumulator.
+	// accumulator. Must be called with the data lock of the table held for writing.
 	ApplyEdits(ctx *sql.Context) error
 	GetByCols(value sql.Row, cols []int, prefixLengths []uint16) (sql.Row, bool, error)
 	// Clear wipes all of the stored inserts and deletes that may or may not have been applied.
@@ -402,6 +499,56 @@ func NewTableEditAccumulator(t *Table) tableEditAccumulator {
 	}
 }
 
+// editAccumulators are the edit accumulators of the sessions writing a table, by session id. The editors a session
+// gets for a table share an accumulator, so that they see each other's pending edits, while each session's pending
+// edits stay out of sight of the other sessions until its statement completes. The zero value has no accumulators.
+type editAccumulators struct {
+	mu           sync.Mutex
+	accumulators map[uint32]tableEditAccumulator
+}
+
+// get returns the edit accumulator of the session of the context given for the table given, creating it if needed.
+func (e *editAccumulators) get(ctx *sql.Context, t *Table) tableEditAccumulator {
+	id := editSessionID(ctx)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if ea, ok := e.accumulators[id]; ok {
+		return ea
+	}
+	if e.accumulators == nil {
+		e.accumulators = make(map[uint32]tableEditAccumulator)
+	}
+	ea := NewTableEditAccumulator(t)
+	e.accumulators[id] = ea
+	return ea
+}
+
+// release forgets the edit accumulator given of the session of the context given, once its edits were applied or
+// discarded. Editors still holding it keep using it.
+func (e *editAccumulators) release(ctx *sql.Context, ea tableEditAccumulator) {
+	id := editSessionID(ctx)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.accumulators[id] == ea {
+		delete(e.accumulators, id)
+	}
+}
+
+// reset forgets the edit accumulators of all sessions, after the schema of the table changed.
+func (e *editAccumulators) reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.accumulators = nil
+}
+
+// editSessionID returns the id of the session of the context given, which is 0 without one.
+func editSessionID(ctx *sql.Context) uint32 {
+	if ctx == nil || ctx.Session == nil {
+		return 0
+	}
+	return ctx.Session.ID()
+}
+
 // pkTableEditAccumulator manages the updates of keyed tables. It uses a map to efficiently toggle edits.
 type pkTableEditAccumulator struct {
 	table   *Table
@@ -444,6 +591,8 @@ func (pke *pkTableEditAccumulator) Get(value sql.Row) (sql.Row, bool, error) {
 	}
 
 	pkColIdxes := pke.pkColumnIndexes()
+	pke.table.dataLock.RLock()
+	defer pke.table.dataLock.RUnlock()
 	for _, partition := range pke.table.partitions {
 		for _, partitionRow := range partition {
 			if columnsMatch(pkColIdxes, nil, partitionRow, value) {
@@ -470,6 +619,8 @@ func (pke *pkTableEditAccumulator) GetByCols(value sql.Row, cols []int, prefixLe
 		}
 	}
 
+	pke.table.dataLock.RLock()
+	defer pke.table.dataLock.RUnlock()
 	for _, partition := range pke.table.partitions {
 		for _, partitionRow := range partition {
 			if columnsMatch(cols, prefixLengths, partitionRow, value) {
@@ -539,6 +690,9 @@ func (pke *pkTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Table,
 			if len(pkColIdxes) > 0 {
 				if columnsMatch(pkColIdxes, nil, partitionRow, row) {
 					table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
+					if err := table.orderedIndexes.rowRemoved(ctx, partitionRow); err != nil {
+						return err
+					}
 					break
 				}
 			}
@@ -551,6 +705,9 @@ func (pke *pkTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Table,
 
 			if matches {
 				table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
+				if err := table.orderedIndexes.rowRemoved(ctx, partitionRow); err != nil {
+					return err
+				}
 				break
 			}
 		}
@@ -564,10 +721,9 @@ func (pke *pkTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Table,
 
 // insertHelper inserts the given row into the given table.
 func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table, row sql.Row) error {
-	key := string(table.partitionKeys[table.insertPartIdx])
-	table.insertPartIdx++
-	if table.insertPartIdx == len(table.partitionKeys) {
-		table.insertPartIdx = 0
+	key, err := table.partitionFor(row)
+	if err != nil {
+		return err
 	}
 
 	pkColIdxes := pke.pkColumnIndexes()
@@ -588,12 +744,16 @@ func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table,
 	}
 
 	if savedPartitionRowIndex > -1 {
+		replaced := table.partitions[savedPartitionIndex][savedPartitionRowIndex]
 		table.partitions[savedPartitionIndex][savedPartitionRowIndex] = row
+		if err := table.orderedIndexes.rowRemoved(ctx, replaced); err != nil {
+			return err
+		}
 	} else {
 		table.partitions[key] = append(table.partitions[key], row)
 	}
 
-	return nil
+	return table.orderedIndexes.rowAdded(ctx, row)
 }
 
 // keylessTableEditAccumulator manages updates for a keyless table.
@@ -657,6 +817,8 @@ func (k *keylessTableEditAccumulator) GetByCols(value sql.Row, cols []int, prefi
 		}
 	}
 
+	k.table.dataLock.RLock()
+	defer k.table.dataLock.RUnlock()
 	for _, partition := range k.table.partitions {
 		for _, partitionRow := range partition {
 			if columnsMatch(cols, prefixLengths, partitionRow, value) {
@@ -723,6 +885,9 @@ func (k *keylessTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Tabl
 
 			if matches {
 				table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
+				if err := table.orderedIndexes.rowRemoved(ctx, partitionRow); err != nil {
+					return err
+				}
 				break
 			}
 		}
@@ -736,17 +901,123 @@ func (k *keylessTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Tabl
 
 // insertHelper inserts into a keyless table.
 func (k *keylessTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table, row sql.Row) error {
-	key := string(table.partitionKeys[table.insertPartIdx])
-	table.insertPartIdx++
-	if table.insertPartIdx == len(table.partitionKeys) {
-		table.insertPartIdx = 0
+	key, err := table.partitionFor(row)
+	if err != nil {
+		return err
 	}
 
 	table.partitions[key] = append(table.partitions[key], row)
 
+	return table.orderedIndexes.rowAdded(ctx, row)
+}
+
+// bulkInsert holds the rows inserted in batches into a table by a statement, along with hashes of the primary and
+// unique keys of the rows of the table and of the rows inserted, to check the keys of each row in constant time.
+type bulkInsert struct {
+	rows          []sql.Row
+	pkColIdxs     []int
+	pkKeys        map[string]sql.Row
+	uniqueIdxCols [][]int
+	prefixLengths [][]uint16
+	uniqueKeys    []map[string]sql.Row
+}
+
+// newBulkInsert returns a bulkInsert for the table of the editor given, with the keys of the rows of the table.
+func newBulkInsert(t *tableEditor) *bulkInsert {
+	b := &bulkInsert{
+		uniqueIdxCols: t.uniqueIdxCols,
+		prefixLengths: t.prefixLengths,
+		uniqueKeys:    make([]map[string]sql.Row, len(t.uniqueIdxCols)),
+	}
+	if !sql.IsKeyless(t.table.schema.Schema) {
+		b.pkColIdxs = t.pkColumnIndexes()
+		b.pkKeys = make(map[string]sql.Row)
+	}
+	for i := range b.uniqueKeys {
+		b.uniqueKeys[i] = make(map[string]sql.Row)
+	}
+	for _, partition := range t.table.partitions {
+		for _, row := range partition {
+			b.addKeys(row)
+		}
+	}
+	return b
+}
+
+// add adds the row given to the rows to insert, returning a unique key error if a row of the table or a row inserted
+// before it has the same primary key or unique key.
+func (b *bulkInsert) add(row sql.Row) error {
+	if b.pkKeys != nil {
+		if existing, ok := b.pkKeys[keyString(row, b.pkColIdxs, nil)]; ok {
+			return sql.NewUniqueKeyErr(formatRow(row, b.pkColIdxs), true, existing)
+		}
+	}
+	for i, cols := range b.uniqueIdxCols {
+		if hasNullForAnyCols(row, cols) {
+			continue
+		}
+		if existing, ok := b.uniqueKeys[i][keyString(row, cols, b.prefixLengths[i])]; ok {
+			return sql.NewUniqueKeyErr(formatRow(row, cols), false, existing)
+		}
+	}
+	b.addKeys(row)
+	b.rows = append(b.rows, row)
 	return nil
 }
 
+// addKeys adds the keys of the row given to the hashes of keys.
+func (b *bulkInsert) addKeys(row sql.Row) {
+	if b.pkKeys != nil {
+		b.pkKeys[keyString(row, b.pkColIdxs, nil)] = row
+	}
+	for i, cols := range b.uniqueIdxCols {
+		if !hasNullForAnyCols(row, cols) {
+			b.uniqueKeys[i][keyString(row, cols, b.prefixLengths[i])] = row
+		}
+	}
+}
+
+// apply adds the rows to insert to the partitions of the table given, keeping the rows of a table with a primary key
+// sorted on it.
+func (b *bulkInsert) apply(ctx *sql.Context, table *Table) error {
+	if len(b.rows) == 0 {
+		return nil
+	}
+	for _, row := range b.rows {
+		key, err := table.partitionFor(row)
+		if err != nil {
+			return err
+		}
+		table.partitions[key] = append(table.partitions[key], row)
+		if err := table.orderedIndexes.rowAdded(ctx, row); err != nil {
+			return err
+		}
+	}
+	if b.pkKeys != nil {
+		table.sortRows()
+	}
+	return nil
+}
+
+// keyString returns a string of the values of the row given for the columns given, which is the same for two rows iff
+// columnsMatch returns true for them.
+func keyString(row sql.Row, cols []int, prefixLengths []uint16) string {
+	var b strings.Builder
+	for i, col := range cols {
+		v := row[col]
+		if bs, ok := v.([]byte); ok {
+			v = string(bs)
+		}
+		if len(prefixLengths) > i && prefixLengths[i] > 0 {
+			if str, ok := v.(string); ok && int(prefixLengths[i]) < len(str) {
+				v = str[:prefixLengths[i]]
+			}
+		}
+		_, _ = fmt.Fprintf(&b, "%T:%v\x00", v, v)
+	}
+	return b.String()
+}
+
 func formatRow(r sql.Row, idxs []int) string {
 	b := &strings.Builder{}
 	b.WriteString("[")
@@ -756,6 +1027,7 @@ func formatRow(r sql.Row, idxs []int) string {
 			_, _ = fmt.Fprintf(b, ",")
 		}
 		_, _ = fmt.Fprintf(b, "%v", r[idx])
+		seenOne = true
 	}
 	b.WriteString("]")
 	return b.String()
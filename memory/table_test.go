@@ -15,11 +15,13 @@
 package memory_test
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
@@ -307,6 +309,38 @@ func TestFilterAndProject(t *testing.T) {
 	}
 }
 
+func TestSortedAndLimited(t *testing.T) {
+	require := require.New(t)
+	test := tests[0]
+
+	table := memory.NewSortedLimitedTable(test.name, test.schema, nil)
+	for _, row := range test.rows {
+		require.NoError(table.Insert(sql.NewEmptyContext(), row))
+	}
+
+	projected := table.WithProjections(test.columns).(*memory.SortedLimitedTable)
+	sorted := projected.WithSortFields(sql.SortFields{
+		{Column: expression.NewGetFieldWithTable(1, types.Int64, "test", "col3", false), Order: sql.Descending},
+		{Column: expression.NewGetFieldWithTable(0, types.Text, "test", "col1", false), Order: sql.Ascending},
+	}).(*memory.SortedLimitedTable)
+	require.Equal([]sql.Row{
+		sql.NewRow("d", int64(200)),
+		sql.NewRow("e", int64(200)),
+		sql.NewRow("f", int64(200)),
+		sql.NewRow("a", int64(100)),
+		sql.NewRow("b", int64(100)),
+		sql.NewRow("c", int64(100)),
+	}, getAllRows(t, sorted))
+
+	limited := sorted.WithLimit(2, 2)
+	require.Equal([]sql.Row{
+		sql.NewRow("f", int64(200)),
+		sql.NewRow("a", int64(100)),
+	}, getAllRows(t, limited))
+
+	require.Empty(getAllRows(t, sorted.WithLimit(2, 10)))
+}
+
 func TestIndexed(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -417,3 +451,313 @@ func TestTableIndexKeyValueIter(t *testing.T) {
 		})
 	}
 }
+
+func newBulkInsertTable(t testing.TB) *memory.Table {
+	ctx := sql.NewEmptyContext()
+	table := memory.NewPartitionedTable("test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: types.Int64, Source: "test", PrimaryKey: true},
+		{Name: "u", Type: types.Int64, Source: "test", Nullable: true},
+	}), nil, 2)
+	require.NoError(t, table.CreateIndex(ctx, sql.IndexDef{
+		Name:       "u",
+		Columns:    []sql.IndexColumn{{Name: "u"}},
+		Constraint: sql.IndexConstraint_Unique,
+	}))
+	return table
+}
+
+func TestBulkInsert(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table := newBulkInsertTable(t)
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(1), int64(1))))
+
+	inserter := table.BulkInserter(ctx)
+	inserter.StatementBegin(ctx)
+	require.NoError(inserter.InsertBatch(ctx, []sql.Row{{int64(3), int64(3)}, {int64(2), nil}}))
+	require.NoError(inserter.InsertBatch(ctx, []sql.Row{{int64(4), nil}}))
+	// the rows of a batch aren't in the table until the statement is complete
+	require.Len(getAllRows(t, table), 1)
+	require.NoError(inserter.StatementComplete(ctx))
+	require.NoError(inserter.Close(ctx))
+	require.Equal([]sql.Row{{int64(1), int64(1)}, {int64(2), nil}, {int64(3), int64(3)}, {int64(4), nil}}, getAllRows(t, table))
+
+	inserter = table.BulkInserter(ctx)
+	inserter.StatementBegin(ctx)
+	require.NoError(inserter.InsertBatch(ctx, []sql.Row{{int64(5), int64(5)}}))
+	err := inserter.InsertBatch(ctx, []sql.Row{{int64(6), int64(5)}})
+	require.True(sql.ErrUniqueKeyViolation.Is(err))
+	err = inserter.InsertBatch(ctx, []sql.Row{{int64(3), int64(6)}})
+	require.True(sql.ErrPrimaryKeyViolation.Is(err))
+	require.NoError(inserter.DiscardChanges(ctx, err))
+	require.NoError(inserter.Close(ctx))
+	require.Len(getAllRows(t, table), 4)
+}
+
+func TestIndexLookups(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	for _, keyless := range []bool{false, true} {
+		// Rows with the same value are sorted on the primary key, or in the order they're stored in if there's none
+		numPartitions := 2
+		if keyless {
+			numPartitions = 1
+		}
+		table := memory.NewPartitionedTable("test", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "pk", Type: types.Int64, Source: "test", PrimaryKey: !keyless},
+			{Name: "v", Type: types.Int64, Source: "test", Nullable: true},
+		}), nil, numPartitions)
+		require.NoError(table.CreateIndex(ctx, sql.IndexDef{
+			Name:    "v",
+			Columns: []sql.IndexColumn{{Name: "v"}},
+		}))
+		for i := int64(1); i <= 6; i++ {
+			var v interface{} = 10 - i%3
+			if i == 6 {
+				v = nil
+			}
+			require.NoError(table.Insert(ctx, sql.NewRow(i, v)))
+		}
+
+		indexes, err := table.GetIndexes(ctx)
+		require.NoError(err)
+		idx := indexes[0]
+		lookup := func(reverse bool, ranges ...sql.Range) []sql.Row {
+			l := sql.IndexLookup{Index: idx, Ranges: ranges, IsReverse: reverse}
+			indexed := table.IndexedAccess(l)
+			pIter, err := indexed.LookupPartitions(ctx, l)
+			require.NoError(err)
+			var rows []sql.Row
+			for {
+				p, err := pIter.Next(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(err)
+				iter, err := indexed.PartitionRows(ctx, p)
+				require.NoError(err)
+				partitionRows, err := sql.RowIterToRows(ctx, nil, iter)
+				require.NoError(err)
+				rows = append(rows, partitionRows...)
+			}
+			return rows
+		}
+		all := sql.Range{sql.AllRangeColumnExpr(types.Int64)}
+		atLeast9 := sql.Range{sql.GreaterOrEqualRangeColumnExpr(int64(9), types.Int64)}
+		equals8 := sql.Range{sql.ClosedRangeColumnExpr(int64(8), int64(8), types.Int64)}
+
+		require.Equal([]sql.Row{{int64(6), nil}, {int64(2), int64(8)}, {int64(5), int64(8)}, {int64(1), int64(9)}, {int64(4), int64(9)}, {int64(3), int64(10)}}, lookup(false, all))
+		require.Equal([]sql.Row{{int64(3), int64(10)}, {int64(4), int64(9)}, {int64(1), int64(9)}, {int64(5), int64(8)}, {int64(2), int64(8)}, {int64(6), nil}}, lookup(true, all))
+		require.Equal([]sql.Row{{int64(1), int64(9)}, {int64(4), int64(9)}, {int64(3), int64(10)}}, lookup(false, atLeast9))
+		require.Equal([]sql.Row{{int64(3), int64(10)}, {int64(4), int64(9)}, {int64(1), int64(9)}}, lookup(true, atLeast9))
+		require.Equal([]sql.Row{{int64(2), int64(8)}, {int64(5), int64(8)}}, lookup(false, equals8))
+		require.Equal([]sql.Row{{int64(2), int64(8)}, {int64(5), int64(8)}, {int64(1), int64(9)}, {int64(4), int64(9)}, {int64(3), int64(10)}}, lookup(false, equals8, atLeast9))
+
+		// The ordered index is kept up to date with the rows written after it was built
+		deleter := table.Deleter(ctx)
+		deleter.StatementBegin(ctx)
+		require.NoError(deleter.Delete(ctx, sql.NewRow(int64(2), int64(8))))
+		require.NoError(deleter.StatementComplete(ctx))
+		require.NoError(deleter.Close(ctx))
+		updater := table.Updater(ctx)
+		updater.StatementBegin(ctx)
+		require.NoError(updater.Update(ctx, sql.NewRow(int64(1), int64(9)), sql.NewRow(int64(1), int64(7))))
+		require.NoError(updater.StatementComplete(ctx))
+		require.NoError(updater.Close(ctx))
+		require.NoError(table.Insert(ctx, sql.NewRow(int64(7), int64(9))))
+
+		require.Equal([]sql.Row{{int64(6), nil}, {int64(1), int64(7)}, {int64(5), int64(8)}, {int64(4), int64(9)}, {int64(7), int64(9)}, {int64(3), int64(10)}}, lookup(false, all))
+		require.Equal([]sql.Row{{int64(4), int64(9)}, {int64(7), int64(9)}}, lookup(false, sql.Range{sql.ClosedRangeColumnExpr(int64(9), int64(9), types.Int64)}))
+	}
+}
+
+func TestConcurrentSessions(t *testing.T) {
+	require := require.New(t)
+	newSessionContext := func() *sql.Context {
+		return sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+	}
+	table := newBulkInsertTable(t)
+	indexes, err := table.GetIndexes(sql.NewEmptyContext())
+	require.NoError(err)
+	lookup := sql.IndexLookup{Index: indexes[0], Ranges: []sql.Range{{sql.AllRangeColumnExpr(types.Int64)}}}
+
+	// The pending edits of a session are neither applied nor rolled back by the statements of other sessions
+	ctx1, ctx2 := newSessionContext(), newSessionContext()
+	inserter1 := table.Inserter(ctx1)
+	inserter1.StatementBegin(ctx1)
+	require.NoError(inserter1.Insert(ctx1, sql.NewRow(int64(1), int64(1))))
+	inserter2 := table.Inserter(ctx2)
+	inserter2.StatementBegin(ctx2)
+	require.NoError(inserter2.Insert(ctx2, sql.NewRow(int64(2), int64(2))))
+	require.NoError(inserter2.StatementComplete(ctx2))
+	require.NoError(inserter2.Close(ctx2))
+	require.Equal([]sql.Row{{int64(2), int64(2)}}, getAllRows(t, table))
+	require.NoError(inserter1.DiscardChanges(ctx1, nil))
+	require.NoError(inserter1.Close(ctx1))
+	require.Equal([]sql.Row{{int64(2), int64(2)}}, getAllRows(t, table))
+
+	// Sessions read the table while others write it
+	const writers, rowsPerWriter = 4, 50
+	var eg errgroup.Group
+	for w := 0; w < writers; w++ {
+		w := w
+		eg.Go(func() error {
+			ctx := newSessionContext()
+			for i := 0; i < rowsPerWriter; i++ {
+				key := int64(100 + w*rowsPerWriter + i)
+				inserter := table.Inserter(ctx)
+				inserter.StatementBegin(ctx)
+				if err := inserter.Insert(ctx, sql.NewRow(key, key)); err != nil {
+					return err
+				}
+				if err := inserter.StatementComplete(ctx); err != nil {
+					return err
+				}
+				if err := inserter.Close(ctx); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		eg.Go(func() error {
+			ctx := newSessionContext()
+			for i := 0; i < rowsPerWriter; i++ {
+				for _, tbl := range []sql.Table{table, table.IndexedAccess(lookup)} {
+					var partitions sql.PartitionIter
+					var err error
+					if indexed, ok := tbl.(sql.IndexedTable); ok {
+						partitions, err = indexed.LookupPartitions(ctx, lookup)
+					} else {
+						partitions, err = tbl.Partitions(ctx)
+					}
+					if err != nil {
+						return err
+					}
+					if _, err := sql.RowIterToRows(ctx, nil, sql.NewTableRowIter(ctx, tbl, partitions)); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+	}
+	require.NoError(eg.Wait())
+
+	rows := getAllRows(t, table)
+	require.Len(rows, 1+writers*rowsPerWriter)
+	count, err := table.ExactRowCount(sql.NewEmptyContext())
+	require.NoError(err)
+	require.Equal(uint64(len(rows)), count)
+}
+
+func TestConcurrentAutoIncrement(t *testing.T) {
+	require := require.New(t)
+	newSessionContext := func() *sql.Context {
+		return sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+	}
+	table := memory.NewTable("test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: types.Int64, Source: "test", PrimaryKey: true, AutoIncrement: true},
+	}), nil)
+
+	// Discarding a statement doesn't give back the AUTO_INCREMENT values that other sessions allocated in the meantime
+	ctx1, ctx2 := newSessionContext(), newSessionContext()
+	inserter1 := table.Inserter(ctx1)
+	inserter1.StatementBegin(ctx1)
+	inserter2 := table.Inserter(ctx2)
+	inserter2.StatementBegin(ctx2)
+	id, err := table.GetNextAutoIncrementValue(ctx2, nil)
+	require.NoError(err)
+	require.Equal(uint64(1), id)
+	require.NoError(inserter2.Insert(ctx2, sql.NewRow(int64(id))))
+	require.NoError(inserter2.StatementComplete(ctx2))
+	require.NoError(inserter2.Close(ctx2))
+	require.NoError(inserter1.DiscardChanges(ctx1, nil))
+	require.NoError(inserter1.Close(ctx1))
+
+	next, err := table.PeekNextAutoIncrementValue(ctx1)
+	require.NoError(err)
+	require.Equal(uint64(2), next)
+	require.Equal([]sql.Row{{int64(1)}}, getAllRows(t, table))
+}
+
+func TestPartitionStrategy(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: types.Int64, Source: "test", PrimaryKey: true},
+		{Name: "v", Type: types.Int64, Source: "test", Nullable: true},
+	})
+	newTable := func(numPartitions int, strategy memory.PartitionStrategy, keys ...int64) *memory.Table {
+		table := memory.NewPartitionedTableWithStrategy("test", schema, nil, numPartitions, strategy)
+		for _, k := range keys {
+			require.NoError(table.Insert(ctx, sql.NewRow(k, k*10)))
+		}
+		return table
+	}
+	partitions := func(table *memory.Table) [][]sql.Row {
+		count, err := table.PartitionCount(ctx)
+		require.NoError(err)
+		partitions := make([][]sql.Row, count)
+		for i := range partitions {
+			partitions[i] = table.GetPartition(fmt.Sprint(i))
+		}
+		return partitions
+	}
+
+	// Rows with the same key go to the same partition whatever the order they're inserted in, sorted within it
+	table := newTable(3, memory.HashPartitioning, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+	hashed := partitions(table)
+	require.Equal(hashed, partitions(newTable(3, memory.HashPartitioning, 9, 8, 7, 6, 5, 4, 3, 2, 1)))
+	var total int
+	for _, p := range hashed {
+		total += len(p)
+		for i := 1; i < len(p); i++ {
+			require.Less(p[i-1][0].(int64), p[i][0].(int64))
+		}
+	}
+	require.Equal(9, total)
+
+	require.NoError(table.Repartition(ctx, 5, memory.HashPartitioning))
+	require.Equal(partitions(newTable(5, memory.HashPartitioning, 1, 2, 3, 4, 5, 6, 7, 8, 9)), partitions(table))
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(10), int64(100))))
+	require.Equal(partitions(newTable(5, memory.HashPartitioning, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)), partitions(table))
+
+	// With round robin partitioning, rows are sorted on their key across partitions
+	require.NoError(table.Repartition(ctx, 2, memory.RoundRobinPartitioning))
+	require.Equal([][]sql.Row{
+		{{int64(1), int64(10)}, {int64(2), int64(20)}, {int64(3), int64(30)}, {int64(4), int64(40)}, {int64(5), int64(50)}},
+		{{int64(6), int64(60)}, {int64(7), int64(70)}, {int64(8), int64(80)}, {int64(9), int64(90)}, {int64(10), int64(100)}},
+	}, partitions(table))
+}
+
+func BenchmarkInsert(b *testing.B) {
+	benchmarkInsert(b, false)
+}
+
+func BenchmarkBulkInsert(b *testing.B) {
+	benchmarkInsert(b, true)
+}
+
+func benchmarkInsert(b *testing.B, bulk bool) {
+	ctx := sql.NewEmptyContext()
+	rows := make([]sql.Row, 2000)
+	for i := range rows {
+		rows[i] = sql.NewRow(int64(i), int64(i))
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		table := newBulkInsertTable(b)
+		inserter := table.BulkInserter(ctx)
+		inserter.StatementBegin(ctx)
+		if bulk {
+			require.NoError(b, inserter.InsertBatch(ctx, rows))
+		} else {
+			for _, row := range rows {
+				require.NoError(b, inserter.Insert(ctx, row))
+			}
+		}
+		require.NoError(b, inserter.StatementComplete(ctx))
+		require.NoError(b, inserter.Close(ctx))
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+)
+
+// preparedStatements is the sql.PreparedStatements of an engine, registered in its services, which keeps the named
+// prepared statements in the engine's PreparedDataCache.
+type preparedStatements struct {
+	e *Engine
+}
+
+var _ sql.PreparedStatements = preparedStatements{}
+
+// Prepare implements the sql.PreparedStatements interface.
+func (p preparedStatements) Prepare(ctx *sql.Context, name string, query string) error {
+	parsed, err := p.e.parseQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+	return p.e.prepareStatement(ctx, name, parsed)
+}
+
+// Execute implements the sql.PreparedStatements interface.
+func (p preparedStatements) Execute(ctx *sql.Context, name string, bindVars []sql.Expression) (sql.Node, sql.RowIter, error) {
+	analyzed, err := p.e.analyzeExecute(ctx, name, bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The statement is run as part of the statement executing it, which tracks its progress
+	analyzed = analyzer.StripPassthroughNodes(analyzed)
+	iter, err := analyzed.RowIter(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return analyzed, iter, nil
+}
+
+// Deallocate implements the sql.PreparedStatements interface.
+func (p preparedStatements) Deallocate(ctx *sql.Context, name string) error {
+	return p.e.deallocateStatement(ctx, name)
+}
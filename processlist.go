@@ -31,6 +31,8 @@ type ProcessList struct {
 	mu         sync.RWMutex
 	procs      map[uint32]*sql.Process
 	byQueryPid map[uint64]uint32
+	// sessions are the sessions of the connections that are ready
+	sessions map[uint32]sql.Session
 }
 
 // NewProcessList creates a new process list.
@@ -38,6 +40,7 @@ func NewProcessList() *ProcessList {
 	return &ProcessList{
 		procs:      make(map[uint32]*sql.Process),
 		byQueryPid: make(map[uint64]uint32),
+		sessions:   make(map[uint32]sql.Session),
 	}
 }
 
@@ -81,6 +84,18 @@ func (pl *ProcessList) ConnectionReady(sess sql.Session) {
 		User:       sess.Client().User,
 		StartedAt:  time.Now(),
 	}
+	pl.sessions[sess.ID()] = sess
+}
+
+// Sessions returns the sessions of the connections that are ready.
+func (pl *ProcessList) Sessions() []sql.Session {
+	pl.mu.RLock()
+	defer pl.mu.RUnlock()
+	sessions := make([]sql.Session, 0, len(pl.sessions))
+	for _, sess := range pl.sessions {
+		sessions = append(sessions, sess)
+	}
+	return sessions
 }
 
 func (pl *ProcessList) RemoveConnection(connID uint32) {
@@ -94,6 +109,7 @@ func (pl *ProcessList) RemoveConnection(connID uint32) {
 		delete(pl.byQueryPid, p.QueryPid)
 		delete(pl.procs, connID)
 	}
+	delete(pl.sessions, connID)
 }
 
 func (pl *ProcessList) BeginQuery(
@@ -121,6 +137,7 @@ func (pl *ProcessList) BeginQuery(
 	p.StartedAt = time.Now()
 	p.Kill = cancel
 	p.Progress = make(map[string]sql.TableProgress)
+	p.Stage = sql.StageProgress{}
 
 	pl.byQueryPid[ctx.Pid()] = ctx.Session.ID()
 
@@ -142,6 +159,7 @@ func (pl *ProcessList) EndQuery(ctx *sql.Context) {
 		p.Kill = nil
 		p.QueryPid = 0
 		p.Progress = nil
+		p.Stage = sql.StageProgress{}
 	}
 }
 
@@ -291,6 +309,45 @@ func (pl *ProcessList) RemovePartitionProgress(pid uint64, tableName, partitionN
 	delete(tablePg.PartitionsProgress, partitionName)
 }
 
+// SetStage sets the stage of the process with the given pid, which does the given total units of work, or -1 units
+// if its total is unknown. An empty name clears the stage of the process.
+func (pl *ProcessList) SetStage(pid uint64, name, unit string, total int64) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	id, ok := pl.byQueryPid[pid]
+	if !ok {
+		return
+	}
+	p, ok := pl.procs[id]
+	if !ok || p.QueryPid != pid {
+		return
+	}
+
+	if name == "" {
+		p.Stage = sql.StageProgress{}
+		return
+	}
+	p.Stage = sql.StageProgress{Progress: sql.Progress{Name: name, Total: total}, Unit: unit}
+}
+
+// UpdateStageProgress adds delta units to the work done in the stage of the process with the given pid.
+func (pl *ProcessList) UpdateStageProgress(pid uint64, delta int64) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	id, ok := pl.byQueryPid[pid]
+	if !ok {
+		return
+	}
+	p, ok := pl.procs[id]
+	if !ok || p.QueryPid != pid || p.Stage.Name == "" {
+		return
+	}
+
+	p.Stage.Done += delta
+}
+
 // Kill terminates all queries for a given connection id.
 func (pl *ProcessList) Kill(connID uint32) {
 	pl.mu.Lock()
@@ -122,6 +122,44 @@ func TestProcessList(t *testing.T) {
 	require.Equal(sql.ProcessCommandSleep, proc.Command)
 }
 
+func TestProcessListStage(t *testing.T) {
+	require := require.New(t)
+
+	p := NewProcessList()
+	p.AddConnection(1, "127.0.0.1:34567")
+	sess := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{Address: "127.0.0.1:34567", User: "foo"}, 1)
+	p.ConnectionReady(sess)
+	ctx := sql.NewContext(context.Background(), sql.WithPid(1), sql.WithSession(sess))
+
+	// Stages of processes that aren't running a query are ignored
+	p.SetStage(1, sql.StageCopyToTmpTable, sql.StageUnitRows, 10)
+	require.Equal(sql.StageProgress{}, p.procs[1].Stage)
+
+	ctx, err := p.BeginQuery(ctx, "ALTER TABLE foo ADD COLUMN bar INT")
+	require.NoError(err)
+
+	p.UpdateStageProgress(ctx.Pid(), 1)
+	require.Equal(sql.StageProgress{}, p.procs[1].Stage)
+
+	p.SetStage(ctx.Pid(), sql.StageCopyToTmpTable, sql.StageUnitRows, 8)
+	p.UpdateStageProgress(ctx.Pid(), 3)
+	p.UpdateStageProgress(ctx.Pid(), 3)
+	expected := sql.StageProgress{Progress: sql.Progress{Name: sql.StageCopyToTmpTable, Done: 6, Total: 8}, Unit: sql.StageUnitRows}
+	require.Equal(expected, p.procs[1].Stage)
+	require.Equal(expected, p.Processes()[0].Stage)
+	require.Equal("copy to tmp table (6/8 rows, 75.00%)", expected.String())
+
+	p.SetStage(ctx.Pid(), "", "", 0)
+	require.Equal(sql.StageProgress{}, p.procs[1].Stage)
+
+	p.SetStage(ctx.Pid(), sql.StageLoadingData, sql.StageUnitBytes, -1)
+	p.UpdateStageProgress(ctx.Pid(), 4096)
+	require.Equal("loading data (4096/? bytes)", p.procs[1].Stage.String())
+
+	p.EndQuery(ctx)
+	require.Equal(sql.StageProgress{}, p.procs[1].Stage)
+}
+
 func sortById(slice []sql.Process) {
 	sort.Slice(slice, func(i, j int) bool {
 		return slice[i].Connection < slice[j].Connection
@@ -0,0 +1,91 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// startProfiling sets a profiler of the query given in the context given if the profiling system variable of its
+// session is enabled, and unsets the profiler of the previous query of the context otherwise.
+func (e *Engine) startProfiling(ctx *sql.Context, query string) *sql.QueryProfiler {
+	var profiler *sql.QueryProfiler
+	if v, err := ctx.GetSessionVariable(ctx, "profiling"); err == nil {
+		if enabled, err := types.ConvertToBool(v); err == nil && enabled {
+			profiler = sql.NewQueryProfiler(query)
+		}
+	}
+	ctx.ApplyOpts(sql.WithQueryProfiler(profiler))
+	return profiler
+}
+
+// stopProfilingStatement unsets the profiler of the context given if the statement given reports profiles, which
+// aren't profiled themselves, so that they don't push the profiles they report out of the history of the session.
+func stopProfilingStatement(ctx *sql.Context, parsed sql.Node) {
+	switch parsed.(type) {
+	case *plan.ShowProfiles, *plan.ShowProfile:
+		ctx.ApplyOpts(sql.WithQueryProfiler(nil))
+	}
+}
+
+// recordProfile records the profile of the query of the profiler given, if it's not nil, in the profiles of the
+// session of the context given.
+func (e *Engine) recordProfile(ctx *sql.Context, profiler *sql.QueryProfiler) {
+	if profiler == nil || e.QueryProfiles == nil {
+		return
+	}
+	historySize := defaultProfilingHistorySize
+	if v, err := ctx.GetSessionVariable(ctx, "profiling_history_size"); err == nil {
+		if size, ok := v.(int64); ok {
+			historySize = int(size)
+		}
+	}
+	e.QueryProfiles.Record(ctx.ID(), historySize, profiler.Finish())
+}
+
+const defaultProfilingHistorySize = 15
+
+// profiledIter is the row iterator of a profiled query, which starts the sending data stage of the query once its
+// first row is read, and records its profile once it's closed.
+type profiledIter struct {
+	sql.RowIter
+	e        *Engine
+	profiler *sql.QueryProfiler
+}
+
+var _ sql.RowIterTypeSelector = (*profiledIter)(nil)
+var _ sql.RowIter2 = (*profiledIter)(nil)
+
+func (i *profiledIter) Next(ctx *sql.Context) (sql.Row, error) {
+	i.profiler.Stage(sql.ProfileStageSendingData)
+	return i.RowIter.Next(ctx)
+}
+
+func (i *profiledIter) Next2(ctx *sql.Context, frame *sql.RowFrame) error {
+	i.profiler.Stage(sql.ProfileStageSendingData)
+	return i.RowIter.(sql.RowIter2).Next2(ctx, frame)
+}
+
+func (i *profiledIter) Close(ctx *sql.Context) error {
+	defer i.e.recordProfile(ctx, i.profiler)
+	return i.RowIter.Close(ctx)
+}
+
+func (i *profiledIter) IsNode2() bool {
+	selector, ok := i.RowIter.(sql.RowIterTypeSelector)
+	return ok && selector.IsNode2()
+}
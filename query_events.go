@@ -0,0 +1,146 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"io"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// defaultQueryEventRowInterval is the number of rows between QueryRowsRead events, if not configured.
+const defaultQueryEventRowInterval = 1000
+
+// queryEvents emits the lifecycle events of a query to the engine's query listeners. A nil *queryEvents emits
+// nothing, so that queries of engines without listeners don't pay for them.
+type queryEvents struct {
+	listeners   []sql.QueryListener
+	rowInterval int64
+	query       string
+	start       time.Time
+	rows        int64
+	done        bool
+}
+
+// newQueryEvents returns the events of the query given, or nil if the engine has no query listeners.
+func (e *Engine) newQueryEvents(query string) *queryEvents {
+	if len(e.QueryListeners) == 0 {
+		return nil
+	}
+	return &queryEvents{
+		listeners:   e.QueryListeners,
+		rowInterval: e.QueryEventRowInterval,
+		query:       query,
+		start:       time.Now(),
+	}
+}
+
+// emit notifies the listeners of an event of the type given, returning the error of the listener vetoing the query,
+// if any.
+func (q *queryEvents) emit(ctx *sql.Context, typ sql.QueryEventType, node sql.Node) error {
+	if q == nil || q.done {
+		return nil
+	}
+	return q.notify(ctx, sql.QueryEvent{Type: typ, Node: node})
+}
+
+func (q *queryEvents) notify(ctx *sql.Context, event sql.QueryEvent) error {
+	event.Query = q.query
+	event.Rows = q.rows
+	event.Elapsed = time.Since(q.start)
+	for _, listener := range q.listeners {
+		if err := listener.OnQueryEvent(ctx, event); err != nil {
+			if event.Type.CanVeto() {
+				return err
+			}
+			ctx.GetLogger().WithError(err).Warnf("query listener failed on %s event", event.Type)
+		}
+	}
+	return nil
+}
+
+// fail emits the QueryFailed event of the error given, unless the query has ended already, and returns the error.
+func (q *queryEvents) fail(ctx *sql.Context, err error) error {
+	if q == nil || q.done {
+		return err
+	}
+	q.done = true
+	q.notify(ctx, sql.QueryEvent{Type: sql.QueryFailed, Err: err})
+	return err
+}
+
+// complete emits the QueryCompleted event, unless the query has ended already.
+func (q *queryEvents) complete(ctx *sql.Context) {
+	if q == nil || q.done {
+		return
+	}
+	q.done = true
+	q.notify(ctx, sql.QueryEvent{Type: sql.QueryCompleted})
+}
+
+// rowRead counts a row returned by the query, emitting QueryRowsRead on every row interval.
+func (q *queryEvents) rowRead(ctx *sql.Context) error {
+	q.rows++
+	if q.rowInterval > 0 && q.rows%q.rowInterval == 0 {
+		return q.emit(ctx, sql.QueryRowsRead, nil)
+	}
+	return nil
+}
+
+// eventIter is the row iterator of a query with query listeners, which emits the events of its rows and its end.
+type eventIter struct {
+	sql.RowIter
+	events *queryEvents
+}
+
+var _ sql.RowIterTypeSelector = (*eventIter)(nil)
+var _ sql.RowIter2 = (*eventIter)(nil)
+
+func (i *eventIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.RowIter.Next(ctx)
+	return row, i.after(ctx, err)
+}
+
+func (i *eventIter) Next2(ctx *sql.Context, frame *sql.RowFrame) error {
+	return i.after(ctx, i.RowIter.(sql.RowIter2).Next2(ctx, frame))
+}
+
+// after counts the row just read, or emits QueryFailed if reading it failed.
+func (i *eventIter) after(ctx *sql.Context, err error) error {
+	if err == io.EOF {
+		return err
+	}
+	if err == nil {
+		err = i.events.rowRead(ctx)
+	}
+	if err != nil {
+		return i.events.fail(ctx, err)
+	}
+	return nil
+}
+
+func (i *eventIter) Close(ctx *sql.Context) error {
+	if err := i.RowIter.Close(ctx); err != nil {
+		return i.events.fail(ctx, err)
+	}
+	i.events.complete(ctx)
+	return nil
+}
+
+func (i *eventIter) IsNode2() bool {
+	selector, ok := i.RowIter.(sql.RowIterTypeSelector)
+	return ok && selector.IsNode2()
+}
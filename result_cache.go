@@ -0,0 +1,257 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// resultCacheSessionVariables are the session variables changing the results of queries, whose values are part of the
+// keys of results.
+var resultCacheSessionVariables = []string{
+	"collation_connection",
+	"character_set_results",
+	"sql_mode",
+	"time_zone",
+	"div_precision_increment",
+	"default_week_format",
+	"lc_time_names",
+	"sql_select_limit",
+}
+
+// useResultCache returns the analyzed query given with its result replaced by the result cached for it, if the
+// engine's result cache has one. Otherwise, it returns the query unchanged, along with the function wrapping its row
+// iterator to cache its result, or nil if the result of the query can't be cached. Queries are analyzed before their
+// results are looked up, so that the privileges of the user running them are checked.
+func (e *Engine) useResultCache(
+	ctx *sql.Context,
+	query string,
+	analyzed sql.Node,
+	bindings map[string]sql.Expression,
+) (sql.Node, func(sql.RowIter) sql.RowIter, error) {
+	if e.ResultCache == nil {
+		return analyzed, nil, nil
+	}
+	key, versions, ok, err := resultCacheKey(ctx, query, analyzed, bindings)
+	if err != nil || !ok {
+		return analyzed, nil, err
+	}
+
+	if rows, ok := e.ResultCache.Get(key, versions); ok {
+		cached, err := withCachedResult(analyzed, rows)
+		return cached, nil, err
+	}
+	return analyzed, func(iter sql.RowIter) sql.RowIter {
+		return &resultCachingIter{RowIter: iter, cache: e.ResultCache, key: key, versions: versions}
+	}, nil
+}
+
+// resultCacheKey returns the key of the result of the analyzed query given in a result cache, along with the data
+// versions of the tables it reads, or false if its result can't be cached. The key is made of the statement digest
+// and the parameters of the query: its literal values, bindings, current database, user and the session variables
+// changing its result, along with its plan.
+func resultCacheKey(ctx *sql.Context, query string, analyzed sql.Node, bindings map[string]sql.Expression) (string, []string, bool, error) {
+	var tables []sql.DataVersionedTable
+	result := queryResultNode(analyzed)
+	if !cacheableResult(result, &tables) || len(tables) == 0 {
+		return "", nil, false, nil
+	}
+
+	digest, values, err := sql.StatementDigestWithValues(query)
+	if err != nil {
+		return "", nil, false, nil
+	}
+	// the plan of the query is part of the key too, since the same statement has another plan once the definition of
+	// a view it reads, or the schema of a table it reads changes
+	parts := append([]string{digest, ctx.GetCurrentDatabase(), ctx.Client().User, result.String()}, values...)
+	for _, name := range resultCacheSessionVariables {
+		val, err := ctx.GetSessionVariable(ctx, name)
+		if err != nil {
+			// variables that aren't defined don't change results
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("@@%s=%v", name, val))
+	}
+	names := make([]string, 0, len(bindings))
+	for name := range bindings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		binding := bindings[name]
+		if lit, ok := binding.(*expression.Literal); ok {
+			parts = append(parts, fmt.Sprintf("%s=%v:%s", name, lit.Value(), lit.Type()))
+		} else {
+			parts = append(parts, name+"="+binding.String())
+		}
+	}
+
+	versions := make([]string, len(tables))
+	for i, table := range tables {
+		version, err := table.DataVersion(ctx)
+		if err != nil {
+			return "", nil, false, err
+		}
+		versions[i] = table.Name() + "@" + version
+	}
+	return sql.DigestOfText(strings.Join(parts, "\x00")), versions, true, nil
+}
+
+// queryResultNode returns the node of the analyzed query given that returns its result, below the nodes tracking
+// the query's process and committing its transaction.
+func queryResultNode(n sql.Node) sql.Node {
+	switch n := n.(type) {
+	case *plan.QueryProcess:
+		return queryResultNode(n.Child())
+	case *plan.TransactionCommittingNode:
+		return queryResultNode(n.Child())
+	default:
+		return n
+	}
+}
+
+// cacheableResult returns whether the result of the query node given only depends on the data of the tables it reads,
+// which it appends to the tables given. Those are the queries only reading tables with data versions, without
+// non-deterministic expressions or variables.
+func cacheableResult(n sql.Node, tables *[]sql.DataVersionedTable) bool {
+	switch n.(type) {
+	case *plan.Project, *plan.Filter, *plan.Limit, *plan.Offset, *plan.Sort, *plan.TopN, *plan.GroupBy, *plan.Having,
+		*plan.Distinct, *plan.OrderedDistinct, *plan.Union, *plan.Window, *plan.SubqueryAlias, *plan.TableAlias,
+		*plan.JoinNode, *plan.With, *plan.Values:
+	default:
+		return false
+	}
+
+	cacheable := true
+	transform.Inspect(n, func(n sql.Node) bool {
+		if !cacheable {
+			return false
+		}
+		switch n := n.(type) {
+		case *plan.ResolvedTable:
+			cacheable = appendVersionedTable(n.Table, tables)
+		case *plan.IndexedTableAccess:
+			cacheable = appendVersionedTable(n.ResolvedTable.Table, tables)
+		case *plan.Limit:
+			// FOUND_ROWS() is set by the query itself, which cached results don't run
+			cacheable = !n.CalcFoundRows
+		case *plan.TopN:
+			cacheable = !n.CalcFoundRows
+		case *plan.Into, *plan.DeferredAsOfTable, *plan.RecursiveCte, *plan.RecursiveTable:
+			cacheable = false
+		}
+		if exprs, ok := n.(sql.Expressioner); ok && cacheable {
+			for _, expr := range exprs.Expressions() {
+				cacheable = cacheable && cacheableExpression(expr, tables)
+			}
+		}
+		return cacheable
+	})
+	return cacheable
+}
+
+// cacheableExpression returns whether the value of the expression given only depends on the row it's evaluated on and
+// on the data of the tables read by its subqueries, which it appends to the tables given.
+func cacheableExpression(e sql.Expression, tables *[]sql.DataVersionedTable) bool {
+	return !transform.InspectExpr(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.UserVar, *expression.SystemVar, *expression.ProcedureParam, *expression.BindVar:
+			return true
+		case *plan.Subquery:
+			return !cacheableResult(e.Query, tables)
+		case sql.NonDeterministicExpression:
+			return e.IsNonDeterministic()
+		}
+		return false
+	})
+}
+
+// appendVersionedTable appends the table given to the tables given if it has data versions, and returns whether it
+// does.
+func appendVersionedTable(table sql.Table, tables *[]sql.DataVersionedTable) bool {
+	// the dual table never changes, but it doesn't make the results of queries reading it depend on any data either
+	if plan.IsDualTable(table) {
+		return true
+	}
+	for {
+		if versioned, ok := table.(sql.DataVersionedTable); ok {
+			*tables = append(*tables, versioned)
+			return true
+		}
+		wrapper, ok := table.(sql.TableWrapper)
+		if !ok {
+			return false
+		}
+		table = wrapper.Underlying()
+	}
+}
+
+// withCachedResult returns the analyzed query given with the node returning its result replaced by the rows given.
+func withCachedResult(n sql.Node, rows []sql.Row) (sql.Node, error) {
+	switch n := n.(type) {
+	case *plan.QueryProcess, *plan.TransactionCommittingNode:
+		child, err := withCachedResult(n.Children()[0], rows)
+		if err != nil {
+			return nil, err
+		}
+		return n.WithChildren(child)
+	default:
+		schema := n.Schema()
+		tuples := make([][]sql.Expression, len(rows))
+		for i, row := range rows {
+			tuples[i] = make([]sql.Expression, len(row))
+			for j, val := range row {
+				tuples[i][j] = expression.NewLiteral(val, schema[j].Type)
+			}
+		}
+		return plan.NewValues(tuples), nil
+	}
+}
+
+// resultCachingIter caches the result of a query once all its rows have been read, unless it has more rows than the
+// cache holds.
+type resultCachingIter struct {
+	sql.RowIter
+	cache    *sql.ResultCache
+	key      string
+	versions []string
+	rows     []sql.Row
+	tooLarge bool
+}
+
+func (i *resultCachingIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.RowIter.Next(ctx)
+	switch {
+	case err == io.EOF:
+		if !i.tooLarge {
+			i.cache.Put(i.key, i.versions, i.rows)
+			i.tooLarge = true
+		}
+	case err != nil || i.tooLarge:
+	case len(i.rows) >= i.cache.MaxRows():
+		i.rows, i.tooLarge = nil, true
+	default:
+		i.rows = append(i.rows, row.Copy())
+	}
+	return row, err
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/encodings"
+)
+
+// setHandshakeCollation sets the character set variables given to the character set of the collation the client
+// requested in its handshake, and collation_connection to that collation, as SET NAMES does. The defaults are kept if
+// the client didn't request a collation, or requested one that isn't known.
+func setHandshakeCollation(ctx *sql.Context, vars sql.SessionVariables, id uint8) error {
+	collation := sql.CollationID(id)
+	if id == 0 || collation.Name() == "" || collation.CharacterSet().Encoder() == nil {
+		return nil
+	}
+
+	charset := collation.CharacterSet().Name()
+	for _, name := range []string{"character_set_client", "character_set_connection", "character_set_results"} {
+		if err := vars.SetSessionVariable(ctx, name, charset); err != nil {
+			return err
+		}
+	}
+	return vars.SetSessionVariable(ctx, "collation_connection", collation.Name())
+}
+
+// clientCharset returns the session's character_set_client, or CharacterSet_Unspecified if statements are sent in a
+// character set whose text is valid utf8mb4 already, and don't need to be transcoded.
+func clientCharset(ctx *sql.Context) (sql.CharacterSetID, error) {
+	val, err := ctx.GetSessionVariable(ctx, "character_set_client")
+	if err != nil {
+		return sql.CharacterSet_Unspecified, err
+	}
+	name, _ := val.(string)
+	charset, err := sql.ParseCharacterSet(name)
+	if err != nil {
+		return sql.CharacterSet_Unspecified, err
+	}
+	switch charset {
+	case sql.CharacterSet_utf8mb4, sql.CharacterSet_utf8mb3, sql.CharacterSet_ascii, sql.CharacterSet_binary:
+		return sql.CharacterSet_Unspecified, nil
+	}
+	if charset.Encoder() == nil {
+		return sql.CharacterSet_Unspecified, nil
+	}
+	return charset, nil
+}
+
+// decodeClientQuery returns the query given, sent in the session's character_set_client, transcoded to utf8mb4.
+func decodeClientQuery(ctx *sql.Context, query string) (string, error) {
+	charset, err := clientCharset(ctx)
+	if err != nil || charset == sql.CharacterSet_Unspecified {
+		return query, err
+	}
+	decoded, ok := charset.Encoder().Decode(encodings.StringToBytes(query))
+	if !ok {
+		return "", sql.ErrCharSetInvalidString.New(charset.Name(), query)
+	}
+	return string(decoded), nil
+}
+
+// encodeClientQuery returns the query given transcoded back to the session's character_set_client. It's used for the
+// remainder of multi-statement queries, which are decoded again when they're run.
+func encodeClientQuery(ctx *sql.Context, query string) (string, error) {
+	charset, err := clientCharset(ctx)
+	if err != nil || charset == sql.CharacterSet_Unspecified {
+		return query, err
+	}
+	encoded, ok := charset.Encoder().Encode(encodings.StringToBytes(query))
+	if !ok {
+		return "", sql.ErrCharSetFailedToEncode.New(charset.Name())
+	}
+	return string(encoded), nil
+}
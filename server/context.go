@@ -115,6 +115,9 @@ func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error
 	}
 
 	session.SetConnectionId(conn.ConnectionID)
+	if err = setHandshakeCollation(sql.NewContext(ctx, sql.WithSession(session)), session, conn.CharacterSet); err != nil {
+		return err
+	}
 
 	s.sessions[conn.ConnectionID] = session
 
@@ -169,10 +172,15 @@ func (s *SessionManager) Iter(f func(session sql.Session) (stop bool, err error)
 	return nil
 }
 
-func (s *SessionManager) session(conn *mysql.Conn) sql.Session {
+// warnings returns the warnings of the session of the connection given, or nil if it has no session or its session
+// doesn't store warnings.
+func (s *SessionManager) warnings(conn *mysql.Conn) sql.SessionWarnings {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.sessions[conn.ConnectionID]
+	if warnings, ok := s.sessions[conn.ConnectionID].(sql.SessionWarnings); ok {
+		return warnings
+	}
+	return nil
 }
 
 // NewContext creates a new context for the session at the given conn.
@@ -17,6 +17,7 @@ package server
 import (
 	"encoding/base64"
 	"io"
+	"math"
 	"net"
 	"regexp"
 	"strconv"
@@ -39,6 +40,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -52,8 +54,23 @@ var ErrConnectionWasClosed = errors.NewKind("connection was closed")
 
 var ErrUnsupportedOperation = errors.NewKind("unsupported operation")
 
+// ErrLongDataTooLarge is returned when a prepared statement is executed with a parameter larger than max_allowed_packet,
+// which clients stream to the server in chunks with COM_STMT_SEND_LONG_DATA
+var ErrLongDataTooLarge = errors.NewKind("Parameter of prepared statement which is set through mysql_send_long_data() is longer than 'max_allowed_packet' bytes")
+
 const rowsBatch = 128
 
+// resultBatchBytes is the size of the rows after which a batch is sent to the client before it has rowsBatch rows, so
+// that batches of large rows aren't held in memory.
+const resultBatchBytes = 1 << 20
+
+// defaultResultBufferRows is the number of rows read ahead of the client, if not configured.
+const defaultResultBufferRows = 512
+
+// moreResultSets is the remainder ComMultiQuery returns while a statement has result sets left to send, so that the
+// connection calls it again to send the next one. It's followed by the remainder of the query once they've been sent.
+const moreResultSets = "\x00more result sets"
+
 var tcpCheckerSleepDuration time.Duration = 1 * time.Second
 
 type MultiStmtMode int
@@ -71,7 +88,20 @@ type Handler struct {
 	disableMultiStmts bool
 	maxLoggedQueryLen int
 	encodeLoggedQuery bool
-	sel               ServerEventListener
+	// resultBufferRows is the number of rows read from the row iterator of a query ahead of the rows sent to the client
+	resultBufferRows int
+	sel              ServerEventListener
+
+	mu sync.Mutex
+	// pendingResults holds the result sets left to send of the last statement of each connection
+	pendingResults map[uint32]*pendingResultSets
+}
+
+// pendingResultSets are the result sets of a statement left to send to the client, one per call of ComMultiQuery,
+// and the remainder of the query to run after them.
+type pendingResultSets struct {
+	results   []*sqltypes.Result
+	remainder string
 }
 
 var _ mysql.Handler = (*Handler)(nil)
@@ -99,6 +129,11 @@ func (h *Handler) ComPrepare(c *mysql.Conn, query string) ([]*query.Field, error
 	if err != nil {
 		return nil, err
 	}
+	query, err = decodeClientQuery(ctx, query)
+	if err != nil {
+		return nil, sql.CastSQLError(err)
+	}
+	ctx = ctx.WithQuery(query)
 
 	var analyzed sql.Node
 	if analyzer.PreparedStmtDisabled {
@@ -114,7 +149,7 @@ func (h *Handler) ComPrepare(c *mysql.Conn, query string) ([]*query.Field, error
 	if types.IsOkResultSchema(analyzed.Schema()) {
 		return nil, nil
 	}
-	return schemaToFields(analyzed.Schema()), nil
+	return schemaToFields(ctx, analyzed.Schema()), nil
 }
 
 func (h *Handler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
@@ -138,6 +173,7 @@ func (h *Handler) ConnectionClosed(c *mysql.Conn) {
 
 	defer h.sm.RemoveConn(c)
 	defer h.e.CloseSession(c.ConnectionID)
+	defer h.takePendingResults(c.ConnectionID)
 
 	if ctx, err := h.sm.NewContextWithQuery(c, ""); err != nil {
 		logrus.Errorf("unable to release all locks on session close: %s", err)
@@ -150,6 +186,9 @@ func (h *Handler) ConnectionClosed(c *mysql.Conn) {
 		if err = h.e.Analyzer.Catalog.UnlockTables(ctx, c.ConnectionID); err != nil {
 			logrus.Errorf("unable to unlock tables on session close: %s", err)
 		}
+		if err = h.e.Analyzer.Catalog.DropTemporaryTables(ctx); err != nil {
+			logrus.Errorf("unable to drop temporary tables on session close: %s", err)
+		}
 	}
 
 	logrus.WithField(sql.ConnectionIdLogField, c.ConnectionID).Infof("ConnectionClosed")
@@ -160,9 +199,41 @@ func (h *Handler) ComMultiQuery(
 	query string,
 	callback func(*sqltypes.Result, bool) error,
 ) (string, error) {
+	if pending := h.takePendingResults(c.ConnectionID); pending != nil && query == moreResultSets {
+		return h.writeResultSets(c, pending, callback)
+	}
 	return h.errorWrappedDoQuery(c, query, MultiStmtModeOn, nil, callback)
 }
 
+// writeResultSets sends the next of the result sets given to the client. The others are sent by the next calls of
+// ComMultiQuery, which the connection makes with the moreResultSets remainder returned until the last one is sent.
+// The connection only writes a single result set per call, followed by the status of the remainder it's given.
+func (h *Handler) writeResultSets(c *mysql.Conn, pending *pendingResultSets, callback func(*sqltypes.Result, bool) error) (string, error) {
+	result := pending.results[0]
+	pending.results = pending.results[1:]
+	if len(pending.results) == 0 {
+		return pending.remainder, callback(result, pending.remainder != "")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.pendingResults == nil {
+		h.pendingResults = make(map[uint32]*pendingResultSets)
+	}
+	h.pendingResults[c.ConnectionID] = pending
+	return moreResultSets, callback(result, true)
+}
+
+// takePendingResults removes and returns the result sets left to send of the connection with the ID given, if any.
+// Result sets left by a query that failed to send them are discarded by the next query.
+func (h *Handler) takePendingResults(connID uint32) *pendingResultSets {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	pending := h.pendingResults[connID]
+	delete(h.pendingResults, connID)
+	return pending
+}
+
 // ComQuery executes a SQL query on the SQLe engine.
 func (h *Handler) ComQuery(
 	c *mysql.Conn,
@@ -173,6 +244,22 @@ func (h *Handler) ComQuery(
 	return err
 }
 
+// checkBindingLengths returns an error if any of the parameters of a prepared statement is longer than
+// max_allowed_packet. Parameters sent in the COM_STMT_EXECUTE packet are bounded by the packet size, but those streamed
+// with COM_STMT_SEND_LONG_DATA are assembled by the connection from any number of chunks.
+func checkBindingLengths(ctx *sql.Context, bindings map[string]*query.BindVariable) error {
+	maxAllowedPacket, err := ctx.GetSessionVariable(ctx, "max_allowed_packet")
+	if err != nil {
+		return err
+	}
+	for _, v := range bindings {
+		if int64(len(v.Value)) > maxAllowedPacket.(int64) {
+			return ErrLongDataTooLarge.New()
+		}
+	}
+	return nil
+}
+
 func bindingsToExprs(bindings map[string]*query.BindVariable) (map[string]sql.Expression, error) {
 	res := make(map[string]sql.Expression, len(bindings))
 	for k, v := range bindings {
@@ -292,6 +379,11 @@ func (h *Handler) doQuery(
 	if err != nil {
 		return "", err
 	}
+	// Statements are sent in the connection's character_set_client, but parsed as utf8mb4
+	query, err = decodeClientQuery(ctx, query)
+	if err != nil {
+		return "", err
+	}
 
 	var remainder string
 	var parsed sql.Node
@@ -301,6 +393,10 @@ func (h *Handler) doQuery(
 		if prequery != "" {
 			query = prequery
 		}
+		// The remainder is passed back to this handler as sent by the client
+		if remainder, err = encodeClientQuery(ctx, remainder); err != nil {
+			return "", err
+		}
 	}
 
 	ctx = ctx.WithQuery(query)
@@ -326,8 +422,12 @@ func (h *Handler) doQuery(
 
 	start := time.Now()
 
-	if parsed == nil {
-		parsed, err = parse.Parse(ctx, query)
+	rewritten, err := h.e.RewriteQuery(ctx, query)
+	if err != nil {
+		return remainder, err
+	}
+	if parsed == nil || rewritten != query {
+		parsed, err = parse.Parse(ctx, rewritten)
 	}
 	if err != nil {
 		return "", err
@@ -335,8 +435,32 @@ func (h *Handler) doQuery(
 
 	ctx.GetLogger().Tracef("beginning execution")
 
+	// Clients using multiple statements also receive every result set of the stored procedures they call. The result
+	// of the CALL statement is the last of them, so it's sent once the others have been.
+	var resultSets []*sqltypes.Result
+	write := callback
+	if _, isCall := parsed.(*plan.Call); isCall && mode == MultiStmtModeOn {
+		ctx = ctx.WithResultSetWriter(func(schema sql.Schema, rows []sql.Row) error {
+			result, err := resultSetToSQL(ctx, schema, rows)
+			if err != nil {
+				return err
+			}
+			resultSets = append(resultSets, result)
+			return nil
+		})
+		callback = func(r *sqltypes.Result, more bool) error {
+			if len(resultSets) > 1 {
+				return nil
+			}
+			return write(r, more)
+		}
+	}
+
 	var sqlBindings map[string]sql.Expression
 	if len(bindings) > 0 {
+		if err = checkBindingLengths(ctx, bindings); err != nil {
+			return remainder, err
+		}
 		sqlBindings, err = bindingsToExprs(bindings)
 		if err != nil {
 			ctx.GetLogger().WithError(err).Errorf("Error processing bindings")
@@ -362,15 +486,21 @@ func (h *Handler) doQuery(
 		return remainder, err
 	}
 
+	limits, err := newResultLimits(ctx)
+	if err != nil {
+		return remainder, err
+	}
+
+	// The row channel is bounded, so that rows are only read ahead of a slow client up to its capacity
 	var rowChan chan sql.Row
 	var row2Chan chan sql.Row2
 
 	var rowIter2 sql.RowIter2
 	if ri2, ok := rowIter.(sql.RowIterTypeSelector); ok && ri2.IsNode2() {
 		rowIter2 = rowIter.(sql.RowIter2)
-		row2Chan = make(chan sql.Row2, 512)
+		row2Chan = make(chan sql.Row2, h.resultBuffer())
 	} else {
-		rowChan = make(chan sql.Row, 512)
+		rowChan = make(chan sql.Row, h.resultBuffer())
 	}
 
 	wg := sync.WaitGroup{}
@@ -388,12 +518,9 @@ func (h *Handler) doQuery(
 				frame.Clear()
 				err := rowIter2.Next2(ctx, frame)
 				if err != nil {
+					// The iterator is closed once all rows have been sent over the wire, below
 					if err == io.EOF {
-						return rowIter2.Close(ctx)
-					}
-					cerr := rowIter2.Close(ctx)
-					if cerr != nil {
-						ctx.GetLogger().WithError(cerr).Warn("error closing row iter")
+						return nil
 					}
 					return err
 				}
@@ -444,6 +571,7 @@ func (h *Handler) doQuery(
 	defer timer.Stop()
 
 	var r *sqltypes.Result
+	var batchBytes int
 	var processedAtLeastOneBatch bool
 
 	// reads rows from the channel, converts them to wire format,
@@ -453,14 +581,15 @@ func (h *Handler) doQuery(
 		defer wg.Done()
 		for {
 			if r == nil {
-				r = &sqltypes.Result{Fields: schemaToFields(schema)}
+				r = &sqltypes.Result{Fields: schemaToFields(ctx, schema)}
 			}
 
-			if r.RowsAffected == rowsBatch {
+			if r.RowsAffected == rowsBatch || batchBytes >= resultBatchBytes {
 				if err := callback(r, more); err != nil {
 					return err
 				}
 				r = nil
+				batchBytes = 0
 				processedAtLeastOneBatch = true
 				continue
 			}
@@ -486,6 +615,11 @@ func (h *Handler) doQuery(
 					if err != nil {
 						return err
 					}
+					size, err := limits.add(outputRow)
+					if err != nil {
+						return err
+					}
+					batchBytes += size
 
 					ctx.GetLogger().Tracef("spooling result row %s", outputRow)
 					r.Rows = append(r.Rows, outputRow)
@@ -517,6 +651,11 @@ func (h *Handler) doQuery(
 					if err != nil {
 						return err
 					}
+					size, err := limits.add(outputRow)
+					if err != nil {
+						return err
+					}
+					batchBytes += size
 
 					ctx.GetLogger().Tracef("spooling result row %s", outputRow)
 					r.Rows = append(r.Rows, outputRow)
@@ -569,6 +708,10 @@ func (h *Handler) doQuery(
 
 	ctx.GetLogger().Debugf("Query finished in %d ms", time.Since(start).Milliseconds())
 
+	if len(resultSets) > 1 {
+		return h.writeResultSets(c, &pendingResultSets{results: resultSets, remainder: remainder}, write)
+	}
+
 	// processedAtLeastOneBatch means we already called callback() at least
 	// once, so no need to call it if RowsAffected == 0.
 	if r != nil && (r.RowsAffected == 0 && processedAtLeastOneBatch) {
@@ -578,6 +721,54 @@ func (h *Handler) doQuery(
 	return remainder, callback(r, more)
 }
 
+// resultBuffer returns the number of rows to read from the row iterator of a query ahead of the client.
+func (h *Handler) resultBuffer() int {
+	if h.resultBufferRows > 0 {
+		return h.resultBufferRows
+	}
+	return defaultResultBufferRows
+}
+
+// resultLimits enforces the limits set by @@max_result_rows and @@max_result_size on the number of rows and bytes of
+// the result of a query. Queries exceeding a limit are aborted, rather than having their result truncated. A limit of
+// zero means there's no limit.
+type resultLimits struct {
+	maxRows  uint64
+	maxBytes uint64
+	rows     uint64
+	bytes    uint64
+}
+
+func newResultLimits(ctx *sql.Context) (*resultLimits, error) {
+	maxRows, err := ctx.GetSessionVariable(ctx, "max_result_rows")
+	if err != nil {
+		return nil, err
+	}
+	maxBytes, err := ctx.GetSessionVariable(ctx, "max_result_size")
+	if err != nil {
+		return nil, err
+	}
+	return &resultLimits{maxRows: maxRows.(uint64), maxBytes: maxBytes.(uint64)}, nil
+}
+
+// add counts the row given against the limits, returning its size in bytes, or ErrResultLimitExceeded if it exceeds a
+// limit.
+func (l *resultLimits) add(row []sqltypes.Value) (int, error) {
+	size := 0
+	for _, v := range row {
+		size += len(v.Raw())
+	}
+	l.rows++
+	l.bytes += uint64(size)
+	if l.maxRows > 0 && l.rows > l.maxRows {
+		return 0, sql.ErrResultLimitExceeded.New("max_result_rows", l.maxRows)
+	}
+	if l.maxBytes > 0 && l.bytes > l.maxBytes {
+		return 0, sql.ErrResultLimitExceeded.New("max_result_size", l.maxBytes)
+	}
+	return size, nil
+}
+
 // See https://dev.mysql.com/doc/internals/en/status-flags.html
 func setConnStatusFlags(ctx *sql.Context, c *mysql.Conn) error {
 	ok, err := isSessionAutocommit(ctx)
@@ -714,8 +905,8 @@ func resultFromOkResult(result types.OkResult) *sqltypes.Result {
 // ComQuery callback if the result does not contain any fields,
 // or after the last ComQuery call completes.
 func (h *Handler) WarningCount(c *mysql.Conn) uint16 {
-	if sess := h.sm.session(c); sess != nil {
-		return sess.WarningCount()
+	if warnings := h.sm.warnings(c); warnings != nil {
+		return warnings.WarningCount()
 	}
 
 	return 0
@@ -739,6 +930,28 @@ func rowToSQL(ctx *sql.Context, s sql.Schema, row sql.Row) ([]sqltypes.Value, er
 	return o, nil
 }
 
+// resultSetToSQL returns the result set with the schema and the rows given in wire format, which is subject to the
+// limits of the results of queries.
+func resultSetToSQL(ctx *sql.Context, schema sql.Schema, rows []sql.Row) (*sqltypes.Result, error) {
+	limits, err := newResultLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{Fields: schemaToFields(ctx, schema)}
+	for _, row := range rows {
+		outputRow, err := rowToSQL(ctx, schema, row)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = limits.add(outputRow); err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, outputRow)
+		result.RowsAffected++
+	}
+	return result, nil
+}
+
 func row2ToSQL(s sql.Schema, row sql.Row2) ([]sqltypes.Value, error) {
 	o := make([]sqltypes.Value, len(row))
 	var err error
@@ -758,25 +971,92 @@ func row2ToSQL(s sql.Schema, row sql.Row2) ([]sqltypes.Value, error) {
 	return o, nil
 }
 
-func schemaToFields(s sql.Schema) []*query.Field {
+// schemaToFields returns the column definitions of a result set of the schema given, which clients use to map the
+// values of its columns to their own types.
+func schemaToFields(ctx *sql.Context, s sql.Schema) []*query.Field {
+	charSetResults := ctx.GetCharacterSetResults()
 	fields := make([]*query.Field, len(s))
 	for i, c := range s {
-		var charset uint32 = mysql.CharacterSetUtf8
-		if types.IsBinaryType(c.Type) {
-			charset = mysql.CharacterSetBinary
+		_, flags := sqltypes.TypeToMySQL(c.Type.Type())
+		charset := uint32(sql.Collation_binary)
+		columnLength := c.Type.MaxTextResponseByteLength()
+		var decimals uint32
+
+		switch t := c.Type.(type) {
+		case sql.StringType:
+			if !types.IsBinaryType(t) {
+				charset, columnLength = resultCollation(charSetResults, t.Collation(), t.MaxCharacterLength(), columnLength)
+			}
+			if types.IsTextBlob(t) {
+				flags |= int64(query.MySqlFlag_BLOB_FLAG)
+			}
+		case sql.EnumType:
+			charset, columnLength = resultCollation(charSetResults, t.Collation(), int64(columnLength)/t.CharacterSet().MaxLength(), columnLength)
+		case sql.SetType:
+			charset, columnLength = resultCollation(charSetResults, t.Collation(), int64(columnLength)/t.CharacterSet().MaxLength(), columnLength)
+		case sql.DecimalType:
+			decimals = uint32(t.Scale())
+		case sql.NumberType:
+			if t.IsFloat() {
+				// Floating point values aren't formatted with a fixed number of decimals
+				decimals = notFixedDecimals
+			}
+		case sql.DatetimeType:
+			if t.Type() != sqltypes.Date {
+				decimals = maxFractionalSecondsPrecision
+			}
+		case types.TimeType:
+			decimals = maxFractionalSecondsPrecision
+		case types.JsonType, sql.SpatialColumnType:
+			flags |= int64(query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)
+		}
+
+		if !c.Nullable {
+			flags |= int64(query.MySqlFlag_NOT_NULL_FLAG)
+		}
+		if c.PrimaryKey {
+			flags |= int64(query.MySqlFlag_PRI_KEY_FLAG)
+		}
+		if c.AutoIncrement {
+			flags |= int64(query.MySqlFlag_AUTO_INCREMENT_FLAG)
 		}
 
 		fields[i] = &query.Field{
 			Name:         c.Name,
 			Type:         c.Type.Type(),
 			Charset:      charset,
-			ColumnLength: c.Type.MaxTextResponseByteLength(),
+			ColumnLength: columnLength,
+			Decimals:     decimals,
+			Flags:        uint32(flags),
 		}
 	}
 
 	return fields
 }
 
+const (
+	// notFixedDecimals is the number of decimals of columns whose values don't have a fixed number of them
+	notFixedDecimals = 31
+	// maxFractionalSecondsPrecision is the number of decimals of the seconds of times, which are kept to the
+	// microsecond
+	maxFractionalSecondsPrecision = 6
+)
+
+// resultCollation returns the collation and length of a column of strings of the collation and length given, as
+// they're sent to clients in the character set of the character_set_results variable. Strings are sent in their own
+// collation if character_set_results is NULL or binary, or if it's their own character set.
+func resultCollation(charSetResults sql.CharacterSetID, collation sql.CollationID, maxCharLength int64, columnLength uint32) (uint32, uint32) {
+	if charSetResults == sql.CharacterSet_Unspecified || charSetResults == sql.CharacterSet_binary ||
+		charSetResults == collation.CharacterSet() {
+		return uint32(collation), columnLength
+	}
+	length := maxCharLength * charSetResults.MaxLength()
+	if length > math.MaxUint32 {
+		length = math.MaxUint32
+	}
+	return uint32(charSetResults.DefaultCollation()), uint32(length)
+}
+
 var (
 	// QueryCounter describes a metric that accumulates number of queries monotonically.
 	QueryCounter = discard.NewCounter()
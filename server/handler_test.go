@@ -158,6 +158,160 @@ func TestHandlerOutput(t *testing.T) {
 	}
 }
 
+func TestHandlerResultLimits(t *testing.T) {
+	e := setupMemDB(require.New(t))
+	dummyConn := newConn(1)
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		readTimeout:      time.Second,
+		resultBufferRows: 1,
+	}
+	handler.NewConnection(dummyConn)
+	handler.ComInitDB(dummyConn, "test")
+
+	query := func(query string) (int, error) {
+		var rows int
+		err := handler.ComQuery(dummyConn, query, func(res *sqltypes.Result, more bool) error {
+			rows += len(res.Rows)
+			return nil
+		})
+		return rows, err
+	}
+
+	// rows are read one at a time ahead of the client, but all of them are returned
+	rows, err := query("SELECT * FROM test")
+	require.NoError(t, err)
+	require.Equal(t, 1010, rows)
+
+	_, err = query("SET @@max_result_rows = 100")
+	require.NoError(t, err)
+	rows, err = query("SELECT * FROM test LIMIT 100")
+	require.NoError(t, err)
+	require.Equal(t, 100, rows)
+	_, err = query("SELECT * FROM test LIMIT 101")
+	require.ErrorContains(t, err, sql.ErrResultLimitExceeded.New("max_result_rows", 100).Error())
+
+	// the first 100 values are 190 bytes long in the text protocol
+	_, err = query("SET @@max_result_rows = 0, @@max_result_size = 190")
+	require.NoError(t, err)
+	rows, err = query("SELECT * FROM test LIMIT 100")
+	require.NoError(t, err)
+	require.Equal(t, 100, rows)
+	_, err = query("SELECT * FROM test LIMIT 101")
+	require.ErrorContains(t, err, sql.ErrResultLimitExceeded.New("max_result_size", 190).Error())
+
+	_, err = query("SET @@max_result_size = 0")
+	require.NoError(t, err)
+	rows, err = query("SELECT * FROM test")
+	require.NoError(t, err)
+	require.Equal(t, 1010, rows)
+}
+
+func TestHandlerComMultiQuery(t *testing.T) {
+	e := setupMemDB(require.New(t))
+	dummyConn := newConn(1)
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		readTimeout: time.Second,
+	}
+	handler.NewConnection(dummyConn)
+	handler.ComInitDB(dummyConn, "test")
+
+	var results []*sqltypes.Result
+	var mores []bool
+	callback := func(res *sqltypes.Result, more bool) error {
+		results = append(results, res)
+		mores = append(mores, more)
+		return nil
+	}
+
+	// each statement is run by its own call, which returns the remaining statements
+	query := "SELECT c1 FROM test WHERE c1 < 2; insert into test values (2000);\nselect count(*) from test"
+	var err error
+	var calls int
+	for query != "" {
+		query, err = handler.ComMultiQuery(dummyConn, query, callback)
+		require.NoError(t, err)
+		calls++
+	}
+	require.Equal(t, 3, calls)
+	require.Equal(t, []bool{true, true, false}, mores)
+	require.Len(t, results[0].Rows, 2)
+	require.Equal(t, uint64(1), results[1].RowsAffected)
+	require.Equal(t, "1011", results[2].Rows[0][0].ToString())
+}
+
+func TestHandlerConnectionCharset(t *testing.T) {
+	e := setupMemDB(require.New(t))
+	// the client requests latin1_swedish_ci in its handshake
+	conn := newConn(1)
+	conn.CharacterSet = uint8(sql.Collation_latin1_swedish_ci)
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			testSessionBuilder,
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		readTimeout: time.Second,
+	}
+	handler.NewConnection(conn)
+	require.NoError(t, handler.ComInitDB(conn, "test"))
+
+	query := func(query string) []sqltypes.Value {
+		var row []sqltypes.Value
+		err := handler.ComQuery(conn, query, func(res *sqltypes.Result, more bool) error {
+			if len(res.Rows) > 0 {
+				row = res.Rows[0]
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		return row
+	}
+
+	row := query("select @@character_set_client, @@character_set_connection, @@character_set_results, @@collation_connection")
+	require.Equal(t, "latin1", row[0].ToString())
+	require.Equal(t, "latin1", row[1].ToString())
+	require.Equal(t, "latin1", row[2].ToString())
+	require.Equal(t, "latin1_swedish_ci", row[3].ToString())
+
+	// statements are decoded from latin1, and results are encoded in latin1
+	row = query("select 'caf\xe9', char_length('caf\xe9')")
+	require.Equal(t, []byte("caf\xe9"), row[0].Raw())
+	require.Equal(t, "4", row[1].ToString())
+
+	// the remainder of multi-statement queries is returned as sent
+	remainder, err := handler.ComMultiQuery(conn, "select 1; select 'caf\xe9'", func(*sqltypes.Result, bool) error { return nil })
+	require.NoError(t, err)
+	require.Equal(t, "select 'caf\xe9'", remainder)
+
+	query("set names utf8mb4")
+	row = query("select 'caf\xc3\xa9', char_length('caf\xc3\xa9'), @@collation_connection")
+	require.Equal(t, []byte("caf\xc3\xa9"), row[0].Raw())
+	require.Equal(t, "4", row[1].ToString())
+	require.Equal(t, "utf8mb4_0900_ai_ci", row[2].ToString())
+}
+
 func TestHandlerComPrepare(t *testing.T) {
 	e := setupMemDB(require.New(t))
 	dummyConn := newConn(1)
@@ -201,13 +355,13 @@ func TestHandlerComPrepare(t *testing.T) {
 			name:      "select statement returns non-nil schema",
 			statement: "select c1 from test where c1 > ?",
 			expected: []*query.Field{
-				{Name: "c1", Type: query.Type_INT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 11},
+				{Name: "c1", Type: query.Type_INT32, Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 			},
 		},
 		{
 			name:        "errors are cast to SQLError",
 			statement:   "SELECT * from doesnotexist LIMIT ?",
-			expectedErr: mysql.NewSQLError(mysql.ERNoSuchTable, "", "table not found: %s", "doesnotexist"),
+			expectedErr: mysql.NewSQLError(mysql.ERNoSuchTable, mysql.SSUnknownTable, "table not found: %s", "doesnotexist"),
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
@@ -266,7 +420,7 @@ func TestHandlerComPrepareExecute(t *testing.T) {
 				},
 			},
 			schema: []*query.Field{
-				{Name: "c1", Type: query.Type_INT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 11},
+				{Name: "c1", Type: query.Type_INT32, Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 			},
 			expected: []sql.Row{
 				{0}, {1}, {2}, {3}, {4},
@@ -342,7 +496,7 @@ func TestHandlerComPrepareExecuteWithPreparedDisabled(t *testing.T) {
 				},
 			},
 			schema: []*query.Field{
-				{Name: "c1", Type: query.Type_INT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 11},
+				{Name: "c1", Type: query.Type_INT32, Charset: mysql.CharacterSetBinary, ColumnLength: 11, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
 			},
 			expected: []sql.Row{
 				{0}, {1}, {2}, {3}, {4},
@@ -485,6 +639,79 @@ func TestServerEventListener(t *testing.T) {
 	require.Equal(0, len(e.PreparedDataCache.GetSessionData(conn3.ConnectionID)))
 }
 
+func TestHandlerTemporaryTables(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	handler := &Handler{
+		e: e,
+		sm: NewSessionManager(
+			func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+				return sql.NewBaseSessionWithClientServer(addr, sql.Client{Capabilities: conn.Capabilities}, conn.ConnectionID), nil
+			},
+			sql.NoopTracer,
+			func(ctx *sql.Context, db string) bool { return db == "test" },
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+	}
+
+	var result *sqltypes.Result
+	query := func(conn *mysql.Conn, q string) [][]sqltypes.Value {
+		result = nil
+		err := handler.ComQuery(conn, q, func(res *sqltypes.Result, more bool) error {
+			result = res
+			return nil
+		})
+		require.NoError(err)
+		return result.Rows
+	}
+
+	conn1 := newConn(1)
+	handler.NewConnection(conn1)
+	handler.ComInitDB(conn1, "test")
+	conn2 := newConn(2)
+	handler.NewConnection(conn2)
+	handler.ComInitDB(conn2, "test")
+
+	// A temporary table shadows the permanent table with the same name for its session only
+	query(conn1, "CREATE TEMPORARY TABLE test (c1 int primary key, c2 int)")
+	query(conn1, "INSERT INTO test VALUES (1, 1)")
+	require.Equal("1", query(conn1, "SELECT COUNT(*) FROM test")[0][0].ToString())
+	require.Equal("1010", query(conn2, "SELECT COUNT(*) FROM test")[0][0].ToString())
+
+	// Other sessions can create temporary tables with the same name
+	query(conn2, "CREATE TEMPORARY TABLE test (c1 int primary key)")
+	require.Equal("0", query(conn2, "SELECT COUNT(*) FROM test")[0][0].ToString())
+	require.Equal("1", query(conn1, "SELECT COUNT(*) FROM test")[0][0].ToString())
+
+	// Temporary tables are not listed alongside permanent tables
+	require.Len(query(conn1, "SHOW TABLES"), 1)
+	require.Equal("0", query(conn2, "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'test' AND table_name = 'test' AND table_type = 'TEMPORARY'")[0][0].ToString())
+
+	// Temporary tables are dropped when their session ends
+	handler.ConnectionClosed(conn1)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSessionWithClientServer("", sql.Client{}, conn1.ConnectionID)))
+	db, err := e.Analyzer.Catalog.Database(ctx, "test")
+	require.NoError(err)
+	tempTables, err := db.(sql.TemporaryTableDatabase).GetAllTemporaryTables(ctx)
+	require.NoError(err)
+	require.Empty(tempTables)
+
+	require.Equal("0", query(conn2, "SELECT COUNT(*) FROM test")[0][0].ToString())
+	query(conn2, "DROP TEMPORARY TABLE test")
+	require.Equal("1010", query(conn2, "SELECT COUNT(*) FROM test")[0][0].ToString())
+
+	// DROP TEMPORARY TABLE leaves permanent tables alone
+	err = handler.ComQuery(conn2, "DROP TEMPORARY TABLE test", func(res *sqltypes.Result, more bool) error {
+		return nil
+	})
+	require.ErrorContains(err, sql.ErrUnknownTable.New("test").Error())
+	query(conn2, "DROP TEMPORARY TABLE IF EXISTS test")
+	require.Equal("1010", query(conn2, "SELECT COUNT(*) FROM test")[0][0].ToString())
+}
+
 func TestHandlerKill(t *testing.T) {
 	require := require.New(t)
 	e := setupMemDB(require)
@@ -545,117 +772,127 @@ func TestSchemaToFields(t *testing.T) {
 
 	schema := sql.Schema{
 		// Blob, Text, and JSON Types
-		{Name: "tinyblob", Type: types.TinyBlob},
-		{Name: "blob", Type: types.Blob},
-		{Name: "mediumblob", Type: types.MediumBlob},
-		{Name: "longblob", Type: types.LongBlob},
-		{Name: "tinytext", Type: types.TinyText},
-		{Name: "text", Type: types.Text},
-		{Name: "mediumtext", Type: types.MediumText},
-		{Name: "longtext", Type: types.LongText},
-		{Name: "json", Type: types.JSON},
+		{Name: "tinyblob", Type: types.TinyBlob, Nullable: true},
+		{Name: "blob", Type: types.Blob, Nullable: true},
+		{Name: "mediumblob", Type: types.MediumBlob, Nullable: true},
+		{Name: "longblob", Type: types.LongBlob, Nullable: true},
+		{Name: "tinytext", Type: types.TinyText, Nullable: true},
+		{Name: "text", Type: types.Text, Nullable: true},
+		{Name: "mediumtext", Type: types.MediumText, Nullable: true},
+		{Name: "longtext", Type: types.LongText, Nullable: true},
+		{Name: "json", Type: types.JSON, Nullable: true},
 
 		// Geometry Types
-		{Name: "geometry", Type: types.GeometryType{}},
-		{Name: "point", Type: types.PointType{}},
-		{Name: "polygon", Type: types.PolygonType{}},
-		{Name: "linestring", Type: types.LineStringType{}},
+		{Name: "geometry", Type: types.GeometryType{}, Nullable: true},
+		{Name: "point", Type: types.PointType{}, Nullable: true},
+		{Name: "polygon", Type: types.PolygonType{}, Nullable: true},
+		{Name: "linestring", Type: types.LineStringType{}, Nullable: true},
 
 		// Integer Types
-		{Name: "uint8", Type: types.Uint8},
-		{Name: "int8", Type: types.Int8},
-		{Name: "uint16", Type: types.Uint16},
-		{Name: "int16", Type: types.Int16},
-		{Name: "uint24", Type: types.Uint24},
-		{Name: "int24", Type: types.Int24},
-		{Name: "uint32", Type: types.Uint32},
-		{Name: "int32", Type: types.Int32},
-		{Name: "uint64", Type: types.Uint64},
-		{Name: "int64", Type: types.Int64},
+		{Name: "uint8", Type: types.Uint8, Nullable: true},
+		{Name: "int8", Type: types.Int8, Nullable: true},
+		{Name: "uint16", Type: types.Uint16, Nullable: true},
+		{Name: "int16", Type: types.Int16, Nullable: true},
+		{Name: "uint24", Type: types.Uint24, Nullable: true},
+		{Name: "int24", Type: types.Int24, Nullable: true},
+		{Name: "uint32", Type: types.Uint32, Nullable: true},
+		{Name: "int32", Type: types.Int32, Nullable: true},
+		{Name: "uint64", Type: types.Uint64, Nullable: true},
+		{Name: "int64", Type: types.Int64, Nullable: true},
 
 		// Floating Point and Decimal Types
-		{Name: "float32", Type: types.Float32},
-		{Name: "float64", Type: types.Float64},
-		{Name: "decimal10_0", Type: types.MustCreateDecimalType(10, 0)},
-		{Name: "decimal60_30", Type: types.MustCreateDecimalType(60, 30)},
+		{Name: "float32", Type: types.Float32, Nullable: true},
+		{Name: "float64", Type: types.Float64, Nullable: true},
+		{Name: "decimal10_0", Type: types.MustCreateDecimalType(10, 0), Nullable: true},
+		{Name: "decimal60_30", Type: types.MustCreateDecimalType(60, 30), Nullable: true},
 
 		// Char, Binary, and Bit Types
-		{Name: "varchar50", Type: types.MustCreateString(sqltypes.VarChar, 50, sql.Collation_Default)},
-		{Name: "varbinary12345", Type: types.MustCreateBinary(sqltypes.VarBinary, 12345)},
-		{Name: "binary123", Type: types.MustCreateBinary(sqltypes.Binary, 123)},
-		{Name: "char123", Type: types.MustCreateString(sqltypes.Char, 123, sql.Collation_Default)},
-		{Name: "bit12", Type: types.MustCreateBitType(12)},
+		{Name: "varchar50", Type: types.MustCreateString(sqltypes.VarChar, 50, sql.Collation_Default), Nullable: true},
+		{Name: "varbinary12345", Type: types.MustCreateBinary(sqltypes.VarBinary, 12345), Nullable: true},
+		{Name: "binary123", Type: types.MustCreateBinary(sqltypes.Binary, 123), Nullable: true},
+		{Name: "char123", Type: types.MustCreateString(sqltypes.Char, 123, sql.Collation_Default), Nullable: true},
+		{Name: "bit12", Type: types.MustCreateBitType(12), Nullable: true},
 
 		// Dates
-		{Name: "datetime", Type: types.MustCreateDatetimeType(sqltypes.Datetime)},
-		{Name: "timestamp", Type: types.MustCreateDatetimeType(sqltypes.Timestamp)},
-		{Name: "date", Type: types.MustCreateDatetimeType(sqltypes.Date)},
-		{Name: "time", Type: types.Time},
-		{Name: "year", Type: types.Year},
+		{Name: "datetime", Type: types.MustCreateDatetimeType(sqltypes.Datetime), Nullable: true},
+		{Name: "timestamp", Type: types.MustCreateDatetimeType(sqltypes.Timestamp), Nullable: true},
+		{Name: "date", Type: types.MustCreateDatetimeType(sqltypes.Date), Nullable: true},
+		{Name: "time", Type: types.Time, Nullable: true},
+		{Name: "year", Type: types.Year, Nullable: true},
 
 		// Set and Enum Types
-		{Name: "set", Type: types.MustCreateSetType([]string{"one", "two", "three", "four"}, sql.Collation_Default)},
-		{Name: "enum", Type: types.MustCreateEnumType([]string{"one", "two", "three", "four"}, sql.Collation_Default)},
+		{Name: "set", Type: types.MustCreateSetType([]string{"one", "two", "three", "four"}, sql.Collation_Default), Nullable: true},
+		{Name: "enum", Type: types.MustCreateEnumType([]string{"one", "two", "three", "four"}, sql.Collation_Default), Nullable: true},
+
+		// Column Attributes
+		{Name: "pk", Type: types.Int64, PrimaryKey: true, AutoIncrement: true},
+		{Name: "notnull", Type: types.Int64},
+		{Name: "latin1", Type: types.MustCreateString(sqltypes.VarChar, 10, sql.Collation_latin1_swedish_ci), Nullable: true},
 	}
 
 	expected := []*query.Field{
 		// Blob, Text, and JSON Types
-		{Name: "tinyblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 255},
-		{Name: "blob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 65_535},
-		{Name: "mediumblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 16_777_215},
-		{Name: "longblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295},
-		{Name: "tinytext", Type: query.Type_TEXT, Charset: mysql.CharacterSetUtf8, ColumnLength: 1020},
-		{Name: "text", Type: query.Type_TEXT, Charset: mysql.CharacterSetUtf8, ColumnLength: 262_140},
-		{Name: "mediumtext", Type: query.Type_TEXT, Charset: mysql.CharacterSetUtf8, ColumnLength: 67_108_860},
-		{Name: "longtext", Type: query.Type_TEXT, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
-		{Name: "json", Type: query.Type_JSON, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
+		{Name: "tinyblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 255, Flags: uint32(query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "blob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 65_535, Flags: uint32(query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "mediumblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 16_777_215, Flags: uint32(query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "longblob", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "tinytext", Type: query.Type_TEXT, Charset: uint32(sql.Collation_Default), ColumnLength: 1020, Flags: uint32(query.MySqlFlag_BLOB_FLAG)},
+		{Name: "text", Type: query.Type_TEXT, Charset: uint32(sql.Collation_Default), ColumnLength: 262_140, Flags: uint32(query.MySqlFlag_BLOB_FLAG)},
+		{Name: "mediumtext", Type: query.Type_TEXT, Charset: uint32(sql.Collation_Default), ColumnLength: 67_108_860, Flags: uint32(query.MySqlFlag_BLOB_FLAG)},
+		{Name: "longtext", Type: query.Type_TEXT, Charset: uint32(sql.Collation_Default), ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_BLOB_FLAG)},
+		{Name: "json", Type: query.Type_JSON, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
 
 		// Geometry Types
-		{Name: "geometry", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
-		{Name: "point", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
-		{Name: "polygon", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
-		{Name: "linestring", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetUtf8, ColumnLength: 4_294_967_295},
+		{Name: "geometry", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "point", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "polygon", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
+		{Name: "linestring", Type: query.Type_GEOMETRY, Charset: mysql.CharacterSetBinary, ColumnLength: 4_294_967_295, Flags: uint32(query.MySqlFlag_BLOB_FLAG | query.MySqlFlag_BINARY_FLAG)},
 
 		// Integer Types
-		{Name: "uint8", Type: query.Type_UINT8, Charset: mysql.CharacterSetUtf8, ColumnLength: 3},
-		{Name: "int8", Type: query.Type_INT8, Charset: mysql.CharacterSetUtf8, ColumnLength: 4},
-		{Name: "uint16", Type: query.Type_UINT16, Charset: mysql.CharacterSetUtf8, ColumnLength: 5},
-		{Name: "int16", Type: query.Type_INT16, Charset: mysql.CharacterSetUtf8, ColumnLength: 6},
-		{Name: "uint24", Type: query.Type_UINT24, Charset: mysql.CharacterSetUtf8, ColumnLength: 8},
-		{Name: "int24", Type: query.Type_INT24, Charset: mysql.CharacterSetUtf8, ColumnLength: 9},
-		{Name: "uint32", Type: query.Type_UINT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 10},
-		{Name: "int32", Type: query.Type_INT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 11},
-		{Name: "uint64", Type: query.Type_UINT64, Charset: mysql.CharacterSetUtf8, ColumnLength: 20},
-		{Name: "int64", Type: query.Type_INT64, Charset: mysql.CharacterSetUtf8, ColumnLength: 20},
+		{Name: "uint8", Type: query.Type_UINT8, Charset: mysql.CharacterSetBinary, ColumnLength: 3, Flags: uint32(query.MySqlFlag_UNSIGNED_FLAG)},
+		{Name: "int8", Type: query.Type_INT8, Charset: mysql.CharacterSetBinary, ColumnLength: 4},
+		{Name: "uint16", Type: query.Type_UINT16, Charset: mysql.CharacterSetBinary, ColumnLength: 5, Flags: uint32(query.MySqlFlag_UNSIGNED_FLAG)},
+		{Name: "int16", Type: query.Type_INT16, Charset: mysql.CharacterSetBinary, ColumnLength: 6},
+		{Name: "uint24", Type: query.Type_UINT24, Charset: mysql.CharacterSetBinary, ColumnLength: 8, Flags: uint32(query.MySqlFlag_UNSIGNED_FLAG)},
+		{Name: "int24", Type: query.Type_INT24, Charset: mysql.CharacterSetBinary, ColumnLength: 9},
+		{Name: "uint32", Type: query.Type_UINT32, Charset: mysql.CharacterSetBinary, ColumnLength: 10, Flags: uint32(query.MySqlFlag_UNSIGNED_FLAG)},
+		{Name: "int32", Type: query.Type_INT32, Charset: mysql.CharacterSetBinary, ColumnLength: 11},
+		{Name: "uint64", Type: query.Type_UINT64, Charset: mysql.CharacterSetBinary, ColumnLength: 20, Flags: uint32(query.MySqlFlag_UNSIGNED_FLAG)},
+		{Name: "int64", Type: query.Type_INT64, Charset: mysql.CharacterSetBinary, ColumnLength: 20},
 
 		// Floating Point and Decimal Types
-		{Name: "float32", Type: query.Type_FLOAT32, Charset: mysql.CharacterSetUtf8, ColumnLength: 12},
-		{Name: "float64", Type: query.Type_FLOAT64, Charset: mysql.CharacterSetUtf8, ColumnLength: 22},
-		{Name: "decimal10_0", Type: query.Type_DECIMAL, Charset: mysql.CharacterSetUtf8, ColumnLength: 11},
-		{Name: "decimal60_30", Type: query.Type_DECIMAL, Charset: mysql.CharacterSetUtf8, ColumnLength: 62},
+		{Name: "float32", Type: query.Type_FLOAT32, Charset: mysql.CharacterSetBinary, ColumnLength: 12, Decimals: 31},
+		{Name: "float64", Type: query.Type_FLOAT64, Charset: mysql.CharacterSetBinary, ColumnLength: 22, Decimals: 31},
+		{Name: "decimal10_0", Type: query.Type_DECIMAL, Charset: mysql.CharacterSetBinary, ColumnLength: 11},
+		{Name: "decimal60_30", Type: query.Type_DECIMAL, Charset: mysql.CharacterSetBinary, ColumnLength: 62, Decimals: 30},
 
 		// Char, Binary, and Bit Types
-		{Name: "varchar50", Type: query.Type_VARCHAR, Charset: mysql.CharacterSetUtf8, ColumnLength: 50 * 4},
-		{Name: "varbinary12345", Type: query.Type_VARBINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 12345},
-		{Name: "binary123", Type: query.Type_BINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 123},
-		{Name: "char123", Type: query.Type_CHAR, Charset: mysql.CharacterSetUtf8, ColumnLength: 123 * 4},
-		{Name: "bit12", Type: query.Type_BIT, Charset: mysql.CharacterSetUtf8, ColumnLength: 12},
+		{Name: "varchar50", Type: query.Type_VARCHAR, Charset: uint32(sql.Collation_Default), ColumnLength: 50 * 4},
+		{Name: "varbinary12345", Type: query.Type_VARBINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 12345, Flags: uint32(query.MySqlFlag_BINARY_FLAG)},
+		{Name: "binary123", Type: query.Type_BINARY, Charset: mysql.CharacterSetBinary, ColumnLength: 123, Flags: uint32(query.MySqlFlag_BINARY_FLAG)},
+		{Name: "char123", Type: query.Type_CHAR, Charset: uint32(sql.Collation_Default), ColumnLength: 123 * 4},
+		{Name: "bit12", Type: query.Type_BIT, Charset: mysql.CharacterSetBinary, ColumnLength: 12, Flags: uint32(query.MySqlFlag_UNSIGNED_FLAG)},
 
 		// Dates
-		{Name: "datetime", Type: query.Type_DATETIME, Charset: mysql.CharacterSetUtf8, ColumnLength: 26},
-		{Name: "timestamp", Type: query.Type_TIMESTAMP, Charset: mysql.CharacterSetUtf8, ColumnLength: 26},
-		{Name: "date", Type: query.Type_DATE, Charset: mysql.CharacterSetUtf8, ColumnLength: 10},
-		{Name: "time", Type: query.Type_TIME, Charset: mysql.CharacterSetUtf8, ColumnLength: 17},
-		{Name: "year", Type: query.Type_YEAR, Charset: mysql.CharacterSetUtf8, ColumnLength: 4},
+		{Name: "datetime", Type: query.Type_DATETIME, Charset: mysql.CharacterSetBinary, ColumnLength: 26, Decimals: 6, Flags: uint32(query.MySqlFlag_BINARY_FLAG)},
+		{Name: "timestamp", Type: query.Type_TIMESTAMP, Charset: mysql.CharacterSetBinary, ColumnLength: 26, Decimals: 6},
+		{Name: "date", Type: query.Type_DATE, Charset: mysql.CharacterSetBinary, ColumnLength: 10, Flags: uint32(query.MySqlFlag_BINARY_FLAG)},
+		{Name: "time", Type: query.Type_TIME, Charset: mysql.CharacterSetBinary, ColumnLength: 17, Decimals: 6, Flags: uint32(query.MySqlFlag_BINARY_FLAG)},
+		{Name: "year", Type: query.Type_YEAR, Charset: mysql.CharacterSetBinary, ColumnLength: 4, Flags: uint32(query.MySqlFlag_UNSIGNED_FLAG)},
 
 		// Set and Enum Types
-		{Name: "set", Type: query.Type_SET, Charset: mysql.CharacterSetUtf8, ColumnLength: 72},
-		{Name: "enum", Type: query.Type_ENUM, Charset: mysql.CharacterSetUtf8, ColumnLength: 20},
+		{Name: "set", Type: query.Type_SET, Charset: uint32(sql.Collation_Default), ColumnLength: 72, Flags: uint32(query.MySqlFlag_SET_FLAG)},
+		{Name: "enum", Type: query.Type_ENUM, Charset: uint32(sql.Collation_Default), ColumnLength: 20, Flags: uint32(query.MySqlFlag_ENUM_FLAG)},
+
+		// Column Attributes
+		{Name: "pk", Type: query.Type_INT64, Charset: mysql.CharacterSetBinary, ColumnLength: 20, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG | query.MySqlFlag_PRI_KEY_FLAG | query.MySqlFlag_AUTO_INCREMENT_FLAG)},
+		{Name: "notnull", Type: query.Type_INT64, Charset: mysql.CharacterSetBinary, ColumnLength: 20, Flags: uint32(query.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "latin1", Type: query.Type_VARCHAR, Charset: uint32(sql.Collation_utf8mb4_0900_ai_ci), ColumnLength: 10 * 4},
 	}
 
 	require.Equal(len(schema), len(expected))
 
-	fields := schemaToFields(schema)
+	fields := schemaToFields(sql.NewEmptyContext(), schema)
 	for i := 0; i < len(fields); i++ {
 		t.Run(schema[i].Name, func(t *testing.T) {
 			assert.Equal(t, expected[i], fields[i])
@@ -893,6 +1130,14 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 			"foo",
 		),
 	}
+	handler.NewConnection(dummyConn)
+	handler.ComInitDB(dummyConn, "test")
+	for _, q := range []string{
+		"CREATE TABLE keyed (pk int primary key)",
+		"INSERT INTO keyed VALUES (1), (2), (3), (4), (5), (6), (7), (8), (9), (10)",
+	} {
+		require.NoError(t, handler.ComQuery(dummyConn, q, func(res *sqltypes.Result, more bool) error { return nil }))
+	}
 
 	tests := []struct {
 		name                 string
@@ -900,6 +1145,7 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 		conn                 *mysql.Conn
 		query                string
 		expectedRowsAffected uint64
+		expectedInfo         string
 	}{
 		{
 			name:                 "Update query should return number of rows matched instead of rows affected",
@@ -907,6 +1153,15 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 			conn:                 dummyConn,
 			query:                "UPDATE test set c1 = c1 where c1 < 10",
 			expectedRowsAffected: uint64(10),
+			expectedInfo:         "Rows matched: 10  Changed: 0  Warnings: 0",
+		},
+		{
+			name:                 "Update join query should return number of rows matched instead of rows affected",
+			handler:              handler,
+			conn:                 dummyConn,
+			query:                "UPDATE keyed t1 JOIN keyed t2 ON t1.pk = t2.pk SET t1.pk = t2.pk WHERE t1.pk < 10",
+			expectedRowsAffected: uint64(9),
+			expectedInfo:         "Rows matched: 9  Changed: 0  Warnings: 0",
 		},
 		{
 			name:                 "INSERT ON UPDATE returns +1 for every row that already exists",
@@ -914,6 +1169,15 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 			conn:                 dummyConn,
 			query:                "INSERT INTO test VALUES (1), (2), (3) ON DUPLICATE KEY UPDATE c1=c1",
 			expectedRowsAffected: uint64(3),
+			expectedInfo:         "Records: 3  Duplicates: 0  Warnings: 0",
+		},
+		{
+			name:                 "INSERT ON UPDATE of a table with a primary key reports existing rows as duplicates",
+			handler:              handler,
+			conn:                 dummyConn,
+			query:                "INSERT INTO keyed VALUES (1), (2), (100) ON DUPLICATE KEY UPDATE pk=pk",
+			expectedRowsAffected: uint64(3),
+			expectedInfo:         "Records: 3  Duplicates: 2  Warnings: 0",
 		},
 		{
 			name:                 "SQL_CALC_ROWS should not affect CLIENT_FOUND_ROWS output",
@@ -928,6 +1192,7 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 			conn:                 dummyConn,
 			query:                "INSERT into test VALUES (10000),(10001),(10002)",
 			expectedRowsAffected: uint64(3),
+			expectedInfo:         "Records: 3  Duplicates: 0  Warnings: 0",
 		},
 	}
 
@@ -935,13 +1200,16 @@ func TestHandlerFoundRowsCapabilities(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			handler.ComInitDB(test.conn, "test")
 			var rowsAffected uint64
+			var info string
 			err := handler.ComQuery(test.conn, test.query, func(res *sqltypes.Result, more bool) error {
 				rowsAffected = uint64(res.RowsAffected)
+				info = res.Info
 				return nil
 			})
 
 			require.NoError(t, err)
 			require.Equal(t, test.expectedRowsAffected, rowsAffected)
+			require.Equal(t, test.expectedInfo, info)
 		})
 	}
 }
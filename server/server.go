@@ -57,6 +57,7 @@ func NewServer(cfg Config, e *sqle.Engine, sb SessionBuilder, listener ServerEve
 		disableMultiStmts: cfg.DisableClientMultiStatements,
 		maxLoggedQueryLen: cfg.MaxLoggedQueryLen,
 		encodeLoggedQuery: cfg.EncodeLoggedQuery,
+		resultBufferRows:  cfg.ResultBufferRows,
 		sel:               listener,
 	}
 	//handler = NewHandler_(e, sm, cfg.ConnReadTimeout, cfg.DisableClientMultiStatements, cfg.MaxLoggedQueryLen, cfg.EncodeLoggedQuery, listener)
@@ -87,6 +88,7 @@ func NewValidatingServer(
 		disableMultiStmts: cfg.DisableClientMultiStatements,
 		maxLoggedQueryLen: cfg.MaxLoggedQueryLen,
 		encodeLoggedQuery: cfg.EncodeLoggedQuery,
+		resultBufferRows:  cfg.ResultBufferRows,
 		sel:               listener,
 	}
 
@@ -108,6 +110,10 @@ func newServerFromHandler(cfg Config, e *sqle.Engine, sm *SessionManager, handle
 		cfg.MaxConnections = 0
 	}
 
+	if err := e.RunInitScripts(e.NewBootstrapContext()); err != nil {
+		return nil, err
+	}
+
 	var unixSocketInUse error
 	l, err := NewListener(cfg.Protocol, cfg.Address, cfg.Socket)
 	if err != nil {
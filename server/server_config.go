@@ -74,6 +74,10 @@ type Config struct {
 	// If true, queries will be logged as base64 encoded strings.
 	// If false (default behavior), queries will be logged as strings, but newlines and tabs will be replaced with spaces.
 	EncodeLoggedQuery bool
+	// ResultBufferRows is the number of rows of a query's result read ahead of the rows sent to the client. Reading
+	// rows pauses once this many are waiting for a slow client, so results aren't held in memory in full. It defaults
+	// to 512.
+	ResultBufferRows int
 }
 
 func (c Config) NewConfig() (Config, error) {
@@ -50,14 +50,21 @@ type WindowFunction interface {
 	StartPartition(*Context, WindowInterval, WindowBuffer) error
 	// DefaultFramer returns a new instance of the default WindowFramer for a particular aggregation
 	DefaultFramer() WindowFramer
-	// NewSlidingFrameInterval is updates the function's internal aggregation state for the next
-	// Compute call using three WindowInterval: added, dropped, and current.
-	// TODO: implement sliding window interface in aggregation functions and windowBlockIter
-	// NewSlidingFrameInterval(added, dropped WindowInterval)
 	// Compute returns an aggregation result for a given interval and buffer
 	Compute(*Context, WindowInterval, WindowBuffer) interface{}
 }
 
+// WindowSlidingFunction is a WindowFunction that aggregates its frames incrementally. The frames of the rows of a
+// partition only move forward, so rather than aggregating every row of each frame, the function is told which rows
+// entered and left the frame since the previous one.
+type WindowSlidingFunction interface {
+	WindowFunction
+
+	// NewSlidingFrameInterval updates the function's internal aggregation state for the next Compute call, adding the
+	// rows of the [added] interval to the frame and dropping those of the [dropped] interval from it.
+	NewSlidingFrameInterval(ctx *Context, added, dropped WindowInterval, buf WindowBuffer) error
+}
+
 // WindowAdaptableExpression is an Expression that can be executed as a window aggregation
 type WindowAdaptableExpression interface {
 	Expression
@@ -81,10 +88,6 @@ type WindowFramer interface {
 	LastIdx() int
 	// Interval returns the current frame as a WindowInterval
 	Interval() (WindowInterval, error)
-	// SlidingInterval returns three WindowIntervals: the current frame, dropped range since the
-	// last frame, and added range since the last frame.
-	// TODO: implement sliding window interface in framers, windowBlockIter, and aggregation functions
-	// SlidingInterval(ctx Context) (WindowInterval, WindowInterval, WindowInterval)
 }
 
 // WindowFrame describe input bounds for an aggregation function
@@ -49,14 +49,14 @@ func flattenAggregationExpressions(ctx *sql.Context, a *Analyzer, n sql.Node, sc
 				return n, transform.SameTree, nil
 			}
 
-			return flattenedGroupBy(ctx, scope, n.SelectedExprs, n.GroupByExprs, n.Child)
+			return flattenedGroupBy(ctx, scope, n.SelectedExprs, n.GroupByExprs, n.Modifier, n.Child)
 		default:
 			return n, transform.SameTree, nil
 		}
 	})
 }
 
-func flattenedGroupBy(ctx *sql.Context, scope *Scope, projection, grouping []sql.Expression, child sql.Node) (sql.Node, transform.TreeIdentity, error) {
+func flattenedGroupBy(ctx *sql.Context, scope *Scope, projection, grouping []sql.Expression, modifier plan.GroupingModifier, child sql.Node) (sql.Node, transform.TreeIdentity, error) {
 	newProjection, newAggregates, allSame, err := replaceAggregatesWithGetFieldProjections(ctx, scope, projection)
 	if err != nil {
 		return nil, transform.SameTree, err
@@ -66,7 +66,7 @@ func flattenedGroupBy(ctx *sql.Context, scope *Scope, projection, grouping []sql
 	}
 	return plan.NewProject(
 		newProjection,
-		plan.NewGroupBy(newAggregates, grouping, child),
+		plan.NewGroupBy(newAggregates, grouping, child).WithModifier(modifier),
 	), transform.NewTree, nil
 }
 
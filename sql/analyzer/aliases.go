@@ -103,7 +103,7 @@ func getTableAliases(n sql.Node, scope *Scope) (TableAliases, error) {
 			return false
 		case *plan.Procedure:
 			return false
-		case *plan.Block:
+		case *plan.Block, *plan.BeginEndBlock, *plan.TriggerBeginEndBlock:
 			// blocks should not be parsed as a whole, just their statements individually
 			for _, child := range node.Children() {
 				_, analysisErr = getTableAliases(child, recScope)
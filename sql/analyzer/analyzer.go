@@ -448,6 +448,40 @@ func newInsertSourceSelector(sel RuleSelector) RuleSelector {
 	}
 }
 
+// optimizerSwitchRules are the rules of the optimizations that the flags of optimizer_switch turn off.
+var optimizerSwitchRules = map[string][]RuleId{
+	"derived_merge":              {mergeDerivedTablesId},
+	"derived_condition_pushdown": {pushdownSubqueryAliasFiltersId},
+	"index_condition_pushdown":   {pushdownIndexConditionsId},
+	"semijoin":                   {transformJoinApplyId, hoistSelectExistsId},
+}
+
+// newOptimizerSwitchSelector returns the rule selector given without the rules of the optimizations turned off by the
+// optimizer_switch of the session.
+func newOptimizerSwitchSelector(ctx *sql.Context, sel RuleSelector) RuleSelector {
+	var off map[RuleId]struct{}
+	for flag, ids := range optimizerSwitchRules {
+		if sql.OptimizerSwitchOn(ctx, flag) {
+			continue
+		}
+		if off == nil {
+			off = make(map[RuleId]struct{})
+		}
+		for _, id := range ids {
+			off[id] = struct{}{}
+		}
+	}
+	if off == nil {
+		return sel
+	}
+	return func(id RuleId) bool {
+		if _, ok := off[id]; ok {
+			return false
+		}
+		return sel(id)
+	}
+}
+
 // Analyze applies the transformation rules to the node given. In the case of an error, the last successfully
 // transformed node is returned along with the error.
 func (a *Analyzer) Analyze(ctx *sql.Context, n sql.Node, scope *Scope) (sql.Node, error) {
@@ -520,6 +554,7 @@ func postPrepareRuleSelector(id RuleId) bool {
 
 		// OnceAfterDefault
 		pushdownFiltersId,
+		pushdownIndexConditionsId,
 		subqueryIndexesId,
 		stripTableNameInDefaultsId,
 		resolvePreparedInsertId,
@@ -607,8 +642,14 @@ func (a *Analyzer) analyzeWithSelector(ctx *sql.Context, n sql.Node, scope *Scop
 		err     error
 	)
 	a.Log("starting analysis of node of type: %T", n)
+	ruleSelector = newOptimizerSwitchSelector(ctx, ruleSelector)
 	for _, batch := range a.Batches {
 		if batchSelector(batch.Desc) {
+			// The rules from once-after on optimize the resolved plan of the query. Subqueries are analyzed with a
+			// scope, as part of the stage of their query.
+			if batch.Desc == "once-after" && scope == nil {
+				ctx.QueryProfiler().Stage(sql.ProfileStageOptimizing)
+			}
 			a.PushDebugContext(batch.Desc)
 			n, same, err = batch.Eval(ctx, a, n, scope, ruleSelector)
 			allSame = allSame && same
@@ -54,6 +54,9 @@ func applyIndexesFromOuterScope(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 		// We can't push any indexes down a branch that have already had an index pushed down it
 		case *plan.IndexedTableAccess:
 			return false
+		// Nor to the destination of an insert, which isn't read
+		case *plan.InsertInto:
+			return false
 		}
 		return true
 	}
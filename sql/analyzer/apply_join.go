@@ -157,6 +157,9 @@ func transformJoinApply(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 				if err != nil {
 					return n, transform.SameTree, err
 				}
+				if m.op == plan.JoinTypeAnti {
+					filter = nullAwareAntiJoinFilter(filter.(expression.Comparer))
+				}
 				filter, _, err = FixFieldIndexes(scope, a, condSch, filter)
 				if err != nil {
 					return n, transform.SameTree, err
@@ -180,6 +183,46 @@ func transformJoinApply(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 	return ret, transform.TreeIdentity(applyId == 0), nil
 }
 
+// nullAwareAntiJoinFilter returns the condition of an anti join that returns the rows for which the comparison given
+// between a value and the values of a subquery is false for all the values, as NOT IN and the negation of a comparison
+// with a scalar subquery do. These are null, rather than true, when the comparison is null for a value, so the anti
+// join excludes the rows the comparison is null for, as well as those it's true for, unless neither side of the
+// comparison can be null.
+func nullAwareAntiJoinFilter(cmp expression.Comparer) sql.Expression {
+	if !mayBeNull(cmp.Left()) && !mayBeNull(cmp.Right()) {
+		return cmp
+	}
+	return expression.NewNot(expression.NewIsFalse(cmp))
+}
+
+// nullAwareEquality returns the equality of an anti join condition built by nullAwareAntiJoinFilter that excludes the
+// rows it's null for.
+func nullAwareEquality(e sql.Expression) (*expression.Equals, bool) {
+	not, ok := e.(*expression.Not)
+	if !ok {
+		return nil, false
+	}
+	isFalse, ok := not.Child.(*expression.IsTrue)
+	if !ok || !isFalse.Inverted() {
+		return nil, false
+	}
+	eq, ok := isFalse.Child.(*expression.Equals)
+	return eq, ok
+}
+
+// mayBeNull returns whether the expression given, or any element of it if it's a tuple, can be null.
+func mayBeNull(e sql.Expression) bool {
+	if tup, ok := e.(expression.Tuple); ok {
+		for _, e := range tup {
+			if mayBeNull(e) {
+				return true
+			}
+		}
+		return false
+	}
+	return e.IsNullable()
+}
+
 // simplifySubqExpr converts a subquery expression into a *plan.TableAlias
 // for scopes with only tables and getField projections or the original
 // node failing simplification.
@@ -70,6 +70,22 @@ func assignCatalog(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 			nc := *node
 			nc.Catalog = a.Catalog
 			return &nc, transform.NewTree, nil
+		case *plan.RenameDB:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, transform.NewTree, nil
+		case *plan.Flush:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, transform.NewTree, nil
+		case *plan.ChecksumTable:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, transform.NewTree, nil
+		case *plan.OptimizeTable:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, transform.NewTree, nil
 		case *plan.LockTables:
 			nc := *node
 			nc.Catalog = a.Catalog
@@ -78,6 +94,31 @@ func assignCatalog(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 			nc := *node
 			nc.Catalog = a.Catalog
 			return &nc, transform.NewTree, nil
+		case *plan.XA:
+			nc := *node
+			nc.Registry = a.Catalog.XATransactions
+			return &nc, transform.NewTree, nil
+		case *plan.HandlerOpen:
+			nc := *node
+			nc.Catalog = a.Catalog
+			nc.Registry = a.Catalog.Handlers
+			return &nc, transform.NewTree, nil
+		case *plan.HandlerClose:
+			nc := *node
+			nc.Registry = a.Catalog.Handlers
+			return &nc, transform.NewTree, nil
+		case *plan.HandlerRead:
+			nc := *node
+			nc.Catalog = a.Catalog
+			nc.Registry = a.Catalog.Handlers
+			// The rows read have the schema of the table of the handler, if the session has opened it. Otherwise, the
+			// statement fails when it's executed.
+			if handler, err := a.Catalog.Handlers.Get(ctx.ID(), node.Name); err == nil {
+				if table, _, err := a.Catalog.Table(ctx, handler.Database, handler.Table); err == nil {
+					nc.TableSchema = table.Schema()
+				}
+			}
+			return &nc, transform.NewTree, nil
 		case *plan.ResolvedTable:
 			ct, ok := node.Table.(sql.CatalogTable)
 			if ok {
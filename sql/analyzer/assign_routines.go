@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
 )
@@ -24,9 +25,9 @@ import (
 type RoutineTable interface {
 	sql.Table
 
-	// AssignProcedures assigns a map of db-procedures to the routines table.
+	// AssignProcedures assigns a map of db-procedures to the routines table. The procedures of each database include
+	// its stored functions, which have a return type.
 	AssignProcedures(p map[string][]*plan.Procedure) sql.Table
-	// TODO: also should assign FUNCTIONS
 }
 
 // assignRoutines sets the catalog in the required nodes.
@@ -56,6 +57,13 @@ func assignRoutines(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 				if scope != nil && scope.procedures != nil {
 					pm[db.Name()] = scope.procedures.AllForDatabase(db.Name())
 				}
+				if fdb, ok := db.(sql.StoredFunctionDatabase); ok && ct != nil {
+					functions, err := loadStoredFunctions(ctx, fdb)
+					if err != nil {
+						return nil, transform.SameTree, err
+					}
+					pm[db.Name()] = append(pm[db.Name()], functions...)
+				}
 			}
 
 			if ok {
@@ -68,3 +76,26 @@ func assignRoutines(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 		}
 	})
 }
+
+// loadStoredFunctions returns the stored functions of the database given, which aren't analyzed.
+func loadStoredFunctions(ctx *sql.Context, db sql.StoredFunctionDatabase) ([]*plan.Procedure, error) {
+	details, err := db.GetStoredFunctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	functions := make([]*plan.Procedure, len(details))
+	for i, function := range details {
+		parsed, err := parse.Parse(ctx, function.CreateStatement)
+		if err != nil {
+			return nil, err
+		}
+		cp, ok := parsed.(*plan.CreateProcedure)
+		if !ok || !cp.IsFunction() {
+			return nil, sql.ErrProcedureCreateStatementInvalid.New(function.CreateStatement)
+		}
+		functions[i] = cp.Procedure
+		functions[i].CreatedAt = function.CreatedAt
+		functions[i].ModifiedAt = function.ModifiedAt
+	}
+	return functions, nil
+}
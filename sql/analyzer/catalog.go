@@ -18,22 +18,30 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/internal/similartext"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/information_schema"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 type Catalog struct {
 	MySQLDb    *mysql_db.MySQLDb
 	InfoSchema sql.Database
 
-	Provider         sql.DatabaseProvider
+	Provider sql.DatabaseProvider
+	// XATransactions keeps track of the XA transactions of all sessions
+	XATransactions *sql.XARegistry
+	// Handlers keeps track of the tables opened by the HANDLER statements of all sessions
+	Handlers         *sql.HandlerRegistry
 	builtInFunctions function.Registry
 	mu               sync.RWMutex
 	locks            sessionLocks
+	rowPolicies      map[string]sql.RowPolicy
+	columnMasks      map[string]sql.ColumnMask
 }
 
 var _ sql.Catalog = (*Catalog)(nil)
@@ -55,6 +63,8 @@ func NewCatalog(provider sql.DatabaseProvider) *Catalog {
 		Provider:         provider,
 		builtInFunctions: function.NewRegistry(),
 		locks:            make(sessionLocks),
+		XATransactions:   sql.NewXARegistry(),
+		Handlers:         sql.NewHandlerRegistry(),
 	}
 }
 
@@ -116,6 +126,30 @@ func (c *Catalog) RemoveDatabase(ctx *sql.Context, dbName string) error {
 	}
 }
 
+// RenameDatabase renames the database named |oldName| to |newName|.
+func (c *Catalog) RenameDatabase(ctx *sql.Context, oldName, newName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	renamer, ok := c.Provider.(sql.DatabaseRenamer)
+	if !ok {
+		return sql.ErrRenameDatabaseNotSupported.New(oldName)
+	}
+	return renamer.RenameDatabase(ctx, oldName, newName)
+}
+
+// Flush passes the FLUSH statement with the |option| and |tables| given along to the provider, if it handles them.
+func (c *Catalog) Flush(ctx *sql.Context, option sql.FlushOption, tables []sql.DbTable) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	handler, ok := c.Provider.(sql.FlushHandler)
+	if !ok {
+		return nil
+	}
+	return handler.Flush(ctx, option, tables)
+}
+
 func (c *Catalog) HasDB(ctx *sql.Context, db string) bool {
 	db = strings.ToLower(db)
 	if db == "information_schema" {
@@ -193,6 +227,38 @@ func (c *Catalog) UnlockTables(ctx *sql.Context, id uint32) error {
 	return nil
 }
 
+// DropTemporaryTables drops every temporary table belonging to the session of the context given. Called when the
+// session ends, since temporary tables must not outlive it.
+func (c *Catalog) DropTemporaryTables(ctx *sql.Context) error {
+	var errors []string
+	for _, db := range c.Provider.AllDatabases(ctx) {
+		tempDb, ok := db.(sql.TemporaryTableDatabase)
+		if !ok {
+			continue
+		}
+		dropper, ok := db.(sql.TableDropper)
+		if !ok {
+			continue
+		}
+
+		tables, err := tempDb.GetAllTemporaryTables(ctx)
+		if err != nil {
+			errors = append(errors, err.Error())
+			continue
+		}
+		for _, table := range tables {
+			if err = dropper.DropTable(ctx, table.Name()); err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("error dropping temporary tables for %d: %s", ctx.Session.ID(), strings.Join(errors, ", "))
+	}
+	return nil
+}
+
 // Table returns the table in the given database with the given name.
 func (c *Catalog) Table(ctx *sql.Context, dbName, tableName string) (sql.Table, sql.Database, error) {
 	c.mu.RLock()
@@ -224,9 +290,14 @@ func (c *Catalog) TableAsOf(ctx *sql.Context, dbName, tableName string, asOf int
 		return nil, nil, err
 	}
 
+	// The past versions of the tables of databases that aren't versioned are those of their system-versioned tables
+	underlying := db
+	if privilegedDb, ok := db.(mysql_db.PrivilegedDatabase); ok {
+		underlying = privilegedDb.Unwrap()
+	}
 	versionedDb, ok := db.(sql.VersionedDatabase)
-	if !ok {
-		return nil, nil, sql.ErrAsOfNotSupported.New(tableName)
+	if _, versioned := underlying.(sql.VersionedDatabase); !ok || !versioned {
+		return systemVersionedTableAsOf(ctx, db, tableName, asOf)
 	}
 
 	tbl, ok, err := versionedDb.GetTableInsensitiveAsOf(ctx, tableName, asOf)
@@ -240,6 +311,34 @@ func (c *Catalog) TableAsOf(ctx *sql.Context, dbName, tableName string, asOf int
 	return tbl, versionedDb, nil
 }
 
+// systemVersionedTableAsOf returns the table with the name given in the database given as of the time given, if it's
+// a system-versioned table.
+func systemVersionedTableAsOf(ctx *sql.Context, db sql.Database, tableName string, asOf interface{}) (sql.Table, sql.Database, error) {
+	tbl, ok, err := db.GetTableInsensitive(ctx, tableName)
+	if err != nil {
+		return nil, nil, err
+	} else if !ok {
+		return nil, nil, suggestSimilarTables(db, ctx, tableName)
+	}
+
+	versioned, ok := tbl.(sql.VersionedTable)
+	if !ok {
+		return nil, nil, sql.ErrAsOfNotSupported.New(tableName)
+	}
+	if _, ok := versioned.SystemTimePeriod(); !ok {
+		return nil, nil, sql.ErrAsOfNotSupported.New(tableName)
+	}
+	t, err := types.Datetime.Convert(asOf)
+	if err != nil {
+		return nil, nil, err
+	}
+	tbl, err = versioned.AsOf(ctx, t.(time.Time))
+	if err != nil {
+		return nil, nil, err
+	}
+	return tbl, db, nil
+}
+
 // RegisterFunction registers the functions given, adding them to the built-in functions.
 // Integrators with custom functions should typically use the FunctionProvider interface instead.
 func (c *Catalog) RegisterFunction(ctx *sql.Context, fns ...sql.Function) {
@@ -251,6 +350,76 @@ func (c *Catalog) RegisterFunction(ctx *sql.Context, fns ...sql.Function) {
 	}
 }
 
+// RegisterRowPolicy registers the row policy given for the table named, replacing any row policy registered for that
+// table before. A nil policy removes the row policy of the table.
+func (c *Catalog) RegisterRowPolicy(db, table string, policy sql.RowPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := rowPolicyKey(db, table)
+	if policy == nil {
+		delete(c.rowPolicies, key)
+		return
+	}
+	if c.rowPolicies == nil {
+		c.rowPolicies = make(map[string]sql.RowPolicy)
+	}
+	c.rowPolicies[key] = policy
+}
+
+// RowPolicy returns the row policy registered for the table named, or nil if it has none.
+func (c *Catalog) RowPolicy(db, table string) sql.RowPolicy {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.rowPolicies[rowPolicyKey(db, table)]
+}
+
+// hasRowPolicies returns whether a row policy is registered for any table.
+func (c *Catalog) hasRowPolicies() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.rowPolicies) > 0
+}
+
+func rowPolicyKey(db, table string) string {
+	return strings.ToLower(db) + "." + strings.ToLower(table)
+}
+
+// RegisterColumnMask registers the column mask given for the column named, replacing any column mask registered for
+// that column before. A nil mask removes the column mask of the column.
+func (c *Catalog) RegisterColumnMask(db, table, column string, mask sql.ColumnMask) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := columnMaskKey(db, table, column)
+	if mask == nil {
+		delete(c.columnMasks, key)
+		return
+	}
+	if c.columnMasks == nil {
+		c.columnMasks = make(map[string]sql.ColumnMask)
+	}
+	c.columnMasks[key] = mask
+}
+
+// ColumnMask returns the column mask registered for the column named, or nil if it has none.
+func (c *Catalog) ColumnMask(db, table, column string) sql.ColumnMask {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.columnMasks[columnMaskKey(db, table, column)]
+}
+
+// hasColumnMasks returns whether a column mask is registered for any column.
+func (c *Catalog) hasColumnMasks() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.columnMasks) > 0
+}
+
+func columnMaskKey(db, table, column string) string {
+	return rowPolicyKey(db, table) + "." + strings.ToLower(column)
+}
+
 // Function returns the function with the name given, or sql.ErrFunctionNotFound if it doesn't exist
 func (c *Catalog) Function(ctx *sql.Context, name string) (sql.Function, error) {
 	if fp, ok := c.Provider.(sql.FunctionProvider); ok {
@@ -137,14 +137,15 @@ func loadChecks(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel Rul
 				return node, transform.SameTree, nil
 			}
 
-			var err error
-			nn.Checks, err = loadChecksFromTable(ctx, table)
+			checks, err := loadChecksFromTable(ctx, table)
 			if err != nil {
 				return nil, transform.SameTree, err
 			}
-			if len(nn.Checks) == 0 {
+			if len(checks) == 0 {
 				return node, transform.SameTree, nil
 			}
+			// Check options of any view this write was issued through are kept alongside the table's checks
+			nn.Checks = append(nn.Checks.ViewCheckOptions(), checks...)
 			return &nn, transform.NewTree, nil
 		case *plan.Update:
 			nn := *node
@@ -159,14 +160,15 @@ func loadChecks(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel Rul
 				return node, transform.SameTree, nil
 			}
 
-			var err error
-			nn.Checks, err = loadChecksFromTable(ctx, table)
+			checks, err := loadChecksFromTable(ctx, table)
 			if err != nil {
 				return nil, transform.SameTree, err
 			}
-			if len(nn.Checks) == 0 {
+			if len(checks) == 0 {
 				return node, transform.SameTree, nil
 			}
+			// Check options of any view this write was issued through are kept alongside the table's checks
+			nn.Checks = append(nn.Checks.ViewCheckOptions(), checks...)
 			return &nn, transform.NewTree, nil
 		case *plan.ShowCreateTable:
 			nn := *node
@@ -0,0 +1,187 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// resolveCheckTables provides the catalog to CHECK TABLE nodes, and the queries verifying the constraints of each of
+// their tables: that NOT NULL columns have no NULL values, that the rows satisfy the enforced check constraints, that
+// unique indexes have no duplicate keys, and that the rows of foreign keys have a parent row. The queries are built
+// from the definitions of the constraints, rather than from the enforcement done by writes, so that they catch rows
+// written while the constraints weren't enforced, such as with foreign_key_checks disabled.
+func resolveCheckTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	ct, ok := n.(*plan.CheckTable)
+	if !ok || ct.Resolved() {
+		return n, transform.SameTree, nil
+	}
+
+	nc := *ct
+	nc.Catalog = a.Catalog
+	nc.Verifications = make([][]plan.TableVerification, len(ct.Tables))
+	for i, name := range ct.Tables {
+		if name.Db == "" {
+			name.Db = ctx.GetCurrentDatabase()
+			if name.Db == "" {
+				return nil, transform.SameTree, sql.ErrNoDatabaseSelected.New()
+			}
+		}
+		// Tables that don't exist are reported by the statement when it's executed
+		table, _, err := a.Catalog.Table(ctx, name.Db, name.Table)
+		if sql.ErrTableNotFound.Is(err) || sql.ErrDatabaseNotFound.Is(err) {
+			continue
+		} else if err != nil {
+			return nil, transform.SameTree, err
+		}
+
+		queries, err := tableVerificationQueries(ctx, name.Db, table)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		for _, q := range queries {
+			query, err := parse.Parse(ctx, q.query)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			query, err = a.Analyze(ctx, query, scope)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			nc.Verifications[i] = append(nc.Verifications[i], plan.TableVerification{
+				Query:   stripQueryWrappers(query),
+				Message: q.message,
+			})
+		}
+	}
+	return &nc, transform.NewTree, nil
+}
+
+type tableVerificationQuery struct {
+	query   string
+	message string
+}
+
+// tableVerificationQueries returns the queries verifying the constraints of the table given, which return a row if the
+// constraint they verify is violated.
+func tableVerificationQueries(ctx *sql.Context, db string, table sql.Table) ([]tableVerificationQuery, error) {
+	from := quoteIdentifier(db) + "." + quoteIdentifier(table.Name())
+	var queries []tableVerificationQuery
+
+	for _, col := range table.Schema() {
+		if col.Nullable {
+			continue
+		}
+		queries = append(queries, tableVerificationQuery{
+			query:   fmt.Sprintf("SELECT 1 FROM %s WHERE %s IS NULL LIMIT 1", from, quoteIdentifier(col.Name)),
+			message: fmt.Sprintf("Column '%s' contains NULL values", col.Name),
+		})
+	}
+
+	if checkTable, ok := table.(sql.CheckTable); ok {
+		checks, err := checkTable.GetChecks(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, check := range checks {
+			if !check.Enforced {
+				continue
+			}
+			queries = append(queries, tableVerificationQuery{
+				query:   fmt.Sprintf("SELECT 1 FROM %s WHERE NOT (%s) LIMIT 1", from, check.CheckExpression),
+				message: fmt.Sprintf("Check constraint '%s' is violated", check.Name),
+			})
+		}
+	}
+
+	if iat, ok := table.(sql.IndexAddressableTable); ok {
+		indexes, err := iat.GetIndexes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, idx := range indexes {
+			if !idx.IsUnique() || idx.IsSpatial() {
+				continue
+			}
+			prefixLengths := idx.PrefixLengths()
+			cols := make([]string, len(idx.Expressions()))
+			notNull := make([]string, len(cols))
+			for j, expr := range idx.Expressions() {
+				col := quoteIdentifier(strings.TrimPrefix(expr, idx.Table()+"."))
+				notNull[j] = col + " IS NOT NULL"
+				if j < len(prefixLengths) && prefixLengths[j] > 0 {
+					col = fmt.Sprintf("LEFT(%s, %d)", col, prefixLengths[j])
+				}
+				cols[j] = col
+			}
+			queries = append(queries, tableVerificationQuery{
+				query: fmt.Sprintf("SELECT 1 FROM %s WHERE %s GROUP BY %s HAVING COUNT(*) > 1 LIMIT 1",
+					from, strings.Join(notNull, " AND "), strings.Join(cols, ", ")),
+				message: fmt.Sprintf("Duplicate entries for key '%s'", idx.ID()),
+			})
+		}
+	}
+
+	if fkt, ok := table.(sql.ForeignKeyTable); ok {
+		fks, err := fkt.GetDeclaredForeignKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fk := range fks {
+			if !fk.IsResolved {
+				continue
+			}
+			parent := quoteIdentifier(fk.ParentDatabase) + "." + quoteIdentifier(fk.ParentTable)
+			notNull := make([]string, len(fk.Columns))
+			matches := make([]string, len(fk.Columns))
+			for j := range fk.Columns {
+				notNull[j] = "c." + quoteIdentifier(fk.Columns[j]) + " IS NOT NULL"
+				matches[j] = "p." + quoteIdentifier(fk.ParentColumns[j]) + " = c." + quoteIdentifier(fk.Columns[j])
+			}
+			queries = append(queries, tableVerificationQuery{
+				query: fmt.Sprintf("SELECT 1 FROM %s c WHERE %s AND NOT EXISTS (SELECT 1 FROM %s p WHERE %s) LIMIT 1",
+					from, strings.Join(notNull, " AND "), parent, strings.Join(matches, " AND ")),
+				message: fmt.Sprintf("Foreign key constraint '%s' is violated", fk.Name),
+			})
+		}
+	}
+
+	return queries, nil
+}
+
+// stripQueryWrappers returns the query given without the nodes wrapping top-level queries, which would end the process
+// of the statement or commit its transaction once the query is done.
+func stripQueryWrappers(n sql.Node) sql.Node {
+	for {
+		switch node := n.(type) {
+		case *plan.QueryProcess:
+			n = node.Child()
+		case *plan.TransactionCommittingNode:
+			n = node.Child()
+		default:
+			return n
+		}
+	}
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
@@ -0,0 +1,246 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// applyColumnMasks replaces every reference to a column that has a column mask registered with the catalog by the
+// expression masking it for the current user. This includes the references in filters, groupings, join conditions and
+// sort fields, not only projections, so that the masked values can't be inferred from the results. This must run
+// after columns are resolved and subqueries are turned into joins, but before filters are pushed down to tables.
+func applyColumnMasks(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("apply_column_masks")
+	defer span.End()
+
+	if !a.Catalog.hasColumnMasks() {
+		return n, transform.SameTree, nil
+	}
+	switch n := n.(type) {
+	case *plan.HandlerOpen, *plan.HandlerRead:
+		// HANDLER reads the rows of its table directly, so their columns can't be masked
+		db, table := handlerTable(ctx, a, n)
+		return n, transform.SameTree, checkNoColumnMasks(ctx, a, "HANDLER", db, table)
+	case *plan.ChecksumTable:
+		// The checksum of a table is computed over the values of its columns
+		for _, t := range n.Tables {
+			if err := checkNoColumnMasks(ctx, a, "CHECKSUM TABLE", maintenanceTableDatabase(ctx, t), t.Table); err != nil {
+				return nil, transform.SameTree, err
+			}
+		}
+		return n, transform.SameTree, nil
+	}
+
+	// Columns of the tables of outer scopes may be referenced by subquery expressions, but tables of inner scopes
+	// shadow the ones of outer scopes with the same name.
+	tables := make(map[string]*plan.ResolvedTable)
+	for _, node := range append([]sql.Node{n}, scope.InnerToOuter()...) {
+		collectMaskableTables(node, tables)
+	}
+	if len(tables) == 0 {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.Node(n, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		expressioner, ok := node.(sql.Expressioner)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		exprs := expressioner.Expressions()
+		var newExprs []sql.Expression
+		for i, e := range exprs {
+			newExpr, same, err := maskColumns(ctx, a, tables, e)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			if same {
+				continue
+			}
+			if newExprs == nil {
+				newExprs = make([]sql.Expression, len(exprs))
+				copy(newExprs, exprs)
+			}
+			newExprs[i] = newExpr
+		}
+		if newExprs == nil {
+			return node, transform.SameTree, nil
+		}
+		newNode, err := expressioner.WithExpressions(newExprs...)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return newNode, transform.NewTree, nil
+	})
+}
+
+// checkNoColumnMasks returns ErrColumnMaskUnsupported for the statement named if any column of the table given has a
+// column mask for the current user.
+func checkNoColumnMasks(ctx *sql.Context, a *Analyzer, statement, db, name string) error {
+	if name == "" {
+		return nil
+	}
+	table, _, err := a.Catalog.Table(ctx, db, name)
+	if err != nil {
+		// The statement fails or warns about the table when it's executed
+		return nil
+	}
+	for i, col := range table.Schema() {
+		mask := a.Catalog.ColumnMask(db, name, col.Name)
+		if mask == nil {
+			continue
+		}
+		masked, err := mask(ctx, expression.NewGetFieldWithTable(i, col.Type, name, col.Name, col.Nullable))
+		if err != nil {
+			return err
+		}
+		if masked != nil {
+			return sql.ErrColumnMaskUnsupported.New(statement, name)
+		}
+	}
+	return nil
+}
+
+// collectMaskableTables adds the tables read by the node given to the map given, keyed by the lowercase name they're
+// referenced by, unless a table is already referenced by that name. Tables of subquery aliases and unions are skipped,
+// because their columns are masked when they're analyzed themselves.
+func collectMaskableTables(n sql.Node, tables map[string]*plan.ResolvedTable) {
+	add := func(name string, rt *plan.ResolvedTable) {
+		name = strings.ToLower(name)
+		if _, ok := tables[name]; !ok && rt.Database != nil {
+			tables[name] = rt
+		}
+	}
+	transform.Inspect(n, func(node sql.Node) bool {
+		switch node := node.(type) {
+		case *plan.TableAlias:
+			if rt, ok := node.Child.(*plan.ResolvedTable); ok {
+				add(node.Name(), rt)
+			}
+			return false
+		case *plan.ResolvedTable:
+			add(node.Name(), node)
+		case sql.OpaqueNode:
+			return false
+		}
+		return true
+	})
+}
+
+// maskColumns returns the expression given with the masks of the columns it references applied. Expressions are
+// transformed top down, so that the columns of masks applied before aren't masked again, and the columns assigned by
+// SetField expressions are left unmasked.
+func maskColumns(ctx *sql.Context, a *Analyzer, tables map[string]*plan.ResolvedTable, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+	switch e := e.(type) {
+	case *columnMaskMarker:
+		return e, transform.SameTree, nil
+	case *expression.SetField:
+		right, same, err := maskColumns(ctx, a, tables, e.Right)
+		if err != nil || same {
+			return e, transform.SameTree, err
+		}
+		newExpr, err := e.WithChildren(e.Left, right)
+		return newExpr, transform.NewTree, err
+	case *expression.GetField:
+		rt, ok := tables[strings.ToLower(e.Table())]
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		mask := a.Catalog.ColumnMask(rt.Database.Name(), rt.Name(), e.Name())
+		if mask == nil {
+			return e, transform.SameTree, nil
+		}
+		masked, err := mask(ctx, e)
+		if err != nil || masked == nil {
+			return e, transform.SameTree, err
+		}
+		return &columnMaskMarker{UnaryExpression: expression.UnaryExpression{Child: masked}, name: e.Name(), table: e.Table()}, transform.NewTree, nil
+	}
+
+	children := e.Children()
+	var newChildren []sql.Expression
+	for i, child := range children {
+		newChild, same, err := maskColumns(ctx, a, tables, child)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if same {
+			continue
+		}
+		if newChildren == nil {
+			newChildren = make([]sql.Expression, len(children))
+			copy(newChildren, children)
+		}
+		newChildren[i] = newChild
+	}
+	if newChildren == nil {
+		return e, transform.SameTree, nil
+	}
+	newExpr, err := e.WithChildren(newChildren...)
+	return newExpr, transform.NewTree, err
+}
+
+// columnMaskMarker marks the expression masking a column. It evaluates to its child, but keeps the name of the column
+// it masks, so that projections of masked columns have the same schema as projections of the columns themselves.
+type columnMaskMarker struct {
+	expression.UnaryExpression
+	name  string
+	table string
+}
+
+var _ sql.Expression = (*columnMaskMarker)(nil)
+var _ sql.Nameable = (*columnMaskMarker)(nil)
+var _ sql.Tableable = (*columnMaskMarker)(nil)
+
+// Name implements the sql.Nameable interface.
+func (m *columnMaskMarker) Name() string {
+	return m.name
+}
+
+// Table implements the sql.Tableable interface.
+func (m *columnMaskMarker) Table() string {
+	return m.table
+}
+
+// Type implements the sql.Expression interface.
+func (m *columnMaskMarker) Type() sql.Type {
+	return m.Child.Type()
+}
+
+// Eval implements the sql.Expression interface.
+func (m *columnMaskMarker) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return m.Child.Eval(ctx, row)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (m *columnMaskMarker) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), 1)
+	}
+	return &columnMaskMarker{UnaryExpression: expression.UnaryExpression{Child: children[0]}, name: m.name, table: m.table}, nil
+}
+
+func (m *columnMaskMarker) String() string {
+	return m.Child.String()
+}
+
+func (m *columnMaskMarker) DebugString() string {
+	return sql.DebugString(m.Child)
+}
@@ -191,6 +191,12 @@ func (b *ExecBuilder) buildHashJoin(j *hashJoin, input sql.Schema, children ...s
 
 	cr := plan.NewCachedResults(children[1])
 	outer := plan.NewHashLookup(cr, outerAttrs, innerAttrs)
+	for _, f := range j.filter {
+		if _, ok := nullAwareEquality(f); ok {
+			outer = plan.NewNullAwareHashLookup(cr, outerAttrs, innerAttrs)
+			break
+		}
+	}
 	inner := children[0]
 	return plan.NewJoin(inner, outer, j.op, filters).WithScopeLen(j.g.m.scopeLen), nil
 }
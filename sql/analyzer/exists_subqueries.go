@@ -0,0 +1,92 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// simplifyExistsSubqueries removes the nodes of EXISTS subqueries that only sort or deduplicate their rows, which
+// doesn't change whether they return any row, so that the subqueries stop reading rows after the first one instead of
+// reading all of them to sort or deduplicate them. Such nodes are removed below the nodes that return the rows of
+// their children as they read them, including joins and unions, but not below the nodes whose result depends on all
+// the rows of their children, such as aggregations and limits. It runs before EXISTS subqueries are turned into semi
+// joins, so that the joins benefit from it as well.
+func simplifyExistsSubqueries(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("simplify_exists_subqueries")
+	defer span.End()
+
+	return transform.NodeExprs(n, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		exists, ok := e.(*plan.ExistsSubquery)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		query, same, err := removeRowOrdering(exists.Query.Query)
+		if err != nil || same {
+			return e, transform.SameTree, err
+		}
+		return plan.NewExistsSubquery(exists.Query.WithQuery(query)), transform.NewTree, nil
+	})
+}
+
+// removeRowOrdering removes the nodes sorting or deduplicating the rows of the node given that can be removed without
+// changing whether it returns any row.
+func removeRowOrdering(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	switch n := n.(type) {
+	case *plan.Sort, *plan.Distinct, *plan.OrderedDistinct:
+		child, _, err := removeRowOrdering(n.Children()[0])
+		return child, transform.NewTree, err
+	case *plan.Union:
+		if n.Limit != nil {
+			return n, transform.SameTree, nil
+		}
+		left, sameLeft, err := removeRowOrdering(n.Left())
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		right, sameRight, err := removeRowOrdering(n.Right())
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if sameLeft && sameRight && len(n.SortFields) == 0 {
+			return n, transform.SameTree, nil
+		}
+		return plan.NewUnion(left, right, n.Distinct, nil, nil), transform.NewTree, nil
+	case *plan.Project, *plan.Filter, *plan.TableAlias, *plan.SubqueryAlias, *plan.JoinNode:
+		children := n.Children()
+		var newChildren []sql.Node
+		for i, child := range children {
+			newChild, same, err := removeRowOrdering(child)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			if !same {
+				if newChildren == nil {
+					newChildren = append([]sql.Node(nil), children...)
+				}
+				newChildren[i] = newChild
+			}
+		}
+		if newChildren == nil {
+			return n, transform.SameTree, nil
+		}
+		newNode, err := n.WithChildren(newChildren...)
+		return newNode, transform.NewTree, err
+	default:
+		return n, transform.SameTree, nil
+	}
+}
@@ -63,7 +63,7 @@ func expandStars(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel Ru
 			if same {
 				return n, transform.SameTree, nil
 			}
-			return plan.NewGroupBy(expanded, n.GroupByExprs, n.Child), transform.NewTree, nil
+			return plan.NewGroupBy(expanded, n.GroupByExprs, n.Child).WithModifier(n.Modifier), transform.NewTree, nil
 		case *plan.Window:
 			if !n.Child.Resolved() {
 				return n, transform.SameTree, nil
@@ -0,0 +1,216 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// functionalDependency states that, in the rows of a node, the values of the columns |from| determine the values of
+// the columns |to|: two rows with the same values for |from| have the same values for |to|. An empty |from| means that
+// the columns |to| have the same value in all rows.
+type functionalDependency struct {
+	from, to []columnRef
+}
+
+// determinedColumns returns the columns of the rows of the node given whose values are determined by the values of the
+// columns given, as MySQL does for ONLY_FULL_GROUP_BY. The columns given are determined themselves. Columns are
+// determined through the unique keys of the tables the node reads, the equalities of its filters and inner join
+// conditions, and the aliases of its projections.
+func determinedColumns(ctx *sql.Context, n sql.Node, columns []columnRef) (map[columnRef]bool, error) {
+	deps, err := functionalDependencies(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	determined := make(map[columnRef]bool)
+	for _, ref := range columns {
+		determined[ref] = true
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, dep := range deps {
+			if !allDetermined(determined, dep.from) || allDetermined(determined, dep.to) {
+				continue
+			}
+			for _, ref := range dep.to {
+				determined[ref] = true
+			}
+			changed = true
+		}
+	}
+	return determined, nil
+}
+
+func allDetermined(determined map[columnRef]bool, refs []columnRef) bool {
+	for _, ref := range refs {
+		if !determined[ref] {
+			return false
+		}
+	}
+	return true
+}
+
+// functionalDependencies returns the functional dependencies that hold for the rows of the node given. Only the
+// dependencies of tables read through nodes that filter, join, sort or project their rows are found.
+func functionalDependencies(ctx *sql.Context, n sql.Node) ([]functionalDependency, error) {
+	switch n := n.(type) {
+	case *plan.Sort, *plan.TopN, *plan.Limit, *plan.Offset, *plan.Distinct:
+		return functionalDependencies(ctx, n.Children()[0])
+	case *plan.Filter:
+		deps, err := functionalDependencies(ctx, n.Child)
+		if err != nil {
+			return nil, err
+		}
+		return append(deps, equalityDependencies(n.Expression)...), nil
+	case *plan.JoinNode:
+		return joinDependencies(ctx, n)
+	case *plan.Project:
+		deps, err := functionalDependencies(ctx, n.Child)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range n.Projections {
+			alias, ok := e.(*expression.Alias)
+			if !ok || !isRowFunction(alias.Child) {
+				continue
+			}
+			deps = append(deps, functionalDependency{
+				from: referencedColumns(alias.Child),
+				to:   []columnRef{newColumnRef("", alias.Name())},
+			})
+			if ref, ok := columnRefOf(alias.Child); ok {
+				deps = append(deps, functionalDependency{from: []columnRef{newColumnRef("", alias.Name())}, to: []columnRef{ref}})
+			}
+		}
+		return deps, nil
+	case *plan.TableAlias:
+		switch child := n.Child.(type) {
+		case *plan.ResolvedTable:
+			return tableDependencies(ctx, child, n.Name(), false)
+		case *plan.IndexedTableAccess:
+			return tableDependencies(ctx, child.ResolvedTable, n.Name(), child.IsPointLookup())
+		default:
+			return nil, nil
+		}
+	case *plan.IndexedTableAccess:
+		return tableDependencies(ctx, n.ResolvedTable, n.Name(), n.IsPointLookup())
+	case *plan.ResolvedTable:
+		return tableDependencies(ctx, n, n.Name(), false)
+	default:
+		return nil, nil
+	}
+}
+
+// joinDependencies returns the functional dependencies of the rows of a join. The dependencies of both sides and the
+// equalities of the join condition hold for an inner join, while only the dependencies of the left side hold for a
+// left outer join, since the columns of the right side are null for the rows it adds.
+func joinDependencies(ctx *sql.Context, j *plan.JoinNode) ([]functionalDependency, error) {
+	switch j.Op {
+	case plan.JoinTypeCross, plan.JoinTypeInner, plan.JoinTypeLookup, plan.JoinTypeHash, plan.JoinTypeMerge:
+		left, err := functionalDependencies(ctx, j.Left())
+		if err != nil {
+			return nil, err
+		}
+		right, err := functionalDependencies(ctx, j.Right())
+		if err != nil {
+			return nil, err
+		}
+		return append(append(left, right...), equalityDependencies(j.JoinCond())...), nil
+	default:
+		if j.Op.IsLeftOuter() {
+			return functionalDependencies(ctx, j.Left())
+		}
+		return nil, nil
+	}
+}
+
+// tableDependencies returns the functional dependencies of the rows of a table, which are read under the name given:
+// each of its unique keys determines all of its columns. If the rows are read with a point lookup, all of its columns
+// have the same value in them.
+func tableDependencies(ctx *sql.Context, rt *plan.ResolvedTable, name string, pointLookup bool) ([]functionalDependency, error) {
+	var columns []columnRef
+	for _, col := range rt.Schema() {
+		columns = append(columns, newColumnRef(name, col.Name))
+	}
+	if pointLookup {
+		return []functionalDependency{{to: columns}}, nil
+	}
+
+	keys, err := tableUniqueKeys(ctx, rt)
+	if err != nil {
+		return nil, err
+	}
+	var deps []functionalDependency
+	for _, key := range renameTable(keys, name) {
+		deps = append(deps, functionalDependency{from: key, to: columns})
+	}
+	return deps, nil
+}
+
+// equalityDependencies returns the functional dependencies of the equalities in the conjunction of a filter: a column
+// that's equal to another one determines it, and a column that's equal to a literal has the same value in all rows.
+func equalityDependencies(filter sql.Expression) []functionalDependency {
+	var deps []functionalDependency
+	for _, e := range splitConjunction(filter) {
+		eq, ok := e.(*expression.Equals)
+		if !ok {
+			continue
+		}
+		left, leftOk := columnRefOf(eq.Left())
+		right, rightOk := columnRefOf(eq.Right())
+		_, leftLiteral := eq.Left().(*expression.Literal)
+		_, rightLiteral := eq.Right().(*expression.Literal)
+		switch {
+		case leftOk && rightOk:
+			deps = append(deps,
+				functionalDependency{from: []columnRef{left}, to: []columnRef{right}},
+				functionalDependency{from: []columnRef{right}, to: []columnRef{left}},
+			)
+		case leftOk && rightLiteral:
+			deps = append(deps, functionalDependency{to: []columnRef{left}})
+		case rightOk && leftLiteral:
+			deps = append(deps, functionalDependency{to: []columnRef{right}})
+		}
+	}
+	return deps
+}
+
+// isRowFunction returns whether the value of the expression given only depends on the columns of the row it's
+// evaluated on.
+func isRowFunction(e sql.Expression) bool {
+	return !hasSideEffects(e) && !transform.InspectExpr(e, func(e sql.Expression) bool {
+		switch e.(type) {
+		case *plan.Subquery, sql.Aggregation, sql.WindowAggregation:
+			return true
+		}
+		return false
+	})
+}
+
+// referencedColumns returns the columns the expression given refers to.
+func referencedColumns(e sql.Expression) []columnRef {
+	var refs []columnRef
+	transform.InspectExpr(e, func(e sql.Expression) bool {
+		if ref, ok := columnRefOf(e); ok {
+			refs = append(refs, ref)
+		}
+		return false
+	})
+	return refs
+}
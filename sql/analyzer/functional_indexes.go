@@ -0,0 +1,181 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// A functional key part of an index indexes the value of an expression of the columns of its table, rather than a
+// column. The expressions of functional key parts are resolved against the schema of their
+// table before the index is created, and the index is then matched to the filters containing them like any other.
+
+// functionalIndexName is the name of the indexes with functional key parts that aren't named, as in MySQL.
+const functionalIndexName = "functional_index"
+
+// nameFunctionalIndex returns the name given to the index with the name and the key parts given, which is its name
+// unless it isn't named and has functional key parts. It's then named functional_index, or functional_index_N if the
+// table already has an index of the names given so named, as in MySQL.
+func nameFunctionalIndex(indexName string, columns []sql.IndexColumn, indexNames []string) string {
+	if indexName != "" || !hasFunctionalKeyPart(columns) {
+		return indexName
+	}
+	taken := make(map[string]bool, len(indexNames))
+	for _, name := range indexNames {
+		taken[strings.ToLower(name)] = true
+	}
+	indexName = functionalIndexName
+	for i := 2; taken[indexName]; i++ {
+		indexName = fmt.Sprintf("%s_%d", functionalIndexName, i)
+	}
+	return indexName
+}
+
+// hasFunctionalKeyPart returns whether any of the key parts given is functional.
+func hasFunctionalKeyPart(columns []sql.IndexColumn) bool {
+	for _, col := range columns {
+		if col.Expression != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveFunctionalKeyParts returns the key parts given, of the index named, with the expressions of their functional
+// key parts resolved against the schema given, the schema of the table of the index, and the key parts named after
+// their hidden columns. Returns an error if the index can't have functional key parts, or if their expressions aren't
+// allowed in them.
+func resolveFunctionalKeyParts(ctx *sql.Context, a *Analyzer, indexName string, constraint sql.IndexConstraint, columns []sql.IndexColumn, sch sql.Schema) ([]sql.IndexColumn, error) {
+	var resolved []sql.IndexColumn
+	for i, col := range columns {
+		if col.Expression == nil {
+			continue
+		}
+		switch constraint {
+		case sql.IndexConstraint_Primary:
+			return nil, sql.ErrFunctionalIndexPrimaryKey.New()
+		case sql.IndexConstraint_Spatial:
+			return nil, sql.ErrFunctionalIndexNotSupported.New("spatial")
+		case sql.IndexConstraint_Fulltext:
+			return nil, sql.ErrFunctionalIndexNotSupported.New("fulltext")
+		}
+
+		// The columns of the schema of a single table all have the same source
+		var source string
+		if len(sch) > 0 {
+			source = sch[0].Source
+		}
+		expr, err := resolveTableExpression(ctx, a, col.Expression, source, sch)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := expr.(*expression.GetField); ok {
+			return nil, sql.ErrFunctionalIndexOnField.New()
+		}
+		disallowed := false
+		sql.Inspect(expr, func(e sql.Expression) bool {
+			disallowed = disallowed || isNonDeterministic(e)
+			return !disallowed
+		})
+		if disallowed {
+			return nil, sql.ErrFunctionalIndexFunction.New(indexName)
+		}
+
+		if resolved == nil {
+			resolved = append([]sql.IndexColumn(nil), columns...)
+		}
+		resolved[i].Name = sql.FunctionalKeyPartColumnName(indexName, i)
+		resolved[i].Expression = expr
+	}
+	if resolved == nil {
+		return columns, nil
+	}
+	return resolved, nil
+}
+
+// resolveTableExpression returns the expression given, which may only reference the columns of the table named
+// |source|, resolved against the schema given, which contains them.
+func resolveTableExpression(ctx *sql.Context, a *Analyzer, expr sql.Expression, source string, sch sql.Schema) (sql.Expression, error) {
+	expr, _, err := transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		var name string
+		switch e := e.(type) {
+		case *expression.UnresolvedColumn:
+			name = e.Name()
+		case *expression.GetField:
+			name = e.Name()
+		default:
+			return resolveFunctionsInExpr(ctx, a)(e)
+		}
+		idx := sch.IndexOf(name, source)
+		if idx == -1 {
+			return nil, transform.SameTree, sql.ErrTableColumnNotFound.New(source, name)
+		}
+		ref := sch[idx]
+		return expression.NewGetFieldWithTable(idx, ref.Type, ref.Source, ref.Name, ref.Nullable), transform.NewTree, nil
+	})
+	return expr, err
+}
+
+// isNonDeterministic returns whether the expression given is a function or subquery that isn't deterministic, which
+// the expressions evaluated on the rows of a table, those of functional key parts and generated columns, can't contain.
+func isNonDeterministic(e sql.Expression) bool {
+	switch e := e.(type) {
+	case *function.GetLock, *function.IsUsedLock, *function.IsFreeLock, function.ReleaseAllLocks, *function.ReleaseLock,
+		*plan.Subquery:
+		return true
+	case sql.NonDeterministicExpression:
+		return e.IsNonDeterministic()
+	default:
+		return false
+	}
+}
+
+// validateColumnNotUsedInFunctionalIndexes validates that the column named isn't referenced by the functional key parts
+// of the indexes of the table given.
+func validateColumnNotUsedInFunctionalIndexes(ctx *sql.Context, table sql.Node, columnName string) error {
+	ia, err := newIndexAnalyzerForNode(ctx, table)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range ia.IndexesByTable(ctx, ctx.GetCurrentDatabase(), getTableName(table)) {
+		fi, ok := index.(sql.FunctionalIndex)
+		if !ok {
+			continue
+		}
+		for _, expr := range fi.FunctionalKeyParts() {
+			if expr == nil {
+				continue
+			}
+			sql.Inspect(expr, func(e sql.Expression) bool {
+				if gf, ok := e.(*expression.GetField); ok && strings.EqualFold(gf.Name(), columnName) {
+					err = sql.ErrColumnReferencedInFunctionalIndex.New(columnName)
+				}
+				return err == nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// The values of generated columns are computed from the other columns of their row whenever it's written: inserts
+// project the values of their generated columns from those of the others, and updates assign them after the other
+// columns. Generated columns can only be inserted or assigned DEFAULT, which is the value generated for them.
+//
+// When a filter contains the expression of a generated column, it's replaced by the column, so that the indexes on
+// the column can be used to evaluate it, as MySQL does.
+
+// parsedGeneratedColumn returns the expression of the generated column given, parsing it if it's unresolved, with the
+// type of the column.
+func parsedGeneratedColumn(ctx *sql.Context, col *sql.Column) (*sql.ColumnDefaultValue, error) {
+	gen := col.Generated
+	if ucd, ok := gen.Expression.(sql.UnresolvedColumnDefault); ok {
+		var err error
+		gen, err = parse.StringToColumnDefaultValue(ctx, ucd.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	// NULL values are validated against the nullability of the column when the row is written
+	return sql.NewColumnDefaultValue(gen.Expression, col.Type, false, true, true)
+}
+
+// resolveGeneratedExpression returns the expression of the generated column given, resolved against the schema given,
+// which contains the columns of the table of the generated column.
+func resolveGeneratedExpression(ctx *sql.Context, a *Analyzer, col *sql.Column, sch sql.Schema) (sql.Expression, error) {
+	gen, err := parsedGeneratedColumn(ctx, col)
+	if err != nil {
+		return nil, err
+	}
+	return resolveTableExpression(ctx, a, gen.Expression, col.Source, sch)
+}
+
+// validateGeneratedColumns validates the expressions of the generated columns of the schema of a new table.
+func validateGeneratedColumns(ctx *sql.Context, a *Analyzer, sch sql.Schema) error {
+	for i, col := range sch {
+		if col.Generated == nil {
+			continue
+		}
+		expr, err := resolveGeneratedExpression(ctx, a, col, sch)
+		if err != nil {
+			return err
+		}
+		sql.Inspect(expr, func(e sql.Expression) bool {
+			switch e := e.(type) {
+			case *expression.GetField:
+				ref := sch[e.Index()]
+				if ref.AutoIncrement {
+					err = sql.ErrGeneratedColumnRefAutoIncrement.New(col.Name)
+				} else if ref.Generated != nil && e.Index() >= i {
+					err = sql.ErrGeneratedColumnOrder.New()
+				}
+			default:
+				if isNonDeterministic(e) {
+					err = sql.ErrGeneratedColumnFunction.New(col.Name)
+				}
+			}
+			return err == nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateColumnNotUsedInGeneratedColumns validates that the column named isn't referenced by the generated columns of
+// the schema given.
+func validateColumnNotUsedInGeneratedColumns(columnName string, sch sql.Schema) error {
+	for _, col := range sch {
+		if col.Generated == nil || strings.EqualFold(col.Name, columnName) {
+			continue
+		}
+		var err error
+		sql.Inspect(col.Generated, func(e sql.Expression) bool {
+			switch e := e.(type) {
+			case *expression.UnresolvedColumn, *expression.GetField:
+				if strings.EqualFold(e.(sql.Nameable).Name(), columnName) {
+					err = sql.ErrColumnReferencedInGenerated.New(columnName)
+				}
+			}
+			return err == nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generatedFromColumns returns whether the column given is a generated column whose expression only references the
+// columns given, keyed by their lower-cased names.
+func generatedFromColumns(col *sql.Column, cols map[string]bool) bool {
+	if col.Generated == nil {
+		return false
+	}
+	found, missing := false, false
+	sql.Inspect(col.Generated, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.UnresolvedColumn, *expression.GetField:
+			found = true
+			missing = missing || !cols[strings.ToLower(e.(sql.Nameable).Name())]
+		}
+		return !missing
+	})
+	return found && !missing
+}
+
+// wrapGeneratedColumns wraps the row source given in a projection that computes the values of the generated columns
+// of its rows. The columns of the table written, whose schema is given, follow the first |offset| columns of the rows,
+// which are passed through. Returns the row source unchanged if the table has no generated columns.
+func wrapGeneratedColumns(ctx *sql.Context, a *Analyzer, source sql.Node, sch sql.Schema, offset int) (sql.Node, error) {
+	hasGenerated := false
+	for _, col := range sch {
+		if col.Generated != nil {
+			hasGenerated = true
+			break
+		}
+	}
+	if !hasGenerated {
+		return source, nil
+	}
+
+	projExprs := make([]sql.Expression, offset+len(sch))
+	for i, col := range source.Schema()[:offset] {
+		projExprs[i] = expression.NewGetField(i, col.Type, col.Name, col.Nullable)
+	}
+	for i, col := range sch {
+		if col.Generated == nil {
+			projExprs[offset+i] = expression.NewGetField(offset+i, col.Type, col.Name, col.Nullable)
+			continue
+		}
+		expr, err := resolveGeneratedExpression(ctx, a, col, sch)
+		if err != nil {
+			return nil, err
+		}
+		// The generated columns this one references are computed by this projection too
+		expr, _, err = transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			gf, ok := e.(*expression.GetField)
+			if !ok {
+				return e, transform.SameTree, nil
+			}
+			if sch[gf.Index()].Generated != nil {
+				return projExprs[offset+gf.Index()], transform.NewTree, nil
+			}
+			// Like the columns passed through, the columns referenced aren't qualified, since the rows of triggers
+			// contain the columns of the table twice
+			return expression.NewGetField(offset+gf.Index(), gf.Type(), gf.Name(), gf.IsNullable()), transform.NewTree, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		projExprs[offset+i], err = sql.NewColumnDefaultValue(expr, col.Type, false, true, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return plan.NewProject(projExprs, source), nil
+}
+
+// assignGeneratedColumns returns the assignments given, which update the tables of the node given, with their
+// generated columns assigned DEFAULT after the other columns, so that their values are computed from the updated row.
+// Returns an error if a generated column is assigned a value other than DEFAULT.
+func assignGeneratedColumns(assignments []sql.Expression, tables sql.Node) ([]sql.Expression, transform.TreeIdentity, error) {
+	type table struct {
+		name string
+		sch  sql.Schema
+	}
+	var tbls []table
+	hasGenerated := false
+	transform.Inspect(tables, func(n sql.Node) bool {
+		var name string
+		switch n := n.(type) {
+		case *plan.TableAlias:
+			name = n.Name()
+		case *plan.ResolvedTable:
+			name = n.Name()
+		case *plan.SubqueryAlias:
+			return false
+		default:
+			return true
+		}
+		sch := n.Schema()
+		for _, col := range sch {
+			if col.Generated != nil {
+				hasGenerated = true
+			}
+		}
+		tbls = append(tbls, table{name: name, sch: sch})
+		return false
+	})
+	if !hasGenerated {
+		return assignments, transform.SameTree, nil
+	}
+
+	updated := make(map[string]bool)
+	generatedAssignments := make(map[string]sql.Expression)
+	newAssignments := make([]sql.Expression, 0, len(assignments))
+	for _, assignment := range assignments {
+		setField, ok := assignment.(*expression.SetField)
+		if !ok {
+			newAssignments = append(newAssignments, assignment)
+			continue
+		}
+		left, ok := setField.Left.(sql.Nameable)
+		if !ok {
+			newAssignments = append(newAssignments, assignment)
+			continue
+		}
+		var tableName string
+		if tableable, ok := setField.Left.(sql.Tableable); ok {
+			tableName = tableable.Table()
+		}
+
+		generated := false
+		for _, t := range tbls {
+			if tableName != "" && !strings.EqualFold(tableName, t.name) {
+				continue
+			}
+			idx := t.sch.IndexOfColName(left.Name())
+			if idx == -1 {
+				continue
+			}
+			updated[strings.ToLower(t.name)] = true
+			col := t.sch[idx]
+			if col.Generated == nil {
+				continue
+			}
+			switch setField.Right.(type) {
+			case *expression.DefaultColumn, *sql.ColumnDefaultValue:
+			default:
+				return nil, transform.SameTree, sql.ErrGeneratedColumnValue.New(col.Name, t.name)
+			}
+			generatedAssignments[strings.ToLower(t.name+"."+col.Name)] = assignment
+			generated = true
+		}
+		if !generated {
+			newAssignments = append(newAssignments, assignment)
+		}
+	}
+
+	for _, t := range tbls {
+		if !updated[strings.ToLower(t.name)] {
+			continue
+		}
+		for _, col := range t.sch {
+			if col.Generated == nil {
+				continue
+			}
+			if assignment, ok := generatedAssignments[strings.ToLower(t.name+"."+col.Name)]; ok {
+				newAssignments = append(newAssignments, assignment)
+			} else {
+				newAssignments = append(newAssignments, expression.NewSetField(
+					expression.NewUnresolvedQualifiedColumn(t.name, col.Name),
+					expression.NewDefaultColumn(""),
+				))
+			}
+		}
+	}
+	return newAssignments, transform.NewTree, nil
+}
+
+// substituteGeneratedColumns replaces the expressions of generated columns in filters with the columns, so that
+// indexes on generated columns can be used to evaluate the filters.
+func substituteGeneratedColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("substitute_generated_columns")
+	defer span.End()
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+
+		sch := filter.Child.Schema()
+		substitutes := make(map[string]sql.Expression)
+		for i, col := range sch {
+			if col.Generated == nil {
+				continue
+			}
+			expr, err := resolveGeneratedExpression(ctx, a, col, sch)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			// The value of the column is the value of its expression converted to its type, so they can only be
+			// substituted for each other if they have the same type
+			if !generatedColumnTypeMatches(col.Type, expr.Type()) {
+				continue
+			}
+			substitutes[strings.ToLower(expr.String())] = expression.NewGetFieldWithTable(i, col.Type, col.Source, col.Name, col.Nullable)
+		}
+		if len(substitutes) == 0 {
+			return n, transform.SameTree, nil
+		}
+
+		newExpr, same, err := transform.Expr(filter.Expression, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			if _, ok := e.(*expression.GetField); ok {
+				return e, transform.SameTree, nil
+			}
+			if substitute, ok := substitutes[strings.ToLower(e.String())]; ok {
+				return substitute, transform.NewTree, nil
+			}
+			return e, transform.SameTree, nil
+		})
+		if err != nil || same {
+			return n, transform.SameTree, err
+		}
+		a.Log("substituted generated columns in filter: %s", newExpr)
+		return plan.NewFilter(newExpr, filter.Child), transform.NewTree, nil
+	})
+}
+
+// generatedColumnTypeMatches returns whether the values of an expression of the type given can be substituted with
+// those of a generated column of the type given: whether they're both integers, both strings of the same collation, or
+// the same type.
+func generatedColumnTypeMatches(colType, exprType sql.Type) bool {
+	switch {
+	case types.IsInteger(colType) && types.IsInteger(exprType):
+		return true
+	case types.IsText(colType) && types.IsText(exprType):
+		return colType.(sql.StringType).Collation() == exprType.(sql.StringType).Collation()
+	default:
+		return colType.Equals(exprType)
+	}
+}
@@ -18,11 +18,12 @@ func _() {
 	_ = x[HintTypeAntiJoin-7]
 	_ = x[HintTypeInnerJoin-8]
 	_ = x[HintTypeNoIndexConditionPushDown-9]
+	_ = x[HintTypeNoMerge-10]
 }
 
-const _HintType_name = "JOIN_ORDERJOIN_FIXED_ORDERMERGE_JOINLOOKUP_JOINHASH_JOINSEMI_JOINANTI_JOININNER_JOINNO_ICP"
+const _HintType_name = "JOIN_ORDERJOIN_FIXED_ORDERMERGE_JOINLOOKUP_JOINHASH_JOINSEMI_JOINANTI_JOININNER_JOINNO_ICPNO_MERGE"
 
-var _HintType_index = [...]uint8{0, 0, 10, 26, 36, 47, 56, 65, 74, 84, 90}
+var _HintType_index = [...]uint8{0, 0, 10, 26, 36, 47, 56, 65, 74, 84, 90, 98}
 
 func (i HintType) String() string {
 	if i >= HintType(len(_HintType_index)-1) {
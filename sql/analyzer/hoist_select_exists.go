@@ -121,9 +121,24 @@ func hoistExistSubqueries(scope *Scope, a *Analyzer, filter *plan.Filter, scopeL
 			}
 
 		case *expression.Not:
-			if esq, ok := e.Child.(*plan.ExistsSubquery); ok {
+			switch c := e.Child.(type) {
+			case *plan.ExistsSubquery:
 				joinType = plan.JoinTypeAnti
-				s, err = decorrelateOuterCols(esq.Query, scopeLen, aliasDisambig)
+				s, err = decorrelateOuterCols(c.Query, scopeLen, aliasDisambig)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
+			case *plan.InSubquery:
+				sq, ok := c.Right.(*plan.Subquery)
+				if !ok {
+					break
+				}
+				exists, ok := notInAsNotExists(c.Left, sq)
+				if !ok {
+					break
+				}
+				joinType = plan.JoinTypeAnti
+				s, err = decorrelateOuterCols(exists, scopeLen, aliasDisambig)
 				if err != nil {
 					return nil, transform.SameTree, err
 				}
@@ -196,6 +211,75 @@ func hoistExistSubqueries(scope *Scope, a *Analyzer, filter *plan.Filter, scopeL
 	return ret, transform.NewTree, nil
 }
 
+// notInAsNotExists returns a subquery that has rows for the rows of the outer scope that |left| NOT IN |sq| isn't true
+// for, so that NOT EXISTS of it is the same as the NOT IN. Its rows are those of the subquery given that its values
+// equal |left|, or for which the equality is null. Only subqueries whose values are projected from the rows of
+// their FROM clause, rather than from groups or a limited number of rows, can be rewritten.
+//
+// For example:
+// select * from a where a.x not in (select b.x from b where a.y = b.y)
+// =>
+// select * from a where not exists (select * from b where a.y = b.y and not (a.x = b.x is false))
+func notInAsNotExists(left sql.Expression, sq *plan.Subquery) (*plan.Subquery, bool) {
+	var p *plan.Project
+	for n := sq.Query; p == nil; {
+		switch nn := n.(type) {
+		case *plan.Sort, *plan.Distinct:
+			n = nn.Children()[0]
+		case *plan.Project:
+			p = nn
+		default:
+			return nil, false
+		}
+	}
+	if len(p.Projections) != 1 || !isRowFunction(p.Projections[0]) {
+		return nil, false
+	}
+	for n := p.Child; n != nil; {
+		switch nn := n.(type) {
+		case *plan.Filter, *plan.Sort, *plan.Distinct:
+			n = nn.Children()[0]
+		case *plan.GroupBy, *plan.Having, *plan.Window, *plan.Limit, *plan.TopN, *plan.Offset, *plan.Project:
+			return nil, false
+		default:
+			n = nil
+		}
+	}
+
+	right := p.Projections[0]
+	if alias, ok := right.(*expression.Alias); ok {
+		right = alias.Child
+	}
+	filter := nullAwareAntiJoinFilter(expression.NewEquals(left, right))
+	return sq.WithQuery(plan.NewFilter(filter, p.Child)), true
+}
+
+// aggregatesOuterCols returns whether the subquery given has rows that depend on aggregating rows filtered on columns
+// of an outer scope, other than groups that exist when any row does: those of a HAVING clause, of a window, or of an
+// aggregation without grouping expressions, which has a row even when no row passes the filters.
+func aggregatesOuterCols(n sql.Node, scopeLen int) bool {
+	var found bool
+	transform.Inspect(n, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.GroupBy:
+			if len(n.GroupByExprs) > 0 {
+				return !found
+			}
+		case *plan.Having, *plan.Window:
+		default:
+			return !found
+		}
+		transform.InspectExpressions(n, func(e sql.Expression) bool {
+			if gf, ok := e.(*expression.GetField); ok && gf.Index() < scopeLen {
+				found = true
+			}
+			return !found
+		})
+		return false
+	})
+	return found
+}
+
 type hoistSubquery struct {
 	inner       sql.Node
 	joinFilters []sql.Expression
@@ -214,6 +298,11 @@ func (f fakeNameable) Name() string { return f.name }
 // If the subquery has aliases that conflict with outside aliases, the internal aliases will be renamed to avoid
 // name collisions.
 func decorrelateOuterCols(e *plan.Subquery, scopeLen int, aliasDisambig *aliasDisambiguator) (*hoistSubquery, error) {
+	if aggregatesOuterCols(e.Query, scopeLen) {
+		// the filters on outer columns select the rows that are aggregated, which a join filter can't do
+		return nil, nil
+	}
+
 	var joinFilters []sql.Expression
 	var filtersToKeep []sql.Expression
 	var emptyScope bool
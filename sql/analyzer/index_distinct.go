@@ -0,0 +1,144 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// applyIndexDistinct replaces the reads of a whole table whose only column used is an indexed column with duplicates
+// removed, such as by SELECT DISTINCT col FROM t or SELECT COUNT(DISTINCT col) FROM t, with an IndexDistinct node
+// iterating the distinct values of an index on the column, if the table is a sql.IndexDistinctTable. The Distinct
+// node of a SELECT DISTINCT is removed, since the values are already distinct. Like applyTableCount, it runs before
+// filters are pushed down to tables, so that the tables it replaces are read in full.
+func applyIndexDistinct(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("apply_index_distinct")
+	defer span.End()
+
+	if !n.Resolved() {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch n := n.(type) {
+		case *plan.Distinct:
+			p, ok := n.Child.(*plan.Project)
+			if !ok || len(p.Projections) != 1 {
+				return n, transform.SameTree, nil
+			}
+			e := p.Projections[0]
+			if alias, ok := e.(*expression.Alias); ok {
+				e = alias.Child
+			}
+			gf, ok := e.(*expression.GetField)
+			if !ok {
+				return n, transform.SameTree, nil
+			}
+			np, same, err := replaceWithIndexDistinct(ctx, a, p, gf)
+			if err != nil || same {
+				return n, transform.SameTree, err
+			}
+			return np, transform.NewTree, nil
+		case *plan.GroupBy:
+			if len(n.GroupByExprs) > 0 || len(n.SelectedExprs) == 0 {
+				return n, transform.SameTree, nil
+			}
+			var field *expression.GetField
+			for _, e := range n.SelectedExprs {
+				gf, ok := countDistinctField(e)
+				if !ok || (field != nil && gf.Index() != field.Index()) {
+					return n, transform.SameTree, nil
+				}
+				field = gf
+			}
+			return replaceWithIndexDistinct(ctx, a, n, field)
+		default:
+			return n, transform.SameTree, nil
+		}
+	})
+}
+
+// countDistinctField returns the column counted by the expression given, if it's a COUNT(DISTINCT col).
+func countDistinctField(e sql.Expression) (*expression.GetField, bool) {
+	if alias, ok := e.(*expression.Alias); ok {
+		e = alias.Child
+	}
+	count, ok := e.(*aggregation.CountDistinct)
+	if !ok || len(count.Children()) != 1 {
+		return nil, false
+	}
+	gf, ok := count.Children()[0].(*expression.GetField)
+	return gf, ok
+}
+
+// replaceWithIndexDistinct returns the node given, whose only column read from its child table is the one given, with
+// its child replaced by an IndexDistinct node on an index whose first column is that column, if there's one.
+func replaceWithIndexDistinct(ctx *sql.Context, a *Analyzer, n sql.Node, field *expression.GetField) (sql.Node, transform.TreeIdentity, error) {
+	table := n.Children()[0]
+	it, ok := plan.GetIndexDistinctTable(table)
+	if !ok {
+		return n, transform.SameTree, nil
+	}
+	schema := table.Schema()
+	if field.Index() >= len(schema) || !strings.EqualFold(schema[field.Index()].Name, field.Name()) {
+		return n, transform.SameTree, nil
+	}
+	column := schema[field.Index()]
+
+	indexes, err := it.GetIndexes(ctx)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	var index sql.Index
+	for _, idx := range indexes {
+		if idx.IsSpatial() || strings.EqualFold(idx.IndexType(), "FULLTEXT") {
+			continue
+		}
+		// The distinct prefixes of a column aren't its distinct values
+		if prefixes := idx.PrefixLengths(); len(prefixes) > 0 && prefixes[0] > 0 {
+			continue
+		}
+		expr := idx.Expressions()[0]
+		if strings.EqualFold(expr[strings.LastIndex(expr, ".")+1:], column.Name) {
+			index = idx
+			break
+		}
+	}
+	if index == nil {
+		return n, transform.SameTree, nil
+	}
+
+	n, err = n.WithChildren(plan.NewIndexDistinct(table, index, column))
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	n, _, err = transform.OneNodeExpressions(n, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		if gf, ok := e.(*expression.GetField); ok {
+			return gf.WithIndex(0), transform.NewTree, nil
+		}
+		return e, transform.SameTree, nil
+	})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	a.Log("replacing the distinct values of column %s with the distinct values of index %s", column.Name, index.ID())
+	return n, transform.NewTree, nil
+}
@@ -177,9 +177,11 @@ func replanJoin(ctx *sql.Context, n *plan.JoinNode, a *Analyzer, scope *Scope) (
 	if err != nil {
 		return nil, err
 	}
-	err = addHashJoins(m)
-	if err != nil {
-		return nil, err
+	if sql.OptimizerSwitchOn(ctx, "hash_join") {
+		err = addHashJoins(m)
+		if err != nil {
+			return nil, err
+		}
 	}
 	err = addMergeJoins(m)
 	if err != nil {
@@ -633,7 +635,7 @@ func addHashJoins(m *Memo) error {
 	seen := make(map[GroupId]struct{})
 	return dfsExprGroup(m.root, m, seen, func(e relExpr) error {
 		switch e.(type) {
-		case *innerJoin, *leftJoin:
+		case *innerJoin, *leftJoin, *antiJoin:
 		default:
 			return nil
 		}
@@ -642,9 +644,19 @@ func addHashJoins(m *Memo) error {
 		if len(join.filter) == 0 {
 			return nil
 		}
+		if _, ok := join.right.first.(*max1Row); ok {
+			// the anti join for a comparison with a scalar subquery
+			// returns no rows when the subquery has none
+			return nil
+		}
 
 		var innerExpr, outerExpr []sql.Expression
 		for _, f := range join.filter {
+			if eq, ok := nullAwareEquality(f); ok {
+				// the hash lookup of an anti join for a NOT IN subquery
+				// also returns the rows with null keys
+				f = eq
+			}
 			switch f := f.(type) {
 			case *expression.Equals:
 				if exprMapsToSource(f.Left(), join.left, m.tableProps) &&
@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -118,8 +119,8 @@ func getIndexes(
 		}
 	case *expression.InTuple, *expression.HashInTuple:
 		cmp := e.(expression.Comparer)
-		if !isEvaluable(cmp.Left()) && isEvaluable(cmp.Right()) {
-			gf := expression.ExtractGetField(cmp.Left())
+		if !isEvaluable(cmp.Left()) && isEvaluable(cmp.Right()) && !isCoercedInTuple(cmp.Left(), cmp.Right()) {
+			gf := extractTableGetField(cmp.Left())
 			if gf == nil {
 				return nil, nil
 			}
@@ -158,7 +159,7 @@ func getIndexes(
 					return nil, err
 				}
 
-				getField := expression.ExtractGetField(cmp.Left())
+				getField := extractTableGetField(cmp.Left())
 				if getField == nil {
 					return result, nil
 				}
@@ -182,7 +183,7 @@ func getIndexes(
 			return result, err
 		}
 
-		getField := expression.ExtractGetField(e)
+		getField := extractTableGetField(e)
 		if getField == nil {
 			return result, nil
 		}
@@ -201,7 +202,7 @@ func getIndexes(
 		}
 	case *expression.Between:
 		if !isEvaluable(e.Val) && isEvaluable(e.Upper) && isEvaluable(e.Lower) {
-			gf := expression.ExtractGetField(e)
+			gf := extractTableGetField(e)
 			if gf == nil {
 				return nil, nil
 			}
@@ -226,7 +227,7 @@ func getIndexes(
 					return nil, err
 				}
 
-				getField := expression.ExtractGetField(e)
+				getField := extractTableGetField(e)
 				if getField == nil {
 					return result, nil
 				}
@@ -361,11 +362,11 @@ func getComparisonIndexLookup(
 		left, right, e = swapTermsOfExpression(e)
 	}
 
-	if isEvaluable(left) || !isEvaluable(right) {
+	if isEvaluable(left) || !isEvaluable(right) || expression.IsCoercedComparison(left, right) {
 		return nil, nil
 	}
 
-	gf := expression.ExtractGetField(left)
+	gf := extractTableGetField(left)
 	if gf == nil {
 		return nil, nil
 	}
@@ -444,6 +445,28 @@ func swapTermsOfExpression(e expression.Comparer) (left sql.Expression, right sq
 	return left, right, e
 }
 
+// extractTableGetField returns a field of the expression given if all its fields are of the same table, as are the
+// fields of the expressions of functional key parts, or nil otherwise.
+func extractTableGetField(e sql.Expression) *expression.GetField {
+	var field *expression.GetField
+	multipleTables := false
+	sql.Inspect(e, func(expr sql.Expression) bool {
+		if f, ok := expr.(*expression.GetField); ok {
+			if field == nil {
+				field = f
+			} else if !strings.EqualFold(field.Table(), f.Table()) {
+				multipleTables = true
+			}
+		}
+		return !multipleTables
+	})
+
+	if multipleTables {
+		return nil
+	}
+	return field
+}
+
 func getNegatedIndexes(
 	ctx *sql.Context,
 	ia *indexAnalyzer,
@@ -738,96 +761,16 @@ func getMultiColumnIndexForExpressions(
 		allMatches = append(allMatches, matchedExprs...)
 
 		for _, expr := range matchedExprs {
-			switch expr.comparison.(type) {
-			case *expression.Equals,
-				*expression.NullSafeEquals,
-				*expression.LessThan,
-				*expression.GreaterThan,
-				*expression.LessThanOrEqual,
-				*expression.GreaterThanOrEqual:
-				if !isEvaluable(expr.comparand) {
-					return nil, nil
-				}
-				val, err := expr.comparand.Eval(ctx, nil)
-				if err != nil {
-					return nil, err
-				}
-				expressions = append(expressions, expr.colExpr)
-
-				switch expr.comparison.(type) {
-				case *expression.NullSafeEquals:
-					if val == nil {
-						indexBuilder = indexBuilder.IsNull(ctx, expr.col.String())
-					} else {
-						indexBuilder = indexBuilder.Equals(ctx, expr.col.String(), val)
-					}
-				case *expression.Equals:
-					indexBuilder = indexBuilder.Equals(ctx, expr.col.String(), val)
-				case *expression.GreaterThan:
-					indexBuilder = indexBuilder.GreaterThan(ctx, expr.col.String(), val)
-				case *expression.GreaterThanOrEqual:
-					indexBuilder = indexBuilder.GreaterOrEqual(ctx, expr.col.String(), val)
-				case *expression.LessThan:
-					indexBuilder = indexBuilder.LessThan(ctx, expr.col.String(), val)
-				case *expression.LessThanOrEqual:
-					indexBuilder = indexBuilder.LessOrEqual(ctx, expr.col.String(), val)
-				default:
-					return nil, nil
-				}
-			case *expression.Between:
-				between, ok := expr.comparison.(*expression.Between)
-				if !ok {
-					return nil, nil
-				}
-				lower, err := between.Lower.Eval(ctx, nil)
-				if err != nil {
-					return nil, err
-				}
-				upper, err := between.Upper.Eval(ctx, nil)
-				if err != nil {
-					return nil, err
-				}
-				expressions = append(expressions, expression.ExtractGetField(between))
-				indexBuilder = indexBuilder.GreaterOrEqual(ctx, expr.col.String(), lower)
-				indexBuilder = indexBuilder.LessOrEqual(ctx, expr.col.String(), upper)
-			case *expression.InTuple:
-				cmp := expr.comparison.(expression.Comparer)
-				if !isEvaluable(cmp.Left()) && isEvaluable(cmp.Right()) {
-					value, err := cmp.Right().Eval(ctx, nil)
-					if err != nil {
-						return nil, err
-					}
-					values, ok := value.([]interface{})
-					if ok {
-						indexBuilder = indexBuilder.Equals(ctx, expr.col.String(), values...)
-					} else {
-						// For single length tuples, we don't return []interface{}, just the first element
-						indexBuilder = indexBuilder.Equals(ctx, expr.col.String(), value)
-					}
-				} else {
-					return nil, nil
-				}
-			case *expression.Not:
-				switch expr.comparison.(*expression.Not).Child.(type) {
-				//TODO: We should transform NOT nodes for comparisons at some other analyzer step, e.g. (NOT <) becomes (>=)
-				case *expression.NullSafeEquals, *expression.Equals:
-					val, err := expr.comparand.Eval(ctx, nil)
-					if err != nil {
-						return nil, err
-					}
-					_, nullsafe := expr.comparison.(*expression.Not).Child.(*expression.NullSafeEquals)
-					expressions = append(expressions, selectedExpr)
-					if val == nil && nullsafe {
-						indexBuilder = indexBuilder.IsNotNull(ctx, expr.col.String())
-					} else {
-						indexBuilder = indexBuilder.NotEquals(ctx, expr.col.String(), val)
-					}
-				default:
-					return nil, nil
-				}
-			default:
+			var ok bool
+			var err error
+			indexBuilder, ok, err = addToIndexBuilder(ctx, index, indexBuilder, expr)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
 				return nil, nil
 			}
+			expressions = append(expressions, expr.colExpr)
 		}
 	}
 
@@ -838,6 +781,14 @@ func getMultiColumnIndexForExpressions(
 	if lookup.IsEmpty() {
 		return nil, nil
 	}
+	// Each combination of the ranges of the columns is its own range, and combinations of IN lists and disjunctions
+	// can overlap, so they're merged to avoid returning the same row more than once
+	newRanges, err := sql.RemoveOverlappingRanges(lookup.Ranges...)
+	if err != nil {
+		return nil, nil
+	}
+	lookup = sql.IndexLookup{Index: index, Ranges: newRanges}
+
 	var lookupExpr sql.Expression
 	for _, m := range allMatches {
 		if lookupExpr == nil {
@@ -854,6 +805,133 @@ func getMultiColumnIndexForExpressions(
 	}, nil
 }
 
+// addToIndexBuilder adds the ranges of the comparison of the joinColExpr given to the index builder given, and returns
+// the index builder along with whether the comparison could be expressed as ranges of its column. A disjunction of
+// comparisons on a single column, such as (col = 1 OR col > 5), adds the union of the ranges of each comparison.
+func addToIndexBuilder(ctx *sql.Context, index sql.Index, b *sql.IndexBuilder, expr *joinColExpr) (*sql.IndexBuilder, bool, error) {
+	switch expr.comparison.(type) {
+	case *expression.Equals,
+		*expression.NullSafeEquals,
+		*expression.LessThan,
+		*expression.GreaterThan,
+		*expression.LessThanOrEqual,
+		*expression.GreaterThanOrEqual:
+		if !isEvaluable(expr.comparand) || expression.IsCoercedComparison(expr.colExpr, expr.comparand) {
+			return b, false, nil
+		}
+		val, err := expr.comparand.Eval(ctx, nil)
+		if err != nil {
+			return b, false, err
+		}
+
+		switch expr.comparison.(type) {
+		case *expression.NullSafeEquals:
+			if val == nil {
+				b = b.IsNull(ctx, expr.col.String())
+			} else {
+				b = b.Equals(ctx, expr.col.String(), val)
+			}
+		case *expression.Equals:
+			b = b.Equals(ctx, expr.col.String(), val)
+		case *expression.GreaterThan:
+			b = b.GreaterThan(ctx, expr.col.String(), val)
+		case *expression.GreaterThanOrEqual:
+			b = b.GreaterOrEqual(ctx, expr.col.String(), val)
+		case *expression.LessThan:
+			b = b.LessThan(ctx, expr.col.String(), val)
+		case *expression.LessThanOrEqual:
+			b = b.LessOrEqual(ctx, expr.col.String(), val)
+		default:
+			return b, false, nil
+		}
+	case *expression.Between:
+		between, ok := expr.comparison.(*expression.Between)
+		if !ok || expression.IsCoercedComparison(between.Val, between.Lower) || expression.IsCoercedComparison(between.Val, between.Upper) {
+			return b, false, nil
+		}
+		lower, err := between.Lower.Eval(ctx, nil)
+		if err != nil {
+			return b, false, err
+		}
+		upper, err := between.Upper.Eval(ctx, nil)
+		if err != nil {
+			return b, false, err
+		}
+		b = b.GreaterOrEqual(ctx, expr.col.String(), lower)
+		b = b.LessOrEqual(ctx, expr.col.String(), upper)
+	case *expression.InTuple:
+		cmp := expr.comparison.(expression.Comparer)
+		if !isEvaluable(cmp.Left()) && isEvaluable(cmp.Right()) && !isCoercedInTuple(cmp.Left(), cmp.Right()) {
+			value, err := cmp.Right().Eval(ctx, nil)
+			if err != nil {
+				return b, false, err
+			}
+			values, ok := value.([]interface{})
+			if ok {
+				b = b.Equals(ctx, expr.col.String(), values...)
+			} else {
+				// For single length tuples, we don't return []interface{}, just the first element
+				b = b.Equals(ctx, expr.col.String(), value)
+			}
+		} else {
+			return b, false, nil
+		}
+	case *expression.Not:
+		switch expr.comparison.(*expression.Not).Child.(type) {
+		//TODO: We should transform NOT nodes for comparisons at some other analyzer step, e.g. (NOT <) becomes (>=)
+		case *expression.NullSafeEquals, *expression.Equals:
+			if expression.IsCoercedComparison(expr.colExpr, expr.comparand) {
+				return b, false, nil
+			}
+			val, err := expr.comparand.Eval(ctx, nil)
+			if err != nil {
+				return b, false, err
+			}
+			_, nullsafe := expr.comparison.(*expression.Not).Child.(*expression.NullSafeEquals)
+			if val == nil && nullsafe {
+				b = b.IsNotNull(ctx, expr.col.String())
+			} else {
+				b = b.NotEquals(ctx, expr.col.String(), val)
+			}
+		default:
+			return b, false, nil
+		}
+	case *expression.Or:
+		disjuncts := splitDisjunction(expr.comparison)
+		builders := make([]*sql.IndexBuilder, len(disjuncts))
+		for i, disjunct := range disjuncts {
+			_, disjunctExpr := extractColumnExpr(disjunct)
+			if disjunctExpr == nil {
+				return b, false, nil
+			}
+			builder, ok, err := addToIndexBuilder(ctx, index, sql.NewIndexBuilder(index), disjunctExpr)
+			if err != nil || !ok {
+				return b, false, err
+			}
+			builders[i] = builder
+		}
+		b = b.Or(ctx, expr.col.String(), builders...)
+	default:
+		return b, false, nil
+	}
+	return b, true, nil
+}
+
+// isCoercedInTuple returns whether comparing the expression given to any of the values of the tuple given converts
+// their types in a way that index ranges can't express.
+func isCoercedInTuple(left, tuple sql.Expression) bool {
+	values := []sql.Expression{tuple}
+	if t, ok := tuple.(expression.Tuple); ok {
+		values = t
+	}
+	for _, v := range values {
+		if expression.IsCoercedComparison(left, v) {
+			return true
+		}
+	}
+	return false
+}
+
 // A joinColExpr  captures a GetField expression used in a comparison, as well as some additional contextual
 // information. Example, for the base expression col1 + 1 > col2 - 1:
 // col refers to `col1`
@@ -1003,6 +1081,32 @@ func extractColumnExpr(e sql.Expression) (string, *joinColExpr) {
 			comparison:   e,
 			matchnull:    false,
 		}
+	case *expression.Or:
+		// A disjunction can only be expressed as ranges of a column if each of its terms compares that same column
+		var table string
+		var col *joinColExpr
+		for _, disjunct := range splitDisjunction(e) {
+			disjunctTable, disjunctCol := extractColumnExpr(disjunct)
+			if disjunctCol == nil {
+				return "", nil
+			}
+			if _, ok := disjunctCol.colExpr.(*expression.GetField); !ok {
+				return "", nil
+			}
+			if col == nil {
+				table, col = disjunctTable, disjunctCol
+			} else if col.col.String() != disjunctCol.col.String() {
+				return "", nil
+			}
+		}
+		return table, &joinColExpr{
+			col:          col.col,
+			colExpr:      col.col,
+			comparand:    nil,
+			comparandCol: nil,
+			comparison:   e,
+			matchnull:    false,
+		}
 	default:
 		return "", nil
 	}
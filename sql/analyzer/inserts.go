@@ -129,7 +129,7 @@ func resolveInsertRows(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 		}
 
 		// The schema of the destination node and the underlying table differ subtly in terms of defaults
-		project, err := wrapRowSource(ctx, source, insertable, insert.Destination.Schema(), columnNames)
+		project, err := wrapRowSource(ctx, a, source, insertable, insert.Destination.Schema(), columnNames)
 		if err != nil {
 			return nil, transform.SameTree, err
 		}
@@ -179,7 +179,7 @@ func existsNonZeroValueCount(values sql.Node) bool {
 
 // wrapRowSource wraps the original row source in a projection so that its schema matches the full schema of the
 // underlying table, in the same order.
-func wrapRowSource(ctx *sql.Context, insertSource sql.Node, destTbl sql.Table, schema sql.Schema, columnNames []string) (sql.Node, error) {
+func wrapRowSource(ctx *sql.Context, a *Analyzer, insertSource sql.Node, destTbl sql.Table, schema sql.Schema, columnNames []string) (sql.Node, error) {
 	projExprs := make([]sql.Expression, len(schema))
 	for i, f := range schema {
 		found := false
@@ -191,6 +191,17 @@ func wrapRowSource(ctx *sql.Context, insertSource sql.Node, destTbl sql.Table, s
 			}
 		}
 
+		if f.Generated != nil {
+			// The DEFAULT values of VALUES have already been validated, and generated columns can't be inserted
+			// anything else
+			if found && !isValuesSource(insertSource) {
+				return nil, sql.ErrGeneratedColumnValue.New(f.Name, destTbl.Name())
+			}
+			// The value is computed by the projection of the generated columns
+			projExprs[i] = expression.NewLiteral(nil, f.Type)
+			continue
+		}
+
 		if !found {
 			if !f.Nullable && f.Default == nil && !f.AutoIncrement {
 				return nil, sql.ErrInsertIntoNonNullableDefaultNullColumn.New(f.Name)
@@ -212,7 +223,16 @@ func wrapRowSource(ctx *sql.Context, insertSource sql.Node, destTbl sql.Table, s
 		return nil, err
 	}
 
-	return plan.NewProject(projExprs, insertSource), nil
+	return wrapGeneratedColumns(ctx, a, plan.NewProject(projExprs, insertSource), schema, 0)
+}
+
+// isValuesSource returns whether the row source of an insert given is VALUES.
+func isValuesSource(insertSource sql.Node) bool {
+	if exchange, ok := insertSource.(*plan.Exchange); ok {
+		insertSource = exchange.Child
+	}
+	_, ok := insertSource.(*plan.Values)
+	return ok
 }
 
 func validateColumns(columnNames []string, dstSchema sql.Schema) error {
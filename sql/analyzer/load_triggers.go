@@ -90,6 +90,33 @@ func loadTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel R
 				}
 			}
 			return node.WithTriggers(triggersForTable), transform.NewTree, nil
+		case *plan.RenameTable:
+			// triggers move with their tables when they're renamed
+			loadedTriggers, err := loadTriggersFromDb(ctx, node.Database())
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			var movedTriggers []sql.TriggerDefinition
+			for _, trigger := range loadedTriggers {
+				tableName := trigger.Table.(*plan.UnresolvedTable).Name()
+				renamedTable := node.RenamedTable(tableName)
+				if renamedTable == tableName {
+					continue
+				}
+				createStatement, err := parse.RenameTriggerTable(trigger.CreateTriggerString, renamedTable)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
+				movedTriggers = append(movedTriggers, sql.TriggerDefinition{
+					Name:            trigger.TriggerName,
+					CreateStatement: createStatement,
+					CreatedAt:       trigger.CreatedAt,
+				})
+			}
+			if len(movedTriggers) == 0 {
+				return node, transform.SameTree, nil
+			}
+			return node.WithTriggers(movedTriggers), transform.NewTree, nil
 		default:
 			return node, transform.SameTree, nil
 		}
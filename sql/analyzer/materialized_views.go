@@ -0,0 +1,139 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"reflect"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// resolveMaterializedViews sets the source of REFRESH MATERIALIZED VIEW statements to the definition of their view.
+// In other statements, it replaces the queries with the same shape as the definition of a materialized view of the
+// current database with the view's backing table, while the view is fresh enough for its MAX STALENESS. It does
+// nothing unless materialized views are enabled by registering a sql.MaterializedViewRegistry.
+func resolveMaterializedViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	registry, ok := sql.GetService(ctx, sql.MaterializedViewsService)
+	if !ok {
+		return n, transform.SameTree, nil
+	}
+
+	if refresh, ok := n.(*plan.RefreshMaterializedView); ok {
+		if refresh.Source != nil {
+			return n, transform.SameTree, nil
+		}
+		dbName := refresh.Database().Name()
+		if dbName == "" {
+			dbName = ctx.GetCurrentDatabase()
+		}
+		view, ok := registry.View(dbName, refresh.Name)
+		if !ok {
+			return nil, transform.SameTree, sql.ErrMaterializedViewDoesNotExist.New(dbName, refresh.Name)
+		}
+		source, err := parse.Parse(ctx, view.Definition)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		source, _, err = applyDatabaseQualifierToView(source, a, view.Database)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return refresh.WithSource(source), transform.NewTree, nil
+	}
+	if create, ok := n.(*plan.CreateMaterializedView); ok {
+		// the definition of a view created in another database reads the tables of that database, as it does when the
+		// view is refreshed
+		if dbName := create.Database().Name(); dbName != "" {
+			source, same, err := applyDatabaseQualifierToView(create.Source, a, dbName)
+			if err != nil || same {
+				return n, transform.SameTree, err
+			}
+			n, err = create.WithChildren(source)
+			return n, transform.NewTree, err
+		}
+		return n, transform.SameTree, nil
+	}
+	if plan.IsDDLNode(n) {
+		return n, transform.SameTree, nil
+	}
+
+	// The shapes of the definitions are the string representations of their parsed statements, which only match
+	// queries written the same way, regardless of whitespace, comments, and the case of keywords
+	now := ctx.QueryTime()
+	shapes := make(map[string]sql.MaterializedView)
+	nodeTypes := make(map[reflect.Type]bool)
+	for _, view := range registry.ViewsInDatabase(ctx.GetCurrentDatabase()) {
+		if !view.IsFresh(now) {
+			continue
+		}
+		definition, err := parse.Parse(ctx, view.Definition)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		shapes[definition.String()] = view
+		nodeTypes[reflect.TypeOf(definition)] = true
+	}
+	if len(shapes) == 0 {
+		return n, transform.SameTree, nil
+	}
+	return substituteMaterializedViews(a, n, shapes, nodeTypes)
+}
+
+// substituteMaterializedViews replaces the outermost nodes of the tree given matching one of the shapes given with
+// a query reading all the columns of the backing table of the materialized view of the shape.
+func substituteMaterializedViews(
+	a *Analyzer,
+	n sql.Node,
+	shapes map[string]sql.MaterializedView,
+	nodeTypes map[reflect.Type]bool,
+) (sql.Node, transform.TreeIdentity, error) {
+	if nodeTypes[reflect.TypeOf(n)] {
+		if view, ok := shapes[n.String()]; ok {
+			a.Log("query replaced by materialized view %q", view.Name)
+			return plan.NewProject(
+				[]sql.Expression{expression.NewStar()},
+				plan.NewUnresolvedTable(view.Name, view.Database),
+			), transform.NewTree, nil
+		}
+	}
+	if opaque, ok := n.(sql.OpaqueNode); ok && opaque.Opaque() {
+		return n, transform.SameTree, nil
+	}
+
+	children := n.Children()
+	var newChildren []sql.Node
+	for i, child := range children {
+		newChild, same, err := substituteMaterializedViews(a, child, shapes, nodeTypes)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if !same {
+			if newChildren == nil {
+				newChildren = make([]sql.Node, len(children))
+				copy(newChildren, children)
+			}
+			newChildren[i] = newChild
+		}
+	}
+	if newChildren == nil {
+		return n, transform.SameTree, nil
+	}
+	n, err := n.WithChildren(newChildren...)
+	return n, transform.NewTree, err
+}
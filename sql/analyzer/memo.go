@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/optgen/cmd/support"
@@ -176,6 +177,7 @@ func (m *Memo) applyHint(hint Hint) {
 	case HintTypeJoinOrder:
 		m.WithJoinOrder(hint.Args)
 	case HintTypeJoinFixedOrder:
+		m.WithJoinFixedOrder()
 	case HintTypeInnerJoin, HintTypeMergeJoin, HintTypeLookupJoin, HintTypeHashJoin, HintTypeSemiJoin, HintTypeAntiJoin:
 		m.WithJoinOp(hint.Typ, hint.Args[0], hint.Args[1])
 	default:
@@ -199,6 +201,26 @@ func (m *Memo) WithJoinOrder(tables []string) {
 	}
 }
 
+// WithJoinFixedOrder adds a join order hint for the tables in the order
+// they're written in, which is the order the join order builder
+// memoized them in.
+func (m *Memo) WithJoinFixedOrder() {
+	ids := make([]GroupId, 0, len(m.tableProps.grpToName))
+	for id := range m.tableProps.grpToName {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	order := make(map[GroupId]uint64)
+	for i, id := range ids {
+		order[id] = uint64(i)
+	}
+	hint := newJoinOrderHint(order)
+	hint.build(m.root)
+	if hint.isValid() {
+		m.hints.order = hint
+	}
+}
+
 func (m *Memo) WithJoinOp(op HintType, left, right string) {
 	lGrp, _ := m.tableProps.getId(left)
 	rGrp, _ := m.tableProps.getId(right)
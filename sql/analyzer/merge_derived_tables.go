@@ -0,0 +1,321 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// mergeDerivedTables merges derived tables and views into the query block that reads them, like MySQL's derived_merge
+// optimization. A derived table can be merged when it selects columns of a single table by their own names, with an
+// optional filter, and so has no aggregation, window, LIMIT, DISTINCT or ORDER BY. The merged derived table is replaced
+// by an alias of its table with the name of the derived table, which lets filters and index lookups of the outer query
+// be pushed down to the table. Derived tables named in a NO_MERGE hint, or all of them when the hint has no
+// arguments, are not merged.
+func mergeDerivedTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("merge_derived_tables")
+	defer span.End()
+
+	if !n.Resolved() {
+		return n, transform.SameTree, nil
+	}
+
+	// The target tables of updates and deletes through joins are resolved by name, so their derived tables are left
+	// alone
+	switch n.(type) {
+	case *plan.InsertInto, *plan.Update, *plan.DeleteFrom:
+		return n, transform.SameTree, nil
+	}
+
+	// Subquery expressions might refer to the columns of a derived table by name from an inner scope, which we don't
+	// rewrite
+	if queryBlockHasSubqueryExpressions(n) {
+		return n, transform.SameTree, nil
+	}
+
+	noMerge, noMergeAll := noMergeHints(n)
+	if noMergeAll {
+		return n, transform.SameTree, nil
+	}
+
+	mergedFilters := make(map[*plan.Filter]struct{})
+	merged, same, err := transform.NodeWithCtx(n, nil, func(c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		switch n := c.Node.(type) {
+		case *plan.SubqueryAlias:
+			if !readsDerivedTables(c.Parent) {
+				return n, transform.SameTree, nil
+			}
+			if _, ok := noMerge[strings.ToLower(n.Name())]; ok {
+				return n, transform.SameTree, nil
+			}
+			mergedTable := mergeDerivedTable(n)
+			if mergedTable == nil {
+				return n, transform.SameTree, nil
+			}
+			f, hasFilter := mergedTable.(*plan.Filter)
+			if hasFilter {
+				if j, ok := c.Parent.(*plan.JoinNode); ok && !canMoveFilterToJoinCond(j, c.ChildNum) {
+					return n, transform.SameTree, nil
+				}
+				mergedFilters[f] = struct{}{}
+			}
+			a.Log("merging derived table %s into the outer query", n.Name())
+			return mergedTable, transform.NewTree, nil
+		case *plan.Filter:
+			// The filter of a merged derived table is combined with the filter of the outer query, as in MySQL, so that
+			// they can be pushed down together
+			child, ok := n.Child.(*plan.Filter)
+			if !ok {
+				return n, transform.SameTree, nil
+			}
+			if _, ok := mergedFilters[child]; !ok {
+				return n, transform.SameTree, nil
+			}
+			return plan.NewFilter(expression.JoinAnd(child.Expression, n.Expression), child.Child), transform.NewTree, nil
+		case *plan.JoinNode:
+			// The join planner expects tables as the children of a join, so the filter of a merged derived table
+			// becomes part of the join condition
+			children := n.Children()
+			cond := n.Filter
+			var moved bool
+			for i, child := range children {
+				f, ok := child.(*plan.Filter)
+				if !ok {
+					continue
+				}
+				if _, ok := mergedFilters[f]; !ok {
+					continue
+				}
+				cond = expression.JoinAnd(cond, f.Expression)
+				children[i] = f.Child
+				moved = true
+			}
+			if !moved {
+				return n, transform.SameTree, nil
+			}
+			newJoin, err := n.WithChildren(children...)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			newJoin, err = newJoin.(*plan.JoinNode).WithExpressions(cond)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			return newJoin, transform.NewTree, nil
+		default:
+			return n, transform.SameTree, nil
+		}
+	})
+	if err != nil || same {
+		return n, transform.SameTree, err
+	}
+
+	merged, _, err = FixFieldIndexesForNode(a, scope, merged)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+
+	// The columns of the query block must not change, since the nodes and scopes above it refer to them
+	if !sameColumns(n.Schema(), merged.Schema()) {
+		return n, transform.SameTree, nil
+	}
+	return merged, transform.NewTree, nil
+}
+
+// mergeDerivedTable returns the node that replaces the derived table given when it's merged into the outer query, or
+// nil if it can't be merged.
+func mergeDerivedTable(sqa *plan.SubqueryAlias) sql.Node {
+	if len(sqa.Columns) > 0 {
+		return nil
+	}
+
+	var project *plan.Project
+	var filters []sql.Expression
+	node := sqa.Child
+	for node != nil {
+		switch n := node.(type) {
+		case *plan.Filter:
+			filters = append(filters, n.Expression)
+			node = n.Child
+			continue
+		case *plan.Project:
+			if project != nil {
+				return nil
+			}
+			project = n
+			node = n.Child
+			continue
+		}
+		break
+	}
+	if project == nil {
+		return nil
+	}
+
+	var table *plan.ResolvedTable
+	switch n := node.(type) {
+	case *plan.ResolvedTable:
+		table = n
+	case *plan.TableAlias:
+		table, _ = n.Child.(*plan.ResolvedTable)
+	}
+	if table == nil {
+		return nil
+	}
+	tableName := node.(sql.Nameable).Name()
+
+	// Every column of the derived table must be a column of its table with the same name, so that the outer query can
+	// keep referring to it by name
+	for _, e := range project.Projections {
+		var name string
+		if alias, ok := e.(*expression.Alias); ok {
+			name = alias.Name()
+			e = alias.Child
+		}
+		gf, ok := e.(*expression.GetField)
+		if !ok || (name != "" && gf.Name() != name) || !strings.EqualFold(gf.Table(), tableName) {
+			return nil
+		}
+	}
+
+	for _, f := range filters {
+		if containsSubquery(f) || !onlyReferencesTable(f, tableName) {
+			return nil
+		}
+	}
+
+	var merged sql.Node = plan.NewTableAlias(sqa.Name(), table)
+	if len(filters) > 0 {
+		cond, _, err := transform.Expr(expression.JoinAnd(filters...), func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			if gf, ok := e.(*expression.GetField); ok {
+				return gf.WithTable(sqa.Name()), transform.NewTree, nil
+			}
+			return e, transform.SameTree, nil
+		})
+		if err != nil {
+			return nil
+		}
+		merged = plan.NewFilter(cond, merged)
+	}
+	return merged
+}
+
+// readsDerivedTables returns whether the node given is an operator of a query block whose derived table children can be
+// merged into it. Other nodes, such as SHOW CREATE TABLE for a view, depend on their child being a SubqueryAlias.
+func readsDerivedTables(n sql.Node) bool {
+	switch n.(type) {
+	case *plan.Project, *plan.Filter, *plan.JoinNode, *plan.GroupBy, *plan.Having, *plan.Sort, *plan.Limit,
+		*plan.Offset, *plan.Distinct, *plan.Window:
+		return true
+	default:
+		return false
+	}
+}
+
+// canMoveFilterToJoinCond returns whether a filter on the child of the join given with the index given has the same
+// result as part of the join condition, which is the case for inner joins and the inner side of outer joins.
+func canMoveFilterToJoinCond(j *plan.JoinNode, childNum int) bool {
+	if j.Filter == nil {
+		return false
+	}
+	switch j.Op {
+	case plan.JoinTypeInner:
+		return true
+	case plan.JoinTypeLeftOuter:
+		return childNum == 1
+	case plan.JoinTypeRightOuter:
+		return childNum == 0
+	default:
+		return false
+	}
+}
+
+// onlyReferencesTable returns whether all the columns in the expression given are columns of the table named.
+func onlyReferencesTable(e sql.Expression, tableName string) bool {
+	return !transform.InspectExpr(e, func(e sql.Expression) bool {
+		gf, ok := e.(*expression.GetField)
+		return ok && !strings.EqualFold(gf.Table(), tableName)
+	})
+}
+
+// queryBlockHasSubqueryExpressions returns whether any node of the query block given, not counting the derived tables
+// it reads, has a subquery expression.
+func queryBlockHasSubqueryExpressions(n sql.Node) bool {
+	var found bool
+	transform.Inspect(n, func(n sql.Node) bool {
+		if found {
+			return false
+		}
+		if ne, ok := n.(sql.Expressioner); ok {
+			for _, e := range ne.Expressions() {
+				if containsSubquery(e) {
+					found = true
+					return false
+				}
+			}
+		}
+		_, isSqa := n.(*plan.SubqueryAlias)
+		return !isSqa
+	})
+	return found
+}
+
+// noMergeHints returns the lower-cased names of the derived tables given in NO_MERGE hints of the query block given,
+// and whether a NO_MERGE hint without arguments disables merging for all of them.
+func noMergeHints(n sql.Node) (map[string]struct{}, bool) {
+	names := make(map[string]struct{})
+	var all bool
+	transform.Inspect(n, func(n sql.Node) bool {
+		var comment string
+		switch n := n.(type) {
+		case *plan.JoinNode:
+			comment = n.Comment()
+		case *plan.SubqueryAlias:
+			comment = n.Comment()
+		}
+		for _, hint := range parseJoinHints(comment) {
+			if hint.Typ != HintTypeNoMerge {
+				continue
+			}
+			if len(hint.Args) == 0 {
+				all = true
+			}
+			for _, arg := range hint.Args {
+				names[arg] = struct{}{}
+			}
+		}
+		_, isSqa := n.(*plan.SubqueryAlias)
+		return !isSqa
+	})
+	return names, all
+}
+
+// sameColumns returns whether the schemas given have the same columns, by name and source.
+func sameColumns(a, b sql.Schema) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Source != b[i].Source {
+			return false
+		}
+	}
+	return true
+}
@@ -45,36 +45,6 @@ func eraseProjection(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope,
 	})
 }
 
-// optimizeDistinct substitutes a Distinct node for an OrderedDistinct node when the child of Distinct is already
-// ordered. The OrderedDistinct node is much faster and uses much less memory, since it only has to compare the
-// previous row to the current one to determine its distinct-ness.
-func optimizeDistinct(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
-	span, ctx := ctx.Span("optimize_distinct")
-	defer span.End()
-
-	if n, ok := node.(*plan.Distinct); ok {
-		var sortField *expression.GetField
-		transform.Inspect(n, func(node sql.Node) bool {
-			// TODO: this is a bug. Every column in the output must be sorted in order for OrderedDistinct to produce a
-			//  correct result. This only checks one sort field
-			if sort, ok := node.(*plan.Sort); ok && sortField == nil {
-				if col, ok := sort.SortFields[0].Column.(*expression.GetField); ok {
-					sortField = col
-				}
-				return false
-			}
-			return true
-		})
-
-		if sortField != nil && n.Schema().Contains(sortField.Name(), sortField.Table()) {
-			a.Log("distinct optimized for ordered output")
-			return plan.NewOrderedDistinct(n.Child), transform.NewTree, nil
-		}
-	}
-
-	return node, transform.SameTree, nil
-}
-
 // moveJoinConditionsToFilter looks for expressions in a join condition that reference only tables in the left or right
 // side of the join, and move those conditions to a new Filter node instead. If the join condition is empty after these
 // moves, the join is converted to a CrossJoin.
@@ -202,7 +172,8 @@ func moveJoinConditionsToFilter(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 	return resultNode, resultIdentity, nil
 }
 
-// removeUnnecessaryConverts removes any Convert expressions that don't alter the type of the expression.
+// removeUnnecessaryConverts removes any Convert expressions that don't alter the type of the expression. Conversions
+// to types with a length are kept, since they pad or truncate their values.
 func removeUnnecessaryConverts(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	span, ctx := ctx.Span("remove_unnecessary_converts")
 	defer span.End()
@@ -212,7 +183,7 @@ func removeUnnecessaryConverts(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 	}
 
 	return transform.NodeExprs(n, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
-		if c, ok := e.(*expression.Convert); ok && c.Child.Type() == c.Type() {
+		if c, ok := e.(*expression.Convert); ok && c.Child.Type() == c.Type() && c.TypeLength() == 0 {
 			return c.Child, transform.NewTree, nil
 		}
 
@@ -22,7 +22,9 @@ import (
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -81,36 +83,85 @@ func TestOptimizeDistinct(t *testing.T) {
 		{Name: "a", Source: "foo"},
 		{Name: "b", Source: "foo"},
 	}), nil)
+	t2 := memory.NewTable("bar", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "bar", Type: types.Int64, PrimaryKey: true},
+		{Name: "b", Source: "bar", Type: types.Int64, Nullable: true},
+	}), nil)
 
 	testCases := []struct {
 		name      string
 		child     sql.Node
 		optimized bool
+		removed   bool
 	}{
 		{
-			"without sort",
-			plan.NewResolvedTable(t1, nil, nil),
-			false,
+			name:  "without sort",
+			child: plan.NewResolvedTable(t1, nil, nil),
 		},
 		{
-			"sort but column not projected",
-			plan.NewSort(
+			name: "sort but column not projected",
+			child: plan.NewSort(
 				[]sql.SortField{
 					{Column: gf(0, "foo", "c")},
 				},
 				plan.NewResolvedTable(t1, nil, nil),
 			),
-			false,
 		},
 		{
-			"sort and column projected",
-			plan.NewSort(
+			name: "sort on some of the columns",
+			child: plan.NewSort(
 				[]sql.SortField{
 					{Column: gf(0, "foo", "a")},
 				},
 				plan.NewResolvedTable(t1, nil, nil),
 			),
-			true,
+		},
+		{
+			name: "sort on all of the columns",
+			child: plan.NewSort(
+				[]sql.SortField{
+					{Column: gf(1, "foo", "b")},
+					{Column: gf(0, "foo", "a"), Order: sql.Descending},
+				},
+				plan.NewResolvedTable(t1, nil, nil),
+			),
+			optimized: true,
+		},
+		{
+			name: "sort on all of the columns after another column",
+			child: plan.NewProject(
+				[]sql.Expression{gf(0, "foo", "a")},
+				plan.NewSort(
+					[]sql.SortField{
+						{Column: gf(1, "foo", "b")},
+						{Column: gf(0, "foo", "a")},
+					},
+					plan.NewResolvedTable(t1, nil, nil),
+				),
+			),
+		},
+		{
+			name: "primary key projected",
+			child: plan.NewProject(
+				[]sql.Expression{gf(1, "bar", "b"), gf(0, "bar", "a")},
+				plan.NewResolvedTable(t2, nil, nil),
+			),
+			removed: true,
+		},
+		{
+			name: "primary key projected with an alias",
+			child: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("x", gf(0, "baz", "a"))},
+				plan.NewTableAlias("baz", plan.NewResolvedTable(t2, nil, nil)),
+			),
+			removed: true,
+		},
+		{
+			name: "primary key not projected",
+			child: plan.NewProject(
+				[]sql.Expression{gf(1, "bar", "b")},
+				plan.NewResolvedTable(t2, nil, nil),
+			),
 		},
 	}
 
@@ -123,6 +174,249 @@ func TestOptimizeDistinct(t *testing.T) {
 
 			_, ok := node.(*plan.OrderedDistinct)
 			require.Equal(t, tt.optimized, ok)
+			if tt.removed {
+				require.Equal(t, tt.child, node)
+			} else {
+				require.NotEqual(t, tt.child, node)
+			}
+		})
+	}
+}
+
+func TestApplyTableCount(t *testing.T) {
+	table := memory.NewPartitionedTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "foo", Type: types.Int64, PrimaryKey: true},
+		{Name: "b", Source: "foo", Type: types.Int64, Nullable: true},
+	}), nil, 2)
+	ctx := sql.NewEmptyContext()
+	for i := int64(0); i < 5; i++ {
+		require.NoError(t, table.Insert(ctx, sql.NewRow(i, nil)))
+	}
+	countStar := func() sql.Expression {
+		return aggregation.NewCount(expression.NewLiteral(int64(1), types.Int64))
+	}
+
+	testCases := []struct {
+		name     string
+		node     sql.Node
+		replaced bool
+	}{
+		{
+			name:     "count of all rows",
+			node:     plan.NewGroupBy([]sql.Expression{countStar()}, nil, plan.NewResolvedTable(table, nil, nil)),
+			replaced: true,
+		},
+		{
+			name: "counts of all rows of an aliased table",
+			node: plan.NewGroupBy(
+				[]sql.Expression{countStar(), expression.NewAlias("c", countStar())},
+				nil,
+				plan.NewTableAlias("f", plan.NewResolvedTable(table, nil, nil)),
+			),
+			replaced: true,
+		},
+		{
+			name: "count of a column",
+			node: plan.NewGroupBy([]sql.Expression{aggregation.NewCount(gf(1, "foo", "b"))}, nil, plan.NewResolvedTable(table, nil, nil)),
+		},
+		{
+			name: "count of groups",
+			node: plan.NewGroupBy([]sql.Expression{countStar()}, []sql.Expression{gf(1, "foo", "b")}, plan.NewResolvedTable(table, nil, nil)),
+		},
+		{
+			name: "count of filtered rows",
+			node: plan.NewGroupBy(
+				[]sql.Expression{countStar()},
+				nil,
+				plan.NewFilter(expression.NewEquals(gf(0, "foo", "a"), expression.NewLiteral(int64(1), types.Int64)), plan.NewResolvedTable(table, nil, nil)),
+			),
+		},
+	}
+
+	rule := getRule(applyTableCountId)
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			node, _, err := rule.Apply(ctx, NewDefault(nil), tt.node, nil, DefaultRuleSelector)
+			require.NoError(t, err)
+			if !tt.replaced {
+				require.Equal(t, tt.node, node)
+				return
+			}
+
+			count, ok := node.(*plan.TableCount)
+			require.True(t, ok)
+			require.Equal(t, tt.node.Schema(), count.Schema())
+			iter, err := count.RowIter(ctx, nil)
+			require.NoError(t, err)
+			rows, err := sql.RowIterToRows(ctx, nil, iter)
+			require.NoError(t, err)
+			expected := make(sql.Row, len(count.Schema()))
+			for i := range expected {
+				expected[i] = int64(5)
+			}
+			require.Equal(t, []sql.Row{expected}, rows)
+		})
+	}
+}
+
+func TestSimplifyExistsSubqueries(t *testing.T) {
+	table := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "foo", Type: types.Int64, PrimaryKey: true},
+		{Name: "b", Source: "foo", Type: types.Int64, Nullable: true},
+	}), nil)
+	sortByB := func(child sql.Node) sql.Node {
+		return plan.NewSort([]sql.SortField{{Column: gf(1, "foo", "b")}}, child)
+	}
+	exists := func(query sql.Node) sql.Node {
+		return plan.NewFilter(plan.NewExistsSubquery(plan.NewSubquery(query, "")), plan.NewResolvedTable(table, nil, nil))
+	}
+
+	testCases := []struct {
+		name     string
+		query    sql.Node
+		expected sql.Node
+	}{
+		{
+			name:     "sorted and distinct rows",
+			query:    plan.NewProject([]sql.Expression{gf(0, "foo", "a")}, sortByB(plan.NewDistinct(plan.NewResolvedTable(table, nil, nil)))),
+			expected: plan.NewProject([]sql.Expression{gf(0, "foo", "a")}, plan.NewResolvedTable(table, nil, nil)),
+		},
+		{
+			name: "sorted union",
+			query: plan.NewUnion(
+				plan.NewResolvedTable(table, nil, nil),
+				plan.NewDistinct(plan.NewResolvedTable(table, nil, nil)),
+				true, nil, sql.SortFields{{Column: gf(1, "foo", "b")}},
+			),
+			expected: plan.NewUnion(plan.NewResolvedTable(table, nil, nil), plan.NewResolvedTable(table, nil, nil), true, nil, nil),
+		},
+		{
+			name: "sorted join child",
+			query: plan.NewCrossJoin(
+				plan.NewTableAlias("x", sortByB(plan.NewResolvedTable(table, nil, nil))),
+				plan.NewResolvedTable(table, nil, nil),
+			),
+			expected: plan.NewCrossJoin(
+				plan.NewTableAlias("x", plan.NewResolvedTable(table, nil, nil)),
+				plan.NewResolvedTable(table, nil, nil),
+			),
+		},
+		{
+			name:     "sorted rows below a limit",
+			query:    plan.NewLimit(expression.NewLiteral(1, types.Int64), sortByB(plan.NewResolvedTable(table, nil, nil))),
+			expected: plan.NewLimit(expression.NewLiteral(1, types.Int64), sortByB(plan.NewResolvedTable(table, nil, nil))),
+		},
+		{
+			name:     "distinct rows below an aggregation",
+			query:    plan.NewGroupBy([]sql.Expression{gf(0, "foo", "a")}, nil, plan.NewDistinct(plan.NewResolvedTable(table, nil, nil))),
+			expected: plan.NewGroupBy([]sql.Expression{gf(0, "foo", "a")}, nil, plan.NewDistinct(plan.NewResolvedTable(table, nil, nil))),
+		},
+	}
+
+	rule := getRule(simplifyExistsSubqueriesId)
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			node, _, err := rule.Apply(sql.NewEmptyContext(), NewDefault(nil), exists(tt.query), nil, DefaultRuleSelector)
+			require.NoError(t, err)
+			require.Equal(t, exists(tt.expected), node)
+		})
+	}
+}
+
+func TestApplyIndexDistinct(t *testing.T) {
+	table := memory.NewPartitionedTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "foo", Type: types.Int64, PrimaryKey: true},
+		{Name: "b", Source: "foo", Type: types.Int64, Nullable: true},
+		{Name: "c", Source: "foo", Type: types.Int64, Nullable: true},
+	}), nil, 2)
+	ctx := sql.NewEmptyContext()
+	require.NoError(t, table.CreateIndex(ctx, sql.IndexDef{
+		Name:       "b",
+		Columns:    []sql.IndexColumn{{Name: "b"}},
+		Constraint: sql.IndexConstraint_None,
+		Storage:    sql.IndexUsing_BTree,
+	}))
+	for i, b := range []interface{}{int64(3), nil, int64(1), int64(3), nil, int64(2), int64(1)} {
+		require.NoError(t, table.Insert(ctx, sql.NewRow(int64(i), b, nil)))
+	}
+
+	testCases := []struct {
+		name     string
+		node     sql.Node
+		expected []sql.Row
+	}{
+		{
+			name: "distinct indexed column",
+			node: plan.NewDistinct(plan.NewProject(
+				[]sql.Expression{gf(1, "foo", "b")},
+				plan.NewResolvedTable(table, nil, nil),
+			)),
+			expected: []sql.Row{{nil}, {int64(1)}, {int64(2)}, {int64(3)}},
+		},
+		{
+			name: "distinct aliased column of an aliased table",
+			node: plan.NewDistinct(plan.NewProject(
+				[]sql.Expression{expression.NewAlias("x", gf(1, "f", "b"))},
+				plan.NewTableAlias("f", plan.NewResolvedTable(table, nil, nil)),
+			)),
+			expected: []sql.Row{{nil}, {int64(1)}, {int64(2)}, {int64(3)}},
+		},
+		{
+			name: "count of distinct indexed column",
+			node: plan.NewGroupBy(
+				[]sql.Expression{aggregation.NewCountDistinct(gf(1, "foo", "b"))},
+				nil,
+				plan.NewResolvedTable(table, nil, nil),
+			),
+			expected: []sql.Row{{int64(3)}},
+		},
+		{
+			name: "distinct unindexed column",
+			node: plan.NewDistinct(plan.NewProject(
+				[]sql.Expression{gf(2, "foo", "c")},
+				plan.NewResolvedTable(table, nil, nil),
+			)),
+		},
+		{
+			name: "distinct filtered column",
+			node: plan.NewDistinct(plan.NewProject(
+				[]sql.Expression{gf(1, "foo", "b")},
+				plan.NewFilter(expression.NewEquals(gf(0, "foo", "a"), expression.NewLiteral(int64(1), types.Int64)), plan.NewResolvedTable(table, nil, nil)),
+			)),
+		},
+		{
+			name: "count of distinct grouped column",
+			node: plan.NewGroupBy(
+				[]sql.Expression{aggregation.NewCountDistinct(gf(1, "foo", "b"))},
+				[]sql.Expression{gf(2, "foo", "c")},
+				plan.NewResolvedTable(table, nil, nil),
+			),
+		},
+	}
+
+	rule := getRule(applyIndexDistinctId)
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			node, _, err := rule.Apply(ctx, NewDefault(nil), tt.node, nil, DefaultRuleSelector)
+			require.NoError(t, err)
+			if tt.expected == nil {
+				require.Equal(t, tt.node, node)
+				return
+			}
+
+			found := false
+			transform.Inspect(node, func(n sql.Node) bool {
+				_, ok := n.(*plan.IndexDistinct)
+				found = found || ok
+				return true
+			})
+			require.True(t, found)
+			require.Equal(t, tt.node.Schema(), node.Schema())
+			iter, err := node.RowIter(ctx, nil)
+			require.NoError(t, err)
+			rows, err := sql.RowIterToRows(ctx, nil, iter)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rows)
 		})
 	}
 }
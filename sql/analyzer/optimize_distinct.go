@@ -0,0 +1,313 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// optimizeDistinct removes Distinct nodes whose child can't return duplicate rows, because its rows include a unique
+// key of the table they're read from, and replaces Distinct nodes whose child returns rows sorted on all of their
+// columns with OrderedDistinct nodes. The rows can be sorted by a Sort node or by the index an IndexedTableAccess reads.
+// The OrderedDistinct node is much faster and uses much less memory, since it only has to compare the previous row to
+// the current one to determine its distinct-ness.
+func optimizeDistinct(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("optimize_distinct")
+	defer span.End()
+
+	if !node.Resolved() {
+		return node, transform.SameTree, nil
+	}
+
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		n, ok := node.(*plan.Distinct)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+
+		keys, err := uniqueKeys(ctx, n.Child)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if len(keys) > 0 {
+			a.Log("distinct removed, since its rows are unique")
+			return n.Child, transform.NewTree, nil
+		}
+
+		if isSortedOnAllColumns(n.Child) {
+			a.Log("distinct optimized for ordered output")
+			return plan.NewOrderedDistinct(n.Child), transform.NewTree, nil
+		}
+
+		return node, transform.SameTree, nil
+	})
+}
+
+// columnRef identifies a column of the rows returned by a node by its table and name, in lower case.
+type columnRef struct {
+	table, name string
+}
+
+func newColumnRef(table, name string) columnRef {
+	return columnRef{table: strings.ToLower(table), name: strings.ToLower(name)}
+}
+
+// columnRefOf returns the column the expression given refers to, if it's a GetField.
+func columnRefOf(e sql.Expression) (columnRef, bool) {
+	gf, ok := e.(*expression.GetField)
+	if !ok {
+		return columnRef{}, false
+	}
+	return newColumnRef(gf.Table(), gf.Name()), true
+}
+
+// projectedColumns returns, for each column of the child of a Project that's projected as is or with an alias, the
+// column of the Project it becomes.
+func projectedColumns(p *plan.Project) map[columnRef]columnRef {
+	refs := make(map[columnRef]columnRef)
+	for _, e := range p.Projections {
+		switch e := e.(type) {
+		case *expression.GetField:
+			ref, _ := columnRefOf(e)
+			refs[ref] = ref
+		case *expression.Alias:
+			if ref, ok := columnRefOf(e.Child); ok {
+				refs[ref] = newColumnRef("", e.Name())
+			}
+		}
+	}
+	return refs
+}
+
+// uniqueKeys returns sets of columns of the rows of the node given that no two rows have the same values for. An
+// empty set means that the node returns at most one row. Only the keys of a single table that's read through nodes
+// that filter, sort or project its rows are found.
+func uniqueKeys(ctx *sql.Context, n sql.Node) ([][]columnRef, error) {
+	switch n := n.(type) {
+	case *plan.Filter, *plan.Sort, *plan.TopN, *plan.Limit, *plan.Offset:
+		return uniqueKeys(ctx, n.Children()[0])
+	case *plan.Project:
+		keys, err := uniqueKeys(ctx, n.Child)
+		if err != nil {
+			return nil, err
+		}
+		return renameColumns(keys, projectedColumns(n)), nil
+	case *plan.TableAlias:
+		keys, err := uniqueKeys(ctx, n.Child)
+		if err != nil {
+			return nil, err
+		}
+		return renameTable(keys, n.Name()), nil
+	case *plan.IndexedTableAccess:
+		if n.IsPointLookup() {
+			return [][]columnRef{{}}, nil
+		}
+		return tableUniqueKeys(ctx, n.ResolvedTable)
+	case *plan.ResolvedTable:
+		return tableUniqueKeys(ctx, n)
+	default:
+		return nil, nil
+	}
+}
+
+// tableUniqueKeys returns the primary key and the unique keys of non-nullable columns of the table given, if all of
+// their columns are returned by it.
+func tableUniqueKeys(ctx *sql.Context, rt *plan.ResolvedTable) ([][]columnRef, error) {
+	if plan.IsDualTable(rt) {
+		return nil, nil
+	}
+
+	returned := make(map[string]bool)
+	for _, col := range rt.Schema() {
+		returned[strings.ToLower(col.Name)] = true
+	}
+	table := rt.Table
+	for {
+		w, ok := table.(sql.TableWrapper)
+		if !ok {
+			break
+		}
+		table = w.Underlying()
+	}
+	nullable := make(map[string]bool)
+	var pk []string
+	for _, col := range table.Schema() {
+		name := strings.ToLower(col.Name)
+		nullable[name] = col.Nullable
+		if col.PrimaryKey {
+			pk = append(pk, name)
+		}
+	}
+
+	var keys [][]string
+	if len(pk) > 0 {
+		keys = append(keys, pk)
+	}
+	if it, ok := table.(sql.IndexAddressableTable); ok {
+		indexes, err := it.GetIndexes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, idx := range indexes {
+			if !idx.IsUnique() || idx.IsSpatial() {
+				continue
+			}
+			var key []string
+			for _, e := range idx.Expressions() {
+				name := strings.ToLower(e[strings.LastIndex(e, ".")+1:])
+				if nullable[name] {
+					key = nil
+					break
+				}
+				key = append(key, name)
+			}
+			if len(key) > 0 {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	var refs [][]columnRef
+	for _, key := range keys {
+		var ref []columnRef
+		for _, name := range key {
+			if !returned[name] {
+				ref = nil
+				break
+			}
+			ref = append(ref, newColumnRef(rt.Name(), name))
+		}
+		if ref != nil {
+			refs = append(refs, ref)
+		}
+	}
+	return refs, nil
+}
+
+// renameColumns returns the keys given whose columns are all projected, renamed to the columns they're projected as.
+func renameColumns(keys [][]columnRef, projected map[columnRef]columnRef) [][]columnRef {
+	var renamed [][]columnRef
+	for _, key := range keys {
+		newKey := make([]columnRef, 0, len(key))
+		for _, ref := range key {
+			newRef, ok := projected[ref]
+			if !ok {
+				newKey = nil
+				break
+			}
+			newKey = append(newKey, newRef)
+		}
+		if newKey != nil {
+			renamed = append(renamed, newKey)
+		}
+	}
+	return renamed
+}
+
+// renameTable returns the columns given with the table given.
+func renameTable(keys [][]columnRef, table string) [][]columnRef {
+	renamed := make([][]columnRef, len(keys))
+	for i, key := range keys {
+		renamed[i] = make([]columnRef, len(key))
+		for j, ref := range key {
+			renamed[i][j] = newColumnRef(table, ref.name)
+		}
+	}
+	return renamed
+}
+
+// sortOrder returns the columns that the rows of the node given are sorted on, in order. Only the leading columns of
+// the sort order that are returned by the node are included.
+func sortOrder(n sql.Node) []columnRef {
+	switch n := n.(type) {
+	case *plan.Filter, *plan.Limit, *plan.Offset:
+		return sortOrder(n.Children()[0])
+	case *plan.Sort:
+		return sortFieldColumns(n.SortFields)
+	case *plan.TopN:
+		return sortFieldColumns(n.Fields)
+	case *plan.Project:
+		projected := projectedColumns(n)
+		var order []columnRef
+		for _, ref := range sortOrder(n.Child) {
+			newRef, ok := projected[ref]
+			if !ok {
+				break
+			}
+			order = append(order, newRef)
+		}
+		return order
+	case *plan.TableAlias:
+		keys := renameTable([][]columnRef{sortOrder(n.Child)}, n.Name())
+		return keys[0]
+	case *plan.IndexedTableAccess:
+		if !n.IsOrdered() {
+			return nil
+		}
+		returned := make(map[string]bool)
+		for _, col := range n.Schema() {
+			returned[strings.ToLower(col.Name)] = true
+		}
+		var order []columnRef
+		for _, e := range n.Index().Expressions() {
+			name := e[strings.LastIndex(e, ".")+1:]
+			if !returned[strings.ToLower(name)] {
+				break
+			}
+			order = append(order, newColumnRef(n.Name(), name))
+		}
+		return order
+	default:
+		return nil
+	}
+}
+
+func sortFieldColumns(fields sql.SortFields) []columnRef {
+	var order []columnRef
+	for _, f := range fields {
+		ref, ok := columnRefOf(f.Column)
+		if !ok {
+			break
+		}
+		order = append(order, ref)
+	}
+	return order
+}
+
+// isSortedOnAllColumns returns whether the rows of the node given are sorted on all of its columns before any other
+// column, so that equal rows are next to each other.
+func isSortedOnAllColumns(n sql.Node) bool {
+	columns := make(map[columnRef]bool)
+	for _, col := range n.Schema() {
+		columns[newColumnRef(col.Source, col.Name)] = false
+	}
+	sorted := 0
+	for _, ref := range sortOrder(n) {
+		seen, ok := columns[ref]
+		if !ok {
+			break
+		}
+		if !seen {
+			columns[ref] = true
+			sorted++
+		}
+	}
+	return sorted > 0 && sorted == len(columns)
+}
@@ -78,6 +78,11 @@ func parallelize(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope, sel
 		} else if _, ok := c.Parent.(*plan.Max1Row); ok {
 			return c.Node, transform.SameTree, nil
 		}
+		switch c.Parent.(type) {
+		case *plan.TableCount, *plan.IndexDistinct:
+			// The tables of these nodes are never read, only asked about their rows
+			return c.Node, transform.SameTree, nil
+		}
 		ParallelQueryCounter.With("parallelism", strconv.Itoa(a.Parallelism)).Add(1)
 
 		return plan.NewExchange(a.Parallelism, c.Node), transform.NewTree, nil
@@ -182,6 +187,14 @@ func isParallelizable(node sql.Node) bool {
 		case *plan.RecursiveCte:
 			parallelizable = false
 			return false
+		// Tables that return their rows in order or up to a limit depend on their partitions being read in order
+		case *plan.ResolvedTable:
+			if hasPushedDownSortOrLimit(node.Table) {
+				parallelizable = false
+				return false
+			}
+			lastWasTable = true
+			tableSeen = true
 		case sql.Table:
 			lastWasTable = true
 			tableSeen = true
@@ -15,6 +15,8 @@
 package analyzer
 
 import (
+	"regexp"
+
 	"github.com/dolthub/vitess/go/mysql"
 
 	"github.com/dolthub/go-mysql-server/sql/transform"
@@ -23,6 +25,22 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
+// storedObjectDefinerRegex matches CREATE TRIGGER and CREATE PROCEDURE statements that name their definer explicitly.
+// Triggers and procedures created before definers were always stored may not, in which case they run with the
+// invoker's privileges.
+var storedObjectDefinerRegex = regexp.MustCompile(`(?is)^\s*CREATE\s+DEFINER\s*=`)
+
+// definerSecurityContext returns a context whose privileges are those of the definer given, which is used to analyze
+// the body of a trigger or stored procedure that runs in its definer's security context. The context given is returned
+// when privileges aren't being checked, or when the statement that created the object doesn't name its definer.
+func definerSecurityContext(ctx *sql.Context, a *Analyzer, definer string, createStatement string) *sql.Context {
+	if !a.Catalog.MySQLDb.Enabled || definer == "" || !storedObjectDefinerRegex.MatchString(createStatement) {
+		return ctx
+	}
+	user, host := plan.SplitDefiner(definer)
+	return ctx.WithSecurityClient(sql.Client{User: user, Address: host})
+}
+
 // validatePrivileges verifies the given statement (node n) by checking that the calling user has the necessary privileges
 // to execute it.
 // TODO: add the remaining statements that interact with the grant tables
@@ -42,11 +60,17 @@ func validatePrivileges(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 	if user == nil {
 		return nil, transform.SameTree, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", ctx.Session.Client().User)
 	}
-	if plan.IsDualTable(getTable(n)) {
-		return n, transform.SameTree, nil
-	}
-	if rt := getResolvedTable(n); rt != nil && rt.Database.Name() == sql.InformationSchemaDatabaseName {
-		return n, transform.SameTree, nil
+	switch n.(type) {
+	case *plan.CreateView, *plan.CreateTrigger, *plan.CreateProcedure:
+		// The definitions of these may only read from the dual table or information_schema, but creating them still
+		// requires privileges
+	default:
+		if plan.IsDualTable(getTable(n)) {
+			return n, transform.SameTree, nil
+		}
+		if rt := getResolvedTable(n); rt != nil && rt.Database.Name() == sql.InformationSchemaDatabaseName {
+			return n, transform.SameTree, nil
+		}
 	}
 	if !n.CheckPrivileges(ctx, a.Catalog.MySQLDb) {
 		return nil, transform.SameTree, sql.ErrPrivilegeCheckFailed.New(user.UserHostToString("'"))
@@ -55,9 +55,9 @@ func pruneColumns(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope, se
 	// Skip pruning columns for insert statements. For inserts involving a select (INSERT INTO table1 SELECT a,b FROM
 	// table2), all columns from the select are used for the insert, and error checking for schema compatibility
 	// happens at execution time. Otherwise the logic below will convert a Project to a ResolvedTable for the selected
-	// table, which can alter the column order of the select.
+	// table, which can alter the column order of the select. The same goes for the queries filling materialized views.
 	switch n := node.(type) {
-	case *plan.InsertInto, *plan.CreateTrigger:
+	case *plan.InsertInto, *plan.CreateTrigger, *plan.CreateMaterializedView, *plan.RefreshMaterializedView:
 		return n, transform.SameTree, nil
 	}
 
@@ -320,7 +320,7 @@ func pruneGroupBy(a *Analyzer, n *plan.GroupBy, columns usedColumns) (sql.Node,
 	if len(remaining) == len(n.SelectedExprs) {
 		return n, transform.SameTree, nil
 	}
-	return plan.NewGroupBy(remaining, n.GroupByExprs, n.Child), transform.NewTree, nil
+	return plan.NewGroupBy(remaining, n.GroupByExprs, n.Child).WithModifier(n.Modifier), transform.NewTree, nil
 }
 
 func shouldPruneExpr(e sql.Expression, cols usedColumns) bool {
@@ -793,142 +793,6 @@ func getIndexesByTable(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scop
 	return indexes, nil
 }
 
-func replacePkSort(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
-	return transform.NodeWithCtx(n, nil, func(tc transform.Context) (sql.Node, transform.TreeIdentity, error) {
-		n := tc.Node
-
-		// Find order by nodes
-		s, ok := n.(*plan.Sort)
-		if !ok {
-			return n, transform.SameTree, nil
-		}
-
-		// Must be sorting by ascending
-		for _, field := range s.SortFields {
-			if field.Order != sql.Ascending {
-				return n, transform.SameTree, nil
-			}
-		}
-
-		// Check for any alias projections
-		var rs *plan.ResolvedTable
-		aliasMap := make(map[string]string)
-		pj, ok := s.UnaryNode.Child.(*plan.Project)
-		var decoratingParent sql.Node
-		if ok {
-			n := pj.Child
-			if rs, ok = n.(*plan.ResolvedTable); !ok {
-				return s, transform.SameTree, nil
-			}
-			// Extract aliases
-			for _, expr := range pj.Expressions() {
-				if alias, ok := expr.(*expression.Alias); ok {
-					aliasMap[alias.Name()] = alias.UnaryExpression.Child.String()
-				}
-			}
-		} else {
-			n := s.Child
-			// Otherwise, sorts immediate child must be ResolvedTable
-			if rs, ok = n.(*plan.ResolvedTable); !ok {
-				return s, transform.SameTree, nil
-			}
-		}
-
-		// Extract primary key columns from index to maintain order
-		table := rs.Table
-		if w, ok := table.(sql.TableWrapper); ok {
-			table = w.Underlying()
-		}
-		idxTbl, ok := table.(sql.IndexAddressableTable)
-		if !ok {
-			return s, transform.SameTree, nil
-		}
-		idxs, err := idxTbl.GetIndexes(ctx)
-		if err != nil {
-			return nil, transform.SameTree, err
-		}
-
-		// Extract primary index
-		var pkIndex sql.Index
-		for _, idx := range idxs {
-			oi, ok := idx.(sql.OrderedIndex)
-			if !ok || oi.Order() != sql.IndexOrderAsc {
-				continue
-			}
-			if idx.ID() == "PRIMARY" {
-				pkIndex = idx
-				break
-			}
-		}
-		if pkIndex == nil {
-			return s, transform.SameTree, nil
-		}
-
-		// Get primary key column names; these are qualified
-		pkColNames := pkIndex.Expressions()
-
-		// Extract SortField Column Names
-		var sfColNames []string
-		for _, field := range s.SortFields {
-			gf, ok := field.Column.(*expression.GetField)
-			if !ok {
-				return n, transform.SameTree, nil
-			}
-			// Resolve aliases; aliases should have empty table in GetField
-			if name, ok := aliasMap[gf.String()]; ok {
-				sfColNames = append(sfColNames, name)
-			} else {
-				sfColNames = append(sfColNames, gf.String())
-			}
-		}
-
-		// SortField is definitely not a prefix to PrimaryKey
-		if len(sfColNames) > len(pkColNames) {
-			return s, transform.SameTree, nil
-		}
-
-		// Check if SortField is a prefix to PrimaryKey
-		for i := 0; i < len(sfColNames); i++ {
-			// Stop when column names stop matching
-			if sfColNames[i] != pkColNames[i] {
-				return s, transform.SameTree, nil
-			}
-		}
-
-		// Create lookup based off of PrimaryKey
-		indexBuilder := sql.NewIndexBuilder(pkIndex)
-		lookup, err := indexBuilder.Build(ctx)
-		if err != nil {
-			return nil, transform.SameTree, err
-		}
-		if !pkIndex.CanSupport(lookup.Ranges...) {
-			return n, transform.SameTree, nil
-		}
-		newNode, err := plan.NewStaticIndexedAccessForResolvedTable(rs, lookup)
-		if err != nil {
-			return nil, transform.SameTree, err
-		}
-
-		var resNode sql.Node = newNode
-		if decoratingParent != nil {
-			resNode, err = decoratingParent.WithChildren(resNode)
-			if err != nil {
-				return nil, transform.SameTree, err
-			}
-		}
-		// Don't forget aliases
-		if pj != nil {
-			resNode, err = pj.WithChildren(resNode)
-			if err != nil {
-				return nil, transform.SameTree, err
-			}
-			return resNode, transform.NewTree, nil
-		}
-
-		return resNode, transform.NewTree, nil
-	})
-}
-
 // convertIsNullForIndexes converts all nested IsNull(col) expressions to Equals(col, nil) expressions, as they are
 // equivalent as far as the index interfaces are concerned.
 func convertIsNullForIndexes(ctx *sql.Context, e sql.Expression) sql.Expression {
@@ -0,0 +1,154 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// pushdownIndexConditions pushes the filters that only reference the columns of a secondary index down to a range
+// scan of that index, when the table of the scan implements sql.IndexConditionTable. The table evaluates them on the
+// entries of the index before it fetches the rows they point to, which is MySQL's index condition pushdown. The other
+// filters are still evaluated by the Filter node above the scan, after the rows are fetched.
+func pushdownIndexConditions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("pushdown_index_conditions")
+	defer span.End()
+
+	if !canDoPushdown(n) {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		f, ok := n.(*plan.Filter)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+		return pushdownIndexConditionToLookup(a, f)
+	})
+}
+
+// pushdownIndexConditionToLookup pushes the filters of the Filter node given that only reference the columns of the
+// index of the static lookup it filters down to the lookup, and returns a Filter node with the remaining filters over
+// the lookup, or the lookup alone if there are none.
+func pushdownIndexConditionToLookup(a *Analyzer, f *plan.Filter) (sql.Node, transform.TreeIdentity, error) {
+	var alias *plan.TableAlias
+	child := f.Child
+	if ta, ok := child.(*plan.TableAlias); ok {
+		alias = ta
+		child = ta.Child
+	}
+	ita, ok := child.(*plan.IndexedTableAccess)
+	if !ok || !ita.IsStatic() {
+		return f, transform.SameTree, nil
+	}
+
+	// The entries of a primary key index are the rows of its table, so there's nothing to save fetching
+	index := ita.Index()
+	if index.IsSpatial() || strings.EqualFold(index.ID(), "PRIMARY") {
+		return f, transform.SameTree, nil
+	}
+	ict, ok := ita.Table.(sql.IndexConditionTable)
+	if !ok || ict.IndexCondition() != nil {
+		return f, transform.SameTree, nil
+	}
+
+	name := ita.Name()
+	if alias != nil {
+		name = alias.Name()
+	}
+	// An index entry only has a prefix of the values of a column with a prefix length
+	columns := make(map[string]bool)
+	prefixLengths := index.PrefixLengths()
+	for i, expr := range index.Expressions() {
+		if i < len(prefixLengths) && prefixLengths[i] > 0 {
+			continue
+		}
+		columns[strings.ToLower(expr[strings.LastIndex(expr, ".")+1:])] = true
+	}
+
+	var conds, remaining []sql.Expression
+	for _, e := range splitConjunction(f.Expression) {
+		if cond, ok := indexCondition(e, name, columns, ita); ok {
+			conds = append(conds, cond)
+		} else {
+			remaining = append(remaining, e)
+		}
+	}
+	if len(conds) == 0 {
+		return f, transform.SameTree, nil
+	}
+	table, ok := ict.WithIndexCondition(expression.JoinAnd(conds...)).(sql.IndexConditionTable)
+	if !ok || table.IndexCondition() == nil {
+		return f, transform.SameTree, nil
+	}
+
+	a.Log("pushing down index condition %s to index lookup on table %s", expression.JoinAnd(conds...), ita.Name())
+	newIta := *ita
+	newIta.Table = table
+	var node sql.Node = &newIta
+	if alias != nil {
+		var err error
+		node, err = alias.WithChildren(node)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+	}
+	if len(remaining) == 0 {
+		return node, transform.NewTree, nil
+	}
+	return plan.NewFilter(expression.JoinAnd(remaining...), node), transform.NewTree, nil
+}
+
+// indexCondition returns the filter given with its columns referring to the schema of the index lookup given, if it
+// only references the columns given of the table with the name given and can be evaluated on an index entry alone.
+func indexCondition(e sql.Expression, name string, columns map[string]bool, ita *plan.IndexedTableAccess) (sql.Expression, bool) {
+	hasColumn := false
+	unsupported := transform.InspectExpr(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			hasColumn = true
+			return !strings.EqualFold(e.Table(), name) || !columns[strings.ToLower(e.Name())]
+		case *plan.Subquery, *expression.BindVar:
+			return true
+		default:
+			return hasSideEffects(e)
+		}
+	})
+	if unsupported || !hasColumn {
+		return nil, false
+	}
+
+	schema := ita.Schema()
+	cond, _, err := transform.Expr(e, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		idx := schema.IndexOfColName(gf.Name())
+		if idx < 0 {
+			return nil, transform.SameTree, ErrFieldMissing.New(gf.Name())
+		}
+		return expression.NewGetFieldWithTable(idx, gf.Type(), ita.Name(), gf.Name(), gf.IsNullable()), transform.NewTree, nil
+	})
+	if err != nil {
+		return nil, false
+	}
+	return cond, true
+}
@@ -0,0 +1,170 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// pushdownJoinsToDatabases offers each join whose tables all belong to the same sql.JoinPushdownDatabase to that
+// database, outermost joins first. A join that the database accepts is replaced by the table the database returns for
+// it, so it's executed by the database instead of the engine.
+func pushdownJoinsToDatabases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("pushdown_joins_to_databases")
+	defer span.End()
+
+	if !canDoPushdown(n) {
+		return n, transform.SameTree, nil
+	}
+
+	// The tables of updates and deletes through joins are resolved by name, so their joins must stay in the plan
+	switch n.(type) {
+	case *plan.Update, *plan.DeleteFrom:
+		return n, transform.SameTree, nil
+	}
+
+	return pushdownJoins(ctx, a, n)
+}
+
+// pushdownJoins replaces the outermost joins in the node given that a database accepts with their pushed down tables.
+func pushdownJoins(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	if j, ok := n.(*plan.JoinNode); ok {
+		if db := joinPushdownDatabase(j); db != nil {
+			table, ok, err := db.PushdownJoin(ctx, j)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			if ok {
+				if sameColumns(j.Schema(), table.Schema()) {
+					a.Log("pushing down join to database %s", db.Name())
+					return plan.NewResolvedTable(table, db, nil), transform.NewTree, nil
+				}
+				a.Log("not pushing down join to database %s, since the schema of its table doesn't match", db.Name())
+			}
+		}
+	}
+
+	if _, ok := n.(sql.OpaqueNode); ok {
+		return n, transform.SameTree, nil
+	}
+
+	children := n.Children()
+	var newChildren []sql.Node
+	for i, child := range children {
+		newChild, same, err := pushdownJoins(ctx, a, child)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if same {
+			continue
+		}
+		if newChildren == nil {
+			newChildren = make([]sql.Node, len(children))
+			copy(newChildren, children)
+		}
+		newChildren[i] = newChild
+	}
+	if newChildren == nil {
+		return n, transform.SameTree, nil
+	}
+
+	newNode, err := n.WithChildren(newChildren...)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return newNode, transform.NewTree, nil
+}
+
+// joinPushdownDatabase returns the database that the join given can be pushed down to, or nil if there isn't one. A
+// join can be pushed down when it's an inner, cross or outer join of tables of a single sql.JoinPushdownDatabase, and
+// its conditions only refer to the columns of those tables.
+func joinPushdownDatabase(j *plan.JoinNode) sql.JoinPushdownDatabase {
+	var db sql.Database
+	tableNames := make(map[string]struct{})
+	var conds []sql.Expression
+	canPushdown := true
+	transform.Inspect(j, func(n sql.Node) bool {
+		if n == nil || !canPushdown {
+			return false
+		}
+
+		var rt *plan.ResolvedTable
+		switch n := n.(type) {
+		case *plan.JoinNode:
+			switch n.Op {
+			case plan.JoinTypeInner, plan.JoinTypeCross, plan.JoinTypeLeftOuter, plan.JoinTypeRightOuter:
+			default:
+				canPushdown = false
+				return false
+			}
+			if n.Filter != nil {
+				conds = append(conds, n.Filter)
+			}
+			return true
+		case *plan.TableAlias:
+			rt, _ = n.Child.(*plan.ResolvedTable)
+			tableNames[strings.ToLower(n.Name())] = struct{}{}
+		case *plan.ResolvedTable:
+			rt = n
+			tableNames[strings.ToLower(n.Name())] = struct{}{}
+		}
+		if rt == nil || rt.Database == nil || plan.IsDualTable(rt) {
+			canPushdown = false
+			return false
+		}
+
+		tableDb := rt.Database
+		if privilegedDatabase, ok := tableDb.(mysql_db.PrivilegedDatabase); ok {
+			tableDb = privilegedDatabase.Unwrap()
+		}
+		if db == nil {
+			db = tableDb
+		} else if !strings.EqualFold(db.Name(), tableDb.Name()) {
+			canPushdown = false
+		}
+		return false
+	})
+	if !canPushdown {
+		return nil
+	}
+
+	for _, cond := range conds {
+		if containsSubquery(cond) {
+			return nil
+		}
+		outerRef := transform.InspectExpr(cond, func(e sql.Expression) bool {
+			switch e := e.(type) {
+			case *expression.GetField:
+				_, ok := tableNames[strings.ToLower(e.Table())]
+				return !ok
+			case *expression.BindVar:
+				return true
+			}
+			return false
+		})
+		if outerRef {
+			return nil
+		}
+	}
+
+	pushdownDb, _ := db.(sql.JoinPushdownDatabase)
+	return pushdownDb
+}
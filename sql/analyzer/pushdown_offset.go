@@ -0,0 +1,143 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// pushdownOffset skips the rows of an OFFSET as early as possible. An Offset node is moved below the Project node it's
+// over, so that the projections aren't evaluated for the rows it discards. Then, when the rows are read in the order of
+// an index, such as when an ORDER BY on the primary key has been replaced with an index scan, the LIMIT and OFFSET are
+// pushed down to the index lookup if its table implements sql.LimitedTable, so that it can skip rows without returning
+// them.
+func pushdownOffset(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("pushdown_offset")
+	defer span.End()
+
+	if !n.Resolved() {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch n := n.(type) {
+		case *plan.Offset:
+			return pushdownOffsetBelowProject(a, n)
+		case *plan.Limit:
+			return pushdownLimitToIndexedTable(ctx, a, n)
+		default:
+			return n, transform.SameTree, nil
+		}
+	})
+}
+
+// pushdownOffsetBelowProject returns the Project node that the Offset node given is over, with the Offset node moved
+// below it, if its projections can be skipped for the rows the Offset discards.
+func pushdownOffsetBelowProject(a *Analyzer, o *plan.Offset) (sql.Node, transform.TreeIdentity, error) {
+	p, ok := o.Child.(*plan.Project)
+	if !ok {
+		return o, transform.SameTree, nil
+	}
+	for _, e := range p.Projections {
+		if hasSideEffects(e) {
+			return o, transform.SameTree, nil
+		}
+	}
+
+	a.Log("pushing down offset %s below projection", o.Offset)
+	offset, err := o.WithChildren(p.Child)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	node, err := p.WithChildren(offset)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return node, transform.NewTree, nil
+}
+
+// hasSideEffects returns whether evaluating the expression given for fewer rows could change the result of a query,
+// because the expression is non-deterministic or acts on something other than the row it's evaluated on.
+func hasSideEffects(e sql.Expression) bool {
+	return transform.InspectExpr(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *function.GetLock, *function.IsUsedLock, *function.IsFreeLock, function.ReleaseAllLocks, *function.ReleaseLock, *function.Sleep:
+			return true
+		case sql.NonDeterministicExpression:
+			return e.IsNonDeterministic()
+		}
+		return false
+	})
+}
+
+// pushdownLimitToIndexedTable pushes the limit and offset of the Limit node given to the static index lookup it limits,
+// if the lookup's table can limit its rows itself, and returns the child of the Limit and Offset nodes in that case.
+// Other than an Offset node, only a Project node above it can be between them, since the projections of a Project node
+// below an Offset node can't be skipped.
+func pushdownLimitToIndexedTable(ctx *sql.Context, a *Analyzer, l *plan.Limit) (sql.Node, transform.TreeIdentity, error) {
+	if l.CalcFoundRows {
+		return l, transform.SameTree, nil
+	}
+	limit, ok := limitValue(ctx, l.Limit)
+	if !ok {
+		return l, transform.SameTree, nil
+	}
+
+	child := l.Child
+	p, ok := child.(*plan.Project)
+	if ok {
+		child = p.Child
+	}
+	var offset int64
+	if o, ok := child.(*plan.Offset); ok {
+		offset, ok = limitValue(ctx, o.Offset)
+		if !ok {
+			return l, transform.SameTree, nil
+		}
+		child = o.Child
+	}
+
+	ita, ok := child.(*plan.IndexedTableAccess)
+	if !ok || !ita.IsStatic() || ita.Index().IsSpatial() {
+		return l, transform.SameTree, nil
+	}
+	lt, ok := ita.Table.(sql.LimitedTable)
+	if !ok {
+		return l, transform.SameTree, nil
+	}
+	if _, _, ok := lt.Limit(); ok {
+		return l, transform.SameTree, nil
+	}
+	it, ok := lt.WithLimit(limit, offset).(sql.IndexedTable)
+	if !ok {
+		return l, transform.SameTree, nil
+	}
+
+	a.Log("pushing down limit %d and offset %d to index lookup on table %s", limit, offset, ita.Name())
+	newIta := *ita
+	newIta.Table = it
+	var node sql.Node = &newIta
+	if p != nil {
+		var err error
+		node, err = p.WithChildren(node)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+	}
+	return node, transform.NewTree, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestPushdownOffset(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "mytable", PrimaryKey: true},
+		{Name: "f", Type: types.Float64, Source: "mytable"},
+	}), nil)
+	table.EnablePrimaryKeyIndexes()
+	indexes, err := table.GetIndexes(ctx)
+	require.NoError(err)
+	lookup, err := sql.NewIndexBuilder(indexes[0]).Build(ctx)
+	require.NoError(err)
+	rt := plan.NewResolvedTable(table, nil, nil)
+	ita, err := plan.NewStaticIndexedAccessForResolvedTable(rt, lookup)
+	require.NoError(err)
+	limitedIta := *ita
+	limitedIta.Table = ita.Table.(*memory.IndexedTable).WithLimit(5, 2).(sql.IndexedTable)
+
+	i := expression.NewGetFieldWithTable(0, types.Int64, "mytable", "i", false)
+	f := expression.NewGetFieldWithTable(1, types.Float64, "mytable", "f", false)
+	fPlusOne := expression.NewArithmetic(f, expression.NewLiteral(1.0, types.Float64), "+")
+	five := expression.NewLiteral(int64(5), types.Int64)
+	two := expression.NewLiteral(int64(2), types.Int64)
+	rand, err := function.NewRand()
+	require.NoError(err)
+
+	a := NewDefault(sql.NewDatabaseProvider())
+
+	tests := []analyzerFnTestCase{
+		{
+			name: "offset pushed down below projection",
+			node: plan.NewLimit(five, plan.NewOffset(two, plan.NewProject([]sql.Expression{i, fPlusOne}, rt))),
+			expected: plan.NewLimit(five, plan.NewProject(
+				[]sql.Expression{i, fPlusOne},
+				plan.NewOffset(two, rt),
+			)),
+		},
+		{
+			name: "offset not pushed down below non-deterministic projection",
+			node: plan.NewLimit(five, plan.NewOffset(two, plan.NewProject([]sql.Expression{i, rand}, rt))),
+		},
+		{
+			name: "limit and offset pushed down to index lookup",
+			node: plan.NewLimit(five, plan.NewOffset(two, plan.NewProject([]sql.Expression{i, fPlusOne}, ita))),
+			expected: plan.NewProject(
+				[]sql.Expression{i, fPlusOne},
+				&limitedIta,
+			),
+		},
+		{
+			name: "limit and offset not pushed down to index lookup below non-deterministic projection",
+			node: plan.NewLimit(five, plan.NewOffset(two, plan.NewProject([]sql.Expression{i, rand}, ita))),
+		},
+		{
+			name: "limit above a filter not pushed down to index lookup",
+			node: plan.NewLimit(five, plan.NewOffset(two, plan.NewFilter(
+				expression.NewGreaterThan(f, expression.NewLiteral(1.0, types.Float64)),
+				ita,
+			))),
+		},
+		{
+			name: "limit with found rows not pushed down to index lookup",
+			node: plan.NewLimit(five, ita).WithCalcFoundRows(true),
+		},
+	}
+
+	runTestCases(t, ctx, tests, a, getRule(pushdownOffsetId))
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// pushdownSortAndLimitToTables pushes the ORDER BY and LIMIT of a query that reads a single table down to the table,
+// when the table implements sql.SortedTable or sql.LimitedTable. The Sort, Limit and Offset nodes that the table
+// handles are removed from the plan.
+func pushdownSortAndLimitToTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("pushdown_sort_and_limit_to_tables")
+	defer span.End()
+
+	if !canDoPushdown(n) {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch n := n.(type) {
+		case *plan.Sort:
+			return pushdownSortToTable(a, n)
+		case *plan.Limit:
+			return pushdownLimitToTable(ctx, a, n)
+		default:
+			return n, transform.SameTree, nil
+		}
+	})
+}
+
+// pushdownSortToTable pushes the sort fields of the Sort node given to the table it sorts, if the table can return its
+// rows in that order, and returns the child of the Sort node in that case.
+func pushdownSortToTable(a *Analyzer, s *plan.Sort) (sql.Node, transform.TreeIdentity, error) {
+	rt, name := pushdownTableBelow(s.Child, true)
+	if rt == nil {
+		return s, transform.SameTree, nil
+	}
+	st, ok := rt.Table.(sql.SortedTable)
+	if !ok || len(st.SortFields()) > 0 {
+		return s, transform.SameTree, nil
+	}
+
+	// The sort fields given to the table refer to its own schema
+	schema := rt.Schema()
+	columns := make([]sql.Expression, len(s.SortFields))
+	for i, sf := range s.SortFields {
+		gf, ok := sf.Column.(*expression.GetField)
+		if !ok || !strings.EqualFold(gf.Table(), name) {
+			return s, transform.SameTree, nil
+		}
+		idx := schema.IndexOfColName(gf.Name())
+		if idx < 0 {
+			return s, transform.SameTree, nil
+		}
+		columns[i] = expression.NewGetFieldWithTable(idx, gf.Type(), rt.Name(), gf.Name(), gf.IsNullable())
+	}
+	sortFields := s.SortFields.FromExpressions(columns...)
+	if !st.CanSort(sortFields) {
+		return s, transform.SameTree, nil
+	}
+
+	a.Log("pushing down sort fields %v to table %s", sortFields, rt.Name())
+	child, _, err := withTable(s.Child, st.WithSortFields(sortFields))
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return child, transform.NewTree, nil
+}
+
+// pushdownLimitToTable pushes the limit and offset of the Limit node given to the table it limits, if the table can
+// limit its rows itself, and returns the child of the Limit and Offset nodes in that case.
+func pushdownLimitToTable(ctx *sql.Context, a *Analyzer, l *plan.Limit) (sql.Node, transform.TreeIdentity, error) {
+	if l.CalcFoundRows {
+		return l, transform.SameTree, nil
+	}
+	limit, ok := limitValue(ctx, l.Limit)
+	if !ok {
+		return l, transform.SameTree, nil
+	}
+
+	child := l.Child
+	var offset int64
+	if o, ok := child.(*plan.Offset); ok {
+		offset, ok = limitValue(ctx, o.Offset)
+		if !ok {
+			return l, transform.SameTree, nil
+		}
+		child = o.Child
+	}
+
+	// Rows removed by a Filter node above the table would count towards the limit, so only a Project node can be
+	// between them
+	rt, _ := pushdownTableBelow(child, false)
+	if rt == nil {
+		return l, transform.SameTree, nil
+	}
+	lt, ok := rt.Table.(sql.LimitedTable)
+	if !ok {
+		return l, transform.SameTree, nil
+	}
+	if _, _, ok := lt.Limit(); ok {
+		return l, transform.SameTree, nil
+	}
+
+	a.Log("pushing down limit %d and offset %d to table %s", limit, offset, rt.Name())
+	child, _, err := withTable(child, lt.WithLimit(limit, offset))
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return child, transform.NewTree, nil
+}
+
+// pushdownTableBelow returns the table read by the node given, and the name it's referred to by, if the node is the
+// table, an alias of it, or a Project, or optionally a Filter, over one of those. Otherwise it returns nil.
+func pushdownTableBelow(n sql.Node, allowFilters bool) (*plan.ResolvedTable, string) {
+	for {
+		switch node := n.(type) {
+		case *plan.Project:
+			n = node.Child
+		case *plan.Filter:
+			if !allowFilters {
+				return nil, ""
+			}
+			n = node.Child
+		case *plan.TableAlias:
+			rt, ok := node.Child.(*plan.ResolvedTable)
+			if !ok {
+				return nil, ""
+			}
+			return rt, node.Name()
+		case *plan.ResolvedTable:
+			if plan.IsDualTable(node) {
+				return nil, ""
+			}
+			return node, node.Name()
+		default:
+			return nil, ""
+		}
+	}
+}
+
+// limitValue returns the value of the LIMIT or OFFSET expression given, if it's an integer literal.
+func limitValue(ctx *sql.Context, e sql.Expression) (int64, bool) {
+	lit, ok := e.(*expression.Literal)
+	if !ok || !types.IsInteger(lit.Type()) {
+		return 0, false
+	}
+	v, err := lit.Eval(ctx, nil)
+	if err != nil {
+		return 0, false
+	}
+	i64, err := types.Int64.Convert(v)
+	if err != nil || i64 == nil {
+		return 0, false
+	}
+	return i64.(int64), true
+}
+
+// hasPushedDownSortOrLimit returns whether the table given returns its rows in a pushed down order or with a pushed
+// down limit, which depend on its partitions being read in order.
+func hasPushedDownSortOrLimit(table sql.Table) bool {
+	if wrapper, ok := table.(sql.TableWrapper); ok {
+		table = wrapper.Underlying()
+	}
+	if st, ok := table.(sql.SortedTable); ok && len(st.SortFields()) > 0 {
+		return true
+	}
+	if lt, ok := table.(sql.LimitedTable); ok {
+		_, _, ok := lt.Limit()
+		return ok
+	}
+	return false
+}
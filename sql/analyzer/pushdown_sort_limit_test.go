@@ -0,0 +1,101 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestPushdownSortAndLimitToTables(t *testing.T) {
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int32, Source: "mytable"},
+		{Name: "f", Type: types.Float64, Source: "mytable"},
+	})
+	table := memory.NewSortedLimitedTable("mytable", schema, nil)
+	plain := memory.NewTable("mytable", schema, nil)
+
+	i := expression.NewGetFieldWithTable(0, types.Int32, "mytable", "i", false)
+	f := expression.NewGetFieldWithTable(1, types.Float64, "mytable", "f", false)
+	aliasF := expression.NewGetFieldWithTable(1, types.Float64, "t", "f", false)
+	tableSortF := sql.SortFields{{Column: f, Column2: f, Order: sql.Descending}}
+
+	a := NewDefault(sql.NewDatabaseProvider())
+
+	tests := []analyzerFnTestCase{
+		{
+			name: "sort and limit pushed down",
+			node: plan.NewLimit(
+				expression.NewLiteral(int64(5), types.Int64),
+				plan.NewOffset(
+					expression.NewLiteral(int64(2), types.Int64),
+					plan.NewProject(
+						[]sql.Expression{i},
+						plan.NewSort(tableSortF, plan.NewResolvedTable(table, nil, nil)),
+					),
+				),
+			),
+			expected: plan.NewProject(
+				[]sql.Expression{i},
+				plan.NewResolvedTable(table.WithSortFields(tableSortF).(*memory.SortedLimitedTable).WithLimit(5, 2), nil, nil),
+			),
+		},
+		{
+			name: "sort of aliased table pushed down",
+			node: plan.NewSort(
+				sql.SortFields{{Column: aliasF, Order: sql.Descending}},
+				plan.NewFilter(
+					expression.NewGreaterThan(aliasF, expression.NewLiteral(1.0, types.Float64)),
+					plan.NewTableAlias("t", plan.NewResolvedTable(table, nil, nil)),
+				),
+			),
+			expected: plan.NewFilter(
+				expression.NewGreaterThan(aliasF, expression.NewLiteral(1.0, types.Float64)),
+				plan.NewTableAlias("t", plan.NewResolvedTable(table.WithSortFields(tableSortF), nil, nil)),
+			),
+		},
+		{
+			name: "limit above a filter not pushed down",
+			node: plan.NewLimit(
+				expression.NewLiteral(int64(5), types.Int64),
+				plan.NewFilter(
+					expression.NewGreaterThan(f, expression.NewLiteral(1.0, types.Float64)),
+					plan.NewResolvedTable(table, nil, nil),
+				),
+			),
+		},
+		{
+			name: "sort of an expression not pushed down",
+			node: plan.NewSort(
+				sql.SortFields{{Column: expression.NewArithmetic(f, i, "+")}},
+				plan.NewResolvedTable(table, nil, nil),
+			),
+		},
+		{
+			name: "table that can't sort or limit",
+			node: plan.NewLimit(
+				expression.NewLiteral(int64(5), types.Int64),
+				plan.NewSort(tableSortF, plan.NewResolvedTable(plain, nil, nil)),
+			),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), tests, a, getRule(pushdownSortAndLimitToTablesId))
+}
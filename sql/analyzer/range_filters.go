@@ -0,0 +1,253 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// rewriteRangeFilters adds range conditions on a column to the filter expressions that only match values of the column
+// in that range, but can't be used for index lookups themselves:
+//   - col LIKE 'abc%def' gets col >= 'abc' AND col < 'abd', when the collation of the comparison sorts strings by
+//     their code points. The LIKE is kept, since the range also includes values that don't match the pattern.
+//   - DATE(col) = '2023-01-01' is replaced with col >= '2023-01-01' AND col < '2023-01-02', and the other
+//     comparisons of DATE(col) with a date constant with the equivalent range of col.
+//
+// It runs before joins are planned and filters are pushed down to tables, so that the ranges are used for index
+// lookups.
+func rewriteRangeFilters(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("rewrite_range_filters")
+	defer span.End()
+
+	if !n.Resolved() {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+		e, same, err := transform.Expr(filter.Expression, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			switch e := e.(type) {
+			case *expression.Like:
+				return likePrefixRange(ctx, e)
+			case expression.Comparer:
+				return dateComparisonRange(e)
+			default:
+				return e, transform.SameTree, nil
+			}
+		})
+		if err != nil || same {
+			return n, transform.SameTree, err
+		}
+		a.Log("rewrote range filter %s", e)
+		return plan.NewFilter(e, filter.Child), transform.NewTree, nil
+	})
+}
+
+// likePrefixRange returns the LIKE given together with the range of the column matching the constant prefix of its
+// pattern, if it has one.
+func likePrefixRange(ctx *sql.Context, like *expression.Like) (sql.Expression, transform.TreeIdentity, error) {
+	gf, ok := like.Left.(*expression.GetField)
+	if !ok || !types.IsText(gf.Type()) {
+		return like, transform.SameTree, nil
+	}
+	collation, _ := like.CollationCoercibility(ctx)
+	colCollation, _ := sql.GetCoercibility(ctx, gf)
+	if collation != colCollation || !sortsByCodePoint(collation) {
+		return like, transform.SameTree, nil
+	}
+
+	pattern, ok := constantString(like.Right)
+	if !ok {
+		return like, transform.SameTree, nil
+	}
+	escape := '\\'
+	if like.Escape != nil {
+		escapeStr, ok := constantString(like.Escape)
+		if !ok || utf8.RuneCountInString(escapeStr) != 1 {
+			return like, transform.SameTree, nil
+		}
+		escape, _ = utf8.DecodeRuneInString(escapeStr)
+	}
+
+	prefix, ok := likePatternPrefix(pattern, escape)
+	if !ok || prefix == "" {
+		return like, transform.SameTree, nil
+	}
+
+	var lower, upper sql.Expression
+	if types.IsBinaryType(gf.Type()) {
+		lower = expression.NewLiteral([]byte(prefix), types.LongBlob)
+		if next, ok := nextBinaryPrefix([]byte(prefix)); ok {
+			upper = expression.NewLiteral(next, types.LongBlob)
+		}
+	} else {
+		if !utf8.ValidString(prefix) {
+			return like, transform.SameTree, nil
+		}
+		lower = expression.NewLiteral(prefix, like.Right.Type())
+		if next, ok := nextStringPrefix(prefix); ok {
+			upper = expression.NewLiteral(next, like.Right.Type())
+		}
+	}
+
+	rng := sql.Expression(expression.NewGreaterThanOrEqual(gf, lower))
+	if upper != nil {
+		rng = expression.NewAnd(rng, expression.NewLessThan(gf, upper))
+	}
+	return expression.NewAnd(rng, like), transform.NewTree, nil
+}
+
+// sortsByCodePoint returns whether strings of the collation given are sorted by the code points of their characters,
+// so that the strings starting with a prefix are all sorted between the prefix and the prefix with its last character
+// incremented.
+func sortsByCodePoint(collation sql.CollationID) bool {
+	return collation == sql.Collation_binary || collation == sql.Collation_utf8mb4_0900_bin
+}
+
+// constantString returns the value of the expression given as a string, if it's a non-NULL literal.
+func constantString(e sql.Expression) (string, bool) {
+	lit, ok := e.(*expression.Literal)
+	if !ok || lit.Value() == nil {
+		return "", false
+	}
+	val, err := types.LongText.Convert(lit.Value())
+	if err != nil {
+		return "", false
+	}
+	s, ok := val.(string)
+	return s, ok
+}
+
+// likePatternPrefix returns the characters of the LIKE pattern given before its first wildcard, unescaped. It returns
+// false for patterns without wildcards, which match a single value.
+func likePatternPrefix(pattern string, escape rune) (string, bool) {
+	prefix := make([]rune, 0, len(pattern))
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == escape && i+1 < len(runes):
+			i++
+			prefix = append(prefix, runes[i])
+		case r == '%' || r == '_':
+			return string(prefix), true
+		default:
+			prefix = append(prefix, r)
+		}
+	}
+	return "", false
+}
+
+// nextBinaryPrefix returns the smallest byte string greater than every byte string starting with the prefix given.
+// It returns false if there's none, when the prefix is made of 0xFF bytes only.
+func nextBinaryPrefix(prefix []byte) ([]byte, bool) {
+	next := append([]byte(nil), prefix...)
+	for i := len(next) - 1; i >= 0; i-- {
+		if next[i] < 0xFF {
+			next[i]++
+			return next[:i+1], true
+		}
+	}
+	return nil, false
+}
+
+// nextStringPrefix returns a string greater than every string starting with the prefix given, in code point order.
+// It returns false if there's none, when the prefix is made of the largest code point only.
+func nextStringPrefix(prefix string) (string, bool) {
+	runes := []rune(prefix)
+	for i := len(runes) - 1; i >= 0; i-- {
+		if runes[i] == utf8.MaxRune {
+			continue
+		}
+		runes[i]++
+		if runes[i] >= 0xD800 && runes[i] <= 0xDFFF {
+			// Surrogate halves aren't characters of valid strings
+			runes[i] = 0xE000
+		}
+		return string(runes[:i+1]), true
+	}
+	return "", false
+}
+
+// dateComparisonRange returns the range of the column equivalent to the comparison given, if it compares DATE(col)
+// with a date constant.
+func dateComparisonRange(cmp expression.Comparer) (sql.Expression, transform.TreeIdentity, error) {
+	left, right := cmp.Left(), cmp.Right()
+	flipped := false
+	if _, ok := right.(*function.Date); ok {
+		left, right = right, left
+		flipped = true
+	}
+	date, ok := left.(*function.Date)
+	if !ok {
+		return cmp, transform.SameTree, nil
+	}
+	gf, ok := date.Child.(*expression.GetField)
+	if !ok || !types.IsTime(gf.Type()) {
+		return cmp, transform.SameTree, nil
+	}
+	lit, ok := right.(*expression.Literal)
+	if !ok || lit.Value() == nil {
+		return cmp, transform.SameTree, nil
+	}
+	val, err := types.Datetime.Convert(lit.Value())
+	if err != nil {
+		return cmp, transform.SameTree, nil
+	}
+	day := val.(time.Time)
+	// Constants with a time part compare as datetimes, and aren't equal to any date
+	if hour, min, sec := day.Clock(); day.IsZero() || hour != 0 || min != 0 || sec != 0 || day.Nanosecond() != 0 {
+		return cmp, transform.SameTree, nil
+	}
+
+	start := expression.NewLiteral(day, gf.Type())
+	end := expression.NewLiteral(day.AddDate(0, 0, 1), gf.Type())
+	switch cmp.(type) {
+	case *expression.Equals:
+		return expression.NewAnd(expression.NewGreaterThanOrEqual(gf, start), expression.NewLessThan(gf, end)), transform.NewTree, nil
+	case *expression.GreaterThan:
+		if flipped {
+			return expression.NewLessThan(gf, start), transform.NewTree, nil
+		}
+		return expression.NewGreaterThanOrEqual(gf, end), transform.NewTree, nil
+	case *expression.GreaterThanOrEqual:
+		if flipped {
+			return expression.NewLessThan(gf, end), transform.NewTree, nil
+		}
+		return expression.NewGreaterThanOrEqual(gf, start), transform.NewTree, nil
+	case *expression.LessThan:
+		if flipped {
+			return expression.NewGreaterThanOrEqual(gf, end), transform.NewTree, nil
+		}
+		return expression.NewLessThan(gf, start), transform.NewTree, nil
+	case *expression.LessThanOrEqual:
+		if flipped {
+			return expression.NewGreaterThanOrEqual(gf, start), transform.NewTree, nil
+		}
+		return expression.NewLessThan(gf, end), transform.NewTree, nil
+	default:
+		return cmp, transform.SameTree, nil
+	}
+}
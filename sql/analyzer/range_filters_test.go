@@ -0,0 +1,70 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLikePatternPrefix(t *testing.T) {
+	tests := []struct {
+		pattern string
+		escape  rune
+		prefix  string
+		ok      bool
+	}{
+		{"abc%", '\\', "abc", true},
+		{"ab_d%", '\\', "ab", true},
+		{"%abc", '\\', "", true},
+		{`ab\%c%`, '\\', "ab%c", true},
+		{"ab|_c_", '|', "ab_c", true},
+		{"abc", '\\', "", false},
+		{`abc\`, '\\', "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			prefix, ok := likePatternPrefix(test.pattern, test.escape)
+			require.Equal(t, test.ok, ok)
+			require.Equal(t, test.prefix, prefix)
+		})
+	}
+}
+
+func TestNextPrefix(t *testing.T) {
+	next, ok := nextStringPrefix("abc")
+	require.True(t, ok)
+	require.Equal(t, "abd", next)
+
+	next, ok = nextStringPrefix("a" + string(utf8.MaxRune))
+	require.True(t, ok)
+	require.Equal(t, "b", next)
+
+	next, ok = nextStringPrefix("a\ud7ff")
+	require.True(t, ok)
+	require.Equal(t, "a\ue000", next)
+
+	_, ok = nextStringPrefix(string(utf8.MaxRune))
+	require.False(t, ok)
+
+	nextBytes, ok := nextBinaryPrefix([]byte{0x61, 0xFF})
+	require.True(t, ok)
+	require.Equal(t, []byte{0x62}, nextBytes)
+
+	_, ok = nextBinaryPrefix([]byte{0xFF, 0xFF})
+	require.False(t, ok)
+}
@@ -0,0 +1,230 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// replaceIdxSort removes Sort nodes whose rows can be read in the order of their sort fields from an index. A Sort
+// over a table is replaced with a scan of an index of the table whose columns start with the sort fields, preferring
+// the primary key, and a Sort over a static index lookup is removed if the lookup returns its rows in the order of the
+// sort fields. When the sort fields are in the reverse of the order of the index, such as for an ORDER BY ... DESC on
+// the columns of an ascending index, the index is read backwards if it's reversible.
+func replaceIdxSort(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		s, ok := n.(*plan.Sort)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+		return replaceSortWithIndex(ctx, a, s)
+	})
+}
+
+// replaceSortWithIndex returns the child of the Sort node given with its table read in the order of the sort fields
+// from an index, or the Sort node if there's no such index. Only a Project node and a Filter node can be between the
+// Sort node and its table.
+func replaceSortWithIndex(ctx *sql.Context, a *Analyzer, s *plan.Sort) (sql.Node, transform.TreeIdentity, error) {
+	// Check for any alias projections
+	aliasMap := make(map[string]string)
+	child := s.Child
+	pj, ok := child.(*plan.Project)
+	if ok {
+		// Extract aliases
+		for _, expr := range pj.Projections {
+			if alias, ok := expr.(*expression.Alias); ok {
+				aliasMap[alias.Name()] = alias.Child.String()
+			}
+		}
+		child = pj.Child
+	}
+	f, ok := child.(*plan.Filter)
+	if ok {
+		child = f.Child
+	}
+
+	// Extract SortField Column Names
+	var sfColNames []string
+	for _, field := range s.SortFields {
+		// An index returns NULL values before all others, and after them when it's read backwards
+		if field.NullOrdering != sql.NullsFirst {
+			return s, transform.SameTree, nil
+		}
+		gf, ok := field.Column.(*expression.GetField)
+		if !ok {
+			return s, transform.SameTree, nil
+		}
+		// Resolve aliases; aliases should have empty table in GetField
+		if name, ok := aliasMap[gf.String()]; ok {
+			sfColNames = append(sfColNames, name)
+		} else {
+			sfColNames = append(sfColNames, gf.String())
+		}
+	}
+
+	var newNode sql.Node
+	var err error
+	switch child := child.(type) {
+	case *plan.ResolvedTable:
+		newNode, err = sortedIndexScan(ctx, child, s.SortFields, sfColNames)
+	case *plan.IndexedTableAccess:
+		newNode = sortedIndexLookup(child, s.SortFields, sfColNames)
+	}
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	if newNode == nil {
+		return s, transform.SameTree, nil
+	}
+	a.Log("replacing sort %s with an index read of table %s", s.SortFields, child.(sql.Nameable).Name())
+
+	if f != nil {
+		newNode, err = f.WithChildren(newNode)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+	}
+	// Don't forget aliases
+	if pj != nil {
+		newNode, err = pj.WithChildren(newNode)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+	}
+	return newNode, transform.NewTree, nil
+}
+
+// sortedIndexScan returns a scan of a whole index of the table given that reads its rows in the order of the sort
+// fields given, on the columns with the names given, or nil if the table has no such index.
+func sortedIndexScan(ctx *sql.Context, rt *plan.ResolvedTable, sortFields sql.SortFields, colNames []string) (sql.Node, error) {
+	table := rt.Table
+	if w, ok := table.(sql.TableWrapper); ok {
+		table = w.Underlying()
+	}
+	idxTbl, ok := table.(sql.IndexAddressableTable)
+	if !ok {
+		return nil, nil
+	}
+	idxs, err := idxTbl.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var index sql.Index
+	var reverse bool
+	for _, idx := range idxs {
+		ok, rev := indexSortOrder(idx, sortFields, colNames, nil)
+		if !ok {
+			continue
+		}
+		if index == nil || strings.EqualFold(idx.ID(), "PRIMARY") {
+			index, reverse = idx, rev
+		}
+	}
+	if index == nil {
+		return nil, nil
+	}
+
+	lookup, err := sql.NewIndexBuilder(index).Build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !index.CanSupport(lookup.Ranges...) {
+		return nil, nil
+	}
+	lookup.IsReverse = reverse
+	return plan.NewStaticIndexedAccessForResolvedTable(rt, lookup)
+}
+
+// sortedIndexLookup returns the static index lookup given, read backwards if it has to be, if it returns its rows in
+// the order of the sort fields given, on the columns with the names given, or nil if it doesn't.
+func sortedIndexLookup(ita *plan.IndexedTableAccess, sortFields sql.SortFields, colNames []string) sql.Node {
+	if !ita.IsStatic() || !ita.IsOrdered() {
+		return nil
+	}
+	lookup := plan.GetIndexLookup(ita)
+
+	// The columns of a leading equality of the lookup have a single value, so they don't affect the order of its rows
+	rang := lookup.Ranges[0]
+	fixed := make([]bool, len(rang))
+	for i, rce := range rang {
+		if ok, err := rce.RepresentsEquals(); err != nil || !ok {
+			break
+		}
+		fixed[i] = true
+	}
+
+	ok, reverse := indexSortOrder(lookup.Index, sortFields, colNames, fixed)
+	if !ok {
+		return nil
+	}
+	if !reverse {
+		return ita
+	}
+	lookup.IsReverse = true
+	return plan.NewStaticIndexedTableAccess(ita.ResolvedTable, ita.Table, lookup)
+}
+
+// indexSortOrder returns whether reading the index given returns its rows in the order of the sort fields given, on the
+// columns with the names given, and whether the index has to be read backwards to do so. The index columns that are
+// fixed to a single value by a lookup can be skipped.
+func indexSortOrder(idx sql.Index, sortFields sql.SortFields, colNames []string, fixed []bool) (ok bool, reverse bool) {
+	oi, ok := idx.(sql.OrderedIndex)
+	if !ok || oi.Order() != sql.IndexOrderAsc || idx.IsSpatial() {
+		return false, false
+	}
+	exprs := idx.Expressions()
+	descending := make([]bool, len(exprs))
+	if di, ok := idx.(sql.DescendingIndex); ok {
+		descending = di.Descending()
+	}
+	// An index on a prefix of a column isn't sorted on the whole column
+	prefixLengths := idx.PrefixLengths()
+
+	sortIdx := 0
+	hasDirection := false
+	for i := 0; i < len(exprs) && sortIdx < len(colNames); i++ {
+		isFixed := i < len(fixed) && fixed[i]
+		if !strings.EqualFold(colNames[sortIdx], exprs[i]) {
+			if isFixed {
+				continue
+			}
+			return false, false
+		}
+		if i < len(prefixLengths) && prefixLengths[i] > 0 {
+			return false, false
+		}
+		if !isFixed {
+			rev := descending[i] != (sortFields[sortIdx].Order == sql.Descending)
+			if hasDirection && rev != reverse {
+				return false, false
+			}
+			reverse, hasDirection = rev, true
+		}
+		sortIdx++
+	}
+	if sortIdx < len(colNames) {
+		return false, false
+	}
+	if reverse && !oi.Reversible() {
+		return false, false
+	}
+	return true, reverse
+}
@@ -15,6 +15,8 @@
 package analyzer
 
 import (
+	"strings"
+
 	"github.com/dolthub/vitess/go/sqltypes"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -787,7 +789,41 @@ func parseColumnDefaults(ctx *sql.Context, _ *Analyzer, n sql.Node, _ *Scope, _
 			}
 
 			nn.Source = newNode
-			return parseDefaultsForNode(ctx, nn)
+			err = referenceValuesInColumnDefaults(ctx, nn)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+
+			onDupExprs := nn.OnDupExprs
+			if len(onDupExprs) > 0 {
+				onDupExprs, _, err = assignGeneratedColumns(onDupExprs, nn.Destination)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
+			}
+			onDupExprs, same, err := fillInAssignmentDefaults(ctx, onDupExprs, nn.Destination)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			nn.OnDupExprs = onDupExprs
+			n, sameDefaults, err := parseDefaultsForNode(ctx, nn)
+			return n, same && sameDefaults, err
+		case *plan.UpdateSource:
+			updateExprs, sameGenerated, err := assignGeneratedColumns(nn.UpdateExprs, nn.Child)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			updateExprs, same, err := fillInAssignmentDefaults(ctx, updateExprs, nn.Child)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			same = same && sameGenerated
+			if !same {
+				// The generated columns may have been assigned, so the number of assignments may have changed
+				n = plan.NewUpdateSource(nn.Child, nn.Ignore, updateExprs)
+			}
+			n, sameDefaults, err := parseDefaultsForNode(ctx, n)
+			return n, same && sameDefaults, err
 		case *plan.ResolvedTable:
 			ct, ok := nn.Table.(*information_schema.ColumnsTable)
 			if !ok {
@@ -875,23 +911,43 @@ func fillInColumnDefaults(_ *sql.Context, insertInto *plan.InsertInto) error {
 
 	// Pull the column default values out into the same order the columns were specified
 	columnDefaultValues := make([]*sql.ColumnDefaultValue, len(insertInto.ColumnNames))
+	generated := make([]bool, len(insertInto.ColumnNames))
 	for i, columnName := range insertInto.ColumnNames {
 		index := schema.IndexOfColName(columnName)
 		if index == -1 {
 			return plan.ErrInsertIntoNonexistentColumn.New(columnName)
 		}
 		columnDefaultValues[i] = schema[index].Default
+		generated[i] = schema[index].Generated != nil
 	}
 
 	// Walk through the expression tuples looking for any column defaults to fill in
 	if values, ok := insertInto.Source.(*plan.Values); ok {
 		for _, exprTuple := range values.ExpressionTuples {
 			for i, value := range exprTuple {
+				// Generated columns can only be inserted DEFAULT, which is filled in already if this insert was
+				// analyzed before
+				if i < len(generated) && generated[i] {
+					switch value.(type) {
+					case *expression.DefaultColumn, *expression.Wrapper:
+					default:
+						return sql.ErrGeneratedColumnValue.New(insertInto.ColumnNames[i], getTableName(insertInto.Destination))
+					}
+				}
 				newExpression, _, err := transform.Expr(value, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
-					if _, ok := e.(*expression.DefaultColumn); ok {
-						return columnDefaultValues[i], transform.NewTree, nil
+					dc, ok := e.(*expression.DefaultColumn)
+					if !ok {
+						return e, transform.SameTree, nil
 					}
-					return e, transform.SameTree, nil
+					// DEFAULT(col) is the default value of the column named, rather than of the one it's inserted into
+					if dc.Name() != "" && !strings.EqualFold(dc.Name(), insertInto.ColumnNames[i]) {
+						index := schema.IndexOfColName(dc.Name())
+						if index == -1 {
+							return nil, transform.SameTree, sql.ErrColumnNotFound.New(dc.Name())
+						}
+						return schema[index].Default, transform.NewTree, nil
+					}
+					return columnDefaultValues[i], transform.NewTree, nil
 				})
 				if err != nil {
 					return err
@@ -904,6 +960,213 @@ func fillInColumnDefaults(_ *sql.Context, insertInto *plan.InsertInto) error {
 	return nil
 }
 
+// referenceValuesInColumnDefaults replaces the references to columns in the expression defaults filled in the VALUES of
+// the insert given with the values inserted in those columns, which they're evaluated against once the other values of
+// their row are, and with the defaults of the columns that aren't inserted.
+func referenceValuesInColumnDefaults(ctx *sql.Context, insertInto *plan.InsertInto) error {
+	values, ok := insertInto.Source.(*plan.Values)
+	if !ok {
+		return nil
+	}
+	schema := insertInto.Destination.Schema()
+
+	var referenceValues func(def *sql.ColumnDefaultValue, seen map[string]bool) (sql.Expression, error)
+	referenceValues = func(def *sql.ColumnDefaultValue, seen map[string]bool) (sql.Expression, error) {
+		newExpr, _, err := transform.Expr(def.Expression, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			var name string
+			switch e := e.(type) {
+			case *expression.UnresolvedColumn:
+				name = e.Name()
+			case *expression.GetField:
+				name = e.Name()
+			default:
+				return e, transform.SameTree, nil
+			}
+			index := schema.IndexOfColName(name)
+			if index == -1 {
+				return nil, transform.SameTree, sql.ErrColumnNotFound.New(name)
+			}
+			col := schema[index]
+			for i, columnName := range insertInto.ColumnNames {
+				if strings.EqualFold(columnName, name) {
+					return expression.NewGetField(i, col.Type, col.Name, col.Nullable), transform.NewTree, nil
+				}
+			}
+
+			// A column that isn't inserted has its default value, which can't reference the column back
+			if seen[strings.ToLower(name)] {
+				return nil, transform.SameTree, sql.ErrInvalidColumnDefaultValue.New(col.Name)
+			}
+			colDefault, err := parsedColumnDefault(ctx, col)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			if colDefault == nil {
+				return expression.NewLiteral(nil, col.Type), transform.NewTree, nil
+			}
+			seen[strings.ToLower(name)] = true
+			defer delete(seen, strings.ToLower(name))
+			colDefault.Expression, err = referenceValues(colDefault, seen)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			return colDefault, transform.NewTree, nil
+		})
+		return newExpr, err
+	}
+
+	for _, exprTuple := range values.ExpressionTuples {
+		for i, value := range exprTuple {
+			wrapper, ok := value.(*expression.Wrapper)
+			if !ok {
+				continue
+			}
+			def, ok := wrapper.Unwrap().(*sql.ColumnDefaultValue)
+			if !ok || def.IsLiteral() {
+				continue
+			}
+			newDef := *def
+			var err error
+			newDef.Expression, err = referenceValues(def, map[string]bool{strings.ToLower(insertInto.ColumnNames[i]): true})
+			if err != nil {
+				return err
+			}
+			exprTuple[i] = expression.WrapExpression(&newDef)
+		}
+	}
+	return nil
+}
+
+// fillInAssignmentDefaults replaces the DEFAULT and DEFAULT(col) expressions in the assignments given with the default
+// values of the columns of the tables of the node given, which the assignments update. The references to columns in
+// the default values are to those of the row they update.
+func fillInAssignmentDefaults(ctx *sql.Context, assignments []sql.Expression, tables sql.Node) ([]sql.Expression, transform.TreeIdentity, error) {
+	var newAssignments []sql.Expression
+	for i, assignment := range assignments {
+		setField, ok := assignment.(*expression.SetField)
+		if !ok {
+			continue
+		}
+		newRight, same, err := transform.Expr(setField.Right, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			dc, ok := e.(*expression.DefaultColumn)
+			if !ok {
+				return e, transform.SameTree, nil
+			}
+			tableName, name := "", dc.Name()
+			if name == "" {
+				left, ok := setField.Left.(sql.Nameable)
+				if !ok {
+					return e, transform.SameTree, nil
+				}
+				name = left.Name()
+				if tableable, ok := setField.Left.(sql.Tableable); ok {
+					tableName = tableable.Table()
+				}
+			}
+			def, err := assignmentDefault(ctx, tables, tableName, name)
+			return def, transform.NewTree, err
+		})
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if same {
+			continue
+		}
+		if newAssignments == nil {
+			newAssignments = make([]sql.Expression, len(assignments))
+			copy(newAssignments, assignments)
+		}
+		newAssignments[i] = expression.NewSetField(setField.Left, newRight)
+	}
+	if newAssignments == nil {
+		return assignments, transform.SameTree, nil
+	}
+	return newAssignments, transform.NewTree, nil
+}
+
+// assignmentDefault returns the default value of the column named in the tables of the node given, with its references
+// to columns qualified with the name of its table.
+func assignmentDefault(ctx *sql.Context, tables sql.Node, tableName, name string) (sql.Expression, error) {
+	var col *sql.Column
+	var colTable string
+	var foundTables []string
+	transform.Inspect(tables, func(n sql.Node) bool {
+		var nodeTable string
+		switch n := n.(type) {
+		case *plan.TableAlias:
+			nodeTable = n.Name()
+		case *plan.ResolvedTable:
+			nodeTable = n.Name()
+		case *plan.SubqueryAlias:
+			return false
+		default:
+			return true
+		}
+		if tableName != "" && !strings.EqualFold(tableName, nodeTable) {
+			return false
+		}
+		sch := n.Schema()
+		if index := sch.IndexOfColName(name); index != -1 {
+			col, colTable = sch[index], nodeTable
+			foundTables = append(foundTables, nodeTable)
+		}
+		return false
+	})
+	if len(foundTables) > 1 {
+		return nil, sql.ErrAmbiguousColumnName.New(name, foundTables)
+	}
+	if col == nil {
+		return nil, sql.ErrColumnNotFound.New(name)
+	}
+
+	var def *sql.ColumnDefaultValue
+	var err error
+	if col.Generated != nil {
+		// The value of a generated column is always the one generated for it
+		def, err = parsedGeneratedColumn(ctx, col)
+	} else {
+		def, err = parsedColumnDefault(ctx, col)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if def == nil {
+		if !col.Nullable && !col.AutoIncrement {
+			return nil, sql.ErrInsertIntoNonNullableDefaultNullColumn.New(col.Name)
+		}
+		return expression.NewLiteral(nil, col.Type), nil
+	}
+
+	def.Expression, _, err = transform.Expr(def.Expression, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		switch e := e.(type) {
+		case *expression.UnresolvedColumn:
+			return expression.NewUnresolvedQualifiedColumn(colTable, e.Name()), transform.NewTree, nil
+		case *expression.GetField:
+			return expression.NewUnresolvedQualifiedColumn(colTable, e.Name()), transform.NewTree, nil
+		default:
+			return e, transform.SameTree, nil
+		}
+	})
+	return def, err
+}
+
+// parsedColumnDefault returns a copy of the default value of the column given, parsing it if it's unresolved, with
+// the type of the column.
+func parsedColumnDefault(ctx *sql.Context, col *sql.Column) (*sql.ColumnDefaultValue, error) {
+	def := col.Default
+	if def == nil {
+		return nil, nil
+	}
+	if ucd, ok := def.Expression.(sql.UnresolvedColumnDefault); ok {
+		var err error
+		def, err = parse.StringToColumnDefaultValue(ctx, ucd.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sql.NewColumnDefaultValue(def.Expression, col.Type, def.IsLiteral(), def.IsParenthesized(), col.Nullable)
+}
+
 // parseColumnDefault transforms an UnresolvedColumnDefault expression into a ColumnDefaultValue expression
 func parseColumnDefault(ctx *sql.Context, e *expression.Wrapper) (sql.Expression, transform.TreeIdentity, error) {
 	newDefault, ok := e.Unwrap().(*sql.ColumnDefaultValue)
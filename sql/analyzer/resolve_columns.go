@@ -196,7 +196,8 @@ func (a availableNames) allTables() []string {
 
 // aliasesAndTablesForColumnAtLevel returns a slice of strings indicating how many distinct alias definitions are available
 // for the specified column name, as well as a slice of strings indicating which distinct tables are available with that
-// column name.
+// column name. Aliases of the same expression, such as those of a projection repeated in a select list, are counted
+// once, since they can't be told apart.
 func (a availableNames) aliasesAndTablesForColumnAtLevel(column string, scopeLevel int) ([]string, []string) {
 	tableNames := a[scopeLevel].availableColumns[column]
 	aliasesFound := make([]string, 0, len(tableNames))
@@ -206,8 +207,13 @@ func (a availableNames) aliasesAndTablesForColumnAtLevel(column string, scopeLev
 			// Regardless of the number of aliases defined with a specific alias name, availableColumns
 			// currently tracks a single empty string to represent them, so check in another datastructure
 			// to see how many alias definitions actually used this name.
-			for range a[scopeLevel].availableAliases[column] {
-				aliasesFound = append(aliasesFound, tableName)
+			seen := make(map[string]struct{})
+			for _, alias := range a[scopeLevel].availableAliases[column] {
+				expr := strings.ToLower(alias.Child.String())
+				if _, ok := seen[expr]; !ok {
+					seen[expr] = struct{}{}
+					aliasesFound = append(aliasesFound, tableName)
+				}
 			}
 		} else {
 			tablesFound = append(tablesFound, tableName)
@@ -217,6 +223,96 @@ func (a availableNames) aliasesAndTablesForColumnAtLevel(column string, scopeLev
 	return aliasesFound, tablesFound
 }
 
+// nameClause is the clause of a query that an unqualified column reference is made in. When a name matches both a
+// column of a table in the FROM clause and an alias of the select list, MySQL decides which one it refers to depending
+// on the clause: a WHERE clause can't reference the aliases of its own scope, a GROUP BY clause prefers the column, an
+// ORDER BY clause prefers the alias, and a HAVING clause prefers the alias unless the column is one the query is grouped
+// by. Every other expression prefers the column.
+type nameClause byte
+
+const (
+	selectClause nameClause = iota
+	whereClause
+	groupByClause
+	havingClause
+	orderByClause
+)
+
+// nameClauseOf returns the clause that the expressions of the node given belong to. The grouping expressions of a
+// GroupBy node are qualified separately, since they share the node with its select list.
+func nameClauseOf(n sql.Node) nameClause {
+	switch n.(type) {
+	case *plan.Filter:
+		return whereClause
+	case *plan.Having:
+		return havingClause
+	case *plan.Sort:
+		return orderByClause
+	default:
+		return selectClause
+	}
+}
+
+// groupedColumnNames returns the lower case names of the table columns that the GroupBy node under the Having node
+// given groups by, which a HAVING clause refers to instead of an alias with the same name.
+func groupedColumnNames(having *plan.Having) map[string]bool {
+	groupBy, ok := having.Child.(*plan.GroupBy)
+	if !ok {
+		return nil
+	}
+	grouped := make(map[string]bool)
+	for _, e := range groupBy.GroupByExprs {
+		if col, ok := e.(column); ok && col.Table() != "" {
+			grouped[strings.ToLower(col.Name())] = true
+		}
+	}
+	return grouped
+}
+
+// resolveName qualifies the unqualified column reference |col|, made in the clause given, to the table column or the
+// select list alias it refers to. Scope levels are searched from the innermost to the outermost, and the first one that
+// has a table column or an alias with the column's name decides what it refers to, following the precedence of the
+// clause. |grouped| has the names of the table columns a HAVING clause's query is grouped by. A nil expression is
+// returned when no scope level has the name.
+func (a availableNames) resolveName(col column, clause nameClause, grouped map[string]bool) (sql.Expression, error) {
+	name := strings.ToLower(col.Name())
+	levels := a.levels()
+	for _, scopeLevel := range levels {
+		aliasesFound, tablesFound := a.aliasesAndTablesForColumnAtLevel(name, scopeLevel)
+		if (clause == whereClause || clause == selectClause) && scopeLevel == levels[0] {
+			// Expression aliases from the same scope are NOT allowed in where/filter clauses or in the select list
+			// they're defined in
+			aliasesFound = nil
+		}
+		if len(aliasesFound)+len(tablesFound) == 0 {
+			// This column could be in an outer scope, keep going
+			continue
+		}
+
+		preferAlias := clause == orderByClause || (clause == havingClause && !grouped[name])
+		if len(aliasesFound) > 0 && (preferAlias || len(tablesFound) == 0) {
+			if len(aliasesFound) > 1 && clause != selectClause && clause != whereClause {
+				return nil, sql.ErrAmbiguousColumnOrAliasName.New(col.Name())
+			}
+			// MySQL allows ambiguity with multiple alias names in some situations, so identify this as an alias
+			// reference and resolve the exact alias definition later
+			return expression.NewAliasReference(col.Name()), nil
+		}
+
+		switch {
+		case len(tablesFound) == 1:
+			return expression.NewUnresolvedQualifiedColumn(tablesFound[0], col.Name()), nil
+		case len(aliasesFound) > 0 && clause != groupByClause:
+			return expression.NewAliasReference(col.Name()), nil
+		case preferAlias:
+			return nil, sql.ErrAmbiguousColumnOrAliasName.New(col.Name())
+		default:
+			return nil, sql.ErrAmbiguousColumnName.New(col.Name(), strings.Join(tablesFound, ", "))
+		}
+	}
+	return nil, nil
+}
+
 func (a availableNames) hasTableCol(tc tableCol) bool {
 	for scopeLevel := range a {
 		_, ok := a[scopeLevel].availableTableCols[tc]
@@ -299,18 +395,20 @@ func qualifyColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 			sameCheckConstraints = false
 		}
 
-		// Before we can qualify references in a GroupBy node, we need to see if any aliases
-		// were defined and then used in grouping expressions
-		sameGroupBy := true
+		// The grouping expressions of a GroupBy node are in a different clause than its select list, so they're
+		// qualified separately
 		if groupBy, ok := n.(*plan.GroupBy); ok {
-			newGroupBy, identity, err := identifyGroupingAliasReferences(groupBy)
+			newNode, sameNode, err := qualifyGroupBy(groupBy, symbols)
 			if err != nil {
 				return originalNode, transform.SameTree, err
 			}
-			if identity == transform.NewTree {
-				n = newGroupBy
-				sameGroupBy = false
-			}
+			return newNode, sameNode, nil
+		}
+
+		clause := nameClauseOf(n)
+		var grouped map[string]bool
+		if having, ok := n.(*plan.Having); ok {
+			grouped = groupedColumnNames(having)
 		}
 
 		newNode, sameNode, err := transform.OneNodeExprsWithNode(n, func(n sql.Node, e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
@@ -318,46 +416,38 @@ func qualifyColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 			if in, ok := n.(*plan.InsertInto); ok && len(in.OnDupExprs) > 0 && onDupUpdateLeftExprs[e] {
 				evalSymbols = onDupUpdateSymbols
 			}
-			return qualifyExpression(e, n, evalSymbols)
+			return qualifyExpression(e, clause, grouped, evalSymbols)
 		})
 		if err != nil {
 			return originalNode, transform.SameTree, err
 		}
 
-		if sameCheckConstraints && sameGroupBy && sameNode == transform.SameTree {
+		if sameCheckConstraints && sameNode == transform.SameTree {
 			return newNode, transform.SameTree, nil
 		}
 		return newNode, transform.NewTree, nil
 	})
 }
 
-// identifyGroupingAliasReferences finds any aliases defined in the projected expressions of
-// the specified GroupBy node, looks for references to those aliases in the grouping expressions
-// of the same GroupBy node, and transforms them to an AliasReference expression. This is
-// necessary because when qualifying columns, we can't currently distinguish between projection
-// expressions and grouping expressions because GroupBy combines both in its Expresions() func,
-// so we special case GroupBy here to identify aliases used in the grouping expressions.
-func identifyGroupingAliasReferences(groupBy *plan.GroupBy) (*plan.GroupBy, transform.TreeIdentity, error) {
-	projectedAliases := aliasesDefinedInNode(groupBy)
-
-	// Temporarily remove projection expressions so we can transform only the grouping expressions
-	groupByWithOnlyGroupingExprs := plan.NewGroupBy(nil, groupBy.GroupByExprs, groupBy.Child)
-	newNode, identity, err := transform.OneNodeExpressions(groupByWithOnlyGroupingExprs, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
-		uc, ok := e.(*expression.UnresolvedColumn)
-		if !ok || uc.Table() != "" {
-			return e, transform.SameTree, nil
-		}
-
-		if stringContains(projectedAliases, strings.ToLower(uc.Name())) {
-			return expression.NewAliasReference(uc.Name()), transform.NewTree, nil
-		}
-
-		return e, transform.SameTree, nil
+// qualifyGroupBy qualifies the column references of the select list and of the grouping expressions of the GroupBy node
+// given. A name in the grouping expressions refers to a table column before an alias of the select list.
+func qualifyGroupBy(groupBy *plan.GroupBy, symbols availableNames) (sql.Node, transform.TreeIdentity, error) {
+	selected, sameSelected, err := transform.Exprs(groupBy.SelectedExprs, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		return qualifyExpression(e, selectClause, nil, symbols)
+	})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	grouping, sameGrouping, err := transform.Exprs(groupBy.GroupByExprs, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		return qualifyExpression(e, groupByClause, nil, symbols)
 	})
-	if identity == transform.NewTree && err == nil {
-		groupBy = plan.NewGroupBy(groupBy.SelectedExprs, newNode.(*plan.GroupBy).GroupByExprs, groupBy.Child)
+	if err != nil {
+		return nil, transform.SameTree, err
 	}
-	return groupBy, identity, err
+	if sameSelected && sameGrouping {
+		return groupBy, transform.SameTree, nil
+	}
+	return plan.NewGroupBy(selected, grouping, groupBy.Child).WithModifier(groupBy.Modifier), transform.NewTree, nil
 }
 
 // qualifyCheckConstraints returns a new set of CheckConstraints created by taking the specified Update node's checks
@@ -438,7 +528,10 @@ func getAvailableNamesByScope(n sql.Node, scope *Scope) availableNames {
 
 	// Get table names in all outer scopes and nodes. Inner scoped names will overwrite those from the outer scope.
 	// note: we terminate the symbols for this level after finding the first column source
-	for scopeLevel, n := range scopeNodes {
+	for i, n := range scopeNodes {
+		// scopeNodes go from the innermost scope outward, while scope levels go from the outermost scope inward, as
+		// they do for the columns above
+		scopeLevel := len(scopeNodes) - 1 - i
 		transform.Inspect(n, func(n sql.Node) bool {
 			switch n := n.(type) {
 			case *plan.SubqueryAlias, *plan.ResolvedTable, *plan.ValueDerivedTable, *plan.RecursiveTable, *plan.RecursiveCte, *plan.IndexedTableAccess, *plan.JSONTable:
@@ -470,10 +563,10 @@ func getAvailableNamesByScope(n sql.Node, scope *Scope) availableNames {
 	return symbols
 }
 
-// qualifyExpression examines the specified expression |e|, coming from the specified node |node|, and uses the |availableNames|
-// symbol map to identify the table or expression alias an unqualified column reference should map to. The updated,
-// qualified expression is returned along with the transform identity, or any error encountered.
-func qualifyExpression(e sql.Expression, node sql.Node, symbols availableNames) (sql.Expression, transform.TreeIdentity, error) {
+// qualifyExpression examines the specified expression |e|, coming from the specified |clause| of a query, and uses the
+// |availableNames| symbol map to identify the table or expression alias an unqualified column reference should map to.
+// The updated, qualified expression is returned along with the transform identity, or any error encountered.
+func qualifyExpression(e sql.Expression, clause nameClause, grouped map[string]bool, symbols availableNames) (sql.Expression, transform.TreeIdentity, error) {
 	switch col := e.(type) {
 	case column:
 		if col.Resolved() {
@@ -496,12 +589,6 @@ func qualifyExpression(e sql.Expression, node sql.Node, symbols availableNames)
 			return col, transform.SameTree, nil
 		}
 
-		canAccessAliasAtCurrentScope := true
-		if _, ok := node.(*plan.Filter); ok {
-			// Expression aliases from the same scope are NOT allowed in where/filter clauses
-			canAccessAliasAtCurrentScope = false
-		}
-
 		// If this column is already qualified, make sure the table name is known
 		if col.Table() != "" {
 			if validateQualifiedColumn(col, symbols) {
@@ -512,58 +599,15 @@ func qualifyExpression(e sql.Expression, node sql.Node, symbols availableNames)
 			}
 		}
 
-		// Look in all the scopes (from inner to outer), to identify the column. Stop as soon as we have a scope with
-		// exactly 1 match for the column name. If there is ambiguity in available column names, that's an error.
-		name := strings.ToLower(col.Name())
-		for _, scopeLevel := range symbols.levels() {
-			aliasesFound, tablesFound := symbols.aliasesAndTablesForColumnAtLevel(name, scopeLevel)
-			switch len(aliasesFound) + len(tablesFound) {
-			case 0:
-				// This column could be in an outer scope, keep going
-				continue
-			case 1:
-				if len(aliasesFound) > 0 {
-					// This indicates we found a match with an alias definition
-					if canAccessAliasAtCurrentScope || scopeLevel != symbols.levels()[0] {
-						return expression.NewAliasReference(col.Name()), transform.NewTree, nil
-					}
-				} else {
-					return expression.NewUnresolvedQualifiedColumn(
-						tablesFound[0],
-						col.Name(),
-					), transform.NewTree, nil
-				}
-			default:
-				switch node.(type) {
-				case *plan.Sort, *plan.Having:
-					// For order by and having clauses... prefer an alias over a column when there is ambiguity
-					if len(aliasesFound) == 0 {
-						if len(tablesFound) == 1 {
-							return expression.NewUnresolvedQualifiedColumn(tablesFound[0], col.Name()), transform.NewTree, nil
-						} else if len(tablesFound) > 1 {
-							return col, transform.SameTree, sql.ErrAmbiguousColumnOrAliasName.New(col.Name())
-						}
-					} else if len(aliasesFound) == 1 {
-						return expression.NewAliasReference(col.Name()), transform.NewTree, nil
-					} else if len(aliasesFound) > 1 {
-						return col, transform.SameTree, sql.ErrAmbiguousColumnOrAliasName.New(col.Name())
-					}
-				default:
-					// otherwise, prefer the table column...
-					if len(tablesFound) == 1 {
-						return expression.NewUnresolvedQualifiedColumn(tablesFound[0], col.Name()), transform.NewTree, nil
-					} else if len(aliasesFound) > 0 {
-						// MySQL allows ambiguity with multiple alias names in some situations, so identify this as an
-						// alias reference and resolve the exact alias definition later
-						return expression.NewAliasReference(col.Name()), transform.NewTree, nil
-					}
-				}
-
-				return nil, transform.SameTree, sql.ErrAmbiguousColumnName.New(col.Name(), strings.Join(tablesFound, ", "))
-			}
+		resolved, err := symbols.resolveName(col, clause, grouped)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if resolved != nil {
+			return resolved, transform.NewTree, nil
 		}
 
-		if !canAccessAliasAtCurrentScope {
+		if clause == whereClause {
 			// return a deferredColumn if we still can't find a column and know this couldn't be an alias reference
 			return &deferredColumn{expression.NewUnresolvedQualifiedColumn(col.Table(), col.Name())}, transform.NewTree, nil
 		}
@@ -961,27 +1005,29 @@ func resolveColumnExpression(a *Analyzer, n sql.Node, e column, columns map[tabl
 			// time to resolve other parts so this can be resolved.
 			a.Log("deferring resolution of column %s", e)
 			return &deferredColumn{uc}, transform.NewTree, nil
-		default:
-			if table != "" {
-				return nil, transform.SameTree, sql.ErrTableColumnNotFound.New(e.Table(), e.Name())
+		case *expression.AliasReference:
+			if columns == nil {
+				// The columns aren't indexed until the outer scope is resolved, so wait for it
+				return e, transform.SameTree, nil
 			}
+		}
 
-			// This means the expression is either a non-existent column or an alias defined in the same projection.
-			// Check for the latter first.
-			// NOTE: For GroupBy nodes, the projected expressions and grouping expressions are both returned from
-			//       Expressions(), so at this point in the code, we can't tell if we are looking at a projected
-			//       expression or a grouping expression here, and the alias resolution rules are different for each.
-			//       We handle this with special casing for GroupBy that transforms identified aliases in grouping
-			//       expressions into AliasReferences, so here we assume that this is a projection expression.
-			//       Being able to differentiate between grouping and projection expressions here could help
-			//       clean up this logic.
-			aliasesInNode := aliasesDefinedInNode(n)
-			if stringContains(aliasesInNode, name) {
-				return nil, transform.SameTree, sql.ErrMisusedAlias.New(name)
-			}
+		if table != "" {
+			return nil, transform.SameTree, sql.ErrTableColumnNotFound.New(e.Table(), e.Name())
+		}
 
-			return nil, transform.SameTree, sql.ErrColumnNotFound.New(e.Name())
+		// This means the expression is either a non-existent column or an alias defined in the same projection.
+		// Check for the latter first.
+		// NOTE: For GroupBy nodes, the projected expressions and grouping expressions are both returned from
+		//       Expressions(), so at this point in the code, we can't tell if we are looking at a projected
+		//       expression or a grouping expression. qualifyGroupBy has already turned the aliases referenced in
+		//       grouping expressions into AliasReferences, so here we assume that this is a projection expression.
+		aliasesInNode := aliasesDefinedInNode(n)
+		if stringContains(aliasesInNode, name) {
+			return nil, transform.SameTree, sql.ErrMisusedAlias.New(name)
 		}
+
+		return nil, transform.SameTree, sql.ErrColumnNotFound.New(e.Name())
 	}
 
 	a.Log("column %s resolved to GetFieldWithTable: idx %d, typ %s, table %s, name %s, nullable %t",
@@ -1039,11 +1085,14 @@ func pushdownGroupByAliases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sc
 		}
 
 		var selectedColumns = make(map[string]column)
+		var pushedAliases = make(map[string]bool)
 		for _, agg := range g.SelectedExprs {
 			// This alias is going to be pushed down, so don't bother gathering
-			// its requirements.
-			if alias, ok := agg.(*expression.Alias); ok {
-				if _, ok := groupingColumns[strings.ToLower(alias.Name())]; ok {
+			// its requirements. Only the first alias with a name is pushed down.
+			if alias, ok := agg.(*expression.Alias); ok && !containsAggregation(alias) {
+				name := strings.ToLower(alias.Name())
+				if _, ok := groupingColumns[name]; ok && !pushedAliases[name] {
+					pushedAliases[name] = true
 					continue
 				}
 			}
@@ -1166,7 +1215,7 @@ func pushdownGroupByAliases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sc
 		return plan.NewGroupBy(
 			newSelectedExprs, newGroupBys,
 			plan.NewProject(projection, g.Child),
-		), transform.NewTree, nil
+		).WithModifier(g.Modifier), transform.NewTree, nil
 	})
 }
 
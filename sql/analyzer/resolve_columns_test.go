@@ -220,6 +220,113 @@ func TestQualifyColumns(t *testing.T) {
 			),
 			err: sql.ErrAmbiguousColumnName,
 		},
+		{
+			name: "where refers to table column, not alias",
+			node: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("i", uc("x"))},
+				plan.NewFilter(
+					gt(uc("i"), lit(1)),
+					plan.NewResolvedTable(table, nil, nil),
+				),
+			),
+			expected: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("i", uqc("mytable", "x"))},
+				plan.NewFilter(
+					gt(uqc("mytable", "i"), lit(1)),
+					plan.NewResolvedTable(table, nil, nil),
+				),
+			),
+		},
+		{
+			name: "group by prefers table column over alias",
+			node: plan.NewGroupBy(
+				[]sql.Expression{expression.NewAlias("i", uc("x"))},
+				[]sql.Expression{uc("i")},
+				plan.NewResolvedTable(table, nil, nil),
+			),
+			expected: plan.NewGroupBy(
+				[]sql.Expression{expression.NewAlias("i", uqc("mytable", "x"))},
+				[]sql.Expression{uqc("mytable", "i")},
+				plan.NewResolvedTable(table, nil, nil),
+			),
+		},
+		{
+			name: "group by alias",
+			node: plan.NewGroupBy(
+				[]sql.Expression{expression.NewAlias("z", uc("x"))},
+				[]sql.Expression{uc("z")},
+				plan.NewResolvedTable(table, nil, nil),
+			),
+			expected: plan.NewGroupBy(
+				[]sql.Expression{expression.NewAlias("z", uqc("mytable", "x"))},
+				[]sql.Expression{expression.NewAliasReference("z")},
+				plan.NewResolvedTable(table, nil, nil),
+			),
+		},
+		{
+			name: "group by ambiguous aliases",
+			node: plan.NewGroupBy(
+				[]sql.Expression{expression.NewAlias("z", uc("x")), expression.NewAlias("z", uc("i"))},
+				[]sql.Expression{uc("z")},
+				plan.NewResolvedTable(table, nil, nil),
+			),
+			err: sql.ErrAmbiguousColumnOrAliasName,
+		},
+		{
+			name: "having prefers grouped column over alias",
+			node: plan.NewHaving(
+				gt(uc("i"), lit(1)),
+				plan.NewGroupBy(
+					[]sql.Expression{expression.NewAlias("i", uqc("mytable", "x"))},
+					[]sql.Expression{uqc("mytable", "i")},
+					plan.NewResolvedTable(table, nil, nil),
+				),
+			),
+			expected: plan.NewHaving(
+				gt(uqc("mytable", "i"), lit(1)),
+				plan.NewGroupBy(
+					[]sql.Expression{expression.NewAlias("i", uqc("mytable", "x"))},
+					[]sql.Expression{uqc("mytable", "i")},
+					plan.NewResolvedTable(table, nil, nil),
+				),
+			),
+		},
+		{
+			name: "having prefers alias over column not grouped",
+			node: plan.NewHaving(
+				gt(uc("i"), lit(1)),
+				plan.NewGroupBy(
+					[]sql.Expression{expression.NewAlias("i", uqc("mytable", "x"))},
+					[]sql.Expression{uqc("mytable", "x")},
+					plan.NewResolvedTable(table, nil, nil),
+				),
+			),
+			expected: plan.NewHaving(
+				gt(expression.NewAliasReference("i"), lit(1)),
+				plan.NewGroupBy(
+					[]sql.Expression{expression.NewAlias("i", uqc("mytable", "x"))},
+					[]sql.Expression{uqc("mytable", "x")},
+					plan.NewResolvedTable(table, nil, nil),
+				),
+			),
+		},
+		{
+			name: "order by prefers alias over table column",
+			node: plan.NewSort(
+				[]sql.SortField{{Column: uc("i"), Column2: uc("i")}},
+				plan.NewProject(
+					[]sql.Expression{expression.NewAlias("i", uqc("mytable", "x"))},
+					plan.NewResolvedTable(table, nil, nil),
+				),
+			),
+			expected: plan.NewSort(
+				[]sql.SortField{{Column: expression.NewAliasReference("i")}},
+				plan.NewProject(
+					[]sql.Expression{expression.NewAlias("i", uqc("mytable", "x"))},
+					plan.NewResolvedTable(table, nil, nil),
+				),
+			),
+		},
 		{
 			name: "subquery, all columns already qualified",
 			node: plan.NewProject(
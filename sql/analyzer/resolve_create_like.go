@@ -48,7 +48,9 @@ func resolveCreateLike(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 				continue
 			}
 			constraint := sql.IndexConstraint_None
-			if index.IsUnique() {
+			if index.IsSpatial() {
+				constraint = sql.IndexConstraint_Spatial
+			} else if index.IsUnique() {
 				if index.ID() == "PRIMARY" {
 					constraint = sql.IndexConstraint_Primary
 				} else {
@@ -56,13 +58,25 @@ func resolveCreateLike(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 				}
 			}
 
+			prefixLengths := index.PrefixLengths()
 			columns := make([]sql.IndexColumn, len(index.Expressions()))
 			for i, col := range index.Expressions() {
 				//TODO: find a better way to get only the column name if the table is present
 				col = strings.TrimPrefix(col, indexableTable.Name()+".")
+				var length int64
+				if i < len(prefixLengths) {
+					length = int64(prefixLengths[i])
+				}
 				columns[i] = sql.IndexColumn{
 					Name:   col,
-					Length: 0,
+					Length: length,
+				}
+				if keyPart := plan.GetFunctionalKeyPart(index, i); keyPart != nil {
+					columns[i].Name = sql.FunctionalKeyPartColumnName(index.ID(), i)
+					columns[i].Expression = keyPart
+				}
+				if di, ok := index.(sql.DescendingIndex); ok {
+					columns[i].Descending = di.Descending()[i]
 				}
 			}
 			idxDefs = append(idxDefs, &plan.IndexDefinition{
@@ -87,8 +101,29 @@ func resolveCreateLike(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 		pkOrdinals = pkTable.PrimaryKeySchema().PkOrdinals
 	}
 
+	var checkDefs []*sql.CheckConstraint
+	if checkTable, ok := likeTable.(sql.CheckTable); ok {
+		checks, err := checkTable.GetChecks(ctx)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		for i := range checks {
+			checkDef, err := ConvertCheckDefToConstraint(ctx, &checks[i])
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			// Generated check names refer to the table they were generated for
+			generatedPrefix := likeTable.Name() + "_chk_"
+			if strings.HasPrefix(checkDef.Name, generatedPrefix) {
+				checkDef.Name = ct.Name() + "_chk_" + strings.TrimPrefix(checkDef.Name, generatedPrefix)
+			}
+			checkDefs = append(checkDefs, checkDef)
+		}
+	}
+
 	tableSpec := &plan.TableSpec{
 		Schema:    sql.NewPrimaryKeySchema(newSch, pkOrdinals...),
+		ChDefs:    checkDefs,
 		IdxDefs:   idxDefs,
 		Collation: likeTable.Collation(),
 	}
@@ -1,9 +1,15 @@
 package analyzer
 
 import (
+	"unicode/utf8"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 func resolveCreateSelect(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
@@ -23,7 +29,7 @@ func resolveCreateSelect(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 	// We don't want to carry any information about keys, constraints, defaults, etc. from a `create table as select`
 	// statement. When the underlying select node is a table, we must remove all such info from its schema. The only
 	// exception is NOT NULL constraints, which we leave alone.
-	selectSchema := stripSchema(analyzedSelect.Schema())
+	selectSchema := createSelectSchema(ctx, analyzedSelect)
 	mergedSchema := mergeSchemas(inputSpec.Schema.Schema, selectSchema)
 	newSch := make(sql.Schema, len(mergedSchema))
 
@@ -67,6 +73,72 @@ func stripSchema(schema sql.Schema) sql.Schema {
 	return sch
 }
 
+// createSelectSchema returns the schema of the columns that a CREATE TABLE ... SELECT statement derives from its select
+// list. Column types are taken from the selected expressions, with literals and NULLs given the types that MySQL
+// assigns to them, and string columns carrying the collation derived from their expression.
+func createSelectSchema(ctx *sql.Context, analyzedSelect sql.Node) sql.Schema {
+	sch := stripSchema(analyzedSelect.Schema())
+	exprs := selectListExpressions(StripPassthroughNodes(analyzedSelect))
+	if len(exprs) != len(sch) {
+		return sch
+	}
+	for i, expr := range exprs {
+		if alias, ok := expr.(*expression.Alias); ok {
+			expr = alias.Child
+		}
+		sch[i].Type = createSelectColumnType(ctx, expr, sch[i].Type)
+	}
+	return sch
+}
+
+// selectListExpressions returns the expressions projected by the top-most projection of the node given, or nil if they
+// cannot be determined.
+func selectListExpressions(n sql.Node) []sql.Expression {
+	switch n := n.(type) {
+	case sql.Projector:
+		return n.ProjectedExprs()
+	case *plan.Sort, *plan.Limit, *plan.Offset, *plan.Distinct, *plan.OrderedDistinct, *plan.Having, *plan.Filter:
+		return selectListExpressions(n.Children()[0])
+	default:
+		return nil
+	}
+}
+
+// createSelectColumnType returns the type of a column created from the expression given, whose type as evaluated is
+// |typ|.
+func createSelectColumnType(ctx *sql.Context, expr sql.Expression, typ sql.Type) sql.Type {
+	if typ == types.Null {
+		return types.MustCreateBinary(sqltypes.Binary, 0)
+	}
+
+	if lit, ok := expr.(*expression.Literal); ok {
+		switch {
+		case typ == types.Int8 || typ == types.Int16 || typ == types.Int24:
+			return types.Int32
+		case types.IsText(typ):
+			str, ok := lit.Value().(string)
+			if !ok {
+				break
+			}
+			collation, _ := lit.CollationCoercibility(ctx)
+			if strType, err := types.CreateString(sqltypes.VarChar, int64(utf8.RuneCountInString(str)), collation); err == nil {
+				return strType
+			}
+		}
+		return typ
+	}
+
+	if strType, ok := typ.(sql.StringType); ok && types.IsText(typ) {
+		collation, _ := sql.GetCoercibility(ctx, expr)
+		if collation != strType.Collation() && collation.CharacterSet() != sql.CharacterSet_binary {
+			if newType, err := types.CreateString(strType.Type(), strType.MaxCharacterLength(), collation); err == nil {
+				return newType
+			}
+		}
+	}
+	return typ
+}
+
 // mergeSchemas takes in the table spec of the CREATE TABLE and merges it with the schema used by the
 // select query. The ultimate structure for the new table will be [CREATE TABLE exclusive columns, columns with the same
 // name, SELECT exclusive columns]
@@ -126,7 +126,22 @@ func resolveFunctionsInExpr(ctx *sql.Context, a *Analyzer) transform.ExprFunc {
 		}
 
 		n := uf.Name()
+		// A function whose name is qualified by a database can only be a stored function, and built-in functions take
+		// precedence over stored functions with the same name otherwise
 		f, err := a.Catalog.Function(ctx, n)
+		if uf.Database != "" || sql.ErrFunctionNotFound.Is(err) {
+			sf, ok, sfErr := resolveStoredFunction(ctx, a, uf)
+			if sfErr != nil {
+				return nil, transform.SameTree, sfErr
+			}
+			if ok {
+				a.Log("resolved stored function %q", n)
+				return sf, transform.NewTree, nil
+			}
+			if uf.Database != "" {
+				return nil, transform.SameTree, sql.ErrStoredFunctionDoesNotExist.New(uf.Database + "." + n)
+			}
+		}
 		if err != nil {
 			return nil, transform.SameTree, err
 		}
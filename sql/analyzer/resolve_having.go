@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"reflect"
+	"sort"
 	"strings"
 
 	"gopkg.in/src-d/go-errors.v1"
@@ -74,20 +75,20 @@ func resolveHaving(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope, s
 	})
 }
 
-func findMissingColumns(node sql.Node, scope *Scope, expr sql.Expression) map[string]bool {
-	var schemaCols []string
-	for _, col := range node.Schema() {
-		schemaCols = append(schemaCols, strings.ToLower(col.Name))
-	}
-	for _, col := range scope.Schema() {
-		schemaCols = append(schemaCols, strings.ToLower(col.Name))
+// findMissingColumns returns the columns referenced by the expression given that aren't in the schema of the node
+// given or of the scope. A column qualified with a table is only found in the columns of that table.
+func findMissingColumns(node sql.Node, scope *Scope, expr sql.Expression) map[tableCol]bool {
+	var schemaCols = make(map[tableCol]bool)
+	for _, col := range append(node.Schema(), scope.Schema()...) {
+		schemaCols[newTableCol("", col.Name)] = true
+		schemaCols[newTableCol(col.Source, col.Name)] = true
 	}
 
-	var missingCols = make(map[string]bool)
+	var missingCols = make(map[tableCol]bool)
 	for _, n := range findExprNameables(expr) {
-		name := strings.ToLower(n.Name())
-		if !stringContains(schemaCols, name) {
-			missingCols[n.Name()] = true
+		tc := tableColFromNameable(n)
+		if !schemaCols[tc] {
+			missingCols[tc] = true
 		}
 	}
 
@@ -111,13 +112,16 @@ var errHavingChildMissingRef = errors.NewKind("cannot find column %s referenced
 // pullMissingColumnsUp will attempt to find given missing columns. It will traverse on plan.Having node and scan
 // its children's schema to find the missing columns. The columns that are found will be added in Projections of
 // underlying plan.Project node and SelectExprs of underlying plan.GroupBy node.
-func pullMissingColumnsUp(having *plan.Having, missingCols map[string]bool, scopeLen int) (*plan.Having, error) {
+func pullMissingColumnsUp(having *plan.Having, missingCols map[tableCol]bool, scopeLen int) (*plan.Having, error) {
 	var newAggregate []sql.Expression
 	loopSchema := func(schema sql.Schema) {
-		for i, c := range schema {
-			if _, ok := missingCols[strings.ToLower(c.Name)]; ok {
-				col := schema[i]
-				delete(missingCols, c.Name)
+		for i, col := range schema {
+			tc := newTableCol(col.Source, col.Name)
+			if !missingCols[tc] {
+				tc = newTableCol("", col.Name)
+			}
+			if missingCols[tc] {
+				delete(missingCols, tc)
 				newAggregate = append(
 					newAggregate,
 					expression.NewGetFieldWithTable(scopeLen+i, col.Type, col.Source, col.Name, col.Nullable),
@@ -136,9 +140,10 @@ func pullMissingColumnsUp(having *plan.Having, missingCols map[string]bool, scop
 
 	if len(missingCols) > 0 {
 		var cs []string
-		for c, _ := range missingCols {
-			cs = append(cs, c)
+		for c := range missingCols {
+			cs = append(cs, c.String())
 		}
+		sort.Strings(cs)
 		return nil, errHavingChildMissingRef.New(strings.Join(cs, ", "))
 	}
 
@@ -207,7 +212,7 @@ func addColumnsToGroupByAndProjectNodes(node sql.Node, columns []sql.Expression,
 		}
 		return node.WithChildren(child)
 	case *plan.GroupBy:
-		return plan.NewGroupBy(append(node.SelectedExprs, columns...), node.GroupByExprs, node.Child), nil
+		return plan.NewGroupBy(append(node.SelectedExprs, columns...), node.GroupByExprs, node.Child).WithModifier(node.Modifier), nil
 	default:
 		return node, nil
 	}
@@ -350,6 +355,15 @@ func replaceAggregations(ctx *sql.Context, having *plan.Having, scopeLen int) (*
 			}
 		}
 
+		if !agg.Resolved() {
+			// An aggregation only in the HAVING clause references columns of the grouping node's child, which it's
+			// evaluated against once added to its select list, rather than the columns of the grouping node
+			resolved, err := resolveColumnsAgainstSchema(agg, groupBy.Child.Schema(), scopeLen)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			agg = resolved.(sql.Aggregation)
+		}
 		newAggregate = append(newAggregate, agg)
 		return expression.NewGetField(
 			scopeLen+len(having.Child.Schema())+len(newAggregate)-1,
@@ -389,14 +403,14 @@ func replaceAggregations(ctx *sql.Context, having *plan.Having, scopeLen int) (*
 			return e, transform.SameTree, nil
 		}
 
-		idx, ok := tokenToIdx[f.Index()]
+		idx, ok := tokenToIdx[f.Index()-scopeLen]
 		if !ok {
 			return e, transform.SameTree, nil
 		}
 
 		idx = pushedUpColumns[idx]
 		col := newSchema[idx]
-		return expression.NewGetFieldWithTable(idx, col.Type, col.Source, col.Name, col.Nullable), transform.NewTree, nil
+		return expression.NewGetFieldWithTable(scopeLen+idx, col.Type, col.Source, col.Name, col.Nullable), transform.NewTree, nil
 	})
 	if err != nil {
 		return nil, false, err
@@ -405,6 +419,30 @@ func replaceAggregations(ctx *sql.Context, having *plan.Having, scopeLen int) (*
 	return plan.NewHaving(cond, having.Child), requiresProjection, nil
 }
 
+// resolveColumnsAgainstSchema replaces the unresolved column references of the expression given with the columns of the
+// schema given that they name.
+func resolveColumnsAgainstSchema(e sql.Expression, schema sql.Schema, scopeLen int) (sql.Expression, error) {
+	resolved, _, err := transform.Expr(e, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		var col column
+		switch e := e.(type) {
+		case *deferredColumn:
+			col = e
+		case *expression.UnresolvedColumn:
+			col = e
+		default:
+			return e, transform.SameTree, nil
+		}
+
+		for i, c := range schema {
+			if strings.EqualFold(c.Name, col.Name()) && (col.Table() == "" || strings.EqualFold(c.Source, col.Table())) {
+				return expression.NewGetFieldWithTable(scopeLen+i, c.Type, c.Source, c.Name, c.Nullable), transform.NewTree, nil
+			}
+		}
+		return nil, transform.SameTree, sql.ErrColumnNotFound.New(col.String())
+	})
+	return resolved, err
+}
+
 func aggregationEquals(ctx *sql.Context, a, b sql.Expression) bool {
 	// First unwrap aliases
 	if alias, ok := b.(*expression.Alias); ok {
@@ -22,16 +22,26 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // resolveNaturalJoins simplifies a natural join into an inner join. The inner
 // join will include equality filters between all common schema attributes
-// of the same name between the two relations.
+// of the same name between the two relations. A JOIN ... USING is a natural
+// join on the columns it names, and outer natural joins are simplified into
+// outer joins the same way.
 //
 // Example:
 // NATURAL_JOIN(xyz,xyw)
 // =>
 // Project([a.x,a.y,a.z,b.w])-> InnerJoin(xyz->a, xyw->b, [a.x=b.x, a.y=b.y])
+//
+// The joined columns are returned once, before the other columns of the first
+// relation and those of the second one. Their value is COALESCE(a.x, b.x),
+// which is the column of the relation whose rows an outer join preserves,
+// since the other one is null when they don't match. For a right join, that's
+// the right relation, which comes first. Unqualified references to the joined
+// columns are replaced with references to these columns.
 func resolveNaturalJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	span, ctx := ctx.Span("resolve_natural_joins")
 	defer span.End()
@@ -68,69 +78,77 @@ func resolveNaturalJoin(
 		return n, nil
 	}
 
-	leftSchema := n.Left().Schema()
-	rightSchema := n.Right().Schema()
+	// A right join is resolved into a left join of its relations swapped,
+	// which returns its columns in the same order.
+	left, right, op := n.Left(), n.Right(), n.Op.FromNatural()
+	if op.IsRightOuter() {
+		left, right, op = right, left, plan.JoinTypeLeftOuter
+	}
 
-	var conditions, common, left, right []sql.Expression
+	leftSchema := left.Schema()
+	rightSchema := right.Schema()
+	getField := func(i int) *expression.GetField {
+		var col *sql.Column
+		if i < len(leftSchema) {
+			col = leftSchema[i]
+		} else {
+			col = rightSchema[i-len(leftSchema)]
+		}
+		return expression.NewGetFieldWithTable(i, col.Type, col.Source, col.Name, col.Nullable)
+	}
+
+	joined := make(map[string]bool)
+	for _, name := range n.UsingCols {
+		if idx, _ := findCol(leftSchema, name); idx < 0 {
+			return nil, sql.ErrUnknownColumn.New(name, "from clause")
+		}
+		if idx, _ := findCol(rightSchema, name); idx < 0 {
+			return nil, sql.ErrUnknownColumn.New(name, "from clause")
+		}
+		joined[strings.ToLower(name)] = true
+	}
+
+	var conditions, common, leftCols, rightCols []sql.Expression
+	rightCommon := make(map[int]bool)
 	for i, lcol := range leftSchema {
-		leftCol := expression.NewGetFieldWithTable(
-			i,
-			lcol.Type,
-			lcol.Source,
-			lcol.Name,
-			lcol.Nullable,
-		)
-		if idx, rcol := findCol(rightSchema, lcol.Name); rcol != nil {
-			common = append(common, leftCol)
+		idx, rcol := findCol(rightSchema, lcol.Name)
+		if rcol == nil || len(joined) > 0 && !joined[strings.ToLower(lcol.Name)] {
+			leftCols = append(leftCols, getField(i))
+			continue
+		}
+		leftCol, rightCol := getField(i), getField(len(leftSchema)+idx)
+		common = append(common, leftCol)
+		rightCommon[idx] = true
+		conditions = append(conditions, expression.NewEquals(leftCol, rightCol))
+
+		// The joined column of the left relation is the value of both columns,
+		// even for the rows of a left join that don't match.
+		replacements[tableCol{"", strings.ToLower(rcol.Name)}] = tableCol{
+			strings.ToLower(lcol.Source), strings.ToLower(lcol.Name),
+		}
+		if op == plan.JoinTypeInner {
 			replacements[tableCol{strings.ToLower(rcol.Source), strings.ToLower(rcol.Name)}] = tableCol{
 				strings.ToLower(lcol.Source), strings.ToLower(lcol.Name),
 			}
-			replacements[tableCol{"", strings.ToLower(rcol.Name)}] = tableCol{
-				strings.ToLower(lcol.Source), strings.ToLower(lcol.Name),
-			}
-
-			conditions = append(
-				conditions,
-				expression.NewEquals(
-					leftCol,
-					expression.NewGetFieldWithTable(
-						len(leftSchema)+idx,
-						rcol.Type,
-						rcol.Source,
-						rcol.Name,
-						rcol.Nullable,
-					),
-				),
-			)
-		} else {
-			left = append(left, leftCol)
 		}
 	}
 
 	if len(conditions) == 0 {
-		return plan.NewCrossJoin(n.Left(), n.Right()), nil
+		if op == plan.JoinTypeInner {
+			return plan.NewCrossJoin(left, right), nil
+		}
+		conditions = append(conditions, expression.NewLiteral(true, types.Boolean))
 	}
 
-	for i, col := range rightSchema {
-		source := strings.ToLower(col.Source)
-		name := strings.ToLower(col.Name)
-		if _, ok := replacements[tableCol{source, name}]; !ok {
-			right = append(
-				right,
-				expression.NewGetFieldWithTable(
-					len(leftSchema)+i,
-					col.Type,
-					col.Source,
-					col.Name,
-					col.Nullable,
-				),
-			)
+	for i := range rightSchema {
+		if !rightCommon[i] {
+			rightCols = append(rightCols, getField(len(leftSchema)+i))
 		}
 	}
 
 	return plan.NewProject(
-		append(append(common, left...), right...),
-		plan.NewInnerJoin(n.Left(), n.Right(), expression.JoinAnd(conditions...)),
+		append(append(common, leftCols...), rightCols...),
+		plan.NewJoin(left, right, op, expression.JoinAnd(conditions...)),
 	), nil
 }
 
@@ -392,3 +392,110 @@ func TestResolveNaturalJoinsDisjoint(t *testing.T) {
 	)
 	require.Equal(expected, result)
 }
+
+func TestResolveUsingJoins(t *testing.T) {
+	left := memory.NewTable("t1", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int64, Source: "t1"},
+		{Name: "b", Type: types.Int64, Source: "t1"},
+		{Name: "c", Type: types.Int64, Source: "t1"},
+	}), nil)
+
+	right := memory.NewTable("t2", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "d", Type: types.Int64, Source: "t2"},
+		{Name: "c", Type: types.Int64, Source: "t2"},
+		{Name: "b", Type: types.Int64, Source: "t2"},
+	}), nil)
+
+	rule := getRule(resolveNaturalJoinsId)
+
+	t.Run("left join on some of the common columns", func(t *testing.T) {
+		node := plan.NewProject(
+			[]sql.Expression{
+				expression.NewUnresolvedColumn("b"),
+				expression.NewUnresolvedQualifiedColumn("t2", "c"),
+			},
+			plan.NewUsingJoin(
+				plan.NewResolvedTable(left, nil, nil),
+				plan.NewResolvedTable(right, nil, nil),
+				plan.JoinTypeLeftOuter,
+				[]string{"B"},
+			),
+		)
+
+		result, _, err := rule.Apply(sql.NewEmptyContext(), NewDefault(nil), node, nil, DefaultRuleSelector)
+		require.NoError(t, err)
+
+		expected := plan.NewProject(
+			[]sql.Expression{
+				expression.NewUnresolvedQualifiedColumn("t1", "b"),
+				expression.NewUnresolvedQualifiedColumn("t2", "c"),
+			},
+			plan.NewProject(
+				[]sql.Expression{
+					expression.NewGetFieldWithTable(1, types.Int64, "t1", "b", false),
+					expression.NewGetFieldWithTable(0, types.Int64, "t1", "a", false),
+					expression.NewGetFieldWithTable(2, types.Int64, "t1", "c", false),
+					expression.NewGetFieldWithTable(3, types.Int64, "t2", "d", false),
+					expression.NewGetFieldWithTable(4, types.Int64, "t2", "c", false),
+				},
+				plan.NewLeftOuterJoin(
+					plan.NewResolvedTable(left, nil, nil),
+					plan.NewResolvedTable(right, nil, nil),
+					expression.NewEquals(
+						expression.NewGetFieldWithTable(1, types.Int64, "t1", "b", false),
+						expression.NewGetFieldWithTable(5, types.Int64, "t2", "b", false),
+					),
+				),
+			),
+		)
+		require.Equal(t, expected, result)
+	})
+
+	t.Run("right join returns the columns of the right table first", func(t *testing.T) {
+		node := plan.NewJoin(
+			plan.NewResolvedTable(left, nil, nil),
+			plan.NewResolvedTable(right, nil, nil),
+			plan.JoinTypeRightOuterNatural,
+			nil,
+		)
+
+		result, _, err := rule.Apply(sql.NewEmptyContext(), NewDefault(nil), node, nil, DefaultRuleSelector)
+		require.NoError(t, err)
+
+		expected := plan.NewProject(
+			[]sql.Expression{
+				expression.NewGetFieldWithTable(1, types.Int64, "t2", "c", false),
+				expression.NewGetFieldWithTable(2, types.Int64, "t2", "b", false),
+				expression.NewGetFieldWithTable(0, types.Int64, "t2", "d", false),
+				expression.NewGetFieldWithTable(3, types.Int64, "t1", "a", false),
+			},
+			plan.NewLeftOuterJoin(
+				plan.NewResolvedTable(right, nil, nil),
+				plan.NewResolvedTable(left, nil, nil),
+				expression.JoinAnd(
+					expression.NewEquals(
+						expression.NewGetFieldWithTable(1, types.Int64, "t2", "c", false),
+						expression.NewGetFieldWithTable(5, types.Int64, "t1", "c", false),
+					),
+					expression.NewEquals(
+						expression.NewGetFieldWithTable(2, types.Int64, "t2", "b", false),
+						expression.NewGetFieldWithTable(4, types.Int64, "t1", "b", false),
+					),
+				),
+			),
+		)
+		require.Equal(t, expected, result)
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		node := plan.NewUsingJoin(
+			plan.NewResolvedTable(left, nil, nil),
+			plan.NewResolvedTable(right, nil, nil),
+			plan.JoinTypeInner,
+			[]string{"a"},
+		)
+
+		_, _, err := rule.Apply(sql.NewEmptyContext(), NewDefault(nil), node, nil, DefaultRuleSelector)
+		require.True(t, sql.ErrUnknownColumn.Is(err))
+	})
+}
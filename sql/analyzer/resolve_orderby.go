@@ -194,7 +194,7 @@ func reorderSort(sort *plan.Sort, missingCols []tableCol) (sql.Node, error) {
 			expressions,
 			plan.NewSort(
 				sort.SortFields,
-				plan.NewGroupBy(newExpressions, child.GroupByExprs, child.Child),
+				plan.NewGroupBy(newExpressions, child.GroupByExprs, child.Child).WithModifier(child.Modifier),
 			),
 		), nil
 	case *plan.Window:
@@ -224,7 +224,7 @@ func pushSortDown(sort *plan.Sort) (sql.Node, transform.TreeIdentity, error) {
 			child.SelectedExprs,
 			child.GroupByExprs,
 			plan.NewSort(sort.SortFields, child.Child),
-		), transform.NewTree, nil
+		).WithModifier(child.Modifier), transform.NewTree, nil
 	case *plan.Window:
 		return plan.NewWindow(
 			child.SelectExprs,
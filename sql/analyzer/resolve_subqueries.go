@@ -15,6 +15,8 @@
 package analyzer
 
 import (
+	"sort"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -183,6 +185,12 @@ func analyzeSubqueryExpression(ctx *sql.Context, a *Analyzer, n sql.Node, sq *pl
 func analyzeSubqueryAlias(ctx *sql.Context, a *Analyzer, sqa *plan.SubqueryAlias, scope *Scope, sel RuleSelector, finalize bool) (sql.Node, transform.TreeIdentity, error) {
 	subScope := scope.newScopeFromSubqueryAlias(sqa)
 
+	// Tables referenced by a view with a definer are resolved with the definer's privileges
+	if sqa.Definer != "" {
+		user, host := plan.SplitDefiner(sqa.Definer)
+		ctx = ctx.WithSecurityClient(sql.Client{User: user, Address: host})
+	}
+
 	var child sql.Node
 	var same transform.TreeIdentity
 	var err error
@@ -242,6 +250,10 @@ func exprIsCacheable(expr sql.Expression, lowestAllowedIdx int) bool {
 			}
 		case *deferredColumn:
 		case sql.NonDeterministicExpression:
+			// Stored functions defined as DETERMINISTIC don't prevent caching
+			if !e.IsNonDeterministic() {
+				return true
+			}
 		default:
 			return true
 		}
@@ -278,6 +290,71 @@ func nodeIsCacheable(n sql.Node, lowestAllowedIdx int) bool {
 	return cacheable
 }
 
+// outerScopeColumns returns the indexes of the columns of the outer scope that the node given references, which are
+// the columns before |lowestAllowedIdx|, and whether the node's results depend only on the values of those columns.
+// This is the case when the node is cacheable, except for those references.
+func outerScopeColumns(n sql.Node, lowestAllowedIdx int) ([]int, bool) {
+	cols := make(map[int]bool)
+	ok := true
+	var inspectExpr func(e sql.Expression) bool
+	inspectNode := func(n sql.Node) {
+		transform.Inspect(n, func(node sql.Node) bool {
+			if !ok {
+				return false
+			}
+			if er, isExpressioner := node.(sql.Expressioner); isExpressioner {
+				for _, expr := range er.Expressions() {
+					sql.Inspect(expr, inspectExpr)
+				}
+			} else if sqa, isSqa := node.(*plan.SubqueryAlias); isSqa {
+				if sqa.OuterScopeVisibility {
+					subCols, subOk := outerScopeColumns(sqa.Child, lowestAllowedIdx)
+					for _, col := range subCols {
+						cols[col] = true
+					}
+					ok = ok && subOk
+				}
+				return false
+			}
+			return true
+		})
+	}
+	inspectExpr = func(e sql.Expression) bool {
+		if !ok {
+			return false
+		}
+		switch e := e.(type) {
+		case *expression.GetField:
+			if e.Index() < lowestAllowedIdx {
+				cols[e.Index()] = true
+			}
+			return true
+		case *plan.Subquery:
+			inspectNode(e.Query)
+			return true
+		case *deferredColumn:
+			ok = false
+			return false
+		case sql.NonDeterministicExpression:
+			ok = false
+			return false
+		default:
+			return true
+		}
+	}
+	inspectNode(n)
+	if !ok {
+		return nil, false
+	}
+
+	indexes := make([]int, 0, len(cols))
+	for col := range cols {
+		indexes = append(indexes, col)
+	}
+	sort.Ints(indexes)
+	return indexes, true
+}
+
 // cacheSubqueryResults determines whether it's safe to cache the results for subqueries (expressions and aliases), and marks the
 // subquery as cacheable if so. Caching subquery results is safe in the case that no outer scope columns are referenced,
 // if all expressions in the subquery are deterministic, and if the subquery isn't inside a trigger block.
@@ -330,6 +407,8 @@ func cacheSubqueryResultsHelper(ctx *sql.Context, a *Analyzer, node sql.Node, sc
 				}
 				if nodeIsCacheable(sq.Query, len(subScope.Schema())) {
 					return sq.WithCachedResults(), transform.NewTree, nil
+				} else if cols, ok := outerScopeColumns(sq.Query, len(subScope.Schema())); ok && len(cols) > 0 {
+					return sq.WithCorrelatedCache(cols), transform.NewTree, nil
 				} else if !same {
 					return sq, transform.NewTree, nil
 				}
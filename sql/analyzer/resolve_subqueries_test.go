@@ -76,7 +76,7 @@ func TestResolveSubqueries(t *testing.T) {
 									plan.NewResolvedTable(bar.WithProjections(make([]string, 0)), db, nil)),
 							),
 						), "select MAX(a) from (select a from bar) sqa1",
-					),
+					).WithCorrelatedCache([]int{0}),
 				},
 				plan.NewResolvedTable(foo.WithProjections([]string{"a"}), db, nil),
 			),
@@ -121,9 +121,7 @@ func TestResolveSubqueries(t *testing.T) {
 						newSubqueryAlias("t1", "", false, true, plan.NewResolvedTable(foo.WithProjections([]string{"a"}), db, nil)),
 
 						newSubqueryAlias("t2", "", false, true,
-							newSubqueryAlias("t2alias", "", false, true,
-								plan.NewResolvedTable(bar.WithProjections([]string{"b"}), db, nil),
-							),
+							plan.NewTableAlias("t2alias", plan.NewResolvedTable(bar.WithProjections([]string{"b"}), db, nil)),
 						),
 					),
 					plan.NewUnresolvedTable("baz", ""),
@@ -514,6 +512,26 @@ func TestCacheSubqueryResults(t *testing.T) {
 				},
 				plan.NewResolvedTable(table, nil, nil),
 			),
+			expected: plan.NewProject(
+				[]sql.Expression{
+					uc("i"),
+					plan.NewSubquery(
+						plan.NewProject(
+							[]sql.Expression{
+								gf(3, "mytable2", "y"),
+							},
+							plan.NewFilter(
+								gt(
+									gf(1, "mytable", "x"),
+									gf(2, "mytable2", "i"),
+								),
+								plan.NewResolvedTable(table2, nil, nil),
+							),
+						),
+						"").WithCorrelatedCache([]int{1}),
+				},
+				plan.NewResolvedTable(table, nil, nil),
+			),
 		},
 		{
 			name: "cacheable",
@@ -559,7 +577,7 @@ func TestCacheSubqueryResults(t *testing.T) {
 			),
 		},
 		{
-			name: "not cacheable, outer scope referenced",
+			name: "outer scope referenced, cached for each value of the referenced columns",
 			node: plan.NewProject(
 				[]sql.Expression{
 					gf(0, "mytable", "i"),
@@ -580,6 +598,26 @@ func TestCacheSubqueryResults(t *testing.T) {
 				},
 				plan.NewResolvedTable(table, nil, nil),
 			),
+			expected: plan.NewProject(
+				[]sql.Expression{
+					gf(0, "mytable", "i"),
+					plan.NewSubquery(
+						plan.NewProject(
+							[]sql.Expression{
+								gf(3, "mytables", "x"),
+							},
+							plan.NewFilter(
+								gt(
+									gf(0, "mytable", "i"),
+									gf(3, "mytable2", "x"),
+								),
+								plan.NewResolvedTable(table2, nil, nil),
+							),
+						),
+						"").WithCorrelatedCache([]int{0}),
+				},
+				plan.NewResolvedTable(table, nil, nil),
+			),
 		},
 		{
 			name: "not cacheable, non-deterministic expression",
@@ -284,7 +284,8 @@ func reresolveTables(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope,
 	})
 }
 
-// transferProjections moves projections from one table scan to another
+// transferProjections moves projections, as well as pushed down filters, sort fields and limits, from one table scan
+// to another
 func transferProjections(ctx *sql.Context, from, to *plan.ResolvedTable) *plan.ResolvedTable {
 	var fromTable sql.Table
 	switch t := from.Table.(type) {
@@ -306,6 +307,17 @@ func transferProjections(ctx *sql.Context, from, to *plan.ResolvedTable) *plan.R
 		projections = pt.Projections()
 	}
 
+	var sortFields sql.SortFields
+	if st, ok := fromTable.(sql.SortedTable); ok {
+		sortFields = st.SortFields()
+	}
+
+	var limit, offset int64
+	var hasLimit bool
+	if lt, ok := fromTable.(sql.LimitedTable); ok {
+		limit, offset, hasLimit = lt.Limit()
+	}
+
 	var toTable sql.Table
 	switch t := to.Table.(type) {
 	case sql.TableWrapper:
@@ -328,6 +340,16 @@ func transferProjections(ctx *sql.Context, from, to *plan.ResolvedTable) *plan.R
 		changed = true
 	}
 
+	if _, ok := toTable.(sql.SortedTable); ok && sortFields != nil {
+		toTable = toTable.(sql.SortedTable).WithSortFields(sortFields)
+		changed = true
+	}
+
+	if _, ok := toTable.(sql.LimitedTable); ok && hasLimit {
+		toTable = toTable.(sql.LimitedTable).WithLimit(limit, offset)
+		changed = true
+	}
+
 	if !changed {
 		return to
 	}
@@ -355,7 +377,7 @@ func validateDropTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 			resolvedTables = append(resolvedTables, table)
 		case *plan.UnresolvedTable:
 			if dt.IfExists() {
-				ctx.Session.Warn(&sql.Warning{
+				ctx.AddWarning(&sql.Warning{
 					Level:   "Note",
 					Code:    mysql.ERBadTable,
 					Message: sql.ErrUnknownTable.New(t.Name()).Error(),
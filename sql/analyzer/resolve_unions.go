@@ -17,6 +17,7 @@ package analyzer
 import (
 	"reflect"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -112,8 +113,14 @@ var (
 	)
 )
 
+func init() {
+	sql.RegisterErrorCode(mysql.ERWrongNumberOfColumnsInSelect, mysql.SSWrongNumberOfColumns, ErrUnionSchemasDifferentLength)
+}
+
 // mergeUnionSchemas determines the narrowest possible shared schema types between the two sides of a union, and
-// applies projections the two sides to convert column types as necessary.
+// converts the columns of each side whose type differs from it. The conversions are done once per side at analysis
+// time: they're folded into the projections of a side that's a Project node, and conversions of literals are
+// evaluated, so that rows aren't converted again by another projection over the side.
 func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	if !n.Resolved() {
 		return n, transform.SameTree, nil
@@ -124,11 +131,9 @@ func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 			if len(ls) != len(rs) {
 				return nil, transform.SameTree, ErrUnionSchemasDifferentLength.New(len(ls), len(rs))
 			}
-			les, res := make([]sql.Expression, len(ls)), make([]sql.Expression, len(rs))
+			convertTo := make([]string, len(ls))
 			hasdiff := false
 			for i := range ls {
-				les[i] = expression.NewGetFieldWithTable(i, ls[i].Type, ls[i].Source, ls[i].Name, ls[i].Nullable)
-				res[i] = expression.NewGetFieldWithTable(i, rs[i].Type, rs[i].Source, rs[i].Name, rs[i].Nullable)
 				if reflect.DeepEqual(ls[i].Type, rs[i].Type) {
 					continue
 				}
@@ -138,31 +143,79 @@ func mergeUnionSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 				hasdiff = true
 
 				// try to get optimal type to convert both into
-				convertTo := getConvertToType(ls[i].Type, rs[i].Type)
-
 				// TODO: Principled type coercion...
-				les[i] = expression.NewConvert(les[i], convertTo)
-				res[i] = expression.NewConvert(res[i], convertTo)
+				convertTo[i] = getConvertToType(ls[i].Type, rs[i].Type)
+			}
+			if !hasdiff {
+				return n, transform.SameTree, nil
+			}
 
-				// Preserve schema names across the conversion.
-				les[i] = expression.NewAlias(ls[i].Name, les[i])
-				res[i] = expression.NewAlias(rs[i].Name, res[i])
+			left, err := convertUnionSide(ctx, u.Left(), convertTo)
+			if err != nil {
+				return nil, transform.SameTree, err
 			}
-			if hasdiff {
-				n, err := u.WithChildren(
-					plan.NewProject(les, u.Left()),
-					plan.NewProject(res, u.Right()),
-				)
-				if err != nil {
-					return nil, transform.SameTree, err
-				}
-				return n, transform.NewTree, nil
+			right, err := convertUnionSide(ctx, u.Right(), convertTo)
+			if err != nil {
+				return nil, transform.SameTree, err
 			}
+			n, err := u.WithChildren(left, right)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			return n, transform.NewTree, nil
 		}
 		return n, transform.SameTree, nil
 	})
 }
 
+// convertUnionSide returns the side of a union given with each of its columns converted to the type named by
+// |convertTo| at the same position, if it isn't that type already. An empty name leaves the column as is.
+func convertUnionSide(ctx *sql.Context, n sql.Node, convertTo []string) (sql.Node, error) {
+	sch := n.Schema()
+	p, isProject := n.(*plan.Project)
+	exprs := make([]sql.Expression, len(sch))
+	hasdiff := false
+	for i, col := range sch {
+		gf := expression.NewGetFieldWithTable(i, col.Type, col.Source, col.Name, col.Nullable)
+		exprs[i] = gf
+		if convertTo[i] == "" || reflect.DeepEqual(col.Type, expression.NewConvert(gf, convertTo[i]).Type()) {
+			continue
+		}
+		hasdiff = true
+
+		var e sql.Expression = gf
+		if isProject {
+			e = p.Projections[i]
+			if alias, ok := e.(*expression.Alias); ok {
+				e = alias.Child
+			}
+		}
+		_, isLiteral := e.(*expression.Literal)
+		e = expression.NewConvert(e, convertTo[i])
+		if isLiteral {
+			// A literal that can't be converted is left to fail the same way when the rows are read.
+			if v, err := e.Eval(ctx, nil); err == nil {
+				e = expression.NewLiteral(v, e.Type())
+			}
+		}
+		// Preserve schema names across the conversion.
+		exprs[i] = expression.NewAlias(col.Name, e)
+	}
+	if !hasdiff {
+		return n, nil
+	}
+
+	if isProject {
+		for i, e := range exprs {
+			if _, ok := e.(*expression.GetField); ok {
+				exprs[i] = p.Projections[i]
+			}
+		}
+		return p.WithExpressions(exprs...)
+	}
+	return plan.NewProject(exprs, n), nil
+}
+
 // getConvertToType returns which type the both left and right values should be converted to.
 // If neither sql.Type represent number, then converted to string. Otherwise, we try to get
 // the appropriate type to avoid any precision loss.
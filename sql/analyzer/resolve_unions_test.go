@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -27,6 +28,11 @@ import (
 )
 
 func TestMergeUnionSchemas(t *testing.T) {
+	table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "mytable"},
+		{Name: "s", Type: types.Int32, Source: "mytable", Nullable: true},
+	}), nil)
+
 	testCases := []struct {
 		name string
 		in   sql.Node
@@ -73,7 +79,7 @@ func TestMergeUnionSchemas(t *testing.T) {
 			errors.New("this is an error"),
 		},
 		{
-			"Mismatched Types Coerced to Strings",
+			"Mismatched Types Converted in Projections",
 			plan.NewUnion(plan.NewProject(
 				[]sql.Expression{expression.NewLiteral(int64(1), types.Int64)},
 				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
@@ -82,23 +88,38 @@ func TestMergeUnionSchemas(t *testing.T) {
 				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
 			), false, nil, nil),
 			plan.NewUnion(plan.NewProject(
+				[]sql.Expression{expression.NewLiteral(int64(1), types.Int64)},
+				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
+			), plan.NewProject(
 				[]sql.Expression{
-					expression.NewAlias("1", expression.NewConvert(
-						expression.NewGetField(0, types.Int64, "1", false), "signed")),
+					expression.NewAlias("3", expression.NewLiteral(int64(3), types.Int64)),
 				},
-				plan.NewProject(
-					[]sql.Expression{expression.NewLiteral(int64(1), types.Int64)},
-					plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
-				),
+				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
+			), false, nil, nil),
+			nil,
+		},
+		{
+			"Mismatched Types Converted Above Other Nodes",
+			plan.NewUnion(plan.NewProject(
+				[]sql.Expression{
+					expression.NewAlias("i", expression.NewLiteral(int8(1), types.Int8)),
+					expression.NewAlias("s", expression.NewLiteral("a", types.LongText)),
+				},
+				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
+			), plan.NewResolvedTable(table, nil, nil), false, nil, nil),
+			plan.NewUnion(plan.NewProject(
+				[]sql.Expression{
+					expression.NewAlias("i", expression.NewLiteral(int64(1), types.Int64)),
+					expression.NewAlias("s", expression.NewLiteral("a", types.LongText)),
+				},
+				plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
 			), plan.NewProject(
 				[]sql.Expression{
-					expression.NewAlias("3", expression.NewConvert(
-						expression.NewGetField(0, types.Int32, "3", false), "signed")),
+					expression.NewGetFieldWithTable(0, types.Int64, "mytable", "i", false),
+					expression.NewAlias("s", expression.NewConvert(
+						expression.NewGetFieldWithTable(1, types.Int32, "mytable", "s", true), "char")),
 				},
-				plan.NewProject(
-					[]sql.Expression{expression.NewLiteral(int32(3), types.Int32)},
-					plan.NewResolvedTable(plan.NewResolvedDualTable(), nil, nil),
-				),
+				plan.NewResolvedTable(table, nil, nil),
 			), false, nil, nil),
 			nil,
 		},
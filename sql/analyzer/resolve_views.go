@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
@@ -41,6 +42,7 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel R
 		}
 
 		var view *sql.View
+		var createViewStmt string
 
 		if dbName != "" {
 			db, err := a.Catalog.Database(ctx, dbName)
@@ -66,6 +68,7 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel R
 						return nil, transform.SameTree, qerr
 					}
 					view = plan.NewSubqueryAlias(viewName, viewDef.TextDefinition, query).AsView(viewDef.CreateViewStatement)
+					createViewStmt = viewDef.CreateViewStatement
 				}
 			}
 		}
@@ -77,6 +80,7 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel R
 			if !ok {
 				return n, transform.SameTree, nil
 			}
+			createViewStmt = view.CreateStatement()
 		}
 
 		a.Log("view resolved: %q", viewName)
@@ -103,10 +107,38 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel R
 		if err != nil {
 			return nil, transform.SameTree, err
 		}
+
+		// The view's security context only matters when privileges are being checked
+		if a.Catalog.MySQLDb.Enabled {
+			sqa := n.(*plan.SubqueryAlias)
+			sqa.ViewDatabase = dbName
+			sqa.Definer, err = viewSecurityDefiner(ctx, createViewStmt)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+		}
 		return n, transform.NewTree, nil
 	})
 }
 
+// viewSecurityDefiner returns the definer of the view with the CREATE VIEW statement given, whose privileges are used
+// to access the tables in the view. Returns an empty string if the view is defined with SQL SECURITY INVOKER, or if
+// the statement doesn't name a definer, as is the case for views created before definers were always stored.
+func viewSecurityDefiner(ctx *sql.Context, createViewStmt string) (string, error) {
+	if createViewStmt == "" {
+		return "", nil
+	}
+	parsed, err := parse.Parse(ctx, createViewStmt)
+	if err != nil {
+		return "", err
+	}
+	cv, ok := parsed.(*plan.CreateView)
+	if !ok || strings.EqualFold(cv.Security, "INVOKER") || !cv.ExplicitDefiner {
+		return "", nil
+	}
+	return cv.Definer, nil
+}
+
 // applyAsOfToView transforms the nodes in the view's execution plan to apply the asOf expression to every
 // individual table involved in the view.
 func applyAsOfToView(n sql.Node, a *Analyzer, asOf sql.Expression) (sql.Node, transform.TreeIdentity, error) {
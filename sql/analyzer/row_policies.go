@@ -0,0 +1,282 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// applyRowPolicies restricts the node given to the rows that the row policies registered with the catalog allow the
+// current user to access. Every read of a table with a row policy is filtered by the policy's predicate, which also
+// limits the rows that UPDATE and DELETE statements change, and rows written by INSERT and UPDATE statements are checked
+// against the predicate. This must run after loadChecks, which replaces the checks of writes.
+func applyRowPolicies(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("apply_row_policies")
+	defer span.End()
+
+	if !a.Catalog.hasRowPolicies() {
+		return n, transform.SameTree, nil
+	}
+
+	switch n := n.(type) {
+	case *plan.Truncate:
+		rt := getResolvedTable(n.Child)
+		if rt == nil {
+			return n, transform.SameTree, nil
+		}
+		predicate, err := rowPolicyPredicate(ctx, a, rt)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if predicate != nil {
+			return nil, transform.SameTree, sql.ErrRowPolicyUnsupported.New("TRUNCATE", rt.Name())
+		}
+		return n, transform.SameTree, nil
+	case *plan.LockTables:
+		return n, transform.SameTree, nil
+	case *plan.HandlerOpen, *plan.HandlerRead:
+		// HANDLER reads the rows of its table directly, so it can't be restricted
+		db, table := handlerTable(ctx, a, n)
+		if err := checkNoRowPolicy(ctx, a, "HANDLER", db, table); err != nil {
+			return nil, transform.SameTree, err
+		}
+		return n, transform.SameTree, nil
+	case *plan.ChecksumTable:
+		// The checksum of a table is computed over all of its rows
+		for _, t := range n.Tables {
+			if err := checkNoRowPolicy(ctx, a, "CHECKSUM TABLE", maintenanceTableDatabase(ctx, t), t.Table); err != nil {
+				return nil, transform.SameTree, err
+			}
+		}
+		return n, transform.SameTree, nil
+	}
+	if plan.IsNoRowNode(n) {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.NodeWithCtx(n, func(c transform.Context) bool {
+		// The destination of an INSERT isn't read, and its source is analyzed on its own
+		_, ok := c.Parent.(*plan.InsertInto)
+		return !ok
+	}, func(c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		switch node := c.Node.(type) {
+		case *plan.InsertInto:
+			return checkInsertRowPolicy(ctx, a, node)
+		case *plan.Update:
+			return checkUpdateRowPolicy(ctx, a, node)
+		case *plan.TableAlias:
+			rt, ok := node.Child.(*plan.ResolvedTable)
+			if !ok || isRowPolicyFilter(c.Parent) {
+				return node, transform.SameTree, nil
+			}
+			return filterRowPolicy(ctx, a, node, rt, node.Name())
+		case *plan.ResolvedTable:
+			if _, ok := c.Parent.(*plan.TableAlias); ok || isRowPolicyFilter(c.Parent) {
+				return node, transform.SameTree, nil
+			}
+			return filterRowPolicy(ctx, a, node, node, node.Name())
+		default:
+			return node, transform.SameTree, nil
+		}
+	})
+}
+
+// filterRowPolicy returns the node given wrapped in a filter on the predicate of the row policy of the table given, if
+// it has one. The columns of the predicate are qualified with the name given, the name the table is referenced by.
+func filterRowPolicy(ctx *sql.Context, a *Analyzer, n sql.Node, rt *plan.ResolvedTable, name string) (sql.Node, transform.TreeIdentity, error) {
+	predicate, err := rowPolicyPredicate(ctx, a, rt)
+	if err != nil || predicate == nil {
+		return n, transform.SameTree, err
+	}
+
+	predicate, _, err = transform.Expr(predicate, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		if col, ok := e.(*expression.UnresolvedColumn); ok && col.Table() == "" {
+			return expression.NewUnresolvedQualifiedColumn(name, col.Name()), transform.NewTree, nil
+		}
+		return e, transform.SameTree, nil
+	})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return plan.NewFilter(&rowPolicyMarker{expression.UnaryExpression{Child: predicate}}, n), transform.NewTree, nil
+}
+
+// isRowPolicyFilter returns whether the node given is a filter added by filterRowPolicy. Subquery expressions and
+// subquery aliases are analyzed from the first rule batch again on every pass of the analyzer, so tables that are
+// already filtered must be skipped.
+func isRowPolicyFilter(n sql.Node) bool {
+	if f, ok := n.(*plan.Filter); ok {
+		_, ok = f.Expression.(*rowPolicyMarker)
+		return ok
+	}
+	return false
+}
+
+// rowPolicyMarker marks the predicate of a row policy filter. It evaluates to its child.
+type rowPolicyMarker struct {
+	expression.UnaryExpression
+}
+
+var _ sql.Expression = (*rowPolicyMarker)(nil)
+
+// Type implements the sql.Expression interface.
+func (p *rowPolicyMarker) Type() sql.Type {
+	return p.Child.Type()
+}
+
+// Eval implements the sql.Expression interface.
+func (p *rowPolicyMarker) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return p.Child.Eval(ctx, row)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (p *rowPolicyMarker) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 1)
+	}
+	return &rowPolicyMarker{expression.UnaryExpression{Child: children[0]}}, nil
+}
+
+func (p *rowPolicyMarker) String() string {
+	return p.Child.String()
+}
+
+func (p *rowPolicyMarker) DebugString() string {
+	return sql.DebugString(p.Child)
+}
+
+// checkInsertRowPolicy adds the predicate of the row policy of the destination of the INSERT given to its checks.
+// Replacing rows and updating them on duplicate keys would change rows the policy doesn't allow, so neither is
+// supported for tables with a row policy.
+func checkInsertRowPolicy(ctx *sql.Context, a *Analyzer, ii *plan.InsertInto) (sql.Node, transform.TreeIdentity, error) {
+	rt := getResolvedTable(ii.Destination)
+	if rt == nil {
+		return ii, transform.SameTree, nil
+	}
+	predicate, err := rowPolicyPredicate(ctx, a, rt)
+	if err != nil || predicate == nil {
+		return ii, transform.SameTree, err
+	}
+
+	if ii.IsReplace {
+		return nil, transform.SameTree, sql.ErrRowPolicyUnsupported.New("REPLACE", rt.Name())
+	}
+	if len(ii.OnDupExprs) > 0 {
+		return nil, transform.SameTree, sql.ErrRowPolicyUnsupported.New("INSERT ... ON DUPLICATE KEY UPDATE", rt.Name())
+	}
+
+	nn := *ii
+	nn.Checks = withRowPolicyCheck(ii.Checks, rt.Name(), predicate)
+	return &nn, transform.NewTree, nil
+}
+
+// checkUpdateRowPolicy adds the predicate of the row policy of the table updated by the UPDATE given to its checks.
+func checkUpdateRowPolicy(ctx *sql.Context, a *Analyzer, update *plan.Update) (sql.Node, transform.TreeIdentity, error) {
+	rt := getResolvedTable(update.Child)
+	if rt == nil {
+		return update, transform.SameTree, nil
+	}
+	predicate, err := rowPolicyPredicate(ctx, a, rt)
+	if err != nil || predicate == nil {
+		return update, transform.SameTree, err
+	}
+
+	nn := *update
+	nn.Checks = withRowPolicyCheck(update.Checks, rt.Name(), predicate)
+	return &nn, transform.NewTree, nil
+}
+
+// rowPolicyPredicate returns the predicate of the row policy of the table given for the current user, or nil if the
+// table has no row policy or the current user isn't restricted by it.
+func rowPolicyPredicate(ctx *sql.Context, a *Analyzer, rt *plan.ResolvedTable) (sql.Expression, error) {
+	if rt.Database == nil {
+		return nil, nil
+	}
+	policy := a.Catalog.RowPolicy(rt.Database.Name(), rt.Name())
+	if policy == nil {
+		return nil, nil
+	}
+	return policy(ctx, rt.Database.Name(), rt.Name())
+}
+
+// withRowPolicyCheck returns the checks given with a check on the row policy predicate given, replacing any row
+// policy check already among them. Unlike CHECK constraints, rows for which the predicate is NULL fail the check,
+// because they wouldn't be visible to the user writing them.
+func withRowPolicyCheck(checks sql.CheckConstraints, table string, predicate sql.Expression) sql.CheckConstraints {
+	var newChecks sql.CheckConstraints
+	for _, check := range checks {
+		if !check.IsRowPolicy {
+			newChecks = append(newChecks, check)
+		}
+	}
+	return append(newChecks, &sql.CheckConstraint{
+		Name:        table,
+		Expr:        expression.NewIsTrue(predicate),
+		Enforced:    true,
+		IsRowPolicy: true,
+	})
+}
+
+// checkNoRowPolicy returns ErrRowPolicyUnsupported for the statement named if the table given has a row policy that
+// restricts the current user.
+func checkNoRowPolicy(ctx *sql.Context, a *Analyzer, statement, db, table string) error {
+	if table == "" {
+		return nil
+	}
+	policy := a.Catalog.RowPolicy(db, table)
+	if policy == nil {
+		return nil
+	}
+	predicate, err := policy(ctx, db, table)
+	if err != nil {
+		return err
+	}
+	if predicate != nil {
+		return sql.ErrRowPolicyUnsupported.New(statement, table)
+	}
+	return nil
+}
+
+// maintenanceTableDatabase returns the database of the table given of a table maintenance statement, which is the
+// current database if none was given.
+func maintenanceTableDatabase(ctx *sql.Context, table sql.DbTable) string {
+	if table.Db != "" {
+		return table.Db
+	}
+	return ctx.GetCurrentDatabase()
+}
+
+// handlerTable returns the database and the name of the table read by the HANDLER statement given, or empty names if
+// it reads a handler the session hasn't opened.
+func handlerTable(ctx *sql.Context, a *Analyzer, n sql.Node) (string, string) {
+	switch n := n.(type) {
+	case *plan.HandlerOpen:
+		if n.Database != "" {
+			return n.Database, n.Table
+		}
+		return ctx.GetCurrentDatabase(), n.Table
+	case *plan.HandlerRead:
+		handler, err := a.Catalog.Handlers.Get(ctx.ID(), n.Name)
+		if err != nil {
+			return "", ""
+		}
+		return handler.Database, handler.Table
+	default:
+		return "", ""
+	}
+}
@@ -7,12 +7,14 @@ type RuleId int
 const (
 	// once before
 	applyDefaultSelectLimitId      RuleId = iota // applyDefaultSelectLimit
+	resolveMaterializedViewsId                   // resolveMaterializedViews
 	validateOffsetAndLimitId                     //validateOffsetAndLimit
 	validateCreateTableId                        // validateCreateTable
 	validateExprSemId                            // validateExprSem
 	resolveVariablesId                           // resolveVariables
 	resolveNamedWindowsId                        // resolveNamedWindows
 	resolveSetVariablesId                        // resolveSetVariables
+	resolveUpdatableViewsId                      // resolveUpdatableViews
 	resolveViewsId                               // resolveViews
 	liftCtesId                                   // liftCtes
 	resolveCtesId                                // resolveCtes
@@ -27,8 +29,10 @@ const (
 	resolveDropConstraintId                      // resolveDropConstraint
 	validateDropConstraintId                     // validateDropConstraint
 	loadCheckConstraintsId                       // loadCheckConstraints
+	applyRowPoliciesId                           // applyRowPolicies
 	assignCatalogId                              // assignCatalog
 	resolveAnalyzeTablesId                       //resolveAnalyzeTables
+	resolveCheckTablesId                         // resolveCheckTables
 	resolveCreateSelectId                        // resolveCreateSelect
 	resolveSubqueriesId                          // resolveSubqueries
 	setViewTargetSchemaId                        // setViewTargetSchema
@@ -65,6 +69,7 @@ const (
 	resolveBarewordSetVariablesId  // resolveBarewordSetVariables
 	replaceCountStarId             // replaceCountStar
 	expandStarsId                  // expandStars
+	mergeDerivedTablesId           // mergeDerivedTables
 	transposeRightJoinsId          // transposeRightJoins
 	resolveHavingId                // resolveHaving
 	mergeUnionSchemasId            // mergeUnionSchemas
@@ -74,41 +79,52 @@ const (
 	replaceCrossJoinsId            // replaceCrossJoins
 	moveJoinCondsToFilterId        // moveJoinCondsToFilter
 	evalFilterId                   // evalFilter
-	optimizeDistinctId             // optimizeDistinct
 
 	// after default
-	hoistOutOfScopeFiltersId     // hoistOutOfScopeFilters
-	transformJoinApplyId         // transformJoinApply
-	hoistSelectExistsId          // hoistSelectExists
-	finalizeSubqueriesId         // finalizeSubqueries
-	finalizeUnionsId             // finalizeUnions
-	loadTriggersId               // loadTriggers
-	processTruncateId            // processTruncate
-	resolveAlterColumnId         // resolveAlterColumn
-	resolveGeneratorsId          // resolveGenerators
-	removeUnnecessaryConvertsId  // removeUnnecessaryConverts
-	pruneColumnsId               // pruneColumns
-	stripTableNameInDefaultsId   // stripTableNamesFromColumnDefaults
-	foldEmptyJoinsId             // foldEmptyJoins
-	optimizeJoinsId              // optimizeJoins
-	concatFiltersId              // concatFilters
-	pushdownFiltersId            // pushdownFilters
-	subqueryIndexesId            // subqueryIndexes
-	pruneTablesId                // pruneTables
-	setJoinScopeLenId            // setJoinScopeLen
-	eraseProjectionId            // eraseProjection
-	replaceSortPkId              // replaceSortPk
-	insertTopNId                 // insertTopN
-	applyHashInId                // applyHashIn
-	resolveInsertRowsId          // resolveInsertRows
-	resolvePreparedInsertId      // resolvePreparedInsert
-	applyTriggersId              // applyTriggers
-	applyProceduresId            // applyProcedures
-	assignRoutinesId             // assignRoutines
-	modifyUpdateExprsForJoinId   // modifyUpdateExprsForJoin
-	applyRowUpdateAccumulatorsId // applyRowUpdateAccumulators
-	wrapWithRollbackId           // rollback triggers
-	applyFKsId                   // applyFKs
+	simplifyExistsSubqueriesId     // simplifyExistsSubqueries
+	hoistOutOfScopeFiltersId       // hoistOutOfScopeFilters
+	transformJoinApplyId           // transformJoinApply
+	hoistSelectExistsId            // hoistSelectExists
+	applyColumnMasksId             // applyColumnMasks
+	finalizeSubqueriesId           // finalizeSubqueries
+	finalizeUnionsId               // finalizeUnions
+	loadTriggersId                 // loadTriggers
+	processTruncateId              // processTruncate
+	resolveAlterColumnId           // resolveAlterColumn
+	resolveGeneratorsId            // resolveGenerators
+	removeUnnecessaryConvertsId    // removeUnnecessaryConverts
+	pruneColumnsId                 // pruneColumns
+	stripTableNameInDefaultsId     // stripTableNamesFromColumnDefaults
+	foldEmptyJoinsId               // foldEmptyJoins
+	simplifyOuterJoinsId           // simplifyOuterJoins
+	substituteGeneratedColumnsId   // substituteGeneratedColumns
+	rewriteRangeFiltersId          // rewriteRangeFilters
+	pushdownJoinsToDatabasesId     // pushdownJoinsToDatabases
+	optimizeJoinsId                // optimizeJoins
+	applyTableCountId              // applyTableCount
+	applyIndexDistinctId           // applyIndexDistinct
+	concatFiltersId                // concatFilters
+	pushdownFiltersId              // pushdownFilters
+	pushdownIndexConditionsId      // pushdownIndexConditions
+	subqueryIndexesId              // subqueryIndexes
+	pruneTablesId                  // pruneTables
+	setJoinScopeLenId              // setJoinScopeLen
+	eraseProjectionId              // eraseProjection
+	pushdownSortAndLimitToTablesId // pushdownSortAndLimitToTables
+	replaceIdxSortId               // replaceIdxSort
+	insertTopNId                   // insertTopN
+	pushdownOffsetId               // pushdownOffset
+	optimizeDistinctId             // optimizeDistinct
+	applyHashInId                  // applyHashIn
+	resolveInsertRowsId            // resolveInsertRows
+	resolvePreparedInsertId        // resolvePreparedInsert
+	applyTriggersId                // applyTriggers
+	applyProceduresId              // applyProcedures
+	assignRoutinesId               // assignRoutines
+	modifyUpdateExprsForJoinId     // modifyUpdateExprsForJoin
+	applyRowUpdateAccumulatorsId   // applyRowUpdateAccumulators
+	wrapWithRollbackId             // rollback triggers
+	applyFKsId                     // applyFKs
 
 	// validate
 	validateResolvedId          // validateResolved
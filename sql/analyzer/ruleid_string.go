@@ -9,128 +9,144 @@ func _() {
 	// Re-run the stringer command to generate them again.
 	var x [1]struct{}
 	_ = x[applyDefaultSelectLimitId-0]
-	_ = x[validateOffsetAndLimitId-1]
-	_ = x[validateCreateTableId-2]
-	_ = x[validateExprSemId-3]
-	_ = x[resolveVariablesId-4]
-	_ = x[resolveNamedWindowsId-5]
-	_ = x[resolveSetVariablesId-6]
-	_ = x[resolveViewsId-7]
-	_ = x[liftCtesId-8]
-	_ = x[resolveCtesId-9]
-	_ = x[liftRecursiveCtesId-10]
-	_ = x[resolveDatabasesId-11]
-	_ = x[resolveTablesId-12]
-	_ = x[loadStoredProceduresId-13]
-	_ = x[validateDropTablesId-14]
-	_ = x[setTargetSchemasId-15]
-	_ = x[resolveCreateLikeId-16]
-	_ = x[parseColumnDefaultsId-17]
-	_ = x[resolveDropConstraintId-18]
-	_ = x[validateDropConstraintId-19]
-	_ = x[loadCheckConstraintsId-20]
-	_ = x[assignCatalogId-21]
-	_ = x[resolveAnalyzeTablesId-22]
-	_ = x[resolveCreateSelectId-23]
-	_ = x[resolveSubqueriesId-24]
-	_ = x[setViewTargetSchemaId-25]
-	_ = x[resolveUnionsId-26]
-	_ = x[resolveDescribeQueryId-27]
-	_ = x[checkUniqueTableNamesId-28]
-	_ = x[resolveTableFunctionsId-29]
-	_ = x[resolveDeclarationsId-30]
-	_ = x[resolveColumnDefaultsId-31]
-	_ = x[validateColumnDefaultsId-32]
-	_ = x[validateCreateTriggerId-33]
-	_ = x[validateCreateProcedureId-34]
-	_ = x[loadInfoSchemaId-35]
-	_ = x[validateReadOnlyDatabaseId-36]
-	_ = x[validateReadOnlyTransactionId-37]
-	_ = x[validateDatabaseSetId-38]
-	_ = x[validatePrivilegesId-39]
-	_ = x[reresolveTablesId-40]
-	_ = x[setInsertColumnsId-41]
-	_ = x[validateJoinComplexityId-42]
-	_ = x[applyBinlogReplicaControllerId-43]
-	_ = x[resolveNaturalJoinsId-44]
-	_ = x[resolveOrderbyLiteralsId-45]
-	_ = x[resolveFunctionsId-46]
-	_ = x[flattenTableAliasesId-47]
-	_ = x[pushdownSortId-48]
-	_ = x[pushdownGroupbyAliasesId-49]
-	_ = x[pushdownSubqueryAliasFiltersId-50]
-	_ = x[qualifyColumnsId-51]
-	_ = x[resolveColumnsId-52]
-	_ = x[validateCheckConstraintId-53]
-	_ = x[resolveBarewordSetVariablesId-54]
-	_ = x[replaceCountStarId-55]
-	_ = x[expandStarsId-56]
-	_ = x[transposeRightJoinsId-57]
-	_ = x[resolveHavingId-58]
-	_ = x[mergeUnionSchemasId-59]
-	_ = x[flattenAggregationExprsId-60]
-	_ = x[reorderProjectionId-61]
-	_ = x[resolveSubqueryExprsId-62]
-	_ = x[replaceCrossJoinsId-63]
-	_ = x[moveJoinCondsToFilterId-64]
-	_ = x[evalFilterId-65]
-	_ = x[optimizeDistinctId-66]
-	_ = x[hoistOutOfScopeFiltersId-67]
-	_ = x[transformJoinApplyId-68]
-	_ = x[hoistSelectExistsId-69]
-	_ = x[finalizeSubqueriesId-70]
-	_ = x[finalizeUnionsId-71]
-	_ = x[loadTriggersId-72]
-	_ = x[processTruncateId-73]
-	_ = x[resolveAlterColumnId-74]
-	_ = x[resolveGeneratorsId-75]
-	_ = x[removeUnnecessaryConvertsId-76]
-	_ = x[pruneColumnsId-77]
-	_ = x[stripTableNameInDefaultsId-78]
-	_ = x[foldEmptyJoinsId-79]
-	_ = x[optimizeJoinsId-80]
-	_ = x[concatFiltersId-81]
-	_ = x[pushdownFiltersId-82]
-	_ = x[subqueryIndexesId-83]
-	_ = x[pruneTablesId-84]
-	_ = x[setJoinScopeLenId-85]
-	_ = x[eraseProjectionId-86]
-	_ = x[replaceSortPkId-87]
-	_ = x[insertTopNId-88]
-	_ = x[applyHashInId-89]
-	_ = x[resolveInsertRowsId-90]
-	_ = x[resolvePreparedInsertId-91]
-	_ = x[applyTriggersId-92]
-	_ = x[applyProceduresId-93]
-	_ = x[assignRoutinesId-94]
-	_ = x[modifyUpdateExprsForJoinId-95]
-	_ = x[applyRowUpdateAccumulatorsId-96]
-	_ = x[wrapWithRollbackId-97]
-	_ = x[applyFKsId-98]
-	_ = x[validateResolvedId-99]
-	_ = x[validateOrderById-100]
-	_ = x[validateGroupById-101]
-	_ = x[validateSchemaSourceId-102]
-	_ = x[validateIndexCreationId-103]
-	_ = x[validateOperandsId-104]
-	_ = x[validateCaseResultTypesId-105]
-	_ = x[validateIntervalUsageId-106]
-	_ = x[validateExplodeUsageId-107]
-	_ = x[validateSubqueryColumnsId-108]
-	_ = x[validateUnionSchemasMatchId-109]
-	_ = x[validateAggregationsId-110]
-	_ = x[validateDeleteFromId-111]
-	_ = x[cacheSubqueryResultsId-112]
-	_ = x[cacheSubqueryAliasesInJoinsId-113]
-	_ = x[AutocommitId-114]
-	_ = x[TrackProcessId-115]
-	_ = x[parallelizeId-116]
-	_ = x[clearWarningsId-117]
+	_ = x[resolveMaterializedViewsId-1]
+	_ = x[validateOffsetAndLimitId-2]
+	_ = x[validateCreateTableId-3]
+	_ = x[validateExprSemId-4]
+	_ = x[resolveVariablesId-5]
+	_ = x[resolveNamedWindowsId-6]
+	_ = x[resolveSetVariablesId-7]
+	_ = x[resolveUpdatableViewsId-8]
+	_ = x[resolveViewsId-9]
+	_ = x[liftCtesId-10]
+	_ = x[resolveCtesId-11]
+	_ = x[liftRecursiveCtesId-12]
+	_ = x[resolveDatabasesId-13]
+	_ = x[resolveTablesId-14]
+	_ = x[loadStoredProceduresId-15]
+	_ = x[validateDropTablesId-16]
+	_ = x[setTargetSchemasId-17]
+	_ = x[resolveCreateLikeId-18]
+	_ = x[parseColumnDefaultsId-19]
+	_ = x[resolveDropConstraintId-20]
+	_ = x[validateDropConstraintId-21]
+	_ = x[loadCheckConstraintsId-22]
+	_ = x[applyRowPoliciesId-23]
+	_ = x[assignCatalogId-24]
+	_ = x[resolveAnalyzeTablesId-25]
+	_ = x[resolveCheckTablesId-26]
+	_ = x[resolveCreateSelectId-27]
+	_ = x[resolveSubqueriesId-28]
+	_ = x[setViewTargetSchemaId-29]
+	_ = x[resolveUnionsId-30]
+	_ = x[resolveDescribeQueryId-31]
+	_ = x[checkUniqueTableNamesId-32]
+	_ = x[resolveTableFunctionsId-33]
+	_ = x[resolveDeclarationsId-34]
+	_ = x[resolveColumnDefaultsId-35]
+	_ = x[validateColumnDefaultsId-36]
+	_ = x[validateCreateTriggerId-37]
+	_ = x[validateCreateProcedureId-38]
+	_ = x[loadInfoSchemaId-39]
+	_ = x[validateReadOnlyDatabaseId-40]
+	_ = x[validateReadOnlyTransactionId-41]
+	_ = x[validateDatabaseSetId-42]
+	_ = x[validatePrivilegesId-43]
+	_ = x[reresolveTablesId-44]
+	_ = x[setInsertColumnsId-45]
+	_ = x[validateJoinComplexityId-46]
+	_ = x[applyBinlogReplicaControllerId-47]
+	_ = x[resolveNaturalJoinsId-48]
+	_ = x[resolveOrderbyLiteralsId-49]
+	_ = x[resolveFunctionsId-50]
+	_ = x[flattenTableAliasesId-51]
+	_ = x[pushdownSortId-52]
+	_ = x[pushdownGroupbyAliasesId-53]
+	_ = x[pushdownSubqueryAliasFiltersId-54]
+	_ = x[qualifyColumnsId-55]
+	_ = x[resolveColumnsId-56]
+	_ = x[validateCheckConstraintId-57]
+	_ = x[resolveBarewordSetVariablesId-58]
+	_ = x[replaceCountStarId-59]
+	_ = x[expandStarsId-60]
+	_ = x[mergeDerivedTablesId-61]
+	_ = x[transposeRightJoinsId-62]
+	_ = x[resolveHavingId-63]
+	_ = x[mergeUnionSchemasId-64]
+	_ = x[flattenAggregationExprsId-65]
+	_ = x[reorderProjectionId-66]
+	_ = x[resolveSubqueryExprsId-67]
+	_ = x[replaceCrossJoinsId-68]
+	_ = x[moveJoinCondsToFilterId-69]
+	_ = x[evalFilterId-70]
+	_ = x[simplifyExistsSubqueriesId-71]
+	_ = x[hoistOutOfScopeFiltersId-72]
+	_ = x[transformJoinApplyId-73]
+	_ = x[hoistSelectExistsId-74]
+	_ = x[applyColumnMasksId-75]
+	_ = x[finalizeSubqueriesId-76]
+	_ = x[finalizeUnionsId-77]
+	_ = x[loadTriggersId-78]
+	_ = x[processTruncateId-79]
+	_ = x[resolveAlterColumnId-80]
+	_ = x[resolveGeneratorsId-81]
+	_ = x[removeUnnecessaryConvertsId-82]
+	_ = x[pruneColumnsId-83]
+	_ = x[stripTableNameInDefaultsId-84]
+	_ = x[foldEmptyJoinsId-85]
+	_ = x[simplifyOuterJoinsId-86]
+	_ = x[substituteGeneratedColumnsId-87]
+	_ = x[rewriteRangeFiltersId-88]
+	_ = x[pushdownJoinsToDatabasesId-89]
+	_ = x[optimizeJoinsId-90]
+	_ = x[applyTableCountId-91]
+	_ = x[applyIndexDistinctId-92]
+	_ = x[concatFiltersId-93]
+	_ = x[pushdownFiltersId-94]
+	_ = x[pushdownIndexConditionsId-95]
+	_ = x[subqueryIndexesId-96]
+	_ = x[pruneTablesId-97]
+	_ = x[setJoinScopeLenId-98]
+	_ = x[eraseProjectionId-99]
+	_ = x[pushdownSortAndLimitToTablesId-100]
+	_ = x[replaceIdxSortId-101]
+	_ = x[insertTopNId-102]
+	_ = x[pushdownOffsetId-103]
+	_ = x[optimizeDistinctId-104]
+	_ = x[applyHashInId-105]
+	_ = x[resolveInsertRowsId-106]
+	_ = x[resolvePreparedInsertId-107]
+	_ = x[applyTriggersId-108]
+	_ = x[applyProceduresId-109]
+	_ = x[assignRoutinesId-110]
+	_ = x[modifyUpdateExprsForJoinId-111]
+	_ = x[applyRowUpdateAccumulatorsId-112]
+	_ = x[wrapWithRollbackId-113]
+	_ = x[applyFKsId-114]
+	_ = x[validateResolvedId-115]
+	_ = x[validateOrderById-116]
+	_ = x[validateGroupById-117]
+	_ = x[validateSchemaSourceId-118]
+	_ = x[validateIndexCreationId-119]
+	_ = x[validateOperandsId-120]
+	_ = x[validateCaseResultTypesId-121]
+	_ = x[validateIntervalUsageId-122]
+	_ = x[validateExplodeUsageId-123]
+	_ = x[validateSubqueryColumnsId-124]
+	_ = x[validateUnionSchemasMatchId-125]
+	_ = x[validateAggregationsId-126]
+	_ = x[validateDeleteFromId-127]
+	_ = x[cacheSubqueryResultsId-128]
+	_ = x[cacheSubqueryAliasesInJoinsId-129]
+	_ = x[AutocommitId-130]
+	_ = x[TrackProcessId-131]
+	_ = x[parallelizeId-132]
+	_ = x[clearWarningsId-133]
 }
 
-const _RuleId_name = "applyDefaultSelectLimitvalidateOffsetAndLimitvalidateCreateTablevalidateExprSemresolveVariablesresolveNamedWindowsresolveSetVariablesresolveViewsliftCtesresolveCtesliftRecursiveCtesresolveDatabasesresolveTablesloadStoredProceduresvalidateDropTablessetTargetSchemasresolveCreateLikeparseColumnDefaultsresolveDropConstraintvalidateDropConstraintloadCheckConstraintsassignCatalogresolveAnalyzeTablesresolveCreateSelectresolveSubqueriessetViewTargetSchemaresolveUnionsresolveDescribeQuerycheckUniqueTableNamesresolveTableFunctionsresolveDeclarationsresolveColumnDefaultsvalidateColumnDefaultsvalidateCreateTriggervalidateCreateProcedureloadInfoSchemavalidateReadOnlyDatabasevalidateReadOnlyTransactionvalidateDatabaseSetvalidatePrivilegesreresolveTablessetInsertColumnsvalidateJoinComplexityapplyBinlogReplicaControllerresolveNaturalJoinsresolveOrderbyLiteralsresolveFunctionsflattenTableAliasespushdownSortpushdownGroupbyAliasespushdownSubqueryAliasFiltersqualifyColumnsresolveColumnsvalidateCheckConstraintresolveBarewordSetVariablesreplaceCountStarexpandStarstransposeRightJoinsresolveHavingmergeUnionSchemasflattenAggregationExprsreorderProjectionresolveSubqueryExprsreplaceCrossJoinsmoveJoinCondsToFilterevalFilteroptimizeDistincthoistOutOfScopeFilterstransformJoinApplyhoistSelectExistsfinalizeSubqueriesfinalizeUnionsloadTriggersprocessTruncateresolveAlterColumnresolveGeneratorsremoveUnnecessaryConvertspruneColumnsstripTableNamesFromColumnDefaultsfoldEmptyJoinsoptimizeJoinsconcatFilterspushdownFilterssubqueryIndexespruneTablessetJoinScopeLeneraseProjectionreplaceSortPkinsertTopNapplyHashInresolveInsertRowsresolvePreparedInsertapplyTriggersapplyProceduresassignRoutinesmodifyUpdateExprsForJoinapplyRowUpdateAccumulatorsrollback triggersapplyFKsvalidateResolvedvalidateOrderByvalidateGroupByvalidateSchemaSourcevalidateIndexCreationvalidateOperandsvalidateCaseResultTypesvalidateIntervalUsagevalidateExplodeUsagevalidateSubqueryColumnsvalidateUnionSchemasMatchvalidateAggregationsvalidateDeleteFromcacheSubqueryResultscacheSubqueryAliasesInJoinsaddAutocommitNodetrackProcessparallelizeclearWarnings"
+const _RuleId_name = "applyDefaultSelectLimitresolveMaterializedViewsvalidateOffsetAndLimitvalidateCreateTablevalidateExprSemresolveVariablesresolveNamedWindowsresolveSetVariablesresolveUpdatableViewsresolveViewsliftCtesresolveCtesliftRecursiveCtesresolveDatabasesresolveTablesloadStoredProceduresvalidateDropTablessetTargetSchemasresolveCreateLikeparseColumnDefaultsresolveDropConstraintvalidateDropConstraintloadCheckConstraintsapplyRowPoliciesassignCatalogresolveAnalyzeTablesresolveCheckTablesresolveCreateSelectresolveSubqueriessetViewTargetSchemaresolveUnionsresolveDescribeQuerycheckUniqueTableNamesresolveTableFunctionsresolveDeclarationsresolveColumnDefaultsvalidateColumnDefaultsvalidateCreateTriggervalidateCreateProcedureloadInfoSchemavalidateReadOnlyDatabasevalidateReadOnlyTransactionvalidateDatabaseSetvalidatePrivilegesreresolveTablessetInsertColumnsvalidateJoinComplexityapplyBinlogReplicaControllerresolveNaturalJoinsresolveOrderbyLiteralsresolveFunctionsflattenTableAliasespushdownSortpushdownGroupbyAliasespushdownSubqueryAliasFiltersqualifyColumnsresolveColumnsvalidateCheckConstraintresolveBarewordSetVariablesreplaceCountStarexpandStarsmergeDerivedTablestransposeRightJoinsresolveHavingmergeUnionSchemasflattenAggregationExprsreorderProjectionresolveSubqueryExprsreplaceCrossJoinsmoveJoinCondsToFilterevalFiltersimplifyExistsSubquerieshoistOutOfScopeFilterstransformJoinApplyhoistSelectExistsapplyColumnMasksfinalizeSubqueriesfinalizeUnionsloadTriggersprocessTruncateresolveAlterColumnresolveGeneratorsremoveUnnecessaryConvertspruneColumnsstripTableNamesFromColumnDefaultsfoldEmptyJoinssimplifyOuterJoinssubstituteGeneratedColumnsrewriteRangeFilterspushdownJoinsToDatabasesoptimizeJoinsapplyTableCountapplyIndexDistinctconcatFilterspushdownFilterspushdownIndexConditionssubqueryIndexespruneTablessetJoinScopeLeneraseProjectionpushdownSortAndLimitToTablesreplaceIdxSortinsertTopNpushdownOffsetoptimizeDistinctapplyHashInresolveInsertRowsresolvePreparedInsertapplyTriggersapplyProceduresassignRoutinesmodifyUpdateExprsForJoinapplyRowUpdateAccumulatorsrollback triggersapplyFKsvalidateResolvedvalidateOrderByvalidateGroupByvalidateSchemaSourcevalidateIndexCreationvalidateOperandsvalidateCaseResultTypesvalidateIntervalUsagevalidateExplodeUsagevalidateSubqueryColumnsvalidateUnionSchemasMatchvalidateAggregationsvalidateDeleteFromcacheSubqueryResultscacheSubqueryAliasesInJoinsaddAutocommitNodetrackProcessparallelizeclearWarnings"
 
-var _RuleId_index = [...]uint16{0, 23, 45, 64, 79, 95, 114, 133, 145, 153, 164, 181, 197, 210, 230, 248, 264, 281, 300, 321, 343, 363, 376, 396, 415, 432, 451, 464, 484, 505, 526, 545, 566, 588, 609, 632, 646, 670, 697, 716, 734, 749, 765, 787, 815, 834, 856, 872, 891, 903, 925, 953, 967, 981, 1004, 1031, 1047, 1058, 1077, 1090, 1107, 1130, 1147, 1167, 1184, 1205, 1215, 1231, 1253, 1271, 1288, 1306, 1320, 1332, 1347, 1365, 1382, 1407, 1419, 1452, 1466, 1479, 1492, 1507, 1522, 1533, 1548, 1563, 1576, 1586, 1597, 1614, 1635, 1648, 1663, 1677, 1701, 1727, 1744, 1752, 1768, 1783, 1798, 1818, 1839, 1855, 1878, 1899, 1919, 1942, 1967, 1987, 2005, 2025, 2052, 2069, 2081, 2092, 2105}
+var _RuleId_index = [...]uint16{0, 23, 47, 69, 88, 103, 119, 138, 157, 178, 190, 198, 209, 226, 242, 255, 275, 293, 309, 326, 345, 366, 388, 408, 424, 437, 457, 475, 494, 511, 530, 543, 563, 584, 605, 624, 645, 667, 688, 711, 725, 749, 776, 795, 813, 828, 844, 866, 894, 913, 935, 951, 970, 982, 1004, 1032, 1046, 1060, 1083, 1110, 1126, 1137, 1155, 1174, 1187, 1204, 1227, 1244, 1264, 1281, 1302, 1312, 1336, 1358, 1376, 1393, 1409, 1427, 1441, 1453, 1468, 1486, 1503, 1528, 1540, 1573, 1587, 1605, 1631, 1650, 1674, 1687, 1702, 1720, 1733, 1748, 1771, 1786, 1797, 1812, 1827, 1855, 1869, 1879, 1893, 1909, 1920, 1937, 1958, 1971, 1986, 2000, 2024, 2050, 2067, 2075, 2091, 2106, 2121, 2141, 2162, 2178, 2201, 2222, 2242, 2265, 2290, 2310, 2328, 2348, 2375, 2392, 2404, 2415, 2428}
 
 func (i RuleId) String() string {
 	if i < 0 || i >= RuleId(len(_RuleId_index)-1) {
@@ -23,12 +23,14 @@ import (
 var OnceBeforeDefault = []Rule{
 	{applyDefaultSelectLimitId, applyDefaultSelectLimit},
 	{applyBinlogReplicaControllerId, applyBinlogReplicaController},
+	{resolveMaterializedViewsId, resolveMaterializedViews},
 	{validateOffsetAndLimitId, validateLimitAndOffset},
 	{validateCreateTableId, validateCreateTable},
 	{validateExprSemId, validateExprSem},
 	{resolveVariablesId, resolveVariables},
 	{resolveNamedWindowsId, replaceNamedWindows},
 	{resolveSetVariablesId, resolveSetVariables},
+	{resolveUpdatableViewsId, resolveUpdatableViews},
 	{resolveViewsId, resolveViews},
 	{liftCtesId, hoistCommonTableExpressions},
 	{resolveCtesId, resolveCommonTableExpressions},
@@ -40,10 +42,12 @@ var OnceBeforeDefault = []Rule{
 	{setInsertColumnsId, setInsertColumns},
 	{setTargetSchemasId, setTargetSchemas},
 	{loadCheckConstraintsId, loadChecks},
+	{applyRowPoliciesId, applyRowPolicies},
 	{resolveAlterColumnId, resolveAlterColumn},
 	{validateDropTablesId, validateDropTables},
 	{resolveCreateLikeId, resolveCreateLike},
 	{resolveAnalyzeTablesId, resolveAnalyzeTables},
+	{resolveCheckTablesId, resolveCheckTables},
 	{assignCatalogId, assignCatalog},
 	{parseColumnDefaultsId, parseColumnDefaults},
 	{resolveDropConstraintId, resolveDropConstraint},
@@ -82,6 +86,7 @@ var DefaultRules = []Rule{
 	{validateCheckConstraintId, validateCheckConstraints},
 	{resolveBarewordSetVariablesId, resolveBarewordSetVariables},
 	{expandStarsId, expandStars},
+	{mergeDerivedTablesId, mergeDerivedTables},
 	{transposeRightJoinsId, transposeRightJoins},
 	{resolveHavingId, resolveHaving},
 	{mergeUnionSchemasId, mergeUnionSchemas},
@@ -91,30 +96,41 @@ var DefaultRules = []Rule{
 	{replaceCrossJoinsId, replaceCrossJoins},
 	{moveJoinCondsToFilterId, moveJoinConditionsToFilter},
 	{evalFilterId, simplifyFilters},
-	{optimizeDistinctId, optimizeDistinct},
 }
 
 // OnceAfterDefault contains the rules to be applied just once after the
 // DefaultRules.
 var OnceAfterDefault = []Rule{
+	{simplifyExistsSubqueriesId, simplifyExistsSubqueries},
 	{hoistOutOfScopeFiltersId, hoistOutOfScopeFilters},
 	{transformJoinApplyId, transformJoinApply},
 	{hoistSelectExistsId, hoistSelectExists},
+	{applyColumnMasksId, applyColumnMasks},
 	{finalizeUnionsId, finalizeUnions},
 	{loadTriggersId, loadTriggers},
 	{processTruncateId, processTruncate},
 	{removeUnnecessaryConvertsId, removeUnnecessaryConverts},
 	{stripTableNameInDefaultsId, stripTableNamesFromColumnDefaults},
 	{foldEmptyJoinsId, foldEmptyJoins},
+	{simplifyOuterJoinsId, simplifyOuterJoins},
+	{substituteGeneratedColumnsId, substituteGeneratedColumns},
+	{rewriteRangeFiltersId, rewriteRangeFilters},
+	{pushdownJoinsToDatabasesId, pushdownJoinsToDatabases},
 	{optimizeJoinsId, constructJoinPlan},
+	{applyTableCountId, applyTableCount},
+	{applyIndexDistinctId, applyIndexDistinct},
 	{pushdownFiltersId, pushdownFilters},
+	{pushdownIndexConditionsId, pushdownIndexConditions},
 	{pruneColumnsId, pruneColumns},
 	{finalizeSubqueriesId, finalizeSubqueries},
 	{subqueryIndexesId, applyIndexesFromOuterScope},
-	{replaceSortPkId, replacePkSort},
+	{pushdownSortAndLimitToTablesId, pushdownSortAndLimitToTables},
+	{replaceIdxSortId, replaceIdxSort},
 	{setJoinScopeLenId, setJoinScopeLen},
 	{eraseProjectionId, eraseProjection},
 	{insertTopNId, insertTopNNodes},
+	{pushdownOffsetId, pushdownOffset},
+	{optimizeDistinctId, optimizeDistinct},
 	{applyHashInId, applyHashIn},
 	{resolveInsertRowsId, resolveInsertRows},
 	{resolvePreparedInsertId, resolvePreparedInsert},
@@ -27,7 +27,7 @@ import (
 
 type HintType uint8
 
-// TODO implement NO_ICP and JOIN_FIXED_ORDER
+// TODO implement NO_ICP
 const (
 	HintTypeUnknown                  HintType = iota //
 	HintTypeJoinOrder                                // JOIN_ORDER
@@ -39,6 +39,7 @@ const (
 	HintTypeAntiJoin                                 // ANTI_JOIN
 	HintTypeInnerJoin                                // INNER_JOIN
 	HintTypeNoIndexConditionPushDown                 // NO_ICP
+	HintTypeNoMerge                                  // NO_MERGE
 )
 
 type Hint struct {
@@ -75,6 +76,8 @@ func newHint(joinTyp string, args []string) Hint {
 		typ = HintTypeAntiJoin
 	case "no_icp":
 		typ = HintTypeNoIndexConditionPushDown
+	case "no_merge":
+		typ = HintTypeNoMerge
 	default:
 		typ = HintTypeUnknown
 	}
@@ -101,6 +104,8 @@ func (h Hint) valid() bool {
 		return len(h.Args) == 2
 	case HintTypeNoIndexConditionPushDown:
 		return len(h.Args) == 0
+	case HintTypeNoMerge:
+		return true
 	case HintTypeUnknown:
 		return false
 	default:
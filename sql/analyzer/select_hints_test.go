@@ -94,6 +94,14 @@ func TestHintParsing(t *testing.T) {
 			comment: "/*+ anti_join(a,b) */",
 			hints:   []Hint{{Typ: HintTypeAntiJoin, Args: []string{"a", "b"}}},
 		},
+		{
+			comment: "/*+ NO_MERGE(dt1, dt2) */",
+			hints:   []Hint{{Typ: HintTypeNoMerge, Args: []string{"dt1", "dt2"}}},
+		},
+		{
+			comment: "/*+ NO_MERGE() */",
+			hints:   []Hint{{Typ: HintTypeNoMerge}},
+		},
 		{
 			comment: "/*+ hash_join(a,b) merge_join(b,c) lookup_join(a,d) */",
 			hints: []Hint{
@@ -0,0 +1,163 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// simplifyOuterJoins converts left joins into inner joins when a filter above them rejects the rows they add for the
+// rows of their left side that don't match any row of their right side. Those rows have nulls for all the columns of
+// the right side, so a filter that can't be true when a column of the right side is null removes all of them, and the
+// left join returns the same rows as an inner join. Inner joins can be reordered and use indexes on both of their
+// sides, so the join planner has more plans to choose from.
+//
+// Right joins have been transposed into left joins by this point.
+func simplifyOuterJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("simplify_outer_joins")
+	defer span.End()
+
+	if !n.Resolved() {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		f, ok := n.(*plan.Filter)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+		child, same, err := simplifyOuterJoinsUnderFilter(a, f.Child, splitConjunction(f.Expression))
+		if err != nil || same {
+			return n, transform.SameTree, err
+		}
+		return plan.NewFilter(f.Expression, child), transform.NewTree, nil
+	})
+}
+
+// simplifyOuterJoinsUnderFilter converts the left joins of the join tree given whose right side has a column that one
+// of the filters given rejects nulls for into inner joins. The filters apply to all the rows of the join tree.
+func simplifyOuterJoinsUnderFilter(a *Analyzer, n sql.Node, filters []sql.Expression) (sql.Node, transform.TreeIdentity, error) {
+	j, ok := n.(*plan.JoinNode)
+	if !ok {
+		return n, transform.SameTree, nil
+	}
+
+	var op plan.JoinType
+	switch {
+	case j.Op.IsInner():
+		op = j.Op
+	case j.Op == plan.JoinTypeLeftOuter:
+		sources := make(map[string]bool)
+		for _, col := range j.Right().Schema() {
+			if col.Source != "" {
+				sources[strings.ToLower(col.Source)] = true
+			}
+		}
+		op = j.Op
+		for _, f := range filters {
+			if rejectsNulls(f, sources) {
+				a.Log("converting left join to inner join, since filter %s rejects its null rows", f)
+				op = plan.JoinTypeInner
+				break
+			}
+		}
+	default:
+		return n, transform.SameTree, nil
+	}
+
+	// The rows of the left side of a join are all in its rows, and so are those of the right side of an inner join, so
+	// the filters apply to them too.
+	left, sameLeft, err := simplifyOuterJoinsUnderFilter(a, j.Left(), filters)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	right, sameRight := j.Right(), transform.SameTree
+	if op.IsInner() {
+		right, sameRight, err = simplifyOuterJoinsUnderFilter(a, j.Right(), filters)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+	}
+	if op == j.Op && sameLeft && sameRight {
+		return n, transform.SameTree, nil
+	}
+
+	ret := *j
+	ret.Op = op
+	newJoin, err := ret.WithChildren(left, right)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return newJoin, transform.NewTree, nil
+}
+
+// rejectsNulls returns whether the filter given is never true when a column of one of the tables given is null. Only
+// filters that compare such a column, or an expression that's null when it is, with another value are found.
+func rejectsNulls(e sql.Expression, sources map[string]bool) bool {
+	switch e := e.(type) {
+	case *expression.And:
+		return rejectsNulls(e.Left, sources) || rejectsNulls(e.Right, sources)
+	case *expression.Or:
+		return rejectsNulls(e.Left, sources) && rejectsNulls(e.Right, sources)
+	case *expression.Not:
+		switch c := e.Child.(type) {
+		case *expression.IsNull:
+			return isNullWhenColumnsAreNull(c.Child, sources)
+		case *expression.Equals:
+			return rejectsNulls(c, sources)
+		}
+		return false
+	case *expression.Equals, *expression.GreaterThan, *expression.LessThan, *expression.GreaterThanOrEqual,
+		*expression.LessThanOrEqual:
+		cmp := e.(expression.Comparer)
+		return isNullWhenColumnsAreNull(cmp.Left(), sources) || isNullWhenColumnsAreNull(cmp.Right(), sources)
+	case *expression.Like:
+		return isNullWhenColumnsAreNull(e.Left, sources) || isNullWhenColumnsAreNull(e.Right, sources)
+	case *expression.InTuple:
+		return isNullWhenColumnsAreNull(e.Left(), sources)
+	case *expression.HashInTuple:
+		return isNullWhenColumnsAreNull(e.Left(), sources)
+	case *expression.Between:
+		return isNullWhenColumnsAreNull(e.Val, sources)
+	default:
+		return false
+	}
+}
+
+// isNullWhenColumnsAreNull returns whether the expression given is null when a column of one of the tables given is
+// null, because it's such a column or an arithmetic expression of one.
+func isNullWhenColumnsAreNull(e sql.Expression, sources map[string]bool) bool {
+	switch e := e.(type) {
+	case *expression.GetField:
+		return sources[strings.ToLower(e.Table())]
+	case *expression.Arithmetic:
+		return isNullWhenColumnsAreNull(e.Left, sources) || isNullWhenColumnsAreNull(e.Right, sources)
+	case *expression.Div:
+		return isNullWhenColumnsAreNull(e.Left, sources) || isNullWhenColumnsAreNull(e.Right, sources)
+	case *expression.Mod:
+		return isNullWhenColumnsAreNull(e.Left, sources) || isNullWhenColumnsAreNull(e.Right, sources)
+	case *expression.UnaryMinus:
+		return isNullWhenColumnsAreNull(e.Child, sources)
+	case *expression.Convert:
+		return isNullWhenColumnsAreNull(e.Child, sources)
+	default:
+		return false
+	}
+}
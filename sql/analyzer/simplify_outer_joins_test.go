@@ -0,0 +1,129 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestSimplifyOuterJoins(t *testing.T) {
+	newTable := func(name string) *plan.ResolvedTable {
+		return plan.NewResolvedTable(memory.NewTable(name, sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "x", Type: types.Int64, Source: name, Nullable: true},
+			{Name: "y", Type: types.Int64, Source: name, Nullable: true},
+		}), nil), nil, nil)
+	}
+	a, b, c := newTable("a"), newTable("b"), newTable("c")
+
+	ax := expression.NewGetFieldWithTable(0, types.Int64, "a", "x", true)
+	bx := expression.NewGetFieldWithTable(2, types.Int64, "b", "x", true)
+	by := expression.NewGetFieldWithTable(3, types.Int64, "b", "y", true)
+	cx := expression.NewGetFieldWithTable(4, types.Int64, "c", "x", true)
+	one := expression.NewLiteral(int64(1), types.Int64)
+
+	leftJoin := plan.NewLeftOuterJoin(a, b, expression.NewEquals(ax, bx))
+	innerJoin := plan.NewInnerJoin(a, b, expression.NewEquals(ax, bx))
+
+	tests := []analyzerFnTestCase{
+		{
+			name:     "comparison with right side column",
+			node:     plan.NewFilter(expression.NewGreaterThan(by, one), leftJoin),
+			expected: plan.NewFilter(expression.NewGreaterThan(by, one), innerJoin),
+		},
+		{
+			name:     "right side column is not null",
+			node:     plan.NewFilter(expression.NewNot(expression.NewIsNull(by)), leftJoin),
+			expected: plan.NewFilter(expression.NewNot(expression.NewIsNull(by)), innerJoin),
+		},
+		{
+			name: "arithmetic on right side column in conjunction",
+			node: plan.NewFilter(expression.NewAnd(
+				expression.NewEquals(ax, one),
+				expression.NewEquals(expression.NewArithmetic(by, one, "+"), one),
+			), leftJoin),
+			expected: plan.NewFilter(expression.NewAnd(
+				expression.NewEquals(ax, one),
+				expression.NewEquals(expression.NewArithmetic(by, one, "+"), one),
+			), innerJoin),
+		},
+		{
+			name: "disjunction rejecting nulls in both branches",
+			node: plan.NewFilter(expression.NewOr(
+				expression.NewEquals(by, one),
+				expression.NewLessThan(bx, one),
+			), leftJoin),
+			expected: plan.NewFilter(expression.NewOr(
+				expression.NewEquals(by, one),
+				expression.NewLessThan(bx, one),
+			), innerJoin),
+		},
+		{
+			name: "disjunction accepting nulls in one branch",
+			node: plan.NewFilter(expression.NewOr(
+				expression.NewEquals(by, one),
+				expression.NewEquals(ax, one),
+			), leftJoin),
+		},
+		{
+			name: "right side column is null",
+			node: plan.NewFilter(expression.NewIsNull(by), leftJoin),
+		},
+		{
+			name: "null safe comparison",
+			node: plan.NewFilter(expression.NewNullSafeEquals(by, one), leftJoin),
+		},
+		{
+			name: "comparison with left side column",
+			node: plan.NewFilter(expression.NewEquals(ax, one), leftJoin),
+		},
+		{
+			name: "nested left join under converted left join",
+			node: plan.NewFilter(
+				expression.NewEquals(cx, one),
+				plan.NewLeftOuterJoin(leftJoin, c, expression.NewEquals(by, cx)),
+			),
+			expected: plan.NewFilter(
+				expression.NewEquals(cx, one),
+				plan.NewInnerJoin(leftJoin, c, expression.NewEquals(by, cx)),
+			),
+		},
+		{
+			name: "left join on the right side of a converted left join",
+			node: plan.NewFilter(
+				expression.NewEquals(by, one),
+				plan.NewLeftOuterJoin(c, leftJoin, expression.NewEquals(cx, ax)),
+			),
+			expected: plan.NewFilter(
+				expression.NewEquals(by, one),
+				plan.NewInnerJoin(c, innerJoin, expression.NewEquals(cx, ax)),
+			),
+		},
+		{
+			name: "left join on the right side of a left join that's kept",
+			node: plan.NewFilter(
+				expression.NewEquals(cx, one),
+				plan.NewLeftOuterJoin(c, leftJoin, expression.NewEquals(cx, ax)),
+			),
+		},
+	}
+
+	runTestCases(t, nil, tests, NewDefault(sql.NewDatabaseProvider()), getRule(simplifyOuterJoinsId))
+}
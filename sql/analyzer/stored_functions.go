@@ -0,0 +1,94 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// storedFunctionsKey is the key of the value of the context holding the names of the stored functions whose calls are
+// being resolved, outermost first, which are used to reject recursive functions.
+type storedFunctionsKey struct{}
+
+// resolveStoredFunction resolves the function given to a call of the stored function with its name, of the database
+// that qualifies it or of the current database. It returns false if there is no such stored function. The body of the
+// function is analyzed for each call, so functions that call themselves, directly or indirectly, are rejected.
+func resolveStoredFunction(ctx *sql.Context, a *Analyzer, uf *expression.UnresolvedFunction) (sql.Expression, bool, error) {
+	dbName := uf.Database
+	if dbName == "" {
+		dbName = ctx.GetCurrentDatabase()
+	}
+	if dbName == "" {
+		return nil, false, nil
+	}
+	name := strings.ToLower(dbName + "." + uf.Name())
+	resolving, _ := ctx.Value(storedFunctionsKey{}).([]string)
+	for _, n := range resolving {
+		if n == name {
+			return nil, false, sql.ErrStoredFunctionRecursion.New()
+		}
+	}
+
+	db, err := a.Catalog.Database(ctx, dbName)
+	if err != nil {
+		return nil, false, err
+	}
+	fdb, ok := db.(sql.StoredFunctionDatabase)
+	if !ok {
+		return nil, false, nil
+	}
+	details, ok, err := fdb.GetStoredFunction(ctx, uf.Name())
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	ctx = withResolvingStoredFunction(ctx, dbName, uf.Name())
+
+	parsed, err := parse.Parse(ctx, details.CreateStatement)
+	if err != nil {
+		return nil, false, err
+	}
+	cp, ok := parsed.(*plan.CreateProcedure)
+	if !ok || !cp.IsFunction() {
+		return nil, false, sql.ErrProcedureCreateStatementInvalid.New(details.CreateStatement)
+	}
+	if len(cp.Params) != len(uf.Arguments) {
+		return nil, false, sql.ErrCallIncorrectParameterCount.New(cp.Name, len(cp.Params), len(uf.Arguments))
+	}
+
+	function, err := analyzeCreateProcedure(ctx, a, cp, nil, DefaultRuleSelector)
+	if err != nil {
+		return nil, false, err
+	}
+	pRef := expression.NewProcedureReference()
+	function, err = applyProcedureReference(ctx, a, function, pRef, nil, DefaultRuleSelector)
+	if err != nil {
+		return nil, false, err
+	}
+	return plan.NewStoredFunction(function, pRef, uf.Arguments...), true, nil
+}
+
+// withResolvingStoredFunction returns a context for resolving the body of the stored function given, in which calls of
+// the function are rejected as recursive.
+func withResolvingStoredFunction(ctx *sql.Context, dbName, name string) *sql.Context {
+	resolving, _ := ctx.Value(storedFunctionsKey{}).([]string)
+	resolving = append(resolving[:len(resolving):len(resolving)], strings.ToLower(dbName+"."+name))
+	return ctx.WithContext(context.WithValue(ctx.Context, storedFunctionsKey{}, resolving))
+}
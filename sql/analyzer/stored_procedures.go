@@ -85,6 +85,10 @@ func analyzeCreateProcedure(ctx *sql.Context, a *Analyzer, cp *plan.CreateProced
 	if err != nil {
 		return nil, err
 	}
+	// The body of a procedure with SQL SECURITY DEFINER is checked with its definer's privileges
+	if cp.SecurityContext == plan.ProcedureSecurityContext_Definer {
+		ctx = definerSecurityContext(ctx, a, cp.Definer, cp.CreateProcedureString)
+	}
 	var analyzedNode sql.Node
 	analyzedNode, _, err = resolveDeclarations(ctx, a, cp.Procedure, scope, sel)
 	if err != nil {
@@ -172,6 +176,13 @@ func validateCreateProcedure(ctx *sql.Context, a *Analyzer, node sql.Node, scope
 	if err != nil {
 		return nil, transform.SameTree, err
 	}
+	if cp.IsFunction() {
+		dbName := cp.Database().Name()
+		if dbName == "" {
+			dbName = ctx.GetCurrentDatabase()
+		}
+		ctx = withResolvingStoredFunction(ctx, dbName, cp.Name)
+	}
 	proc, _, err := resolveDeclarations(ctx, a, cp.Procedure, scope, sel)
 	if err != nil {
 		return nil, transform.SameTree, err
@@ -180,6 +191,10 @@ func validateCreateProcedure(ctx *sql.Context, a *Analyzer, node sql.Node, scope
 	if err != nil {
 		return nil, transform.SameTree, err
 	}
+	// Tables are only resolved once the body has been analyzed, so this is where SELECT statements can be told apart
+	if p, ok := newProc.(*plan.Procedure); ok && p.IsFunction() && p.ReturnsResultSet() {
+		return nil, transform.SameTree, sql.ErrStoredFunctionResultSet.New()
+	}
 
 	node, err = cp.WithChildren(StripPassthroughNodes(newProc))
 	if err != nil {
@@ -221,6 +236,7 @@ func validateStoredProcedure(_ *sql.Context, proc *plan.Procedure) error {
 		return err
 	}
 
+	hasReturn := false
 	transform.Inspect(proc, func(n sql.Node) bool {
 		switch n := n.(type) {
 		case *plan.Call:
@@ -235,6 +251,15 @@ func validateStoredProcedure(_ *sql.Context, proc *plan.Procedure) error {
 			err = sql.ErrProcedureInvalidBodyStatement.New("USE")
 		case *plan.LoadData:
 			err = sql.ErrProcedureInvalidBodyStatement.New("LOAD DATA")
+		case *plan.Return:
+			if !proc.IsFunction() {
+				err = sql.ErrReturnOutsideFunction.New()
+			}
+			hasReturn = true
+		case *plan.PrepareQuery, *plan.ExecuteQuery, *plan.DeallocateQuery:
+			if proc.IsFunction() {
+				err = sql.ErrDynamicSQLInStoredFunction.New()
+			}
 		default:
 			return true
 		}
@@ -244,6 +269,11 @@ func validateStoredProcedure(_ *sql.Context, proc *plan.Procedure) error {
 		return err
 	}
 
+	if proc.IsFunction() {
+		if !hasReturn {
+			return sql.ErrStoredFunctionNoReturn.New(proc.Name)
+		}
+	}
 	return nil
 }
 
@@ -415,11 +445,30 @@ func applyProceduresCall(ctx *sql.Context, a *Analyzer, call *plan.Call, scope *
 	pRef := expression.NewProcedureReference()
 	call = call.WithParamReference(pRef)
 
+	procedure, err := applyProcedureReference(ctx, a, procedure, pRef, scope, sel)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+
+	if len(procedure.Params) != len(call.Params) {
+		return nil, transform.SameTree, sql.ErrCallIncorrectParameterCount.New(procedure.Name, len(procedure.Params), len(call.Params))
+	}
+
+	call = call.WithProcedure(procedure)
+	return call, transform.NewTree, nil
+}
+
+// applyProcedureReference returns the procedure given with the *ProcedureReference given set on all the nodes and
+// expressions of its body that refer to the state of a running procedure, such as its parameters and variables. The
+// stored procedures that it calls are applied as well.
+func applyProcedureReference(ctx *sql.Context, a *Analyzer, procedure *plan.Procedure, pRef *expression.ProcedureReference, scope *Scope, sel RuleSelector) (*plan.Procedure, error) {
 	var procParamTransformFunc transform.ExprFunc
 	procParamTransformFunc = func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 		switch expr := e.(type) {
 		case *expression.ProcedureParam:
 			return expr.WithParamReference(pRef), transform.NewTree, nil
+		case *expression.DiagnosticsItem:
+			return expr.WithParamReference(pRef), transform.NewTree, nil
 		case sql.ExpressionWithNodes:
 			children := expr.NodeChildren()
 			var newChildren []sql.Node
@@ -450,7 +499,7 @@ func applyProceduresCall(ctx *sql.Context, a *Analyzer, call *plan.Call, scope *
 	}
 	transformedProcedure, _, err := transform.NodeExprsWithOpaque(procedure, procParamTransformFunc)
 	if err != nil {
-		return nil, transform.SameTree, err
+		return nil, err
 	}
 	// Some nodes do not expose all of their children, so we need to handle them here.
 	transformedProcedure, _, err = transform.NodeWithOpaque(transformedProcedure, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
@@ -485,7 +534,7 @@ func applyProceduresCall(ctx *sql.Context, a *Analyzer, call *plan.Call, scope *
 		}
 	})
 	if err != nil {
-		return nil, transform.SameTree, err
+		return nil, err
 	}
 
 	transformedProcedure, _, err = transform.Node(transformedProcedure, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
@@ -498,19 +547,12 @@ func applyProceduresCall(ctx *sql.Context, a *Analyzer, call *plan.Call, scope *
 
 	transformedProcedure, _, err = applyProcedures(ctx, a, transformedProcedure, scope, sel)
 	if err != nil {
-		return nil, transform.SameTree, err
+		return nil, err
 	}
 
-	var ok bool
-	procedure, ok = transformedProcedure.(*plan.Procedure)
+	procedure, ok := transformedProcedure.(*plan.Procedure)
 	if !ok {
-		return nil, transform.SameTree, fmt.Errorf("expected `*plan.Procedure` but got `%T`", transformedProcedure)
-	}
-
-	if len(procedure.Params) != len(call.Params) {
-		return nil, transform.SameTree, sql.ErrCallIncorrectParameterCount.New(procedure.Name, len(procedure.Params), len(call.Params))
+		return nil, fmt.Errorf("expected `*plan.Procedure` but got `%T`", transformedProcedure)
 	}
-
-	call = call.WithProcedure(procedure)
-	return call, transform.NewTree, nil
+	return procedure, nil
 }
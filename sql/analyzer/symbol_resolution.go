@@ -189,11 +189,15 @@ func pruneTableCols(
 	}
 
 	cols := make([]string, 0)
+	kept := make(map[string]bool)
 	source := strings.ToLower(t.Name())
 	for _, col := range t.Schema() {
 		c := tableCol{table: strings.ToLower(source), col: strings.ToLower(col.Name)}
-		if selectStar || parentCols[c] > 0 {
+		// generated columns computed from the columns kept are kept too, so that filters on their expressions can be
+		// replaced by them
+		if selectStar || parentCols[c] > 0 || generatedFromColumns(col, kept) {
 			cols = append(cols, c.col)
+			kept[c.col] = true
 		}
 	}
 
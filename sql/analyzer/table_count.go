@@ -0,0 +1,68 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// applyTableCount replaces aggregations that only count all the rows of a table, such as SELECT COUNT(*) FROM t, with
+// a TableCount node asking the table for its number of rows, if it's a sql.RowCounter. It runs before filters are
+// pushed down to tables, so that the tables of the aggregations it replaces are read in full.
+func applyTableCount(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("apply_table_count")
+	defer span.End()
+
+	if !n.Resolved() {
+		return n, transform.SameTree, nil
+	}
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		gb, ok := n.(*plan.GroupBy)
+		if !ok || len(gb.GroupByExprs) > 0 || len(gb.SelectedExprs) == 0 {
+			return n, transform.SameTree, nil
+		}
+		for _, e := range gb.SelectedExprs {
+			if !isCountOfAllRows(e) {
+				return n, transform.SameTree, nil
+			}
+		}
+
+		counter, ok := plan.GetRowCounter(gb.Child)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+		a.Log("replacing the count of all the rows of table %s with its row count", counter.Name())
+		return plan.NewTableCount(gb.Child, gb.Schema()), transform.NewTree, nil
+	})
+}
+
+// isCountOfAllRows returns whether the expression given counts every row it aggregates, as COUNT(*) does once it's
+// been replaced with a count of a non-null literal.
+func isCountOfAllRows(e sql.Expression) bool {
+	if alias, ok := e.(*expression.Alias); ok {
+		e = alias.Child
+	}
+	count, ok := e.(*aggregation.Count)
+	if !ok {
+		return false
+	}
+	lit, ok := count.Child.(*expression.Literal)
+	return ok && lit.Value() != nil
+}
@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/dolthub/vitess/go/vt/sqlparser"
@@ -138,12 +139,21 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 
 	var affectedTables []string
 	var triggerEvent plan.TriggerEvent
+	// insertAfterEvents are the events of the AFTER triggers that an INSERT statement also activates: UPDATE triggers
+	// for the rows updated by ON DUPLICATE KEY UPDATE, and DELETE triggers for the rows deleted by REPLACE
+	var insertAfterEvents []plan.TriggerEvent
 	db := ctx.GetCurrentDatabase()
 	transform.Inspect(n, func(n sql.Node) bool {
 		switch n := n.(type) {
 		case *plan.InsertInto:
 			affectedTables = append(affectedTables, getTableName(n))
 			triggerEvent = plan.InsertTrigger
+			if len(n.OnDupExprs) > 0 {
+				insertAfterEvents = append(insertAfterEvents, plan.UpdateTrigger)
+			}
+			if n.IsReplace {
+				insertAfterEvents = append(insertAfterEvents, plan.DeleteTrigger)
+			}
 			if n.Database() != nil && n.Database().Name() != "" {
 				db = n.Database().Name()
 			}
@@ -195,7 +205,8 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 			}
 
 			triggerTable := getTableName(ct.Table)
-			if stringContains(affectedTables, triggerTable) && triggerEventsMatch(triggerEvent, ct.TriggerEvent) {
+			if stringContains(affectedTables, triggerTable) && (triggerEventsMatch(triggerEvent, ct.TriggerEvent) ||
+				(ct.TriggerTime == sqlparser.AfterStr && anyTriggerEventsMatch(insertAfterEvents, ct.TriggerEvent))) {
 				if block, ok := ct.Body.(*plan.BeginEndBlock); ok {
 					ct.Body = plan.NewTriggerBeginEndBlock(block)
 				}
@@ -208,6 +219,11 @@ func applyTriggers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel
 		return n, transform.SameTree, nil
 	}
 
+	// The UPDATE and DELETE triggers of an INSERT statement run before its INSERT triggers, like MySQL runs the AFTER
+	// DELETE triggers of a row deleted by REPLACE before the AFTER INSERT triggers of the row replacing it
+	sort.SliceStable(affectedTriggers, func(i, j int) bool {
+		return affectedTriggers[i].TriggerEvent != sqlparser.InsertStr && affectedTriggers[j].TriggerEvent == sqlparser.InsertStr
+	})
 	triggers := orderTriggersAndReverseAfter(affectedTriggers)
 	originalNode := n
 	same := transform.SameTree
@@ -249,14 +265,37 @@ func applyTrigger(ctx *sql.Context, a *Analyzer, originalNode, n sql.Node, scope
 
 		switch n := c.Node.(type) {
 		case *plan.InsertInto:
+			if trigger.TriggerEvent != sqlparser.InsertStr {
+				// AFTER UPDATE and AFTER DELETE triggers wrap the insert like AFTER INSERT triggers, and only run for
+				// the rows it updated or deleted
+				triggerEvent := plan.TriggerEvent(plan.UpdateTrigger)
+				if trigger.TriggerEvent == sqlparser.DeleteStr {
+					triggerEvent = plan.DeleteTrigger
+				}
+				ins := *n
+				ins.HasTriggers = true
+				return plan.NewTriggerExecutor(&ins, triggerLogic, triggerEvent, plan.TriggerTime(trigger.TriggerTime), sql.TriggerDefinition{
+					Name:            trigger.TriggerName,
+					CreateStatement: trigger.CreateTriggerString,
+				}), transform.NewTree, nil
+			}
 			if trigger.TriggerTime == sqlparser.BeforeStr {
 				triggerExecutor := plan.NewTriggerExecutor(n.Source, triggerLogic, plan.InsertTrigger, plan.TriggerTime(trigger.TriggerTime), sql.TriggerDefinition{
 					Name:            trigger.TriggerName,
 					CreateStatement: trigger.CreateTriggerString,
 				})
-				return n.WithSource(triggerExecutor), transform.NewTree, nil
+				// The trigger may change the columns the generated columns are computed from
+				source, err := wrapGeneratedColumns(ctx, a, triggerExecutor, n.Destination.Schema(), 0)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
+				ins := n.WithSource(source).(*plan.InsertInto)
+				ins.HasTriggers = true
+				return ins, transform.NewTree, nil
 			} else {
-				return plan.NewTriggerExecutor(n, triggerLogic, plan.InsertTrigger, plan.TriggerTime(trigger.TriggerTime), sql.TriggerDefinition{
+				ins := *n
+				ins.HasTriggers = true
+				return plan.NewTriggerExecutor(&ins, triggerLogic, plan.InsertTrigger, plan.TriggerTime(trigger.TriggerTime), sql.TriggerDefinition{
 					Name:            trigger.TriggerName,
 					CreateStatement: trigger.CreateTriggerString,
 				}), transform.NewTree, nil
@@ -267,7 +306,14 @@ func applyTrigger(ctx *sql.Context, a *Analyzer, originalNode, n sql.Node, scope
 					Name:            trigger.TriggerName,
 					CreateStatement: trigger.CreateTriggerString,
 				})
-				node, err := n.WithChildren(triggerExecutor)
+				// The rows updated are followed by their new values, whose generated columns are computed from the
+				// columns the trigger may change
+				sch := n.Child.Schema()
+				source, err := wrapGeneratedColumns(ctx, a, triggerExecutor, sch[len(sch)/2:], len(sch)/2)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
+				node, err := n.WithChildren(source)
 				return node, transform.NewTree, err
 			} else {
 				return plan.NewTriggerExecutor(n, triggerLogic, plan.UpdateTrigger, plan.TriggerTime(trigger.TriggerTime), sql.TriggerDefinition{
@@ -319,6 +365,9 @@ func getTriggerLogic(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, tr
 		return DefaultRuleSelector(id) && id != applyRowUpdateAccumulatorsId
 	}
 
+	// Trigger bodies always run with the privileges of the trigger's definer
+	ctx = definerSecurityContext(ctx, a, trigger.Definer, trigger.CreateTriggerString)
+
 	// For the reference to the row in the trigger table, we use the scope mechanism. This is a little strange because
 	// scopes for subqueries work with the child schemas of a scope node, but we don't have such a node here. Instead we
 	// fabricate one with the right properties (its child schema matches the table schema, with the right aliased name)
@@ -394,6 +443,16 @@ func triggerEventsMatch(event plan.TriggerEvent, event2 string) bool {
 	return strings.ToLower((string)(event)) == strings.ToLower(event2)
 }
 
+// anyTriggerEventsMatch returns whether any of the events given matches the event of a trigger given.
+func anyTriggerEventsMatch(events []plan.TriggerEvent, event2 string) bool {
+	for _, event := range events {
+		if triggerEventsMatch(event, event2) {
+			return true
+		}
+	}
+	return false
+}
+
 // wrapWritesWithRollback wraps the entire tree iff it contains a trigger, allowing rollback when a trigger errors
 func wrapWritesWithRollback(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	// Check if tree contains a TriggerExecutor
@@ -0,0 +1,423 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// updatableView is a view definition flattened onto the single base table it selects from, used to rewrite writes
+// against the view as writes against that table.
+type updatableView struct {
+	// table is the base table the view (and any view it's defined on) ultimately reads from
+	table *plan.UnresolvedTable
+	// columns are the names of the view's columns, in order
+	columns []string
+	// exprs are the view's column definitions in terms of the base table's columns, keyed by lowercase column name
+	exprs map[string]sql.Expression
+	// filter is the conjunction of the WHERE clauses of the view and the views it's defined on, or nil
+	filter sql.Expression
+	// checks are the predicates that rows written through the view must satisfy
+	checks sql.CheckConstraints
+}
+
+// resolveUpdatableViews rewrites INSERT, UPDATE and DELETE statements that target a view into statements against the
+// view's base table. Only single-table views without aggregation, DISTINCT, UNION or similar are updatable. Writes
+// through a view that specifies WITH CHECK OPTION get the view's WHERE clause added as a check on written rows. This
+// must run before resolveViews, which replaces view references with their definitions.
+func resolveUpdatableViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, sel RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	span, ctx := ctx.Span("resolve_updatable_views")
+	defer span.End()
+
+	return transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch n := n.(type) {
+		case *plan.InsertInto:
+			return rewriteInsertIntoView(ctx, a, n)
+		case *plan.Update:
+			return rewriteWriteThroughView(ctx, a, n, "UPDATE")
+		case *plan.DeleteFrom:
+			if n.HasExplicitTargets() {
+				return n, transform.SameTree, nil
+			}
+			return rewriteWriteThroughView(ctx, a, n, "DELETE")
+		default:
+			return n, transform.SameTree, nil
+		}
+	})
+}
+
+// rewriteInsertIntoView rewrites the INSERT given to insert directly into the base table of its destination view,
+// mapping the insert's column names onto base table columns.
+func rewriteInsertIntoView(ctx *sql.Context, a *Analyzer, ii *plan.InsertInto) (sql.Node, transform.TreeIdentity, error) {
+	urt, ok := ii.Destination.(*plan.UnresolvedTable)
+	if !ok {
+		return ii, transform.SameTree, nil
+	}
+
+	view, ok, err := loadUpdatableView(ctx, a, urt.Database(), urt.Name(), "INSERT")
+	if err != nil || !ok {
+		return ii, transform.SameTree, err
+	}
+
+	viewColumns := ii.ColumnNames
+	if len(viewColumns) == 0 {
+		viewColumns = view.columns
+	}
+	columns := make([]string, len(viewColumns))
+	for i, name := range viewColumns {
+		col, err := view.baseColumn(name)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		columns[i] = col
+	}
+
+	if err := view.checkSetFields(ii.OnDupExprs); err != nil {
+		return nil, transform.SameTree, err
+	}
+	onDupExprs := make([]sql.Expression, len(ii.OnDupExprs))
+	for i, e := range ii.OnDupExprs {
+		onDupExprs[i], _, err = view.rewriteColumnReferences(e, urt.Name())
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+	}
+
+	nn, err := ii.WithDatabase(sql.UnresolvedDatabase(view.table.Database()))
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	newInsert := nn.(*plan.InsertInto)
+	newInsert.Destination = view.table
+	newInsert.ColumnNames = columns
+	newInsert.OnDupExprs = onDupExprs
+	newInsert.Checks = append(newInsert.Checks, view.checks...)
+	return newInsert, transform.NewTree, nil
+}
+
+// rewriteWriteThroughView rewrites the UPDATE or DELETE given, replacing its single target view with the view's base
+// table filtered by the view's WHERE clause, and rewriting references to view columns in terms of base table columns.
+func rewriteWriteThroughView(ctx *sql.Context, a *Analyzer, n sql.Node, op string) (sql.Node, transform.TreeIdentity, error) {
+	var target *plan.UnresolvedTable
+	alias := ""
+	aliased := false
+	targetCount := 0
+	transform.Inspect(n, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.TableAlias:
+			if urt, ok := n.Child.(*plan.UnresolvedTable); ok {
+				target, alias, aliased = urt, n.Name(), true
+				targetCount++
+				return false
+			}
+		case *plan.UnresolvedTable:
+			target, alias = n, n.Name()
+			targetCount++
+		}
+		return true
+	})
+	if targetCount != 1 {
+		return n, transform.SameTree, nil
+	}
+
+	view, ok, err := loadUpdatableView(ctx, a, target.Database(), target.Name(), op)
+	if err != nil || !ok {
+		return n, transform.SameTree, err
+	}
+
+	transform.InspectExpressions(n, func(e sql.Expression) bool {
+		if err == nil {
+			err = view.checkSetFields([]sql.Expression{e})
+		}
+		return err == nil
+	})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+
+	// References to view columns are rewritten first, so that the base table columns they're rewritten to aren't
+	// confused with view columns of the same name
+	newNode, _, err := transform.NodeExprs(n, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		return view.rewriteColumnReferences(e, alias)
+	})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+
+	var baseTable sql.Node = plan.NewTableAlias(alias, view.table)
+	if view.filter != nil {
+		baseTable = plan.NewFilter(view.filter, baseTable)
+	}
+	newNode, _, err = transform.Node(newNode, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch n := n.(type) {
+		case *plan.TableAlias:
+			if urt, ok := n.Child.(*plan.UnresolvedTable); ok && urt == target {
+				return baseTable, transform.NewTree, nil
+			}
+		case *plan.UnresolvedTable:
+			if n == target && !aliased {
+				return baseTable, transform.NewTree, nil
+			}
+		}
+		return n, transform.SameTree, nil
+	})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+
+	if update, ok := newNode.(*plan.Update); ok && len(view.checks) > 0 {
+		update.Checks = append(update.Checks, view.checks...)
+	}
+	return newNode, transform.NewTree, nil
+}
+
+// loadUpdatableView loads the view with the name given and flattens it onto its base table, returning false if there
+// is no such view. Returns an error if the view exists but can't be written through.
+func loadUpdatableView(ctx *sql.Context, a *Analyzer, dbName, viewName, op string) (*updatableView, bool, error) {
+	if dbName == "" {
+		dbName = ctx.GetCurrentDatabase()
+	}
+	if dbName == "" {
+		return nil, false, nil
+	}
+
+	createViewStmt, ok, err := getViewCreateStatement(ctx, a, dbName, viewName)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	parsed, err := parse.Parse(ctx, createViewStmt)
+	if err != nil {
+		return nil, false, err
+	}
+	cv, ok := parsed.(*plan.CreateView)
+	if !ok || !plan.GetIsUpdatableFromCreateView(cv) {
+		return nil, false, sql.ErrViewNotUpdatable.New(viewName, op)
+	}
+
+	// Only a projection of a (possibly filtered) single table can be written through
+	node := cv.Definition.Child
+	var projections []sql.Expression
+	if project, ok := node.(*plan.Project); ok {
+		projections = project.Projections
+		node = project.Child
+	}
+	var filter sql.Expression
+	if f, ok := node.(*plan.Filter); ok {
+		filter = f.Expression
+		node = f.Child
+	}
+	if ta, ok := node.(*plan.TableAlias); ok {
+		node = ta.Child
+	}
+	urt, ok := node.(*plan.UnresolvedTable)
+	if !ok || urt.AsOf() != nil {
+		return nil, false, sql.ErrViewNotUpdatable.New(viewName, op)
+	}
+
+	baseDbName := urt.Database()
+	if baseDbName == "" {
+		baseDbName = dbName
+	}
+
+	// The base of this view may itself be a view, in which case this view's columns are defined in terms of it
+	inner, isView, err := loadUpdatableView(ctx, a, baseDbName, urt.Name(), op)
+	if err != nil {
+		return nil, false, err
+	}
+	if !isView {
+		table, _, err := a.Catalog.Table(ctx, baseDbName, urt.Name())
+		if err != nil {
+			return nil, false, err
+		}
+		inner = &updatableView{
+			table: plan.NewUnresolvedTable(urt.Name(), baseDbName),
+			exprs: make(map[string]sql.Expression),
+		}
+		for _, col := range table.Schema() {
+			inner.columns = append(inner.columns, col.Name)
+			inner.exprs[strings.ToLower(col.Name)] = expression.NewUnresolvedColumn(col.Name)
+		}
+	}
+
+	view := &updatableView{
+		table: inner.table,
+		exprs: make(map[string]sql.Expression),
+	}
+	if projections == nil {
+		projections = []sql.Expression{expression.NewStar()}
+	}
+	for _, p := range projections {
+		switch p := p.(type) {
+		case *expression.Star:
+			for _, name := range inner.columns {
+				view.addColumn(name, inner.exprs[strings.ToLower(name)])
+			}
+		case *expression.Alias:
+			expr, err := inner.rewriteExpression(p.Child)
+			if err != nil {
+				return nil, false, err
+			}
+			view.addColumn(p.Name(), expr)
+		case *expression.UnresolvedColumn:
+			expr, err := inner.rewriteExpression(p)
+			if err != nil {
+				return nil, false, err
+			}
+			view.addColumn(p.Name(), expr)
+		default:
+			expr, err := inner.rewriteExpression(p)
+			if err != nil {
+				return nil, false, err
+			}
+			view.addColumn(p.String(), expr)
+		}
+	}
+
+	var filters []sql.Expression
+	if filter != nil {
+		filter, err = inner.rewriteExpression(filter)
+		if err != nil {
+			return nil, false, err
+		}
+		filters = append(filters, filter)
+	}
+	if inner.filter != nil {
+		filters = append(filters, inner.filter)
+	}
+	view.filter = expression.JoinAnd(filters...)
+
+	// LOCAL only checks this view's WHERE clause, deferring to the underlying views' own check options. CASCADED
+	// also checks the WHERE clauses of all underlying views.
+	checkName := fmt.Sprintf("%s.%s", dbName, viewName)
+	switch cv.CheckOpt {
+	case plan.ViewCheckOptionCascaded:
+		if view.filter != nil {
+			view.checks = sql.CheckConstraints{{Name: checkName, Expr: view.filter, Enforced: true, IsViewCheckOption: true}}
+		}
+	case plan.ViewCheckOptionLocal:
+		if filter != nil {
+			view.checks = sql.CheckConstraints{{Name: checkName, Expr: filter, Enforced: true, IsViewCheckOption: true}}
+		}
+		view.checks = append(view.checks, inner.checks...)
+	default:
+		view.checks = inner.checks
+	}
+
+	return view, true, nil
+}
+
+// getViewCreateStatement returns the CREATE VIEW statement of the view named, or false if there is no such view.
+func getViewCreateStatement(ctx *sql.Context, a *Analyzer, dbName, viewName string) (string, bool, error) {
+	db, err := a.Catalog.Database(ctx, dbName)
+	if err != nil {
+		if sql.ErrDatabaseNotFound.Is(err) || sql.ErrDatabaseAccessDeniedForUser.Is(err) || sql.ErrTableAccessDeniedForUser.Is(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	if privilegedDatabase, ok := db.(mysql_db.PrivilegedDatabase); ok {
+		db = privilegedDatabase.Unwrap()
+	}
+	if vdb, ok := db.(sql.ViewDatabase); ok {
+		viewDef, ok, err := vdb.GetViewDefinition(ctx, viewName)
+		if err != nil || ok {
+			return viewDef.CreateViewStatement, ok, err
+		}
+	}
+
+	if view, ok := ctx.GetViewRegistry().View(dbName, viewName); ok {
+		return view.CreateStatement(), true, nil
+	}
+	return "", false, nil
+}
+
+func (v *updatableView) addColumn(name string, expr sql.Expression) {
+	v.columns = append(v.columns, name)
+	v.exprs[strings.ToLower(name)] = expr
+}
+
+// baseColumn returns the name of the base table column that the view column given refers to, or an error if the view
+// column isn't a plain column reference.
+func (v *updatableView) baseColumn(name string) (string, error) {
+	expr, ok := v.exprs[strings.ToLower(name)]
+	if !ok {
+		return "", plan.ErrInsertIntoNonexistentColumn.New(name)
+	}
+	uc, ok := expr.(*expression.UnresolvedColumn)
+	if !ok {
+		return "", sql.ErrViewColumnNotUpdatable.New(name)
+	}
+	return uc.Name(), nil
+}
+
+// rewriteExpression returns the expression given, which refers to columns of this view, rewritten in terms of the
+// columns of the base table.
+func (v *updatableView) rewriteExpression(e sql.Expression) (sql.Expression, error) {
+	newExpr, _, err := transform.Expr(e, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		uc, ok := e.(*expression.UnresolvedColumn)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		expr, ok := v.exprs[strings.ToLower(uc.Name())]
+		if !ok {
+			return nil, transform.SameTree, sql.ErrColumnNotFound.New(uc.Name())
+		}
+		return expr, transform.NewTree, nil
+	})
+	return newExpr, err
+}
+
+// rewriteColumnReferences rewrites references to this view's columns in the expression given, either unqualified or
+// qualified by the name the view is referenced by, in terms of the columns of the base table. Other column references
+// are left alone.
+func (v *updatableView) rewriteColumnReferences(e sql.Expression, alias string) (sql.Expression, transform.TreeIdentity, error) {
+	return transform.Expr(e, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		uc, ok := e.(*expression.UnresolvedColumn)
+		if !ok || (uc.Table() != "" && !strings.EqualFold(uc.Table(), alias)) {
+			return e, transform.SameTree, nil
+		}
+		if expr, ok := v.exprs[strings.ToLower(uc.Name())]; ok {
+			return expr, transform.NewTree, nil
+		}
+		return e, transform.SameTree, nil
+	})
+}
+
+// checkSetFields returns an error if any of the assignments given targets a view column that isn't a plain reference
+// to a base table column.
+func (v *updatableView) checkSetFields(exprs []sql.Expression) error {
+	for _, e := range exprs {
+		sf, ok := e.(*expression.SetField)
+		if !ok {
+			continue
+		}
+		if uc, ok := sf.Left.(*expression.UnresolvedColumn); ok {
+			if _, err := v.baseColumn(uc.Name()); err != nil && !plan.ErrInsertIntoNonexistentColumn.Is(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
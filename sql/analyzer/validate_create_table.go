@@ -34,6 +34,20 @@ func validateCreateTable(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 		return n, transform.SameTree, nil
 	}
 
+	var indexNames []string
+	for _, idxDef := range ct.TableSpec().IdxDefs {
+		indexNames = append(indexNames, idxDef.IndexName)
+	}
+	for _, idxDef := range ct.TableSpec().IdxDefs {
+		idxDef.IndexName = nameFunctionalIndex(idxDef.IndexName, idxDef.Columns, indexNames)
+		indexNames = append(indexNames, idxDef.IndexName)
+		columns, err := resolveFunctionalKeyParts(ctx, a, idxDef.IndexName, idxDef.Constraint, idxDef.Columns, ct.CreateSchema.Schema)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		idxDef.Columns = columns
+	}
+
 	err := validateIndexes(ctx, ct.TableSpec())
 	if err != nil {
 		return nil, transform.SameTree, err
@@ -53,6 +67,11 @@ func validateCreateTable(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 		return nil, transform.SameTree, err
 	}
 
+	err = validateGeneratedColumns(ctx, a, ct.CreateSchema.Schema)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+
 	return n, transform.SameTree, nil
 }
 
@@ -115,6 +134,12 @@ func resolveAlterColumn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 			if err != nil {
 				return nil, transform.SameTree, err
 			}
+			if !strings.EqualFold(nn.Column(), nn.NewColumn().Name) {
+				err = validateColumnNotUsedInFunctionalIndexes(ctx, nn.Table, nn.Column())
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
+			}
 			return n, transform.NewTree, nil
 		case *plan.RenameColumn:
 			n, err := nn.WithTargetSchema(sch.Copy())
@@ -125,6 +150,10 @@ func resolveAlterColumn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 			if err != nil {
 				return nil, transform.SameTree, err
 			}
+			err = validateColumnNotUsedInFunctionalIndexes(ctx, nn.Table, nn.ColumnName)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
 			return n, transform.NewTree, nil
 		case *plan.AddColumn:
 			// TODO: can't `alter table add column j int unique auto_increment` as it ignores unique
@@ -147,13 +176,25 @@ func resolveAlterColumn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 			if err != nil {
 				return nil, transform.SameTree, err
 			}
+			err = validateColumnNotUsedInFunctionalIndexes(ctx, nn.Table, nn.Column)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
 			return n, transform.NewTree, nil
 		case *plan.AlterIndex:
-			indexes, err = validateAlterIndex(ctx, initialSch, sch, n.(*plan.AlterIndex), indexes)
+			ai := *nn
+			if ai.Action == plan.IndexAction_Create {
+				ai.IndexName = nameFunctionalIndex(ai.IndexName, ai.Columns, indexes)
+			}
+			ai.Columns, err = resolveFunctionalKeyParts(ctx, a, ai.IndexName, ai.Constraint, ai.Columns, sch)
 			if err != nil {
 				return nil, transform.SameTree, err
 			}
-			return n, transform.NewTree, nil
+			indexes, err = validateAlterIndex(ctx, initialSch, sch, &ai, indexes)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			return &ai, transform.NewTree, nil
 		case *plan.AlterPK:
 			n, err := nn.WithTargetSchema(sch.Copy())
 			if err != nil {
@@ -215,6 +256,11 @@ func validateRenameColumn(initialSch, sch sql.Schema, rc *plan.RenameColumn) (sq
 		return nil, err
 	}
 
+	err = validateColumnNotUsedInGeneratedColumns(rc.ColumnName, sch)
+	if err != nil {
+		return nil, err
+	}
+
 	return renameInSchema(sch, rc.ColumnName, rc.NewColumnName, nameable.Name()), nil
 }
 
@@ -227,6 +273,11 @@ func validateAddColumn(initialSch sql.Schema, schema sql.Schema, ac *plan.AddCol
 		return nil, sql.ErrColumnExists.New(ac.Column().Name)
 	}
 
+	// The values of generated columns would have to be computed for the existing rows
+	if ac.Column().Generated != nil {
+		return nil, sql.ErrUnsupportedFeature.New("adding generated columns")
+	}
+
 	// Make sure columns named in After clause exist
 	idx := -1
 	if ac.Order() != nil && ac.Order().AfterColumn != "" {
@@ -267,6 +318,16 @@ func validateModifyColumn(ctx *sql.Context, initialSch sql.Schema, schema sql.Sc
 		return nil, sql.ErrTableColumnNotFound.New(nameable.Name(), mc.Column())
 	}
 
+	if mc.NewColumn().Generated != nil {
+		return nil, sql.ErrUnsupportedFeature.New("modifying generated columns")
+	}
+	if !strings.EqualFold(mc.Column(), mc.NewColumn().Name) {
+		err := validateColumnNotUsedInGeneratedColumns(mc.Column(), schema)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	newSch := replaceInSchema(schema, mc.NewColumn(), nameable.Name())
 
 	err := validateAutoIncrement(newSch, keyedColumns)
@@ -294,7 +355,7 @@ func validateModifyColumn(ctx *sql.Context, initialSch sql.Schema, schema sql.Sc
 		prefixLengths := index.PrefixLengths()
 		for i, expr := range index.Expressions() {
 			col := plan.GetColumnFromIndexExpr(expr, getTable(table))
-			if col.Name == mc.Column() {
+			if col != nil && col.Name == mc.Column() {
 				if len(prefixLengths) == 0 || prefixLengths[i] == 0 {
 					return nil, sql.ErrInvalidBlobTextKey.New(col.Name)
 				}
@@ -486,22 +547,60 @@ func validatePrefixLength(schCol *sql.Column, idxCol sql.IndexColumn) error {
 	return nil
 }
 
+// validateKeyLength returns an error if the string key parts of an index are longer than the maximum key length
+// altogether. A key part of a whole string column is as long as the longest value of the column.
+func validateKeyLength(schCols []*sql.Column, idxCols []sql.IndexColumn) error {
+	var keyLength int64
+	for i, idxCol := range idxCols {
+		st, ok := schCols[i].Type.(sql.StringType)
+		if !ok {
+			continue
+		}
+		if idxCol.Length == 0 {
+			keyLength += st.MaxByteLength()
+		} else if types.IsTextOnly(st) {
+			keyLength += idxCol.Length * st.CharacterSet().MaxLength()
+		} else {
+			keyLength += idxCol.Length
+		}
+	}
+	if keyLength > MaxBytePrefix {
+		return sql.ErrKeyTooLong.New()
+	}
+	return nil
+}
+
 // validateIndexType prevents creating invalid indexes
 func validateIndexType(cols []sql.IndexColumn, sch sql.Schema) error {
-	for _, idxCol := range cols {
+	schCols := make([]*sql.Column, len(cols))
+	for i, idxCol := range cols {
+		if idxCol.Expression != nil {
+			schCols[i] = functionalKeyPartColumn(idxCol)
+			continue
+		}
 		schCol := sch[sch.IndexOfColName(idxCol.Name)]
 		err := validatePrefixLength(schCol, idxCol)
 		if err != nil {
 			return err
 		}
+		schCols[i] = schCol
 	}
-	return nil
+	return validateKeyLength(schCols, cols)
+}
+
+// functionalKeyPartColumn returns the hidden column of the functional key part given, whose values are those of its
+// expression.
+func functionalKeyPartColumn(idxCol sql.IndexColumn) *sql.Column {
+	return &sql.Column{Name: idxCol.Name, Type: idxCol.Expression.Type(), Nullable: true}
 }
 
 // missingIdxColumn takes in a set of IndexColumns and returns false, along with the offending column name, if
 // an index Column is not in an index.
 func missingIdxColumn(cols []sql.IndexColumn, sch sql.Schema, tableName string) (string, bool) {
 	for _, c := range cols {
+		if c.Expression != nil {
+			continue
+		}
 		if ok := sch.Contains(c.Name, tableName); !ok {
 			return c.Name, false
 		}
@@ -605,7 +704,12 @@ func validateIndexes(ctx *sql.Context, tableSpec *plan.TableSpec) error {
 		if idx.Constraint == sql.IndexConstraint_Primary {
 			hasPkIndexDef = true
 		}
-		for _, idxCol := range idx.Columns {
+		schCols := make([]*sql.Column, len(idx.Columns))
+		for i, idxCol := range idx.Columns {
+			if idxCol.Expression != nil {
+				schCols[i] = functionalKeyPartColumn(idxCol)
+				continue
+			}
 			schCol, ok := lwrNames[strings.ToLower(idxCol.Name)]
 			if !ok {
 				return sql.ErrUnknownIndexColumn.New(idxCol.Name, idx.IndexName)
@@ -614,6 +718,12 @@ func validateIndexes(ctx *sql.Context, tableSpec *plan.TableSpec) error {
 			if err != nil {
 				return err
 			}
+			schCols[i] = schCol
+		}
+		if idx.Constraint != sql.IndexConstraint_Fulltext {
+			if err := validateKeyLength(schCols, idx.Columns); err != nil {
+				return err
+			}
 		}
 		if idx.Constraint == sql.IndexConstraint_Spatial {
 			if len(idx.Columns) != 1 {
@@ -636,10 +746,19 @@ func validateIndexes(ctx *sql.Context, tableSpec *plan.TableSpec) error {
 	// if there was not a PkIndexDef, then any primary key text/blob columns must not have index lengths
 	// otherwise, then it would've been validated before this
 	if !hasPkIndexDef {
+		var pkCols []*sql.Column
+		var pkIdxCols []sql.IndexColumn
 		for _, col := range tableSpec.Schema.Schema {
 			if col.PrimaryKey && types.IsTextBlob(col.Type) {
 				return sql.ErrInvalidBlobTextKey.New(col.Name)
 			}
+			if col.PrimaryKey {
+				pkCols = append(pkCols, col)
+				pkIdxCols = append(pkIdxCols, sql.IndexColumn{Name: col.Name})
+			}
+		}
+		if err := validateKeyLength(pkCols, pkIdxCols); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -696,12 +815,17 @@ func validatePrimaryKey(initialSch, sch sql.Schema, ai *plan.AlterPK) (sql.Schem
 			return nil, sql.ErrMultiplePrimaryKeysDefined.New()
 		}
 
-		for _, idxCol := range ai.Columns {
+		schCols := make([]*sql.Column, len(ai.Columns))
+		for i, idxCol := range ai.Columns {
 			schCol := sch[sch.IndexOf(idxCol.Name, tableName)]
 			err := validatePrefixLength(schCol, idxCol)
 			if err != nil {
 				return nil, err
 			}
+			schCols[i] = schCol
+		}
+		if err := validateKeyLength(schCols, ai.Columns); err != nil {
+			return nil, err
 		}
 
 		// Set the primary keys
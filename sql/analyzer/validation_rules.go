@@ -330,8 +330,8 @@ func validateGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, se
 
 		switch parent.(type) {
 		case *plan.Having, *plan.Project, *plan.Sort:
-			// TODO: these shouldn't be skipped; you can group by primary key without problem b/c only one value
-			// https://dev.mysql.com/doc/refman/8.0/en/group-by-handling.html#:~:text=The%20query%20is%20valid%20if%20name%20is%20a%20primary%20key
+			// TODO: these shouldn't be skipped, but the selected expressions of these group bys include the columns that
+			//  their parents need, which aren't always selected in the query.
 			return true
 		}
 
@@ -342,13 +342,25 @@ func validateGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, se
 		}
 
 		var groupBys []string
+		var groupByColumns []columnRef
 		for _, expr := range gb.GroupByExprs {
 			groupBys = append(groupBys, expr.String())
+			if ref, ok := columnRefOf(expr); ok {
+				groupByColumns = append(groupByColumns, ref)
+			}
+		}
+
+		// Columns that are functionally dependent on the grouping columns, such as the columns of a table grouped by its
+		// primary key, have a single value in each group.
+		var determined map[columnRef]bool
+		determined, err = determinedColumns(ctx, gb.Child, groupByColumns)
+		if err != nil {
+			return false
 		}
 
 		for _, expr := range gb.SelectedExprs {
 			if _, ok := expr.(sql.Aggregation); !ok {
-				if !expressionReferencesOnlyGroupBys(groupBys, expr) {
+				if !expressionReferencesOnlyGroupBys(groupBys, determined, expr) {
 					err = ErrValidationGroupBy.New(expr.String())
 					return false
 				}
@@ -360,7 +372,7 @@ func validateGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, se
 	return n, transform.SameTree, err
 }
 
-func expressionReferencesOnlyGroupBys(groupBys []string, expr sql.Expression) bool {
+func expressionReferencesOnlyGroupBys(groupBys []string, determined map[columnRef]bool, expr sql.Expression) bool {
 	valid := true
 	sql.Inspect(expr, func(expr sql.Expression) bool {
 		switch expr := expr.(type) {
@@ -372,8 +384,14 @@ func expressionReferencesOnlyGroupBys(groupBys []string, expr sql.Expression) bo
 			}
 			return true
 		// cc: https://dev.mysql.com/doc/refman/8.0/en/group-by-handling.html
-		// Each part of the SelectExpr must refer to the aggregated columns in some way
-		// TODO: this isn't complete, it's overly restrictive. Dependant columns are fine to reference.
+		// Each part of the SelectExpr must refer to the aggregated columns in some way, or to columns that are
+		// functionally dependent on them.
+		case *expression.GetField:
+			if ref, _ := columnRefOf(expr); determined[ref] || stringContains(groupBys, expr.String()) {
+				return false
+			}
+			valid = false
+			return false
 		default:
 			if stringContains(groupBys, expr.String()) {
 				return true
@@ -147,6 +147,79 @@ func TestValidateGroupByErr(t *testing.T) {
 	require.Error(err)
 }
 
+func TestValidateGroupByFunctionalDependencies(t *testing.T) {
+	vr := getValidationRule(validateGroupById)
+	err := sql.SystemVariables.SetGlobal("sql_mode", "STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION,ONLY_FULL_GROUP_BY")
+	require.NoError(t, err)
+
+	table := memory.NewTable("members", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "id", Type: types.Int64, Source: "members", PrimaryKey: true},
+		{Name: "team", Type: types.Text, Source: "members", Nullable: true},
+		{Name: "name", Type: types.Text, Source: "members", Nullable: true},
+	}), nil)
+	rt := plan.NewResolvedTable(table, nil, nil)
+
+	id := expression.NewGetFieldWithTable(0, types.Int64, "members", "id", false)
+	team := expression.NewGetFieldWithTable(1, types.Text, "members", "team", true)
+	name := expression.NewGetFieldWithTable(2, types.Text, "members", "name", true)
+
+	testCases := []struct {
+		name     string
+		selected []sql.Expression
+		grouping []sql.Expression
+		child    sql.Node
+		ok       bool
+	}{
+		{
+			name:     "columns determined by the primary key",
+			selected: []sql.Expression{name, team},
+			grouping: []sql.Expression{id},
+			child:    rt,
+			ok:       true,
+		},
+		{
+			name:     "column not determined by the grouping column",
+			selected: []sql.Expression{name},
+			grouping: []sql.Expression{team},
+			child:    rt,
+			ok:       false,
+		},
+		{
+			name:     "column equal to the grouping column",
+			selected: []sql.Expression{name},
+			grouping: []sql.Expression{team},
+			child:    plan.NewFilter(expression.NewEquals(name, team), rt),
+			ok:       true,
+		},
+		{
+			name:     "column equal to a literal",
+			selected: []sql.Expression{name},
+			grouping: []sql.Expression{team},
+			child:    plan.NewFilter(expression.NewEquals(name, expression.NewLiteral("a", types.Text)), rt),
+			ok:       true,
+		},
+		{
+			name:     "columns determined by the primary key through an alias",
+			selected: []sql.Expression{name},
+			grouping: []sql.Expression{expression.NewGetField(0, types.Int64, "x", false)},
+			child:    plan.NewProject([]sql.Expression{expression.NewAlias("x", id), name}, rt),
+			ok:       true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			gb := plan.NewGroupBy(tt.selected, tt.grouping, tt.child)
+			_, _, err := vr.Apply(sql.NewEmptyContext(), nil, gb, nil, DefaultRuleSelector)
+			if tt.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateSchemaSource(t *testing.T) {
 	testCases := []struct {
 		name string
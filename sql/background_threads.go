@@ -17,6 +17,7 @@ package sql
 import (
 	"context"
 	"errors"
+	"sort"
 	"sync"
 )
 
@@ -29,6 +30,8 @@ type BackgroundThreads struct {
 	parentCancel context.CancelFunc
 	nameToCancel map[string]context.CancelFunc
 	nameToCtx    map[string]context.Context
+	// running holds whether each thread is running, until it returns
+	running map[string]bool
 }
 
 func NewBackgroundThreads() *BackgroundThreads {
@@ -40,6 +43,7 @@ func NewBackgroundThreads() *BackgroundThreads {
 		mu:           &sync.Mutex{},
 		nameToCancel: make(map[string]context.CancelFunc),
 		nameToCtx:    make(map[string]context.Context),
+		running:      make(map[string]bool),
 	}
 }
 
@@ -60,16 +64,36 @@ func (bt *BackgroundThreads) Add(name string, f func(ctx context.Context)) error
 
 	bt.nameToCancel[name] = threadCancel
 	bt.nameToCtx[name] = threadCtx
+	bt.running[name] = true
 	bt.wg.Add(1)
 
 	go func() {
 		defer bt.wg.Done()
+		defer func() {
+			bt.mu.Lock()
+			defer bt.mu.Unlock()
+			bt.running[name] = false
+		}()
 		f(threadCtx)
 	}()
 
 	return nil
 }
 
+// Threads returns the state of the threads added, in the order of their names.
+func (bt *BackgroundThreads) Threads() []BackgroundThreadStatus {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+	threads := make([]BackgroundThreadStatus, 0, len(bt.running))
+	for name, running := range bt.running {
+		threads = append(threads, BackgroundThreadStatus{Name: name, Running: running})
+	}
+	sort.Slice(threads, func(i, j int) bool {
+		return threads[i].Name < threads[j].Name
+	})
+	return threads
+}
+
 // Shutdown cancels the parent context for every async thread,
 // and waits for each goroutine to drain and return before exiting.
 func (bt *BackgroundThreads) Shutdown() error {
@@ -35,6 +35,13 @@ type Catalog interface {
 	// RemoveDatabase removes the  database named, or returns an error if the operation isn't supported or fails.
 	RemoveDatabase(ctx *Context, dbName string) error
 
+	// RenameDatabase renames the database named, or returns an error if the operation isn't supported or fails.
+	RenameDatabase(ctx *Context, oldName, newName string) error
+
+	// Flush passes the FLUSH statement with the option and tables given along to the database provider, if it's a
+	// FlushHandler.
+	Flush(ctx *Context, option FlushOption, tables []DbTable) error
+
 	// Table returns the table with the name given in the db with the name given
 	Table(ctx *Context, dbName, tableName string) (Table, Database, error)
 
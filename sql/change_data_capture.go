@@ -0,0 +1,402 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ChangeFeedService is the key of the feed of the committed changes of the tables of an engine in its service
+// registry. Changes are only captured while a ChangeFeed is registered with this key.
+var ChangeFeedService = NewServiceKey[*ChangeFeed]("change data capture")
+
+// ChangeType is the type of the change to a row reported by a ChangeEvent.
+type ChangeType byte
+
+const (
+	// ChangeInsert is the insertion of a row. Its event only has an after image.
+	ChangeInsert ChangeType = iota
+	// ChangeUpdate is the update of a row. Its event has both a before and an after image.
+	ChangeUpdate
+	// ChangeDelete is the deletion of a row, including by the truncation of its table. Its event only has a before
+	// image.
+	ChangeDelete
+)
+
+func (t ChangeType) String() string {
+	switch t {
+	case ChangeInsert:
+		return "insert"
+	case ChangeUpdate:
+		return "update"
+	case ChangeDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// ChangeEvent is a committed change to a row of a table.
+type ChangeEvent struct {
+	Database string
+	Table    string
+	Type     ChangeType
+	// Before is the row before the change, or nil for inserts
+	Before Row
+	// After is the row after the change, or nil for deletes
+	After Row
+	// Sequence is the number of the transaction that committed the change, which increases with every transaction
+	// committing changes to the tables of the feed, and is shared by all the changes of a transaction
+	Sequence uint64
+	// CommitTime is the time the transaction that committed the change was committed
+	CommitTime time.Time
+}
+
+// ChangeCallback is called with a committed change of a table by the subscriptions of a ChangeFeed registered with
+// OnChange. Its error is logged, since the change is committed already.
+type ChangeCallback func(ctx *Context, event ChangeEvent) error
+
+// ChangeFeed captures the changes to the rows of the tables its subscriptions are interested in, and delivers them to
+// the subscriptions once the transaction that made them is committed. Changes of transactions that are rolled back
+// are discarded. The changes of a transaction are delivered together and in order, after the changes of the
+// transactions committed before it.
+//
+// The changes of a table are only captured while there's a subscription to them, and only if the table is a
+// ChangeNotifyingTable. Changes are captured from the table given when subscribing, so a table that's dropped and
+// created again must be subscribed to again.
+type ChangeFeed struct {
+	mu            sync.Mutex
+	subscriptions map[*ChangeSubscription]struct{}
+	tables        map[DbTable]*capturedTable
+	// pending are the changes captured in the current transaction of each session, by session ID
+	pending map[uint32][]ChangeEvent
+
+	// publishMu serializes the delivery of the changes of transactions
+	publishMu sync.Mutex
+	sequence  uint64
+}
+
+// NewChangeFeed returns a new change feed without subscriptions.
+func NewChangeFeed() *ChangeFeed {
+	return &ChangeFeed{
+		subscriptions: make(map[*ChangeSubscription]struct{}),
+		tables:        make(map[DbTable]*capturedTable),
+		pending:       make(map[uint32][]ChangeEvent),
+	}
+}
+
+// CapturedTable is a table whose changes are captured for a subscription.
+type CapturedTable struct {
+	Database string
+	Table    ChangeNotifyingTable
+}
+
+// capturedTable listens to the changes of a table with subscriptions, and adds them to the changes of the current
+// transaction of the session making them.
+type capturedTable struct {
+	feed          *ChangeFeed
+	database      string
+	table         ChangeNotifyingTable
+	subscriptions int
+}
+
+var _ TableChangeListener = (*capturedTable)(nil)
+
+// RowsChanged implements the TableChangeListener interface.
+func (c *capturedTable) RowsChanged(ctx *Context, table Table, changes []RowChange) {
+	// Like the binary log, the feed doesn't capture the changes of sessions that turned off sql_log_bin
+	if logBin, err := ctx.GetSessionVariable(ctx, "sql_log_bin"); err == nil && logBin == int8(0) {
+		return
+	}
+
+	events := make([]ChangeEvent, len(changes))
+	for i, change := range changes {
+		event := ChangeEvent{Database: c.database, Table: table.Name()}
+		switch {
+		case change.Old == nil:
+			event.Type = ChangeInsert
+		case change.New == nil:
+			event.Type = ChangeDelete
+		default:
+			event.Type = ChangeUpdate
+		}
+		if change.Old != nil {
+			event.Before = change.Old.Copy()
+		}
+		if change.New != nil {
+			event.After = change.New.Copy()
+		}
+		events[i] = event
+	}
+
+	c.feed.mu.Lock()
+	defer c.feed.mu.Unlock()
+	c.feed.pending[ctx.ID()] = append(c.feed.pending[ctx.ID()], events...)
+}
+
+// Subscribe returns a new subscription to the changes of the tables given, which buffers the number of changes given
+// until they're read. Once its buffer is full, transactions committing changes to the tables wait for the
+// subscription to read them.
+func (f *ChangeFeed) Subscribe(bufferSize int, tables ...CapturedTable) *ChangeSubscription {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	return f.subscribe(&ChangeSubscription{events: make(chan ChangeEvent, bufferSize)}, tables)
+}
+
+// OnChange returns a new subscription to the changes of the tables given, which calls the callback given with each of
+// them, until it's closed. The callback is called once the transaction making the changes is committed, on the
+// goroutine committing it, which waits for the callback to return. Since the changes of transactions are delivered
+// one transaction at a time, the callback must not commit changes to the tables of the feed itself.
+func (f *ChangeFeed) OnChange(callback ChangeCallback, tables ...CapturedTable) *ChangeSubscription {
+	return f.subscribe(&ChangeSubscription{callback: callback}, tables)
+}
+
+// subscribe adds the subscription given to the changes of the tables given, capturing the changes of the tables that
+// had no subscription, and returns it.
+func (f *ChangeFeed) subscribe(s *ChangeSubscription, tables []CapturedTable) *ChangeSubscription {
+	s.feed = f
+	s.tables = make(map[DbTable]struct{}, len(tables))
+	s.done = make(chan struct{})
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, t := range tables {
+		key := NewDbTable(t.Database, t.Table.Name())
+		if _, ok := s.tables[key]; ok {
+			continue
+		}
+		s.tables[key] = struct{}{}
+		captured, ok := f.tables[key]
+		if !ok || captured.table != t.Table {
+			if ok {
+				captured.table.RemoveChangeListener(captured)
+			}
+			captured = &capturedTable{feed: f, database: t.Database, table: t.Table, subscriptions: captured.subscriptionCount()}
+			f.tables[key] = captured
+			t.Table.AddChangeListener(captured)
+		}
+		captured.subscriptions++
+	}
+	f.subscriptions[s] = struct{}{}
+	return s
+}
+
+func (c *capturedTable) subscriptionCount() int {
+	if c == nil {
+		return 0
+	}
+	return c.subscriptions
+}
+
+// unsubscribe removes the subscription given, and stops capturing the changes of the tables no other subscription is
+// interested in.
+func (f *ChangeFeed) unsubscribe(s *ChangeSubscription) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.subscriptions[s]; !ok {
+		return
+	}
+	delete(f.subscriptions, s)
+	for key := range s.tables {
+		captured, ok := f.tables[key]
+		if !ok {
+			continue
+		}
+		captured.subscriptions--
+		if captured.subscriptions <= 0 {
+			captured.table.RemoveChangeListener(captured)
+			delete(f.tables, key)
+		}
+	}
+}
+
+// take removes the changes captured in the current transaction of the session given, and returns them.
+func (f *ChangeFeed) take(sessionID uint32) []ChangeEvent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	events := f.pending[sessionID]
+	delete(f.pending, sessionID)
+	return events
+}
+
+// publish delivers the changes of a committed transaction to the subscriptions interested in them, waiting for the
+// subscriptions whose buffer is full to read them. If the context given is canceled while waiting, the subscription
+// waited for misses the rest of the changes, and its next read returns ErrChangeSubscriptionLagged.
+func (f *ChangeFeed) publish(ctx *Context, events []ChangeEvent) {
+	if len(events) == 0 {
+		return
+	}
+	f.publishMu.Lock()
+	defer f.publishMu.Unlock()
+
+	f.sequence++
+	commitTime := time.Now()
+	f.mu.Lock()
+	subscriptions := make([]*ChangeSubscription, 0, len(f.subscriptions))
+	for s := range f.subscriptions {
+		subscriptions = append(subscriptions, s)
+	}
+	f.mu.Unlock()
+
+	for _, s := range subscriptions {
+		for _, event := range events {
+			if _, ok := s.tables[NewDbTable(event.Database, event.Table)]; !ok {
+				continue
+			}
+			event.Sequence = f.sequence
+			event.CommitTime = commitTime
+			if !s.deliver(ctx, event) {
+				break
+			}
+		}
+	}
+}
+
+// EndSession discards the changes captured in the transaction of the session with the ID given, which has ended.
+func (f *ChangeFeed) EndSession(sessionID uint32) {
+	f.take(sessionID)
+}
+
+// PublishCapturedChanges delivers the changes captured in the transaction of the session of the context given, which
+// has just been committed, to the subscriptions of the change feed of the context, if there's one.
+func PublishCapturedChanges(ctx *Context) {
+	if feed, ok := GetService(ctx, ChangeFeedService); ok {
+		feed.publish(ctx, feed.take(ctx.ID()))
+	}
+}
+
+// DiscardCapturedChanges discards the changes captured in the transaction of the session of the context given, which
+// has just been rolled back.
+func DiscardCapturedChanges(ctx *Context) {
+	if feed, ok := GetService(ctx, ChangeFeedService); ok {
+		feed.take(ctx.ID())
+	}
+}
+
+// TakeCapturedChanges removes the changes captured in the transaction of the session of the context given and returns
+// them, so that they're published with PublishChanges once the transaction is committed by another session.
+func TakeCapturedChanges(ctx *Context) []ChangeEvent {
+	if feed, ok := GetService(ctx, ChangeFeedService); ok {
+		return feed.take(ctx.ID())
+	}
+	return nil
+}
+
+// PublishChanges delivers the changes given, taken from a transaction that has just been committed with
+// TakeCapturedChanges, to the subscriptions of the change feed of the context given, if there's one.
+func PublishChanges(ctx *Context, events []ChangeEvent) {
+	if feed, ok := GetService(ctx, ChangeFeedService); ok {
+		feed.publish(ctx, events)
+	}
+}
+
+// ChangeSubscription is a subscription to the committed changes of some tables, returned by ChangeFeed.Subscribe. Its
+// changes are read with Next, and it must be closed with Close once it's not read anymore, since transactions
+// committing changes to its tables wait for it to read them once its buffer is full. The changes of the subscriptions
+// returned by ChangeFeed.OnChange are passed to their callback instead, and aren't read with Next.
+type ChangeSubscription struct {
+	feed     *ChangeFeed
+	tables   map[DbTable]struct{}
+	events   chan ChangeEvent
+	callback ChangeCallback
+	done     chan struct{}
+
+	closeOnce sync.Once
+	mu        sync.Mutex
+	lagErr    error
+}
+
+// deliver adds the change given to the buffer of this subscription, waiting for it to have room, or passes it to the
+// callback of the subscription, and returns whether it was delivered. Changes aren't delivered anymore once the
+// subscription missed some.
+func (s *ChangeSubscription) deliver(ctx *Context, event ChangeEvent) bool {
+	if s.callback != nil {
+		select {
+		case <-s.done:
+			return false
+		default:
+		}
+		if err := s.callback(ctx, event); err != nil {
+			ctx.GetLogger().WithError(err).Warnf("change callback failed on %s of %s.%s", event.Type, event.Database, event.Table)
+		}
+		return true
+	}
+
+	s.mu.Lock()
+	lagged := s.lagErr != nil
+	s.mu.Unlock()
+	if lagged {
+		return false
+	}
+
+	select {
+	case s.events <- event:
+		return true
+	case <-s.done:
+		return false
+	case <-ctx.Done():
+		s.mu.Lock()
+		if s.lagErr == nil {
+			s.lagErr = ErrChangeSubscriptionLagged.New(ctx.Err().Error())
+		}
+		s.mu.Unlock()
+		return false
+	}
+}
+
+// Next returns the next change of this subscription, waiting for one to be committed if there's none. It returns the
+// error of the context given if it's canceled while waiting, ErrChangeSubscriptionClosed once the subscription is
+// closed, and ErrChangeSubscriptionLagged once the changes buffered before the subscription missed changes have been
+// read.
+func (s *ChangeSubscription) Next(ctx context.Context) (ChangeEvent, error) {
+	select {
+	case event := <-s.events:
+		return event, nil
+	default:
+	}
+
+	s.mu.Lock()
+	lagErr := s.lagErr
+	s.mu.Unlock()
+	if lagErr != nil {
+		return ChangeEvent{}, lagErr
+	}
+
+	select {
+	case event := <-s.events:
+		return event, nil
+	case <-s.done:
+		return ChangeEvent{}, ErrChangeSubscriptionClosed.New()
+	case <-ctx.Done():
+		return ChangeEvent{}, ctx.Err()
+	}
+}
+
+// Buffered returns the number of changes of this subscription that haven't been read yet.
+func (s *ChangeSubscription) Buffered() int {
+	return len(s.events)
+}
+
+// Close ends this subscription. Changes buffered but not read yet are discarded.
+func (s *ChangeSubscription) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.feed.unsubscribe(s)
+	})
+	return nil
+}
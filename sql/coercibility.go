@@ -43,7 +43,12 @@ func ResolveCoercibility(leftCollation CollationID, leftCoercibility byte, right
 		leftCharset := leftCollation.CharacterSet()
 		rightCharset := rightCollation.CharacterSet()
 		if leftCharset != rightCharset {
-			if leftCharset.MaxLength() == 1 && rightCharset.MaxLength() > 1 { // Left non-Unicode, Right Unicode
+			// Binary strings are compared byte by byte with the strings of any character set
+			if leftCollation == Collation_binary {
+				return leftCollation, leftCoercibility
+			} else if rightCollation == Collation_binary {
+				return rightCollation, rightCoercibility
+			} else if leftCharset.MaxLength() == 1 && rightCharset.MaxLength() > 1 { // Left non-Unicode, Right Unicode
 				return rightCollation, rightCoercibility
 			} else if leftCharset.MaxLength() > 1 && rightCharset.MaxLength() == 1 { // Left Unicode, Right non-Unicode
 				return leftCollation, leftCoercibility
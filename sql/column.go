@@ -47,6 +47,11 @@ type Column struct {
 	Comment string
 	// Extra contains any additional information to put in the `extra` column under `information_schema.columns`.
 	Extra string
+	// Generated contains the expression the values of a generated column are computed from, or nil if the column
+	// isn't generated.
+	Generated *ColumnDefaultValue
+	// Virtual is true if the column is a generated column whose values aren't stored, but computed when they're read.
+	Virtual bool
 }
 
 // Check ensures the value is correct for this column.
@@ -65,6 +70,8 @@ func (c *Column) Equals(c2 *Column) bool {
 		c.Source == c2.Source &&
 		c.Nullable == c2.Nullable &&
 		reflect.DeepEqual(c.Default, c2.Default) &&
+		reflect.DeepEqual(c.Generated, c2.Generated) &&
+		c.Virtual == c2.Virtual &&
 		reflect.DeepEqual(c.Type, c2.Type)
 }
 
@@ -96,6 +103,14 @@ func (c *Column) DebugString() string {
 	sb.WriteString(", ")
 	sb.WriteString("Extra: ")
 	sb.WriteString(c.Extra)
+	if c.Generated != nil {
+		sb.WriteString(", ")
+		sb.WriteString("Generated: ")
+		sb.WriteString(DebugString(c.Generated))
+		sb.WriteString(", ")
+		sb.WriteString("Virtual: ")
+		sb.WriteString(fmt.Sprintf("%v", c.Virtual))
+	}
 
 	return sb.String()
 }
@@ -111,5 +126,7 @@ func (c *Column) Copy() *Column {
 		PrimaryKey:    c.PrimaryKey,
 		Comment:       c.Comment,
 		Extra:         c.Extra,
+		Generated:     c.Generated,
+		Virtual:       c.Virtual,
 	}
 }
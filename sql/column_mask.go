@@ -0,0 +1,31 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// ColumnMask redacts the values of a table column that a user may read. Integrators register column masks with the
+// analyzer's catalog to hide sensitive values, such as email addresses, from users lacking a privilege to see them,
+// without rewriting their queries.
+//
+// A column mask returns the expression that replaces the column given for the user of the context given, or nil if
+// that user may read the column's values. The expression usually refers to the column given, such as a function
+// keeping the domain of an email address, but may be a literal instead. Every reference to the column in a query is
+// replaced, so that masked values are also the ones that rows are filtered, grouped, joined and sorted by, and the
+// real values can't be inferred from the results. The columns assigned by UPDATE and INSERT statements aren't masked.
+// HANDLER and CHECKSUM TABLE statements, which read the rows of a table directly, are rejected for tables with masked
+// columns.
+//
+// The user is the account that the statement executes as, which is the definer of views, triggers and stored
+// procedures that execute in their definer's security context.
+type ColumnMask func(ctx *Context, column Expression) (Expression, error)
@@ -86,10 +86,37 @@ type CheckConstraint struct {
 	Name     string
 	Expr     Expression
 	Enforced bool
+	// IsViewCheckOption is set when this constraint enforces the WITH CHECK OPTION clause of the view named by Name,
+	// rather than a CHECK constraint defined on a table.
+	IsViewCheckOption bool
+	// IsRowPolicy is set when this constraint enforces the row policy of the table named by Name
+	IsRowPolicy bool
+}
+
+// NewViolationError returns the error reported when a row fails this constraint.
+func (c *CheckConstraint) NewViolationError() error {
+	if c.IsViewCheckOption {
+		return ErrViewCheckOptionFailed.New(c.Name)
+	}
+	if c.IsRowPolicy {
+		return ErrRowPolicyViolated.New(c.Name)
+	}
+	return ErrCheckConstraintViolated.New(c.Name)
 }
 
 type CheckConstraints []*CheckConstraint
 
+// ViewCheckOptions returns the subset of these constraints that enforce a view's WITH CHECK OPTION clause.
+func (checks CheckConstraints) ViewCheckOptions() CheckConstraints {
+	var viewChecks CheckConstraints
+	for _, check := range checks {
+		if check.IsViewCheckOption {
+			viewChecks = append(viewChecks, check)
+		}
+	}
+	return viewChecks
+}
+
 // ToExpressions returns the check expressions in these constraints as a slice of sql.Expression
 func (checks CheckConstraints) ToExpressions() []Expression {
 	exprs := make([]Expression, len(checks))
@@ -16,6 +16,15 @@ func ConvertToValue(v interface{}) (Value, error) {
 			Typ: query.Type_NULL_TYPE,
 			Val: nil,
 		}, nil
+	case bool:
+		var b int8
+		if v {
+			b = 1
+		}
+		return Value{
+			Typ: query.Type_INT8,
+			Val: values.WriteInt8(make([]byte, values.Int8Size), b),
+		}, nil
 	case int:
 		return Value{
 			Typ: query.Type_INT64,
@@ -46,6 +46,15 @@ type MutableDatabaseProvider interface {
 	DropDatabase(ctx *Context, name string) error
 }
 
+// DatabaseRenamer is a MutableDatabaseProvider that can rename the databases it provides.
+type DatabaseRenamer interface {
+	MutableDatabaseProvider
+
+	// RenameDatabase renames the database named |oldName| to |newName|. The database must be renamed atomically, along
+	// with every reference to it held by the provider, such as the foreign keys of other databases.
+	RenameDatabase(ctx *Context, oldName, newName string) error
+}
+
 // CollatedDatabaseProvider is a DatabaseProvider that can create a Database with a specific collation.
 type CollatedDatabaseProvider interface {
 	MutableDatabaseProvider
@@ -54,6 +63,35 @@ type CollatedDatabaseProvider interface {
 	CreateCollatedDatabase(ctx *Context, name string, collation CollationID) error
 }
 
+// FlushOption is the kind of a FLUSH statement, such as TABLES or LOGS.
+type FlushOption string
+
+const (
+	FlushTables         FlushOption = "TABLES"
+	FlushStatus         FlushOption = "STATUS"
+	FlushLogs           FlushOption = "LOGS"
+	FlushBinaryLogs     FlushOption = "BINARY LOGS"
+	FlushEngineLogs     FlushOption = "ENGINE LOGS"
+	FlushErrorLogs      FlushOption = "ERROR LOGS"
+	FlushGeneralLogs    FlushOption = "GENERAL LOGS"
+	FlushRelayLogs      FlushOption = "RELAY LOGS"
+	FlushSlowLogs       FlushOption = "SLOW LOGS"
+	FlushHosts          FlushOption = "HOSTS"
+	FlushOptimizerCosts FlushOption = "OPTIMIZER_COSTS"
+	FlushUserResources  FlushOption = "USER_RESOURCES"
+)
+
+// FlushHandler is a DatabaseProvider that reacts to FLUSH statements, such as by closing and reopening its log files
+// for FLUSH LOGS, or by discarding the table metadata it caches for FLUSH TABLES. The engine handles the effects of
+// FLUSH statements on its own state before calling the handler.
+type FlushHandler interface {
+	DatabaseProvider
+
+	// Flush handles a FLUSH statement with the option given. For FLUSH TABLES, |tables| are the tables named by the
+	// statement, or nil if it names none, in which case all tables are flushed.
+	Flush(ctx *Context, option FlushOption, tables []DbTable) error
+}
+
 // TableFunctionProvider is an interface that allows custom table functions to be provided. It's usually (but not
 // always) implemented by a DatabaseProvider.
 type TableFunctionProvider interface {
@@ -165,6 +203,16 @@ type CollatedDatabase interface {
 	SetCollation(ctx *Context, collation CollationID) error
 }
 
+// EncryptedDatabase is a CollatedDatabase that can also store and update its DEFAULT ENCRYPTION setting. Databases
+// that do not implement this interface are always reported as unencrypted.
+type EncryptedDatabase interface {
+	CollatedDatabase
+	// GetDefaultEncryption returns whether tables in this database are encrypted by default.
+	GetDefaultEncryption(ctx *Context) bool
+	// SetDefaultEncryption updates whether tables in this database are encrypted by default.
+	SetDefaultEncryption(ctx *Context, encrypted bool) error
+}
+
 // TriggerDatabase is a Database that supports creating and storing triggers. The engine handles all parsing and
 // execution logic for triggers. Integrators are not expected to parse or understand the trigger definitions, but must
 // store and return them when asked.
@@ -205,6 +253,17 @@ type TableCopierDatabase interface {
 	CopyTableData(ctx *Context, sourceTable string, destinationTable string) (uint64, error)
 }
 
+// JoinPushdownDatabase is a database that can execute joins of its own tables natively, such as a database backed by
+// a remote server that speaks SQL. The analyzer offers it each join of a query whose tables all belong to it, so that
+// the join doesn't have to be computed by streaming the rows of every table to the engine.
+type JoinPushdownDatabase interface {
+	Database
+	// PushdownJoin returns a table that returns the rows of the join given, or false if the database can't execute the
+	// join. The join's children are joins, tables of this database, or aliases of them, and the schema of the table
+	// returned must be the schema of the join, with the same column sources.
+	PushdownJoin(ctx *Context, join Node) (Table, bool, error)
+}
+
 // StoredProcedureDatabase is a database that supports the creation and execution of stored procedures. The engine will
 // handle all parsing and execution logic for stored procedures. Integrators only need to store and retrieve
 // StoredProcedureDetails, while verifying that all stored procedures have a unique name without regard to
@@ -222,6 +281,23 @@ type StoredProcedureDatabase interface {
 	DropStoredProcedure(ctx *Context, name string) error
 }
 
+// StoredFunctionDatabase is a database that supports the creation and execution of stored functions, which are
+// defined by CREATE FUNCTION statements and called in expressions. Like stored procedures, the engine handles all
+// parsing and execution logic, and integrators only need to store and retrieve their StoredProcedureDetails, whose
+// CreateStatement is a CREATE FUNCTION statement. Stored functions and stored procedures have separate namespaces.
+type StoredFunctionDatabase interface {
+	Database
+	// GetStoredFunction returns the desired StoredProcedureDetails of a stored function from the database.
+	GetStoredFunction(ctx *Context, name string) (StoredProcedureDetails, bool, error)
+	// GetStoredFunctions returns the StoredProcedureDetails of all stored functions of the database.
+	GetStoredFunctions(ctx *Context) ([]StoredProcedureDetails, error)
+	// SaveStoredFunction stores the given StoredProcedureDetails of a stored function to the database. The integrator
+	// should verify that the name of the new stored function is unique amongst existing stored functions.
+	SaveStoredFunction(ctx *Context, sfd StoredProcedureDetails) error
+	// DropStoredFunction removes the stored function with the matching name from the database.
+	DropStoredFunction(ctx *Context, name string) error
+}
+
 // ViewDatabase is implemented by databases that persist view definitions
 type ViewDatabase interface {
 	// CreateView persists the definition a view with the name and select statement given. If a view with that name
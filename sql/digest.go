@@ -0,0 +1,209 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// The tokens that lists of values are reduced to in digest texts, as in MySQL
+const (
+	digestValue           = "?"
+	digestValueList       = "?, ..."
+	digestRowSingleValue  = "(?)"
+	digestRowValueList    = "(...)"
+	digestRowsSuffix      = " /* , ... */"
+	digestRowsSingleValue = digestRowSingleValue + digestRowsSuffix
+	digestRowsValueList   = digestRowValueList + digestRowsSuffix
+)
+
+// digestOperators are the texts of the operator tokens that the tokenizer returns without their text.
+var digestOperators = map[int]string{
+	sqlparser.LE:                      "<=",
+	sqlparser.GE:                      ">=",
+	sqlparser.NE:                      "!=",
+	sqlparser.NULL_SAFE_EQUAL:         "<=>",
+	sqlparser.SHIFT_LEFT:              "<<",
+	sqlparser.SHIFT_RIGHT:             ">>",
+	sqlparser.AND:                     "&&",
+	sqlparser.OR:                      "||",
+	sqlparser.JSON_EXTRACT_OP:         "->",
+	sqlparser.JSON_UNQUOTE_EXTRACT_OP: "->>",
+}
+
+// StatementDigestText returns the normalized text of the statement given, like MySQL's STATEMENT_DIGEST_TEXT(). The
+// text doesn't depend on the literal values, comments, whitespace and the case of keywords of the statement: literal
+// values are replaced with ?, lists of values are reduced to a single item, keywords are uppercase, identifiers are
+// quoted, and tokens are separated by a single space. Statements with the same digest text have the same shape, so it
+// identifies statements for statistics, caching and throttling.
+func StatementDigestText(query string) (string, error) {
+	text, _, err := digestStatement(query)
+	return text, err
+}
+
+// StatementDigestWithValues returns the digest of the statement given, along with the literal values that its digest
+// text replaces with ?, in order. Statements with the same digest and values are the same statement.
+func StatementDigestWithValues(query string) (string, []string, error) {
+	text, values, err := digestStatement(query)
+	if err != nil {
+		return "", nil, err
+	}
+	return DigestOfText(text), values, nil
+}
+
+// digestStatement returns the digest text of the statement given, and the literal values it replaces.
+func digestStatement(query string) (string, []string, error) {
+	tokenizer := sqlparser.NewStringTokenizer(strings.TrimSuffix(strings.TrimSpace(query), ";"))
+	var tokens, values []string
+	for {
+		typ, val := tokenizer.Scan()
+		switch typ {
+		case 0:
+			return strings.Join(tokens, " "), values, nil
+		case sqlparser.LEX_ERROR:
+			return "", nil, ErrInvalidDigestStatement.New(string(val))
+		case sqlparser.COMMENT:
+			continue
+		case sqlparser.STRING, sqlparser.INTEGRAL, sqlparser.FLOAT, sqlparser.HEXNUM, sqlparser.HEX, sqlparser.BIT_LITERAL,
+			sqlparser.TRUE, sqlparser.FALSE:
+			tokens, values = appendDigestValue(tokens, values, digestValueText(typ, val))
+		case sqlparser.NULL:
+			// NULL is a value, except in IS NULL and IS NOT NULL
+			if n := len(tokens); n > 0 && (tokens[n-1] == "IS" || tokens[n-1] == "NOT" && n > 1 && tokens[n-2] == "IS") {
+				tokens = append(tokens, "NULL")
+			} else {
+				tokens, values = appendDigestValue(tokens, values, "NULL")
+			}
+		case sqlparser.ID:
+			// variables are identifiers too, whose names are kept as they are
+			if val[0] == '@' {
+				tokens = append(tokens, string(val))
+			} else {
+				tokens = append(tokens, "`"+strings.ReplaceAll(string(val), "`", "``")+"`")
+			}
+		case sqlparser.VALUE_ARG, sqlparser.LIST_ARG:
+			tokens, values = appendDigestValue(tokens, values, string(val))
+		case int(')'):
+			tokens = appendDigestCloseParen(tokens)
+		default:
+			if typ < 256 {
+				tokens = append(tokens, string(rune(typ)))
+			} else if len(val) > 0 {
+				tokens = append(tokens, strings.ToUpper(string(val)))
+			} else if op, ok := digestOperators[typ]; ok {
+				tokens = append(tokens, op)
+			} else {
+				tokens = append(tokens, strings.ToUpper(sqlparser.KeywordString(typ)))
+			}
+		}
+	}
+}
+
+// StatementDigest returns the digest of the statement given, like MySQL's STATEMENT_DIGEST(): the SHA-256 hash of its
+// digest text, as a hexadecimal string.
+func StatementDigest(query string) (string, error) {
+	text, err := StatementDigestText(query)
+	if err != nil {
+		return "", err
+	}
+	return DigestOfText(text), nil
+}
+
+// DigestOfText returns the digest of the digest text given.
+func DigestOfText(text string) string {
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
+
+// digestValueText returns the text of the literal value of the type and text given, which distinguishes literals of
+// different types with the same text.
+func digestValueText(typ int, val []byte) string {
+	switch typ {
+	case sqlparser.STRING:
+		return "'" + strings.ReplaceAll(string(val), "'", "''") + "'"
+	case sqlparser.HEX:
+		return "X'" + string(val) + "'"
+	case sqlparser.BIT_LITERAL:
+		return "B'" + string(val) + "'"
+	case sqlparser.TRUE:
+		return "TRUE"
+	case sqlparser.FALSE:
+		return "FALSE"
+	default:
+		return string(val)
+	}
+}
+
+// appendDigestValue appends a value to the digest tokens given, and its text to the values given. It reduces the value
+// and a preceding value and comma to a list, and merges it with a preceding minus or plus sign that doesn't follow an
+// operand.
+func appendDigestValue(tokens, values []string, value string) ([]string, []string) {
+	n := len(tokens)
+	if n > 0 && (tokens[n-1] == "-" || tokens[n-1] == "+") && (n == 1 || !isDigestOperand(tokens[n-2])) {
+		value = tokens[n-1] + value
+		tokens = tokens[:n-1]
+		n--
+	}
+	values = append(values, value)
+	if n > 1 && tokens[n-1] == "," && (tokens[n-2] == digestValue || tokens[n-2] == digestValueList) {
+		return append(tokens[:n-2], digestValueList), values
+	}
+	return append(tokens, digestValue), values
+}
+
+// appendDigestCloseParen appends a closing parenthesis to the digest tokens given, reducing a parenthesized value or
+// list of values to a row, and a row and a preceding row and comma to a list of rows.
+func appendDigestCloseParen(tokens []string) []string {
+	n := len(tokens)
+	var row string
+	switch {
+	case n > 1 && tokens[n-2] == "(" && tokens[n-1] == digestValue:
+		row = digestRowSingleValue
+	case n > 1 && tokens[n-2] == "(" && tokens[n-1] == digestValueList:
+		row = digestRowValueList
+	default:
+		return append(tokens, ")")
+	}
+	tokens = tokens[:n-2]
+	n -= 2
+
+	if n > 1 && tokens[n-1] == "," {
+		switch tokens[n-2] {
+		case digestRowSingleValue, digestRowsSingleValue:
+			if row == digestRowSingleValue {
+				return append(tokens[:n-2], digestRowsSingleValue)
+			}
+		case digestRowValueList, digestRowsValueList:
+			if row == digestRowValueList {
+				return append(tokens[:n-2], digestRowsValueList)
+			}
+		}
+	}
+	return append(tokens, row)
+}
+
+// isDigestOperand returns whether the digest token given ends an operand, after which a minus or plus sign is a
+// binary operator.
+func isDigestOperand(token string) bool {
+	switch token {
+	case digestValue, digestValueList, digestRowSingleValue, digestRowValueList, digestRowsSingleValue, digestRowsValueList, ")":
+		return true
+	}
+	return strings.HasPrefix(token, "`") || strings.HasPrefix(token, "@")
+}
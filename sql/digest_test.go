@@ -0,0 +1,72 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementDigestText(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"SELECT * FROM t1 WHERE c1 = 1", "SELECT * FROM `t1` WHERE `c1` = ?"},
+		{"select *\n from T1 /* comment */ where c1 = 'abc';", "SELECT * FROM `T1` WHERE `c1` = ?"},
+		{"select a.b, c from db.t a where a.x in (1, 2, 3)", "SELECT `a` . `b` , `c` FROM `db` . `t` `a` WHERE `a` . `x` IN (...)"},
+		{"select * from t where x in (1)", "SELECT * FROM `t` WHERE `x` IN (?)"},
+		{"insert into t values (1, 'a'), (2, 'b'), (3, 'c')", "INSERT INTO `t` VALUES (...) /* , ... */"},
+		{"insert into t values (1), (2)", "INSERT INTO `t` VALUES (?) /* , ... */"},
+		{"select 1, 2, 3", "SELECT ?, ..."},
+		{"select x - 1, -1, 2 * -3.5 from t", "SELECT `x` - ?, ... * ? FROM `t`"},
+		{"select * from t where a is null and b is not null and c = null", "SELECT * FROM `t` WHERE `a` IS NULL AND `b` IS NOT NULL AND `c` = ?"},
+		{"select * from t where a <= 1 && b <> x'0A'", "SELECT * FROM `t` WHERE `a` <= ? && `b` != ?"},
+		{"select count(*), abs(x), abs(-1) from t limit 10", "SELECT COUNT ( * ) , `abs` ( `x` ) , `abs` (?) FROM `t` LIMIT ?"},
+		{"set @a = 1", "SET @a = ?"},
+		{"select * from t where a = ?", "SELECT * FROM `t` WHERE `a` = ?"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			text, err := StatementDigestText(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, text)
+		})
+	}
+}
+
+func TestStatementDigest(t *testing.T) {
+	d1, err := StatementDigest("select * from t where a = 1")
+	require.NoError(t, err)
+	d2, err := StatementDigest("SELECT * FROM t WHERE a = 'xyz'")
+	require.NoError(t, err)
+	d3, err := StatementDigest("select * from t where b = 1")
+	require.NoError(t, err)
+	require.Len(t, d1, 64)
+	require.Equal(t, d1, d2)
+	require.NotEqual(t, d1, d3)
+}
+
+func TestStatementDigestWithValues(t *testing.T) {
+	d1, values, err := StatementDigestWithValues("select x - 1, -2, 'a', 1 from t where a in (null, x'0A', ?) and b is null")
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "-2", "'a'", "1", "NULL", "X'0A'", ":v1"}, values)
+
+	d2, values, err := StatementDigestWithValues("SELECT x - 3, 2, 'b', '1' FROM t WHERE a IN (1, 2, ?) AND b IS NULL")
+	require.NoError(t, err)
+	require.Equal(t, d1, d2)
+	require.Equal(t, []string{"3", "2", "'b'", "'1'", "1", "2", ":v1"}, values)
+}
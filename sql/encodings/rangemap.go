@@ -79,14 +79,17 @@ func (rm *RangeMap) Encode(str []byte) ([]byte, bool) {
 		encodedRuneLen := 1
 		// The most common strings for most expected applications will find their result in the first loop, so the
 		// performance here shouldn't be as bad as it may seem.
-		for ; encodedRuneLen <= len(rm.inputEntries); encodedRuneLen++ {
+		for ; encodedRuneLen <= len(rm.outputEntries); encodedRuneLen++ {
+			if encodedRuneLen > len(str) {
+				return nil, false
+			}
 			var ok bool
 			encodedRune, ok = rm.EncodeRune(str[:encodedRuneLen])
 			if ok {
 				break
 			}
 		}
-		if encodedRuneLen > len(rm.inputEntries) {
+		if encodedRuneLen > len(rm.outputEntries) {
 			return nil, false
 		}
 		encodedStr = append(encodedStr, encodedRune...)
@@ -0,0 +1,165 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// EngineStatusService is the key of the reporter of the internal state of an engine in its service registry, which the
+// SHOW ENGINE GMS STATUS statement uses. The engine registers itself with this key when it's created.
+var EngineStatusService = NewServiceKey[EngineStatusReporter]("engine status")
+
+// EngineStatusReporter reports the internal state of an engine, for operational debugging.
+type EngineStatusReporter interface {
+	// EngineStatus returns the current state of the engine.
+	EngineStatus(ctx *Context) (EngineStatus, error)
+}
+
+// EngineStatus is the internal state of an engine at a point in time.
+type EngineStatus struct {
+	PlanCache PlanCacheStatus
+	Memory    MemoryStatus
+	// Transactions are the open transactions of the connections of the engine's servers.
+	Transactions []TransactionStatus
+	// Replication is the state of the binlog replica of the engine, or nil if it has no replica controller.
+	Replication       *ReplicationStatus
+	BackgroundThreads []BackgroundThreadStatus
+	Analyzer          AnalyzerStatus
+	// StatementRetries is the number of statements retried after serialization failures.
+	StatementRetries uint64
+}
+
+// PlanCacheStatus is the state of the cache of plans of prepared statements of an engine.
+type PlanCacheStatus struct {
+	// Sessions is the number of sessions with cached plans.
+	Sessions int
+	// Plans is the number of cached plans of all sessions.
+	Plans int
+	// NamedStatements is the number of named prepared statements of all sessions.
+	NamedStatements int
+	// MaxPlansPerSession is the maximum number of plans cached for each session, or zero if it's unbounded.
+	MaxPlansPerSession int
+}
+
+// MemoryStatus is the state of the memory manager of an engine.
+type MemoryStatus struct {
+	// UsedBytes is the memory used by the process.
+	UsedBytes uint64
+	// MaxBytes is the memory budget of the engine, or zero if it's unbounded.
+	MaxBytes uint64
+	// Caches is the number of caches of rows held by queries.
+	Caches int
+}
+
+// TransactionStatus is an open transaction of a connection.
+type TransactionStatus struct {
+	Connection  uint32
+	User        string
+	Host        string
+	Transaction string
+	ReadOnly    bool
+}
+
+// ReplicationStatus is the state of the binlog replica of an engine.
+type ReplicationStatus struct {
+	SourceHost   string
+	SourcePort   uint
+	IoRunning    string
+	SqlRunning   string
+	LastIoError  string
+	LastSqlError string
+}
+
+// BackgroundThreadStatus is the state of a background thread of an engine.
+type BackgroundThreadStatus struct {
+	Name string
+	// Running is false once the thread has returned.
+	Running bool
+}
+
+// AnalyzerStatus holds the timings of the analysis of the queries of an engine.
+type AnalyzerStatus struct {
+	// Queries is the number of queries analyzed.
+	Queries uint64
+	// TotalTime is the time spent analyzing queries.
+	TotalTime time.Duration
+	// MaxTime is the longest time spent analyzing a query.
+	MaxTime time.Duration
+}
+
+// EngineStatusEntry is a named value of an EngineStatus.
+type EngineStatusEntry struct {
+	Name  string
+	Value string
+}
+
+// Entries returns the values of the status as named entries, as reported by SHOW ENGINE GMS STATUS.
+func (s EngineStatus) Entries() []EngineStatusEntry {
+	var entries []EngineStatusEntry
+	add := func(name string, value interface{}) {
+		entries = append(entries, EngineStatusEntry{Name: name, Value: fmt.Sprint(value)})
+	}
+
+	add("plan_cache.sessions", s.PlanCache.Sessions)
+	add("plan_cache.plans", s.PlanCache.Plans)
+	add("plan_cache.named_statements", s.PlanCache.NamedStatements)
+	add("plan_cache.max_plans_per_session", s.PlanCache.MaxPlansPerSession)
+
+	add("memory.used_bytes", s.Memory.UsedBytes)
+	add("memory.max_bytes", s.Memory.MaxBytes)
+	add("memory.caches", s.Memory.Caches)
+
+	add("transactions.active", len(s.Transactions))
+	for _, tx := range s.Transactions {
+		prefix := "transactions." + strconv.FormatUint(uint64(tx.Connection), 10)
+		add(prefix+".user", tx.User+"@"+tx.Host)
+		add(prefix+".transaction", tx.Transaction)
+		add(prefix+".read_only", tx.ReadOnly)
+	}
+
+	if s.Replication == nil {
+		add("replication.configured", false)
+	} else {
+		add("replication.configured", true)
+		add("replication.source", fmt.Sprintf("%s:%d", s.Replication.SourceHost, s.Replication.SourcePort))
+		add("replication.io_running", s.Replication.IoRunning)
+		add("replication.sql_running", s.Replication.SqlRunning)
+		add("replication.last_io_error", s.Replication.LastIoError)
+		add("replication.last_sql_error", s.Replication.LastSqlError)
+	}
+
+	for _, thread := range s.BackgroundThreads {
+		state := "running"
+		if !thread.Running {
+			state = "stopped"
+		}
+		add("background_threads."+thread.Name, state)
+	}
+
+	add("analyzer.queries", s.Analyzer.Queries)
+	add("analyzer.total_time", s.Analyzer.TotalTime)
+	add("analyzer.max_time", s.Analyzer.MaxTime)
+	var average time.Duration
+	if s.Analyzer.Queries > 0 {
+		average = s.Analyzer.TotalTime / time.Duration(s.Analyzer.Queries)
+	}
+	add("analyzer.average_time", average)
+
+	add("statement_retries", s.StatementRetries)
+	return entries
+}
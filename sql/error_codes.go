@@ -0,0 +1,271 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	goerrors "errors"
+	"sync"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// errorCode is the MySQL error number and SQLSTATE that errors of a kind are reported to clients with.
+type errorCode struct {
+	kind     *errors.Kind
+	code     int
+	sqlState string
+}
+
+// errorCodes are the error codes of the error kinds of the engine. The codes are those that MySQL reports for the
+// same failures. Codes that vitess doesn't define a constant for are commented with the name MySQL gives them.
+var errorCodes = []errorCode{
+	// Parsing and name resolution
+	{ErrSyntaxError, mysql.ERParseError, mysql.SSClientError},
+	{ErrUnsupportedSyntax, mysql.ERNotSupportedYet, mysql.SSClientError},
+	{ErrUnsupportedFeature, mysql.ERNotSupportedYet, mysql.SSClientError},
+	{ErrColumnNotFound, mysql.ERBadFieldError, mysql.SSBadFieldError},
+	{ErrTableColumnNotFound, mysql.ERBadFieldError, mysql.SSBadFieldError},
+	{ErrUnknownColumn, mysql.ERBadFieldError, mysql.SSBadFieldError},
+	{ErrAmbiguousColumnName, mysql.ERNonUniq, mysql.SSConstraintViolation},
+	{ErrAmbiguousColumnOrAliasName, mysql.ERNonUniq, mysql.SSConstraintViolation},
+	{ErrAmbiguousColumnInOrderBy, mysql.ERNonUniq, mysql.SSConstraintViolation},
+	{ErrTableNotFound, mysql.ERNoSuchTable, mysql.SSUnknownTable},
+	{ErrUnknownTable, mysql.ERBadTable, mysql.SSUnknownTable},
+	{ErrViewDoesNotExist, mysql.ERBadTable, mysql.SSUnknownTable},
+	{ErrDuplicateAliasOrTable, mysql.ERNonUniqTable, mysql.SSClientError},
+	{ErrDatabaseNotFound, mysql.ERBadDb, mysql.SSClientError},
+	{ErrNoDatabaseSelected, mysql.ERNoDb, mysql.SSNoDB},
+	{ErrNoTablesUsed, mysql.ERNoTablesUsed, mysql.SSUnknownSQLState},
+	{ErrFunctionNotFound, mysql.ERSPDoesNotExist, mysql.SSClientError},
+	{ErrInvalidArgumentNumber, 1582, mysql.SSClientError}, // ER_WRONG_PARAMCOUNT_TO_NATIVE_FCT
+	{ErrInvalidArgument, mysql.ERWrongArguments, mysql.SSUnknownSQLState},
+	{ErrInvalidArgumentDetails, mysql.ERWrongArguments, mysql.SSUnknownSQLState},
+	{ErrUnknownWindowName, 3579, mysql.SSUnknownSQLState},         // ER_WINDOW_NO_SUCH_WINDOW
+	{ErrCircularWindowInheritance, 3580, mysql.SSUnknownSQLState}, // ER_WINDOW_CIRCULARITY_IN_WINDOW_GRAPH
+
+	// Query evaluation
+	{ErrExpectedSingleRow, mysql.ERSubqueryNo1Row, mysql.SSWrongNumberOfColumns},
+	{ErrInvalidOperandColumns, mysql.EROperandColumns, mysql.SSWrongNumberOfColumns},
+	{ErrColumnNumberDoesNotMatch, mysql.ERWrongNumberOfColumnsInSelect, mysql.SSWrongNumberOfColumns},
+	{ErrNonAggregatedColumnWithoutGroupBy, mysql.ERMixOfGroupFuncAndFields, mysql.SSClientError},
+	{ErrGroupingArgumentNotGrouped, 3601, mysql.SSUnknownSQLState}, // ER_FIELD_IN_GROUPING_NOT_GROUP_BY
+	{ErrMoreThanOneRow, mysql.ERTooManyRows, mysql.SSClientError},
+	{ErrCteRecursionLimitExceeded, 3636, mysql.SSUnknownSQLState}, // ER_CTE_MAX_RECURSION_DEPTH
+	{ErrInvalidJSONText, mysql.ERInvalidJSONTextInParams, "22032"},
+	{ErrInvalidJson, mysql.ERInvalidJSONText, "22032"},
+	{ErrJSONObjectAggNullKey, 3158, "22032"}, // ER_JSON_DOCUMENT_NULL_KEY
+	{ErrValueOutOfRange, mysql.ERWarnDataOutOfRange, mysql.SSDataOutOfRange},
+	{ErrInvalidValue, mysql.ERTruncatedWrongValueForField, mysql.SSUnknownSQLState},
+	{ErrTruncatedIncorrectValue, mysql.ERTruncatedWrongValue, "22007"},
+	{ErrInvalidGISData, 3037, "22023"},                             // ER_GIS_INVALID_DATA
+	{ErrSpatialTypeConversion, 1416, mysql.SSDataOutOfRange},       // ER_CANT_CREATE_GEOMETRY_OBJECT
+	{ErrNotMatchingSRID, 3643, mysql.SSUnknownSQLState},            // ER_WRONG_SRID_FOR_COLUMN
+	{ErrNotMatchingSRIDWithColName, 3643, mysql.SSUnknownSQLState}, // ER_WRONG_SRID_FOR_COLUMN
+	{ErrCollationUnknown, mysql.ERUnknownCollation, mysql.SSUnknownSQLState},
+	{ErrCollationInvalidForCharSet, mysql.ERCollationCharsetMismatch, mysql.SSClientError},
+	{ErrCollationIllegalMix, mysql.ERCantAggregate2Collations, mysql.SSUnknownSQLState},
+	{ErrCharSetUnknown, mysql.ERUnknownCharacterSet, mysql.SSClientError},
+	{ErrCharSetInvalidString, mysql.ERInvalidCharacterString, mysql.SSUnknownSQLState},
+
+	// Writes and constraints
+	{ErrInsertIntoNonNullableProvidedNull, mysql.ERBadNullError, mysql.SSConstraintViolation},
+	{ErrInsertIntoNonNullableDefaultNullColumn, mysql.ERNoDefaultForField, mysql.SSUnknownSQLState},
+	{ErrPrimaryKeyViolation, mysql.ERDupEntry, mysql.SSDupKey},
+	{ErrUniqueKeyViolation, mysql.ERDupEntry, mysql.SSDupKey},
+	{ErrDuplicateEntry, mysql.ERDupEntry, mysql.SSDupKey},
+	{ErrForeignKeyChildViolation, mysql.ErNoReferencedRow2, mysql.SSConstraintViolation}, // test with mysql returns 1452 vs 1216
+	{ErrForeignKeyNotResolved, mysql.ErNoReferencedRow2, mysql.SSConstraintViolation},
+	{ErrForeignKeyParentViolation, mysql.ERRowIsReferenced2, mysql.SSConstraintViolation}, // test with mysql returns 1451 vs 1215
+	{ErrForeignKeyDepthLimit, 3008, mysql.SSUnknownSQLState},                              // ER_FK_DEPTH_EXCEEDED
+	{ErrCheckConstraintViolated, 3819, mysql.SSUnknownSQLState},                           // ER_CHECK_CONSTRAINT_VIOLATED
+	{ErrPartitionNotFound, 1526, mysql.SSUnknownSQLState},                                 // ER_NO_PARTITION_FOR_GIVEN_VALUE
+	{ErrViewNotUpdatable, mysql.ERNonUpdateableTable, mysql.SSUnknownSQLState},
+	{ErrViewColumnNotUpdatable, 1348, mysql.SSUnknownSQLState}, // ER_NONUPDATEABLE_COLUMN
+	{ErrViewCheckOptionFailed, 1369, mysql.SSUnknownSQLState},  // ER_VIEW_CHECK_FAILED
+	{ErrReadOnly, mysql.EROptionPreventsStatement, mysql.SSUnknownSQLState},
+	{ErrReadOnlyTransaction, 1792, "25006"}, // ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION
+
+	// Schema changes
+	{ErrDatabaseExists, mysql.ERDbCreateExists, mysql.SSUnknownSQLState},
+	{ErrTableAlreadyExists, mysql.ERTableExists, "42S01"},
+	{ErrExistingView, mysql.ERTableExists, "42S01"},
+	{ErrColumnExists, mysql.ERDupFieldName, mysql.SSDupFieldName},
+	{ErrMultiplePrimaryKeysDefined, mysql.ERMultiplePriKey, mysql.SSClientError},
+	{ErrWrongAutoKey, mysql.ERWrongAutoKey, mysql.SSClientError},
+	{ErrInvalidAutoIncCols, mysql.ERWrongAutoKey, mysql.SSClientError},
+	{ErrKeyColumnDoesNotExist, mysql.ERKeyColumnDoesNotExist, mysql.SSClientError},
+	{ErrUnknownIndexColumn, mysql.ERKeyColumnDoesNotExist, mysql.SSClientError},
+	{ErrCantDropFieldOrKey, mysql.ERCantDropFieldOrKey, mysql.SSClientError},
+	{ErrForeignKeyNotFound, mysql.ERCantDropFieldOrKey, mysql.SSClientError},
+	{ErrTooManyKeyParts, mysql.ERTooManyKeyParts, mysql.SSClientError},
+	{ErrKeyTooLong, mysql.ERTooLongKey, mysql.SSClientError},
+	{ErrInvalidBlobTextKey, mysql.ERBlobKeyWithoutLength, mysql.SSClientError},
+	{ErrForeignKeyTextBlob, mysql.ERBlobKeyWithoutLength, mysql.SSClientError},
+	{ErrInvalidIndexPrefix, mysql.ERWrongSubKey, mysql.SSUnknownSQLState},
+	{ErrInvalidColumnDefaultValue, mysql.ERInvalidDefault, mysql.SSClientError},
+	{ErrIncompatibleDefaultType, mysql.ERInvalidDefault, mysql.SSClientError},
+	{ErrInvalidTextBlobColumnDefault, mysql.ERBlobCantHaveDefault, mysql.SSClientError},
+	{ErrColumnCountMismatch, 1353, mysql.SSUnknownSQLState},             // ER_VIEW_WRONG_LIST
+	{ErrUnknownConstraint, 3940, mysql.SSUnknownSQLState},               // ER_CONSTRAINT_NOT_FOUND
+	{ErrCantDropIndex, 1553, mysql.SSUnknownSQLState},                   // ER_DROP_INDEX_FK
+	{ErrForeignKeyDropIndex, 1553, mysql.SSUnknownSQLState},             // ER_DROP_INDEX_FK
+	{ErrForeignKeyMissingReferenceIndex, 1822, mysql.SSUnknownSQLState}, // ER_FK_NO_INDEX_PARENT
+	{ErrForeignKeyDuplicateName, 1826, mysql.SSUnknownSQLState},         // ER_FK_DUP_NAME
+	{ErrForeignKeyDropColumn, 1828, mysql.SSUnknownSQLState},            // ER_FK_COLUMN_CANNOT_DROP
+	{ErrForeignKeySetNullNonNullable, 1830, mysql.SSUnknownSQLState},    // ER_FK_COLUMN_NOT_NULL
+	{ErrForeignKeyTypeChange, 1832, mysql.SSUnknownSQLState},            // ER_FK_COLUMN_CANNOT_CHANGE
+	{ErrForeignKeyDropTable, 3730, mysql.SSUnknownSQLState},             // ER_FK_CANNOT_DROP_PARENT
+	{ErrForeignKeyColumnTypeMismatch, 3780, mysql.SSUnknownSQLState},    // ER_FK_INCOMPATIBLE_COLUMNS
+	{ErrTruncateReferencedFromForeignKey, 1701, mysql.SSClientError},    // ER_TRUNCATE_ILLEGAL_FK
+	{ErrTriggerDoesNotExist, 1360, mysql.SSUnknownSQLState},             // ER_TRG_DOES_NOT_EXIST
+	{ErrStoredProcedureAlreadyExists, 1304, mysql.SSClientError},        // ER_SP_ALREADY_EXISTS
+	{ErrStoredProcedureDoesNotExist, mysql.ERSPDoesNotExist, mysql.SSClientError},
+
+	// Variables
+	{ErrUnknownSystemVariable, mysql.ERUnknownSystemVariable, mysql.SSUnknownSQLState},
+	{ErrInvalidSystemVariableValue, mysql.ERWrongValueForVar, mysql.SSClientError},
+	{ErrSystemVariableReadOnly, mysql.ERIncorrectGlobalLocalVar, mysql.SSUnknownSQLState},
+	{ErrSystemVariableSessionOnly, mysql.ERLocalVariable, mysql.SSUnknownSQLState},
+	{ErrSystemVariableGlobalOnly, mysql.ERGlobalVariable, mysql.SSUnknownSQLState},
+
+	// Prepared statements
+	{ErrUnknownPreparedStatement, 1243, mysql.SSUnknownSQLState}, // ER_UNKNOWN_STMT_HANDLER
+
+	// Stored procedures, functions and triggers
+	{ErrCallIncorrectParameterCount, 1318, mysql.SSClientError},  // ER_SP_WRONG_NO_OF_ARGS
+	{ErrProcedureRecursiveCall, 1456, mysql.SSUnknownSQLState},   // ER_SP_RECURSION_LIMIT
+	{ErrLoopLabelNotFound, 1308, mysql.SSClientError},            // ER_SP_LILABEL_MISMATCH
+	{ErrLoopRedefinition, 1309, mysql.SSClientError},             // ER_SP_LABEL_REDEFINE
+	{ErrDeclareConditionNotFound, 1319, mysql.SSClientError},     // ER_SP_COND_MISMATCH
+	{ErrCursorNotFound, 1324, mysql.SSClientError},               // ER_SP_CURSOR_MISMATCH
+	{ErrCursorAlreadyOpen, 1325, "24000"},                        // ER_SP_CURSOR_ALREADY_OPEN
+	{ErrCursorNotOpen, 1326, "24000"},                            // ER_SP_CURSOR_NOT_OPEN
+	{ErrFetchIncorrectCount, 1328, mysql.SSUnknownSQLState},      // ER_SP_WRONG_NO_OF_FETCH_ARGS
+	{ErrFetchNoData, 1329, "02000"},                              // ER_SP_FETCH_NO_DATA
+	{ErrDeclareVariableDuplicate, 1331, mysql.SSClientError},     // ER_SP_DUP_VAR
+	{ErrDeclareConditionDuplicate, 1332, mysql.SSClientError},    // ER_SP_DUP_COND
+	{ErrDeclareCursorDuplicate, 1333, mysql.SSClientError},       // ER_SP_DUP_CURS
+	{ErrDeclareVariableOrderInvalid, 1337, mysql.SSClientError},  // ER_SP_VARCOND_AFTER_CURSHNDLR
+	{ErrDeclareConditionOrderInvalid, 1337, mysql.SSClientError}, // ER_SP_VARCOND_AFTER_CURSHNDLR
+	{ErrDeclareCursorOrderInvalid, 1338, mysql.SSClientError},    // ER_SP_CURSOR_AFTER_HANDLER
+	{ErrDeclareHandlerDuplicate, 1413, mysql.SSClientError},      // ER_SP_DUP_HANDLER
+	{ErrSignalOnlySqlState, 1646, mysql.SSUnknownSQLState},       // ER_SIGNAL_BAD_CONDITION_TYPE
+	{ErrStackedDiagnosticsWithoutHandler, 1887, "0Z002"},         // ER_GET_STACKED_DA_WITHOUT_ACTIVE_HANDLER
+	{ErrInvalidConditionNumber, 1758, "35000"},                   // ER_DA_INVALID_CONDITION_NUMBER
+	{ErrStoredFunctionAlreadyExists, 1304, mysql.SSClientError},  // ER_SP_ALREADY_EXISTS
+	{ErrStoredFunctionDoesNotExist, mysql.ERSPDoesNotExist, mysql.SSClientError},
+	{ErrStoredFunctionRecursion, 1424, mysql.SSUnknownSQLState},     // ER_SP_NO_RECURSION
+	{ErrReturnOutsideFunction, 1313, mysql.SSClientError},           // ER_SP_BADRETURN
+	{ErrStoredFunctionNoReturn, 1320, mysql.SSClientError},          // ER_SP_NORETURN
+	{ErrStoredFunctionEndedWithoutReturn, 1321, "2F005"},            // ER_SP_NORETURNEND
+	{ErrStoredFunctionResultSet, 1415, "0A000"},                     // ER_SP_NO_RETSET
+	{ErrDynamicSQLInStoredFunction, 1336, "0A000"},                  // ER_STMT_NOT_ALLOWED_IN_SF_OR_TRG
+	{ErrTriggerTableInUse, 1442, mysql.SSUnknownSQLState},           // ER_CANT_UPDATE_USED_TABLE_IN_SF_OR_TRG
+	{ErrInvalidUpdateOfOldRow, 1362, mysql.SSUnknownSQLState},       // ER_TRG_CANT_CHANGE_ROW
+	{ErrInvalidUpdateInAfterTrigger, 1362, mysql.SSUnknownSQLState}, // ER_TRG_CANT_CHANGE_ROW
+	{ErrInvalidUseOfOldNew, 1363, mysql.SSUnknownSQLState},          // ER_TRG_NO_SUCH_ROW_IN_TRG
+	{ErrSavepointDoesNotExist, mysql.ERSPDoesNotExist, mysql.SSClientError},
+
+	// HANDLER statements
+	{ErrHandlerAlreadyOpen, mysql.ERNonUniqTable, mysql.SSClientError},
+	{ErrUnknownHandler, mysql.ERUnknownTable, mysql.SSUnknownTable},
+	{ErrHandlerIndexNotFound, mysql.ERKeyDoesNotExist, mysql.SSUnknownSQLState},
+
+	// Transactions
+	{ErrLockDeadlock, mysql.ERLockDeadlock, mysql.SSLockDeadlock},
+	{ErrXAUnknownXID, 1397, "XAE04"},   // ER_XAER_NOTA
+	{ErrXAInvalidState, 1399, "XAE07"}, // ER_XAER_RMFAIL
+	{ErrXAOutside, 1400, "XAE09"},      // ER_XAER_OUTSIDE
+	{ErrXARollback, 1402, "XA100"},     // ER_XA_RBROLLBACK
+	{ErrXADuplicateXID, 1440, "XAE08"}, // ER_XAER_DUPID
+
+	// Users and privileges
+	{ErrDatabaseAccessDeniedForUser, mysql.ERDBAccessDenied, mysql.SSClientError},
+	{ErrTableAccessDeniedForUser, 1142, mysql.SSClientError}, // ER_TABLEACCESS_DENIED_ERROR
+	{ErrPrivilegeCheckFailed, 1142, mysql.SSClientError},     // ER_TABLEACCESS_DENIED_ERROR
+	{ErrGrantRevokeIllegalPrivilege, mysql.ERIllegalGrantForTable, mysql.SSClientError},
+	{ErrGrantRevokeIllegalPrivilegeWithMessage, mysql.ERIllegalGrantForTable, mysql.SSClientError},
+	{ErrRevokeUserDoesNotExist, mysql.ERNonExistingGrant, mysql.SSClientError},
+	{ErrShowGrantsUserDoesNotExist, mysql.ERNonExistingGrant, mysql.SSClientError},
+	{ErrUserCreationFailure, 1396, mysql.SSUnknownSQLState},         // ER_CANNOT_USER
+	{ErrUserDeletionFailure, 1396, mysql.SSUnknownSQLState},         // ER_CANNOT_USER
+	{ErrRoleCreationFailure, 1396, mysql.SSUnknownSQLState},         // ER_CANNOT_USER
+	{ErrRoleDeletionFailure, 1396, mysql.SSUnknownSQLState},         // ER_CANNOT_USER
+	{ErrGrantUserDoesNotExist, 1410, mysql.SSClientError},           // ER_CANT_CREATE_USER_WITH_GRANT
+	{ErrGrantRevokeRoleDoesNotExist, 3523, mysql.SSUnknownSQLState}, // ER_UNKNOWN_AUTHID
+}
+
+var errorCodesMu sync.RWMutex
+
+// RegisterErrorCode registers the MySQL error number and SQLSTATE that errors of the kinds given are reported to
+// clients with, for the error kinds defined outside of this package, such as by integrators. Registrations take
+// precedence over earlier registrations of the same kinds.
+func RegisterErrorCode(code int, sqlState string, kinds ...*errors.Kind) {
+	errorCodesMu.Lock()
+	defer errorCodesMu.Unlock()
+	for _, kind := range kinds {
+		errorCodes = append(errorCodes, errorCode{kind: kind, code: code, sqlState: sqlState})
+	}
+}
+
+// ErrorCode returns the MySQL error number and SQLSTATE of the error given. Errors that wrap other errors, through
+// causes or wrapping error types, have the code of the outermost error with a code. Errors without one have the code
+// ER_UNKNOWN_ERROR.
+func ErrorCode(err error) (int, string) {
+	for err != nil {
+		if code, ok := errorCodeOf(err); ok {
+			return code.code, code.sqlState
+		}
+		switch e := err.(type) {
+		case *mysql.SQLError:
+			return e.Num, e.State
+		case WrappedInsertError:
+			err = e.Cause
+		case WrappedTypeConversionError:
+			err = e.Err
+		case *errors.Error:
+			err = e.Cause()
+		default:
+			err = goerrors.Unwrap(err)
+		}
+	}
+	return mysql.ERUnknownError, mysql.SSUnknownSQLState
+}
+
+// errorCodeOf returns the code of the error given, without considering the errors it wraps.
+func errorCodeOf(err error) (errorCode, bool) {
+	switch err {
+	case context.Canceled:
+		return errorCode{code: mysql.ERQueryInterrupted, sqlState: mysql.SSQueryInterrupted}, true
+	case context.DeadlineExceeded:
+		return errorCode{code: mysql.ERQueryTimeout, sqlState: mysql.SSUnknownSQLState}, true
+	}
+
+	e, ok := err.(*errors.Error)
+	if !ok {
+		return errorCode{}, false
+	}
+	errorCodesMu.RLock()
+	defer errorCodesMu.RUnlock()
+	// Kind.Is matches the causes of errors too, which are only considered after the error itself
+	for i := len(errorCodes) - 1; i >= 0; i-- {
+		if errorCodes[i].kind.Is(e) && !errorCodes[i].kind.Is(e.Cause()) {
+			return errorCodes[i], true
+		}
+	}
+	return errorCode{}, false
+}
@@ -82,9 +82,18 @@ var (
 	// ErrRenameTableNotSupported is thrown when the database doesn't support renaming tables
 	ErrRenameTableNotSupported = errors.NewKind("tables cannot be renamed on database %s")
 
+	// ErrRenameDatabaseNotSupported is thrown when the database provider doesn't support renaming databases
+	ErrRenameDatabaseNotSupported = errors.NewKind("database %s cannot be renamed")
+
 	// ErrDatabaseCollationsNotSupported is thrown when a database does not allow updating its collation
 	ErrDatabaseCollationsNotSupported = errors.NewKind("database %s does not support collation operations")
 
+	// ErrDatabaseEncryptionNotSupported is thrown when a database does not allow updating its default encryption
+	ErrDatabaseEncryptionNotSupported = errors.NewKind("database %s does not support encryption operations")
+
+	// ErrInvalidEncryptionOption is thrown when a DEFAULT ENCRYPTION clause is given a value other than 'Y' or 'N'
+	ErrInvalidEncryptionOption = errors.NewKind("Invalid encryption option.")
+
 	// ErrTableCreatedNotFound is thrown when a table is created from CREATE TABLE but cannot be found immediately afterward
 	ErrTableCreatedNotFound = errors.NewKind("table was created but could not be found")
 
@@ -154,6 +163,47 @@ var (
 	// ErrDropColumnReferencedInDefault is returned when a column cannot be dropped as it is referenced by another column's default value.
 	ErrDropColumnReferencedInDefault = errors.NewKind(`cannot drop column "%s" as default value of column "%s" references it`)
 
+	// ErrGeneratedColumnWithDefault is returned when a generated column is declared with a default value.
+	ErrGeneratedColumnWithDefault = errors.NewKind("Incorrect usage of DEFAULT and generated column")
+
+	// ErrGeneratedColumnAutoIncrement is returned when a generated column is declared as auto-incrementing.
+	ErrGeneratedColumnAutoIncrement = errors.NewKind("Incorrect usage of AUTO_INCREMENT and generated column")
+
+	// ErrGeneratedColumnFunction is returned when the expression of a generated column contains a function or subquery
+	// that isn't deterministic.
+	ErrGeneratedColumnFunction = errors.NewKind("Expression of generated column '%s' contains a disallowed function.")
+
+	// ErrGeneratedColumnOrder is returned when a generated column references a generated column defined after it.
+	ErrGeneratedColumnOrder = errors.NewKind("Generated column can refer only to generated columns defined prior to it.")
+
+	// ErrGeneratedColumnRefAutoIncrement is returned when a generated column references an auto-increment column.
+	ErrGeneratedColumnRefAutoIncrement = errors.NewKind("Generated column '%s' cannot refer to auto-increment column.")
+
+	// ErrGeneratedColumnValue is returned when a value other than DEFAULT is inserted in or assigned to a generated
+	// column.
+	ErrGeneratedColumnValue = errors.NewKind("The value specified for generated column '%s' in table '%s' is not allowed.")
+
+	// ErrColumnReferencedInGenerated is returned when a column cannot be dropped or renamed as it is referenced by a
+	// generated column.
+	ErrColumnReferencedInGenerated = errors.NewKind("Column '%s' has a generated column dependency.")
+
+	// ErrFunctionalIndexOnField is returned when a functional key part of an index is only a column.
+	ErrFunctionalIndexOnField = errors.NewKind("Functional index on a column is not supported. Consider using a regular index instead.")
+
+	// ErrFunctionalIndexPrimaryKey is returned when a primary key has a functional key part.
+	ErrFunctionalIndexPrimaryKey = errors.NewKind("The primary key cannot be a functional index")
+
+	// ErrFunctionalIndexFunction is returned when a functional key part of an index contains a function or subquery that
+	// isn't deterministic.
+	ErrFunctionalIndexFunction = errors.NewKind("Expression of functional index '%s' contains a disallowed function.")
+
+	// ErrFunctionalIndexNotSupported is returned when an index with functional key parts can't be created.
+	ErrFunctionalIndexNotSupported = errors.NewKind("functional key parts are not supported in %s indexes")
+
+	// ErrColumnReferencedInFunctionalIndex is returned when a column cannot be dropped or renamed as it is referenced by
+	// a functional key part of an index.
+	ErrColumnReferencedInFunctionalIndex = errors.NewKind("Column '%s' has a functional index dependency and cannot be dropped or renamed.")
+
 	// ErrTriggersNotSupported is returned when attempting to create a trigger on a database that doesn't support them
 	ErrTriggersNotSupported = errors.NewKind(`database "%s" doesn't support triggers`)
 
@@ -489,6 +539,10 @@ var (
 	// ErrInvalidValue is returned when a given value does not match what is expected.
 	ErrInvalidValue = errors.NewKind(`error: '%v' is not a valid value for '%v'`)
 
+	// ErrTruncatedIncorrectValue is returned when a value is truncated to convert it for a comparison, and the
+	// strict_comparisons system variable is set.
+	ErrTruncatedIncorrectValue = errors.NewKind(`Truncated incorrect %s value: '%v'`)
+
 	// ErrInvalidValueType is returned when a given value's type does not match what is expected.
 	ErrInvalidValueType = errors.NewKind(`error: '%T' is not a valid value type for '%v'`)
 
@@ -507,6 +561,10 @@ var (
 	ErrNonAggregatedColumnWithoutGroupBy = errors.NewKind("in aggregated query without GROUP BY, expression #%d of SELECT list contains nonaggregated column '%s'; " +
 		"this is incompatible with sql_mode=only_full_group_by")
 
+	// ErrGroupingArgumentNotGrouped is returned when an argument of the GROUPING function isn't a grouping expression.
+	// MySQL error code: 3601, SQL state: HY000
+	ErrGroupingArgumentNotGrouped = errors.NewKind("Argument #%d of GROUPING function is not in GROUP BY")
+
 	// ErrInvalidArgumentNumber is returned when the number of arguments to call a
 	// function is different from the function arity.
 	ErrInvalidArgumentNumber = errors.NewKind("function '%s' expected %v arguments, %v received")
@@ -540,12 +598,169 @@ var (
 	// are automatically rolled back. Clients receiving this error must retry the transaction.
 	ErrLockDeadlock = errors.NewKind("serialization failure: %s, try restarting transaction.")
 
+	// ErrTwoPhaseCommitPrepare is returned when a database written by a transaction fails to prepare its commit, in
+	// which case the transaction is rolled back in all of the databases it wrote to.
+	ErrTwoPhaseCommitPrepare = errors.NewKind("unable to commit transaction, database %s failed to prepare: %s")
+
+	// ErrXAInvalidState is returned when an XA statement, or a statement that isn't allowed in an XA transaction, is
+	// executed while the session's XA transaction is in the state given.
+	ErrXAInvalidState = errors.NewKind("XAER_RMFAIL: The command cannot be executed when global transaction is in the %s state")
+
+	// ErrXAUnknownXID is returned when an XA statement refers to an XA transaction that doesn't exist.
+	ErrXAUnknownXID = errors.NewKind("XAER_NOTA: Unknown XID")
+
+	// ErrXADuplicateXID is returned by XA START when an XA transaction with the same XID already exists.
+	ErrXADuplicateXID = errors.NewKind("XAER_DUPID: The XID already exists")
+
+	// ErrXAOutside is returned by XA START when the session has work pending in a transaction that isn't an XA
+	// transaction.
+	ErrXAOutside = errors.NewKind("XAER_OUTSIDE: Some work is done outside global transaction")
+
+	// ErrXARollback is returned when an XA transaction is rolled back because it failed to prepare.
+	ErrXARollback = errors.NewKind("XA_RBROLLBACK: Transaction branch was rolled back: %s")
+
 	// ErrExistingView is returned when a CREATE VIEW statement uses a name that already exists
 	ErrExistingView = errors.NewKind("the view %s.%s already exists")
 
 	// ErrViewDoesNotExist is returned when a DROP VIEW statement drops a view that does not exist
 	ErrViewDoesNotExist = errors.NewKind("the view %s.%s does not exist")
 
+	// ErrViewNotUpdatable is returned when an INSERT, UPDATE or DELETE targets a view that can't be rewritten as a
+	// write against a single base table
+	ErrViewNotUpdatable = errors.NewKind("The target table %s of the %s is not updatable")
+
+	// ErrViewColumnNotUpdatable is returned when a write through a view targets a view column that isn't a plain
+	// reference to a base table column
+	ErrViewColumnNotUpdatable = errors.NewKind("Column '%s' is not updatable")
+
+	// ErrViewCheckOptionFailed is returned when a row written through a view WITH CHECK OPTION wouldn't be visible
+	// through that view
+	ErrViewCheckOptionFailed = errors.NewKind("CHECK OPTION failed '%s'")
+
+	// ErrRowPolicyViolated is returned when a row written to a table doesn't satisfy the row policy of that table
+	ErrRowPolicyViolated = errors.NewKind("row policy violated for table '%s'")
+
+	// ErrRowPolicyUnsupported is returned for statements that can't be restricted by the row policy of their table
+	ErrRowPolicyUnsupported = errors.NewKind("%s is not supported for table '%s', which has a row policy")
+
+	// ErrColumnMaskUnsupported is returned for statements that can't mask the columns of their table that have a column
+	// mask
+	ErrColumnMaskUnsupported = errors.NewKind("%s is not supported for table '%s', which has a column mask")
+
+	// ErrInvalidRewriteRule is returned when a query rewrite rule can't be used
+	ErrInvalidRewriteRule = errors.NewKind("invalid rewrite rule for pattern '%s': %s")
+
+	// ErrInvalidRewriteRulesTable is returned when rewrite rules are loaded from a table without the columns they need
+	ErrInvalidRewriteRulesTable = errors.NewKind("table '%s' must have pattern and replacement columns to load rewrite rules from it")
+
+	// ErrInvalidDigestStatement is returned when the digest of a statement that can't be tokenized is requested
+	ErrInvalidDigestStatement = errors.NewKind("cannot compute the digest of a statement with the unexpected character '%s'")
+
+	// ErrQueryThrottled is returned when a query exceeds a limit of the query throttler and can't be queued
+	ErrQueryThrottled = errors.NewKind("query rejected: the %s limit of %v for its statement digest or user was exceeded")
+
+	// ErrUnknownStorageEngine is returned when a statement names a storage engine that doesn't exist
+	ErrUnknownStorageEngine = errors.NewKind("Unknown storage engine '%s'")
+
+	// ErrIteratorUnresponsive is returned when an iterator of a table doesn't return within the grace period after its
+	// query is canceled
+	ErrIteratorUnresponsive = errors.NewKind("query canceled: a table iterator didn't return within %v of the cancelation")
+
+	// ErrResultLimitExceeded is returned when the result of a query exceeds the limit set by @@max_result_rows or
+	// @@max_result_size
+	ErrResultLimitExceeded = errors.NewKind("query aborted: its result exceeds @@%s = %d")
+
+	// ErrServiceNotRegistered is returned when a service required by a rule, function or table isn't registered in the
+	// service registry of the context
+	ErrServiceNotRegistered = errors.NewKind("the service %s is not registered")
+
+	// ErrMaterializedViewExists is returned when a CREATE MATERIALIZED VIEW statement uses a name that already exists
+	ErrMaterializedViewExists = errors.NewKind("the materialized view %s.%s already exists")
+
+	// ErrMaterializedViewDoesNotExist is returned when a statement refreshes or drops a materialized view that doesn't
+	// exist
+	ErrMaterializedViewDoesNotExist = errors.NewKind("the materialized view %s.%s does not exist")
+
+	// ErrMaterializedViewNotQuery is returned when a materialized view is defined by a statement other than a query
+	ErrMaterializedViewNotQuery = errors.NewKind("materialized view %s must be defined by a SELECT statement")
+
+	// ErrInvalidRefreshInterval is returned for an interval of a materialized view that isn't a positive number of a
+	// supported unit
+	ErrInvalidRefreshInterval = errors.NewKind("invalid interval '%s' for materialized view, expected a positive number of SECOND, MINUTE, HOUR, DAY or WEEK")
+
+	// ErrInvalidSystemVersioning is returned for a CREATE TABLE statement with an invalid WITH SYSTEM VERSIONING
+	// definition
+	ErrInvalidSystemVersioning = errors.NewKind("invalid system versioning: %s")
+
+	// ErrSystemVersioningNotSupported is returned when creating a system-versioned table in a database whose tables
+	// can't be system-versioned
+	ErrSystemVersioningNotSupported = errors.NewKind("table %s cannot be system-versioned")
+
+	// ErrSystemVersionedTableAlter is returned when altering the columns of a system-versioned table, which would
+	// invalidate the past versions of its rows
+	ErrSystemVersionedTableAlter = errors.NewKind("the columns of system-versioned table %s cannot be altered")
+
+	// ErrChangeCaptureNotSupported is returned when subscribing to the changes of a table that doesn't notify them
+	ErrChangeCaptureNotSupported = errors.NewKind("the changes of table %s cannot be captured")
+
+	// ErrChangeSubscriptionClosed is returned when reading the changes of a subscription that has been closed
+	ErrChangeSubscriptionClosed = errors.NewKind("the change subscription is closed")
+
+	// ErrChangeSubscriptionLagged is returned when reading the changes of a subscription that missed changes, because
+	// a transaction was canceled while waiting for the subscription to have room for its changes
+	ErrChangeSubscriptionLagged = errors.NewKind("the change subscription missed changes: %s")
+
+	// ErrOnlineIndexBuildNotSupported is returned by an OnlineIndexBuilder for an index it can't build online, which
+	// the engine then creates with CreateIndex
+	ErrOnlineIndexBuildNotSupported = errors.NewKind("index %s of table %s cannot be built online")
+
+	// ErrHandlerAlreadyOpen is returned by HANDLER ... OPEN when the session already has a handler with the same name
+	ErrHandlerAlreadyOpen = errors.NewKind("Not unique table/alias: '%s'")
+
+	// ErrUnknownHandler is returned when a HANDLER statement names a handler the session hasn't opened
+	ErrUnknownHandler = errors.NewKind("Unknown table '%s' in HANDLER")
+
+	// ErrHandlerIndexNotFound is returned when HANDLER ... READ names an index the table of the handler doesn't have
+	ErrHandlerIndexNotFound = errors.NewKind("Key '%s' doesn't exist in table '%s'")
+
+	// ErrFetchNoData is the condition raised when a FETCH finds no more rows in its cursor, which NOT FOUND handlers
+	// handle
+	ErrFetchNoData = errors.NewKind("No data - zero rows fetched, selected, or processed")
+
+	// ErrStackedDiagnosticsWithoutHandler is returned by GET STACKED DIAGNOSTICS outside of a condition handler
+	ErrStackedDiagnosticsWithoutHandler = errors.NewKind("GET STACKED DIAGNOSTICS when handler not active")
+
+	// ErrInvalidConditionNumber is returned by GET DIAGNOSTICS for a condition number that isn't in the diagnostics area
+	ErrInvalidConditionNumber = errors.NewKind("Invalid condition number")
+
+	// ErrStoredFunctionsNotSupported is returned when attempting to create a stored function on a database that doesn't support them.
+	ErrStoredFunctionsNotSupported = errors.NewKind(`database "%s" doesn't support stored functions`)
+
+	// ErrStoredFunctionAlreadyExists is returned when creating a stored function with the name of an existing one.
+	ErrStoredFunctionAlreadyExists = errors.NewKind("FUNCTION %s already exists")
+
+	// ErrStoredFunctionDoesNotExist is returned when a stored function does not exist.
+	ErrStoredFunctionDoesNotExist = errors.NewKind("FUNCTION %s does not exist")
+
+	// ErrStoredFunctionRecursion is returned when a stored function calls itself, directly or indirectly.
+	ErrStoredFunctionRecursion = errors.NewKind("Recursive stored functions and triggers are not allowed.")
+
+	// ErrReturnOutsideFunction is returned when a RETURN statement is used outside of a stored function.
+	ErrReturnOutsideFunction = errors.NewKind("RETURN is only allowed in a FUNCTION")
+
+	// ErrStoredFunctionNoReturn is returned when creating a stored function without a RETURN statement.
+	ErrStoredFunctionNoReturn = errors.NewKind("No RETURN found in FUNCTION %s")
+
+	// ErrStoredFunctionEndedWithoutReturn is returned when a stored function finishes without running a RETURN statement.
+	ErrStoredFunctionEndedWithoutReturn = errors.NewKind("FUNCTION %s ended without RETURN")
+
+	// ErrStoredFunctionResultSet is returned when the body of a stored function has a statement that returns rows.
+	ErrStoredFunctionResultSet = errors.NewKind("Not allowed to return a result set from a function")
+
+	// ErrDynamicSQLInStoredFunction is returned when the body of a stored function has a PREPARE, EXECUTE or
+	// DEALLOCATE PREPARE statement.
+	ErrDynamicSQLInStoredFunction = errors.NewKind("Dynamic SQL is not allowed in stored function or trigger")
+
 	// ErrSessionDoesNotSupportPersistence is thrown when a feature is not already supported
 	ErrSessionDoesNotSupportPersistence = errors.NewKind("session does not support persistence")
 
@@ -786,9 +1001,6 @@ func CastSQLError(err error) *mysql.SQLError {
 		return mysqlErr
 	}
 
-	var code int
-	var sqlState string = ""
-
 	if w, ok := err.(WrappedInsertError); ok {
 		return CastSQLError(w.Cause)
 	}
@@ -797,59 +1009,7 @@ func CastSQLError(err error) *mysql.SQLError {
 		return CastSQLError(wm.Err)
 	}
 
-	switch {
-	case ErrTableNotFound.Is(err):
-		code = mysql.ERNoSuchTable
-	case ErrDatabaseExists.Is(err):
-		code = mysql.ERDbCreateExists
-	case ErrExpectedSingleRow.Is(err):
-		code = mysql.ERSubqueryNo1Row
-	case ErrInvalidOperandColumns.Is(err):
-		code = mysql.EROperandColumns
-	case ErrInsertIntoNonNullableProvidedNull.Is(err):
-		code = mysql.ERBadNullError
-	case ErrNonAggregatedColumnWithoutGroupBy.Is(err):
-		code = mysql.ERMixOfGroupFuncAndFields
-	case ErrPrimaryKeyViolation.Is(err):
-		code = mysql.ERDupEntry
-	case ErrUniqueKeyViolation.Is(err):
-		code = mysql.ERDupEntry
-	case ErrPartitionNotFound.Is(err):
-		code = 1526 // TODO: Needs to be added to vitess
-	case ErrForeignKeyChildViolation.Is(err):
-		code = mysql.ErNoReferencedRow2 // test with mysql returns 1452 vs 1216
-	case ErrForeignKeyParentViolation.Is(err):
-		code = mysql.ERRowIsReferenced2 // test with mysql returns 1451 vs 1215
-	case ErrDuplicateEntry.Is(err):
-		code = mysql.ERDupEntry
-	case ErrInvalidJSONText.Is(err):
-		code = 3141 // TODO: Needs to be added to vitess
-	case ErrMultiplePrimaryKeysDefined.Is(err):
-		code = mysql.ERMultiplePriKey
-	case ErrWrongAutoKey.Is(err):
-		code = mysql.ERWrongAutoKey
-	case ErrKeyColumnDoesNotExist.Is(err):
-		code = mysql.ERKeyColumnDoesNotExist
-	case ErrCantDropFieldOrKey.Is(err):
-		code = mysql.ERCantDropFieldOrKey
-	case ErrReadOnlyTransaction.Is(err):
-		code = 1792 // TODO: Needs to be added to vitess
-	case ErrCantDropIndex.Is(err):
-		code = 1553 // TODO: Needs to be added to vitess
-	case ErrInvalidValue.Is(err):
-		code = mysql.ERTruncatedWrongValueForField
-	case ErrLockDeadlock.Is(err):
-		// ER_LOCK_DEADLOCK signals that the transaction was rolled back
-		// due to a deadlock between concurrent transactions.
-		// MySQL maps this error to the ANSI SQLSTATE code of 40001 which
-		// has the more general meaning of "serialization failure".
-		// 	https://mariadb.com/kb/en/mariadb-error-codes/
-		// 	https://en.wikipedia.org/wiki/SQLSTATE
-		code = mysql.ERLockDeadlock
-		sqlState = mysql.SSLockDeadlock
-	default:
-		code = mysql.ERUnknownError
-	}
+	code, sqlState := ErrorCode(err)
 
 	// This uses the given error as a format string, so we have to escape any percentage signs else they'll show up as "%!(MISSING)"
 	return mysql.NewSQLError(code, sqlState, strings.Replace(err.Error(), `%`, `%%`, -1))
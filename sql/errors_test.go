@@ -1,6 +1,7 @@
 package sql
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
 )
 
 func TestSQLErrorCast(t *testing.T) {
@@ -17,6 +19,11 @@ func TestSQLErrorCast(t *testing.T) {
 		code int
 	}{
 		{ErrTableNotFound.New("table not found err"), mysql.ERNoSuchTable},
+		{NewWrappedInsertError(Row{1}, ErrPrimaryKeyViolation.New()), mysql.ERDupEntry},
+		{fmt.Errorf("wrapped: %w", ErrColumnNotFound.New("c")), mysql.ERBadFieldError},
+		{ErrInvalidType.Wrap(ErrDatabaseNotFound.New("db"), "t"), mysql.ERBadDb},
+		{ErrTableAlreadyExists.Wrap(ErrDatabaseNotFound.New("db"), "t"), mysql.ERTableExists},
+		{context.Canceled, mysql.ERQueryInterrupted},
 		{ErrInvalidType.New("unhandled mysql error"), mysql.ERUnknownError},
 		{fmt.Errorf("generic error"), mysql.ERUnknownError},
 		{nil, mysql.ERUnknownError},
@@ -35,3 +42,25 @@ func TestSQLErrorCast(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorCode(t *testing.T) {
+	code, sqlState := ErrorCode(ErrTableNotFound.New("t"))
+	require.Equal(t, mysql.ERNoSuchTable, code)
+	require.Equal(t, mysql.SSUnknownTable, sqlState)
+
+	code, sqlState = ErrorCode(fmt.Errorf("unknown"))
+	require.Equal(t, mysql.ERUnknownError, code)
+	require.Equal(t, mysql.SSUnknownSQLState, sqlState)
+
+	code, sqlState = ErrorCode(fmt.Errorf("wrapped: %w", mysql.NewSQLError(mysql.ERLockWaitTimeout, "HY000", "timeout")))
+	require.Equal(t, mysql.ERLockWaitTimeout, code)
+	require.Equal(t, mysql.SSUnknownSQLState, sqlState)
+
+	errCustom := errors.NewKind("custom error")
+	code, _ = ErrorCode(errCustom.New())
+	require.Equal(t, mysql.ERUnknownError, code)
+	RegisterErrorCode(mysql.ERNotSupportedYet, mysql.SSClientError, errCustom)
+	code, sqlState = ErrorCode(errCustom.New())
+	require.Equal(t, mysql.ERNotSupportedYet, code)
+	require.Equal(t, mysql.SSClientError, sqlState)
+}
@@ -85,6 +85,7 @@ type Alias struct {
 }
 
 var _ sql.Expression = (*Alias)(nil)
+var _ sql.Expression2 = (*Alias)(nil)
 var _ sql.CollationCoercible = (*Alias)(nil)
 
 // NewAlias returns a new Alias node.
@@ -107,6 +108,16 @@ func (e *Alias) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return e.Child.Eval(ctx, row)
 }
 
+// Eval2 implements the sql.Expression2 interface.
+func (e *Alias) Eval2(ctx *sql.Context, row sql.Row2) (sql.Value, error) {
+	return e.Child.(sql.Expression2).Eval2(ctx, row)
+}
+
+// Type2 implements the sql.Expression2 interface.
+func (e *Alias) Type2() sql.Type2 {
+	return e.Child.(sql.Expression2).Type2()
+}
+
 func (e *Alias) String() string {
 	return fmt.Sprintf("%s as %s", e.Child, e.name)
 }
@@ -41,7 +41,7 @@ var (
 )
 
 func arithmeticWarning(ctx *sql.Context, errCode int, errMsg string) {
-	ctx.Session.Warn(&sql.Warning{
+	ctx.AddWarning(&sql.Warning{
 		Level:   "Warning",
 		Code:    errCode,
 		Message: errMsg,
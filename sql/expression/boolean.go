@@ -17,6 +17,8 @@ package expression
 import (
 	"fmt"
 
+	"github.com/dolthub/vitess/go/vt/proto/query"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -27,6 +29,7 @@ type Not struct {
 }
 
 var _ sql.Expression = (*Not)(nil)
+var _ sql.Expression2 = (*Not)(nil)
 var _ sql.CollationCoercible = (*Not)(nil)
 
 // NewNot returns a new Not node.
@@ -65,6 +68,50 @@ func (e *Not) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return !b, nil
 }
 
+// Eval2 implements the sql.Expression2 interface.
+func (e *Not) Eval2(ctx *sql.Context, row sql.Row2) (sql.Value, error) {
+	v, err := e.Child.(sql.Expression2).Eval2(ctx, row)
+	if err != nil {
+		return sql.Value{}, err
+	}
+	if v.IsNull() {
+		return nullValue2, nil
+	}
+
+	b, err := isTrue2(v)
+	if err != nil {
+		return sql.Value{}, err
+	}
+	return boolValue2(!b), nil
+}
+
+// Type2 implements the sql.Expression2 interface.
+func (e *Not) Type2() sql.Type2 {
+	return types.Boolean.(sql.Type2)
+}
+
+// The values that boolean expressions evaluate to on row frames.
+var (
+	nullValue2     = sql.Value{Typ: query.Type_NULL_TYPE}
+	falseValue2, _ = sql.ConvertToValue(false)
+	trueValue2, _  = sql.ConvertToValue(true)
+	zeroValue2     = types.Float64.(sql.Type2).Zero2()
+)
+
+func boolValue2(b bool) sql.Value {
+	if b {
+		return trueValue2
+	}
+	return falseValue2
+}
+
+// isTrue2 returns whether the value given, which isn't NULL, is true. Only numbers are evaluated on row frames, since
+// only number types implement sql.Type2, and any number but zero is true.
+func isTrue2(v sql.Value) (bool, error) {
+	cmp, err := types.Float64.(sql.Type2).Compare2(v, zeroValue2)
+	return cmp != 0, err
+}
+
 func (e *Not) String() string {
 	return fmt.Sprintf("(NOT(%s))", e.Child)
 }
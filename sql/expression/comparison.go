@@ -16,8 +16,15 @@ package expression
 
 import (
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/shopspring/decimal"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/internal/regex"
@@ -63,12 +70,63 @@ func ContainsImpreciseComparison(e sql.Expression) bool {
 	return imprecise
 }
 
+// IsCoercedComparison returns whether comparing the expressions given converts strings or hexadecimal literals to
+// numbers, or numbers to dates. The ranges of an index on either side can't express such comparisons.
+func IsCoercedComparison(left, right sql.Expression) bool {
+	leftType, rightType := left.Type(), right.Type()
+	switch {
+	case types.IsNumber(leftType):
+		return types.IsText(rightType) || types.IsTime(rightType) || isHexLiteral(right)
+	case types.IsNumber(rightType):
+		return types.IsText(leftType) || types.IsTime(leftType) || isHexLiteral(left)
+	default:
+		return false
+	}
+}
+
 type comparison struct {
 	BinaryExpression
+	// compare compares the values of both sides when their types are known to be equal, or is nil if the types have to
+	// be checked for each row.
+	compare *types.Comparator
 }
 
 func newComparison(left, right sql.Expression) comparison {
-	return comparison{BinaryExpression{left, right}}
+	return comparison{
+		BinaryExpression: BinaryExpression{left, right},
+		compare:          typedComparator(left, right),
+	}
+}
+
+// typedComparator returns the function that compares the values of the expressions given, if both are columns or
+// literals of the same type. The types of columns and literals don't change once they're resolved, so the comparator
+// of their type can be selected once instead of for each row.
+func typedComparator(left, right sql.Expression) *types.Comparator {
+	if !hasStaticType(left) || !hasStaticType(right) {
+		return nil
+	}
+	leftType, rightType := left.Type(), right.Type()
+	if types.TypesEqual(leftType, rightType) {
+		cmp := types.NewComparator(leftType)
+		return &cmp
+	}
+	// Strings of different lengths are compared the same way when their collations match
+	if types.IsTextOnly(leftType) && types.IsTextOnly(rightType) &&
+		leftType.(sql.StringType).Collation() == rightType.(sql.StringType).Collation() {
+		cmp := types.NewComparator(leftType)
+		return &cmp
+	}
+	return nil
+}
+
+// hasStaticType returns whether the type of the expression given is fixed once it's resolved.
+func hasStaticType(e sql.Expression) bool {
+	switch e.(type) {
+	case *GetField, *Literal:
+		return e.Resolved()
+	default:
+		return false
+	}
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -91,6 +149,10 @@ func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 		return 0, ErrNilOperand.New()
 	}
 
+	if c.compare != nil {
+		return c.compare.Compare(left, right)
+	}
+
 	if types.TypesEqual(c.Left().Type(), c.Right().Type()) {
 		return c.Left().Type().Compare(left, right)
 	}
@@ -127,7 +189,7 @@ func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 		}
 	}
 	if compareType == nil {
-		left, right, compareType, err = c.castLeftAndRight(left, right)
+		left, right, compareType, err = c.castLeftAndRight(ctx, left, right)
 		if err != nil {
 			return 0, err
 		}
@@ -144,6 +206,54 @@ func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 	return compareType.Compare(left, right)
 }
 
+// Compare2 compares the values of both sides of the comparison in the row frame given. Only numbers are compared on
+// row frames, since only number types implement sql.Type2, and they're compared as doubles if either side is a
+// floating point number, and otherwise as signed or unsigned integers, like Compare compares them.
+func (c *comparison) Compare2(ctx *sql.Context, row sql.Row2) (int, error) {
+	left, err := c.Left().(sql.Expression2).Eval2(ctx, row)
+	if err != nil {
+		return 0, err
+	}
+	right, err := c.Right().(sql.Expression2).Eval2(ctx, row)
+	if err != nil {
+		return 0, err
+	}
+
+	if left.IsNull() || right.IsNull() {
+		return 0, ErrNilOperand.New()
+	}
+
+	leftType, rightType := c.Left().Type(), c.Right().Type()
+	var compareType sql.Type
+	switch {
+	case types.IsFloat(leftType) || types.IsFloat(rightType):
+		compareType = types.Float64
+	case types.IsSigned(leftType) || types.IsSigned(rightType):
+		compareType = types.Int64
+	default:
+		compareType = types.Uint64
+	}
+	return compareType.(sql.Type2).Compare2(left, right)
+}
+
+// eval2 returns whether the result of Compare2 satisfies the test given, or NULL if either side of the comparison is
+// NULL.
+func (c *comparison) eval2(ctx *sql.Context, row sql.Row2, test func(cmp int) bool) (sql.Value, error) {
+	result, err := c.Compare2(ctx, row)
+	if err != nil {
+		if ErrNilOperand.Is(err) {
+			return nullValue2, nil
+		}
+		return sql.Value{}, err
+	}
+	return boolValue2(test(result)), nil
+}
+
+// Type2 implements the sql.Expression2 interface.
+func (*comparison) Type2() sql.Type2 {
+	return types.Boolean.(sql.Type2)
+}
+
 func (c *comparison) evalLeftAndRight(ctx *sql.Context, row sql.Row) (interface{}, interface{}, error) {
 	left, err := c.Left().Eval(ctx, row)
 	if err != nil {
@@ -158,14 +268,25 @@ func (c *comparison) evalLeftAndRight(ctx *sql.Context, row sql.Row) (interface{
 	return left, right, nil
 }
 
-func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, interface{}, sql.Type, error) {
+func (c *comparison) castLeftAndRight(ctx *sql.Context, left, right interface{}) (interface{}, interface{}, sql.Type, error) {
 	leftType := c.Left().Type()
 	rightType := c.Right().Type()
 	if types.IsTuple(leftType) && types.IsTuple(rightType) {
 		return left, right, c.Left().Type(), nil
 	}
 
+	// Hexadecimal literals are binary strings, except when compared to numbers, where they're unsigned integers
+	if isHexLiteral(c.Left()) && types.IsNumber(rightType) {
+		left, leftType = hexToUnsigned(left), types.Uint64
+	} else if isHexLiteral(c.Right()) && types.IsNumber(leftType) {
+		right, rightType = hexToUnsigned(right), types.Uint64
+	}
+
 	if types.IsTime(leftType) || types.IsTime(rightType) {
+		if types.IsNumber(leftType) || types.IsNumber(rightType) {
+			return castTemporalAndNumber(left, right, leftType, rightType)
+		}
+
 		l, r, err := convertLeftAndRight(left, right, ConvertToDatetime)
 		if err != nil {
 			return nil, nil, nil, err
@@ -174,6 +295,19 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 		return l, r, types.Datetime, nil
 	}
 
+	// Strings compared to numbers are compared as doubles, reading the longest numeric prefix of the strings
+	if (types.IsNumber(leftType) && types.IsText(rightType)) || (types.IsText(leftType) && types.IsNumber(rightType)) {
+		l, err := convertToDoubleForComparison(ctx, left)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		r, err := convertToDoubleForComparison(ctx, right)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return l, r, types.Float64, nil
+	}
+
 	if types.IsBinaryType(leftType) || types.IsBinaryType(rightType) {
 		l, r, err := convertLeftAndRight(left, right, ConvertToBinary)
 		if err != nil {
@@ -245,6 +379,142 @@ func convertLeftAndRight(left, right interface{}, convertTo string) (interface{}
 	return l, r, nil
 }
 
+// numericPrefix matches the longest prefix of a string that's read when the string is converted to a number.
+var numericPrefix = regexp.MustCompile(`^[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?`)
+
+// convertToDoubleForComparison converts the value given to a double to compare it to a number. Strings that aren't
+// numbers are read up to the end of their longest numeric prefix, like MySQL does, with a warning, or an error if the
+// strict_comparisons system variable is set.
+func convertToDoubleForComparison(ctx *sql.Context, val interface{}) (interface{}, error) {
+	var s string
+	switch v := val.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return convertValue(val, ConvertToDouble, nil)
+	}
+
+	trimmed := strings.TrimLeft(s, " \t\n\r")
+	prefix := numericPrefix.FindString(trimmed)
+	f, _ := strconv.ParseFloat(prefix, 64)
+	if prefix != strings.TrimRight(trimmed, " \t\n\r") {
+		if strictComparisons(ctx) {
+			return nil, sql.ErrTruncatedIncorrectValue.New("DOUBLE", s)
+		}
+		ctx.Warn(mysql.ERTruncatedWrongValue, "Truncated incorrect DOUBLE value: '%s'", s)
+	}
+	return f, nil
+}
+
+// strictComparisons returns whether the strict_comparisons system variable is set, which makes comparisons fail
+// instead of truncating the values they convert.
+func strictComparisons(ctx *sql.Context) bool {
+	v, err := ctx.GetSessionVariable(ctx, "strict_comparisons")
+	if err != nil {
+		return false
+	}
+	strict, ok := v.(int8)
+	return ok && strict == 1
+}
+
+// isHexLiteral returns whether the expression given is a hexadecimal literal.
+func isHexLiteral(e sql.Expression) bool {
+	lit, ok := e.(*Literal)
+	return ok && lit.IsHex()
+}
+
+// hexToUnsigned returns the bytes of a hexadecimal literal as an unsigned integer. Literals longer than 8 bytes are the
+// largest unsigned integer.
+func hexToUnsigned(val interface{}) interface{} {
+	b, ok := val.([]byte)
+	if !ok {
+		return val
+	}
+	if len(b) > 8 {
+		return uint64(math.MaxUint64)
+	}
+	var u uint64
+	for _, c := range b {
+		u = u<<8 | uint64(c)
+	}
+	return u
+}
+
+// castTemporalAndNumber converts the date and the number given to compare them. Numbers are read as dates in the
+// YYYYMMDD[hhmmss] or YYMMDD[hhmmss] formats, and when they aren't valid dates, the dates are read as numbers in the
+// same format instead, so both are compared as doubles.
+func castTemporalAndNumber(left, right interface{}, leftType, rightType sql.Type) (interface{}, interface{}, sql.Type, error) {
+	temporal, number, temporalType := left, right, leftType
+	swapped := !types.IsTime(leftType)
+	if swapped {
+		temporal, number, temporalType = right, left, rightType
+	}
+
+	t, err := types.Datetime.Convert(temporal)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var l, r interface{}
+	var compareType sql.Type
+	if d, ok := numberAsDatetime(number); ok {
+		l, r, compareType = t, d, types.Datetime
+	} else {
+		layout := "20060102150405"
+		if types.IsDateType(temporalType) {
+			layout = "20060102"
+		}
+		tf, _ := strconv.ParseFloat(t.(time.Time).Format(layout), 64)
+		nf, err := convertValue(number, ConvertToDouble, nil)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		l, r, compareType = tf, nf, types.Float64
+	}
+
+	if swapped {
+		l, r = r, l
+	}
+	return l, r, compareType, nil
+}
+
+// numberAsDatetime returns the date that the integer part of the number given represents in the YYYYMMDD[hhmmss] or
+// YYMMDD[hhmmss] formats, and whether it's a valid date.
+func numberAsDatetime(val interface{}) (time.Time, bool) {
+	var s string
+	switch v := val.(type) {
+	case decimal.Decimal:
+		s = v.Truncate(0).String()
+	case float32:
+		s = strconv.FormatFloat(math.Trunc(float64(v)), 'f', 0, 64)
+	case float64:
+		s = strconv.FormatFloat(math.Trunc(v), 'f', 0, 64)
+	default:
+		s = fmt.Sprint(v)
+	}
+
+	var layout string
+	switch len(s) {
+	case 6:
+		layout = "060102"
+	case 8:
+		layout = "20060102"
+	case 12:
+		layout = "060102150405"
+	case 14:
+		layout = "20060102150405"
+	default:
+		return time.Time{}, false
+	}
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
 // Type implements the Expression interface.
 func (*comparison) Type() sql.Type {
 	return types.Boolean
@@ -262,6 +532,7 @@ type Equals struct {
 }
 
 var _ sql.Expression = (*Equals)(nil)
+var _ sql.Expression2 = (*Equals)(nil)
 var _ sql.CollationCoercible = (*Equals)(nil)
 
 // NewEquals returns a new Equals expression.
@@ -288,6 +559,11 @@ func (e *Equals) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return result == 0, nil
 }
 
+// Eval2 implements the sql.Expression2 interface.
+func (e *Equals) Eval2(ctx *sql.Context, row sql.Row2) (sql.Value, error) {
+	return e.eval2(ctx, row, func(cmp int) bool { return cmp == 0 })
+}
+
 // WithChildren implements the Expression interface.
 func (e *Equals) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -353,12 +629,16 @@ func (e *NullSafeEquals) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 		return -1, nil
 	}
 
+	if e.compare != nil {
+		return e.compare.Compare(left, right)
+	}
+
 	if types.TypesEqual(e.Left().Type(), e.Right().Type()) {
 		return e.Left().Type().Compare(left, right)
 	}
 
 	var compareType sql.Type
-	left, right, compareType, err = e.castLeftAndRight(left, right)
+	left, right, compareType, err = e.castLeftAndRight(ctx, left, right)
 	if err != nil {
 		return 0, err
 	}
@@ -533,6 +813,7 @@ type GreaterThan struct {
 }
 
 var _ sql.Expression = (*GreaterThan)(nil)
+var _ sql.Expression2 = (*GreaterThan)(nil)
 var _ sql.CollationCoercible = (*GreaterThan)(nil)
 
 // NewGreaterThan creates a new GreaterThan expression.
@@ -559,6 +840,11 @@ func (gt *GreaterThan) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	return result == 1, nil
 }
 
+// Eval2 implements the sql.Expression2 interface.
+func (gt *GreaterThan) Eval2(ctx *sql.Context, row sql.Row2) (sql.Value, error) {
+	return gt.eval2(ctx, row, func(cmp int) bool { return cmp == 1 })
+}
+
 // WithChildren implements the Expression interface.
 func (gt *GreaterThan) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -585,6 +871,7 @@ type LessThan struct {
 }
 
 var _ sql.Expression = (*LessThan)(nil)
+var _ sql.Expression2 = (*LessThan)(nil)
 var _ sql.CollationCoercible = (*LessThan)(nil)
 
 // NewLessThan creates a new LessThan expression.
@@ -611,6 +898,11 @@ func (lt *LessThan) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return result == -1, nil
 }
 
+// Eval2 implements the sql.Expression2 interface.
+func (lt *LessThan) Eval2(ctx *sql.Context, row sql.Row2) (sql.Value, error) {
+	return lt.eval2(ctx, row, func(cmp int) bool { return cmp == -1 })
+}
+
 // WithChildren implements the Expression interface.
 func (lt *LessThan) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -638,6 +930,7 @@ type GreaterThanOrEqual struct {
 }
 
 var _ sql.Expression = (*GreaterThanOrEqual)(nil)
+var _ sql.Expression2 = (*GreaterThanOrEqual)(nil)
 var _ sql.CollationCoercible = (*GreaterThanOrEqual)(nil)
 
 // NewGreaterThanOrEqual creates a new GreaterThanOrEqual
@@ -664,6 +957,11 @@ func (gte *GreaterThanOrEqual) Eval(ctx *sql.Context, row sql.Row) (interface{},
 	return result > -1, nil
 }
 
+// Eval2 implements the sql.Expression2 interface.
+func (gte *GreaterThanOrEqual) Eval2(ctx *sql.Context, row sql.Row2) (sql.Value, error) {
+	return gte.eval2(ctx, row, func(cmp int) bool { return cmp > -1 })
+}
+
 // WithChildren implements the Expression interface.
 func (gte *GreaterThanOrEqual) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -691,6 +989,7 @@ type LessThanOrEqual struct {
 }
 
 var _ sql.Expression = (*LessThanOrEqual)(nil)
+var _ sql.Expression2 = (*LessThanOrEqual)(nil)
 var _ sql.CollationCoercible = (*LessThanOrEqual)(nil)
 
 // NewLessThanOrEqual creates a LessThanOrEqual expression.
@@ -717,6 +1016,11 @@ func (lte *LessThanOrEqual) Eval(ctx *sql.Context, row sql.Row) (interface{}, er
 	return result < 1, nil
 }
 
+// Eval2 implements the sql.Expression2 interface.
+func (lte *LessThanOrEqual) Eval2(ctx *sql.Context, row sql.Row2) (sql.Value, error) {
+	return lte.eval2(ctx, row, func(cmp int) bool { return cmp < 1 })
+}
+
 // WithChildren implements the Expression interface.
 func (lte *LessThanOrEqual) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
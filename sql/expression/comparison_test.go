@@ -15,6 +15,7 @@
 package expression_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -275,3 +276,79 @@ func eval(t *testing.T, e sql.Expression, row sql.Row) interface{} {
 	require.NoError(t, err)
 	return v
 }
+
+func TestComparisonEval2(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	values := []struct {
+		typ sql.Type
+		val interface{}
+	}{
+		{types.Int8, int8(-1)},
+		{types.Int64, int64(2)},
+		{types.Uint64, uint64(2)},
+		{types.Int32, int32(3)},
+		{types.Float64, 2.5},
+		{types.Int64, nil},
+	}
+	comparisons := map[string]func(l, r sql.Expression) sql.Expression{
+		"=":  func(l, r sql.Expression) sql.Expression { return expression.NewEquals(l, r) },
+		"<":  func(l, r sql.Expression) sql.Expression { return expression.NewLessThan(l, r) },
+		">":  func(l, r sql.Expression) sql.Expression { return expression.NewGreaterThan(l, r) },
+		"<=": func(l, r sql.Expression) sql.Expression { return expression.NewLessThanOrEqual(l, r) },
+		">=": func(l, r sql.Expression) sql.Expression { return expression.NewGreaterThanOrEqual(l, r) },
+	}
+
+	// Comparisons evaluated on row frames have the same results as comparisons evaluated on rows
+	for _, left := range values {
+		for _, right := range values {
+			row := sql.NewRow(left.val, right.val)
+			leftValue, err := sql.ConvertToValue(left.val)
+			require.NoError(t, err)
+			rightValue, err := sql.ConvertToValue(right.val)
+			require.NoError(t, err)
+			row2 := sql.Row2{leftValue, rightValue}
+
+			for op, newComparison := range comparisons {
+				cmp := newComparison(
+					expression.NewGetField(0, left.typ, "l", true),
+					expression.NewGetField(1, right.typ, "r", true),
+				)
+				t.Run(fmt.Sprintf("%v %s %v", left.val, op, right.val), func(t *testing.T) {
+					result, err := cmp.Eval(ctx, row)
+					require.NoError(t, err)
+					expected, err := sql.ConvertToValue(result)
+					require.NoError(t, err)
+
+					actual, err := cmp.(sql.Expression2).Eval2(ctx, row2)
+					require.NoError(t, err)
+					require.Equal(t, expected, actual)
+				})
+			}
+		}
+	}
+}
+
+func TestIsCoercedComparison(t *testing.T) {
+	intField := expression.NewGetField(0, types.Int32, "i", true)
+	textField := expression.NewGetField(1, types.LongText, "s", true)
+	dateField := expression.NewGetField(2, types.Date, "d", true)
+	blobField := expression.NewGetField(3, types.LongBlob, "b", true)
+	tests := []struct {
+		left, right sql.Expression
+		expected    bool
+	}{
+		{intField, expression.NewLiteral(int64(1), types.Int64), false},
+		{intField, expression.NewLiteral("1", types.LongText), true},
+		{expression.NewLiteral("1", types.LongText), intField, true},
+		{intField, expression.NewHexLiteral([]byte{1}), true},
+		{blobField, expression.NewHexLiteral([]byte{1}), false},
+		{textField, expression.NewLiteral("a", types.LongText), false},
+		{dateField, expression.NewLiteral(int64(20200102), types.Int64), true},
+		{dateField, expression.NewLiteral("2020-01-02", types.LongText), false},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s = %s", test.left, test.right), func(t *testing.T) {
+			require.Equal(t, test.expected, expression.IsCoercedComparison(test.left, test.right))
+		})
+	}
+}
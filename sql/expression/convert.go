@@ -62,6 +62,8 @@ type Convert struct {
 	UnaryExpression
 	// Type to cast
 	castToType string
+	// typeLength is the length of BINARY(n) and CHAR(n) conversions, or 0 if the type has no length
+	typeLength int
 }
 
 var _ sql.Expression = (*Convert)(nil)
@@ -75,6 +77,24 @@ func NewConvert(expr sql.Expression, castToType string) *Convert {
 	}
 }
 
+// NewConvertWithLength creates a new Convert expression to a BINARY(n) or CHAR(n) type of the length given. Binary
+// strings are padded with zero bytes up to the length, and longer values are truncated with a warning.
+func NewConvertWithLength(expr sql.Expression, castToType string, typeLength int) *Convert {
+	c := NewConvert(expr, castToType)
+	c.typeLength = typeLength
+	return c
+}
+
+// TypeToConvert returns the name of the type the expression is cast to, such as ConvertToSigned.
+func (c *Convert) TypeToConvert() string {
+	return c.castToType
+}
+
+// TypeLength returns the length of the type the expression is cast to, or 0 if the type has no length.
+func (c *Convert) TypeLength() int {
+	return c.typeLength
+}
+
 // IsNullable implements the Expression interface.
 func (c *Convert) IsNullable() bool {
 	switch c.castToType {
@@ -144,7 +164,7 @@ func (c *Convert) CollationCoercibility(ctx *sql.Context) (collation sql.Collati
 
 // String implements the Stringer interface.
 func (c *Convert) String() string {
-	return fmt.Sprintf("convert(%v, %v)", c.Child, c.castToType)
+	return fmt.Sprintf("convert(%v, %v)", c.Child, c.typeName())
 }
 
 // DebugString implements the Expression interface.
@@ -152,7 +172,7 @@ func (c *Convert) DebugString() string {
 	pr := sql.NewTreePrinter()
 	_ = pr.WriteNode("convert")
 	children := []string{
-		fmt.Sprintf("type: %v", c.castToType),
+		fmt.Sprintf("type: %v", c.typeName()),
 		fmt.Sprintf(sql.DebugString(c.Child)),
 	}
 	_ = pr.WriteChildren(children...)
@@ -164,7 +184,7 @@ func (c *Convert) WithChildren(children ...sql.Expression) (sql.Expression, erro
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
 	}
-	return NewConvert(children[0], c.castToType), nil
+	return NewConvertWithLength(children[0], c.castToType, c.typeLength), nil
 }
 
 // Eval implements the Expression interface.
@@ -188,9 +208,41 @@ func (c *Convert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	if c.typeLength > 0 {
+		return c.fitToLength(ctx, casted, val), nil
+	}
 	return casted, nil
 }
 
+// typeName returns the name of the type the expression is cast to, with its length if it has one.
+func (c *Convert) typeName() string {
+	if c.typeLength > 0 {
+		return fmt.Sprintf("%s(%d)", c.castToType, c.typeLength)
+	}
+	return c.castToType
+}
+
+// fitToLength pads binary strings with zero bytes up to the length of the conversion, and truncates longer binary and
+// character strings with a warning.
+func (c *Convert) fitToLength(ctx *sql.Context, casted, val interface{}) interface{} {
+	switch v := casted.(type) {
+	case []byte:
+		if len(v) > c.typeLength {
+			ctx.Warn(1292, "Truncated incorrect %s value: %v", strings.ToUpper(c.typeName()), val)
+			return v[:c.typeLength]
+		}
+		if len(v) < c.typeLength {
+			return append(v, make([]byte, c.typeLength-len(v))...)
+		}
+	case string:
+		if runes := []rune(v); len(runes) > c.typeLength {
+			ctx.Warn(1292, "Truncated incorrect %s value: %v", strings.ToUpper(c.typeName()), val)
+			return string(runes[:c.typeLength])
+		}
+	}
+	return casted
+}
+
 // convertValue only returns an error if converting to JSON, Date, and Datetime;
 // the zero value is returned for float types.
 // Nil is returned in all other cases.
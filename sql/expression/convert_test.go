@@ -30,6 +30,7 @@ func TestConvert(t *testing.T) {
 		row         sql.Row
 		expression  sql.Expression
 		castTo      string
+		length      int
 		expected    interface{}
 		expectedErr bool
 	}{
@@ -129,6 +130,33 @@ func TestConvert(t *testing.T) {
 			expected:    []byte("-2.3"),
 			expectedErr: false,
 		},
+		{
+			name:        "string to binary of a longer length",
+			row:         nil,
+			castTo:      ConvertToBinary,
+			length:      3,
+			expression:  NewLiteral("a", types.LongText),
+			expected:    []byte{'a', 0, 0},
+			expectedErr: false,
+		},
+		{
+			name:        "string to binary of a shorter length",
+			row:         nil,
+			castTo:      ConvertToBinary,
+			length:      2,
+			expression:  NewLiteral("abcd", types.LongText),
+			expected:    []byte("ab"),
+			expectedErr: false,
+		},
+		{
+			name:        "string to char of a shorter length",
+			row:         nil,
+			castTo:      ConvertToChar,
+			length:      2,
+			expression:  NewLiteral("abcd", types.LongText),
+			expected:    "ab",
+			expectedErr: false,
+		},
 		{
 			name:        "string to json",
 			row:         nil,
@@ -174,7 +202,7 @@ func TestConvert(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			require := require.New(t)
-			convert := NewConvert(test.expression, test.castTo)
+			convert := NewConvertWithLength(test.expression, test.castTo, test.length)
 			val, err := convert.Eval(sql.NewEmptyContext(), test.row)
 			if test.expectedErr {
 				require.Error(err)
@@ -0,0 +1,205 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// DiagnosticsStatementItems are the statement information items of GET DIAGNOSTICS.
+var DiagnosticsStatementItems = []string{"NUMBER", "ROW_COUNT"}
+
+// DiagnosticsConditionItems are the condition information items of GET DIAGNOSTICS ... CONDITION.
+var DiagnosticsConditionItems = []string{
+	"CLASS_ORIGIN",
+	"SUBCLASS_ORIGIN",
+	"RETURNED_SQLSTATE",
+	"MESSAGE_TEXT",
+	"MYSQL_ERRNO",
+	"CONSTRAINT_CATALOG",
+	"CONSTRAINT_SCHEMA",
+	"CONSTRAINT_NAME",
+	"CATALOG_NAME",
+	"SCHEMA_NAME",
+	"TABLE_NAME",
+	"COLUMN_NAME",
+	"CURSOR_NAME",
+}
+
+// DiagnosticsItem is an information item of the diagnostics area read by a GET DIAGNOSTICS statement, which assigns
+// the values of its items to variables like SELECT ... INTO does.
+// https://dev.mysql.com/doc/refman/8.0/en/get-diagnostics.html
+//
+// The current diagnostics area has the warnings of the session and the row count of the last statement, unless a
+// condition handler of a stored procedure is running, in which case both the current and the stacked diagnostics
+// areas have only the condition being handled.
+type DiagnosticsItem struct {
+	// Stacked is whether the item is read from the stacked diagnostics area rather than the current one
+	Stacked bool
+	// Condition is the number of the condition the item is about, or nil for a statement information item
+	Condition sql.Expression
+	// Item is the name of the item, in upper case
+	Item string
+	pRef *ProcedureReference
+}
+
+var _ sql.Expression = (*DiagnosticsItem)(nil)
+var _ sql.CollationCoercible = (*DiagnosticsItem)(nil)
+
+// NewDiagnosticsItem returns a new *DiagnosticsItem reading the item given, of the condition with the number given if
+// it isn't nil.
+func NewDiagnosticsItem(stacked bool, condition sql.Expression, item string) *DiagnosticsItem {
+	return &DiagnosticsItem{Stacked: stacked, Condition: condition, Item: strings.ToUpper(item)}
+}
+
+// Children implements the sql.Expression interface.
+func (d *DiagnosticsItem) Children() []sql.Expression {
+	if d.Condition == nil {
+		return nil
+	}
+	return []sql.Expression{d.Condition}
+}
+
+// Resolved implements the sql.Expression interface.
+func (d *DiagnosticsItem) Resolved() bool {
+	return d.Condition == nil || d.Condition.Resolved()
+}
+
+// IsNullable implements the sql.Expression interface.
+func (d *DiagnosticsItem) IsNullable() bool {
+	return false
+}
+
+// Type implements the sql.Expression interface.
+func (d *DiagnosticsItem) Type() sql.Type {
+	switch d.Item {
+	case "NUMBER", "ROW_COUNT", "MYSQL_ERRNO":
+		return types.Int64
+	default:
+		return types.LongText
+	}
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (d *DiagnosticsItem) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	if types.IsText(d.Type()) {
+		return ctx.GetCollation(), 3
+	}
+	return sql.Collation_binary, 5
+}
+
+// String implements the sql.Expression interface.
+func (d *DiagnosticsItem) String() string {
+	area := "CURRENT"
+	if d.Stacked {
+		area = "STACKED"
+	}
+	if d.Condition == nil {
+		return fmt.Sprintf("GET %s DIAGNOSTICS %s", area, d.Item)
+	}
+	return fmt.Sprintf("GET %s DIAGNOSTICS CONDITION %s %s", area, d.Condition, d.Item)
+}
+
+// Eval implements the sql.Expression interface.
+func (d *DiagnosticsItem) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	var conditions []*mysql.SQLError
+	handled := d.pRef.HandledCondition()
+	switch {
+	case handled != nil:
+		conditions = []*mysql.SQLError{handled}
+	case d.Stacked:
+		return nil, sql.ErrStackedDiagnosticsWithoutHandler.New()
+	default:
+		// Session warnings are returned most recent first, but conditions are numbered in the order they were raised
+		warnings := ctx.Warnings()
+		for i := len(warnings) - 1; i >= 0; i-- {
+			w := warnings[i]
+			state := "01000"
+			if strings.EqualFold(w.Level, "Error") {
+				state = mysql.SSUnknownSQLState
+			}
+			conditions = append(conditions, mysql.NewSQLError(w.Code, state, "%s", w.Message))
+		}
+	}
+
+	switch d.Item {
+	case "NUMBER":
+		return int64(len(conditions)), nil
+	case "ROW_COUNT":
+		if handled != nil {
+			return int64(0), nil
+		}
+		return ctx.GetLastQueryInfo(sql.RowCount), nil
+	}
+
+	n, err := d.Condition.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	n, err = types.Int64.Convert(n)
+	if err != nil || n == nil || n.(int64) < 1 || n.(int64) > int64(len(conditions)) {
+		return nil, sql.ErrInvalidConditionNumber.New()
+	}
+	condition := conditions[n.(int64)-1]
+
+	switch d.Item {
+	case "RETURNED_SQLSTATE":
+		return condition.SQLState(), nil
+	case "MESSAGE_TEXT":
+		return condition.Message, nil
+	case "MYSQL_ERRNO":
+		return int64(condition.Number()), nil
+	case "CLASS_ORIGIN", "SUBCLASS_ORIGIN":
+		// Classes defined by the SQL standard start with 0-4 or A-H, and MySQL defines the others. The subclass of a
+		// standard class is standard if it's 000.
+		state := condition.SQLState()
+		standard := strings.ContainsRune("01234ABCDEFGH", rune(state[0]))
+		if d.Item == "SUBCLASS_ORIGIN" {
+			standard = standard && state[2:] == "000"
+		}
+		if standard {
+			return "ISO 9075", nil
+		}
+		return "MySQL", nil
+	default:
+		return "", nil
+	}
+}
+
+// WithChildren implements the sql.Expression interface.
+func (d *DiagnosticsItem) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(d.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), len(d.Children()))
+	}
+	nd := *d
+	if len(children) > 0 {
+		nd.Condition = children[0]
+	}
+	return &nd, nil
+}
+
+// WithParamReference returns a new *DiagnosticsItem containing the given *ProcedureReference, so that it can read the
+// conditions of the handlers of the stored procedure.
+func (d *DiagnosticsItem) WithParamReference(pRef *ProcedureReference) *DiagnosticsItem {
+	nd := *d
+	nd.pRef = pRef
+	return &nd
+}
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
@@ -31,6 +32,10 @@ import (
 
 var ErrIntDivDataOutOfRange = errors.NewKind("BIGINT value is out of range (%s DIV %s)")
 
+func init() {
+	sql.RegisterErrorCode(mysql.ERDataOutOfRange, mysql.SSDataOutOfRange, ErrIntDivDataOutOfRange)
+}
+
 // '4 scales' are added to scale of the number on the left side of division operator at every division operation.
 // The default value is 4, and it can be set using sysvar https://dev.mysql.com/doc/refman/8.0/en/server-system-variables.html#sysvar_div_precision_increment
 const divPrecisionIncrement = 4
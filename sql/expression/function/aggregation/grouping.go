@@ -0,0 +1,132 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Grouping is the GROUPING function, which tells the super-aggregate rows added by the WITH ROLLUP and WITH CUBE
+// modifiers of a GROUP BY clause apart from its groups. Its arguments are grouping expressions, and it returns a
+// bitmask with a bit for each of them, the last one being the lowest bit, which is set if the expression is rolled up
+// in the row. The grouping node evaluates it in super-aggregate rows; in the groups themselves, it's always 0.
+type Grouping struct {
+	expression.NaryExpression
+	window *sql.WindowDefinition
+}
+
+var _ sql.FunctionExpression = (*Grouping)(nil)
+var _ sql.Aggregation = (*Grouping)(nil)
+var _ sql.CollationCoercible = (*Grouping)(nil)
+
+// NewGrouping returns a new GROUPING function of the expressions given.
+func NewGrouping(exprs ...sql.Expression) (sql.Expression, error) {
+	if len(exprs) == 0 {
+		return nil, sql.ErrInvalidArgumentNumber.New("GROUPING", "1 or more", 0)
+	}
+	return &Grouping{NaryExpression: expression.NaryExpression{ChildExpressions: exprs}}, nil
+}
+
+// Type implements the Expression interface.
+func (g *Grouping) Type() sql.Type {
+	return types.Int64
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*Grouping) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// IsNullable implements the Expression interface.
+func (g *Grouping) IsNullable() bool {
+	return false
+}
+
+// Eval implements the Expression interface.
+func (g *Grouping) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("Grouping")
+}
+
+// WithChildren implements the Expression interface.
+func (g *Grouping) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) == 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), len(g.ChildExpressions))
+	}
+	ng := *g
+	ng.ChildExpressions = children
+	return &ng, nil
+}
+
+// FunctionName implements the FunctionExpression interface.
+func (g *Grouping) FunctionName() string {
+	return "grouping"
+}
+
+// Description implements the FunctionExpression interface.
+func (g *Grouping) Description() string {
+	return "returns whether the grouping expressions given are rolled up in a super-aggregate row."
+}
+
+// NewBuffer implements the Aggregation interface.
+func (g *Grouping) NewBuffer() (sql.AggregationBuffer, error) {
+	return groupingBuffer{}, nil
+}
+
+// WithWindow implements the Aggregation interface.
+func (g *Grouping) WithWindow(window *sql.WindowDefinition) (sql.Aggregation, error) {
+	ng := *g
+	ng.window = window
+	return &ng, nil
+}
+
+// Window implements the Aggregation interface.
+func (g *Grouping) Window() *sql.WindowDefinition {
+	return g.window
+}
+
+// NewWindowFunction implements the WindowAdaptableExpression interface.
+func (g *Grouping) NewWindowFunction() (sql.WindowFunction, error) {
+	return nil, sql.ErrUnsupportedFeature.New("GROUPING as a window function")
+}
+
+func (g *Grouping) String() string {
+	args := make([]string, len(g.ChildExpressions))
+	for i, e := range g.ChildExpressions {
+		args[i] = e.String()
+	}
+	return fmt.Sprintf("GROUPING(%s)", strings.Join(args, ", "))
+}
+
+// groupingBuffer is the buffer of the GROUPING function in the groups of a GROUP BY clause, in which no grouping
+// expression is rolled up.
+type groupingBuffer struct{}
+
+// Update implements the AggregationBuffer interface.
+func (groupingBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	return nil
+}
+
+// Eval implements the AggregationBuffer interface.
+func (groupingBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	return int64(0), nil
+}
+
+// Dispose implements the Disposable interface.
+func (groupingBuffer) Dispose() {}
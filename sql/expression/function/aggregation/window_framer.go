@@ -109,10 +109,6 @@ func (f *PartitionFramer) Interval() (sql.WindowInterval, error) {
 	return sql.WindowInterval{Start: f.frameStart, End: f.frameEnd}, nil
 }
 
-func (f *PartitionFramer) SlidingInterval(ctx sql.Context) (sql.WindowInterval, sql.WindowInterval, sql.WindowInterval) {
-	panic("implement me")
-}
-
 func (f *PartitionFramer) Close() {
 	panic("implement me")
 }
@@ -171,10 +167,6 @@ func (f *GroupByFramer) Interval() (sql.WindowInterval, error) {
 	return sql.WindowInterval{Start: f.frameStart, End: f.frameEnd}, nil
 }
 
-func (f *GroupByFramer) SlidingInterval(ctx sql.Context) (sql.WindowInterval, sql.WindowInterval, sql.WindowInterval) {
-	panic("implement me")
-}
-
 // rowFramerBase is a sql.WindowFramer iterator that tracks
 // index frames in a sql.WindowBuffer using integer offsets.
 // Only a subset of bound conditions will be set for a given
@@ -29,11 +29,20 @@ var _ sql.WindowFunction = (*MinAgg)(nil)
 var _ sql.WindowFunction = (*AvgAgg)(nil)
 var _ sql.WindowFunction = (*LastAgg)(nil)
 var _ sql.WindowFunction = (*FirstAgg)(nil)
+var _ sql.WindowFunction = (*BitAndAgg)(nil)
+var _ sql.WindowFunction = (*BitOrAgg)(nil)
+var _ sql.WindowFunction = (*BitXorAgg)(nil)
 var _ sql.WindowFunction = (*CountAgg)(nil)
 var _ sql.WindowFunction = (*GroupConcatAgg)(nil)
 var _ sql.WindowFunction = (*WindowedJSONArrayAgg)(nil)
 var _ sql.WindowFunction = (*WindowedJSONObjectAgg)(nil)
 
+var _ sql.WindowSlidingFunction = (*MaxAgg)(nil)
+var _ sql.WindowSlidingFunction = (*MinAgg)(nil)
+var _ sql.WindowSlidingFunction = (*BitAndAgg)(nil)
+var _ sql.WindowSlidingFunction = (*BitOrAgg)(nil)
+var _ sql.WindowSlidingFunction = (*BitXorAgg)(nil)
+
 var _ sql.WindowFunction = (*PercentRank)(nil)
 var _ sql.WindowFunction = (*RowNumber)(nil)
 var _ sql.WindowFunction = (*Lag)(nil)
@@ -140,10 +149,6 @@ func (a *SumAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, b
 	return err
 }
 
-func (a *SumAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
-}
-
 func (a *SumAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
 	if interval.End-interval.Start < 1 {
 		return nil
@@ -239,10 +244,6 @@ func (a *AvgAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, b
 	return err
 }
 
-func (a *AvgAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
-}
-
 func (a *AvgAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
 	startIdx := interval.Start - a.partitionStart - 1
 	endIdx := interval.End - a.partitionStart - 1
@@ -260,13 +261,15 @@ func (a *AvgAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.
 }
 
 type BitAndAgg struct {
-	expr   sql.Expression
-	framer sql.WindowFramer
+	expr    sql.Expression
+	framer  sql.WindowFramer
+	sliding slidingBits
 }
 
 func NewBitAndAgg(e sql.Expression) *BitAndAgg {
 	return &BitAndAgg{
-		expr: e,
+		expr:    e,
+		sliding: slidingBits{expr: e},
 	}
 }
 
@@ -296,14 +299,22 @@ func (b *BitAndAgg) DefaultFramer() sql.WindowFramer {
 
 func (b *BitAndAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
 	b.Dispose()
+	b.sliding.reset()
 	return nil
 }
 
-func (b *BitAndAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
+func (b *BitAndAgg) NewSlidingFrameInterval(ctx *sql.Context, added, dropped sql.WindowInterval, buf sql.WindowBuffer) error {
+	return b.sliding.slide(ctx, added, dropped, buf)
 }
 
 func (b *BitAndAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
+	if b.sliding.valid {
+		if b.sliding.invalid > 0 {
+			return 0
+		}
+		return b.sliding.result(func(count int) bool { return count == b.sliding.values })
+	}
+
 	res := ^uint64(0) // bitwise not xor, so 0xffff...
 	for i := interval.Start; i < interval.End; i++ {
 		row := buf[i]
@@ -327,13 +338,15 @@ func (b *BitAndAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf s
 }
 
 type BitOrAgg struct {
-	expr   sql.Expression
-	framer sql.WindowFramer
+	expr    sql.Expression
+	framer  sql.WindowFramer
+	sliding slidingBits
 }
 
 func NewBitOrAgg(e sql.Expression) *BitOrAgg {
 	return &BitOrAgg{
-		expr: e,
+		expr:    e,
+		sliding: slidingBits{expr: e},
 	}
 }
 
@@ -363,14 +376,22 @@ func (b *BitOrAgg) DefaultFramer() sql.WindowFramer {
 
 func (b *BitOrAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
 	b.Dispose()
+	b.sliding.reset()
 	return nil
 }
 
-func (b *BitOrAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
+func (b *BitOrAgg) NewSlidingFrameInterval(ctx *sql.Context, added, dropped sql.WindowInterval, buf sql.WindowBuffer) error {
+	return b.sliding.slide(ctx, added, dropped, buf)
 }
 
 func (b *BitOrAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
+	if b.sliding.valid {
+		if b.sliding.invalid > 0 {
+			return 0
+		}
+		return b.sliding.result(func(count int) bool { return count > 0 })
+	}
+
 	var res uint64
 	for i := interval.Start; i < interval.End; i++ {
 		row := buf[i]
@@ -394,13 +415,15 @@ func (b *BitOrAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sq
 }
 
 type BitXorAgg struct {
-	expr   sql.Expression
-	framer sql.WindowFramer
+	expr    sql.Expression
+	framer  sql.WindowFramer
+	sliding slidingBits
 }
 
 func NewBitXorAgg(e sql.Expression) *BitXorAgg {
 	return &BitXorAgg{
-		expr: e,
+		expr:    e,
+		sliding: slidingBits{expr: e},
 	}
 }
 
@@ -430,14 +453,22 @@ func (b *BitXorAgg) DefaultFramer() sql.WindowFramer {
 
 func (b *BitXorAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
 	b.Dispose()
+	b.sliding.reset()
 	return nil
 }
 
-func (b *BitXorAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
+func (b *BitXorAgg) NewSlidingFrameInterval(ctx *sql.Context, added, dropped sql.WindowInterval, buf sql.WindowBuffer) error {
+	return b.sliding.slide(ctx, added, dropped, buf)
 }
 
 func (b *BitXorAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
+	if b.sliding.valid {
+		if b.sliding.invalid > 0 {
+			return 0
+		}
+		return b.sliding.result(func(count int) bool { return count%2 == 1 })
+	}
+
 	var res uint64
 	for i := interval.Start; i < interval.End; i++ {
 		row := buf[i]
@@ -462,13 +493,15 @@ func (b *BitXorAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf s
 }
 
 type MaxAgg struct {
-	expr   sql.Expression
-	framer sql.WindowFramer
+	expr    sql.Expression
+	framer  sql.WindowFramer
+	sliding slidingExtremum
 }
 
 func NewMaxAgg(e sql.Expression) *MaxAgg {
 	return &MaxAgg{
-		expr: e,
+		expr:    e,
+		sliding: slidingExtremum{expr: e, more: 1},
 	}
 }
 
@@ -498,14 +531,18 @@ func (a *MaxAgg) DefaultFramer() sql.WindowFramer {
 
 func (a *MaxAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) error {
 	a.Dispose()
+	a.sliding.reset()
 	return nil
 }
 
-func (a *MaxAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
+func (a *MaxAgg) NewSlidingFrameInterval(ctx *sql.Context, added, dropped sql.WindowInterval, buf sql.WindowBuffer) error {
+	return a.sliding.slide(ctx, added, dropped, buf)
 }
 
 func (a *MaxAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) interface{} {
+	if a.sliding.valid {
+		return a.sliding.extremum()
+	}
 	var max interface{}
 	for i := interval.Start; i < interval.End; i++ {
 		row := buffer[i]
@@ -534,13 +571,15 @@ func (a *MaxAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer s
 }
 
 type MinAgg struct {
-	expr   sql.Expression
-	framer sql.WindowFramer
+	expr    sql.Expression
+	framer  sql.WindowFramer
+	sliding slidingExtremum
 }
 
 func NewMinAgg(e sql.Expression) *MinAgg {
 	return &MinAgg{
-		expr: e,
+		expr:    e,
+		sliding: slidingExtremum{expr: e, more: -1},
 	}
 }
 
@@ -570,14 +609,18 @@ func (a *MinAgg) DefaultFramer() sql.WindowFramer {
 
 func (a *MinAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) error {
 	a.Dispose()
+	a.sliding.reset()
 	return nil
 }
 
-func (a *MinAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
+func (a *MinAgg) NewSlidingFrameInterval(ctx *sql.Context, added, dropped sql.WindowInterval, buf sql.WindowBuffer) error {
+	return a.sliding.slide(ctx, added, dropped, buf)
 }
 
 func (a *MinAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
+	if a.sliding.valid {
+		return a.sliding.extremum()
+	}
 	var min interface{}
 	for _, row := range buf[interval.Start:interval.End] {
 		v, err := a.expr.Eval(ctx, row)
@@ -645,10 +688,6 @@ func (a *LastAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval,
 	return nil
 }
 
-func (a *LastAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
-}
-
 func (a *LastAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) interface{} {
 	if interval.End-interval.Start < 1 {
 		return nil
@@ -703,10 +742,6 @@ func (a *FirstAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval,
 	return nil
 }
 
-func (a *FirstAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
-}
-
 func (a *FirstAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) interface{} {
 	if interval.End-interval.Start < 1 {
 		return nil
@@ -804,10 +839,6 @@ func (a *CountAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval,
 	return nil
 }
 
-func (a *CountAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
-}
-
 func (a *CountAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
 	a.pos++
 	return int64(computePrefixSum(sql.WindowInterval{Start: interval.Start, End: interval.End}, a.partitionStart, a.prefixSum))
@@ -888,10 +919,6 @@ func (a *GroupConcatAgg) StartPartition(ctx *sql.Context, interval sql.WindowInt
 	return err
 }
 
-func (a *GroupConcatAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
-}
-
 func (a *GroupConcatAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
 	rows := a.rows
 
@@ -1028,10 +1055,6 @@ func (a *WindowedJSONArrayAgg) StartPartition(ctx *sql.Context, interval sql.Win
 	return nil
 }
 
-func (a *WindowedJSONArrayAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
-}
-
 func (a *WindowedJSONArrayAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
 	res, err := a.aggregateVals(ctx, interval, buf)
 	if err != nil {
@@ -1107,10 +1130,6 @@ func (a *WindowedJSONObjectAgg) StartPartition(ctx *sql.Context, interval sql.Wi
 	return err
 }
 
-func (a *WindowedJSONObjectAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("sliding window interface not implemented yet")
-}
-
 func (a *WindowedJSONObjectAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
 	if len(a.vals) == 0 {
 		return nil
@@ -1186,10 +1205,6 @@ func (a *RowNumber) StartPartition(ctx *sql.Context, interval sql.WindowInterval
 	return nil
 }
 
-func (a *RowNumber) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("implement me")
-}
-
 func (a *RowNumber) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) interface{} {
 	if interval.End-interval.Start < 1 {
 		return nil
@@ -1231,10 +1246,6 @@ func (a *rankBase) StartPartition(ctx *sql.Context, interval sql.WindowInterval,
 	return nil
 }
 
-func (a *rankBase) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("implement me")
-}
-
 // Compute returns the number of elements before the current peer group (rank) + 1.
 // ex: [1, 2, 2, 2, 3, 3, 3, 4, 5, 5, 6] => every 3 returns uint64(5) because
 // there are 4 values less than 3
@@ -1389,10 +1400,6 @@ func (a *leadLagBase) StartPartition(ctx *sql.Context, interval sql.WindowInterv
 	return nil
 }
 
-func (a *leadLagBase) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
-	panic("implement me")
-}
-
 func (a *leadLagBase) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) interface{} {
 	var res interface{}
 	var err error
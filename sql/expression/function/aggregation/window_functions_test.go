@@ -392,6 +392,62 @@ func TestWindowedAggFuncs(t *testing.T) {
 
 }
 
+func TestSlidingAggFuncs(t *testing.T) {
+	x := expression.NewGetField(0, types.Int64, "x", true)
+	aggs := map[string]func() sql.WindowFunction{
+		"max":     func() sql.WindowFunction { return NewMaxAgg(x) },
+		"min":     func() sql.WindowFunction { return NewMinAgg(x) },
+		"bit and": func() sql.WindowFunction { return NewBitAndAgg(x) },
+		"bit or":  func() sql.WindowFunction { return NewBitOrAgg(x) },
+		"bit xor": func() sql.WindowFunction { return NewBitXorAgg(x) },
+	}
+	framers := map[string]sql.WindowFramer{
+		"2 preceding to 1 following":     &rowFramerBase{startNPreceding: 2, endNFollowing: 1},
+		"unbounded preceding to current": NewUnboundedPrecedingToCurrentRowFramer(),
+		"current to unbounded following": &rowFramerBase{startCurrentRow: true, unboundedFollowing: true},
+		"3 preceding to 1 preceding":     &rowFramerBase{startNPreceding: 3, endNPreceding: 1},
+		"partition":                      NewPartitionFramer(),
+		"1 following to 4 following":     &rowFramerBase{startNFollowing: 1, endNFollowing: 4},
+		"current row":                    &rowFramerBase{startCurrentRow: true, endCurrentRow: true},
+	}
+
+	buf := []sql.Row{
+		{int64(5)}, {int64(3)}, {nil}, {int64(9)}, {int64(3)}, {int64(12)}, {int64(1)},
+		{int64(7)}, {nil}, {nil}, {int64(7)},
+		{int64(6)}, {int64(2)}, {int64(6)}, {int64(10)}, {int64(4)},
+	}
+	partitions := []sql.WindowInterval{
+		{Start: 0, End: 7},
+		{Start: 7, End: 11},
+		{Start: 11, End: 16},
+	}
+
+	for aggName, newAgg := range aggs {
+		for framerName, framer := range framers {
+			t.Run(aggName+" "+framerName, func(t *testing.T) {
+				ctx := sql.NewEmptyContext()
+				agg := NewAggregation(newAgg(), framer)
+				for _, p := range partitions {
+					require.NoError(t, agg.startPartition(ctx, p, buf))
+					for {
+						interval, err := agg.framer.Next(ctx, buf)
+						if errors.Is(err, io.EOF) {
+							break
+						}
+						require.NoError(t, err)
+						actual, err := agg.compute(ctx, interval, buf)
+						require.NoError(t, err)
+
+						fn := newAgg()
+						require.NoError(t, fn.StartPartition(ctx, p, buf))
+						require.Equal(t, fn.Compute(ctx, interval, buf), actual, "frame %v", interval)
+					}
+				}
+			})
+		}
+	}
+}
+
 func mustNewGroupByConcat(distinct string, orderBy sql.SortFields, separator string, selectExprs []sql.Expression, maxLen int) *GroupConcat {
 	gc, err := NewGroupConcat(distinct, orderBy, separator, selectExprs, maxLen)
 	if err != nil {
@@ -17,6 +17,7 @@ package aggregation
 import (
 	"errors"
 	"io"
+	"sort"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -24,7 +25,8 @@ import (
 // WindowIter is a wrapper that evaluates a set of WindowPartitionIter.
 //
 // The current implementation has 3 steps:
-// 1. Materialize [iter] and duplicate a sql.WindowBuffer for each partition.
+// 1. Materialize [iter] and sort a sql.WindowBuffer for each partition. Partitions
+// whose sorting is a prefix of another's share its sorted buffer.
 // 2. Collect rows from child partitions.
 // 3. Rearrange partition results into the projected ordering given by [outputOrdinals].
 //
@@ -102,9 +104,9 @@ func (i *WindowIter) size() int {
 	return size + 1
 }
 
-// initializeIters materializes and copies the input buffer into each
-// WindowPartitionIter.
-// TODO: share the child buffer and sort/partition inbetween WindowPartitionIters
+// initializeIters materializes the input buffer and sorts it for each
+// WindowPartitionIter. The buffer is sorted once for a set of WindowPartitionIters
+// with the same partitions and sort keys that are prefixes of one another.
 func (i *WindowIter) initializeIters(ctx *sql.Context) error {
 	buf := make(sql.WindowBuffer, 0)
 	var row sql.Row
@@ -121,30 +123,30 @@ func (i *WindowIter) initializeIters(ctx *sql.Context) error {
 		buf = append(buf, row)
 	}
 
-	for _, i := range i.partitionIters {
-		// each iter has its own copy of input buffer
-		i.child = &windowBufferIter{buf: buf}
+	// visit the iters with the most sort keys first, which sort for those with fewer
+	iters := make([]*WindowPartitionIter, len(i.partitionIters))
+	copy(iters, i.partitionIters)
+	sort.SliceStable(iters, func(j, k int) bool {
+		return len(iters[j].w.SortBy) > len(iters[k].w.SortBy)
+	})
+
+	var sorted []*WindowPartitionIter
+	for _, p := range iters {
+		var shared *WindowPartitionIter
+		for _, s := range sorted {
+			if s.w.sortsFor(p.w) {
+				shared = s
+				break
+			}
+		}
+		if shared != nil {
+			p.input, p.outputOrdering = shared.input, shared.outputOrdering
+		} else {
+			p.input, p.outputOrdering = sortWindowBuffer(ctx, buf, p.w.sortFields())
+			sorted = append(sorted, p)
+		}
+		p.sorted = true
 	}
 	i.initialized = true
 	return nil
 }
-
-// windowBufferIter bridges an in-memory buffer to the sql.RowIter interface
-type windowBufferIter struct {
-	buf sql.WindowBuffer
-	pos int
-}
-
-func (i *windowBufferIter) Next(ctx *sql.Context) (sql.Row, error) {
-	if i.pos >= len(i.buf) {
-		return nil, io.EOF
-	}
-	row := i.buf[i.pos]
-	i.pos++
-	return row, nil
-}
-
-func (i *windowBufferIter) Close(ctx *sql.Context) error {
-	i.buf = nil
-	return nil
-}
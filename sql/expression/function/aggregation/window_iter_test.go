@@ -83,6 +83,38 @@ func TestWindowIter(t *testing.T) {
 				{"desert", float64(23), "mummy"},
 			},
 		},
+		{
+			Name: "shared partition sorting",
+			PartitionIters: []*WindowPartitionIter{
+				NewWindowPartitionIter(
+					&WindowPartition{
+						PartitionBy: partitionByX,
+						Aggs: []*Aggregation{
+							NewAggregation(NewSumAgg(expression.NewGetField(3, types.Int64, "z", true)), NewPartitionFramer()),
+						},
+					}),
+				NewWindowPartitionIter(
+					&WindowPartition{
+						PartitionBy: partitionByX,
+						SortBy:      sortByW,
+						Aggs: []*Aggregation{
+							NewAggregation(NewMaxAgg(expression.NewGetField(3, types.Int64, "z", true)), NewUnboundedPrecedingToCurrentRowFramer()),
+						},
+					}),
+			},
+			OutputOrdinals: [][]int{{1}, {0}},
+			Expected: []sql.Row{
+				{int32(4), float64(27)},
+				{int32(4), float64(27)},
+				{int32(6), float64(27)},
+				{int32(6), float64(27)},
+				{int32(10), float64(27)},
+				{int32(4), float64(23)},
+				{int32(6), float64(23)},
+				{int32(8), float64(23)},
+				{int32(8), float64(23)},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -30,9 +30,13 @@ var ErrNoPartitions = errors.New("no partitions")
 // Iteration logic is divided between [fn] and [framer] depending on context.
 // For example, some aggregation functions like PercentRank and CountAgg track peer
 // groups within a partition, more state than the framer provides.
+// A [fn] that is a sql.WindowSlidingFunction is told the rows entering and
+// leaving each [frame] instead of aggregating every row of it.
 type Aggregation struct {
 	fn     sql.WindowFunction
 	framer sql.WindowFramer
+
+	partition, frame sql.WindowInterval
 }
 
 func NewAggregation(a sql.WindowFunction, f sql.WindowFramer) *Aggregation {
@@ -49,9 +53,33 @@ func (a *Aggregation) startPartition(ctx *sql.Context, interval sql.WindowInterv
 	if err != nil {
 		return err
 	}
+	a.partition = interval
+	a.frame = sql.WindowInterval{Start: interval.Start, End: interval.Start}
 	return nil
 }
 
+// compute returns the result of the aggregation [fn] for the frame [interval].
+func (a *Aggregation) compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) (interface{}, error) {
+	sliding, ok := a.fn.(sql.WindowSlidingFunction)
+	if !ok {
+		return a.fn.Compute(ctx, interval, buf), nil
+	}
+
+	if interval.Start < a.frame.Start || interval.End < a.frame.End {
+		// frames only move backwards when a framer starts over, so start over the aggregation too
+		if err := a.fn.StartPartition(ctx, a.partition, buf); err != nil {
+			return nil, err
+		}
+		a.frame = sql.WindowInterval{Start: a.partition.Start, End: a.partition.Start}
+	}
+	added, dropped := slidingIntervals(a.frame, interval)
+	if err := sliding.NewSlidingFrameInterval(ctx, added, dropped, buf); err != nil {
+		return nil, err
+	}
+	a.frame = interval
+	return a.fn.Compute(ctx, interval, buf), nil
+}
+
 // WindowPartition is an Aggregation set with unique partition and sorting keys.
 // There may be several WindowPartitions in one query, but each has unique key set.
 // A WindowPartitionIter is used to evaluate a WindowPartition with a specific sql.RowIter.
@@ -73,6 +101,32 @@ func (w *WindowPartition) AddAggregation(agg *Aggregation) {
 	w.Aggs = append(w.Aggs, agg)
 }
 
+// sortFields returns the fields that the input rows of the partition are sorted by: its partition keys, then its sort
+// keys.
+func (w *WindowPartition) sortFields() sql.SortFields {
+	return append(partitionsToSortFields(w.PartitionBy), w.SortBy...)
+}
+
+// sortsFor returns whether rows sorted for [w] are sorted for [other] as well, which is the case when they have the
+// same partition keys and the sort keys of [other] are a prefix of those of [w].
+func (w *WindowPartition) sortsFor(other *WindowPartition) bool {
+	if len(w.PartitionBy) != len(other.PartitionBy) || len(w.SortBy) < len(other.SortBy) {
+		return false
+	}
+	for i, e := range other.PartitionBy {
+		if w.PartitionBy[i].String() != e.String() {
+			return false
+		}
+	}
+	for i, f := range other.SortBy {
+		sf := w.SortBy[i]
+		if sf.Column.String() != f.Column.String() || sf.Order != f.Order || sf.NullOrdering != f.NullOrdering {
+			return false
+		}
+	}
+	return true
+}
+
 // WindowPartitionIter evaluates a WindowPartition with a sql.RowIter child.
 // A parent WindowIter is expected to maintain the projection ordering for
 // WindowPartition output columns.
@@ -88,6 +142,9 @@ type WindowPartitionIter struct {
 	w             *WindowPartition
 	child         sql.RowIter
 	input, output sql.WindowBuffer
+	// sorted is whether [input] and [outputOrdering] were set by a parent WindowIter,
+	// rather than materialized from [child]
+	sorted bool
 
 	pos               int
 	outputOrderingPos int
@@ -127,9 +184,11 @@ func (i *WindowPartitionIter) Dispose() {
 func (i *WindowPartitionIter) Next(ctx *sql.Context) (sql.Row, error) {
 	var err error
 	if i.output == nil {
-		i.input, i.outputOrdering, err = i.materializeInput(ctx)
-		if err != nil {
-			return nil, err
+		if !i.sorted {
+			i.input, i.outputOrdering, err = i.materializeInput(ctx)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		i.partitions, err = i.initializePartitions(ctx)
@@ -161,7 +220,6 @@ func (i *WindowPartitionIter) Next(ctx *sql.Context) (sql.Row, error) {
 // a sorted sql.WindowBuffer and a list of original row indices for resorting.
 func (i *WindowPartitionIter) materializeInput(ctx *sql.Context) (sql.WindowBuffer, []int, error) {
 	input := make(sql.WindowBuffer, 0)
-	j := 0
 	for {
 		row, err := i.child.Next(ctx)
 		if err != nil {
@@ -170,31 +228,45 @@ func (i *WindowPartitionIter) materializeInput(ctx *sql.Context) (sql.WindowBuff
 			}
 			return nil, nil, err
 		}
-		input = append(input, append(row, j))
-		j++
+		input = append(input, row)
 	}
 
-	if len(input) == 0 {
-		return nil, nil, nil
-	}
+	input, outputOrdering := sortWindowBuffer(ctx, input, i.w.sortFields())
+	return input, outputOrdering, nil
+}
 
-	// sort all rows by partition
-	sorter := &expression.Sorter{
-		SortFields: append(partitionsToSortFields(i.w.PartitionBy), i.w.SortBy...),
-		Rows:       input,
-		Ctx:        ctx,
+// sortWindowBuffer returns the rows of [buf] sorted by [sortFields], and a list of their
+// original indices for resorting. [buf] itself is left untouched, so that it can be sorted
+// for several WindowPartitions.
+func sortWindowBuffer(ctx *sql.Context, buf sql.WindowBuffer, sortFields sql.SortFields) (sql.WindowBuffer, []int) {
+	if len(buf) == 0 {
+		return nil, nil
 	}
-	sort.Stable(sorter)
 
 	// maintain output sort ordering
 	// TODO: push sort above aggregation, makes this code unnecessarily complex
+	input := make(sql.WindowBuffer, len(buf))
+	for j, row := range buf {
+		input[j] = append(row[:len(row):len(row)], j)
+	}
+
+	// sort all rows by partition
+	if len(sortFields) > 0 {
+		sorter := &expression.Sorter{
+			SortFields: sortFields,
+			Rows:       input,
+			Ctx:        ctx,
+		}
+		sort.Stable(sorter)
+	}
+
 	outputOrdering := make([]int, len(input))
 	outputIdx := len(input[0]) - 1
 	for k, row := range input {
 		outputOrdering[k], input[k] = row[outputIdx].(int), row[:outputIdx]
 	}
 
-	return input, outputOrdering, nil
+	return input, outputOrdering
 }
 
 // initializePartitions walks the [i.input] buffer using [i.PartitionBy] and
@@ -275,7 +347,10 @@ func (i *WindowPartitionIter) compute(ctx *sql.Context) (sql.Row, error) {
 				return nil, err
 			}
 		}
-		row[j] = agg.fn.Compute(ctx, interval, i.input)
+		row[j], err = agg.compute(ctx, interval, i.input)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// TODO: move sort by above aggregation
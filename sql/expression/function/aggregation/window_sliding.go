@@ -0,0 +1,159 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"math/bits"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// slidingIntervals returns the rows added to and dropped from the [last] frame of a partition to get to the [next]
+// one, which must not start or end before it.
+//
+// Ex: last = {2,5}; next = {3,7}
+// =>
+// dropped: {2,3}, added: {5,7}
+func slidingIntervals(last, next sql.WindowInterval) (added, dropped sql.WindowInterval) {
+	dropped = sql.WindowInterval{Start: last.Start, End: next.Start}
+	if dropped.End > last.End {
+		dropped.End = last.End
+	}
+	added = sql.WindowInterval{Start: last.End, End: next.End}
+	if added.Start < next.Start {
+		added.Start = next.Start
+	}
+	return added, dropped
+}
+
+// extremumCandidate is a row of a frame whose value may be the frame's extremum.
+type extremumCandidate struct {
+	idx int
+	val interface{}
+}
+
+// slidingExtremum aggregates the MIN or MAX of a sliding frame. It keeps a queue of the rows of the frame whose value
+// is more extreme than those of all the rows after them: the first one is the extremum of the frame, and the next ones
+// take its place as the rows before them are dropped from the frame. Each row is added to the queue and removed from
+// it once, so that sliding the frame over a partition is linear.
+type slidingExtremum struct {
+	expr sql.Expression
+	// more is the comparison of a value more extreme than another: 1 for MAX, -1 for MIN
+	more int
+	// valid is whether the queue holds the current frame, which is only the case for sliding frames
+	valid bool
+	queue []extremumCandidate
+}
+
+func (s *slidingExtremum) reset() {
+	s.valid = false
+	s.queue = s.queue[:0]
+}
+
+func (s *slidingExtremum) slide(ctx *sql.Context, added, dropped sql.WindowInterval, buf sql.WindowBuffer) error {
+	s.valid = true
+	for len(s.queue) > 0 && s.queue[0].idx < dropped.End {
+		s.queue = s.queue[1:]
+	}
+	for i := added.Start; i < added.End; i++ {
+		v, err := s.expr.Eval(ctx, buf[i])
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			continue
+		}
+		for len(s.queue) > 0 {
+			cmp, err := s.expr.Type().Compare(v, s.queue[len(s.queue)-1].val)
+			if err != nil {
+				return err
+			}
+			if cmp != s.more {
+				break
+			}
+			s.queue = s.queue[:len(s.queue)-1]
+		}
+		s.queue = append(s.queue, extremumCandidate{idx: i, val: v})
+	}
+	return nil
+}
+
+func (s *slidingExtremum) extremum() interface{} {
+	if len(s.queue) == 0 {
+		return nil
+	}
+	return s.queue[0].val
+}
+
+// slidingBits aggregates the bitwise operations of a sliding frame by counting the rows of the frame in which each
+// bit is set.
+type slidingBits struct {
+	expr sql.Expression
+	// valid is whether the counts are those of the current frame, which is only the case for sliding frames
+	valid bool
+	// counts is the number of values of the frame with each bit set
+	counts [64]int
+	// values is the number of non-NULL values of the frame
+	values int
+	// invalid is the number of values of the frame that aren't numbers
+	invalid int
+}
+
+func (s *slidingBits) reset() {
+	*s = slidingBits{expr: s.expr}
+}
+
+func (s *slidingBits) slide(ctx *sql.Context, added, dropped sql.WindowInterval, buf sql.WindowBuffer) error {
+	s.valid = true
+	if err := s.count(ctx, dropped, buf, -1); err != nil {
+		return err
+	}
+	return s.count(ctx, added, buf, 1)
+}
+
+func (s *slidingBits) count(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer, inc int) error {
+	for i := interval.Start; i < interval.End; i++ {
+		v, err := s.expr.Eval(ctx, buf[i])
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			continue
+		}
+		val, err := types.Uint64.Convert(v)
+		if err != nil {
+			s.invalid += inc
+			continue
+		}
+		s.values += inc
+		for b := val.(uint64); b != 0; b &= b - 1 {
+			s.counts[bits.TrailingZeros64(b)] += inc
+		}
+	}
+	return nil
+}
+
+// result returns the value with the bits set for which [set] returns true given the number of values of the frame
+// with the bit set.
+func (s *slidingBits) result(set func(count int) bool) uint64 {
+	var res uint64
+	for b, count := range s.counts {
+		if set(count) {
+			res |= 1 << b
+		}
+	}
+	return res
+}
@@ -89,6 +89,7 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "from_unixtime", Fn: NewFromUnixtime},
 	sql.FunctionN{Name: "greatest", Fn: NewGreatest},
 	sql.Function0{Name: "group_concat", Fn: aggregation.NewEmptyGroupConcat},
+	sql.FunctionN{Name: "grouping", Fn: aggregation.NewGrouping},
 	sql.Function1{Name: "hex", Fn: NewHex},
 	sql.Function1{Name: "hour", Fn: NewHour},
 	sql.Function3{Name: "if", Fn: NewIf},
@@ -194,6 +195,8 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "sleep", Fn: NewSleep},
 	sql.Function1{Name: "soundex", Fn: NewSoundex},
 	sql.Function1{Name: "sqrt", Fn: NewSqrt},
+	sql.Function1{Name: "statement_digest", Fn: NewStatementDigest},
+	sql.Function1{Name: "statement_digest_text", Fn: NewStatementDigestText},
 	sql.FunctionN{Name: "str_to_date", Fn: NewStrToDate},
 	sql.Function2{Name: "point", Fn: spatial.NewPoint},
 	sql.FunctionN{Name: "linestring", Fn: spatial.NewLineString},
@@ -0,0 +1,111 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// StatementDigest function returns the digest of a statement.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_statement-digest
+type StatementDigest struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*StatementDigest)(nil)
+var _ sql.CollationCoercible = (*StatementDigest)(nil)
+
+// NewStatementDigest returns a new STATEMENT_DIGEST function expression
+func NewStatementDigest(arg sql.Expression) sql.Expression {
+	return &StatementDigest{NewUnaryFunc(arg, "STATEMENT_DIGEST", types.LongText)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *StatementDigest) Description() string {
+	return "computes the statement digest hash value."
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*StatementDigest) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return ctx.GetCollation(), 4
+}
+
+// Eval implements sql.Expression
+func (f *StatementDigest) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	stmt, err := evalStatementArg(ctx, f.UnaryFunc, row)
+	if err != nil || stmt == nil {
+		return nil, err
+	}
+	return sql.StatementDigest(stmt.(string))
+}
+
+// WithChildren implements sql.Expression
+func (f *StatementDigest) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewStatementDigest(children[0]), nil
+}
+
+// StatementDigestText function returns the normalized text of a statement.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_statement-digest-text
+type StatementDigestText struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*StatementDigestText)(nil)
+var _ sql.CollationCoercible = (*StatementDigestText)(nil)
+
+// NewStatementDigestText returns a new STATEMENT_DIGEST_TEXT function expression
+func NewStatementDigestText(arg sql.Expression) sql.Expression {
+	return &StatementDigestText{NewUnaryFunc(arg, "STATEMENT_DIGEST_TEXT", types.LongText)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *StatementDigestText) Description() string {
+	return "computes the normalized statement digest."
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*StatementDigestText) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return ctx.GetCollation(), 4
+}
+
+// Eval implements sql.Expression
+func (f *StatementDigestText) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	stmt, err := evalStatementArg(ctx, f.UnaryFunc, row)
+	if err != nil || stmt == nil {
+		return nil, err
+	}
+	return sql.StatementDigestText(stmt.(string))
+}
+
+// WithChildren implements sql.Expression
+func (f *StatementDigestText) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewStatementDigestText(children[0]), nil
+}
+
+// evalStatementArg returns the statement argument of the function given as a string, or nil if it's NULL.
+func evalStatementArg(ctx *sql.Context, f *UnaryFunc, row sql.Row) (interface{}, error) {
+	arg, err := f.EvalChild(ctx, row)
+	if err != nil || arg == nil {
+		return nil, err
+	}
+	return types.LongText.Convert(arg)
+}
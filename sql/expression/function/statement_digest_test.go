@@ -0,0 +1,45 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+func TestStatementDigest(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	stmt := expression.NewLiteral("select * from t where a = 1", types.LongText)
+
+	res, err := NewStatementDigestText(stmt).Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM `t` WHERE `a` = ?", res)
+
+	res, err = NewStatementDigest(stmt).Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, sql.DigestOfText("SELECT * FROM `t` WHERE `a` = ?"), res)
+
+	res, err = NewStatementDigest(expression.NewLiteral(nil, types.Null)).Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Nil(t, res)
+	res, err = NewStatementDigestText(expression.NewLiteral(nil, types.Null)).Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
@@ -111,7 +111,7 @@ func (p *GetField) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	if p.fieldIndex < 0 || p.fieldIndex >= len(row) {
 		return nil, ErrIndexOutOfBounds.New(p.fieldIndex, len(row))
 	}
-	return row[p.fieldIndex], nil
+	return sql.LoadValue(ctx, row[p.fieldIndex])
 }
 
 func (p *GetField) Eval2(ctx *sql.Context, row sql.Row2) (sql.Value, error) {
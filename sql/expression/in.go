@@ -309,7 +309,7 @@ func convertOrTruncate(ctx *sql.Context, i interface{}, t sql.Type) (interface{}
 		Message: fmt.Sprintf("Truncated incorrect %s value: %v", t.String(), i),
 		Code:    1292,
 	}
-	ctx.Session.Warn(&warning)
+	ctx.AddWarning(&warning)
 	return t.Zero(), nil
 }
 
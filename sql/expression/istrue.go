@@ -43,6 +43,11 @@ func NewIsFalse(child sql.Expression) *IsTrue {
 	return &IsTrue{UnaryExpression: UnaryExpression{child}, invert: true}
 }
 
+// Inverted returns whether this expression checks if its child is false, rather than true.
+func (e *IsTrue) Inverted() bool {
+	return e.invert
+}
+
 // Type implements the Expression interface.
 func (*IsTrue) Type() sql.Type {
 	return types.Boolean
@@ -30,6 +30,8 @@ type Literal struct {
 	value     interface{}
 	val2      sql.Value
 	fieldType sql.Type
+	// hex is whether the literal was written as a hexadecimal or bit-value literal, which is a number in numeric contexts
+	hex bool
 }
 
 var _ sql.Expression = &Literal{}
@@ -46,6 +48,25 @@ func NewLiteral(value interface{}, fieldType sql.Type) *Literal {
 	}
 }
 
+// NewHexLiteral creates a new Literal for a hexadecimal literal such as X'4D' or 0x4D. Hexadecimal literals are binary
+// strings, except when they're compared to numbers, where they're treated as unsigned integers.
+func NewHexLiteral(value []byte) *Literal {
+	lit := NewLiteral(value, types.LongBlob)
+	lit.hex = true
+	return lit
+}
+
+// NewBitLiteral creates a new Literal for a bit-value literal such as b'01001101' or 0b01001101. Like hexadecimal
+// literals, bit-value literals are binary strings, except when they're compared to numbers.
+func NewBitLiteral(value []byte) *Literal {
+	return NewHexLiteral(value)
+}
+
+// IsHex returns whether the literal was written as a hexadecimal or bit-value literal.
+func (lit *Literal) IsHex() bool {
+	return lit.hex
+}
+
 // Resolved implements the Expression interface.
 func (lit *Literal) Resolved() bool {
 	return true
@@ -67,6 +88,9 @@ func (lit *Literal) CollationCoercibility(ctx *sql.Context) (collation sql.Colla
 		collation, _ = lit.fieldType.CollationCoercibility(ctx)
 		return collation, 4
 	}
+	if types.IsBinaryType(lit.fieldType) {
+		return sql.Collation_binary, 4
+	}
 	return sql.Collation_binary, 5
 }
 
@@ -27,6 +27,7 @@ type And struct {
 }
 
 var _ sql.Expression = (*And)(nil)
+var _ sql.Expression2 = (*And)(nil)
 var _ sql.CollationCoercible = (*And)(nil)
 
 // NewAnd creates a new And expression.
@@ -119,6 +120,43 @@ func (a *And) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return true, nil
 }
 
+// Eval2 implements the sql.Expression2 interface.
+func (a *And) Eval2(ctx *sql.Context, row sql.Row2) (sql.Value, error) {
+	lval, err := a.Left.(sql.Expression2).Eval2(ctx, row)
+	if err != nil {
+		return sql.Value{}, err
+	}
+	if !lval.IsNull() {
+		if b, err := isTrue2(lval); err != nil {
+			return sql.Value{}, err
+		} else if !b {
+			return falseValue2, nil
+		}
+	}
+
+	rval, err := a.Right.(sql.Expression2).Eval2(ctx, row)
+	if err != nil {
+		return sql.Value{}, err
+	}
+	if !rval.IsNull() {
+		if b, err := isTrue2(rval); err != nil {
+			return sql.Value{}, err
+		} else if !b {
+			return falseValue2, nil
+		}
+	}
+
+	if lval.IsNull() || rval.IsNull() {
+		return nullValue2, nil
+	}
+	return trueValue2, nil
+}
+
+// Type2 implements the sql.Expression2 interface.
+func (*And) Type2() sql.Type2 {
+	return types.Boolean.(sql.Type2)
+}
+
 // WithChildren implements the Expression interface.
 func (a *And) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -133,6 +171,7 @@ type Or struct {
 }
 
 var _ sql.Expression = (*Or)(nil)
+var _ sql.Expression2 = (*Or)(nil)
 var _ sql.CollationCoercible = (*Or)(nil)
 
 // NewOr creates a new Or expression.
@@ -195,6 +234,43 @@ func (o *Or) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return nil, nil
 }
 
+// Eval2 implements the sql.Expression2 interface.
+func (o *Or) Eval2(ctx *sql.Context, row sql.Row2) (sql.Value, error) {
+	lval, err := o.Left.(sql.Expression2).Eval2(ctx, row)
+	if err != nil {
+		return sql.Value{}, err
+	}
+	if !lval.IsNull() {
+		if b, err := isTrue2(lval); err != nil {
+			return sql.Value{}, err
+		} else if b {
+			return trueValue2, nil
+		}
+	}
+
+	rval, err := o.Right.(sql.Expression2).Eval2(ctx, row)
+	if err != nil {
+		return sql.Value{}, err
+	}
+	if !rval.IsNull() {
+		if b, err := isTrue2(rval); err != nil {
+			return sql.Value{}, err
+		} else if b {
+			return trueValue2, nil
+		}
+	}
+
+	if lval.IsNull() || rval.IsNull() {
+		return nullValue2, nil
+	}
+	return falseValue2, nil
+}
+
+// Type2 implements the sql.Expression2 interface.
+func (*Or) Type2() sql.Type2 {
+	return types.Boolean.(sql.Type2)
+}
+
 // WithChildren implements the Expression interface.
 func (o *Or) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -15,6 +15,7 @@
 package expression
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -149,3 +150,26 @@ func TestJoinAnd(t *testing.T) {
 		),
 	)
 }
+
+func TestAndOrNotEval2(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	values := []interface{}{int8(1), int8(0), nil}
+	for _, left := range values {
+		for _, right := range values {
+			l, r := NewLiteral(left, types.Boolean), NewLiteral(right, types.Boolean)
+			for _, e := range []sql.Expression{NewAnd(l, r), NewOr(l, r), NewNot(l)} {
+				t.Run(fmt.Sprintf("%v %v %v", e, left, right), func(t *testing.T) {
+					require := require.New(t)
+					result, err := e.Eval(ctx, nil)
+					require.NoError(err)
+					expected, err := sql.ConvertToValue(result)
+					require.NoError(err)
+
+					actual, err := e.(sql.Expression2).Eval2(ctx, nil)
+					require.NoError(err)
+					require.Equal(expected, actual)
+				})
+			}
+		}
+	}
+}
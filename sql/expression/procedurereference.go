@@ -19,6 +19,8 @@ import (
 	"io"
 	"strings"
 
+	"github.com/dolthub/vitess/go/mysql"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
@@ -27,6 +29,9 @@ import (
 type ProcedureReference struct {
 	innermostScope *procedureScope
 	height         int
+	// handledConditions are the conditions being handled by the handlers currently running, innermost last. They're
+	// what GET STACKED DIAGNOSTICS reads.
+	handledConditions []*mysql.SQLError
 }
 type procedureScope struct {
 	parent    *procedureScope
@@ -158,6 +163,10 @@ func (ppr *ProcedureReference) VariableHasBeenSet(name string) bool {
 // been declared, then returns the given error. Otherwise, returns a new error that was created from the HANDLER, or a
 // nil error if it was a CONTINUE HANDLER.
 func (ppr *ProcedureReference) HandleError(ctx *sql.Context, incomingErr error) error {
+	condition := sql.CastSQLError(incomingErr)
+	if incomingErr == io.EOF {
+		condition = sql.CastSQLError(sql.ErrFetchNoData.New())
+	}
 	scope := ppr.innermostScope
 	for scope != nil {
 		for i := len(scope.handlers) - 1; i >= 0; i-- {
@@ -167,6 +176,10 @@ func (ppr *ProcedureReference) HandleError(ctx *sql.Context, incomingErr error)
 				ppr.innermostScope = originalScope
 			}()
 			ppr.innermostScope = scope
+			ppr.handledConditions = append(ppr.handledConditions, condition)
+			defer func() {
+				ppr.handledConditions = ppr.handledConditions[:len(ppr.handledConditions)-1]
+			}()
 			handlerRefVal := scope.handlers[i]
 
 			handlerRowIter, err := handlerRefVal.Stmt.RowIter(ctx, nil)
@@ -296,6 +309,21 @@ func (ppr *ProcedureReference) CloseAllCursors(ctx *sql.Context) error {
 	return err
 }
 
+// HandledCondition returns the condition being handled by the innermost handler currently running, or nil if no handler
+// is running.
+func (ppr *ProcedureReference) HandledCondition() *mysql.SQLError {
+	if ppr == nil || len(ppr.handledConditions) == 0 {
+		return nil
+	}
+	return ppr.handledConditions[len(ppr.handledConditions)-1]
+}
+
+// Reset discards all variables, cursors and handlers, so that the stored function that this reference belongs to can
+// be run again from its initial state. Open cursors should be closed first.
+func (ppr *ProcedureReference) Reset() {
+	*ppr = *NewProcedureReference()
+}
+
 // CurrentHeight returns the current height of the scope stack.
 func (ppr *ProcedureReference) CurrentHeight() int {
 	return ppr.height
@@ -110,14 +110,18 @@ func (s *Sorter2) Less(i, j int) bool {
 	a := s.Rows[i]
 	b := s.Rows[j]
 	for _, sf := range s.SortFields {
-		typ := sf.Column2.Type2()
-		av, err := sf.Column2.Eval2(s.Ctx, a)
+		col := sf.Column2
+		if col == nil {
+			col = sf.Column.(sql.Expression2)
+		}
+		typ := col.Type2()
+		av, err := col.Eval2(s.Ctx, a)
 		if err != nil {
 			s.LastError = sql.ErrUnableSort.Wrap(err)
 			return false
 		}
 
-		bv, err := sf.Column2.Eval2(s.Ctx, b)
+		bv, err := col.Eval2(s.Ctx, b)
 		if err != nil {
 			s.LastError = sql.ErrUnableSort.Wrap(err)
 			return false
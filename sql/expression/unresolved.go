@@ -225,6 +225,8 @@ var _ sql.CollationCoercible = (*UnresolvedFunction)(nil)
 // supposed to be called.
 type UnresolvedFunction struct {
 	name string
+	// Database is the database that qualifies the function's name, which can only be a stored function, if any
+	Database string
 	// IsAggregate or not.
 	IsAggregate bool
 	// Window is the window for this function, if present
@@ -327,5 +329,7 @@ func (uf *UnresolvedFunction) WithChildren(children ...sql.Expression) (sql.Expr
 		return nil, err
 	}
 
-	return NewUnresolvedFunction(uf.name, uf.IsAggregate, window, children[:len(uf.Arguments)]...), nil
+	nf := NewUnresolvedFunction(uf.name, uf.IsAggregate, window, children[:len(uf.Arguments)]...)
+	nf.Database = uf.Database
+	return nf, nil
 }
@@ -0,0 +1,112 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// Handler is a table opened by a HANDLER ... OPEN statement, which reads its rows one batch at a time with HANDLER ...
+// READ statements, in the order of the table or of one of its indexes, until it's closed.
+// https://dev.mysql.com/doc/refman/8.0/en/handler.html
+type Handler struct {
+	// Name is the name of the handler, the alias given when it was opened or else the name of its table
+	Name string
+	// Database is the name of the database of the table
+	Database string
+	// Table is the name of the table
+	Table string
+	// Index is the name of the index the last rows were read through, or empty if they were read in the order of the
+	// table
+	Index string
+	// Positioned is whether any rows have been read through the handler, and its position is set
+	Positioned bool
+	// Last is the last row read through an index that's read with lookups, which the next rows are read from. It's nil
+	// once reads have gone past the first or the last row, in the direction of Forward.
+	Last Row
+	// Forward is whether the last rows read through an index that's read with lookups were read forward
+	Forward bool
+	// Position is the position of the last row read in the order of Index when the rows are read without lookups, in
+	// the order of the table if it has no primary key. It's -1 or the number of rows once reads have gone past the
+	// first or the last row.
+	Position int
+}
+
+// HandlerRegistry keeps track of the handlers opened by all sessions, from HANDLER ... OPEN until they're closed with
+// HANDLER ... CLOSE or their session ends. The names of the handlers of a session are case-insensitive. A handler is
+// only used by the session that opened it, one statement at a time, so its position isn't protected by the registry.
+type HandlerRegistry struct {
+	mu       sync.Mutex
+	sessions map[uint32]map[string]*Handler
+}
+
+// NewHandlerRegistry returns a new, empty HandlerRegistry.
+func NewHandlerRegistry() *HandlerRegistry {
+	return &HandlerRegistry{sessions: make(map[uint32]map[string]*Handler)}
+}
+
+// Open registers the handler given for the session with the connection ID given. It returns ErrHandlerAlreadyOpen if
+// the session already has a handler with the same name.
+func (r *HandlerRegistry) Open(connID uint32, h *Handler) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	handlers, ok := r.sessions[connID]
+	if !ok {
+		handlers = make(map[string]*Handler)
+		r.sessions[connID] = handlers
+	}
+	name := strings.ToLower(h.Name)
+	if _, ok := handlers[name]; ok {
+		return ErrHandlerAlreadyOpen.New(h.Name)
+	}
+	handlers[name] = h
+	return nil
+}
+
+// Get returns the handler with the name given of the session with the connection ID given. It returns
+// ErrUnknownHandler if the session has no such handler.
+func (r *HandlerRegistry) Get(connID uint32, name string) (*Handler, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	h, ok := r.sessions[connID][strings.ToLower(name)]
+	if !ok {
+		return nil, ErrUnknownHandler.New(name)
+	}
+	return h, nil
+}
+
+// Close removes the handler with the name given of the session with the connection ID given. It returns
+// ErrUnknownHandler if the session has no such handler.
+func (r *HandlerRegistry) Close(connID uint32, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	handlers := r.sessions[connID]
+	if _, ok := handlers[strings.ToLower(name)]; !ok {
+		return ErrUnknownHandler.New(name)
+	}
+	delete(handlers, strings.ToLower(name))
+	if len(handlers) == 0 {
+		delete(r.sessions, connID)
+	}
+	return nil
+}
+
+// EndSession closes the handlers of the session with the connection ID given, as the session is being closed.
+func (r *HandlerRegistry) EndSession(connID uint32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sessions, connID)
+}
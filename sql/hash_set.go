@@ -0,0 +1,285 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"sort"
+)
+
+// HashSet is a set of hashes, such as the hashes of the rows that a DISTINCT has already returned.
+type HashSet interface {
+	// Add adds a hash to the set, and returns whether it wasn't in the set already.
+	Add(uint64) (bool, error)
+	// Size returns the number of hashes in the set.
+	Size() int
+}
+
+const (
+	// hashSetEntrySize is the approximate memory used by each hash of a hashSet that's kept in memory.
+	hashSetEntrySize = 16
+	// maxHashSetRuns is the number of runs of hashes a hashSet spills to disk before merging them into a single run.
+	maxHashSetRuns = 8
+)
+
+// NewHashSet returns an empty hash set and a function to dispose it when it's no longer needed. The set keeps up to
+// |maxMemory| bytes of hashes in memory, or less if the memory of the process runs out, and spills the rest to sorted
+// files in |dir|. If |dir| is empty, the default directory for temporary files is used.
+func (m *MemoryManager) NewHashSet(maxMemory uint64, dir string) (HashSet, DisposeFunc) {
+	s := newHashSet(m, m.reporter, maxMemory, dir)
+	pos := m.addCache(s)
+	return s, func() {
+		s.Dispose()
+		m.removeCache(pos)
+	}
+}
+
+// hashSet is a HashSet that keeps its hashes in memory until it reaches its limit, and then writes them to a sorted
+// run of hashes on disk. Hashes are looked up in memory first, and then with a binary search of each run.
+type hashSet struct {
+	memory     Freeable
+	reporter   Reporter
+	maxEntries int
+	dir        string
+	hashes     map[uint64]struct{}
+	runs       []*hashRun
+	size       int
+}
+
+func newHashSet(memory Freeable, r Reporter, maxMemory uint64, dir string) *hashSet {
+	maxEntries := int(maxMemory / hashSetEntrySize)
+	if maxEntries < 1 {
+		maxEntries = 1
+	}
+	return &hashSet{
+		memory:     memory,
+		reporter:   r,
+		maxEntries: maxEntries,
+		dir:        dir,
+		hashes:     make(map[uint64]struct{}),
+	}
+}
+
+// Add implements the HashSet interface.
+func (s *hashSet) Add(h uint64) (bool, error) {
+	if _, ok := s.hashes[h]; ok {
+		return false, nil
+	}
+	for _, run := range s.runs {
+		ok, err := run.contains(h)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return false, nil
+		}
+	}
+
+	s.hashes[h] = struct{}{}
+	s.size++
+	if len(s.hashes) >= s.maxEntries || !releaseMemoryIfNeeded(s.reporter, s.memory.Free) {
+		if err := s.spill(); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Size implements the HashSet interface.
+func (s *hashSet) Size() int {
+	return s.size
+}
+
+// spill writes the hashes in memory to a new run, merging the runs once there are too many of them.
+func (s *hashSet) spill() error {
+	hashes := make([]uint64, 0, len(s.hashes))
+	for h := range s.hashes {
+		hashes = append(hashes, h)
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+
+	run, err := newHashRun(s.dir, func(w *bufio.Writer) error {
+		for _, h := range hashes {
+			if err := writeHash(w, h); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.runs = append(s.runs, run)
+	s.hashes = make(map[uint64]struct{})
+
+	if len(s.runs) > maxHashSetRuns {
+		merged, err := mergeHashRuns(s.dir, s.runs)
+		if err != nil {
+			return err
+		}
+		for _, run := range s.runs {
+			run.dispose()
+		}
+		s.runs = []*hashRun{merged}
+	}
+	return nil
+}
+
+// Dispose implements the Disposable interface.
+func (s *hashSet) Dispose() {
+	for _, run := range s.runs {
+		run.dispose()
+	}
+	s.runs = nil
+	s.hashes = nil
+	s.memory = nil
+}
+
+// hashRun is a file of sorted hashes.
+type hashRun struct {
+	file     *os.File
+	len      int64
+	min, max uint64
+}
+
+// newHashRun returns a run of the hashes that |write| writes in ascending order.
+func newHashRun(dir string, write func(w *bufio.Writer) error) (*hashRun, error) {
+	file, err := os.CreateTemp(dir, "gms-hashset-*")
+	if err != nil {
+		return nil, err
+	}
+	run := &hashRun{file: file}
+
+	w := bufio.NewWriter(file)
+	if err = write(w); err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		run.dispose()
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		run.dispose()
+		return nil, err
+	}
+	run.len = info.Size() / 8
+	if run.len > 0 {
+		if run.min, err = run.at(0); err == nil {
+			run.max, err = run.at(run.len - 1)
+		}
+		if err != nil {
+			run.dispose()
+			return nil, err
+		}
+	}
+	return run, nil
+}
+
+func (r *hashRun) at(i int64) (uint64, error) {
+	var b [8]byte
+	if _, err := r.file.ReadAt(b[:], i*8); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(b[:]), nil
+}
+
+// contains returns whether this run contains the hash given.
+func (r *hashRun) contains(h uint64) (bool, error) {
+	if r.len == 0 || h < r.min || h > r.max {
+		return false, nil
+	}
+	lo, hi := int64(0), r.len-1
+	for lo <= hi {
+		mid := lo + (hi-lo)/2
+		v, err := r.at(mid)
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case v == h:
+			return true, nil
+		case v < h:
+			lo = mid + 1
+		default:
+			hi = mid - 1
+		}
+	}
+	return false, nil
+}
+
+func (r *hashRun) dispose() {
+	name := r.file.Name()
+	_ = r.file.Close()
+	_ = os.Remove(name)
+}
+
+func writeHash(w *bufio.Writer, h uint64) error {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], h)
+	_, err := w.Write(b[:])
+	return err
+}
+
+// mergeHashRuns returns a single run with the hashes of all the runs given.
+func mergeHashRuns(dir string, runs []*hashRun) (*hashRun, error) {
+	return newHashRun(dir, func(w *bufio.Writer) error {
+		readers := make([]*bufio.Reader, len(runs))
+		heads := make([]uint64, len(runs))
+		live := make([]bool, len(runs))
+		next := func(i int) error {
+			var b [8]byte
+			if _, err := io.ReadFull(readers[i], b[:]); err != nil {
+				if err == io.EOF {
+					live[i] = false
+					return nil
+				}
+				return err
+			}
+			heads[i] = binary.BigEndian.Uint64(b[:])
+			live[i] = true
+			return nil
+		}
+
+		for i, run := range runs {
+			readers[i] = bufio.NewReader(io.NewSectionReader(run.file, 0, run.len*8))
+			if err := next(i); err != nil {
+				return err
+			}
+		}
+
+		for {
+			min := -1
+			for i := range runs {
+				if live[i] && (min < 0 || heads[i] < heads[min]) {
+					min = i
+				}
+			}
+			if min < 0 {
+				return nil
+			}
+			if err := writeHash(w, heads[min]); err != nil {
+				return err
+			}
+			if err := next(min); err != nil {
+				return err
+			}
+		}
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashSet(t *testing.T) {
+	require := require.New(t)
+	dir := t.TempDir()
+	m := NewMemoryManager(nil)
+
+	// 4 hashes fit in memory, so adding 100 of them spills and merges runs
+	set, dispose := m.NewHashSet(4*hashSetEntrySize, dir)
+	for i := uint64(0); i < 100; i++ {
+		added, err := set.Add(i * 7)
+		require.NoError(err)
+		require.True(added)
+	}
+	require.Equal(100, set.Size())
+
+	for i := uint64(0); i < 100; i++ {
+		added, err := set.Add(i * 7)
+		require.NoError(err)
+		require.False(added)
+	}
+	added, err := set.Add(3)
+	require.NoError(err)
+	require.True(added)
+	require.Equal(101, set.Size())
+
+	files, err := filepath.Glob(filepath.Join(dir, "gms-hashset-*"))
+	require.NoError(err)
+	require.NotEmpty(files)
+	require.LessOrEqual(len(files), maxHashSetRuns)
+
+	dispose()
+	entries, err := os.ReadDir(dir)
+	require.NoError(err)
+	require.Empty(entries)
+	require.Equal(0, m.NumCaches())
+}
@@ -29,6 +29,19 @@ type IndexColumn struct {
 	Name string
 	// Length represents the index prefix length. If zero, then no length was specified.
 	Length int64
+	// Descending is true if the column was declared with DESC, so that the index is sorted on it in descending order.
+	Descending bool
+	// Expression is the expression of a functional key part, which indexes the value of the expression rather than a
+	// column, or nil if this is a column. Name is then the name MySQL gives the hidden column of the key part, given by
+	// FunctionalKeyPartColumnName once the index is named, and the expression is resolved against the schema of the
+	// table when the index is created.
+	Expression Expression
+}
+
+// FunctionalKeyPartColumnName returns the name of the hidden column of the functional key part at position |part| of
+// the index named.
+func FunctionalKeyPartColumnName(indexName string, part int) string {
+	return fmt.Sprintf("!hidden!%s!%d!0", indexName, part)
 }
 
 // IndexConstraint represents any constraints that should be applied to the index.
@@ -85,6 +98,16 @@ type Index interface {
 	PrefixLengths() []uint16
 }
 
+// FunctionalIndex is an Index with functional key parts, which index the values of expressions rather than columns.
+// Like those of columns, the expressions of functional key parts are returned by Expressions(), so that filters on
+// them can be matched to the index.
+type FunctionalIndex interface {
+	Index
+	// FunctionalKeyParts returns the expression of each key part of this index that is functional, or nil for the key
+	// parts that are columns.
+	FunctionalKeyParts() []Expression
+}
+
 // IndexLookup is the implementation-specific definition of an index lookup. The IndexLookup must contain all necessary
 // information to retrieve exactly the rows in the table as specified by the ranges given to their parent index.
 // Implementors are responsible for all semantics of correctly returning rows that match an index lookup.
@@ -98,6 +121,9 @@ type IndexLookup struct {
 	IsPointLookup   bool
 	IsEmptyRange    bool
 	IsSpatialLookup bool
+	// IsReverse is true if the rows of the lookup should be returned in the reverse order of the index, which is only
+	// valid for an OrderedIndex that is Reversible.
+	IsReverse bool
 }
 
 var emptyLookup = IndexLookup{}
@@ -143,6 +169,18 @@ type OrderedIndex interface {
 	Index
 	// Order returns the order of results for reads from this index
 	Order() IndexOrder
+	// Reversible returns whether this index can be read backwards, returning its results in the reverse of its order,
+	// for lookups with IsReverse set
+	Reversible() bool
+}
+
+// DescendingIndex is an extension of |OrderedIndex| that allows indexes to declare which of their columns they are sorted
+// on in descending order, such as the columns declared with DESC in an index definition. The results of reads from an
+// OrderedIndex that isn't a DescendingIndex are sorted on all of its columns in ascending order.
+type DescendingIndex interface {
+	OrderedIndex
+	// Descending returns whether this index is sorted on each of its expressions in descending order
+	Descending() []bool
 }
 
 // ColumnExpressionType returns a column expression along with its Type.
@@ -191,6 +191,45 @@ func (b *IndexBuilder) IsNotNull(ctx *Context, colExpr string) *IndexBuilder {
 	return b
 }
 
+// Or represents the disjunction of the conditions that the builders given place on colExpr, such as
+// (colExpr = key1 OR colExpr > key2). Each of the builders given must be for the same index as this builder, and must
+// only place conditions on colExpr.
+func (b *IndexBuilder) Or(ctx *Context, colExpr string, builders ...*IndexBuilder) *IndexBuilder {
+	if b.isInvalid {
+		return b
+	}
+	if _, ok := b.colExprTypes[colExpr]; !ok {
+		b.isInvalid = true
+		b.err = ErrInvalidColExpr.New(colExpr, b.idx.ID())
+		return b
+	}
+	var potentialRanges []RangeColumnExpr
+	for _, builder := range builders {
+		if builder.err != nil {
+			b.isInvalid = true
+			b.err = builder.err
+			return b
+		}
+		// An invalid builder matches no rows, so it doesn't contribute any ranges to the disjunction
+		if builder.isInvalid {
+			continue
+		}
+		potentialRanges = append(potentialRanges, builder.ranges[colExpr]...)
+	}
+	if len(potentialRanges) == 0 {
+		b.isInvalid = true
+		return b
+	}
+	potentialRanges, err := SimplifyRangeColumn(potentialRanges...)
+	if err != nil {
+		b.isInvalid = true
+		b.err = err
+		return b
+	}
+	b.updateCol(ctx, colExpr, potentialRanges...)
+	return b
+}
+
 // Ranges returns all ranges for this index builder. If the builder is in an error state then this returns nil.
 func (b *IndexBuilder) Ranges(ctx *Context) RangeCollection {
 	if b.err != nil {
@@ -123,6 +123,55 @@ func TestIndexBuilderRanges(t *testing.T) {
 		assert.Equal(t, sql.RangeCollection{sql.Range{sql.OpenRangeColumnExpr(2, 4, types.Int8)}, sql.Range{sql.GreaterThanRangeColumnExpr(4, types.Int8)}, sql.Range{sql.LessThanRangeColumnExpr(2, types.Int8)}}, ranges)
 	})
 
+	t.Run("Equals1,Or(Equals2,GT4)=[1,1],[2,2],(4,Inf)", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testIndex{2})
+		builder = builder.Equals(ctx, "column_0", 1)
+		builder = builder.Or(ctx, "column_1",
+			sql.NewIndexBuilder(testIndex{2}).Equals(ctx, "column_1", 2),
+			sql.NewIndexBuilder(testIndex{2}).GreaterThan(ctx, "column_1", 4),
+		)
+		ranges := builder.Ranges(ctx)
+		assert.NotNil(t, ranges)
+		assert.Equal(t, sql.RangeCollection{
+			sql.Range{sql.ClosedRangeColumnExpr(1, 1, types.Int8), sql.GreaterThanRangeColumnExpr(4, types.Int8)},
+			sql.Range{sql.ClosedRangeColumnExpr(1, 1, types.Int8), sql.ClosedRangeColumnExpr(2, 2, types.Int8)},
+		}, ranges)
+	})
+
+	t.Run("Or(LT4,GT2)=[NULL,Inf)", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testIndex{1})
+		builder = builder.Or(ctx, "column_0",
+			sql.NewIndexBuilder(testIndex{1}).LessThan(ctx, "column_0", 4),
+			sql.NewIndexBuilder(testIndex{1}).GreaterThan(ctx, "column_0", 2),
+		)
+		ranges := builder.Ranges(ctx)
+		assert.NotNil(t, ranges)
+		assert.Equal(t, sql.RangeCollection{sql.Range{sql.NotNullRangeColumnExpr(types.Int8)}}, ranges)
+	})
+
+	t.Run("Or(IsNull,Equals2),Equals2=[2,2]", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testIndex{1})
+		builder = builder.Or(ctx, "column_0",
+			sql.NewIndexBuilder(testIndex{1}).IsNull(ctx, "column_0"),
+			sql.NewIndexBuilder(testIndex{1}).Equals(ctx, "column_0", 2),
+		)
+		builder = builder.Equals(ctx, "column_0", 2)
+		ranges := builder.Ranges(ctx)
+		assert.NotNil(t, ranges)
+		assert.Equal(t, sql.RangeCollection{sql.Range{sql.ClosedRangeColumnExpr(2, 2, types.Int8)}}, ranges)
+	})
+
+	t.Run("Or(GT2,LT4 and GT6)=(2,Inf)", func(t *testing.T) {
+		builder := sql.NewIndexBuilder(testIndex{1})
+		builder = builder.Or(ctx, "column_0",
+			sql.NewIndexBuilder(testIndex{1}).GreaterThan(ctx, "column_0", 2),
+			sql.NewIndexBuilder(testIndex{1}).LessThan(ctx, "column_0", 4).GreaterThan(ctx, "column_0", 6),
+		)
+		ranges := builder.Ranges(ctx)
+		assert.NotNil(t, ranges)
+		assert.Equal(t, sql.RangeCollection{sql.Range{sql.GreaterThanRangeColumnExpr(2, types.Int8)}}, ranges)
+	})
+
 	t.Run("ThreeColumnCombine", func(t *testing.T) {
 		clauses := make([]sql.RangeCollection, 3)
 		clauses[0] = sql.NewIndexBuilder(testIndex{3}).GreaterOrEqual(ctx, "column_0", 99).LessThan(ctx, "column_1", 66).Ranges(ctx)
@@ -0,0 +1,136 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// OnlineIndexBuilder is an IndexAlterableTable that can build a new index while the table is read and written. The
+// engine creates an index of such a table in phases:
+//  1. StartIndexBuild adds the index, which isn't used by reads yet, and starts tracking the writes to the table.
+//  2. Copy fills the index with the rows of the table as of the start of the build, while reads and writes continue.
+//  3. CatchUp applies the writes tracked since the start of the build to the index and makes it usable. Writes to the
+//     table may be blocked while it runs, which should be short compared to the copy.
+//
+// If a phase fails or the statement is canceled, Abort drops the index. StartIndexBuild returns
+// ErrOnlineIndexBuildNotSupported for indexes the table can't build online, which the engine creates with CreateIndex.
+type OnlineIndexBuilder interface {
+	IndexAlterableTable
+	// StartIndexBuild starts building the index given.
+	StartIndexBuild(ctx *Context, idx IndexDef) (IndexBuild, error)
+}
+
+// IndexBuild is an index being built by an OnlineIndexBuilder.
+type IndexBuild interface {
+	// Copy fills the index with the rows of the table as of the start of the build, calling progress with the number
+	// of rows copied since its previous call. It returns once the copy is done, or the context is canceled.
+	Copy(ctx *Context, progress func(rows int64)) error
+	// CatchUp applies the writes made to the table since the start of the build to the index, and makes it usable.
+	CatchUp(ctx *Context) error
+	// Abort stops the build and drops the index.
+	Abort(ctx *Context) error
+}
+
+// IndexBuildsService is the key of the registry of the index builds in progress in the service registry of an
+// engine, which are reported by SHOW INDEX BUILDS.
+var IndexBuildsService = NewServiceKey[*IndexBuilds]("index builds")
+
+// The phases of an index build.
+const (
+	IndexBuildPhaseCopying    = "copying"
+	IndexBuildPhaseCatchingUp = "catching up"
+)
+
+// IndexBuildStatus is the status of an index build in progress.
+type IndexBuildStatus struct {
+	// ID is the number of the build in the registry, starting at 1.
+	ID           uint64
+	Database     string
+	Table        string
+	Index        string
+	ConnectionID uint32
+	Phase        string
+	// RowsCopied is the number of rows copied into the index so far.
+	RowsCopied int64
+	// RowsTotal is the estimated number of rows of the table, or -1 if it's unknown.
+	RowsTotal int64
+	StartedAt time.Time
+}
+
+// IndexBuilds keeps track of the index builds in progress.
+type IndexBuilds struct {
+	mu     sync.Mutex
+	builds map[uint64]*IndexBuildStatus
+	lastID uint64
+}
+
+// NewIndexBuilds returns a new, empty IndexBuilds.
+func NewIndexBuilds() *IndexBuilds {
+	return &IndexBuilds{builds: make(map[uint64]*IndexBuildStatus)}
+}
+
+// Start registers the build given, in its copying phase, and returns its ID.
+func (b *IndexBuilds) Start(status IndexBuildStatus) uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lastID++
+	status.ID = b.lastID
+	status.Phase = IndexBuildPhaseCopying
+	status.StartedAt = time.Now()
+	b.builds[status.ID] = &status
+	return status.ID
+}
+
+// AddRowsCopied adds rows to the rows copied by the build with the ID given.
+func (b *IndexBuilds) AddRowsCopied(id uint64, rows int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if status, ok := b.builds[id]; ok {
+		status.RowsCopied += rows
+	}
+}
+
+// SetPhase sets the phase of the build with the ID given.
+func (b *IndexBuilds) SetPhase(id uint64, phase string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if status, ok := b.builds[id]; ok {
+		status.Phase = phase
+	}
+}
+
+// End removes the build with the ID given, as it's done or has failed.
+func (b *IndexBuilds) End(id uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.builds, id)
+}
+
+// Builds returns the statuses of the builds in progress, oldest first.
+func (b *IndexBuilds) Builds() []IndexBuildStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	builds := make([]IndexBuildStatus, 0, len(b.builds))
+	for _, status := range b.builds {
+		builds = append(builds, *status)
+	}
+	sort.Slice(builds, func(i, j int) bool {
+		return builds[i].ID < builds[j].ID
+	})
+	return builds
+}
@@ -221,6 +221,11 @@ func getRowFromColumn(ctx *sql.Context, curOrdPos int, col *sql.Column, dbName,
 
 	columnDefault := getColumnDefault(ctx, col.Default)
 
+	var generationExpression string
+	if col.Generated != nil {
+		generationExpression = col.Generated.Expression.String()
+	}
+
 	extra := col.Extra
 	// If extra is not defined, fill it here.
 	if extra == "" && !col.Default.IsLiteral() {
@@ -244,28 +249,28 @@ func getRowFromColumn(ctx *sql.Context, curOrdPos int, col *sql.Column, dbName,
 	privileges := strings.Join(curColPrivStr, ",")
 
 	return sql.Row{
-		"def",             // table_catalog
-		dbName,            // table_schema
-		tblName,           // table_name
-		col.Name,          // column_name
-		ordinalPos,        // ordinal_position
-		columnDefault,     // column_default
-		nullable,          // is_nullable
-		dataType,          // data_type
-		charMaxLen,        // character_maximum_length
-		charOctetLen,      // character_octet_length
-		numericPrecision,  // numeric_precision
-		numericScale,      // numeric_scale
-		datetimePrecision, // datetime_precision
-		charName,          // character_set_name
-		collName,          // collation_name
-		colType,           // column_type
-		columnKey,         // column_key
-		extra,             // extra
-		privileges,        // privileges
-		col.Comment,       // column_comment
-		"",                // generation_expression
-		srsId,             // srs_id
+		"def",                // table_catalog
+		dbName,               // table_schema
+		tblName,              // table_name
+		col.Name,             // column_name
+		ordinalPos,           // ordinal_position
+		columnDefault,        // column_default
+		nullable,             // is_nullable
+		dataType,             // data_type
+		charMaxLen,           // character_maximum_length
+		charOctetLen,         // character_octet_length
+		numericPrecision,     // numeric_precision
+		numericScale,         // numeric_scale
+		datetimePrecision,    // datetime_precision
+		charName,             // character_set_name
+		collName,             // collation_name
+		colType,              // column_type
+		columnKey,            // column_key
+		extra,                // extra
+		privileges,           // privileges
+		col.Comment,          // column_comment
+		generationExpression, // generation_expression
+		srsId,                // srs_id
 	}
 }
 
@@ -25,6 +25,7 @@ import (
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/shopspring/decimal"
 
 	. "github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/spatial"
@@ -465,9 +466,9 @@ var profilingSchema = Schema{
 	{Name: "QUERY_ID", Type: types.Int32, Default: nil, Nullable: false, Source: ProfilingTableName},
 	{Name: "SEQ", Type: types.Int32, Default: nil, Nullable: false, Source: ProfilingTableName},
 	{Name: "STATE", Type: types.MustCreateString(sqltypes.VarChar, 30, Collation_Information_Schema_Default), Default: nil, Nullable: false, Source: ProfilingTableName},
-	{Name: "DURATION", Type: types.MustCreateDecimalType(types.DecimalTypeMaxPrecision, 0), Default: nil, Nullable: false, Source: ProfilingTableName},
-	{Name: "CPU_USER", Type: types.MustCreateDecimalType(types.DecimalTypeMaxPrecision, 0), Default: nil, Nullable: true, Source: ProfilingTableName},
-	{Name: "CPU_SYSTEM", Type: types.MustCreateDecimalType(types.DecimalTypeMaxPrecision, 0), Default: nil, Nullable: true, Source: ProfilingTableName},
+	{Name: "DURATION", Type: types.MustCreateDecimalType(9, 6), Default: nil, Nullable: false, Source: ProfilingTableName},
+	{Name: "CPU_USER", Type: types.MustCreateDecimalType(9, 6), Default: nil, Nullable: true, Source: ProfilingTableName},
+	{Name: "CPU_SYSTEM", Type: types.MustCreateDecimalType(9, 6), Default: nil, Nullable: true, Source: ProfilingTableName},
 	{Name: "CONTEXT_VOLUNTARY", Type: types.Int32, Default: nil, Nullable: true, Source: ProfilingTableName},
 	{Name: "CONTEXT_INVOLUNTARY", Type: types.Int32, Default: nil, Nullable: true, Source: ProfilingTableName},
 	{Name: "BLOCK_OPS_IN", Type: types.Int32, Default: nil, Nullable: true, Source: ProfilingTableName},
@@ -1074,10 +1075,13 @@ func processListRowIter(ctx *Context, c Catalog) (RowIter, error) {
 		for name, progress := range proc.Progress {
 			status = append(status, fmt.Sprintf("%s(%s)", name, progress))
 		}
+		sort.Strings(status)
+		if proc.Stage.Name != "" {
+			status = append([]string{proc.Stage.String()}, status...)
+		}
 		if len(status) == 0 {
 			status = []string{"running"}
 		}
-		sort.Strings(status)
 		rows[i] = Row{
 			uint64(proc.Connection),      // id
 			proc.User,                    // user
@@ -1093,6 +1097,28 @@ func processListRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// profilingRowIter implements the sql.RowIter for the information_schema.PROFILING table, holding the stages of the
+// queries profiled by the session. Only the durations of the stages are recorded.
+func profilingRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	profiles, ok := GetService(ctx, QueryProfilesService)
+	if !ok {
+		return RowsToRowIter(), nil
+	}
+
+	var rows []Row
+	for _, profile := range profiles.Profiles(ctx.ID()) {
+		for i, stage := range profile.Stages {
+			row := make(Row, len(profilingSchema))
+			row[0] = int32(profile.ID)                                       // query_id
+			row[1] = int32(i + 1)                                            // seq
+			row[2] = stage.Name                                              // state
+			row[3] = decimal.NewFromFloat(stage.Duration.Seconds()).Round(6) // duration
+			rows = append(rows, row)
+		}
+	}
+	return RowsToRowIter(rows...), nil
+}
+
 // referentialConstraintsRowIter implements the sql.RowIter for the information_schema.REFERENTIAL_CONSTRAINTS table.
 func referentialConstraintsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	var rows []Row
@@ -1247,13 +1273,17 @@ func schemataRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	var rows []Row
 	for _, db := range dbs {
 		collation := plan.GetDatabaseCollation(ctx, db)
+		encryption := "NO"
+		if plan.GetDatabaseEncryption(ctx, db) {
+			encryption = "YES"
+		}
 		rows = append(rows, Row{
 			"def",                             // catalog_name
 			db.Name(),                         // schema_name
 			collation.CharacterSet().String(), // default_character_set_name
 			collation.String(),                // default_collation_name
 			nil,                               // sql_path
-			"NO",                              // default_encryption
+			encryption,                        // default_encryption
 		})
 	}
 
@@ -1401,61 +1431,65 @@ func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 					// setting `VISIBLE` is not supported, so defaulting it to "YES"
 					isVisible = "YES"
 
-					// Create a Row for each column this index refers too.
-					i := 0
+					// Create a Row for each column or expression this index refers too.
 					for j, expr := range index.Expressions() {
-						col := plan.GetColumnFromIndexExpr(expr, tbl)
-						if col != nil {
-							i += 1
-							var (
-								collation   string
-								nullable    string
-								cardinality int64
-								subPart     interface{}
-							)
-
-							seqInIndex := i
-							colName := strings.Replace(col.Name, "`", "", -1) // get rid of backticks
-
-							// collation is "A" for ASC ; "D" for DESC ; "NULL" for not sorted
-							collation = "A"
-
-							// TODO : cardinality is an estimate of the number of unique values in the index.
-
-							if j < len(index.PrefixLengths()) {
-								subPart = int64(index.PrefixLengths()[j])
-							}
-
-							// if nullable, 'YES'; if not, ''
+						var (
+							collation   string
+							nullable    string
+							cardinality int64
+							subPart     interface{}
+							colName     interface{}
+							expression  interface{}
+						)
+
+						seqInIndex := j + 1
+
+						// A functional key part has no column, and it's nullable as the expression may be NULL
+						if keyPart := plan.GetFunctionalKeyPart(index, j); keyPart != nil {
+							expression = keyPart.String()
+							nullable = "YES"
+						} else if col := plan.GetColumnFromIndexExpr(expr, tbl); col != nil {
+							colName = strings.Replace(col.Name, "`", "", -1) // get rid of backticks
 							if col.Nullable {
 								nullable = "YES"
-							} else {
-								nullable = ""
 							}
+						} else {
+							expression = expr
+							nullable = "YES"
+						}
 
-							// TODO: we currently don't support expression index such as ((i * 20))
-
-							rows = append(rows, Row{
-								"def",        // table_catalog
-								db.Name(),    // table_schema
-								tbl.Name(),   // table_name
-								nonUnique,    // non_unique		NOT NULL
-								db.Name(),    // index_schema
-								indexName,    // index_name
-								seqInIndex,   // seq_in_index	NOT NULL
-								colName,      // column_name
-								collation,    // collation
-								cardinality,  // cardinality
-								subPart,      // sub_part
-								nil,          // packed
-								nullable,     // is_nullable	NOT NULL
-								indexType,    // index_type		NOT NULL
-								comment,      // comment		NOT NULL
-								indexComment, // index_comment	NOT NULL
-								isVisible,    // is_visible		NOT NULL
-								nil,          // expression
-							})
+						// collation is "A" for ASC ; "D" for DESC ; "NULL" for not sorted
+						collation = "A"
+						if di, ok := index.(DescendingIndex); ok && di.Descending()[j] {
+							collation = "D"
 						}
+
+						// TODO : cardinality is an estimate of the number of unique values in the index.
+
+						if j < len(index.PrefixLengths()) {
+							subPart = int64(index.PrefixLengths()[j])
+						}
+
+						rows = append(rows, Row{
+							"def",        // table_catalog
+							db.Name(),    // table_schema
+							tbl.Name(),   // table_name
+							nonUnique,    // non_unique		NOT NULL
+							db.Name(),    // index_schema
+							indexName,    // index_name
+							seqInIndex,   // seq_in_index	NOT NULL
+							colName,      // column_name
+							collation,    // collation
+							cardinality,  // cardinality
+							subPart,      // sub_part
+							nil,          // packed
+							nullable,     // is_nullable	NOT NULL
+							indexType,    // index_type		NOT NULL
+							comment,      // comment		NOT NULL
+							indexComment, // index_comment	NOT NULL
+							isVisible,    // is_visible		NOT NULL
+							expression,   // expression
+						})
 					}
 				}
 			}
@@ -2053,7 +2087,6 @@ func viewsRowIter(ctx *Context, catalog Catalog) (RowIter, error) {
 			viewDef := view.TextDefinition
 			definer := removeBackticks(viewPlan.Definer)
 
-			// TODO: WITH CHECK OPTION is not supported yet.
 			checkOpt := viewPlan.CheckOpt
 			if checkOpt == "" {
 				checkOpt = "NONE"
@@ -2212,7 +2245,7 @@ func NewInformationSchemaDatabase() Database {
 			ProfilingTableName: &informationSchemaTable{
 				name:   ProfilingTableName,
 				schema: profilingSchema,
-				reader: emptyRowIter,
+				reader: profilingRowIter,
 			},
 			ReferentialConstraintsTableName: &informationSchemaTable{
 				name:   ReferentialConstraintsTableName,
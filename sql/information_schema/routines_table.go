@@ -201,21 +201,36 @@ func routinesRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure) (R
 			if procedure.SecurityContext == plan.ProcedureSecurityContext_Invoker {
 				securityType = "INVOKER"
 			}
+
+			// The data type columns describe the return type of functions
+			routineType := "PROCEDURE"
+			var (
+				dataType                                     = ""
+				dtdId, charName, collName                    interface{}
+				charMaxLen, charOctetLen                     interface{}
+				numericPrecision, numericScale, datetimePrec interface{}
+			)
+			if procedure.IsFunction() {
+				routineType = "FUNCTION"
+				dtdId, dataType = getDtdIdAndDataType(procedure.ReturnType)
+				charName, collName, charMaxLen, charOctetLen = getCharAndCollNamesAndCharMaxAndOctetLens(procedure.ReturnType)
+				numericPrecision, numericScale, datetimePrec = getRoutineTypePrecision(procedure.ReturnType)
+			}
 			rows = append(rows, Row{
 				procedure.Name,             // specific_name NOT NULL
 				"def",                      // routine_catalog
 				dbName,                     // routine_schema
 				procedure.Name,             // routine_name NOT NULL
-				"PROCEDURE",                // routine_type NOT NULL
-				"",                         // data_type
-				nil,                        // character_maximum_length
-				nil,                        // character_octet_length
-				nil,                        // numeric_precision
-				nil,                        // numeric_scale
-				nil,                        // datetime_precision
-				nil,                        // character_set_name
-				nil,                        // collation_name
-				nil,                        // dtd_identifier
+				routineType,                // routine_type NOT NULL
+				dataType,                   // data_type
+				charMaxLen,                 // character_maximum_length
+				charOctetLen,               // character_octet_length
+				numericPrecision,           // numeric_precision
+				numericScale,               // numeric_scale
+				datetimePrec,               // datetime_precision
+				charName,                   // character_set_name
+				collName,                   // collation_name
+				dtdId,                      // dtd_identifier
 				"SQL",                      // routine_body NOT NULL
 				routineDef,                 // routine_definition
 				nil,                        // external_name
@@ -237,11 +252,27 @@ func routinesRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure) (R
 		}
 	}
 
-	// TODO: need to add FUNCTIONS routine_type
-
 	return RowsToRowIter(rows...), nil
 }
 
+// getRoutineTypePrecision returns the numeric precision, numeric scale and datetime precision of the type given of a
+// parameter or return value of a routine.
+func getRoutineTypePrecision(typ Type) (numericPrecision, numericScale, datetimePrecision interface{}) {
+	numericPrecision, numericScale = getColumnPrecisionAndScale(typ)
+	// float types get nil for numericScale, but it gets 0 for routines
+	if _, ok := typ.(NumberType); ok {
+		numericScale = 0
+	}
+
+	if types.IsDatetimeType(typ) || types.IsTimestampType(typ) {
+		datetimePrecision = 0
+	} else if types.IsTimespan(typ) {
+		// TODO: TIME length not yet supported
+		datetimePrecision = 6
+	}
+	return numericPrecision, numericScale, datetimePrecision
+}
+
 // parametersRowIter implements the sql.RowIter for the information_schema.PARAMETERS table.
 func parametersRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure) (RowIter, error) {
 	var rows []Row
@@ -268,16 +299,29 @@ func parametersRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure)
 				continue
 			}
 
-			for i, param := range procedure.Params {
+			// The return value of a function is described by a row with ordinal position 0 and no mode or name
+			params := procedure.Params
+			routineType := "PROCEDURE"
+			if procedure.IsFunction() {
+				params = append([]plan.ProcedureParam{{Type: procedure.ReturnType}}, params...)
+				routineType = "FUNCTION"
+			}
+			for i, param := range params {
 				var (
-					ordinalPos        = uint64(i + 1)
-					datetimePrecision interface{}
-					parameterMode     interface{}
+					ordinalPos    = uint64(i + 1)
+					parameterMode interface{}
+					parameterName interface{} = param.Name
 				)
 
 				dtdId, dataType := getDtdIdAndDataType(param.Type)
 
-				if param.Direction == plan.ProcedureParamDirection_In {
+				if procedure.IsFunction() {
+					// Function parameters have no mode
+					ordinalPos = uint64(i)
+					if i == 0 {
+						parameterName = nil
+					}
+				} else if param.Direction == plan.ProcedureParamDirection_In {
 					parameterMode = "IN"
 				} else if param.Direction == plan.ProcedureParamDirection_Inout {
 					parameterMode = "INOUT"
@@ -286,26 +330,15 @@ func parametersRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure)
 				}
 
 				charName, collName, charMaxLen, charOctetLen := getCharAndCollNamesAndCharMaxAndOctetLens(param.Type)
-				numericPrecision, numericScale := getColumnPrecisionAndScale(param.Type)
-				// float types get nil for numericScale, but it gets 0 for this table
-				if _, ok := param.Type.(NumberType); ok {
-					numericScale = 0
-				}
-
-				if types.IsDatetimeType(param.Type) || types.IsTimestampType(param.Type) {
-					datetimePrecision = 0
-				} else if types.IsTimespan(param.Type) {
-					// TODO: TIME length not yet supported
-					datetimePrecision = 6
-				}
+				numericPrecision, numericScale, datetimePrecision := getRoutineTypePrecision(param.Type)
 
 				rows = append(rows, Row{
 					"def",             // specific_catalog
 					dbName,            // specific_schema
 					procedure.Name,    // specific_name
-					ordinalPos,        // ordinal_position - 0 for FUNCTIONS
+					ordinalPos,        // ordinal_position - 0 for the return value of FUNCTIONS
 					parameterMode,     // parameter_mode   - NULL for FUNCTIONS
-					param.Name,        // parameter_name   - NULL for FUNCTIONS
+					parameterName,     // parameter_name   - NULL for the return value of FUNCTIONS
 					dataType,          // data_type
 					charMaxLen,        // character_maximum_length
 					charOctetLen,      // character_octet_length
@@ -315,12 +348,11 @@ func parametersRowIter(ctx *Context, c Catalog, p map[string][]*plan.Procedure)
 					charName,          // character_set_name
 					collName,          // collation_name
 					dtdId,             // dtd_identifier
-					"PROCEDURE",       // routine_type
+					routineType,       // routine_type
 				})
 			}
 		}
 	}
-	// TODO: need to add FUNCTIONS routine_type
 
 	return RowsToRowIter(rows...), nil
 }
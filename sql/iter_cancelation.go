@@ -0,0 +1,172 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"time"
+)
+
+// The iterators of tables, partitions and index lookups returned by integrators are called with the context of the
+// query reading them. Once the context is canceled, e.g. when the query is killed or its client disconnects, they must
+// return the error of the context promptly, including when they're blocked on reads from their storage. Iterators that
+// only block on other contexts can wrap themselves with NewCancelableRowIter or NewCancelablePartitionIter to honor
+// the contract between reads. The engine can also wrap the iterators of integrators with a watchdog, which abandons an
+// iterator that keeps running for longer than a grace period after its context is canceled, with WithIteratorGracePeriod.
+
+// cancelableRowIter is a RowIter returning the error of its context once it's canceled, instead of calling its
+// wrapped iterator.
+type cancelableRowIter struct {
+	iter RowIter
+}
+
+var _ RowIter = (*cancelableRowIter)(nil)
+
+// NewCancelableRowIter returns an iterator over the rows of the iterator given, which returns the error of the context
+// of each call to Next once it's canceled, without calling the wrapped iterator.
+func NewCancelableRowIter(iter RowIter) RowIter {
+	return &cancelableRowIter{iter: iter}
+}
+
+// Next implements the RowIter interface.
+func (i *cancelableRowIter) Next(ctx *Context) (Row, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return i.iter.Next(ctx)
+}
+
+// Close implements the RowIter interface.
+func (i *cancelableRowIter) Close(ctx *Context) error {
+	return i.iter.Close(ctx)
+}
+
+// cancelablePartitionIter is a PartitionIter returning the error of its context once it's canceled, instead of calling
+// its wrapped iterator.
+type cancelablePartitionIter struct {
+	iter PartitionIter
+}
+
+var _ PartitionIter = (*cancelablePartitionIter)(nil)
+
+// NewCancelablePartitionIter returns an iterator over the partitions of the iterator given, which returns the error of
+// the context of each call to Next once it's canceled, without calling the wrapped iterator.
+func NewCancelablePartitionIter(iter PartitionIter) PartitionIter {
+	return &cancelablePartitionIter{iter: iter}
+}
+
+// Next implements the PartitionIter interface.
+func (i *cancelablePartitionIter) Next(ctx *Context) (Partition, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return i.iter.Next(ctx)
+}
+
+// Close implements the PartitionIter interface.
+func (i *cancelablePartitionIter) Close(ctx *Context) error {
+	return i.iter.Close(ctx)
+}
+
+// WithIteratorGracePeriod sets how long the engine waits for the iterators of integrators to return after the context
+// is canceled, before abandoning them and returning ErrIteratorUnresponsive. Iterators aren't watched if it's zero,
+// which is the default.
+func WithIteratorGracePeriod(grace time.Duration) ContextOption {
+	return func(ctx *Context) {
+		ctx.iterGrace = grace
+	}
+}
+
+// IteratorGracePeriod returns how long the engine waits for the iterators of integrators to return after the context is
+// canceled, or zero if they aren't watched.
+func (c *Context) IteratorGracePeriod() time.Duration {
+	return c.iterGrace
+}
+
+// iterWatchdog runs the calls to the iterators of an integrator on a goroutine of its own, so that callers stop waiting
+// for a call that doesn't return within the grace period after its context is canceled.
+type iterWatchdog struct {
+	grace time.Duration
+	calls chan func()
+	done  chan struct{}
+	// abandoned is set when a call didn't return within the grace period, after which calls fail and the iterators are
+	// closed by the goroutine once the call returns.
+	abandoned bool
+}
+
+// newIterWatchdog returns a new watchdog with the grace period given, whose goroutine is started by its first call.
+func newIterWatchdog(grace time.Duration) *iterWatchdog {
+	return &iterWatchdog{grace: grace}
+}
+
+// run runs the watchdog's calls until it's stopped.
+func (w *iterWatchdog) run() {
+	for call := range w.calls {
+		call()
+	}
+	close(w.done)
+}
+
+// call runs the function given on the watchdog's goroutine and waits for it to return. If the context is canceled, it
+// waits for the grace period at most, and then abandons the call and returns ErrIteratorUnresponsive.
+func (w *iterWatchdog) call(ctx *Context, f func()) error {
+	if w.abandoned {
+		return ErrIteratorUnresponsive.New(w.grace)
+	}
+	if w.calls == nil {
+		w.calls = make(chan func())
+		w.done = make(chan struct{})
+		go w.run()
+	}
+
+	returned := make(chan struct{})
+	w.calls <- func() {
+		defer close(returned)
+		f()
+	}
+	select {
+	case <-returned:
+		return nil
+	case <-ctx.Done():
+	}
+
+	timer := time.NewTimer(w.grace)
+	defer timer.Stop()
+	select {
+	case <-returned:
+		return nil
+	case <-timer.C:
+		w.abandoned = true
+		ctx.GetLogger().Warnf("abandoned an iterator that didn't return within %v of its query being canceled", w.grace)
+		return ErrIteratorUnresponsive.New(w.grace)
+	}
+}
+
+// stop stops the watchdog's goroutine once its calls have returned, after running the function given on it, or on the
+// caller's goroutine if the watchdog's calls haven't been abandoned, whose error is returned.
+func (w *iterWatchdog) stop(f func() error) error {
+	if w.calls == nil {
+		return f()
+	}
+	if w.abandoned {
+		go func() {
+			w.calls <- func() { _ = f() }
+			close(w.calls)
+		}()
+		return nil
+	}
+	close(w.calls)
+	<-w.done
+	return f()
+}
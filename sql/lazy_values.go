@@ -0,0 +1,59 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// Tables may return large BLOB, TEXT and JSON values stored out of band as LazyValue handles in their rows, instead of
+// reading the values along with the rest of their rows. The engine loads the values of handles when they're used in an
+// expression, hashed to remove duplicate rows, written to a table, or returned to the client, so the values of rows that
+// are filtered out, or of columns that are only passed through, are never read.
+
+// LazyValue is a handle to a large value that a table returns in its rows in place of the value itself.
+type LazyValue interface {
+	// Load returns the value the handle refers to, which must be a value of the type of its column. Load may be called
+	// more than once for the same handle, so handles whose values are expensive to read should keep them once loaded.
+	Load(ctx *Context) (interface{}, error)
+}
+
+// LoadValue returns the value given, or the value it refers to if it's a LazyValue.
+func LoadValue(ctx *Context, v interface{}) (interface{}, error) {
+	if lazy, ok := v.(LazyValue); ok {
+		return lazy.Load(ctx)
+	}
+	return v, nil
+}
+
+// LoadRow returns the row given with the values of its LazyValue handles loaded. The row is copied, rather than
+// modified, when it holds any handles.
+func LoadRow(ctx *Context, row Row) (Row, error) {
+	var loaded Row
+	for i, v := range row {
+		lazy, ok := v.(LazyValue)
+		if !ok {
+			continue
+		}
+		if loaded == nil {
+			loaded = row.Copy()
+		}
+		value, err := lazy.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded[i] = value
+	}
+	if loaded == nil {
+		return row, nil
+	}
+	return loaded, nil
+}
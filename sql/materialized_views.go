@@ -0,0 +1,178 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MaterializedViewsService is the key of the registry of materialized views in the service registry of an engine.
+// Materialized views are an extension of the engine: CREATE, REFRESH and DROP MATERIALIZED VIEW statements fail with
+// ErrServiceNotRegistered unless a MaterializedViewRegistry is registered with this key.
+var MaterializedViewsService = NewServiceKey[*MaterializedViewRegistry]("materialized views")
+
+// MaterializedView is a view whose result is stored in a backing table of the same name, in the same database. The
+// backing table is updated when the view is refreshed, or as the tables it reads change if it's maintained incrementally.
+type MaterializedView struct {
+	Database string
+	Name     string
+	// Definition is the text of the SELECT statement defining the view
+	Definition string
+	// RefreshInterval is the time between scheduled refreshes of the view, or zero if it's only refreshed on demand
+	RefreshInterval time.Duration
+	// MaxStaleness is how long after a refresh queries matching the view's definition read its backing table instead,
+	// or zero if they never do
+	MaxStaleness time.Duration
+	// LastRefresh is the time the view was last refreshed
+	LastRefresh time.Time
+	// Maintainer keeps the backing table of the view up to date as the tables it reads change, or is nil if the view
+	// can't be maintained incrementally
+	Maintainer MaterializedViewMaintainer
+}
+
+// MaterializedViewMaintainer keeps the backing table of a materialized view up to date with the changes to the rows of
+// the tables it reads, so that refreshing the view doesn't recompute its result.
+type MaterializedViewMaintainer interface {
+	// IsCurrent returns whether the backing table reflects every change to the tables read by the view. It doesn't
+	// once a change couldn't be applied to it, until the view is rebuilt.
+	IsCurrent() bool
+	// Rebuild recomputes the backing table of the view from the tables it reads, returning the number of its rows. It
+	// returns an error if the view can't be maintained anymore, such as after the schema of a table it reads changed.
+	Rebuild(ctx *Context) (int, error)
+	// Stop stops maintaining the view.
+	Stop()
+}
+
+// IsFresh returns whether queries matching the view's definition at the time given may read its backing table, which
+// they may within the view's MAX STALENESS of its last refresh, or at any time while it's maintained incrementally.
+func (v MaterializedView) IsFresh(now time.Time) bool {
+	if v.MaxStaleness <= 0 {
+		return false
+	}
+	if v.Maintainer != nil && v.Maintainer.IsCurrent() {
+		return true
+	}
+	return !now.After(v.LastRefresh.Add(v.MaxStaleness))
+}
+
+// IsRefreshDue returns whether a scheduled refresh of the view is due at the time given.
+func (v MaterializedView) IsRefreshDue(now time.Time) bool {
+	return v.RefreshInterval > 0 && !now.Before(v.LastRefresh.Add(v.RefreshInterval))
+}
+
+// MaterializedViewRegistry holds the materialized views of an engine. Views are registered in memory, so they don't
+// outlive the engine even though their backing tables may.
+type MaterializedViewRegistry struct {
+	mu    sync.RWMutex
+	views map[string]MaterializedView
+}
+
+// NewMaterializedViewRegistry returns a new empty registry of materialized views.
+func NewMaterializedViewRegistry() *MaterializedViewRegistry {
+	return &MaterializedViewRegistry{views: make(map[string]MaterializedView)}
+}
+
+func materializedViewKey(db, name string) string {
+	return strings.ToLower(db) + "." + strings.ToLower(name)
+}
+
+// Register adds the view given, or returns ErrMaterializedViewExists if there's one with the same name already.
+func (r *MaterializedViewRegistry) Register(view MaterializedView) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := materializedViewKey(view.Database, view.Name)
+	if _, ok := r.views[key]; ok {
+		return ErrMaterializedViewExists.New(view.Database, view.Name)
+	}
+	r.views[key] = view
+	return nil
+}
+
+// View returns the view with the name given in the database given, and whether there's one.
+func (r *MaterializedViewRegistry) View(db, name string) (MaterializedView, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	view, ok := r.views[materializedViewKey(db, name)]
+	return view, ok
+}
+
+// Delete removes the view with the name given in the database given, or returns ErrMaterializedViewDoesNotExist if
+// there's none.
+func (r *MaterializedViewRegistry) Delete(db, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := materializedViewKey(db, name)
+	if _, ok := r.views[key]; !ok {
+		return ErrMaterializedViewDoesNotExist.New(db, name)
+	}
+	delete(r.views, key)
+	return nil
+}
+
+// SetRefreshed records that the view with the name given in the database given was refreshed at the time given.
+func (r *MaterializedViewRegistry) SetRefreshed(db, name string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := materializedViewKey(db, name)
+	view, ok := r.views[key]
+	if !ok {
+		return ErrMaterializedViewDoesNotExist.New(db, name)
+	}
+	view.LastRefresh = at
+	r.views[key] = view
+	return nil
+}
+
+// SetMaintainer sets the maintainer of the view with the name given in the database given.
+func (r *MaterializedViewRegistry) SetMaintainer(db, name string, maintainer MaterializedViewMaintainer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := materializedViewKey(db, name)
+	view, ok := r.views[key]
+	if !ok {
+		return ErrMaterializedViewDoesNotExist.New(db, name)
+	}
+	view.Maintainer = maintainer
+	r.views[key] = view
+	return nil
+}
+
+// ViewsInDatabase returns the views of the database given, sorted by name.
+func (r *MaterializedViewRegistry) ViewsInDatabase(db string) []MaterializedView {
+	var views []MaterializedView
+	for _, view := range r.AllViews() {
+		if strings.EqualFold(view.Database, db) {
+			views = append(views, view)
+		}
+	}
+	return views
+}
+
+// AllViews returns all the views of this registry, sorted by database and name.
+func (r *MaterializedViewRegistry) AllViews() []MaterializedView {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	views := make([]MaterializedView, 0, len(r.views))
+	for _, view := range r.views {
+		views = append(views, view)
+	}
+	sort.Slice(views, func(i, j int) bool {
+		return materializedViewKey(views[i].Database, views[i].Name) < materializedViewKey(views[j].Database, views[j].Name)
+	})
+	return views
+}
@@ -123,6 +123,19 @@ func (processReporter) UsedMemory() uint64 {
 
 func (processReporter) MaxMemory() uint64 { return maxMemory }
 
+// ProcessMemoryWithLimit returns a reporter for the memory used by the process, whose maximum amount of memory allowed
+// is the number of bytes given rather than the one of the MAX_MEMORY environment variable.
+func ProcessMemoryWithLimit(max uint64) Reporter {
+	return limitedProcessReporter{max: max}
+}
+
+type limitedProcessReporter struct {
+	processReporter
+	max uint64
+}
+
+func (r limitedProcessReporter) MaxMemory() uint64 { return r.max }
+
 // HasAvailableMemory reports whether more memory is available to the program if
 // it hasn't reached the max memory limit.
 func HasAvailableMemory(r Reporter) bool {
@@ -157,6 +170,17 @@ func NewMemoryManager(r Reporter) *MemoryManager {
 	}
 }
 
+// UsedMemory returns the memory in use in bytes, as reported by the manager's reporter.
+func (m *MemoryManager) UsedMemory() uint64 {
+	return m.reporter.UsedMemory()
+}
+
+// MaxMemory returns the maximum number of bytes of memory allowed, as reported by the manager's reporter, or zero if
+// it's unbounded.
+func (m *MemoryManager) MaxMemory() uint64 {
+	return m.reporter.MaxMemory()
+}
+
 // HasAvailable reports whether the memory manager has any available memory.
 func (m *MemoryManager) HasAvailable() bool {
 	return HasAvailableMemory(m.reporter)
@@ -21,11 +21,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
-	"sort"
 	"strings"
+	"sync"
 
 	"github.com/dolthub/vitess/go/mysql"
-	flatbuffers "github.com/google/flatbuffers/go"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db/serial"
@@ -46,6 +45,15 @@ func (p *NoopPersister) Persist(ctx *sql.Context, data []byte) error {
 	return nil
 }
 
+// LoadableMySQLDbPersistence is a MySQLDbPersistence that can also return the data it last persisted. When the
+// persister implements it, FLUSH PRIVILEGES reloads the grant tables from the persisted data, so that changes made to
+// it outside the engine take effect.
+type LoadableMySQLDbPersistence interface {
+	MySQLDbPersistence
+	// Load returns the data last persisted, or nil if nothing has been persisted yet.
+	Load(ctx *sql.Context) ([]byte, error)
+}
+
 type PlaintextAuthPlugin interface {
 	Authenticate(db *MySQLDb, user string, userEntry *User, pass string) (bool, error)
 }
@@ -71,11 +79,16 @@ type MySQLDb struct {
 	persister MySQLDbPersistence
 	plugins   map[string]PlaintextAuthPlugin
 
+	// persisted is a copy of the data last loaded or persisted, or nil if nothing has been loaded or persisted yet
+	persisted *persistedData
+	persistMu sync.Mutex
+
 	updateCounter uint64
 }
 
 var _ sql.Database = (*MySQLDb)(nil)
 var _ mysql.AuthServer = (*MySQLDb)(nil)
+var _ sql.UserPrivilegedOperationChecker = (*MySQLDb)(nil)
 
 // CreateEmptyMySQLDb returns a collection of MySQL Tables that do not contain any data.
 func CreateEmptyMySQLDb() *MySQLDb {
@@ -139,6 +152,7 @@ func (db *MySQLDb) LoadPrivilegeData(ctx *sql.Context, users []*User, roleConnec
 	}
 
 	db.updateCounter++
+	db.setPersisted(ctx)
 
 	return nil
 }
@@ -212,6 +226,7 @@ func (db *MySQLDb) LoadData(ctx *sql.Context, buf []byte) (err error) {
 	}
 
 	db.updateCounter++
+	db.setPersisted(ctx)
 
 	// TODO: fill in other tables when they exist
 	return
@@ -305,7 +320,7 @@ func (db *MySQLDb) GetUser(user string, host string, roleSearch bool) *User {
 // UserActivePrivilegeSet fetches the User, and returns their entire active privilege set. This takes into account the
 // active roles, which are set in the context, therefore the user is also pulled from the context.
 func (db *MySQLDb) UserActivePrivilegeSet(ctx *sql.Context) PrivilegeSet {
-	if privSet, counter := ctx.Session.GetPrivilegeSet(); db.updateCounter == counter {
+	if privSet, counter := ctx.GetPrivilegeSet(); db.updateCounter == counter {
 		// If the counters are equal, we can guarantee that the privilege set exists and is valid
 		return privSet.(PrivilegeSet)
 	}
@@ -316,6 +331,14 @@ func (db *MySQLDb) UserActivePrivilegeSet(ctx *sql.Context) PrivilegeSet {
 		return NewPrivilegeSet()
 	}
 
+	privSet := db.userPrivilegeSet(user)
+	ctx.SetPrivilegeSet(privSet, db.updateCounter)
+	return privSet
+}
+
+// userPrivilegeSet returns the privilege set of the user given, combined with the privileges of every role granted to
+// them.
+func (db *MySQLDb) userPrivilegeSet(user *User) PrivilegeSet {
 	privSet := user.PrivilegeSet.Copy()
 	roleEdgeEntries := db.role_edges.data.Get(RoleEdgesToKey{
 		ToHost: user.Host,
@@ -330,8 +353,6 @@ func (db *MySQLDb) UserActivePrivilegeSet(ctx *sql.Context) PrivilegeSet {
 			privSet.UnionWith(role.PrivilegeSet)
 		}
 	}
-
-	ctx.Session.SetPrivilegeSet(privSet, db.updateCounter)
 	return privSet
 }
 
@@ -342,7 +363,46 @@ func (db *MySQLDb) UserHasPrivileges(ctx *sql.Context, operations ...sql.Privile
 	if !db.Enabled {
 		return true
 	}
-	privSet := db.UserActivePrivilegeSet(ctx)
+	return privilegeSetHasPrivileges(ctx, db.UserActivePrivilegeSet(ctx), operations...)
+}
+
+// ForUser implements the interface sql.UserPrivilegedOperationChecker.
+func (db *MySQLDb) ForUser(ctx *sql.Context, user string, host string) (sql.PrivilegedOperationChecker, bool) {
+	if !db.Enabled {
+		return db, true
+	}
+	u := db.GetUser(user, host, false)
+	if u == nil {
+		return nil, false
+	}
+	return userPrivilegeChecker{db: db, privSet: db.userPrivilegeSet(u)}, true
+}
+
+// userPrivilegeChecker checks privileges against the privilege set of a specific user, rather than the user of the
+// session, such as when accessing tables through a view with the privileges of its definer.
+type userPrivilegeChecker struct {
+	db      *MySQLDb
+	privSet PrivilegeSet
+}
+
+var _ sql.UserPrivilegedOperationChecker = userPrivilegeChecker{}
+
+// UserHasPrivileges implements the interface sql.PrivilegedOperationChecker.
+func (c userPrivilegeChecker) UserHasPrivileges(ctx *sql.Context, operations ...sql.PrivilegedOperation) bool {
+	if !c.db.Enabled {
+		return true
+	}
+	return privilegeSetHasPrivileges(ctx, c.privSet, operations...)
+}
+
+// ForUser implements the interface sql.UserPrivilegedOperationChecker.
+func (c userPrivilegeChecker) ForUser(ctx *sql.Context, user string, host string) (sql.PrivilegedOperationChecker, bool) {
+	return c.db.ForUser(ctx, user, host)
+}
+
+// privilegeSetHasPrivileges returns whether the privilege set given has the privileges necessary to perform the
+// privileged operation(s) given.
+func privilegeSetHasPrivileges(ctx *sql.Context, privSet PrivilegeSet, operations ...sql.PrivilegedOperation) bool {
 	for _, operation := range operations {
 		for _, operationPriv := range operation.StaticPrivileges {
 			if privSet.Has(operationPriv) {
@@ -526,81 +586,116 @@ func (db *MySQLDb) Negotiate(c *mysql.Conn, user string, addr net.Addr) (mysql.G
 	return nil, fmt.Errorf(`the only user login interface currently supported is "mysql_native_password"`)
 }
 
-// Persist passes along all changes to the integrator.
+// Persist passes along all changes to the integrator, if it has set a persister. If the persister is an
+// IncrementalMySQLDbPersistence, only the changes made since the data was last loaded or persisted are passed along.
+// If persisting fails, the grant tables are reverted to the data last loaded or persisted, so that they don't diverge
+// from the persisted data.
 func (db *MySQLDb) Persist(ctx *sql.Context) error {
+	db.persistMu.Lock()
+	defer db.persistMu.Unlock()
 	db.updateCounter++
-	// Extract all user entries from table, and sort
-	userEntries := db.user.data.ToSlice(ctx)
-	users := make([]*User, 0)
-	for _, userEntry := range userEntries {
-		user := userEntry.(*User)
-		if user.IsSuperUser {
-			continue
+	if db.persister == nil {
+		return nil
+	}
+
+	current := snapshotPersistedData(ctx, db)
+	var err error
+	if incremental, ok := db.persister.(IncrementalMySQLDbPersistence); ok {
+		changes := db.persisted.changesTo(ctx, current)
+		if changes.Reset || !changes.IsEmpty() {
+			err = incremental.PersistChanges(ctx, changes)
 		}
-		users = append(users, user)
+	} else {
+		err = db.persister.Persist(ctx, current.serialize())
 	}
-	sort.Slice(users, func(i, j int) bool {
-		if users[i].Host == users[j].Host {
-			return users[i].User < users[j].User
+	if err != nil {
+		if revertErr := db.revertToPersisted(ctx); revertErr != nil {
+			ctx.GetLogger().Warnf("unable to revert grant tables after failing to persist them: %s", revertErr.Error())
 		}
-		return users[i].Host < users[j].Host
-	})
+		return err
+	}
+	db.persisted = current
+	return nil
+}
 
-	// Extract all role entries from table, and sort
-	roleEntries := db.role_edges.data.ToSlice(ctx)
-	roles := make([]*RoleEdge, len(roleEntries))
-	for i, roleEntry := range roleEntries {
-		roles[i] = roleEntry.(*RoleEdge)
-	}
-	sort.Slice(roles, func(i, j int) bool {
-		if roles[i].FromHost == roles[j].FromHost {
-			if roles[i].FromUser == roles[j].FromUser {
-				if roles[i].ToHost == roles[j].ToHost {
-					return roles[i].ToUser < roles[j].ToUser
-				}
-				return roles[i].ToHost < roles[j].ToHost
-			}
-			return roles[i].FromUser < roles[j].FromUser
+// revertToPersisted reverts the grant tables to the data last loaded or persisted, keeping super users, which aren't
+// persisted. The tables are left as they are if nothing has been loaded or persisted yet.
+func (db *MySQLDb) revertToPersisted(ctx *sql.Context) error {
+	if db.persisted == nil {
+		return nil
+	}
+	var superUsers []*User
+	for _, userEntry := range db.user.data.ToSlice(ctx) {
+		if user := userEntry.(*User); user.IsSuperUser {
+			superUsers = append(superUsers, user)
 		}
-		return roles[i].FromHost < roles[j].FromHost
-	})
-
-	// Extract all replica source info entries from table, and sort
-	replicaSourceInfoEntries := db.replica_source_info.data.ToSlice(ctx)
-	replicaSourceInfos := make([]*ReplicaSourceInfo, len(replicaSourceInfoEntries))
-	for i, replicaSourceInfoEntry := range replicaSourceInfoEntries {
-		replicaSourceInfos[i] = replicaSourceInfoEntry.(*ReplicaSourceInfo)
 	}
-	sort.Slice(replicaSourceInfos, func(i, j int) bool {
-		if replicaSourceInfos[i].Host == replicaSourceInfos[j].Host {
-			if replicaSourceInfos[i].Port == replicaSourceInfos[j].Port {
-				return replicaSourceInfos[i].User < replicaSourceInfos[j].User
-			}
-			return replicaSourceInfos[i].Port < replicaSourceInfos[j].Port
+	db.user.data.Clear()
+	db.role_edges.data.Clear()
+	db.replica_source_info.data.Clear()
+	for _, user := range superUsers {
+		if err := db.user.data.Put(ctx, user); err != nil {
+			return err
 		}
-		return replicaSourceInfos[i].Host < replicaSourceInfos[j].Host
-	})
-
-	// TODO: serialize other tables when they exist
-
-	// Create flatbuffer
-	b := flatbuffers.NewBuilder(0)
-	user := serializeUser(b, users)
-	roleEdge := serializeRoleEdge(b, roles)
-	replicaSourceInfo := serializeReplicaSourceInfo(b, replicaSourceInfos)
+	}
+	for _, user := range db.persisted.users {
+		if err := db.user.data.Put(ctx, user.Copy(ctx)); err != nil {
+			return err
+		}
+	}
+	for _, edge := range db.persisted.roleEdges {
+		if err := db.role_edges.data.Put(ctx, edge.Copy(ctx)); err != nil {
+			return err
+		}
+	}
+	for _, info := range db.persisted.replicaSourceInfos {
+		if err := db.replica_source_info.data.Put(ctx, info.Copy(ctx)); err != nil {
+			return err
+		}
+	}
+	db.updateCounter++
+	return nil
+}
 
-	// Write MySQL DB
-	serial.MySQLDbStart(b)
-	serial.MySQLDbAddUser(b, user)
-	serial.MySQLDbAddRoleEdges(b, roleEdge)
-	serial.MySQLDbAddReplicaSourceInfo(b, replicaSourceInfo)
-	mysqlDbOffset := serial.MySQLDbEnd(b)
+// setPersisted records the current data of the grant tables as the data last loaded or persisted.
+func (db *MySQLDb) setPersisted(ctx *sql.Context) {
+	db.persistMu.Lock()
+	defer db.persistMu.Unlock()
+	db.persisted = snapshotPersistedData(ctx, db)
+}
 
-	// Finish writing
-	b.Finish(mysqlDbOffset)
+// Reload reloads the grant tables from the persister, if it's a LoadableMySQLDbPersistence that has persisted data,
+// discarding any changes made directly to the tables that haven't been persisted. Super users aren't persisted, and
+// are kept. Otherwise, the tables are persisted as they are. Either way, the privileges cached by all sessions are
+// refreshed.
+func (db *MySQLDb) Reload(ctx *sql.Context) error {
+	loader, ok := db.persister.(LoadableMySQLDbPersistence)
+	if !ok {
+		return db.Persist(ctx)
+	}
+	buf, err := loader.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if len(buf) == 0 {
+		return db.Persist(ctx)
+	}
 
-	// Persist data
-	return db.persister.Persist(ctx, b.FinishedBytes())
+	var superUsers []*User
+	for _, userEntry := range db.user.data.ToSlice(ctx) {
+		if user := userEntry.(*User); user.IsSuperUser {
+			superUsers = append(superUsers, user)
+		}
+	}
+	db.user.data.Clear()
+	db.role_edges.data.Clear()
+	db.replica_source_info.data.Clear()
+	for _, user := range superUsers {
+		if err = db.user.data.Put(ctx, user); err != nil {
+			return err
+		}
+	}
+	return db.LoadData(ctx, buf)
 }
 
 // UserTable returns the "user" table.
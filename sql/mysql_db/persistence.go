@@ -0,0 +1,236 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql_db
+
+import (
+	"sort"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db/serial"
+)
+
+// IncrementalMySQLDbPersistence is a MySQLDbPersistence that persists only the changes made to the grant tables by
+// each statement, rather than all of their data. When the persister implements it, PersistChanges is called instead
+// of Persist.
+type IncrementalMySQLDbPersistence interface {
+	MySQLDbPersistence
+	// PersistChanges persists the changes given, which must be persisted atomically: if an error is returned, none of
+	// them may have been persisted, and the grant tables are reverted to the data last loaded or persisted.
+	PersistChanges(ctx *sql.Context, changes MySQLDbChanges) error
+}
+
+// MySQLDbChanges are the changes made to the grant tables since their data was last loaded or persisted. Super users
+// are never persisted, and aren't included.
+type MySQLDbChanges struct {
+	// Reset is set when nothing has been loaded or persisted yet, in which case the changes hold all of the data of
+	// the grant tables, which replaces any data persisted previously.
+	Reset bool
+	// Users are the users and roles that were added or changed
+	Users []*User
+	// RemovedUsers are the keys of the users and roles that were removed
+	RemovedUsers []UserPrimaryKey
+	// RoleEdges are the role grants that were added or changed
+	RoleEdges []*RoleEdge
+	// RemovedRoleEdges are the keys of the role grants that were removed
+	RemovedRoleEdges []RoleEdgesPrimaryKey
+	// ReplicaSourceInfos are the replication sources that were added or changed
+	ReplicaSourceInfos []*ReplicaSourceInfo
+	// RemovedReplicaSourceInfos are the keys of the replication sources that were removed
+	RemovedReplicaSourceInfos []ReplicaSourceInfoPrimaryKey
+}
+
+// IsEmpty returns whether there are no changes.
+func (c MySQLDbChanges) IsEmpty() bool {
+	return len(c.Users) == 0 && len(c.RemovedUsers) == 0 &&
+		len(c.RoleEdges) == 0 && len(c.RemovedRoleEdges) == 0 &&
+		len(c.ReplicaSourceInfos) == 0 && len(c.RemovedReplicaSourceInfos) == 0
+}
+
+// persistedData is a copy of the data of the grant tables that's persisted, which is every table but super users.
+type persistedData struct {
+	users              map[UserPrimaryKey]*User
+	roleEdges          map[RoleEdgesPrimaryKey]*RoleEdge
+	replicaSourceInfos map[ReplicaSourceInfoPrimaryKey]*ReplicaSourceInfo
+}
+
+// snapshotPersistedData returns a copy of the data of the grant tables of the database given that's persisted.
+func snapshotPersistedData(ctx *sql.Context, db *MySQLDb) *persistedData {
+	data := &persistedData{
+		users:              make(map[UserPrimaryKey]*User),
+		roleEdges:          make(map[RoleEdgesPrimaryKey]*RoleEdge),
+		replicaSourceInfos: make(map[ReplicaSourceInfoPrimaryKey]*ReplicaSourceInfo),
+	}
+	for _, entry := range db.user.data.ToSlice(ctx) {
+		user := entry.(*User)
+		if user.IsSuperUser {
+			continue
+		}
+		data.users[UserPrimaryKey{Host: user.Host, User: user.User}] = user.Copy(ctx).(*User)
+	}
+	for _, entry := range db.role_edges.data.ToSlice(ctx) {
+		edge := entry.(*RoleEdge)
+		data.roleEdges[roleEdgeKey(edge)] = edge.Copy(ctx).(*RoleEdge)
+	}
+	for _, entry := range db.replica_source_info.data.ToSlice(ctx) {
+		info := entry.(*ReplicaSourceInfo)
+		data.replicaSourceInfos[replicaSourceInfoKey(info)] = info.Copy(ctx).(*ReplicaSourceInfo)
+	}
+	return data
+}
+
+// changesTo returns the changes that turn this data into the data given. A nil receiver is data that was never loaded
+// or persisted, in which case all of the data given is returned, with Reset set.
+func (p *persistedData) changesTo(ctx *sql.Context, current *persistedData) MySQLDbChanges {
+	if p == nil {
+		return MySQLDbChanges{
+			Reset:              true,
+			Users:              current.sortedUsers(),
+			RoleEdges:          current.sortedRoleEdges(),
+			ReplicaSourceInfos: current.sortedReplicaSourceInfos(),
+		}
+	}
+
+	var changes MySQLDbChanges
+	for _, user := range current.sortedUsers() {
+		old, ok := p.users[UserPrimaryKey{Host: user.Host, User: user.User}]
+		if !ok || !old.Equals(ctx, user) || old.IsRole != user.IsRole {
+			changes.Users = append(changes.Users, user)
+		}
+	}
+	for _, user := range p.sortedUsers() {
+		key := UserPrimaryKey{Host: user.Host, User: user.User}
+		if _, ok := current.users[key]; !ok {
+			changes.RemovedUsers = append(changes.RemovedUsers, key)
+		}
+	}
+	for _, edge := range current.sortedRoleEdges() {
+		old, ok := p.roleEdges[roleEdgeKey(edge)]
+		if !ok || !old.Equals(ctx, edge) {
+			changes.RoleEdges = append(changes.RoleEdges, edge)
+		}
+	}
+	for _, edge := range p.sortedRoleEdges() {
+		if _, ok := current.roleEdges[roleEdgeKey(edge)]; !ok {
+			changes.RemovedRoleEdges = append(changes.RemovedRoleEdges, roleEdgeKey(edge))
+		}
+	}
+	for _, info := range current.sortedReplicaSourceInfos() {
+		old, ok := p.replicaSourceInfos[replicaSourceInfoKey(info)]
+		if !ok || !old.Equals(ctx, info) {
+			changes.ReplicaSourceInfos = append(changes.ReplicaSourceInfos, info)
+		}
+	}
+	for _, info := range p.sortedReplicaSourceInfos() {
+		key := replicaSourceInfoKey(info)
+		if _, ok := current.replicaSourceInfos[key]; !ok {
+			changes.RemovedReplicaSourceInfos = append(changes.RemovedReplicaSourceInfos, key)
+		}
+	}
+	return changes
+}
+
+// serialize returns the data serialized as it's passed to MySQLDbPersistence.Persist.
+func (p *persistedData) serialize() []byte {
+	// TODO: serialize other tables when they exist
+
+	// Create flatbuffer
+	b := flatbuffers.NewBuilder(0)
+	user := serializeUser(b, p.sortedUsers())
+	roleEdge := serializeRoleEdge(b, p.sortedRoleEdges())
+	replicaSourceInfo := serializeReplicaSourceInfo(b, p.sortedReplicaSourceInfos())
+
+	// Write MySQL DB
+	serial.MySQLDbStart(b)
+	serial.MySQLDbAddUser(b, user)
+	serial.MySQLDbAddRoleEdges(b, roleEdge)
+	serial.MySQLDbAddReplicaSourceInfo(b, replicaSourceInfo)
+	mysqlDbOffset := serial.MySQLDbEnd(b)
+
+	// Finish writing
+	b.Finish(mysqlDbOffset)
+	return b.FinishedBytes()
+}
+
+// sortedUsers returns the users, sorted by host and user.
+func (p *persistedData) sortedUsers() []*User {
+	users := make([]*User, 0, len(p.users))
+	for _, user := range p.users {
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].Host == users[j].Host {
+			return users[i].User < users[j].User
+		}
+		return users[i].Host < users[j].Host
+	})
+	return users
+}
+
+// sortedRoleEdges returns the role edges, sorted by their from host and user, and then their to host and user.
+func (p *persistedData) sortedRoleEdges() []*RoleEdge {
+	roles := make([]*RoleEdge, 0, len(p.roleEdges))
+	for _, role := range p.roleEdges {
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		if roles[i].FromHost == roles[j].FromHost {
+			if roles[i].FromUser == roles[j].FromUser {
+				if roles[i].ToHost == roles[j].ToHost {
+					return roles[i].ToUser < roles[j].ToUser
+				}
+				return roles[i].ToHost < roles[j].ToHost
+			}
+			return roles[i].FromUser < roles[j].FromUser
+		}
+		return roles[i].FromHost < roles[j].FromHost
+	})
+	return roles
+}
+
+// sortedReplicaSourceInfos returns the replica source infos, sorted by host, port and user.
+func (p *persistedData) sortedReplicaSourceInfos() []*ReplicaSourceInfo {
+	replicaSourceInfos := make([]*ReplicaSourceInfo, 0, len(p.replicaSourceInfos))
+	for _, info := range p.replicaSourceInfos {
+		replicaSourceInfos = append(replicaSourceInfos, info)
+	}
+	sort.Slice(replicaSourceInfos, func(i, j int) bool {
+		if replicaSourceInfos[i].Host == replicaSourceInfos[j].Host {
+			if replicaSourceInfos[i].Port == replicaSourceInfos[j].Port {
+				return replicaSourceInfos[i].User < replicaSourceInfos[j].User
+			}
+			return replicaSourceInfos[i].Port < replicaSourceInfos[j].Port
+		}
+		return replicaSourceInfos[i].Host < replicaSourceInfos[j].Host
+	})
+	return replicaSourceInfos
+}
+
+// roleEdgeKey returns the primary key of the role edge given.
+func roleEdgeKey(edge *RoleEdge) RoleEdgesPrimaryKey {
+	return RoleEdgesPrimaryKey{
+		FromHost: edge.FromHost,
+		FromUser: edge.FromUser,
+		ToHost:   edge.ToHost,
+		ToUser:   edge.ToUser,
+	}
+}
+
+// replicaSourceInfoKey returns the primary key of the replica source info given. Only the default replication channel
+// is supported, so all replica source infos share the same key.
+func replicaSourceInfoKey(info *ReplicaSourceInfo) ReplicaSourceInfoPrimaryKey {
+	return ReplicaSourceInfoPrimaryKey{Channel: ""}
+}
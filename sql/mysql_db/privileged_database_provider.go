@@ -111,10 +111,12 @@ var _ sql.TableDropper = PrivilegedDatabase{}
 var _ sql.TableRenamer = PrivilegedDatabase{}
 var _ sql.TriggerDatabase = PrivilegedDatabase{}
 var _ sql.StoredProcedureDatabase = PrivilegedDatabase{}
+var _ sql.StoredFunctionDatabase = PrivilegedDatabase{}
 var _ sql.TableCopierDatabase = PrivilegedDatabase{}
 var _ sql.ReadOnlyDatabase = PrivilegedDatabase{}
 var _ sql.TemporaryTableDatabase = PrivilegedDatabase{}
 var _ sql.CollatedDatabase = PrivilegedDatabase{}
+var _ sql.EncryptedDatabase = PrivilegedDatabase{}
 
 // NewPrivilegedDatabase returns a new PrivilegedDatabase.
 func NewPrivilegedDatabase(grantTables *MySQLDb, db sql.Database) sql.Database {
@@ -337,6 +339,44 @@ func (pdb PrivilegedDatabase) DropStoredProcedure(ctx *sql.Context, name string)
 	return sql.ErrStoredProceduresNotSupported.New(pdb.db.Name())
 }
 
+// GetStoredFunction implements the interface sql.StoredFunctionDatabase.
+func (pdb PrivilegedDatabase) GetStoredFunction(ctx *sql.Context, name string) (sql.StoredProcedureDetails, bool, error) {
+	if pdb.db.Name() == "information_schema" {
+		return sql.StoredProcedureDetails{}, false, nil
+	}
+	if db, ok := pdb.db.(sql.StoredFunctionDatabase); ok {
+		return db.GetStoredFunction(ctx, name)
+	}
+	return sql.StoredProcedureDetails{}, false, sql.ErrStoredFunctionsNotSupported.New(pdb.db.Name())
+}
+
+// GetStoredFunctions implements the interface sql.StoredFunctionDatabase.
+func (pdb PrivilegedDatabase) GetStoredFunctions(ctx *sql.Context) ([]sql.StoredProcedureDetails, error) {
+	if pdb.db.Name() == "information_schema" {
+		return nil, nil
+	}
+	if db, ok := pdb.db.(sql.StoredFunctionDatabase); ok {
+		return db.GetStoredFunctions(ctx)
+	}
+	return nil, sql.ErrStoredFunctionsNotSupported.New(pdb.db.Name())
+}
+
+// SaveStoredFunction implements the interface sql.StoredFunctionDatabase.
+func (pdb PrivilegedDatabase) SaveStoredFunction(ctx *sql.Context, sfd sql.StoredProcedureDetails) error {
+	if db, ok := pdb.db.(sql.StoredFunctionDatabase); ok {
+		return db.SaveStoredFunction(ctx, sfd)
+	}
+	return sql.ErrStoredFunctionsNotSupported.New(pdb.db.Name())
+}
+
+// DropStoredFunction implements the interface sql.StoredFunctionDatabase.
+func (pdb PrivilegedDatabase) DropStoredFunction(ctx *sql.Context, name string) error {
+	if db, ok := pdb.db.(sql.StoredFunctionDatabase); ok {
+		return db.DropStoredFunction(ctx, name)
+	}
+	return sql.ErrStoredFunctionsNotSupported.New(pdb.db.Name())
+}
+
 // CopyTableData implements the interface sql.TableCopierDatabase.
 func (pdb PrivilegedDatabase) CopyTableData(ctx *sql.Context, sourceTable string, destinationTable string) (uint64, error) {
 	if db, ok := pdb.db.(sql.TableCopierDatabase); ok {
@@ -379,6 +419,22 @@ func (pdb PrivilegedDatabase) SetCollation(ctx *sql.Context, collation sql.Colla
 	return sql.ErrDatabaseCollationsNotSupported.New(pdb.db.Name())
 }
 
+// GetDefaultEncryption implements the interface sql.EncryptedDatabase.
+func (pdb PrivilegedDatabase) GetDefaultEncryption(ctx *sql.Context) bool {
+	if db, ok := pdb.db.(sql.EncryptedDatabase); ok {
+		return db.GetDefaultEncryption(ctx)
+	}
+	return false
+}
+
+// SetDefaultEncryption implements the interface sql.EncryptedDatabase.
+func (pdb PrivilegedDatabase) SetDefaultEncryption(ctx *sql.Context, encrypted bool) error {
+	if db, ok := pdb.db.(sql.EncryptedDatabase); ok {
+		return db.SetDefaultEncryption(ctx, encrypted)
+	}
+	return sql.ErrDatabaseEncryptionNotSupported.New(pdb.db.Name())
+}
+
 // Unwrap returns the wrapped sql.Database.
 func (pdb PrivilegedDatabase) Unwrap() sql.Database {
 	return pdb.db
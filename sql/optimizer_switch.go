@@ -0,0 +1,140 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+)
+
+// OptimizerSwitchVariable is the system variable holding the flags that turn optimizations on and off.
+const OptimizerSwitchVariable = "optimizer_switch"
+
+// optimizerSwitchFlag is a flag of the optimizer_switch system variable.
+type optimizerSwitchFlag struct {
+	name string
+	on   bool
+}
+
+// optimizerSwitchFlags are the flags of optimizer_switch with their default values, in the order MySQL lists them.
+// Flags of optimizations the engine doesn't have are accepted for compatibility, but have no effect.
+var optimizerSwitchFlags = []optimizerSwitchFlag{
+	{"index_merge", true},
+	{"index_merge_union", true},
+	{"index_merge_sort_union", true},
+	{"index_merge_intersection", true},
+	{"engine_condition_pushdown", true},
+	{"index_condition_pushdown", true},
+	{"mrr", true},
+	{"mrr_cost_based", true},
+	{"block_nested_loop", true},
+	{"batched_key_access", false},
+	{"materialization", true},
+	{"semijoin", true},
+	{"loosescan", true},
+	{"firstmatch", true},
+	{"duplicateweedout", true},
+	{"subquery_materialization_cost_based", true},
+	{"use_index_extensions", true},
+	{"condition_fanout_filter", true},
+	{"derived_merge", true},
+	{"use_invisible_indexes", false},
+	{"skip_scan", true},
+	{"hash_join", true},
+	{"subquery_to_derived", false},
+	{"prefer_ordering_index", true},
+	{"derived_condition_pushdown", true},
+}
+
+// OptimizerSwitchDefault is the default value of optimizer_switch, with every flag at its default.
+var OptimizerSwitchDefault = formatOptimizerSwitch(defaultOptimizerSwitch())
+
+func defaultOptimizerSwitch() map[string]bool {
+	flags := make(map[string]bool, len(optimizerSwitchFlags))
+	for _, flag := range optimizerSwitchFlags {
+		flags[flag.name] = flag.on
+	}
+	return flags
+}
+
+func parseOptimizerSwitch(value string) map[string]bool {
+	flags := defaultOptimizerSwitch()
+	for _, setting := range strings.Split(value, ",") {
+		name, state, _ := strings.Cut(strings.TrimSpace(setting), "=")
+		if _, ok := flags[name]; ok {
+			flags[name] = state == "on"
+		}
+	}
+	return flags
+}
+
+func formatOptimizerSwitch(flags map[string]bool) string {
+	settings := make([]string, len(optimizerSwitchFlags))
+	for i, flag := range optimizerSwitchFlags {
+		state := "off"
+		if flags[flag.name] {
+			state = "on"
+		}
+		settings[i] = flag.name + "=" + state
+	}
+	return strings.Join(settings, ",")
+}
+
+// MergeOptimizerSwitch returns the value of optimizer_switch after it's set to the value given, which is a list of
+// flag=on, flag=off or flag=default settings, optionally starting with default to reset the other flags. Like MySQL,
+// flags that aren't in the list keep their current value.
+func MergeOptimizerSwitch(current string, value interface{}) (string, error) {
+	valueStr, ok := value.(string)
+	if !ok {
+		return "", ErrInvalidSystemVariableValue.New(OptimizerSwitchVariable, value)
+	}
+	flags := parseOptimizerSwitch(current)
+	defaults := defaultOptimizerSwitch()
+	for i, setting := range strings.Split(strings.ToLower(valueStr), ",") {
+		setting = strings.TrimSpace(setting)
+		if setting == "default" && i == 0 {
+			flags = defaultOptimizerSwitch()
+			continue
+		}
+		name, state, _ := strings.Cut(setting, "=")
+		name, state = strings.TrimSpace(name), strings.TrimSpace(state)
+		if _, ok := flags[name]; !ok {
+			return "", ErrInvalidSystemVariableValue.New(OptimizerSwitchVariable, valueStr)
+		}
+		switch state {
+		case "on":
+			flags[name] = true
+		case "off":
+			flags[name] = false
+		case "default":
+			flags[name] = defaults[name]
+		default:
+			return "", ErrInvalidSystemVariableValue.New(OptimizerSwitchVariable, valueStr)
+		}
+	}
+	return formatOptimizerSwitch(flags), nil
+}
+
+// OptimizerSwitchOn returns whether the optimizer_switch flag given is on for the session.
+func OptimizerSwitchOn(ctx *Context, flag string) bool {
+	if ctx == nil || ctx.Session == nil {
+		return true
+	}
+	value, err := ctx.GetSessionVariable(ctx, OptimizerSwitchVariable)
+	if err != nil {
+		return true
+	}
+	valueStr, _ := value.(string)
+	return parseOptimizerSwitch(valueStr)[flag]
+}
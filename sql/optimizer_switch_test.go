@@ -0,0 +1,47 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeOptimizerSwitch(t *testing.T) {
+	require := require.New(t)
+
+	value, err := MergeOptimizerSwitch(OptimizerSwitchDefault, "hash_join=off, SEMIJOIN=off")
+	require.NoError(err)
+	require.Equal(strings.NewReplacer("semijoin=on", "semijoin=off", "hash_join=on", "hash_join=off").Replace(OptimizerSwitchDefault), value)
+
+	value, err = MergeOptimizerSwitch(value, "semijoin=default,batched_key_access=on")
+	require.NoError(err)
+	require.Equal(strings.NewReplacer("hash_join=on", "hash_join=off", "batched_key_access=off", "batched_key_access=on").Replace(OptimizerSwitchDefault), value)
+
+	value, err = MergeOptimizerSwitch(value, "default")
+	require.NoError(err)
+	require.Equal(OptimizerSwitchDefault, value)
+
+	value, err = MergeOptimizerSwitch(value, "default,derived_merge=off")
+	require.NoError(err)
+	require.Equal(strings.Replace(OptimizerSwitchDefault, "derived_merge=on", "derived_merge=off", 1), value)
+
+	for _, invalid := range []interface{}{"no_such_flag=on", "hash_join", "hash_join=yes", "hash_join=off,default", int64(1)} {
+		_, err = MergeOptimizerSwitch(OptimizerSwitchDefault, invalid)
+		require.True(ErrInvalidSystemVariableValue.Is(err), "%v", invalid)
+	}
+}
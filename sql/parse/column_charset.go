@@ -0,0 +1,74 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+var charsetKeywordRegex = regexp.MustCompile(`(?is)\bCHARSET\b`)
+
+// rewriteCharsetKeywords rewrites the CHARSET keywords of the column definitions of the CREATE and ALTER statement
+// given as CHARACTER SET, returning the rewritten statement and the edits made to it. CHARSET is a synonym of CHARACTER
+// SET, but the parser only understands it in table options, and not in column definitions. CHARSET isn't reserved, so
+// it's only rewritten where it follows a column type or option and precedes the name of a character set:
+//
+//	a varchar(10) CHARSET ascii NOT NULL
+//	a text NOT NULL CHARSET utf8mb4
+//	a varchar(10) COLLATE utf8mb4_bin CHARSET utf8mb4
+func rewriteCharsetKeywords(query string) (string, queryEdits) {
+	if !charsetKeywordRegex.MatchString(query) {
+		return query, nil
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil || len(tokens) == 0 || (tokens[0].typ != sqlparser.CREATE && tokens[0].typ != sqlparser.ALTER) {
+		return query, nil
+	}
+
+	r := queryRewriter{query: query}
+	for i := 1; i < len(tokens); i++ {
+		if tokens[i].typ != sqlparser.CHARSET || i+1 == len(tokens) || !isCharsetName(tokens[i+1].typ) {
+			continue
+		}
+		if !isColumnTypeEnd(tokens[:i]) {
+			continue
+		}
+		r.replace(tokens[i].start, tokens[i].end, "CHARACTER SET")
+	}
+	return r.rewritten()
+}
+
+// isCharsetName returns whether a token of the type given can be the name of a character set.
+func isCharsetName(typ int) bool {
+	return typ == sqlparser.ID || typ == sqlparser.BINARY
+}
+
+// isColumnTypeEnd returns whether the last of the tokens given can end a column type or one of its options, which a
+// character set can follow.
+func isColumnTypeEnd(tokens []queryToken) bool {
+	switch tokens[len(tokens)-1].typ {
+	case ')', sqlparser.NULL, sqlparser.STRING, sqlparser.CHAR, sqlparser.VARCHAR, sqlparser.NCHAR, sqlparser.NVARCHAR,
+		sqlparser.VARYING, sqlparser.TEXT, sqlparser.TINYTEXT, sqlparser.MEDIUMTEXT, sqlparser.LONGTEXT, sqlparser.LONG:
+		return true
+	case sqlparser.ID:
+		// The name of a collation
+		return len(tokens) > 1 && tokens[len(tokens)-2].typ == sqlparser.COLLATE
+	default:
+		return false
+	}
+}
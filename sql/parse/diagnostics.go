@@ -0,0 +1,141 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+var getDiagnosticsRegex = regexp.MustCompile(`(?is)\bGET\s+((CURRENT|STACKED)\s+)?DIAGNOSTICS\b`)
+
+// diagnosticsFunction is the name of the function that the items of GET DIAGNOSTICS statements are rewritten to call.
+// Its calls are converted to expression.DiagnosticsItem expressions.
+const diagnosticsFunction = "__get_diagnostics"
+
+// rewriteGetDiagnostics rewrites the GET DIAGNOSTICS statements of the statement given, which the parser doesn't
+// understand, returning the rewritten statement and the edits made to it. They may be statements of a stored
+// procedure or trigger. Each one is rewritten to select its items into its targets:
+// GET STACKED DIAGNOSTICS CONDITION 1 @msg = MESSAGE_TEXT becomes
+// SELECT __get_diagnostics('STACKED', 'MESSAGE_TEXT', 1) INTO @msg.
+//
+//	GET [CURRENT | STACKED] DIAGNOSTICS target = {NUMBER | ROW_COUNT} [, target = ...]
+//	GET [CURRENT | STACKED] DIAGNOSTICS CONDITION condition_number target = condition_item [, target = ...]
+func rewriteGetDiagnostics(query string) (string, queryEdits, error) {
+	if !getDiagnosticsRegex.MatchString(query) {
+		return query, nil, nil
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return query, nil, nil
+	}
+	// text returns the text of the token at index i as written, including any quotes
+	text := func(i int) string {
+		return query[tokens[i].start:tokens[i].end]
+	}
+
+	r := queryRewriter{query: query}
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].word() != "GET" {
+			continue
+		}
+		j := i + 1
+		area := "CURRENT"
+		if j < len(tokens) && (tokens[j].word() == "CURRENT" || tokens[j].word() == "STACKED") {
+			area = tokens[j].word()
+			j++
+		}
+		if j >= len(tokens) || tokens[j].word() != "DIAGNOSTICS" {
+			continue
+		}
+		j++
+
+		items := expression.DiagnosticsStatementItems
+		condition := ""
+		if j < len(tokens) && tokens[j].word() == "CONDITION" {
+			if j+1 >= len(tokens) {
+				return "", nil, sql.ErrSyntaxError.New("expected condition number in GET DIAGNOSTICS")
+			}
+			items = expression.DiagnosticsConditionItems
+			condition = ", " + text(j+1)
+			j += 2
+		}
+
+		var exprs, targets []string
+		for {
+			if j+2 >= len(tokens) || tokens[j+1].typ != '=' {
+				return "", nil, sql.ErrSyntaxError.New("expected target = item in GET DIAGNOSTICS")
+			}
+			item := tokens[j+2].word()
+			if !containsItem(items, item) {
+				return "", nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected item %s in GET DIAGNOSTICS", text(j+2)))
+			}
+			targets = append(targets, text(j))
+			exprs = append(exprs, fmt.Sprintf("%s('%s', '%s'%s)", diagnosticsFunction, area, item, condition))
+			j += 3
+			if j >= len(tokens) || tokens[j].typ != ',' {
+				break
+			}
+			j++
+		}
+		if j < len(tokens) && tokens[j].typ != ';' {
+			return "", nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected %s in GET DIAGNOSTICS", text(j)))
+		}
+
+		r.replace(tokens[i].start, tokens[j-1].end, fmt.Sprintf("SELECT %s INTO %s", strings.Join(exprs, ", "), strings.Join(targets, ", ")))
+		i = j
+	}
+	rewritten, edits := r.rewritten()
+	return rewritten, edits, nil
+}
+
+// containsItem returns whether the items given contain the item given.
+func containsItem(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// convertDiagnosticsItem converts the arguments of a call to diagnosticsFunction, which a GET DIAGNOSTICS statement was
+// rewritten to, to the item it reads.
+func convertDiagnosticsItem(args []sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, sql.ErrSyntaxError.New("invalid GET DIAGNOSTICS item")
+	}
+	var names [2]string
+	for i := range names {
+		lit, ok := args[i].(*expression.Literal)
+		if !ok {
+			return nil, sql.ErrSyntaxError.New("invalid GET DIAGNOSTICS item")
+		}
+		names[i], ok = lit.Value().(string)
+		if !ok {
+			return nil, sql.ErrSyntaxError.New("invalid GET DIAGNOSTICS item")
+		}
+	}
+	var condition sql.Expression
+	if len(args) == 3 {
+		condition = args[2]
+	}
+	return expression.NewDiagnosticsItem(names[0] == "STACKED", condition, names[1]), nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var flushTablesRegex = regexp.MustCompile(`(?is)^\s*FLUSH\s+(?:(?:NO_WRITE_TO_BINLOG|LOCAL)\s+)?TABLES?(?:[\s;]|$)`)
+
+// parseFlushTables parses a FLUSH TABLES statement, which the parser doesn't understand. It returns a nil node if the
+// statement given isn't a FLUSH TABLES statement.
+//
+//	FLUSH [NO_WRITE_TO_BINLOG | LOCAL] {TABLE | TABLES} [tbl_name [, tbl_name] ...]
+//
+// The WITH READ LOCK and FOR EXPORT clauses aren't supported.
+func parseFlushTables(query string) (sql.Node, error) {
+	if !flushTablesRegex.MatchString(query) {
+		return nil, nil
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("%s in FLUSH TABLES", err))
+	}
+	tokens, ok := trimStatementEnd(tokens)
+	if !ok {
+		return nil, sql.ErrSyntaxError.New("unexpected statement after FLUSH TABLES")
+	}
+	// The regex guarantees the statement starts with FLUSH, an optional flush type, and TABLE or TABLES
+	writesToBinlog := true
+	if tokens = tokens[1:]; !strings.HasPrefix(tokens[0].word(), "TABLE") {
+		writesToBinlog = false
+		tokens = tokens[1:]
+	}
+	tokens = tokens[1:]
+
+	var tables []sql.DbTable
+	if len(tokens) > 0 && tokens[0].typ != sqlparser.WITH && tokens[0].typ != sqlparser.FOR {
+		if tables, tokens, err = parseTableNames(query, tokens, "FLUSH TABLES"); err != nil {
+			return nil, err
+		}
+	}
+	if len(tokens) > 0 {
+		if tokens[0].typ == sqlparser.WITH || tokens[0].typ == sqlparser.FOR {
+			return nil, sql.ErrUnsupportedFeature.New(fmt.Sprintf("FLUSH TABLES %s", tokens[0].word()))
+		}
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected %q in FLUSH TABLES", query[tokens[0].start:tokens[0].end]))
+	}
+	return plan.NewFlush(sql.FlushTables, tables, writesToBinlog), nil
+}
+
+// parseTableNames parses the comma-separated list of table names, optionally qualified by the names of their
+// databases, at the start of the tokens of the statement given. It returns the tables along with the tokens following
+// the list. |stmt| names the statement in errors.
+func parseTableNames(query string, tokens []queryToken, stmt string) ([]sql.DbTable, []queryToken, error) {
+	// name returns the identifier of the token at index |i|, or false if it isn't one
+	name := func(i int) (string, bool) {
+		if i >= len(tokens) || tokens[i].val == "" || tokens[i].typ == sqlparser.STRING {
+			return "", false
+		}
+		return tokens[i].val, true
+	}
+
+	var tables []sql.DbTable
+	for i := 0; ; i++ {
+		table, ok := name(i)
+		if !ok {
+			if i < len(tokens) {
+				return nil, nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected %q in %s", query[tokens[i].start:tokens[i].end], stmt))
+			}
+			return nil, nil, sql.ErrSyntaxError.New(fmt.Sprintf("expected table name in %s", stmt))
+		}
+		db := ""
+		if i+1 < len(tokens) && tokens[i+1].typ == '.' {
+			db = table
+			if table, ok = name(i + 2); !ok {
+				return nil, nil, sql.ErrSyntaxError.New(fmt.Sprintf("expected table name in %s", stmt))
+			}
+			i += 2
+		}
+		tables = append(tables, sql.DbTable{Db: db, Table: table})
+		if i+1 == len(tokens) || tokens[i+1].typ != ',' {
+			return tables, tokens[i+1:], nil
+		}
+		i++
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+var functionalKeyPartRegex = regexp.MustCompile(`(?is)\b(?:index|key|unique)\b.*[(,]\s*\(`)
+
+// functionalKeyPartPrefix is the prefix of the names that replace the functional key parts of a statement before it's
+// parsed, which are followed by the position of the key part in the statement.
+const functionalKeyPartPrefix = "!functional!"
+
+// rewriteFunctionalKeyParts replaces the functional key parts of the indexes defined by the CREATE or ALTER statement
+// given with quoted names, returning the rewritten statement, the edits made to it, and the expressions of the key
+// parts replaced, in order. The parser only understands columns as key parts, so
+//
+//	CREATE INDEX idx ON t ((lower(name)), (a + b) DESC)
+//
+// is parsed as
+//
+//	CREATE INDEX idx ON t (`!functional!0`, `!functional!1` DESC)
+//
+// and the expressions are applied to the resulting node by applyFunctionalKeyParts.
+func rewriteFunctionalKeyParts(query string) (string, queryEdits, []string) {
+	if !functionalKeyPartRegex.MatchString(query) {
+		return query, nil, nil
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil || len(tokens) == 0 || (tokens[0].typ != sqlparser.CREATE && tokens[0].typ != sqlparser.ALTER) {
+		return query, nil, nil
+	}
+
+	r := queryRewriter{query: query}
+	var exprs []string
+	for i := 0; i < len(tokens); i++ {
+		start := keyPartListStart(tokens, i)
+		if start == -1 {
+			continue
+		}
+		// Each key part of the list either starts with a parenthesis, and is an expression, or is a column
+		i = start + 1
+		for i < len(tokens) {
+			if tokens[i].typ == '(' {
+				end := closingParen(tokens, i)
+				if end == -1 {
+					return query, nil, nil
+				}
+				// A prefix length isn't allowed after an expression, so the key part is left for the parser to reject
+				if end+1 < len(tokens) && tokens[end+1].typ != '(' {
+					name := functionalKeyPartPrefix + strconv.Itoa(len(exprs))
+					exprs = append(exprs, query[tokens[i].end:tokens[end].start])
+					r.replace(tokens[i].start, tokens[end].end, sql.QuoteIdentifier(name))
+				}
+				i = end + 1
+			}
+			// Skip to the next key part, or the end of the list
+			depth := 0
+			for ; i < len(tokens); i++ {
+				if tokens[i].typ == '(' {
+					depth++
+				} else if tokens[i].typ == ')' && depth > 0 {
+					depth--
+				} else if depth == 0 && (tokens[i].typ == ',' || tokens[i].typ == ')') {
+					break
+				}
+			}
+			if i == len(tokens) || tokens[i].typ == ')' {
+				break
+			}
+			i++
+		}
+	}
+	query, edits := r.rewritten()
+	return query, edits, exprs
+}
+
+// keyPartListStart returns the index of the opening parenthesis of the key part list of the index defined by the tokens
+// starting at index |i|, if they start the definition of an index, or -1 otherwise. The definition of an index starts
+// with INDEX or KEY, or UNIQUE if neither follows, and is followed by the optional name of the index, the optional
+// USING clause, and the table of the index in CREATE INDEX statements.
+func keyPartListStart(tokens []queryToken, i int) int {
+	switch tokens[i].word() {
+	case "INDEX", "KEY":
+	case "UNIQUE":
+		if i+1 < len(tokens) && (tokens[i+1].word() == "INDEX" || tokens[i+1].word() == "KEY") {
+			return -1
+		}
+	default:
+		return -1
+	}
+	i++
+	if i < len(tokens) && tokens[i].typ != '(' && tokens[i].word() != "USING" && tokens[i].word() != "ON" {
+		i++
+	}
+	if i+1 < len(tokens) && tokens[i].word() == "USING" {
+		i += 2
+	}
+	if i+1 < len(tokens) && tokens[i].word() == "ON" {
+		i += 2
+		if i+1 < len(tokens) && tokens[i].typ == '.' {
+			i += 2
+		}
+	}
+	if i < len(tokens) && tokens[i].typ == '(' {
+		return i
+	}
+	return -1
+}
+
+// closingParen returns the index of the parenthesis closing the one at index |i|, or -1 if it's not closed.
+func closingParen(tokens []queryToken, i int) int {
+	depth := 0
+	for ; i < len(tokens); i++ {
+		switch tokens[i].typ {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// applyFunctionalKeyParts applies the expressions of the functional key parts replaced by rewriteFunctionalKeyParts to
+// the indexes defined by the node given. The key parts and the indexes that aren't named are named by the analyzer,
+// which knows the names of the other indexes of their tables.
+func applyFunctionalKeyParts(ctx *sql.Context, node sql.Node, exprs []string) (sql.Node, error) {
+	// apply returns the index columns given with the expressions of their functional key parts
+	apply := func(columns []sql.IndexColumn) ([]sql.IndexColumn, error) {
+		columns = append([]sql.IndexColumn(nil), columns...)
+		for i, col := range columns {
+			if !strings.HasPrefix(col.Name, functionalKeyPartPrefix) {
+				continue
+			}
+			n, err := strconv.Atoi(strings.TrimPrefix(col.Name, functionalKeyPartPrefix))
+			if err != nil || n >= len(exprs) {
+				return nil, fmt.Errorf("unexpected functional key part %s", col.Name)
+			}
+			expr, err := StringToColumnDefaultValue(ctx, exprs[n])
+			if err != nil {
+				return nil, err
+			}
+			columns[i].Name = ""
+			columns[i].Expression = expr.Expression
+		}
+		return columns, nil
+	}
+
+	var err error
+	transform.Inspect(node, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.CreateTable:
+			for _, idxDef := range n.TableSpec().IdxDefs {
+				idxDef.Columns, err = apply(idxDef.Columns)
+				if err != nil {
+					return false
+				}
+			}
+		case *plan.AlterIndex:
+			n.Columns, err = apply(n.Columns)
+			if err != nil {
+				return false
+			}
+		case *plan.AlterPK:
+			for _, col := range n.Columns {
+				if strings.HasPrefix(col.Name, functionalKeyPartPrefix) {
+					err = sql.ErrFunctionalIndexPrimaryKey.New()
+					return false
+				}
+			}
+		}
+		return true
+	})
+	return node, err
+}
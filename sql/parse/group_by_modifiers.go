@@ -0,0 +1,104 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var groupByModifierRegex = regexp.MustCompile(`(?is)\bWITH\s+(ROLLUP|CUBE)\b`)
+
+// groupByModifierMarkers are the names of the functions that the WITH ROLLUP and WITH CUBE modifiers of GROUP BY
+// clauses are rewritten to, as their last grouping expression, since the parser doesn't understand them.
+var groupByModifierMarkers = map[string]plan.GroupingModifier{
+	"__with_rollup__": plan.GroupingModifierRollup,
+	"__with_cube__":   plan.GroupingModifierCube,
+}
+
+// rewriteGroupByModifiers rewrites the WITH ROLLUP and WITH CUBE modifiers of the GROUP BY clauses of the statement
+// given as calls to their marker functions, appended to the grouping expressions. It returns the rewritten statement
+// and the edits made to it.
+func rewriteGroupByModifiers(query string) (string, queryEdits) {
+	if !groupByModifierRegex.MatchString(query) {
+		return query, nil
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return query, nil
+	}
+
+	r := queryRewriter{query: query}
+	// inGroupBy tracks, for each level of parentheses, whether the tokens are in a GROUP BY clause
+	inGroupBy := []bool{false}
+	for i := 0; i < len(tokens); i++ {
+		depth := len(inGroupBy) - 1
+		switch tokens[i].typ {
+		case '(':
+			inGroupBy = append(inGroupBy, false)
+		case ')':
+			if depth > 0 {
+				inGroupBy = inGroupBy[:depth]
+			}
+		case sqlparser.GROUP:
+			if i+1 < len(tokens) && tokens[i+1].typ == sqlparser.BY {
+				inGroupBy[depth] = true
+			}
+		case sqlparser.SELECT, sqlparser.UNION, sqlparser.HAVING, sqlparser.ORDER, sqlparser.LIMIT, sqlparser.WINDOW:
+			inGroupBy[depth] = false
+		case sqlparser.WITH:
+			if !inGroupBy[depth] || i+1 >= len(tokens) {
+				continue
+			}
+			var marker string
+			next := tokens[i+1]
+			switch {
+			case next.typ == sqlparser.ID && strings.EqualFold(next.val, "rollup"):
+				marker = "__with_rollup__"
+			case next.typ == sqlparser.CUBE:
+				marker = "__with_cube__"
+			default:
+				continue
+			}
+
+			r.replace(tokens[i].start, next.end, ", "+marker+"()")
+			inGroupBy[depth] = false
+			i++
+		}
+	}
+	return r.rewritten()
+}
+
+// groupByModifier returns the grouping expressions given without the marker function of a GROUP BY modifier, and the
+// modifier it marks.
+func groupByModifier(g sqlparser.GroupBy) (sqlparser.GroupBy, plan.GroupingModifier) {
+	if len(g) == 0 {
+		return g, plan.GroupingModifierNone
+	}
+	fn, ok := g[len(g)-1].(*sqlparser.FuncExpr)
+	if !ok || len(fn.Exprs) > 0 || !fn.Qualifier.IsEmpty() {
+		return g, plan.GroupingModifierNone
+	}
+	modifier, ok := groupByModifierMarkers[fn.Name.Lowered()]
+	if !ok {
+		return g, plan.GroupingModifierNone
+	}
+	return g[:len(g)-1], modifier
+}
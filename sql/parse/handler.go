@@ -0,0 +1,225 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var handlerRegex = regexp.MustCompile(`(?is)^\s*HANDLER\s`)
+
+// parseHandler parses a HANDLER statement, which the parser doesn't understand. It returns a nil node if the
+// statement given isn't a HANDLER statement. The WHERE clause of HANDLER ... READ isn't supported.
+//
+//	HANDLER [db_name.]tbl_name OPEN [[AS] alias]
+//	HANDLER tbl_name READ index_name {= | <= | >= | < | >} (value1, value2, ...) [LIMIT [offset,] row_count]
+//	HANDLER tbl_name READ index_name {FIRST | NEXT | PREV | LAST} [LIMIT [offset,] row_count]
+//	HANDLER tbl_name READ {FIRST | NEXT} [LIMIT [offset,] row_count]
+//	HANDLER tbl_name CLOSE
+func parseHandler(ctx *sql.Context, query string) (sql.Node, error) {
+	if !handlerRegex.MatchString(query) {
+		return nil, nil
+	}
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("%s in HANDLER statement", err))
+	}
+	tokens, ok := trimStatementEnd(tokens)
+	if !ok {
+		return nil, sql.ErrSyntaxError.New("unexpected statement after HANDLER statement")
+	}
+	// The first token is the HANDLER keyword
+	tokens = tokens[1:]
+
+	var db, name string
+	switch {
+	case len(tokens) >= 3 && tokens[0].val != "" && tokens[1].typ == '.' && tokens[2].val != "":
+		db, name = tokens[0].val, tokens[2].val
+		tokens = tokens[3:]
+	case len(tokens) >= 1 && tokens[0].val != "":
+		name = tokens[0].val
+		tokens = tokens[1:]
+	default:
+		return nil, sql.ErrSyntaxError.New("expected table name in HANDLER statement")
+	}
+	if len(tokens) == 0 {
+		return nil, sql.ErrSyntaxError.New("expected OPEN, READ or CLOSE in HANDLER statement")
+	}
+
+	action, tokens := tokens[0].word(), tokens[1:]
+	if action == "OPEN" {
+		if len(tokens) > 0 && tokens[0].word() == "AS" && tokens[0].typ != sqlparser.ID {
+			if tokens = tokens[1:]; len(tokens) == 0 {
+				return nil, sql.ErrSyntaxError.New("expected alias after HANDLER ... OPEN AS")
+			}
+		}
+		switch {
+		case len(tokens) == 0:
+			return plan.NewHandlerOpen(db, name, ""), nil
+		case len(tokens) == 1 && tokens[0].val != "":
+			return plan.NewHandlerOpen(db, name, tokens[0].val), nil
+		default:
+			return nil, sql.ErrSyntaxError.New("unexpected tokens after HANDLER ... OPEN alias")
+		}
+	}
+
+	if db != "" {
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected database name in HANDLER ... %s", action))
+	}
+	switch action {
+	case "CLOSE":
+		if len(tokens) > 0 {
+			return nil, sql.ErrSyntaxError.New("unexpected tokens after HANDLER ... CLOSE")
+		}
+		return plan.NewHandlerClose(name), nil
+	case "READ":
+		return parseHandlerRead(ctx, query, name, tokens)
+	default:
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("expected OPEN, READ or CLOSE in HANDLER statement, found %s", action))
+	}
+}
+
+// parseHandlerRead parses the tokens of a HANDLER ... READ statement of the query given after the READ keyword.
+func parseHandlerRead(ctx *sql.Context, query string, name string, tokens []queryToken) (sql.Node, error) {
+	if len(tokens) == 0 {
+		return nil, sql.ErrSyntaxError.New("expected index name, FIRST or NEXT in HANDLER ... READ")
+	}
+
+	modes := map[string]plan.HandlerReadMode{
+		"FIRST": plan.HandlerReadFirst,
+		"NEXT":  plan.HandlerReadNext,
+		"PREV":  plan.HandlerReadPrev,
+		"LAST":  plan.HandlerReadLast,
+	}
+	var index string
+	if mode, ok := modes[tokens[0].word()]; ok && tokens[0].typ != sqlparser.ID {
+		if mode != plan.HandlerReadFirst && mode != plan.HandlerReadNext {
+			return nil, sql.ErrSyntaxError.New(fmt.Sprintf("HANDLER ... READ %s requires an index name", mode))
+		}
+	} else {
+		index, tokens = tokens[0].val, tokens[1:]
+		if len(tokens) == 0 {
+			return nil, sql.ErrSyntaxError.New("expected FIRST, NEXT, PREV, LAST or a comparison in HANDLER ... READ")
+		}
+	}
+
+	var node *plan.HandlerRead
+	if mode, ok := modes[tokens[0].word()]; ok {
+		node = plan.NewHandlerRead(name, index, mode)
+		tokens = tokens[1:]
+	} else {
+		var op string
+		switch tokens[0].typ {
+		case '=', '<', '>':
+			op = string(rune(tokens[0].typ))
+		case sqlparser.LE:
+			op = "<="
+		case sqlparser.GE:
+			op = ">="
+		default:
+			return nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected %q in HANDLER ... READ", query[tokens[0].start:tokens[0].end]))
+		}
+		key, rest, err := parseHandlerKey(ctx, query, tokens[1:])
+		if err != nil {
+			return nil, err
+		}
+		node = plan.NewHandlerRead(name, index, plan.HandlerReadKey)
+		node.KeyOp = op
+		node.Key = key
+		tokens = rest
+	}
+
+	if len(tokens) > 0 && tokens[0].word() == "WHERE" {
+		return nil, sql.ErrUnsupportedFeature.New("WHERE clause of HANDLER ... READ")
+	}
+	if len(tokens) > 0 && tokens[0].word() == "LIMIT" {
+		var numbers []int64
+		for i, t := range tokens[1:] {
+			if i%2 == 1 {
+				if t.typ != ',' || len(numbers) > 1 {
+					return nil, sql.ErrSyntaxError.New("invalid LIMIT clause in HANDLER ... READ")
+				}
+				continue
+			}
+			n, err := strconv.ParseInt(t.val, 10, 64)
+			if t.typ != sqlparser.INTEGRAL || err != nil {
+				return nil, sql.ErrSyntaxError.New("invalid LIMIT clause in HANDLER ... READ")
+			}
+			numbers = append(numbers, n)
+		}
+		switch len(numbers) {
+		case 1:
+			node.Limit = numbers[0]
+		case 2:
+			node.Offset, node.Limit = numbers[0], numbers[1]
+		default:
+			return nil, sql.ErrSyntaxError.New("invalid LIMIT clause in HANDLER ... READ")
+		}
+		if len(tokens) != 2*len(numbers) {
+			return nil, sql.ErrSyntaxError.New("invalid LIMIT clause in HANDLER ... READ")
+		}
+		tokens = nil
+	}
+	if len(tokens) > 0 {
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected %q in HANDLER ... READ", query[tokens[0].start:tokens[0].end]))
+	}
+	return node, nil
+}
+
+// parseHandlerKey parses the parenthesized list of key values at the start of the tokens given, returning the values
+// and the remaining tokens. The values are parsed as the select expressions of a SELECT statement.
+func parseHandlerKey(ctx *sql.Context, query string, tokens []queryToken) ([]sql.Expression, []queryToken, error) {
+	if len(tokens) == 0 || tokens[0].typ != '(' {
+		return nil, nil, sql.ErrSyntaxError.New("expected key values in parentheses in HANDLER ... READ")
+	}
+	depth := 0
+	for i, t := range tokens {
+		switch t.typ {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth > 0 {
+			continue
+		}
+
+		parsed, err := Parse(ctx, "SELECT "+query[tokens[0].end:t.start])
+		if err != nil {
+			return nil, nil, err
+		}
+		project, ok := parsed.(*plan.Project)
+		if !ok {
+			return nil, nil, sql.ErrSyntaxError.New("invalid key values in HANDLER ... READ")
+		}
+		key := make([]sql.Expression, len(project.Projections))
+		for j, e := range project.Projections {
+			if alias, ok := e.(*expression.Alias); ok {
+				e = alias.Child
+			}
+			key[j] = e
+		}
+		return key, tokens[i+1:], nil
+	}
+	return nil, nil, sql.ErrSyntaxError.New("unclosed parenthesis in HANDLER ... READ")
+}
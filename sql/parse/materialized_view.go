@@ -0,0 +1,128 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// materializedViewNamePattern matches the name of a materialized view, optionally qualified by its database name.
+const materializedViewNamePattern = "(?:(`(?:[^`]|``)+`|\\w+)\\s*\\.\\s*)?(`(?:[^`]|``)+`|\\w+)"
+
+var (
+	materializedViewRegex = regexp.MustCompile(`(?is)^\s*(?:CREATE|REFRESH|DROP)\s+MATERIALIZED\s+VIEW\s`)
+
+	createMaterializedViewRegex = regexp.MustCompile(`(?is)^\s*CREATE\s+MATERIALIZED\s+VIEW\s+(IF\s+NOT\s+EXISTS\s+)?` +
+		materializedViewNamePattern +
+		`\s+(?:REFRESH\s+EVERY\s+(\S+)\s+(\w+)\s+)?(?:MAX\s+STALENESS\s+(\S+)\s+(\w+)\s+)?AS\s+(.+)$`)
+
+	refreshMaterializedViewRegex = regexp.MustCompile(`(?is)^\s*REFRESH\s+MATERIALIZED\s+VIEW\s+` +
+		materializedViewNamePattern + `\s*$`)
+
+	dropMaterializedViewRegex = regexp.MustCompile(`(?is)^\s*DROP\s+MATERIALIZED\s+VIEW\s+(IF\s+EXISTS\s+)?` +
+		materializedViewNamePattern + `\s*$`)
+)
+
+// parseMaterializedView parses the statements of materialized views, which aren't part of MySQL's grammar. It returns
+// a nil node if the statement given isn't one of them.
+//
+//	CREATE MATERIALIZED VIEW [IF NOT EXISTS] [db_name.]view_name
+//	    [REFRESH EVERY interval] [MAX STALENESS interval] AS select_statement
+//	REFRESH MATERIALIZED VIEW [db_name.]view_name
+//	DROP MATERIALIZED VIEW [IF EXISTS] [db_name.]view_name
+//
+//	interval: number {SECOND | MINUTE | HOUR | DAY | WEEK}
+func parseMaterializedView(ctx *sql.Context, query string) (sql.Node, error) {
+	if !materializedViewRegex.MatchString(query) {
+		return nil, nil
+	}
+
+	if match := refreshMaterializedViewRegex.FindStringSubmatch(query); match != nil {
+		return plan.NewRefreshMaterializedView(materializedViewDatabase(match[1]), unquoteIdentifier(match[2])), nil
+	}
+	if match := dropMaterializedViewRegex.FindStringSubmatch(query); match != nil {
+		return plan.NewDropMaterializedView(materializedViewDatabase(match[2]), unquoteIdentifier(match[3]), match[1] != ""), nil
+	}
+	match := createMaterializedViewRegex.FindStringSubmatch(query)
+	if match == nil {
+		return nil, sql.ErrSyntaxError.New("invalid materialized view statement")
+	}
+
+	name := unquoteIdentifier(match[3])
+	refreshInterval, err := parseMaterializedViewInterval(match[4], match[5])
+	if err != nil {
+		return nil, err
+	}
+	maxStaleness, err := parseMaterializedViewInterval(match[6], match[7])
+	if err != nil {
+		return nil, err
+	}
+
+	definition := strings.TrimSpace(match[8])
+	stmt, err := sqlparser.Parse(definition)
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+	if _, ok := stmt.(sqlparser.SelectStatement); !ok {
+		return nil, sql.ErrMaterializedViewNotQuery.New(name)
+	}
+	source, err := convert(ctx, stmt, definition)
+	if err != nil {
+		return nil, err
+	}
+	return plan.NewCreateMaterializedView(materializedViewDatabase(match[2]), name, match[1] != "", definition, source,
+		refreshInterval, maxStaleness), nil
+}
+
+// materializedViewDatabase returns the database with the name given, which is the current database if it's empty.
+func materializedViewDatabase(name string) sql.Database {
+	return sql.UnresolvedDatabase(unquoteIdentifier(name))
+}
+
+// parseMaterializedViewInterval returns the duration of the interval with the number and unit given, or zero if
+// they're empty.
+func parseMaterializedViewInterval(number, unit string) (time.Duration, error) {
+	if number == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseUint(number, 10, 32)
+	if err != nil || n == 0 {
+		return 0, sql.ErrInvalidRefreshInterval.New(number + " " + unit)
+	}
+	var d time.Duration
+	switch strings.ToUpper(unit) {
+	case "SECOND":
+		d = time.Second
+	case "MINUTE":
+		d = time.Minute
+	case "HOUR":
+		d = time.Hour
+	case "DAY":
+		d = 24 * time.Hour
+	case "WEEK":
+		d = 7 * 24 * time.Hour
+	default:
+		return 0, sql.ErrInvalidRefreshInterval.New(number + " " + unit)
+	}
+	return time.Duration(n) * d, nil
+}
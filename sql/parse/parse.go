@@ -18,6 +18,7 @@ import (
 	"encoding/hex"
 	goerrors "errors"
 	"fmt"
+	"math/big"
 	"regexp"
 	"strconv"
 	"strings"
@@ -51,9 +52,31 @@ var (
 	tableCharsetOptionRegex = regexp.MustCompile(`(?i)(DEFAULT)?\s+CHARACTER\s+SET((\s*=?\s*)|\s+)([A-Za-z0-9_]+)`)
 
 	tableCollationOptionRegex = regexp.MustCompile(`(?i)(DEFAULT)?\s+COLLATE((\s*=?\s*)|\s+)([A-Za-z0-9_]+)`)
+
+	alterViewRegex = regexp.MustCompile(`(?is)^\s*(ALTER)\s+((ALGORITHM\s*=\s*\w+|DEFINER\s*=\s*\S+|SQL\s+SECURITY\s+\w+)\s+)*VIEW\s`)
+
+	renameDatabaseRegex = regexp.MustCompile("(?is)^\\s*RENAME\\s+(?:DATABASE|SCHEMA)\\s+(`(?:[^`]|``)+`|\\w+)\\s+TO\\s+(`(?:[^`]|``)+`|\\w+)\\s*$")
+
+	explainJSONFormatRegex = regexp.MustCompile(`(?is)^\s*(?:EXPLAIN|DESCRIBE|DESC)\s+FORMAT\s*=\s*(JSON)\s`)
+
+	dropTemporaryTableRegex = regexp.MustCompile(`(?is)\bDROP\s+TEMPORARY\s+TABLE\b`)
+
+	viewCheckOptionRegex = regexp.MustCompile(`(?is)\bVIEW\b.*\bCHECK\s+OPTION\b`)
+
+	valuesRowRegex = regexp.MustCompile(`(?is)\bVALUES\s+ROW\s*\(`)
+
+	createKeywordRegex = regexp.MustCompile(`(?is)^\s*CREATE\s+`)
+
+	// syntaxErrorPositionRegex matches the messages of the parser's syntax errors, which report the position in the
+	// parsed statement where the error was found, and the token it was found after.
+	syntaxErrorPositionRegex = regexp.MustCompile(`(?s)^(.*) at position (\d+)( near '.*')?$`)
 )
 
-var describeSupportedFormats = []string{"tree"}
+func init() {
+	sql.RegisterErrorCode(mysql.ERPrimaryCantHaveNull, mysql.SSClientError, ErrPrimaryKeyOnNullField)
+}
+
+var describeSupportedFormats = []string{"traditional", "tree", "json"}
 
 // These constants aren't exported from vitess for some reason. This could be removed if we changed this.
 const (
@@ -90,13 +113,131 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 	var parsed string
 	var remainder string
 
+	// The parser doesn't understand RENAME DATABASE, which isn't part of MySQL's grammar, but is supported for database
+	// providers that can rename databases.
+	if match := renameDatabaseRegex.FindStringSubmatch(s); match != nil {
+		return plan.NewRenameDatabase(unquoteIdentifier(match[1]), unquoteIdentifier(match[2])), s, "", nil
+	}
+
+	// The parser doesn't understand XA statements either
+	if node, err := parseXA(s); node != nil || err != nil {
+		return node, s, "", err
+	}
+
+	// Nor does it understand FLUSH TABLES
+	if node, err := parseFlushTables(s); node != nil || err != nil {
+		return node, s, "", err
+	}
+
+	// Nor does it understand CHECKSUM TABLE, CHECK TABLE and OPTIMIZE TABLE
+	if node, err := parseTableMaintenance(s); node != nil || err != nil {
+		return node, s, "", err
+	}
+
+	// Nor does it understand HANDLER statements
+	if node, err := parseHandler(ctx, s); node != nil || err != nil {
+		return node, s, "", err
+	}
+
+	// Nor does it understand SHOW ENGINE ... STATUS
+	if node, err := parseShowEngineStatus(s); node != nil || err != nil {
+		return node, s, "", err
+	}
+
+	// Nor does it understand SHOW SLAVE STATUS
+	if node, err := parseShowSlaveStatus(s); node != nil || err != nil {
+		return node, s, "", err
+	}
+
+	// Nor does it understand SHOW PROFILES and SHOW PROFILE
+	if node, err := parseShowProfile(s); node != nil || err != nil {
+		return node, s, "", err
+	}
+
+	// Nor does it understand SHOW INDEX BUILDS, which is an extension of the engine
+	if node, err := parseShowIndexBuilds(s); node != nil || err != nil {
+		return node, s, "", err
+	}
+
+	// Nor does it understand materialized views, which are an extension of the engine
+	if node, err := parseMaterializedView(ctx, s); node != nil || err != nil {
+		return node, s, "", err
+	}
+
+	// The parser doesn't understand the WITH CHECK OPTION clause of view definitions, so it's blanked out of the
+	// statement before parsing and applied to the resulting node afterward.
+	toParse, checkOpt, checkOptPos := stripViewCheckOption(s)
+	// The parser doesn't understand ALTER VIEW either, so it's parsed as CREATE VIEW. Positions in the parsed statement
+	// are mapped back to the statement before it was rewritten, as they are for the rewrites below.
+	var rewrites queryRewrites
+	toParse, edits := rewriteAlterView(toParse)
+	rewrites = append(rewrites, edits)
+	isAlterView := len(edits) > 0
+	// Nor does it understand DROP TEMPORARY TABLE, which is parsed as DROP TABLE.
+	toParse, edits = rewriteDropTemporaryTable(toParse)
+	rewrites = append(rewrites, edits)
+	isDropTemporary := len(edits) > 0
+	// The parser only accepts an identifier as the format of an EXPLAIN statement, but JSON is a keyword, so it's
+	// quoted.
+	toParse, edits = quoteExplainJSONFormat(toParse)
+	rewrites = append(rewrites, edits)
+	// The parser only understands table value constructors as derived tables. The others are rewritten.
+	toParse, edits = rewriteValuesStatements(toParse)
+	rewrites = append(rewrites, edits)
+	// Nor does it understand SELECT modifiers in any order but its own, or SQL_SMALL_RESULT and SQL_BIG_RESULT.
+	toParse, edits = rewriteSelectModifiers(toParse)
+	rewrites = append(rewrites, edits)
+	// Nor does it understand the scheduling modifiers of INSERT, REPLACE, UPDATE and DELETE statements, which have no
+	// effect, or the IGNORE modifier of DELETE statements.
+	toParse, edits, modifiers := rewriteStatementModifiers(toParse)
+	modifierRewrites := rewrites
+	rewrites = append(rewrites, edits)
+	// Nor does it understand CHARSET as a synonym of CHARACTER SET in column definitions.
+	toParse, edits = rewriteCharsetKeywords(toParse)
+	rewrites = append(rewrites, edits)
+	// Nor does it understand the WITH ROLLUP and WITH CUBE modifiers of GROUP BY clauses, which are rewritten as calls
+	// to marker functions appended to the grouping expressions.
+	toParse, edits = rewriteGroupByModifiers(toParse)
+	rewrites = append(rewrites, edits)
+	// Nor does it understand the clauses of system-versioned tables, which are removed before parsing. The period of
+	// a system-versioned table created by the statement is applied to the resulting node afterward.
+	toParse, edits, systemTimePeriod, err := rewriteSystemVersioning(toParse)
+	if err != nil {
+		return nil, s, "", err
+	}
+	rewrites = append(rewrites, edits)
+	// Nor does it understand GET DIAGNOSTICS, which is rewritten to select the items of the diagnostics area into the
+	// statement's targets.
+	toParse, edits, err = rewriteGetDiagnostics(toParse)
+	if err != nil {
+		return nil, s, "", err
+	}
+	rewrites = append(rewrites, edits)
+	// Nor does it understand functional key parts, which are replaced by quoted names. Their expressions are applied to
+	// the resulting node afterward.
+	toParse, edits, keyPartExprs := rewriteFunctionalKeyParts(toParse)
+	rewrites = append(rewrites, edits)
+	// Nor does it understand stored functions. Statements about them are rewritten to be about stored procedures, and
+	// the resulting nodes are converted back afterward.
+	toParse, edits, function, err := rewriteStoredFunction(toParse)
+	if err != nil {
+		return nil, s, "", err
+	}
+	rewrites = append(rewrites, edits)
+
+	// originalPosition returns the position in the statement given that corresponds to a position in the parsed one
+	originalPosition := func(pos int) int {
+		return rewrites.originalPosition(pos)
+	}
+
 	parsed = s
 	if !multi {
-		stmt, err = sqlparser.Parse(s)
+		stmt, err = sqlparser.Parse(toParse)
 	} else {
 		var ri int
-		stmt, ri, err = sqlparser.ParseOne(s)
-		if ri != 0 && ri < len(s) {
+		stmt, ri, err = sqlparser.ParseOne(toParse)
+		ri = originalPosition(ri)
+		if ri > 0 && ri < len(s) {
 			parsed = s[:ri]
 			parsed = strings.TrimSpace(parsed)
 			if strings.HasSuffix(parsed, ";") {
@@ -111,14 +252,259 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 			ctx.Warn(0, "query was empty after trimming comments, so it will be ignored")
 			return plan.Nothing, parsed, remainder, nil
 		}
-		return nil, parsed, remainder, sql.ErrSyntaxError.New(err.Error())
+		return nil, parsed, remainder, syntaxError(err, s, originalPosition)
+	}
+
+	// The modifiers removed from the parsed statement are noted. Those of the statements following it are noted when
+	// they're parsed.
+	for _, modifier := range modifiers {
+		if remainder == "" || modifierRewrites.originalPosition(modifier.start) < len(s)-len(remainder) {
+			ctx.AddWarning(&sql.Warning{
+				Level:   "Note",
+				Code:    mysql.ERNotSupportedYet,
+				Message: fmt.Sprintf("the %s modifier has no effect and was ignored", strings.ToUpper(modifier.val)),
+			})
+		}
+	}
+
+	if ddl, ok := stmt.(*sqlparser.DDL); ok && rewrites.changed() {
+		ddl.SubStatementPositionStart = originalPosition(ddl.SubStatementPositionStart)
+		ddl.SubStatementPositionEnd = originalPosition(ddl.SubStatementPositionEnd)
+	}
+	// The definition of a view ends where its check option clause begins
+	if ddl, ok := stmt.(*sqlparser.DDL); ok && checkOpt != "" && ddl.SubStatementPositionEnd > checkOptPos {
+		ddl.SubStatementPositionEnd = checkOptPos
 	}
 
 	node, err := convert(ctx, stmt, s)
+	if function != nil && err == nil {
+		node, err = function.convert(ctx, node)
+	}
+	if len(keyPartExprs) > 0 && err == nil {
+		node, err = applyFunctionalKeyParts(ctx, node, keyPartExprs)
+	}
+	if systemTimePeriod != nil && err == nil {
+		ct, ok := node.(*plan.CreateTable)
+		if !ok {
+			return nil, parsed, remainder, sql.ErrInvalidSystemVersioning.New("only CREATE TABLE statements can define system-versioned tables")
+		}
+		node, err = ct.WithSystemTimePeriod(*systemTimePeriod)
+	}
+	if dt, ok := node.(*plan.DropTable); ok && err == nil && isDropTemporary {
+		node = dt.WithTemporary(plan.IsTempTable)
+	}
+	if cv, ok := node.(*plan.CreateView); ok && err == nil {
+		cv.CheckOpt = checkOpt
+		if isAlterView {
+			cv.IsAlter = true
+			cv.IsReplace = true
+		}
+	}
 
 	return node, parsed, remainder, err
 }
 
+// unquoteIdentifier removes the backticks quoting the identifier given, if any.
+func unquoteIdentifier(identifier string) string {
+	if len(identifier) >= 2 && identifier[0] == '`' && identifier[len(identifier)-1] == '`' {
+		return strings.ReplaceAll(identifier[1:len(identifier)-1], "``", "`")
+	}
+	return identifier
+}
+
+// rewriteAlterView rewrites an ALTER VIEW statement as the equivalent CREATE VIEW statement, returning the rewritten
+// statement and the edits made to it. Other statements are returned unchanged.
+func rewriteAlterView(query string) (string, queryEdits) {
+	r := queryRewriter{query: query}
+	if match := alterViewRegex.FindStringSubmatchIndex(query); match != nil {
+		r.replace(match[2], match[3], "CREATE")
+	}
+	return r.rewritten()
+}
+
+// quoteExplainJSONFormat quotes the JSON format of an EXPLAIN FORMAT=JSON statement, returning the rewritten statement
+// and the edits made to it. Other statements are returned unchanged.
+func quoteExplainJSONFormat(query string) (string, queryEdits) {
+	r := queryRewriter{query: query}
+	if match := explainJSONFormatRegex.FindStringSubmatchIndex(query); match != nil {
+		r.replace(match[2], match[2], "`")
+		r.replace(match[3], match[3], "`")
+	}
+	return r.rewritten()
+}
+
+// rewriteDropTemporaryTable removes the TEMPORARY keyword from a DROP TEMPORARY TABLE statement, returning the
+// rewritten statement and the edits made to it. Other statements are returned unchanged.
+func rewriteDropTemporaryTable(query string) (string, queryEdits) {
+	if !dropTemporaryTableRegex.MatchString(query) {
+		return query, nil
+	}
+	tokens, err := tokenizeQuery(query)
+	if err != nil || len(tokens) < 3 {
+		return query, nil
+	}
+	if tokens[0].typ != sqlparser.DROP || tokens[1].typ != sqlparser.TEMPORARY || tokens[2].typ != sqlparser.TABLE {
+		return query, nil
+	}
+	r := queryRewriter{query: query}
+	r.replace(tokens[1].start, tokens[1].end, "")
+	return r.rewritten()
+}
+
+// stripViewCheckOption removes the WITH [CASCADED | LOCAL] CHECK OPTION clause ending the view definition given,
+// returning the remaining statement, the check option it specified (CASCADED if neither was given) and the position
+// where the view's definition ends before it. The clause is replaced with spaces, so that positions in the statement
+// are unchanged, and comments following it are kept. Statements without the clause are returned unchanged along with
+// an empty check option.
+func stripViewCheckOption(query string) (string, string, int) {
+	if !viewCheckOptionRegex.MatchString(query) {
+		return query, "", 0
+	}
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return query, "", 0
+	}
+	tokens, _ = trimStatementEnd(tokens)
+	if len(tokens) < 4 || (tokens[0].typ != sqlparser.CREATE && tokens[0].typ != sqlparser.ALTER) {
+		return query, "", 0
+	}
+	isView := false
+	for _, t := range tokens {
+		if t.word() == "VIEW" {
+			isView = true
+		}
+		if t.typ == sqlparser.AS {
+			break
+		}
+	}
+	n := len(tokens)
+	if !isView || tokens[n-2].word() != "CHECK" || tokens[n-1].word() != "OPTION" {
+		return query, "", 0
+	}
+
+	with, checkOpt := n-3, plan.ViewCheckOptionCascaded
+	if word := tokens[with].word(); word == "CASCADED" || word == "LOCAL" {
+		with, checkOpt = with-1, word
+	}
+	if tokens[with].word() != "WITH" {
+		return query, "", 0
+	}
+	start, end := tokens[with].start, tokens[n-1].end
+	return query[:start] + strings.Repeat(" ", end-start) + query[end:], checkOpt, tokens[with-1].end
+}
+
+// valuesAlias is the name of the derived table that a VALUES statement is rewritten to select from.
+const valuesAlias = "`values`"
+
+// syntaxError returns an ErrSyntaxError for the error given, returned by the parser for the statement given. The
+// position of syntax errors in the parsed statement is mapped to the statement given with the function given, and
+// reported as a line and column of the statement given, since statements may span several lines.
+func syntaxError(err error, query string, originalPosition func(int) int) error {
+	match := syntaxErrorPositionRegex.FindStringSubmatch(err.Error())
+	if match == nil {
+		return sql.ErrSyntaxError.New(err.Error())
+	}
+	pos, err := strconv.Atoi(match[2])
+	if err != nil {
+		return sql.ErrSyntaxError.New(match[0])
+	}
+
+	// The parser's positions are one past the offset of the character the error was found at
+	pos = originalPosition(pos) - 1
+	if pos < 0 {
+		pos = 0
+	} else if pos > len(query) {
+		pos = len(query)
+	}
+	line := strings.Count(query[:pos], "\n") + 1
+	column := pos - strings.LastIndex(query[:pos], "\n")
+	return sql.ErrSyntaxError.New(fmt.Sprintf("%s at line %d, column %d%s", match[1], line, column, match[3]))
+}
+
+// rewriteValuesStatements rewrites the table value constructors of the statement given that the parser doesn't
+// understand, returning the rewritten statement and the edits made to it:
+//   - A VALUES statement, such as a standalone statement, an operand of a UNION, or a subquery, is rewritten to select
+//     all the columns of the equivalent derived table: VALUES ROW(1), ROW(2) becomes
+//     SELECT * FROM (VALUES ROW(1), ROW(2)) AS `values`. Its columns are named column_0, column_1, and so on.
+//   - The ROW keywords of the rows of an INSERT statement's VALUES clause are removed.
+//
+// Table value constructors that are already derived tables, because they're followed by an alias, aren't changed.
+func rewriteValuesStatements(query string) (string, queryEdits) {
+	if !valuesRowRegex.MatchString(query) {
+		return query, nil
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return query, nil
+	}
+
+	r := queryRewriter{query: query}
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].typ != sqlparser.VALUES {
+			continue
+		}
+		rows, end := valuesRows(tokens, i+1)
+		if len(rows) == 0 {
+			continue
+		}
+
+		prev := 0
+		if i > 0 {
+			prev = tokens[i-1].typ
+		}
+		switch prev {
+		case 0, ';', '(', sqlparser.UNION, sqlparser.ALL, sqlparser.DISTINCT, sqlparser.EXCEPT, sqlparser.AS:
+			// A derived table is followed by the closing parenthesis of its subquery and its alias
+			if prev == '(' && end+1 < len(tokens) && tokens[end].typ == ')' &&
+				(tokens[end+1].typ == sqlparser.AS || tokens[end+1].typ == sqlparser.ID) {
+				break
+			}
+			r.replace(tokens[i].start, tokens[i].start, "SELECT * FROM (")
+			r.replace(tokens[end-1].end, tokens[end-1].end, ") AS "+valuesAlias)
+		default:
+			for _, row := range rows {
+				r.replace(tokens[row].start, tokens[row].end, "")
+			}
+		}
+		i = end - 1
+	}
+	return r.rewritten()
+}
+
+// valuesRows returns the indexes of the ROW keywords of the rows of a table value constructor that starts with the
+// token at index |start|, and the index of the token after its last row. No rows are returned if it isn't a list of
+// rows.
+func valuesRows(tokens []queryToken, start int) ([]int, int) {
+	var rows []int
+	i := start
+	for {
+		if i+1 >= len(tokens) || tokens[i].typ != sqlparser.ROW || tokens[i+1].typ != '(' {
+			return nil, start
+		}
+		rows = append(rows, i)
+		depth := 0
+		for i++; i < len(tokens); i++ {
+			if tokens[i].typ == '(' {
+				depth++
+			} else if tokens[i].typ == ')' {
+				depth--
+				if depth == 0 {
+					break
+				}
+			}
+		}
+		if i == len(tokens) {
+			return nil, start
+		}
+		i++
+		if i >= len(tokens) || tokens[i].typ != ',' {
+			return rows, i
+		}
+		i++
+	}
+}
+
 // ParseColumnTypeString will return a SQL type for the given string that represents a column type.
 // For example, giving the string `VARCHAR(255)` will return the string SQL type with the internal type set to Varchar
 // and the length set to 255 with the default collation.
@@ -240,6 +626,8 @@ func convert(ctx *sql.Context, stmt sqlparser.Statement, query string) (sql.Node
 		return convertLeave(ctx, n)
 	case *sqlparser.Iterate:
 		return convertIterate(ctx, n)
+	case *sqlparser.Return:
+		return convertReturn(ctx, n)
 	case *sqlparser.Kill:
 		return convertKill(ctx, n)
 	case *sqlparser.Signal:
@@ -422,12 +810,16 @@ func convertExplain(ctx *sql.Context, n *sqlparser.Explain) (sql.Node, error) {
 		return nil, err
 	}
 
-	explainFmt := sqlparser.TreeStr
+	explainFmt := plan.DescribeFormatTraditional
 	switch strings.ToLower(n.ExplainFormat) {
-	case "", sqlparser.TreeStr:
-	// tree format, do nothing
+	case "", sqlparser.TraditionalStr:
+	// traditional format, do nothing
+	case sqlparser.TreeStr:
+		explainFmt = sqlparser.TreeStr
 	case "debug":
 		explainFmt = "debug"
+	case plan.DescribeFormatJSON:
+		explainFmt = plan.DescribeFormatJSON
 	default:
 		return nil, errInvalidDescribeFormat.New(
 			n.ExplainFormat,
@@ -439,35 +831,24 @@ func convertExplain(ctx *sql.Context, n *sqlparser.Explain) (sql.Node, error) {
 }
 
 func convertPrepare(ctx *sql.Context, n *sqlparser.Prepare) (sql.Node, error) {
-	expr := n.Expr
+	// The text of statements prepared from user variables is only known once the PREPARE statement is run, which
+	// may be after statements setting the variable in a stored procedure
 	if strings.HasPrefix(n.Expr, "@") {
 		varName := strings.ToLower(strings.Trim(n.Expr, "@"))
-		_, val, err := ctx.GetUserVariable(ctx, varName)
-		if err != nil {
-			return nil, err
-		}
-		strVal, err := types.LongText.Convert(val)
-		if err != nil {
-			return nil, err
-		}
-		if strVal == nil {
-			expr = "NULL"
-		} else {
-			expr = strVal.(string)
-		}
+		return plan.NewPrepareQuery(n.Name, expression.NewUserVar(varName), nil), nil
 	}
 
-	childStmt, err := sqlparser.Parse(expr)
+	childStmt, err := sqlparser.Parse(n.Expr)
 	if err != nil {
-		return nil, err
+		return nil, syntaxError(err, n.Expr, func(pos int) int { return pos })
 	}
 
-	child, err := convert(ctx, childStmt, expr)
+	child, err := convert(ctx, childStmt, n.Expr)
 	if err != nil {
 		return nil, err
 	}
 
-	return plan.NewPrepareQuery(n.Name, child), nil
+	return plan.NewPrepareQuery(n.Name, expression.NewLiteral(n.Expr, types.LongText), child), nil
 }
 
 func convertExecute(ctx *sql.Context, n *sqlparser.Execute) (sql.Node, error) {
@@ -881,7 +1262,7 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 		}
 		var node sql.Node
 		var err error
-		node = plan.ShowWarnings(ctx.Session.Warnings())
+		node = plan.ShowWarnings(ctx.Warnings())
 		if s.Limit != nil {
 			if s.Limit.Offset != nil {
 				node, err = offsetToOffset(ctx, s.Limit.Offset, node)
@@ -1062,9 +1443,18 @@ func convertSelect(ctx *sql.Context, s *sqlparser.Select) (sql.Node, error) {
 		return nil, err
 	}
 
+	var comment string
+	if len(s.Comments) > 0 {
+		comment = string(s.Comments[0])
+	}
+	// SELECT STRAIGHT_JOIN and the STRAIGHT_JOIN operator keep the tables in the order they're written in
+	if s.Hints == sqlparser.StraightJoinHint || hasStraightJoin(s.From) {
+		comment = withJoinFixedOrderHint(comment)
+	}
+
 	// If the top level node can store comments and one was provided, store it.
-	if cn, ok := node.(sql.CommentedNode); ok && len(s.Comments) > 0 {
-		node = cn.WithComment(string(s.Comments[0]))
+	if cn, ok := node.(sql.CommentedNode); ok && comment != "" {
+		node = cn.WithComment(comment)
 	}
 
 	if s.Where != nil {
@@ -1263,29 +1653,13 @@ func convertMultiAlterDDL(ctx *sql.Context, query string, c *sqlparser.MultiAlte
 func convertDBDDL(ctx *sql.Context, c *sqlparser.DBDDL) (sql.Node, error) {
 	switch strings.ToLower(c.Action) {
 	case sqlparser.CreateStr:
-		var charsetStr *string
-		var collationStr *string
-		for _, cc := range c.CharsetCollate {
-			ccType := strings.ToLower(cc.Type)
-			if ccType == "character set" {
-				val := cc.Value
-				charsetStr = &val
-			} else if ccType == "collate" {
-				val := cc.Value
-				collationStr = &val
-			} else {
-				ctx.Session.Warn(&sql.Warning{
-					Level:   "Warning",
-					Code:    mysql.ERNotSupportedYet,
-					Message: fmt.Sprintf("Setting CHARACTER SET, COLLATION and ENCRYPTION are not supported yet"),
-				})
-			}
-		}
-		collation, err := sql.ParseCollation(charsetStr, collationStr, false)
+		collation, encryption, err := convertDatabaseOptions(c.CharsetCollate)
 		if err != nil {
 			return nil, err
 		}
-		return plan.NewCreateDatabase(c.DBName, c.IfNotExists, collation), nil
+		createDb := plan.NewCreateDatabase(c.DBName, c.IfNotExists, collation)
+		createDb.Encryption = encryption
+		return createDb, nil
 	case sqlparser.DropStr:
 		return plan.NewDropDatabase(c.DBName, c.IfExists), nil
 	case sqlparser.AlterStr:
@@ -1297,28 +1671,52 @@ func convertDBDDL(ctx *sql.Context, c *sqlparser.DBDDL) (sql.Node, error) {
 			}
 		}
 
-		var charsetStr *string
-		var collationStr *string
-		for _, cc := range c.CharsetCollate {
-			ccType := strings.ToLower(cc.Type)
-			if ccType == "character set" {
-				val := cc.Value
-				charsetStr = &val
-			} else if ccType == "collate" {
-				val := cc.Value
-				collationStr = &val
-			}
-		}
-		collation, err := sql.ParseCollation(charsetStr, collationStr, false)
+		collation, encryption, err := convertDatabaseOptions(c.CharsetCollate)
 		if err != nil {
 			return nil, err
 		}
-		return plan.NewAlterDatabase(c.DBName, collation), nil
+		alterDb := plan.NewAlterDatabase(c.DBName, collation)
+		alterDb.Encryption = encryption
+		return alterDb, nil
 	default:
 		return nil, sql.ErrUnsupportedSyntax.New(sqlparser.String(c))
 	}
 }
 
+// convertDatabaseOptions returns the collation and encryption given by the options of a CREATE or ALTER DATABASE
+// statement. The collation is sql.Collation_Unspecified and the encryption is nil when they are not given.
+func convertDatabaseOptions(options []*sqlparser.CharsetAndCollate) (sql.CollationID, *bool, error) {
+	var charsetStr *string
+	var collationStr *string
+	var encryption *bool
+	for _, cc := range options {
+		switch strings.ToLower(cc.Type) {
+		case "character set", "charset":
+			val := cc.Value
+			charsetStr = &val
+		case "collate":
+			val := cc.Value
+			collationStr = &val
+		case "encryption":
+			var encrypted bool
+			switch strings.ToLower(cc.Value) {
+			case "y":
+				encrypted = true
+			case "n":
+				encrypted = false
+			default:
+				return sql.Collation_Unspecified, nil, sql.ErrInvalidEncryptionOption.New()
+			}
+			encryption = &encrypted
+		}
+	}
+	collation, err := sql.ParseCollation(charsetStr, collationStr, false)
+	if err != nil {
+		return sql.Collation_Unspecified, nil, err
+	}
+	return collation, encryption, nil
+}
+
 func convertCreateTrigger(ctx *sql.Context, query string, c *sqlparser.DDL) (sql.Node, error) {
 	var triggerOrder *plan.TriggerOrder
 	if c.TriggerSpec.Order != nil {
@@ -1340,6 +1738,10 @@ func convertCreateTrigger(ctx *sql.Context, query string, c *sqlparser.DDL) (sql
 		return nil, err
 	}
 	definer := getCurrentUserForDefiner(ctx, c.TriggerSpec.Definer)
+	createStr := query
+	if c.TriggerSpec.Definer == "" {
+		createStr = withDefinerClause(query, definer)
+	}
 
 	return plan.NewCreateTrigger(
 		sql.UnresolvedDatabase(c.TriggerSpec.TrigName.Qualifier.String()),
@@ -1349,7 +1751,7 @@ func convertCreateTrigger(ctx *sql.Context, query string, c *sqlparser.DDL) (sql
 		triggerOrder,
 		tableNameToUnresolvedTable(c.Table),
 		body,
-		query,
+		createStr,
 		bodyStr,
 		ctx.QueryTime(),
 		definer,
@@ -1417,11 +1819,16 @@ func convertCreateProcedure(ctx *sql.Context, query string, c *sqlparser.DDL) (s
 	if err != nil {
 		return nil, err
 	}
+	definer := getCurrentUserForDefiner(ctx, c.ProcedureSpec.Definer)
+	createStr := query
+	if c.ProcedureSpec.Definer == "" {
+		createStr = withDefinerClause(query, definer)
+	}
 
 	return plan.NewCreateProcedure(
 		sql.UnresolvedDatabase(c.ProcedureSpec.ProcName.Qualifier.String()),
 		c.ProcedureSpec.ProcName.Name.String(),
-		c.ProcedureSpec.Definer,
+		definer,
 		params,
 		time.Now(),
 		time.Now(),
@@ -1429,7 +1836,7 @@ func convertCreateProcedure(ctx *sql.Context, query string, c *sqlparser.DDL) (s
 		characteristics,
 		body,
 		comment,
-		query,
+		createStr,
 		bodyStr,
 	), nil
 }
@@ -1602,6 +2009,14 @@ func convertIterate(ctx *sql.Context, iterate *sqlparser.Iterate) (sql.Node, err
 	return plan.NewIterate(iterate.Label), nil
 }
 
+func convertReturn(ctx *sql.Context, r *sqlparser.Return) (sql.Node, error) {
+	expr, err := ExprToExpression(ctx, r.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return plan.NewReturn(expr), nil
+}
+
 func convertSignal(ctx *sql.Context, s *sqlparser.Signal) (sql.Node, error) {
 	// https://dev.mysql.com/doc/refman/8.0/en/signal.html#signal-condition-information-items
 	var err error
@@ -1898,8 +2313,9 @@ func gatherIndexColumns(cols []*sqlparser.IndexColumn) ([]sql.IndexColumn, error
 			}
 		}
 		out[i] = sql.IndexColumn{
-			Name:   col.Column.String(),
-			Length: length,
+			Name:       col.Column.String(),
+			Length:     length,
+			Descending: strings.EqualFold(col.Order, sqlparser.DescScr),
 		}
 	}
 	return out, nil
@@ -2225,8 +2641,10 @@ func convertCreateView(ctx *sql.Context, query string, c *sqlparser.DDL) (sql.No
 	queryAlias := plan.NewSubqueryAlias(c.ViewSpec.ViewName.Name.String(), selectStr, queryNode)
 	definer := getCurrentUserForDefiner(ctx, c.ViewSpec.Definer)
 
-	return plan.NewCreateView(
-		sql.UnresolvedDatabase(""), c.ViewSpec.ViewName.Name.String(), []string{}, queryAlias, c.OrReplace, query, c.ViewSpec.Algorithm, definer, c.ViewSpec.Security), nil
+	cv := plan.NewCreateView(
+		sql.UnresolvedDatabase(""), c.ViewSpec.ViewName.Name.String(), []string{}, queryAlias, c.OrReplace, query, c.ViewSpec.Algorithm, definer, c.ViewSpec.Security)
+	cv.ExplicitDefiner = c.ViewSpec.Definer != ""
+	return cv, nil
 }
 
 func convertDropView(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
@@ -2317,7 +2735,13 @@ func convertDelete(ctx *sql.Context, d *sqlparser.Delete) (sql.Node, error) {
 		}
 	}
 
-	node = plan.NewDeleteFrom(node, targets)
+	del := plan.NewDeleteFrom(node, targets)
+	for _, comment := range d.Comments {
+		if string(comment) == deleteIgnoreComment {
+			del.Ignore = true
+		}
+	}
+	node = del
 
 	if d.With != nil {
 		node, err = ctesToWith(ctx, d.With, node)
@@ -2530,6 +2954,27 @@ func columnDefinitionToColumn(ctx *sql.Context, cd *sqlparser.ColumnDefinition,
 		extra = "auto_increment"
 	}
 
+	var generated *sql.ColumnDefaultValue
+	virtual := false
+	if cd.Type.GeneratedExpr != nil {
+		if defaultVal != nil {
+			return nil, sql.ErrGeneratedColumnWithDefault.New()
+		}
+		if cd.Type.Autoincrement {
+			return nil, sql.ErrGeneratedColumnAutoIncrement.New()
+		}
+		generated, err = convertDefaultExpression(ctx, &sqlparser.ParenExpr{Expr: cd.Type.GeneratedExpr})
+		if err != nil {
+			return nil, err
+		}
+		virtual = !bool(cd.Type.Stored)
+		if virtual {
+			extra = "VIRTUAL GENERATED"
+		} else {
+			extra = "STORED GENERATED"
+		}
+	}
+
 	if cd.Type.SRID != nil {
 		sridVal, sErr := strconv.ParseInt(string(cd.Type.SRID.Val), 10, 32)
 		if sErr != nil {
@@ -2554,6 +2999,8 @@ func columnDefinitionToColumn(ctx *sql.Context, cd *sqlparser.ColumnDefinition,
 		AutoIncrement: bool(cd.Type.Autoincrement),
 		Comment:       comment,
 		Extra:         extra,
+		Generated:     generated,
+		Virtual:       virtual,
 	}, nil
 }
 
@@ -2915,13 +3362,16 @@ func convertFlush(ctx *sql.Context, f *sqlparser.Flush) (sql.Node, error) {
 	var writesToBinlog = true
 	switch strings.ToLower(f.Type) {
 	case "no_write_to_binlog", "local":
-		//writesToBinlog = false
-		return nil, fmt.Errorf("%s not supported", f.Type)
+		writesToBinlog = false
 	}
 
-	switch strings.ToLower(f.Option.Name) {
-	case "privileges":
+	switch option := sql.FlushOption(strings.ToUpper(f.Option.Name)); option {
+	case "PRIVILEGES":
 		return plan.NewFlushPrivileges(writesToBinlog), nil
+	case sql.FlushStatus, sql.FlushLogs, sql.FlushBinaryLogs, sql.FlushEngineLogs, sql.FlushErrorLogs,
+		sql.FlushGeneralLogs, sql.FlushRelayLogs, sql.FlushSlowLogs, sql.FlushHosts, sql.FlushOptimizerCosts,
+		sql.FlushUserResources:
+		return plan.NewFlush(option, nil, writesToBinlog), nil
 	default:
 		return nil, fmt.Errorf("%s not supported", f.Option.Name)
 	}
@@ -3092,12 +3542,6 @@ func tableExprToTable(
 }
 
 func joinTableExpr(ctx *sql.Context, t *sqlparser.JoinTableExpr) (sql.Node, error) {
-	// TODO: add support for using, once we have proper table
-	// qualification of fields
-	if len(t.Condition.Using) > 0 {
-		return nil, sql.ErrUnsupportedFeature.New("USING clause on join")
-	}
-
 	left, err := tableExprToTable(ctx, t.LeftExpr)
 	if err != nil {
 		return nil, err
@@ -3108,8 +3552,32 @@ func joinTableExpr(ctx *sql.Context, t *sqlparser.JoinTableExpr) (sql.Node, erro
 		return nil, err
 	}
 
-	if t.Join == sqlparser.NaturalJoinStr {
+	switch strings.ToLower(t.Join) {
+	case sqlparser.NaturalJoinStr:
 		return plan.NewNaturalJoin(left, right), nil
+	case sqlparser.NaturalLeftJoinStr:
+		return plan.NewJoin(left, right, plan.JoinTypeLeftOuterNatural, nil), nil
+	case sqlparser.NaturalRightJoinStr:
+		return plan.NewJoin(left, right, plan.JoinTypeRightOuterNatural, nil), nil
+	}
+
+	if len(t.Condition.Using) > 0 {
+		var op plan.JoinType
+		switch strings.ToLower(t.Join) {
+		case sqlparser.JoinStr:
+			op = plan.JoinTypeInner
+		case sqlparser.LeftJoinStr:
+			op = plan.JoinTypeLeftOuter
+		case sqlparser.RightJoinStr:
+			op = plan.JoinTypeRightOuter
+		default:
+			return nil, sql.ErrUnsupportedFeature.New("USING clause on " + t.Join)
+		}
+		cols := make([]string, len(t.Condition.Using))
+		for i, col := range t.Condition.Using {
+			cols[i] = col.String()
+		}
+		return plan.NewUsingJoin(left, right, op, cols), nil
 	}
 
 	if t.Condition.On == nil {
@@ -3122,7 +3590,7 @@ func joinTableExpr(ctx *sql.Context, t *sqlparser.JoinTableExpr) (sql.Node, erro
 	}
 
 	switch strings.ToLower(t.Join) {
-	case sqlparser.JoinStr:
+	case sqlparser.JoinStr, sqlparser.StraightJoinStr:
 		return plan.NewInnerJoin(left, right, cond), nil
 	case sqlparser.LeftJoinStr:
 		return plan.NewLeftOuterJoin(left, right, cond), nil
@@ -3135,6 +3603,34 @@ func joinTableExpr(ctx *sql.Context, t *sqlparser.JoinTableExpr) (sql.Node, erro
 	}
 }
 
+// hasStraightJoin returns whether the table expressions of a FROM clause join tables with the STRAIGHT_JOIN operator.
+// The FROM clauses of derived tables aren't included.
+func hasStraightJoin(exprs sqlparser.TableExprs) bool {
+	for _, e := range exprs {
+		switch e := e.(type) {
+		case *sqlparser.JoinTableExpr:
+			if strings.ToLower(e.Join) == sqlparser.StraightJoinStr ||
+				hasStraightJoin(sqlparser.TableExprs{e.LeftExpr, e.RightExpr}) {
+				return true
+			}
+		case *sqlparser.ParenTableExpr:
+			if hasStraightJoin(e.Exprs) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// withJoinFixedOrderHint adds the JOIN_FIXED_ORDER optimizer hint to the comment of a SELECT statement, which makes
+// the join planner keep the tables of its FROM clause in the order they're written in.
+func withJoinFixedOrderHint(comment string) string {
+	if strings.HasPrefix(comment, "/*+") {
+		return "/*+ JOIN_FIXED_ORDER" + comment[len("/*+"):]
+	}
+	return "/*+ JOIN_FIXED_ORDER */"
+}
+
 func jsonTableExpr(ctx *sql.Context, t *sqlparser.JSONTableExpr) (sql.Node, error) {
 	data, err := ExprToExpression(ctx, t.Data)
 	if err != nil {
@@ -3223,6 +3719,12 @@ func havingToHaving(ctx *sql.Context, having *sqlparser.Where, node sql.Node) (s
 		return nil, err
 	}
 
+	// Aggregations in the HAVING clause of a query without any make its rows a single group, like those in its select
+	// list do
+	if project, ok := node.(*plan.Project); ok && isAggregateExpr(cond) {
+		node = plan.NewGroupBy(project.Projections, nil, project.Child)
+	}
+
 	return plan.NewHaving(cond, node), nil
 }
 
@@ -3330,6 +3832,7 @@ func selectToSelectionNode(
 		}
 	}
 
+	g, modifier := groupByModifier(g)
 	if isWindow {
 		if len(g) > 0 {
 			return nil, sql.ErrUnsupportedFeature.New("group by with window functions")
@@ -3360,8 +3863,9 @@ func selectToSelectionNode(
 				if i64, err := types.Int64.Convert(l.Value()); err == nil {
 					if idx, ok := i64.(int64); ok && idx > 0 && idx <= agglen {
 						aggexpr := selectExprs[idx-1]
+						// The index refers to the select expression, even if a table column has the same name as its alias
 						if alias, ok := aggexpr.(*expression.Alias); ok {
-							aggexpr = expression.NewUnresolvedColumn(alias.Name())
+							aggexpr = expression.NewAliasReference(alias.Name())
 						}
 						groupingExprs[i] = aggexpr
 					}
@@ -3369,7 +3873,7 @@ func selectToSelectionNode(
 			}
 		}
 
-		return plan.NewGroupBy(selectExprs, groupingExprs, child), nil
+		return plan.NewGroupBy(selectExprs, groupingExprs, child).WithModifier(modifier), nil
 	}
 
 	return plan.NewProject(selectExprs, child), nil
@@ -3535,6 +4039,13 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 				v.Name.String(),
 			), nil
 		}
+		if name := v.Name.String(); bitValueLiteral.MatchString(name) {
+			val, err := bitValueBytes(name[2:])
+			if err != nil {
+				return nil, err
+			}
+			return expression.NewBitLiteral(val), nil
+		}
 		return expression.NewUnresolvedColumn(v.Name.String()), nil
 	case *sqlparser.FuncExpr:
 		exprs, err := selectExprsToExpressions(ctx, v.Exprs)
@@ -3556,12 +4067,17 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 
 			exprs[0] = expression.NewDistinctExpression(exprs[0])
 		}
+		if v.Name.Lowered() == diagnosticsFunction {
+			return convertDiagnosticsItem(exprs)
+		}
 		over, err := windowDefToWindow(ctx, (*sqlparser.WindowDef)(v.Over))
 		if err != nil {
 			return nil, err
 		}
-		return expression.NewUnresolvedFunction(v.Name.Lowered(),
-			isAggregateFunc(v), over, exprs...), nil
+		uf := expression.NewUnresolvedFunction(v.Name.Lowered(),
+			isAggregateFunc(v), over, exprs...)
+		uf.Database = v.Qualifier.String()
+		return uf, nil
 	case *sqlparser.GroupConcatExpr:
 		exprs, err := selectExprsToExpressions(ctx, v.Exprs)
 		if err != nil {
@@ -3630,6 +4146,14 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 			return nil, err
 		}
 
+		castToType := strings.ToLower(v.Type.Type)
+		if v.Type.Length != nil && (castToType == expression.ConvertToBinary || castToType == expression.ConvertToChar || castToType == expression.ConvertToNChar) {
+			length, err := strconv.Atoi(string(v.Type.Length.Val))
+			if err != nil {
+				return nil, err
+			}
+			return expression.NewConvertWithLength(expr, castToType, length), nil
+		}
 		return expression.NewConvert(expr, v.Type.Type), nil
 	case *sqlparser.RangeCond:
 		val, err := ExprToExpression(ctx, v.Left)
@@ -3790,7 +4314,7 @@ func windowDefToWindow(ctx *sql.Context, def *sqlparser.WindowDef) (*sql.WindowD
 func isAggregateFunc(v *sqlparser.FuncExpr) bool {
 	switch v.Name.Lowered() {
 	case "first", "last", "count", "sum", "any_value", "avg", "max", "min",
-		"count_distinct", "json_arrayagg",
+		"count_distinct", "json_arrayagg", "grouping",
 		"row_number", "percent_rank", "lag", "first_value":
 		return true
 	}
@@ -3879,32 +4403,44 @@ func convertVal(ctx *sql.Context, v *sqlparser.SQLVal) (sql.Expression, error) {
 		if err != nil {
 			return nil, err
 		}
-		return expression.NewLiteral(dst, types.LongBlob), nil
+		return expression.NewHexLiteral(dst), nil
 	case sqlparser.HexVal:
 		//TODO: binary collation?
 		val, err := v.HexDecode()
 		if err != nil {
 			return nil, err
 		}
-		return expression.NewLiteral(val, types.LongBlob), nil
+		return expression.NewHexLiteral(val), nil
 	case sqlparser.ValArg:
 		return expression.NewBindVar(strings.TrimPrefix(string(v.Val), ":")), nil
 	case sqlparser.BitVal:
-		if len(v.Val) == 0 {
-			return expression.NewLiteral(0, types.Uint64), nil
-		}
-
-		res, err := strconv.ParseUint(string(v.Val), 2, 64)
+		val, err := bitValueBytes(string(v.Val))
 		if err != nil {
 			return nil, err
 		}
-
-		return expression.NewLiteral(res, types.Uint64), nil
+		return expression.NewBitLiteral(val), nil
 	}
 
 	return nil, sql.ErrInvalidSQLValType.New(v.Type)
 }
 
+// bitValueBytes returns the binary string of the digits of a bit-value literal, which has as many bytes as needed to
+// hold the digits, padded with zero bits on the left.
+func bitValueBytes(digits string) ([]byte, error) {
+	if len(digits) == 0 {
+		return []byte{}, nil
+	}
+	i, ok := new(big.Int).SetString(digits, 2)
+	if !ok {
+		return nil, sql.ErrInvalidSQLValType.New(sqlparser.BitVal)
+	}
+	return i.FillBytes(make([]byte, (len(digits)+7)/8)), nil
+}
+
+// bitValueLiteral matches the column names that are bit-value literals in the 0b01 notation, which the tokenizer reads
+// as identifiers.
+var bitValueLiteral = regexp.MustCompile(`^0b[01]+$`)
+
 func isExprToExpression(ctx *sql.Context, c *sqlparser.IsExpr) (sql.Expression, error) {
 	e, err := ExprToExpression(ctx, c.Expr)
 	if err != nil {
@@ -4382,3 +4918,14 @@ func getCurrentUserForDefiner(ctx *sql.Context, definer string) string {
 	}
 	return definer
 }
+
+// withDefinerClause returns the CREATE statement given with a DEFINER clause naming the definer given. Triggers and
+// procedures store their statement this way when it doesn't name a definer, as the definer would otherwise default to
+// the user the statement is parsed for when it's loaded.
+func withDefinerClause(query, definer string) string {
+	loc := createKeywordRegex.FindStringIndex(query)
+	if loc == nil {
+		return query
+	}
+	return query[:loc[1]] + "DEFINER=" + definer + " " + query[loc[1]:]
+}
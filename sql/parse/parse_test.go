@@ -33,6 +33,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
@@ -337,7 +338,7 @@ func TestParse(t *testing.T) {
 							IndexName:  "",
 							Using:      sql.IndexUsing_Default,
 							Constraint: sql.IndexConstraint_None,
-							Columns:    []sql.IndexColumn{{"b", 0}},
+							Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 							Comment:    "",
 						},
 					},
@@ -367,7 +368,7 @@ func TestParse(t *testing.T) {
 						IndexName:  "idx_name",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_None,
-						Columns:    []sql.IndexColumn{{"b", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 						Comment:    "",
 					}},
 				},
@@ -396,7 +397,7 @@ func TestParse(t *testing.T) {
 						IndexName:  "idx_name",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_None,
-						Columns:    []sql.IndexColumn{{"b", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 						Comment:    "hi",
 					}},
 				},
@@ -425,7 +426,7 @@ func TestParse(t *testing.T) {
 						IndexName:  "",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_Unique,
-						Columns:    []sql.IndexColumn{{"b", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 						Comment:    "",
 					}},
 				},
@@ -454,7 +455,7 @@ func TestParse(t *testing.T) {
 						IndexName:  "",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_Unique,
-						Columns:    []sql.IndexColumn{{"b", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 						Comment:    "",
 					}},
 				},
@@ -483,7 +484,7 @@ func TestParse(t *testing.T) {
 						IndexName:  "",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_None,
-						Columns:    []sql.IndexColumn{{"b", 0}, {"a", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}, {Name: "a", Length: 0}},
 						Comment:    "",
 					}},
 				},
@@ -512,13 +513,13 @@ func TestParse(t *testing.T) {
 						IndexName:  "",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_None,
-						Columns:    []sql.IndexColumn{{"b", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}},
 						Comment:    "",
 					}, {
 						IndexName:  "",
 						Using:      sql.IndexUsing_Default,
 						Constraint: sql.IndexConstraint_None,
-						Columns:    []sql.IndexColumn{{"b", 0}, {"a", 0}},
+						Columns:    []sql.IndexColumn{{Name: "b", Length: 0}, {Name: "a", Length: 0}},
 						Comment:    "",
 					}},
 				},
@@ -1101,6 +1102,18 @@ CREATE TABLE t2
 				[]sql.Node{plan.NewUnresolvedTable("foo", "curdb"), plan.NewUnresolvedTable("bar", "curdb"), plan.NewUnresolvedTable("baz", "curdb")}, true,
 			),
 		},
+		{
+			input: `DROP TEMPORARY TABLE t1, t2`,
+			plan: plan.NewDropTable(
+				[]sql.Node{plan.NewUnresolvedTable("t1", ""), plan.NewUnresolvedTable("t2", "")}, false,
+			).WithTemporary(plan.IsTempTable),
+		},
+		{
+			input: `/* c */ drop temporary table if exists foo`,
+			plan: plan.NewDropTable(
+				[]sql.Node{plan.NewUnresolvedTable("foo", "")}, true,
+			).WithTemporary(plan.IsTempTable),
+		},
 		{
 			input: `RENAME TABLE foo TO bar`,
 			plan: plan.NewRenameTable(
@@ -1257,7 +1270,7 @@ CREATE TABLE t2
 				"",
 				sql.IndexUsing_BTree,
 				sql.IndexConstraint_None,
-				[]sql.IndexColumn{{"v1", 0}},
+				[]sql.IndexColumn{{Name: "v1", Length: 0}},
 				"",
 			),
 		},
@@ -1522,10 +1535,34 @@ CREATE TABLE t2
 					plan.NewUnresolvedTable("foo", "")),
 			),
 		},
+		{
+			input: "EXPLAIN FORMAT=TRADITIONAL SELECT * FROM foo",
+			plan: plan.NewDescribeQuery(
+				plan.DescribeFormatTraditional, plan.NewProject(
+					[]sql.Expression{expression.NewStar()},
+					plan.NewUnresolvedTable("foo", "")),
+			),
+		},
+		{
+			input: "EXPLAIN FORMAT=JSON SELECT * FROM foo",
+			plan: plan.NewDescribeQuery(
+				plan.DescribeFormatJSON, plan.NewProject(
+					[]sql.Expression{expression.NewStar()},
+					plan.NewUnresolvedTable("foo", "")),
+			),
+		},
+		{
+			input: "desc format = json SELECT * FROM foo",
+			plan: plan.NewDescribeQuery(
+				plan.DescribeFormatJSON, plan.NewProject(
+					[]sql.Expression{expression.NewStar()},
+					plan.NewUnresolvedTable("foo", "")),
+			),
+		},
 		{
 			input: "DESCRIBE SELECT * FROM foo",
 			plan: plan.NewDescribeQuery(
-				"tree", plan.NewProject(
+				plan.DescribeFormatTraditional, plan.NewProject(
 					[]sql.Expression{expression.NewStar()},
 					plan.NewUnresolvedTable("foo", ""),
 				)),
@@ -1533,7 +1570,7 @@ CREATE TABLE t2
 		{
 			input: "DESC SELECT * FROM foo",
 			plan: plan.NewDescribeQuery(
-				"tree", plan.NewProject(
+				plan.DescribeFormatTraditional, plan.NewProject(
 					[]sql.Expression{expression.NewStar()},
 					plan.NewUnresolvedTable("foo", ""),
 				)),
@@ -1541,7 +1578,7 @@ CREATE TABLE t2
 		{
 			input: "EXPLAIN SELECT * FROM foo",
 			plan: plan.NewDescribeQuery(
-				"tree", plan.NewProject(
+				plan.DescribeFormatTraditional, plan.NewProject(
 					[]sql.Expression{expression.NewStar()},
 					plan.NewUnresolvedTable("foo", "")),
 			),
@@ -2036,7 +2073,14 @@ CREATE TABLE t2
 		{
 			input: `INSERT INTO t1 VALUES (b'0111')`,
 			plan: plan.NewInsertInto(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("t1", ""), plan.NewValues([][]sql.Expression{{
-				expression.NewLiteral(uint64(7), types.Uint64),
+				expression.NewBitLiteral([]byte{7}),
+			}}), false, []string{}, []sql.Expression{}, false),
+		},
+		{
+			input: `INSERT INTO t1 VALUES (0b0110000101100010, b'')`,
+			plan: plan.NewInsertInto(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("t1", ""), plan.NewValues([][]sql.Expression{{
+				expression.NewBitLiteral([]byte("ab")),
+				expression.NewBitLiteral([]byte{}),
 			}}), false, []string{}, []sql.Expression{}, false),
 		},
 		{
@@ -2392,7 +2436,7 @@ CREATE TABLE t2
 			plan: plan.NewProject(
 				[]sql.Expression{
 					expression.NewAlias("0x01AF",
-						expression.NewLiteral([]byte{1, 175}, types.LongBlob),
+						expression.NewHexLiteral([]byte{1, 175}),
 					),
 				},
 				plan.NewResolvedDualTable(),
@@ -2403,7 +2447,7 @@ CREATE TABLE t2
 			plan: plan.NewProject(
 				[]sql.Expression{
 					expression.NewAlias("X'41'",
-						expression.NewLiteral([]byte{'A'}, types.LongBlob),
+						expression.NewHexLiteral([]byte{'A'}),
 					),
 				},
 				plan.NewResolvedDualTable(),
@@ -2823,7 +2867,22 @@ CREATE TABLE t2
 				sql.IndexUsing_BTree,
 				sql.IndexConstraint_None,
 				[]sql.IndexColumn{
-					{"bar", 0},
+					{Name: "bar", Length: 0},
+				},
+				"",
+			),
+		},
+		{
+			input: `CREATE INDEX idx ON foo(bar DESC, baz)`,
+			plan: plan.NewAlterCreateIndex(
+				sql.UnresolvedDatabase(""),
+				plan.NewUnresolvedTable("foo", ""),
+				"idx",
+				sql.IndexUsing_BTree,
+				sql.IndexConstraint_None,
+				[]sql.IndexColumn{
+					{Name: "bar", Length: 0, Descending: true},
+					{Name: "baz", Length: 0},
 				},
 				"",
 			),
@@ -2837,7 +2896,7 @@ CREATE TABLE t2
 				sql.IndexUsing_BTree,
 				sql.IndexConstraint_None,
 				[]sql.IndexColumn{
-					{"bar", 0},
+					{Name: "bar", Length: 0},
 				},
 				"",
 			),
@@ -2865,6 +2924,42 @@ CREATE TABLE t2
 				),
 			),
 		},
+		{
+			input: `SELECT * FROM foo NATURAL LEFT JOIN bar`,
+			plan: plan.NewProject(
+				[]sql.Expression{expression.NewStar()},
+				plan.NewJoin(
+					plan.NewUnresolvedTable("foo", ""),
+					plan.NewUnresolvedTable("bar", ""),
+					plan.JoinTypeLeftOuterNatural,
+					nil,
+				),
+			),
+		},
+		{
+			input: `SELECT * FROM foo JOIN bar USING (a, b)`,
+			plan: plan.NewProject(
+				[]sql.Expression{expression.NewStar()},
+				plan.NewUsingJoin(
+					plan.NewUnresolvedTable("foo", ""),
+					plan.NewUnresolvedTable("bar", ""),
+					plan.JoinTypeInner,
+					[]string{"a", "b"},
+				),
+			),
+		},
+		{
+			input: `SELECT * FROM foo RIGHT JOIN bar USING (a)`,
+			plan: plan.NewProject(
+				[]sql.Expression{expression.NewStar()},
+				plan.NewUsingJoin(
+					plan.NewUnresolvedTable("foo", ""),
+					plan.NewUnresolvedTable("bar", ""),
+					plan.JoinTypeRightOuter,
+					[]string{"a"},
+				),
+			),
+		},
 		{
 			input: `DROP INDEX foo ON bar`,
 			plan: plan.NewAlterDropIndex(
@@ -3069,6 +3164,50 @@ CREATE TABLE t2
 				),
 			),
 		},
+		{
+			input: `SELECT STRAIGHT_JOIN * FROM b join a on c = d`,
+			plan: plan.NewProject(
+				[]sql.Expression{
+					expression.NewStar(),
+				},
+				plan.NewInnerJoin(
+					plan.NewUnresolvedTable("b", ""),
+					plan.NewUnresolvedTable("a", ""),
+					expression.NewEquals(
+						expression.NewUnresolvedColumn("c"),
+						expression.NewUnresolvedColumn("d"),
+					),
+				).WithComment("/*+ JOIN_FIXED_ORDER */"),
+			),
+		},
+		{
+			input: `SELECT /*+ LOOKUP_JOIN(b,a) */ * FROM b straight_join a on c = d`,
+			plan: plan.NewProject(
+				[]sql.Expression{
+					expression.NewStar(),
+				},
+				plan.NewInnerJoin(
+					plan.NewUnresolvedTable("b", ""),
+					plan.NewUnresolvedTable("a", ""),
+					expression.NewEquals(
+						expression.NewUnresolvedColumn("c"),
+						expression.NewUnresolvedColumn("d"),
+					),
+				).WithComment("/*+ JOIN_FIXED_ORDER LOOKUP_JOIN(b,a) */"),
+			),
+		},
+		{
+			input: `SELECT * FROM b straight_join a`,
+			plan: plan.NewProject(
+				[]sql.Expression{
+					expression.NewStar(),
+				},
+				plan.NewCrossJoin(
+					plan.NewUnresolvedTable("b", ""),
+					plan.NewUnresolvedTable("a", ""),
+				).WithComment("/*+ JOIN_FIXED_ORDER */"),
+			),
+		},
 		{
 			input: `SHOW DATABASES`,
 			plan:  plan.NewShowDatabases(),
@@ -4866,7 +5005,7 @@ END;`,
 			plan: plan.NewCreateProcedure(
 				sql.UnresolvedDatabase(""),
 				"p1",
-				"",
+				"``@``",
 				[]plan.ProcedureParam{
 					{
 						Direction: plan.ProcedureParamDirection_Inout,
@@ -4899,7 +5038,7 @@ END;`,
 					}),
 				),
 				"",
-				`CREATE PROCEDURE p1(INOUT a INT, IN b SMALLINT)
+				"CREATE DEFINER=``@`` "+`PROCEDURE p1(INOUT a INT, IN b SMALLINT)
 BEGIN
 	DECLARE c BIGINT;
 	DECLARE cur1 CURSOR FOR SELECT 1;
@@ -4972,7 +5111,7 @@ func TestParseCreateTrigger(t *testing.T) {
 					), false, []string{"a", "b"}, []sql.Expression{}, false),
 				}),
 			),
-			`CREATE TRIGGER myTrigger BEFORE UPDATE ON foo FOR EACH ROW 
+			"CREATE DEFINER=``@`` "+`TRIGGER myTrigger BEFORE UPDATE ON foo FOR EACH ROW 
    BEGIN 
      UPDATE bar SET x = old.y WHERE z = new.y;
 		 DELETE FROM baz WHERE a = old.b;
@@ -4994,7 +5133,7 @@ func TestParseCreateTrigger(t *testing.T) {
 				expression.NewUnresolvedQualifiedColumn("old", "b"),
 			}},
 			), false, []string{"a", "b"}, []sql.Expression{}, false),
-			`CREATE TRIGGER myTrigger BEFORE UPDATE ON foo FOR EACH ROW INSERT INTO zzz (a,b) VALUES (old.a, old.b)`,
+			"CREATE DEFINER=``@`` "+`TRIGGER myTrigger BEFORE UPDATE ON foo FOR EACH ROW INSERT INTO zzz (a,b) VALUES (old.a, old.b)`,
 			`INSERT INTO zzz (a,b) VALUES (old.a, old.b)`,
 			time.Unix(0, 0),
 			"``@``",
@@ -5008,7 +5147,7 @@ func TestParseCreateTrigger(t *testing.T) {
 				expression.NewUnresolvedQualifiedColumn("old", "b"),
 			}},
 			), false, []string{"a", "b"}, []sql.Expression{}, false),
-			`CREATE TRIGGER myTrigger BEFORE UPDATE ON foo FOR EACH ROW FOLLOWS yourTrigger INSERT INTO zzz (a,b) VALUES (old.a, old.b)`,
+			"CREATE DEFINER=``@`` "+`TRIGGER myTrigger BEFORE UPDATE ON foo FOR EACH ROW FOLLOWS yourTrigger INSERT INTO zzz (a,b) VALUES (old.a, old.b)`,
 			`INSERT INTO zzz (a,b) VALUES (old.a, old.b)`,
 			time.Unix(0, 0),
 			"``@``",
@@ -5038,7 +5177,7 @@ func TestParseCreateTrigger(t *testing.T) {
 				}),
 			},
 			)),
-			`create trigger signal_with_user_var
+			"create DEFINER=``@`` "+`trigger signal_with_user_var
     BEFORE DELETE ON FOO FOR EACH ROW
 		BEGIN
         SET @message_text = CONCAT('ouch', 'oof');
@@ -5181,6 +5320,10 @@ func TestParseOne(t *testing.T) {
 			"SELECT 1; SELECT 2; -- empty statement with comment\n",
 			[]string{"SELECT 1", "SELECT 2", "-- empty statement with comment"},
 		},
+		{
+			"VALUES ROW(1), ROW(2); INSERT INTO t VALUES ROW(3); SELECT 4",
+			[]string{"VALUES ROW(1), ROW(2)", "INSERT INTO t VALUES ROW(3)", "SELECT 4"},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.input, func(t *testing.T) {
@@ -5200,6 +5343,815 @@ func TestParseOne(t *testing.T) {
 	}
 }
 
+func TestParseViewCheckOption(t *testing.T) {
+	cases := []struct {
+		input      string
+		checkOpt   string
+		definition string
+	}{
+		{
+			input:      "CREATE VIEW v AS SELECT * FROM t WHERE a > 1",
+			checkOpt:   "",
+			definition: "SELECT * FROM t WHERE a > 1",
+		},
+		{
+			input:      "CREATE VIEW v AS SELECT * FROM t WHERE a > 1 WITH CHECK OPTION",
+			checkOpt:   plan.ViewCheckOptionCascaded,
+			definition: "SELECT * FROM t WHERE a > 1",
+		},
+		{
+			input:      "create or replace view v as select * from t where a > 1 with cascaded check option;",
+			checkOpt:   plan.ViewCheckOptionCascaded,
+			definition: "select * from t where a > 1",
+		},
+		{
+			input:      "CREATE DEFINER = 'root'@'localhost' VIEW v AS SELECT * FROM t\nWITH  LOCAL\tCHECK OPTION",
+			checkOpt:   plan.ViewCheckOptionLocal,
+			definition: "SELECT * FROM t",
+		},
+		{
+			input:      "CREATE VIEW v AS SELECT * FROM t WITH CHECK OPTION -- note",
+			checkOpt:   plan.ViewCheckOptionCascaded,
+			definition: "SELECT * FROM t",
+		},
+		{
+			input:      "CREATE VIEW v AS SELECT * FROM t WITH LOCAL /* c */ CHECK OPTION /* note */;",
+			checkOpt:   plan.ViewCheckOptionLocal,
+			definition: "SELECT * FROM t",
+		},
+		{
+			input:      "CREATE VIEW v AS SELECT 'a WITH CHECK OPTION' FROM t",
+			checkOpt:   "",
+			definition: "SELECT 'a WITH CHECK OPTION' FROM t",
+		},
+		{
+			input:      "CREATE VIEW v AS SELECT * FROM t /* WITH CHECK OPTION */",
+			checkOpt:   "",
+			definition: "SELECT * FROM t /* WITH CHECK OPTION */",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			node, err := Parse(ctx, tc.input)
+			require.NoError(t, err)
+			cv, ok := node.(*plan.CreateView)
+			require.True(t, ok)
+			require.Equal(t, tc.checkOpt, cv.CheckOpt)
+			require.Equal(t, tc.definition, cv.Definition.TextDefinition)
+		})
+	}
+}
+
+func TestParseAlterView(t *testing.T) {
+	cases := []struct {
+		input      string
+		checkOpt   string
+		definition string
+		security   string
+	}{
+		{
+			input:      "ALTER VIEW v AS SELECT * FROM t WHERE a > 1",
+			definition: "SELECT * FROM t WHERE a > 1",
+		},
+		{
+			input:      "alter algorithm=merge definer=`root`@`localhost` sql security invoker view v as select 1 with local check option",
+			checkOpt:   plan.ViewCheckOptionLocal,
+			definition: "select 1",
+			security:   "invoker",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			node, err := Parse(ctx, tc.input)
+			require.NoError(t, err)
+			cv, ok := node.(*plan.CreateView)
+			require.True(t, ok)
+			require.True(t, cv.IsAlter)
+			require.True(t, cv.IsReplace)
+			require.Equal(t, tc.checkOpt, cv.CheckOpt)
+			require.Equal(t, tc.definition, cv.Definition.TextDefinition)
+			require.Equal(t, tc.security, cv.Security)
+		})
+	}
+}
+
+func TestParseSelectModifiers(t *testing.T) {
+	cases := []struct {
+		input      string
+		equivalent string
+	}{
+		{
+			input:      "SELECT SQL_SMALL_RESULT a, COUNT(*) FROM t GROUP BY a",
+			equivalent: "SELECT a, COUNT(*) FROM t GROUP BY a",
+		},
+		{
+			input:      "select distinct sql_big_result sql_no_cache a from t",
+			equivalent: "select sql_no_cache distinct a from t",
+		},
+		{
+			input:      "SELECT /*+ JOIN_ORDER(t) */ STRAIGHT_JOIN SQL_CALC_FOUND_ROWS SQL_NO_CACHE * FROM t LIMIT 1",
+			equivalent: "SELECT /*+ JOIN_ORDER(t) */ SQL_NO_CACHE SQL_CALC_FOUND_ROWS STRAIGHT_JOIN * FROM t LIMIT 1",
+		},
+		{
+			input:      "SELECT * FROM t WHERE a IN (SELECT SQL_BIG_RESULT DISTINCT b FROM u)",
+			equivalent: "SELECT * FROM t WHERE a IN (SELECT DISTINCT b FROM u)",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			node, err := Parse(ctx, tc.input)
+			require.NoError(t, err)
+			expected, err := Parse(ctx, tc.equivalent)
+			require.NoError(t, err)
+			require.Equal(t, expected, node)
+		})
+	}
+
+	ctx := sql.NewEmptyContext()
+	_, err := Parse(ctx, "SELECT SQL_BIG_RESULT a FROM t WHERE")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "at line 1, column 37")
+}
+
+func TestParseGroupByModifiers(t *testing.T) {
+	cases := []struct {
+		input    string
+		modifier plan.GroupingModifier
+		grouping int
+	}{
+		{"SELECT a, b, SUM(c) FROM t GROUP BY a, b WITH ROLLUP", plan.GroupingModifierRollup, 2},
+		{"select a, sum(c) from t group by a with cube having grouping(a) = 0", plan.GroupingModifierCube, 1},
+		{"SELECT a FROM t GROUP BY a", plan.GroupingModifierNone, 1},
+		{"WITH rollup AS (SELECT a FROM t) SELECT a FROM rollup GROUP BY a WITH ROLLUP", plan.GroupingModifierRollup, 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			node, err := Parse(sql.NewEmptyContext(), tc.input)
+			require.NoError(t, err)
+			var groupBy *plan.GroupBy
+			transform.Inspect(node, func(n sql.Node) bool {
+				if g, ok := n.(*plan.GroupBy); ok {
+					groupBy = g
+				}
+				return groupBy == nil
+			})
+			require.NotNil(t, groupBy)
+			require.Equal(t, tc.modifier, groupBy.Modifier)
+			require.Len(t, groupBy.GroupByExprs, tc.grouping)
+		})
+	}
+
+	_, err := Parse(sql.NewEmptyContext(), "SELECT a FROM t GROUP BY a WITH ROLLUP WHERE")
+	require.Error(t, err)
+}
+
+func TestParseValuesStatement(t *testing.T) {
+	cases := []struct {
+		input      string
+		equivalent string
+	}{
+		{
+			input:      "VALUES ROW(1, 'a'), ROW(2, 'b')",
+			equivalent: "SELECT * FROM (VALUES ROW(1, 'a'), ROW(2, 'b')) AS `values`",
+		},
+		{
+			input:      "values row(1, ')'), /* row(3) */ row(2, 'b') order by column_1 limit 1",
+			equivalent: "SELECT * FROM (VALUES ROW(1, ')'), ROW(2, 'b')) AS `values` ORDER BY column_1 LIMIT 1",
+		},
+		{
+			input:      "SELECT 1 UNION VALUES ROW(2) UNION ALL VALUES ROW(3)",
+			equivalent: "SELECT 1 UNION SELECT * FROM (VALUES ROW(2)) AS `values` UNION ALL SELECT * FROM (VALUES ROW(3)) AS `values`",
+		},
+		{
+			input:      "SELECT * FROM t WHERE a IN (VALUES ROW(1), ROW(2 * (3 + 4)))",
+			equivalent: "SELECT * FROM t WHERE a IN (SELECT * FROM (VALUES ROW(1), ROW(2 * (3 + 4))) AS `values`)",
+		},
+		{
+			input:      "SELECT * FROM t JOIN (VALUES ROW(1, 2)) AS v (x, y) ON t.a = v.x",
+			equivalent: "SELECT * FROM t JOIN (VALUES ROW(1, 2)) AS v (x, y) ON t.a = v.x",
+		},
+		{
+			input:      "INSERT INTO t (b, a) VALUES ROW('x', 1), ROW('y', 2)",
+			equivalent: "INSERT INTO t (b, a) VALUES ('x', 1), ('y', 2)",
+		},
+		{
+			input:      "INSERT INTO t VALUES ROW(1, 'values row(2)') ON DUPLICATE KEY UPDATE b = VALUES(b)",
+			equivalent: "INSERT INTO t VALUES (1, 'values row(2)') ON DUPLICATE KEY UPDATE b = VALUES(b)",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			node, err := Parse(ctx, tc.input)
+			require.NoError(t, err)
+			expected, err := Parse(ctx, tc.equivalent)
+			require.NoError(t, err)
+			require.Equal(t, expected, node)
+		})
+	}
+
+	ctx := sql.NewEmptyContext()
+	node, err := Parse(ctx, "CREATE VIEW v AS VALUES ROW(1), ROW(2) WITH CHECK OPTION")
+	require.NoError(t, err)
+	cv, ok := node.(*plan.CreateView)
+	require.True(t, ok)
+	require.Equal(t, "VALUES ROW(1), ROW(2)", cv.Definition.TextDefinition)
+}
+
+func TestParseRenameDatabase(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	node, err := Parse(ctx, "RENAME DATABASE olddb TO `new``db`;")
+	require.NoError(t, err)
+	require.Equal(t, plan.NewRenameDatabase("olddb", "new`db"), node)
+
+	node, err = Parse(ctx, "rename schema `a` to b")
+	require.NoError(t, err)
+	require.Equal(t, plan.NewRenameDatabase("a", "b"), node)
+}
+
+func TestParseFunctionalKeyParts(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	node, err := Parse(ctx, "CREATE INDEX idx ON t ((lower(name)) DESC, a, (a + b))")
+	require.NoError(t, err)
+	ai, ok := node.(*plan.AlterIndex)
+	require.True(t, ok, "unexpected node %T", node)
+	require.Equal(t, "idx", ai.IndexName)
+	require.Len(t, ai.Columns, 3)
+	require.Equal(t, "lower(name)", ai.Columns[0].Expression.String())
+	require.True(t, ai.Columns[0].Descending)
+	require.Equal(t, sql.IndexColumn{Name: "a"}, ai.Columns[1])
+	require.Equal(t, "(a + b)", ai.Columns[2].Expression.String())
+
+	node, err = Parse(ctx, "CREATE TABLE t (a int, b varchar(10), KEY (a), KEY ((a * 2)), UNIQUE KEY b (b(3)), INDEX i (a, (b)))")
+	require.NoError(t, err)
+	create, ok := node.(*plan.CreateTable)
+	require.True(t, ok, "unexpected node %T", node)
+	idxDefs := create.TableSpec().IdxDefs
+	require.Len(t, idxDefs, 4)
+	require.Equal(t, []sql.IndexColumn{{Name: "a"}}, idxDefs[0].Columns)
+	require.Equal(t, "(a * 2)", idxDefs[1].Columns[0].Expression.String())
+	require.Equal(t, []sql.IndexColumn{{Name: "b", Length: 3}}, idxDefs[2].Columns)
+	require.Equal(t, "a", idxDefs[3].Columns[0].Name)
+	require.Equal(t, "b", idxDefs[3].Columns[1].Expression.String())
+
+	// Statements other than CREATE and ALTER aren't rewritten
+	node, err = Parse(ctx, "SELECT 'index ((a))'")
+	require.NoError(t, err)
+	expected, err := Parse(ctx, "SELECT 'index ((a))'")
+	require.NoError(t, err)
+	require.Equal(t, expected, node)
+
+	_, err = Parse(ctx, "ALTER TABLE t ADD PRIMARY KEY ((a + 1))")
+	require.True(t, sql.ErrFunctionalIndexPrimaryKey.Is(err), "unexpected error %v", err)
+}
+
+func TestRewriteFunctionalKeyParts(t *testing.T) {
+	for _, test := range []struct {
+		query     string
+		rewritten string
+		exprs     []string
+	}{
+		{
+			query:     "CREATE INDEX i ON t ((lower(substr(n, 1, (a + 2)))) DESC, a)",
+			rewritten: "CREATE INDEX i ON t (`!functional!0` DESC, a)",
+			exprs:     []string{"lower(substr(n, 1, (a + 2)))"},
+		},
+		{
+			query:     "CREATE INDEX i ON t ((concat(n, ')', '((')), (n = 'a, b'))",
+			rewritten: "CREATE INDEX i ON t (`!functional!0`, `!functional!1`)",
+			exprs:     []string{"concat(n, ')', '((')", "n = 'a, b'"},
+		},
+		{
+			query:     "CREATE TABLE t (n varchar(10) DEFAULT ')', KEY k (( n )), INDEX (`(a)`, ((n) + 1)))",
+			rewritten: "CREATE TABLE t (n varchar(10) DEFAULT ')', KEY k (`!functional!0`), INDEX (`(a)`, `!functional!1`))",
+			exprs:     []string{" n ", "(n) + 1"},
+		},
+		{
+			query:     "ALTER TABLE t ADD INDEX /* ((x)) */ i ((n -- )\n))",
+			rewritten: "ALTER TABLE t ADD INDEX /* ((x)) */ i (`!functional!0`)",
+			exprs:     []string{"n -- )\n"},
+		},
+		{
+			query:     "CREATE INDEX i ON t (n(3), (a))",
+			rewritten: "CREATE INDEX i ON t (n(3), `!functional!0`)",
+			exprs:     []string{"a"},
+		},
+		{
+			query:     "INSERT INTO t VALUES ('index ((a))')",
+			rewritten: "INSERT INTO t VALUES ('index ((a))')",
+		},
+	} {
+		t.Run(test.query, func(t *testing.T) {
+			rewritten, edits, exprs := rewriteFunctionalKeyParts(test.query)
+			require.Equal(t, test.rewritten, rewritten)
+			require.Equal(t, test.exprs, exprs)
+			// The end of the rewritten statement maps back to the end of the statement as written
+			require.Equal(t, len(test.query), edits.originalPosition(len(rewritten)))
+		})
+	}
+
+	ctx := sql.NewEmptyContext()
+	node, err := Parse(ctx, "CREATE INDEX i ON t ((concat(n, ')', '((')), (lower(substr(n, 1, (a + 2)))))")
+	require.NoError(t, err)
+	ai, ok := node.(*plan.AlterIndex)
+	require.True(t, ok, "unexpected node %T", node)
+	require.Len(t, ai.Columns, 2)
+	require.Equal(t, "concat(n, ')', '((')", ai.Columns[0].Expression.String())
+	require.Equal(t, "lower(substr(n, 1, (a + 2)))", ai.Columns[1].Expression.String())
+}
+
+func TestParseXA(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	xid := sql.XID{Gtrid: "gtrid", Bqual: "", FormatID: 1}
+	onePhase := plan.NewXA(plan.XACommit, sql.XID{Gtrid: "g", Bqual: "b", FormatID: 7})
+	onePhase.OnePhase = true
+	convert := plan.NewXA(plan.XARecover, sql.XID{})
+	convert.ConvertXID = true
+
+	for query, expected := range map[string]sql.Node{
+		"XA START 'gtrid'":                   plan.NewXA(plan.XAStart, xid),
+		"xa begin \"gtrid\" join;":           plan.NewXA(plan.XAStart, xid),
+		"XA END 'gtrid' SUSPEND FOR MIGRATE": plan.NewXA(plan.XAEnd, xid),
+		"XA PREPARE X'6774726964'":           plan.NewXA(plan.XAPrepare, xid),
+		"XA COMMIT 'g', 0x62, 7 ONE PHASE":   onePhase,
+		"XA ROLLBACK 'gtrid', '', 1":         plan.NewXA(plan.XARollback, xid),
+		"XA RECOVER":                         plan.NewXA(plan.XARecover, sql.XID{}),
+		"XA RECOVER CONVERT XID":             convert,
+	} {
+		t.Run(query, func(t *testing.T) {
+			node, err := Parse(ctx, query)
+			require.NoError(t, err)
+			require.Equal(t, expected, node)
+		})
+	}
+
+	for _, query := range []string{
+		"XA",
+		"XA START",
+		"XA START gtrid",
+		"XA START ''",
+		"XA START 'g', 'b', 'c'",
+		"XA PREPARE 'g' ONE PHASE",
+		"XA COMMIT 'g' TWO PHASE",
+		"XA RECOVER 'g'",
+		"XA FORGET 'g'",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := Parse(ctx, query)
+			require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+		})
+	}
+}
+
+func TestParseHandler(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	limited := plan.NewHandlerRead("t", "", plan.HandlerReadNext)
+	limited.Offset, limited.Limit = 2, 5
+	key := plan.NewHandlerRead("h", "idx", plan.HandlerReadKey)
+	key.KeyOp = ">="
+	key.Key = []sql.Expression{expression.NewLiteral(int8(1), types.Int8), expression.NewLiteral("a", types.LongText)}
+
+	for query, expected := range map[string]sql.Node{
+		"HANDLER t OPEN":                 plan.NewHandlerOpen("", "t", ""),
+		"handler mydb.`t 1` open as h;":  plan.NewHandlerOpen("mydb", "t 1", "h"),
+		"HANDLER t OPEN h":               plan.NewHandlerOpen("", "t", "h"),
+		"HANDLER t READ FIRST":           plan.NewHandlerRead("t", "", plan.HandlerReadFirst),
+		"HANDLER t READ NEXT LIMIT 2, 5": limited,
+		"HANDLER t READ `PRIMARY` LAST":  plan.NewHandlerRead("t", "PRIMARY", plan.HandlerReadLast),
+		"handler h read idx >= (1, 'a')": key,
+		"HANDLER t CLOSE":                plan.NewHandlerClose("t"),
+	} {
+		t.Run(query, func(t *testing.T) {
+			node, err := Parse(ctx, query)
+			require.NoError(t, err)
+			require.Equal(t, expected, node)
+		})
+	}
+
+	for _, query := range []string{
+		"HANDLER",
+		"HANDLER t",
+		"HANDLER t OPEN AS",
+		"HANDLER t OPEN AS h h2",
+		"HANDLER t READ PREV",
+		"HANDLER t READ idx",
+		"HANDLER t READ idx = 1",
+		"HANDLER t READ idx = (1",
+		"HANDLER t READ FIRST LIMIT",
+		"HANDLER t READ FIRST LIMIT 1, 2, 3",
+		"HANDLER db.t CLOSE",
+		"HANDLER t DROP",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := Parse(ctx, query)
+			require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+		})
+	}
+}
+
+func TestParseStoredFunction(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	for query, expected := range map[string]sql.Type{
+		"CREATE FUNCTION f() RETURNS INT RETURN 1":                                               types.Int32,
+		"create definer = `root`@`localhost` function f(x int) returns bigint unsigned return x": types.Uint64,
+		"CREATE FUNCTION f() RETURNS VARCHAR(20) CHARSET latin1 DETERMINISTIC RETURN 'a'":        types.MustCreateString(sqltypes.VarChar, 20, sql.Collation_latin1_swedish_ci),
+	} {
+		t.Run(query, func(t *testing.T) {
+			node, err := Parse(ctx, query)
+			require.NoError(t, err)
+			cp, ok := node.(*plan.CreateProcedure)
+			require.True(t, ok, "unexpected node %T", node)
+			require.True(t, cp.IsFunction())
+			require.Equal(t, expected, cp.ReturnType)
+		})
+	}
+
+	node, err := Parse(ctx, "DROP FUNCTION IF EXISTS mydb.f")
+	require.NoError(t, err)
+	require.Equal(t, plan.NewDropFunction(sql.UnresolvedDatabase("mydb"), "f", true), node)
+	node, err = Parse(ctx, "SHOW CREATE FUNCTION mydb.f")
+	require.NoError(t, err)
+	require.Equal(t, plan.NewShowCreateFunction(sql.UnresolvedDatabase("mydb"), "f"), node)
+
+	for _, query := range []string{
+		"CREATE FUNCTION f() RETURN 1",
+		"CREATE FUNCTION f() RETURNS",
+		"CREATE FUNCTION f() RETURNS VARCHAR(20",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := Parse(ctx, query)
+			require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+		})
+	}
+}
+
+func TestParseGetDiagnostics(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	condition := expression.NewLiteral(int8(2), types.Int8)
+	for query, expected := range map[string][]sql.Expression{
+		"GET DIAGNOSTICS @a = ROW_COUNT": {
+			expression.NewDiagnosticsItem(false, nil, "ROW_COUNT"),
+		},
+		"get current diagnostics @a = number, b = row_count;": {
+			expression.NewDiagnosticsItem(false, nil, "NUMBER"),
+			expression.NewDiagnosticsItem(false, nil, "ROW_COUNT"),
+		},
+		"GET STACKED DIAGNOSTICS CONDITION 2 @a = MESSAGE_TEXT, `b` = RETURNED_SQLSTATE": {
+			expression.NewDiagnosticsItem(true, condition, "MESSAGE_TEXT"),
+			expression.NewDiagnosticsItem(true, condition, "RETURNED_SQLSTATE"),
+		},
+	} {
+		t.Run(query, func(t *testing.T) {
+			node, err := Parse(ctx, query)
+			require.NoError(t, err)
+			into, ok := node.(*plan.Into)
+			require.True(t, ok, "unexpected node %T", node)
+			require.Len(t, into.IntoVars, len(expected))
+			project, ok := into.Child.(*plan.Project)
+			require.True(t, ok, "unexpected node %T", into.Child)
+			var items []sql.Expression
+			for _, e := range project.Projections {
+				items = append(items, e.(*expression.Alias).Child)
+			}
+			require.Equal(t, expected, items)
+		})
+	}
+
+	for _, query := range []string{
+		"GET DIAGNOSTICS",
+		"GET DIAGNOSTICS @a",
+		"GET DIAGNOSTICS @a = MESSAGE_TEXT",
+		"GET DIAGNOSTICS CONDITION 1 @a = ROW_COUNT",
+		"GET DIAGNOSTICS CONDITION 1 @a = MESSAGE_TEXT @b",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := Parse(ctx, query)
+			require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+		})
+	}
+}
+
+func TestParseFlush(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	for query, expected := range map[string]sql.Node{
+		"FLUSH TABLES":                        plan.NewFlush(sql.FlushTables, nil, true),
+		"flush local table;":                  plan.NewFlush(sql.FlushTables, nil, false),
+		"FLUSH TABLES t1, mydb.`t 2`":         plan.NewFlush(sql.FlushTables, []sql.DbTable{{Table: "t1"}, {Db: "mydb", Table: "t 2"}}, true),
+		"FLUSH NO_WRITE_TO_BINLOG TABLES t1":  plan.NewFlush(sql.FlushTables, []sql.DbTable{{Table: "t1"}}, false),
+		"FLUSH STATUS":                        plan.NewFlush(sql.FlushStatus, nil, true),
+		"FLUSH LOGS":                          plan.NewFlush(sql.FlushLogs, nil, true),
+		"flush local binary logs":             plan.NewFlush(sql.FlushBinaryLogs, nil, false),
+		"FLUSH RELAY LOGS FOR CHANNEL 'c1'":   plan.NewFlush(sql.FlushRelayLogs, nil, true),
+		"FLUSH NO_WRITE_TO_BINLOG PRIVILEGES": plan.NewFlushPrivileges(false),
+	} {
+		t.Run(query, func(t *testing.T) {
+			node, err := Parse(ctx, query)
+			require.NoError(t, err)
+			require.Equal(t, expected, node)
+		})
+	}
+
+	for _, query := range []string{
+		"FLUSH TABLES t1,",
+		"FLUSH TABLES t1 t2",
+		"FLUSH TABLES db.t1.c1",
+		"FLUSH TABLES 't1'",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := Parse(ctx, query)
+			require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+		})
+	}
+
+	for _, query := range []string{
+		"FLUSH TABLES WITH READ LOCK",
+		"FLUSH TABLES t1 FOR EXPORT",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := Parse(ctx, query)
+			require.True(t, sql.ErrUnsupportedFeature.Is(err), "unexpected error %v", err)
+		})
+	}
+}
+
+func TestParseMaterializedView(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	node, err := Parse(ctx, "CREATE MATERIALIZED VIEW IF NOT EXISTS mydb.`m v` REFRESH EVERY 5 minute MAX STALENESS 1 HOUR AS select a from t;")
+	require.NoError(t, err)
+	create, ok := node.(*plan.CreateMaterializedView)
+	require.True(t, ok)
+	require.Equal(t, "mydb", create.Database().Name())
+	require.Equal(t, "m v", create.Name)
+	require.True(t, create.IfNotExists)
+	require.Equal(t, "select a from t", create.Definition)
+	require.Equal(t, 5*time.Minute, create.RefreshInterval)
+	require.Equal(t, time.Hour, create.MaxStaleness)
+
+	node, err = Parse(ctx, "refresh materialized view mv")
+	require.NoError(t, err)
+	require.Equal(t, plan.NewRefreshMaterializedView(sql.UnresolvedDatabase(""), "mv"), node)
+
+	node, err = Parse(ctx, "DROP MATERIALIZED VIEW IF EXISTS mydb.mv")
+	require.NoError(t, err)
+	require.Equal(t, plan.NewDropMaterializedView(sql.UnresolvedDatabase("mydb"), "mv", true), node)
+
+	_, err = Parse(ctx, "CREATE MATERIALIZED VIEW mv AS insert into t values (1)")
+	require.True(t, sql.ErrMaterializedViewNotQuery.Is(err), "unexpected error %v", err)
+	_, err = Parse(ctx, "CREATE MATERIALIZED VIEW mv REFRESH EVERY 0 SECOND AS select 1")
+	require.True(t, sql.ErrInvalidRefreshInterval.Is(err), "unexpected error %v", err)
+	_, err = Parse(ctx, "CREATE MATERIALIZED VIEW mv REFRESH EVERY 1 YEAR AS select 1")
+	require.True(t, sql.ErrInvalidRefreshInterval.Is(err), "unexpected error %v", err)
+	_, err = Parse(ctx, "REFRESH MATERIALIZED VIEW a, b")
+	require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+}
+
+func TestParseShowEngineStatus(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	for query, expected := range map[string]sql.Node{
+		"SHOW ENGINE GMS STATUS":       plan.NewShowEngineStatus("GMS"),
+		"show engine gms status;":      plan.NewShowEngineStatus("gms"),
+		"SHOW ENGINE `innodb` STATUS":  plan.NewShowEngineStatus("innodb"),
+		"  SHOW\nENGINE GMS\tSTATUS  ": plan.NewShowEngineStatus("GMS"),
+	} {
+		t.Run(query, func(t *testing.T) {
+			node, err := Parse(ctx, query)
+			require.NoError(t, err)
+			require.Equal(t, expected, node)
+		})
+	}
+}
+
+func TestParseShowProfile(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	limited := plan.NewShowProfile([]string{"CPU", "BLOCK IO"}, 3)
+	limited.Limit, limited.Offset = 2, 1
+	for query, expected := range map[string]sql.Node{
+		"SHOW PROFILES":            plan.NewShowProfiles(),
+		"show profiles;":           plan.NewShowProfiles(),
+		"SHOW PROFILE":             plan.NewShowProfile(nil, 0),
+		"show profile for query 2": plan.NewShowProfile(nil, 2),
+		"SHOW PROFILE ALL":         plan.NewShowProfile([]string{"ALL"}, 0),
+		"SHOW PROFILE cpu, block  io FOR QUERY 3 LIMIT 2 OFFSET 1": limited,
+	} {
+		t.Run(query, func(t *testing.T) {
+			node, err := Parse(ctx, query)
+			require.NoError(t, err)
+			require.Equal(t, expected, node)
+		})
+	}
+}
+
+func TestParseShowIndexBuilds(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	for _, query := range []string{"SHOW INDEX BUILDS", "show index  builds;"} {
+		node, err := Parse(ctx, query)
+		require.NoError(t, err)
+		require.Equal(t, plan.NewShowIndexBuilds(), node)
+	}
+}
+
+func TestParseTableMaintenance(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	for query, expected := range map[string]sql.Node{
+		"CHECKSUM TABLE t1":                              plan.NewChecksumTable([]sql.DbTable{{Table: "t1"}}, ""),
+		"checksum table t1, mydb.`t 2` quick":            plan.NewChecksumTable([]sql.DbTable{{Table: "t1"}, {Db: "mydb", Table: "t 2"}}, "QUICK"),
+		"CHECKSUM TABLE t1 EXTENDED;":                    plan.NewChecksumTable([]sql.DbTable{{Table: "t1"}}, "EXTENDED"),
+		"CHECK TABLE t1, t2":                             plan.NewCheckTable([]sql.DbTable{{Table: "t1"}, {Table: "t2"}}, nil),
+		"check table t1 for upgrade quick":               plan.NewCheckTable([]sql.DbTable{{Table: "t1"}}, []string{"FOR UPGRADE", "QUICK"}),
+		"OPTIMIZE TABLE t1":                              plan.NewOptimizeTable([]sql.DbTable{{Table: "t1"}}),
+		"optimize no_write_to_binlog tables mydb.t1, t2": plan.NewOptimizeTable([]sql.DbTable{{Db: "mydb", Table: "t1"}, {Table: "t2"}}),
+	} {
+		t.Run(query, func(t *testing.T) {
+			node, err := Parse(ctx, query)
+			require.NoError(t, err)
+			require.Equal(t, expected, node)
+		})
+	}
+
+	for _, query := range []string{
+		"CHECKSUM TABLE",
+		"CHECKSUM TABLE t1 QUICK EXTENDED",
+		"CHECK TABLE t1,",
+		"CHECK TABLE t1 FOR",
+		"CHECK TABLE t1 SLOW",
+		"OPTIMIZE TABLE t1 QUICK",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := Parse(ctx, query)
+			require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+		})
+	}
+}
+
+func TestParseOnlineSchemaChangeStatements(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	for query, equivalent := range map[string]string{
+		"INSERT LOW_PRIORITY IGNORE INTO t (a) SELECT a FROM s":                                  "INSERT IGNORE INTO t (a) SELECT a FROM s",
+		"insert /* copy */ high_priority into t values (1)":                                      "insert into t values (1)",
+		"INSERT DELAYED INTO t VALUES (1) ON DUPLICATE KEY UPDATE a = 2":                         "INSERT INTO t VALUES (1) ON DUPLICATE KEY UPDATE a = 2",
+		"REPLACE LOW_PRIORITY INTO t VALUES (1)":                                                 "REPLACE INTO t VALUES (1)",
+		"UPDATE LOW_PRIORITY IGNORE t SET a = 1":                                                 "UPDATE IGNORE t SET a = 1",
+		"CREATE TABLE t (a varchar(10) charset ascii not null)":                                  "CREATE TABLE t (a varchar(10) character set ascii not null)",
+		"ALTER TABLE t MODIFY a varchar(10) CHARSET utf8mb4 COLLATE utf8mb4_bin":                 "ALTER TABLE t MODIFY a varchar(10) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin",
+		"CREATE TABLE t (a text not null charset ascii, b char collate ascii_bin charset ascii)": "CREATE TABLE t (a text not null character set ascii, b char collate ascii_bin character set ascii)",
+	} {
+		t.Run(query, func(t *testing.T) {
+			node, err := Parse(ctx, query)
+			require.NoError(t, err)
+			expected, err := Parse(ctx, equivalent)
+			require.NoError(t, err)
+			require.Equal(t, expected, node)
+		})
+	}
+
+	for query, notes := range map[string]int{
+		"DELETE LOW_PRIORITY QUICK IGNORE FROM t WHERE a = 1": 2,
+		"delete ignore from t where a = 1":                    0,
+	} {
+		t.Run(query, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			node, err := Parse(ctx, query)
+			require.NoError(t, err)
+			require.Len(t, ctx.Warnings(), notes)
+			expected, err := Parse(sql.NewEmptyContext(), "DELETE FROM t WHERE a = 1")
+			require.NoError(t, err)
+			expected.(*plan.DeleteFrom).Ignore = true
+			require.Equal(t, expected, node)
+		})
+	}
+
+	// CHARSET isn't reserved, so it can name columns
+	for _, query := range []string{
+		"CREATE TABLE c1 (charset varchar(10))",
+		"CREATE TABLE c1 (a int, charset varchar(10) charset ascii, index (charset))",
+		"ALTER TABLE c1 MODIFY COLUMN charset varchar(10)",
+		"CREATE VIEW v AS SELECT charset c FROM c1",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := Parse(ctx, query)
+			require.NoError(t, err)
+		})
+	}
+
+	for _, query := range []string{
+		"SHOW SLAVE STATUS",
+		"show /* gh-ost */ slave status;",
+	} {
+		t.Run(query, func(t *testing.T) {
+			node, err := Parse(ctx, query)
+			require.NoError(t, err)
+			require.Equal(t, plan.NewShowSlaveStatus(), node)
+		})
+	}
+
+	for _, query := range []string{
+		"SHOW SLAVE HOSTS",
+		"SHOW SLAVE STATUS FOR CHANNEL c",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := Parse(ctx, query)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRenameTriggerTable(t *testing.T) {
+	for statement, expected := range map[string]string{
+		"create trigger trig after insert on t for each row insert into log values (new.a)":           "create trigger trig after insert on `t_old` for each row insert into log values (new.a)",
+		"CREATE TRIGGER `trig` AFTER DELETE ON `mydb`.`t` FOR EACH ROW DELETE FROM t2 WHERE a = 1":    "CREATE TRIGGER `trig` AFTER DELETE ON `mydb`.`t_old` FOR EACH ROW DELETE FROM t2 WHERE a = 1",
+		"create /* on t */ trigger trig before update on mydb . t for each row set new.a = 1":         "create /* on t */ trigger trig before update on mydb . `t_old` for each row set new.a = 1",
+		"create definer = `root`@`localhost` trigger trig before insert on t for each row set @x = 1": "create definer = `root`@`localhost` trigger trig before insert on `t_old` for each row set @x = 1",
+	} {
+		t.Run(statement, func(t *testing.T) {
+			renamed, err := RenameTriggerTable(statement, "t_old")
+			require.NoError(t, err)
+			require.Equal(t, expected, renamed)
+		})
+	}
+
+	_, err := RenameTriggerTable("create table t (a int)", "t_old")
+	require.True(t, sql.ErrTriggerCreateStatementInvalid.Is(err))
+}
+
+func TestParseSystemVersioning(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	node, err := Parse(ctx, "SELECT * FROM t FOR SYSTEM_TIME AS OF '2023-01-01'")
+	require.NoError(t, err)
+	expected, err := Parse(ctx, "SELECT * FROM t AS OF '2023-01-01'")
+	require.NoError(t, err)
+	require.Equal(t, expected, node)
+
+	node, err = Parse(ctx, `CREATE TABLE t (
+		a int primary key,
+		s timestamp(6) GENERATED ALWAYS AS ROW START,
+		e timestamp(6) GENERATED ALWAYS AS ROW END,
+		PERIOD FOR SYSTEM_TIME (s, e)
+	) WITH SYSTEM VERSIONING`)
+	require.NoError(t, err)
+	create, ok := node.(*plan.CreateTable)
+	require.True(t, ok)
+	require.Equal(t, []string{"a", "s", "e"}, []string{create.CreateSchema.Schema[0].Name, create.CreateSchema.Schema[1].Name, create.CreateSchema.Schema[2].Name})
+	require.True(t, create.CreateSchema.Schema[1].Nullable)
+	require.True(t, create.CreateSchema.Schema[2].Nullable)
+
+	for _, query := range []string{
+		"CREATE TABLE t (a int, s datetime GENERATED ALWAYS AS ROW START, e datetime GENERATED ALWAYS AS ROW END)",
+		"CREATE TABLE t (a int, s datetime GENERATED ALWAYS AS ROW START) WITH SYSTEM VERSIONING",
+		"CREATE TABLE t (a int, s datetime GENERATED ALWAYS AS ROW START, e datetime GENERATED ALWAYS AS ROW END, PERIOD FOR SYSTEM_TIME (e, s)) WITH SYSTEM VERSIONING",
+		"CREATE TABLE t (a int, s int GENERATED ALWAYS AS ROW START, e datetime GENERATED ALWAYS AS ROW END) WITH SYSTEM VERSIONING",
+	} {
+		_, err = Parse(ctx, query)
+		require.True(t, sql.ErrInvalidSystemVersioning.Is(err), "unexpected error %v for %s", err, query)
+	}
+}
+
+func TestParseDatabaseOptions(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	node, err := Parse(ctx, "CREATE DATABASE test CHARSET latin1 DEFAULT ENCRYPTION = 'Y'")
+	require.NoError(t, err)
+	createDb, ok := node.(*plan.CreateDB)
+	require.True(t, ok)
+	require.Equal(t, sql.Collation_latin1_swedish_ci, createDb.Collation)
+	require.NotNil(t, createDb.Encryption)
+	require.True(t, *createDb.Encryption)
+
+	node, err = Parse(ctx, "ALTER DATABASE test ENCRYPTION 'n'")
+	require.NoError(t, err)
+	alterDb, ok := node.(*plan.AlterDB)
+	require.True(t, ok)
+	require.Equal(t, sql.Collation_Unspecified, alterDb.Collation)
+	require.NotNil(t, alterDb.Encryption)
+	require.False(t, *alterDb.Encryption)
+
+	_, err = Parse(ctx, "CREATE DATABASE test ENCRYPTION 'yes'")
+	require.True(t, sql.ErrInvalidEncryptionOption.Is(err))
+}
+
+func TestParseSyntaxErrorPosition(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	_, err := Parse(ctx, "selec 1")
+	require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+	require.Equal(t, "syntax error at line 1, column 6 near 'selec'", err.Error())
+
+	_, err = Parse(ctx, "select *\nfrom t\nwhere a = = 1")
+	require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+	require.Contains(t, err.Error(), "at line 3, column 12")
+
+	// Positions in rewritten statements are reported in the statement as it was given
+	_, err = Parse(ctx, "create function f(x int) returns int\nreturn x +")
+	require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+	require.Contains(t, err.Error(), "at line 2, column 11")
+
+	// Including statements rewritten several times, with quoted tokens and comments around the edits
+	query := "alter view v as select /* big */ sql_big_result `a` from t group by `a` with rollup having"
+	_, err = Parse(ctx, query)
+	require.True(t, sql.ErrSyntaxError.Is(err), "unexpected error %v", err)
+	require.Contains(t, err.Error(), fmt.Sprintf("at line 1, column %d", len(query)+1))
+}
+
 func TestParseErrors(t *testing.T) {
 	for query, expectedError := range fixturesErrors {
 		t.Run(query, func(t *testing.T) {
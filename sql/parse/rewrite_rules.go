@@ -0,0 +1,314 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RewriteRule rewrites the statements matching a pattern into a replacement, like the rules of MySQL's Rewriter query
+// rewrite plugin. Each ? in the pattern matches a literal value, and each ? in the replacement is replaced with the
+// value matched by the ? in the same position of the pattern. Statements match a pattern when their tokens are the
+// same, so differences in whitespace, comments and the case of keywords and identifiers don't prevent a match.
+type RewriteRule struct {
+	// Pattern is the statement to rewrite, with ? in place of literal values
+	Pattern string
+	// PatternDatabase restricts the rule to statements executed in the named database, if set
+	PatternDatabase string
+	// Replacement is the statement to rewrite matching statements into
+	Replacement string
+}
+
+// RewriteRules is a set of rewrite rules. Its Rewrite method is a sql.PreParseRewriter, which rewrites statements with
+// the first rule they match. The rules may be replaced at any time, such as after reloading them from the table they're
+// stored in.
+type RewriteRules struct {
+	mu    sync.RWMutex
+	rules []compiledRewriteRule
+}
+
+// compiledRewriteRule is a rewrite rule with its pattern split into tokens.
+type compiledRewriteRule struct {
+	RewriteRule
+	pattern      []rewriteToken
+	placeholders int
+}
+
+// rewriteToken is a token of a statement. The value of literals is the text of the literal in a statement.
+type rewriteToken struct {
+	typ       int
+	val       string
+	isLiteral bool
+}
+
+// rewritePlaceholder is the type of the tokens of a ? in a pattern.
+const rewritePlaceholder = sqlparser.VALUE_ARG
+
+// NewRewriteRules returns a set of the rewrite rules given, or an error if any of them is invalid.
+func NewRewriteRules(rules ...RewriteRule) (*RewriteRules, error) {
+	r := &RewriteRules{}
+	if err := r.SetRules(rules...); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// SetRules replaces the rules of this set with the ones given. The rules aren't changed if any of them is invalid.
+func (r *RewriteRules) SetRules(rules ...RewriteRule) error {
+	compiled := make([]compiledRewriteRule, len(rules))
+	for i, rule := range rules {
+		pattern, err := tokenizeRewriteStatement(rule.Pattern)
+		if err != nil {
+			return sql.ErrInvalidRewriteRule.New(rule.Pattern, err.Error())
+		}
+		if len(pattern) == 0 {
+			return sql.ErrInvalidRewriteRule.New(rule.Pattern, "the pattern is empty")
+		}
+		placeholders := 0
+		for _, tok := range pattern {
+			if tok.typ == rewritePlaceholder {
+				placeholders++
+			}
+		}
+		if n := len(replacementPlaceholders(rule.Replacement)); n > placeholders {
+			return sql.ErrInvalidRewriteRule.New(rule.Pattern,
+				fmt.Sprintf("the replacement has %d parameter markers, but the pattern only has %d", n, placeholders))
+		}
+		compiled[i] = compiledRewriteRule{RewriteRule: rule, pattern: pattern, placeholders: placeholders}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules = compiled
+	return nil
+}
+
+// Rules returns the rules of this set.
+func (r *RewriteRules) Rules() []RewriteRule {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	rules := make([]RewriteRule, len(r.rules))
+	for i, rule := range r.rules {
+		rules[i] = rule.RewriteRule
+	}
+	return rules
+}
+
+// LoadRules replaces the rules of this set with the ones stored in the table given, which has the columns of MySQL's
+// query_rewrite.rewrite_rules table: pattern, pattern_database and replacement, along with an optional enabled column.
+// Rows whose enabled column is neither 'YES' nor true are skipped.
+func (r *RewriteRules) LoadRules(ctx *sql.Context, table sql.Table) error {
+	sch := table.Schema()
+	pattern, patternDb, replacement, enabled := sch.IndexOf("pattern", table.Name()), sch.IndexOf("pattern_database", table.Name()),
+		sch.IndexOf("replacement", table.Name()), sch.IndexOf("enabled", table.Name())
+	if pattern < 0 || replacement < 0 {
+		return sql.ErrInvalidRewriteRulesTable.New(table.Name())
+	}
+
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := sql.RowIterToRows(ctx, sch, sql.NewTableRowIter(ctx, table, partitions))
+	if err != nil {
+		return err
+	}
+
+	var rules []RewriteRule
+	for _, row := range rows {
+		if enabled >= 0 && !rewriteRuleEnabled(row[enabled]) {
+			continue
+		}
+		rule := RewriteRule{
+			Pattern:     rewriteRuleString(row[pattern]),
+			Replacement: rewriteRuleString(row[replacement]),
+		}
+		if patternDb >= 0 {
+			rule.PatternDatabase = rewriteRuleString(row[patternDb])
+		}
+		rules = append(rules, rule)
+	}
+	return r.SetRules(rules...)
+}
+
+// Rewrite rewrites the statement given with the first rule it matches, returning it unchanged if it matches none.
+// Statements that can't be tokenized are returned unchanged, so that parsing them reports the error.
+func (r *RewriteRules) Rewrite(ctx *sql.Context, query string) (string, error) {
+	r.mu.RLock()
+	rules := r.rules
+	r.mu.RUnlock()
+	if len(rules) == 0 {
+		return query, nil
+	}
+
+	tokens, err := tokenizeRewriteStatement(query)
+	if err != nil {
+		return query, nil
+	}
+	for _, rule := range rules {
+		if rule.PatternDatabase != "" && !strings.EqualFold(rule.PatternDatabase, ctx.GetCurrentDatabase()) {
+			continue
+		}
+		if values, ok := rule.match(tokens); ok {
+			return rule.replace(values), nil
+		}
+	}
+	return query, nil
+}
+
+// match returns the values of the literals matched by the placeholders of this rule's pattern, in order, and whether
+// the tokens given match the pattern.
+func (r compiledRewriteRule) match(tokens []rewriteToken) ([]string, bool) {
+	if len(tokens) != len(r.pattern) {
+		return nil, false
+	}
+	values := make([]string, 0, r.placeholders)
+	for i, tok := range r.pattern {
+		switch {
+		case tok.typ == rewritePlaceholder:
+			if !tokens[i].isLiteral {
+				return nil, false
+			}
+			values = append(values, tokens[i].val)
+		case tok.typ != tokens[i].typ || !strings.EqualFold(tok.val, tokens[i].val):
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+// replace returns this rule's replacement with its placeholders replaced by the values given.
+func (r compiledRewriteRule) replace(values []string) string {
+	var sb strings.Builder
+	last := 0
+	for i, pos := range replacementPlaceholders(r.Replacement) {
+		sb.WriteString(r.Replacement[last:pos])
+		sb.WriteString(values[i])
+		last = pos + 1
+	}
+	sb.WriteString(r.Replacement[last:])
+	return sb.String()
+}
+
+// replacementPlaceholders returns the positions of the placeholders of the replacement given. Question marks in string
+// literals and quoted identifiers aren't placeholders.
+func replacementPlaceholders(replacement string) []int {
+	var positions []int
+	var quote rune
+	for i, c := range replacement {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '?':
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
+
+// tokenizeRewriteStatement returns the tokens of the statement given, skipping comments and a trailing semicolon.
+// Literals are merged with a preceding minus sign, so that a placeholder matches negative numbers.
+func tokenizeRewriteStatement(query string) ([]rewriteToken, error) {
+	queryTokens, err := tokenizeQuery(strings.TrimSuffix(strings.TrimSpace(query), ";"))
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []rewriteToken
+	for _, t := range queryTokens {
+		literal, ok := rewriteLiteral(t.typ, []byte(t.val))
+		if !ok {
+			tokens = append(tokens, rewriteToken{typ: t.typ, val: t.val})
+			continue
+		}
+		// A minus sign is part of a number unless it follows an operand, in which case it's a subtraction
+		if n := len(tokens); n > 0 && tokens[n-1].typ == '-' && (t.typ == sqlparser.INTEGRAL || t.typ == sqlparser.FLOAT) &&
+			(n == 1 || !isRewriteOperand(tokens[n-2])) {
+			tokens = tokens[:n-1]
+			literal = "-" + literal
+		}
+		tokens = append(tokens, rewriteToken{typ: t.typ, val: literal, isLiteral: true})
+	}
+	return tokens, nil
+}
+
+// rewriteLiteral returns the text of the literal of the type and value given, and whether the token is a literal.
+func rewriteLiteral(typ int, val []byte) (string, bool) {
+	var expr sqlparser.Expr
+	switch typ {
+	case sqlparser.STRING:
+		expr = sqlparser.NewStrVal(val)
+	case sqlparser.INTEGRAL:
+		expr = sqlparser.NewIntVal(val)
+	case sqlparser.FLOAT:
+		expr = sqlparser.NewFloatVal(val)
+	case sqlparser.HEXNUM:
+		expr = sqlparser.NewHexNum(val)
+	case sqlparser.HEX:
+		expr = sqlparser.NewHexVal(val)
+	case sqlparser.BIT_LITERAL:
+		expr = sqlparser.NewBitVal(val)
+	case sqlparser.NULL:
+		return "NULL", true
+	case sqlparser.TRUE:
+		return "TRUE", true
+	case sqlparser.FALSE:
+		return "FALSE", true
+	default:
+		return "", false
+	}
+	return sqlparser.String(expr), true
+}
+
+// isRewriteOperand returns whether the token given ends an operand.
+func isRewriteOperand(tok rewriteToken) bool {
+	return tok.isLiteral || tok.typ == sqlparser.ID || tok.typ == ')' || tok.typ == rewritePlaceholder
+}
+
+func rewriteRuleEnabled(v interface{}) bool {
+	switch v := v.(type) {
+	case string:
+		return strings.EqualFold(v, "YES") || v == "1"
+	case bool:
+		return v
+	case nil:
+		return false
+	default:
+		return fmt.Sprint(v) == "1"
+	}
+}
+
+func rewriteRuleString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestRewriteRules(t *testing.T) {
+	rules, err := NewRewriteRules(
+		RewriteRule{Pattern: "SELECT * FROM orders WHERE customer = ?", Replacement: "SELECT * FROM orders WHERE customer = ? LIMIT 100"},
+		RewriteRule{Pattern: "select a from t where b = ? and c = ?", Replacement: "select a from t use index (bc) where b = ? and c = ?"},
+		RewriteRule{Pattern: "select ? from dual", PatternDatabase: "mydb", Replacement: "select ?, 'mydb?' from dual"},
+	)
+	require.NoError(t, err)
+
+	ctx := sql.NewEmptyContext()
+	ctx.SetCurrentDatabase("mydb")
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"SELECT * FROM orders WHERE customer = 5", "SELECT * FROM orders WHERE customer = 5 LIMIT 100"},
+		{"select *\n  from ORDERS /* comment */ where customer='it''s';", "SELECT * FROM orders WHERE customer = 'it\\'s' LIMIT 100"},
+		{"select * from orders where customer = -1.5", "SELECT * FROM orders WHERE customer = -1.5 LIMIT 100"},
+		{"select * from orders where customer = x'0A'", "SELECT * FROM orders WHERE customer = X'0A' LIMIT 100"},
+		{"select * from orders where customer = name", "select * from orders where customer = name"},
+		{"select * from orders where customer = 5 limit 1", "select * from orders where customer = 5 limit 1"},
+		{"select a from t where b = 1 and c = 'x'", "select a from t use index (bc) where b = 1 and c = 'x'"},
+		{"select 1 from dual", "select 1, 'mydb?' from dual"},
+		{"select 1 from", "select 1 from"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			rewritten, err := rules.Rewrite(ctx, tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rewritten)
+		})
+	}
+
+	ctx.SetCurrentDatabase("otherdb")
+	rewritten, err := rules.Rewrite(ctx, "select 1 from dual")
+	require.NoError(t, err)
+	require.Equal(t, "select 1 from dual", rewritten)
+
+	err = rules.SetRules(RewriteRule{Pattern: "select ? from dual", Replacement: "select ?, ? from dual"})
+	require.True(t, sql.ErrInvalidRewriteRule.Is(err))
+	require.Len(t, rules.Rules(), 3)
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+var selectModifiersRegex = regexp.MustCompile(`(?is)\b(SQL_SMALL_RESULT|SQL_BIG_RESULT|SQL_CACHE|SQL_NO_CACHE|SQL_CALC_FOUND_ROWS|STRAIGHT_JOIN)\b`)
+
+// selectModifierRanks are the positions of the SELECT modifiers the parser understands, in the order it expects them.
+var selectModifierRanks = map[int]int{
+	sqlparser.SQL_CACHE:           0,
+	sqlparser.SQL_NO_CACHE:        0,
+	sqlparser.ALL:                 1,
+	sqlparser.DISTINCT:            1,
+	sqlparser.SQL_CALC_FOUND_ROWS: 2,
+	sqlparser.STRAIGHT_JOIN:       3,
+}
+
+// rewriteSelectModifiers rewrites the modifiers following the SELECT keywords of the statement given, returning the
+// rewritten statement and the edits made to it. MySQL accepts them in any order, but the parser only accepts them in
+// a fixed order, and doesn't understand SQL_SMALL_RESULT and SQL_BIG_RESULT at all:
+//   - SQL_SMALL_RESULT and SQL_BIG_RESULT are removed. They advise MySQL about the size of the result of a GROUP BY or
+//     DISTINCT to choose between in-memory and on-disk temporary tables, and grouping always happens in memory.
+//   - The other modifiers are put in the order the parser expects them in.
+func rewriteSelectModifiers(query string) (string, queryEdits) {
+	if !selectModifiersRegex.MatchString(query) {
+		return query, nil
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return query, nil
+	}
+
+	// isModifier returns whether the token at index |i| is a SELECT modifier
+	isModifier := func(i int) bool {
+		switch tokens[i].typ {
+		case sqlparser.SQL_SMALL_RESULT, sqlparser.SQL_BIG_RESULT:
+			return true
+		default:
+			_, ok := selectModifierRanks[tokens[i].typ]
+			return ok
+		}
+	}
+
+	r := queryRewriter{query: query}
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].typ != sqlparser.SELECT {
+			continue
+		}
+		first := i + 1
+		last := first
+		for last < len(tokens) && isModifier(last) {
+			last++
+		}
+		if first == last {
+			continue
+		}
+
+		var modifiers []queryToken
+		changed := false
+		for _, token := range tokens[first:last] {
+			if _, ok := selectModifierRanks[token.typ]; !ok {
+				changed = true
+				continue
+			}
+			if len(modifiers) > 0 && selectModifierRanks[modifiers[len(modifiers)-1].typ] > selectModifierRanks[token.typ] {
+				changed = true
+			}
+			modifiers = append(modifiers, token)
+		}
+		i = last - 1
+		if !changed {
+			continue
+		}
+
+		sort.SliceStable(modifiers, func(a, b int) bool {
+			return selectModifierRanks[modifiers[a].typ] < selectModifierRanks[modifiers[b].typ]
+		})
+		words := make([]string, len(modifiers))
+		for j, modifier := range modifiers {
+			words[j] = modifier.val
+		}
+		r.replace(tokens[first].start, tokens[last-1].end, strings.Join(words, " "))
+	}
+	return r.rewritten()
+}
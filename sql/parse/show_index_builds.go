@@ -0,0 +1,33 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var showIndexBuildsRegex = regexp.MustCompile(`(?is)^\s*SHOW\s+INDEX\s+BUILDS\s*$`)
+
+// parseShowIndexBuilds parses a SHOW INDEX BUILDS statement, which is an extension of the engine. It returns a nil
+// node if the statement given isn't one.
+func parseShowIndexBuilds(query string) (sql.Node, error) {
+	if !showIndexBuildsRegex.MatchString(query) {
+		return nil, nil
+	}
+	return plan.NewShowIndexBuilds(), nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+const profileTypePattern = `(?:ALL|BLOCK\s+IO|CONTEXT\s+SWITCHES|CPU|IPC|MEMORY|PAGE\s+FAULTS|SOURCE|SWAPS)`
+
+var (
+	showProfilesRegex = regexp.MustCompile(`(?is)^\s*SHOW\s+PROFILES\s*$`)
+	showProfileRegex  = regexp.MustCompile(`(?is)^\s*SHOW\s+PROFILE` +
+		`((?:\s+` + profileTypePattern + `(?:\s*,\s*` + profileTypePattern + `)*)?)` +
+		`(?:\s+FOR\s+QUERY\s+(\d+))?` +
+		`(?:\s+LIMIT\s+(\d+)(?:\s+OFFSET\s+(\d+))?)?\s*$`)
+	whitespaceRegex = regexp.MustCompile(`\s+`)
+)
+
+// parseShowProfile parses a SHOW PROFILES or SHOW PROFILE statement, which the parser doesn't understand. It returns a
+// nil node if the statement given is neither.
+//
+//	SHOW PROFILES
+//	SHOW PROFILE [type [, type] ...] [FOR QUERY n] [LIMIT row_count [OFFSET offset]]
+func parseShowProfile(query string) (sql.Node, error) {
+	if showProfilesRegex.MatchString(query) {
+		return plan.NewShowProfiles(), nil
+	}
+	match := showProfileRegex.FindStringSubmatch(query)
+	if match == nil {
+		return nil, nil
+	}
+
+	var types []string
+	if strings.TrimSpace(match[1]) != "" {
+		for _, typ := range strings.Split(match[1], ",") {
+			types = append(types, strings.ToUpper(whitespaceRegex.ReplaceAllString(strings.TrimSpace(typ), " ")))
+		}
+	}
+
+	var queryID int64
+	var err error
+	if match[2] != "" {
+		if queryID, err = strconv.ParseInt(match[2], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	node := plan.NewShowProfile(types, queryID)
+	if match[3] != "" {
+		if node.Limit, err = strconv.ParseInt(match[3], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if match[4] != "" {
+		if node.Offset, err = strconv.ParseInt(match[4], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
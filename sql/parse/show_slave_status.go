@@ -0,0 +1,47 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var showSlaveStatusRegex = regexp.MustCompile(`(?is)^\s*SHOW\b.*\bSLAVE\b`)
+
+// parseShowSlaveStatus parses a SHOW SLAVE STATUS statement, which the parser doesn't understand. It returns a nil node
+// if the statement given isn't a SHOW SLAVE STATUS statement. Tools such as gh-ost comment their statements, so
+// comments between the keywords are allowed.
+//
+//	SHOW SLAVE STATUS
+func parseShowSlaveStatus(query string) (sql.Node, error) {
+	if !showSlaveStatusRegex.MatchString(query) {
+		return nil, nil
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, nil
+	}
+	tokens, ok := trimStatementEnd(tokens)
+	if !ok || len(tokens) != 3 || tokens[0].typ != sqlparser.SHOW || tokens[1].typ != sqlparser.SLAVE || tokens[2].typ != sqlparser.STATUS {
+		return nil, nil
+	}
+	return plan.NewShowSlaveStatus(), nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+var statementModifiersRegex = regexp.MustCompile(`(?is)\b(LOW_PRIORITY|HIGH_PRIORITY|DELAYED|QUICK|IGNORE)\b`)
+
+// deleteIgnoreComment is the comment that the IGNORE modifier of DELETE statements is replaced with. The parser keeps
+// the comments following the DELETE keyword, so the statements with the comment are converted to DELETE IGNORE.
+const deleteIgnoreComment = "/*+ IGNORE */"
+
+// rewriteStatementModifiers removes the modifiers following the INSERT, REPLACE, UPDATE and DELETE keywords of the
+// statement given that the parser doesn't understand, returning the rewritten statement, the edits made to it and the
+// modifiers removed, which have no effect:
+//   - LOW_PRIORITY, HIGH_PRIORITY and DELAYED schedule statements with respect to the other statements using their
+//     table, and only affect storage engines with table-level locking. DELAYED has been ignored by MySQL since 5.7.
+//   - QUICK advises MyISAM not to merge index leaves when deleting rows.
+//
+// The IGNORE modifier of DELETE statements is replaced with deleteIgnoreComment. The IGNORE modifier of INSERT,
+// REPLACE and UPDATE statements is kept, since the parser understands it.
+func rewriteStatementModifiers(query string) (string, queryEdits, []queryToken) {
+	if !statementModifiersRegex.MatchString(query) {
+		return query, nil, nil
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return query, nil, nil
+	}
+
+	// isRemoved returns whether the token at index |i| is a modifier of the statement starting with a token of type
+	// |stmt| that the parser doesn't understand
+	isRemoved := func(stmt int, i int) bool {
+		switch stmt {
+		case sqlparser.INSERT, sqlparser.REPLACE:
+			switch tokens[i].typ {
+			case sqlparser.LOW_PRIORITY, sqlparser.HIGH_PRIORITY, sqlparser.DELAYED:
+				return true
+			}
+		case sqlparser.UPDATE:
+			return tokens[i].typ == sqlparser.LOW_PRIORITY
+		case sqlparser.DELETE:
+			switch tokens[i].typ {
+			case sqlparser.LOW_PRIORITY, sqlparser.IGNORE:
+				return true
+			case sqlparser.ID:
+				return strings.EqualFold(tokens[i].val, "quick")
+			}
+		}
+		return false
+	}
+	// isModifier returns whether the token at index |i| is a modifier of the statement starting with a token of type
+	// |stmt|, whether the parser understands it or not
+	isModifier := func(stmt int, i int) bool {
+		return isRemoved(stmt, i) || (tokens[i].typ == sqlparser.IGNORE && stmt != sqlparser.DELETE)
+	}
+
+	r := queryRewriter{query: query}
+	var removed []queryToken
+	for i := 0; i < len(tokens); i++ {
+		stmt := tokens[i].typ
+		switch stmt {
+		case sqlparser.INSERT, sqlparser.REPLACE, sqlparser.UPDATE, sqlparser.DELETE:
+		default:
+			continue
+		}
+		// The UPDATE of an ON DUPLICATE KEY UPDATE clause isn't followed by modifiers
+		if stmt == sqlparser.UPDATE && i > 0 && tokens[i-1].typ == sqlparser.KEY {
+			continue
+		}
+		for i++; i < len(tokens) && isModifier(stmt, i); i++ {
+			if !isRemoved(stmt, i) {
+				continue
+			}
+			if tokens[i].typ == sqlparser.IGNORE {
+				r.replace(tokens[i].start, tokens[i].end, deleteIgnoreComment)
+				continue
+			}
+			r.replace(tokens[i].start, tokens[i].end, "")
+			removed = append(removed, tokens[i])
+		}
+		i--
+	}
+	rewritten, edits := r.rewritten()
+	return rewritten, edits, removed
+}
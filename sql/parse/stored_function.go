@@ -0,0 +1,182 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var storedFunctionRegex = regexp.MustCompile(`(?is)^\s*(CREATE|DROP|SHOW)\s.*?\bFUNCTION\b`)
+
+// returnTypeWords are the words that may follow the name of the return type of a stored function, as part of the type.
+var returnTypeWords = map[string]bool{
+	"UNSIGNED": true, "SIGNED": true, "ZEROFILL": true, "BINARY": true, "PRECISION": true, "VARYING": true,
+	"CHAR": true, "CHARACTER": true, "VARCHAR": true, "VARCHARACTER": true, "VARBINARY": true, "ASCII": true,
+	"UNICODE": true,
+}
+
+// storedFunction is a statement about a stored function that was rewritten to be about a stored procedure, so that
+// the parser understands it.
+type storedFunction struct {
+	// returnType is the type of the RETURNS clause of a CREATE FUNCTION statement, which was removed from it
+	returnType string
+}
+
+// rewriteStoredFunction rewrites the statements about stored functions that the parser doesn't understand to be about
+// stored procedures, returning the rewritten statement, the edits made to it, and the *storedFunction that converts
+// the node of the rewritten statement back. It returns a nil *storedFunction for other statements.
+//
+//	CREATE [DEFINER = user] FUNCTION name ([param type [, ...]]) RETURNS type [characteristic ...] body
+//	DROP FUNCTION [IF EXISTS] name
+//	SHOW CREATE FUNCTION name
+//
+// SHOW FUNCTION STATUS is understood by the parser.
+func rewriteStoredFunction(query string) (string, queryEdits, *storedFunction, error) {
+	if !storedFunctionRegex.MatchString(query) {
+		return query, nil, nil, nil
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		// The parser reports the error
+		return query, nil, nil, nil
+	}
+
+	function := -1
+	switch {
+	case len(tokens) > 2 && tokens[0].typ == sqlparser.CREATE && tokens[1].typ == sqlparser.FUNCTION:
+		function = 1
+	case len(tokens) > 2 && tokens[0].typ == sqlparser.CREATE && tokens[1].typ == sqlparser.DEFINER:
+		// The definer is a user name and host, or CURRENT_USER
+		for i := 2; i < len(tokens) && i < 10; i++ {
+			if tokens[i].typ == sqlparser.FUNCTION {
+				function = i
+				break
+			}
+			if tokens[i].typ == sqlparser.PROCEDURE || tokens[i].typ == sqlparser.TRIGGER || tokens[i].typ == sqlparser.VIEW {
+				break
+			}
+		}
+	case len(tokens) > 2 && tokens[0].typ == sqlparser.DROP && tokens[1].typ == sqlparser.FUNCTION:
+		function = 1
+	case len(tokens) > 3 && tokens[0].typ == sqlparser.SHOW && tokens[1].typ == sqlparser.CREATE && tokens[2].typ == sqlparser.FUNCTION:
+		function = 2
+	}
+	if function < 0 {
+		return query, nil, nil, nil
+	}
+
+	r := queryRewriter{query: query}
+	r.replace(tokens[function].start, tokens[function].end, "PROCEDURE")
+	if tokens[0].typ != sqlparser.CREATE {
+		rewritten, edits := r.rewritten()
+		return rewritten, edits, &storedFunction{}, nil
+	}
+
+	// The RETURNS clause follows the parameters
+	i := function + 1
+	for i < len(tokens) && tokens[i].typ != '(' {
+		i++
+	}
+	for depth := 0; i < len(tokens); i++ {
+		if tokens[i].typ == '(' {
+			depth++
+		} else if tokens[i].typ == ')' {
+			if depth--; depth == 0 {
+				break
+			}
+		}
+	}
+	i++
+	if i+1 >= len(tokens) || tokens[i].typ != sqlparser.ID || tokens[i].word() != "RETURNS" {
+		return "", nil, nil, sql.ErrSyntaxError.New("expected RETURNS clause after the parameters of CREATE FUNCTION")
+	}
+	returns := i
+
+	// The return type is a type name, which may be followed by a parenthesized length or list of values, and by
+	// attributes such as UNSIGNED, CHARACTER SET and COLLATE
+	i++
+	typeStart, typeEnd := tokens[i].start, tokens[i].end
+	// Column definitions, which the return type is parsed as, don't accept the CHARSET synonym of CHARACTER SET
+	var charsets []queryToken
+	for i++; i < len(tokens); i++ {
+		word := tokens[i].word()
+		switch {
+		case tokens[i].typ == '(':
+			for depth := 0; i < len(tokens); i++ {
+				if tokens[i].typ == '(' {
+					depth++
+				} else if tokens[i].typ == ')' {
+					if depth--; depth == 0 {
+						break
+					}
+				}
+			}
+			if i == len(tokens) {
+				return "", nil, nil, sql.ErrSyntaxError.New("unclosed parenthesis in RETURNS clause of CREATE FUNCTION")
+			}
+		case word == "CHARACTER" && i+2 < len(tokens) && tokens[i+1].typ == sqlparser.SET:
+			i += 2
+		case word == "CHARSET" || word == "COLLATE":
+			if word == "CHARSET" {
+				charsets = append(charsets, tokens[i])
+			}
+			if i+1 >= len(tokens) {
+				return "", nil, nil, sql.ErrSyntaxError.New("unexpected end of RETURNS clause of CREATE FUNCTION")
+			}
+			i++
+		case returnTypeWords[word]:
+		default:
+			r.replace(tokens[returns].start, typeEnd, "")
+			var returnType strings.Builder
+			copied := typeStart
+			for _, charset := range charsets {
+				returnType.WriteString(query[copied:charset.start])
+				returnType.WriteString("CHARACTER SET")
+				copied = charset.end
+			}
+			returnType.WriteString(query[copied:typeEnd])
+			rewritten, edits := r.rewritten()
+			return rewritten, edits, &storedFunction{returnType: returnType.String()}, nil
+		}
+		typeEnd = tokens[i].end
+	}
+	return "", nil, nil, sql.ErrSyntaxError.New("expected body of CREATE FUNCTION")
+}
+
+// convert converts the node given, of the statement rewritten to be about a stored procedure, to be about a stored
+// function.
+func (f *storedFunction) convert(ctx *sql.Context, node sql.Node) (sql.Node, error) {
+	switch n := node.(type) {
+	case *plan.CreateProcedure:
+		returnType, err := ParseColumnTypeString(ctx, f.returnType)
+		if err != nil {
+			return nil, err
+		}
+		return n.WithReturnType(returnType), nil
+	case *plan.DropProcedure:
+		return plan.NewDropFunction(n.Database(), n.ProcedureName, n.IfExists), nil
+	case *plan.ShowCreateProcedure:
+		return plan.NewShowCreateFunction(n.Database(), n.ProcedureName), nil
+	default:
+		return node, nil
+	}
+}
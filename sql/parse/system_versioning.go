@@ -0,0 +1,125 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var systemVersioningRegex = regexp.MustCompile(`(?is)\bsystem_time\b|\bsystem\s+versioning\b|\bgenerated\s+always\s+as\s+row\b`)
+
+// rewriteSystemVersioning removes the clauses of system-versioned tables that the parser doesn't understand from the
+// statement given, returning the rewritten statement, the edits made to it, and the system time period of the table
+// created by a CREATE TABLE ... WITH SYSTEM VERSIONING statement, if that's what it is:
+//   - FOR SYSTEM_TIME AS OF of a table reference becomes AS OF.
+//   - The GENERATED ALWAYS AS ROW START and ROW END clauses of column definitions are removed, and name the columns
+//     of the period.
+//   - PERIOD FOR SYSTEM_TIME (start, end) table elements and WITH SYSTEM VERSIONING table options are removed.
+func rewriteSystemVersioning(query string) (string, queryEdits, *sql.SystemTimePeriod, error) {
+	if !systemVersioningRegex.MatchString(query) {
+		return query, nil, nil, nil
+	}
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return query, nil, nil, nil
+	}
+	// is returns whether the tokens starting at index |i| are the words given
+	is := func(i int, words ...string) bool {
+		if i+len(words) > len(tokens) {
+			return false
+		}
+		for j, word := range words {
+			if !strings.EqualFold(tokens[i+j].word(), word) {
+				return false
+			}
+		}
+		return true
+	}
+
+	r := queryRewriter{query: query}
+	// remove removes the tokens from index |from| to index |to|, inclusive.
+	remove := func(from, to int) {
+		r.replace(tokens[from].start, tokens[to].end, "")
+	}
+
+	isCreateTable := is(0, "create", "table") || is(0, "create", "temporary", "table")
+	var period sql.SystemTimePeriod
+	var periodStart, periodEnd, column string
+	withVersioning := false
+	depth := 0
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i].typ {
+		case '(':
+			depth++
+			if isCreateTable && depth == 1 && i+1 < len(tokens) {
+				column = tokens[i+1].val
+			}
+			continue
+		case ')':
+			depth--
+			continue
+		case ',':
+			if isCreateTable && depth == 1 && i+1 < len(tokens) {
+				column = tokens[i+1].val
+			}
+		}
+
+		switch {
+		case is(i, "for", "system_time", "as", "of"):
+			r.replace(tokens[i].start, tokens[i+2].start, "")
+			i += 3
+		case isCreateTable && depth == 1 && is(i, "generated", "always", "as", "row") &&
+			(is(i+4, "start") || is(i+4, "end")):
+			if strings.EqualFold(tokens[i+4].val, "start") {
+				period.Start = column
+			} else {
+				period.End = column
+			}
+			remove(i, i+4)
+			i += 4
+		case isCreateTable && depth == 1 && i > 0 && tokens[i-1].typ == ',' && is(i, "period", "for", "system_time") &&
+			i+7 < len(tokens) && tokens[i+3].typ == '(' && tokens[i+5].typ == ',' && tokens[i+7].typ == ')':
+			periodStart, periodEnd = tokens[i+4].val, tokens[i+6].val
+			remove(i-1, i+7)
+			i += 7
+		case isCreateTable && depth == 0 && is(i, "with", "system", "versioning"):
+			withVersioning = true
+			remove(i, i+2)
+			i += 2
+		}
+	}
+	rewritten, edits := r.rewritten()
+	if len(edits) == 0 {
+		return query, nil, nil, nil
+	}
+
+	if !isCreateTable || (!withVersioning && period == sql.SystemTimePeriod{} && periodStart == "") {
+		return rewritten, edits, nil, nil
+	}
+	if !withVersioning {
+		return "", nil, nil, sql.ErrInvalidSystemVersioning.New("the table must be created WITH SYSTEM VERSIONING")
+	}
+	if period.Start == "" || period.End == "" {
+		return "", nil, nil, sql.ErrInvalidSystemVersioning.New("the table must have GENERATED ALWAYS AS ROW START and ROW END columns")
+	}
+	if periodStart != "" && (!strings.EqualFold(periodStart, period.Start) || !strings.EqualFold(periodEnd, period.End)) {
+		return "", nil, nil, sql.ErrInvalidSystemVersioning.New("PERIOD FOR SYSTEM_TIME must name the ROW START and ROW END columns")
+	}
+	return rewritten, edits, &period, nil
+}
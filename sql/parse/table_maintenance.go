@@ -0,0 +1,112 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var (
+	checksumTableRegex = regexp.MustCompile(`(?is)^\s*CHECKSUM\s+TABLES?(?:[\s;]|$)`)
+	checkTableRegex    = regexp.MustCompile(`(?is)^\s*CHECK\s+TABLES?(?:[\s;]|$)`)
+	optimizeTableRegex = regexp.MustCompile(`(?is)^\s*OPTIMIZE\s+(?:(?:NO_WRITE_TO_BINLOG|LOCAL)\s+)?TABLES?(?:[\s;]|$)`)
+)
+
+// parseTableMaintenance parses the CHECKSUM TABLE, CHECK TABLE and OPTIMIZE TABLE statements, which the parser
+// doesn't understand. It returns a nil node if the statement given isn't one of them.
+//
+//	CHECKSUM TABLE tbl_name [, tbl_name] ... [QUICK | EXTENDED]
+//	CHECK TABLE tbl_name [, tbl_name] ... [option] ...
+//	  option: {FOR UPGRADE | QUICK | FAST | MEDIUM | EXTENDED | CHANGED}
+//	OPTIMIZE [NO_WRITE_TO_BINLOG | LOCAL] TABLE tbl_name [, tbl_name] ...
+func parseTableMaintenance(query string) (sql.Node, error) {
+	switch {
+	case checksumTableRegex.MatchString(query):
+		tables, options, err := parseMaintenanceTables(query, "CHECKSUM TABLE", 2)
+		if err != nil {
+			return nil, err
+		}
+		if len(options) > 1 || (len(options) == 1 && options[0] != "QUICK" && options[0] != "EXTENDED") {
+			return nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected %q in CHECKSUM TABLE", strings.Join(options, " ")))
+		}
+		return plan.NewChecksumTable(tables, strings.Join(options, "")), nil
+	case checkTableRegex.MatchString(query):
+		tables, words, err := parseMaintenanceTables(query, "CHECK TABLE", 2)
+		if err != nil {
+			return nil, err
+		}
+		var options []string
+		for i := 0; i < len(words); i++ {
+			switch words[i] {
+			case "QUICK", "FAST", "MEDIUM", "EXTENDED", "CHANGED":
+				options = append(options, words[i])
+			case "FOR":
+				if i+1 == len(words) || words[i+1] != "UPGRADE" {
+					return nil, sql.ErrSyntaxError.New("expected UPGRADE after FOR in CHECK TABLE")
+				}
+				options = append(options, "FOR UPGRADE")
+				i++
+			default:
+				return nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected %q in CHECK TABLE", words[i]))
+			}
+		}
+		return plan.NewCheckTable(tables, options), nil
+	case optimizeTableRegex.MatchString(query):
+		skip := 2
+		if fields := strings.Fields(query); !strings.HasPrefix(strings.ToUpper(fields[1]), "TABLE") {
+			skip = 3
+		}
+		tables, options, err := parseMaintenanceTables(query, "OPTIMIZE TABLE", skip)
+		if err != nil {
+			return nil, err
+		}
+		if len(options) > 0 {
+			return nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected %q in OPTIMIZE TABLE", options[0]))
+		}
+		return plan.NewOptimizeTable(tables), nil
+	default:
+		return nil, nil
+	}
+}
+
+// parseMaintenanceTables parses the list of tables of the table maintenance statement given, after skipping its
+// first tokens, and returns them with the upper-cased words following the list.
+func parseMaintenanceTables(query, stmt string, skip int) ([]sql.DbTable, []string, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, nil, sql.ErrSyntaxError.New(fmt.Sprintf("%s in %s", err, stmt))
+	}
+	tokens, ok := trimStatementEnd(tokens)
+	if !ok {
+		return nil, nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected statement after %s", stmt))
+	}
+
+	tables, tokens, err := parseTableNames(query, tokens[skip:], stmt)
+	if err != nil {
+		return nil, nil, err
+	}
+	words := make([]string, len(tokens))
+	for i, t := range tokens {
+		if words[i] = t.word(); words[i] == "" {
+			return nil, nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected %q in %s", query[t.start:t.end], stmt))
+		}
+	}
+	return tables, words, nil
+}
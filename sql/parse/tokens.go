@@ -0,0 +1,175 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// The parser doesn't understand some statements and clauses. Statements that it doesn't understand at all are parsed
+// from their tokens, and statements with clauses that it doesn't understand are rewritten before parsing them. The
+// edits made by those rewrites are recorded, so that positions in the rewritten statement can be mapped back to the
+// statement as written.
+
+// queryToken is a token of a statement, other than a comment. Its value is the one returned by the tokenizer, so
+// identifiers and strings are unquoted, and punctuation has no value. It starts at position |start| of the statement
+// and ends at position |end|, so quotes are included in that range.
+type queryToken struct {
+	typ        int
+	val        string
+	start, end int
+}
+
+// word returns the upper-cased keyword or identifier of this token, or an empty string if it's a literal or
+// punctuation.
+func (t queryToken) word() string {
+	switch t.typ {
+	case sqlparser.STRING, sqlparser.INTEGRAL, sqlparser.FLOAT, sqlparser.HEX, sqlparser.HEXNUM,
+		sqlparser.BIT_LITERAL:
+		return ""
+	}
+	return strings.ToUpper(t.val)
+}
+
+// lexError is the error returned when a statement can't be tokenized.
+type lexError struct {
+	val string
+}
+
+func (e lexError) Error() string {
+	return fmt.Sprintf("unexpected %q", e.val)
+}
+
+// tokenizeQuery returns the tokens of the statement given, skipping comments. Returns a lexError if the statement
+// can't be tokenized.
+func tokenizeQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	tkn := sqlparser.NewStringTokenizer(query)
+	// prevEnd is the end of the previous token, including comments
+	prevEnd := 0
+	for {
+		typ, val := tkn.Scan()
+		switch typ {
+		case 0:
+			return tokens, nil
+		case sqlparser.LEX_ERROR:
+			return nil, lexError{val: string(val)}
+		}
+
+		end := tkn.Position - 1
+		start := prevEnd
+		for start < end && isSpace(query[start]) {
+			start++
+		}
+		if start > end {
+			// The tokens of MySQL-specific comments are positioned within the comment
+			start = end - len(val)
+		}
+		prevEnd = end
+		if typ != sqlparser.COMMENT {
+			tokens = append(tokens, queryToken{typ: typ, val: string(val), start: start, end: end})
+		}
+	}
+}
+
+// trimStatementEnd returns the tokens given without the semicolon ending their statement, if any. Returns false if
+// another statement follows it.
+func trimStatementEnd(tokens []queryToken) ([]queryToken, bool) {
+	for i, t := range tokens {
+		if t.typ == ';' {
+			return tokens[:i], i == len(tokens)-1
+		}
+	}
+	return tokens, true
+}
+
+// isSpace returns whether the character given is whitespace between the tokens of a statement.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// queryEdit is a change made to a statement before parsing it: |delta| characters were inserted, or removed if it's
+// negative, ending at position |pos| of the rewritten statement.
+type queryEdit struct {
+	pos, delta int
+}
+
+type queryEdits []queryEdit
+
+// originalPosition returns the position in the statement before it was rewritten that corresponds to position |pos|
+// of the rewritten statement.
+func (e queryEdits) originalPosition(pos int) int {
+	original := pos
+	for _, edit := range e {
+		if edit.pos > pos {
+			break
+		}
+		original -= edit.delta
+	}
+	return original
+}
+
+// queryRewrites are the edits made to a statement by each of the rewrites applied to it, in the order they were
+// applied.
+type queryRewrites []queryEdits
+
+// originalPosition returns the position in the statement before any of the rewrites that corresponds to position
+// |pos| of the statement after all of them.
+func (r queryRewrites) originalPosition(pos int) int {
+	for i := len(r) - 1; i >= 0; i-- {
+		pos = r[i].originalPosition(pos)
+	}
+	return pos
+}
+
+// changed returns whether any of the rewrites edited the statement.
+func (r queryRewrites) changed() bool {
+	for _, edits := range r {
+		if len(edits) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// queryRewriter rewrites a statement by replacing parts of it, in order, and records the edits made.
+type queryRewriter struct {
+	query  string
+	sb     strings.Builder
+	copied int
+	edits  queryEdits
+}
+
+// replace replaces the characters of the statement from position |from| to position |to| with the text given.
+// Replacements must be made in order, and mustn't overlap.
+func (r *queryRewriter) replace(from, to int, text string) {
+	r.sb.WriteString(r.query[r.copied:from])
+	r.sb.WriteString(text)
+	r.copied = to
+	r.edits = append(r.edits, queryEdit{pos: r.sb.Len(), delta: len(text) - (to - from)})
+}
+
+// rewritten returns the rewritten statement and the edits made to it. The statement is returned unchanged if no
+// replacements were made.
+func (r *queryRewriter) rewritten() (string, queryEdits) {
+	if len(r.edits) == 0 {
+		return r.query, nil
+	}
+	r.sb.WriteString(r.query[r.copied:])
+	return r.sb.String(), r.edits
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RenameTriggerTable returns the CREATE TRIGGER statement given with the table of its trigger renamed to the name
+// given. The rest of the statement, including the database qualifying the table, is unchanged. It's used to move the
+// triggers of renamed tables to their new names, like MySQL does.
+func RenameTriggerTable(createStatement string, table string) (string, error) {
+	tokens, err := tokenizeQuery(createStatement)
+	if err != nil {
+		return "", sql.ErrTriggerCreateStatementInvalid.New(createStatement)
+	}
+
+	seenTrigger := false
+	for i, t := range tokens {
+		if t.typ == sqlparser.TRIGGER {
+			seenTrigger = true
+		}
+		if t.typ != sqlparser.ON || !seenTrigger {
+			continue
+		}
+		// The table name is an identifier, optionally qualified by the name of its database
+		name := i + 1
+		if name+2 < len(tokens) && tokens[name+1].typ == '.' {
+			name += 2
+		}
+		if name >= len(tokens) || tokens[i+1].typ != sqlparser.ID || tokens[name].typ != sqlparser.ID {
+			break
+		}
+		quoted := "`" + strings.ReplaceAll(table, "`", "``") + "`"
+		return createStatement[:tokens[name].start] + quoted + createStatement[tokens[name].end:], nil
+	}
+	return "", sql.ErrTriggerCreateStatementInvalid.New(createStatement)
+}
@@ -0,0 +1,189 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var xaRegex = regexp.MustCompile(`(?is)^\s*XA\s`)
+
+// maxXIDPartLength is the maximum length of the gtrid and bqual values of an XID.
+const maxXIDPartLength = 64
+
+// xaToken is a token of an XA statement. Keywords are upper case, and string literals are unquoted.
+type xaToken struct {
+	val      string
+	isString bool
+}
+
+// parseXA parses an XA statement, which the parser doesn't understand. It returns a nil node if the statement given
+// isn't an XA statement.
+//
+//	XA {START|BEGIN} xid [JOIN|RESUME]
+//	XA END xid [SUSPEND [FOR MIGRATE]]
+//	XA PREPARE xid
+//	XA COMMIT xid [ONE PHASE]
+//	XA ROLLBACK xid
+//	XA RECOVER [CONVERT XID]
+//
+//	xid: gtrid [, bqual [, formatID]]
+func parseXA(query string) (sql.Node, error) {
+	if !xaRegex.MatchString(query) {
+		return nil, nil
+	}
+	tokens, err := xaTokens(query)
+	if err != nil {
+		return nil, err
+	}
+	// The first token is the XA keyword
+	tokens = tokens[1:]
+	if len(tokens) == 0 {
+		return nil, sql.ErrSyntaxError.New("expected XA statement")
+	}
+
+	var action plan.XAAction
+	switch tokens[0].val {
+	case "START", "BEGIN":
+		action = plan.XAStart
+	case "END":
+		action = plan.XAEnd
+	case "PREPARE":
+		action = plan.XAPrepare
+	case "COMMIT":
+		action = plan.XACommit
+	case "ROLLBACK":
+		action = plan.XARollback
+	case "RECOVER":
+		node := plan.NewXA(plan.XARecover, sql.XID{})
+		switch rest := xaWords(tokens[1:]); rest {
+		case "":
+		case "CONVERT XID":
+			node.ConvertXID = true
+		default:
+			return nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected %q in XA RECOVER", rest))
+		}
+		return node, nil
+	default:
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("unknown XA statement %s", tokens[0].val))
+	}
+
+	xid, rest, err := parseXID(tokens[1:])
+	if err != nil {
+		return nil, err
+	}
+	node := plan.NewXA(action, xid)
+
+	// The JOIN, RESUME, SUSPEND and FOR MIGRATE clauses are accepted, but have no effect, as in MySQL
+	switch words := xaWords(rest); {
+	case words == "":
+	case action == plan.XAStart && (words == "JOIN" || words == "RESUME"):
+	case action == plan.XAEnd && (words == "SUSPEND" || words == "SUSPEND FOR MIGRATE"):
+	case action == plan.XACommit && words == "ONE PHASE":
+		node.OnePhase = true
+	default:
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("unexpected %q in XA %s", words, action))
+	}
+	return node, nil
+}
+
+// parseXID parses the XID at the start of the tokens given, and returns it along with the remaining tokens.
+func parseXID(tokens []xaToken) (sql.XID, []xaToken, error) {
+	var xid sql.XID
+	var parts []string
+	for len(parts) < 3 {
+		if len(tokens) == 0 {
+			return xid, nil, sql.ErrSyntaxError.New("expected XID")
+		}
+		if len(parts) < 2 && !tokens[0].isString {
+			return xid, nil, sql.ErrSyntaxError.New(fmt.Sprintf("expected string in XID, found %s", tokens[0].val))
+		}
+		parts = append(parts, tokens[0].val)
+		tokens = tokens[1:]
+		if len(tokens) == 0 || tokens[0].val != "," || tokens[0].isString {
+			break
+		}
+		tokens = tokens[1:]
+	}
+
+	xid.Gtrid = parts[0]
+	if len(parts) > 1 {
+		xid.Bqual = parts[1]
+	}
+	if len(xid.Gtrid) == 0 || len(xid.Gtrid) > maxXIDPartLength || len(xid.Bqual) > maxXIDPartLength {
+		return xid, nil, sql.ErrSyntaxError.New("XID gtrid must be 1 to 64 bytes long, and bqual at most 64 bytes long")
+	}
+	xid.FormatID = 1
+	if len(parts) > 2 {
+		formatID, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil {
+			return xid, nil, sql.ErrSyntaxError.New(fmt.Sprintf("invalid XID format ID %s", parts[2]))
+		}
+		xid.FormatID = formatID
+	}
+	return xid, tokens, nil
+}
+
+// xaTokens returns the tokens of the XA statement given, with hexadecimal literals decoded as strings.
+func xaTokens(query string) ([]xaToken, error) {
+	queryTokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("%s in XA statement", err))
+	}
+	queryTokens, ok := trimStatementEnd(queryTokens)
+	if !ok {
+		return nil, sql.ErrSyntaxError.New("unexpected statement after XA statement")
+	}
+
+	tokens := make([]xaToken, len(queryTokens))
+	for i, t := range queryTokens {
+		switch t.typ {
+		case sqlparser.STRING:
+			tokens[i] = xaToken{val: t.val, isString: true}
+		case sqlparser.HEX, sqlparser.HEXNUM:
+			decoded, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(t.val, "0x"), "0X"))
+			if err != nil {
+				return nil, sql.ErrSyntaxError.New(fmt.Sprintf("invalid hexadecimal literal %s in XID", t.val))
+			}
+			tokens[i] = xaToken{val: string(decoded), isString: true}
+		default:
+			val := t.val
+			if val == "" {
+				// Punctuation has no value
+				val = query[t.start:t.end]
+			}
+			tokens[i] = xaToken{val: strings.ToUpper(val)}
+		}
+	}
+	return tokens, nil
+}
+
+// xaWords returns the values of the tokens given joined by spaces.
+func xaWords(tokens []xaToken) string {
+	words := make([]string, len(tokens))
+	for i, t := range tokens {
+		words[i] = t.val
+	}
+	return strings.Join(words, " ")
+}
@@ -152,6 +152,10 @@ func (p *AlterIndex) Execute(ctx *sql.Context) error {
 			seenCols[col.Name] = false
 		}
 		for _, indexCol := range p.Columns {
+			// The expressions of functional key parts were resolved against the table during analysis
+			if indexCol.Expression != nil {
+				continue
+			}
 			if seen, ok := seenCols[indexCol.Name]; ok {
 				if !seen {
 					seenCols[indexCol.Name] = true
@@ -189,13 +193,20 @@ func (p *AlterIndex) Execute(ctx *sql.Context) error {
 				}
 			}
 		}
-		err = indexable.CreateIndex(ctx, sql.IndexDef{
+		indexDef := sql.IndexDef{
 			Name:       indexName,
 			Columns:    p.Columns,
 			Constraint: p.Constraint,
 			Storage:    p.Using,
 			Comment:    p.Comment,
-		})
+		}
+		if builder, ok := indexable.(sql.OnlineIndexBuilder); ok {
+			err = p.buildIndexOnline(ctx, builder, indexDef)
+			if !sql.ErrOnlineIndexBuildNotSupported.Is(err) {
+				return err
+			}
+		}
+		err = indexable.CreateIndex(ctx, indexDef)
 		if err != nil {
 			return err
 		}
@@ -217,6 +228,9 @@ func (p *AlterIndex) Execute(ctx *sql.Context) error {
 
 		rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
 
+		stage := sql.StartStage(ctx, sql.StageBuildingIndex, sql.StageUnitRows, sql.TableRowCountEstimate(ctx, rwt))
+		defer stage.End()
+
 		for {
 			r, err := rowIter.Next(ctx)
 			if err == io.EOF {
@@ -229,6 +243,7 @@ func (p *AlterIndex) Execute(ctx *sql.Context) error {
 			if err != nil {
 				return err
 			}
+			stage.Add(1)
 		}
 
 		// TODO: move this into iter.close, probably
@@ -271,7 +286,7 @@ func (p *AlterIndex) Execute(ctx *sql.Context) error {
 	case IndexAction_Rename:
 		return indexable.RenameIndex(ctx, p.PreviousIndexName, p.IndexName)
 	case IndexAction_DisableEnableKeys:
-		ctx.Session.Warn(&sql.Warning{
+		ctx.AddWarning(&sql.Warning{
 			Level:   "Warning",
 			Code:    mysql.ERNotSupportedYet,
 			Message: fmt.Sprintf("'disable/enable keys' feature is not supported yet"),
@@ -282,6 +297,56 @@ func (p *AlterIndex) Execute(ctx *sql.Context) error {
 	}
 }
 
+// buildIndexOnline creates the index given with the OnlineIndexBuilder given, which copies the rows of the table into
+// it while the table is read and written. The progress of the build is reported in the index builds of the engine and
+// in the process list.
+func (p *AlterIndex) buildIndexOnline(ctx *sql.Context, builder sql.OnlineIndexBuilder, indexDef sql.IndexDef) (err error) {
+	build, err := builder.StartIndexBuild(ctx, indexDef)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if abortErr := build.Abort(ctx); abortErr != nil {
+			ctx.GetLogger().WithError(abortErr).Warnf("failed to abort the build of index %s of table %s", indexDef.Name, builder.Name())
+		}
+	}()
+
+	total := sql.TableRowCountEstimate(ctx, builder)
+	builds, tracked := sql.GetService(ctx, sql.IndexBuildsService)
+	var id uint64
+	if tracked {
+		id = builds.Start(sql.IndexBuildStatus{
+			Database:     p.Database().Name(),
+			Table:        builder.Name(),
+			Index:        indexDef.Name,
+			ConnectionID: ctx.ID(),
+			RowsTotal:    total,
+		})
+		defer builds.End(id)
+	}
+
+	stage := sql.StartStage(ctx, sql.StageBuildingIndex, sql.StageUnitRows, total)
+	defer stage.End()
+
+	err = build.Copy(ctx, func(rows int64) {
+		stage.Add(rows)
+		if tracked {
+			builds.AddRowsCopied(id, rows)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if tracked {
+		builds.SetPhase(id, sql.IndexBuildPhaseCatchingUp)
+	}
+	return build.CatchUp(ctx)
+}
+
 // RowIter implements the Node interface.
 func (p *AlterIndex) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	err := p.Execute(ctx)
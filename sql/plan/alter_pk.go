@@ -162,6 +162,9 @@ func (d *dropPkIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable) err
 
 	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
 
+	stage := sql.StartStage(ctx, sql.StageCopyToTmpTable, sql.StageUnitRows, sql.TableRowCountEstimate(ctx, rwt))
+	defer stage.End()
+
 	for {
 		r, err := rowIter.Next(ctx)
 		if err == io.EOF {
@@ -174,6 +177,7 @@ func (d *dropPkIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable) err
 		if err != nil {
 			return err
 		}
+		stage.Add(1)
 	}
 
 	// TODO: move this into iter.close, probably
@@ -245,6 +249,9 @@ func (c *createPkIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable) e
 
 	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
 
+	stage := sql.StartStage(ctx, sql.StageCopyToTmpTable, sql.StageUnitRows, sql.TableRowCountEstimate(ctx, rwt))
+	defer stage.End()
+
 	for {
 		r, err := rowIter.Next(ctx)
 		if err == io.EOF {
@@ -264,6 +271,7 @@ func (c *createPkIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable) e
 		if err != nil {
 			return err
 		}
+		stage.Add(1)
 	}
 
 	// TODO: move this into iter.close, probably
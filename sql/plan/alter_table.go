@@ -31,6 +31,9 @@ type RenameTable struct {
 	ddlNode
 	oldNames []string
 	newNames []string
+	// triggers are the triggers of the renamed tables, with their statements changed to create them on the new
+	// names of their tables
+	triggers []sql.TriggerDefinition
 }
 
 var _ sql.Node = (*RenameTable)(nil)
@@ -56,73 +59,159 @@ func (r *RenameTable) String() string {
 	return fmt.Sprintf("Rename table %s to %s", r.oldNames, r.newNames)
 }
 
+// RenamedTable returns the name that the table with the name given has once the renames of this statement are applied,
+// which is the name given if the table isn't renamed.
+func (r *RenameTable) RenamedTable(name string) string {
+	for i, oldName := range r.oldNames {
+		if strings.ToLower(oldName) == strings.ToLower(name) {
+			name = r.newNames[i]
+		}
+	}
+	return name
+}
+
+// WithTriggers returns this node with the triggers given, which are the triggers of the renamed tables with their
+// statements changed to create them on the new names of their tables. They replace the existing triggers once the
+// tables are renamed.
+func (r *RenameTable) WithTriggers(triggers []sql.TriggerDefinition) sql.Node {
+	nr := *r
+	nr.triggers = triggers
+	return &nr
+}
+
+// RowIter implements the interface sql.Node. Renames are applied in order, so tables may be swapped through an
+// intermediate name. Every rename is validated before any is applied, and renames already applied are reverted if a
+// later one fails, so that the statement either renames every table or none of them.
 func (r *RenameTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	renamer, ok := r.db.(sql.TableRenamer)
 	if !ok {
 		return nil, sql.ErrRenameTableNotSupported.New(r.db.Name())
 	}
 
-	var err error
+	if err := r.validateRenames(ctx); err != nil {
+		return nil, err
+	}
+
 	for i, oldName := range r.oldNames {
-		var tbl sql.Table
-		var ok bool
-		tbl, ok, err = r.db.GetTableInsensitive(ctx, oldName)
+		err := r.renameTable(ctx, renamer, oldName, r.newNames[i])
 		if err != nil {
+			for j := i - 1; j >= 0; j-- {
+				if revertErr := r.renameTable(ctx, renamer, r.newNames[j], r.oldNames[j]); revertErr != nil {
+					return nil, fmt.Errorf("%w; additionally, reverting the rename of table %s failed: %s", err, r.oldNames[j], revertErr.Error())
+				}
+			}
 			return nil, err
 		}
+	}
 
+	if len(r.triggers) > 0 {
+		triggerDb, ok := r.db.(sql.TriggerDatabase)
 		if !ok {
-			return nil, sql.ErrTableNotFound.New(oldName)
+			return nil, fmt.Errorf(`renamed tables %v are referenced in triggers, but database does not support triggers`, r.oldNames)
+		}
+		//TODO: if moving any triggers fails, then we'll be left in a state where triggers exist for a table that was renamed
+		for _, trigger := range r.triggers {
+			if err := triggerDb.DropTrigger(ctx, trigger.Name); err != nil {
+				return nil, err
+			}
+			if err := triggerDb.CreateTrigger(ctx, trigger); err != nil {
+				return nil, err
+			}
 		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
 
-		if fkTable, ok := tbl.(sql.ForeignKeyTable); ok {
-			parentFks, err := fkTable.GetReferencedForeignKeys(ctx)
+// validateRenames checks that every rename in the statement can be applied, accounting for the renames preceding it.
+func (r *RenameTable) validateRenames(ctx *sql.Context) error {
+	// Tables whose existence was changed by an earlier rename in this statement, keyed by lowercase name
+	renamed := make(map[string]bool)
+	exists := func(name string) (bool, error) {
+		if tableExists, ok := renamed[strings.ToLower(name)]; ok {
+			return tableExists, nil
+		}
+		_, tableExists, err := r.db.GetTableInsensitive(ctx, name)
+		return tableExists, err
+	}
+
+	for i, oldName := range r.oldNames {
+		newName := r.newNames[i]
+		oldExists, err := exists(oldName)
+		if err != nil {
+			return err
+		}
+		if !oldExists {
+			return sql.ErrTableNotFound.New(oldName)
+		}
+		if strings.ToLower(oldName) != strings.ToLower(newName) {
+			newExists, err := exists(newName)
 			if err != nil {
-				return nil, err
+				return err
 			}
-			for _, parentFk := range parentFks {
-				//TODO: support renaming tables across databases for foreign keys
-				if strings.ToLower(parentFk.Database) != strings.ToLower(parentFk.ParentDatabase) {
-					return nil, fmt.Errorf("updating foreign key table names across databases is not yet supported")
-				}
-				parentFk.ParentTable = r.newNames[i]
-				childTbl, ok, err := r.db.GetTableInsensitive(ctx, parentFk.Table)
-				if err != nil {
-					return nil, err
-				}
-				if !ok {
-					return nil, sql.ErrTableNotFound.New(parentFk.Table)
-				}
-				childFkTbl, ok := childTbl.(sql.ForeignKeyTable)
-				if !ok {
-					return nil, fmt.Errorf("referenced table `%s` supports foreign keys but declaring table `%s` does not", parentFk.ParentTable, parentFk.Table)
-				}
-				err = childFkTbl.UpdateForeignKey(ctx, parentFk.Name, parentFk)
-				if err != nil {
-					return nil, err
-				}
+			if newExists {
+				return sql.ErrTableAlreadyExists.New(newName)
 			}
+		}
+		renamed[strings.ToLower(oldName)] = false
+		renamed[strings.ToLower(newName)] = true
+	}
+	return nil
+}
 
-			fks, err := fkTable.GetDeclaredForeignKeys(ctx)
+// renameTable renames the table |oldName| to |newName|, updating the foreign keys that refer to it.
+func (r *RenameTable) renameTable(ctx *sql.Context, renamer sql.TableRenamer, oldName, newName string) error {
+	tbl, ok, err := r.db.GetTableInsensitive(ctx, oldName)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return sql.ErrTableNotFound.New(oldName)
+	}
+
+	if fkTable, ok := tbl.(sql.ForeignKeyTable); ok {
+		parentFks, err := fkTable.GetReferencedForeignKeys(ctx)
+		if err != nil {
+			return err
+		}
+		for _, parentFk := range parentFks {
+			//TODO: support renaming tables across databases for foreign keys
+			if strings.ToLower(parentFk.Database) != strings.ToLower(parentFk.ParentDatabase) {
+				return fmt.Errorf("updating foreign key table names across databases is not yet supported")
+			}
+			parentFk.ParentTable = newName
+			childTbl, ok, err := r.db.GetTableInsensitive(ctx, parentFk.Table)
 			if err != nil {
-				return nil, err
+				return err
 			}
-			for _, fk := range fks {
-				fk.Table = r.newNames[i]
-				err = fkTable.UpdateForeignKey(ctx, fk.Name, fk)
-				if err != nil {
-					return nil, err
-				}
+			if !ok {
+				return sql.ErrTableNotFound.New(parentFk.Table)
+			}
+			childFkTbl, ok := childTbl.(sql.ForeignKeyTable)
+			if !ok {
+				return fmt.Errorf("referenced table `%s` supports foreign keys but declaring table `%s` does not", parentFk.ParentTable, parentFk.Table)
+			}
+			err = childFkTbl.UpdateForeignKey(ctx, parentFk.Name, parentFk)
+			if err != nil {
+				return err
 			}
 		}
 
-		err = renamer.RenameTable(ctx, tbl.Name(), r.newNames[i])
+		fks, err := fkTable.GetDeclaredForeignKeys(ctx)
 		if err != nil {
-			return nil, err
+			return err
+		}
+		for _, fk := range fks {
+			fk.Table = newName
+			err = fkTable.UpdateForeignKey(ctx, fk.Name, fk)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
-	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+	return renamer.RenameTable(ctx, tbl.Name(), newName)
 }
 
 func (r *RenameTable) WithChildren(children ...sql.Node) (sql.Node, error) {
@@ -510,6 +599,9 @@ func (i *addColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable)
 
 	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
 
+	stage := sql.StartStage(ctx, sql.StageCopyToTmpTable, sql.StageUnitRows, sql.TableRowCountEstimate(ctx, rwt))
+	defer stage.End()
+
 	var val uint64
 	autoIncColIdx := -1
 	if newSch.HasAutoIncrement() && !i.a.targetSch.HasAutoIncrement() {
@@ -551,6 +643,7 @@ func (i *addColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable)
 		if err != nil {
 			return false, err
 		}
+		stage.Add(1)
 	}
 
 	// TODO: move this into iter.close, probably
@@ -784,6 +877,9 @@ func (i *dropColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable)
 
 	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
 
+	stage := sql.StartStage(ctx, sql.StageCopyToTmpTable, sql.StageUnitRows, sql.TableRowCountEstimate(ctx, rwt))
+	defer stage.End()
+
 	for {
 		r, err := rowIter.Next(ctx)
 		if err == io.EOF {
@@ -801,6 +897,7 @@ func (i *dropColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTable)
 		if err != nil {
 			return false, err
 		}
+		stage.Add(1)
 	}
 
 	// TODO: move this into iter.close, probably
@@ -913,6 +1010,26 @@ func (d *DropColumn) validate(ctx *sql.Context, tbl sql.Table) error {
 		}
 	}
 
+	for _, col := range d.targetSchema {
+		if col.Generated == nil || strings.EqualFold(col.Name, d.Column) {
+			continue
+		}
+		var err error
+		sql.Inspect(col.Generated, func(expr sql.Expression) bool {
+			switch expr := expr.(type) {
+			case *expression.UnresolvedColumn, *expression.GetField:
+				if strings.EqualFold(expr.(sql.Nameable).Name(), d.Column) {
+					err = sql.ErrColumnReferencedInGenerated.New(d.Column)
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	if fkTable, ok := tbl.(sql.ForeignKeyTable); ok {
 		lowercaseColumn := strings.ToLower(d.Column)
 		fks, err := fkTable.GetDeclaredForeignKeys(ctx)
@@ -1436,6 +1553,9 @@ func (i *modifyColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTabl
 
 	rowIter := sql.NewTableRowIter(ctx, rwt, partitions)
 
+	stage := sql.StartStage(ctx, sql.StageCopyToTmpTable, sql.StageUnitRows, sql.TableRowCountEstimate(ctx, rwt))
+	defer stage.End()
+
 	for {
 		r, err := rowIter.Next(ctx)
 		if err == io.EOF {
@@ -1458,6 +1578,7 @@ func (i *modifyColumnIter) rewriteTable(ctx *sql.Context, rwt sql.RewritableTabl
 		if err != nil {
 			return false, err
 		}
+		stage.Add(1)
 	}
 
 	// TODO: move this into iter.close, probably
@@ -180,6 +180,12 @@ func (b *Block) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 					if isSelect || !selectSeen {
 						returnRows = rowCache.Get()
 					}
+					// The result sets of nested blocks and procedures are written by those blocks themselves, unlike
+					// those of the statements run by EXECUTE
+					_, isBlock := subIter.(BlockRowIter)
+					if _, isExecute := subIter.(*executeIter); isSelect && (!isBlock || isExecute) && !isCallNode(s) {
+						return ctx.WriteResultSet(subIterSch, returnRows)
+					}
 					break
 				} else if err != nil {
 					return err
@@ -205,6 +211,12 @@ func (b *Block) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	}, nil
 }
 
+// isCallNode returns whether the node given is a CALL statement.
+func isCallNode(n sql.Node) bool {
+	_, ok := n.(*Call)
+	return ok
+}
+
 // implementsRepresentsBlock implements the RepresentsBlock interface.
 func (b *Block) implementsRepresentsBlock() {}
 
@@ -145,6 +145,10 @@ func (ci *concatIter) Next(ctx *sql.Context) (sql.Row, error) {
 		if err != nil {
 			return nil, err
 		}
+		res, err = sql.LoadRow(ctx, res)
+		if err != nil {
+			return nil, err
+		}
 		hash, err := sql.HashOf(res)
 		if err != nil {
 			return nil, err
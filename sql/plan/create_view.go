@@ -25,6 +25,15 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
+const (
+	// ViewCheckOptionCascaded is the check option of views created WITH CASCADED CHECK OPTION, or WITH CHECK OPTION.
+	// Rows written through such a view must satisfy the WHERE clauses of the view and every view it's defined on.
+	ViewCheckOptionCascaded = "CASCADED"
+	// ViewCheckOptionLocal is the check option of views created WITH LOCAL CHECK OPTION. Rows written through such a
+	// view must satisfy the view's WHERE clause, and the check options of the views it's defined on.
+	ViewCheckOptionLocal = "LOCAL"
+)
+
 // CreateView is a node representing the creation (or replacement) of a view,
 // which is defined by the Child node. The Columns member represent the
 // explicit columns specified by the query, if any.
@@ -38,8 +47,13 @@ type CreateView struct {
 	CreateViewString string
 	Algorithm        string
 	Definer          string
-	Security         string
-	CheckOpt         string
+	// ExplicitDefiner is set when the statement names its definer with a DEFINER clause, rather than defaulting
+	// Definer to the current user.
+	ExplicitDefiner bool
+	Security        string
+	CheckOpt        string
+	// IsAlter is set for ALTER VIEW statements, which replace the definition of a view that must already exist.
+	IsAlter bool
 }
 
 var _ sql.Node = (*CreateView)(nil)
@@ -71,7 +85,33 @@ func NewCreateView(
 
 // View returns the view that will be created by this node.
 func (cv *CreateView) View() *sql.View {
-	return cv.Definition.AsView(cv.CreateViewString)
+	return cv.Definition.AsView(cv.storedCreateStatement())
+}
+
+// storedCreateStatement returns the CREATE VIEW statement that is stored to define this view. Unlike the statement as
+// written, it always names the view's definer, since the definer would otherwise default to the user reading the
+// definition back.
+func (cv *CreateView) storedCreateStatement() string {
+	if cv.Definer == "" && !cv.IsAlter {
+		return cv.CreateViewString
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString("CREATE ")
+	if cv.Algorithm != "" {
+		sb.WriteString(fmt.Sprintf("ALGORITHM=%s ", cv.Algorithm))
+	}
+	if cv.Definer != "" {
+		sb.WriteString(fmt.Sprintf("DEFINER=%s ", cv.Definer))
+	}
+	if cv.Security != "" {
+		sb.WriteString(fmt.Sprintf("SQL SECURITY %s ", cv.Security))
+	}
+	sb.WriteString(fmt.Sprintf("VIEW `%s` AS %s", strings.ReplaceAll(cv.Name, "`", "``"), cv.Definition.TextDefinition))
+	if cv.CheckOpt != "" {
+		sb.WriteString(fmt.Sprintf(" WITH %s CHECK OPTION", cv.CheckOpt))
+	}
+	return sb.String()
 }
 
 // Children implements the Node interface. It returns the Child of the
@@ -93,6 +133,19 @@ func (cv *CreateView) Resolved() bool {
 // empty.
 func (cv *CreateView) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	registry := ctx.GetViewRegistry()
+	if cv.IsAlter {
+		exists := registry.Exists(cv.database.Name(), cv.Name)
+		if viewDb, ok := cv.database.(sql.ViewDatabase); ok && !exists {
+			var err error
+			_, exists, err = viewDb.GetViewDefinition(ctx, cv.Name)
+			if err != nil {
+				return sql.RowsToRowIter(), err
+			}
+		}
+		if !exists {
+			return sql.RowsToRowIter(), sql.ErrViewDoesNotExist.New(cv.database.Name(), cv.Name)
+		}
+	}
 	if cv.IsReplace {
 		if dropper, ok := cv.database.(sql.ViewDatabase); ok {
 			err := dropper.DropView(ctx, cv.Name)
@@ -121,7 +174,7 @@ func (cv *CreateView) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 
 	creator, ok := cv.database.(sql.ViewDatabase)
 	if ok {
-		return sql.RowsToRowIter(), creator.CreateView(ctx, cv.Name, cv.Definition.TextDefinition, cv.CreateViewString)
+		return sql.RowsToRowIter(), creator.CreateView(ctx, cv.Name, cv.Definition.TextDefinition, cv.storedCreateStatement())
 	} else {
 		return sql.RowsToRowIter(), registry.Register(cv.database.Name(), cv.View())
 	}
@@ -158,6 +211,7 @@ func (cv *CreateView) WithChildren(children ...sql.Node) (sql.Node, error) {
 func (cv *CreateView) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
 	return opChecker.UserHasPrivileges(ctx,
 		sql.NewPrivilegedOperation(cv.database.Name(), "", "", sql.PrivilegeType_CreateView)) &&
+		checkDefinerPrivileges(ctx, opChecker, cv.Definer) &&
 		cv.Child.CheckPrivileges(ctx, opChecker)
 }
 
@@ -183,6 +237,28 @@ func (cv *CreateView) WithDatabase(database sql.Database) (sql.Node, error) {
 	return &newCreate, nil
 }
 
+// SplitDefiner splits a definer of the form user@host, in which either part may be quoted, into its user and host.
+// The host is "%" if the definer doesn't specify one.
+func SplitDefiner(definer string) (user string, host string) {
+	user, host = definer, "%"
+	if i := strings.LastIndex(definer, "@"); i >= 0 {
+		user, host = definer[:i], definer[i+1:]
+	}
+	return unquoteAccountNamePart(user), unquoteAccountNamePart(host)
+}
+
+func unquoteAccountNamePart(s string) string {
+	if len(s) >= 2 {
+		switch q := s[0]; q {
+		case '`', '\'', '"':
+			if s[len(s)-1] == q {
+				return strings.ReplaceAll(s[1:len(s)-1], string([]byte{q, q}), string(q))
+			}
+		}
+	}
+	return s
+}
+
 // GetIsUpdatableFromCreateView returns whether the view is updatable or not.
 // https://dev.mysql.com/doc/refman/8.0/en/view-updatability.html
 func GetIsUpdatableFromCreateView(cv *CreateView) bool {
@@ -31,6 +31,8 @@ type CreateDB struct {
 	dbName      string
 	IfNotExists bool
 	Collation   sql.CollationID
+	// Encryption is the DEFAULT ENCRYPTION clause of the statement, or nil if it was not given.
+	Encryption *bool
 }
 
 var _ sql.Node = (*CreateDB)(nil)
@@ -62,7 +64,7 @@ func (c *CreateDB) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 
 	if exists {
 		if c.IfNotExists {
-			ctx.Session.Warn(&sql.Warning{
+			ctx.AddWarning(&sql.Warning{
 				Level:   "Note",
 				Code:    mysql.ERDbCreateExists,
 				Message: fmt.Sprintf("Can't create database %s; database exists ", c.dbName),
@@ -83,6 +85,26 @@ func (c *CreateDB) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 
+	if c.Encryption != nil {
+		db, err := c.Catalog.Database(ctx, c.dbName)
+		if err != nil {
+			return nil, err
+		}
+		// As with collations, a database that doesn't support encryption is still created, but the caller is told
+		// that the requested encryption was ignored.
+		if encryptedDb, ok := db.(sql.EncryptedDatabase); ok {
+			if err = encryptedDb.SetDefaultEncryption(ctx, *c.Encryption); err != nil {
+				return nil, err
+			}
+		} else if *c.Encryption {
+			ctx.AddWarning(&sql.Warning{
+				Level:   "Warning",
+				Code:    mysql.ERNotSupportedYet,
+				Message: sql.ErrDatabaseEncryptionNotSupported.New(c.dbName).Error(),
+			})
+		}
+	}
+
 	return sql.RowsToRowIter(rows...), nil
 }
 
@@ -148,7 +170,7 @@ func (d *DropDB) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	exists := d.Catalog.HasDB(ctx, d.dbName)
 	if !exists {
 		if d.IfExists {
-			ctx.Session.Warn(&sql.Warning{
+			ctx.AddWarning(&sql.Warning{
 				Level:   "Note",
 				Code:    mysql.ERDbDropExists,
 				Message: fmt.Sprintf("Can't drop database %s; database doesn't exist ", d.dbName),
@@ -205,6 +227,8 @@ type AlterDB struct {
 	Catalog   sql.Catalog
 	dbName    string
 	Collation sql.CollationID
+	// Encryption is the DEFAULT ENCRYPTION clause of the statement, or nil if it was not given.
+	Encryption *bool
 }
 
 var _ sql.Node = (*AlterDB)(nil)
@@ -221,7 +245,14 @@ func (c *AlterDB) String() string {
 	if len(c.dbName) > 0 {
 		dbName = fmt.Sprintf(" %s", c.dbName)
 	}
-	return fmt.Sprintf("%s database%s collate %s", sqlparser.AlterStr, dbName, c.Collation.Name())
+	var options string
+	if c.Collation != sql.Collation_Unspecified {
+		options += fmt.Sprintf(" collate %s", c.Collation.Name())
+	}
+	if c.Encryption != nil {
+		options += fmt.Sprintf(" encryption %s", encryptionOptionString(*c.Encryption))
+	}
+	return fmt.Sprintf("%s database%s%s", sqlparser.AlterStr, dbName, options)
 }
 
 // Schema implements the interface sql.Node.
@@ -245,17 +276,31 @@ func (c *AlterDB) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	if err != nil {
 		return nil, err
 	}
-	collatedDb, ok := db.(sql.CollatedDatabase)
-	if !ok {
-		return nil, sql.ErrDatabaseCollationsNotSupported.New(dbName)
-	}
 
-	collation := c.Collation
-	if collation == sql.Collation_Unspecified {
-		collation = sql.Collation_Default
+	// A statement that only changes the encryption leaves the collation untouched
+	if c.Collation != sql.Collation_Unspecified || c.Encryption == nil {
+		collatedDb, ok := db.(sql.CollatedDatabase)
+		if !ok {
+			return nil, sql.ErrDatabaseCollationsNotSupported.New(dbName)
+		}
+
+		collation := c.Collation
+		if collation == sql.Collation_Unspecified {
+			collation = sql.Collation_Default
+		}
+		if err = collatedDb.SetCollation(ctx, collation); err != nil {
+			return nil, err
+		}
 	}
-	if err = collatedDb.SetCollation(ctx, collation); err != nil {
-		return nil, err
+
+	if c.Encryption != nil {
+		encryptedDb, ok := db.(sql.EncryptedDatabase)
+		if !ok {
+			return nil, sql.ErrDatabaseEncryptionNotSupported.New(dbName)
+		}
+		if err = encryptedDb.SetDefaultEncryption(ctx, *c.Encryption); err != nil {
+			return nil, err
+		}
 	}
 
 	rows := []sql.Row{{types.OkResult{RowsAffected: 1}}}
@@ -294,6 +339,84 @@ func NewAlterDatabase(dbName string, collation sql.CollationID) *AlterDB {
 	}
 }
 
+// RenameDB renames a database in the Catalog, for database providers that support it.
+type RenameDB struct {
+	Catalog sql.Catalog
+	oldName string
+	newName string
+}
+
+var _ sql.Node = (*RenameDB)(nil)
+var _ sql.CollationCoercible = (*RenameDB)(nil)
+
+// NewRenameDatabase returns a new RenameDB.
+func NewRenameDatabase(oldName, newName string) *RenameDB {
+	return &RenameDB{
+		oldName: oldName,
+		newName: newName,
+	}
+}
+
+// Resolved implements the interface sql.Node.
+func (r *RenameDB) Resolved() bool {
+	return true
+}
+
+// String implements the interface sql.Node.
+func (r *RenameDB) String() string {
+	return fmt.Sprintf("%s database %s to %s", sqlparser.RenameStr, r.oldName, r.newName)
+}
+
+// Schema implements the interface sql.Node.
+func (r *RenameDB) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+// Children implements the interface sql.Node.
+func (r *RenameDB) Children() []sql.Node {
+	return nil
+}
+
+// RowIter implements the interface sql.Node.
+func (r *RenameDB) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if !r.Catalog.HasDB(ctx, r.oldName) {
+		return nil, sql.ErrDatabaseNotFound.New(r.oldName)
+	}
+	if strings.ToLower(r.oldName) != strings.ToLower(r.newName) && r.Catalog.HasDB(ctx, r.newName) {
+		return nil, sql.ErrDatabaseExists.New(r.newName)
+	}
+
+	if err := r.Catalog.RenameDatabase(ctx, r.oldName, r.newName); err != nil {
+		return nil, err
+	}
+
+	// Follows the current database to its new name. Database name is case-insensitive.
+	if strings.ToLower(ctx.GetCurrentDatabase()) == strings.ToLower(r.oldName) {
+		ctx.SetCurrentDatabase(r.newName)
+		ctx.Session.SetTransactionDatabase(r.newName)
+	}
+
+	rows := []sql.Row{{types.OkResult{RowsAffected: 1}}}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (r *RenameDB) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(r, children...)
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (r *RenameDB) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation(r.oldName, "", "", sql.PrivilegeType_Alter, sql.PrivilegeType_Drop),
+		sql.NewPrivilegedOperation(r.newName, "", "", sql.PrivilegeType_Create))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*RenameDB) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
 // GetDatabaseCollation returns a database's collation. Also handles when a database does not explicitly support collations.
 func GetDatabaseCollation(ctx *sql.Context, db sql.Database) sql.CollationID {
 	collatedDb, ok := db.(sql.CollatedDatabase)
@@ -306,3 +429,21 @@ func GetDatabaseCollation(ctx *sql.Context, db sql.Database) sql.CollationID {
 	}
 	return collation
 }
+
+// GetDatabaseEncryption returns whether a database's tables are encrypted by default. Databases that do not support
+// encryption are never encrypted.
+func GetDatabaseEncryption(ctx *sql.Context, db sql.Database) bool {
+	encryptedDb, ok := db.(sql.EncryptedDatabase)
+	if !ok {
+		return false
+	}
+	return encryptedDb.GetDefaultEncryption(ctx)
+}
+
+// encryptionOptionString returns the value of a DEFAULT ENCRYPTION clause as it is written in SQL.
+func encryptionOptionString(encrypted bool) string {
+	if encrypted {
+		return "'Y'"
+	}
+	return "'N'"
+}
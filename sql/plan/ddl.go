@@ -131,6 +131,8 @@ type CreateTable struct {
 	like         sql.Node
 	temporary    TempTableOption
 	selectNode   sql.Node
+	// systemTimePeriod is the period of the table if it's created WITH SYSTEM VERSIONING
+	systemTimePeriod *sql.SystemTimePeriod
 }
 
 var _ sql.Databaser = (*CreateTable)(nil)
@@ -315,6 +317,7 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 	if err != nil && !(sql.ErrTableAlreadyExists.Is(err) && (c.ifNotExists == IfNotExists)) {
 		return sql.RowsToRowIter(), err
 	}
+	created := err == nil
 
 	vd, _ = maybePrivDb.(sql.ViewDatabase)
 	if vd != nil {
@@ -366,9 +369,46 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		}
 	}
 
+	if c.systemTimePeriod != nil && created {
+		versionable, ok := tableNode.(sql.SystemVersionableTable)
+		if !ok {
+			return sql.RowsToRowIter(), sql.ErrSystemVersioningNotSupported.New(c.name)
+		}
+		if err := versionable.EnableSystemVersioning(ctx, *c.systemTimePeriod); err != nil {
+			return sql.RowsToRowIter(), err
+		}
+	}
+
 	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
 }
 
+// WithSystemTimePeriod returns a copy of this node creating a system-versioned table with the period given, whose
+// columns must be DATETIME or TIMESTAMP columns of the table. The period columns are nullable, since their values are
+// set by the table rather than by the statements inserting rows.
+func (c *CreateTable) WithSystemTimePeriod(period sql.SystemTimePeriod) (*CreateTable, error) {
+	if strings.EqualFold(period.Start, period.End) {
+		return nil, sql.ErrInvalidSystemVersioning.New("ROW START and ROW END must be different columns")
+	}
+	nc := *c
+	nc.systemTimePeriod = &period
+	nc.CreateSchema.Schema = c.CreateSchema.Schema.Copy()
+	for _, name := range []string{period.Start, period.End} {
+		idx := nc.CreateSchema.Schema.IndexOfColName(name)
+		if idx < 0 {
+			return nil, sql.ErrInvalidSystemVersioning.New(fmt.Sprintf("column %s does not exist", name))
+		}
+		col := nc.CreateSchema.Schema[idx]
+		if !types.IsDatetimeType(col.Type) && !types.IsTimestampType(col.Type) {
+			return nil, sql.ErrInvalidSystemVersioning.New(fmt.Sprintf("column %s must be a DATETIME or TIMESTAMP column", name))
+		}
+		if col.PrimaryKey {
+			return nil, sql.ErrInvalidSystemVersioning.New(fmt.Sprintf("column %s cannot be part of the primary key", name))
+		}
+		col.Nullable = true
+	}
+	return &nc, nil
+}
+
 // ForeignKeys returns any foreign keys that will be declared on this table.
 func (c *CreateTable) ForeignKeys() []*sql.ForeignKeyConstraint {
 	return c.fkDefs
@@ -708,6 +748,7 @@ func (c *CreateTable) validateDefaultPosition() error {
 type DropTable struct {
 	Tables       []sql.Node
 	ifExists     bool
+	temporary    TempTableOption
 	triggerNames []string
 }
 
@@ -729,6 +770,13 @@ func (d *DropTable) WithTriggers(triggers []string) sql.Node {
 	return &nd
 }
 
+// WithTemporary returns this node but dropping only temporary tables, as DROP TEMPORARY TABLE does.
+func (d *DropTable) WithTemporary(temp TempTableOption) *DropTable {
+	nd := *d
+	nd.temporary = temp
+	return &nd
+}
+
 // TableNames returns the names of the tables to drop.
 func (d *DropTable) TableNames() ([]string, error) {
 	tblNames := make([]string, len(d.Tables))
@@ -755,7 +803,23 @@ func (d *DropTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 	var err error
 	var curdb sql.Database
 
-	for _, table := range d.Tables {
+	tables := d.Tables
+	if d.temporary == IsTempTable {
+		// DROP TEMPORARY TABLE doesn't drop permanent tables, and drops nothing if any of the tables isn't temporary
+		tables = make([]sql.Node, 0, len(d.Tables))
+		for _, table := range d.Tables {
+			tbl := table.(*ResolvedTable)
+			if !sql.IsTemporaryTable(tbl.Table) {
+				if d.ifExists {
+					continue
+				}
+				return nil, sql.ErrUnknownTable.New(tbl.Name())
+			}
+			tables = append(tables, table)
+		}
+	}
+
+	for _, table := range tables {
 		tbl := table.(*ResolvedTable)
 		curdb = tbl.Database
 
@@ -792,7 +856,8 @@ func (d *DropTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		}
 	}
 
-	if len(d.triggerNames) > 0 {
+	// The triggers are those of the tables named, and temporary tables can't have any
+	if len(d.triggerNames) > 0 && d.temporary != IsTempTable {
 		triggerDb, ok := curdb.(sql.TriggerDatabase)
 		if !ok {
 			tblNames, _ := d.TableNames()
@@ -868,5 +933,8 @@ func (d *DropTable) String() string {
 	if d.ifExists {
 		ifExists = "if exists "
 	}
+	if d.temporary == IsTempTable {
+		return fmt.Sprintf("Drop temporary table %s%s", ifExists, names)
+	}
 	return fmt.Sprintf("Drop table %s%s", ifExists, names)
 }
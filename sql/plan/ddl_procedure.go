@@ -110,7 +110,8 @@ func (c *CreateProcedure) WithChildren(children ...sql.Node) (sql.Node, error) {
 // CheckPrivileges implements the interface sql.Node.
 func (c *CreateProcedure) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
 	return opChecker.UserHasPrivileges(ctx,
-		sql.NewPrivilegedOperation(c.db.Name(), "", "", sql.PrivilegeType_CreateRoutine))
+		sql.NewPrivilegedOperation(c.db.Name(), "", "", sql.PrivilegeType_CreateRoutine)) &&
+		checkDefinerPrivileges(ctx, opChecker, c.Definer)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -139,6 +140,10 @@ func (c *CreateProcedure) String() string {
 	for _, characteristic := range c.Characteristics {
 		characteristics += fmt.Sprintf(" %s", characteristic.String())
 	}
+	if c.IsFunction() {
+		return fmt.Sprintf("CREATE%s FUNCTION %s (%s) RETURNS %s %s%s%s %s",
+			definer, c.Name, params, c.ReturnType.String(), c.SecurityContext.String(), comment, characteristics, c.Procedure.String())
+	}
 	return fmt.Sprintf("CREATE%s PROCEDURE %s (%s) %s%s%s %s",
 		definer, c.Name, params, c.SecurityContext.String(), comment, characteristics, c.Procedure.String())
 }
@@ -164,6 +169,10 @@ func (c *CreateProcedure) DebugString() string {
 	for _, characteristic := range c.Characteristics {
 		characteristics += fmt.Sprintf(" %s", characteristic.String())
 	}
+	if c.IsFunction() {
+		return fmt.Sprintf("CREATE%s FUNCTION %s (%s) RETURNS %s %s%s%s %s",
+			definer, c.Name, params, c.ReturnType.String(), c.SecurityContext.String(), comment, characteristics, sql.DebugString(c.Procedure))
+	}
 	return fmt.Sprintf("CREATE%s PROCEDURE %s (%s) %s%s%s %s",
 		definer, c.Name, params, c.SecurityContext.String(), comment, characteristics, sql.DebugString(c.Procedure))
 }
@@ -177,15 +186,27 @@ func (c *CreateProcedure) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 			CreatedAt:       c.CreatedAt,
 			ModifiedAt:      c.ModifiedAt,
 		},
-		db: c.db,
+		db:         c.db,
+		isFunction: c.IsFunction(),
 	}, nil
 }
 
+// WithReturnType returns a new *CreateProcedure node that creates a stored function returning values of the type
+// given, rather than a stored procedure.
+func (c *CreateProcedure) WithReturnType(returnType sql.Type) *CreateProcedure {
+	nc := *c
+	procedure := *c.Procedure
+	procedure.ReturnType = returnType
+	nc.Procedure = &procedure
+	return &nc
+}
+
 // createProcedureIter is the row iterator for *CreateProcedure.
 type createProcedureIter struct {
-	once sync.Once
-	spd  sql.StoredProcedureDetails
-	db   sql.Database
+	once       sync.Once
+	spd        sql.StoredProcedureDetails
+	db         sql.Database
+	isFunction bool
 }
 
 // Next implements the sql.RowIter interface.
@@ -198,6 +219,17 @@ func (c *createProcedureIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, io.EOF
 	}
 	//TODO: if "automatic_sp_privileges" is true then the creator automatically gets EXECUTE and ALTER ROUTINE on this procedure
+	if c.isFunction {
+		fdb, ok := c.db.(sql.StoredFunctionDatabase)
+		if !ok {
+			return nil, sql.ErrStoredFunctionsNotSupported.New(c.db.Name())
+		}
+		if err := fdb.SaveStoredFunction(ctx, c.spd); err != nil {
+			return nil, err
+		}
+		return sql.Row{types.NewOkResult(0)}, nil
+	}
+
 	pdb, ok := c.db.(sql.StoredProcedureDatabase)
 	if !ok {
 		return nil, sql.ErrStoredProceduresNotSupported.New(c.db.Name())
@@ -0,0 +1,63 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+)
+
+// DynamicPrivilege_SetUserID is the dynamic privilege required to create a view, trigger or stored procedure whose
+// definer is an account other than the current user.
+// https://dev.mysql.com/doc/refman/8.0/en/privileges-provided.html#priv_set-user-id
+const DynamicPrivilege_SetUserID = "set_user_id"
+
+// checkDefinerPrivileges returns whether the current user may create an object with the definer given. Naming any
+// account other than the current user requires the SET_USER_ID or SUPER privilege.
+func checkDefinerPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker, definer string) bool {
+	if definer == "" || definerIsCurrentUser(ctx, opChecker, definer) {
+		return true
+	}
+	return opChecker.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(DynamicPrivilege_SetUserID)) ||
+		opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_Super))
+}
+
+// definerIsCurrentUser returns whether the definer given names the current user, either by the host they connected
+// from, or by the host of the account they were authenticated as.
+func definerIsCurrentUser(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker, definer string) bool {
+	user, host := SplitDefiner(definer)
+	client := ctx.Session.Client()
+	if user != client.User {
+		return false
+	}
+	if host == client.Address {
+		return true
+	}
+	if db, ok := opChecker.(*mysql_db.MySQLDb); ok {
+		if account := db.GetUser(client.User, client.Address, false); account != nil {
+			return account.Host == host
+		}
+	}
+	return false
+}
+
+// formatDefiner returns the definer given as it's displayed by SHOW statements, which is user@host without quotes.
+func formatDefiner(definer string) string {
+	if definer == "" {
+		return ""
+	}
+	user, host := SplitDefiner(definer)
+	return user + "@" + host
+}
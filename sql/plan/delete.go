@@ -33,6 +33,9 @@ type DeleteFrom struct {
 	// single source table, targets do NOT need to be explicitly specified and will not be set here. For DELETE FROM JOIN
 	// statements, targets MUST be explicitly specified by the user and will be populated here.
 	explicitTargets []sql.Node
+	// Ignore is whether the errors of rows that can't be deleted are turned into warnings, as with DELETE IGNORE. The
+	// rows are skipped.
+	Ignore bool
 }
 
 var _ sql.Databaseable = (*DeleteFrom)(nil)
@@ -188,7 +191,7 @@ func (p *DeleteFrom) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		}
 		schemaPositionDeleters[i] = schemaPositionDeleter{deleter, int(start), int(end)}
 	}
-	return newDeleteIter(iter, p.Child.Schema(), schemaPositionDeleters...), nil
+	return newDeleteIter(iter, p.Child.Schema(), p.Ignore, schemaPositionDeleters...), nil
 }
 
 // schemaPositionDeleter contains a sql.RowDeleter and the start (inclusive) and end (exclusive) position
@@ -236,6 +239,7 @@ type deleteIter struct {
 	deleters  []schemaPositionDeleter
 	schema    sql.Schema
 	childIter sql.RowIter
+	ignore    bool
 	closed    bool
 }
 
@@ -263,6 +267,9 @@ func (d *deleteIter) Next(ctx *sql.Context) (sql.Row, error) {
 		}
 		err = deleter.deleter.Delete(ctx, subSlice)
 		if err != nil {
+			if d.ignore {
+				return nil, warnOnIgnorableError(ctx, row, err)
+			}
 			return nil, err
 		}
 	}
@@ -293,16 +300,22 @@ func (d *deleteIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
-func newDeleteIter(childIter sql.RowIter, schema sql.Schema, deleters ...schemaPositionDeleter) sql.RowIter {
+func newDeleteIter(childIter sql.RowIter, schema sql.Schema, ignore bool, deleters ...schemaPositionDeleter) sql.RowIter {
 	openerClosers := make([]sql.EditOpenerCloser, len(deleters))
 	for i, ds := range deleters {
 		openerClosers[i] = ds.deleter
 	}
-	return NewTableEditorIter(&deleteIter{
+	iter := &deleteIter{
 		deleters:  deleters,
 		childIter: childIter,
 		schema:    schema,
-	}, openerClosers...)
+		ignore:    ignore,
+	}
+	if ignore {
+		// The deletes of a row that fails are discarded from every table before it's skipped
+		return NewCheckpointingTableEditorIter(iter, openerClosers...)
+	}
+	return NewTableEditorIter(iter, openerClosers...)
 }
 
 // WithChildren implements the Node interface.
@@ -310,7 +323,9 @@ func (p *DeleteFrom) WithChildren(children ...sql.Node) (sql.Node, error) {
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 1)
 	}
-	return NewDeleteFrom(children[0], p.explicitTargets), nil
+	np := *p
+	np.UnaryNode = UnaryNode{children[0]}
+	return &np, nil
 }
 
 // CheckPrivileges implements the interface sql.Node.
@@ -341,14 +356,22 @@ func (*DeleteFrom) CollationCoercibility(ctx *sql.Context) (collation sql.Collat
 
 func (p *DeleteFrom) String() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("Delete")
+	if p.Ignore {
+		_ = pr.WriteNode("Delete ignore")
+	} else {
+		_ = pr.WriteNode("Delete")
+	}
 	_ = pr.WriteChildren(p.Child.String())
 	return pr.String()
 }
 
 func (p *DeleteFrom) DebugString() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("Delete")
+	if p.Ignore {
+		_ = pr.WriteNode("Delete ignore")
+	} else {
+		_ = pr.WriteNode("Delete")
+	}
 	_ = pr.WriteChildren(sql.DebugString(p.Child))
 	return pr.String()
 }
@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // Describe is a node that describes its children.
@@ -95,6 +96,15 @@ func (i *describeIter) Close(*sql.Context) error {
 	return nil
 }
 
+const (
+	// DescribeFormatTraditional is the format of a DescribeQuery that returns one row for each table read by its
+	// query, with the same columns as MySQL's EXPLAIN. It's the format used when none is given.
+	DescribeFormatTraditional = "traditional"
+	// DescribeFormatJSON is the format of a DescribeQuery that returns its plan as a single MySQL-compatible JSON
+	// document, as produced by EXPLAIN FORMAT=JSON.
+	DescribeFormatJSON = "json"
+)
+
 // DescribeQuery returns the description of the query plan.
 type DescribeQuery struct {
 	child  sql.Node
@@ -134,6 +144,11 @@ var DescribeSchema = sql.Schema{
 	{Name: "plan", Type: VarChar25000},
 }
 
+// DescribeJSONSchema is the schema returned by a DescribeQuery node with the JSON format.
+var DescribeJSONSchema = sql.Schema{
+	{Name: "EXPLAIN", Type: types.LongText},
+}
+
 // NewDescribeQuery creates a new DescribeQuery node.
 func NewDescribeQuery(format string, child sql.Node) *DescribeQuery {
 	return &DescribeQuery{child, format}
@@ -141,11 +156,33 @@ func NewDescribeQuery(format string, child sql.Node) *DescribeQuery {
 
 // Schema implements the Node interface.
 func (d *DescribeQuery) Schema() sql.Schema {
-	return DescribeSchema
+	switch d.Format {
+	case DescribeFormatTraditional:
+		return DescribeTraditionalSchema
+	case DescribeFormatJSON:
+		return DescribeJSONSchema
+	default:
+		return DescribeSchema
+	}
 }
 
 // RowIter implements the Node interface.
 func (d *DescribeQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	switch d.Format {
+	case DescribeFormatTraditional:
+		rows, err := describeTraditional(ctx, d.child)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowsToRowIter(rows...), nil
+	case DescribeFormatJSON:
+		formatString, err := describeJSON(ctx, d.child)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowsToRowIter(sql.NewRow(formatString)), nil
+	}
+
 	var rows []sql.Row
 	var formatString string
 	if d.Format == "debug" {
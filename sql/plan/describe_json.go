@@ -0,0 +1,787 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+const (
+	// These mirror the default values of MySQL's cost model, so that the costs reported by EXPLAIN FORMAT=JSON are on
+	// the same scale as the ones that GUI tools expect.
+	explainSeqReadCost  = 0.25
+	explainRandReadCost = 1.0
+	explainRowEvalCost  = 0.1
+
+	// explainDefaultRowCount is the row estimate used for tables that do not report statistics.
+	explainDefaultRowCount = 1000
+	// explainRefSelectivity is the fraction of a table that a non-unique index lookup is assumed to return.
+	explainRefSelectivity = 0.1
+	// explainRangeSelectivity is the fraction of a table that a range condition is assumed to return.
+	explainRangeSelectivity = 1.0 / 3.0
+)
+
+// explainJSON is the root of the document returned by EXPLAIN FORMAT=JSON. The field order of these structs matches
+// the key order of MySQL's output.
+type explainJSON struct {
+	QueryBlock *explainQueryBlock `json:"query_block"`
+}
+
+type explainQueryBlock struct {
+	SelectID int               `json:"select_id,omitempty"`
+	CostInfo *explainQueryCost `json:"cost_info,omitempty"`
+	explainBody
+	// rows is the estimated number of rows produced by this query block
+	rows float64
+}
+
+type explainQueryCost struct {
+	QueryCost string `json:"query_cost"`
+}
+
+// explainBody is the content shared by query blocks and the operations nested within them.
+type explainBody struct {
+	Message           string                   `json:"message,omitempty"`
+	OrderingOperation *explainOperation        `json:"ordering_operation,omitempty"`
+	GroupingOperation *explainOperation        `json:"grouping_operation,omitempty"`
+	DuplicatesRemoval *explainOperation        `json:"duplicates_removal,omitempty"`
+	UnionResult       *explainUnionResult      `json:"union_result,omitempty"`
+	Table             *explainTable            `json:"table,omitempty"`
+	NestedLoop        []explainNestedLoopEntry `json:"nested_loop,omitempty"`
+	InsertFrom        *explainBody             `json:"insert_from,omitempty"`
+}
+
+type explainOperation struct {
+	UsingTemporaryTable bool `json:"using_temporary_table,omitempty"`
+	UsingFilesort       bool `json:"using_filesort"`
+	explainBody
+}
+
+type explainUnionResult struct {
+	UsingTemporaryTable bool                `json:"using_temporary_table"`
+	TableName           string              `json:"table_name"`
+	AccessType          string              `json:"access_type"`
+	QuerySpecifications []*explainSubselect `json:"query_specifications"`
+}
+
+type explainSubselect struct {
+	UsingTemporaryTable bool               `json:"using_temporary_table,omitempty"`
+	Dependent           bool               `json:"dependent"`
+	Cacheable           bool               `json:"cacheable"`
+	QueryBlock          *explainQueryBlock `json:"query_block"`
+}
+
+type explainNestedLoopEntry struct {
+	Table *explainTable `json:"table"`
+}
+
+type explainTable struct {
+	Insert                   bool              `json:"insert,omitempty"`
+	Update                   bool              `json:"update,omitempty"`
+	Delete                   bool              `json:"delete,omitempty"`
+	TableName                string            `json:"table_name"`
+	AccessType               string            `json:"access_type"`
+	PossibleKeys             []string          `json:"possible_keys,omitempty"`
+	Key                      string            `json:"key,omitempty"`
+	UsedKeyParts             []string          `json:"used_key_parts,omitempty"`
+	KeyLength                string            `json:"key_length,omitempty"`
+	Ref                      []string          `json:"ref,omitempty"`
+	RowsExaminedPerScan      uint64            `json:"rows_examined_per_scan,omitempty"`
+	RowsProducedPerJoin      uint64            `json:"rows_produced_per_join,omitempty"`
+	Filtered                 string            `json:"filtered,omitempty"`
+	BackwardIndexScan        bool              `json:"backward_index_scan,omitempty"`
+	UsingJoinBuffer          string            `json:"using_join_buffer,omitempty"`
+	CostInfo                 *explainTableCost `json:"cost_info,omitempty"`
+	UsedColumns              []string          `json:"used_columns,omitempty"`
+	IndexCondition           string            `json:"index_condition,omitempty"`
+	AttachedCondition        string            `json:"attached_condition,omitempty"`
+	MaterializedFromSubquery *explainSubselect `json:"materialized_from_subquery,omitempty"`
+
+	// examined is the estimated number of rows read from this table for each row of the tables before it
+	examined float64
+	// selectivity is the estimated fraction of examined rows that satisfy the attached conditions
+	selectivity float64
+	// readCost is the cost of reading a single row from this table
+	readCost float64
+	// conditions are the conditions that are evaluated against the rows read from this table
+	conditions []sql.Expression
+}
+
+type explainTableCost struct {
+	ReadCost   string `json:"read_cost"`
+	EvalCost   string `json:"eval_cost"`
+	PrefixCost string `json:"prefix_cost"`
+}
+
+// describeJSON returns the EXPLAIN FORMAT=JSON document for the analyzed node given.
+func describeJSON(ctx *sql.Context, n sql.Node) (string, error) {
+	b := &explainBuilder{}
+	qb, err := b.queryBlock(ctx, n)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err = enc.Encode(explainJSON{QueryBlock: qb}); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// explainBuilder converts an analyzed plan into the query blocks that are described by EXPLAIN. The same query blocks
+// are used by every format, so that they all agree with each other.
+type explainBuilder struct {
+	lastSelectID int
+}
+
+// queryBlock returns the query block for the node given, assigning it the next select ID.
+func (b *explainBuilder) queryBlock(ctx *sql.Context, n sql.Node) (*explainQueryBlock, error) {
+	if u, ok := unwrapUnion(n); ok {
+		// A UNION doesn't get a select ID of its own, only the query specifications within it do
+		union, err := b.unionResult(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		qb := &explainQueryBlock{explainBody: explainBody{UnionResult: union}}
+		for _, spec := range union.QuerySpecifications {
+			qb.rows += spec.QueryBlock.rows
+		}
+		return qb, nil
+	}
+
+	b.lastSelectID++
+	qb := &explainQueryBlock{SelectID: b.lastSelectID}
+	body, err := b.body(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	qb.explainBody = *body
+
+	var tables []*explainTable
+	for _, t := range qb.tables() {
+		// The table of an INSERT is only written to, so it has no cost
+		if !t.Insert {
+			tables = append(tables, t)
+		}
+	}
+	if len(tables) == 0 {
+		if qb.UnionResult == nil && qb.Table == nil {
+			qb.Message = "No tables used"
+		}
+		qb.rows = 1
+		return qb, nil
+	}
+	cost, rows := estimateTableCosts(tables)
+	qb.CostInfo = &explainQueryCost{QueryCost: formatExplainFloat(cost)}
+	qb.rows = rows
+	return qb, nil
+}
+
+// unwrapUnion returns the Union beneath any nodes that don't change the shape of a query's results.
+func unwrapUnion(n sql.Node) (*Union, bool) {
+	for {
+		switch nn := n.(type) {
+		case *Union:
+			return nn, true
+		case *Limit, *Offset, *Exchange:
+			n = nn.Children()[0]
+		default:
+			return nil, false
+		}
+	}
+}
+
+// unionResult returns the union_result of the UNION given, with one query specification per UNION operand.
+func (b *explainBuilder) unionResult(ctx *sql.Context, u *Union) (*explainUnionResult, error) {
+	var operands []sql.Node
+	var collect func(n sql.Node)
+	collect = func(n sql.Node) {
+		if child, ok := n.(*Union); ok {
+			collect(child.Left())
+			collect(child.Right())
+			return
+		}
+		operands = append(operands, n)
+	}
+	collect(u)
+
+	res := &explainUnionResult{
+		UsingTemporaryTable: u.Distinct,
+		AccessType:          "ALL",
+	}
+	ids := make([]string, len(operands))
+	for i, operand := range operands {
+		qb, err := b.queryBlock(ctx, operand)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = fmt.Sprint(qb.SelectID)
+		res.QuerySpecifications = append(res.QuerySpecifications, &explainSubselect{
+			Cacheable:  true,
+			QueryBlock: qb,
+		})
+	}
+	res.TableName = fmt.Sprintf("<union%s>", strings.Join(ids, ","))
+	return res, nil
+}
+
+// body returns the contents of the query block for the node given.
+func (b *explainBuilder) body(ctx *sql.Context, n sql.Node) (*explainBody, error) {
+	switch n := n.(type) {
+	case *Sort:
+		return b.operation(ctx, n.Child, func(op *explainOperation) *explainBody {
+			op.UsingFilesort = true
+			return &explainBody{OrderingOperation: op}
+		})
+	case *TopN:
+		return b.operation(ctx, n.Child, func(op *explainOperation) *explainBody {
+			op.UsingFilesort = true
+			return &explainBody{OrderingOperation: op}
+		})
+	case *GroupBy:
+		return b.operation(ctx, n.Child, func(op *explainOperation) *explainBody {
+			op.UsingTemporaryTable = true
+			return &explainBody{GroupingOperation: op}
+		})
+	case *Distinct:
+		return b.operation(ctx, n.Child, func(op *explainOperation) *explainBody {
+			op.UsingTemporaryTable = true
+			return &explainBody{DuplicatesRemoval: op}
+		})
+	case *OrderedDistinct:
+		return b.operation(ctx, n.Child, func(op *explainOperation) *explainBody {
+			return &explainBody{DuplicatesRemoval: op}
+		})
+	case *Union:
+		union, err := b.unionResult(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		return &explainBody{UnionResult: union}, nil
+	case *Filter:
+		body, err := b.body(ctx, n.Child)
+		if err != nil {
+			return nil, err
+		}
+		if tables := body.tables(); len(tables) > 0 {
+			tables[len(tables)-1].attachCondition(n.Expression)
+		}
+		return body, nil
+	case *InsertInto:
+		dest, err := b.tables(ctx, n.Destination)
+		if err != nil {
+			return nil, err
+		}
+		body := &explainBody{}
+		if len(dest) == 1 {
+			dest[0].Insert = true
+			dest[0].UsedColumns = nil
+			body.Table = dest[0]
+		}
+		source, err := b.body(ctx, n.Source)
+		if err != nil {
+			return nil, err
+		}
+		if len(source.tables()) > 0 {
+			body.InsertFrom = source
+		}
+		return body, nil
+	case *Update:
+		body, err := b.body(ctx, n.Child)
+		if err != nil {
+			return nil, err
+		}
+		if tables := body.tables(); len(tables) > 0 {
+			tables[0].Update = true
+		}
+		return body, nil
+	case *DeleteFrom:
+		body, err := b.body(ctx, n.Child)
+		if err != nil {
+			return nil, err
+		}
+		if tables := body.tables(); len(tables) > 0 {
+			tables[0].Delete = true
+		}
+		return body, nil
+	case *TableAlias, *SubqueryAlias:
+		return b.tableBody(ctx, n)
+	default:
+		// Nodes that only transform the rows of their child, such as projections, don't appear in the plan
+		if children := n.Children(); len(children) == 1 {
+			return b.body(ctx, children[0])
+		}
+		return b.tableBody(ctx, n)
+	}
+}
+
+// tableBody returns a body that reads the tables of the node given.
+func (b *explainBuilder) tableBody(ctx *sql.Context, n sql.Node) (*explainBody, error) {
+	tables, err := b.tables(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	body := &explainBody{}
+	if len(tables) == 1 {
+		body.Table = tables[0]
+	} else {
+		for _, t := range tables {
+			body.NestedLoop = append(body.NestedLoop, explainNestedLoopEntry{Table: t})
+		}
+	}
+	return body, nil
+}
+
+// operation returns a body containing the operation returned by |wrap|, which is built around the body of |child|.
+func (b *explainBuilder) operation(ctx *sql.Context, child sql.Node, wrap func(*explainOperation) *explainBody) (*explainBody, error) {
+	body, err := b.body(ctx, child)
+	if err != nil {
+		return nil, err
+	}
+	return wrap(&explainOperation{explainBody: *body}), nil
+}
+
+// tables returns the tables read by the node given, in the order that they are joined.
+func (b *explainBuilder) tables(ctx *sql.Context, n sql.Node) ([]*explainTable, error) {
+	switch n := n.(type) {
+	case *JoinNode:
+		left, err := b.tables(ctx, n.Left())
+		if err != nil {
+			return nil, err
+		}
+		right, err := b.tables(ctx, n.Right())
+		if err != nil {
+			return nil, err
+		}
+		if len(right) > 0 {
+			if n.Op.IsHash() {
+				right[0].UsingJoinBuffer = "hash join"
+			}
+			// The condition of a lookup join is represented by the ref of the inner table
+			if n.Filter != nil && !n.Op.IsLookup() {
+				right[len(right)-1].attachCondition(n.Filter)
+			}
+		}
+		return append(left, right...), nil
+	case *Filter:
+		tables, err := b.tables(ctx, n.Child)
+		if err != nil {
+			return nil, err
+		}
+		if len(tables) > 0 {
+			tables[len(tables)-1].attachCondition(n.Expression)
+		}
+		return tables, nil
+	case *TableAlias:
+		tables, err := b.tables(ctx, n.Child)
+		if err != nil {
+			return nil, err
+		}
+		if len(tables) == 1 {
+			tables[0].TableName = n.Name()
+		}
+		return tables, nil
+	case *SubqueryAlias:
+		qb, err := b.queryBlock(ctx, n.Child)
+		if err != nil {
+			return nil, err
+		}
+		return []*explainTable{{
+			TableName:   n.Name(),
+			AccessType:  "ALL",
+			examined:    qb.rows,
+			selectivity: 1,
+			readCost:    explainSeqReadCost,
+			MaterializedFromSubquery: &explainSubselect{
+				UsingTemporaryTable: true,
+				Cacheable:           n.CanCacheResults,
+				QueryBlock:          qb,
+			},
+		}}, nil
+	case *ResolvedTable:
+		if IsDualTable(n.Table) {
+			return nil, nil
+		}
+		t, err := newExplainTable(ctx, n.Name(), n.Table)
+		if err != nil {
+			return nil, err
+		}
+		return []*explainTable{t}, nil
+	case *IndexedTableAccess:
+		t, err := newExplainIndexedTable(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		return []*explainTable{t}, nil
+	case *ValueDerivedTable:
+		return []*explainTable{{
+			TableName:   n.Name(),
+			AccessType:  "ALL",
+			examined:    float64(len(n.ExpressionTuples)),
+			selectivity: 1,
+			readCost:    explainSeqReadCost,
+		}}, nil
+	case *Values:
+		return nil, nil
+	}
+
+	children := n.Children()
+	if len(children) == 0 {
+		// Any other leaf, such as a table function, is treated as a full scan of a table without statistics
+		if nameable, ok := n.(sql.Nameable); ok {
+			return []*explainTable{{
+				TableName:   nameable.Name(),
+				AccessType:  "ALL",
+				examined:    explainDefaultRowCount,
+				selectivity: 1,
+				readCost:    explainSeqReadCost,
+			}}, nil
+		}
+		return nil, nil
+	}
+
+	var tables []*explainTable
+	for _, child := range children {
+		childTables, err := b.tables(ctx, child)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, childTables...)
+	}
+	return tables, nil
+}
+
+// newExplainTable returns the explainTable for a full scan of the table given.
+func newExplainTable(ctx *sql.Context, name string, table sql.Table) (*explainTable, error) {
+	rows, err := explainRowCount(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	t := &explainTable{
+		TableName:   name,
+		AccessType:  "ALL",
+		UsedColumns: explainUsedColumns(table),
+		examined:    rows,
+		selectivity: 1,
+		readCost:    explainSeqReadCost,
+	}
+	t.attachPushedFilters(table, true)
+	return t, nil
+}
+
+// newExplainIndexedTable returns the explainTable for an index lookup into a table.
+func newExplainIndexedTable(ctx *sql.Context, n *IndexedTableAccess) (*explainTable, error) {
+	rows, err := explainRowCount(ctx, n.ResolvedTable.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	index := n.Index()
+	keyParts := make([]string, len(index.Expressions()))
+	for i, expr := range index.Expressions() {
+		keyParts[i] = expr[strings.LastIndex(expr, ".")+1:]
+	}
+
+	t := &explainTable{
+		TableName:    n.ResolvedTable.Name(),
+		PossibleKeys: []string{index.ID()},
+		Key:          index.ID(),
+		UsedColumns:  explainUsedColumns(n.Table),
+		selectivity:  1,
+	}
+
+	if n.lookup.IsEmpty() {
+		// The lookup is built from the rows of the tables before this one, as in a lookup join
+		keyExprs := n.lb.Expressions()
+		usedParts := len(keyExprs)
+		if usedParts > len(keyParts) {
+			usedParts = len(keyParts)
+		}
+		t.UsedKeyParts = keyParts[:usedParts]
+		for _, expr := range keyExprs {
+			t.Ref = append(t.Ref, expr.String())
+		}
+		t.readCost = explainRandReadCost
+		if index.IsUnique() && usedParts == len(keyParts) {
+			t.AccessType = "eq_ref"
+			t.examined = 1
+		} else {
+			t.AccessType = "ref"
+			t.examined = math.Max(1, rows*explainRefSelectivity)
+		}
+	} else {
+		t.AccessType, t.examined, t.UsedKeyParts = explainStaticLookup(n.lookup, keyParts, rows)
+		t.BackwardIndexScan = n.lookup.IsReverse
+		if t.AccessType == "const" {
+			t.readCost = explainRandReadCost
+		} else {
+			t.readCost = explainSeqReadCost
+		}
+	}
+
+	t.KeyLength = fmt.Sprint(explainKeyLength(n.ResolvedTable.Schema(), index, len(t.UsedKeyParts)))
+
+	// The rows estimated for the lookup already account for the conditions on the index
+	t.attachPushedFilters(n.Table, false)
+	if ict, ok := n.Table.(sql.IndexConditionTable); ok && ict.IndexCondition() != nil {
+		t.IndexCondition = ict.IndexCondition().String()
+	}
+	return t, nil
+}
+
+// explainStaticLookup returns the access type, estimated rows, and used key parts of a lookup whose ranges are known
+// before execution.
+func explainStaticLookup(lookup sql.IndexLookup, keyParts []string, rows float64) (string, float64, []string) {
+	usedParts := 0
+	allEquals := true
+	for _, rang := range lookup.Ranges {
+		for i, col := range rang {
+			if col.Type() != sql.RangeType_All && i+1 > usedParts {
+				usedParts = i + 1
+			}
+			if isEquals, err := col.RepresentsEquals(); err != nil || !isEquals {
+				allEquals = false
+			}
+		}
+	}
+
+	if usedParts == 0 {
+		// Every range covers the whole index, so this is a scan of the index rather than a lookup
+		return "index", rows, keyParts
+	}
+	if usedParts > len(keyParts) {
+		usedParts = len(keyParts)
+	}
+
+	ranges := float64(len(lookup.Ranges))
+	if allEquals && lookup.Index.IsUnique() && usedParts == len(keyParts) {
+		if len(lookup.Ranges) == 1 {
+			return "const", 1, keyParts[:usedParts]
+		}
+		return "range", ranges, keyParts[:usedParts]
+	}
+
+	selectivity := explainRangeSelectivity
+	if allEquals {
+		selectivity = explainRefSelectivity
+	}
+	return "range", math.Min(rows, math.Max(1, ranges*rows*selectivity)), keyParts[:usedParts]
+}
+
+// explainKeyLength returns the number of bytes of the first |usedParts| columns of an index, which is how MySQL reports
+// the part of an index that is used by a lookup.
+func explainKeyLength(sch sql.Schema, index sql.Index, usedParts int) int64 {
+	var length int64
+	prefixLengths := index.PrefixLengths()
+	for i, col := range index.ColumnExpressionTypes() {
+		if i >= usedParts {
+			break
+		}
+		nullable := false
+		if idx := sch.IndexOfColName(col.Expression[strings.LastIndex(col.Expression, ".")+1:]); idx >= 0 {
+			nullable = sch[idx].Nullable
+		}
+		var prefixLength uint16
+		if i < len(prefixLengths) {
+			prefixLength = prefixLengths[i]
+		}
+		length += explainKeyPartLength(col.Type, nullable, prefixLength)
+	}
+	return length
+}
+
+// explainKeyPartLength returns the number of bytes used by a single index column of the type given.
+func explainKeyPartLength(typ sql.Type, nullable bool, prefixLength uint16) int64 {
+	var length int64
+	switch typ.Type() {
+	case sqltypes.Int8, sqltypes.Uint8, sqltypes.Year:
+		length = 1
+	case sqltypes.Int16, sqltypes.Uint16:
+		length = 2
+	case sqltypes.Int24, sqltypes.Uint24, sqltypes.Date, sqltypes.Time:
+		length = 3
+	case sqltypes.Int32, sqltypes.Uint32, sqltypes.Float32, sqltypes.Timestamp:
+		length = 4
+	case sqltypes.Int64, sqltypes.Uint64, sqltypes.Float64, sqltypes.Bit:
+		length = 8
+	case sqltypes.Datetime:
+		length = 5
+	case sqltypes.Decimal:
+		if dt, ok := typ.(sql.DecimalType); ok {
+			length = explainDecimalLength(int(dt.Precision()), int(dt.Scale()))
+		}
+	case sqltypes.Enum, sqltypes.Set:
+		length = 2
+	default:
+		if st, ok := typ.(sql.StringType); ok {
+			length = st.MaxByteLength()
+			if prefixLength > 0 {
+				length = int64(prefixLength) * st.CharacterSet().MaxLength()
+			}
+			switch typ.Type() {
+			case sqltypes.Char, sqltypes.Binary:
+			default:
+				// Variable length strings store their length alongside their contents
+				length += 2
+			}
+		}
+	}
+	if nullable {
+		length++
+	}
+	return length
+}
+
+// explainDecimalLength returns the number of bytes used to store a decimal with the precision and scale given, which
+// packs every nine digits into four bytes.
+func explainDecimalLength(precision, scale int) int64 {
+	leftoverBytes := []int64{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+	integral := precision - scale
+	return int64(integral/9*4) + leftoverBytes[integral%9] + int64(scale/9*4) + leftoverBytes[scale%9]
+}
+
+// explainRowCount returns the number of rows in the table given, or a default estimate if the table does not report
+// its statistics.
+func explainRowCount(ctx *sql.Context, table sql.Table) (float64, error) {
+	if w, ok := table.(sql.TableWrapper); ok {
+		table = w.Underlying()
+	}
+	st, ok := table.(sql.StatisticsTable)
+	if !ok {
+		return explainDefaultRowCount, nil
+	}
+	rows, err := st.RowCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return math.Max(1, float64(rows)), nil
+}
+
+// explainUsedColumns returns the columns of the table given that are read by the query.
+func explainUsedColumns(table sql.Table) []string {
+	if pt, ok := table.(sql.ProjectedTable); ok && pt.Projections() != nil {
+		columns := make([]string, len(pt.Projections()))
+		for i, c := range pt.Projections() {
+			columns[i] = strings.ToLower(c)
+		}
+		return columns
+	}
+	columns := make([]string, len(table.Schema()))
+	for i, c := range table.Schema() {
+		columns[i] = strings.ToLower(c.Name)
+	}
+	return columns
+}
+
+// attachPushedFilters attaches the filters that were pushed down into the table given, optionally including them in
+// the estimate of the rows the table produces.
+func (t *explainTable) attachPushedFilters(table sql.Table, estimate bool) {
+	if ft, ok := table.(sql.FilteredTable); ok {
+		for _, f := range ft.Filters() {
+			t.conditions = append(t.conditions, f)
+			if estimate {
+				t.selectivity *= explainSelectivity(f)
+			}
+		}
+		if len(t.conditions) > 0 {
+			t.AttachedCondition = expression.JoinAnd(t.conditions...).String()
+		}
+	}
+}
+
+// attachCondition adds a condition that is evaluated against the rows of this table.
+func (t *explainTable) attachCondition(cond sql.Expression) {
+	t.conditions = append(t.conditions, cond)
+	t.AttachedCondition = expression.JoinAnd(t.conditions...).String()
+	t.selectivity *= explainSelectivity(cond)
+}
+
+// explainSelectivity returns the estimated fraction of rows that satisfy the condition given, using the same fixed
+// guesses as MySQL does for conditions without histograms.
+func explainSelectivity(cond sql.Expression) float64 {
+	switch cond := cond.(type) {
+	case *expression.And:
+		return explainSelectivity(cond.Left) * explainSelectivity(cond.Right)
+	case *expression.Or:
+		l, r := explainSelectivity(cond.Left), explainSelectivity(cond.Right)
+		return l + r - l*r
+	case *expression.Equals, *expression.NullSafeEquals, *expression.InTuple:
+		return explainRefSelectivity
+	case *expression.GreaterThan, *expression.GreaterThanOrEqual, *expression.LessThan, *expression.LessThanOrEqual,
+		*expression.Between, *expression.Like:
+		return explainRangeSelectivity
+	default:
+		return 1
+	}
+}
+
+// estimateTableCosts fills in the row and cost estimates of the tables of a query block, which are given in join
+// order. It returns the total cost and the number of rows produced by the query block.
+func estimateTableCosts(tables []*explainTable) (float64, float64) {
+	prefixRows, prefixCost := 1.0, 0.0
+	for _, t := range tables {
+		examined := prefixRows * t.examined
+		readCost := examined * t.readCost
+		evalCost := examined * explainRowEvalCost
+		prefixRows = examined * t.selectivity
+		prefixCost += readCost + evalCost
+
+		t.RowsExaminedPerScan = uint64(math.Max(1, math.Round(t.examined)))
+		t.RowsProducedPerJoin = uint64(math.Max(1, math.Round(prefixRows)))
+		t.Filtered = formatExplainFloat(t.selectivity * 100)
+		t.CostInfo = &explainTableCost{
+			ReadCost:   formatExplainFloat(readCost),
+			EvalCost:   formatExplainFloat(evalCost),
+			PrefixCost: formatExplainFloat(prefixCost),
+		}
+	}
+	return prefixCost, prefixRows
+}
+
+// tables returns the tables of this body in join order, including those nested within its operations.
+func (b *explainBody) tables() []*explainTable {
+	var tables []*explainTable
+	for _, op := range []*explainOperation{b.OrderingOperation, b.GroupingOperation, b.DuplicatesRemoval} {
+		if op != nil {
+			tables = append(tables, op.tables()...)
+		}
+	}
+	if b.Table != nil {
+		tables = append(tables, b.Table)
+	}
+	for _, entry := range b.NestedLoop {
+		tables = append(tables, entry.Table)
+	}
+	if b.InsertFrom != nil {
+		tables = append(tables, b.InsertFrom.tables()...)
+	}
+	return tables
+}
+
+func formatExplainFloat(f float64) string {
+	return fmt.Sprintf("%.2f", f)
+}
@@ -108,3 +108,95 @@ func TestDescribeQuery(t *testing.T) {
 
 	require.Equal(expected, rows)
 }
+
+func TestDescribeQueryJSON(t *testing.T) {
+	require := require.New(t)
+
+	table := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Source: "foo", Name: "a", Type: types.Text},
+		{Source: "foo", Name: "b", Type: types.Text},
+	}), nil)
+
+	node := NewDescribeQuery(DescribeFormatJSON, NewSort(
+		[]sql.SortField{{Column: expression.NewGetFieldWithTable(1, types.Text, "foo", "b", false)}},
+		NewFilter(
+			expression.NewEquals(
+				expression.NewGetFieldWithTable(0, types.Text, "foo", "a", false),
+				expression.NewLiteral("foo", types.LongText),
+			),
+			NewResolvedTable(table, nil, nil),
+		),
+	))
+	require.Equal(DescribeJSONSchema, node.Schema())
+
+	ctx := sql.NewEmptyContext()
+	iter, err := node.RowIter(ctx, nil)
+	require.NoError(err)
+
+	rows, err := sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(err)
+
+	expected := `{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {
+      "query_cost": "0.35"
+    },
+    "ordering_operation": {
+      "using_filesort": true,
+      "table": {
+        "table_name": "foo",
+        "access_type": "ALL",
+        "rows_examined_per_scan": 1,
+        "rows_produced_per_join": 1,
+        "filtered": "10.00",
+        "cost_info": {
+          "read_cost": "0.25",
+          "eval_cost": "0.10",
+          "prefix_cost": "0.35"
+        },
+        "used_columns": [
+          "a",
+          "b"
+        ],
+        "attached_condition": "(foo.a = 'foo')"
+      }
+    }
+  }
+}`
+	require.Equal([]sql.Row{{expected}}, rows)
+}
+
+func TestDescribeQueryTraditional(t *testing.T) {
+	require := require.New(t)
+
+	table := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Source: "foo", Name: "a", Type: types.Text},
+		{Source: "foo", Name: "b", Type: types.Text},
+	}), nil)
+
+	node := NewDescribeQuery(DescribeFormatTraditional, NewGroupBy(
+		[]sql.Expression{expression.NewGetFieldWithTable(1, types.Text, "foo", "b", false)},
+		[]sql.Expression{expression.NewGetFieldWithTable(1, types.Text, "foo", "b", false)},
+		NewFilter(
+			expression.NewGreaterThan(
+				expression.NewGetFieldWithTable(0, types.Text, "foo", "a", false),
+				expression.NewLiteral("foo", types.LongText),
+			),
+			NewResolvedTable(table, nil, nil),
+		),
+	))
+	require.Equal(DescribeTraditionalSchema, node.Schema())
+
+	ctx := sql.NewEmptyContext()
+	iter, err := node.RowIter(ctx, nil)
+	require.NoError(err)
+
+	rows, err := sql.RowIterToRows(ctx, nil, iter)
+	require.NoError(err)
+
+	expected := []sql.Row{
+		{int64(1), "SIMPLE", "foo", nil, "ALL", nil, nil, nil, nil, int64(1), 33.33, "Using where; Using temporary"},
+	}
+	require.Equal(expected, rows)
+}
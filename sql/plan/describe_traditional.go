@@ -0,0 +1,214 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// DescribeTraditionalSchema is the schema returned by a DescribeQuery node with the traditional format, which has
+// the same columns as MySQL's EXPLAIN.
+var DescribeTraditionalSchema = sql.Schema{
+	{Name: "id", Type: types.Int64, Nullable: true},
+	{Name: "select_type", Type: types.LongText},
+	{Name: "table", Type: types.LongText, Nullable: true},
+	{Name: "partitions", Type: types.LongText, Nullable: true},
+	{Name: "type", Type: types.LongText, Nullable: true},
+	{Name: "possible_keys", Type: types.LongText, Nullable: true},
+	{Name: "key", Type: types.LongText, Nullable: true},
+	{Name: "key_len", Type: types.LongText, Nullable: true},
+	{Name: "ref", Type: types.LongText, Nullable: true},
+	{Name: "rows", Type: types.Int64, Nullable: true},
+	{Name: "filtered", Type: types.Float64, Nullable: true},
+	{Name: "Extra", Type: types.LongText, Nullable: true},
+}
+
+// describeTraditional returns the rows of EXPLAIN FORMAT=TRADITIONAL for the analyzed node given, with one row for
+// each table read by the query.
+func describeTraditional(ctx *sql.Context, n sql.Node) ([]sql.Row, error) {
+	b := &explainBuilder{}
+	qb, err := b.queryBlock(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &explainRowWriter{}
+	selectType := "PRIMARY"
+	if b.lastSelectID <= 1 && qb.UnionResult == nil {
+		selectType = "SIMPLE"
+	}
+	w.queryBlock(qb, selectType)
+	return w.rows, nil
+}
+
+// explainRowWriter flattens query blocks into the rows of EXPLAIN FORMAT=TRADITIONAL.
+type explainRowWriter struct {
+	rows []sql.Row
+}
+
+// queryBlock writes the rows of the query block given, followed by the rows of the derived tables it reads.
+func (w *explainRowWriter) queryBlock(qb *explainQueryBlock, selectType string) {
+	if qb.UnionResult != nil {
+		w.unionResult(qb.UnionResult, selectType)
+		return
+	}
+
+	id := int64(qb.SelectID)
+	if qb.Message != "" {
+		w.rows = append(w.rows, sql.Row{id, selectType, nil, nil, nil, nil, nil, nil, nil, nil, nil, qb.Message})
+		return
+	}
+
+	opExtras := qb.explainBody.operationExtras()
+	tables := qb.tables()
+	for i, t := range tables {
+		var extras []string
+		if t.IndexCondition != "" {
+			extras = append(extras, "Using index condition")
+		}
+		if t.AttachedCondition != "" {
+			extras = append(extras, "Using where")
+		}
+		if t.BackwardIndexScan {
+			extras = append(extras, "Backward index scan")
+		}
+		if i == 0 {
+			extras = append(extras, opExtras...)
+		}
+		if t.UsingJoinBuffer != "" {
+			extras = append(extras, fmt.Sprintf("Using join buffer (%s)", t.UsingJoinBuffer))
+		}
+		w.rows = append(w.rows, t.traditionalRow(id, selectType, extras))
+	}
+
+	for _, t := range tables {
+		if t.MaterializedFromSubquery != nil {
+			w.queryBlock(t.MaterializedFromSubquery.QueryBlock, "DERIVED")
+		}
+	}
+}
+
+// unionResult writes the rows of each query specification of a UNION, followed by the row of the UNION's result.
+func (w *explainRowWriter) unionResult(union *explainUnionResult, selectType string) {
+	for i, spec := range union.QuerySpecifications {
+		specType := "UNION"
+		if i == 0 {
+			specType = selectType
+		}
+		w.queryBlock(spec.QueryBlock, specType)
+	}
+
+	var extra interface{}
+	if union.UsingTemporaryTable {
+		extra = "Using temporary"
+	}
+	w.rows = append(w.rows, sql.Row{nil, "UNION RESULT", union.TableName, nil, union.AccessType, nil, nil, nil, nil, nil, nil, extra})
+}
+
+// operationExtras returns the Extra notes for the operations of this body, which MySQL reports on the first table of
+// the query block.
+func (b *explainBody) operationExtras() []string {
+	var temporary, filesort bool
+	for body := b; body != nil; {
+		var op *explainOperation
+		switch {
+		case body.OrderingOperation != nil:
+			op = body.OrderingOperation
+		case body.GroupingOperation != nil:
+			op = body.GroupingOperation
+		case body.DuplicatesRemoval != nil:
+			op = body.DuplicatesRemoval
+		default:
+			body = nil
+			continue
+		}
+		temporary = temporary || op.UsingTemporaryTable
+		filesort = filesort || op.UsingFilesort
+		body = &op.explainBody
+	}
+
+	var extras []string
+	if temporary {
+		extras = append(extras, "Using temporary")
+	}
+	if filesort {
+		extras = append(extras, "Using filesort")
+	}
+	return extras
+}
+
+// traditionalRow returns the row of EXPLAIN FORMAT=TRADITIONAL for this table.
+func (t *explainTable) traditionalRow(id int64, selectType string, extras []string) sql.Row {
+	switch {
+	case t.Insert:
+		selectType = "INSERT"
+	case t.Update:
+		selectType = "UPDATE"
+	case t.Delete:
+		selectType = "DELETE"
+	}
+
+	table := t.TableName
+	if t.MaterializedFromSubquery != nil {
+		table = fmt.Sprintf("<derived%d>", t.MaterializedFromSubquery.QueryBlock.firstSelectID())
+	}
+
+	ref := strings.Join(t.Ref, ",")
+	if ref == "" && t.AccessType == "const" {
+		ref = "const"
+	}
+
+	// Tables that are only written to, such as the table of an INSERT, have no estimates
+	var rows, filtered interface{}
+	if t.CostInfo != nil {
+		rows = int64(t.RowsExaminedPerScan)
+		filtered = math.Round(t.selectivity*10000) / 100
+	}
+
+	return sql.Row{
+		id,
+		selectType,
+		table,
+		nil,
+		t.AccessType,
+		explainNullableString(strings.Join(t.PossibleKeys, ",")),
+		explainNullableString(t.Key),
+		explainNullableString(t.KeyLength),
+		explainNullableString(ref),
+		rows,
+		filtered,
+		explainNullableString(strings.Join(extras, "; ")),
+	}
+}
+
+// firstSelectID returns the select ID of this query block, or of its first query specification if it's a UNION.
+func (qb *explainQueryBlock) firstSelectID() int {
+	if qb.UnionResult != nil && len(qb.UnionResult.QuerySpecifications) > 0 {
+		return qb.UnionResult.QuerySpecifications[0].QueryBlock.firstSelectID()
+	}
+	return qb.SelectID
+}
+
+func explainNullableString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -18,6 +18,7 @@ import (
 	"io"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // Distinct is a node that ensures all rows that come from it are unique.
@@ -50,7 +51,7 @@ func (d *Distinct) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, newDistinctIter(ctx, it)), nil
+	return sql.NewSpanIter(span, newDistinctIter(ctx, d.Child.Schema(), it)), nil
 }
 
 // WithChildren implements the Node interface.
@@ -87,25 +88,53 @@ func (d Distinct) DebugString() string {
 }
 
 // distinctIter keeps track of the hashes of all rows that have been emitted.
-// It does not emit any rows whose hashes have been seen already.
-// TODO: come up with a way to use less memory than keeping all hashes in memory.
-// Even though they are just 64-bit integers, this could be a problem in large
-// result sets.
+// It does not emit any rows whose hashes have been seen already. The hashes are
+// kept in memory up to the size given by the tmp_table_size system variable,
+// and spilled to disk past that.
 type distinctIter struct {
 	childIter sql.RowIter
-	seen      sql.KeyValueCache
+	hasher    *types.RowHasher
+	seen      sql.HashSet
 	dispose   sql.DisposeFunc
 }
 
-func newDistinctIter(ctx *sql.Context, child sql.RowIter) *distinctIter {
-	cache, dispose := ctx.Memory.NewHistoryCache()
+func newDistinctIter(ctx *sql.Context, sch sql.Schema, child sql.RowIter) *distinctIter {
+	seen, dispose := ctx.Memory.NewHashSet(tmpTableSize(ctx), tmpDir(ctx))
 	return &distinctIter{
 		childIter: child,
-		seen:      cache,
+		hasher:    types.NewRowHasher(sch),
+		seen:      seen,
 		dispose:   dispose,
 	}
 }
 
+// tmpTableSize returns the memory that a temporary table, such as the set of rows seen by a DISTINCT, may use before
+// it's spilled to disk, given by the tmp_table_size system variable.
+func tmpTableSize(ctx *sql.Context) uint64 {
+	v, err := ctx.GetSessionVariable(ctx, "tmp_table_size")
+	if err != nil {
+		return defaultTmpTableSize
+	}
+	size, ok := v.(uint64)
+	if !ok {
+		return defaultTmpTableSize
+	}
+	return size
+}
+
+const defaultTmpTableSize = 16 * 1024 * 1024
+
+// tmpDir returns the directory for the files of temporary tables that are spilled to disk, given by the tmpdir system
+// variable.
+func tmpDir(ctx *sql.Context) string {
+	v, err := ctx.GetSessionVariable(ctx, "tmpdir")
+	if err != nil {
+		return ""
+	}
+	dir, _ := v.(string)
+	return dir
+}
+
 func (di *distinctIter) Next(ctx *sql.Context) (sql.Row, error) {
 	for {
 		row, err := di.childIter.Next(ctx)
@@ -116,18 +145,22 @@ func (di *distinctIter) Next(ctx *sql.Context) (sql.Row, error) {
 			return nil, err
 		}
 
-		hash, err := sql.HashOf(row)
+		row, err = sql.LoadRow(ctx, row)
 		if err != nil {
 			return nil, err
 		}
-
-		if _, err := di.seen.Get(hash); err == nil {
-			continue
+		hash, err := di.hasher.HashOf(row)
+		if err != nil {
+			return nil, err
 		}
 
-		if err := di.seen.Put(hash, struct{}{}); err != nil {
+		added, err := di.seen.Add(hash)
+		if err != nil {
 			return nil, err
 		}
+		if !added {
+			continue
+		}
 
 		return row, nil
 	}
@@ -141,6 +174,7 @@ func (di *distinctIter) Close(ctx *sql.Context) error {
 func (di *distinctIter) Dispose() {
 	if di.dispose != nil {
 		di.dispose()
+		di.dispose = nil
 	}
 }
 
@@ -169,7 +203,7 @@ func (d *OrderedDistinct) Resolved() bool {
 func (d *OrderedDistinct) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.OrderedDistinct")
 
-	it, err := d.Child.RowIter(ctx, nil)
+	it, err := d.Child.RowIter(ctx, row)
 	if err != nil {
 		span.End()
 		return nil, err
@@ -222,6 +256,10 @@ func (di *orderedDistinctIter) Next(ctx *sql.Context) (sql.Row, error) {
 		if err != nil {
 			return nil, err
 		}
+		row, err = sql.LoadRow(ctx, row)
+		if err != nil {
+			return nil, err
+		}
 
 		if di.prevRow != nil {
 			ok, err := di.prevRow.Equals(row, di.schema)
@@ -232,7 +232,7 @@ func (e *Exchange) getRowIter2Func() func(*sql.Context, sql.Partition, *sql.RowF
 		if err != nil {
 			return nil, err
 		}
-		return node.(sql.Node2).RowIter2(ctx, frame)
+		return sql.NewRowIter2(ctx, node, frame)
 	}
 }
 
@@ -25,6 +25,7 @@ type Filter struct {
 }
 
 var _ sql.Node = (*Filter)(nil)
+var _ sql.Node2 = (*Filter)(nil)
 var _ sql.CollationCoercible = (*Filter)(nil)
 
 // NewFilter creates a new filter node.
@@ -53,6 +54,19 @@ func (f *Filter) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	return sql.NewSpanIter(span, NewFilterIter(f.Expression, i)), nil
 }
 
+// RowIter2 implements the sql.Node2 interface.
+func (f *Filter) RowIter2(ctx *sql.Context, frame *sql.RowFrame) (sql.RowIter2, error) {
+	span, ctx := ctx.Span("plan.Filter")
+
+	i, err := sql.NewRowIter2(ctx, f.Child, frame)
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+
+	return sql.NewSpanIter(span, NewFilterIter(f.Expression, i)).(sql.RowIter2), nil
+}
+
 // WithChildren implements the Node interface.
 func (f *Filter) WithChildren(children ...sql.Node) (sql.Node, error) {
 	if len(children) != 1 {
@@ -107,14 +121,20 @@ func (f *Filter) Expressions() []sql.Expression {
 type FilterIter struct {
 	cond      sql.Expression
 	childIter sql.RowIter
+	// childIter2 is the child iterator as a RowIter2, if it is one
+	childIter2 sql.RowIter2
 }
 
+var _ sql.RowReuser = (*FilterIter)(nil)
+var _ sql.RowIter2 = (*FilterIter)(nil)
+
 // NewFilterIter creates a new FilterIter.
 func NewFilterIter(
 	cond sql.Expression,
 	child sql.RowIter,
 ) *FilterIter {
-	return &FilterIter{cond: cond, childIter: child}
+	childIter2, _ := child.(sql.RowIter2)
+	return &FilterIter{cond: cond, childIter: child, childIter2: childIter2}
 }
 
 // Next implements the RowIter interface.
@@ -136,6 +156,40 @@ func (i *FilterIter) Next(ctx *sql.Context) (sql.Row, error) {
 	}
 }
 
+// Next2 implements the sql.RowIter2 interface. The rows of the child are read into the frame given after the values it
+// already has, and those of the rows that don't match the condition are removed again.
+func (i *FilterIter) Next2(ctx *sql.Context, frame *sql.RowFrame) error {
+	cond := i.cond.(sql.Expression2)
+	zero := cond.Type2().Zero2()
+	start := len(frame.Values)
+	for {
+		if err := i.childIter2.Next2(ctx, frame); err != nil {
+			return err
+		}
+
+		res, err := cond.Eval2(ctx, frame.Row2()[start:])
+		if err != nil {
+			return err
+		}
+		if !res.IsNull() {
+			cmp, err := cond.Type2().Compare2(res, zero)
+			if err != nil {
+				return err
+			}
+			if cmp != 0 {
+				return nil
+			}
+		}
+		frame.Truncate(start)
+	}
+}
+
+// ReuseRows implements the sql.RowReuser interface. Rows are returned as they're read from the child, so the child
+// can reuse them when the caller of this iterator doesn't keep them.
+func (i *FilterIter) ReuseRows() {
+	sql.ReuseRows(i.childIter)
+}
+
 // Close implements the RowIter interface.
 func (i *FilterIter) Close(ctx *sql.Context) error {
 	return i.childIter.Close(ctx)
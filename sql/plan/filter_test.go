@@ -102,3 +102,41 @@ func TestFilter(t *testing.T) {
 	require.Equal(int32(3333), row[2])
 	require.Equal(int64(4444), row[3])
 }
+
+func TestFilterRowIter2(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	child := memory.NewTable("test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int32, Nullable: true},
+		{Name: "b", Type: types.Int64, Nullable: true},
+	}), nil)
+	for _, r := range []sql.Row{
+		sql.NewRow(int32(1), int64(10)),
+		sql.NewRow(int32(2), nil),
+		sql.NewRow(int32(3), int64(30)),
+		sql.NewRow(int32(4), int64(40)),
+	} {
+		require.NoError(child.Insert(ctx, r))
+	}
+
+	a := expression.NewGetField(0, types.Int32, "a", true)
+	b := expression.NewGetField(1, types.Int64, "b", true)
+	cond := expression.NewAnd(
+		expression.NewGreaterThan(a, expression.NewLiteral(int8(1), types.Int8)),
+		expression.NewNot(expression.NewEquals(b, expression.NewLiteral(int64(30), types.Int64))),
+	)
+
+	// The rows of a child that doesn't implement sql.Node2 are converted to row frames
+	for _, c := range []sql.Node{
+		NewResolvedTable(child, nil, nil),
+		NewOffset(expression.NewLiteral(int64(0), types.Int64), NewResolvedTable(child, nil, nil)),
+	} {
+		f := NewFilter(cond, c)
+		iter, err := f.RowIter2(ctx, nil)
+		require.NoError(err)
+		rows, err := sql.RowIter2ToRows(ctx, f.Schema(), iter)
+		require.NoError(err)
+		require.Equal([]sql.Row{{int32(4), int64(40)}}, rows)
+	}
+}
@@ -15,12 +15,16 @@
 package plan
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
-// FlushPrivileges reads privileges from mysql tables and registers any unregistered privileges found.
+// FlushPrivileges reloads the grant tables from the integrator's persistence, if it supports loading them, or
+// otherwise persists any changes made directly to the grant tables, and makes them take effect.
 type FlushPrivileges struct {
 	writesToBinlog bool
 	mysqlDb        sql.Database
@@ -44,7 +48,7 @@ func (f *FlushPrivileges) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, err
 	if !ok {
 		return nil, sql.ErrDatabaseNotFound.New("mysql")
 	}
-	err := gts.Persist(ctx)
+	err := gts.Reload(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -101,3 +105,89 @@ func (f *FlushPrivileges) WithDatabase(db sql.Database) (sql.Node, error) {
 	fp.mysqlDb = db
 	return &fp, nil
 }
+
+// Flush is a FLUSH statement other than FLUSH PRIVILEGES. The engine handles its effects on the engine's own state,
+// such as discarding cached query plans for FLUSH TABLES, and passes it along to the database provider if it's a
+// sql.FlushHandler.
+type Flush struct {
+	Option sql.FlushOption
+	// Tables are the tables named by FLUSH TABLES, if any
+	Tables         []sql.DbTable
+	Catalog        sql.Catalog
+	writesToBinlog bool
+}
+
+var _ sql.Node = (*Flush)(nil)
+var _ sql.CollationCoercible = (*Flush)(nil)
+
+// NewFlush creates a new Flush node.
+func NewFlush(option sql.FlushOption, tables []sql.DbTable, writesToBinlog bool) *Flush {
+	return &Flush{
+		Option:         option,
+		Tables:         tables,
+		writesToBinlog: writesToBinlog,
+	}
+}
+
+// RowIter implements the interface sql.Node.
+func (f *Flush) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	tables := make([]sql.DbTable, len(f.Tables))
+	for i, table := range f.Tables {
+		if table.Db == "" {
+			table.Db = ctx.GetCurrentDatabase()
+		}
+		tables[i] = table
+	}
+	if len(tables) == 0 {
+		tables = nil
+	}
+
+	if err := f.Catalog.Flush(ctx, f.Option, tables); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
+}
+
+// String implements the interface sql.Node.
+func (f *Flush) String() string {
+	if len(f.Tables) == 0 {
+		return fmt.Sprintf("FLUSH %s", f.Option)
+	}
+	names := make([]string, len(f.Tables))
+	for i, table := range f.Tables {
+		if table.Db == "" {
+			names[i] = table.Table
+		} else {
+			names[i] = fmt.Sprintf("%s.%s", table.Db, table.Table)
+		}
+	}
+	return fmt.Sprintf("FLUSH %s %s", f.Option, strings.Join(names, ", "))
+}
+
+// WithChildren implements the interface sql.Node.
+func (f *Flush) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 0)
+	}
+
+	return f, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (f *Flush) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_Reload))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*Flush) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// Resolved implements the interface sql.Node.
+func (*Flush) Resolved() bool { return true }
+
+// Children implements the sql.Node interface.
+func (*Flush) Children() []sql.Node { return nil }
+
+// Schema implements the sql.Node interface.
+func (*Flush) Schema() sql.Schema { return types.OkResultSchema }
@@ -217,7 +217,7 @@ func (p *Privilege) String() string {
 func (p *Privilege) IsValidDynamic() bool {
 	if p.Type == PrivilegeType_Dynamic {
 		switch p.Dynamic {
-		case DynamicPrivilege_ReplicationSlaveAdmin:
+		case DynamicPrivilege_ReplicationSlaveAdmin, DynamicPrivilege_XaRecoverAdmin, DynamicPrivilege_SetUserID:
 			return true
 		}
 	}
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"hash"
 	"io"
 	"strings"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // ErrGroupBy is returned when the aggregation is not supported.
@@ -37,6 +39,35 @@ type GroupBy struct {
 	UnaryNode
 	SelectedExprs []sql.Expression
 	GroupByExprs  []sql.Expression
+	// Modifier is the WITH ROLLUP or WITH CUBE modifier of the grouping, if any.
+	Modifier GroupingModifier
+}
+
+// GroupingModifier is a modifier of a GROUP BY clause, which adds super-aggregate rows to the groups. The grouping
+// expressions that are rolled up in a super-aggregate row are NULL in it, and the GROUPING function tells them apart
+// from NULL values of the groups.
+type GroupingModifier byte
+
+const (
+	// GroupingModifierNone is a GROUP BY clause without modifiers.
+	GroupingModifierNone GroupingModifier = iota
+	// GroupingModifierRollup is the WITH ROLLUP modifier, which adds a super-aggregate row for each prefix of the
+	// grouping expressions, from the longest to the empty one.
+	GroupingModifierRollup
+	// GroupingModifierCube is the WITH CUBE modifier, which adds a super-aggregate row for each subset of the grouping
+	// expressions.
+	GroupingModifierCube
+)
+
+func (m GroupingModifier) String() string {
+	switch m {
+	case GroupingModifierRollup:
+		return "WITH ROLLUP"
+	case GroupingModifierCube:
+		return "WITH CUBE"
+	default:
+		return ""
+	}
 }
 
 var _ sql.Expressioner = (*GroupBy)(nil)
@@ -56,6 +87,13 @@ func NewGroupBy(selectedExprs, groupByExprs []sql.Expression, child sql.Node) *G
 	}
 }
 
+// WithModifier returns a copy of the node with the grouping modifier given.
+func (g *GroupBy) WithModifier(modifier GroupingModifier) *GroupBy {
+	ng := *g
+	ng.Modifier = modifier
+	return &ng
+}
+
 // Resolved implements the Resolvable interface.
 func (g *GroupBy) Resolved() bool {
 	return g.UnaryNode.Child.Resolved() &&
@@ -80,9 +118,10 @@ func (g *GroupBy) Schema() sql.Schema {
 		}
 
 		s[i] = &sql.Column{
-			Name:     name,
-			Type:     e.Type(),
-			Nullable: e.IsNullable(),
+			Name: name,
+			Type: e.Type(),
+			// The grouping expressions rolled up in super-aggregate rows are NULL
+			Nullable: e.IsNullable() || g.Modifier != GroupingModifierNone,
 			Source:   table,
 		}
 	}
@@ -106,6 +145,13 @@ func (g *GroupBy) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	var iter sql.RowIter
 	if len(g.GroupByExprs) == 0 {
 		iter = newGroupByIter(g.SelectedExprs, i)
+	} else if g.Modifier != GroupingModifierNone {
+		iter, err = newGroupingSetsIter(g.SelectedExprs, g.GroupByExprs, g.Modifier, i)
+		if err != nil {
+			span.End()
+			i.Close(ctx)
+			return nil, err
+		}
 	} else {
 		iter = newGroupByGroupingIter(ctx, g.SelectedExprs, g.GroupByExprs, i)
 	}
@@ -119,7 +165,7 @@ func (g *GroupBy) WithChildren(children ...sql.Node) (sql.Node, error) {
 		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), 1)
 	}
 
-	return NewGroupBy(g.SelectedExprs, g.GroupByExprs, children[0]), nil
+	return NewGroupBy(g.SelectedExprs, g.GroupByExprs, children[0]).WithModifier(g.Modifier), nil
 }
 
 // CheckPrivileges implements the interface sql.Node.
@@ -145,7 +191,7 @@ func (g *GroupBy) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 	grouping := make([]sql.Expression, len(g.GroupByExprs))
 	copy(grouping, exprs[len(g.SelectedExprs):])
 
-	return NewGroupBy(agg, grouping, g.Child), nil
+	return NewGroupBy(agg, grouping, g.Child).WithModifier(g.Modifier), nil
 }
 
 func (g *GroupBy) String() string {
@@ -162,9 +208,13 @@ func (g *GroupBy) String() string {
 		grouping[i] = g.String()
 	}
 
+	groupingChild := fmt.Sprintf("Grouping(%s)", strings.Join(grouping, ", "))
+	if g.Modifier != GroupingModifierNone {
+		groupingChild += " " + g.Modifier.String()
+	}
 	_ = pr.WriteChildren(
 		fmt.Sprintf("SelectedExprs(%s)", strings.Join(selectedExprs, ", ")),
-		fmt.Sprintf("Grouping(%s)", strings.Join(grouping, ", ")),
+		groupingChild,
 		g.Child.String(),
 	)
 	return pr.String()
@@ -184,9 +234,13 @@ func (g *GroupBy) DebugString() string {
 		grouping[i] = sql.DebugString(g)
 	}
 
+	groupingChild := fmt.Sprintf("group: %s", strings.Join(grouping, ", "))
+	if g.Modifier != GroupingModifierNone {
+		groupingChild += " " + g.Modifier.String()
+	}
 	_ = pr.WriteChildren(
 		fmt.Sprintf("select: %s", strings.Join(selectedExprs, ", ")),
-		fmt.Sprintf("group: %s", strings.Join(grouping, ", ")),
+		groupingChild,
 		sql.DebugString(g.Child),
 	)
 	return pr.String()
@@ -215,7 +269,7 @@ func (g *GroupBy) WithProjectedExprs(exprs ...sql.Expression) (sql.Projector, er
 	projections := make([]sql.Expression, len(g.SelectedExprs))
 	copy(projections, exprs)
 
-	return NewGroupBy(projections, g.GroupByExprs, g.Child), nil
+	return NewGroupBy(projections, g.GroupByExprs, g.Child).WithModifier(g.Modifier), nil
 }
 
 type groupByIter struct {
@@ -306,6 +360,9 @@ type groupByGroupingIter struct {
 	pos           int
 	child         sql.RowIter
 	dispose       sql.DisposeFunc
+	// hashers write the values of the grouping expressions to the hash of their grouping key
+	hashers []types.HashFunc
+	hash    hash.Hash64
 }
 
 func newGroupByGroupingIter(
@@ -313,10 +370,16 @@ func newGroupByGroupingIter(
 	selectedExprs, groupByExprs []sql.Expression,
 	child sql.RowIter,
 ) *groupByGroupingIter {
+	hashers := make([]types.HashFunc, len(groupByExprs))
+	for i, e := range groupByExprs {
+		hashers[i] = types.Hasher(e.Type())
+	}
 	return &groupByGroupingIter{
 		selectedExprs: selectedExprs,
 		groupByExprs:  groupByExprs,
 		child:         child,
+		hashers:       hashers,
+		hash:          xxhash.New(),
 	}
 }
 
@@ -350,7 +413,7 @@ func (i *groupByGroupingIter) compute(ctx *sql.Context) error {
 			return err
 		}
 
-		key, err := groupingKey(ctx, i.groupByExprs, row)
+		key, err := groupingKey(ctx, i.hash, i.hashers, i.groupByExprs, row)
 		if err != nil {
 			return err
 		}
@@ -422,30 +485,19 @@ func (i *groupByGroupingIter) Dispose() {
 
 func groupingKey(
 	ctx *sql.Context,
+	hash hash.Hash64,
+	hashers []types.HashFunc,
 	exprs []sql.Expression,
 	row sql.Row,
 ) (uint64, error) {
-	hash := xxhash.New()
+	hash.Reset()
 	for i, expr := range exprs {
 		v, err := expr.Eval(ctx, row)
 		if err != nil {
 			return 0, err
 		}
 
-		if i > 0 {
-			// separate each expression in the grouping key with a nil byte
-			if _, err = hash.Write([]byte{0}); err != nil {
-				return 0, err
-			}
-		}
-
-		t, isStringType := expr.Type().(sql.StringType)
-		if isStringType && v != nil {
-			err = t.Collation().WriteWeightString(hash, v.(string))
-		} else {
-			_, err = fmt.Fprintf(hash, "%v", v)
-		}
-		if err != nil {
+		if err = hashers[i](hash, v); err != nil {
 			return 0, err
 		}
 	}
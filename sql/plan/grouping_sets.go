@@ -0,0 +1,300 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/binary"
+	"hash"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/cespare/xxhash"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// maxCubeExpressions is the maximum number of grouping expressions of a GROUP BY clause WITH CUBE, which has a grouping
+// set for each of their subsets.
+const maxCubeExpressions = 16
+
+// groupingSet is a set of the grouping expressions of a GROUP BY clause with a modifier, whose groups are aggregated
+// with the other grouping expressions rolled up.
+type groupingSet struct {
+	// rolledUp has a bit set for each grouping expression rolled up, the first one being the lowest bit
+	rolledUp uint64
+	// selectedExprs are the selected expressions of the grouping node, with the grouping expressions rolled up
+	// replaced with NULL, and the GROUPING functions with their values
+	selectedExprs []sql.Expression
+}
+
+// rolledUpGroup is a group of a grouping set.
+type rolledUpGroup struct {
+	set     *groupingSet
+	values  []interface{}
+	buffers []sql.AggregationBuffer
+}
+
+// groupingSetsIter groups the rows of its child by each of the grouping sets of a grouping modifier. The groups with
+// no grouping expression rolled up are those of the GROUP BY clause, and the others are its super-aggregate rows.
+// Like MySQL, rows are returned ordered by their grouping values, and super-aggregate rows follow the rows they
+// aggregate.
+type groupingSetsIter struct {
+	groupByExprs []sql.Expression
+	sets         []*groupingSet
+	child        sql.RowIter
+	groups       map[uint64]*rolledUpGroup
+	ordered      []*rolledUpGroup
+	pos          int
+	computed     bool
+	hashers      []types.HashFunc
+	hash         hash.Hash64
+}
+
+func newGroupingSetsIter(
+	selectedExprs, groupByExprs []sql.Expression,
+	modifier GroupingModifier,
+	child sql.RowIter,
+) (*groupingSetsIter, error) {
+	n := len(groupByExprs)
+	var masks []uint64
+	switch modifier {
+	case GroupingModifierRollup:
+		if n >= 64 {
+			return nil, sql.ErrUnsupportedFeature.New("WITH ROLLUP of 64 or more grouping expressions")
+		}
+		all := uint64(1)<<n - 1
+		for prefix := n; prefix >= 0; prefix-- {
+			masks = append(masks, all&^(uint64(1)<<prefix-1))
+		}
+	case GroupingModifierCube:
+		if n > maxCubeExpressions {
+			return nil, sql.ErrUnsupportedFeature.New("WITH CUBE of more than 16 grouping expressions")
+		}
+		for mask := uint64(0); mask < uint64(1)<<n; mask++ {
+			masks = append(masks, mask)
+		}
+	}
+
+	sets := make([]*groupingSet, len(masks))
+	for i, mask := range masks {
+		set := &groupingSet{rolledUp: mask, selectedExprs: make([]sql.Expression, len(selectedExprs))}
+		for j, e := range selectedExprs {
+			rolledUp, err := rollUpExpression(e, groupByExprs, mask)
+			if err != nil {
+				return nil, err
+			}
+			set.selectedExprs[j] = rolledUp
+		}
+		sets[i] = set
+	}
+
+	hashers := make([]types.HashFunc, n)
+	for i, e := range groupByExprs {
+		hashers[i] = types.Hasher(e.Type())
+	}
+	return &groupingSetsIter{
+		groupByExprs: groupByExprs,
+		sets:         sets,
+		child:        child,
+		groups:       make(map[uint64]*rolledUpGroup),
+		hashers:      hashers,
+		hash:         xxhash.New(),
+	}, nil
+}
+
+// rollUpExpression returns the selected expression given for the grouping set with the grouping expressions of the
+// mask given rolled up. Aggregations are computed over the rows of the set's groups, so only the other expressions
+// have the grouping expressions rolled up replaced with NULL.
+func rollUpExpression(e sql.Expression, groupByExprs []sql.Expression, mask uint64) (sql.Expression, error) {
+	switch e := e.(type) {
+	case *aggregation.Grouping:
+		var bits int64
+		for i, arg := range e.Children() {
+			idx := groupingExpressionIndex(arg, groupByExprs)
+			if idx < 0 {
+				return nil, sql.ErrGroupingArgumentNotGrouped.New(i + 1)
+			}
+			bits <<= 1
+			if mask&(1<<idx) != 0 {
+				bits |= 1
+			}
+		}
+		return expression.NewLiteral(bits, e.Type()), nil
+	case sql.Aggregation:
+		return e, nil
+	}
+
+	if idx := groupingExpressionIndex(e, groupByExprs); idx >= 0 && mask&(1<<idx) != 0 {
+		return expression.NewLiteral(nil, e.Type()), nil
+	}
+	children := e.Children()
+	if len(children) == 0 {
+		return e, nil
+	}
+	rolledUp := make([]sql.Expression, len(children))
+	for i, child := range children {
+		var err error
+		if rolledUp[i], err = rollUpExpression(child, groupByExprs, mask); err != nil {
+			return nil, err
+		}
+	}
+	return e.WithChildren(rolledUp...)
+}
+
+// groupingExpressionIndex returns the index of the grouping expression that the expression given is, or -1 if it's
+// not one of them.
+func groupingExpressionIndex(e sql.Expression, groupByExprs []sql.Expression) int {
+	for i, g := range groupByExprs {
+		if strings.EqualFold(e.String(), g.String()) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (i *groupingSetsIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if !i.computed {
+		i.computed = true
+		if err := i.compute(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if i.pos >= len(i.ordered) {
+		return nil, io.EOF
+	}
+	group := i.ordered[i.pos]
+	i.pos++
+	return evalBuffers(ctx, group.buffers)
+}
+
+func (i *groupingSetsIter) compute(ctx *sql.Context) error {
+	values := make([]interface{}, len(i.groupByExprs))
+	for {
+		row, err := i.child.Next(ctx)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+
+		for j, e := range i.groupByExprs {
+			if values[j], err = e.Eval(ctx, row); err != nil {
+				return err
+			}
+		}
+		for _, set := range i.sets {
+			group, err := i.group(set, values)
+			if err != nil {
+				return err
+			}
+			if err := updateBuffers(ctx, group.buffers, row); err != nil {
+				return err
+			}
+		}
+	}
+
+	var cmpErr error
+	sort.SliceStable(i.ordered, func(a, b int) bool {
+		cmp, err := i.compare(i.ordered[a], i.ordered[b])
+		if err != nil && cmpErr == nil {
+			cmpErr = err
+		}
+		return cmp < 0
+	})
+	return cmpErr
+}
+
+// group returns the group of the grouping set given with the grouping values given, which is created if it's new.
+func (i *groupingSetsIter) group(set *groupingSet, values []interface{}) (*rolledUpGroup, error) {
+	i.hash.Reset()
+	var mask [8]byte
+	binary.LittleEndian.PutUint64(mask[:], set.rolledUp)
+	if _, err := i.hash.Write(mask[:]); err != nil {
+		return nil, err
+	}
+	for j, v := range values {
+		if set.rolledUp&(1<<j) != 0 {
+			continue
+		}
+		if err := i.hashers[j](i.hash, v); err != nil {
+			return nil, err
+		}
+	}
+	key := i.hash.Sum64()
+
+	if group, ok := i.groups[key]; ok {
+		return group, nil
+	}
+	group := &rolledUpGroup{
+		set:     set,
+		values:  append([]interface{}(nil), values...),
+		buffers: make([]sql.AggregationBuffer, len(set.selectedExprs)),
+	}
+	for j, e := range set.selectedExprs {
+		var err error
+		if group.buffers[j], err = newAggregationBuffer(e); err != nil {
+			return nil, err
+		}
+	}
+	i.groups[key] = group
+	i.ordered = append(i.ordered, group)
+	return group, nil
+}
+
+// compare orders the groups given by their grouping values. A grouping expression rolled up in a group sorts after
+// the values of the groups in which it isn't.
+func (i *groupingSetsIter) compare(a, b *rolledUpGroup) (int, error) {
+	for j, e := range i.groupByExprs {
+		bit := uint64(1) << j
+		aRolledUp, bRolledUp := a.set.rolledUp&bit != 0, b.set.rolledUp&bit != 0
+		switch {
+		case aRolledUp && bRolledUp:
+			continue
+		case aRolledUp:
+			return 1, nil
+		case bRolledUp:
+			return -1, nil
+		}
+		cmp, err := e.Type().Compare(a.values[j], b.values[j])
+		if err != nil {
+			return 0, err
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	return 0, nil
+}
+
+func (i *groupingSetsIter) Close(ctx *sql.Context) error {
+	i.Dispose()
+	i.groups = nil
+	i.ordered = nil
+	return i.child.Close(ctx)
+}
+
+func (i *groupingSetsIter) Dispose() {
+	for _, group := range i.ordered {
+		for _, b := range group.buffers {
+			b.Dispose()
+		}
+	}
+}
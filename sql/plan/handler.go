@@ -0,0 +1,830 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// HandlerOpen is a HANDLER ... OPEN statement, which opens a table for the session to read its rows with HANDLER ...
+// READ statements, under the alias given or else the name of the table.
+// https://dev.mysql.com/doc/refman/8.0/en/handler.html
+type HandlerOpen struct {
+	Database string
+	Table    string
+	Alias    string
+	Catalog  sql.Catalog
+	// Registry keeps track of the handlers of all sessions
+	Registry *sql.HandlerRegistry
+}
+
+var _ sql.Node = (*HandlerOpen)(nil)
+var _ sql.CollationCoercible = (*HandlerOpen)(nil)
+
+// NewHandlerOpen returns a new HandlerOpen node opening the table given under the alias given, if it's not empty.
+func NewHandlerOpen(database, table, alias string) *HandlerOpen {
+	return &HandlerOpen{Database: database, Table: table, Alias: alias}
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerOpen) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (h *HandlerOpen) Children() []sql.Node {
+	return nil
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerOpen) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+// String implements the sql.Node interface.
+func (h *HandlerOpen) String() string {
+	name := h.Table
+	if h.Database != "" {
+		name = fmt.Sprintf("%s.%s", h.Database, h.Table)
+	}
+	if h.Alias != "" {
+		return fmt.Sprintf("HANDLER %s OPEN AS %s", name, h.Alias)
+	}
+	return fmt.Sprintf("HANDLER %s OPEN", name)
+}
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerOpen) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 0)
+	}
+	return h, nil
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (h *HandlerOpen) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(h.database(ctx), h.Table, "", sql.PrivilegeType_Select))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*HandlerOpen) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the sql.Node interface.
+func (h *HandlerOpen) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if h.Registry == nil {
+		return nil, fmt.Errorf("no handler registry available for %s", h)
+	}
+	db := h.database(ctx)
+	if db == "" {
+		return nil, sql.ErrNoDatabaseSelected.New()
+	}
+	table, _, err := h.Catalog.Table(ctx, db, h.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	name := h.Alias
+	if name == "" {
+		name = h.Table
+	}
+	err = h.Registry.Open(ctx.ID(), &sql.Handler{Name: name, Database: db, Table: table.Name()})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
+}
+
+// database returns the database of the table to open, which is the current database if none was given.
+func (h *HandlerOpen) database(ctx *sql.Context) string {
+	if h.Database != "" {
+		return h.Database
+	}
+	return ctx.GetCurrentDatabase()
+}
+
+// HandlerClose is a HANDLER ... CLOSE statement, which closes a handler opened by the session.
+type HandlerClose struct {
+	Name string
+	// Registry keeps track of the handlers of all sessions
+	Registry *sql.HandlerRegistry
+}
+
+var _ sql.Node = (*HandlerClose)(nil)
+var _ sql.CollationCoercible = (*HandlerClose)(nil)
+
+// NewHandlerClose returns a new HandlerClose node closing the handler with the name given.
+func NewHandlerClose(name string) *HandlerClose {
+	return &HandlerClose{Name: name}
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerClose) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (h *HandlerClose) Children() []sql.Node {
+	return nil
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerClose) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+// String implements the sql.Node interface.
+func (h *HandlerClose) String() string {
+	return fmt.Sprintf("HANDLER %s CLOSE", h.Name)
+}
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerClose) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 0)
+	}
+	return h, nil
+}
+
+// CheckPrivileges implements the sql.Node interface. Only handlers opened by the session can be closed.
+func (h *HandlerClose) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*HandlerClose) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the sql.Node interface.
+func (h *HandlerClose) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if h.Registry == nil {
+		return nil, fmt.Errorf("no handler registry available for %s", h)
+	}
+	if err := h.Registry.Close(ctx.ID(), h.Name); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
+}
+
+// HandlerReadMode is the way HANDLER ... READ positions itself in the order of the rows it reads.
+type HandlerReadMode byte
+
+const (
+	// HandlerReadFirst reads from the first row
+	HandlerReadFirst HandlerReadMode = iota
+	// HandlerReadNext reads from the row after the last row read, or the first row if none has been read
+	HandlerReadNext
+	// HandlerReadPrev reads backward from the row before the last row read, or the last row if none has been read
+	HandlerReadPrev
+	// HandlerReadLast reads backward from the last row
+	HandlerReadLast
+	// HandlerReadKey reads from the first row whose key compares to the key values of the statement as its
+	// comparison operator requires, which is the last such row for the < and <= operators, that read backward
+	HandlerReadKey
+)
+
+// String returns the keyword of the read mode.
+func (m HandlerReadMode) String() string {
+	switch m {
+	case HandlerReadFirst:
+		return "FIRST"
+	case HandlerReadNext:
+		return "NEXT"
+	case HandlerReadPrev:
+		return "PREV"
+	case HandlerReadLast:
+		return "LAST"
+	default:
+		return "KEY"
+	}
+}
+
+// HandlerRead is a HANDLER ... READ statement, which reads the next rows of a table opened by the session, in the
+// order of the table or of one of its indexes, from a position given by its read mode. Every statement reads the rows
+// from the table again, with an index lookup that starts at the last row read when the index can be read in order, so
+// rows written by other statements in between can be skipped or read twice, as MySQL allows. Tables without a primary
+// key are read in the order of the table up to the last row read, and indexes that can't be read in order with lookups
+// are read by sorting the rows of the table.
+type HandlerRead struct {
+	Name string
+	// Index is the name of the index to read the rows through, or empty to read them in the order of the table
+	Index string
+	Mode  HandlerReadMode
+	// KeyOp is the comparison operator of HandlerReadKey: =, <=, >=, < or >
+	KeyOp string
+	// Key holds the values of the leading columns of the index that HandlerReadKey compares the rows to
+	Key    []sql.Expression
+	Limit  int64
+	Offset int64
+	// TableSchema is the schema of the table of the handler, set by the analyzer if it's open
+	TableSchema sql.Schema
+	Catalog     sql.Catalog
+	// Registry keeps track of the handlers of all sessions
+	Registry *sql.HandlerRegistry
+}
+
+var _ sql.Node = (*HandlerRead)(nil)
+var _ sql.CollationCoercible = (*HandlerRead)(nil)
+
+// NewHandlerRead returns a new HandlerRead node reading at most one row with the read mode given from the handler
+// with the name given, through the index given, if any.
+func NewHandlerRead(name, index string, mode HandlerReadMode) *HandlerRead {
+	return &HandlerRead{Name: name, Index: index, Mode: mode, Limit: 1}
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerRead) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (h *HandlerRead) Children() []sql.Node {
+	return nil
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerRead) Schema() sql.Schema {
+	return h.TableSchema
+}
+
+// String implements the sql.Node interface.
+func (h *HandlerRead) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("HANDLER %s READ", h.Name))
+	if h.Index != "" {
+		sb.WriteString(" " + h.Index)
+	}
+	if h.Mode == HandlerReadKey {
+		key := make([]string, len(h.Key))
+		for i, e := range h.Key {
+			key[i] = e.String()
+		}
+		sb.WriteString(fmt.Sprintf(" %s (%s)", h.KeyOp, strings.Join(key, ", ")))
+	} else {
+		sb.WriteString(" " + h.Mode.String())
+	}
+	sb.WriteString(fmt.Sprintf(" LIMIT %d, %d", h.Offset, h.Limit))
+	return sb.String()
+}
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerRead) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 0)
+	}
+	return h, nil
+}
+
+// CheckPrivileges implements the sql.Node interface. The privileges on the table of the handler are checked again for
+// every read, since they may have been revoked since it was opened. Reads of handlers the session hasn't opened fail
+// when they're executed.
+func (h *HandlerRead) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	if h.Registry == nil {
+		return true
+	}
+	handler, err := h.Registry.Get(ctx.ID(), h.Name)
+	if err != nil {
+		return true
+	}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(handler.Database, handler.Table, "", sql.PrivilegeType_Select))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*HandlerRead) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the sql.Node interface.
+func (h *HandlerRead) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if h.Registry == nil {
+		return nil, fmt.Errorf("no handler registry available for %s", h)
+	}
+	handler, err := h.Registry.Get(ctx.ID(), h.Name)
+	if err != nil {
+		return nil, err
+	}
+	table, _, err := h.Catalog.Table(ctx, handler.Database, handler.Table)
+	if err != nil {
+		return nil, err
+	}
+	if h.Index == "" && h.Mode == HandlerReadKey {
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("%s requires an index", h))
+	}
+	if !strings.EqualFold(handler.Index, h.Index) {
+		handler.Index = h.Index
+		handler.Positioned = false
+	}
+	if h.Limit <= 0 {
+		return sql.RowsToRowIter(), nil
+	}
+
+	// The rows of a table are read in the order of its primary key when no index is given, if it has one
+	index := h.Index
+	if index == "" {
+		index = "PRIMARY"
+	}
+	idx, err := orderedHandlerIndex(ctx, table, index)
+	if err != nil {
+		return nil, err
+	}
+	var key *handlerKey
+	if idx != nil || h.Index != "" {
+		if key, err = h.newHandlerKey(ctx, table, index); err != nil {
+			return nil, err
+		}
+	}
+
+	var rows []sql.Row
+	switch {
+	case idx != nil:
+		rows, err = h.readIndex(ctx, handler, table.(sql.IndexAddressableTable), idx, key)
+	case key != nil:
+		rows, err = h.readSorted(ctx, handler, table, key)
+	default:
+		rows, err = h.readTable(ctx, handler, table)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// readIndex reads the rows of this statement from the table given through the index given, with a lookup that starts
+// at the position of the handler or the key of this statement, so that only the rows read and the rows that share the
+// first column of the index with the first of them are read from the table.
+func (h *HandlerRead) readIndex(ctx *sql.Context, handler *sql.Handler, table sql.IndexAddressableTable, idx sql.Index, key *handlerKey) ([]sql.Row, error) {
+	// The rows are read from |from|, compared on its first |n| key columns, or else from the first or last row
+	var from sql.Row
+	var n int
+	var forward, inclusive bool
+	switch h.Mode {
+	case HandlerReadFirst:
+		forward = true
+	case HandlerReadLast:
+	case HandlerReadNext, HandlerReadPrev:
+		forward = h.Mode == HandlerReadNext
+		if handler.Positioned {
+			if handler.Last == nil && handler.Forward == forward {
+				// The last read went past the first or last row, so there are no more rows to read in its direction
+				return nil, nil
+			}
+			from, n, inclusive = handler.Last, len(key.ordinals), true
+		}
+	case HandlerReadKey:
+		from, n = key.valuesRow(), len(key.values)
+		switch h.KeyOp {
+		case "=", ">=":
+			forward, inclusive = true, true
+		case ">":
+			forward = true
+		case "<=":
+			inclusive = true
+		case "<":
+		default:
+			return nil, fmt.Errorf("unknown comparison operator %s in %s", h.KeyOp, h)
+		}
+	}
+	if n == 0 {
+		from = nil
+	}
+
+	lookup := sql.IndexLookup{Index: idx, Ranges: sql.RangeCollection{key.lookupRange(idx, from, forward)}, IsReverse: !forward}
+	indexed := table.IndexedAccess(lookup)
+	if indexed == nil {
+		return h.readSorted(ctx, handler, table, key)
+	}
+	partitions, err := indexed.LookupPartitions(ctx, lookup)
+	if err != nil {
+		return nil, err
+	}
+	iter := sql.NewTableRowIter(ctx, indexed, partitions)
+	defer iter.Close(ctx)
+
+	// Rows with the key of the last row read come before or after it, so the ones before it are skipped, unless it's
+	// no longer in the table
+	afterLast := from != nil && h.Mode != HandlerReadKey
+	var ties []sql.Row
+	var rows []sql.Row
+	for int64(len(rows)) < h.Offset+h.Limit {
+		r, err := iter.Next(ctx)
+		if err == io.EOF {
+			if afterLast {
+				rows = append(rows, ties...)
+			}
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if from == nil {
+			rows = append(rows, r)
+			continue
+		}
+
+		cmp, err := key.compare(r, from, n)
+		if err != nil {
+			return nil, err
+		}
+		if !forward {
+			cmp = -cmp
+		}
+		if cmp < 0 || cmp == 0 && !inclusive {
+			continue
+		}
+		if afterLast {
+			if cmp == 0 {
+				isLast, err := r.Equals(from, key.schema)
+				if err != nil {
+					return nil, err
+				}
+				if isLast {
+					afterLast, ties = false, nil
+				} else {
+					ties = append(ties, r)
+				}
+				continue
+			}
+			afterLast = false
+			rows = append(rows, ties...)
+		}
+		if cmp > 0 && h.Mode == HandlerReadKey && h.KeyOp == "=" {
+			break
+		}
+		rows = append(rows, r)
+	}
+
+	var result []sql.Row
+	if int64(len(rows)) > h.Offset {
+		result = rows[h.Offset:]
+	}
+	if int64(len(result)) > h.Limit {
+		result = result[:h.Limit]
+	}
+	// The handler is left on the last row read, or past the first or last row if there are no more rows to read
+	handler.Positioned = true
+	handler.Forward = forward
+	handler.Last = nil
+	if len(result) > 0 {
+		handler.Last = result[len(result)-1]
+	}
+	return result, nil
+}
+
+// readTable reads the rows of this statement from the table given in the order of the table, for tables without a
+// primary key that can be read in order. Without an index, rows are only read with FIRST and NEXT, and the rows of the
+// table are read up to the last row read.
+func (h *HandlerRead) readTable(ctx *sql.Context, handler *sql.Handler, table sql.Table) ([]sql.Row, error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	defer iter.Close(ctx)
+
+	start := 0
+	if h.Mode == HandlerReadNext && handler.Positioned {
+		start = handler.Position + 1
+	}
+	start += int(h.Offset)
+	var result []sql.Row
+	pos := 0
+	for ; int64(len(result)) < h.Limit; pos++ {
+		r, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if pos >= start {
+			result = append(result, r)
+		}
+	}
+	// The handler is left on the last row read, or past the last row if there are no more rows to read
+	handler.Positioned = true
+	if len(result) > 0 {
+		handler.Position = pos - 1
+	} else {
+		handler.Position = pos
+	}
+	return result, nil
+}
+
+// readSorted reads the rows of this statement from the table given, in the order of the key given, for indexes that
+// can't be read in order with lookups. All the rows of the table are read and sorted on the key.
+func (h *HandlerRead) readSorted(ctx *sql.Context, handler *sql.Handler, table sql.Table, key *handlerKey) ([]sql.Row, error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sql.RowIterToRows(ctx, nil, sql.NewTableRowIter(ctx, table, partitions))
+	if err != nil {
+		return nil, err
+	}
+	if err = key.sort(rows); err != nil {
+		return nil, err
+	}
+	return h.readRows(handler, key, rows)
+}
+
+// readRows reads the rows of this statement from the rows given, which are all the rows of the table sorted on the key
+// given, and sets the position of the handler to the position of the last row read among them.
+func (h *HandlerRead) readRows(handler *sql.Handler, key *handlerKey, rows []sql.Row) ([]sql.Row, error) {
+	pos, forward, err := h.start(handler, key, rows)
+	if err != nil {
+		return nil, err
+	}
+	step := 1
+	if !forward {
+		step = -1
+	}
+	pos += step * int(h.Offset)
+
+	var result []sql.Row
+	for ; pos >= 0 && pos < len(rows) && int64(len(result)) < h.Limit; pos += step {
+		if h.Mode == HandlerReadKey && h.KeyOp == "=" {
+			cmp, err := key.compareToValues(rows[pos])
+			if err != nil {
+				return nil, err
+			}
+			if cmp != 0 {
+				break
+			}
+		}
+		result = append(result, rows[pos])
+	}
+	// The handler is left on the last row read, or past the first or last row if there are no more rows to read
+	if len(result) > 0 {
+		pos -= step
+	} else if pos < 0 {
+		pos = -1
+	} else if pos > len(rows) {
+		pos = len(rows)
+	}
+	handler.Positioned = true
+	handler.Position = pos
+	return result, nil
+}
+
+// start returns the position of the first row to read, and whether the rows are read forward.
+func (h *HandlerRead) start(handler *sql.Handler, key *handlerKey, rows []sql.Row) (int, bool, error) {
+	switch h.Mode {
+	case HandlerReadFirst:
+		return 0, true, nil
+	case HandlerReadLast:
+		return len(rows) - 1, false, nil
+	case HandlerReadNext:
+		if !handler.Positioned {
+			return 0, true, nil
+		}
+		return handler.Position + 1, true, nil
+	case HandlerReadPrev:
+		if !handler.Positioned {
+			return len(rows) - 1, false, nil
+		}
+		return handler.Position - 1, false, nil
+	}
+
+	// The rows are sorted on the key, so the rows that compare as the operator requires are a prefix or a suffix of them
+	var searchErr error
+	compare := func(i int) int {
+		cmp, err := key.compareToValues(rows[i])
+		if err != nil && searchErr == nil {
+			searchErr = err
+		}
+		return cmp
+	}
+	switch h.KeyOp {
+	case "=", ">=":
+		return sort.Search(len(rows), func(i int) bool { return compare(i) >= 0 }), true, searchErr
+	case ">":
+		return sort.Search(len(rows), func(i int) bool { return compare(i) > 0 }), true, searchErr
+	case "<=":
+		return sort.Search(len(rows), func(i int) bool { return compare(i) > 0 }) - 1, false, searchErr
+	case "<":
+		return sort.Search(len(rows), func(i int) bool { return compare(i) >= 0 }) - 1, false, searchErr
+	default:
+		return 0, false, fmt.Errorf("unknown comparison operator %s in %s", h.KeyOp, h)
+	}
+}
+
+// handlerKey is the key of the index a HANDLER ... READ statement reads the rows of a table through.
+type handlerKey struct {
+	schema     sql.Schema
+	ordinals   []int
+	descending []bool
+	// values are the key values of HandlerReadKey, converted to the types of the leading columns of the index
+	values []interface{}
+}
+
+// newHandlerKey returns the key of the index with the name given of the table given, with the key values of this
+// statement.
+func (h *HandlerRead) newHandlerKey(ctx *sql.Context, table sql.Table, index string) (*handlerKey, error) {
+	ordinals, descending, err := handlerIndexColumns(ctx, table, index)
+	if err != nil {
+		return nil, err
+	}
+	key := &handlerKey{schema: table.Schema(), ordinals: ordinals, descending: descending}
+	if len(h.Key) > len(ordinals) {
+		return nil, sql.ErrSyntaxError.New(fmt.Sprintf("too many key values for index %s", index))
+	}
+	for i, e := range h.Key {
+		val, err := e.Eval(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		if val != nil {
+			if val, err = key.schema[ordinals[i]].Type.Convert(val); err != nil {
+				return nil, err
+			}
+		}
+		key.values = append(key.values, val)
+	}
+	return key, nil
+}
+
+// sort sorts the rows given on this key.
+func (k *handlerKey) sort(rows []sql.Row) error {
+	var sortErr error
+	sort.SliceStable(rows, func(i, j int) bool {
+		cmp, err := k.compare(rows[i], rows[j], len(k.ordinals))
+		if err != nil && sortErr == nil {
+			sortErr = err
+		}
+		return cmp < 0
+	})
+	return sortErr
+}
+
+// compareToValues compares the leading columns of the key of the row given to the key values of HandlerReadKey.
+func (k *handlerKey) compareToValues(row sql.Row) (int, error) {
+	return k.compare(row, k.valuesRow(), len(k.values))
+}
+
+// valuesRow returns a row of the table with the key values of HandlerReadKey in the leading columns of the key.
+func (k *handlerKey) valuesRow() sql.Row {
+	values := make(sql.Row, len(k.schema))
+	for i, val := range k.values {
+		values[k.ordinals[i]] = val
+	}
+	return values
+}
+
+// lookupRange returns the range of a lookup on the index given of the rows that are read from the row given, forward
+// or backward in the order of the index, which only bounds the first column of the key. All the rows of the index are
+// in the range if the row is nil.
+func (k *handlerKey) lookupRange(idx sql.Index, from sql.Row, forward bool) sql.Range {
+	cets := idx.ColumnExpressionTypes()
+	rang := make(sql.Range, len(cets))
+	for i, cet := range cets {
+		rang[i] = sql.AllRangeColumnExpr(cet.Type)
+	}
+	if from == nil {
+		return rang
+	}
+
+	// NULL comes before any other value in ascending order
+	typ, val := cets[0].Type, from[k.ordinals[0]]
+	switch {
+	case forward != k.descending[0]:
+		if val != nil {
+			rang[0] = sql.GreaterOrEqualRangeColumnExpr(val, typ)
+		}
+	case val == nil:
+		rang[0] = sql.NullRangeColumnExpr(typ)
+	default:
+		rang[0] = sql.RangeColumnExpr{LowerBound: sql.BelowNull{}, UpperBound: sql.Above{Key: val}, Typ: typ}
+	}
+	return rang
+}
+
+// compare compares the first |n| columns of the key of the rows given, in the order of the index.
+func (k *handlerKey) compare(a, b sql.Row, n int) (int, error) {
+	for i, ord := range k.ordinals[:n] {
+		cmp, err := compareHandlerValues(k.schema[ord].Type, a[ord], b[ord])
+		if err != nil {
+			return 0, err
+		}
+		if k.descending[i] {
+			cmp = -cmp
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	return 0, nil
+}
+
+// orderedHandlerIndex returns the index with the name given of the table given if the rows of the table can be read in
+// its order with lookups, forward and backward, or nil if they can't.
+func orderedHandlerIndex(ctx *sql.Context, table sql.Table, name string) (sql.Index, error) {
+	it, ok := table.(sql.IndexAddressableTable)
+	if !ok {
+		return nil, nil
+	}
+	indexes, err := it.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, idx := range indexes {
+		if !strings.EqualFold(idx.ID(), name) {
+			continue
+		}
+		oi, ok := idx.(sql.OrderedIndex)
+		if !ok || oi.Order() != sql.IndexOrderAsc || !oi.Reversible() || idx.IsSpatial() {
+			return nil, nil
+		}
+		// An index on a prefix of a column isn't sorted on the whole column
+		for _, prefixLength := range idx.PrefixLengths() {
+			if prefixLength > 0 {
+				return nil, nil
+			}
+		}
+		return idx, nil
+	}
+	return nil, nil
+}
+
+// handlerIndexColumns returns the positions in the schema of the table given of the columns of its index with the
+// name given, and whether the index is sorted on each of them in descending order. The PRIMARY index of a table with
+// a primary key is always found, even if the table doesn't return it from GetIndexes.
+func handlerIndexColumns(ctx *sql.Context, table sql.Table, name string) ([]int, []bool, error) {
+	schema := table.Schema()
+	var exprs []string
+	var descending []bool
+	if it, ok := table.(sql.IndexAddressableTable); ok {
+		indexes, err := it.GetIndexes(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, idx := range indexes {
+			if strings.EqualFold(idx.ID(), name) {
+				exprs = idx.Expressions()
+				descending = make([]bool, len(exprs))
+				if di, ok := idx.(sql.DescendingIndex); ok {
+					descending = di.Descending()
+				}
+				break
+			}
+		}
+	}
+
+	var ordinals []int
+	if exprs == nil && strings.EqualFold(name, "PRIMARY") {
+		if pkt, ok := table.(sql.PrimaryKeyTable); ok {
+			ordinals = pkt.PrimaryKeySchema().PkOrdinals
+		} else {
+			for i, col := range schema {
+				if col.PrimaryKey {
+					ordinals = append(ordinals, i)
+				}
+			}
+		}
+		descending = make([]bool, len(ordinals))
+	}
+	for _, e := range exprs {
+		ord := schema.IndexOfColName(e[strings.LastIndex(e, ".")+1:])
+		if ord < 0 {
+			return nil, nil, sql.ErrHandlerIndexNotFound.New(name, table.Name())
+		}
+		ordinals = append(ordinals, ord)
+	}
+	if len(ordinals) == 0 {
+		return nil, nil, sql.ErrHandlerIndexNotFound.New(name, table.Name())
+	}
+	return ordinals, descending, nil
+}
+
+// compareHandlerValues compares two values of the type given, with NULL before any other value, as indexes sort them.
+func compareHandlerValues(typ sql.Type, a, b interface{}) (int, error) {
+	switch {
+	case a == nil && b == nil:
+		return 0, nil
+	case a == nil:
+		return -1, nil
+	case b == nil:
+		return 1, nil
+	default:
+		return typ.Compare(a, b)
+	}
+}
@@ -15,7 +15,9 @@
 package plan
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -39,12 +41,31 @@ func NewHashLookup(n *CachedResults, childProjection sql.Expression, lookupProje
 	}
 }
 
+// NewNullAwareHashLookup returns a HashLookup for the right side of an
+// anti join for a NOT IN subquery. Its lookups return the cached rows
+// whose hash key is null, or has a null element, along with the rows
+// with the key looked up, and all the cached rows for a key that's null
+// or has a null element, since the equality of these keys is null
+// rather than false.
+func NewNullAwareHashLookup(n *CachedResults, childProjection sql.Expression, lookupProjection sql.Expression) *HashLookup {
+	ret := NewHashLookup(n, childProjection, lookupProjection)
+	ret.nullAware = true
+	return ret
+}
+
 type HashLookup struct {
 	UnaryNode
-	inner  sql.Expression
-	outer  sql.Expression
-	mutex  *sync.Mutex
-	lookup map[interface{}][]sql.Row
+	inner     sql.Expression
+	outer     sql.Expression
+	mutex     *sync.Mutex
+	lookup    map[interface{}][]sql.Row
+	nullAware bool
+	// filled is set once the first RowIter call has read all the rows of
+	// the child into its cache
+	filled bool
+	// all and nulls are the cached rows, and those with a null key, of a
+	// null-aware lookup
+	all, nulls []sql.Row
 }
 
 var _ sql.Node = (*HashLookup)(nil)
@@ -68,10 +89,11 @@ func (n *HashLookup) WithExpressions(exprs ...sql.Expression) (sql.Node, error)
 func (n *HashLookup) String() string {
 	pr := sql.NewTreePrinter()
 	_ = pr.WriteNode("HashLookup")
-	children := make([]string, 3)
-	children[0] = fmt.Sprintf("outer: %s", n.outer)
-	children[1] = fmt.Sprintf("inner: %s", n.inner)
-	children[2] = n.Child.String()
+	children := []string{fmt.Sprintf("outer: %s", n.outer), fmt.Sprintf("inner: %s", n.inner)}
+	if n.nullAware {
+		children = append(children, "null-aware: true")
+	}
+	children = append(children, n.Child.String())
 	_ = pr.WriteChildren(children...)
 	return pr.String()
 }
@@ -79,10 +101,11 @@ func (n *HashLookup) String() string {
 func (n *HashLookup) DebugString() string {
 	pr := sql.NewTreePrinter()
 	_ = pr.WriteNode("HashLookup")
-	children := make([]string, 3)
-	children[0] = fmt.Sprintf("source: %s", sql.DebugString(n.outer))
-	children[1] = fmt.Sprintf("target: %s", sql.DebugString(n.inner))
-	children[2] = sql.DebugString(n.Child)
+	children := []string{fmt.Sprintf("source: %s", sql.DebugString(n.outer)), fmt.Sprintf("target: %s", sql.DebugString(n.inner))}
+	if n.nullAware {
+		children = append(children, "null-aware: true")
+	}
+	children = append(children, sql.DebugString(n.Child))
 	_ = pr.WriteChildren(children...)
 	return pr.String()
 }
@@ -117,15 +140,31 @@ func (n *HashLookup) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
 		// RowIter, we currently make use of CachedResults and require
 		// *CachedResults to be our direct child.
 		cr := n.UnaryNode.Child.(*CachedResults)
+		if !n.filled {
+			// Partial joins stop reading the rows for a lookup once they
+			// find a match, so the cache is filled before the first lookup
+			// rather than by it.
+			n.filled = true
+			iter, err := n.fillCache(ctx, cr, r)
+			if err != nil || iter != nil {
+				return iter, err
+			}
+		}
 		if res := cr.getCachedResults(); res != nil {
 			n.lookup = make(map[interface{}][]sql.Row)
 			for _, row := range res {
 				// TODO: Maybe do not put nil stuff in here.
-				key, err := n.getHashKey(ctx, n.inner, row)
+				key, null, err := n.getHashKey(ctx, n.inner, row)
 				if err != nil {
 					return nil, err
 				}
 				n.lookup[key] = append(n.lookup[key], row)
+				if n.nullAware && null {
+					n.nulls = append(n.nulls, row)
+				}
+			}
+			if n.nullAware {
+				n.all = res
 			}
 			// CachedResult is safe to Dispose after contents are transferred
 			// to |n.lookup|
@@ -133,51 +172,90 @@ func (n *HashLookup) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
 		}
 	}
 	if n.lookup != nil {
-		key, err := n.getHashKey(ctx, n.outer, r)
+		key, null, err := n.getHashKey(ctx, n.outer, r)
 		if err != nil {
 			return nil, err
 		}
+		if n.nullAware {
+			if null {
+				return sql.RowsToRowIter(n.all...), nil
+			}
+			return sql.RowsToRowIter(append(n.nulls[:len(n.nulls):len(n.nulls)], n.lookup[key]...)...), nil
+		}
 		return sql.RowsToRowIter(n.lookup[key]...), nil
 	}
 	return n.UnaryNode.Child.RowIter(ctx, r)
 }
 
+// fillCache reads all the rows of the CachedResults given, so that it
+// caches them if they fit in memory. If it has already found that there
+// are no rows, it returns the empty iterator that joins short-circuit on.
+func (n *HashLookup) fillCache(ctx *sql.Context, cr *CachedResults, r sql.Row) (sql.RowIter, error) {
+	iter, err := cr.RowIter(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if isEmptyIter(iter) {
+		return iter, nil
+	}
+	for {
+		_, err = iter.Next(ctx)
+		if err != nil {
+			break
+		}
+	}
+	if !errors.Is(err, io.EOF) {
+		iter.Close(ctx)
+		return nil, err
+	}
+	return nil, iter.Close(ctx)
+}
+
 // Convert a tuple expression returning []interface{} into something comparable.
 // Fast paths a few smaller slices into fixed size arrays, puts everything else
 // through string serialization and a hash for now. It is OK to hash lossy here
 // as the join condition is still evaluated after the matching rows are returned.
-func (n *HashLookup) getHashKey(ctx *sql.Context, e sql.Expression, row sql.Row) (interface{}, error) {
+// Also returns whether the key is null or has a null element.
+func (n *HashLookup) getHashKey(ctx *sql.Context, e sql.Expression, row sql.Row) (interface{}, bool, error) {
 	key, err := e.Eval(ctx, row)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	key, err = n.outer.Type().Convert(key)
 	if err != nil {
-		return nil, err
+		return nil, false, err
+	}
+	if key == nil {
+		return nil, true, nil
 	}
 	if s, ok := key.([]interface{}); ok {
+		var null bool
+		for _, v := range s {
+			null = null || v == nil
+		}
 		switch len(s) {
 		case 0:
-			return [0]interface{}{}, nil
+			return [0]interface{}{}, null, nil
 		case 1:
-			return [1]interface{}{s[0]}, nil
+			return [1]interface{}{s[0]}, null, nil
 		case 2:
-			return [2]interface{}{s[0], s[1]}, nil
+			return [2]interface{}{s[0], s[1]}, null, nil
 		case 3:
-			return [3]interface{}{s[0], s[1], s[2]}, nil
+			return [3]interface{}{s[0], s[1], s[2]}, null, nil
 		case 4:
-			return [4]interface{}{s[0], s[1], s[2], s[3]}, nil
+			return [4]interface{}{s[0], s[1], s[2], s[3]}, null, nil
 		case 5:
-			return [5]interface{}{s[0], s[1], s[2], s[3], s[4]}, nil
+			return [5]interface{}{s[0], s[1], s[2], s[3], s[4]}, null, nil
 		default:
-			return sql.HashOf(s)
+			key, err := sql.HashOf(s)
+			return key, null, err
 		}
 	}
 	// byte slices are not hashable
 	if k, ok := key.([]byte); ok {
 		key = string(k)
 	}
-	return key, nil
+	return key, false, nil
 }
 
 func (n *HashLookup) Dispose() {
@@ -0,0 +1,120 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// IndexDistinct returns the distinct values of the first column of an index of a table, in the order of the index,
+// from a table that can iterate them without reading all of its rows. It replaces the reads of a single indexed column
+// of a whole table whose duplicate values are removed, such as by SELECT DISTINCT col or COUNT(DISTINCT col). Its
+// child is the table node whose index is iterated, a ResolvedTable or a TableAlias of one, which isn't read.
+type IndexDistinct struct {
+	UnaryNode
+	index  sql.Index
+	column *sql.Column
+}
+
+var _ sql.Node = (*IndexDistinct)(nil)
+var _ sql.CollationCoercible = (*IndexDistinct)(nil)
+
+// NewIndexDistinct returns a node returning the distinct values of the column given, the first column of the index
+// given, of the table node given.
+func NewIndexDistinct(table sql.Node, index sql.Index, column *sql.Column) *IndexDistinct {
+	return &IndexDistinct{UnaryNode: UnaryNode{Child: table}, index: index, column: column}
+}
+
+// GetIndexDistinctTable returns the table of the table node given, or the table it wraps, if it's a
+// sql.IndexDistinctTable.
+func GetIndexDistinctTable(n sql.Node) (sql.IndexDistinctTable, bool) {
+	if ta, ok := n.(*TableAlias); ok {
+		n = ta.Child
+	}
+	rt, ok := n.(*ResolvedTable)
+	if !ok {
+		return nil, false
+	}
+	table := rt.Table
+	for {
+		if it, ok := table.(sql.IndexDistinctTable); ok {
+			return it, true
+		}
+		wrapper, ok := table.(sql.TableWrapper)
+		if !ok {
+			return nil, false
+		}
+		table = wrapper.Underlying()
+	}
+}
+
+// Index returns the index whose distinct values are returned.
+func (d *IndexDistinct) Index() sql.Index {
+	return d.index
+}
+
+// Schema implements the sql.Node interface.
+func (d *IndexDistinct) Schema() sql.Schema {
+	return sql.Schema{d.column}
+}
+
+// RowIter implements the sql.Node interface.
+func (d *IndexDistinct) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	table, err := unwrapUnreadTable(ctx, d.Child)
+	if err != nil {
+		return nil, err
+	}
+	it, ok := GetIndexDistinctTable(table)
+	if !ok {
+		return nil, fmt.Errorf("cannot iterate the distinct index values of %T", table)
+	}
+	return it.DistinctIndexValues(ctx, d.index)
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *IndexDistinct) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 1)
+	}
+	nd := *d
+	nd.Child = children[0]
+	return &nd, nil
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (d *IndexDistinct) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return d.Child.CheckPrivileges(ctx, opChecker)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (d *IndexDistinct) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, d.Child)
+}
+
+func (d *IndexDistinct) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("IndexDistinct")
+	_ = pr.WriteChildren(fmt.Sprintf("index: %s", formatIndexDecoratorString(d.index)), d.Child.String())
+	return pr.String()
+}
+
+func (d *IndexDistinct) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("IndexDistinct")
+	_ = pr.WriteChildren(fmt.Sprintf("index: %s", formatIndexDecoratorString(d.index)), sql.DebugString(d.Child))
+	return pr.String()
+}
@@ -34,6 +34,7 @@ type IndexedTableAccess struct {
 	lb            *LookupBuilder
 	lookup        sql.IndexLookup
 	Table         sql.IndexedTable
+	pointLookup   bool
 }
 
 var _ sql.Table = (*IndexedTableAccess)(nil)
@@ -85,11 +86,13 @@ func NewIndexedAccessForResolvedTable(rt *ResolvedTable, lb *LookupBuilder) (*In
 // RowIter() without consideration of the row given. The key expression should faithfully represent this lookup, but is
 // only for display purposes.
 func NewStaticIndexedTableAccess(rt *ResolvedTable, t sql.IndexedTable, lookup sql.IndexLookup) *IndexedTableAccess {
-	return &IndexedTableAccess{
+	ita := &IndexedTableAccess{
 		ResolvedTable: rt,
 		lookup:        lookup,
 		Table:         t,
 	}
+	ita.pointLookup = ita.IsPointLookup()
+	return ita
 }
 
 // NewStaticIndexedAccessForResolvedTable creates an IndexedTableAccess node if the resolved table embeds
@@ -108,11 +111,13 @@ func NewStaticIndexedAccessForResolvedTable(rt *ResolvedTable, lookup sql.IndexL
 		return nil, ErrInvalidLookupForIndexedTable.New(lookup.Ranges.DebugString())
 	}
 	ia := iaTable.IndexedAccess(lookup)
-	return &IndexedTableAccess{
+	ita := &IndexedTableAccess{
 		ResolvedTable: rt,
 		lookup:        lookup,
 		Table:         ia,
-	}, nil
+	}
+	ita.pointLookup = ita.IsPointLookup()
+	return ita, nil
 }
 
 func (i *IndexedTableAccess) IsStatic() bool {
@@ -167,7 +172,39 @@ func (i *IndexedTableAccess) Index() sql.Index {
 	return i.lb.index
 }
 
+// IsPointLookup returns whether this is a static lookup of the full key of a unique index by equality, such as a
+// lookup by primary key, which matches at most one row.
+func (i *IndexedTableAccess) IsPointLookup() bool {
+	if i.lookup.IsEmpty() || !i.lookup.Index.IsUnique() || len(i.lookup.Ranges) != 1 {
+		return false
+	}
+	rang := i.lookup.Ranges[0]
+	if len(rang) != len(i.lookup.Index.Expressions()) {
+		return false
+	}
+	for _, rce := range rang {
+		if ok, err := rce.RepresentsEquals(); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsOrdered returns whether the rows of this access are returned in the order of its index, which is the case for a
+// static lookup of a single range of an index that declares its order.
+func (i *IndexedTableAccess) IsOrdered() bool {
+	if i.lookup.IsEmpty() || len(i.lookup.Ranges) != 1 {
+		return false
+	}
+	oi, ok := i.lookup.Index.(sql.OrderedIndex)
+	return ok && oi.Order() != sql.IndexOrderNone
+}
+
 func (i *IndexedTableAccess) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if plt, ok := i.Table.(sql.PointLookupTable); ok && i.pointLookup {
+		return i.pointLookupRowIter(ctx, plt)
+	}
+
 	span, ctx := ctx.Span("plan.IndexedTableAccess")
 
 	lookup, err := i.getLookup(ctx, row)
@@ -183,6 +220,19 @@ func (i *IndexedTableAccess) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter
 	return sql.NewSpanIter(span, sql.NewTableRowIter(ctx, i.Table, partIter)), nil
 }
 
+// pointLookupRowIter returns an iterator over the rows matched by the point lookup of this access, which are read
+// directly from its table before the iterator is returned.
+func (i *IndexedTableAccess) pointLookupRowIter(ctx *sql.Context, plt sql.PointLookupTable) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.IndexedTableAccess")
+	defer span.End()
+
+	rows, err := plt.PointLookup(ctx, i.lookup)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
 func (i *IndexedTableAccess) RowIter2(ctx *sql.Context, f *sql.RowFrame) (sql.RowIter2, error) {
 	lookup, err := i.getLookup2(ctx, f.Row2())
 	if err != nil {
@@ -242,6 +292,9 @@ func (i *IndexedTableAccess) String() string {
 	children = append(children, fmt.Sprintf("index: %s", formatIndexDecoratorString(i.Index())))
 	if !i.lookup.IsEmpty() {
 		children = append(children, fmt.Sprintf("filters: %s", i.lookup.Ranges.DebugString()))
+		if i.lookup.IsReverse {
+			children = append(children, "reverse: true")
+		}
 	}
 
 	if pt, ok := i.Table.(sql.ProjectedTable); ok {
@@ -264,6 +317,10 @@ func (i *IndexedTableAccess) String() string {
 			pr.WriteChildren(fmt.Sprintf("filters: %v", filters))
 		}
 	}
+	if ict, ok := i.Table.(sql.IndexConditionTable); ok && ict.IndexCondition() != nil {
+		children = append(children, fmt.Sprintf("index condition: %s", ict.IndexCondition()))
+	}
+	children = append(children, sortAndLimitStrings(i.Table)...)
 
 	pr.WriteChildren(children...)
 	return pr.String()
@@ -284,6 +341,9 @@ func (i *IndexedTableAccess) DebugString() string {
 	children = append(children, fmt.Sprintf("index: %s", formatIndexDecoratorString(i.Index())))
 	if !i.lookup.IsEmpty() {
 		children = append(children, fmt.Sprintf("static: %s", i.lookup.Ranges.DebugString()))
+		if i.lookup.IsReverse {
+			children = append(children, "reverse: true")
+		}
 	}
 
 	var columns []string
@@ -310,6 +370,10 @@ func (i *IndexedTableAccess) DebugString() string {
 			pr.WriteChildren(fmt.Sprintf("filters: %v", filters))
 		}
 	}
+	if ict, ok := i.Table.(sql.IndexConditionTable); ok && ict.IndexCondition() != nil {
+		children = append(children, fmt.Sprintf("index condition: %s", sql.DebugString(ict.IndexCondition())))
+	}
+	children = append(children, sortAndLimitStrings(i.Table)...)
 
 	pr.WriteChildren(children...)
 	return pr.String()
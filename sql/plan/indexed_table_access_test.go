@@ -0,0 +1,103 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// indexedTableWithoutPointLookups hides the point lookups of the table it wraps, so that they're read like other lookups.
+type indexedTableWithoutPointLookups struct {
+	sql.IndexedTable
+}
+
+// pointLookupTable returns a table with a primary key i and rowCount rows, and its primary key index.
+func pointLookupTable(t require.TestingT, ctx *sql.Context, rowCount int) (*memory.Table, sql.Index) {
+	table := memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "t", PrimaryKey: true},
+		{Name: "s", Type: types.Text, Source: "t", Nullable: true},
+	}), nil)
+	table.EnablePrimaryKeyIndexes()
+	for i := 0; i < rowCount; i++ {
+		require.NoError(t, table.Insert(ctx, sql.NewRow(int64(i), fmt.Sprintf("row %d", i))))
+	}
+	indexes, err := table.GetIndexes(ctx)
+	require.NoError(t, err)
+	return table, indexes[0]
+}
+
+// pointLookupAccess returns an IndexedTableAccess of the point lookup of the key given of the table and index given,
+// whose point lookups are read directly if |direct| is true.
+func pointLookupAccess(t require.TestingT, ctx *sql.Context, table *memory.Table, idx sql.Index, key int64, direct bool) *IndexedTableAccess {
+	lookup, err := sql.NewIndexBuilder(idx).Equals(ctx, "t.i", key).Build(ctx)
+	require.NoError(t, err)
+	indexed := table.IndexedAccess(lookup)
+	if !direct {
+		indexed = indexedTableWithoutPointLookups{indexed}
+	}
+	ita := NewStaticIndexedTableAccess(NewResolvedTable(table, nil, nil), indexed, lookup)
+	require.True(t, ita.IsPointLookup())
+	return ita
+}
+
+func TestPointLookupRowIter(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	table, idx := pointLookupTable(t, ctx, 10)
+
+	for _, direct := range []bool{true, false} {
+		t.Run(fmt.Sprintf("direct=%t", direct), func(t *testing.T) {
+			iter, err := pointLookupAccess(t, ctx, table, idx, 3, direct).RowIter(ctx, nil)
+			require.NoError(t, err)
+			rows, err := sql.RowIterToRows(ctx, nil, iter)
+			require.NoError(t, err)
+			require.Equal(t, []sql.Row{{int64(3), "row 3"}}, rows)
+
+			iter, err = pointLookupAccess(t, ctx, table, idx, 10, direct).RowIter(ctx, nil)
+			require.NoError(t, err)
+			rows, err = sql.RowIterToRows(ctx, nil, iter)
+			require.NoError(t, err)
+			require.Empty(t, rows)
+		})
+	}
+}
+
+func BenchmarkPointLookup(b *testing.B) {
+	ctx := sql.NewEmptyContext()
+	table, idx := pointLookupTable(b, ctx, 10000)
+
+	for _, direct := range []bool{true, false} {
+		ita := pointLookupAccess(b, ctx, table, idx, 5000, direct)
+		b.Run(fmt.Sprintf("direct=%t", direct), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				iter, err := ita.RowIter(ctx, nil)
+				if err != nil {
+					b.Fatal(err)
+				}
+				rows, err := sql.RowIterToRows(ctx, nil, iter)
+				if err != nil || len(rows) != 1 {
+					b.Fatal(rows, err)
+				}
+			}
+		})
+	}
+}
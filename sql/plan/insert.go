@@ -19,6 +19,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/vt/proto/query"
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -40,6 +41,12 @@ var ErrInsertIntoDuplicateColumn = errors.NewKind("duplicate column name %v")
 var ErrInsertIntoNonexistentColumn = errors.NewKind("invalid column name %v")
 var ErrInsertIntoIncompatibleTypes = errors.NewKind("cannot convert type %s to %s")
 
+func init() {
+	sql.RegisterErrorCode(mysql.ERWrongValueCountOnRow, mysql.SSWrongValueCountOnRow, ErrInsertIntoMismatchValueCount)
+	sql.RegisterErrorCode(mysql.ERFieldSpecifiedTwice, mysql.SSClientError, ErrInsertIntoDuplicateColumn)
+	sql.RegisterErrorCode(mysql.ERBadFieldError, mysql.SSBadFieldError, ErrInsertIntoNonexistentColumn)
+}
+
 // cc: https://dev.mysql.com/doc/refman/8.0/en/sql-mode.html#sql-mode-strict
 // The INSERT IGNORE syntax applies to these ignorable errors
 // ER_BAD_NULL_ERROR - yes
@@ -52,7 +59,7 @@ var ErrInsertIntoIncompatibleTypes = errors.NewKind("cannot convert type %s to %
 // ER_ROW_DOES_NOT_MATCH_GIVEN_PARTITION_SET - No
 // ER_ROW_IS_REFERENCED_2 - Yes
 // ER_SUBQUERY_NO_1_ROW - yes
-// ER_VIEW_CHECK_FAILED - No
+// ER_VIEW_CHECK_FAILED - Yes
 var IgnorableErrors = []*errors.Kind{sql.ErrInsertIntoNonNullableProvidedNull,
 	sql.ErrPrimaryKeyViolation,
 	sql.ErrPartitionNotFound,
@@ -62,6 +69,8 @@ var IgnorableErrors = []*errors.Kind{sql.ErrInsertIntoNonNullableProvidedNull,
 	sql.ErrDuplicateEntry,
 	sql.ErrUniqueKeyViolation,
 	sql.ErrCheckConstraintViolated,
+	sql.ErrViewCheckOptionFailed,
+	sql.ErrRowPolicyViolated,
 }
 
 // InsertInto is the top level node for INSERT INTO statements. It has a source for rows and a destination to insert
@@ -75,6 +84,8 @@ type InsertInto struct {
 	OnDupExprs  []sql.Expression
 	Checks      sql.CheckConstraints
 	Ignore      bool
+	// HasTriggers is whether triggers run for the rows of this insert, which must then be inserted one at a time
+	HasTriggers bool
 }
 
 var _ sql.Databaser = (*InsertInto)(nil)
@@ -214,13 +225,31 @@ func (id *InsertDestination) CollationCoercibility(ctx *sql.Context) (collation
 	return sql.GetCoercibility(ctx, id.Child)
 }
 
+// bulkInsertBatchSize is the number of rows inserted at a time into a sql.BulkInsertTable.
+const bulkInsertBatchSize = 1024
+
+// InsertInfo is the Info for OKResults returned by Insert nodes that insert more than a single row of values.
+type InsertInfo struct {
+	Records, Duplicates, Warnings int
+}
+
+// String implements fmt.Stringer
+func (ii InsertInfo) String() string {
+	return fmt.Sprintf("Records: %d  Duplicates: %d  Warnings: %d", ii.Records, ii.Duplicates, ii.Warnings)
+}
+
 type insertIter struct {
 	schema              sql.Schema
 	inserter            sql.RowInserter
+	bulkInserter        sql.BulkRowInserter
+	batch               []sql.Row
+	batchSize           int
 	replacer            sql.RowReplacer
 	updater             sql.RowUpdater
 	rowSource           sql.RowIter
+	autoIncTable        sql.AutoIncrementTable
 	lastInsertIdUpdated bool
+	explicitInsertId    bool
 	ctx                 *sql.Context
 	insertExprs         []sql.Expression
 	updateExprs         []sql.Expression
@@ -269,6 +298,7 @@ func newInsertIter(
 	checks sql.CheckConstraints,
 	row sql.Row,
 	ignore bool,
+	hasTriggers bool,
 ) (sql.RowIter, error) {
 	// This schema may vary from the table itself, particularly in terms of column defaults
 	dstSchema := dest.Schema()
@@ -292,24 +322,70 @@ func newInsertIter(
 		}
 	}
 
-	rowIter, err := values.RowIter(ctx, row)
+	var editor interface{} = inserter
+	if replacer != nil {
+		editor = replacer
+	}
+	checker, err := newUniqueKeyChecker(ctx, insertable, editor)
 	if err != nil {
 		return nil, err
 	}
+	if checker != nil {
+		if replacer != nil {
+			replacer = &uniqueKeyReplacer{RowReplacer: replacer, checker: checker}
+		} else {
+			inserter = &uniqueKeyInserter{RowInserter: inserter, checker: checker}
+		}
+		if updater != nil {
+			updater = &uniqueKeyUpdater{RowUpdater: updater, checker: checker}
+		}
+	}
 
 	insertExpressions := getInsertExpressions(values)
+
+	// Rows are inserted in batches when the insert doesn't have to handle the result of each row before the next
+	var bulkInserter sql.BulkRowInserter
+	var batchSize int
+	if bulkTable, ok := insertable.(sql.BulkInsertTable); ok && inserter != nil && updater == nil && checker == nil && !ignore && !hasTriggers {
+		bulkInserter = bulkTable.BulkInserter(ctx)
+		inserter = bulkInserter
+		batchSize = bulkInsertBatchSize
+		// Auto increment values are generated from the rows inserted before them
+		for _, expr := range insertExpressions {
+			if _, ok := expr.(*expression.AutoIncrement); ok {
+				batchSize = 1
+			}
+		}
+	}
+
+	// Values generated for an AUTO_INCREMENT column are told apart from explicit ones by the table's next value
+	var autoIncTable sql.AutoIncrementTable
+	for _, expr := range insertExpressions {
+		if _, ok := expr.(*expression.AutoIncrement); ok {
+			autoIncTable, _ = insertable.(sql.AutoIncrementTable)
+		}
+	}
+
+	rowIter, err := values.RowIter(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
 	insertIter := &insertIter{
-		schema:      dstSchema,
-		tableNode:   dest,
-		inserter:    inserter,
-		replacer:    replacer,
-		updater:     updater,
-		rowSource:   rowIter,
-		updateExprs: onDupUpdateExpr,
-		insertExprs: insertExpressions,
-		checks:      checks,
-		ctx:         ctx,
-		ignore:      ignore,
+		schema:       dstSchema,
+		tableNode:    dest,
+		inserter:     inserter,
+		bulkInserter: bulkInserter,
+		batchSize:    batchSize,
+		replacer:     replacer,
+		updater:      updater,
+		rowSource:    rowIter,
+		autoIncTable: autoIncTable,
+		updateExprs:  onDupUpdateExpr,
+		insertExprs:  insertExpressions,
+		checks:       checks,
+		ctx:          ctx,
+		ignore:       ignore,
 	}
 
 	var ed sql.EditOpenerCloser
@@ -340,8 +416,22 @@ func getInsertExpressions(values sql.Node) []sql.Expression {
 }
 
 func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error) {
+	var nextAutoInc uint64
+	if i.autoIncTable != nil && !i.lastInsertIdUpdated {
+		var err error
+		nextAutoInc, err = i.autoIncTable.PeekNextAutoIncrementValue(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	row, err := i.rowSource.Next(ctx)
 	if err == io.EOF {
+		if len(i.batch) > 0 {
+			if err := i.insertBatch(ctx); err != nil {
+				return nil, err
+			}
+		}
 		return nil, err
 	}
 
@@ -355,6 +445,11 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 		row = row[len(row)-len(i.schema):]
 	}
 
+	row, err = sql.LoadRow(ctx, row)
+	if err != nil {
+		return nil, i.ignoreOrClose(ctx, row, err)
+	}
+
 	err = i.validateNullability(ctx, i.schema, row)
 	if err != nil {
 		return nil, i.ignoreOrClose(ctx, row, err)
@@ -424,7 +519,15 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 				break
 			}
 		}
+		i.updateLastInsertId(ctx, row, nextAutoInc)
 		return toReturn, nil
+	} else if i.bulkInserter != nil {
+		i.batch = append(i.batch, row)
+		if len(i.batch) >= i.batchSize {
+			if err := i.insertBatch(ctx); err != nil {
+				return nil, err
+			}
+		}
 	} else {
 		if err := i.inserter.Insert(ctx, row); err != nil {
 			if (!sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) && !sql.ErrDuplicateEntry.Is(err)) || len(i.updateExprs) == 0 {
@@ -436,11 +539,23 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 		}
 	}
 
-	i.updateLastInsertId(ctx, row)
+	i.updateLastInsertId(ctx, row, nextAutoInc)
 
 	return row, nil
 }
 
+// insertBatch inserts the rows of the current batch into the table.
+func (i *insertIter) insertBatch(ctx *sql.Context) error {
+	batch := i.batch
+	i.batch = nil
+	if err := i.bulkInserter.InsertBatch(ctx, batch); err != nil {
+		i.rowSource.Close(ctx)
+		i.rowSource = nil
+		return sql.NewWrappedInsertError(batch[len(batch)-1], err)
+	}
+	return nil
+}
+
 func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, row, rowToUpdate sql.Row) (returnRow sql.Row, returnErr error) {
 	err := i.resolveValues(ctx, row)
 	if err != nil {
@@ -551,7 +666,10 @@ func (i *insertIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
-func (i *insertIter) updateLastInsertId(ctx *sql.Context, row sql.Row) {
+// updateLastInsertId sets the insert id of the statement from the AUTO_INCREMENT column of a row that was inserted,
+// given the next value of the column before the row was evaluated. As in MySQL, the insert id is the first value that
+// was generated for the column, and an explicit value is only reported while no value has been generated.
+func (i *insertIter) updateLastInsertId(ctx *sql.Context, row sql.Row, nextAutoInc uint64) {
 	if i.lastInsertIdUpdated {
 		return
 	}
@@ -566,10 +684,16 @@ func (i *insertIter) updateLastInsertId(ctx *sql.Context, row sql.Row) {
 		}
 	}
 
-	if found {
+	if !found {
+		return
+	}
+
+	generated := i.autoIncTable == nil || (autoIncVal > 0 && uint64(autoIncVal) == nextAutoInc)
+	if generated || !i.explicitInsertId {
 		ctx.SetLastQueryInfo(sql.LastInsertId, autoIncVal)
-		i.lastInsertIdUpdated = true
 	}
+	i.lastInsertIdUpdated = generated
+	i.explicitInsertId = true
 }
 
 func (i *insertIter) ignoreOrClose(ctx *sql.Context, row sql.Row, err error) error {
@@ -594,7 +718,7 @@ func convertDataAndWarn(ctx *sql.Context, tableSchema sql.Schema, row sql.Row, c
 	sqlerr := sql.CastSQLError(err)
 
 	// Add a warning instead
-	ctx.Session.Warn(&sql.Warning{
+	ctx.AddWarning(&sql.Warning{
 		Level:   "Note",
 		Code:    sqlerr.Num,
 		Message: err.Error(),
@@ -610,7 +734,7 @@ func warnOnIgnorableError(ctx *sql.Context, row sql.Row, err error) error {
 			sqlerr := sql.CastSQLError(err)
 
 			// Add a warning instead
-			ctx.Session.Warn(&sql.Warning{
+			ctx.AddWarning(&sql.Warning{
 				Level:   "Note",
 				Code:    sqlerr.Num,
 				Message: err.Error(),
@@ -642,7 +766,7 @@ func (i *insertIter) evaluateChecks(ctx *sql.Context, row sql.Row) error {
 		}
 
 		if sql.IsFalse(res) {
-			return sql.ErrCheckConstraintViolated.New(check.Name)
+			return check.NewViolationError()
 		}
 	}
 
@@ -682,7 +806,7 @@ func toInt64(x interface{}) int64 {
 
 // RowIter implements the Node interface.
 func (ii *InsertInto) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	return newInsertIter(ctx, ii.Destination, ii.Source, ii.IsReplace, ii.OnDupExprs, ii.Checks, row, ii.Ignore)
+	return newInsertIter(ctx, ii.Destination, ii.Source, ii.IsReplace, ii.OnDupExprs, ii.Checks, row, ii.Ignore, ii.HasTriggers)
 }
 
 // WithChildren implements the Node interface.
@@ -36,30 +36,32 @@ var useInMemoryJoins = shouldUseMemoryJoinsByEnv()
 type JoinType uint16
 
 const (
-	JoinTypeUnknown         JoinType = iota // UnknownJoin
-	JoinTypeCross                           // CrossJoin
-	JoinTypeInner                           // InnerJoin
-	JoinTypeSemi                            // SemiJoin
-	JoinTypeAnti                            // AntiJoin
-	JoinTypeRightSemi                       // RightSemiJoin
-	JoinTypeLeftOuter                       // LeftOuterJoin
-	JoinTypeFullOuter                       // FullOuterJoin
-	JoinTypeGroupBy                         // GroupByJoin
-	JoinTypeRightOuter                      // RightJoin
-	JoinTypeLookup                          // LookupJoin
-	JoinTypeLeftOuterLookup                 // LeftOuterLookupJoin
-	JoinTypeHash                            // HashJoin
-	JoinTypeLeftOuterHash                   // LeftOuterHashJoin
-	JoinTypeMerge                           // MergeJoin
-	JoinTypeLeftOuterMerge                  // LeftOuterMergeJoin
-	JoinTypeSemiHash                        // SemiHashJoin
-	JoinTypeAntiHash                        // AntiHashJoin
-	JoinTypeSemiLookup                      // SemiLookupJoin
-	JoinTypeAntiLookup                      // AntiLookupJoin
-	JoinTypeRightSemiLookup                 // RightSemiLookupJoin
-	JoinTypeSemiMerge                       // SemiMergeJoin
-	JoinTypeAntiMerge                       // AntiMergeJoin
-	JoinTypeNatural                         // NaturalJoin
+	JoinTypeUnknown           JoinType = iota // UnknownJoin
+	JoinTypeCross                             // CrossJoin
+	JoinTypeInner                             // InnerJoin
+	JoinTypeSemi                              // SemiJoin
+	JoinTypeAnti                              // AntiJoin
+	JoinTypeRightSemi                         // RightSemiJoin
+	JoinTypeLeftOuter                         // LeftOuterJoin
+	JoinTypeFullOuter                         // FullOuterJoin
+	JoinTypeGroupBy                           // GroupByJoin
+	JoinTypeRightOuter                        // RightJoin
+	JoinTypeLookup                            // LookupJoin
+	JoinTypeLeftOuterLookup                   // LeftOuterLookupJoin
+	JoinTypeHash                              // HashJoin
+	JoinTypeLeftOuterHash                     // LeftOuterHashJoin
+	JoinTypeMerge                             // MergeJoin
+	JoinTypeLeftOuterMerge                    // LeftOuterMergeJoin
+	JoinTypeSemiHash                          // SemiHashJoin
+	JoinTypeAntiHash                          // AntiHashJoin
+	JoinTypeSemiLookup                        // SemiLookupJoin
+	JoinTypeAntiLookup                        // AntiLookupJoin
+	JoinTypeRightSemiLookup                   // RightSemiLookupJoin
+	JoinTypeSemiMerge                         // SemiMergeJoin
+	JoinTypeAntiMerge                         // AntiMergeJoin
+	JoinTypeNatural                           // NaturalJoin
+	JoinTypeLeftOuterNatural                  // NaturalLeftJoin
+	JoinTypeRightOuterNatural                 // NaturalRightJoin
 )
 
 func (i JoinType) IsLeftOuter() bool {
@@ -101,7 +103,37 @@ func (i JoinType) IsInner() bool {
 }
 
 func (i JoinType) IsNatural() bool {
-	return i == JoinTypeNatural
+	switch i {
+	case JoinTypeNatural, JoinTypeLeftOuterNatural, JoinTypeRightOuterNatural:
+		return true
+	default:
+		return false
+	}
+}
+
+// AsNatural returns the natural join type that joins like this join type.
+func (i JoinType) AsNatural() JoinType {
+	switch i {
+	case JoinTypeLeftOuter:
+		return JoinTypeLeftOuterNatural
+	case JoinTypeRightOuter:
+		return JoinTypeRightOuterNatural
+	default:
+		return JoinTypeNatural
+	}
+}
+
+// FromNatural returns the join type that a natural join of this type is resolved into, once its join condition is
+// known.
+func (i JoinType) FromNatural() JoinType {
+	switch i {
+	case JoinTypeLeftOuterNatural:
+		return JoinTypeLeftOuter
+	case JoinTypeRightOuterNatural:
+		return JoinTypeRightOuter
+	default:
+		return JoinTypeInner
+	}
 }
 
 func (i JoinType) IsDegenerate() bool {
@@ -168,7 +200,7 @@ func (i JoinType) IsPartial() bool {
 
 func (i JoinType) IsPlaceholder() bool {
 	return i == JoinTypeRightOuter ||
-		i == JoinTypeNatural
+		i.IsNatural()
 }
 
 func (i JoinType) IsLookup() bool {
@@ -239,6 +271,9 @@ type JoinNode struct {
 	Op         JoinType
 	CommentStr string
 	ScopeLen   int
+	// UsingCols are the columns of a JOIN ... USING, which is a natural join on only these columns. They're empty for
+	// other joins.
+	UsingCols []string
 }
 
 var _ sql.Node = (*JoinNode)(nil)
@@ -387,6 +422,9 @@ func (j *JoinNode) WithComment(comment string) sql.Node {
 func (j *JoinNode) String() string {
 	pr := sql.NewTreePrinter()
 	var children []string
+	if len(j.UsingCols) > 0 {
+		children = append(children, fmt.Sprintf("using: (%s)", strings.Join(j.UsingCols, ", ")))
+	}
 	if j.Filter != nil {
 		if j.Op.IsMerge() {
 			filters := expression.SplitConjunction(j.Filter)
@@ -407,6 +445,9 @@ func (j *JoinNode) String() string {
 func (j *JoinNode) DebugString() string {
 	pr := sql.NewTreePrinter()
 	var children []string
+	if len(j.UsingCols) > 0 {
+		children = append(children, fmt.Sprintf("using: (%s)", strings.Join(j.UsingCols, ", ")))
+	}
 	if j.Filter != nil {
 		if j.Op.IsMerge() {
 			filters := expression.SplitConjunction(j.Filter)
@@ -464,6 +505,15 @@ func NewNaturalJoin(left, right sql.Node) *JoinNode {
 	return NewJoin(left, right, JoinTypeNatural, nil)
 }
 
+// NewUsingJoin returns a join of the type given on the columns given, as in JOIN ... USING. Like a natural join,
+// it's a placeholder node that's transformed into a join of the type given during analysis, and returns each of the
+// columns it joins on once.
+func NewUsingJoin(left, right sql.Node, op JoinType, cols []string) *JoinNode {
+	j := NewJoin(left, right, op.AsNatural(), nil)
+	j.UsingCols = cols
+	return j
+}
+
 // An LookupJoin is a join that uses index lookups for the secondary table.
 func NewLookupJoin(left, right sql.Node, cond sql.Expression) *JoinNode {
 	return NewJoin(left, right, JoinTypeLookup, cond)
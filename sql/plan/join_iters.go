@@ -52,6 +52,8 @@ func newJoinIter(ctx *sql.Context, j *JoinNode, row sql.Row) (sql.RowIter, error
 		span.End()
 		return nil, err
 	}
+	// The rows of the primary iterator are copied into the primary row, so they can be reused
+	sql.ReuseRows(l)
 	return sql.NewSpanIter(span, &joinIter{
 		parentRow:         row,
 		primary:           l,
@@ -77,8 +79,15 @@ type joinIter struct {
 	foundMatch bool
 	rowSize    int
 	scopeLen   int
+
+	// buf holds the candidate rows of the join, which are only allocated when they match
+	buf *sql.RowBuffer
+	// reuse is whether the row returned by the last call to Next can be overwritten by the next one
+	reuse bool
 }
 
+var _ sql.RowReuser = (*joinIter)(nil)
+
 func (i *joinIter) loadPrimary(ctx *sql.Context) error {
 	if i.primaryRow == nil {
 		r, err := i.primary.Next(ctx)
@@ -102,6 +111,8 @@ func (i *joinIter) loadSecondary(ctx *sql.Context) (sql.Row, error) {
 		if isEmptyIter(rowIter) {
 			return nil, ErrEmptyCachedResult
 		}
+		// Secondary rows are copied into the candidate row before the next one is read
+		sql.ReuseRows(rowIter)
 		i.secondary = rowIter
 	}
 
@@ -135,14 +146,14 @@ func (i *joinIter) Next(ctx *sql.Context) (sql.Row, error) {
 				if !i.foundMatch && i.joinType.IsLeftOuter() {
 					i.primaryRow = nil
 					row := i.buildRow(primary, nil)
-					return i.removeParentRow(row), nil
+					return i.result(row), nil
 				}
 				continue
 			} else if errors.Is(err, ErrEmptyCachedResult) {
 				if !i.foundMatch && i.joinType.IsLeftOuter() {
 					i.primaryRow = nil
 					row := i.buildRow(primary, nil)
-					return i.removeParentRow(row), nil
+					return i.result(row), nil
 				}
 
 				return nil, io.EOF
@@ -161,10 +172,25 @@ func (i *joinIter) Next(ctx *sql.Context) (sql.Row, error) {
 		}
 
 		i.foundMatch = true
-		return i.removeParentRow(row), nil
+		return i.result(row), nil
 	}
 }
 
+// result returns the row of the join for the candidate row given, which is copied unless the caller of this iterator
+// reuses rows.
+func (i *joinIter) result(row sql.Row) sql.Row {
+	row = i.removeParentRow(row)
+	if i.reuse {
+		return row
+	}
+	return row.Copy()
+}
+
+// ReuseRows implements the sql.RowReuser interface.
+func (i *joinIter) ReuseRows() {
+	i.reuse = true
+}
+
 func (i *joinIter) removeParentRow(r sql.Row) sql.Row {
 	copy(r[i.scopeLen:], r[len(i.parentRow):])
 	r = r[:len(r)-len(i.parentRow)+i.scopeLen]
@@ -181,17 +207,30 @@ func conditionIsTrue(ctx *sql.Context, row sql.Row, cond sql.Expression) (bool,
 	return v == true, nil
 }
 
-// buildRow builds the result set row using the rows from the primary and secondary tables
+// buildRow builds the result set row using the rows from the primary and secondary tables. The row is built in the
+// buffer of this iterator, and is overwritten by the next call.
 func (i *joinIter) buildRow(primary, secondary sql.Row) sql.Row {
-	row := make(sql.Row, i.rowSize)
+	if i.buf == nil {
+		i.buf = sql.NewRowBuffer(i.rowSize)
+	}
+	// removeParentRow shortens the last row built, so the full row is sliced again
+	row := i.buf.Row[:i.rowSize]
 
-	copy(row, primary)
-	copy(row[len(primary):], secondary)
+	n := copy(row, primary)
+	n += copy(row[n:], secondary)
+	for j := n; j < len(row); j++ {
+		row[j] = nil
+	}
 
 	return row
 }
 
 func (i *joinIter) Close(ctx *sql.Context) (err error) {
+	defer func() {
+		i.buf.Release()
+		i.buf = nil
+	}()
+
 	if i.primary != nil {
 		if err = i.primary.Close(ctx); err != nil {
 			if i.secondary != nil {
@@ -227,6 +266,7 @@ func newExistsIter(ctx *sql.Context, j *JoinNode, row sql.Row) (sql.RowIter, err
 	if err != nil {
 		return nil, err
 	}
+	_, scalarRight := j.right.(*Max1Row)
 	return &existsIter{
 		parentRow:         row,
 		typ:               j.Op,
@@ -236,6 +276,7 @@ func newExistsIter(ctx *sql.Context, j *JoinNode, row sql.Row) (sql.RowIter, err
 		scopeLen:          j.ScopeLen,
 		rowSize:           len(row) + len(j.left.Schema()) + len(j.right.Schema()),
 		nullRej:           !(j.Filter != nil && IsNullRejecting(j.Filter)),
+		scalarRight:       scalarRight,
 	}, nil
 }
 
@@ -251,6 +292,8 @@ type existsIter struct {
 	scopeLen  int
 	rowSize   int
 	nullRej   bool
+	// scalarRight is set when the right side is a scalar subquery
+	scalarRight bool
 }
 
 type existsState uint8
@@ -275,7 +318,8 @@ func (i *existsIter) Next(ctx *sql.Context) (sql.Row, error) {
 	// notable exceptions are represented as goto jumps:
 	//  - non-null rejecting filters jump to COMPARE with a nil right row
 	//    when the secondaryProvider is empty
-	//  - antiJoin succeeds to RET when LOAD_RIGHT EOF's
+	//  - antiJoin succeeds to RET when LOAD_RIGHT EOF's, or when the
+	//    secondaryProvider is empty and isn't a scalar subquery
 	//  - semiJoin fails when LOAD_RIGHT EOF's, falling back to LOAD_LEFT
 	//  - antiJoin fails when COMPARE returns true, falling back to LOAD_LEFT
 	nextState := esIncLeft
@@ -292,10 +336,19 @@ func (i *existsIter) Next(ctx *sql.Context) (sql.Row, error) {
 				return nil, err
 			}
 			if isEmptyIter(rIter) {
-				if i.nullRej || i.typ.IsAnti() {
+				switch {
+				case i.typ.IsAnti() && i.scalarRight:
+					// a scalar subquery with no rows is null, and no
+					// comparison with it is false
+					return nil, io.EOF
+				case i.typ.IsAnti():
+					// no right row matches any left row
+					nextState = esRet
+				case i.nullRej:
 					return nil, io.EOF
+				default:
+					nextState = esCompare
 				}
-				nextState = esCompare
 			} else {
 				nextState = esIncRight
 			}
@@ -32,11 +32,13 @@ func _() {
 	_ = x[JoinTypeSemiMerge-21]
 	_ = x[JoinTypeAntiMerge-22]
 	_ = x[JoinTypeNatural-23]
+	_ = x[JoinTypeLeftOuterNatural-24]
+	_ = x[JoinTypeRightOuterNatural-25]
 }
 
-const _JoinType_name = "UnknownJoinCrossJoinInnerJoinSemiJoinAntiJoinRightSemiJoinLeftOuterJoinFullOuterJoinGroupByJoinRightJoinLookupJoinLeftOuterLookupJoinHashJoinLeftOuterHashJoinMergeJoinLeftOuterMergeJoinSemiHashJoinAntiHashJoinSemiLookupJoinAntiLookupJoinRightSemiLookupJoinSemiMergeJoinAntiMergeJoinNaturalJoin"
+const _JoinType_name = "UnknownJoinCrossJoinInnerJoinSemiJoinAntiJoinRightSemiJoinLeftOuterJoinFullOuterJoinGroupByJoinRightJoinLookupJoinLeftOuterLookupJoinHashJoinLeftOuterHashJoinMergeJoinLeftOuterMergeJoinSemiHashJoinAntiHashJoinSemiLookupJoinAntiLookupJoinRightSemiLookupJoinSemiMergeJoinAntiMergeJoinNaturalJoinNaturalLeftJoinNaturalRightJoin"
 
-var _JoinType_index = [...]uint16{0, 11, 20, 29, 37, 45, 58, 71, 84, 95, 104, 114, 133, 141, 158, 167, 185, 197, 209, 223, 237, 256, 269, 282, 293}
+var _JoinType_index = [...]uint16{0, 11, 20, 29, 37, 45, 58, 71, 84, 95, 104, 114, 133, 141, 158, 167, 185, 197, 209, 223, 237, 256, 269, 282, 293, 308, 324}
 
 func (i JoinType) String() string {
 	if i >= JoinType(len(_JoinType_index)-1) {
@@ -32,6 +32,7 @@ type Limit struct {
 }
 
 var _ sql.Node = (*Limit)(nil)
+var _ sql.Node2 = (*Limit)(nil)
 var _ sql.CollationCoercible = (*Limit)(nil)
 
 // NewLimit creates a new Limit node with the given size.
@@ -89,6 +90,29 @@ func (l *Limit) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	}), nil
 }
 
+// RowIter2 implements the sql.Node2 interface.
+func (l *Limit) RowIter2(ctx *sql.Context, f *sql.RowFrame) (sql.RowIter2, error) {
+	span, ctx := ctx.Span("plan.Limit", trace.WithAttributes(attribute.Stringer("limit", l.Limit)))
+
+	limit, err := getInt64Value(ctx, l.Limit)
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+
+	childIter, err := sql.NewRowIter2(ctx, l.Child, f)
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+	return sql.NewSpanIter(span, &limitIter{
+		calcFoundRows: l.CalcFoundRows,
+		limit:         limit,
+		childIter:     childIter,
+		childIter2:    childIter,
+	}).(sql.RowIter2), nil
+}
+
 // getInt64Value returns the int64 literal value in the expression given, or an error with the errStr given if it
 // cannot.
 func getInt64Value(ctx *sql.Context, expr sql.Expression) (int64, error) {
@@ -163,9 +187,12 @@ type limitIter struct {
 	calcFoundRows bool
 	currentPos    int64
 	childIter     sql.RowIter
+	childIter2    sql.RowIter2
 	limit         int64
 }
 
+var _ sql.RowIter2 = (*limitIter)(nil)
+
 func (li *limitIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if li.currentPos >= li.limit {
 		// If we were asked to calc all found rows, then when we are past the limit we iterate over the rest of the
@@ -192,6 +219,29 @@ func (li *limitIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return childRow, nil
 }
 
+// Next2 implements the sql.RowIter2 interface.
+func (li *limitIter) Next2(ctx *sql.Context, frame *sql.RowFrame) error {
+	if li.currentPos >= li.limit {
+		if li.calcFoundRows {
+			f := sql.NewRowFrame()
+			defer f.Recycle()
+			for {
+				f.Clear()
+				if err := li.childIter2.Next2(ctx, f); err != nil {
+					return err
+				}
+				li.currentPos++
+			}
+		}
+
+		return io.EOF
+	}
+
+	err := li.childIter2.Next2(ctx, frame)
+	li.currentPos++
+	return err
+}
+
 func (li *limitIter) Close(ctx *sql.Context) error {
 	err := li.childIter.Close(ctx)
 	if err != nil {
@@ -135,3 +135,22 @@ func getLimitedIterator(t *testing.T, limitSize int64) (sql.RowIter, error) {
 func receivesNode(n sql.Node) bool {
 	return true
 }
+
+func TestLimitRowIter2(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	child := memory.NewTable("test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int64},
+	}), nil)
+	for i := int64(1); i <= 4; i++ {
+		require.NoError(child.Insert(ctx, sql.NewRow(i)))
+	}
+
+	l := NewLimit(expression.NewLiteral(int64(2), types.Int64), NewResolvedTable(child, nil, nil)).WithCalcFoundRows(true)
+	iter, err := l.RowIter2(ctx, nil)
+	require.NoError(err)
+	rows, err := sql.RowIter2ToRows(ctx, l.Schema(), iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(1)}, {int64(2)}}, rows)
+	require.Equal(int64(4), ctx.GetLastQueryInfo(sql.FoundRows))
+}
@@ -49,6 +49,16 @@ type LoadData struct {
 var _ sql.Node = (*LoadData)(nil)
 var _ sql.CollationCoercible = (*LoadData)(nil)
 
+// LoadDataInfo is the Info for OKResults returned by Insert nodes that load their rows from a file.
+type LoadDataInfo struct {
+	Records, Deleted, Skipped, Warnings int
+}
+
+// String implements fmt.Stringer
+func (li LoadDataInfo) String() string {
+	return fmt.Sprintf("Records: %d  Deleted: %d  Skipped: %d  Warnings: %d", li.Records, li.Deleted, li.Skipped, li.Warnings)
+}
+
 // Default values as defined here: https://dev.mysql.com/doc/refman/8.0/en/load-data.html
 const (
 	defaultFieldsTerminatedByDelim = "\t"
@@ -184,6 +194,17 @@ func (l *LoadData) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		reader = file
 	}
 
+	total := int64(-1)
+	if file, ok := reader.(*os.File); ok {
+		if info, err := file.Stat(); err == nil && info.Mode().IsRegular() {
+			total = info.Size()
+		}
+	}
+	reader = &stageReader{
+		ReadCloser: reader,
+		stage:      sql.StartStage(ctx, sql.StageLoadingData, sql.StageUnitBytes, total),
+	}
+
 	scanner := bufio.NewScanner(reader)
 
 	// Set the split function for lines.
@@ -229,6 +250,24 @@ func (l *LoadData) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	}, nil
 }
 
+// stageReader is the reader of the file of a LOAD DATA, which reports the bytes read from it as the progress of the
+// stage of the query, and ends the stage once it's closed.
+type stageReader struct {
+	io.ReadCloser
+	stage *sql.StageTracker
+}
+
+func (r *stageReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.stage.Add(int64(n))
+	return n, err
+}
+
+func (r *stageReader) Close() error {
+	r.stage.End()
+	return r.ReadCloser.Close()
+}
+
 type loadDataIter struct {
 	scanner                 *bufio.Scanner
 	destination             sql.Node
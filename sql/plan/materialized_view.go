@@ -0,0 +1,459 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// CreateMaterializedView is a node creating a materialized view, whose backing table is created and filled with the
+// result of its definition, the child of this node.
+type CreateMaterializedView struct {
+	ddlNode
+	Name        string
+	IfNotExists bool
+	// Definition is the text of the SELECT statement defining the view
+	Definition string
+	// Source is the query defining the view
+	Source          sql.Node
+	RefreshInterval time.Duration
+	MaxStaleness    time.Duration
+	// definition is the query defining the view as it was parsed, before it was analyzed
+	definition sql.Node
+}
+
+var _ sql.Node = (*CreateMaterializedView)(nil)
+var _ sql.Databaser = (*CreateMaterializedView)(nil)
+var _ sql.CollationCoercible = (*CreateMaterializedView)(nil)
+
+// NewCreateMaterializedView returns a node creating the materialized view with the name given in the database given,
+// defined by the query given.
+func NewCreateMaterializedView(
+	db sql.Database,
+	name string,
+	ifNotExists bool,
+	definition string,
+	source sql.Node,
+	refreshInterval, maxStaleness time.Duration,
+) *CreateMaterializedView {
+	return &CreateMaterializedView{
+		ddlNode:         ddlNode{db: db},
+		Name:            name,
+		IfNotExists:     ifNotExists,
+		Definition:      definition,
+		Source:          source,
+		RefreshInterval: refreshInterval,
+		MaxStaleness:    maxStaleness,
+		definition:      source,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (c *CreateMaterializedView) Resolved() bool {
+	return c.ddlNode.Resolved() && c.Source.Resolved()
+}
+
+// Children implements the sql.Node interface.
+func (c *CreateMaterializedView) Children() []sql.Node {
+	return []sql.Node{c.Source}
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *CreateMaterializedView) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	nc := *c
+	nc.Source = children[0]
+	return &nc, nil
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (c *CreateMaterializedView) WithDatabase(db sql.Database) (sql.Node, error) {
+	if privilegedDatabase, ok := db.(mysql_db.PrivilegedDatabase); ok {
+		db = privilegedDatabase.Unwrap()
+	}
+	nc := *c
+	nc.db = db
+	return &nc, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (c *CreateMaterializedView) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation(c.db.Name(), "", "", sql.PrivilegeType_Create)) &&
+		c.Source.CheckPrivileges(ctx, opChecker)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*CreateMaterializedView) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the sql.Node interface. It creates the backing table of the view, fills it and registers the
+// view. The backing table is dropped if filling it fails. Views reading a single table whose changes are notified,
+// grouped and aggregated with COUNT, SUM, MIN and MAX, are maintained incrementally as the table changes.
+func (c *CreateMaterializedView) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	registry, err := sql.MustGetService(ctx, sql.MaterializedViewsService)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := registry.View(c.db.Name(), c.Name); ok {
+		if c.IfNotExists {
+			return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+		}
+		return nil, sql.ErrMaterializedViewExists.New(c.db.Name(), c.Name)
+	}
+	if _, exists, err := c.db.GetTableInsensitive(ctx, c.Name); err != nil {
+		return nil, err
+	} else if exists {
+		return nil, sql.ErrTableAlreadyExists.New(c.Name)
+	}
+
+	creator, ok := c.db.(sql.TableCreator)
+	if !ok {
+		return nil, sql.ErrCreateTableNotSupported.New(c.db.Name())
+	}
+	// the columns of the backing table are nullable, since aggregates such as SUM return NULL for NULL values even
+	// when their columns aren't nullable
+	schema := make(sql.Schema, len(c.Source.Schema()))
+	for i, col := range c.Source.Schema() {
+		schema[i] = &sql.Column{
+			Name:     col.Name,
+			Type:     col.Type,
+			Nullable: true,
+			Source:   c.Name,
+		}
+	}
+	if err := creator.CreateTable(ctx, c.Name, sql.NewPrimaryKeySchema(schema), sql.Collation_Default); err != nil {
+		return nil, err
+	}
+
+	var rows int
+	var maintainer sql.MaterializedViewMaintainer
+	m, err := newIncrementalViewMaintainer(ctx, c.db, c.Name, c.definition, schema)
+	if err == nil && m != nil {
+		maintainer = m
+		rows, err = m.Rebuild(ctx)
+	} else if err == nil {
+		rows, err = refreshMaterializedView(ctx, c.db, c.Name, c.Source, row)
+	}
+	if err == nil {
+		err = registry.Register(sql.MaterializedView{
+			Database:        c.db.Name(),
+			Name:            c.Name,
+			Definition:      c.Definition,
+			RefreshInterval: c.RefreshInterval,
+			MaxStaleness:    c.MaxStaleness,
+			LastRefresh:     ctx.QueryTime(),
+			Maintainer:      maintainer,
+		})
+	}
+	if err != nil {
+		if maintainer != nil {
+			maintainer.Stop()
+		}
+		if dropper, ok := c.db.(sql.TableDropper); ok {
+			if dropErr := dropper.DropTable(ctx, c.Name); dropErr != nil {
+				return nil, dropErr
+			}
+		}
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(rows))), nil
+}
+
+func (c *CreateMaterializedView) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("CreateMaterializedView(%s)", c.Name)
+	_ = pr.WriteChildren(c.Source.String())
+	return pr.String()
+}
+
+// RefreshMaterializedView is a node replacing the rows of the backing table of a materialized view with the result
+// of its definition. Its source is the query defining the view, which is set by the analyzer.
+type RefreshMaterializedView struct {
+	ddlNode
+	Name   string
+	Source sql.Node
+}
+
+var _ sql.Node = (*RefreshMaterializedView)(nil)
+var _ sql.Databaser = (*RefreshMaterializedView)(nil)
+var _ sql.CollationCoercible = (*RefreshMaterializedView)(nil)
+
+// NewRefreshMaterializedView returns a node refreshing the materialized view with the name given in the database
+// given.
+func NewRefreshMaterializedView(db sql.Database, name string) *RefreshMaterializedView {
+	return &RefreshMaterializedView{ddlNode: ddlNode{db: db}, Name: name}
+}
+
+// WithSource returns a copy of this node refreshing the view with the result of the query given.
+func (r *RefreshMaterializedView) WithSource(source sql.Node) *RefreshMaterializedView {
+	nr := *r
+	nr.Source = source
+	return &nr
+}
+
+// Resolved implements the sql.Node interface.
+func (r *RefreshMaterializedView) Resolved() bool {
+	return r.ddlNode.Resolved() && r.Source != nil && r.Source.Resolved()
+}
+
+// Children implements the sql.Node interface.
+func (r *RefreshMaterializedView) Children() []sql.Node {
+	if r.Source == nil {
+		return nil
+	}
+	return []sql.Node{r.Source}
+}
+
+// WithChildren implements the sql.Node interface.
+func (r *RefreshMaterializedView) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != len(r.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.Children()))
+	}
+	if len(children) == 0 {
+		return r, nil
+	}
+	return r.WithSource(children[0]), nil
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (r *RefreshMaterializedView) WithDatabase(db sql.Database) (sql.Node, error) {
+	if privilegedDatabase, ok := db.(mysql_db.PrivilegedDatabase); ok {
+		db = privilegedDatabase.Unwrap()
+	}
+	nr := *r
+	nr.db = db
+	return &nr, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (r *RefreshMaterializedView) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation(r.db.Name(), r.Name, "", sql.PrivilegeType_Delete, sql.PrivilegeType_Insert)) &&
+		(r.Source == nil || r.Source.CheckPrivileges(ctx, opChecker))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*RefreshMaterializedView) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the sql.Node interface. Views maintained incrementally are only rebuilt if a change couldn't be
+// applied to them, and stop being maintained if they can't be rebuilt.
+func (r *RefreshMaterializedView) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	registry, err := sql.MustGetService(ctx, sql.MaterializedViewsService)
+	if err != nil {
+		return nil, err
+	}
+	view, ok := registry.View(r.db.Name(), r.Name)
+	if !ok {
+		return nil, sql.ErrMaterializedViewDoesNotExist.New(r.db.Name(), r.Name)
+	}
+
+	rows, refreshed := 0, false
+	if maintainer := view.Maintainer; maintainer != nil {
+		if maintainer.IsCurrent() {
+			refreshed = true
+		} else if rows, err = maintainer.Rebuild(ctx); err == nil {
+			refreshed = true
+		} else {
+			ctx.GetLogger().WithError(err).Warnf("materialized view %s is not maintained incrementally anymore", r.Name)
+			maintainer.Stop()
+			if err := registry.SetMaintainer(r.db.Name(), r.Name, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !refreshed {
+		if rows, err = refreshMaterializedView(ctx, r.db, r.Name, r.Source, row); err != nil {
+			return nil, err
+		}
+	}
+	if err := registry.SetRefreshed(r.db.Name(), r.Name, ctx.QueryTime()); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(rows))), nil
+}
+
+func (r *RefreshMaterializedView) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("RefreshMaterializedView(%s)", r.Name)
+	if r.Source != nil {
+		_ = pr.WriteChildren(r.Source.String())
+	}
+	return pr.String()
+}
+
+// DropMaterializedView is a node dropping a materialized view and its backing table.
+type DropMaterializedView struct {
+	ddlNode
+	Name     string
+	IfExists bool
+}
+
+var _ sql.Node = (*DropMaterializedView)(nil)
+var _ sql.Databaser = (*DropMaterializedView)(nil)
+var _ sql.CollationCoercible = (*DropMaterializedView)(nil)
+
+// NewDropMaterializedView returns a node dropping the materialized view with the name given in the database given.
+func NewDropMaterializedView(db sql.Database, name string, ifExists bool) *DropMaterializedView {
+	return &DropMaterializedView{ddlNode: ddlNode{db: db}, Name: name, IfExists: ifExists}
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *DropMaterializedView) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(d, children...)
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (d *DropMaterializedView) WithDatabase(db sql.Database) (sql.Node, error) {
+	if privilegedDatabase, ok := db.(mysql_db.PrivilegedDatabase); ok {
+		db = privilegedDatabase.Unwrap()
+	}
+	nd := *d
+	nd.db = db
+	return &nd, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (d *DropMaterializedView) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation(d.db.Name(), d.Name, "", sql.PrivilegeType_Drop))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*DropMaterializedView) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the sql.Node interface.
+func (d *DropMaterializedView) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	registry, err := sql.MustGetService(ctx, sql.MaterializedViewsService)
+	if err != nil {
+		return nil, err
+	}
+	view, ok := registry.View(d.db.Name(), d.Name)
+	if !ok {
+		if d.IfExists {
+			return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+		}
+		return nil, sql.ErrMaterializedViewDoesNotExist.New(d.db.Name(), d.Name)
+	}
+
+	dropper, ok := d.db.(sql.TableDropper)
+	if !ok {
+		return nil, sql.ErrDropTableNotSupported.New(d.db.Name())
+	}
+	if view.Maintainer != nil {
+		view.Maintainer.Stop()
+	}
+	if err := dropper.DropTable(ctx, d.Name); err != nil && !sql.ErrTableNotFound.Is(err) {
+		return nil, err
+	}
+	if err := registry.Delete(d.db.Name(), d.Name); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+func (d *DropMaterializedView) String() string {
+	return fmt.Sprintf("DropMaterializedView(%s)", d.Name)
+}
+
+// refreshMaterializedView replaces the rows of the backing table of the materialized view with the name given with
+// the rows of the query given, converted to the types of its columns, returning the number of rows inserted.
+func refreshMaterializedView(ctx *sql.Context, db sql.Database, name string, source sql.Node, row sql.Row) (int, error) {
+	table, ok, err := db.GetTableInsensitive(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, sql.ErrTableNotFound.New(name)
+	}
+	truncatable, err := getTruncatableTable(table)
+	if err != nil {
+		return 0, err
+	}
+	insertable, err := getInsertableTable(table)
+	if err != nil {
+		return 0, err
+	}
+
+	iter, err := source.RowIter(ctx, row)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = truncatable.Truncate(ctx); err != nil {
+		iter.Close(ctx)
+		return 0, err
+	}
+
+	inserter := insertable.Inserter(ctx)
+	inserter.StatementBegin(ctx)
+	rows := 0
+	for {
+		var r sql.Row
+		r, err = iter.Next(ctx)
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		if err == nil {
+			r, err = convertMaterializedViewRow(table.Schema(), r)
+		}
+		if err == nil {
+			err = inserter.Insert(ctx, r)
+		}
+		if err != nil {
+			break
+		}
+		rows++
+	}
+	if closeErr := iter.Close(ctx); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		inserter.DiscardChanges(ctx, err)
+		inserter.Close(ctx)
+		return 0, err
+	}
+	if err := inserter.StatementComplete(ctx); err != nil {
+		inserter.Close(ctx)
+		return 0, err
+	}
+	return rows, inserter.Close(ctx)
+}
+
+// convertMaterializedViewRow converts the values of the row given to the types of the columns of the backing table of
+// a materialized view, since aggregates such as SUM don't return values of their type.
+func convertMaterializedViewRow(schema sql.Schema, row sql.Row) (sql.Row, error) {
+	converted := make(sql.Row, len(row))
+	for i, v := range row {
+		var err error
+		if converted[i], err = schema[i].Type.Convert(v); err != nil {
+			return nil, err
+		}
+	}
+	return converted, nil
+}
@@ -0,0 +1,668 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/cespare/xxhash"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// viewAggregate is the value of a column of an incrementally maintained materialized view.
+type viewAggregate byte
+
+const (
+	// viewGroupKey is the value of a grouping expression
+	viewGroupKey viewAggregate = iota
+	viewCountStar
+	viewCount
+	viewSum
+	viewMin
+	viewMax
+)
+
+// viewColumn is a column of an incrementally maintained materialized view.
+type viewColumn struct {
+	aggregate viewAggregate
+	// group is the index of the grouping expression of viewGroupKey columns
+	group int
+	// arg is the argument of the aggregate of the column, resolved on the schema of the base table
+	arg sql.Expression
+}
+
+// viewGroup is the state of a group of the rows of the base table of an incrementally maintained materialized view.
+type viewGroup struct {
+	key  sql.Row
+	rows int64
+	aggs []viewAggregateState
+	// row is the row of the group in the backing table, or nil if it has none
+	row sql.Row
+}
+
+// viewAggregateState is the state of an aggregate of a group.
+type viewAggregateState struct {
+	// count is the number of non-NULL values of the aggregate's argument
+	count int64
+	// sum is either a float64 or a decimal.Decimal, as it is for SUM
+	sum     interface{}
+	extreme interface{}
+	// extremeRemoved is set when the MIN or MAX of the group was removed, so that it must be computed again from the
+	// rows of the group
+	extremeRemoved bool
+}
+
+// incrementalViewMaintainer maintains the backing table of a materialized view reading a single table of its database,
+// optionally filtered, and grouped by expressions of that table's columns. Its columns are grouping expressions and
+// COUNT, SUM, MIN and MAX aggregates. The state of each group is kept in memory, so that the rows of the groups changed
+// by a statement are computed without reading the base table, unless the MIN or MAX of a group is removed.
+type incrementalViewMaintainer struct {
+	mu         sync.Mutex
+	db         sql.Database
+	name       string
+	base       sql.ChangeNotifyingTable
+	baseSchema sql.Schema
+	filter     sql.Expression
+	groupBy    []sql.Expression
+	hashers    []types.HashFunc
+	hash       hash.Hash64
+	columns    []viewColumn
+	schema     sql.Schema
+	groups     map[uint64]*viewGroup
+	current    bool
+}
+
+var _ sql.MaterializedViewMaintainer = (*incrementalViewMaintainer)(nil)
+var _ sql.TableChangeListener = (*incrementalViewMaintainer)(nil)
+
+// newIncrementalViewMaintainer returns a maintainer of the materialized view with the name given in the database
+// given, defined by the parsed query given, whose backing table has the schema given. It returns nil if the view
+// can't be maintained incrementally. The maintainer doesn't listen to the changes of the base table until it's
+// rebuilt.
+func newIncrementalViewMaintainer(ctx *sql.Context, db sql.Database, name string, definition sql.Node, schema sql.Schema) (*incrementalViewMaintainer, error) {
+	n := definition
+	if sort, ok := n.(*Sort); ok {
+		n = sort.Child
+	}
+	groupBy, ok := n.(*GroupBy)
+	if !ok {
+		return nil, nil
+	}
+	n = groupBy.Child
+	var filter sql.Expression
+	if f, ok := n.(*Filter); ok {
+		filter, n = f.Expression, f.Child
+	}
+	var alias string
+	if ta, ok := n.(*TableAlias); ok {
+		alias, n = ta.Name(), ta.Child
+	}
+	urt, ok := n.(*UnresolvedTable)
+	if !ok || urt.AsOf() != nil || (urt.Database() != "" && !strings.EqualFold(urt.Database(), db.Name())) {
+		return nil, nil
+	}
+	table, ok, err := db.GetTableInsensitive(ctx, urt.Name())
+	if err != nil || !ok {
+		return nil, err
+	}
+	base, ok := sql.GetChangeNotifyingTable(table)
+	if !ok {
+		return nil, nil
+	}
+	if alias == "" {
+		alias = urt.Name()
+	}
+
+	m := &incrementalViewMaintainer{
+		db:         db,
+		name:       name,
+		base:       base,
+		baseSchema: base.Schema().Copy(),
+		hash:       xxhash.New(),
+		schema:     schema,
+	}
+	if filter != nil {
+		if m.filter, ok = m.resolve(filter, alias); !ok {
+			return nil, nil
+		}
+	}
+	for _, e := range groupBy.GroupByExprs {
+		resolved, ok := m.resolve(e, alias)
+		if !ok {
+			return nil, nil
+		}
+		m.groupBy = append(m.groupBy, resolved)
+		m.hashers = append(m.hashers, types.Hasher(resolved.Type()))
+	}
+	for _, e := range groupBy.SelectedExprs {
+		col, ok := m.column(e, alias)
+		if !ok {
+			return nil, nil
+		}
+		m.columns = append(m.columns, col)
+	}
+	if len(m.columns) != len(schema) {
+		return nil, nil
+	}
+	return m, nil
+}
+
+// resolve returns the parsed expression given resolved on the schema of the base table, whose name in the definition
+// of the view is the one given, or false if it isn't a deterministic expression of its columns.
+func (m *incrementalViewMaintainer) resolve(e sql.Expression, tableName string) (sql.Expression, bool) {
+	ok := true
+	resolved, _, err := transform.Expr(e, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		switch e := e.(type) {
+		case *expression.UnresolvedColumn:
+			idx := m.baseSchema.IndexOfColName(e.Name())
+			if idx < 0 || (e.Table() != "" && !strings.EqualFold(e.Table(), tableName)) {
+				ok = false
+				return e, transform.SameTree, nil
+			}
+			col := m.baseSchema[idx]
+			return expression.NewGetFieldWithTable(idx, col.Type, tableName, col.Name, col.Nullable), transform.NewTree, nil
+		case *expression.UserVar, *expression.SystemVar, *expression.BindVar, *expression.ProcedureParam,
+			*expression.DistinctExpression, *expression.Star:
+			ok = false
+		case sql.NonDeterministicExpression:
+			ok = ok && !e.IsNonDeterministic()
+		}
+		return e, transform.SameTree, nil
+	})
+	return resolved, err == nil && ok && resolved.Resolved()
+}
+
+// column returns the column of the view selecting the parsed expression given, or false if it's neither a grouping
+// expression nor a supported aggregate.
+func (m *incrementalViewMaintainer) column(e sql.Expression, tableName string) (viewColumn, bool) {
+	if alias, ok := e.(*expression.Alias); ok {
+		e = alias.Child
+	}
+
+	if f, ok := e.(*expression.UnresolvedFunction); ok {
+		if f.Window != nil || len(f.Arguments) != 1 {
+			return viewColumn{}, false
+		}
+		col := viewColumn{group: -1}
+		switch strings.ToLower(f.Name()) {
+		case "count":
+			if _, ok := f.Arguments[0].(*expression.Star); ok {
+				col.aggregate = viewCountStar
+				return col, true
+			}
+			col.aggregate = viewCount
+		case "sum":
+			col.aggregate = viewSum
+		case "min":
+			col.aggregate = viewMin
+		case "max":
+			col.aggregate = viewMax
+		default:
+			return viewColumn{}, false
+		}
+		col.arg, ok = m.resolve(f.Arguments[0], tableName)
+		return col, ok
+	}
+
+	resolved, ok := m.resolve(e, tableName)
+	if !ok {
+		return viewColumn{}, false
+	}
+	for i, g := range m.groupBy {
+		if g.String() == resolved.String() {
+			return viewColumn{aggregate: viewGroupKey, group: i}, true
+		}
+	}
+	return viewColumn{}, false
+}
+
+// IsCurrent implements the sql.MaterializedViewMaintainer interface. The view isn't current once the schema of its
+// base table changed.
+func (m *incrementalViewMaintainer) IsCurrent() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.current && m.base.Schema().Equals(m.baseSchema)
+}
+
+// Rebuild implements the sql.MaterializedViewMaintainer interface. It computes the state of every group from the
+// rows of the base table, and replaces the rows of the backing table with the rows of the groups.
+func (m *incrementalViewMaintainer) Rebuild(ctx *sql.Context) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.current {
+		// changes are only applied to a current view, so the listener is added again when the view is rebuilt
+		m.base.RemoveChangeListener(m)
+		m.base.AddChangeListener(m)
+	}
+	m.current = false
+	if !m.base.Schema().Equals(m.baseSchema) {
+		return 0, fmt.Errorf("the schema of table %s read by materialized view %s changed", m.base.Name(), m.name)
+	}
+
+	m.groups = make(map[uint64]*viewGroup)
+	changed := make(map[uint64]*viewGroup)
+	if len(m.groupBy) == 0 {
+		// views without grouping expressions have a single row, even if the base table has no rows
+		m.hash.Reset()
+		m.group(m.hash.Sum64(), nil, changed)
+	}
+	err := m.scan(ctx, func(row sql.Row) error {
+		return m.accumulate(ctx, row, 1, changed)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	table, err := m.backingTable(ctx)
+	if err != nil {
+		return 0, err
+	}
+	truncatable, err := getTruncatableTable(table)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := truncatable.Truncate(ctx); err != nil {
+		return 0, err
+	}
+	for _, g := range m.groups {
+		g.row = nil
+	}
+	if err := m.write(ctx, m.groups); err != nil {
+		return 0, err
+	}
+	m.current = true
+	return len(m.groups), nil
+}
+
+// Stop implements the sql.MaterializedViewMaintainer interface.
+func (m *incrementalViewMaintainer) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.base.RemoveChangeListener(m)
+	m.current = false
+	m.groups = nil
+}
+
+// RowsChanged implements the sql.TableChangeListener interface. The view stops being current if the changes can't be
+// applied to it.
+func (m *incrementalViewMaintainer) RowsChanged(ctx *sql.Context, table sql.Table, changes []sql.RowChange) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.current {
+		return
+	}
+	if err := m.apply(ctx, changes); err != nil {
+		m.current = false
+		m.groups = nil
+		ctx.GetLogger().WithError(err).Warnf("materialized view %s must be refreshed", m.name)
+	}
+}
+
+// apply applies the changes given to the rows of the base table to the groups of the view and its backing table.
+func (m *incrementalViewMaintainer) apply(ctx *sql.Context, changes []sql.RowChange) error {
+	if !m.base.Schema().Equals(m.baseSchema) {
+		return fmt.Errorf("the schema of table %s changed", m.base.Name())
+	}
+
+	changed := make(map[uint64]*viewGroup)
+	for _, change := range changes {
+		if change.Old != nil {
+			if err := m.accumulate(ctx, change.Old, -1, changed); err != nil {
+				return err
+			}
+		}
+		if change.New != nil {
+			if err := m.accumulate(ctx, change.New, 1, changed); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The MIN or MAX of the groups whose extreme value was removed is computed again from the base table
+	removed := make(map[uint64]*viewGroup)
+	for key, g := range changed {
+		for i := range g.aggs {
+			if g.aggs[i].extremeRemoved {
+				g.aggs[i].extremeRemoved = false
+				g.aggs[i].extreme = nil
+				removed[key] = g
+			}
+		}
+	}
+	if len(removed) > 0 {
+		err := m.scan(ctx, func(row sql.Row) error {
+			key, _, ok, err := m.groupingKey(ctx, row)
+			if err != nil || !ok {
+				return err
+			}
+			if g, ok := removed[key]; ok {
+				return m.accumulateExtremes(ctx, g, row)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for key, g := range changed {
+		if g.rows == 0 && len(m.groupBy) > 0 {
+			delete(m.groups, key)
+		}
+	}
+	return m.write(ctx, changed)
+}
+
+// scan calls the function given for every row of the base table.
+func (m *incrementalViewMaintainer) scan(ctx *sql.Context, f func(row sql.Row) error) error {
+	partitions, err := m.base.Partitions(ctx)
+	if err != nil {
+		return err
+	}
+	iter := sql.NewTableRowIter(ctx, m.base, partitions)
+	defer iter.Close(ctx)
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := f(row); err != nil {
+			return err
+		}
+	}
+}
+
+// groupingKey returns the key of the group of the row of the base table given, along with the values of its grouping
+// expressions, or false if the row is filtered out of the view.
+func (m *incrementalViewMaintainer) groupingKey(ctx *sql.Context, row sql.Row) (uint64, sql.Row, bool, error) {
+	if m.filter != nil {
+		res, err := sql.EvaluateCondition(ctx, m.filter, row)
+		if err != nil || !sql.IsTrue(res) {
+			return 0, nil, false, err
+		}
+	}
+	m.hash.Reset()
+	values := make(sql.Row, len(m.groupBy))
+	for i, e := range m.groupBy {
+		v, err := e.Eval(ctx, row)
+		if err != nil {
+			return 0, nil, false, err
+		}
+		if err := m.hashers[i](m.hash, v); err != nil {
+			return 0, nil, false, err
+		}
+		values[i] = v
+	}
+	return m.hash.Sum64(), values, true, nil
+}
+
+// group returns the group with the key given, creating it with the values of its grouping expressions given if it
+// doesn't exist, and adds it to the groups changed.
+func (m *incrementalViewMaintainer) group(key uint64, values sql.Row, changed map[uint64]*viewGroup) *viewGroup {
+	g, ok := m.groups[key]
+	if !ok {
+		g = &viewGroup{key: values, aggs: make([]viewAggregateState, len(m.columns))}
+		m.groups[key] = g
+	}
+	changed[key] = g
+	return g
+}
+
+// accumulate adds the row of the base table given to its group if sign is 1, or removes it if sign is -1.
+func (m *incrementalViewMaintainer) accumulate(ctx *sql.Context, row sql.Row, sign int64, changed map[uint64]*viewGroup) error {
+	key, values, ok, err := m.groupingKey(ctx, row)
+	if err != nil || !ok {
+		return err
+	}
+	g := m.group(key, values, changed)
+	if sign < 0 && g.rows == 0 {
+		return fmt.Errorf("a row removed from table %s isn't in materialized view %s", m.base.Name(), m.name)
+	}
+	g.rows += sign
+
+	for i, col := range m.columns {
+		if col.arg == nil {
+			continue
+		}
+		v, err := col.arg.Eval(ctx, row)
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			continue
+		}
+		agg := &g.aggs[i]
+		agg.count += sign
+		switch col.aggregate {
+		case viewSum:
+			agg.sum, err = addToSum(agg.sum, v, col.arg.Type(), sign)
+			if err != nil {
+				return err
+			}
+		case viewMin, viewMax:
+			if agg.count == 0 {
+				agg.extreme, agg.extremeRemoved = nil, false
+				continue
+			}
+			if agg.extremeRemoved {
+				continue
+			}
+			cmp := 0
+			if agg.extreme != nil {
+				if cmp, err = col.arg.Type().Compare(v, agg.extreme); err != nil {
+					return err
+				}
+			}
+			switch {
+			case sign < 0:
+				agg.extremeRemoved = cmp == 0
+			case agg.extreme == nil || (col.aggregate == viewMin && cmp < 0) || (col.aggregate == viewMax && cmp > 0):
+				agg.extreme = v
+			}
+		}
+	}
+	return nil
+}
+
+// accumulateExtremes adds the row of the base table given to the MIN and MAX aggregates of the group given.
+func (m *incrementalViewMaintainer) accumulateExtremes(ctx *sql.Context, g *viewGroup, row sql.Row) error {
+	for i, col := range m.columns {
+		if col.aggregate != viewMin && col.aggregate != viewMax {
+			continue
+		}
+		v, err := col.arg.Eval(ctx, row)
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			continue
+		}
+		agg := &g.aggs[i]
+		if agg.extreme == nil {
+			agg.extreme = v
+			continue
+		}
+		cmp, err := col.arg.Type().Compare(v, agg.extreme)
+		if err != nil {
+			return err
+		}
+		if (col.aggregate == viewMin && cmp < 0) || (col.aggregate == viewMax && cmp > 0) {
+			agg.extreme = v
+		}
+	}
+	return nil
+}
+
+// addToSum returns the sum given with the value given added if sign is 1, or subtracted if sign is -1. Sums are
+// decimal.Decimal for decimal values, and float64 for other values, as they are for SUM.
+func addToSum(sum interface{}, v interface{}, typ sql.Type, sign int64) (interface{}, error) {
+	if s, ok := v.(string); ok && types.IsDecimal(typ) {
+		converted, err := typ.Convert(s)
+		if err != nil {
+			return nil, err
+		}
+		v = converted
+	}
+	if d, ok := v.(decimal.Decimal); ok {
+		if sign < 0 {
+			d = d.Neg()
+		}
+		switch s := sum.(type) {
+		case decimal.Decimal:
+			return s.Add(d), nil
+		case float64:
+			return decimal.NewFromFloat(s).Add(d), nil
+		default:
+			return d, nil
+		}
+	}
+
+	f, err := types.Float64.Convert(v)
+	if err != nil {
+		f = float64(0)
+	}
+	val := f.(float64) * float64(sign)
+	switch s := sum.(type) {
+	case decimal.Decimal:
+		return s.Add(decimal.NewFromFloat(val)), nil
+	case float64:
+		return s + val, nil
+	default:
+		return val, nil
+	}
+}
+
+// row returns the row of the group given in the backing table.
+func (m *incrementalViewMaintainer) row(g *viewGroup) (sql.Row, error) {
+	row := make(sql.Row, len(m.columns))
+	for i, col := range m.columns {
+		agg := g.aggs[i]
+		var v interface{}
+		switch col.aggregate {
+		case viewGroupKey:
+			v = g.key[col.group]
+		case viewCountStar:
+			v = g.rows
+		case viewCount:
+			v = agg.count
+		case viewSum:
+			if agg.count > 0 {
+				v = agg.sum
+			}
+		case viewMin, viewMax:
+			v = agg.extreme
+		}
+		row[i] = v
+	}
+	return convertMaterializedViewRow(m.schema, row)
+}
+
+// backingTable returns the backing table of the view.
+func (m *incrementalViewMaintainer) backingTable(ctx *sql.Context) (sql.Table, error) {
+	table, ok, err := m.db.GetTableInsensitive(ctx, m.name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrTableNotFound.New(m.name)
+	}
+	return table, nil
+}
+
+// write replaces the rows of the groups given in the backing table with their current rows.
+func (m *incrementalViewMaintainer) write(ctx *sql.Context, groups map[uint64]*viewGroup) error {
+	var deleted, inserted []sql.Row
+	for key, g := range groups {
+		var row sql.Row
+		if _, ok := m.groups[key]; ok {
+			var err error
+			if row, err = m.row(g); err != nil {
+				return err
+			}
+		}
+		if g.row != nil && row != nil {
+			if equal, err := g.row.Equals(row, m.schema); err != nil {
+				return err
+			} else if equal {
+				continue
+			}
+		}
+		if g.row != nil {
+			deleted = append(deleted, g.row)
+		}
+		if row != nil {
+			inserted = append(inserted, row)
+		}
+		g.row = row
+	}
+
+	table, err := m.backingTable(ctx)
+	if err != nil {
+		return err
+	}
+	if len(deleted) > 0 {
+		deletable, err := getDeletableTable(table)
+		if err != nil {
+			return err
+		}
+		deleter := deletable.Deleter(ctx)
+		if err := writeRows(ctx, deleter, deleted, deleter.Delete); err != nil {
+			return err
+		}
+	}
+	if len(inserted) > 0 {
+		insertable, err := getInsertableTable(table)
+		if err != nil {
+			return err
+		}
+		inserter := insertable.Inserter(ctx)
+		if err := writeRows(ctx, inserter, inserted, inserter.Insert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeRows writes the rows given with the editor given, in a statement of their own.
+func writeRows(ctx *sql.Context, editor interface {
+	sql.EditOpenerCloser
+	sql.Closer
+}, rows []sql.Row, write func(*sql.Context, sql.Row) error) error {
+	editor.StatementBegin(ctx)
+	for _, row := range rows {
+		if err := write(ctx, row); err != nil {
+			editor.DiscardChanges(ctx, err)
+			editor.Close(ctx)
+			return err
+		}
+	}
+	if err := editor.StatementComplete(ctx); err != nil {
+		editor.Close(ctx)
+		return err
+	}
+	return editor.Close(ctx)
+}
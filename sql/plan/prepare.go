@@ -16,23 +16,30 @@ package plan
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/types"
 )
 
 // PrepareQuery is a node that prepares the query
 type PrepareQuery struct {
-	Name  string
+	Name string
+	// Query evaluates to the text of the statement prepared, which is a string literal or a user variable
+	Query sql.Expression
+	// Child is the statement prepared, or nil if its text is the value of a user variable, which is only known once
+	// the PREPARE statement is run
 	Child sql.Node
 }
 
 var _ sql.Node = (*PrepareQuery)(nil)
+var _ sql.Expressioner = (*PrepareQuery)(nil)
 var _ sql.CollationCoercible = (*PrepareQuery)(nil)
 
 // NewPrepareQuery creates a new PrepareQuery node.
-func NewPrepareQuery(name string, child sql.Node) *PrepareQuery {
-	return &PrepareQuery{Name: name, Child: child}
+func NewPrepareQuery(name string, query sql.Expression, child sql.Node) *PrepareQuery {
+	return &PrepareQuery{Name: name, Query: query, Child: child}
 }
 
 // Schema implements the Node interface.
@@ -49,13 +56,32 @@ func (pi PrepareInfo) String() string {
 	return "Statement prepared"
 }
 
-// RowIter implements the Node interface.
+// RowIter implements the Node interface. The statement is prepared when the PREPARE statement is run, rather than
+// when it's analyzed, so that its text may be set by the statements before it in a stored procedure.
 func (p *PrepareQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	statements, err := sql.MustGetService(ctx, sql.PreparedStatementsService)
+	if err != nil {
+		return nil, err
+	}
+	query, err := p.Query.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	query, err = types.LongText.Convert(query)
+	if err != nil {
+		return nil, err
+	}
+	if query == nil {
+		query = "NULL"
+	}
+	if err := statements.Prepare(ctx, p.Name, query.(string)); err != nil {
+		return nil, err
+	}
 	return sql.RowsToRowIter(sql.NewRow(types.OkResult{RowsAffected: 0, Info: PrepareInfo{}})), nil
 }
 
 func (p *PrepareQuery) Resolved() bool {
-	return true
+	return p.Query.Resolved()
 }
 
 // Children implements the Node interface.
@@ -71,9 +97,25 @@ func (p *PrepareQuery) WithChildren(children ...sql.Node) (sql.Node, error) {
 	return p, nil
 }
 
-// CheckPrivileges implements the interface sql.Node.
+// Expressions implements the sql.Expressioner interface.
+func (p *PrepareQuery) Expressions() []sql.Expression {
+	return []sql.Expression{p.Query}
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (p *PrepareQuery) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(exprs), 1)
+	}
+	np := *p
+	np.Query = exprs[0]
+	return &np, nil
+}
+
+// CheckPrivileges implements the interface sql.Node. The privileges of statements whose text isn't known yet are
+// checked when they're executed.
 func (p *PrepareQuery) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
-	return p.Child.CheckPrivileges(ctx, opChecker)
+	return p.Child == nil || p.Child.CheckPrivileges(ctx, opChecker)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -82,16 +124,21 @@ func (*PrepareQuery) CollationCoercibility(ctx *sql.Context) (collation sql.Coll
 }
 
 func (p *PrepareQuery) String() string {
+	if p.Child == nil {
+		return fmt.Sprintf("Prepare(%s)", p.Query.String())
+	}
 	return fmt.Sprintf("Prepare(%s)", p.Child.String())
 }
 
-// ExecuteQuery is a node that prepares the query
+// ExecuteQuery is a node that executes a prepared statement. Top-level EXECUTE statements are replaced with the
+// statement prepared by the engine before they're analyzed, so ExecuteQuery nodes are only run in stored procedures.
 type ExecuteQuery struct {
 	Name     string
 	BindVars []sql.Expression
 }
 
 var _ sql.Node = (*ExecuteQuery)(nil)
+var _ sql.Expressioner = (*ExecuteQuery)(nil)
 var _ sql.CollationCoercible = (*ExecuteQuery)(nil)
 
 // NewExecuteQuery executes a prepared statement
@@ -99,33 +146,67 @@ func NewExecuteQuery(name string, bindVars ...sql.Expression) *ExecuteQuery {
 	return &ExecuteQuery{Name: name, BindVars: bindVars}
 }
 
-// Schema implements the Node interface.
+// Schema implements the Node interface. The schema of the statement executed is only known once it's run, so it's
+// returned by the BlockRowIter of the statement.
 func (p *ExecuteQuery) Schema() sql.Schema {
-	panic("ExecuteQuery methods shouldn't be used")
+	return nil
 }
 
 // RowIter implements the Node interface.
 func (p *ExecuteQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	panic("ExecuteQuery methods shouldn't be used")
+	statements, err := sql.MustGetService(ctx, sql.PreparedStatementsService)
+	if err != nil {
+		return nil, err
+	}
+	// The values of the variables are bound when the statement is executed
+	bindVars := make([]sql.Expression, len(p.BindVars))
+	for i, bindVar := range p.BindVars {
+		val, err := bindVar.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		bindVars[i] = expression.NewLiteral(val, bindVar.Type())
+	}
+	node, iter, err := statements.Execute(ctx, p.Name, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &executeIter{RowIter: iter, node: node}, nil
 }
 
 func (p *ExecuteQuery) Resolved() bool {
-	panic("ExecuteQuery methods shouldn't be used")
+	return expression.ExpressionsResolved(p.BindVars...)
 }
 
 // Children implements the Node interface.
 func (p *ExecuteQuery) Children() []sql.Node {
-	panic("ExecuteQuery methods shouldn't be used")
+	return nil
 }
 
 // WithChildren implements the Node interface.
 func (p *ExecuteQuery) WithChildren(children ...sql.Node) (sql.Node, error) {
-	panic("ExecuteQuery methods shouldn't be used")
+	return NillaryWithChildren(p, children...)
 }
 
-// CheckPrivileges implements the interface sql.Node.
+// Expressions implements the sql.Expressioner interface.
+func (p *ExecuteQuery) Expressions() []sql.Expression {
+	return p.BindVars
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (p *ExecuteQuery) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(p.BindVars) {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(exprs), len(p.BindVars))
+	}
+	np := *p
+	np.BindVars = exprs
+	return &np, nil
+}
+
+// CheckPrivileges implements the interface sql.Node. The privileges of the statement executed are checked when it's
+// analyzed.
 func (p *ExecuteQuery) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
-	panic("ExecuteQuery methods shouldn't be used")
+	return true
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -134,10 +215,43 @@ func (*ExecuteQuery) CollationCoercibility(ctx *sql.Context) (collation sql.Coll
 }
 
 func (p *ExecuteQuery) String() string {
-	panic("ExecuteQuery methods shouldn't be used")
+	if len(p.BindVars) == 0 {
+		return fmt.Sprintf("Execute(%s)", p.Name)
+	}
+	bindVars := make([]string, len(p.BindVars))
+	for i, bindVar := range p.BindVars {
+		bindVars[i] = bindVar.String()
+	}
+	return fmt.Sprintf("Execute(%s, %s)", p.Name, strings.Join(bindVars, ", "))
+}
+
+// executeIter iterates over the rows of a statement executed by an EXECUTE statement, which it represents in blocks.
+type executeIter struct {
+	sql.RowIter
+	node sql.Node
+}
+
+var _ BlockRowIter = (*executeIter)(nil)
+
+// RepresentingNode implements the BlockRowIter interface.
+func (i *executeIter) RepresentingNode() sql.Node {
+	return i.node
+}
+
+// Schema implements the BlockRowIter interface.
+func (i *executeIter) Schema() sql.Schema {
+	return i.node.Schema()
+}
+
+// Close implements the sql.RowIter interface. The statement executed isn't tracked as part of the statement executing
+// it, so the caches of its subqueries are disposed of here.
+func (i *executeIter) Close(ctx *sql.Context) error {
+	err := i.RowIter.Close(ctx)
+	disposeNode(i.node)
+	return err
 }
 
-// DeallocateQuery is a node that prepares the query
+// DeallocateQuery is a node that deallocates a prepared statement
 type DeallocateQuery struct {
 	Name string
 }
@@ -157,6 +271,13 @@ func (p *DeallocateQuery) Schema() sql.Schema {
 
 // RowIter implements the Node interface.
 func (p *DeallocateQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	statements, err := sql.MustGetService(ctx, sql.PreparedStatementsService)
+	if err != nil {
+		return nil, err
+	}
+	if err := statements.Deallocate(ctx, p.Name); err != nil {
+		return nil, err
+	}
 	return sql.RowsToRowIter(sql.NewRow(types.OkResult{})), nil
 }
 
@@ -71,7 +71,8 @@ const (
 	Characteristic_ModifiesSqlData
 )
 
-// Procedure is a stored procedure that may be executed using the CALL statement.
+// Procedure is a stored procedure that may be executed using the CALL statement. It's also used for stored functions,
+// which are called in expressions, and have a ReturnType.
 type Procedure struct {
 	Name                  string
 	Definer               string
@@ -84,6 +85,8 @@ type Procedure struct {
 	CreatedAt             time.Time
 	ModifiedAt            time.Time
 	ValidationError       error
+	// ReturnType is the type of the values returned by a stored function, or nil for a stored procedure.
+	ReturnType sql.Type
 }
 
 var _ sql.Node = (*Procedure)(nil)
@@ -238,6 +241,44 @@ func (p *Procedure) IsExternal() bool {
 	return false
 }
 
+// IsFunction returns whether this is a stored function rather than a stored procedure.
+func (p *Procedure) IsFunction() bool {
+	return p.ReturnType != nil
+}
+
+// HasCharacteristic returns whether the procedure was defined with the characteristic given.
+func (p *Procedure) HasCharacteristic(characteristic Characteristic) bool {
+	for _, c := range p.Characteristics {
+		if c == characteristic {
+			return true
+		}
+	}
+	return false
+}
+
+// ReturnsResultSet returns whether any of the statements of the procedure's body return rows to the client.
+func (p *Procedure) ReturnsResultSet() bool {
+	return statementsReturnResultSet(p.Body)
+}
+
+// statementsReturnResultSet returns whether the statement given, or any of the statements of the block given, return
+// rows to the client.
+func statementsReturnResultSet(n sql.Node) bool {
+	switch n := n.(type) {
+	case *DeclareCursor, *Call:
+		return false
+	case RepresentsBlock, *CaseStatement:
+		for _, child := range n.Children() {
+			if statementsReturnResultSet(child) {
+				return true
+			}
+		}
+		return false
+	default:
+		return nodeRepresentsSelect(n)
+	}
+}
+
 // String returns the original SQL representation.
 func (pst ProcedureSecurityContext) String() string {
 	switch pst {
@@ -78,13 +78,14 @@ func (p *QueryProcess) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, erro
 
 	trackedIter := newTrackedRowIter(p.Child(), iter, nil, p.Notify)
 	trackedIter.queryType = qType
+	trackedIter.loadValues = true
 	trackedIter.shouldSetFoundRows = qType == queryTypeSelect && p.shouldSetFoundRows()
 
 	return trackedIter, nil
 }
 
 func (p *QueryProcess) RowIter2(ctx *sql.Context, f *sql.RowFrame) (sql.RowIter2, error) {
-	iter, err := p.Child().(sql.Node2).RowIter2(ctx, f)
+	iter, err := sql.NewRowIter2(ctx, p.Child(), f)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +94,7 @@ func (p *QueryProcess) RowIter2(ctx *sql.Context, f *sql.RowFrame) (sql.RowIter2
 
 	trackedIter := newTrackedRowIter(p.Child(), iter, nil, p.Notify)
 	trackedIter.queryType = qType
+	trackedIter.loadValues = true
 	trackedIter.shouldSetFoundRows = qType == queryTypeSelect && p.shouldSetFoundRows()
 
 	return trackedIter, nil
@@ -341,6 +343,7 @@ type trackedRowIter struct {
 	numRows            int64
 	queryType          queryType
 	shouldSetFoundRows bool
+	loadValues         bool
 	onDone             NotifyFunc
 	onNext             NotifyFunc
 }
@@ -388,6 +391,13 @@ func (i *trackedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Values of tables that haven't been loaded yet are loaded to return them to the client
+	if i.loadValues {
+		row, err = sql.LoadRow(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	i.numRows++
 
@@ -527,11 +537,12 @@ func IsDDLNode(node sql.Node) bool {
 	switch node.(type) {
 	case *CreateTable, *DropTable, *Truncate,
 		*AddColumn, *ModifyColumn, *DropColumn,
-		*CreateDB, *DropDB, *AlterDB,
+		*CreateDB, *DropDB, *AlterDB, *RenameDB,
 		*RenameTable, *RenameColumn,
 		*CreateView, *DropView,
+		*CreateMaterializedView, *RefreshMaterializedView, *DropMaterializedView,
 		*CreateIndex, *AlterIndex, *DropIndex,
-		*CreateProcedure, *DropProcedure,
+		*CreateProcedure, *DropProcedure, *DropFunction,
 		*CreateForeignKey, *DropForeignKey,
 		*CreateCheck, *DropCheck,
 		*CreateTrigger, *DropTrigger, *AlterPK,
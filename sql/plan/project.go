@@ -35,6 +35,7 @@ type Project struct {
 
 var _ sql.Expressioner = (*Project)(nil)
 var _ sql.Node = (*Project)(nil)
+var _ sql.Node2 = (*Project)(nil)
 var _ sql.Projector = (*Project)(nil)
 var _ sql.CollationCoercible = (*Project)(nil)
 
@@ -72,6 +73,8 @@ func (p *Project) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		span.End()
 		return nil, err
 	}
+	// Projections only read the row of the child, so it can be reused
+	sql.ReuseRows(i)
 
 	return sql.NewSpanIter(span, &projectIter{
 		p:         p.Projections,
@@ -79,6 +82,25 @@ func (p *Project) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	}), nil
 }
 
+// RowIter2 implements the sql.Node2 interface.
+func (p *Project) RowIter2(ctx *sql.Context, f *sql.RowFrame) (sql.RowIter2, error) {
+	span, ctx := ctx.Span("plan.Project", trace.WithAttributes(
+		attribute.Int("projections", len(p.Projections)),
+	))
+
+	i, err := sql.NewRowIter2(ctx, p.Child, f)
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+
+	return sql.NewSpanIter(span, &projectIter{
+		p:          p.Projections,
+		childIter:  i,
+		childIter2: i,
+	}).(sql.RowIter2), nil
+}
+
 func (p *Project) String() string {
 	pr := sql.NewTreePrinter()
 	_ = pr.WriteNode("Project")
@@ -149,38 +171,100 @@ func (p *Project) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 }
 
 type projectIter struct {
-	p         []sql.Expression
-	childIter sql.RowIter
+	p          []sql.Expression
+	childIter  sql.RowIter
+	childIter2 sql.RowIter2
+	// reuse is whether the row returned by the last call to Next can be overwritten by the next one
+	reuse bool
+	row   sql.Row
+	// childFrame is the frame the rows of the child are read into by Next2
+	childFrame *sql.RowFrame
 }
 
+var _ sql.RowReuser = (*projectIter)(nil)
+var _ sql.RowIter2 = (*projectIter)(nil)
+
 func (i *projectIter) Next(ctx *sql.Context) (sql.Row, error) {
 	childRow, err := i.childIter.Next(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return ProjectRow(ctx, i.p, childRow)
+	if !i.reuse {
+		return ProjectRow(ctx, i.p, childRow)
+	}
+	i.row, err = projectRowInto(ctx, i.p, childRow, i.row[:0])
+	return i.row, err
+}
+
+// ReuseRows implements the sql.RowReuser interface.
+func (i *projectIter) ReuseRows() {
+	i.reuse = true
+}
+
+// Next2 implements the sql.RowIter2 interface.
+func (i *projectIter) Next2(ctx *sql.Context, frame *sql.RowFrame) error {
+	if i.childFrame == nil {
+		i.childFrame = sql.NewRowFrame()
+	}
+	i.childFrame.Clear()
+	if err := i.childIter2.Next2(ctx, i.childFrame); err != nil {
+		return err
+	}
+
+	row := i.childFrame.Row2()
+	for _, expr := range i.p {
+		v, err := expr.(sql.Expression2).Eval2(ctx, row)
+		if err != nil {
+			return err
+		}
+		frame.Append(v)
+	}
+	return nil
 }
 
 func (i *projectIter) Close(ctx *sql.Context) error {
+	if i.childFrame != nil {
+		i.childFrame.Recycle()
+		i.childFrame = nil
+	}
 	return i.childIter.Close(ctx)
 }
 
+// isExpressionDefault returns whether the expression given is a default value that's an expression, which may be
+// wrapped.
+func isExpressionDefault(expr sql.Expression) bool {
+	if wrapper, ok := expr.(*expression.Wrapper); ok {
+		expr = wrapper.Unwrap()
+	}
+	defaultVal, ok := expr.(*sql.ColumnDefaultValue)
+	return ok && !defaultVal.IsLiteral()
+}
+
 // ProjectRow evaluates a set of projections.
 func ProjectRow(
 	ctx *sql.Context,
 	projections []sql.Expression,
 	row sql.Row,
+) (sql.Row, error) {
+	return projectRowInto(ctx, projections, row, make(sql.Row, 0, len(projections)))
+}
+
+// projectRowInto evaluates a set of projections, and appends their values to the empty row given.
+func projectRowInto(
+	ctx *sql.Context,
+	projections []sql.Expression,
+	row sql.Row,
+	fields sql.Row,
 ) (sql.Row, error) {
 	var err error
 	var secondPass []int
-	var fields sql.Row
 	for i, expr := range projections {
 		// Default values that are expressions may reference other fields, thus they must evaluate after all other exprs.
 		// Also default expressions may not refer to other columns that come after them if they also have a default expr.
 		// This ensures that all columns referenced by expressions will have already been evaluated.
 		// Since literals do not reference other columns, they're evaluated on the first pass.
-		if defaultVal, ok := expr.(*sql.ColumnDefaultValue); ok && !defaultVal.IsLiteral() {
+		if isExpressionDefault(expr) {
 			fields = append(fields, nil)
 			secondPass = append(secondPass, i)
 			continue
@@ -197,5 +281,5 @@ func ProjectRow(
 			return nil, err
 		}
 	}
-	return sql.NewRow(fields...), nil
+	return fields, nil
 }
@@ -74,6 +74,45 @@ func TestProject(t *testing.T) {
 	require.Equal(schema.Schema, p.Schema())
 }
 
+func TestProjectReuseRows(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	childSchema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "col1", Type: types.Text, Nullable: true},
+		{Name: "col2", Type: types.Text, Nullable: true},
+	})
+	child := memory.NewTable("test", childSchema, nil)
+	child.Insert(ctx, sql.NewRow("col1_1", "col2_1"))
+	child.Insert(ctx, sql.NewRow("col1_2", "col2_2"))
+	p := NewProject(
+		[]sql.Expression{expression.NewGetField(1, types.Text, "col2", true)},
+		NewResolvedTable(child, nil, nil),
+	)
+
+	iter, err := p.RowIter(ctx, nil)
+	require.NoError(err)
+	first, err := iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(sql.NewRow("col2_1"), first)
+	second, err := iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(sql.NewRow("col2_2"), second)
+	require.Equal(sql.NewRow("col2_1"), first)
+	require.NoError(iter.Close(ctx))
+
+	iter, err = p.RowIter(ctx, nil)
+	require.NoError(err)
+	sql.ReuseRows(iter)
+	first, err = iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(sql.NewRow("col2_1"), first)
+	second, err = iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(sql.NewRow("col2_2"), second)
+	require.Equal(&first[0], &second[0])
+	require.NoError(iter.Close(ctx))
+}
+
 func BenchmarkProject(b *testing.B) {
 	require := require.New(b)
 	ctx := sql.NewEmptyContext()
@@ -102,3 +141,31 @@ func BenchmarkProject(b *testing.B) {
 		}
 	}
 }
+
+func TestProjectRowIter2(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	child := memory.NewTable("test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: types.Int32, Nullable: true},
+		{Name: "b", Type: types.Float64, Nullable: true},
+	}), nil)
+	require.NoError(child.Insert(ctx, sql.NewRow(int32(1), 1.5)))
+	require.NoError(child.Insert(ctx, sql.NewRow(int32(2), nil)))
+
+	a := expression.NewGetField(0, types.Int32, "a", true)
+	b := expression.NewGetField(1, types.Float64, "b", true)
+	p := NewProject([]sql.Expression{
+		b,
+		expression.NewAlias("x", a),
+		expression.NewAlias("y", expression.NewLessThan(a, b)),
+	}, NewResolvedTable(child, nil, nil))
+
+	iter, err := p.RowIter2(ctx, nil)
+	require.NoError(err)
+	rows, err := sql.RowIter2ToRows(ctx, p.Schema(), iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{1.5, int32(1), int8(1)},
+		{nil, int32(2), nil},
+	}, rows)
+}
@@ -280,6 +280,10 @@ func (r *recursiveCteIter) Next(ctx *sql.Context) (sql.Row, error) {
 
 		var key uint64
 		if r.deduplicate {
+			row, err = sql.LoadRow(ctx, row)
+			if err != nil {
+				return nil, err
+			}
 			key, _ = sql.HashOf(row)
 			if k, _ := r.cache.Get(key); k != nil {
 				// skip duplicate
@@ -83,6 +83,7 @@ func (t *ResolvedTable) String() string {
 			children = append(children, fmt.Sprintf("filters: %v", filters))
 		}
 	}
+	children = append(children, sortAndLimitStrings(table)...)
 
 	pr.WriteChildren(children...)
 	return pr.String()
@@ -118,11 +119,33 @@ func (t *ResolvedTable) DebugString() string {
 			children = append(children, fmt.Sprintf("filters: %v", filters))
 		}
 	}
+	children = append(children, sortAndLimitStrings(table)...)
 
 	pr.WriteChildren(children...)
 	return pr.String()
 }
 
+// sortAndLimitStrings returns the descriptions of the sort fields and limit pushed down to the table given, if any.
+func sortAndLimitStrings(table sql.Table) []string {
+	var children []string
+	if st, ok := table.(sql.SortedTable); ok && len(st.SortFields()) > 0 {
+		sortFields := make([]string, len(st.SortFields()))
+		for i, sf := range st.SortFields() {
+			sortFields[i] = sf.String()
+		}
+		children = append(children, fmt.Sprintf("sort: [%s]", strings.Join(sortFields, ", ")))
+	}
+	if lt, ok := table.(sql.LimitedTable); ok {
+		if limit, offset, ok := lt.Limit(); ok {
+			children = append(children, fmt.Sprintf("limit: %d", limit))
+			if offset > 0 {
+				children = append(children, fmt.Sprintf("offset: %d", offset))
+			}
+		}
+	}
+	return children
+}
+
 // Children implements the Node interface.
 func (*ResolvedTable) Children() []sql.Node { return nil }
 
@@ -136,7 +159,25 @@ func (t *ResolvedTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, err
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, sql.NewTableRowIter(ctx, t.Table, partitions)), nil
+	var iter sql.RowIter = sql.NewTableRowIter(ctx, t.Table, partitions)
+	if readAhead := partitionReadAhead(ctx); readAhead > 0 {
+		iter = sql.NewPrefetchRowIter(ctx, iter, readAhead)
+	}
+	return sql.NewSpanIter(span, iter), nil
+}
+
+// partitionReadAhead returns the number of row batches to read ahead of a table's rows, given by the
+// partition_read_ahead system variable.
+func partitionReadAhead(ctx *sql.Context) int {
+	v, err := ctx.GetSessionVariable(ctx, "partition_read_ahead")
+	if err != nil {
+		return 0
+	}
+	readAhead, ok := v.(int64)
+	if !ok {
+		return 0
+	}
+	return int(readAhead)
 }
 
 func (t *ResolvedTable) RowIter2(ctx *sql.Context, f *sql.RowFrame) (sql.RowIter2, error) {
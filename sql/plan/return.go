@@ -0,0 +1,116 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Return represents the RETURN statement of a stored function, which ends the function and returns the value of its
+// expression.
+type Return struct {
+	Expr sql.Expression
+}
+
+var _ sql.Node = (*Return)(nil)
+var _ sql.Expressioner = (*Return)(nil)
+var _ sql.CollationCoercible = (*Return)(nil)
+
+// NewReturn returns a new *Return node.
+func NewReturn(expr sql.Expression) *Return {
+	return &Return{
+		Expr: expr,
+	}
+}
+
+// Resolved implements the interface sql.Node.
+func (r *Return) Resolved() bool {
+	return r.Expr.Resolved()
+}
+
+// String implements the interface sql.Node.
+func (r *Return) String() string {
+	return fmt.Sprintf("RETURN %s", r.Expr.String())
+}
+
+// DebugString implements the interface sql.DebugStringer.
+func (r *Return) DebugString() string {
+	return fmt.Sprintf("RETURN %s", sql.DebugString(r.Expr))
+}
+
+// Schema implements the interface sql.Node.
+func (r *Return) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the interface sql.Node.
+func (r *Return) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (r *Return) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(r, children...)
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (r *Return) Expressions() []sql.Expression {
+	return []sql.Expression{r.Expr}
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (r *Return) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(exprs), 1)
+	}
+	nr := *r
+	nr.Expr = exprs[0]
+	return &nr, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (r *Return) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*Return) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the interface sql.Node. The value returned is carried to the stored function being evaluated by
+// the error returned, through the blocks of the function's body.
+func (r *Return) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	val, err := r.Expr.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return nil, returnError{Value: val}
+}
+
+// returnError is an error used to return a value from a stored function.
+type returnError struct {
+	Value interface{}
+}
+
+var _ error = returnError{}
+
+// Error implements the interface error. As long as the analysis step is implemented correctly, this should never be
+// seen, as RETURN is only allowed in stored functions.
+func (r returnError) Error() string {
+	return sql.ErrReturnOutsideFunction.New().Error()
+}
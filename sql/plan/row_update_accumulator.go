@@ -95,7 +95,8 @@ func (r RowUpdateAccumulator) DebugString() string {
 
 type accumulatorRowHandler interface {
 	handleRowUpdate(row sql.Row) error
-	okResult() types.OkResult
+	// okResult returns the result of the statement, which raised the number of warnings given
+	okResult(warnings int) types.OkResult
 }
 
 // TODO: Extend this to UPDATE IGNORE JOIN
@@ -104,8 +105,65 @@ type updateIgnoreAccumulatorRowHandler interface {
 	handleRowUpdateWithIgnore(row sql.Row, ignore bool) error
 }
 
+// insertInfoType is the kind of info string in the result of an insert. Like MySQL, an insert of a single row of values
+// reports none.
+type insertInfoType int
+
+const (
+	noInsertInfo insertInfoType = iota
+	valuesInsertInfo
+	loadDataInsertInfo
+)
+
+// getInsertInfoType returns the kind of info string reported by the insert in the node given.
+func getInsertInfoType(n sql.Node) insertInfoType {
+	infoType := noInsertInfo
+	found := false
+	transform.Inspect(n, func(node sql.Node) bool {
+		if found {
+			return false
+		}
+		ii, ok := node.(*InsertInto)
+		if !ok {
+			return true
+		}
+		found = true
+		infoType = valuesInsertInfo
+		transform.Inspect(ii.Source, func(node sql.Node) bool {
+			switch node := node.(type) {
+			case *Values:
+				if len(node.ExpressionTuples) == 1 {
+					infoType = noInsertInfo
+				}
+				return false
+			case *LoadData:
+				infoType = loadDataInsertInfo
+				return false
+			}
+			return true
+		})
+		return false
+	})
+	return infoType
+}
+
+// info returns the info string of an insert that processed |records| rows, |duplicates| of which were either skipped or
+// replaced existing rows.
+func (t insertInfoType) info(records, duplicates, warnings int) fmt.Stringer {
+	switch t {
+	case valuesInsertInfo:
+		return InsertInfo{Records: records, Duplicates: duplicates, Warnings: warnings}
+	case loadDataInsertInfo:
+		return LoadDataInfo{Records: records, Skipped: duplicates, Warnings: warnings}
+	default:
+		return nil
+	}
+}
+
 type insertRowHandler struct {
 	rowsAffected int
+	rowsSkipped  int
+	infoType     insertInfoType
 }
 
 func (i *insertRowHandler) handleRowUpdate(_ sql.Row) error {
@@ -113,17 +171,31 @@ func (i *insertRowHandler) handleRowUpdate(_ sql.Row) error {
 	return nil
 }
 
-func (i *insertRowHandler) okResult() types.OkResult {
-	// TODO: the auto inserted id should be in this result. Needs to be passed up by the insert iter, which is a larger
-	//  change.
-	return types.NewOkResult(i.rowsAffected)
+func (i *insertRowHandler) handleRowUpdateWithIgnore(row sql.Row, ignore bool) error {
+	if !ignore {
+		return i.handleRowUpdate(row)
+	}
+
+	i.rowsSkipped++
+	return nil
+}
+
+func (i *insertRowHandler) okResult(warnings int) types.OkResult {
+	return types.OkResult{
+		RowsAffected: uint64(i.rowsAffected),
+		Info:         i.infoType.info(i.rowsAffected+i.rowsSkipped, i.rowsSkipped, warnings),
+	}
 }
 
 type replaceRowHandler struct {
 	rowsAffected int
+	rowsReplaced int
+	records      int
+	infoType     insertInfoType
 }
 
 func (r *replaceRowHandler) handleRowUpdate(row sql.Row) error {
+	r.records++
 	r.rowsAffected++
 
 	// If a row was deleted as well as inserted, increment the counter again. A row was deleted if at least one column in
@@ -131,6 +203,7 @@ func (r *replaceRowHandler) handleRowUpdate(row sql.Row) error {
 	for i := 0; i < len(row)/2; i++ {
 		if row[i] != nil {
 			r.rowsAffected++
+			r.rowsReplaced++
 			break
 		}
 	}
@@ -138,17 +211,26 @@ func (r *replaceRowHandler) handleRowUpdate(row sql.Row) error {
 	return nil
 }
 
-func (r *replaceRowHandler) okResult() types.OkResult {
-	return types.NewOkResult(r.rowsAffected)
+func (r *replaceRowHandler) okResult(warnings int) types.OkResult {
+	return types.OkResult{
+		RowsAffected: uint64(r.rowsAffected),
+		Info:         r.infoType.info(r.records, r.rowsReplaced, warnings),
+	}
 }
 
 type onDuplicateUpdateHandler struct {
 	rowsAffected              int
+	rowsUpdated               int
+	rowsSkipped               int
+	records                   int
 	schema                    sql.Schema
 	clientFoundRowsCapability bool
+	infoType                  insertInfoType
 }
 
 func (o *onDuplicateUpdateHandler) handleRowUpdate(row sql.Row) error {
+	o.records++
+
 	// See https://dev.mysql.com/doc/refman/8.0/en/insert-on-duplicate.html for row count semantics
 	// If a row was inserted, increment by 1
 	if len(row) == len(o.schema) {
@@ -164,19 +246,40 @@ func (o *onDuplicateUpdateHandler) handleRowUpdate(row sql.Row) error {
 			// Ig the CLIENT_FOUND_ROWS capabilities flag is set, increment by 1 if a row stays the same.
 			if o.clientFoundRowsCapability {
 				o.rowsAffected++
+				o.rowsUpdated++
 			}
 		} else {
 			o.rowsAffected += 2
+			o.rowsUpdated++
 		}
 	} else {
 		o.rowsAffected++
+		o.rowsUpdated++
 	}
 
 	return nil
 }
 
-func (o *onDuplicateUpdateHandler) okResult() types.OkResult {
-	return types.NewOkResult(o.rowsAffected)
+func (o *onDuplicateUpdateHandler) handleRowUpdateWithIgnore(row sql.Row, ignore bool) error {
+	if !ignore {
+		return o.handleRowUpdate(row)
+	}
+
+	o.records++
+	o.rowsSkipped++
+	return nil
+}
+
+func (o *onDuplicateUpdateHandler) okResult(warnings int) types.OkResult {
+	// Rows that were skipped under IGNORE are reported as duplicates instead of the rows that were updated
+	duplicates := o.rowsUpdated
+	if o.rowsSkipped > 0 {
+		duplicates = o.rowsSkipped
+	}
+	return types.OkResult{
+		RowsAffected: uint64(o.rowsAffected),
+		Info:         o.infoType.info(o.records, duplicates, warnings),
+	}
 }
 
 type updateRowHandler struct {
@@ -209,7 +312,7 @@ func (u *updateRowHandler) handleRowUpdateWithIgnore(row sql.Row, ignore bool) e
 	return nil
 }
 
-func (u *updateRowHandler) okResult() types.OkResult {
+func (u *updateRowHandler) okResult(warnings int) types.OkResult {
 	affected := u.rowsAffected
 	if u.clientFoundRowsCapability {
 		affected = u.rowsMatched
@@ -219,7 +322,7 @@ func (u *updateRowHandler) okResult() types.OkResult {
 		Info: UpdateInfo{
 			Matched:  u.rowsMatched,
 			Updated:  u.rowsAffected,
-			Warnings: 0,
+			Warnings: warnings,
 		},
 	}
 }
@@ -230,11 +333,14 @@ func (u *updateRowHandler) RowsMatched() int64 {
 
 // updateJoinRowHandler handles row update count for all UPDATEs that use a JOIN.
 type updateJoinRowHandler struct {
-	rowsMatched  int
-	rowsAffected int
-	joinSchema   sql.Schema
-	tableMap     map[string]sql.Schema // Needs to only be the tables that can be updated.
-	updaterMap   map[string]sql.RowUpdater
+	rowsMatched               int
+	rowsAffected              int
+	joinSchema                sql.Schema
+	tableMap                  map[string]sql.Schema // Needs to only be the tables that can be updated.
+	updaterMap                map[string]sql.RowUpdater
+	clientFoundRowsCapability bool
+	// matchedRows are the hashes of the rows of each table that were matched, which may be joined to several rows
+	matchedRows map[string]map[uint64]struct{}
 }
 
 func (u *updateJoinRowHandler) handleRowUpdate(row sql.Row) error {
@@ -245,9 +351,25 @@ func (u *updateJoinRowHandler) handleRowUpdate(row sql.Row) error {
 	tableToNewRow := splitRowIntoTableRowMap(newJoinRow, u.joinSchema)
 
 	for tableName, _ := range u.updaterMap {
-		u.rowsMatched++ // TODO: This currently returns the incorrect answer
 		tableOldRow := tableToOldRow[tableName]
 		tableNewRow := tableToNewRow[tableName]
+		// A row of all nils is the missing side of an outer join, which isn't matched
+		if isNullRow(tableOldRow) {
+			continue
+		}
+		hash, err := sql.HashOf(tableOldRow)
+		if err != nil {
+			return err
+		}
+		if _, ok := u.matchedRows[tableName][hash]; ok {
+			continue
+		}
+		if u.matchedRows[tableName] == nil {
+			u.matchedRows[tableName] = make(map[uint64]struct{})
+		}
+		u.matchedRows[tableName][hash] = struct{}{}
+		u.rowsMatched++
+
 		if equals, err := tableOldRow.Equals(tableNewRow, u.tableMap[tableName]); err == nil {
 			if !equals {
 				u.rowsAffected++
@@ -259,13 +381,26 @@ func (u *updateJoinRowHandler) handleRowUpdate(row sql.Row) error {
 	return nil
 }
 
-func (u *updateJoinRowHandler) okResult() types.OkResult {
+func isNullRow(row sql.Row) bool {
+	for _, v := range row {
+		if v != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *updateJoinRowHandler) okResult(warnings int) types.OkResult {
+	affected := u.rowsAffected
+	if u.clientFoundRowsCapability {
+		affected = u.rowsMatched
+	}
 	return types.OkResult{
-		RowsAffected: uint64(u.rowsAffected),
+		RowsAffected: uint64(affected),
 		Info: UpdateInfo{
 			Matched:  u.rowsMatched,
 			Updated:  u.rowsAffected,
-			Warnings: 0,
+			Warnings: warnings,
 		},
 	}
 }
@@ -299,7 +434,7 @@ func (u *deleteRowHandler) handleRowUpdate(row sql.Row) error {
 	return nil
 }
 
-func (u *deleteRowHandler) okResult() types.OkResult {
+func (u *deleteRowHandler) okResult(int) types.OkResult {
 	return types.NewOkResult(u.rowsAffected)
 }
 
@@ -307,6 +442,8 @@ type accumulatorIter struct {
 	iter             sql.RowIter
 	once             sync.Once
 	updateRowHandler accumulatorRowHandler
+	// warningCount is the number of session warnings before the statement ran
+	warningCount uint16
 }
 
 func (a *accumulatorIter) Next(ctx *sql.Context) (r sql.Row, err error) {
@@ -343,7 +480,11 @@ func (a *accumulatorIter) Next(ctx *sql.Context) (r sql.Row, err error) {
 		default:
 		}
 		if err == io.EOF {
-			res := a.updateRowHandler.okResult() // TODO: Should add warnings here
+			warnings := ctx.WarningCount()
+			if warnings >= a.warningCount {
+				warnings -= a.warningCount
+			}
+			res := a.updateRowHandler.okResult(int(warnings))
 
 			// TODO: The information flow here is pretty gnarly. We
 			// set some session variables based on the result, and
@@ -404,11 +545,11 @@ func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIte
 	var rowHandler accumulatorRowHandler
 	switch r.RowUpdateType {
 	case UpdateTypeInsert:
-		rowHandler = &insertRowHandler{}
+		rowHandler = &insertRowHandler{infoType: getInsertInfoType(r.Child())}
 	case UpdateTypeReplace:
-		rowHandler = &replaceRowHandler{}
+		rowHandler = &replaceRowHandler{infoType: getInsertInfoType(r.Child())}
 	case UpdateTypeDuplicateKeyUpdate:
-		rowHandler = &onDuplicateUpdateHandler{schema: r.Child().Schema(), clientFoundRowsCapability: clientFoundRowsToggled}
+		rowHandler = &onDuplicateUpdateHandler{schema: r.Child().Schema(), clientFoundRowsCapability: clientFoundRowsToggled, infoType: getInsertInfoType(r.Child())}
 	case UpdateTypeUpdate:
 		schema := r.Child().Schema()
 		// the schema of the update node is a self-concatenation of the underlying table's, so split it in half for new /
@@ -436,7 +577,7 @@ func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIte
 			return nil, fmt.Errorf("error: No JoinNode found in query plan to go along with an UpdateTypeJoinUpdate")
 		}
 
-		rowHandler = &updateJoinRowHandler{joinSchema: schema, tableMap: recreateTableSchemaFromJoinSchema(schema), updaterMap: updaterMap}
+		rowHandler = &updateJoinRowHandler{joinSchema: schema, tableMap: recreateTableSchemaFromJoinSchema(schema), updaterMap: updaterMap, clientFoundRowsCapability: clientFoundRowsToggled, matchedRows: make(map[string]map[uint64]struct{})}
 	default:
 		panic(fmt.Sprintf("Unrecognized RowUpdateType %d", r.RowUpdateType))
 	}
@@ -444,5 +585,6 @@ func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIte
 	return &accumulatorIter{
 		iter:             rowIter,
 		updateRowHandler: rowHandler,
+		warningCount:     ctx.WarningCount(),
 	}, nil
 }
@@ -149,11 +149,34 @@ func setUserVar(ctx *sql.Context, userVar *expression.UserVar, right sql.Express
 	return nil
 }
 
+// mergeOptimizerSwitch returns the value of optimizer_switch in the scope of the variable given after it's set to the
+// value given, since the flags that aren't set keep their current value.
+func mergeOptimizerSwitch(ctx *sql.Context, sysVar *expression.SystemVar, val interface{}) (interface{}, error) {
+	var current interface{}
+	if sysVar.Scope == sql.SystemVariableScope_Session {
+		var err error
+		current, err = ctx.GetSessionVariable(ctx, sysVar.Name)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		_, current, _ = sql.SystemVariables.GetGlobal(sysVar.Name)
+	}
+	currentStr, _ := current.(string)
+	return sql.MergeOptimizerSwitch(currentStr, val)
+}
+
 func setSystemVar(ctx *sql.Context, sysVar *expression.SystemVar, right sql.Expression, row sql.Row) error {
 	val, err := right.Eval(ctx, row)
 	if err != nil {
 		return err
 	}
+	if strings.EqualFold(sysVar.Name, sql.OptimizerSwitchVariable) {
+		val, err = mergeOptimizerSwitch(ctx, sysVar, val)
+		if err != nil {
+			return err
+		}
+	}
 	switch sysVar.Scope {
 	case sql.SystemVariableScope_Global:
 		err = sql.SystemVariables.SetGlobal(sysVar.Name, val)
@@ -68,11 +68,15 @@ func (s *ShowCreateDatabase) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter
 	buf.WriteRune('`')
 	buf.WriteString(name)
 	buf.WriteRune('`')
+	collation := GetDatabaseCollation(ctx, s.db)
 	buf.WriteString(fmt.Sprintf(
 		" /*!40100 DEFAULT CHARACTER SET %s COLLATE %s */",
-		sql.Collation_Default.CharacterSet().String(),
-		sql.Collation_Default.String(),
+		collation.CharacterSet().String(),
+		collation.String(),
 	))
+	if GetDatabaseEncryption(ctx, s.db) {
+		buf.WriteString(fmt.Sprintf(" /*!80016 DEFAULT ENCRYPTION=%s */", encryptionOptionString(true)))
+	}
 
 	return sql.RowsToRowIter(
 		sql.NewRow(name, buf.String()),
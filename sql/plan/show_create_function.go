@@ -0,0 +1,139 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ShowCreateFunction is the SHOW CREATE FUNCTION statement, which shows the statement that created a stored function.
+type ShowCreateFunction struct {
+	db           sql.Database
+	FunctionName string
+}
+
+var _ sql.Databaser = (*ShowCreateFunction)(nil)
+var _ sql.Node = (*ShowCreateFunction)(nil)
+var _ sql.CollationCoercible = (*ShowCreateFunction)(nil)
+
+var showCreateFunctionSchema = sql.Schema{
+	&sql.Column{Name: "Function", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "sql_mode", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Create Function", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "character_set_client", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "collation_connection", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Database Collation", Type: types.LongText, Nullable: false},
+}
+
+// NewShowCreateFunction creates a new ShowCreateFunction node for SHOW CREATE FUNCTION statements.
+func NewShowCreateFunction(db sql.Database, function string) *ShowCreateFunction {
+	return &ShowCreateFunction{
+		db:           db,
+		FunctionName: strings.ToLower(function),
+	}
+}
+
+// String implements the sql.Node interface.
+func (s *ShowCreateFunction) String() string {
+	return fmt.Sprintf("SHOW CREATE FUNCTION %s", s.FunctionName)
+}
+
+// Resolved implements the sql.Node interface.
+func (s *ShowCreateFunction) Resolved() bool {
+	_, ok := s.db.(sql.UnresolvedDatabase)
+	return !ok
+}
+
+// Children implements the sql.Node interface.
+func (s *ShowCreateFunction) Children() []sql.Node {
+	return nil
+}
+
+// Schema implements the sql.Node interface.
+func (s *ShowCreateFunction) Schema() sql.Schema {
+	return showCreateFunctionSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (s *ShowCreateFunction) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	characterSetClient, err := ctx.GetSessionVariable(ctx, "character_set_client")
+	if err != nil {
+		return nil, err
+	}
+	collationConnection, err := ctx.GetSessionVariable(ctx, "collation_connection")
+	if err != nil {
+		return nil, err
+	}
+	collationServer, err := ctx.GetSessionVariable(ctx, "collation_server")
+	if err != nil {
+		return nil, err
+	}
+
+	fdb, ok := s.db.(sql.StoredFunctionDatabase)
+	if !ok {
+		return nil, sql.ErrStoredFunctionsNotSupported.New(s.db.Name())
+	}
+	function, ok, err := fdb.GetStoredFunction(ctx, s.FunctionName)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrStoredFunctionDoesNotExist.New(s.FunctionName)
+	}
+	return sql.RowsToRowIter(sql.Row{
+		function.Name,            // Function
+		"",                       // sql_mode
+		function.CreateStatement, // Create Function
+		characterSetClient,       // character_set_client
+		collationConnection,      // collation_connection
+		collationServer,          // Database Collation
+	}), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowCreateFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(s, children...)
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (s *ShowCreateFunction) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// According to: https://dev.mysql.com/doc/refman/8.0/en/show-create-function.html
+	// Must have SELECT, SHOW_ROUTINE, CREATE_ROUTINE, ALTER_ROUTINE, or EXECUTE privileges.
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_Select)) ||
+		opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(s.db.Name(), "", "", sql.PrivilegeType_CreateRoutine)) ||
+		opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(s.db.Name(), "", "", sql.PrivilegeType_AlterRoutine)) ||
+		opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(s.db.Name(), "", "", sql.PrivilegeType_Execute))
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*ShowCreateFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// Database implements the sql.Databaser interface.
+func (s *ShowCreateFunction) Database() sql.Database {
+	return s.db
+}
+
+// WithDatabase implements the sql.Databaser interface.
+func (s *ShowCreateFunction) WithDatabase(db sql.Database) (sql.Node, error) {
+	ns := *s
+	ns.db = db
+	return &ns, nil
+}
@@ -286,7 +286,11 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 			pkOrdinals = append(pkOrdinals, i)
 		}
 
-		colStmts[i] = sql.GenerateCreateTableColumnDefinition(col.Name, col.Type, col.Nullable, col.AutoIncrement, col.Default != nil, colDefault, col.Comment)
+		if col.Generated != nil {
+			colStmts[i] = sql.GenerateCreateTableGeneratedColumnDefinition(col.Name, col.Type, col.Nullable, col.Generated.String(), col.Virtual, col.Comment)
+		} else {
+			colStmts[i] = sql.GenerateCreateTableColumnDefinition(col.Name, col.Type, col.Nullable, col.AutoIncrement, col.Default != nil, colDefault, col.Comment)
+		}
 	}
 
 	for _, i := range pkOrdinals {
@@ -306,12 +310,19 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		prefixLengths := index.PrefixLengths()
 		var indexCols []string
 		for i, expr := range index.Expressions() {
-			col := GetColumnFromIndexExpr(expr, table)
-			if col != nil {
-				indexDef := sql.QuoteIdentifier(col.Name)
+			var indexDef string
+			if keyPart := GetFunctionalKeyPart(index, i); keyPart != nil {
+				indexDef = "(" + keyPart.String() + ")"
+			} else if col := GetColumnFromIndexExpr(expr, table); col != nil {
+				indexDef = sql.QuoteIdentifier(col.Name)
+			}
+			if indexDef != "" {
 				if len(prefixLengths) > i && prefixLengths[i] != 0 {
 					indexDef += fmt.Sprintf("(%v)", prefixLengths[i])
 				}
+				if di, ok := index.(sql.DescendingIndex); ok && di.Descending()[i] {
+					indexDef += " DESC"
+				}
 				indexCols = append(indexCols, indexDef)
 			}
 		}
@@ -0,0 +1,105 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// EngineStatusName is the name of the engine in SHOW ENGINE ... STATUS statements, which report the internal state of
+// the engine given by sql.EngineStatusService.
+const EngineStatusName = "GMS"
+
+// ShowEngineStatus is the SHOW ENGINE GMS STATUS statement, which reports the internal state of the engine as rows of
+// named values, for operational debugging.
+type ShowEngineStatus struct {
+	Engine string
+}
+
+var _ sql.Node = (*ShowEngineStatus)(nil)
+var _ sql.CollationCoercible = (*ShowEngineStatus)(nil)
+
+// NewShowEngineStatus returns a new ShowEngineStatus node for the engine named.
+func NewShowEngineStatus(engine string) *ShowEngineStatus {
+	return &ShowEngineStatus{Engine: engine}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *ShowEngineStatus) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Node interface.
+func (s *ShowEngineStatus) String() string {
+	return "SHOW ENGINE " + s.Engine + " STATUS"
+}
+
+// Schema implements the sql.Node interface.
+func (s *ShowEngineStatus) Schema() sql.Schema {
+	return sql.Schema{
+		{Name: "Type", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 10), Nullable: false},
+		{Name: "Name", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 512), Nullable: false},
+		{Name: "Status", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 2048), Nullable: false},
+	}
+}
+
+// Children implements the sql.Node interface.
+func (s *ShowEngineStatus) Children() []sql.Node {
+	return nil
+}
+
+// RowIter implements the sql.Node interface.
+func (s *ShowEngineStatus) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if !strings.EqualFold(s.Engine, EngineStatusName) {
+		return nil, sql.ErrUnknownStorageEngine.New(s.Engine)
+	}
+	reporter, err := sql.MustGetService(ctx, sql.EngineStatusService)
+	if err != nil {
+		return nil, err
+	}
+	status, err := reporter.EngineStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sql.Row
+	for _, entry := range status.Entries() {
+		rows = append(rows, sql.Row{EngineStatusName, entry.Name, entry.Value})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowEngineStatus) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+	return s, nil
+}
+
+// CheckPrivileges implements the sql.Node interface. Like SHOW ENGINE in MySQL, it requires the PROCESS privilege.
+func (s *ShowEngineStatus) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_Process))
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*ShowEngineStatus) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ShowIndexBuilds is the SHOW INDEX BUILDS statement, an extension of the engine which lists the indexes being built
+// online by the tables that are sql.OnlineIndexBuilders, with the progress of their builds.
+type ShowIndexBuilds struct{}
+
+var _ sql.Node = (*ShowIndexBuilds)(nil)
+var _ sql.CollationCoercible = (*ShowIndexBuilds)(nil)
+
+// NewShowIndexBuilds returns a new ShowIndexBuilds node.
+func NewShowIndexBuilds() *ShowIndexBuilds {
+	return &ShowIndexBuilds{}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *ShowIndexBuilds) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Node interface.
+func (s *ShowIndexBuilds) String() string {
+	return "SHOW INDEX BUILDS"
+}
+
+// Schema implements the sql.Node interface.
+func (s *ShowIndexBuilds) Schema() sql.Schema {
+	return sql.Schema{
+		{Name: "Id", Type: types.Uint64, Nullable: false},
+		{Name: "Db", Type: types.LongText, Nullable: false},
+		{Name: "Table", Type: types.LongText, Nullable: false},
+		{Name: "Index", Type: types.LongText, Nullable: false},
+		{Name: "Connection", Type: types.Uint32, Nullable: false},
+		{Name: "Phase", Type: types.LongText, Nullable: false},
+		{Name: "Rows_copied", Type: types.Int64, Nullable: false},
+		{Name: "Rows_total", Type: types.Int64, Nullable: true},
+		{Name: "Progress", Type: types.MustCreateDecimalType(5, 2), Nullable: true},
+		{Name: "Time", Type: types.Int64, Nullable: false},
+	}
+}
+
+// Children implements the sql.Node interface.
+func (s *ShowIndexBuilds) Children() []sql.Node {
+	return nil
+}
+
+// RowIter implements the sql.Node interface.
+func (s *ShowIndexBuilds) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	builds, ok := sql.GetService(ctx, sql.IndexBuildsService)
+	if !ok {
+		return sql.RowsToRowIter(), nil
+	}
+
+	var rows []sql.Row
+	for _, build := range builds.Builds() {
+		var total, progress interface{}
+		if build.RowsTotal >= 0 {
+			total = build.RowsTotal
+		}
+		if build.RowsTotal > 0 {
+			// The total is an estimate, which the rows copied may exceed
+			percent := decimal.NewFromInt(build.RowsCopied).Mul(decimal.NewFromInt(100)).Div(decimal.NewFromInt(build.RowsTotal))
+			progress = decimal.Min(percent, decimal.NewFromInt(100)).Round(2)
+		}
+		rows = append(rows, sql.Row{
+			build.ID,
+			build.Database,
+			build.Table,
+			build.Index,
+			build.ConnectionID,
+			build.Phase,
+			build.RowsCopied,
+			total,
+			progress,
+			int64(time.Since(build.StartedAt) / time.Second),
+		})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowIndexBuilds) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+	return s, nil
+}
+
+// CheckPrivileges implements the sql.Node interface. Like SHOW PROCESSLIST, it requires the PROCESS privilege.
+func (s *ShowIndexBuilds) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return opChecker.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation("", "", "", sql.PrivilegeType_Process))
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*ShowIndexBuilds) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
@@ -126,7 +126,9 @@ func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
 	}
 
 	nullable := ""
-	if col := GetColumnFromIndexExpr(show.expression, tbl); col != nil {
+	if keyPart := GetFunctionalKeyPart(show.index, show.exPosition); keyPart != nil {
+		expression, nullable = keyPart.String(), "YES"
+	} else if col := GetColumnFromIndexExpr(show.expression, tbl); col != nil {
 		columnName, expression = col.Name, nil
 		if col.Nullable {
 			nullable = "YES"
@@ -164,6 +166,20 @@ func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
 	), nil
 }
 
+// GetFunctionalKeyPart returns the expression of the key part at position |i| of the index given, or nil if the key part
+// is a column.
+func GetFunctionalKeyPart(index sql.Index, i int) sql.Expression {
+	fi, ok := index.(sql.FunctionalIndex)
+	if !ok {
+		return nil
+	}
+	keyParts := fi.FunctionalKeyParts()
+	if i >= len(keyParts) {
+		return nil
+	}
+	return keyParts[i]
+}
+
 // GetColumnFromIndexExpr returns column from the table given using the expression string given, in the form
 // "table.column". Returns nil if the expression doesn't represent a column.
 func GetColumnFromIndexExpr(expr string, table sql.Table) *sql.Column {
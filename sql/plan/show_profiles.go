@@ -0,0 +1,201 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ShowProfiles is the SHOW PROFILES statement, which lists the queries of the session profiled while its profiling
+// system variable was enabled, with their durations in seconds.
+// https://dev.mysql.com/doc/refman/8.0/en/show-profiles.html
+type ShowProfiles struct{}
+
+var _ sql.Node = (*ShowProfiles)(nil)
+var _ sql.CollationCoercible = (*ShowProfiles)(nil)
+
+// NewShowProfiles returns a new ShowProfiles node.
+func NewShowProfiles() *ShowProfiles {
+	return &ShowProfiles{}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *ShowProfiles) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Node interface.
+func (s *ShowProfiles) String() string {
+	return "SHOW PROFILES"
+}
+
+// Schema implements the sql.Node interface.
+func (s *ShowProfiles) Schema() sql.Schema {
+	return sql.Schema{
+		{Name: "Query_ID", Type: types.Int64, Nullable: false},
+		{Name: "Duration", Type: types.Float64, Nullable: false},
+		{Name: "Query", Type: types.LongText, Nullable: false},
+	}
+}
+
+// Children implements the sql.Node interface.
+func (s *ShowProfiles) Children() []sql.Node {
+	return nil
+}
+
+// RowIter implements the sql.Node interface.
+func (s *ShowProfiles) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	var rows []sql.Row
+	for _, profile := range sessionProfiles(ctx) {
+		rows = append(rows, sql.Row{int64(profile.ID), profile.Duration().Seconds(), profile.Query})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowProfiles) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+	return s, nil
+}
+
+// CheckPrivileges implements the sql.Node interface. Sessions only see their own profiles.
+func (s *ShowProfiles) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*ShowProfiles) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// ShowProfile is the SHOW PROFILE statement, which reports the durations in seconds of the stages of a query profiled
+// by the session, or of its latest profiled query. Only durations are recorded, so the types of information given
+// are accepted for compatibility with MySQL, but don't add any columns.
+// https://dev.mysql.com/doc/refman/8.0/en/show-profile.html
+type ShowProfile struct {
+	// Types are the types of information requested, such as CPU or BLOCK IO.
+	Types []string
+	// QueryID is the ID of the query given by SHOW PROFILES, or zero for the latest query profiled.
+	QueryID int64
+	// Limit is the maximum number of stages returned, or -1 if they're not limited.
+	Limit  int64
+	Offset int64
+}
+
+var _ sql.Node = (*ShowProfile)(nil)
+var _ sql.CollationCoercible = (*ShowProfile)(nil)
+
+// NewShowProfile returns a new ShowProfile node of the query with the ID given, or of the latest query if it's zero,
+// with no limit on the stages returned.
+func NewShowProfile(types []string, queryID int64) *ShowProfile {
+	return &ShowProfile{Types: types, QueryID: queryID, Limit: -1}
+}
+
+// Resolved implements the sql.Node interface.
+func (s *ShowProfile) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Node interface.
+func (s *ShowProfile) String() string {
+	var sb strings.Builder
+	sb.WriteString("SHOW PROFILE")
+	if len(s.Types) > 0 {
+		sb.WriteString(" ")
+		sb.WriteString(strings.Join(s.Types, ", "))
+	}
+	if s.QueryID > 0 {
+		fmt.Fprintf(&sb, " FOR QUERY %d", s.QueryID)
+	}
+	if s.Limit >= 0 {
+		fmt.Fprintf(&sb, " LIMIT %d OFFSET %d", s.Limit, s.Offset)
+	}
+	return sb.String()
+}
+
+// Schema implements the sql.Node interface.
+func (s *ShowProfile) Schema() sql.Schema {
+	return sql.Schema{
+		{Name: "Status", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 30), Nullable: false},
+		{Name: "Duration", Type: types.Float64, Nullable: false},
+	}
+}
+
+// Children implements the sql.Node interface.
+func (s *ShowProfile) Children() []sql.Node {
+	return nil
+}
+
+// RowIter implements the sql.Node interface.
+func (s *ShowProfile) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	profiles := sessionProfiles(ctx)
+	var profile *sql.QueryProfile
+	for i := range profiles {
+		if s.QueryID == 0 || int64(profiles[i].ID) == s.QueryID {
+			profile = &profiles[i]
+		}
+	}
+	if profile == nil {
+		return sql.RowsToRowIter(), nil
+	}
+
+	var rows []sql.Row
+	for i, stage := range profile.Stages {
+		if int64(i) < s.Offset {
+			continue
+		}
+		if s.Limit >= 0 && int64(len(rows)) >= s.Limit {
+			break
+		}
+		rows = append(rows, sql.Row{stage.Name, stage.Duration.Seconds()})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (s *ShowProfile) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+	return s, nil
+}
+
+// CheckPrivileges implements the sql.Node interface. Sessions only see their own profiles.
+func (s *ShowProfile) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*ShowProfile) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// sessionProfiles returns the profiles of the queries of the session of the context given, which are empty if the
+// engine doesn't keep profiles.
+func sessionProfiles(ctx *sql.Context) []sql.QueryProfile {
+	profiles, ok := sql.GetService(ctx, sql.QueryProfilesService)
+	if !ok {
+		return nil
+	}
+	return profiles.Profiles(ctx.ID())
+}
@@ -25,10 +25,13 @@ import (
 	"github.com/dolthub/vitess/go/sqltypes"
 )
 
-// ShowReplicaStatus is the plan node for the "SHOW REPLICA STATUS" statement.
+// ShowReplicaStatus is the plan node for the "SHOW REPLICA STATUS" statement, and for the "SHOW SLAVE STATUS"
+// statement, which returns the same status with the column names MySQL used before 8.0.22.
 // https://dev.mysql.com/doc/refman/8.0/en/show-replica-status.html
 type ShowReplicaStatus struct {
 	replicaController binlogreplication.BinlogReplicaController
+	// legacyNames is set for SHOW SLAVE STATUS
+	legacyNames bool
 }
 
 var _ sql.Node = (*ShowReplicaStatus)(nil)
@@ -39,6 +42,11 @@ func NewShowReplicaStatus() *ShowReplicaStatus {
 	return &ShowReplicaStatus{}
 }
 
+// NewShowSlaveStatus returns a node for the SHOW SLAVE STATUS statement.
+func NewShowSlaveStatus() *ShowReplicaStatus {
+	return &ShowReplicaStatus{legacyNames: true}
+}
+
 // WithBinlogReplicaController implements the BinlogReplicaControllerCommand interface.
 func (s *ShowReplicaStatus) WithBinlogReplicaController(controller binlogreplication.BinlogReplicaController) sql.Node {
 	nc := *s
@@ -51,11 +59,14 @@ func (s *ShowReplicaStatus) Resolved() bool {
 }
 
 func (s *ShowReplicaStatus) String() string {
+	if s.legacyNames {
+		return "SHOW SLAVE STATUS"
+	}
 	return "SHOW REPLICA STATUS"
 }
 
 func (s *ShowReplicaStatus) Schema() sql.Schema {
-	return sql.Schema{
+	sch := sql.Schema{
 		{Name: "Replica_IO_State", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: false},
 		{Name: "Source_Host", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 255), Default: nil, Nullable: false},
 		{Name: "Source_User", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: false},
@@ -112,6 +123,26 @@ func (s *ShowReplicaStatus) Schema() sql.Schema {
 		{Name: "Auto_Position", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: false},
 		{Name: "Replicate_Rewrite_DB", Type: types.MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: false},
 	}
+	if s.legacyNames {
+		for _, col := range sch {
+			if name, ok := legacyReplicaStatusColumns[col.Name]; ok {
+				col.Name = name
+			} else {
+				col.Name = legacyReplicaStatusReplacer.Replace(col.Name)
+			}
+		}
+	}
+	return sch
+}
+
+// legacyReplicaStatusReplacer renames the columns of SHOW REPLICA STATUS to the names SHOW SLAVE STATUS gives them.
+var legacyReplicaStatusReplacer = strings.NewReplacer("Replica_", "Slave_", "Source", "Master")
+
+// legacyReplicaStatusColumns are the columns of SHOW REPLICA STATUS whose SHOW SLAVE STATUS names aren't given by
+// legacyReplicaStatusReplacer.
+var legacyReplicaStatusColumns = map[string]string{
+	"Source_SSL_CRL_File": "Master_SSL_Crl",
+	"Source_SSL_CRL_Path": "Master_SSL_Crlpath",
 }
 
 func (s *ShowReplicaStatus) Children() []sql.Node {
@@ -92,17 +92,17 @@ func (s *ShowTriggers) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, erro
 			return nil, err
 		}
 		rows = append(rows, sql.Row{
-			trigger.TriggerName, // Trigger
-			triggerEvent,        // Event
-			tableName,           // Table
-			trigger.BodyString,  // Statement
-			triggerTime,         // Timing
-			trigger.CreatedAt,   // Created
-			"",                  // sql_mode
-			"",                  // Definer
-			characterSetClient,  // character_set_client
-			collationConnection, // collation_connection
-			collationServer,     // Database Collation
+			trigger.TriggerName,            // Trigger
+			triggerEvent,                   // Event
+			tableName,                      // Table
+			trigger.BodyString,             // Statement
+			triggerTime,                    // Timing
+			trigger.CreatedAt,              // Created
+			"",                             // sql_mode
+			formatDefiner(trigger.Definer), // Definer
+			characterSetClient,             // character_set_client
+			collationConnection,            // collation_connection
+			collationServer,                // Database Collation
 		})
 	}
 	return sql.RowsToRowIter(rows...), nil
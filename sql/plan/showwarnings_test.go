@@ -27,11 +27,11 @@ func TestShowWarnings(t *testing.T) {
 	require := require.New(t)
 
 	ctx := sql.NewEmptyContext()
-	ctx.Session.Warn(&sql.Warning{Level: "l1", Message: "w1", Code: 1})
-	ctx.Session.Warn(&sql.Warning{Level: "l2", Message: "w2", Code: 2})
-	ctx.Session.Warn(&sql.Warning{Level: "l4", Message: "w3", Code: 3})
+	ctx.AddWarning(&sql.Warning{Level: "l1", Message: "w1", Code: 1})
+	ctx.AddWarning(&sql.Warning{Level: "l2", Message: "w2", Code: 2})
+	ctx.AddWarning(&sql.Warning{Level: "l4", Message: "w3", Code: 3})
 
-	sw := ShowWarnings(ctx.Session.Warnings())
+	sw := ShowWarnings(ctx.Warnings())
 	require.True(sw.Resolved())
 
 	it, err := sw.RowIter(ctx, nil)
@@ -67,7 +67,7 @@ func (s *Sort) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 
 func (s *Sort) RowIter2(ctx *sql.Context, f *sql.RowFrame) (sql.RowIter2, error) {
 	span, ctx := ctx.Span("plan.Sort")
-	i, err := s.UnaryNode.Child.(sql.Node2).RowIter2(ctx, f)
+	i, err := sql.NewRowIter2(ctx, s.UnaryNode.Child, f)
 	if err != nil {
 		span.End()
 		return nil, err
@@ -204,6 +204,9 @@ func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
 	cache, dispose := ctx.Memory.NewRowsCache()
 	defer dispose()
 
+	stage := sql.StartStage(ctx, sql.StageCreatingSortIndex, sql.StageUnitRows, -1)
+	defer stage.End()
+
 	for {
 		row, err := i.childIter.Next(ctx)
 
@@ -217,6 +220,7 @@ func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
 		if err := cache.Add(row); err != nil {
 			return err
 		}
+		stage.Add(1)
 	}
 
 	rows := cache.Get()
@@ -238,6 +242,9 @@ func (i *sortIter) computeSortedRows2(ctx *sql.Context) error {
 	cache, dispose := ctx.Memory.NewRows2Cache()
 	defer dispose()
 
+	stage := sql.StartStage(ctx, sql.StageCreatingSortIndex, sql.StageUnitRows, -1)
+	defer stage.End()
+
 	f := sql.NewRowFrame()
 	defer f.Recycle()
 
@@ -254,6 +261,7 @@ func (i *sortIter) computeSortedRows2(ctx *sql.Context) error {
 		if err := cache.Add2(f.Row2Copy()); err != nil {
 			return err
 		}
+		stage.Add(1)
 	}
 
 	rows := cache.Get2()
@@ -0,0 +1,200 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// StoredFunction is a call of a stored function, defined by a CREATE FUNCTION statement, in an expression. It's in the
+// plan package instead of the expression package because it runs the statements of the function's body, like CALL
+// does for stored procedures.
+//
+// The body of a DETERMINISTIC function that doesn't read or modify data only depends on its arguments, so its results
+// are cached for each of the arguments it's called with.
+type StoredFunction struct {
+	// Function is the analyzed stored function. It's opaque to the analyzer of the statement calling it.
+	Function *Procedure
+	// Args are the arguments of the call
+	Args  []sql.Expression
+	pRef  *expression.ProcedureReference
+	state *storedFunctionState
+}
+
+// storedFunctionState is the state shared by the copies of a StoredFunction, which share a ProcedureReference.
+type storedFunctionState struct {
+	// mu serializes the evaluations of the function, which use the same ProcedureReference
+	mu      sync.Mutex
+	results map[uint64]interface{}
+}
+
+var _ sql.Expression = (*StoredFunction)(nil)
+var _ sql.NonDeterministicExpression = (*StoredFunction)(nil)
+var _ sql.CollationCoercible = (*StoredFunction)(nil)
+
+// NewStoredFunction returns a new *StoredFunction calling the function given, whose statements use the
+// *ProcedureReference given, with the arguments given.
+func NewStoredFunction(function *Procedure, pRef *expression.ProcedureReference, args ...sql.Expression) *StoredFunction {
+	return &StoredFunction{
+		Function: function,
+		Args:     args,
+		pRef:     pRef,
+		state:    &storedFunctionState{results: make(map[uint64]interface{})},
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (f *StoredFunction) Children() []sql.Expression {
+	return f.Args
+}
+
+// Resolved implements the sql.Expression interface.
+func (f *StoredFunction) Resolved() bool {
+	for _, arg := range f.Args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return f.Function.Resolved()
+}
+
+// IsNullable implements the sql.Expression interface.
+func (f *StoredFunction) IsNullable() bool {
+	return true
+}
+
+// Type implements the sql.Expression interface.
+func (f *StoredFunction) Type() sql.Type {
+	return f.Function.ReturnType
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (f *StoredFunction) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	if collated, ok := f.Function.ReturnType.(sql.TypeWithCollation); ok {
+		return collated.Collation(), 2
+	}
+	return sql.Collation_binary, 5
+}
+
+// IsNonDeterministic implements the sql.NonDeterministicExpression interface. Functions are NOT DETERMINISTIC unless
+// they're defined as DETERMINISTIC.
+func (f *StoredFunction) IsNonDeterministic() bool {
+	return !f.Function.HasCharacteristic(Characteristic_Deterministic)
+}
+
+// cachesResults returns whether the results of the function only depend on its arguments, so that they can be cached.
+func (f *StoredFunction) cachesResults() bool {
+	return !f.IsNonDeterministic() &&
+		!f.Function.HasCharacteristic(Characteristic_ReadsSqlData) &&
+		!f.Function.HasCharacteristic(Characteristic_ModifiesSqlData)
+}
+
+// String implements the sql.Expression interface.
+func (f *StoredFunction) String() string {
+	args := make([]string, len(f.Args))
+	for i, arg := range f.Args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", f.Function.Name, strings.Join(args, ", "))
+}
+
+// Eval implements the sql.Expression interface.
+func (f *StoredFunction) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	args := make(sql.Row, len(f.Args))
+	for i, arg := range f.Args {
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		args[i], err = f.Function.Params[i].Type.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f.state.mu.Lock()
+	defer f.state.mu.Unlock()
+
+	var key uint64
+	cache := f.cachesResults()
+	if cache {
+		var err error
+		if key, err = sql.HashOf(args); err != nil {
+			return nil, err
+		}
+		if result, ok := f.state.results[key]; ok {
+			return result, nil
+		}
+	}
+
+	result, err := f.run(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if cache {
+		f.state.results[key] = result
+	}
+	return result, nil
+}
+
+// run runs the statements of the function's body with the arguments given, returning the value of the RETURN
+// statement that ends it.
+func (f *StoredFunction) run(ctx *sql.Context, args sql.Row) (interface{}, error) {
+	f.pRef.Reset()
+	for i, param := range f.Function.Params {
+		if err := f.pRef.InitializeVariable(param.Name, param.Type, args[i]); err != nil {
+			return nil, err
+		}
+	}
+	f.pRef.PushScope()
+
+	iter, err := f.Function.RowIter(ctx, nil)
+	if err == nil {
+		for err == nil {
+			_, err = iter.Next(ctx)
+		}
+		if cErr := iter.Close(ctx); err == io.EOF {
+			err = cErr
+		}
+	}
+	if cErr := f.pRef.CloseAllCursors(ctx); err == nil {
+		err = cErr
+	}
+
+	ret, ok := err.(returnError)
+	if !ok {
+		if err != nil {
+			return nil, err
+		}
+		return nil, sql.ErrStoredFunctionEndedWithoutReturn.New(f.Function.Name)
+	}
+	return f.Function.ReturnType.Convert(ret.Value)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *StoredFunction) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(f.Args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), len(f.Args))
+	}
+	nf := *f
+	nf.Args = children
+	return &nf, nil
+}
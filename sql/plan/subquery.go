@@ -45,10 +45,35 @@ type Subquery struct {
 	// Dispose function for the cache, if any. This would appear to violate the rule that nodes must be comparable by
 	// reflect.DeepEquals, but it's safe in practice because the function is always nil until execution.
 	disposeFunc sql.DisposeFunc
+	// Whether the subquery returned any rows, if that's been cached
+	hasResultRowCached bool
+	hasResultRow       bool
+	// The indexes of the columns of the outer scope row that a correlated subquery references, if its results can be
+	// cached for each of their values
+	correlatedCols []int
+	// Results of a correlated subquery for the values of its correlated columns, once the subquery has been evaluated
+	correlatedCache         sql.KeyValueCache
+	correlatedDisposeFunc   sql.DisposeFunc
+	correlatedKeys          int
+	correlatedCacheDisabled bool
 	// Mutex to guard the caches
 	cacheMu sync.Mutex
 }
 
+// correlatedCacheSize is the number of distinct values of the correlated columns of a subquery that its results are
+// cached for. Once a query evaluates the subquery for more distinct values than that, caching its results is unlikely
+// to pay off, and is stopped.
+const correlatedCacheSize = 1024
+
+// correlatedResult is the result of a correlated subquery for some values of its correlated columns. Its rows are only
+// read when they're needed, since whether the subquery returns any row is found by reading a single row.
+type correlatedResult struct {
+	rows         []interface{}
+	rowsRead     bool
+	hashed       sql.KeyValueCache
+	hasResultRow bool
+}
+
 // NewSubquery returns a new subquery expression.
 func NewSubquery(node sql.Node, queryString string) *Subquery {
 	return &Subquery{Query: node, QueryString: queryString}
@@ -209,7 +234,7 @@ func (s *Subquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return s.cache[0], nil
 	}
 
-	rows, err := s.evalMultiple(ctx, row)
+	rows, err := s.evalMultipleCorrelated(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +263,7 @@ func (s *Subquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 func prependRowInPlan(row sql.Row) func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
 	return func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
 		switch n := n.(type) {
-		case sql.Table, sql.Projector, *ValueDerivedTable:
+		case sql.Table, sql.Projector, *ValueDerivedTable, *TableCount, *IndexDistinct:
 			return &prependNode{
 				UnaryNode: UnaryNode{Child: n},
 				row:       row,
@@ -413,7 +438,7 @@ func (s *Subquery) EvalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, e
 		return s.cache, nil
 	}
 
-	result, err := s.evalMultiple(ctx, row)
+	result, err := s.evalMultipleCorrelated(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -429,6 +454,106 @@ func (s *Subquery) EvalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, e
 	return result, nil
 }
 
+// evalMultipleCorrelated returns all rows returned by a subquery, from the cache of the results of a correlated
+// subquery if it has one.
+func (s *Subquery) evalMultipleCorrelated(ctx *sql.Context, row sql.Row) ([]interface{}, error) {
+	key, ok, err := s.correlatedKey(row)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return s.evalMultiple(ctx, row)
+	}
+	if r := s.getCorrelatedResult(key); r != nil && r.rowsRead {
+		return r.rows, nil
+	}
+
+	rows, err := s.evalMultiple(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	err = s.putCorrelatedResult(ctx, key, func(r *correlatedResult) {
+		r.rows, r.rowsRead, r.hasResultRow = rows, true, len(rows) > 0
+	})
+	return rows, err
+}
+
+// correlatedKey returns the key of the results of a correlated subquery for the row of the outer scope given, and
+// whether its results are cached.
+func (s *Subquery) correlatedKey(row sql.Row) (uint64, bool, error) {
+	if len(s.correlatedCols) == 0 || s.canCacheResults {
+		return 0, false, nil
+	}
+	s.cacheMu.Lock()
+	disabled := s.correlatedCacheDisabled
+	s.cacheMu.Unlock()
+	if disabled {
+		return 0, false, nil
+	}
+
+	values := make(sql.Row, len(s.correlatedCols))
+	for i, idx := range s.correlatedCols {
+		if idx >= len(row) {
+			return 0, false, nil
+		}
+		values[i] = row[idx]
+	}
+	key, err := sql.HashOf(values)
+	if err != nil {
+		return 0, false, err
+	}
+	return key, true, nil
+}
+
+// getCorrelatedResult returns the cached result of a correlated subquery for the key given, if any.
+func (s *Subquery) getCorrelatedResult(key uint64) *correlatedResult {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	if s.correlatedCache == nil {
+		return nil
+	}
+	v, err := s.correlatedCache.Get(key)
+	if err != nil {
+		return nil
+	}
+	return v.(*correlatedResult)
+}
+
+// putCorrelatedResult updates the cached result of a correlated subquery for the key given with |update|. Caching is
+// stopped once results have been cached for more than correlatedCacheSize keys.
+func (s *Subquery) putCorrelatedResult(ctx *sql.Context, key uint64, update func(r *correlatedResult)) error {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+	if s.correlatedCacheDisabled {
+		return nil
+	}
+	if s.correlatedCache == nil {
+		s.correlatedCache, s.correlatedDisposeFunc = ctx.Memory.NewLRUCache(correlatedCacheSize)
+	}
+
+	// Cached results are never modified, since they're read without holding the lock
+	r := &correlatedResult{}
+	if v, err := s.correlatedCache.Get(key); err == nil {
+		*r = *v.(*correlatedResult)
+	} else {
+		s.correlatedKeys++
+		if s.correlatedKeys > correlatedCacheSize {
+			s.disposeCorrelatedCache()
+			s.correlatedCacheDisabled = true
+			return nil
+		}
+	}
+	update(r)
+	return s.correlatedCache.Put(key, r)
+}
+
+func (s *Subquery) disposeCorrelatedCache() {
+	if s.correlatedDisposeFunc != nil {
+		s.correlatedDisposeFunc()
+	}
+	s.correlatedCache, s.correlatedDisposeFunc = nil, nil
+}
+
 func (s *Subquery) evalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, error) {
 	// Any source of rows, as well as any node that alters the schema of its children, needs to be wrapped so that its
 	// result rows are prepended with the scope row.
@@ -481,7 +606,17 @@ func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCach
 		return s.hashCache, nil
 	}
 
-	result, err := s.evalMultiple(ctx, row)
+	key, ok, err := s.correlatedKey(row)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if r := s.getCorrelatedResult(key); r != nil && r.hashed != nil {
+			return r.hashed, nil
+		}
+	}
+
+	result, err := s.evalMultipleCorrelated(ctx, row)
 	if err != nil {
 		return nil, err
 	}
@@ -501,20 +636,61 @@ func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCach
 	}
 
 	cache := sql.NewMapCache()
-	return cache, putAllRows(cache, result)
+	if err := putAllRows(cache, result); err != nil {
+		return nil, err
+	}
+	if ok {
+		err = s.putCorrelatedResult(ctx, key, func(r *correlatedResult) {
+			r.rows, r.rowsRead, r.hashed, r.hasResultRow = result, true, cache, len(result) > 0
+		})
+	}
+	return cache, err
 }
 
 // HasResultRow returns whether the subquery has a result set > 0.
 func (s *Subquery) HasResultRow(ctx *sql.Context, row sql.Row) (bool, error) {
 	// First check if the query was cached.
 	s.cacheMu.Lock()
-	cached := s.resultsCached
+	cached, hasResultRowCached := s.resultsCached, s.hasResultRowCached
 	s.cacheMu.Unlock()
 
 	if cached {
 		return len(s.cache) > 0, nil
 	}
+	if hasResultRowCached {
+		return s.hasResultRow, nil
+	}
 
+	key, ok, err := s.correlatedKey(row)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		if r := s.getCorrelatedResult(key); r != nil {
+			return r.hasResultRow, nil
+		}
+	}
+
+	hasResultRow, err := s.hasResultRowUncached(ctx, row)
+	if err != nil {
+		return false, err
+	}
+
+	if s.canCacheResults {
+		s.cacheMu.Lock()
+		s.hasResultRow, s.hasResultRowCached = hasResultRow, true
+		s.cacheMu.Unlock()
+	} else if ok {
+		err = s.putCorrelatedResult(ctx, key, func(r *correlatedResult) {
+			r.hasResultRow = hasResultRow
+		})
+	}
+	return hasResultRow, err
+}
+
+// hasResultRowUncached returns whether the subquery returns any row for the row of the outer scope given, by reading
+// its first row.
+func (s *Subquery) hasResultRowUncached(ctx *sql.Context, row sql.Row) (bool, error) {
 	// Any source of rows, as well as any node that alters the schema of its children, needs to be wrapped so that its
 	// result rows are prepended with the scope row.
 	q, _, err := transform.Node(s.Query, prependRowInPlan(row))
@@ -527,13 +703,15 @@ func (s *Subquery) HasResultRow(ctx *sql.Context, row sql.Row) (bool, error) {
 		return false, err
 	}
 
-	// Call the iterator once and see if it has a row. If io.EOF is received return false.
+	// Call the iterator once and see if it has a row. If io.EOF is received return false. The iterator is closed
+	// right away either way, so that the nodes of the subquery stop reading rows after the first one.
 	_, err = iter.Next(ctx)
 	if err == io.EOF {
-		return false, nil
+		return false, iter.Close(ctx)
 	}
 
 	if err != nil {
+		iter.Close(ctx)
 		return false, err
 	}
 
@@ -646,12 +824,28 @@ func (s *Subquery) CanCacheResults() bool {
 	return s.canCacheResults
 }
 
+// WithCorrelatedCache returns the subquery with its results cached for each of the values of the columns of the outer
+// scope row given, which must be the only values of the outer scope that the subquery depends on.
+func (s *Subquery) WithCorrelatedCache(cols []int) *Subquery {
+	ns := s.WithQuery(s.Query)
+	ns.correlatedCols = cols
+	return ns
+}
+
+// CorrelatedCols returns the columns of the outer scope row that the results of this subquery are cached for, if any.
+func (s *Subquery) CorrelatedCols() []int {
+	return s.correlatedCols
+}
+
 // Dispose implements sql.Disposable
 func (s *Subquery) Dispose() {
 	if s.disposeFunc != nil {
 		s.disposeFunc()
 		s.disposeFunc = nil
 	}
+	s.disposeCorrelatedCache()
+	s.correlatedKeys, s.correlatedCacheDisabled = 0, false
+	s.hasResultRow, s.hasResultRowCached = false, false
 	disposeNode(s.Query)
 }
 
@@ -83,3 +83,95 @@ func TestSubqueryMultipleRows(t *testing.T) {
 	require.NoError(err)
 	require.Equal(values, []interface{}{"one", "two", "three"})
 }
+
+// countingExpression is the expression it wraps, and counts the number of times it's evaluated.
+type countingExpression struct {
+	sql.Expression
+	evals int
+}
+
+func (c *countingExpression) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	c.evals++
+	return c.Expression.Eval(ctx, row)
+}
+
+func (c *countingExpression) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return c, nil
+}
+
+func TestSubqueryCorrelatedCache(t *testing.T) {
+	require := require.New(t)
+
+	ctx := sql.NewEmptyContext()
+	table := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "t", Source: "foo", Type: types.Text},
+	}), nil)
+	require.NoError(table.Insert(ctx, sql.Row{"one"}))
+	require.NoError(table.Insert(ctx, sql.Row{"two"}))
+
+	// select t from foo where foo.t = outer.t, whose rows are the outer row followed by the row of foo
+	counter := &countingExpression{Expression: expression.NewGetFieldWithTable(1, types.Text, "foo", "t", false)}
+	subquery := plan.NewSubquery(plan.NewProject(
+		[]sql.Expression{counter},
+		plan.NewFilter(
+			expression.NewEquals(
+				expression.NewGetFieldWithTable(0, types.Text, "outer", "t", false),
+				expression.NewGetFieldWithTable(1, types.Text, "foo", "t", false),
+			),
+			plan.NewResolvedTable(table, nil, nil),
+		),
+	), "select t from foo where foo.t = outer.t").WithCorrelatedCache([]int{0})
+
+	outerRows := []sql.Row{{"one"}, {"two"}, {"one"}, {"three"}, {"two"}, {"three"}}
+	expected := []interface{}{"one", "two", "one", nil, "two", nil}
+	for i, row := range outerRows {
+		value, err := subquery.Eval(ctx, row)
+		require.NoError(err)
+		require.Equal(expected[i], value)
+	}
+	require.Equal(2, counter.evals)
+
+	in := plan.NewInSubquery(expression.NewLiteral("two", types.Text), subquery)
+	for _, row := range outerRows {
+		_, err := in.Eval(ctx, row)
+		require.NoError(err)
+	}
+	require.Equal(2, counter.evals)
+
+	exists := plan.NewExistsSubquery(subquery)
+	for i, row := range outerRows {
+		value, err := exists.Eval(ctx, row)
+		require.NoError(err)
+		require.Equal(expected[i] != nil, value)
+	}
+	require.Equal(2, counter.evals)
+
+	subquery.Dispose()
+	_, err := subquery.Eval(ctx, outerRows[0])
+	require.NoError(err)
+	require.Equal(3, counter.evals)
+}
+
+func TestSubqueryExistsCache(t *testing.T) {
+	require := require.New(t)
+
+	ctx := sql.NewEmptyContext()
+	table := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "t", Source: "foo", Type: types.Text},
+	}), nil)
+	require.NoError(table.Insert(ctx, sql.Row{"one"}))
+
+	counter := &countingExpression{Expression: expression.NewGetFieldWithTable(1, types.Text, "foo", "t", false)}
+	subquery := plan.NewSubquery(plan.NewProject(
+		[]sql.Expression{counter},
+		plan.NewResolvedTable(table, nil, nil),
+	), "select t from foo").WithCachedResults()
+
+	exists := plan.NewExistsSubquery(subquery)
+	for _, row := range []sql.Row{{"a"}, {"b"}, {"c"}} {
+		value, err := exists.Eval(ctx, row)
+		require.NoError(err)
+		require.Equal(true, value)
+	}
+	require.Equal(1, counter.evals)
+}
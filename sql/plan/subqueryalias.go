@@ -30,9 +30,19 @@ type SubqueryAlias struct {
 	// expression and is eligible to have visibility to outer scopes of the query.
 	OuterScopeVisibility bool
 	CanCacheResults      bool
+	// ViewDatabase is the name of the database of the view this node is the definition of. It's empty for derived
+	// tables.
+	ViewDatabase string
+	// Definer is the account whose privileges are used to access the tables in the definition of a view with
+	// SQL SECURITY DEFINER. It's empty for derived tables and for views with SQL SECURITY INVOKER.
+	Definer string
+	// CommentStr is the comment of the SELECT statement that reads this derived table directly, which may contain
+	// optimizer hints.
+	CommentStr string
 }
 
 var _ sql.Node = (*SubqueryAlias)(nil)
+var _ sql.CommentedNode = (*SubqueryAlias)(nil)
 var _ sql.CollationCoercible = (*SubqueryAlias)(nil)
 
 // NewSubqueryAlias creates a new SubqueryAlias node.
@@ -96,9 +106,30 @@ func (sq *SubqueryAlias) WithChildren(children ...sql.Node) (sql.Node, error) {
 	return &nn, nil
 }
 
-// CheckPrivileges implements the interface sql.Node.
+// CheckPrivileges implements the interface sql.Node. Views require the SELECT privilege on the view itself, and when
+// defined with SQL SECURITY DEFINER, the view's definition is checked with the privileges of its definer.
 func (sq *SubqueryAlias) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
-	return sq.Child.CheckPrivileges(ctx, opChecker)
+	if sq.ViewDatabase == "" {
+		return sq.Child.CheckPrivileges(ctx, opChecker)
+	}
+
+	if !opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(sq.ViewDatabase, sq.name, "", sql.PrivilegeType_Select)) {
+		return false
+	}
+	if sq.Definer == "" {
+		return sq.Child.CheckPrivileges(ctx, opChecker)
+	}
+
+	userChecker, ok := opChecker.(sql.UserPrivilegedOperationChecker)
+	if !ok {
+		return sq.Child.CheckPrivileges(ctx, opChecker)
+	}
+	user, host := SplitDefiner(sq.Definer)
+	definerChecker, ok := userChecker.ForUser(ctx, user, host)
+	if !ok {
+		return false
+	}
+	return sq.Child.CheckPrivileges(ctx, definerChecker)
 }
 
 // CollationCoercibility implements the interface sql.CollationCoercible.
@@ -118,6 +149,18 @@ func (sq *SubqueryAlias) WithName(name string) *SubqueryAlias {
 	return &ret
 }
 
+// Comment implements sql.CommentedNode
+func (sq *SubqueryAlias) Comment() string {
+	return sq.CommentStr
+}
+
+// WithComment implements sql.CommentedNode
+func (sq *SubqueryAlias) WithComment(comment string) sql.Node {
+	ret := *sq
+	ret.CommentStr = comment
+	return &ret
+}
+
 func (sq *SubqueryAlias) WithCachedResults() *SubqueryAlias {
 	ret := *sq
 	ret.CanCacheResults = true
@@ -2,6 +2,7 @@ package plan
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
@@ -62,7 +63,12 @@ func (tc *TableCopier) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, erro
 func (tc *TableCopier) processCreateTable(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	ct := tc.destination.(*CreateTable)
 
-	_, err := ct.RowIter(ctx, row)
+	_, tableExisted, err := tc.db.GetTableInsensitive(ctx, ct.Name())
+	if err != nil {
+		return sql.RowsToRowIter(), err
+	}
+
+	_, err = ct.RowIter(ctx, row)
 	if err != nil {
 		return sql.RowsToRowIter(), err
 	}
@@ -76,17 +82,73 @@ func (tc *TableCopier) processCreateTable(ctx *sql.Context, row sql.Row) (sql.Ro
 		return sql.RowsToRowIter(), fmt.Errorf("error: Newly created table does not exist")
 	}
 
+	var iter sql.RowIter
 	if tc.createTableSelectCanBeCopied(table) {
-		return tc.copyTableOver(ctx, tc.source.Schema()[0].Source, table.Name())
+		iter, err = tc.copyTableOver(ctx, tc.source.Schema()[0].Source, table.Name())
+	} else {
+		// TODO: Improve parsing for CREATE TABLE SELECT to allow for IGNORE/REPLACE and custom specs
+		ii := NewInsertInto(tc.db, NewResolvedTable(table, tc.db, nil), tc.source, tc.options.replace, nil, nil, tc.options.ignore)
+
+		// Wrap the insert into a row update accumulator
+		roa := NewRowUpdateAccumulator(ii, UpdateTypeInsert)
+		iter, err = roa.RowIter(ctx, row)
 	}
 
-	// TODO: Improve parsing for CREATE TABLE SELECT to allow for IGNORE/REPLACE and custom specs
-	ii := NewInsertInto(tc.db, NewResolvedTable(table, tc.db, nil), tc.source, tc.options.replace, nil, nil, tc.options.ignore)
+	// A table created by this statement must not outlive a failure to populate it
+	if tableExisted {
+		return iter, err
+	}
+	if err != nil {
+		return sql.RowsToRowIter(), tc.dropCreatedTable(ctx, ct.Name(), err)
+	}
+	return &createTableSelectIter{RowIter: iter, tc: tc, tableName: ct.Name()}, nil
+}
 
-	// Wrap the insert into a row update accumulator
-	roa := NewRowUpdateAccumulator(ii, UpdateTypeInsert)
+// dropCreatedTable drops the table created by this node after its population failed with |cause|, if the database
+// supports dropping tables. Returns |cause|, or the error encountered when dropping the table.
+func (tc *TableCopier) dropCreatedTable(ctx *sql.Context, tableName string, cause error) error {
+	dropper, ok := tc.db.(sql.TableDropper)
+	if !ok {
+		return cause
+	}
+	if err := dropper.DropTable(ctx, tableName); err != nil {
+		return err
+	}
+	return cause
+}
+
+// createTableSelectIter wraps the iterator populating a table created by CREATE TABLE ... SELECT, dropping the table
+// when populating it fails.
+type createTableSelectIter struct {
+	sql.RowIter
+	tc        *TableCopier
+	tableName string
+	err       error
+}
+
+var _ sql.RowIter = (*createTableSelectIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (i *createTableSelectIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.RowIter.Next(ctx)
+	if err != nil && err != io.EOF {
+		i.err = err
+	}
+	return row, err
+}
 
-	return roa.RowIter(ctx, row)
+// Close implements the interface sql.RowIter.
+func (i *createTableSelectIter) Close(ctx *sql.Context) error {
+	err := i.RowIter.Close(ctx)
+	if err != nil && i.err == nil {
+		i.err = err
+	}
+	if i.err != nil {
+		if dropErr := i.tc.dropCreatedTable(ctx, i.tableName, i.err); dropErr != i.err {
+			return dropErr
+		}
+	}
+	return err
 }
 
 // createTableSelectCanBeCopied determines whether the newly created table's data can just be copied from the source table
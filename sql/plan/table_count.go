@@ -0,0 +1,140 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// TableCount returns the number of rows of a table that can count them without reading them, in each of its columns.
+// It replaces an aggregation of COUNT(*) over all the rows of the table, whose schema it keeps. Its child is the table
+// node whose rows are counted, a ResolvedTable or a TableAlias of one, which isn't read.
+type TableCount struct {
+	UnaryNode
+	schema sql.Schema
+}
+
+var _ sql.Node = (*TableCount)(nil)
+var _ sql.CollationCoercible = (*TableCount)(nil)
+
+// NewTableCount returns a node counting the rows of the table node given, returning the count in each column of the
+// schema given.
+func NewTableCount(table sql.Node, schema sql.Schema) *TableCount {
+	return &TableCount{UnaryNode: UnaryNode{Child: table}, schema: schema}
+}
+
+// GetRowCounter returns the table of the table node given, or the table it wraps, if it's a sql.RowCounter.
+func GetRowCounter(n sql.Node) (sql.RowCounter, bool) {
+	if ta, ok := n.(*TableAlias); ok {
+		n = ta.Child
+	}
+	rt, ok := n.(*ResolvedTable)
+	if !ok {
+		return nil, false
+	}
+	table := rt.Table
+	for {
+		if counter, ok := table.(sql.RowCounter); ok {
+			return counter, true
+		}
+		wrapper, ok := table.(sql.TableWrapper)
+		if !ok {
+			return nil, false
+		}
+		table = wrapper.Underlying()
+	}
+}
+
+// unwrapUnreadTable returns the ResolvedTable of the table node given, which is the child of a node like TableCount
+// that asks the table for information about its rows rather than reading them.
+func unwrapUnreadTable(ctx *sql.Context, table sql.Node) (sql.Node, error) {
+	// Subqueries prepend the outer scope row to the rows of their tables, which doesn't change what's asked of them
+	for {
+		if pn, ok := table.(*prependNode); ok {
+			table = pn.Child
+		} else if ta, ok := table.(*TableAlias); ok {
+			table = ta.Child
+		} else {
+			break
+		}
+	}
+	// The tables of stored procedures are resolved again every time they're read
+	if prt, ok := table.(*ProcedureResolvedTable); ok {
+		return prt.newestTable(ctx)
+	}
+	return table, nil
+}
+
+// Schema implements the sql.Node interface.
+func (t *TableCount) Schema() sql.Schema {
+	return t.schema
+}
+
+// RowIter implements the sql.Node interface.
+func (t *TableCount) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	table, err := unwrapUnreadTable(ctx, t.Child)
+	if err != nil {
+		return nil, err
+	}
+	counter, ok := GetRowCounter(table)
+	if !ok {
+		return nil, fmt.Errorf("cannot count the rows of %T", table)
+	}
+	count, err := counter.ExactRowCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(sql.Row, len(t.schema))
+	for i := range result {
+		result[i] = int64(count)
+	}
+	return sql.RowsToRowIter(result), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (t *TableCount) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 1)
+	}
+	nt := *t
+	nt.Child = children[0]
+	return &nt, nil
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (t *TableCount) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return t.Child.CheckPrivileges(ctx, opChecker)
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*TableCount) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+func (t *TableCount) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("TableCount")
+	_ = pr.WriteChildren(t.Child.String())
+	return pr.String()
+}
+
+func (t *TableCount) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("TableCount")
+	_ = pr.WriteChildren(sql.DebugString(t.Child))
+	return pr.String()
+}
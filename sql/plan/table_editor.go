@@ -94,35 +94,41 @@ func (s *tableEditorIter) Close(ctx *sql.Context) error {
 }
 
 type checkpointingTableEditorIter struct {
-	editIter sql.EditOpenerCloser
-	inner    sql.RowIter
+	editIters []sql.EditOpenerCloser
+	inner     sql.RowIter
 }
 
 var _ sql.RowIter = (*tableEditorIter)(nil)
 
 // NewCheckpointingTableEditorIter is similar to NewTableEditorIter except that
-// it returns an iter that calls BeginStatement and CompleteStatement on |table|
-// after every iter of |wrappedIter|. While SLOW, this functionality ensures
+// it returns an iter that calls BeginStatement and CompleteStatement on each of
+// |tables| after every iter of |wrappedIter|. While SLOW, this functionality ensures
 // correctness for statements that need to rollback individual statements that
 // error such as INSERT IGNORE INTO.
-func NewCheckpointingTableEditorIter(wrappedIter sql.RowIter, table sql.EditOpenerCloser) sql.RowIter {
+func NewCheckpointingTableEditorIter(wrappedIter sql.RowIter, tables ...sql.EditOpenerCloser) sql.RowIter {
 	return &checkpointingTableEditorIter{
-		editIter: table,
-		inner:    wrappedIter,
+		editIters: tables,
+		inner:     wrappedIter,
 	}
 }
 
 func (c checkpointingTableEditorIter) Next(ctx *sql.Context) (sql.Row, error) {
-	c.editIter.StatementBegin(ctx)
+	for _, editIter := range c.editIters {
+		editIter.StatementBegin(ctx)
+	}
 	row, err := c.inner.Next(ctx)
 	if err != nil && err != io.EOF {
-		if dErr := c.editIter.DiscardChanges(ctx, err); dErr != nil {
-			return nil, dErr
+		for _, editIter := range c.editIters {
+			if dErr := editIter.DiscardChanges(ctx, err); dErr != nil {
+				return nil, dErr
+			}
 		}
 		return row, err
 	}
-	if sErr := c.editIter.StatementComplete(ctx); sErr != nil {
-		return row, sErr
+	for _, editIter := range c.editIters {
+		if sErr := editIter.StatementComplete(ctx); sErr != nil {
+			return row, sErr
+		}
 	}
 	return row, err
 }
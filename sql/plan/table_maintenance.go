@@ -0,0 +1,442 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// ChecksumTable is the CHECKSUM TABLE statement, which returns a checksum of the rows of each of the tables given.
+// Tables that are sql.ChecksumTables give their own checksum, unless the EXTENDED option is given. Otherwise, the
+// checksum is the sum of the CRC32 of each row, modulo 2^32, which doesn't depend on the order of the rows. The analyzer
+// rejects the statement for tables whose rows or columns are restricted for the current user by a row policy or a
+// column mask.
+// https://dev.mysql.com/doc/refman/8.0/en/checksum-table.html
+type ChecksumTable struct {
+	Tables []sql.DbTable
+	// Option is QUICK, EXTENDED, or empty.
+	Option  string
+	Catalog sql.Catalog
+}
+
+var _ sql.Node = (*ChecksumTable)(nil)
+var _ sql.CollationCoercible = (*ChecksumTable)(nil)
+
+// NewChecksumTable returns a new ChecksumTable node of the tables given.
+func NewChecksumTable(tables []sql.DbTable, option string) *ChecksumTable {
+	return &ChecksumTable{Tables: tables, Option: option}
+}
+
+var checksumTableSchema = sql.Schema{
+	{Name: "Table", Type: types.LongText, Nullable: false},
+	{Name: "Checksum", Type: types.Int64, Nullable: true},
+}
+
+// Schema implements the sql.Node interface.
+func (n *ChecksumTable) Schema() sql.Schema {
+	return checksumTableSchema
+}
+
+// String implements the sql.Node interface.
+func (n *ChecksumTable) String() string {
+	s := "CHECKSUM TABLE " + maintenanceTableNames(n.Tables)
+	if n.Option != "" {
+		s += " " + n.Option
+	}
+	return s
+}
+
+// Resolved implements the sql.Node interface.
+func (n *ChecksumTable) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (n *ChecksumTable) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (n *ChecksumTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (n *ChecksumTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return checkMaintenancePrivileges(ctx, opChecker, n.Tables, sql.PrivilegeType_Select)
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*ChecksumTable) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the sql.Node interface.
+func (n *ChecksumTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	rows := make([]sql.Row, len(n.Tables))
+	for i, name := range n.Tables {
+		name, err := qualifyMaintenanceTable(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		table, _, err := n.Catalog.Table(ctx, name.Db, name.Table)
+		if sql.ErrTableNotFound.Is(err) || sql.ErrDatabaseNotFound.Is(err) {
+			ctx.AddWarning(&sql.Warning{
+				Level:   "Error",
+				Code:    mysql.ERNoSuchTable,
+				Message: fmt.Sprintf("Table '%s' doesn't exist", name.String()),
+			})
+			rows[i] = sql.Row{name.String(), nil}
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		checksum, err := n.checksum(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		rows[i] = sql.Row{name.String(), checksum}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// checksum returns the checksum of the table given, or nil if the QUICK option is given and the table doesn't keep a
+// live checksum.
+func (n *ChecksumTable) checksum(ctx *sql.Context, table sql.Table) (interface{}, error) {
+	if ct, ok := table.(sql.ChecksumTable); ok && n.Option != "EXTENDED" {
+		checksum, err := ct.TableChecksum(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return int64(checksum), nil
+	}
+	if n.Option == "QUICK" {
+		return nil, nil
+	}
+
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	defer iter.Close(ctx)
+
+	var checksum uint32
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if row, err = sql.LoadRow(ctx, row); err != nil {
+			return nil, err
+		}
+		checksum += rowChecksum(row)
+	}
+	return int64(checksum), nil
+}
+
+// rowChecksum returns the CRC32 of the values of the row given.
+func rowChecksum(row sql.Row) uint32 {
+	hash := crc32.NewIEEE()
+	for _, v := range row {
+		switch v := v.(type) {
+		case nil:
+			hash.Write([]byte{0xFF})
+		case []byte:
+			hash.Write(v)
+		default:
+			fmt.Fprint(hash, v)
+		}
+		hash.Write([]byte{0})
+	}
+	return hash.Sum32()
+}
+
+// CheckTable is the CHECK TABLE statement, which checks each of the tables given for errors. The engine verifies
+// that the rows of a table satisfy its constraints, and tables that are sql.CheckableTables check their own storage.
+// https://dev.mysql.com/doc/refman/8.0/en/check-table.html
+type CheckTable struct {
+	Tables []sql.DbTable
+	// Options are the options of the statement, such as QUICK or FOR UPGRADE, which are given to the tables.
+	Options []string
+	Catalog sql.Catalog
+	// Verifications are the queries verifying the constraints of each of the tables, set by the analyzer.
+	Verifications [][]TableVerification
+}
+
+// TableVerification is a query verifying a constraint of a table for CHECK TABLE, which returns a row if the
+// constraint is violated.
+type TableVerification struct {
+	Query sql.Node
+	// Message is the error reported if the constraint is violated.
+	Message string
+}
+
+var _ sql.Node = (*CheckTable)(nil)
+var _ sql.CollationCoercible = (*CheckTable)(nil)
+
+// NewCheckTable returns a new CheckTable node of the tables given.
+func NewCheckTable(tables []sql.DbTable, options []string) *CheckTable {
+	return &CheckTable{Tables: tables, Options: options}
+}
+
+// Schema implements the sql.Node interface.
+func (n *CheckTable) Schema() sql.Schema {
+	return analyzeSchema
+}
+
+// String implements the sql.Node interface.
+func (n *CheckTable) String() string {
+	s := "CHECK TABLE " + maintenanceTableNames(n.Tables)
+	if len(n.Options) > 0 {
+		s += " " + strings.Join(n.Options, " ")
+	}
+	return s
+}
+
+// Resolved implements the sql.Node interface.
+func (n *CheckTable) Resolved() bool {
+	return n.Catalog != nil
+}
+
+// Children implements the sql.Node interface.
+func (n *CheckTable) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (n *CheckTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (n *CheckTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return checkMaintenancePrivileges(ctx, opChecker, n.Tables, sql.PrivilegeType_Select)
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*CheckTable) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the sql.Node interface.
+func (n *CheckTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	var rows []sql.Row
+	for i, name := range n.Tables {
+		name, err := qualifyMaintenanceTable(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		table, _, err := n.Catalog.Table(ctx, name.Db, name.Table)
+		if sql.ErrTableNotFound.Is(err) || sql.ErrDatabaseNotFound.Is(err) {
+			rows = append(rows, tableNotFoundRows(name, "check")...)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		var messages []sql.TableMaintenanceMessage
+		if ct, ok := table.(sql.CheckableTable); ok {
+			if messages, err = ct.CheckTable(ctx, n.Options); err != nil {
+				return nil, err
+			}
+		}
+		if i < len(n.Verifications) {
+			for _, verification := range n.Verifications[i] {
+				violated, err := verification.violated(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if violated {
+					messages = append(messages, sql.TableMaintenanceMessage{Type: sql.TableMaintenanceError, Text: verification.Message})
+				}
+			}
+		}
+
+		corrupt := false
+		for _, message := range messages {
+			rows = append(rows, sql.Row{name.String(), "check", message.Type, message.Text})
+			corrupt = corrupt || message.Type == sql.TableMaintenanceError
+		}
+		if corrupt {
+			rows = append(rows, sql.Row{name.String(), "check", sql.TableMaintenanceError, "Corrupt"})
+		} else {
+			rows = append(rows, sql.Row{name.String(), "check", sql.TableMaintenanceStatus, "OK"})
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// violated returns whether the query of the verification returns a row.
+func (v TableVerification) violated(ctx *sql.Context) (bool, error) {
+	iter, err := v.Query.RowIter(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	_, err = iter.Next(ctx)
+	violated := err == nil
+	if err == io.EOF {
+		err = nil
+	}
+	if closeErr := iter.Close(ctx); err == nil {
+		err = closeErr
+	}
+	return violated, err
+}
+
+// OptimizeTable is the OPTIMIZE TABLE statement, which reorganizes the storage of each of the tables given that is an
+// sql.OptimizableTable. Other tables are left untouched, with a note that they don't support it.
+// https://dev.mysql.com/doc/refman/8.0/en/optimize-table.html
+type OptimizeTable struct {
+	Tables  []sql.DbTable
+	Catalog sql.Catalog
+}
+
+var _ sql.Node = (*OptimizeTable)(nil)
+var _ sql.CollationCoercible = (*OptimizeTable)(nil)
+
+// NewOptimizeTable returns a new OptimizeTable node of the tables given.
+func NewOptimizeTable(tables []sql.DbTable) *OptimizeTable {
+	return &OptimizeTable{Tables: tables}
+}
+
+// Schema implements the sql.Node interface.
+func (n *OptimizeTable) Schema() sql.Schema {
+	return analyzeSchema
+}
+
+// String implements the sql.Node interface.
+func (n *OptimizeTable) String() string {
+	return "OPTIMIZE TABLE " + maintenanceTableNames(n.Tables)
+}
+
+// Resolved implements the sql.Node interface.
+func (n *OptimizeTable) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (n *OptimizeTable) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (n *OptimizeTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (n *OptimizeTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return checkMaintenancePrivileges(ctx, opChecker, n.Tables, sql.PrivilegeType_Select, sql.PrivilegeType_Insert)
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (*OptimizeTable) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the sql.Node interface.
+func (n *OptimizeTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	var rows []sql.Row
+	for _, name := range n.Tables {
+		name, err := qualifyMaintenanceTable(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		table, _, err := n.Catalog.Table(ctx, name.Db, name.Table)
+		if sql.ErrTableNotFound.Is(err) || sql.ErrDatabaseNotFound.Is(err) {
+			rows = append(rows, tableNotFoundRows(name, "optimize")...)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		ot, ok := table.(sql.OptimizableTable)
+		if !ok {
+			rows = append(rows, sql.Row{name.String(), "optimize", sql.TableMaintenanceNote, "The storage engine for the table doesn't support optimize"})
+			continue
+		}
+		if err := ot.OptimizeTable(ctx); err != nil {
+			rows = append(rows,
+				sql.Row{name.String(), "optimize", sql.TableMaintenanceError, err.Error()},
+				sql.Row{name.String(), "optimize", sql.TableMaintenanceStatus, "Operation failed"})
+			continue
+		}
+		rows = append(rows, sql.Row{name.String(), "optimize", sql.TableMaintenanceStatus, "OK"})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// qualifyMaintenanceTable returns the table name given qualified with the current database if it isn't already.
+func qualifyMaintenanceTable(ctx *sql.Context, name sql.DbTable) (sql.DbTable, error) {
+	if name.Db != "" {
+		return name, nil
+	}
+	name.Db = ctx.GetCurrentDatabase()
+	if name.Db == "" {
+		return name, sql.ErrNoDatabaseSelected.New()
+	}
+	return name, nil
+}
+
+// tableNotFoundRows returns the rows reported by the table maintenance operation given for a table that doesn't exist.
+func tableNotFoundRows(name sql.DbTable, op string) []sql.Row {
+	return []sql.Row{
+		{name.String(), op, "Error", fmt.Sprintf("Table '%s' doesn't exist", name.String())},
+		{name.String(), op, sql.TableMaintenanceStatus, "Operation failed"},
+	}
+}
+
+// checkMaintenancePrivileges returns whether the user has the privileges given on each of the tables given.
+func checkMaintenancePrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker, tables []sql.DbTable, privileges ...sql.PrivilegeType) bool {
+	for _, table := range tables {
+		db := table.Db
+		if db == "" {
+			db = ctx.GetCurrentDatabase()
+		}
+		if !opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(db, table.Table, "", privileges...)) {
+			return false
+		}
+	}
+	return true
+}
+
+func maintenanceTableNames(tables []sql.DbTable) string {
+	names := make([]string, len(tables))
+	for i, table := range tables {
+		names[i] = table.String()
+	}
+	return strings.Join(names, ", ")
+}
@@ -66,8 +66,14 @@ func NewStartTransaction(transactionChar sql.TransactionCharacteristic) *StartTr
 
 // RowIter implements the sql.Node interface.
 func (s *StartTransaction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if err := sql.CommitTwoPhaseTransactions(ctx); err != nil {
+		sql.DiscardCapturedChanges(ctx)
+		return nil, err
+	}
+
 	ts, ok := ctx.Session.(sql.TransactionSession)
 	if !ok {
+		sql.PublishCapturedChanges(ctx)
 		return sql.RowsToRowIter(), nil
 	}
 
@@ -81,6 +87,7 @@ func (s *StartTransaction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter,
 			return nil, err
 		}
 	}
+	sql.PublishCapturedChanges(ctx)
 
 	transaction, err := ts.StartTransaction(ctx, s.transChar)
 	if err != nil {
@@ -123,14 +130,23 @@ func NewCommit() *Commit {
 
 // RowIter implements the sql.Node interface.
 func (c *Commit) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	// The databases that commit in two phases are committed first, so that the transaction can still be rolled back
+	// if any of them fails to prepare
+	if err := sql.CommitTwoPhaseTransactions(ctx); err != nil {
+		sql.DiscardCapturedChanges(ctx)
+		return nil, err
+	}
+
 	ts, ok := ctx.Session.(sql.TransactionSession)
 	if !ok {
+		sql.PublishCapturedChanges(ctx)
 		return sql.RowsToRowIter(), nil
 	}
 
 	transaction := ctx.GetTransaction()
 
 	if transaction == nil {
+		sql.PublishCapturedChanges(ctx)
 		return sql.RowsToRowIter(), nil
 	}
 
@@ -141,6 +157,7 @@ func (c *Commit) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
 
 	ctx.SetIgnoreAutoCommit(false)
 	ctx.SetTransaction(nil)
+	sql.PublishCapturedChanges(ctx)
 
 	return sql.RowsToRowIter(), nil
 }
@@ -172,6 +189,11 @@ func NewRollback() *Rollback {
 
 // RowIter implements the sql.Node interface.
 func (r *Rollback) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	sql.DiscardCapturedChanges(ctx)
+	if err := sql.RollbackTwoPhaseTransactions(ctx); err != nil {
+		return nil, err
+	}
+
 	ts, ok := ctx.Session.(sql.TransactionSession)
 	if !ok {
 		return sql.RowsToRowIter(), nil
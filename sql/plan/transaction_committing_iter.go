@@ -72,12 +72,12 @@ func (t *TransactionCommittingNode) RowIter(ctx *sql.Context, row sql.Row) (sql.
 
 // RowIter2 implements the sql.Node interface.
 func (t *TransactionCommittingNode) RowIter2(ctx *sql.Context, f *sql.RowFrame) (sql.RowIter2, error) {
-	iter2, err := t.Child().(sql.Node2).RowIter2(ctx, nil)
+	iter2, err := sql.NewRowIter2(ctx, t.Child(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return transactionCommittingIter{childIter: nil, childIter2: iter2}, nil
+	return transactionCommittingIter{childIter: iter2, childIter2: iter2}, nil
 }
 
 // WithChildren implements the sql.Node interface.
@@ -142,6 +142,21 @@ func (t transactionCommittingIter) Close(ctx *sql.Context) error {
 		return err
 	}
 
+	if !ctx.GetIgnoreAutoCommit() && autocommit {
+		if err := sql.CommitTwoPhaseTransactions(ctx); err != nil {
+			sql.DiscardCapturedChanges(ctx)
+			// The writes to the databases that commit in two phases have been rolled back, so the rest of the
+			// transaction must be too
+			if ts, ok := ctx.Session.(sql.TransactionSession); ok && tx != nil {
+				if rbErr := ts.Rollback(ctx, tx); rbErr != nil {
+					ctx.GetLogger().Warnf("unable to roll back transaction %s: %s", tx, rbErr.Error())
+				}
+			}
+			ctx.SetTransaction(nil)
+			return err
+		}
+	}
+
 	commitTransaction := ((tx != nil) && !ctx.GetIgnoreAutoCommit()) && autocommit
 	if commitTransaction {
 		ts, ok := ctx.Session.(sql.TransactionSession)
@@ -151,6 +166,7 @@ func (t transactionCommittingIter) Close(ctx *sql.Context) error {
 
 		ctx.GetLogger().Tracef("committing transaction %s", tx)
 		if err := ts.CommitTransaction(ctx, tx); err != nil {
+			sql.DiscardCapturedChanges(ctx)
 			return err
 		}
 
@@ -158,6 +174,10 @@ func (t transactionCommittingIter) Close(ctx *sql.Context) error {
 		ctx.SetTransaction(nil)
 	}
 
+	if !ctx.GetIgnoreAutoCommit() && autocommit {
+		sql.PublishCapturedChanges(ctx)
+	}
+
 	return nil
 }
 
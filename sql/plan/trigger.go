@@ -107,6 +107,8 @@ type triggerIter struct {
 	triggerTime    TriggerTime
 	triggerEvent   TriggerEvent
 	ctx            *sql.Context
+	// insert is the INSERT statement that the AFTER trigger runs for, if any
+	insert *InsertInto
 }
 
 // prependRowInPlanForTriggerExecution returns a transformation function that prepends the row given to any row source in a query
@@ -143,8 +145,16 @@ func (t *triggerIter) Next(ctx *sql.Context) (row sql.Row, returnErr error) {
 		return nil, err
 	}
 
+	triggerRow := childRow
+	if t.insert != nil {
+		var ok bool
+		if triggerRow, ok = t.afterInsertRow(childRow); !ok {
+			return childRow, nil
+		}
+	}
+
 	// Wrap the execution logic with the current child row before executing it.
-	logic, _, err := transform.NodeWithCtx(t.executionLogic, nil, prependRowInPlanForTriggerExecution(childRow))
+	logic, _, err := transform.NodeWithCtx(t.executionLogic, nil, prependRowInPlanForTriggerExecution(triggerRow))
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +164,7 @@ func (t *triggerIter) Next(ctx *sql.Context) (row sql.Row, returnErr error) {
 	ctx, cancelFunc := t.ctx.NewSubContext()
 	defer cancelFunc()
 
-	logicIter, err := logic.RowIter(ctx, childRow)
+	logicIter, err := logic.RowIter(ctx, triggerRow)
 	if err != nil {
 		return nil, err
 	}
@@ -180,13 +190,45 @@ func (t *triggerIter) Next(ctx *sql.Context) (row sql.Row, returnErr error) {
 
 	// For some logic statements, we want to return the result of the logic operation as our row, e.g. a Set that alters
 	// the fields of the new row
-	if ok, returnRow := shouldUseLogicResult(logic, logicRow); ok {
+	if ok, returnRow := shouldUseLogicResult(logic, logicRow); ok && t.insert == nil {
 		return returnRow, nil
 	}
 
 	return childRow, nil
 }
 
+// afterInsertRow returns the row that an AFTER trigger of an INSERT statement runs with for the row given, which the
+// statement returned, and whether the trigger runs for it. The statement returns the old values of the rows that it
+// deleted or updated followed by their new values, and the old values are nil for the rows that REPLACE inserted
+// without deleting any. INSERT triggers run with the new values of the rows that the statement inserted, DELETE
+// triggers with the old values of the rows that REPLACE deleted, and UPDATE triggers with both the old and new values
+// of the rows that ON DUPLICATE KEY UPDATE updated.
+func (t *triggerIter) afterInsertRow(row sql.Row) (sql.Row, bool) {
+	width := len(t.insert.Destination.Schema())
+	if len(row) != 2*width {
+		return row, t.triggerEvent == InsertTrigger
+	}
+
+	existed := false
+	for _, v := range row[:width] {
+		if v != nil {
+			existed = true
+			break
+		}
+	}
+
+	switch t.triggerEvent {
+	case InsertTrigger:
+		return row[width:], !existed || t.insert.IsReplace
+	case DeleteTrigger:
+		return row[:width], existed && t.insert.IsReplace
+	case UpdateTrigger:
+		return row, existed && !t.insert.IsReplace
+	default:
+		return row, true
+	}
+}
+
 func shouldUseLogicResult(logic sql.Node, row sql.Row) (bool, sql.Row) {
 	switch logic := logic.(type) {
 	// TODO: are there other statement types that we should use here?
@@ -236,15 +278,36 @@ func (t *TriggerExecutor) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 		return nil, err
 	}
 
+	var insert *InsertInto
+	if t.TriggerTime == AfterTrigger {
+		insert = triggerInsertInto(t.left)
+	}
+
 	return &triggerIter{
 		child:          childIter,
 		triggerTime:    t.TriggerTime,
 		triggerEvent:   t.TriggerEvent,
 		executionLogic: t.right,
 		ctx:            ctx,
+		insert:         insert,
 	}, nil
 }
 
+// triggerInsertInto returns the INSERT statement that the AFTER triggers wrapping the node given run for, or nil if
+// the node isn't an INSERT statement.
+func triggerInsertInto(node sql.Node) *InsertInto {
+	for {
+		switch n := node.(type) {
+		case *InsertInto:
+			return n
+		case *TriggerExecutor:
+			node = n.left
+		default:
+			return nil
+		}
+	}
+}
+
 const SavePointName = "__go_mysql_server_starting_savepoint__"
 
 // TriggerRollback is a node that wraps the entire tree iff it contains a trigger, creates a savepoint, and performs a
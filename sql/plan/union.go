@@ -124,19 +124,19 @@ func (u *Union) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.Union")
 	var iter sql.RowIter
 	var err error
-	iter, err = u.left.RowIter(ctx, row)
+	branches := u.branches()
+	iter, err = branches[0].RowIter(ctx, row)
 	if err != nil {
 		span.End()
 		return nil, err
 	}
 	iter = &unionIter{
-		cur: iter,
-		nextIter: func(ctx *sql.Context) (sql.RowIter, error) {
-			return u.right.RowIter(ctx, row)
-		},
+		cur:      iter,
+		branches: branches[1:],
+		row:      row,
 	}
 	if u.Distinct {
-		iter = newDistinctIter(ctx, iter)
+		iter = newDistinctIter(ctx, u.Schema(), iter)
 	}
 	if u.Limit != nil && len(u.SortFields) > 0 {
 		limit, err := getInt64Value(ctx, u.Limit)
@@ -217,29 +217,45 @@ func (u Union) DebugString() string {
 	return pr.String()
 }
 
+// branches returns the nodes whose rows this union returns, in order. The branches of a union that's a child of
+// this one are returned in its place if it has no limit or sort order, and it doesn't remove duplicate rows that this
+// union would keep, so that the rows of all of them are read by a single iterator and deduplicated at most once.
+func (u *Union) branches() []sql.Node {
+	var branches []sql.Node
+	for _, child := range u.Children() {
+		if c, ok := child.(*Union); ok && c.Limit == nil && len(c.SortFields) == 0 && (u.Distinct || !c.Distinct) {
+			branches = append(branches, c.branches()...)
+		} else {
+			branches = append(branches, child)
+		}
+	}
+	return branches
+}
+
+// unionIter returns the rows of each of the branches of a union in turn, without buffering them.
 type unionIter struct {
 	cur      sql.RowIter
-	nextIter func(ctx *sql.Context) (sql.RowIter, error)
+	branches []sql.Node
+	row      sql.Row
 }
 
 func (ui *unionIter) Next(ctx *sql.Context) (sql.Row, error) {
-	res, err := ui.cur.Next(ctx)
-	if err == io.EOF {
-		if ui.nextIter == nil {
-			return nil, io.EOF
+	for {
+		res, err := ui.cur.Next(ctx)
+		if err != io.EOF || len(ui.branches) == 0 {
+			return res, err
 		}
 		err = ui.cur.Close(ctx)
+		ui.cur = nil
 		if err != nil {
 			return nil, err
 		}
-		ui.cur, err = ui.nextIter(ctx)
-		ui.nextIter = nil
+		ui.cur, err = ui.branches[0].RowIter(ctx, ui.row)
+		ui.branches = ui.branches[1:]
 		if err != nil {
 			return nil, err
 		}
-		return ui.cur.Next(ctx)
 	}
-	return res, err
 }
 
 func (ui *unionIter) Close(ctx *sql.Context) error {
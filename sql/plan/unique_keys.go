@@ -0,0 +1,238 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// uniqueKeyChecker enforces the unique keys of a sql.UnenforcedUniqueKeyTable, by looking up the rows with the same
+// key as a row being written for each of the unique indexes of the table.
+type uniqueKeyChecker struct {
+	table  sql.UnenforcedUniqueKeyTable
+	editor sql.IndexAddressable
+	sch    sql.Schema
+	keys   []uniqueKey
+}
+
+// uniqueKey is a unique index, along with the positions in the table's rows of the columns of the index.
+type uniqueKey struct {
+	index     sql.Index
+	positions []int
+}
+
+// newUniqueKeyChecker returns a checker for the unique keys of the table given, which looks up rows through the
+// editor given if it's a sql.IndexAddressable, or nil if the table enforces its own unique keys.
+func newUniqueKeyChecker(ctx *sql.Context, table sql.Table, editor interface{}) (*uniqueKeyChecker, error) {
+	ukTable := getUnenforcedUniqueKeyTable(table)
+	if ukTable == nil || !ukTable.UnenforcedUniqueKeys() {
+		return nil, nil
+	}
+	idxs, err := ukTable.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sch := ukTable.Schema()
+	var keys []uniqueKey
+	for _, idx := range idxs {
+		// The primary key is always enforced by the table, and a key on column prefixes can't be looked up by value
+		if !idx.IsUnique() || strings.EqualFold(idx.ID(), "PRIMARY") || idx.IsSpatial() {
+			continue
+		}
+		if hasPrefixLength(idx) {
+			continue
+		}
+		positions := make([]int, len(idx.Expressions()))
+		for i, expr := range idx.Expressions() {
+			positions[i] = sch.IndexOfColName(expr[strings.LastIndex(expr, ".")+1:])
+			if positions[i] < 0 {
+				return nil, fmt.Errorf("unique index `%s` refers to column `%s` not found on table `%s`", idx.ID(), expr, ukTable.Name())
+			}
+		}
+		keys = append(keys, uniqueKey{index: idx, positions: positions})
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	checker := &uniqueKeyChecker{table: ukTable, sch: sch, keys: keys}
+	switch editor := editor.(type) {
+	case *ForeignKeyHandler:
+		checker.editor = editor.Editor.Editor
+	case sql.IndexAddressable:
+		checker.editor = editor
+	}
+	return checker, nil
+}
+
+// getUnenforcedUniqueKeyTable returns the sql.UnenforcedUniqueKeyTable underlying the table given, or nil if there
+// isn't one.
+func getUnenforcedUniqueKeyTable(t sql.Table) sql.UnenforcedUniqueKeyTable {
+	switch t := t.(type) {
+	case sql.UnenforcedUniqueKeyTable:
+		return t
+	case *ForeignKeyHandler:
+		return getUnenforcedUniqueKeyTable(t.Table)
+	case sql.TableWrapper:
+		return getUnenforcedUniqueKeyTable(t.Underlying())
+	default:
+		return nil
+	}
+}
+
+// check returns a unique key error for the first unique key, in index order, for which a row other than the old row
+// given has the same key as the row given. A key with a NULL value never conflicts, and neither does a key that the
+// row shares with the old row, as updating a row doesn't conflict with itself.
+func (c *uniqueKeyChecker) check(ctx *sql.Context, row, oldRow sql.Row) error {
+	for _, key := range c.keys {
+		if hasNullKey(row, key.positions) || (oldRow != nil && c.sameKey(row, oldRow, key.positions)) {
+			continue
+		}
+		existing, err := c.lookup(ctx, key, row)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			keyStrParts := make([]string, len(key.positions))
+			for i, pos := range key.positions {
+				keyStrParts[i] = fmt.Sprint(row[pos])
+			}
+			return sql.NewUniqueKeyErr(fmt.Sprintf("[%s]", strings.Join(keyStrParts, ",")), false, existing)
+		}
+	}
+	return nil
+}
+
+// lookup returns a row with the same value for the unique key given as the row given, or nil if there's none.
+func (c *uniqueKeyChecker) lookup(ctx *sql.Context, key uniqueKey, row sql.Row) (sql.Row, error) {
+	rang := make(sql.Range, len(key.positions))
+	for i, pos := range key.positions {
+		rang[i] = sql.ClosedRangeColumnExpr(row[pos], row[pos], c.sch[pos].Type)
+	}
+	if !key.index.CanSupport(rang) {
+		return nil, ErrInvalidLookupForIndexedTable.New(rang.DebugString())
+	}
+	lookup := sql.IndexLookup{Ranges: []sql.Range{rang}, Index: key.index}
+
+	var table sql.Table
+	var partIter sql.PartitionIter
+	var err error
+	if c.editor != nil {
+		editorData := c.editor.IndexedAccess(lookup)
+		table = editorData
+		partIter, err = editorData.LookupPartitions(ctx, lookup)
+	} else {
+		table = c.table
+		partIter, err = c.table.IndexedAccess(lookup).LookupPartitions(ctx, lookup)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	iter := sql.NewTableRowIter(ctx, table, partIter)
+	defer iter.Close(ctx)
+	existing, err := iter.Next(ctx)
+	if err == io.EOF {
+		return nil, nil
+	}
+	return existing, err
+}
+
+// hasNullKey returns whether the row given has a NULL value in any of the positions given.
+func hasNullKey(row sql.Row, positions []int) bool {
+	for _, pos := range positions {
+		if row[pos] == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPrefixLength returns whether any of the columns of the index given is a column prefix.
+func hasPrefixLength(idx sql.Index) bool {
+	for _, prefixLength := range idx.PrefixLengths() {
+		if prefixLength > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// sameKey returns whether the rows given have the same values in all of the positions given.
+func (c *uniqueKeyChecker) sameKey(row, other sql.Row, positions []int) bool {
+	for _, pos := range positions {
+		if other[pos] == nil {
+			return false
+		}
+		cmp, err := c.sch[pos].Type.Compare(row[pos], other[pos])
+		if err != nil || cmp != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// uniqueKeyInserter is a sql.RowInserter that checks the unique keys of its table before inserting a row.
+type uniqueKeyInserter struct {
+	sql.RowInserter
+	checker *uniqueKeyChecker
+}
+
+var _ sql.RowInserter = (*uniqueKeyInserter)(nil)
+
+// Insert implements the interface sql.RowInserter.
+func (u *uniqueKeyInserter) Insert(ctx *sql.Context, row sql.Row) error {
+	if err := u.checker.check(ctx, row, nil); err != nil {
+		return err
+	}
+	return u.RowInserter.Insert(ctx, row)
+}
+
+// uniqueKeyReplacer is a sql.RowReplacer that checks the unique keys of its table before inserting a row.
+type uniqueKeyReplacer struct {
+	sql.RowReplacer
+	checker *uniqueKeyChecker
+}
+
+var _ sql.RowReplacer = (*uniqueKeyReplacer)(nil)
+
+// Insert implements the interface sql.RowReplacer.
+func (u *uniqueKeyReplacer) Insert(ctx *sql.Context, row sql.Row) error {
+	if err := u.checker.check(ctx, row, nil); err != nil {
+		return err
+	}
+	return u.RowReplacer.Insert(ctx, row)
+}
+
+// uniqueKeyUpdater is a sql.RowUpdater that checks the unique keys of its table before updating a row.
+type uniqueKeyUpdater struct {
+	sql.RowUpdater
+	checker *uniqueKeyChecker
+}
+
+var _ sql.RowUpdater = (*uniqueKeyUpdater)(nil)
+
+// Update implements the interface sql.RowUpdater.
+func (u *uniqueKeyUpdater) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	if err := u.checker.check(ctx, new, old); err != nil {
+		return err
+	}
+	return u.RowUpdater.Update(ctx, old, new)
+}
@@ -159,6 +159,10 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if err != nil {
 		return nil, err
 	}
+	oldAndNewRow, err = sql.LoadRow(ctx, oldAndNewRow)
+	if err != nil {
+		return nil, err
+	}
 
 	oldRow, newRow := oldAndNewRow[:len(oldAndNewRow)/2], oldAndNewRow[len(oldAndNewRow)/2:]
 	if equals, err := oldRow.Equals(newRow, u.schema); err == nil {
@@ -175,7 +179,7 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 				}
 
 				if sql.IsFalse(res) {
-					return nil, u.ignoreOrError(ctx, newRow, sql.ErrCheckConstraintViolated.New(check.Name))
+					return nil, u.ignoreOrError(ctx, newRow, check.NewViolationError())
 				}
 			}
 
@@ -290,6 +294,13 @@ func (u *Update) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 	updater := updatable.Updater(ctx)
+	checker, err := newUniqueKeyChecker(ctx, updatable, updater)
+	if err != nil {
+		return nil, err
+	}
+	if checker != nil {
+		updater = &uniqueKeyUpdater{RowUpdater: updater, checker: checker}
+	}
 
 	iter, err := u.Child.RowIter(ctx, row)
 	if err != nil {
@@ -115,6 +115,9 @@ func (u *updateJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
 		tableToOldRowMap := splitRowIntoTableRowMap(oldJoinRow, u.joinSchema)
 		tableToNewRowMap := splitRowIntoTableRowMap(newJoinRow, u.joinSchema)
 
+		// Rows are returned when they match a row of an updated table for the first time, even if it doesn't change, so
+		// that the update accumulator counts the rows matched
+		matched := false
 		for tableName, _ := range u.updaters {
 			oldTableRow := tableToOldRowMap[tableName]
 
@@ -142,6 +145,7 @@ func (u *updateJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
 			_, err = cache.Get(hash)
 			if errors.Is(err, sql.ErrKeyNotFound) {
 				cache.Put(hash, struct{}{})
+				matched = true
 				continue
 			} else if err != nil {
 				return nil, err
@@ -152,12 +156,8 @@ func (u *updateJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
 			tableToNewRowMap[tableName] = oldTableRow
 		}
 
-		newJoinRow = recreateRowFromMap(tableToNewRowMap, u.joinSchema)
-		equals, err := oldJoinRow.Equals(newJoinRow, u.joinSchema)
-		if err != nil {
-			return nil, err
-		}
-		if !equals {
+		if matched {
+			newJoinRow = recreateRowFromMap(tableToNewRowMap, u.joinSchema)
 			return append(oldJoinRow, newJoinRow...), nil
 		}
 	}
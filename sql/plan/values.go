@@ -79,16 +79,12 @@ func (p *Values) Resolved() bool {
 func (p *Values) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	rows := make([]sql.Row, len(p.ExpressionTuples))
 	for i, et := range p.ExpressionTuples {
-		vals := make([]interface{}, len(et))
-		for j, e := range et {
-			var err error
-			vals[j], err = e.Eval(ctx, row)
-			if err != nil {
-				return nil, err
-			}
+		// The expression defaults of a tuple reference its other values, like those of a projection
+		vals, err := projectRowInto(ctx, et, row, make(sql.Row, 0, len(et)))
+		if err != nil {
+			return nil, err
 		}
-
-		rows[i] = sql.NewRow(vals...)
+		rows[i] = vals
 	}
 
 	return sql.RowsToRowIter(rows...), nil
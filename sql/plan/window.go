@@ -142,11 +142,11 @@ func (w *Window) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 
 // windowToIter transforms a plan.Window into a series
 // of aggregation.WindowPartitionIter and a list of output projection indexes
-// for each window partition.
-// TODO: make partition ordering deterministic
+// for each window partition, in the order of their first window function.
 func windowToIter(w *Window) ([]*aggregation.WindowPartitionIter, [][]int, error) {
 	partIdToOutputIdxs := make(map[uint64][]int, 0)
 	partIdToBlock := make(map[uint64]*aggregation.WindowPartition, 0)
+	var partIds []uint64
 	var window *sql.WindowDefinition
 	var agg *aggregation.Aggregation
 	var fn sql.WindowFunction
@@ -188,6 +188,7 @@ func windowToIter(w *Window) ([]*aggregation.WindowPartitionIter, [][]int, error
 				[]*aggregation.Aggregation{agg},
 			)
 			partIdToOutputIdxs[id] = []int{i}
+			partIds = append(partIds, id)
 		} else {
 			block.AddAggregation(agg)
 			partIdToOutputIdxs[id] = append(partIdToOutputIdxs[id], i)
@@ -197,12 +198,9 @@ func windowToIter(w *Window) ([]*aggregation.WindowPartitionIter, [][]int, error
 	// convert partition hash map into list
 	blockIters := make([]*aggregation.WindowPartitionIter, len(partIdToBlock))
 	outputOrdinals := make([][]int, len(partIdToBlock))
-	i := 0
-	for id, block := range partIdToBlock {
-		outputIdx := partIdToOutputIdxs[id]
-		blockIters[i] = aggregation.NewWindowPartitionIter(block)
-		outputOrdinals[i] = outputIdx
-		i++
+	for i, id := range partIds {
+		blockIters[i] = aggregation.NewWindowPartitionIter(partIdToBlock[id])
+		outputOrdinals[i] = partIdToOutputIdxs[id]
 	}
 	return blockIters, outputOrdinals, nil
 }
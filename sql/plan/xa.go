@@ -0,0 +1,318 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// DynamicPrivilege_XaRecoverAdmin is the dynamic privilege required to execute XA RECOVER.
+// https://dev.mysql.com/doc/refman/8.0/en/privileges-provided.html#priv_xa-recover-admin
+const DynamicPrivilege_XaRecoverAdmin = "xa_recover_admin"
+
+// XAAction is the kind of an XA statement.
+type XAAction byte
+
+const (
+	XAStart XAAction = iota
+	XAEnd
+	XAPrepare
+	XACommit
+	XARollback
+	XARecover
+)
+
+// String returns the keyword of the XA statement.
+func (a XAAction) String() string {
+	switch a {
+	case XAStart:
+		return "START"
+	case XAEnd:
+		return "END"
+	case XAPrepare:
+		return "PREPARE"
+	case XACommit:
+		return "COMMIT"
+	case XARollback:
+		return "ROLLBACK"
+	case XARecover:
+		return "RECOVER"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// xaRecoverSchema is the schema of the rows returned by XA RECOVER.
+var xaRecoverSchema = sql.Schema{
+	{Name: "formatID", Type: types.Int64},
+	{Name: "gtrid_length", Type: types.Int64},
+	{Name: "bqual_length", Type: types.Int64},
+	{Name: "data", Type: types.LongText},
+}
+
+// XA is an XA transaction statement, which lets an external transaction manager drive a branch of a distributed
+// transaction: XA START begins a branch in the session's transaction, XA END ends the work done in it, XA PREPARE
+// prepares it to be committed and detaches it from the session, and XA COMMIT and XA ROLLBACK end it. XA RECOVER
+// lists the prepared branches.
+// https://dev.mysql.com/doc/refman/8.0/en/xa-statements.html
+type XA struct {
+	Action XAAction
+	XID    sql.XID
+	// OnePhase is set for XA COMMIT ... ONE PHASE, which prepares and commits a branch that hasn't been prepared
+	OnePhase bool
+	// ConvertXID is set for XA RECOVER CONVERT XID, which returns the XIDs in hexadecimal
+	ConvertXID bool
+	// Registry keeps track of the branches of all sessions
+	Registry *sql.XARegistry
+}
+
+var _ sql.Node = (*XA)(nil)
+var _ sql.CollationCoercible = (*XA)(nil)
+
+// NewXA returns a new XA node for the statement given.
+func NewXA(action XAAction, xid sql.XID) *XA {
+	return &XA{Action: action, XID: xid}
+}
+
+// Resolved implements the sql.Node interface.
+func (x *XA) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (x *XA) Children() []sql.Node {
+	return nil
+}
+
+// Schema implements the sql.Node interface.
+func (x *XA) Schema() sql.Schema {
+	if x.Action == XARecover {
+		return xaRecoverSchema
+	}
+	return nil
+}
+
+// String implements the sql.Node interface.
+func (x *XA) String() string {
+	switch x.Action {
+	case XARecover:
+		if x.ConvertXID {
+			return "XA RECOVER CONVERT XID"
+		}
+		return "XA RECOVER"
+	case XACommit:
+		if x.OnePhase {
+			return fmt.Sprintf("XA COMMIT %s ONE PHASE", x.XID)
+		}
+	}
+	return fmt.Sprintf("XA %s %s", x.Action, x.XID)
+}
+
+// WithChildren implements the sql.Node interface.
+func (x *XA) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(x, len(children), 0)
+	}
+	return x, nil
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (x *XA) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	if x.Action == XARecover {
+		return opChecker.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(DynamicPrivilege_XaRecoverAdmin))
+	}
+	return true
+}
+
+// CollationCoercibility implements the interface sql.CollationCoercible.
+func (*XA) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 7
+}
+
+// RowIter implements the sql.Node interface.
+func (x *XA) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if x.Registry == nil {
+		return nil, fmt.Errorf("no XA transaction registry available for %s", x)
+	}
+
+	var err error
+	switch x.Action {
+	case XAStart:
+		err = x.start(ctx)
+	case XAEnd:
+		err = x.end(ctx)
+	case XAPrepare:
+		err = x.prepare(ctx)
+	case XACommit:
+		err = x.commit(ctx)
+	case XARollback:
+		err = x.rollback(ctx)
+	case XARecover:
+		return x.recover(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// start begins a branch in the session's transaction. Like START TRANSACTION, it suspends autocommit until the branch
+// is prepared, committed or rolled back.
+func (x *XA) start(ctx *sql.Context) error {
+	if xa := x.Registry.SessionTransaction(ctx.ID()); xa != nil {
+		return sql.ErrXAInvalidState.New(xa.State)
+	}
+	if ctx.GetIgnoreAutoCommit() || len(ctx.GetTwoPhaseTransactions()) > 0 {
+		return sql.ErrXAOutside.New()
+	}
+
+	xa, err := x.Registry.Start(ctx.ID(), x.XID)
+	if err != nil {
+		return err
+	}
+	if ts, ok := ctx.Session.(sql.TransactionSession); ok && ctx.GetTransaction() == nil {
+		tx, err := ts.StartTransaction(ctx, sql.ReadWrite)
+		if err != nil {
+			x.Registry.Remove(xa)
+			return err
+		}
+		ctx.SetTransaction(tx)
+	}
+	ctx.SetIgnoreAutoCommit(true)
+	return nil
+}
+
+// end ends the work done in the session's branch, which can then only be prepared, committed or rolled back.
+func (x *XA) end(ctx *sql.Context) error {
+	xa, err := x.sessionTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	if xa.State != sql.XAActive {
+		return sql.ErrXAInvalidState.New(xa.State)
+	}
+	x.Registry.SetState(xa, sql.XAIdle)
+	return nil
+}
+
+// prepare prepares the two-phase transactions of the databases written by the session's branch, and detaches the
+// branch and the session's transaction from the session. The branch is rolled back if any of them fails to prepare.
+func (x *XA) prepare(ctx *sql.Context) error {
+	xa, err := x.sessionTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	if xa.State != sql.XAIdle {
+		return sql.ErrXAInvalidState.New(xa.State)
+	}
+
+	x.detach(ctx, xa)
+	if err := xa.Prepare(ctx); err != nil {
+		x.Registry.Remove(xa)
+		return err
+	}
+	x.Registry.SetState(xa, sql.XAPrepared)
+	return nil
+}
+
+// commit commits a prepared branch, or the session's branch if it's committed in one phase.
+func (x *XA) commit(ctx *sql.Context) error {
+	if xa := x.Registry.SessionTransaction(ctx.ID()); xa != nil {
+		if xa.XID != x.XID {
+			return sql.ErrXAUnknownXID.New()
+		}
+		if !x.OnePhase || xa.State != sql.XAIdle {
+			return sql.ErrXAInvalidState.New(xa.State)
+		}
+		x.Registry.Remove(xa)
+		x.detach(ctx, xa)
+		if err := xa.Prepare(ctx); err != nil {
+			return err
+		}
+		return xa.Commit(ctx)
+	}
+	if x.OnePhase {
+		return sql.ErrXAUnknownXID.New()
+	}
+
+	xa, err := x.Registry.TakePrepared(x.XID)
+	if err != nil {
+		return err
+	}
+	return xa.Commit(ctx)
+}
+
+// rollback rolls back a prepared branch, or the session's branch once its work has ended.
+func (x *XA) rollback(ctx *sql.Context) error {
+	if xa := x.Registry.SessionTransaction(ctx.ID()); xa != nil {
+		if xa.XID != x.XID {
+			return sql.ErrXAUnknownXID.New()
+		}
+		if xa.State != sql.XAIdle {
+			return sql.ErrXAInvalidState.New(xa.State)
+		}
+		x.Registry.Remove(xa)
+		x.detach(ctx, xa)
+		return xa.Rollback(ctx)
+	}
+
+	xa, err := x.Registry.TakePrepared(x.XID)
+	if err != nil {
+		return err
+	}
+	return xa.Rollback(ctx)
+}
+
+// recover returns the XIDs of the prepared branches.
+func (x *XA) recover() sql.RowIter {
+	xids := x.Registry.PreparedTransactions()
+	rows := make([]sql.Row, len(xids))
+	for i, xid := range xids {
+		data := xid.Gtrid + xid.Bqual
+		if x.ConvertXID {
+			data = "0x" + strings.ToUpper(hex.EncodeToString([]byte(data)))
+		}
+		rows[i] = sql.Row{xid.FormatID, int64(len(xid.Gtrid)), int64(len(xid.Bqual)), data}
+	}
+	return sql.RowsToRowIter(rows...)
+}
+
+// sessionTransaction returns the branch the session is associated with, which must have the XID of the statement.
+func (x *XA) sessionTransaction(ctx *sql.Context) (*sql.XATransaction, error) {
+	xa := x.Registry.SessionTransaction(ctx.ID())
+	if xa == nil || xa.XID != x.XID {
+		return nil, sql.ErrXAUnknownXID.New()
+	}
+	return xa, nil
+}
+
+// detach moves the session's transaction and the two-phase transactions of the databases it wrote to into the branch
+// given, and resumes autocommit in the session.
+func (x *XA) detach(ctx *sql.Context, xa *sql.XATransaction) {
+	xa.Participants = ctx.GetTwoPhaseTransactions()
+	xa.Changes = sql.TakeCapturedChanges(ctx)
+	ctx.SetTwoPhaseTransactions(nil)
+	if ts, ok := ctx.Session.(sql.TransactionSession); ok {
+		xa.Session = ts
+		xa.Transaction = ctx.GetTransaction()
+	}
+	ctx.SetTransaction(nil)
+	ctx.SetIgnoreAutoCommit(false)
+}
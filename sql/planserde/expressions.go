@@ -0,0 +1,300 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planserde
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// functionTag is the tag of the functions without a codec of their own, which are serialized by name and looked up
+// in the catalog when deserialized.
+const functionTag = "function"
+
+func init() {
+	RegisterExpression(functionTag, nil, ExpressionCodec{
+		Encode: func(enc *Encoder, e sql.Expression) (interface{}, error) {
+			return strings.ToLower(e.(sql.FunctionExpression).FunctionName()), nil
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			var name string
+			if err := decodeAttrs(attrs, &name); err != nil {
+				return nil, err
+			}
+			fn, err := dec.Catalog().Function(dec.Context(), name)
+			if err != nil {
+				return nil, err
+			}
+			return fn.NewInstance(children)
+		},
+	})
+
+	RegisterExpression("get_field", &expression.GetField{}, ExpressionCodec{
+		Encode: func(enc *Encoder, e sql.Expression) (interface{}, error) {
+			gf := e.(*expression.GetField)
+			typ, err := enc.Type(gf.Type())
+			return getFieldAttrs{
+				Index:    gf.Index(),
+				Type:     typ,
+				Table:    gf.Table(),
+				Name:     gf.Name(),
+				Nullable: gf.IsNullable(),
+			}, err
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			var a getFieldAttrs
+			if err := decodeAttrs(attrs, &a); err != nil {
+				return nil, err
+			}
+			typ, err := dec.Type(a.Type)
+			if err != nil {
+				return nil, err
+			}
+			return expression.NewGetFieldWithTable(a.Index, typ, a.Table, a.Name, a.Nullable), nil
+		},
+	})
+	RegisterExpression("literal", &expression.Literal{}, ExpressionCodec{
+		Encode: func(enc *Encoder, e sql.Expression) (interface{}, error) {
+			lit := e.(*expression.Literal)
+			v, err := enc.Value(lit.Type(), lit.Value())
+			if err != nil {
+				return nil, err
+			}
+			return literalAttrs{Value: *v, Hex: lit.IsHex()}, nil
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			var a literalAttrs
+			if err := decodeAttrs(attrs, &a); err != nil {
+				return nil, err
+			}
+			typ, value, err := dec.Value(&a.Value)
+			if err != nil {
+				return nil, err
+			}
+			if b, ok := value.([]byte); ok && a.Hex {
+				return expression.NewHexLiteral(b), nil
+			}
+			return expression.NewLiteral(value, typ), nil
+		},
+	})
+	RegisterExpression("alias", &expression.Alias{}, ExpressionCodec{
+		Encode: func(enc *Encoder, e sql.Expression) (interface{}, error) {
+			return e.(*expression.Alias).Name(), nil
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			var name string
+			if err := decodeAttrs(attrs, &name); err != nil {
+				return nil, err
+			}
+			if len(children) != 1 {
+				return nil, sql.ErrInvalidChildrenNumber.New("alias", len(children), 1)
+			}
+			return expression.NewAlias(name, children[0]), nil
+		},
+	})
+	RegisterExpression("arithmetic", &expression.Arithmetic{}, ExpressionCodec{
+		Encode: func(enc *Encoder, e sql.Expression) (interface{}, error) {
+			return e.(*expression.Arithmetic).Op, nil
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			var op string
+			if err := decodeAttrs(attrs, &op); err != nil {
+				return nil, err
+			}
+			if len(children) != 2 {
+				return nil, sql.ErrInvalidChildrenNumber.New("arithmetic", len(children), 2)
+			}
+			return expression.NewArithmetic(children[0], children[1], op), nil
+		},
+	})
+	RegisterExpression("like", &expression.Like{}, ExpressionCodec{
+		// The escape character of LIKE isn't one of its children
+		Encode: func(enc *Encoder, e sql.Expression) (interface{}, error) {
+			return enc.Expression(e.(*expression.Like).Escape)
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			escape, err := decodeExpression(dec, attrs)
+			if err != nil {
+				return nil, err
+			}
+			if len(children) != 2 {
+				return nil, sql.ErrInvalidChildrenNumber.New("like", len(children), 2)
+			}
+			return expression.NewLike(children[0], children[1], escape), nil
+		},
+	})
+	RegisterExpression("convert", &expression.Convert{}, ExpressionCodec{
+		Encode: func(enc *Encoder, e sql.Expression) (interface{}, error) {
+			c := e.(*expression.Convert)
+			if c.TypeLength() > 0 {
+				return fmt.Sprintf("%s(%d)", c.TypeToConvert(), c.TypeLength()), nil
+			}
+			return c.TypeToConvert(), nil
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			var typ string
+			if err := decodeAttrs(attrs, &typ); err != nil {
+				return nil, err
+			}
+			if len(children) != 1 {
+				return nil, sql.ErrInvalidChildrenNumber.New("convert", len(children), 1)
+			}
+			if name, length, ok := strings.Cut(typ, "("); ok {
+				n, err := strconv.Atoi(strings.TrimSuffix(length, ")"))
+				if err != nil {
+					return nil, err
+				}
+				return expression.NewConvertWithLength(children[0], name, n), nil
+			}
+			return expression.NewConvert(children[0], typ), nil
+		},
+	})
+	RegisterExpression("is_true", &expression.IsTrue{}, ExpressionCodec{
+		Encode: func(enc *Encoder, e sql.Expression) (interface{}, error) {
+			return e.(*expression.IsTrue).Inverted(), nil
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			var inverted bool
+			if err := decodeAttrs(attrs, &inverted); err != nil {
+				return nil, err
+			}
+			if len(children) != 1 {
+				return nil, sql.ErrInvalidChildrenNumber.New("is_true", len(children), 1)
+			}
+			if inverted {
+				return expression.NewIsFalse(children[0]), nil
+			}
+			return expression.NewIsTrue(children[0]), nil
+		},
+	})
+	RegisterExpression("between", &expression.Between{}, ExpressionCodec{
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			if len(children) != 3 {
+				return nil, sql.ErrInvalidChildrenNumber.New("between", len(children), 3)
+			}
+			return expression.NewBetween(children[0], children[1], children[2]), nil
+		},
+	})
+	RegisterExpression("hash_in_tuple", &expression.HashInTuple{}, ExpressionCodec{
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			if len(children) != 2 {
+				return nil, sql.ErrInvalidChildrenNumber.New("hash_in_tuple", len(children), 2)
+			}
+			return expression.NewHashInTuple(dec.Context(), children[0], children[1])
+		},
+	})
+	RegisterExpression("tuple", expression.Tuple{}, ExpressionCodec{
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			return expression.NewTuple(children...), nil
+		},
+	})
+	RegisterExpression("bind_var", &expression.BindVar{}, ExpressionCodec{
+		Encode: func(enc *Encoder, e sql.Expression) (interface{}, error) {
+			bv := e.(*expression.BindVar)
+			a := bindVarAttrs{Name: bv.Name}
+			if bv.Typ != nil {
+				typ, err := enc.Type(bv.Typ)
+				if err != nil {
+					return nil, err
+				}
+				a.Type = &typ
+			}
+			return a, nil
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			var a bindVarAttrs
+			if err := decodeAttrs(attrs, &a); err != nil {
+				return nil, err
+			}
+			bv := &expression.BindVar{Name: a.Name}
+			if a.Type != nil {
+				typ, err := dec.Type(*a.Type)
+				if err != nil {
+					return nil, err
+				}
+				bv.Typ = typ
+			}
+			return bv, nil
+		},
+	})
+
+	registerUnary("not", &expression.Not{}, func(e sql.Expression) sql.Expression { return expression.NewNot(e) })
+	registerUnary("is_null", &expression.IsNull{}, func(e sql.Expression) sql.Expression { return expression.NewIsNull(e) })
+	registerUnary("unary_minus", &expression.UnaryMinus{}, func(e sql.Expression) sql.Expression { return expression.NewUnaryMinus(e) })
+
+	registerBinary("div", &expression.Div{}, func(l, r sql.Expression) sql.Expression { return expression.NewDiv(l, r) })
+	registerBinary("int_div", &expression.IntDiv{}, func(l, r sql.Expression) sql.Expression { return expression.NewIntDiv(l, r) })
+	registerBinary("mod", &expression.Mod{}, func(l, r sql.Expression) sql.Expression { return expression.NewMod(l, r) })
+	registerBinary("equals", &expression.Equals{}, func(l, r sql.Expression) sql.Expression { return expression.NewEquals(l, r) })
+	registerBinary("null_safe_equals", &expression.NullSafeEquals{}, func(l, r sql.Expression) sql.Expression { return expression.NewNullSafeEquals(l, r) })
+	registerBinary("greater_than", &expression.GreaterThan{}, func(l, r sql.Expression) sql.Expression { return expression.NewGreaterThan(l, r) })
+	registerBinary("less_than", &expression.LessThan{}, func(l, r sql.Expression) sql.Expression { return expression.NewLessThan(l, r) })
+	registerBinary("greater_than_or_equal", &expression.GreaterThanOrEqual{}, func(l, r sql.Expression) sql.Expression { return expression.NewGreaterThanOrEqual(l, r) })
+	registerBinary("less_than_or_equal", &expression.LessThanOrEqual{}, func(l, r sql.Expression) sql.Expression { return expression.NewLessThanOrEqual(l, r) })
+	registerBinary("regexp", &expression.Regexp{}, func(l, r sql.Expression) sql.Expression { return expression.NewRegexp(l, r) })
+	registerBinary("and", &expression.And{}, expression.NewAnd)
+	registerBinary("or", &expression.Or{}, expression.NewOr)
+	registerBinary("xor", &expression.Xor{}, expression.NewXor)
+	registerBinary("in_tuple", &expression.InTuple{}, func(l, r sql.Expression) sql.Expression { return expression.NewInTuple(l, r) })
+}
+
+type getFieldAttrs struct {
+	Index    int    `json:"index"`
+	Type     string `json:"type"`
+	Table    string `json:"table,omitempty"`
+	Name     string `json:"name"`
+	Nullable bool   `json:"nullable,omitempty"`
+}
+
+// literalAttrs are the attributes of a literal, whose value is embedded so the literals of other types keep the encoding
+// of plain values.
+type literalAttrs struct {
+	Value
+	Hex bool `json:"hex,omitempty"`
+}
+
+type bindVarAttrs struct {
+	Name string  `json:"name"`
+	Type *string `json:"type,omitempty"`
+}
+
+// registerUnary registers a codec for the expressions of the type given, which have a single child and no attributes.
+func registerUnary(tag string, expr sql.Expression, newExpr func(sql.Expression) sql.Expression) {
+	RegisterExpression(tag, expr, ExpressionCodec{
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			if len(children) != 1 {
+				return nil, sql.ErrInvalidChildrenNumber.New(tag, len(children), 1)
+			}
+			return newExpr(children[0]), nil
+		},
+	})
+}
+
+// registerBinary registers a codec for the expressions of the type given, which have two children and no attributes.
+func registerBinary(tag string, expr sql.Expression, newExpr func(l, r sql.Expression) sql.Expression) {
+	RegisterExpression(tag, expr, ExpressionCodec{
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error) {
+			if len(children) != 2 {
+				return nil, sql.ErrInvalidChildrenNumber.New(tag, len(children), 2)
+			}
+			return newExpr(children[0], children[1]), nil
+		},
+	})
+}
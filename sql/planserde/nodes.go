@@ -0,0 +1,389 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planserde
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func init() {
+	RegisterNode("query_process", &plan.QueryProcess{}, NodeCodec{
+		// The process of the query is tracked by the engine running it, which isn't known to the plan
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			return plan.NewQueryProcess(children[0], func() {}), nil
+		},
+	})
+	RegisterNode("transaction_committing", &plan.TransactionCommittingNode{}, NodeCodec{
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			return plan.NewTransactionCommittingNode(children[0]), nil
+		},
+	})
+	RegisterNode("project", &plan.Project{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			return enc.Expressions(n.(*plan.Project).Projections)
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			projections, err := decodeExpressionList(dec, attrs)
+			if err != nil {
+				return nil, err
+			}
+			return plan.NewProject(projections, children[0]), nil
+		},
+	})
+	RegisterNode("filter", &plan.Filter{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			return enc.Expression(n.(*plan.Filter).Expression)
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			cond, err := decodeExpression(dec, attrs)
+			if err != nil {
+				return nil, err
+			}
+			return plan.NewFilter(cond, children[0]), nil
+		},
+	})
+	RegisterNode("having", &plan.Having{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			return enc.Expression(n.(*plan.Having).Cond)
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			cond, err := decodeExpression(dec, attrs)
+			if err != nil {
+				return nil, err
+			}
+			return plan.NewHaving(cond, children[0]), nil
+		},
+	})
+	RegisterNode("limit", &plan.Limit{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			limit := n.(*plan.Limit)
+			size, err := enc.Expression(limit.Limit)
+			return limitAttrs{Limit: size, CalcFoundRows: limit.CalcFoundRows}, err
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			var a limitAttrs
+			size, err := a.decode(dec, attrs)
+			if err != nil {
+				return nil, err
+			}
+			limit := plan.NewLimit(size, children[0])
+			limit.CalcFoundRows = a.CalcFoundRows
+			return limit, nil
+		},
+	})
+	RegisterNode("offset", &plan.Offset{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			return enc.Expression(n.(*plan.Offset).Offset)
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			offset, err := decodeExpression(dec, attrs)
+			if err != nil {
+				return nil, err
+			}
+			return plan.NewOffset(offset, children[0]), nil
+		},
+	})
+	RegisterNode("sort", &plan.Sort{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			return encodeSortFields(enc, n.(*plan.Sort).SortFields)
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			var fields []sortField
+			if err := decodeAttrs(attrs, &fields); err != nil {
+				return nil, err
+			}
+			sortFields, err := decodeSortFields(dec, fields)
+			if err != nil {
+				return nil, err
+			}
+			return plan.NewSort(sortFields, children[0]), nil
+		},
+	})
+	RegisterNode("top_n", &plan.TopN{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			topN := n.(*plan.TopN)
+			size, err := enc.Expression(topN.Limit)
+			if err != nil {
+				return nil, err
+			}
+			fields, err := encodeSortFields(enc, topN.Fields)
+			return topNAttrs{limitAttrs: limitAttrs{Limit: size, CalcFoundRows: topN.CalcFoundRows}, Fields: fields}, err
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			var a topNAttrs
+			size, err := a.decode(dec, attrs)
+			if err != nil {
+				return nil, err
+			}
+			sortFields, err := decodeSortFields(dec, a.Fields)
+			if err != nil {
+				return nil, err
+			}
+			topN := plan.NewTopN(sortFields, size, children[0])
+			topN.CalcFoundRows = a.CalcFoundRows
+			return topN, nil
+		},
+	})
+	RegisterNode("group_by", &plan.GroupBy{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			groupBy := n.(*plan.GroupBy)
+			selected, err := enc.Expressions(groupBy.SelectedExprs)
+			if err != nil {
+				return nil, err
+			}
+			grouping, err := enc.Expressions(groupBy.GroupByExprs)
+			return groupByAttrs{Selected: selected, Grouping: grouping, Modifier: groupBy.Modifier.String()}, err
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			var a groupByAttrs
+			if err := decodeAttrs(attrs, &a); err != nil {
+				return nil, err
+			}
+			selected, err := dec.Expressions(a.Selected)
+			if err != nil {
+				return nil, err
+			}
+			grouping, err := dec.Expressions(a.Grouping)
+			if err != nil {
+				return nil, err
+			}
+			modifier, err := groupingModifier(a.Modifier)
+			if err != nil {
+				return nil, err
+			}
+			return plan.NewGroupBy(selected, grouping, children[0]).WithModifier(modifier), nil
+		},
+	})
+	RegisterNode("distinct", &plan.Distinct{}, NodeCodec{
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			return plan.NewDistinct(children[0]), nil
+		},
+	})
+	RegisterNode("ordered_distinct", &plan.OrderedDistinct{}, NodeCodec{
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			return plan.NewOrderedDistinct(children[0]), nil
+		},
+	})
+	RegisterNode("table_alias", &plan.TableAlias{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			return n.(*plan.TableAlias).Name(), nil
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			var name string
+			if err := decodeAttrs(attrs, &name); err != nil {
+				return nil, err
+			}
+			return plan.NewTableAlias(name, children[0]), nil
+		},
+	})
+	RegisterNode("join", &plan.JoinNode{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			join := n.(*plan.JoinNode)
+			cond, err := enc.Expression(join.Filter)
+			return joinAttrs{
+				Op:        join.Op.String(),
+				Filter:    cond,
+				Comment:   join.CommentStr,
+				ScopeLen:  join.ScopeLen,
+				UsingCols: join.UsingCols,
+			}, err
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			var a joinAttrs
+			if err := decodeAttrs(attrs, &a); err != nil {
+				return nil, err
+			}
+			op, err := joinType(a.Op)
+			if err != nil {
+				return nil, err
+			}
+			cond, err := dec.Expression(a.Filter)
+			if err != nil {
+				return nil, err
+			}
+			join := plan.NewJoin(children[0], children[1], op, cond)
+			join.CommentStr = a.Comment
+			join.ScopeLen = a.ScopeLen
+			join.UsingCols = a.UsingCols
+			return join, nil
+		},
+	})
+	RegisterNode("exchange", &plan.Exchange{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			return n.(*plan.Exchange).Parallelism, nil
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			var parallelism int
+			if err := decodeAttrs(attrs, &parallelism); err != nil {
+				return nil, err
+			}
+			return plan.NewExchange(parallelism, children[0]), nil
+		},
+	})
+	RegisterNode("values", &plan.Values{}, NodeCodec{
+		Encode: func(enc *Encoder, n sql.Node) (interface{}, error) {
+			var tuples [][]*Expression
+			for _, tuple := range n.(*plan.Values).ExpressionTuples {
+				encoded, err := enc.Expressions(tuple)
+				if err != nil {
+					return nil, err
+				}
+				tuples = append(tuples, encoded)
+			}
+			return tuples, nil
+		},
+		Decode: func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+			var tuples [][]*Expression
+			if err := decodeAttrs(attrs, &tuples); err != nil {
+				return nil, err
+			}
+			decoded := make([][]sql.Expression, len(tuples))
+			for i, tuple := range tuples {
+				var err error
+				if decoded[i], err = dec.Expressions(tuple); err != nil {
+					return nil, err
+				}
+			}
+			return plan.NewValues(decoded), nil
+		},
+	})
+	RegisterNode("resolved_table", &plan.ResolvedTable{}, NodeCodec{
+		Encode: encodeResolvedTable,
+		Decode: decodeResolvedTable,
+	})
+}
+
+type limitAttrs struct {
+	Limit         *Expression `json:"limit"`
+	CalcFoundRows bool        `json:"calc_found_rows,omitempty"`
+}
+
+// decode unmarshals the attributes given into these attributes, and returns the limit.
+func (a *limitAttrs) decode(dec *Decoder, attrs json.RawMessage) (sql.Expression, error) {
+	if err := decodeAttrs(attrs, a); err != nil {
+		return nil, err
+	}
+	return dec.Expression(a.Limit)
+}
+
+type topNAttrs struct {
+	limitAttrs
+	Fields []sortField `json:"fields"`
+}
+
+// decode unmarshals the attributes given into these attributes, and returns the limit.
+func (a *topNAttrs) decode(dec *Decoder, attrs json.RawMessage) (sql.Expression, error) {
+	if err := decodeAttrs(attrs, a); err != nil {
+		return nil, err
+	}
+	return dec.Expression(a.Limit)
+}
+
+type groupByAttrs struct {
+	Selected []*Expression `json:"selected"`
+	Grouping []*Expression `json:"grouping"`
+	Modifier string        `json:"modifier,omitempty"`
+}
+
+// groupingModifier returns the grouping modifier with the name given.
+func groupingModifier(name string) (plan.GroupingModifier, error) {
+	for _, modifier := range []plan.GroupingModifier{plan.GroupingModifierNone, plan.GroupingModifierRollup, plan.GroupingModifierCube} {
+		if modifier.String() == name {
+			return modifier, nil
+		}
+	}
+	return plan.GroupingModifierNone, ErrUnknownTag.New("grouping modifier", name)
+}
+
+type joinAttrs struct {
+	Op        string      `json:"op"`
+	Filter    *Expression `json:"filter,omitempty"`
+	Comment   string      `json:"comment,omitempty"`
+	ScopeLen  int         `json:"scope_len,omitempty"`
+	UsingCols []string    `json:"using_cols,omitempty"`
+}
+
+// joinType returns the join type with the name given.
+func joinType(name string) (plan.JoinType, error) {
+	for op := plan.JoinTypeUnknown; op <= plan.JoinTypeRightOuterNatural; op++ {
+		if op.String() == name {
+			return op, nil
+		}
+	}
+	return plan.JoinTypeUnknown, fmt.Errorf("unknown join type %q in serialized plan", name)
+}
+
+type sortField struct {
+	Column     *Expression `json:"column"`
+	Descending bool        `json:"descending,omitempty"`
+	NullsLast  bool        `json:"nulls_last,omitempty"`
+}
+
+func encodeSortFields(enc *Encoder, fields sql.SortFields) ([]sortField, error) {
+	encoded := make([]sortField, len(fields))
+	for i, field := range fields {
+		column, err := enc.Expression(field.Column)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = sortField{
+			Column:     column,
+			Descending: field.Order == sql.Descending,
+			NullsLast:  field.NullOrdering == sql.NullsLast,
+		}
+	}
+	return encoded, nil
+}
+
+func decodeSortFields(dec *Decoder, fields []sortField) (sql.SortFields, error) {
+	decoded := make(sql.SortFields, len(fields))
+	for i, field := range fields {
+		column, err := dec.Expression(field.Column)
+		if err != nil {
+			return nil, err
+		}
+		column2, _ := column.(sql.Expression2)
+		decoded[i] = sql.SortField{Column: column, Column2: column2, Order: sql.Ascending, NullOrdering: sql.NullsFirst}
+		if field.Descending {
+			decoded[i].Order = sql.Descending
+		}
+		if field.NullsLast {
+			decoded[i].NullOrdering = sql.NullsLast
+		}
+	}
+	return decoded, nil
+}
+
+// decodeExpression unmarshals the attributes given, which are a single expression, and returns the expression.
+func decodeExpression(dec *Decoder, attrs json.RawMessage) (sql.Expression, error) {
+	var expr *Expression
+	if err := decodeAttrs(attrs, &expr); err != nil {
+		return nil, err
+	}
+	return dec.Expression(expr)
+}
+
+// decodeExpressionList unmarshals the attributes given, which are a list of expressions, and returns the expressions.
+func decodeExpressionList(dec *Decoder, attrs json.RawMessage) ([]sql.Expression, error) {
+	var exprs []*Expression
+	if err := decodeAttrs(attrs, &exprs); err != nil {
+		return nil, err
+	}
+	return dec.Expressions(exprs)
+}
@@ -0,0 +1,426 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package planserde serializes resolved plans to JSON and back, so that plans analyzed once can be persisted across
+// restarts, shipped to other processes to run, or compared in tests.
+//
+// Each type of node and expression is serialized with the codec registered for it under a tag, which identifies the
+// type in serialized plans and must never change once plans using it have been persisted. The codecs of the nodes and
+// expressions of the plans of common queries are registered by this package, and integrators register the codecs of
+// their own types with RegisterNode and RegisterExpression. Serializing a plan with a node or an expression without a
+// codec fails with ErrNodeNotSerializable or ErrExpressionNotSerializable. Functions without a codec are serialized by
+// name, and looked up in the catalog when the plan is deserialized.
+//
+// Tables are serialized by the names of their database and table, along with the filters, projections, sort fields
+// and limits pushed down to them, and are looked up in the catalog when the plan is deserialized. The schemas of the
+// tables must not have changed since the plan was serialized, as the plan isn't analyzed again.
+package planserde
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Version is the version of the format of serialized plans. Plans serialized with other versions can't be
+// deserialized.
+const Version = 1
+
+var (
+	// ErrNodeNotSerializable is returned when serializing a plan with a node whose type has no codec.
+	ErrNodeNotSerializable = errors.NewKind("plan node of type %T can't be serialized")
+	// ErrExpressionNotSerializable is returned when serializing a plan with an expression whose type has no codec.
+	ErrExpressionNotSerializable = errors.NewKind("expression of type %T can't be serialized")
+	// ErrUnknownTag is returned when deserializing a plan with a tag that no codec is registered under.
+	ErrUnknownTag = errors.NewKind("unknown %s tag %q in serialized plan")
+	// ErrUnsupportedVersion is returned when deserializing a plan serialized with another version of the format.
+	ErrUnsupportedVersion = errors.NewKind("unsupported version %d of serialized plan, expected version %d")
+)
+
+// Node is a serialized plan node.
+type Node struct {
+	// Type is the tag of the codec of the node.
+	Type string `json:"type"`
+	// Attrs are the attributes of the node other than its children, as encoded by its codec.
+	Attrs    json.RawMessage `json:"attrs,omitempty"`
+	Children []*Node         `json:"children,omitempty"`
+}
+
+// Expression is a serialized expression.
+type Expression struct {
+	// Type is the tag of the codec of the expression.
+	Type string `json:"type"`
+	// Attrs are the attributes of the expression other than its children, as encoded by its codec.
+	Attrs    json.RawMessage `json:"attrs,omitempty"`
+	Children []*Expression   `json:"children,omitempty"`
+}
+
+// Value is a serialized value of a type, in its SQL representation.
+type Value struct {
+	Type string `json:"type"`
+	// Text is the value, if it's valid UTF-8
+	Text *string `json:"text,omitempty"`
+	// Bytes is the value, if it's not valid UTF-8
+	Bytes []byte `json:"bytes,omitempty"`
+}
+
+// serializedPlan is the document of a serialized plan.
+type serializedPlan struct {
+	Version int   `json:"version"`
+	Plan    *Node `json:"plan"`
+}
+
+// NodeCodec serializes the nodes of a type. The children of nodes are serialized by the Encoder, and deserialized
+// before the node itself.
+type NodeCodec struct {
+	// Encode returns the attributes of the node given, other than its children, which are marshaled to JSON. It may be
+	// nil for nodes without attributes.
+	Encode func(enc *Encoder, n sql.Node) (interface{}, error)
+	// Decode returns a node with the attributes and the children given.
+	Decode func(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error)
+}
+
+// ExpressionCodec serializes the expressions of a type. The children of expressions are serialized by the Encoder,
+// and deserialized before the expression itself.
+type ExpressionCodec struct {
+	// Encode returns the attributes of the expression given, other than its children, which are marshaled to JSON. It
+	// may be nil for expressions without attributes.
+	Encode func(enc *Encoder, e sql.Expression) (interface{}, error)
+	// Decode returns an expression with the attributes and the children given.
+	Decode func(dec *Decoder, attrs json.RawMessage, children []sql.Expression) (sql.Expression, error)
+}
+
+// registry holds the codecs of the types of nodes and expressions, by type and by tag.
+type registry struct {
+	mu          sync.RWMutex
+	nodeTags    map[reflect.Type]string
+	nodes       map[string]NodeCodec
+	exprTags    map[reflect.Type]string
+	expressions map[string]ExpressionCodec
+}
+
+var codecs = &registry{
+	nodeTags:    make(map[reflect.Type]string),
+	nodes:       make(map[string]NodeCodec),
+	exprTags:    make(map[reflect.Type]string),
+	expressions: make(map[string]ExpressionCodec),
+}
+
+// RegisterNode registers the codec given for the nodes of the type of the node given, under the tag given. It panics
+// if the tag is already registered.
+func RegisterNode(tag string, node sql.Node, codec NodeCodec) {
+	codecs.mu.Lock()
+	defer codecs.mu.Unlock()
+	if _, ok := codecs.nodes[tag]; ok {
+		panic(fmt.Sprintf("plan node tag %q is already registered", tag))
+	}
+	codecs.nodeTags[reflect.TypeOf(node)] = tag
+	codecs.nodes[tag] = codec
+}
+
+// RegisterExpression registers the codec given for the expressions of the type of the expression given, under the
+// tag given. It panics if the tag is already registered.
+func RegisterExpression(tag string, expr sql.Expression, codec ExpressionCodec) {
+	codecs.mu.Lock()
+	defer codecs.mu.Unlock()
+	if _, ok := codecs.expressions[tag]; ok {
+		panic(fmt.Sprintf("expression tag %q is already registered", tag))
+	}
+	if expr != nil {
+		codecs.exprTags[reflect.TypeOf(expr)] = tag
+	}
+	codecs.expressions[tag] = codec
+}
+
+// nodeCodec returns the tag and the codec of the node given.
+func (r *registry) nodeCodec(n sql.Node) (string, NodeCodec, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tag, ok := r.nodeTags[reflect.TypeOf(n)]
+	return tag, r.nodes[tag], ok
+}
+
+// expressionCodec returns the tag and the codec of the expression given. Functions without a codec of their own have
+// the codec of functionTag.
+func (r *registry) expressionCodec(e sql.Expression) (string, ExpressionCodec, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tag, ok := r.exprTags[reflect.TypeOf(e)]
+	if !ok {
+		if _, isFunction := e.(sql.FunctionExpression); isFunction {
+			tag, ok = functionTag, true
+		}
+	}
+	return tag, r.expressions[tag], ok
+}
+
+// Marshal serializes the resolved plan given to JSON.
+func Marshal(ctx *sql.Context, n sql.Node) ([]byte, error) {
+	node, err := NewEncoder(ctx).Node(n)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(serializedPlan{Version: Version, Plan: node})
+}
+
+// Unmarshal deserializes a plan serialized with Marshal, whose tables and functions are looked up in the catalog
+// given.
+func Unmarshal(ctx *sql.Context, catalog sql.Catalog, data []byte) (sql.Node, error) {
+	var p serializedPlan
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	if p.Version != Version {
+		return nil, ErrUnsupportedVersion.New(p.Version, Version)
+	}
+	return NewDecoder(ctx, catalog).Node(p.Plan)
+}
+
+// Encoder serializes the nodes and expressions of plans, for the codecs of their types.
+type Encoder struct {
+	ctx *sql.Context
+}
+
+// NewEncoder returns a new Encoder serializing values in the context given.
+func NewEncoder(ctx *sql.Context) *Encoder {
+	return &Encoder{ctx: ctx}
+}
+
+// Node serializes the node given and its children.
+func (e *Encoder) Node(n sql.Node) (*Node, error) {
+	tag, codec, ok := codecs.nodeCodec(n)
+	if !ok {
+		return nil, ErrNodeNotSerializable.New(n)
+	}
+	node := &Node{Type: tag}
+	if codec.Encode != nil {
+		attrs, err := codec.Encode(e, n)
+		if err != nil {
+			return nil, err
+		}
+		if node.Attrs, err = json.Marshal(attrs); err != nil {
+			return nil, err
+		}
+	}
+	for _, child := range n.Children() {
+		encoded, err := e.Node(child)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, encoded)
+	}
+	return node, nil
+}
+
+// Expression serializes the expression given and its children. A nil expression is serialized as nil.
+func (e *Encoder) Expression(expr sql.Expression) (*Expression, error) {
+	if expr == nil {
+		return nil, nil
+	}
+	tag, codec, ok := codecs.expressionCodec(expr)
+	if !ok {
+		return nil, ErrExpressionNotSerializable.New(expr)
+	}
+	encoded := &Expression{Type: tag}
+	if codec.Encode != nil {
+		attrs, err := codec.Encode(e, expr)
+		if err != nil {
+			return nil, err
+		}
+		if encoded.Attrs, err = json.Marshal(attrs); err != nil {
+			return nil, err
+		}
+	}
+	for _, child := range expr.Children() {
+		child, err := e.Expression(child)
+		if err != nil {
+			return nil, err
+		}
+		encoded.Children = append(encoded.Children, child)
+	}
+	return encoded, nil
+}
+
+// Expressions serializes the expressions given.
+func (e *Encoder) Expressions(exprs []sql.Expression) ([]*Expression, error) {
+	encoded := make([]*Expression, len(exprs))
+	for i, expr := range exprs {
+		var err error
+		if encoded[i], err = e.Expression(expr); err != nil {
+			return nil, err
+		}
+	}
+	return encoded, nil
+}
+
+// nullTypeName is the serialized name of the type of NULL literals, which isn't a column type.
+const nullTypeName = "null"
+
+// Type serializes the type given as its column type definition, which includes its collation.
+func (e *Encoder) Type(typ sql.Type) (string, error) {
+	if typ == types.Null {
+		return nullTypeName, nil
+	}
+	name := typ.String()
+	if collated, ok := typ.(interface{ Collation() sql.CollationID }); ok && !strings.Contains(name, " COLLATE ") {
+		name += " COLLATE " + collated.Collation().Name()
+	}
+	return name, nil
+}
+
+// Value serializes the value given of the type given.
+func (e *Encoder) Value(typ sql.Type, v interface{}) (*Value, error) {
+	name, err := e.Type(typ)
+	if err != nil {
+		return nil, err
+	}
+	value := &Value{Type: name}
+	if v == nil {
+		return value, nil
+	}
+	sqlValue, err := typ.SQL(e.ctx, nil, v)
+	if err != nil {
+		return nil, err
+	}
+	if raw := sqlValue.Raw(); utf8.Valid(raw) {
+		text := string(raw)
+		value.Text = &text
+	} else {
+		value.Bytes = raw
+	}
+	return value, nil
+}
+
+// Decoder deserializes the nodes and expressions of plans, for the codecs of their types.
+type Decoder struct {
+	ctx     *sql.Context
+	catalog sql.Catalog
+}
+
+// NewDecoder returns a new Decoder deserializing plans in the context given, whose tables and functions are looked up
+// in the catalog given.
+func NewDecoder(ctx *sql.Context, catalog sql.Catalog) *Decoder {
+	return &Decoder{ctx: ctx, catalog: catalog}
+}
+
+// Context returns the context plans are deserialized in.
+func (d *Decoder) Context() *sql.Context {
+	return d.ctx
+}
+
+// Catalog returns the catalog the tables and functions of plans are looked up in.
+func (d *Decoder) Catalog() sql.Catalog {
+	return d.catalog
+}
+
+// Node deserializes the node given and its children.
+func (d *Decoder) Node(n *Node) (sql.Node, error) {
+	if n == nil {
+		return nil, fmt.Errorf("missing plan node in serialized plan")
+	}
+	codecs.mu.RLock()
+	codec, ok := codecs.nodes[n.Type]
+	codecs.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownTag.New("plan node", n.Type)
+	}
+	children := make([]sql.Node, len(n.Children))
+	for i, child := range n.Children {
+		var err error
+		if children[i], err = d.Node(child); err != nil {
+			return nil, err
+		}
+	}
+	return codec.Decode(d, n.Attrs, children)
+}
+
+// Expression deserializes the expression given and its children. A nil expression is deserialized as nil.
+func (d *Decoder) Expression(e *Expression) (sql.Expression, error) {
+	if e == nil {
+		return nil, nil
+	}
+	codecs.mu.RLock()
+	codec, ok := codecs.expressions[e.Type]
+	codecs.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownTag.New("expression", e.Type)
+	}
+	children := make([]sql.Expression, len(e.Children))
+	for i, child := range e.Children {
+		var err error
+		if children[i], err = d.Expression(child); err != nil {
+			return nil, err
+		}
+	}
+	return codec.Decode(d, e.Attrs, children)
+}
+
+// Expressions deserializes the expressions given.
+func (d *Decoder) Expressions(exprs []*Expression) ([]sql.Expression, error) {
+	decoded := make([]sql.Expression, len(exprs))
+	for i, expr := range exprs {
+		var err error
+		if decoded[i], err = d.Expression(expr); err != nil {
+			return nil, err
+		}
+	}
+	return decoded, nil
+}
+
+// Type deserializes the type serialized as the name given.
+func (d *Decoder) Type(name string) (sql.Type, error) {
+	if name == nullTypeName {
+		return types.Null, nil
+	}
+	return parse.ParseColumnTypeString(d.ctx, name)
+}
+
+// Value deserializes the value given, returning its type and the value.
+func (d *Decoder) Value(v *Value) (sql.Type, interface{}, error) {
+	typ, err := d.Type(v.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+	var raw interface{}
+	switch {
+	case v.Text != nil:
+		raw = *v.Text
+	case v.Bytes != nil:
+		raw = string(v.Bytes)
+	default:
+		return typ, nil, nil
+	}
+	value, err := typ.Convert(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	return typ, value, nil
+}
+
+// decodeAttrs unmarshals the attributes given into the value given.
+func decodeAttrs(attrs json.RawMessage, v interface{}) error {
+	if len(attrs) == 0 {
+		return nil
+	}
+	return json.Unmarshal(attrs, v)
+}
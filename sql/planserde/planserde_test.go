@@ -0,0 +1,102 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planserde_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planserde"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/go-mysql-server/sql/variables"
+)
+
+func init() {
+	variables.InitSystemVariables()
+}
+
+func TestRoundTrip(t *testing.T) {
+	ctx, a := setup(t)
+
+	queries := []string{
+		"select 1 + 2, 'a' like 'b%', cast(3 as char), null",
+		"select i, s from mytable where i > 1 and s <> 'b' order by i desc limit 2",
+		"select i * 2 as x from mytable where s in ('a', 'c') or i between 2 and 3",
+		"select s, count(*) from mytable group by s having count(*) > 0 order by s",
+		"select distinct upper(s) from mytable",
+		"select s, count(*), grouping(s) from mytable group by s with rollup",
+		"select a.i, b.s from mytable a join mytable b on a.i < b.i where not a.s is null",
+	}
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			n := analyze(t, ctx, a, query)
+			data, err := planserde.Marshal(ctx, n)
+			require.NoError(t, err)
+
+			decoded, err := planserde.Unmarshal(ctx, a.Catalog, data)
+			require.NoError(t, err)
+			require.Equal(t, sql.DebugString(n), sql.DebugString(decoded))
+
+			expected, err := sql.NodeToRows(ctx, n)
+			require.NoError(t, err)
+			actual, err := sql.NodeToRows(ctx, decoded)
+			require.NoError(t, err)
+			require.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestNotSerializable(t *testing.T) {
+	ctx, a := setup(t)
+
+	_, err := planserde.Marshal(ctx, plan.NewShowProfiles())
+	require.True(t, planserde.ErrNodeNotSerializable.Is(err))
+
+	_, err = planserde.Unmarshal(ctx, a.Catalog, []byte(`{"version":1,"plan":{"type":"unknown"}}`))
+	require.True(t, planserde.ErrUnknownTag.Is(err))
+
+	_, err = planserde.Unmarshal(ctx, a.Catalog, []byte(`{"version":2,"plan":{"type":"project"}}`))
+	require.True(t, planserde.ErrUnsupportedVersion.Is(err))
+}
+
+func setup(t *testing.T) (*sql.Context, *analyzer.Analyzer) {
+	db := memory.NewDatabase("mydb")
+	table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "mytable", PrimaryKey: true},
+		{Name: "s", Type: types.Text, Source: "mytable", Nullable: true},
+	}), nil)
+	db.AddTable("mytable", table)
+
+	ctx := sql.NewEmptyContext()
+	ctx.SetCurrentDatabase("mydb")
+	for _, row := range []sql.Row{{int64(1), "a"}, {int64(2), "b"}, {int64(3), "c"}, {int64(4), nil}} {
+		require.NoError(t, table.Insert(ctx, row))
+	}
+	return ctx, analyzer.NewDefault(sql.NewDatabaseProvider(db))
+}
+
+func analyze(t *testing.T, ctx *sql.Context, a *analyzer.Analyzer, query string) sql.Node {
+	parsed, err := parse.Parse(ctx, query)
+	require.NoError(t, err)
+	n, err := a.Analyze(ctx, parsed, nil)
+	require.NoError(t, err)
+	return n
+}
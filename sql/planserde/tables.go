@@ -0,0 +1,144 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planserde
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// tableAttrs are the attributes of a resolved table, which is looked up by name, and to which the filters,
+// projections, sort fields and limit pushed down to it are applied again.
+type tableAttrs struct {
+	Dual     bool   `json:"dual,omitempty"`
+	Database string `json:"database,omitempty"`
+	Table    string `json:"table,omitempty"`
+	// Projections are the columns projected, if any. An empty projection is valid, and is kept apart from none.
+	Projections *[]string     `json:"projections,omitempty"`
+	Filters     []*Expression `json:"filters,omitempty"`
+	SortFields  []sortField   `json:"sort_fields,omitempty"`
+	Limit       *tableLimit   `json:"limit,omitempty"`
+}
+
+type tableLimit struct {
+	Limit  int64 `json:"limit"`
+	Offset int64 `json:"offset"`
+}
+
+func encodeResolvedTable(enc *Encoder, n sql.Node) (interface{}, error) {
+	rt := n.(*plan.ResolvedTable)
+	if rt.AsOf != nil {
+		return nil, fmt.Errorf("table %s read as of a revision can't be serialized", rt.Name())
+	}
+
+	table := rt.Table
+	// The tables tracked by the process of a query are wrapped by the analyzer, which wraps them again when the plan
+	// is run by an engine
+	switch t := table.(type) {
+	case *plan.ProcessTable:
+		table = t.Underlying()
+	case *plan.ProcessIndexableTable:
+		table = t.Underlying()
+	}
+	if _, ok := table.(sql.TableWrapper); ok {
+		return nil, fmt.Errorf("table %s wrapped by %T can't be serialized", table.Name(), table)
+	}
+	if plan.IsDualTable(table) {
+		return tableAttrs{Dual: true}, nil
+	}
+
+	attrs := tableAttrs{Database: rt.Database.Name(), Table: table.Name()}
+	if projected, ok := table.(sql.ProjectedTable); ok {
+		if projections := projected.Projections(); projections != nil {
+			attrs.Projections = &projections
+		}
+	}
+	if filtered, ok := table.(sql.FilteredTable); ok {
+		filters, err := enc.Expressions(filtered.Filters())
+		if err != nil {
+			return nil, err
+		}
+		attrs.Filters = filters
+	}
+	if sorted, ok := table.(sql.SortedTable); ok {
+		fields, err := encodeSortFields(enc, sorted.SortFields())
+		if err != nil {
+			return nil, err
+		}
+		attrs.SortFields = fields
+	}
+	if limited, ok := table.(sql.LimitedTable); ok {
+		if limit, offset, ok := limited.Limit(); ok {
+			attrs.Limit = &tableLimit{Limit: limit, Offset: offset}
+		}
+	}
+	return attrs, nil
+}
+
+func decodeResolvedTable(dec *Decoder, attrs json.RawMessage, children []sql.Node) (sql.Node, error) {
+	var a tableAttrs
+	if err := decodeAttrs(attrs, &a); err != nil {
+		return nil, err
+	}
+	if a.Dual {
+		return plan.NewResolvedDualTable(), nil
+	}
+
+	ctx := dec.Context()
+	table, db, err := dec.Catalog().Table(ctx, a.Database, a.Table)
+	if err != nil {
+		return nil, err
+	}
+	if len(a.Filters) > 0 {
+		filtered, ok := table.(sql.FilteredTable)
+		if !ok {
+			return nil, fmt.Errorf("table %s of serialized plan can't be filtered", a.Table)
+		}
+		filters, err := dec.Expressions(a.Filters)
+		if err != nil {
+			return nil, err
+		}
+		table = filtered.WithFilters(ctx, filters)
+	}
+	if a.Projections != nil {
+		projected, ok := table.(sql.ProjectedTable)
+		if !ok {
+			return nil, fmt.Errorf("table %s of serialized plan can't be projected", a.Table)
+		}
+		table = projected.WithProjections(*a.Projections)
+	}
+	if len(a.SortFields) > 0 {
+		sorted, ok := table.(sql.SortedTable)
+		if !ok {
+			return nil, fmt.Errorf("table %s of serialized plan can't be sorted", a.Table)
+		}
+		fields, err := decodeSortFields(dec, a.SortFields)
+		if err != nil {
+			return nil, err
+		}
+		table = sorted.WithSortFields(fields)
+	}
+	if a.Limit != nil {
+		limited, ok := table.(sql.LimitedTable)
+		if !ok {
+			return nil, fmt.Errorf("table %s of serialized plan can't be limited", a.Table)
+		}
+		table = limited.WithLimit(a.Limit.Limit, a.Limit.Offset)
+	}
+	return plan.NewResolvedTable(table, db, nil), nil
+}
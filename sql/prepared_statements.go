@@ -0,0 +1,34 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// PreparedStatementsService is the key of the named prepared statements of an engine in its service registry, which
+// the PREPARE, EXECUTE and DEALLOCATE PREPARE statements use when they're run, such as in stored procedures. The
+// engine registers its prepared statements with this key when it's created.
+var PreparedStatementsService = NewServiceKey[PreparedStatements]("prepared statements")
+
+// PreparedStatements manages the named prepared statements of the sessions of an engine.
+type PreparedStatements interface {
+	// Prepare parses and prepares the statement given as the named prepared statement of the session of the context
+	// given, replacing the prepared statement of the same name if there's one.
+	Prepare(ctx *Context, name string, query string) error
+	// Execute analyzes the named prepared statement of the session of the context given, with the values given bound
+	// to its parameters in order, and returns the analyzed statement along with an iterator of its result. Prepared
+	// statements are prepared again if the objects they refer to have changed since they were prepared.
+	Execute(ctx *Context, name string, bindVars []Expression) (Node, RowIter, error)
+	// Deallocate removes the named prepared statement of the session of the context given. It returns
+	// ErrUnknownPreparedStatement if there's no prepared statement of that name.
+	Deallocate(ctx *Context, name string) error
+}
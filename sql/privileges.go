@@ -50,6 +50,17 @@ type PrivilegedOperationChecker interface {
 	UserHasPrivileges(ctx *Context, operations ...PrivilegedOperation) bool
 }
 
+// UserPrivilegedOperationChecker is a PrivilegedOperationChecker that is also able to check the privileges of users
+// other than the one pulled from the context. This is used to check privileges in the security context of an object's
+// definer, such as a view defined with SQL SECURITY DEFINER.
+type UserPrivilegedOperationChecker interface {
+	PrivilegedOperationChecker
+	// ForUser returns a PrivilegedOperationChecker that checks the privileges of the given user, along with all of
+	// their granted roles, rather than the privileges of the user in the context. Returns false if the user does not
+	// exist.
+	ForUser(ctx *Context, user string, host string) (PrivilegedOperationChecker, bool)
+}
+
 // PrivilegeSet is a set containing privileges. Integrators should not implement this interface.
 type PrivilegeSet interface {
 	// Has returns whether the given global privilege(s) exists.
@@ -66,6 +66,13 @@ type ProcessList interface {
 	// RemovePartitionProgress removes an existing partition tracking progress from the
 	// process with the given pid, if it exists.
 	RemovePartitionProgress(pid uint64, tableName, partitionName string)
+
+	// SetStage sets the stage of the process with the given pid, which does the given total units of work, or -1
+	// units if its total is unknown. An empty name clears the stage of the process.
+	SetStage(pid uint64, name, unit string, total int64)
+
+	// UpdateStageProgress adds delta units to the work done in the stage of the process with the given pid.
+	UpdateStageProgress(pid uint64, delta int64)
 }
 
 type ProcessCommand string
@@ -93,7 +100,9 @@ type Process struct {
 	QueryPid uint64
 	Query    string
 	Progress map[string]TableProgress
-	Kill     context.CancelFunc
+	// Stage is the progress of the long-running stage the query is in, if any
+	Stage StageProgress
+	Kill  context.CancelFunc
 }
 
 // Done needs to be called when this process has finished.
@@ -148,6 +157,25 @@ func (p PartitionProgress) String() string {
 	return fmt.Sprintf("%s (%d/%s rows)", p.Name, p.Done, p.totalString())
 }
 
+// StageProgress keeps track of the progress of a long-running stage of a query, such as the copy of the rows of a
+// table rewritten by an ALTER TABLE, in the unit of work of the stage.
+type StageProgress struct {
+	Progress
+	Unit string
+}
+
+func (p StageProgress) String() string {
+	if p.Total <= 0 {
+		return fmt.Sprintf("%s (%d/? %s)", p.Name, p.Done, p.Unit)
+	}
+	// The total may be an estimate, which is exceeded by the work done
+	percent := 100 * float64(p.Done) / float64(p.Total)
+	if percent > 100 {
+		percent = 100
+	}
+	return fmt.Sprintf("%s (%d/%d %s, %.2f%%)", p.Name, p.Done, p.Total, p.Unit, percent)
+}
+
 // EmptyProcessList is a no-op implementation of ProcessList suitable for use in tests or other installations that
 // don't require a process list
 type EmptyProcessList struct{}
@@ -177,3 +205,5 @@ func (e EmptyProcessList) AddPartitionProgress(pid uint64, tableName, partitionN
 }
 func (e EmptyProcessList) RemoveTableProgress(pid uint64, name string)                         {}
 func (e EmptyProcessList) RemovePartitionProgress(pid uint64, tableName, partitionName string) {}
+func (e EmptyProcessList) SetStage(pid uint64, name, unit string, total int64)                 {}
+func (e EmptyProcessList) UpdateStageProgress(pid uint64, delta int64)                         {}
@@ -0,0 +1,154 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sync"
+	"time"
+)
+
+// QueryProfilesService is the key of the profiles of the queries of all sessions in the service registry of an engine,
+// which are recorded while the profiling system variable of a session is enabled, and reported by SHOW PROFILES, SHOW
+// PROFILE and the information_schema.profiling table.
+var QueryProfilesService = NewServiceKey[*QueryProfiles]("query profiles")
+
+// The stages of a query recorded by its profile, in the order they run.
+const (
+	ProfileStageParsing     = "parsing"
+	ProfileStageAnalyzing   = "analyzing"
+	ProfileStageOptimizing  = "optimizing"
+	ProfileStageExecuting   = "executing"
+	ProfileStageSendingData = "sending data"
+)
+
+// ProfileStage is the time spent by a query in one of its stages.
+type ProfileStage struct {
+	Name     string
+	Duration time.Duration
+}
+
+// QueryProfile is the profile of a query, the time it spent in each of its stages.
+type QueryProfile struct {
+	// ID is the number of the query in the profiles of its session, starting at 1.
+	ID     int
+	Query  string
+	Stages []ProfileStage
+}
+
+// Duration returns the time spent by the query in all of its stages.
+func (p QueryProfile) Duration() time.Duration {
+	var d time.Duration
+	for _, stage := range p.Stages {
+		d += stage.Duration
+	}
+	return d
+}
+
+// QueryProfiler records the stages of a query as it runs. A query's profiler is set in its context with
+// WithQueryProfiler, and the engine and the analyzer mark the start of each stage with Stage. The methods of a nil
+// profiler do nothing, so stages are marked whether or not the query is profiled.
+type QueryProfiler struct {
+	query  string
+	stages []ProfileStage
+	stage  string
+	start  time.Time
+}
+
+// NewQueryProfiler returns a profiler of the query given, whose first stage is parsing.
+func NewQueryProfiler(query string) *QueryProfiler {
+	return &QueryProfiler{query: query, stage: ProfileStageParsing, start: time.Now()}
+}
+
+// Stage ends the current stage of the query, and starts the stage with the name given.
+func (p *QueryProfiler) Stage(name string) {
+	if p == nil || p.stage == name {
+		return
+	}
+	now := time.Now()
+	p.stages = append(p.stages, ProfileStage{Name: p.stage, Duration: now.Sub(p.start)})
+	p.stage, p.start = name, now
+}
+
+// Finish ends the current stage of the query and returns its profile, without an ID.
+func (p *QueryProfiler) Finish() QueryProfile {
+	stages := append(p.stages, ProfileStage{Name: p.stage, Duration: time.Since(p.start)})
+	return QueryProfile{Query: p.query, Stages: stages}
+}
+
+// WithQueryProfiler sets the profiler of the query of the context, or unsets it if it's nil.
+func WithQueryProfiler(p *QueryProfiler) ContextOption {
+	return func(ctx *Context) {
+		ctx.profiler = p
+	}
+}
+
+// QueryProfiler returns the profiler of the query of the context, or nil if it's not profiled.
+func (c *Context) QueryProfiler() *QueryProfiler {
+	return c.profiler
+}
+
+// QueryProfiles keeps the profiles of the latest queries of each session, up to the profiling_history_size of the
+// session, until the session ends.
+type QueryProfiles struct {
+	mu       sync.Mutex
+	sessions map[uint32]*sessionProfiles
+}
+
+// sessionProfiles are the profiles of the latest queries of a session.
+type sessionProfiles struct {
+	profiles []QueryProfile
+	lastID   int
+}
+
+// NewQueryProfiles returns a new, empty QueryProfiles.
+func NewQueryProfiles() *QueryProfiles {
+	return &QueryProfiles{sessions: make(map[uint32]*sessionProfiles)}
+}
+
+// Record records the profile given of a query of the session with the connection ID given, assigning it the next ID
+// of the session. The oldest profiles of the session are dropped so that it keeps historySize profiles at most.
+func (q *QueryProfiles) Record(connID uint32, historySize int, profile QueryProfile) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	s, ok := q.sessions[connID]
+	if !ok {
+		s = &sessionProfiles{}
+		q.sessions[connID] = s
+	}
+	s.lastID++
+	profile.ID = s.lastID
+	s.profiles = append(s.profiles, profile)
+	if len(s.profiles) > historySize {
+		s.profiles = append([]QueryProfile(nil), s.profiles[len(s.profiles)-historySize:]...)
+	}
+}
+
+// Profiles returns the profiles kept of the session with the connection ID given, oldest first.
+func (q *QueryProfiles) Profiles(connID uint32) []QueryProfile {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	s, ok := q.sessions[connID]
+	if !ok {
+		return nil
+	}
+	return append([]QueryProfile(nil), s.profiles...)
+}
+
+// EndSession drops the profiles of the session with the connection ID given, as the session is being closed.
+func (q *QueryProfiles) EndSession(connID uint32) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.sessions, connID)
+}
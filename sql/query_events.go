@@ -0,0 +1,101 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import "time"
+
+// QueryEventType is the stage of the execution of a query that a QueryEvent reports.
+type QueryEventType byte
+
+const (
+	// QueryParsed is emitted once a query has been parsed and rewritten. Its node is the parsed statement.
+	QueryParsed QueryEventType = iota
+	// QueryAnalyzed is emitted once a query has been analyzed. Its node is the analyzed plan.
+	QueryAnalyzed
+	// QueryExecuteBegin is emitted right before the row iterator of a query is built. Its node is the analyzed plan.
+	QueryExecuteBegin
+	// QueryRowsRead is emitted every time the number of rows returned by a query reaches a multiple of the engine's
+	// row event interval.
+	QueryRowsRead
+	// QueryCompleted is emitted when the row iterator of a query is closed without an error.
+	QueryCompleted
+	// QueryFailed is emitted when a query fails, before or during its execution. Its error is the error of the query.
+	QueryFailed
+)
+
+func (t QueryEventType) String() string {
+	switch t {
+	case QueryParsed:
+		return "parsed"
+	case QueryAnalyzed:
+		return "analyzed"
+	case QueryExecuteBegin:
+		return "execute begin"
+	case QueryRowsRead:
+		return "rows read"
+	case QueryCompleted:
+		return "completed"
+	case QueryFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// CanVeto returns whether a listener returning an error for events of this type aborts the query, which is the case
+// for the events emitted before its execution begins and for row milestones.
+func (t QueryEventType) CanVeto() bool {
+	return t != QueryCompleted && t != QueryFailed
+}
+
+// QueryEvent is an event of the lifecycle of a query, reported to the engine's query listeners.
+type QueryEvent struct {
+	Type QueryEventType
+	// Query is the text of the query, as it was received
+	Query string
+	// Node is the parsed statement or the analyzed plan of the query, depending on the type of the event
+	Node Node
+	// Rows is the number of rows the query has returned so far
+	Rows int64
+	// Err is the error of the query, for QueryFailed events
+	Err error
+	// Elapsed is the time since the query started
+	Elapsed time.Duration
+}
+
+// QueryListener is notified of the lifecycle events of the queries of an engine, for auditing, caching or metrics.
+//
+// Listeners are called in the order they were registered, on the goroutine running or reading the query, so they
+// should return quickly. The events of a query are emitted in order: QueryParsed, QueryAnalyzed, QueryExecuteBegin,
+// then QueryRowsRead any number of times, and exactly one of QueryCompleted or QueryFailed, which ends every query,
+// including queries failing before they're parsed. QueryAnalyzed and QueryExecuteBegin are emitted again when a
+// statement is retried after a serialization failure.
+//
+// A listener vetoes a query by returning an error for an event whose type CanVeto: the query fails with that error,
+// the remaining listeners aren't notified of the event, and QueryFailed is emitted. Errors returned for the other
+// events are logged.
+type QueryListener interface {
+	OnQueryEvent(ctx *Context, event QueryEvent) error
+}
+
+// QueryListenerFunc is a function implementing QueryListener.
+type QueryListenerFunc func(ctx *Context, event QueryEvent) error
+
+var _ QueryListener = QueryListenerFunc(nil)
+
+// OnQueryEvent implements the QueryListener interface.
+func (f QueryListenerFunc) OnQueryEvent(ctx *Context, event QueryEvent) error {
+	return f(ctx, event)
+}
@@ -0,0 +1,25 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// PreParseRewriter rewrites the text of a statement before it's parsed, like MySQL's pre-parse query rewrite plugins.
+// It returns the statement given if it doesn't rewrite it. Integrators register pre-parse rewriters with the engine to
+// change problematic statements sent by applications they can't change, such as the statements of an ORM.
+type PreParseRewriter func(ctx *Context, query string) (string, error)
+
+// PostParseRewriter rewrites a parsed statement before it's analyzed, like MySQL's post-parse query rewrite plugins.
+// It's given the text of the statement as it was received, before any pre-parse rewriters rewrote it, and returns the
+// node given if it doesn't rewrite it.
+type PostParseRewriter func(ctx *Context, query string, parsed Node) (Node, error)
@@ -0,0 +1,207 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planserde"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// Exchange is a node running its child on workers, each of which scans some of the partitions of the table of the
+// child, and merging the rows they return in no particular order.
+type Exchange struct {
+	plan.UnaryNode
+	Workers []Transport
+}
+
+var _ sql.Node = (*Exchange)(nil)
+var _ sql.CollationCoercible = (*Exchange)(nil)
+
+// NewExchange returns a new Exchange node running the child given on the workers given.
+func NewExchange(workers []Transport, child sql.Node) *Exchange {
+	return &Exchange{UnaryNode: plan.UnaryNode{Child: child}, Workers: workers}
+}
+
+// Distribute replaces the Exchange nodes of the analyzed plan given, which run their children in parallel locally,
+// with Exchange nodes running them on the workers given. Exchange nodes whose children can't be serialized are kept.
+func Distribute(ctx *sql.Context, n sql.Node, workers ...Transport) (sql.Node, error) {
+	if len(workers) == 0 {
+		return n, nil
+	}
+	n, _, err := transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		exchange, ok := n.(*plan.Exchange)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+		if _, err := planserde.Marshal(ctx, exchange.Child); err != nil {
+			return n, transform.SameTree, nil
+		}
+		return NewExchange(workers, exchange.Child), transform.NewTree, nil
+	})
+	return n, err
+}
+
+// RowIter implements the sql.Node interface.
+func (e *Exchange) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	table := findTable(e.Child)
+	if table == nil {
+		return nil, plan.ErrNoPartitionable.New()
+	}
+	assigned, err := e.assignPartitions(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	data, err := planserde.Marshal(ctx, e.Child)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := ctx.NewSubContext()
+	rows := make(chan sql.Row, len(e.Workers)*16)
+	iter := &exchangeIter{rows: rows, cancel: cancel}
+	eg, egCtx := ctx.NewErrgroup()
+	for i, worker := range e.Workers {
+		if len(assigned[i]) == 0 {
+			continue
+		}
+		worker, req := worker, &Request{Plan: data, Partitions: assigned[i]}
+		eg.Go(func() error {
+			workerIter, err := worker.Execute(egCtx, req)
+			if err != nil {
+				return err
+			}
+			return sendRows(egCtx, workerIter, rows)
+		})
+	}
+	go func() {
+		iter.err = eg.Wait()
+		close(rows)
+	}()
+	return iter, nil
+}
+
+// assignPartitions returns the keys of the partitions of the table given scanned by each worker, which are assigned
+// in turn.
+func (e *Exchange) assignPartitions(ctx *sql.Context, table sql.Table) ([][][]byte, error) {
+	iter, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close(ctx)
+
+	assigned := make([][][]byte, len(e.Workers))
+	for i := 0; ; i++ {
+		partition, err := iter.Next(ctx)
+		if err == io.EOF {
+			return assigned, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		worker := i % len(e.Workers)
+		assigned[worker] = append(assigned[worker], partition.Key())
+	}
+}
+
+func (e *Exchange) String() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("RemoteExchange")
+	_ = p.WriteChildren(e.Child.String())
+	return p.String()
+}
+
+func (e *Exchange) DebugString() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("RemoteExchange(workers=%d)", len(e.Workers))
+	_ = p.WriteChildren(sql.DebugString(e.Child))
+	return p.String()
+}
+
+// WithChildren implements the sql.Node interface.
+func (e *Exchange) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
+	}
+	return NewExchange(e.Workers, children[0]), nil
+}
+
+// CheckPrivileges implements the sql.Node interface.
+func (e *Exchange) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return e.Child.CheckPrivileges(ctx, opChecker)
+}
+
+// CollationCoercibility implements the sql.CollationCoercible interface.
+func (e *Exchange) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.GetCoercibility(ctx, e.Child)
+}
+
+// sendRows sends the rows of the iterator given on the channel given, and closes the iterator.
+func sendRows(ctx *sql.Context, iter sql.RowIter, rows chan<- sql.Row) (err error) {
+	defer func() {
+		if cerr := iter.Close(ctx); err == nil {
+			err = cerr
+		}
+	}()
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case rows <- row:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// exchangeIter merges the rows returned by the workers of an Exchange node. Its error is set before its rows are
+// closed, once all the workers are done.
+type exchangeIter struct {
+	rows   <-chan sql.Row
+	err    error
+	cancel context.CancelFunc
+}
+
+var _ sql.RowIter = (*exchangeIter)(nil)
+
+func (i *exchangeIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, ok := <-i.rows
+	if !ok {
+		if i.err != nil {
+			return nil, i.err
+		}
+		return nil, io.EOF
+	}
+	return row, nil
+}
+
+func (i *exchangeIter) Close(ctx *sql.Context) error {
+	i.cancel()
+	for range i.rows {
+	}
+	if i.err == context.Canceled {
+		return nil
+	}
+	return i.err
+}
@@ -0,0 +1,189 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/planserde"
+)
+
+// message is a line of the response of a worker served over HTTP, which streams the rows of a request as JSON lines
+// and ends with a message that is done, or has an error.
+type message struct {
+	Row   []*planserde.Value `json:"row,omitempty"`
+	Done  bool               `json:"done,omitempty"`
+	Error string             `json:"error,omitempty"`
+}
+
+// Handler serves the requests posted to it with a Worker.
+type Handler struct {
+	worker     *Worker
+	newContext func(r *http.Request) (*sql.Context, error)
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+// NewHandler returns a new Handler running requests on the worker given, in the contexts returned by the function
+// given for their HTTP requests.
+func NewHandler(worker *Worker, newContext func(r *http.Request) (*sql.Context, error)) *Handler {
+	return &Handler{worker: worker, newContext: newContext}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "worker requests must be posted", http.StatusMethodNotAllowed)
+		return
+	}
+	var req Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx, err := h.newContext(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	n, err := h.worker.plan(ctx, &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	iter, err := n.RowIter(ctx, nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	enc := json.NewEncoder(w)
+	if err := writeRows(ctx, n.Schema(), iter, enc); err != nil {
+		_ = enc.Encode(message{Error: err.Error()})
+		return
+	}
+	_ = enc.Encode(message{Done: true})
+}
+
+// writeRows writes the rows of the iterator given as messages, and closes the iterator.
+func writeRows(ctx *sql.Context, sch sql.Schema, iter sql.RowIter, enc *json.Encoder) (err error) {
+	defer func() {
+		if cerr := iter.Close(ctx); err == nil {
+			err = cerr
+		}
+	}()
+	values := planserde.NewEncoder(ctx)
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		msg := message{Row: make([]*planserde.Value, len(row))}
+		for i, v := range row {
+			if msg.Row[i], err = values.Value(sch[i].Type, v); err != nil {
+				return err
+			}
+		}
+		if err := enc.Encode(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// HTTPTransport sends requests to a worker served by a Handler at its URL.
+type HTTPTransport struct {
+	URL string
+	// Client is the client sending requests, or nil for http.DefaultClient.
+	Client *http.Client
+}
+
+var _ Transport = (*HTTPTransport)(nil)
+
+// Execute implements the Transport interface.
+func (t *HTTPTransport) Execute(ctx *sql.Context, req *Request) (sql.RowIter, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, t.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	client := t.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("worker %s: %s", t.URL, strings.TrimSpace(string(msg)))
+	}
+	return &httpRowIter{url: t.URL, body: resp.Body, dec: json.NewDecoder(resp.Body), values: planserde.NewDecoder(ctx, nil)}, nil
+}
+
+// httpRowIter reads the rows of a response streamed by a worker.
+type httpRowIter struct {
+	url    string
+	body   io.ReadCloser
+	dec    *json.Decoder
+	values *planserde.Decoder
+}
+
+var _ sql.RowIter = (*httpRowIter)(nil)
+
+func (i *httpRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	var msg message
+	if err := i.dec.Decode(&msg); err != nil {
+		if err == io.EOF {
+			// The response must end with a done message
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if msg.Error != "" {
+		return nil, fmt.Errorf("worker %s: %s", i.url, msg.Error)
+	}
+	if msg.Done {
+		return nil, io.EOF
+	}
+	row := make(sql.Row, len(msg.Row))
+	for j, v := range msg.Row {
+		var err error
+		if _, row[j], err = i.values.Value(v); err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+func (i *httpRowIter) Close(ctx *sql.Context) error {
+	return i.body.Close()
+}
@@ -0,0 +1,181 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package remote runs the partitions of a table scan on worker processes hosting the same storage as the
+// coordinator, which merges the rows they return. Plans are shipped to workers serialized with package planserde.
+//
+// The subtrees run remotely are those the analyzer runs in parallel under an Exchange node: a table scan, with its
+// filters and projections. Distribute replaces the Exchange nodes of an analyzed plan with remote ones, so that the
+// nodes above them, such as aggregations, run on the coordinator over the merged rows.
+package remote
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	errors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/planserde"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// ErrNoTable is returned when the plan of a request has no table to scan.
+var ErrNoTable = errors.NewKind("no table found in remote plan")
+
+// ErrPartitionNotFound is returned when a worker doesn't have a partition of a request.
+var ErrPartitionNotFound = errors.NewKind("partition %q of table %s not found by worker")
+
+// Request is a request to a worker to run a plan over some partitions of the table it scans.
+type Request struct {
+	// Plan is the plan to run, serialized with planserde.Marshal.
+	Plan json.RawMessage `json:"plan"`
+	// Partitions are the keys of the partitions of the table to scan.
+	Partitions [][]byte `json:"partitions"`
+}
+
+// Transport sends requests to a worker, returning the rows of their plans.
+type Transport interface {
+	Execute(ctx *sql.Context, req *Request) (sql.RowIter, error)
+}
+
+// Worker runs requests on the tables of its catalog. It may be used as the Transport of a worker in the same process.
+type Worker struct {
+	catalog sql.Catalog
+}
+
+var _ Transport = (*Worker)(nil)
+
+// NewWorker returns a new Worker looking up the tables and functions of plans in the catalog given.
+func NewWorker(catalog sql.Catalog) *Worker {
+	return &Worker{catalog: catalog}
+}
+
+// Execute implements the Transport interface.
+func (w *Worker) Execute(ctx *sql.Context, req *Request) (sql.RowIter, error) {
+	n, err := w.plan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return n.RowIter(ctx, nil)
+}
+
+// plan returns the plan of the request given, scanning only the partitions of the request.
+func (w *Worker) plan(ctx *sql.Context, req *Request) (sql.Node, error) {
+	n, err := planserde.Unmarshal(ctx, w.catalog, req.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	table := findTable(n)
+	if table == nil {
+		return nil, ErrNoTable.New()
+	}
+	partitions, err := lookupPartitions(ctx, table, req.Partitions)
+	if err != nil {
+		return nil, err
+	}
+
+	n, _, err = transform.Node(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		if t, ok := n.(sql.Table); ok {
+			return &partitionsScan{table: t, partitions: partitions}, transform.NewTree, nil
+		}
+		return n, transform.SameTree, nil
+	})
+	return n, err
+}
+
+// findTable returns the table scanned by the plan given, or nil if it has none.
+func findTable(n sql.Node) sql.Table {
+	var table sql.Table
+	transform.Inspect(n, func(n sql.Node) bool {
+		if t, ok := n.(sql.Table); ok {
+			table = t
+			return false
+		}
+		return true
+	})
+	return table
+}
+
+// lookupPartitions returns the partitions of the table given with the keys given, in the order of the keys.
+func lookupPartitions(ctx *sql.Context, table sql.Table, keys [][]byte) ([]sql.Partition, error) {
+	iter, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close(ctx)
+
+	found := make([]sql.Partition, len(keys))
+	remaining := len(keys)
+	for remaining > 0 {
+		partition, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i, key := range keys {
+			if found[i] == nil && bytes.Equal(partition.Key(), key) {
+				found[i] = partition
+				remaining--
+			}
+		}
+	}
+	for i, partition := range found {
+		if partition == nil {
+			return nil, ErrPartitionNotFound.New(string(keys[i]), table.Name())
+		}
+	}
+	return found, nil
+}
+
+// partitionsScan is a node scanning the rows of some partitions of a table.
+type partitionsScan struct {
+	table      sql.Table
+	partitions []sql.Partition
+}
+
+var _ sql.Node = (*partitionsScan)(nil)
+
+func (p *partitionsScan) String() string {
+	return "RemotePartitions(" + p.table.Name() + ")"
+}
+
+func (p *partitionsScan) Resolved() bool { return true }
+
+func (p *partitionsScan) Children() []sql.Node { return nil }
+
+func (p *partitionsScan) Schema() sql.Schema {
+	return p.table.Schema()
+}
+
+func (p *partitionsScan) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return sql.NewTableRowIter(ctx, p.table, sql.PartitionsToPartitionIter(p.partitions...)), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (p *partitionsScan) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 0)
+	}
+	return p, nil
+}
+
+// CheckPrivileges implements the sql.Node interface. Privileges are checked by the coordinator.
+func (p *partitionsScan) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/remote"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/go-mysql-server/sql/variables"
+)
+
+func init() {
+	variables.InitSystemVariables()
+}
+
+func TestDistribute(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	table := memory.NewPartitionedTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: types.Int64, Source: "mytable", PrimaryKey: true},
+		{Name: "s", Type: types.Text, Source: "mytable", Nullable: true},
+	}), nil, 5)
+	db.AddTable("mytable", table)
+	ctx := sql.NewEmptyContext()
+	ctx.SetCurrentDatabase("mydb")
+	for i := int64(0); i < 50; i++ {
+		require.NoError(t, table.Insert(ctx, sql.Row{i, []string{"a", "b", "c"}[i%3]}))
+	}
+	a := analyzer.NewBuilder(sql.NewDatabaseProvider(db)).WithParallelism(2).Build()
+
+	worker := remote.NewWorker(a.Catalog)
+	server := httptest.NewServer(remote.NewHandler(worker, func(r *http.Request) (*sql.Context, error) {
+		return sql.NewContext(r.Context()), nil
+	}))
+	defer server.Close()
+	workers := []remote.Transport{worker, &remote.HTTPTransport{URL: server.URL}}
+
+	for _, query := range []string{
+		"select s, count(*), sum(i) from mytable where i > 3 group by s order by s",
+		"select i, upper(s) from mytable where s <> 'b' order by i",
+	} {
+		t.Run(query, func(t *testing.T) {
+			parsed, err := parse.Parse(ctx, query)
+			require.NoError(t, err)
+			n, err := a.Analyze(ctx, parsed, nil)
+			require.NoError(t, err)
+			expected, err := sql.NodeToRows(ctx, n)
+			require.NoError(t, err)
+
+			distributed, err := remote.Distribute(ctx, n, workers...)
+			require.NoError(t, err)
+			var exchanges int
+			transform.Inspect(distributed, func(n sql.Node) bool {
+				if _, ok := n.(*remote.Exchange); ok {
+					exchanges++
+				}
+				return true
+			})
+			require.Equal(t, 1, exchanges)
+
+			actual, err := sql.NodeToRows(ctx, distributed)
+			require.NoError(t, err)
+			require.Equal(t, expected, actual)
+		})
+	}
+
+	_, err := (&remote.HTTPTransport{URL: server.URL}).Execute(ctx, &remote.Request{Plan: []byte(`{"version":1,"plan":{"type":"unknown"}}`)})
+	require.Error(t, err)
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+)
+
+// ResultCache caches the results of read-only queries, keyed by their statement digest and parameters, along with the
+// data versions of the tables they read (see DataVersionedTable). A cached result is only returned while the versions
+// of its tables are the ones it was read at. The least recently used results are evicted once the cache holds its
+// maximum number of results.
+type ResultCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	maxRows    int
+	entries    map[string]*list.Element
+	lru        *list.List
+
+	hits   uint64
+	misses uint64
+}
+
+// resultCacheEntry is a cached result, which is an element of the LRU list of a ResultCache.
+type resultCacheEntry struct {
+	key      string
+	versions []string
+	rows     []Row
+}
+
+// NewResultCache returns a new result cache holding up to |maxEntries| results, of up to |maxRows| rows each.
+// Results with more rows aren't cached.
+func NewResultCache(maxEntries, maxRows int) *ResultCache {
+	return &ResultCache{
+		maxEntries: maxEntries,
+		maxRows:    maxRows,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// MaxRows returns the maximum number of rows of the results this cache holds.
+func (c *ResultCache) MaxRows() int {
+	return c.maxRows
+}
+
+// Get returns the rows of the result cached with the key given, if it was read at the table versions given.
+func (c *ResultCache) Get(key string, versions []string) ([]Row, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false
+	}
+	entry := elem.Value.(*resultCacheEntry)
+	if !equalVersions(entry.versions, versions) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	atomic.AddUint64(&c.hits, 1)
+	return entry.rows, true
+}
+
+// Put caches the rows given as the result with the key given, read at the table versions given.
+func (c *ResultCache) Put(key string, versions []string, rows []Row) {
+	if len(rows) > c.maxRows || c.maxEntries <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &resultCacheEntry{key: key, versions: versions, rows: rows}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*resultCacheEntry).key)
+	}
+}
+
+// Clear removes all the results of this cache.
+func (c *ResultCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+// Len returns the number of results in this cache.
+func (c *ResultCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// Stats returns the number of lookups of this cache that found a valid result, and the number that didn't.
+func (c *ResultCache) Stats() (hits, misses uint64) {
+	return atomic.LoadUint64(&c.hits), atomic.LoadUint64(&c.misses)
+}
+
+func equalVersions(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
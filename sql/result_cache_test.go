@@ -0,0 +1,56 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultCache(t *testing.T) {
+	require := require.New(t)
+	cache := NewResultCache(2, 2)
+
+	cache.Put("a", []string{"t@1"}, []Row{{1}})
+	cache.Put("b", []string{"t@1", "u@1"}, []Row{{2}, {3}})
+	cache.Put("c", []string{"t@1"}, []Row{{4}, {5}, {6}})
+	require.Equal(2, cache.Len())
+
+	rows, ok := cache.Get("a", []string{"t@1"})
+	require.True(ok)
+	require.Equal([]Row{{1}}, rows)
+
+	// results read at other versions are invalid, and removed
+	_, ok = cache.Get("b", []string{"t@1", "u@2"})
+	require.False(ok)
+	require.Equal(1, cache.Len())
+
+	// the least recently used results are evicted
+	cache.Put("b", []string{"t@1", "u@2"}, []Row{{2}})
+	cache.Get("a", []string{"t@1"})
+	cache.Put("d", []string{"t@1"}, nil)
+	_, ok = cache.Get("b", []string{"t@1", "u@2"})
+	require.False(ok)
+	_, ok = cache.Get("d", []string{"t@1"})
+	require.True(ok)
+
+	hits, misses := cache.Stats()
+	require.Equal(uint64(3), hits)
+	require.Equal(uint64(2), misses)
+
+	cache.Clear()
+	require.Equal(0, cache.Len())
+}
@@ -129,6 +129,17 @@ func (f *RowFrame) Clear() {
 	f.off = 0
 }
 
+// Truncate removes the values after the first |n| values of this frame, such as the values of a row that's been
+// filtered out. The space of the removed values is only reused when the frame is truncated to no values.
+func (f *RowFrame) Truncate(n int) {
+	if n == 0 {
+		f.Clear()
+		return
+	}
+	f.Types = f.Types[:n]
+	f.Values = f.Values[:n]
+}
+
 // Append appends the values given into this frame.
 func (f *RowFrame) Append(vals ...Value) {
 	for _, v := range vals {
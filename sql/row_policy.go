@@ -0,0 +1,30 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// RowPolicy restricts the rows of a table that a user may read and write. Integrators register row policies with the
+// analyzer's catalog to isolate the rows of different users of the same table, such as the tenants of a multi-tenant
+// database, without rewriting their queries.
+//
+// A row policy returns the predicate that the rows of the table must satisfy for the user of the context given, or nil
+// if that user isn't restricted. The predicate refers to the table's columns with unqualified unresolved columns. It's
+// added as a filter to every read of the table, which also restricts the rows that UPDATE and DELETE statements may
+// change, and rows written by INSERT and UPDATE statements must satisfy it. Statements whose effects can't be
+// restricted this way, namely TRUNCATE, REPLACE, INSERT ... ON DUPLICATE KEY UPDATE, HANDLER and CHECKSUM TABLE, are
+// rejected for restricted users.
+//
+// The user is the account that the statement executes as, which is the definer of views, triggers and stored
+// procedures that execute in their definer's security context.
+type RowPolicy func(ctx *Context, db, table string) (Expression, error)
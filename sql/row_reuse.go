@@ -0,0 +1,93 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"os"
+	"sync"
+)
+
+const retainRowsFlag = "GMS_RETAIN_ROWS"
+
+// RetainRowsFeatureFlag disables the reuse of rows between calls to RowIter.Next. It's meant for integrators whose
+// aggregation buffers or other consumers of rows keep the rows they're given instead of the values in them, and is set
+// with the GMS_RETAIN_ROWS environment variable.
+var RetainRowsFeatureFlag = false
+
+func init() {
+	if v, ok := os.LookupEnv(retainRowsFlag); ok && v != "" {
+		RetainRowsFeatureFlag = true
+	}
+}
+
+// RowReuser is a RowIter that can reuse the storage of the rows it returns, instead of allocating a new row for each
+// call to Next.
+//
+// By default, the rows returned by a RowIter belong to its caller. A caller that's done with each row it gets by the
+// time it calls Next again, such as an iterator that only evaluates expressions on the rows of its child, can call
+// ReuseRows to let the iterator overwrite each row it returned with the next one. Callers must never modify the rows
+// they're given either way.
+type RowReuser interface {
+	RowIter
+	// ReuseRows tells this iterator that a row it returns is only used until the next call to Next.
+	ReuseRows()
+}
+
+// ReuseRows calls ReuseRows on the iterator given if it's a RowReuser, unless RetainRowsFeatureFlag is set. Only the
+// caller of the iterator's Next method may call this.
+func ReuseRows(iter RowIter) {
+	if RetainRowsFeatureFlag {
+		return
+	}
+	if r, ok := iter.(RowReuser); ok {
+		r.ReuseRows()
+	}
+}
+
+// RowBuffer is a row whose storage comes from a pool of rows, to be used by iterators for the rows they build and
+// discard, such as the candidate rows of a join.
+type RowBuffer struct {
+	Row Row
+}
+
+var rowBufferPool = sync.Pool{
+	New: func() interface{} {
+		return &RowBuffer{}
+	},
+}
+
+// NewRowBuffer returns a RowBuffer whose row has the length given, from a pool of rows. The values of the row are
+// undefined. The buffer should be returned to the pool with Release when it's no longer used.
+func NewRowBuffer(size int) *RowBuffer {
+	b := rowBufferPool.Get().(*RowBuffer)
+	if cap(b.Row) < size {
+		b.Row = make(Row, size)
+	}
+	b.Row = b.Row[:size]
+	return b
+}
+
+// Release returns this buffer to the pool of rows. Neither the buffer nor its row may be used after it's released.
+func (b *RowBuffer) Release() {
+	if b == nil {
+		return
+	}
+	// Clear the values so that the pool doesn't keep them alive
+	for i := range b.Row {
+		b.Row[i] = nil
+	}
+	b.Row = b.Row[:0]
+	rowBufferPool.Put(b)
+}
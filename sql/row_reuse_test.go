@@ -0,0 +1,72 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowBuffer(t *testing.T) {
+	require := require.New(t)
+
+	b := NewRowBuffer(3)
+	require.Len(b.Row, 3)
+	b.Row[0], b.Row[1], b.Row[2] = 1, "a", 2.5
+	b.Release()
+	require.Len(b.Row, 0)
+	require.Equal(Row{nil, nil, nil}, b.Row[:3])
+
+	b = NewRowBuffer(5)
+	require.Len(b.Row, 5)
+	b.Release()
+}
+
+func TestReuseRows(t *testing.T) {
+	require := require.New(t)
+
+	iter := &reusingRowIter{}
+	ReuseRows(iter)
+	require.True(iter.reuse)
+
+	defer func(retain bool) {
+		RetainRowsFeatureFlag = retain
+	}(RetainRowsFeatureFlag)
+	RetainRowsFeatureFlag = true
+
+	iter = &reusingRowIter{}
+	ReuseRows(iter)
+	require.False(iter.reuse)
+
+	// Iterators that don't reuse rows are left alone
+	ReuseRows(RowsToRowIter(NewRow(1)))
+}
+
+type reusingRowIter struct {
+	reuse bool
+}
+
+func (i *reusingRowIter) Next(*Context) (Row, error) {
+	return nil, nil
+}
+
+func (i *reusingRowIter) Close(*Context) error {
+	return nil
+}
+
+func (i *reusingRowIter) ReuseRows() {
+	i.reuse = true
+}
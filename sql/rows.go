@@ -152,69 +152,101 @@ func RowIter2ToRows(ctx *Context, sch Schema, i RowIter2) ([]Row, error) {
 
 func rowFromRow2(sch Schema, r Row2) Row {
 	row := make(Row, len(sch))
-	for i, col := range sch {
-		switch col.Type.Type() {
-		case query.Type_INT8:
-			row[i] = values.ReadInt8(r.GetField(i).Val)
-		case query.Type_UINT8:
-			row[i] = values.ReadUint8(r.GetField(i).Val)
-		case query.Type_INT16:
-			row[i] = values.ReadInt16(r.GetField(i).Val)
-		case query.Type_UINT16:
-			row[i] = values.ReadUint16(r.GetField(i).Val)
-		case query.Type_INT32:
-			row[i] = values.ReadInt32(r.GetField(i).Val)
-		case query.Type_UINT32:
-			row[i] = values.ReadUint32(r.GetField(i).Val)
-		case query.Type_INT64:
-			row[i] = values.ReadInt64(r.GetField(i).Val)
-		case query.Type_UINT64:
-			row[i] = values.ReadUint64(r.GetField(i).Val)
-		case query.Type_FLOAT32:
-			row[i] = values.ReadFloat32(r.GetField(i).Val)
-		case query.Type_FLOAT64:
-			row[i] = values.ReadFloat64(r.GetField(i).Val)
-		case query.Type_TEXT, query.Type_VARCHAR, query.Type_CHAR:
-			row[i] = values.ReadString(r.GetField(i).Val, values.ByteOrderCollation)
-		case query.Type_BLOB, query.Type_VARBINARY, query.Type_BINARY:
-			row[i] = values.ReadBytes(r.GetField(i).Val, values.ByteOrderCollation)
-		case query.Type_BIT:
-			fallthrough
-		case query.Type_ENUM:
-			fallthrough
-		case query.Type_SET:
-			fallthrough
-		case query.Type_TUPLE:
-			fallthrough
-		case query.Type_GEOMETRY:
-			fallthrough
-		case query.Type_JSON:
-			fallthrough
-		case query.Type_EXPRESSION:
-			fallthrough
-		case query.Type_INT24:
-			fallthrough
-		case query.Type_UINT24:
-			fallthrough
-		case query.Type_TIMESTAMP:
-			fallthrough
-		case query.Type_DATE:
-			fallthrough
-		case query.Type_TIME:
-			fallthrough
-		case query.Type_DATETIME:
-			fallthrough
-		case query.Type_YEAR:
-			fallthrough
-		case query.Type_DECIMAL:
-			panic(fmt.Sprintf("Unimplemented type conversion: %T", col.Type))
-		default:
-			panic(fmt.Sprintf("unknown type %T", col.Type))
-		}
+	for i := range sch {
+		row[i] = valueToInterface(r.GetField(i))
 	}
 	return row
 }
 
+// valueToInterface returns the value given as the Go value a Row holds for it. Values are read according to their own
+// type, rather than the type of the column they're returned for, since the values of a column can be of any type
+// that converts to it.
+func valueToInterface(v Value) interface{} {
+	if v.IsNull() {
+		return nil
+	}
+	switch v.Typ {
+	case query.Type_INT8:
+		return values.ReadInt8(v.Val)
+	case query.Type_UINT8:
+		return values.ReadUint8(v.Val)
+	case query.Type_INT16:
+		return values.ReadInt16(v.Val)
+	case query.Type_UINT16:
+		return values.ReadUint16(v.Val)
+	case query.Type_INT32:
+		return values.ReadInt32(v.Val)
+	case query.Type_UINT32:
+		return values.ReadUint32(v.Val)
+	case query.Type_INT64:
+		return values.ReadInt64(v.Val)
+	case query.Type_UINT64:
+		return values.ReadUint64(v.Val)
+	case query.Type_FLOAT32:
+		return values.ReadFloat32(v.Val)
+	case query.Type_FLOAT64:
+		return values.ReadFloat64(v.Val)
+	case query.Type_TEXT, query.Type_VARCHAR, query.Type_CHAR:
+		return values.ReadString(v.Val, values.ByteOrderCollation)
+	case query.Type_BLOB, query.Type_VARBINARY, query.Type_BINARY:
+		return values.ReadBytes(v.Val, values.ByteOrderCollation)
+	default:
+		panic(fmt.Sprintf("Unimplemented type conversion: %s", v.Typ))
+	}
+}
+
+// NewRowIter2 returns an iterator of the rows of the node given as row frames, with the row of the outer scope in the
+// frame given. A node that doesn't implement Node2 is iterated with its RowIter, and the values of its rows are
+// converted as they're read, so that a node that implements Node2 can have any node as its child.
+func NewRowIter2(ctx *Context, n Node, f *RowFrame) (RowIter2, error) {
+	if n2, ok := n.(Node2); ok {
+		return n2.RowIter2(ctx, f)
+	}
+
+	var row Row
+	for _, v := range f.Row2() {
+		row = append(row, valueToInterface(v))
+	}
+	iter, err := n.RowIter(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return RowIterToRowIter2(iter), nil
+}
+
+// RowIterToRowIter2 returns the iterator given as a RowIter2. An iterator that doesn't implement RowIter2 already
+// has the values of its rows converted to row frames as they're read.
+func RowIterToRowIter2(i RowIter) RowIter2 {
+	if i2, ok := i.(RowIter2); ok {
+		return i2
+	}
+	return &rowIter2Adapter{RowIter: i}
+}
+
+// rowIter2Adapter is a RowIter2 that reads the rows of a RowIter.
+type rowIter2Adapter struct {
+	RowIter
+}
+
+var _ RowIter2 = (*rowIter2Adapter)(nil)
+
+// Next2 implements the RowIter2 interface.
+func (i *rowIter2Adapter) Next2(ctx *Context, frame *RowFrame) error {
+	row, err := i.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range row {
+		value, err := ConvertToValue(v)
+		if err != nil {
+			return err
+		}
+		frame.Append(value)
+	}
+	return nil
+}
+
 // NodeToRows converts a node to a slice of rows.
 func NodeToRows(ctx *Context, n Node) ([]Row, error) {
 	i, err := n.RowIter(ctx, nil)
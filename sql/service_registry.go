@@ -0,0 +1,115 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sync"
+)
+
+// ServiceKey identifies a service of type T in a ServiceRegistry. Keys are compared by identity, so each service is
+// registered and looked up with the same key, usually a package-level variable of the package providing the service.
+type ServiceKey[T any] struct {
+	name string
+}
+
+// NewServiceKey returns a new key for a service of type T. The name given is only used in error messages.
+func NewServiceKey[T any](name string) *ServiceKey[T] {
+	return &ServiceKey[T]{name: name}
+}
+
+// Name returns the name of this key.
+func (k *ServiceKey[T]) Name() string {
+	return k.name
+}
+
+// ServiceRegistry holds the services shared by the queries of an engine, such as caches, connections and
+// configuration, for the rules, functions and tables provided by integrators. They access them through their context
+// with GetService, rather than with global variables or by asserting the type of the session. Services are registered
+// with RegisterService, and looked up in the registry's parent, if any, when they're not registered in it.
+type ServiceRegistry struct {
+	mu       sync.RWMutex
+	services map[interface{}]interface{}
+	parent   *ServiceRegistry
+}
+
+// NewServiceRegistry returns a new empty service registry.
+func NewServiceRegistry() *ServiceRegistry {
+	return &ServiceRegistry{services: make(map[interface{}]interface{})}
+}
+
+// NewChild returns a new empty service registry whose services are looked up in this one when they're not registered
+// in it, so that a session or query can override services of the engine.
+func (r *ServiceRegistry) NewChild() *ServiceRegistry {
+	child := NewServiceRegistry()
+	child.parent = r
+	return child
+}
+
+func (r *ServiceRegistry) lookup(key interface{}) (interface{}, bool) {
+	for reg := r; reg != nil; reg = reg.parent {
+		reg.mu.RLock()
+		svc, ok := reg.services[key]
+		reg.mu.RUnlock()
+		if ok {
+			return svc, true
+		}
+	}
+	return nil, false
+}
+
+// RegisterService registers the service given with the key given in the registry given, replacing the service
+// previously registered with the key, if any.
+func RegisterService[T any](r *ServiceRegistry, key *ServiceKey[T], svc T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.services[key] = svc
+}
+
+// UnregisterService removes the service registered with the key given from the registry given. Services registered
+// in the registry's parent aren't removed.
+func UnregisterService[T any](r *ServiceRegistry, key *ServiceKey[T]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.services, key)
+}
+
+// LookupService returns the service registered with the key given in the registry given, and whether there is one.
+func LookupService[T any](r *ServiceRegistry, key *ServiceKey[T]) (T, bool) {
+	var zero T
+	if r == nil {
+		return zero, false
+	}
+	svc, ok := r.lookup(key)
+	if !ok {
+		return zero, false
+	}
+	return svc.(T), true
+}
+
+// GetService returns the service registered with the key given in the service registry of the context given, and
+// whether there is one.
+func GetService[T any](ctx *Context, key *ServiceKey[T]) (T, bool) {
+	return LookupService(ctx.ServiceRegistry(), key)
+}
+
+// MustGetService returns the service registered with the key given in the service registry of the context given, or
+// ErrServiceNotRegistered if there's none.
+func MustGetService[T any](ctx *Context, key *ServiceKey[T]) (T, error) {
+	svc, ok := GetService(ctx, key)
+	if !ok {
+		return svc, ErrServiceNotRegistered.New(key.name)
+	}
+	return svc, nil
+}
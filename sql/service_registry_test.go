@@ -0,0 +1,62 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceRegistry(t *testing.T) {
+	require := require.New(t)
+	nameKey := NewServiceKey[string]("name")
+	otherNameKey := NewServiceKey[string]("other name")
+	countKey := NewServiceKey[*int]("count")
+
+	engine := NewServiceRegistry()
+	RegisterService(engine, nameKey, "engine")
+	count := 1
+	RegisterService(engine, countKey, &count)
+
+	ctx := NewEmptyContext()
+	_, ok := GetService(ctx, nameKey)
+	require.False(ok)
+	_, err := MustGetService(ctx, nameKey)
+	require.True(ErrServiceNotRegistered.Is(err))
+
+	ctx.ApplyOpts(WithServiceRegistry(engine))
+	name, ok := GetService(ctx, nameKey)
+	require.True(ok)
+	require.Equal("engine", name)
+	_, ok = GetService(ctx, otherNameKey)
+	require.False(ok)
+	c, err := MustGetService(ctx, countKey)
+	require.NoError(err)
+	require.Same(&count, c)
+
+	// services of a child registry override the ones of its parent
+	session := engine.NewChild()
+	RegisterService(session, nameKey, "session")
+	ctx.ApplyOpts(WithServiceRegistry(session))
+	name, _ = GetService(ctx, nameKey)
+	require.Equal("session", name)
+	_, ok = GetService(ctx, countKey)
+	require.True(ok)
+
+	UnregisterService(session, nameKey)
+	name, _ = GetService(ctx, nameKey)
+	require.Equal("engine", name)
+}
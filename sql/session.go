@@ -56,42 +56,24 @@ type Client struct {
 	Capabilities uint32
 }
 
-// Session holds the session data.
+// Session holds the session data. Its variables and transaction state are the feature interfaces it embeds. Sessions
+// may also store warnings and cache privileges, implementing SessionWarnings and SessionPrivilegeCache, which
+// BaseSession does. The Context has methods that use those features when its session has them.
 type Session interface {
+	SessionVariables
+	SessionTransactionState
 	// Address of the server.
 	Address() string
 	// Client returns the user of the session.
 	Client() Client
 	// SetClient returns a new session with the given client.
 	SetClient(Client)
-	// SetSessionVariable sets the given system variable to the value given for this session.
-	SetSessionVariable(ctx *Context, sysVarName string, value interface{}) error
-	// InitSessionVariable sets the given system variable to the value given for this session and will allow for
-	// initialization of readonly variables.
-	InitSessionVariable(ctx *Context, sysVarName string, value interface{}) error
-	// SetUserVariable sets the given user variable to the value given for this session, or creates it for this session.
-	SetUserVariable(ctx *Context, varName string, value interface{}, typ Type) error
-	// GetSessionVariable returns this session's value of the system variable with the given name.
-	GetSessionVariable(ctx *Context, sysVarName string) (interface{}, error)
-	// GetUserVariable returns this session's value of the user variable with the given name, along with its most
-	// appropriate type.
-	GetUserVariable(ctx *Context, varName string) (Type, interface{}, error)
-	// GetAllSessionVariables returns a copy of all session variable values.
-	GetAllSessionVariables() map[string]interface{}
 	// GetCurrentDatabase gets the current database for this session
 	GetCurrentDatabase() string
 	// SetCurrentDatabase sets the current database for this session
 	SetCurrentDatabase(dbName string)
 	// ID returns the unique ID of the connection.
 	ID() uint32
-	// Warn stores the warning in the session.
-	Warn(warn *Warning)
-	// Warnings returns a copy of session warnings (from the most recent).
-	Warnings() []*Warning
-	// ClearWarnings cleans up session warnings.
-	ClearWarnings()
-	// WarningCount returns a number of session warnings
-	WarningCount() uint16
 	// AddLock adds a lock to the set of locks owned by this user which will need to be released if this session terminates
 	AddLock(lockName string) error
 	// DelLock removes a lock from the set of locks owned by this user
@@ -102,14 +84,6 @@ type Session interface {
 	SetLastQueryInfo(key string, value int64)
 	// GetLastQueryInfo returns the session-level query info for the key given, for the query most recently executed.
 	GetLastQueryInfo(key string) int64
-	// GetTransaction returns the active transaction, if any
-	GetTransaction() Transaction
-	// SetTransaction sets the session's transaction
-	SetTransaction(tx Transaction)
-	// SetIgnoreAutoCommit instructs the session to ignore the value of the @@autocommit variable, or consider it again
-	SetIgnoreAutoCommit(ignore bool)
-	// GetIgnoreAutoCommit returns whether this session should ignore the @@autocommit variable
-	GetIgnoreAutoCommit() bool
 	// GetLogger returns the logger for this session, useful if clients want to log messages with the same format / output
 	// as the running server. Clients should instantiate their own global logger with formatting options, and session
 	// implementations should return the logger to be used for the running server.
@@ -129,28 +103,8 @@ type Session interface {
 	SetViewRegistry(*ViewRegistry)
 	// SetConnectionId sets this sessions unique ID
 	SetConnectionId(connId uint32)
-	// GetCharacterSet returns the character set for this session (defined by the system variable `character_set_connection`).
-	GetCharacterSet() CharacterSetID
-	// GetCharacterSetResults returns the result character set for this session (defined by the system variable `character_set_results`).
-	GetCharacterSetResults() CharacterSetID
-	// GetCollation returns the collation for this session (defined by the system variable `collation_connection`).
-	GetCollation() CollationID
-	// GetPrivilegeSet returns the cached privilege set associated with this session, along with its counter. The
-	// PrivilegeSet is only valid when the counter is greater than zero.
-	GetPrivilegeSet() (PrivilegeSet, uint64)
-	// SetPrivilegeSet updates this session's cache with the given counter and privilege set. Setting the counter to a
-	// value of zero will force the cache to reload. This is an internal function and is not intended to be used by
-	// integrators.
-	SetPrivilegeSet(newPs PrivilegeSet, counter uint64)
 	// ValidateSession provides integrators a chance to do any custom validation of this session before any query is executed in it. For example, Dolt uses this hook to validate that the session's working set is valid.
 	ValidateSession(ctx *Context, dbName string) error
-	// SetTransactionDatabase is called when a transaction begins, and is set to the name of the database in scope for
-	// that transaction. GetTransactionDatabase can be called by integrators to retrieve this database later, when it's
-	// time to commit via TransactionSession.CommitTransaction. This supports implementations that can only support a
-	// single database being modified per transaction.
-	SetTransactionDatabase(dbName string)
-	// GetTransactionDatabase returns the name of the database considered in scope when the current transaction began.
-	GetTransactionDatabase() string
 }
 
 // PersistableSession supports serializing/deserializing global system variables/
@@ -218,11 +172,14 @@ type Context struct {
 	Memory      *MemoryManager
 	ProcessList ProcessList
 	services    Services
+	registry    *ServiceRegistry
 	pid         uint64
 	query       string
 	queryTime   time.Time
 	tracer      trace.Tracer
 	rootSpan    trace.Span
+	iterGrace   time.Duration
+	profiler    *QueryProfiler
 }
 
 // ContextOption is a function to configure the context.
@@ -283,6 +240,13 @@ func WithServices(services Services) ContextOption {
 	}
 }
 
+// WithServiceRegistry sets the service registry of the context, holding the services of integrators.
+func WithServiceRegistry(registry *ServiceRegistry) ContextOption {
+	return func(ctx *Context) {
+		ctx.registry = registry
+	}
+}
+
 var ctxNowFunc = time.Now
 var ctxNowFuncMutex = &sync.Mutex{}
 
@@ -406,7 +370,7 @@ func (c *Context) RootSpan() trace.Span {
 
 // Error adds an error as warning to the session.
 func (c *Context) Error(code int, msg string, args ...interface{}) {
-	c.Session.Warn(&Warning{
+	c.AddWarning(&Warning{
 		Level:   "Error",
 		Code:    code,
 		Message: fmt.Sprintf(msg, args...),
@@ -415,13 +379,59 @@ func (c *Context) Error(code int, msg string, args ...interface{}) {
 
 // Warn adds a warning to the session.
 func (c *Context) Warn(code int, msg string, args ...interface{}) {
-	c.Session.Warn(&Warning{
+	c.AddWarning(&Warning{
 		Level:   "Warning",
 		Code:    code,
 		Message: fmt.Sprintf(msg, args...),
 	})
 }
 
+// AddWarning adds the warning given to the session, if it stores warnings. It's dropped otherwise.
+func (c *Context) AddWarning(warn *Warning) {
+	if warnings, ok := c.Session.(SessionWarnings); ok {
+		warnings.Warn(warn)
+	}
+}
+
+// Warnings returns the warnings of the session, from the most recent, which are empty if it doesn't store warnings.
+func (c *Context) Warnings() []*Warning {
+	if warnings, ok := c.Session.(SessionWarnings); ok {
+		return warnings.Warnings()
+	}
+	return nil
+}
+
+// ClearWarnings clears the warnings of the session, if it stores warnings.
+func (c *Context) ClearWarnings() {
+	if warnings, ok := c.Session.(SessionWarnings); ok {
+		warnings.ClearWarnings()
+	}
+}
+
+// WarningCount returns the number of warnings of the session, which is zero if it doesn't store warnings.
+func (c *Context) WarningCount() uint16 {
+	if warnings, ok := c.Session.(SessionWarnings); ok {
+		return warnings.WarningCount()
+	}
+	return 0
+}
+
+// GetPrivilegeSet returns the privilege set cached by the session and its counter, which is zero if the session
+// doesn't cache one, so that it's always reloaded.
+func (c *Context) GetPrivilegeSet() (PrivilegeSet, uint64) {
+	if cache, ok := c.Session.(SessionPrivilegeCache); ok {
+		return cache.GetPrivilegeSet()
+	}
+	return nil, 0
+}
+
+// SetPrivilegeSet caches the privilege set given in the session with its counter, if the session caches one.
+func (c *Context) SetPrivilegeSet(newPs PrivilegeSet, counter uint64) {
+	if cache, ok := c.Session.(SessionPrivilegeCache); ok {
+		cache.SetPrivilegeSet(newPs, counter)
+	}
+}
+
 // Terminate the connection associated with |connID|.
 func (c *Context) KillConnection(connID uint32) error {
 	if c.services.KillConnection != nil {
@@ -440,6 +450,31 @@ func (c *Context) LoadInfile(filename string) (io.ReadCloser, error) {
 	return nil, ErrUnsupportedFeature.New("LOAD DATA LOCAL INFILE ...")
 }
 
+// WriteResultSet passes the result set of a SELECT statement run by a stored
+// procedure to the client, if it supports multiple result sets. It does
+// nothing otherwise, in which case only the last result set is returned. The
+// server sets it for clients with CLIENT_MULTI_STATEMENTS.
+func (c *Context) WriteResultSet(schema Schema, rows []Row) error {
+	if c.services.WriteResultSet != nil {
+		return c.services.WriteResultSet(schema, rows)
+	}
+	return nil
+}
+
+// WithResultSetWriter returns a new Context whose WriteResultSet service is
+// the function given.
+func (c *Context) WithResultSetWriter(write func(schema Schema, rows []Row) error) *Context {
+	nc := *c
+	nc.services.WriteResultSet = write
+	return &nc
+}
+
+// ServiceRegistry returns the service registry of this context, or nil if it has none. Services are usually looked up
+// with GetService instead.
+func (c *Context) ServiceRegistry() *ServiceRegistry {
+	return c.registry
+}
+
 func (c *Context) NewErrgroup() (*errgroup.Group, *Context) {
 	eg, egCtx := errgroup.WithContext(c.Context)
 	return eg, c.WithContext(egCtx)
@@ -449,10 +484,48 @@ func (c *Context) NewErrgroup() (*errgroup.Group, *Context) {
 func (c *Context) NewCtxWithClient(client Client) *Context {
 	nc := *c
 	nc.Session.SetClient(client)
-	nc.Session.SetPrivilegeSet(nil, 0)
+	nc.SetPrivilegeSet(nil, 0)
+	return &nc
+}
+
+// WithSecurityClient returns a new Context whose session reports the given [client], without modifying the session of
+// this Context. Privileges are evaluated for the given client, which is used to access objects (such as views) that
+// execute in a security context other than the current user's.
+func (c *Context) WithSecurityClient(client Client) *Context {
+	nc := *c
+	nc.Session = &securityClientSession{Session: c.Session, PrivilegeCache: &PrivilegeCache{}, client: client}
 	return &nc
 }
 
+// securityClientSession wraps a Session to report a different client, along with a privilege set cache that belongs to
+// that client rather than to the wrapped session.
+type securityClientSession struct {
+	Session
+	*PrivilegeCache
+	client Client
+}
+
+// GetPrivilegeSet implements the Session interface.
+func (s *securityClientSession) GetPrivilegeSet() (PrivilegeSet, uint64) {
+	return s.PrivilegeCache.GetPrivilegeSet()
+}
+
+// SetPrivilegeSet implements the Session interface.
+func (s *securityClientSession) SetPrivilegeSet(newPs PrivilegeSet, counter uint64) {
+	s.PrivilegeCache.SetPrivilegeSet(newPs, counter)
+}
+
+// Client implements the Session interface.
+func (s *securityClientSession) Client() Client {
+	return s.client
+}
+
+// SetClient implements the Session interface.
+func (s *securityClientSession) SetClient(client Client) {
+	s.client = client
+	s.SetPrivilegeSet(nil, 0)
+}
+
 // Services are handles to optional or plugin functionality that can be
 // used by the SQL implementation in certain situations. An integrator can set
 // methods on Services for a given *Context and different parts of go-mysql-server
@@ -463,6 +536,11 @@ func (c *Context) NewCtxWithClient(client Client) *Context {
 type Services struct {
 	KillConnection func(connID uint32) error
 	LoadInfile     func(filename string) (io.ReadCloser, error)
+	// WriteResultSet receives the result set of every SELECT statement run by
+	// a stored procedure, in order. The last one is also the result of the
+	// CALL statement, so clients supporting multiple result sets return the
+	// others before it.
+	WriteResultSet func(schema Schema, rows []Row) error
 }
 
 // NewSpanIter creates a RowIter executed in the given span.
@@ -496,6 +574,12 @@ type spanIter struct {
 
 var _ RowIter = (*spanIter)(nil)
 var _ RowIter2 = (*spanIter)(nil)
+var _ RowReuser = (*spanIter)(nil)
+
+// ReuseRows implements the RowReuser interface.
+func (i *spanIter) ReuseRows() {
+	ReuseRows(i.iter)
+}
 
 func (i *spanIter) updateTimings(start time.Time) {
 	elapsed := time.Since(start)
@@ -619,7 +703,7 @@ func GetTmpdirSessionVar() string {
 }
 
 // HasDefaultValue checks if session variable value is the default one.
-func HasDefaultValue(ctx *Context, s Session, key string) (bool, interface{}) {
+func HasDefaultValue(ctx *Context, s SessionVariables, key string) (bool, interface{}) {
 	val, err := s.GetSessionVariable(ctx, key)
 	if err == nil {
 		sysVar, _, ok := SystemVariables.GetGlobal(key)
@@ -0,0 +1,425 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// Sessions are made up of the feature interfaces below, each of which has a base implementation that BaseSession
+// embeds. The Session interface embeds SessionVariables and SessionTransactionState, which every session has. The
+// others are optional, and are used through the methods of the Context with the same names, which do without them when
+// the session doesn't implement them. Code that only needs one feature of a session should take its feature interface
+// rather than a Session, so that it can be given the base implementation alone.
+// Integrators with custom sessions should embed BaseSession, or the base implementations of the features they don't
+// replace, rather than reimplement them: the base implementations are where the engine adds the state new features
+// need, so sessions embedding them get that state without changes.
+
+// SessionVariables stores the system and user variables of a session.
+type SessionVariables interface {
+	SessionUserVariables
+	// SetSessionVariable sets the given system variable to the value given for this session.
+	SetSessionVariable(ctx *Context, sysVarName string, value interface{}) error
+	// InitSessionVariable sets the given system variable to the value given for this session and will allow for
+	// initialization of readonly variables.
+	InitSessionVariable(ctx *Context, sysVarName string, value interface{}) error
+	// GetSessionVariable returns this session's value of the system variable with the given name.
+	GetSessionVariable(ctx *Context, sysVarName string) (interface{}, error)
+	// GetAllSessionVariables returns a copy of all session variable values.
+	GetAllSessionVariables() map[string]interface{}
+	// GetCharacterSet returns the character set for this session (defined by the system variable `character_set_connection`).
+	GetCharacterSet() CharacterSetID
+	// GetCharacterSetResults returns the result character set for this session (defined by the system variable `character_set_results`).
+	GetCharacterSetResults() CharacterSetID
+	// GetCollation returns the collation for this session (defined by the system variable `collation_connection`).
+	GetCollation() CollationID
+}
+
+// SessionTransactionState stores the state of the transaction of a session.
+type SessionTransactionState interface {
+	// GetTransaction returns the active transaction, if any
+	GetTransaction() Transaction
+	// SetTransaction sets the session's transaction
+	SetTransaction(tx Transaction)
+	// GetTwoPhaseTransactions returns the two-phase transactions of the databases written by the session's transaction,
+	// by database name
+	GetTwoPhaseTransactions() map[string]TwoPhaseTransaction
+	// SetTwoPhaseTransactions sets the two-phase transactions of the databases written by the session's transaction
+	SetTwoPhaseTransactions(txs map[string]TwoPhaseTransaction)
+	// SetIgnoreAutoCommit instructs the session to ignore the value of the @@autocommit variable, or consider it again
+	SetIgnoreAutoCommit(ignore bool)
+	// GetIgnoreAutoCommit returns whether this session should ignore the @@autocommit variable
+	GetIgnoreAutoCommit() bool
+	// SetTransactionDatabase is called when a transaction begins, and is set to the name of the database in scope for
+	// that transaction. GetTransactionDatabase can be called by integrators to retrieve this database later, when it's
+	// time to commit via TransactionSession.CommitTransaction. This supports implementations that can only support a
+	// single database being modified per transaction.
+	SetTransactionDatabase(dbName string)
+	// GetTransactionDatabase returns the name of the database considered in scope when the current transaction began.
+	GetTransactionDatabase() string
+}
+
+// SessionWarnings stores the warnings of the statements of a session.
+type SessionWarnings interface {
+	// Warn stores the warning in the session.
+	Warn(warn *Warning)
+	// Warnings returns a copy of session warnings (from the most recent).
+	Warnings() []*Warning
+	// ClearWarnings cleans up session warnings.
+	ClearWarnings()
+	// WarningCount returns a number of session warnings
+	WarningCount() uint16
+}
+
+// SessionPrivilegeCache caches the privilege set of the client of a session.
+type SessionPrivilegeCache interface {
+	// GetPrivilegeSet returns the cached privilege set associated with this session, along with its counter. The
+	// PrivilegeSet is only valid when the counter is greater than zero.
+	GetPrivilegeSet() (PrivilegeSet, uint64)
+	// SetPrivilegeSet updates this session's cache with the given counter and privilege set. Setting the counter to a
+	// value of zero will force the cache to reload. This is an internal function and is not intended to be used by
+	// integrators.
+	SetPrivilegeSet(newPs PrivilegeSet, counter uint64)
+}
+
+// VariableStore is the base implementation of SessionVariables. Its system variables are initialized with the
+// session values of the system variables registered when it's created; variables registered later are added the first
+// time they're set.
+type VariableStore struct {
+	mu         sync.RWMutex
+	systemVars map[string]SystemVarValue
+	userVars   SessionUserVariables
+}
+
+var _ SessionVariables = (*VariableStore)(nil)
+
+// NewVariableStore returns a new variable store with the session values of the registered system variables, and no
+// user variables.
+func NewVariableStore() *VariableStore {
+	var sessionVars map[string]SystemVarValue
+	if SystemVariables != nil {
+		sessionVars = SystemVariables.NewSessionMap()
+	} else {
+		sessionVars = make(map[string]SystemVarValue)
+	}
+	return &VariableStore{
+		systemVars: sessionVars,
+		userVars:   NewUserVars(),
+	}
+}
+
+// GetAllSessionVariables implements the SessionVariables interface.
+func (s *VariableStore) GetAllSessionVariables() map[string]interface{} {
+	m := make(map[string]interface{})
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for k, v := range s.systemVars {
+		m[k] = v.Val
+	}
+	return m
+}
+
+// SetSessionVariable implements the SessionVariables interface.
+func (s *VariableStore) SetSessionVariable(ctx *Context, sysVarName string, value interface{}) error {
+	sysVarName = strings.ToLower(sysVarName)
+	s.mu.RLock()
+	sysVar, ok := s.systemVars[sysVarName]
+	s.mu.RUnlock()
+
+	// Since we initialized the system variables in this session at session start time, any variables that were added since that time
+	// will need to be added dynamically here.
+	// TODO: fix this with proper session lifecycle management
+	if !ok {
+		if SystemVariables != nil {
+			sv, _, ok := SystemVariables.GetGlobal(sysVarName)
+			if !ok {
+				return ErrUnknownSystemVariable.New(sysVarName)
+			}
+			return s.setSessVar(ctx, sv, value)
+		} else {
+			return ErrUnknownSystemVariable.New(sysVarName)
+		}
+	}
+
+	if !sysVar.Var.Dynamic {
+		return ErrSystemVariableReadOnly.New(sysVarName)
+	}
+	return s.setSessVar(ctx, sysVar.Var, value)
+}
+
+// InitSessionVariable implements the SessionVariables interface and is used to initialize variables (Including
+// read-only variables)
+func (s *VariableStore) InitSessionVariable(ctx *Context, sysVarName string, value interface{}) error {
+	sysVar, _, ok := SystemVariables.GetGlobal(sysVarName)
+	if !ok {
+		return ErrUnknownSystemVariable.New(sysVarName)
+	}
+
+	s.mu.RLock()
+	val, ok := s.systemVars[sysVar.Name]
+	s.mu.RUnlock()
+	if ok && val.Val != sysVar.Default {
+		return ErrSystemVariableReinitialized.New(sysVarName)
+	}
+
+	return s.setSessVar(ctx, sysVar, value)
+}
+
+func (s *VariableStore) setSessVar(ctx *Context, sysVar SystemVariable, value interface{}) error {
+	if sysVar.Scope == SystemVariableScope_Global {
+		return ErrSystemVariableGlobalOnly.New(sysVar.Name)
+	}
+	convertedVal, err := sysVar.Type.Convert(value)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.systemVars[sysVar.Name] = SystemVarValue{
+		Var: sysVar,
+		Val: convertedVal,
+	}
+	return nil
+}
+
+// SetUserVariable implements the SessionVariables interface.
+func (s *VariableStore) SetUserVariable(ctx *Context, varName string, value interface{}, typ Type) error {
+	return s.userVars.SetUserVariable(ctx, varName, value, typ)
+}
+
+// GetSessionVariable implements the SessionVariables interface.
+func (s *VariableStore) GetSessionVariable(ctx *Context, sysVarName string) (interface{}, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sysVarName = strings.ToLower(sysVarName)
+	sysVar, ok := s.systemVars[sysVarName]
+	if !ok {
+		return nil, ErrUnknownSystemVariable.New(sysVarName)
+	}
+	// TODO: this is duplicated from within variables.globalSystemVariables, suggesting the need for an interface
+	if sysType, ok := sysVar.Var.Type.(SetType); ok {
+		if sv, ok := sysVar.Val.(uint64); ok {
+			return sysType.BitsToString(sv)
+		}
+	}
+	return sysVar.Val, nil
+}
+
+// GetUserVariable implements the SessionVariables interface.
+func (s *VariableStore) GetUserVariable(ctx *Context, varName string) (Type, interface{}, error) {
+	return s.userVars.GetUserVariable(ctx, varName)
+}
+
+// GetCharacterSet implements the SessionVariables interface.
+func (s *VariableStore) GetCharacterSet() CharacterSetID {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sysVar, _ := s.systemVars[characterSetConnectionSysVarName]
+	if sysVar.Val == nil {
+		return CharacterSet_Unspecified
+	}
+	charSet, err := ParseCharacterSet(sysVar.Val.(string))
+	if err != nil {
+		panic(err) // shouldn't happen
+	}
+	return charSet
+}
+
+// GetCharacterSetResults implements the SessionVariables interface.
+func (s *VariableStore) GetCharacterSetResults() CharacterSetID {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sysVar, _ := s.systemVars[characterSetResultsSysVarName]
+	if sysVar.Val == nil {
+		return CharacterSet_Unspecified
+	}
+	charSet, err := ParseCharacterSet(sysVar.Val.(string))
+	if err != nil {
+		panic(err) // shouldn't happen
+	}
+	return charSet
+}
+
+// GetCollation implements the SessionVariables interface.
+func (s *VariableStore) GetCollation() CollationID {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sysVar, ok := s.systemVars[collationConnectionSysVarName]
+
+	// In tests, the collation may not be set because the sys vars haven't been initialized
+	if !ok {
+		return Collation_Default
+	}
+	if sysVar.Val == nil {
+		return Collation_Unspecified
+	}
+	valStr := sysVar.Val.(string)
+	collation, err := ParseCollation(nil, &valStr, false)
+	if err != nil {
+		panic(err) // shouldn't happen
+	}
+	return collation
+}
+
+// TransactionState is the base implementation of SessionTransactionState. The zero value has no transaction.
+type TransactionState struct {
+	mu               sync.RWMutex
+	tx               Transaction
+	twoPhaseTxs      map[string]TwoPhaseTransaction
+	transactionDb    string
+	ignoreAutocommit bool
+}
+
+var _ SessionTransactionState = (*TransactionState)(nil)
+
+// GetTransaction implements the SessionTransactionState interface.
+func (s *TransactionState) GetTransaction() Transaction {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tx
+}
+
+// SetTransaction implements the SessionTransactionState interface.
+func (s *TransactionState) SetTransaction(tx Transaction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tx = tx
+}
+
+// GetTwoPhaseTransactions implements the SessionTransactionState interface.
+func (s *TransactionState) GetTwoPhaseTransactions() map[string]TwoPhaseTransaction {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.twoPhaseTxs
+}
+
+// SetTwoPhaseTransactions implements the SessionTransactionState interface.
+func (s *TransactionState) SetTwoPhaseTransactions(txs map[string]TwoPhaseTransaction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.twoPhaseTxs = txs
+}
+
+// SetIgnoreAutoCommit implements the SessionTransactionState interface.
+func (s *TransactionState) SetIgnoreAutoCommit(ignore bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ignoreAutocommit = ignore
+}
+
+// GetIgnoreAutoCommit implements the SessionTransactionState interface.
+func (s *TransactionState) GetIgnoreAutoCommit() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.ignoreAutocommit
+}
+
+// SetTransactionDatabase implements the SessionTransactionState interface.
+func (s *TransactionState) SetTransactionDatabase(dbName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.transactionDb = dbName
+}
+
+// GetTransactionDatabase implements the SessionTransactionState interface.
+func (s *TransactionState) GetTransactionDatabase() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.transactionDb
+}
+
+// WarningStore is the base implementation of SessionWarnings. The zero value has no warnings.
+type WarningStore struct {
+	mu       sync.RWMutex
+	warnings []*Warning
+	warncnt  uint16
+}
+
+var _ SessionWarnings = (*WarningStore)(nil)
+
+// Warn implements the SessionWarnings interface.
+func (s *WarningStore) Warn(warn *Warning) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.warnings = append(s.warnings, warn)
+}
+
+// Warnings returns a copy of session warnings (from the most recent - the last one)
+// The function implements the SessionWarnings interface
+func (s *WarningStore) Warnings() []*Warning {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	n := len(s.warnings)
+	warns := make([]*Warning, n)
+	for i := 0; i < n; i++ {
+		warns[i] = s.warnings[n-i-1]
+	}
+
+	return warns
+}
+
+// ClearWarnings cleans up session warnings. Warnings are kept until the statement after the one that produced them
+// clears them, so that SHOW WARNINGS can report them.
+func (s *WarningStore) ClearWarnings() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cnt := uint16(len(s.warnings))
+	if s.warncnt == cnt {
+		if s.warnings != nil {
+			s.warnings = s.warnings[:0]
+		}
+		s.warncnt = 0
+	} else {
+		s.warncnt = cnt
+	}
+}
+
+// WarningCount returns a number of session warnings
+func (s *WarningStore) WarningCount() uint16 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return uint16(len(s.warnings))
+}
+
+// PrivilegeCache is the base implementation of SessionPrivilegeCache. The zero value has no privilege set cached.
+type PrivilegeCache struct {
+	mu sync.RWMutex
+	// When the MySQL database updates any tables related to privileges, it increments its counter. We then update our
+	// privilege set if our counter doesn't equal the database's counter.
+	privSetCounter uint64
+	privilegeSet   PrivilegeSet
+}
+
+var _ SessionPrivilegeCache = (*PrivilegeCache)(nil)
+
+// GetPrivilegeSet implements the SessionPrivilegeCache interface.
+func (s *PrivilegeCache) GetPrivilegeSet() (PrivilegeSet, uint64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.privilegeSet, s.privSetCounter
+}
+
+// SetPrivilegeSet implements the SessionPrivilegeCache interface.
+func (s *PrivilegeCache) SetPrivilegeSet(newPs PrivilegeSet, counter uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.privSetCounter = counter
+	s.privilegeSet = newPs
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// countingWarnings is a SessionWarnings replacing the base implementation of a session, as integrators do.
+type countingWarnings struct {
+	WarningStore
+	count int
+}
+
+func (w *countingWarnings) Warn(warn *Warning) {
+	w.count++
+	w.WarningStore.Warn(warn)
+}
+
+type composedSession struct {
+	*BaseSession
+	*countingWarnings
+}
+
+func (s composedSession) Warn(warn *Warning)   { s.countingWarnings.Warn(warn) }
+func (s composedSession) Warnings() []*Warning { return s.countingWarnings.Warnings() }
+func (s composedSession) ClearWarnings()       { s.countingWarnings.ClearWarnings() }
+func (s composedSession) WarningCount() uint16 { return s.countingWarnings.WarningCount() }
+
+var _ Session = composedSession{}
+
+func TestComposedSession(t *testing.T) {
+	require := require.New(t)
+	warnings := &countingWarnings{}
+	sess := composedSession{BaseSession: NewBaseSession(), countingWarnings: warnings}
+	ctx := NewContext(context.TODO(), WithSession(sess))
+
+	ctx.Warn(1000, "first")
+	ctx.Warn(1001, "second")
+	require.Equal(2, warnings.count)
+	require.Equal(uint16(2), sess.WarningCount())
+	require.Equal(uint16(0), sess.BaseSession.WarningCount())
+	require.Equal("second", sess.Warnings()[0].Message)
+
+	// warnings are kept until the statement after the one producing them clears them
+	sess.ClearWarnings()
+	require.Equal(uint16(2), sess.WarningCount())
+	sess.ClearWarnings()
+	require.Equal(uint16(0), sess.WarningCount())
+}
+
+func TestSessionFeatures(t *testing.T) {
+	require := require.New(t)
+
+	var txState TransactionState
+	require.Nil(txState.GetTransaction())
+	txState.SetTransactionDatabase("mydb")
+	txState.SetIgnoreAutoCommit(true)
+	require.Equal("mydb", txState.GetTransactionDatabase())
+	require.True(txState.GetIgnoreAutoCommit())
+
+	var privs PrivilegeCache
+	ps, counter := privs.GetPrivilegeSet()
+	require.Nil(ps)
+	require.Zero(counter)
+	privs.SetPrivilegeSet(nil, 3)
+	_, counter = privs.GetPrivilegeSet()
+	require.Equal(uint64(3), counter)
+
+	vars := NewVariableStore()
+	ctx := NewEmptyContext()
+	require.NoError(vars.SetUserVariable(ctx, "MyVar", int64(1), nil))
+	_, val, err := vars.GetUserVariable(ctx, "myvar")
+	require.NoError(err)
+	require.Equal(int64(1), val)
+	_, err = vars.GetSessionVariable(ctx, "no_such_variable")
+	require.True(ErrUnknownSystemVariable.Is(err))
+}
@@ -98,3 +98,26 @@ func TestSessionIterator(t *testing.T) {
 		counter++
 	}
 }
+
+// featurelessSession is a session without the optional features of sessions, since only the methods of the Session
+// interface are promoted from the session it wraps.
+type featurelessSession struct {
+	Session
+}
+
+func TestContextOptionalSessionFeatures(t *testing.T) {
+	require := require.New(t)
+
+	ctx := NewContext(context.Background(), WithSession(featurelessSession{NewBaseSession()}))
+	ctx.Warn(1105, "dropped")
+	require.Empty(ctx.Warnings())
+	require.Zero(ctx.WarningCount())
+	ctx.SetPrivilegeSet(nil, 1)
+	_, counter := ctx.GetPrivilegeSet()
+	require.Zero(counter)
+
+	ctx = NewContext(context.Background(), WithSession(NewBaseSession()))
+	ctx.Warn(1105, "kept")
+	require.Equal(uint16(1), ctx.WarningCount())
+	require.Equal("kept", ctx.Warnings()[0].Message)
+}
@@ -60,6 +60,24 @@ func GenerateCreateTableColumnDefinition(colName string, colType Type, nullable
 	return stmt
 }
 
+// GenerateCreateTableGeneratedColumnDefinition returns column definition string for 'CREATE TABLE' statement for given
+// generated column.
+func GenerateCreateTableGeneratedColumnDefinition(colName string, colType Type, nullable bool, generated string, virtual bool, comment string) string {
+	stmt := fmt.Sprintf("  %s %s GENERATED ALWAYS AS %s", QuoteIdentifier(colName), colType.String(), generated)
+	if virtual {
+		stmt = fmt.Sprintf("%s VIRTUAL", stmt)
+	} else {
+		stmt = fmt.Sprintf("%s STORED", stmt)
+	}
+	if !nullable {
+		stmt = fmt.Sprintf("%s NOT NULL", stmt)
+	}
+	if comment != "" {
+		stmt = fmt.Sprintf("%s COMMENT '%s'", stmt, comment)
+	}
+	return stmt
+}
+
 // GenerateCreateTablePrimaryKeyDefinition returns primary key definition string for 'CREATE TABLE' statement
 // for given column(s). This part comes after each column definitions.
 func GenerateCreateTablePrimaryKeyDefinition(pkCols []string) string {
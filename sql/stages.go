@@ -0,0 +1,86 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// The long-running stages of queries whose progress is reported in the process list.
+const (
+	// StageCopyToTmpTable is the copy of the rows of a table rewritten by an ALTER TABLE.
+	StageCopyToTmpTable = "copy to tmp table"
+	// StageBuildingIndex is the build of an index being added to a table, or the check of its rows for a unique one.
+	StageBuildingIndex = "building index"
+	// StageLoadingData is the read of the file of a LOAD DATA.
+	StageLoadingData = "loading data"
+	// StageCreatingSortIndex is the read of the rows of a sort, before they're sorted.
+	StageCreatingSortIndex = "creating sort index"
+)
+
+// The units of work of stages.
+const (
+	StageUnitRows  = "rows"
+	StageUnitBytes = "bytes"
+)
+
+// stageReportBatchSize is the number of units of work done in a stage after which a StageTracker reports its
+// progress, so that stages processing rows one by one don't lock the process list for each of them.
+const stageReportBatchSize = 1000
+
+// StageTracker reports the progress of a long-running stage of the query of a context to its process list, in batches
+// of units of work.
+type StageTracker struct {
+	ctx     *Context
+	pending int64
+}
+
+// StartStage sets the stage with the name given as the stage of the query of the context given, and returns a tracker
+// of its progress, which must be ended with End. The total is -1 if it's unknown.
+func StartStage(ctx *Context, name, unit string, total int64) *StageTracker {
+	ctx.ProcessList.SetStage(ctx.Pid(), name, unit, total)
+	return &StageTracker{ctx: ctx}
+}
+
+// Add adds n units to the work done in the stage.
+func (t *StageTracker) Add(n int64) {
+	t.pending += n
+	if t.pending >= stageReportBatchSize {
+		t.flush()
+	}
+}
+
+// End reports the work done in the stage that wasn't reported yet, and clears the stage of the query.
+func (t *StageTracker) End() {
+	t.flush()
+	t.ctx.ProcessList.SetStage(t.ctx.Pid(), "", "", 0)
+}
+
+func (t *StageTracker) flush() {
+	if t.pending > 0 {
+		t.ctx.ProcessList.UpdateStageProgress(t.ctx.Pid(), t.pending)
+		t.pending = 0
+	}
+}
+
+// TableRowCountEstimate returns the number of rows of the table given for the total of a stage reading them, or -1 if
+// the table doesn't provide it.
+func TableRowCountEstimate(ctx *Context, table Table) int64 {
+	st, ok := table.(StatisticsTable)
+	if !ok {
+		return -1
+	}
+	count, err := st.RowCount(ctx)
+	if err != nil {
+		return -1
+	}
+	return int64(count)
+}
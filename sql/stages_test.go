@@ -0,0 +1,59 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// stageRecordingProcessList is a process list that records the stage of its only process.
+type stageRecordingProcessList struct {
+	EmptyProcessList
+	stage   StageProgress
+	updates int
+}
+
+func (p *stageRecordingProcessList) SetStage(pid uint64, name, unit string, total int64) {
+	p.stage = StageProgress{Progress: Progress{Name: name, Total: total}, Unit: unit}
+}
+
+func (p *stageRecordingProcessList) UpdateStageProgress(pid uint64, delta int64) {
+	p.stage.Done += delta
+	p.updates++
+}
+
+func TestStageTracker(t *testing.T) {
+	require := require.New(t)
+
+	pl := &stageRecordingProcessList{}
+	ctx := NewContext(context.Background(), WithProcessList(pl))
+
+	stage := StartStage(ctx, StageCopyToTmpTable, StageUnitRows, 2500)
+	require.Equal(StageProgress{Progress: Progress{Name: StageCopyToTmpTable, Total: 2500}, Unit: StageUnitRows}, pl.stage)
+
+	for i := 0; i < 2500; i++ {
+		stage.Add(1)
+	}
+	// Progress is reported in batches, the rest of it when the stage ends
+	require.Equal(int64(2000), pl.stage.Done)
+	require.Equal(2, pl.updates)
+
+	stage.End()
+	require.Equal(StageProgress{}, pl.stage)
+	require.Equal(3, pl.updates)
+}
@@ -29,6 +29,15 @@ type StatisticsTable interface {
 	RowCount(ctx *Context) (uint64, error)
 }
 
+// RowCounter is a table that can count its rows without reading them, such as one that keeps track of its number of
+// rows. Unlike the RowCount of a StatisticsTable, which may be an estimate, the count must be exact, since COUNT(*)
+// over the whole table is answered with it.
+type RowCounter interface {
+	Table
+	// ExactRowCount returns the number of rows of the table.
+	ExactRowCount(ctx *Context) (uint64, error)
+}
+
 type StatsReader interface {
 	CatalogTable
 	// Hist returns a HistogramMap providing statistics for a table's columns
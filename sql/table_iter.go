@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"context"
 	"fmt"
 	"io"
 )
@@ -26,6 +27,8 @@ type TableRowIter struct {
 	partition  Partition
 	rows       RowIter
 	rows2      RowIter2
+	// watchdog runs the calls to the table's iterators when the context has an iterator grace period
+	watchdog *iterWatchdog
 }
 
 var _ RowIter = (*TableRowIter)(nil)
@@ -33,7 +36,24 @@ var _ RowIter2 = (*TableRowIter)(nil)
 
 // NewTableRowIter returns a new iterator over the rows in the partitions of the table given.
 func NewTableRowIter(ctx *Context, table Table, partitions PartitionIter) *TableRowIter {
-	return &TableRowIter{table: table, partitions: partitions}
+	i := &TableRowIter{table: table, partitions: partitions}
+	if grace := ctx.IteratorGracePeriod(); grace > 0 {
+		i.watchdog = newIterWatchdog(grace)
+	}
+	return i
+}
+
+// watch runs the function given, which calls the table's iterators, on the goroutine of the iterator's watchdog if it
+// has one.
+func (i *TableRowIter) watch(ctx *Context, f func() error) error {
+	if i.watchdog == nil {
+		return f()
+	}
+	var err error
+	if werr := i.watchdog.call(ctx, func() { err = f() }); werr != nil {
+		return werr
+	}
+	return err
 }
 
 func (i *TableRowIter) Next(ctx *Context) (Row, error) {
@@ -42,38 +62,47 @@ func (i *TableRowIter) Next(ctx *Context) (Row, error) {
 	}
 
 	if i.partition == nil {
-		partition, err := i.partitions.Next(ctx)
-		if err != nil {
+		err := i.watch(ctx, func() (err error) {
+			i.partition, err = i.partitions.Next(ctx)
 			if err == io.EOF {
 				if e := i.partitions.Close(ctx); e != nil {
-					return nil, e
+					return e
 				}
 			}
-
+			return err
+		})
+		if err != nil {
 			return nil, err
 		}
-
-		i.partition = partition
 	}
 
 	if i.rows == nil {
-		rows, err := i.table.PartitionRows(ctx, i.partition)
+		err := i.watch(ctx, func() (err error) {
+			i.rows, err = i.table.PartitionRows(ctx, i.partition)
+			return err
+		})
 		if err != nil {
 			return nil, err
 		}
-
-		i.rows = rows
 	}
 
-	row, err := i.rows.Next(ctx)
-	if err != nil && err == io.EOF {
-		if err = i.rows.Close(ctx); err != nil {
-			return nil, err
+	var row Row
+	err := i.watch(ctx, func() (err error) {
+		row, err = i.rows.Next(ctx)
+		if err == io.EOF {
+			err = i.rows.Close(ctx)
+			i.partition = nil
+			i.rows = nil
+			if err == nil {
+				err = io.EOF
+			}
 		}
-
-		i.partition = nil
-		i.rows = nil
+		return err
+	})
+	if err == io.EOF {
 		row, err = i.Next(ctx)
+	} else if err != nil {
+		return nil, err
 	}
 	select {
 	case <-ctx.Done():
@@ -132,6 +161,15 @@ func (i *TableRowIter) Next2(ctx *Context, frame *RowFrame) error {
 }
 
 func (i *TableRowIter) Close(ctx *Context) error {
+	if i.watchdog != nil {
+		return i.watchdog.stop(func() error {
+			return i.close(ctx)
+		})
+	}
+	return i.close(ctx)
+}
+
+func (i *TableRowIter) close(ctx *Context) error {
 	if i.rows != nil {
 		if err := i.rows.Close(ctx); err != nil {
 			_ = i.partitions.Close(ctx)
@@ -140,3 +178,121 @@ func (i *TableRowIter) Close(ctx *Context) error {
 	}
 	return i.partitions.Close(ctx)
 }
+
+// prefetchBatchSize is the number of rows in each batch read ahead by a PrefetchRowIter.
+const prefetchBatchSize = 128
+
+// prefetchBatch is a batch of rows read ahead by a PrefetchRowIter, or the error that ended the reads.
+type prefetchBatch struct {
+	rows []Row
+	err  error
+}
+
+// PrefetchRowIter reads the rows of another iterator ahead of its caller on a background goroutine, in batches, so
+// that the latency of reading partitions from a storage backend overlaps with the processing of the rows already read.
+// Rows are returned in the same order as the wrapped iterator returns them.
+type PrefetchRowIter struct {
+	iter    RowIter
+	batches chan prefetchBatch
+	// cancel cancels the context of the background goroutine, which closes |exited| when it returns
+	cancel context.CancelFunc
+	exited chan struct{}
+	batch  prefetchBatch
+	pos    int
+	closed bool
+}
+
+var _ RowIter = (*PrefetchRowIter)(nil)
+
+// NewPrefetchRowIter returns a new iterator over the rows of the iterator given, which reads up to |batches| batches of
+// rows ahead. The wrapped iterator is owned by the returned iterator, and is closed when it's closed.
+func NewPrefetchRowIter(ctx *Context, iter RowIter, batches int) *PrefetchRowIter {
+	prefetchCtx, cancel := ctx.NewSubContext()
+	i := &PrefetchRowIter{
+		iter:    iter,
+		batches: make(chan prefetchBatch, batches),
+		cancel:  cancel,
+		exited:  make(chan struct{}),
+	}
+	go i.prefetch(prefetchCtx)
+	return i
+}
+
+// prefetch reads the rows of the wrapped iterator into batches until it's exhausted, it fails, or the context given
+// is canceled.
+func (i *PrefetchRowIter) prefetch(ctx *Context) {
+	defer func() {
+		close(i.batches)
+		close(i.exited)
+	}()
+
+	for {
+		batch := readPrefetchBatch(ctx, i.iter)
+		select {
+		case i.batches <- batch:
+		case <-ctx.Done():
+			return
+		}
+		if batch.err != nil {
+			return
+		}
+	}
+}
+
+// readPrefetchBatch reads the next batch of rows of the iterator given.
+func readPrefetchBatch(ctx *Context, iter RowIter) (batch prefetchBatch) {
+	defer func() {
+		if r := recover(); r != nil {
+			batch.err = fmt.Errorf("panic in PrefetchRowIter: %v", r)
+		}
+	}()
+
+	batch.rows = make([]Row, 0, prefetchBatchSize)
+	for len(batch.rows) < prefetchBatchSize {
+		row, err := iter.Next(ctx)
+		if err != nil {
+			batch.err = err
+			break
+		}
+		batch.rows = append(batch.rows, row)
+	}
+	return batch
+}
+
+// Next implements the RowIter interface.
+func (i *PrefetchRowIter) Next(ctx *Context) (Row, error) {
+	for i.pos >= len(i.batch.rows) {
+		if i.batch.err != nil {
+			return nil, i.batch.err
+		}
+		select {
+		case batch, ok := <-i.batches:
+			if !ok {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+				return nil, io.EOF
+			}
+			i.batch = batch
+			i.pos = 0
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	row := i.batch.rows[i.pos]
+	i.pos++
+	return row, nil
+}
+
+// Close implements the RowIter interface. It stops the reads of the background goroutine, waits for it to return and
+// then closes the wrapped iterator.
+func (i *PrefetchRowIter) Close(ctx *Context) error {
+	if i.closed {
+		return nil
+	}
+	i.closed = true
+	i.cancel()
+	<-i.exited
+	return i.iter.Close(ctx)
+}
@@ -0,0 +1,236 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefetchRowIter(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+
+	var rows []Row
+	for i := 0; i < 3*prefetchBatchSize+5; i++ {
+		rows = append(rows, NewRow(int64(i)))
+	}
+
+	iter := NewPrefetchRowIter(ctx, RowsToRowIter(rows...), 2)
+	result, err := RowIterToRows(ctx, nil, iter)
+	require.NoError(err)
+	require.Equal(rows, result)
+
+	_, err = iter.Next(ctx)
+	require.Equal(io.EOF, err)
+	require.NoError(iter.Close(ctx))
+}
+
+func TestPrefetchRowIterError(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+
+	expected := errors.New("read failed")
+	child := &failingRowIter{rows: []Row{NewRow(1), NewRow(2)}, err: expected}
+	iter := NewPrefetchRowIter(ctx, child, 1)
+
+	row, err := iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(NewRow(1), row)
+	row, err = iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(NewRow(2), row)
+	_, err = iter.Next(ctx)
+	require.Equal(expected, err)
+
+	require.NoError(iter.Close(ctx))
+	require.True(child.closed)
+}
+
+func TestPrefetchRowIterClose(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+
+	child := &failingRowIter{err: io.EOF}
+	for i := 0; i < 10*prefetchBatchSize; i++ {
+		child.rows = append(child.rows, NewRow(i))
+	}
+	iter := NewPrefetchRowIter(ctx, child, 1)
+
+	row, err := iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(NewRow(0), row)
+
+	require.NoError(iter.Close(ctx))
+	require.True(child.closed)
+	require.Less(child.pos, len(child.rows))
+}
+
+func TestPrefetchRowIterCloseWaits(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+
+	child := &cancelWaitingRowIter{started: make(chan struct{})}
+	iter := NewPrefetchRowIter(ctx, child, 1)
+	<-child.started
+
+	// The goroutine reads with its own context, which is canceled by Close before the child is closed
+	require.NoError(iter.Close(ctx))
+	require.True(child.closed)
+	require.True(child.returned)
+	require.NoError(ctx.Err())
+}
+
+// cancelWaitingRowIter blocks in Next until the context it's called with is canceled.
+type cancelWaitingRowIter struct {
+	started  chan struct{}
+	returned bool
+	closed   bool
+}
+
+func (i *cancelWaitingRowIter) Next(ctx *Context) (Row, error) {
+	close(i.started)
+	<-ctx.Done()
+	i.returned = true
+	return nil, ctx.Err()
+}
+
+func (i *cancelWaitingRowIter) Close(*Context) error {
+	i.closed = true
+	return nil
+}
+
+// failingRowIter returns its rows and then the error given.
+type failingRowIter struct {
+	rows   []Row
+	err    error
+	pos    int
+	closed bool
+}
+
+func (i *failingRowIter) Next(*Context) (Row, error) {
+	if i.pos >= len(i.rows) {
+		return nil, i.err
+	}
+	row := i.rows[i.pos]
+	i.pos++
+	return row, nil
+}
+
+func (i *failingRowIter) Close(*Context) error {
+	i.closed = true
+	return nil
+}
+
+func TestCancelableRowIter(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := NewEmptyContext().NewSubContext()
+
+	child := &failingRowIter{rows: []Row{NewRow(1), NewRow(2)}, err: io.EOF}
+	iter := NewCancelableRowIter(child)
+	row, err := iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(NewRow(1), row)
+
+	cancel()
+	_, err = iter.Next(ctx)
+	require.Equal(context.Canceled, err)
+	require.Equal(1, child.pos)
+	require.NoError(iter.Close(ctx))
+	require.True(child.closed)
+
+	partitions := NewCancelablePartitionIter(PartitionsToPartitionIter(testPartition("p")))
+	_, err = partitions.Next(ctx)
+	require.Equal(context.Canceled, err)
+}
+
+func TestTableRowIterWatchdog(t *testing.T) {
+	t.Run("responsive iterators", func(t *testing.T) {
+		require := require.New(t)
+		ctx := NewContext(context.Background(), WithIteratorGracePeriod(time.Second))
+		child := &failingRowIter{rows: []Row{NewRow(1), NewRow(2)}, err: io.EOF}
+		table := &testTable{rows: child}
+		iter := NewTableRowIter(ctx, table, PartitionsToPartitionIter(testPartition("p")))
+		rows, err := RowIterToRows(ctx, nil, iter)
+		require.NoError(err)
+		require.Equal([]Row{NewRow(1), NewRow(2)}, rows)
+		require.True(child.closed)
+	})
+
+	t.Run("unresponsive iterator", func(t *testing.T) {
+		require := require.New(t)
+		ctx, cancel := NewContext(context.Background(), WithIteratorGracePeriod(10*time.Millisecond)).NewSubContext()
+		unblock := make(chan struct{})
+		closed := make(chan struct{})
+		table := &testTable{rows: &blockingRowIter{unblock: unblock, closed: closed}}
+		iter := NewTableRowIter(ctx, table, PartitionsToPartitionIter(testPartition("p")))
+
+		time.AfterFunc(10*time.Millisecond, cancel)
+		_, err := iter.Next(ctx)
+		require.True(ErrIteratorUnresponsive.Is(err), "unexpected error: %v", err)
+		require.NoError(iter.Close(ctx))
+
+		// The abandoned iterator is closed once its call returns
+		close(unblock)
+		select {
+		case <-closed:
+		case <-time.After(time.Second):
+			require.Fail("abandoned iterator wasn't closed")
+		}
+	})
+}
+
+type testPartition string
+
+func (p testPartition) Key() []byte { return []byte(p) }
+
+// testTable is a table with a single partition, whose rows are those of the iterator given.
+type testTable struct {
+	rows RowIter
+}
+
+func (t *testTable) Name() string           { return "test" }
+func (t *testTable) String() string         { return "test" }
+func (t *testTable) Schema() Schema         { return nil }
+func (t *testTable) Collation() CollationID { return Collation_Default }
+
+func (t *testTable) Partitions(*Context) (PartitionIter, error) {
+	return PartitionsToPartitionIter(testPartition("p")), nil
+}
+
+func (t *testTable) PartitionRows(*Context, Partition) (RowIter, error) {
+	return t.rows, nil
+}
+
+// blockingRowIter blocks in Next, ignoring its context, until it's unblocked.
+type blockingRowIter struct {
+	unblock chan struct{}
+	closed  chan struct{}
+}
+
+func (i *blockingRowIter) Next(*Context) (Row, error) {
+	<-i.unblock
+	return nil, io.EOF
+}
+
+func (i *blockingRowIter) Close(*Context) error {
+	close(i.closed)
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// The types of the messages of the table maintenance statements, given in their Msg_type column.
+const (
+	TableMaintenanceStatus  = "status"
+	TableMaintenanceError   = "error"
+	TableMaintenanceInfo    = "info"
+	TableMaintenanceNote    = "note"
+	TableMaintenanceWarning = "warning"
+)
+
+// TableMaintenanceMessage is a message about a table reported by a table maintenance statement, such as CHECK TABLE,
+// as a row of its result.
+type TableMaintenanceMessage struct {
+	// Type is the Msg_type of the message, such as TableMaintenanceError.
+	Type string
+	Text string
+}
+
+// ChecksumTable is a table that keeps a live checksum of its rows, which CHECKSUM TABLE returns instead of computing
+// one from the rows of the table. Without the EXTENDED option, CHECKSUM TABLE uses the checksum of the table, and with
+// the QUICK option, it returns NULL for tables that don't keep one.
+type ChecksumTable interface {
+	Table
+	// TableChecksum returns the checksum of the rows of the table.
+	TableChecksum(ctx *Context) (uint64, error)
+}
+
+// CheckableTable is a table that checks its storage for errors when CHECK TABLE is run on it, in addition to the
+// verification of its constraints by the engine.
+type CheckableTable interface {
+	Table
+	// CheckTable checks the table with the options of the CHECK TABLE statement given, such as QUICK or EXTENDED,
+	// and returns messages about the problems found, if any. Messages of type TableMaintenanceError mark the table
+	// as corrupt.
+	CheckTable(ctx *Context, options []string) ([]TableMaintenanceMessage, error)
+}
+
+// OptimizableTable is a table that reorganizes its storage when OPTIMIZE TABLE is run on it. OPTIMIZE TABLE leaves
+// other tables untouched, with a note that they don't support it.
+type OptimizableTable interface {
+	Table
+	// OptimizeTable reorganizes the storage of the table.
+	OptimizeTable(ctx *Context) error
+}
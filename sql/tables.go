@@ -14,7 +14,10 @@
 
 package sql
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // Table is a SQL table.
 type Table interface {
@@ -26,7 +29,8 @@ type Table interface {
 	Collation() CollationID
 	// Partitions returns the table's partitions in an iterator.
 	Partitions(*Context) (PartitionIter, error)
-	// PartitionRows returns the rows in the given partition, which was returned by Partitions.
+	// PartitionRows returns the rows in the given partition, which was returned by Partitions. The iterators of tables
+	// must return the error of the context they're called with promptly once it's canceled, see NewCancelableRowIter.
 	PartitionRows(*Context, Partition) (RowIter, error)
 }
 
@@ -49,6 +53,96 @@ type TemporaryTable interface {
 	IsTemporary() bool
 }
 
+// IsTemporaryTable returns whether the table given, or the table it wraps, is a TemporaryTable that is temporary.
+func IsTemporaryTable(table Table) bool {
+	for {
+		if t, ok := table.(TemporaryTable); ok {
+			return t.IsTemporary()
+		}
+		wrapper, ok := table.(TableWrapper)
+		if !ok {
+			return false
+		}
+		table = wrapper.Underlying()
+	}
+}
+
+// DataVersionedTable is a table reporting a version token of its data, which changes every time the table's data or
+// schema change. The engine's result cache uses it to tell whether a cached result of a query reading the table is
+// still valid, so a token must never be reported for different data of the table, including by a table dropped and
+// created again with the same name.
+type DataVersionedTable interface {
+	Table
+	// DataVersion returns the current version token of the table's data, as seen by the session of the context given
+	DataVersion(ctx *Context) (string, error)
+}
+
+// RowChange is a change to a row of a table. Old is nil for inserted rows, and New is nil for deleted rows.
+type RowChange struct {
+	Old Row
+	New Row
+}
+
+// TableChangeListener is notified of the changes to the rows of the tables it listens to.
+type TableChangeListener interface {
+	// RowsChanged is called with the rows changed by a statement writing the table given, in the order they were
+	// changed, once the statement's edits are complete. Listeners are called on the goroutine running the statement,
+	// before its transaction commits.
+	RowsChanged(ctx *Context, table Table, changes []RowChange)
+}
+
+// ChangeNotifyingTable is an editable table notifying listeners of the changes to its rows, such as the maintainers
+// of the materialized views reading it. Rows removed by truncating the table are reported as deleted.
+type ChangeNotifyingTable interface {
+	Table
+	// AddChangeListener adds a listener notified of the changes to the rows of the table
+	AddChangeListener(listener TableChangeListener)
+	// RemoveChangeListener removes a listener added with AddChangeListener
+	RemoveChangeListener(listener TableChangeListener)
+}
+
+// SystemTimePeriod names the columns of a system-versioned table holding the times each version of a row started and
+// stopped being current, declared with GENERATED ALWAYS AS ROW START and ROW END.
+type SystemTimePeriod struct {
+	Start string
+	End   string
+}
+
+// VersionedTable is a table that can be system-versioned, keeping the past versions of its rows so that they can be
+// read with FOR SYSTEM_TIME AS OF or AS OF. The engine reads the past versions of such tables in databases that aren't
+// VersionedDatabases.
+type VersionedTable interface {
+	Table
+	// SystemTimePeriod returns the period of the table, and whether it's system-versioned
+	SystemTimePeriod() (SystemTimePeriod, bool)
+	// AsOf returns the rows of the table as they were at the time given. Tables that aren't system-versioned return
+	// ErrAsOfNotSupported.
+	AsOf(ctx *Context, asOf time.Time) (Table, error)
+}
+
+// SystemVersionableTable is a VersionedTable that can be made system-versioned, as it is when it's created by a
+// CREATE TABLE ... WITH SYSTEM VERSIONING statement.
+type SystemVersionableTable interface {
+	VersionedTable
+	// EnableSystemVersioning makes the table system-versioned with the period given, whose columns are DATETIME or
+	// TIMESTAMP columns of the table. The table sets the values of the period columns of the rows it stores.
+	EnableSystemVersioning(ctx *Context, period SystemTimePeriod) error
+}
+
+// GetChangeNotifyingTable returns the table given, or the table it wraps, if it's a ChangeNotifyingTable.
+func GetChangeNotifyingTable(table Table) (ChangeNotifyingTable, bool) {
+	for {
+		if t, ok := table.(ChangeNotifyingTable); ok {
+			return t, true
+		}
+		wrapper, ok := table.(TableWrapper)
+		if !ok {
+			return nil, false
+		}
+		table = wrapper.Underlying()
+	}
+}
+
 // TableWrapper is a node that wraps the real table. This is needed because wrappers cannot implement some methods the
 // table may implement. This interface is used in analysis and planning and is not expected to be implemented by
 // integrators.
@@ -83,6 +177,32 @@ type ProjectedTable interface {
 	Projections() []string
 }
 
+// SortedTable is a table that can return its rows from RowIter in the order of sort fields that would otherwise be
+// applied by a separate Sort node. When sort fields are applied, the rows of all partitions, read one partition after
+// another in the order returned by Partitions, must be in that order.
+type SortedTable interface {
+	Table
+	// SortFields returns the sort fields that have been applied to this table, or nil if none have.
+	SortFields() SortFields
+	// CanSort returns whether this table can return its rows in the order of the sort fields given, whose columns are
+	// GetField expressions on the schema of this table.
+	CanSort(sortFields SortFields) bool
+	// WithSortFields returns a table that returns its rows in the order of the sort fields given.
+	WithSortFields(sortFields SortFields) Table
+}
+
+// LimitedTable is a table that can skip rows and stop returning rows from RowIter after a limit, which would
+// otherwise be done by separate Offset and Limit nodes. The limit and offset apply to the rows of all partitions
+// together, after any filters applied to the table and in the order of any sort fields applied to it. An IndexedTable
+// that's a LimitedTable applies them to the rows of each lookup, in the order of its index.
+type LimitedTable interface {
+	Table
+	// Limit returns the limit and offset that have been applied to this table, and whether any has been applied.
+	Limit() (limit, offset int64, ok bool)
+	// WithLimit returns a table that skips |offset| rows and returns at most |limit| rows after them.
+	WithLimit(limit, offset int64) Table
+}
+
 // IndexAddressable is a table that can be scanned through a primary index
 type IndexAddressable interface {
 	// IndexedAccess returns a table that can perform scans constrained to
@@ -99,6 +219,28 @@ type IndexAddressableTable interface {
 	IndexAddressable
 }
 
+// IndexDistinctTable is a table that can iterate the distinct values of the first column of its indexes without
+// reading and hashing all of its rows, such as by skipping from each key of an index to the next different one. It
+// answers queries like SELECT DISTINCT col FROM t and SELECT COUNT(DISTINCT col) FROM t for indexed columns.
+type IndexDistinctTable interface {
+	IndexAddressableTable
+	// DistinctIndexValues returns a row for each distinct value of the first expression of the index given, one of the
+	// indexes returned by GetIndexes, including NULL if any row has it, in the order of the index.
+	DistinctIndexValues(ctx *Context, idx Index) (RowIter, error)
+}
+
+// UnenforcedUniqueKeyTable is a table that can leave the enforcement of the unique keys of its unique indexes to the
+// engine, for backends without native unique indexes. Before a row is inserted into or updated in such a table, the
+// engine looks up the rows with the same values for the columns of each unique index, in the order of GetIndexes, and
+// fails the edit with a unique key error for the first one it finds. Rows with a NULL value for any column of an index
+// never conflict on that index. If the editor of the table is an IndexAddressable, the lookups are made through it, so
+// they must include the edits made by the statement so far.
+type UnenforcedUniqueKeyTable interface {
+	IndexAddressableTable
+	// UnenforcedUniqueKeys returns whether the engine has to enforce the unique keys of this table.
+	UnenforcedUniqueKeys() bool
+}
+
 // IndexedTable is a table with an index chosen for range scans
 type IndexedTable interface {
 	Table
@@ -106,6 +248,29 @@ type IndexedTable interface {
 	LookupPartitions(*Context, IndexLookup) (PartitionIter, error)
 }
 
+// IndexConditionTable is an IndexedTable that can evaluate a condition on the columns of its index while it reads the
+// entries of the index for a lookup, and only fetch the rows of the entries that satisfy it. This is MySQL's index
+// condition pushdown, which saves fetching the rows of a secondary index that a filter would remove.
+type IndexConditionTable interface {
+	IndexedTable
+	// IndexCondition returns the condition that has been applied to this table, or nil if none has.
+	IndexCondition() Expression
+	// WithIndexCondition returns a table that only returns the rows of a lookup whose index entries satisfy the
+	// condition given, whose columns are GetField expressions on the schema of this table. The condition only
+	// references columns of the index.
+	WithIndexCondition(cond Expression) Table
+}
+
+// PointLookupTable is an IndexedTable that can read the row matched by a point lookup, a lookup of the full key of a
+// unique index by equality, directly. Point lookups of these tables skip the partitions and row iterators of other
+// lookups, whose fixed cost dominates the cost of reading a single row.
+type PointLookupTable interface {
+	IndexedTable
+	// PointLookup returns the rows matched by the point lookup given, of which there's at most one unless the table
+	// doesn't enforce the uniqueness of the index.
+	PointLookup(ctx *Context, lookup IndexLookup) ([]Row, error)
+}
+
 // IndexAlterableTable represents a table that supports index modification operations.
 type IndexAlterableTable interface {
 	Table
@@ -224,6 +389,27 @@ type RowInserter interface {
 	Closer
 }
 
+// BulkInsertTable is a table that can insert rows in batches, which is faster than inserting them one at a time. The
+// engine uses it for inserts that don't have to observe the effects of each row before inserting the next, such as LOAD
+// DATA and INSERT statements with many values, when the insert has no triggers, isn't an INSERT IGNORE, REPLACE or
+// INSERT ... ON DUPLICATE KEY UPDATE, and the table has no foreign keys.
+type BulkInsertTable interface {
+	InsertableTable
+	// BulkInserter returns a BulkRowInserter for this table. The BulkRowInserter will get calls to InsertBatch with the
+	// rows to be inserted, and will end with a call to Close() to finalize the insert operation.
+	BulkInserter(*Context) BulkRowInserter
+}
+
+// BulkRowInserter is an insert cursor that can insert batches of rows to a table.
+type BulkRowInserter interface {
+	RowInserter
+	// InsertBatch inserts the rows given, in order, returning an error for the first one that cannot be inserted. The
+	// inserter may defer maintaining the indexes of the table until the statement is complete, so the rows inserted by
+	// a statement don't have to be visible to lookups until then. Rows with duplicate keys must still be rejected by the
+	// batch they're in, whether they conflict with the rows of the table or with the rows inserted before them.
+	InsertBatch(*Context, []Row) error
+}
+
 // DeletableTable is a table that can delete rows.
 type DeletableTable interface {
 	Table
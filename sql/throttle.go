@@ -0,0 +1,213 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// ThrottleRule limits the queries matching it, which are the queries of a statement digest, of a user, or of a
+// statement digest run by a user. The limits of a rule are shared by all the queries matching it.
+type ThrottleRule struct {
+	// Digest restricts the rule to the statements with this digest, as returned by StatementDigest, if set
+	Digest string
+	// User restricts the rule to the queries of this user, if set
+	User string
+	// MaxConcurrency is the number of matching queries that may run at the same time. A query runs until its rows
+	// have been returned and its iterator closed. There's no limit if it's zero.
+	MaxConcurrency int
+	// MaxQPS is the number of matching queries that may start every second. There's no limit if it's zero.
+	MaxQPS float64
+	// QueueTimeout is how long a query exceeding a limit waits for the limit to allow it before it's rejected with
+	// ErrQueryThrottled. Queries exceeding a limit are rejected immediately if it's zero.
+	QueueTimeout time.Duration
+}
+
+// QueryThrottler limits the number of queries that run at the same time and start every second, according to its
+// throttle rules. Integrators set the engine's query throttler to protect instances shared by many clients from
+// clients running too many or too expensive queries.
+type QueryThrottler struct {
+	mu    sync.RWMutex
+	rules []*throttleRuleState
+}
+
+// throttleRuleState is the state of the limits of a throttle rule.
+type throttleRuleState struct {
+	ThrottleRule
+	// slots holds a value for each running query, if the rule limits concurrency
+	slots chan struct{}
+	mu    sync.Mutex
+	// tokens is the number of queries that may start before the rate limit is exceeded, refilled at MaxQPS per second
+	tokens     float64
+	lastRefill time.Time
+}
+
+// NewQueryThrottler returns a new query throttler with the rules given.
+func NewQueryThrottler(rules ...ThrottleRule) *QueryThrottler {
+	t := &QueryThrottler{}
+	t.SetRules(rules...)
+	return t
+}
+
+// SetRules replaces the rules of this throttler with the ones given. Queries already running aren't counted against
+// the limits of the new rules.
+func (t *QueryThrottler) SetRules(rules ...ThrottleRule) {
+	states := make([]*throttleRuleState, len(rules))
+	now := time.Now()
+	for i, rule := range rules {
+		states[i] = &throttleRuleState{ThrottleRule: rule, tokens: rule.burst(), lastRefill: now}
+		if rule.MaxConcurrency > 0 {
+			states[i].slots = make(chan struct{}, rule.MaxConcurrency)
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rules = states
+}
+
+// Rules returns the rules of this throttler.
+func (t *QueryThrottler) Rules() []ThrottleRule {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	rules := make([]ThrottleRule, len(t.rules))
+	for i, rule := range t.rules {
+		rules[i] = rule.ThrottleRule
+	}
+	return rules
+}
+
+// HasRules returns whether this throttler has any rules.
+func (t *QueryThrottler) HasRules() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.rules) > 0
+}
+
+// Acquire waits until the limits of every rule matching the statement digest given and the user of the context given
+// allow the query to run, and returns the function to call once it's done running. It returns ErrQueryThrottled if a
+// limit doesn't allow the query before the queue timeout of its rule, or the error of the context if it's canceled.
+func (t *QueryThrottler) Acquire(ctx *Context, digest string) (func(), error) {
+	t.mu.RLock()
+	rules := t.rules
+	t.mu.RUnlock()
+
+	user := ctx.Session.Client().User
+	var acquired []*throttleRuleState
+	release := func() {
+		for _, rule := range acquired {
+			rule.releaseSlot()
+		}
+	}
+	for _, rule := range rules {
+		if !rule.matches(digest, user) {
+			continue
+		}
+		if err := rule.acquire(ctx); err != nil {
+			release()
+			return nil, err
+		}
+		acquired = append(acquired, rule)
+	}
+
+	var once sync.Once
+	return func() { once.Do(release) }, nil
+}
+
+// burst returns the number of queries that may start at once before the rate limit of this rule is exceeded.
+func (r ThrottleRule) burst() float64 {
+	if r.MaxQPS < 1 {
+		return 1
+	}
+	return r.MaxQPS
+}
+
+func (r *throttleRuleState) matches(digest, user string) bool {
+	return (r.Digest == "" || strings.EqualFold(r.Digest, digest)) && (r.User == "" || r.User == user)
+}
+
+// acquire waits for the limits of this rule to allow a query to run, for up to the queue timeout of the rule.
+func (r *throttleRuleState) acquire(ctx *Context) error {
+	deadline := time.Now().Add(r.QueueTimeout)
+
+	if r.slots != nil {
+		select {
+		case r.slots <- struct{}{}:
+		default:
+			if r.QueueTimeout <= 0 {
+				return ErrQueryThrottled.New("concurrency", r.MaxConcurrency)
+			}
+			timer := time.NewTimer(r.QueueTimeout)
+			defer timer.Stop()
+			select {
+			case r.slots <- struct{}{}:
+			case <-timer.C:
+				return ErrQueryThrottled.New("concurrency", r.MaxConcurrency)
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	if r.MaxQPS > 0 {
+		for {
+			wait := r.takeToken()
+			if wait == 0 {
+				break
+			}
+			if time.Now().Add(wait).After(deadline) {
+				r.releaseSlot()
+				return ErrQueryThrottled.New("rate", r.MaxQPS)
+			}
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				r.releaseSlot()
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// takeToken takes a token of the rate limit of this rule if there is one, returning zero, or returns how long it
+// takes for the next token to be available.
+func (r *throttleRuleState) takeToken() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.tokens += now.Sub(r.lastRefill).Seconds() * r.MaxQPS
+	if burst := r.burst(); r.tokens > burst {
+		r.tokens = burst
+	}
+	r.lastRefill = now
+
+	if r.tokens >= 1 {
+		r.tokens--
+		return 0
+	}
+	return time.Duration((1 - r.tokens) / r.MaxQPS * float64(time.Second))
+}
+
+func (r *throttleRuleState) releaseSlot() {
+	if r.slots != nil {
+		<-r.slots
+	}
+}
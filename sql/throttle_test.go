@@ -0,0 +1,109 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newThrottleTestContext(user string) *Context {
+	return NewContext(context.Background(), WithSession(NewBaseSessionWithClientServer("", Client{User: user, Address: "localhost"}, 1)))
+}
+
+func TestQueryThrottlerConcurrency(t *testing.T) {
+	require := require.New(t)
+	throttler := NewQueryThrottler(ThrottleRule{Digest: "abc", MaxConcurrency: 1})
+	ctx := newThrottleTestContext("alice")
+
+	release, err := throttler.Acquire(ctx, "abc")
+	require.NoError(err)
+
+	_, err = throttler.Acquire(ctx, "abc")
+	require.True(ErrQueryThrottled.Is(err))
+
+	// other digests aren't limited by the rule
+	other, err := throttler.Acquire(ctx, "def")
+	require.NoError(err)
+	other()
+
+	release()
+	// releasing twice doesn't free a slot held by another query
+	release()
+	release, err = throttler.Acquire(ctx, "abc")
+	require.NoError(err)
+	_, err = throttler.Acquire(ctx, "abc")
+	require.True(ErrQueryThrottled.Is(err))
+	release()
+
+	throttler.SetRules()
+	require.False(throttler.HasRules())
+}
+
+func TestQueryThrottlerQueue(t *testing.T) {
+	require := require.New(t)
+	throttler := NewQueryThrottler(ThrottleRule{User: "alice", MaxConcurrency: 1, QueueTimeout: time.Second})
+	alice := newThrottleTestContext("alice")
+
+	release, err := throttler.Acquire(alice, "abc")
+	require.NoError(err)
+
+	// queries of other users aren't limited by the rule
+	bob, err := throttler.Acquire(newThrottleTestContext("bob"), "abc")
+	require.NoError(err)
+	bob()
+
+	// queued queries run once the running query is done
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		release()
+	}()
+	queued, err := throttler.Acquire(alice, "def")
+	require.NoError(err)
+
+	// queued queries are rejected once their context is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := alice.WithContext(ctx)
+	cancel()
+	_, err = throttler.Acquire(canceled, "abc")
+	require.ErrorIs(err, context.Canceled)
+	queued()
+}
+
+func TestQueryThrottlerRate(t *testing.T) {
+	require := require.New(t)
+	throttler := NewQueryThrottler(ThrottleRule{MaxQPS: 2})
+	ctx := newThrottleTestContext("alice")
+
+	for i := 0; i < 2; i++ {
+		release, err := throttler.Acquire(ctx, "abc")
+		require.NoError(err)
+		release()
+	}
+	_, err := throttler.Acquire(ctx, "abc")
+	require.True(ErrQueryThrottled.Is(err))
+
+	throttler.SetRules(ThrottleRule{MaxQPS: 20, QueueTimeout: time.Second})
+	start := time.Now()
+	for i := 0; i < 21; i++ {
+		release, err := throttler.Acquire(ctx, "abc")
+		require.NoError(err)
+		release()
+	}
+	require.GreaterOrEqual(time.Since(start), 40*time.Millisecond)
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"strings"
+)
+
+// TwoPhaseTransaction is a transaction in a single database that is committed in two phases, so that a transaction
+// writing to several databases is either committed in all of them or in none. Every database written by the
+// transaction is asked to prepare its commit before any of them is asked to commit. Once a transaction has been
+// prepared successfully, its database must be able to commit it.
+type TwoPhaseTransaction interface {
+	Transaction
+	// Prepare makes the changes of the transaction durable without making them visible, or returns an error if they
+	// can't be committed.
+	Prepare(ctx *Context) error
+	// Commit commits the changes of the transaction, which has been prepared.
+	Commit(ctx *Context) error
+	// Rollback discards the changes of the transaction, whether it has been prepared or not.
+	Rollback(ctx *Context) error
+}
+
+// TwoPhaseCommitDatabase is a Database whose writes take part in a transaction committed in two phases. The engine
+// starts a TwoPhaseTransaction in each such database written by a transaction, and commits or rolls back all of them
+// when the transaction ends.
+type TwoPhaseCommitDatabase interface {
+	Database
+	// StartTwoPhaseTransaction starts a transaction for the writes of the current transaction of the session in this
+	// database.
+	StartTwoPhaseTransaction(ctx *Context) (TwoPhaseTransaction, error)
+}
+
+// StartTwoPhaseTransaction starts a two-phase transaction in the database given for the current transaction of the
+// session, if it's a TwoPhaseCommitDatabase and the transaction hasn't written to it yet.
+func StartTwoPhaseTransaction(ctx *Context, db Database) error {
+	tpcDb, ok := db.(TwoPhaseCommitDatabase)
+	if !ok {
+		return nil
+	}
+	name := strings.ToLower(db.Name())
+	txs := ctx.GetTwoPhaseTransactions()
+	if _, ok := txs[name]; ok {
+		return nil
+	}
+
+	tx, err := tpcDb.StartTwoPhaseTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	newTxs := make(map[string]TwoPhaseTransaction, len(txs)+1)
+	for n, t := range txs {
+		newTxs[n] = t
+	}
+	newTxs[name] = tx
+	ctx.SetTwoPhaseTransactions(newTxs)
+	return nil
+}
+
+// CommitTwoPhaseTransactions commits the two-phase transactions of the current transaction of the session. All of
+// them are prepared before any of them is committed, and if any of them fails to prepare, all of them are rolled back
+// and an ErrTwoPhaseCommitPrepare is returned.
+func CommitTwoPhaseTransactions(ctx *Context) error {
+	txs, names := takeTwoPhaseTransactions(ctx)
+	for _, name := range names {
+		if err := txs[name].Prepare(ctx); err != nil {
+			rollbackTwoPhaseTransactions(ctx, txs, names)
+			return ErrTwoPhaseCommitPrepare.New(name, err.Error())
+		}
+	}
+
+	var commitErr error
+	for _, name := range names {
+		ctx.GetLogger().Tracef("committing two-phase transaction %s in database %s", txs[name], name)
+		if err := txs[name].Commit(ctx); err != nil && commitErr == nil {
+			commitErr = err
+		}
+	}
+	return commitErr
+}
+
+// RollbackTwoPhaseTransactions rolls back the two-phase transactions of the current transaction of the session.
+func RollbackTwoPhaseTransactions(ctx *Context) error {
+	txs, names := takeTwoPhaseTransactions(ctx)
+	return rollbackTwoPhaseTransactions(ctx, txs, names)
+}
+
+// takeTwoPhaseTransactions removes the two-phase transactions from the session, and returns them along with the names
+// of their databases in sorted order.
+func takeTwoPhaseTransactions(ctx *Context) (map[string]TwoPhaseTransaction, []string) {
+	txs := ctx.GetTwoPhaseTransactions()
+	if len(txs) == 0 {
+		return nil, nil
+	}
+	ctx.SetTwoPhaseTransactions(nil)
+
+	names := make([]string, 0, len(txs))
+	for name := range txs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return txs, names
+}
+
+// rollbackTwoPhaseTransactions rolls back all of the transactions given, and returns the first error encountered.
+func rollbackTwoPhaseTransactions(ctx *Context, txs map[string]TwoPhaseTransaction, names []string) error {
+	var rollbackErr error
+	for _, name := range names {
+		if err := txs[name].Rollback(ctx); err != nil {
+			ctx.GetLogger().Warnf("unable to roll back transaction in database %s: %s", name, err.Error())
+			if rollbackErr == nil {
+				rollbackErr = err
+			}
+		}
+	}
+	return rollbackErr
+}
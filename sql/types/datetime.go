@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
 	"github.com/shopspring/decimal"
@@ -86,6 +87,10 @@ var (
 	datetimeValueType = reflect.TypeOf(time.Time{})
 )
 
+func init() {
+	sql.RegisterErrorCode(mysql.ERTruncatedWrongValue, "22007", ErrConvertingToTime)
+}
+
 type datetimeType struct {
 	baseType query.Type
 }
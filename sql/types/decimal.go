@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
 	"github.com/shopspring/decimal"
@@ -45,6 +46,10 @@ var (
 	decimalValueType = reflect.TypeOf(decimal.Decimal{})
 )
 
+func init() {
+	sql.RegisterErrorCode(mysql.ERWarnDataOutOfRange, mysql.SSDataOutOfRange, ErrConvertToDecimalLimit)
+}
+
 type DecimalType_ struct {
 	exclusiveUpperBound decimal.Decimal
 	definesColumn       bool
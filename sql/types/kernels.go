@@ -0,0 +1,360 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/cespare/xxhash"
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// HashFunc writes a value of a type to a hash. Values that the Compare method of the type considers equal are written
+// the same way, as long as they're represented by the same Go type.
+type HashFunc func(h hash.Hash64, v interface{}) error
+
+// Comparator compares values of a type, with the same result as the Compare method of the type. For integers, floats,
+// strings with a binary collation and datetimes, it compares the values that the Convert method of the type returns
+// without converting them, and only falls back to the Compare method of the type for values of any other Go type. It's
+// meant to be created once for expressions whose type is known, instead of comparing each row through the type.
+type Comparator struct {
+	typ  sql.Type
+	kind comparatorKind
+}
+
+type comparatorKind uint8
+
+const (
+	compareWithType comparatorKind = iota
+	compareInt8
+	compareInt16
+	compareInt32
+	compareInt64
+	compareUint8
+	compareUint16
+	compareUint32
+	compareUint64
+	compareFloat32
+	compareFloat64
+	compareBinaryString
+	compareTime
+)
+
+// NewComparator returns a Comparator for the type given.
+func NewComparator(t sql.Type) Comparator {
+	kind := compareWithType
+	switch t := t.(type) {
+	case NumberTypeImpl_:
+		switch t.baseType {
+		case sqltypes.Int8:
+			kind = compareInt8
+		case sqltypes.Int16:
+			kind = compareInt16
+		case sqltypes.Int24, sqltypes.Int32:
+			kind = compareInt32
+		case sqltypes.Int64:
+			kind = compareInt64
+		case sqltypes.Uint8:
+			kind = compareUint8
+		case sqltypes.Uint16:
+			kind = compareUint16
+		case sqltypes.Uint24, sqltypes.Uint32:
+			kind = compareUint32
+		case sqltypes.Uint64:
+			kind = compareUint64
+		case sqltypes.Float32:
+			kind = compareFloat32
+		case sqltypes.Float64:
+			kind = compareFloat64
+		}
+	case StringType:
+		// Binary collations order strings by their bytes, which is the order of their runes for utf8mb4
+		if IsTextOnly(t) && (t.collation == sql.Collation_binary || t.collation == sql.Collation_utf8mb4_0900_bin) {
+			kind = compareBinaryString
+		}
+	case datetimeType:
+		if t.baseType == sqltypes.Datetime || t.baseType == sqltypes.Timestamp {
+			kind = compareTime
+		}
+	}
+	return Comparator{typ: t, kind: kind}
+}
+
+// Compare compares the values given.
+func (c Comparator) Compare(a, b interface{}) (int, error) {
+	switch c.kind {
+	case compareInt8:
+		return compareOrdered[int8](c.typ, a, b)
+	case compareInt16:
+		return compareOrdered[int16](c.typ, a, b)
+	case compareInt32:
+		return compareOrdered[int32](c.typ, a, b)
+	case compareInt64:
+		return compareOrdered[int64](c.typ, a, b)
+	case compareUint8:
+		return compareOrdered[uint8](c.typ, a, b)
+	case compareUint16:
+		return compareOrdered[uint16](c.typ, a, b)
+	case compareUint32:
+		return compareOrdered[uint32](c.typ, a, b)
+	case compareUint64:
+		return compareOrdered[uint64](c.typ, a, b)
+	case compareFloat32:
+		return compareOrdered[float32](c.typ, a, b)
+	case compareFloat64:
+		return compareOrdered[float64](c.typ, a, b)
+	case compareBinaryString:
+		return compareStrings(c.typ, a, b)
+	case compareTime:
+		return compareTimes(c.typ, a, b)
+	default:
+		return c.typ.Compare(a, b)
+	}
+}
+
+// ordered is the set of Go types that number types convert their values to.
+type ordered interface {
+	int8 | int16 | int32 | int64 | uint8 | uint16 | uint32 | uint64 | float32 | float64
+}
+
+func compareOrdered[T ordered](t sql.Type, a, b interface{}) (int, error) {
+	ca, aok := a.(T)
+	cb, bok := b.(T)
+	if !aok || !bok {
+		return t.Compare(a, b)
+	}
+	if ca == cb {
+		return 0, nil
+	}
+	if ca < cb {
+		return -1, nil
+	}
+	return +1, nil
+}
+
+func compareStrings(t sql.Type, a, b interface{}) (int, error) {
+	ca, aok := a.(string)
+	cb, bok := b.(string)
+	if !aok || !bok {
+		return t.Compare(a, b)
+	}
+	return strings.Compare(ca, cb), nil
+}
+
+func compareTimes(t sql.Type, a, b interface{}) (int, error) {
+	ca, aok := a.(time.Time)
+	cb, bok := b.(time.Time)
+	if !aok || !bok {
+		return t.Compare(a, b)
+	}
+	if ca.Before(cb) {
+		return -1, nil
+	} else if ca.After(cb) {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Hasher returns a HashFunc for the type given. Integers, floats and datetimes are written as their binary
+// representation and strings as their weight strings in the collation of the type, so that strings that the collation
+// considers equal are written the same way. Values of any other type are written as they're formatted by fmt.
+func Hasher(t sql.Type) HashFunc {
+	switch t := t.(type) {
+	case NumberTypeImpl_:
+		switch t.baseType {
+		case sqltypes.Float32, sqltypes.Float64:
+			return hashFloat
+		case sqltypes.Uint8, sqltypes.Uint16, sqltypes.Uint24, sqltypes.Uint32, sqltypes.Uint64:
+			return hashUnsigned
+		default:
+			return hashSigned
+		}
+	case StringType:
+		if IsTextOnly(t) {
+			return hashString(t.collation)
+		}
+		return hashBinary
+	case datetimeType:
+		return hashTime(t.baseType == sqltypes.Date)
+	}
+	return hashFormatted
+}
+
+const (
+	hashTagNull byte = iota
+	hashTagValue
+	hashTagFormatted
+)
+
+func hashFormatted(h hash.Hash64, v interface{}) error {
+	if v == nil {
+		_, err := h.Write([]byte{hashTagNull})
+		return err
+	}
+	if _, err := h.Write([]byte{hashTagFormatted}); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(h, "%v", v)
+	return err
+}
+
+// hashUint64 writes a value tag followed by the value given to the hash.
+func hashUint64(h hash.Hash64, v uint64) error {
+	var b [9]byte
+	b[0] = hashTagValue
+	binary.LittleEndian.PutUint64(b[1:], v)
+	_, err := h.Write(b[:])
+	return err
+}
+
+func hashSigned(h hash.Hash64, v interface{}) error {
+	switch v := v.(type) {
+	case int64:
+		return hashUint64(h, uint64(v))
+	case int32:
+		return hashUint64(h, uint64(v))
+	case int16:
+		return hashUint64(h, uint64(v))
+	case int8:
+		return hashUint64(h, uint64(v))
+	}
+	return hashFormatted(h, v)
+}
+
+func hashUnsigned(h hash.Hash64, v interface{}) error {
+	switch v := v.(type) {
+	case uint64:
+		return hashUint64(h, v)
+	case uint32:
+		return hashUint64(h, uint64(v))
+	case uint16:
+		return hashUint64(h, uint64(v))
+	case uint8:
+		return hashUint64(h, uint64(v))
+	}
+	return hashFormatted(h, v)
+}
+
+func hashFloat(h hash.Hash64, v interface{}) error {
+	var f float64
+	switch v := v.(type) {
+	case float64:
+		f = v
+	case float32:
+		f = float64(v)
+	default:
+		return hashFormatted(h, v)
+	}
+	// Zero and negative zero are equal
+	if f == 0 {
+		f = 0
+	}
+	return hashUint64(h, math.Float64bits(f))
+}
+
+func hashString(collation sql.CollationID) HashFunc {
+	return func(h hash.Hash64, v interface{}) error {
+		s, ok := v.(string)
+		if !ok {
+			return hashFormatted(h, v)
+		}
+		if _, err := h.Write([]byte{hashTagValue}); err != nil {
+			return err
+		}
+		return collation.WriteWeightString(h, s)
+	}
+}
+
+func hashBinary(h hash.Hash64, v interface{}) error {
+	var b []byte
+	switch v := v.(type) {
+	case []byte:
+		b = v
+	case string:
+		// Binary values are sometimes represented as strings, which are written the same way
+		if _, err := h.Write([]byte{hashTagValue}); err != nil {
+			return err
+		}
+		_, err := h.Write([]byte(v))
+		return err
+	default:
+		return hashFormatted(h, v)
+	}
+	if _, err := h.Write([]byte{hashTagValue}); err != nil {
+		return err
+	}
+	_, err := h.Write(b)
+	return err
+}
+
+func hashTime(truncateToDate bool) HashFunc {
+	return func(h hash.Hash64, v interface{}) error {
+		t, ok := v.(time.Time)
+		if !ok {
+			return hashFormatted(h, v)
+		}
+		if truncateToDate {
+			t = t.Truncate(24 * time.Hour)
+		}
+		var b [13]byte
+		b[0] = hashTagValue
+		binary.LittleEndian.PutUint64(b[1:], uint64(t.Unix()))
+		binary.LittleEndian.PutUint32(b[9:], uint32(t.Nanosecond()))
+		_, err := h.Write(b[:])
+		return err
+	}
+}
+
+// RowHasher hashes the rows of a schema with the HashFunc of the type of each of its columns. A RowHasher isn't safe
+// for concurrent use.
+type RowHasher struct {
+	hashers []HashFunc
+	hash    hash.Hash64
+}
+
+// NewRowHasher returns a RowHasher for the rows of the schema given.
+func NewRowHasher(sch sql.Schema) *RowHasher {
+	hashers := make([]HashFunc, len(sch))
+	for i, col := range sch {
+		hashers[i] = Hasher(col.Type)
+	}
+	return &RowHasher{
+		hashers: hashers,
+		hash:    xxhash.New(),
+	}
+}
+
+// HashOf returns the hash of the row given. Rows with more values than the schema of this hasher has columns have
+// their remaining values written as they're formatted by fmt.
+func (r *RowHasher) HashOf(row sql.Row) (uint64, error) {
+	r.hash.Reset()
+	for i, v := range row {
+		hashValue := hashFormatted
+		if i < len(r.hashers) {
+			hashValue = r.hashers[i]
+		}
+		if err := hashValue(r.hash, v); err != nil {
+			return 0, err
+		}
+	}
+	return r.hash.Sum64(), nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cespare/xxhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestComparator(t *testing.T) {
+	ciText := MustCreateString(Text.Type(), Text.Length(), sql.Collation_utf8mb4_0900_ai_ci)
+	date1 := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	date2 := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		typ  sql.Type
+		val1 interface{}
+		val2 interface{}
+	}{
+		{Int8, int8(-1), int8(2)},
+		{Int16, int16(3), int16(3)},
+		{Int24, int32(4), int32(-4)},
+		{Int64, int64(math.MinInt64), int64(math.MaxInt64)},
+		{Int64, int64(1), "2"},
+		{Uint8, uint8(7), uint8(6)},
+		{Uint32, uint32(9), uint32(10)},
+		{Uint64, uint64(math.MaxUint64), uint64(0)},
+		{Float32, float32(-1.5), float32(1.5)},
+		{Float64, 2.5, 2.5},
+		{Float64, 2.5, int64(3)},
+		{LongText, "abc", "abd"},
+		{LongText, "b", "a"},
+		{LongText, "a", 1},
+		{ciText, "a", "A"},
+		{ciText, "a", "B"},
+		{Datetime, date1, date2},
+		{Datetime, date2, "2020-01-02"},
+		{Date, date1, date2},
+		{Timestamp, date2, date2},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v %v", test.typ, test.val1, test.val2), func(t *testing.T) {
+			expected, err := test.typ.Compare(test.val1, test.val2)
+			require.NoError(t, err)
+			cmp, err := NewComparator(test.typ).Compare(test.val1, test.val2)
+			require.NoError(t, err)
+			assert.Equal(t, expected, cmp)
+		})
+	}
+}
+
+func TestHasher(t *testing.T) {
+	ciText := MustCreateString(Text.Type(), Text.Length(), sql.Collation_utf8mb4_0900_ai_ci)
+	tests := []struct {
+		typ   sql.Type
+		val1  interface{}
+		val2  interface{}
+		equal bool
+	}{
+		{Int64, int64(1), int64(1), true},
+		{Int64, int64(1), int8(1), true},
+		{Int64, int64(1), int64(2), false},
+		{Int64, int64(0), nil, false},
+		{Uint64, uint64(1), uint32(1), true},
+		{Float64, 0.0, math.Copysign(0, -1), true},
+		{Float64, 1.5, float32(1.5), true},
+		{Float64, 1.5, 2.5, false},
+		{LongText, "a", "a", true},
+		{LongText, "a", "A", false},
+		{ciText, "a", "A", true},
+		{ciText, "a", "b", false},
+		{ciText, "", nil, false},
+		{LongBlob, []byte("abc"), []byte("abc"), true},
+		{LongBlob, []byte("abc"), "abc", true},
+		{Datetime, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{Datetime, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 1, 0, 0, 0, 1000, time.UTC), false},
+		{Date, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC), true},
+		{MustCreateDecimalType(10, 2), "1.50", "1.50", true},
+		{MustCreateDecimalType(10, 2), "1.50", "1.51", false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v %v", test.typ, test.val1, test.val2), func(t *testing.T) {
+			hashValue := Hasher(test.typ)
+			h1, h2 := xxhash.New(), xxhash.New()
+			require.NoError(t, hashValue(h1, test.val1))
+			require.NoError(t, hashValue(h2, test.val2))
+			assert.Equal(t, test.equal, h1.Sum64() == h2.Sum64())
+		})
+	}
+}
+
+func TestRowHasher(t *testing.T) {
+	require := require.New(t)
+	sch := sql.Schema{
+		{Name: "i", Type: Int64},
+		{Name: "s", Type: MustCreateString(Text.Type(), Text.Length(), sql.Collation_utf8mb4_0900_ai_ci)},
+	}
+	hasher := NewRowHasher(sch)
+
+	hashOf := func(row sql.Row) uint64 {
+		h, err := hasher.HashOf(row)
+		require.NoError(err)
+		return h
+	}
+
+	require.Equal(hashOf(sql.NewRow(int64(1), "a")), hashOf(sql.NewRow(int64(1), "A")))
+	require.NotEqual(hashOf(sql.NewRow(int64(1), "a")), hashOf(sql.NewRow(int64(2), "a")))
+	require.NotEqual(hashOf(sql.NewRow(int64(1), nil)), hashOf(sql.NewRow(nil, int64(1))))
+	require.NotEqual(hashOf(sql.NewRow(int64(1), "a")), hashOf(sql.NewRow(int64(1), "a", int64(1))))
+}
@@ -560,43 +560,32 @@ func (t NumberTypeImpl_) SQL2(v sql.Value) (sqltypes.Value, error) {
 		return sqltypes.NULL, nil
 	}
 
+	// The value is read according to its own type, which can be any number type that converts to this one
 	var val []byte
 	switch t.baseType {
-	case sqltypes.Int8:
-		x := values.ReadInt8(v.Val)
-		val = []byte(strconv.FormatInt(int64(x), 10))
-	case sqltypes.Int16:
-		x := values.ReadInt16(v.Val)
-		val = []byte(strconv.FormatInt(int64(x), 10))
-	case sqltypes.Int24:
-		x := values.ReadInt24(v.Val)
-		val = []byte(strconv.FormatInt(int64(x), 10))
-	case sqltypes.Int32:
-		x := values.ReadInt32(v.Val)
-		val = []byte(strconv.FormatInt(int64(x), 10))
-	case sqltypes.Int64:
-		x := values.ReadInt64(v.Val)
+	case sqltypes.Int8, sqltypes.Int16, sqltypes.Int24, sqltypes.Int32, sqltypes.Int64:
+		x, err := convertValueToInt64(t, v)
+		if err != nil {
+			return sqltypes.Value{}, err
+		}
 		val = []byte(strconv.FormatInt(x, 10))
-	case sqltypes.Uint8:
-		x := values.ReadUint8(v.Val)
-		val = []byte(strconv.FormatUint(uint64(x), 10))
-	case sqltypes.Uint16:
-		x := values.ReadUint16(v.Val)
-		val = []byte(strconv.FormatUint(uint64(x), 10))
-	case sqltypes.Uint24:
-		x := values.ReadUint24(v.Val)
-		val = []byte(strconv.FormatUint(uint64(x), 10))
-	case sqltypes.Uint32:
-		x := values.ReadUint32(v.Val)
-		val = []byte(strconv.FormatUint(uint64(x), 10))
-	case sqltypes.Uint64:
-		x := values.ReadUint64(v.Val)
+	case sqltypes.Uint8, sqltypes.Uint16, sqltypes.Uint24, sqltypes.Uint32, sqltypes.Uint64:
+		x, err := convertValueToUint64(t, v)
+		if err != nil {
+			return sqltypes.Value{}, err
+		}
 		val = []byte(strconv.FormatUint(x, 10))
 	case sqltypes.Float32:
-		x := values.ReadFloat32(v.Val)
-		val = []byte(strconv.FormatFloat(float64(x), 'f', -1, 32))
+		x, err := convertValueToFloat64(t, v)
+		if err != nil {
+			return sqltypes.Value{}, err
+		}
+		val = []byte(strconv.FormatFloat(x, 'f', -1, 32))
 	case sqltypes.Float64:
-		x := values.ReadFloat64(v.Val)
+		x, err := convertValueToFloat64(t, v)
+		if err != nil {
+			return sqltypes.Value{}, err
+		}
 		val = []byte(strconv.FormatFloat(x, 'f', -1, 64))
 	default:
 		panic(sql.ErrInvalidBaseType.New(t.baseType.String(), "number"))
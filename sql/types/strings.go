@@ -22,6 +22,7 @@ import (
 	"time"
 	"unicode/utf8"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
 	"github.com/shopspring/decimal"
@@ -47,6 +48,9 @@ var (
 	ErrLengthTooLarge    = errors.NewKind("length is %v but max allowed is %v")
 	ErrLengthBeyondLimit = errors.NewKind("string '%v' is too large for column '%v'")
 	ErrBinaryCollation   = errors.NewKind("binary types must have the binary collation")
+	// ErrIncorrectStringValue is returned when a string has characters that can't be represented in the character set
+	// of the column it's stored in.
+	ErrIncorrectStringValue = errors.NewKind("Incorrect string value: '%s' for column of type %s")
 
 	TinyText   = MustCreateStringWithDefaults(sqltypes.Text, TinyTextBlobMax)
 	Text       = MustCreateStringWithDefaults(sqltypes.Text, TextBlobMax)
@@ -61,6 +65,11 @@ var (
 	byteValueType   = reflect.TypeOf(([]byte)(nil))
 )
 
+func init() {
+	sql.RegisterErrorCode(mysql.ERDataTooLong, mysql.SSDataTooLong, ErrLengthBeyondLimit)
+	sql.RegisterErrorCode(mysql.ERTruncatedWrongValueForField, mysql.SSUnknownSQLState, ErrIncorrectStringValue)
+}
+
 type StringType struct {
 	baseType              query.Type
 	maxCharLength         int64
@@ -379,24 +388,18 @@ func ConvertToString(v interface{}, t sql.StringType) (string, error) {
 	}
 
 	s := t.(StringType)
+	// Strings are held as utf8mb4, so their lengths are those of their encoding in the type's character set
+	byteLength, err := s.encodedLength(val)
+	if err != nil {
+		return "", err
+	}
 	if s.baseType == sqltypes.Text {
 		// for TEXT types, we use the byte length instead of the character length
-		if int64(len(val)) > s.maxByteLength {
+		if int64(byteLength) > s.maxByteLength {
 			return "", ErrLengthBeyondLimit.New(val, t.String())
 		}
-	} else {
-		if t.CharacterSet().MaxLength() == 1 {
-			// if the character set only has a max size of 1, we can just count the bytes
-			if int64(len(val)) > s.maxCharLength {
-				return "", ErrLengthBeyondLimit.New(val, t.String())
-			}
-		} else {
-			// TODO: this should count the string's length properly according to the character set
-			// convert 'val' string to rune to count the character length, not byte length
-			if int64(len([]rune(val))) > s.maxCharLength {
-				return "", ErrLengthBeyondLimit.New(val, t.String())
-			}
-		}
+	} else if int64(utf8.RuneCountInString(val)) > s.maxCharLength {
+		return "", ErrLengthBeyondLimit.New(val, t.String())
 	}
 
 	if s.baseType == sqltypes.Binary {
@@ -406,6 +409,58 @@ func ConvertToString(v interface{}, t sql.StringType) (string, error) {
 	return val, nil
 }
 
+// encodedLength returns the length in bytes of the string given when encoded in the character set of this type, or
+// ErrIncorrectStringValue if it has characters that the character set can't represent, such as characters outside the
+// Basic Multilingual Plane in utf8mb3, or characters other than those of Western European languages in latin1.
+func (t StringType) encodedLength(val string) (int, error) {
+	charset := t.CharacterSet()
+	switch charset {
+	case sql.CharacterSet_Unspecified, sql.CharacterSet_binary, sql.CharacterSet_utf8mb4:
+		return len(val), nil
+	case sql.CharacterSet_utf8mb3, sql.CharacterSet_latin1, sql.CharacterSet_ascii:
+		// These character sets encode ASCII as utf8mb4 does
+		if isASCII(val) {
+			return len(val), nil
+		}
+	}
+	encoder := charset.Encoder()
+	if encoder == nil {
+		return len(val), nil
+	}
+	encoded, ok := encoder.Encode(encodings.StringToBytes(val))
+	if !ok {
+		return 0, ErrIncorrectStringValue.New(unencodableRune(encoder, val), t.String())
+	}
+	return len(encoded), nil
+}
+
+// unencodableRune returns the bytes of the first rune of the string given that the encoder given can't encode, in
+// hexadecimal as MySQL reports them.
+func unencodableRune(encoder encodings.Encoder, val string) string {
+	for i := 0; i < len(val); {
+		_, size := utf8.DecodeRuneInString(val[i:])
+		runeBytes := []byte(val[i : i+size])
+		i += size
+		if _, ok := encoder.EncodeRune(runeBytes); !ok {
+			var sb strings2.Builder
+			for _, b := range runeBytes {
+				fmt.Fprintf(&sb, "\\x%02X", b)
+			}
+			return sb.String()
+		}
+	}
+	return val
+}
+
+func isASCII(val string) bool {
+	for i := 0; i < len(val); i++ {
+		if val[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
 // ConvertToCollatedString returns the given interface as a string, along with its collation. If the Type possess a
 // collation, then that collation is returned. If the Type does not possess a collation (such as an integer), then the
 // value is converted to a string and the default collation is used. If the value is already a string then no additional
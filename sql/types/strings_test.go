@@ -322,6 +322,16 @@ func TestStringConvert(t *testing.T) {
 		{MustCreateBinary(sqltypes.VarBinary, 3), []byte{01, 02, 03, 04}, nil, true},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), []byte("abcd"), nil, true},
 		{MustCreateStringWithDefaults(sqltypes.Char, 20), JSONDocument{Val: nil}, "null", false},
+
+		// lengths are those of the strings in the column's character set, whose characters they must be made of
+		{MustCreateString(sqltypes.VarChar, 3, sql.Collation_latin1_swedish_ci), "été", "été", false},
+		{MustCreateString(sqltypes.VarChar, 3, sql.Collation_latin1_swedish_ci), "étés", nil, true},
+		{MustCreateString(sqltypes.Text, 255, sql.Collation_latin1_swedish_ci), strings.Repeat("é", 255), strings.Repeat("é", 255), false},
+		{MustCreateString(sqltypes.Text, 255, sql.Collation_latin1_swedish_ci), strings.Repeat("é", 256), nil, true},
+		{MustCreateString(sqltypes.VarChar, 3, sql.Collation_latin1_swedish_ci), "ā", nil, true},
+		{MustCreateString(sqltypes.VarChar, 3, sql.Collation_utf8mb3_general_ci), "é", "é", false},
+		{MustCreateString(sqltypes.VarChar, 3, sql.Collation_utf8mb3_general_ci), "😀", nil, true},
+		{MustCreateString(sqltypes.VarChar, 3, sql.Collation_ascii_general_ci), "é", nil, true},
 	}
 
 	for _, test := range tests {
@@ -340,6 +350,12 @@ func TestStringConvert(t *testing.T) {
 	}
 }
 
+func TestStringConvertIncorrectStringValue(t *testing.T) {
+	_, err := MustCreateString(sqltypes.VarChar, 10, sql.Collation_utf8mb3_general_ci).Convert("ab😀")
+	require.True(t, ErrIncorrectStringValue.Is(err))
+	require.Equal(t, `Incorrect string value: '\xF0\x9F\x98\x80' for column of type varchar(10) CHARACTER SET utf8mb3 COLLATE utf8mb3_general_ci`, err.Error())
+}
+
 func TestStringString(t *testing.T) {
 	tests := []struct {
 		typ         sql.Type
@@ -378,6 +378,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemStringType("bind_address"),
 		Default:           "*",
 	},
+	"binlog_format": {
+		Name:              "binlog_format",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemEnumType("binlog_format", "ROW", "STATEMENT", "MIXED"),
+		Default:           "ROW",
+	},
 	"binlog_gtid_simple_recovery": {
 		Name:              "binlog_gtid_simple_recovery",
 		Scope:             sql.SystemVariableScope_Global,
@@ -386,6 +394,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemBoolType("binlog_gtid_simple_recovery"),
 		Default:           int8(1),
 	},
+	"binlog_row_image": {
+		Name:              "binlog_row_image",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemEnumType("binlog_row_image", "FULL", "MINIMAL", "NOBLOB"),
+		Default:           "FULL",
+	},
 	"block_encryption_mode": {
 		Name:              "block_encryption_mode",
 		Scope:             sql.SystemVariableScope_Both,
@@ -978,6 +994,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemBoolType("inmemory_joins"),
 		Default:           int8(0),
 	},
+	"innodb_lock_wait_timeout": {
+		Name:              "innodb_lock_wait_timeout",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemIntType("innodb_lock_wait_timeout", 1, 1073741824, false),
+		Default:           int64(50),
+	},
 	"innodb_stats_auto_recalc": {
 		Name:              "innodb_stats_auto_recalc",
 		Scope:             sql.SystemVariableScope_Global,
@@ -1146,6 +1170,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("lock_wait_timeout", 1, 31536000, false),
 		Default:           int64(31536000),
 	},
+	"log_bin": {
+		Name:              "log_bin",
+		Scope:             sql.SystemVariableScope_Global,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemBoolType("log_bin"),
+		Default:           int8(0),
+	},
 	"log_error": {
 		Name:              "log_error",
 		Scope:             sql.SystemVariableScope_Global,
@@ -1202,6 +1234,22 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemBoolType("log_raw"),
 		Default:           int8(0),
 	},
+	"log_replica_updates": {
+		Name:              "log_replica_updates",
+		Scope:             sql.SystemVariableScope_Global,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemBoolType("log_replica_updates"),
+		Default:           int8(1),
+	},
+	"log_slave_updates": {
+		Name:              "log_slave_updates",
+		Scope:             sql.SystemVariableScope_Global,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemBoolType("log_slave_updates"),
+		Default:           int8(1),
+	},
 	"log_slow_admin_statements": {
 		Name:              "log_slow_admin_statements",
 		Scope:             sql.SystemVariableScope_Global,
@@ -1410,6 +1458,22 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("max_prepared_stmt_count", 0, 4194304, false),
 		Default:           int64(16382),
 	},
+	"max_result_rows": {
+		Name:              "max_result_rows",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              types.NewSystemUintType("max_result_rows", 0, 18446744073709551615),
+		Default:           uint64(0),
+	},
+	"max_result_size": {
+		Name:              "max_result_size",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              types.NewSystemUintType("max_result_size", 0, 18446744073709551615),
+		Default:           uint64(0),
+	},
 	"max_seeks_for_key": {
 		Name:              "max_seeks_for_key",
 		Scope:             sql.SystemVariableScope_Both,
@@ -1674,15 +1738,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("optimizer_search_depth", 0, 62, false),
 		Default:           int64(62),
 	},
-	// TODO: add proper support for this
-	// "optimizer_switch": {
-	//	Name: "optimizer_switch",
-	//	Scope: SystemVariableScope_Both,
-	//	Dynamic: true,
-	//	SetVarHintApplies: true,
-	//	Type: NewSystemSetType("optimizer_switch"),
-	//	Default: "",
-	// },
+	"optimizer_switch": {
+		Name:              "optimizer_switch",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              types.NewSystemStringType("optimizer_switch"),
+		Default:           sql.OptimizerSwitchDefault,
+	},
 	"optimizer_trace": {
 		Name:              "optimizer_trace",
 		Scope:             sql.SystemVariableScope_Both,
@@ -1747,6 +1810,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemBoolType("partial_revokes"),
 		Default:           int8(0),
 	},
+	"partition_read_ahead": {
+		Name:              "partition_read_ahead",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              types.NewSystemIntType("partition_read_ahead", 0, 1024, false),
+		Default:           int64(0),
+	},
 	"password_history": {
 		Name:              "password_history",
 		Scope:             sql.SystemVariableScope_Global,
@@ -1835,6 +1906,22 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemBoolType("print_identified_with_as_hex"),
 		Default:           int8(0),
 	},
+	"profiling": {
+		Name:              "profiling",
+		Scope:             sql.SystemVariableScope_Session,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemBoolType("profiling"),
+		Default:           int8(0),
+	},
+	"profiling_history_size": {
+		Name:              "profiling_history_size",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              types.NewSystemIntType("profiling_history_size", 0, 100, false),
+		Default:           int64(15),
+	},
 	"protocol_compression_algorithms": {
 		Name:              "protocol_compression_algorithms",
 		Scope:             sql.SystemVariableScope_Global,
@@ -2279,11 +2366,11 @@ var systemVars = map[string]sql.SystemVariable{
 	},
 	"sql_log_bin": {
 		Name:              "sql_log_bin",
-		Scope:             sql.SystemVariableScope_Both,
+		Scope:             sql.SystemVariableScope_Session,
 		Dynamic:           true,
 		SetVarHintApplies: false,
 		Type:              types.NewSystemBoolType("sql_log_bin"),
-		Default:           int8(0),
+		Default:           int8(1),
 	},
 	"sql_log_off": {
 		Name:              "sql_log_off",
@@ -2429,6 +2516,14 @@ var systemVars = map[string]sql.SystemVariable{
 		Type:              types.NewSystemIntType("stored_program_definition_cache", 256, 524288, false),
 		Default:           int64(256),
 	},
+	"strict_comparisons": {
+		Name:              "strict_comparisons",
+		Scope:             sql.SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              types.NewSystemBoolType("strict_comparisons"),
+		Default:           int8(0),
+	},
 	"super_read_only": {
 		Name:              "super_read_only",
 		Scope:             sql.SystemVariableScope_Global,
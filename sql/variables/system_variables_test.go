@@ -110,6 +110,12 @@ func TestHasDefaultValue(t *testing.T) {
 	require.NoError(err)
 	require.False(sql.HasDefaultValue(ctx, sess, "auto_increment_increment"))
 	require.True(sql.HasDefaultValue(ctx, sess, "non_existing_key")) // Returns true for non-existent keys
+
+	// Only the variables of a session are needed
+	vars := sql.NewVariableStore()
+	require.True(sql.HasDefaultValue(ctx, vars, "auto_increment_increment"))
+	require.NoError(vars.SetSessionVariable(ctx, "auto_increment_increment", 123))
+	require.False(sql.HasDefaultValue(ctx, vars, "auto_increment_increment"))
 }
 
 func TestInitReadonlySessionVariable(t *testing.T) {
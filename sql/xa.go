@@ -0,0 +1,248 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// XID identifies a branch of an XA transaction, which is a transaction coordinated by an external transaction manager.
+// https://dev.mysql.com/doc/refman/8.0/en/xa-statements.html
+type XID struct {
+	// Gtrid is the global transaction identifier
+	Gtrid string
+	// Bqual is the branch qualifier
+	Bqual string
+	// FormatID identifies the format used by the gtrid and bqual values
+	FormatID int64
+}
+
+// String returns the XID as it's written in XA statements.
+func (x XID) String() string {
+	return fmt.Sprintf("X'%s',X'%s',%d", hex.EncodeToString([]byte(x.Gtrid)), hex.EncodeToString([]byte(x.Bqual)), x.FormatID)
+}
+
+// XAState is the state of a branch of an XA transaction.
+type XAState byte
+
+const (
+	// XAActive is the state of a branch after XA START, while statements are executed in it
+	XAActive XAState = iota
+	// XAIdle is the state of a branch after XA END, when it can only be prepared, committed or rolled back
+	XAIdle
+	// XAPrepared is the state of a branch after XA PREPARE, when it's no longer associated with a session and can be
+	// committed or rolled back by any session
+	XAPrepared
+)
+
+// String returns the state as MySQL names it.
+func (s XAState) String() string {
+	switch s {
+	case XAActive:
+		return "ACTIVE"
+	case XAIdle:
+		return "IDLE"
+	case XAPrepared:
+		return "PREPARED"
+	default:
+		return "NON-EXISTING"
+	}
+}
+
+// XATransaction is a branch of an XA transaction. Its work is done in the transaction of the session that started
+// it, along with the two-phase transactions of the databases that the session wrote to. When it's prepared, its
+// two-phase transactions are prepared, and it's detached from the session along with the session's transaction, so
+// that any session can commit or roll it back.
+type XATransaction struct {
+	XID   XID
+	State XAState
+	// ConnectionID is the ID of the session the branch is associated with, or zero once it has been prepared
+	ConnectionID uint32
+	// Session is the session that started the branch, or nil if it doesn't implement TransactionSession
+	Session TransactionSession
+	// Transaction is the transaction of the session that started the branch, if any
+	Transaction Transaction
+	// Participants are the two-phase transactions of the databases written by the branch, by database name
+	Participants map[string]TwoPhaseTransaction
+	// Changes are the changes to the rows of tables captured in the branch, published once it's committed
+	Changes []ChangeEvent
+}
+
+// Prepare prepares the two-phase transactions of the branch. If any of them fails to prepare, the branch is rolled
+// back and ErrXARollback is returned.
+func (x *XATransaction) Prepare(ctx *Context) error {
+	for _, name := range sortedParticipants(x.Participants) {
+		if err := x.Participants[name].Prepare(ctx); err != nil {
+			if rbErr := x.Rollback(ctx); rbErr != nil {
+				ctx.GetLogger().Warnf("unable to roll back XA transaction %s: %s", x.XID, rbErr.Error())
+			}
+			return ErrXARollback.New(fmt.Sprintf("database %s failed to prepare: %s", name, err.Error()))
+		}
+	}
+	return nil
+}
+
+// Commit commits the two-phase transactions of the branch, which must have been prepared, followed by the transaction
+// of the session that started it.
+func (x *XATransaction) Commit(ctx *Context) error {
+	var commitErr error
+	for _, name := range sortedParticipants(x.Participants) {
+		if err := x.Participants[name].Commit(ctx); err != nil && commitErr == nil {
+			commitErr = err
+		}
+	}
+	if x.Session != nil && x.Transaction != nil {
+		if err := x.Session.CommitTransaction(ctx, x.Transaction); err != nil && commitErr == nil {
+			commitErr = err
+		}
+	}
+	if commitErr == nil {
+		PublishChanges(ctx, x.Changes)
+	}
+	return commitErr
+}
+
+// Rollback rolls back the two-phase transactions of the branch, followed by the transaction of the session that
+// started it.
+func (x *XATransaction) Rollback(ctx *Context) error {
+	rollbackErr := rollbackTwoPhaseTransactions(ctx, x.Participants, sortedParticipants(x.Participants))
+	if x.Session != nil && x.Transaction != nil {
+		if err := x.Session.Rollback(ctx, x.Transaction); err != nil && rollbackErr == nil {
+			rollbackErr = err
+		}
+	}
+	return rollbackErr
+}
+
+// sortedParticipants returns the names of the databases of the two-phase transactions given, in sorted order.
+func sortedParticipants(txs map[string]TwoPhaseTransaction) []string {
+	names := make([]string, 0, len(txs))
+	for name := range txs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// XARegistry keeps track of the branches of XA transactions of all sessions, from XA START until they're committed or
+// rolled back.
+type XARegistry struct {
+	mu       sync.Mutex
+	txs      map[XID]*XATransaction
+	sessions map[uint32]*XATransaction
+}
+
+// NewXARegistry returns a new, empty XARegistry.
+func NewXARegistry() *XARegistry {
+	return &XARegistry{
+		txs:      make(map[XID]*XATransaction),
+		sessions: make(map[uint32]*XATransaction),
+	}
+}
+
+// Start registers a new active branch with the XID given for the session with the connection ID given. It returns
+// ErrXADuplicateXID if a branch with the same XID already exists, and ErrXAInvalidState if the session is already
+// associated with a branch.
+func (r *XARegistry) Start(connID uint32, xid XID) (*XATransaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if current, ok := r.sessions[connID]; ok {
+		return nil, ErrXAInvalidState.New(current.State)
+	}
+	if _, ok := r.txs[xid]; ok {
+		return nil, ErrXADuplicateXID.New()
+	}
+	tx := &XATransaction{XID: xid, State: XAActive, ConnectionID: connID}
+	r.txs[xid] = tx
+	r.sessions[connID] = tx
+	return tx, nil
+}
+
+// SessionTransaction returns the branch the session with the connection ID given is associated with, or nil if there
+// is none.
+func (r *XARegistry) SessionTransaction(connID uint32) *XATransaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.sessions[connID]
+}
+
+// SetState sets the state of the branch given. A branch that's prepared is detached from its session.
+func (r *XARegistry) SetState(tx *XATransaction, state XAState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tx.State = state
+	if state == XAPrepared {
+		delete(r.sessions, tx.ConnectionID)
+		tx.ConnectionID = 0
+	}
+}
+
+// TakePrepared removes the prepared branch with the XID given from the registry and returns it, so that it can be
+// committed or rolled back by a single session. It returns ErrXAUnknownXID if there's no prepared branch with the XID.
+func (r *XARegistry) TakePrepared(xid XID) (*XATransaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tx, ok := r.txs[xid]
+	if !ok || tx.State != XAPrepared {
+		return nil, ErrXAUnknownXID.New()
+	}
+	delete(r.txs, xid)
+	return tx, nil
+}
+
+// Remove removes the branch given, which must not be prepared, from the registry.
+func (r *XARegistry) Remove(tx *XATransaction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.txs, tx.XID)
+	delete(r.sessions, tx.ConnectionID)
+}
+
+// PreparedTransactions returns the XIDs of the prepared branches, sorted by their gtrid and bqual values.
+func (r *XARegistry) PreparedTransactions() []XID {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var xids []XID
+	for xid, tx := range r.txs {
+		if tx.State == XAPrepared {
+			xids = append(xids, xid)
+		}
+	}
+	sort.Slice(xids, func(i, j int) bool {
+		if c := strings.Compare(xids[i].Gtrid, xids[j].Gtrid); c != 0 {
+			return c < 0
+		}
+		if c := strings.Compare(xids[i].Bqual, xids[j].Bqual); c != 0 {
+			return c < 0
+		}
+		return xids[i].FormatID < xids[j].FormatID
+	})
+	return xids
+}
+
+// EndSession removes the branch the session with the connection ID given is associated with, if any, as the session
+// is being closed. Prepared branches aren't associated with a session, and outlive it.
+func (r *XARegistry) EndSession(connID uint32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if tx, ok := r.sessions[connID]; ok {
+		delete(r.sessions, connID)
+		delete(r.txs, tx.XID)
+	}
+}
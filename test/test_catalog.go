@@ -67,6 +67,24 @@ func (c *Catalog) RemoveDatabase(ctx *sql.Context, dbName string) error {
 	}
 }
 
+// RenameDatabase renames a database in the catalog.
+func (c *Catalog) RenameDatabase(ctx *sql.Context, oldName, newName string) error {
+	renamer, ok := c.provider.(sql.DatabaseRenamer)
+	if !ok {
+		return sql.ErrRenameDatabaseNotSupported.New(oldName)
+	}
+	return renamer.RenameDatabase(ctx, oldName, newName)
+}
+
+// Flush passes a FLUSH statement along to the provider, if it handles them.
+func (c *Catalog) Flush(ctx *sql.Context, option sql.FlushOption, tables []sql.DbTable) error {
+	handler, ok := c.provider.(sql.FlushHandler)
+	if !ok {
+		return nil
+	}
+	return handler.Flush(ctx, option, tables)
+}
+
 func (c *Catalog) HasDB(ctx *sql.Context, db string) bool {
 	return c.provider.HasDatabase(ctx, db)
 }